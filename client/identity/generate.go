@@ -0,0 +1,3 @@
+package identity
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../../service/identity/handler/api.yaml
@@ -0,0 +1,16410 @@
+// Package inventory provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package inventory
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Batch defines model for Batch.
+type Batch struct {
+	BrewDate  *time.Time `json:"brew_date"`
+	Notes     *string    `json:"notes"`
+	Recipe    *string    `json:"recipe"`
+	Ref       *string    `json:"ref"`
+	ShortName *string    `json:"short_name"`
+	Skip      *bool      `json:"skip"`
+}
+
+// BatchIngredientLotResponse defines model for BatchIngredientLotResponse.
+type BatchIngredientLotResponse struct {
+	BreweryLotCode        *string `json:"brewery_lot_code"`
+	IngredientCategory    string  `json:"ingredient_category"`
+	IngredientLotUuid     string  `json:"ingredient_lot_uuid"`
+	IngredientName        string  `json:"ingredient_name"`
+	IngredientUuid        string  `json:"ingredient_uuid"`
+	PurchaseOrderLineUuid *string `json:"purchase_order_line_uuid"`
+	ReceivedUnit          string  `json:"received_unit"`
+}
+
+// BatchUsageMovementResponse defines model for BatchUsageMovementResponse.
+type BatchUsageMovementResponse struct {
+	Amount                int64     `json:"amount"`
+	AmountUnit            string    `json:"amount_unit"`
+	BreweryLotCode        *string   `json:"brewery_lot_code"`
+	IngredientCategory    string    `json:"ingredient_category"`
+	IngredientLotUuid     string    `json:"ingredient_lot_uuid"`
+	IngredientName        string    `json:"ingredient_name"`
+	IngredientUuid        string    `json:"ingredient_uuid"`
+	MovementUuid          string    `json:"movement_uuid"`
+	OccurredAt            time.Time `json:"occurred_at"`
+	PurchaseOrderLineUuid *string   `json:"purchase_order_line_uuid"`
+	Reason                string    `json:"reason"`
+	UsageUuid             string    `json:"usage_uuid"`
+}
+
+// BatchUsagePick defines model for BatchUsagePick.
+type BatchUsagePick struct {
+	Amount            *int64 `json:"amount"`
+	AmountUnit        string `json:"amount_unit"`
+	IngredientLotUuid string `json:"ingredient_lot_uuid"`
+	StockLocationUuid string `json:"stock_location_uuid"`
+}
+
+// BatchUsageResponse defines model for BatchUsageResponse.
+type BatchUsageResponse struct {
+	Movements []InventoryMovementResponse `json:"movements"`
+	UsageUuid string                      `json:"usage_uuid"`
+}
+
+// BeerLotItemEventResponse defines model for BeerLotItemEventResponse.
+type BeerLotItemEventResponse struct {
+	BeerLotItemUuid string    `json:"beer_lot_item_uuid"`
+	CreatedAt       time.Time `json:"created_at"`
+	Destination     *string   `json:"destination"`
+	FromStatus      *string   `json:"from_status"`
+	Notes           *string   `json:"notes"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	ToStatus        string    `json:"to_status"`
+	Uuid            string    `json:"uuid"`
+}
+
+// BeerLotItemResponse defines model for BeerLotItemResponse.
+type BeerLotItemResponse struct {
+	BeerLotUuid string     `json:"beer_lot_uuid"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Identifier  *string    `json:"identifier"`
+	Notes       *string    `json:"notes"`
+	Status      string     `json:"status"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Uuid        string     `json:"uuid"`
+}
+
+// BeerLotResponse defines model for BeerLotResponse.
+type BeerLotResponse struct {
+	BestBy              *string                `json:"best_by"`
+	Container           *string                `json:"container"`
+	CreatedAt           time.Time              `json:"created_at"`
+	DeletedAt           *time.Time             `json:"deleted_at"`
+	Items               *[]BeerLotItemResponse `json:"items,omitempty"`
+	LotCode             *string                `json:"lot_code"`
+	Notes               *string                `json:"notes"`
+	PackageFormatName   *string                `json:"package_format_name"`
+	PackagedAt          time.Time              `json:"packaged_at"`
+	PackagingRunUuid    *string                `json:"packaging_run_uuid"`
+	ProductionBatchUuid string                 `json:"production_batch_uuid"`
+	Quantity            *int                   `json:"quantity"`
+	UpdatedAt           time.Time              `json:"updated_at"`
+	Uuid                string                 `json:"uuid"`
+	VolumePerUnit       *int64                 `json:"volume_per_unit"`
+	VolumePerUnitUnit   *string                `json:"volume_per_unit_unit"`
+}
+
+// BeerLotStockLevelResponse defines model for BeerLotStockLevelResponse.
+type BeerLotStockLevelResponse struct {
+	BeerLotUuid         string     `json:"beer_lot_uuid"`
+	BestBy              *string    `json:"best_by"`
+	Container           *string    `json:"container"`
+	CurrentQuantity     *int       `json:"current_quantity"`
+	CurrentVolume       int64      `json:"current_volume"`
+	CurrentVolumeUnit   string     `json:"current_volume_unit"`
+	InitialQuantity     *int       `json:"initial_quantity"`
+	LotCode             *string    `json:"lot_code"`
+	PackageFormatName   *string    `json:"package_format_name"`
+	PackagedAt          *time.Time `json:"packaged_at"`
+	PackagingRunUuid    *string    `json:"packaging_run_uuid"`
+	ProductionBatchUuid string     `json:"production_batch_uuid"`
+	StockLocationName   string     `json:"stock_location_name"`
+	StockLocationUuid   string     `json:"stock_location_uuid"`
+	VolumePerUnit       *int64     `json:"volume_per_unit"`
+	VolumePerUnitUnit   *string    `json:"volume_per_unit_unit"`
+}
+
+// BrewimportResult defines model for BrewimportResult.
+type BrewimportResult struct {
+	DryRun  bool             `json:"dry_run"`
+	Results []RecordResult   `json:"results"`
+	Totals  BrewimportTotals `json:"totals"`
+}
+
+// BrewimportTotals defines model for BrewimportTotals.
+type BrewimportTotals struct {
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+	Matched int `json:"matched"`
+	Records int `json:"records"`
+	Skipped int `json:"skipped"`
+	Valid   int `json:"valid"`
+}
+
+// ConsumptionCategoryTotal defines model for ConsumptionCategoryTotal.
+type ConsumptionCategoryTotal struct {
+	Category  string `json:"category"`
+	CostCents int64  `json:"cost_cents"`
+}
+
+// ConsumptionReasonTotal defines model for ConsumptionReasonTotal.
+type ConsumptionReasonTotal struct {
+	CostCents int64  `json:"cost_cents"`
+	Movements int    `json:"movements"`
+	Reason    string `json:"reason"`
+}
+
+// CostedMovement defines model for CostedMovement.
+type CostedMovement struct {
+	Amount            int64     `json:"amount"`
+	AmountUnit        string    `json:"amount_unit"`
+	Category          string    `json:"category"`
+	Direction         string    `json:"direction"`
+	IngredientLotUuid string    `json:"ingredient_lot_uuid"`
+	IngredientName    string    `json:"ingredient_name"`
+	IngredientUuid    string    `json:"ingredient_uuid"`
+	MovementUuid      string    `json:"movement_uuid"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	Reason            string    `json:"reason"`
+	StockLocationUuid string    `json:"stock_location_uuid"`
+	ValueCents        int64     `json:"value_cents"`
+}
+
+// CreateBatchUsageRequest defines model for CreateBatchUsageRequest.
+type CreateBatchUsageRequest struct {
+	Notes             *string          `json:"notes"`
+	Picks             []BatchUsagePick `json:"picks"`
+	ProductionRefUuid *string          `json:"production_ref_uuid"`
+	Reason            *string          `json:"reason"`
+	UsedAt            *string          `json:"used_at"`
+}
+
+// CreateBeerLotItemRequest defines model for CreateBeerLotItemRequest.
+type CreateBeerLotItemRequest struct {
+	BeerLotUuid string  `json:"beer_lot_uuid"`
+	Identifier  *string `json:"identifier"`
+	Notes       *string `json:"notes"`
+	Status      *string `json:"status"`
+}
+
+// CreateBeerLotRequest defines model for CreateBeerLotRequest.
+type CreateBeerLotRequest struct {
+	BestBy              *string    `json:"best_by"`
+	Container           *string    `json:"container"`
+	ItemIdentifiers     *[]string  `json:"item_identifiers"`
+	LotCode             *string    `json:"lot_code"`
+	Notes               *string    `json:"notes"`
+	PackageFormatName   *string    `json:"package_format_name"`
+	PackagedAt          *time.Time `json:"packaged_at"`
+	PackagingRunUuid    *string    `json:"packaging_run_uuid"`
+	ProductionBatchUuid string     `json:"production_batch_uuid"`
+	Quantity            *int       `json:"quantity"`
+	StockLocationUuid   *string    `json:"stock_location_uuid"`
+	VolumePerUnit       *int64     `json:"volume_per_unit"`
+	VolumePerUnitUnit   *string    `json:"volume_per_unit_unit"`
+}
+
+// CreateCycleCountRequest defines model for CreateCycleCountRequest.
+type CreateCycleCountRequest struct {
+	Blind             *bool   `json:"blind"`
+	Notes             *string `json:"notes"`
+	StockLocationUuid string  `json:"stock_location_uuid"`
+}
+
+// CreateIngredientLotHopDetailRequest defines model for CreateIngredientLotHopDetailRequest.
+type CreateIngredientLotHopDetailRequest struct {
+	AlphaAcid         *float64 `json:"alpha_acid"`
+	BetaAcid          *float64 `json:"beta_acid"`
+	IngredientLotUuid string   `json:"ingredient_lot_uuid"`
+}
+
+// CreateIngredientLotMaltDetailRequest defines model for CreateIngredientLotMaltDetailRequest.
+type CreateIngredientLotMaltDetailRequest struct {
+	IngredientLotUuid string   `json:"ingredient_lot_uuid"`
+	MoisturePercent   *float64 `json:"moisture_percent"`
+}
+
+// CreateIngredientLotRequest defines model for CreateIngredientLotRequest.
+type CreateIngredientLotRequest struct {
+	BestByAt              *time.Time `json:"best_by_at"`
+	BreweryLotCode        *string    `json:"brewery_lot_code"`
+	ExpiresAt             *time.Time `json:"expires_at"`
+	IngredientUuid        string     `json:"ingredient_uuid"`
+	Notes                 *string    `json:"notes"`
+	OriginatorLotCode     *string    `json:"originator_lot_code"`
+	OriginatorName        *string    `json:"originator_name"`
+	OriginatorType        *string    `json:"originator_type"`
+	PurchaseOrderLineUuid *string    `json:"purchase_order_line_uuid"`
+	ReceiptUuid           *string    `json:"receipt_uuid"`
+	ReceivedAmount        *int64     `json:"received_amount"`
+	ReceivedAt            *time.Time `json:"received_at"`
+	ReceivedUnit          string     `json:"received_unit"`
+	SupplierUuid          *string    `json:"supplier_uuid"`
+}
+
+// CreateIngredientLotYeastDetailRequest defines model for CreateIngredientLotYeastDetailRequest.
+type CreateIngredientLotYeastDetailRequest struct {
+	Generation        *int     `json:"generation"`
+	IngredientLotUuid string   `json:"ingredient_lot_uuid"`
+	ViabilityPercent  *float64 `json:"viability_percent"`
+}
+
+// CreateIngredientRequest defines model for CreateIngredientRequest.
+type CreateIngredientRequest struct {
+	Category    *string `json:"category"`
+	DefaultUnit string  `json:"default_unit"`
+	Description *string `json:"description"`
+	Name        string  `json:"name"`
+}
+
+// CreateInventoryAdjustmentRequest defines model for CreateInventoryAdjustmentRequest.
+type CreateInventoryAdjustmentRequest struct {
+	AdjustedAt        *time.Time `json:"adjusted_at"`
+	Amount            *int64     `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	IngredientLotUuid *string    `json:"ingredient_lot_uuid"`
+	Notes             *string    `json:"notes"`
+	Reason            string     `json:"reason"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+}
+
+// CreateInventoryMovementRequest defines model for CreateInventoryMovementRequest.
+type CreateInventoryMovementRequest struct {
+	AdjustmentUuid    *string    `json:"adjustment_uuid"`
+	Amount            *int64     `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	Direction         *string    `json:"direction"`
+	IngredientLotUuid *string    `json:"ingredient_lot_uuid"`
+	Notes             *string    `json:"notes"`
+	OccurredAt        *time.Time `json:"occurred_at"`
+	Reason            *string    `json:"reason"`
+	ReceiptUuid       *string    `json:"receipt_uuid"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+	TransferUuid      *string    `json:"transfer_uuid"`
+	UsageUuid         *string    `json:"usage_uuid"`
+}
+
+// CreateInventoryReceiptRequest defines model for CreateInventoryReceiptRequest.
+type CreateInventoryReceiptRequest struct {
+	Notes             *string    `json:"notes"`
+	PurchaseOrderUuid *string    `json:"purchase_order_uuid"`
+	ReceivedAt        *time.Time `json:"received_at"`
+	ReferenceCode     *string    `json:"reference_code"`
+	SupplierUuid      *string    `json:"supplier_uuid"`
+}
+
+// CreateInventoryReservationRequest defines model for CreateInventoryReservationRequest.
+type CreateInventoryReservationRequest struct {
+	Amount            *int64  `json:"amount"`
+	AmountUnit        string  `json:"amount_unit"`
+	IngredientLotUuid string  `json:"ingredient_lot_uuid"`
+	Notes             *string `json:"notes"`
+	ProductionRefUuid *string `json:"production_ref_uuid"`
+	StockLocationUuid string  `json:"stock_location_uuid"`
+}
+
+// CreateInventoryTransferRequest defines model for CreateInventoryTransferRequest.
+type CreateInventoryTransferRequest struct {
+	Amount             *int64     `json:"amount"`
+	AmountUnit         string     `json:"amount_unit"`
+	BeerLotUuid        *string    `json:"beer_lot_uuid"`
+	DestLocationUuid   string     `json:"dest_location_uuid"`
+	IngredientLotUuid  *string    `json:"ingredient_lot_uuid"`
+	Notes              *string    `json:"notes"`
+	SourceLocationUuid string     `json:"source_location_uuid"`
+	TransferredAt      *time.Time `json:"transferred_at"`
+}
+
+// CreateInventoryUsageRequest defines model for CreateInventoryUsageRequest.
+type CreateInventoryUsageRequest struct {
+	Notes             *string    `json:"notes"`
+	ProductionRefUuid *string    `json:"production_ref_uuid"`
+	UsedAt            *time.Time `json:"used_at"`
+}
+
+// CreateJournalExportRequest defines model for CreateJournalExportRequest.
+type CreateJournalExportRequest struct {
+	Format   *string `json:"format"`
+	Period   *string `json:"period"`
+	Reexport *bool   `json:"reexport"`
+}
+
+// CreateKegEventRequest defines model for CreateKegEventRequest.
+type CreateKegEventRequest struct {
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	Custodian         *string    `json:"custodian"`
+	DepositCents      *int64     `json:"deposit_cents"`
+	EventType         string     `json:"event_type"`
+	Notes             *string    `json:"notes"`
+	OccurredAt        *time.Time `json:"occurred_at"`
+	StockLocationUuid *string    `json:"stock_location_uuid"`
+	WriteOffCents     *int64     `json:"write_off_cents"`
+}
+
+// CreateKegRequest defines model for CreateKegRequest.
+type CreateKegRequest struct {
+	Capacity          *int64     `json:"capacity"`
+	CapacityUnit      string     `json:"capacity_unit"`
+	Currency          *string    `json:"currency"`
+	Lessor            *string    `json:"lessor"`
+	Notes             *string    `json:"notes"`
+	Ownership         *string    `json:"ownership"`
+	PurchaseCostCents *int64     `json:"purchase_cost_cents"`
+	PurchasedAt       *time.Time `json:"purchased_at"`
+	Serial            string     `json:"serial"`
+	StockLocationUuid *string    `json:"stock_location_uuid"`
+}
+
+// CreateRemovalRequest defines model for CreateRemovalRequest.
+type CreateRemovalRequest struct {
+	Amount            *int64     `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	BatchUuid         *string    `json:"batch_uuid"`
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	Category          string     `json:"category"`
+	Destination       *string    `json:"destination"`
+	Notes             *string    `json:"notes"`
+	OccupancyUuid     *string    `json:"occupancy_uuid"`
+	PerformedBy       *string    `json:"performed_by"`
+	Reason            string     `json:"reason"`
+	ReferenceCode     *string    `json:"reference_code"`
+	RemovedAt         *time.Time `json:"removed_at"`
+	StockLocationUuid *string    `json:"stock_location_uuid"`
+}
+
+// CreateReorderPolicyRequest defines model for CreateReorderPolicyRequest.
+type CreateReorderPolicyRequest struct {
+	IngredientUuid        *string `json:"ingredient_uuid"`
+	LeadTimeDays          *int    `json:"lead_time_days"`
+	MaxLevel              *int64  `json:"max_level"`
+	MinLevel              *int64  `json:"min_level"`
+	Notes                 *string `json:"notes"`
+	PreferredSupplierUuid *string `json:"preferred_supplier_uuid"`
+	SafetyStock           *int64  `json:"safety_stock"`
+	StockLocationUuid     *string `json:"stock_location_uuid"`
+}
+
+// CreateReplenishmentPurchaseOrdersRequest defines model for CreateReplenishmentPurchaseOrdersRequest.
+type CreateReplenishmentPurchaseOrdersRequest struct {
+	Currency    *string   `json:"currency"`
+	PolicyUuids *[]string `json:"policy_uuids"`
+	WindowDays  *int      `json:"window_days"`
+}
+
+// CreateStockLocationRequest defines model for CreateStockLocationRequest.
+type CreateStockLocationRequest struct {
+	Description  *string `json:"description"`
+	LocationType *string `json:"location_type"`
+	Name         string  `json:"name"`
+}
+
+// CreateSupplierReturnRequest defines model for CreateSupplierReturnRequest.
+type CreateSupplierReturnRequest struct {
+	Amount            *int64     `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	CreditAmountCents *int64     `json:"credit_amount_cents"`
+	CreditCurrency    *string    `json:"credit_currency"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	Notes             *string    `json:"notes"`
+	Reason            *string    `json:"reason"`
+	ReferenceCode     *string    `json:"reference_code"`
+	ReturnedAt        *time.Time `json:"returned_at"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+}
+
+// CycleCountAccuracyEntry defines model for CycleCountAccuracyEntry.
+type CycleCountAccuracyEntry struct {
+	AbsoluteVarianceValueCents int64     `json:"absolute_variance_value_cents"`
+	AccuracyPercent            float64   `json:"accuracy_percent"`
+	CycleCountUuid             string    `json:"cycle_count_uuid"`
+	LinesAccurate              int       `json:"lines_accurate"`
+	LinesCounted               int       `json:"lines_counted"`
+	NetVarianceValueCents      int64     `json:"net_variance_value_cents"`
+	PostedAt                   time.Time `json:"posted_at"`
+	StockLocationName          string    `json:"stock_location_name"`
+	StockLocationUuid          string    `json:"stock_location_uuid"`
+	UnvaluedVariances          int       `json:"unvalued_variances"`
+}
+
+// CycleCountAccuracyPeriod defines model for CycleCountAccuracyPeriod.
+type CycleCountAccuracyPeriod struct {
+	AbsoluteVarianceValueCents int64   `json:"absolute_variance_value_cents"`
+	AccuracyPercent            float64 `json:"accuracy_percent"`
+	Counts                     int     `json:"counts"`
+	LinesAccurate              int     `json:"lines_accurate"`
+	LinesCounted               int     `json:"lines_counted"`
+	Month                      *string `json:"month,omitempty"`
+	NetVarianceValueCents      int64   `json:"net_variance_value_cents"`
+	UnvaluedVariances          int     `json:"unvalued_variances"`
+}
+
+// CycleCountAccuracyResponse defines model for CycleCountAccuracyResponse.
+type CycleCountAccuracyResponse struct {
+	Counts        []CycleCountAccuracyEntry  `json:"counts"`
+	From          string                     `json:"from"`
+	Months        []CycleCountAccuracyPeriod `json:"months"`
+	To            string                     `json:"to"`
+	Total         CycleCountAccuracyPeriod   `json:"total"`
+	ValueCurrency *string                    `json:"value_currency"`
+}
+
+// CycleCountEntryRequest defines model for CycleCountEntryRequest.
+type CycleCountEntryRequest struct {
+	AmountUnit        *string `json:"amount_unit"`
+	BeerLotUuid       *string `json:"beer_lot_uuid"`
+	CountedAmount     *int64  `json:"counted_amount"`
+	IngredientLotUuid *string `json:"ingredient_lot_uuid"`
+	LineUuid          *string `json:"line_uuid"`
+	Notes             *string `json:"notes"`
+}
+
+// CycleCountLineResponse defines model for CycleCountLineResponse.
+type CycleCountLineResponse struct {
+	AdjustmentUuid     *string    `json:"adjustment_uuid"`
+	AmountUnit         string     `json:"amount_unit"`
+	BeerLotUuid        *string    `json:"beer_lot_uuid"`
+	CountedAmount      *int64     `json:"counted_amount"`
+	CountedAt          *time.Time `json:"counted_at"`
+	ExpectedAmount     *int64     `json:"expected_amount"`
+	IngredientLotUuid  *string    `json:"ingredient_lot_uuid"`
+	ItemName           *string    `json:"item_name"`
+	LotCode            *string    `json:"lot_code"`
+	Notes              *string    `json:"notes"`
+	Uuid               string     `json:"uuid"`
+	ValueCurrency      *string    `json:"value_currency"`
+	Variance           *int64     `json:"variance"`
+	VarianceValueCents *int64     `json:"variance_value_cents"`
+}
+
+// CycleCountResponse defines model for CycleCountResponse.
+type CycleCountResponse struct {
+	Blind             bool                      `json:"blind"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	FrozenAt          time.Time                 `json:"frozen_at"`
+	Lines             *[]CycleCountLineResponse `json:"lines,omitempty"`
+	Notes             *string                   `json:"notes"`
+	PostedAt          *time.Time                `json:"posted_at"`
+	Status            string                    `json:"status"`
+	StockLocationName string                    `json:"stock_location_name"`
+	StockLocationUuid string                    `json:"stock_location_uuid"`
+	Summary           *CycleCountSummary        `json:"summary"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+	Uuid              string                    `json:"uuid"`
+}
+
+// CycleCountSummary defines model for CycleCountSummary.
+type CycleCountSummary struct {
+	LinesCounted       int     `json:"lines_counted"`
+	LinesTotal         int     `json:"lines_total"`
+	LinesWithVariance  int     `json:"lines_with_variance"`
+	UnvaluedVariances  int     `json:"unvalued_variances"`
+	ValueCurrency      *string `json:"value_currency"`
+	VarianceValueCents int64   `json:"variance_value_cents"`
+}
+
+// Entry defines model for Entry.
+type Entry struct {
+	Date   time.Time `json:"date"`
+	Lines  []Line    `json:"lines"`
+	Memo   string    `json:"memo"`
+	Number string    `json:"number"`
+}
+
+// Ingredient defines model for Ingredient.
+type Ingredient struct {
+	Category    *string `json:"category"`
+	DefaultUnit *string `json:"default_unit"`
+	Description *string `json:"description"`
+	Name        *string `json:"name"`
+	Ref         *string `json:"ref"`
+	Skip        *bool   `json:"skip"`
+}
+
+// IngredientLotHopDetailResponse defines model for IngredientLotHopDetailResponse.
+type IngredientLotHopDetailResponse struct {
+	AlphaAcid         *float64   `json:"alpha_acid"`
+	BetaAcid          *float64   `json:"beta_acid"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// IngredientLotMaltDetailResponse defines model for IngredientLotMaltDetailResponse.
+type IngredientLotMaltDetailResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	MoisturePercent   *float64   `json:"moisture_percent"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// IngredientLotResponse defines model for IngredientLotResponse.
+type IngredientLotResponse struct {
+	BestByAt              *time.Time `json:"best_by_at"`
+	BreweryLotCode        *string    `json:"brewery_lot_code"`
+	CreatedAt             time.Time  `json:"created_at"`
+	CurrentAmount         int64      `json:"current_amount"`
+	CurrentUnit           string     `json:"current_unit"`
+	DeletedAt             *time.Time `json:"deleted_at"`
+	ExpiresAt             *time.Time `json:"expires_at"`
+	IngredientUuid        string     `json:"ingredient_uuid"`
+	Notes                 *string    `json:"notes"`
+	OriginatorLotCode     *string    `json:"originator_lot_code"`
+	OriginatorName        *string    `json:"originator_name"`
+	OriginatorType        *string    `json:"originator_type"`
+	PurchaseOrderLineUuid *string    `json:"purchase_order_line_uuid"`
+	ReceiptUuid           *string    `json:"receipt_uuid"`
+	ReceivedAmount        int64      `json:"received_amount"`
+	ReceivedAt            time.Time  `json:"received_at"`
+	ReceivedUnit          string     `json:"received_unit"`
+	SupplierUuid          *string    `json:"supplier_uuid"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	Uuid                  string     `json:"uuid"`
+}
+
+// IngredientLotStockLevelResponse defines model for IngredientLotStockLevelResponse.
+type IngredientLotStockLevelResponse struct {
+	AvailableAmount    int64      `json:"available_amount"`
+	BestByAt           *time.Time `json:"best_by_at"`
+	BreweryLotCode     *string    `json:"brewery_lot_code"`
+	CurrentAmount      int64      `json:"current_amount"`
+	CurrentUnit        string     `json:"current_unit"`
+	ExpiresAt          *time.Time `json:"expires_at"`
+	IngredientCategory string     `json:"ingredient_category"`
+	IngredientLotUuid  string     `json:"ingredient_lot_uuid"`
+	IngredientName     string     `json:"ingredient_name"`
+	IngredientUuid     string     `json:"ingredient_uuid"`
+	ReceivedAmount     int64      `json:"received_amount"`
+	ReceivedAt         string     `json:"received_at"`
+	ReceivedUnit       string     `json:"received_unit"`
+	ReservedAmount     int64      `json:"reserved_amount"`
+	StockLocationName  string     `json:"stock_location_name"`
+	StockLocationUuid  string     `json:"stock_location_uuid"`
+}
+
+// IngredientLotYeastDetailResponse defines model for IngredientLotYeastDetailResponse.
+type IngredientLotYeastDetailResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Generation        *int       `json:"generation"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+	ViabilityPercent  *float64   `json:"viability_percent"`
+}
+
+// IngredientResponse defines model for IngredientResponse.
+type IngredientResponse struct {
+	Category    string     `json:"category"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DefaultUnit string     `json:"default_unit"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description"`
+	Name        string     `json:"name"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Uuid        string     `json:"uuid"`
+}
+
+// InventoryAdjustmentResponse defines model for InventoryAdjustmentResponse.
+type InventoryAdjustmentResponse struct {
+	AdjustedAt time.Time  `json:"adjusted_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	DeletedAt  *time.Time `json:"deleted_at"`
+	Notes      *string    `json:"notes"`
+	Reason     string     `json:"reason"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Uuid       string     `json:"uuid"`
+}
+
+// InventoryConsumptionResponse defines model for InventoryConsumptionResponse.
+type InventoryConsumptionResponse struct {
+	AdjustmentsInCents int64                      `json:"adjustments_in_cents"`
+	BaseCurrency       *string                    `json:"base_currency,omitempty"`
+	ByCategory         []ConsumptionCategoryTotal `json:"by_category"`
+	ByReason           []ConsumptionReasonTotal   `json:"by_reason"`
+	ClosingValueCents  int64                      `json:"closing_value_cents"`
+	ConsumptionCents   int64                      `json:"consumption_cents"`
+	From               string                     `json:"from"`
+	Method             string                     `json:"method"`
+	Movements          []CostedMovement           `json:"movements"`
+	OpeningValueCents  int64                      `json:"opening_value_cents"`
+	ReceiptsCents      int64                      `json:"receipts_cents"`
+	To                 string                     `json:"to"`
+	UncostedLots       []UncostedLot              `json:"uncosted_lots"`
+}
+
+// InventoryMovementResponse defines model for InventoryMovementResponse.
+type InventoryMovementResponse struct {
+	AdjustmentUuid    *string    `json:"adjustment_uuid"`
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Direction         string     `json:"direction"`
+	IngredientLotUuid *string    `json:"ingredient_lot_uuid"`
+	Notes             *string    `json:"notes"`
+	OccurredAt        time.Time  `json:"occurred_at"`
+	Reason            string     `json:"reason"`
+	ReceiptUuid       *string    `json:"receipt_uuid"`
+	RemovalUuid       *string    `json:"removal_uuid"`
+	ReturnUuid        *string    `json:"return_uuid"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+	TransferUuid      *string    `json:"transfer_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	UsageUuid         *string    `json:"usage_uuid"`
+	Uuid              string     `json:"uuid"`
+}
+
+// InventoryReceiptResponse defines model for InventoryReceiptResponse.
+type InventoryReceiptResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Notes             *string    `json:"notes"`
+	PurchaseOrderUuid *string    `json:"purchase_order_uuid"`
+	ReceivedAt        time.Time  `json:"received_at"`
+	ReferenceCode     *string    `json:"reference_code"`
+	SupplierUuid      *string    `json:"supplier_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// InventoryReservationResponse defines model for InventoryReservationResponse.
+type InventoryReservationResponse struct {
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	ConsumedAmount    int64      `json:"consumed_amount"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	Notes             *string    `json:"notes"`
+	OutstandingAmount int64      `json:"outstanding_amount"`
+	ProductionRefUuid string     `json:"production_ref_uuid"`
+	ReleasedAt        *time.Time `json:"released_at"`
+	Status            string     `json:"status"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// InventoryTransferResponse defines model for InventoryTransferResponse.
+type InventoryTransferResponse struct {
+	CreatedAt          time.Time  `json:"created_at"`
+	DeletedAt          *time.Time `json:"deleted_at"`
+	DestLocationUuid   string     `json:"dest_location_uuid"`
+	Notes              *string    `json:"notes"`
+	SourceLocationUuid string     `json:"source_location_uuid"`
+	TransferredAt      time.Time  `json:"transferred_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	Uuid               string     `json:"uuid"`
+}
+
+// InventoryUsageResponse defines model for InventoryUsageResponse.
+type InventoryUsageResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Notes             *string    `json:"notes"`
+	ProductionRefUuid *string    `json:"production_ref_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	UsedAt            time.Time  `json:"used_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// InventoryValuationResponse defines model for InventoryValuationResponse.
+type InventoryValuationResponse struct {
+	AsOf            string                   `json:"as_of"`
+	BaseCurrency    *string                  `json:"base_currency,omitempty"`
+	Categories      []ValuationCategoryTotal `json:"categories"`
+	Items           []ValuationItem          `json:"items"`
+	Locations       []ValuationLocationTotal `json:"locations"`
+	Method          string                   `json:"method"`
+	TotalValueCents int64                    `json:"total_value_cents"`
+	UncostedLots    []UncostedLot            `json:"uncosted_lots"`
+}
+
+// Journal defines model for Journal.
+type Journal struct {
+	BaseCurrency string    `json:"base_currency"`
+	Entries      []Entry   `json:"entries"`
+	Period       string    `json:"period"`
+	Skipped      []Skipped `json:"skipped"`
+	TotalCents   int64     `json:"total_cents"`
+}
+
+// JournalExportResponse defines model for JournalExportResponse.
+type JournalExportResponse struct {
+	EntryCount int       `json:"entry_count"`
+	ExportedAt time.Time `json:"exported_at"`
+	FileName   string    `json:"file_name"`
+	Format     string    `json:"format"`
+	Period     string    `json:"period"`
+	TotalCents int64     `json:"total_cents"`
+	Uuid       string    `json:"uuid"`
+}
+
+// KegEventResponse defines model for KegEventResponse.
+type KegEventResponse struct {
+	BeerLotItemUuid   *string   `json:"beer_lot_item_uuid"`
+	CreatedAt         time.Time `json:"created_at"`
+	Custodian         *string   `json:"custodian"`
+	DepositCents      *int64    `json:"deposit_cents"`
+	EventType         string    `json:"event_type"`
+	FromStatus        string    `json:"from_status"`
+	KegUuid           string    `json:"keg_uuid"`
+	Notes             *string   `json:"notes"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	StockLocationUuid *string   `json:"stock_location_uuid"`
+	ToStatus          string    `json:"to_status"`
+	Uuid              string    `json:"uuid"`
+	WriteOffCents     *int64    `json:"write_off_cents"`
+}
+
+// KegResponse defines model for KegResponse.
+type KegResponse struct {
+	Capacity               int64      `json:"capacity"`
+	CapacityUnit           string     `json:"capacity_unit"`
+	CreatedAt              time.Time  `json:"created_at"`
+	Currency               *string    `json:"currency"`
+	CurrentBeerLotItemUuid *string    `json:"current_beer_lot_item_uuid"`
+	CurrentBeerLotUuid     *string    `json:"current_beer_lot_uuid"`
+	Custodian              *string    `json:"custodian"`
+	DeletedAt              *time.Time `json:"deleted_at"`
+	DepositBalanceCents    int64      `json:"deposit_balance_cents"`
+	Lessor                 *string    `json:"lessor"`
+	Notes                  *string    `json:"notes"`
+	Ownership              string     `json:"ownership"`
+	PurchaseCostCents      *int64     `json:"purchase_cost_cents"`
+	PurchasedAt            *time.Time `json:"purchased_at"`
+	Serial                 string     `json:"serial"`
+	Status                 string     `json:"status"`
+	StockLocationUuid      *string    `json:"stock_location_uuid"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+	Uuid                   string     `json:"uuid"`
+}
+
+// KegTurnTimeReportResponse defines model for KegTurnTimeReportResponse.
+type KegTurnTimeReportResponse struct {
+	AvgDaysOut  float64 `json:"avg_days_out"`
+	AvgTurnDays float64 `json:"avg_turn_days"`
+	Cycles      int     `json:"cycles"`
+	Trips       int     `json:"trips"`
+}
+
+// KegWriteOffReportResponse defines model for KegWriteOffReportResponse.
+type KegWriteOffReportResponse struct {
+	Count                int                   `json:"count"`
+	TotalCentsByCurrency map[string]int64      `json:"total_cents_by_currency"`
+	WriteOffs            []KegWriteOffResponse `json:"write_offs"`
+}
+
+// KegWriteOffResponse defines model for KegWriteOffResponse.
+type KegWriteOffResponse struct {
+	Currency      *string   `json:"currency"`
+	Custodian     *string   `json:"custodian"`
+	KegUuid       string    `json:"keg_uuid"`
+	Notes         *string   `json:"notes"`
+	Serial        string    `json:"serial"`
+	WriteOffCents *int64    `json:"write_off_cents"`
+	WrittenOffAt  time.Time `json:"written_off_at"`
+}
+
+// LabelTemplateRequest defines model for LabelTemplateRequest.
+type LabelTemplateRequest struct {
+	Barcode        *string     `json:"barcode"`
+	BarcodeContent *string     `json:"barcode_content"`
+	Fields         *[]string   `json:"fields"`
+	FontSizePt     *float64    `json:"font_size_pt"`
+	HeightMm       *float64    `json:"height_mm"`
+	IsDefault      *bool       `json:"is_default"`
+	Name           string      `json:"name"`
+	PrinterDpi     *int        `json:"printer_dpi"`
+	Sheet          *SheetInput `json:"sheet"`
+	Subject        *string     `json:"subject"`
+	WidthMm        *float64    `json:"width_mm"`
+}
+
+// LabelTemplateResponse defines model for LabelTemplateResponse.
+type LabelTemplateResponse struct {
+	Barcode        string    `json:"barcode"`
+	BarcodeContent string    `json:"barcode_content"`
+	CreatedAt      time.Time `json:"created_at"`
+	Fields         []string  `json:"fields"`
+	FontSizePt     float64   `json:"font_size_pt"`
+	HeightMm       float64   `json:"height_mm"`
+	IsDefault      bool      `json:"is_default"`
+	Name           string    `json:"name"`
+	PrinterDpi     int       `json:"printer_dpi"`
+	Sheet          *Sheet    `json:"sheet"`
+	Subject        string    `json:"subject"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	Uuid           string    `json:"uuid"`
+	WidthMm        float64   `json:"width_mm"`
+}
+
+// Line defines model for Line.
+type Line struct {
+	Account     string  `json:"account"`
+	CreditCents int64   `json:"credit_cents"`
+	DebitCents  int64   `json:"debit_cents"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// Lot defines model for Lot.
+type Lot struct {
+	Amount            *float64   `json:"amount"`
+	BreweryLotCode    *string    `json:"brewery_lot_code"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	Ingredient        *string    `json:"ingredient"`
+	Notes             *string    `json:"notes"`
+	OriginatorLotCode *string    `json:"originator_lot_code"`
+	OriginatorName    *string    `json:"originator_name"`
+	ReceivedAt        *time.Time `json:"received_at"`
+	Ref               *string    `json:"ref"`
+	Skip              *bool      `json:"skip"`
+	StockLocation     *string    `json:"stock_location"`
+	Unit              *string    `json:"unit"`
+}
+
+// OverdueKegResponse defines model for OverdueKegResponse.
+type OverdueKegResponse struct {
+	BeerLotUuid *string   `json:"beer_lot_uuid"`
+	Custodian   *string   `json:"custodian"`
+	DaysOut     int       `json:"days_out"`
+	KegUuid     string    `json:"keg_uuid"`
+	Serial      string    `json:"serial"`
+	ShippedAt   time.Time `json:"shipped_at"`
+}
+
+// Plan defines model for Plan.
+type Plan struct {
+	Batches              *[]Batch      `json:"batches"`
+	DefaultStockLocation *string       `json:"default_stock_location"`
+	Ingredients          *[]Ingredient `json:"ingredients"`
+	Lots                 *[]Lot        `json:"lots"`
+	Recipes              *[]Recipe     `json:"recipes"`
+	Source               *string       `json:"source"`
+	Vessels              *[]Vessel     `json:"vessels"`
+	Warnings             *[]Warning    `json:"warnings"`
+}
+
+// Recipe defines model for Recipe.
+type Recipe struct {
+	BatchSize           *float64            `json:"batch_size"`
+	BatchSizeUnit       *string             `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64            `json:"brewhouse_efficiency"`
+	Ingredients         *[]RecipeIngredient `json:"ingredients"`
+	Name                *string             `json:"name"`
+	Notes               *string             `json:"notes"`
+	Ref                 *string             `json:"ref"`
+	Skip                *bool               `json:"skip"`
+	Style               *string             `json:"style"`
+	TargetFg            *float64            `json:"target_fg"`
+	TargetIbu           *float64            `json:"target_ibu"`
+	TargetOg            *float64            `json:"target_og"`
+}
+
+// RecipeIngredient defines model for RecipeIngredient.
+type RecipeIngredient struct {
+	AlphaAcidAssumed      *float64 `json:"alpha_acid_assumed"`
+	Amount                *float64 `json:"amount"`
+	AmountUnit            *string  `json:"amount_unit"`
+	IngredientType        *string  `json:"ingredient_type"`
+	Name                  *string  `json:"name"`
+	TimingDurationMinutes *int     `json:"timing_duration_minutes"`
+	UseStage              *string  `json:"use_stage"`
+	UseType               *string  `json:"use_type"`
+}
+
+// RecordCycleCountRequest defines model for RecordCycleCountRequest.
+type RecordCycleCountRequest struct {
+	Counts []CycleCountEntryRequest `json:"counts"`
+}
+
+// RecordKegEventResponse defines model for RecordKegEventResponse.
+type RecordKegEventResponse struct {
+	Event KegEventResponse `json:"event"`
+	Keg   KegResponse      `json:"keg"`
+}
+
+// RecordResult defines model for RecordResult.
+type RecordResult struct {
+	Error  *string     `json:"error"`
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	Record interface{} `json:"record"`
+	Ref    string      `json:"ref"`
+	Status string      `json:"status"`
+}
+
+// ReleaseInventoryReservationsRequest defines model for ReleaseInventoryReservationsRequest.
+type ReleaseInventoryReservationsRequest struct {
+	ProductionRefUuid *string `json:"production_ref_uuid"`
+}
+
+// ReleaseInventoryReservationsResponse defines model for ReleaseInventoryReservationsResponse.
+type ReleaseInventoryReservationsResponse struct {
+	ProductionRefUuid string `json:"production_ref_uuid"`
+	Released          int64  `json:"released"`
+}
+
+// RemovalCategorySummaryDTO defines model for RemovalCategorySummaryDTO.
+type RemovalCategorySummaryDTO struct {
+	Category string  `json:"category"`
+	Count    int     `json:"count"`
+	TotalBbl float64 `json:"total_bbl"`
+}
+
+// RemovalResponse defines model for RemovalResponse.
+type RemovalResponse struct {
+	Amount            int64      `json:"amount"`
+	AmountBbl         *float64   `json:"amount_bbl"`
+	AmountUnit        string     `json:"amount_unit"`
+	BatchUuid         *string    `json:"batch_uuid"`
+	BeerLotUuid       *string    `json:"beer_lot_uuid"`
+	Category          string     `json:"category"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Destination       *string    `json:"destination"`
+	IsTaxable         bool       `json:"is_taxable"`
+	MovementUuid      *string    `json:"movement_uuid"`
+	Notes             *string    `json:"notes"`
+	OccupancyUuid     *string    `json:"occupancy_uuid"`
+	PerformedBy       *string    `json:"performed_by"`
+	Reason            string     `json:"reason"`
+	ReferenceCode     *string    `json:"reference_code"`
+	RemovedAt         time.Time  `json:"removed_at"`
+	StockLocationUuid *string    `json:"stock_location_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// RemovalSummaryResponse defines model for RemovalSummaryResponse.
+type RemovalSummaryResponse struct {
+	ByCategory []RemovalCategorySummaryDTO `json:"by_category"`
+	TaxFreeBbl float64                     `json:"tax_free_bbl"`
+	TaxableBbl float64                     `json:"taxable_bbl"`
+	TotalBbl   float64                     `json:"total_bbl"`
+	TotalCount int                         `json:"total_count"`
+}
+
+// ReorderPolicyResponse defines model for ReorderPolicyResponse.
+type ReorderPolicyResponse struct {
+	CreatedAt             time.Time  `json:"created_at"`
+	DeletedAt             *time.Time `json:"deleted_at"`
+	IngredientName        string     `json:"ingredient_name"`
+	IngredientUuid        string     `json:"ingredient_uuid"`
+	LeadTimeDays          *int       `json:"lead_time_days"`
+	MaxLevel              int64      `json:"max_level"`
+	MinLevel              int64      `json:"min_level"`
+	Notes                 *string    `json:"notes"`
+	PreferredSupplierUuid *string    `json:"preferred_supplier_uuid"`
+	SafetyStock           int64      `json:"safety_stock"`
+	StockLocationName     *string    `json:"stock_location_name"`
+	StockLocationUuid     *string    `json:"stock_location_uuid"`
+	Unit                  string     `json:"unit"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	Uuid                  string     `json:"uuid"`
+}
+
+// ReplenishmentPurchaseOrder defines model for ReplenishmentPurchaseOrder.
+type ReplenishmentPurchaseOrder struct {
+	ExpectedAt        *time.Time                       `json:"expected_at"`
+	Lines             []ReplenishmentPurchaseOrderLine `json:"lines"`
+	OrderNumber       string                           `json:"order_number"`
+	PurchaseOrderUuid string                           `json:"purchase_order_uuid"`
+	SupplierUuid      string                           `json:"supplier_uuid"`
+}
+
+// ReplenishmentPurchaseOrderLine defines model for ReplenishmentPurchaseOrderLine.
+type ReplenishmentPurchaseOrderLine struct {
+	Currency       string `json:"currency"`
+	IngredientUuid string `json:"ingredient_uuid"`
+	ItemName       string `json:"item_name"`
+	PolicyUuid     string `json:"policy_uuid"`
+	Quantity       int64  `json:"quantity"`
+	QuantityUnit   string `json:"quantity_unit"`
+	UnitCostCents  int64  `json:"unit_cost_cents"`
+}
+
+// ReplenishmentPurchaseOrdersResponse defines model for ReplenishmentPurchaseOrdersResponse.
+type ReplenishmentPurchaseOrdersResponse struct {
+	PurchaseOrders []ReplenishmentPurchaseOrder `json:"purchase_orders"`
+	Unassigned     []UnassignedReplenishment    `json:"unassigned"`
+}
+
+// ReplenishmentResponse defines model for ReplenishmentResponse.
+type ReplenishmentResponse struct {
+	GeneratedAt time.Time                 `json:"generated_at"`
+	Suggestions []ReplenishmentSuggestion `json:"suggestions"`
+	WindowDays  int                       `json:"window_days"`
+}
+
+// ReplenishmentSuggestion defines model for ReplenishmentSuggestion.
+type ReplenishmentSuggestion struct {
+	Available         int64                  `json:"available"`
+	DailyUsage        float64                `json:"daily_usage"`
+	DaysOfCover       *float64               `json:"days_of_cover"`
+	IngredientName    string                 `json:"ingredient_name"`
+	IngredientUuid    string                 `json:"ingredient_uuid"`
+	LeadTimeDays      int                    `json:"lead_time_days"`
+	MaxLevel          int64                  `json:"max_level"`
+	MinLevel          int64                  `json:"min_level"`
+	NeedsReorder      bool                   `json:"needs_reorder"`
+	OnHand            int64                  `json:"on_hand"`
+	OnOrder           int64                  `json:"on_order"`
+	OtherUnits        *[]string              `json:"other_units,omitempty"`
+	PolicyUuid        string                 `json:"policy_uuid"`
+	ReorderPoint      int64                  `json:"reorder_point"`
+	Reserved          int64                  `json:"reserved"`
+	SafetyStock       int64                  `json:"safety_stock"`
+	StockLocationName *string                `json:"stock_location_name"`
+	StockLocationUuid *string                `json:"stock_location_uuid"`
+	SuggestedQuantity int64                  `json:"suggested_quantity"`
+	Supplier          *ReplenishmentSupplier `json:"supplier"`
+	Unit              string                 `json:"unit"`
+	UsedInWindow      int64                  `json:"used_in_window"`
+}
+
+// ReplenishmentSupplier defines model for ReplenishmentSupplier.
+type ReplenishmentSupplier struct {
+	Currency      *string `json:"currency"`
+	ItemName      *string `json:"item_name"`
+	QuantityUnit  *string `json:"quantity_unit"`
+	Source        string  `json:"source"`
+	SupplierName  *string `json:"supplier_name"`
+	SupplierUuid  string  `json:"supplier_uuid"`
+	UnitCostCents *int64  `json:"unit_cost_cents"`
+}
+
+// Result defines model for Result.
+type Result struct {
+	DryRun  bool        `json:"dry_run"`
+	Results []RowResult `json:"results"`
+	Totals  Totals      `json:"totals"`
+}
+
+// RowResult defines model for RowResult.
+type RowResult struct {
+	Error  *string     `json:"error"`
+	Record interface{} `json:"record"`
+	Row    int         `json:"row"`
+	Status string      `json:"status"`
+}
+
+// ScanBeerLotItemRequest defines model for ScanBeerLotItemRequest.
+type ScanBeerLotItemRequest struct {
+	Destination *string    `json:"destination"`
+	Identifier  string     `json:"identifier"`
+	Notes       *string    `json:"notes"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+	Status      string     `json:"status"`
+}
+
+// ScanMatchResponse defines model for ScanMatchResponse.
+type ScanMatchResponse struct {
+	Actions    []string `json:"actions"`
+	EntityType string   `json:"entity_type"`
+	MatchedOn  string   `json:"matched_on"`
+	Name       string   `json:"name"`
+	State      string   `json:"state"`
+	Uuid       string   `json:"uuid"`
+}
+
+// ScanResponse defines model for ScanResponse.
+type ScanResponse struct {
+	Code    string              `json:"code"`
+	Matches []ScanMatchResponse `json:"matches"`
+}
+
+// Sheet defines model for Sheet.
+type Sheet struct {
+	Columns      int     `json:"columns"`
+	GapMm        float64 `json:"gap_mm"`
+	MarginMm     float64 `json:"margin_mm"`
+	PageHeightMm float64 `json:"page_height_mm"`
+	PageWidthMm  float64 `json:"page_width_mm"`
+	Rows         int     `json:"rows"`
+}
+
+// SheetInput defines model for SheetInput.
+type SheetInput struct {
+	Columns      *int     `json:"columns"`
+	GapMm        *float64 `json:"gap_mm"`
+	MarginMm     *float64 `json:"margin_mm"`
+	PageHeightMm *float64 `json:"page_height_mm"`
+	PageWidthMm  *float64 `json:"page_width_mm"`
+	Rows         *int     `json:"rows"`
+}
+
+// Skipped defines model for Skipped.
+type Skipped struct {
+	Reason    string `json:"reason"`
+	Reference string `json:"reference"`
+}
+
+// StockLevelLocationResponse defines model for StockLevelLocationResponse.
+type StockLevelLocationResponse struct {
+	Available    float64 `json:"available"`
+	LocationName string  `json:"location_name"`
+	LocationUuid string  `json:"location_uuid"`
+	Quantity     float64 `json:"quantity"`
+	Reserved     float64 `json:"reserved"`
+}
+
+// StockLevelResponse defines model for StockLevelResponse.
+type StockLevelResponse struct {
+	Category       string                       `json:"category"`
+	DefaultUnit    string                       `json:"default_unit"`
+	IngredientName string                       `json:"ingredient_name"`
+	IngredientUuid string                       `json:"ingredient_uuid"`
+	Locations      []StockLevelLocationResponse `json:"locations"`
+	TotalAvailable float64                      `json:"total_available"`
+	TotalOnHand    float64                      `json:"total_on_hand"`
+	TotalReserved  float64                      `json:"total_reserved"`
+}
+
+// StockLocationResponse defines model for StockLocationResponse.
+type StockLocationResponse struct {
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	Description  *string    `json:"description"`
+	LocationType *string    `json:"location_type"`
+	Name         string     `json:"name"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Uuid         string     `json:"uuid"`
+}
+
+// SupplierReturnResponse defines model for SupplierReturnResponse.
+type SupplierReturnResponse struct {
+	Amount                  int64      `json:"amount"`
+	AmountUnit              string     `json:"amount_unit"`
+	BreweryLotCode          *string    `json:"brewery_lot_code"`
+	CreatedAt               time.Time  `json:"created_at"`
+	CreditAmountCents       *int64     `json:"credit_amount_cents"`
+	CreditCurrency          *string    `json:"credit_currency"`
+	CreditResolvedAt        *time.Time `json:"credit_resolved_at"`
+	CreditStatus            string     `json:"credit_status"`
+	IngredientLotUuid       string     `json:"ingredient_lot_uuid"`
+	IngredientName          string     `json:"ingredient_name"`
+	IngredientUuid          string     `json:"ingredient_uuid"`
+	MovementUuid            *string    `json:"movement_uuid"`
+	Notes                   *string    `json:"notes"`
+	PurchaseOrderLineUuid   string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderSyncError  *string    `json:"purchase_order_sync_error"`
+	PurchaseOrderSyncStatus string     `json:"purchase_order_sync_status"`
+	PurchaseOrderSyncedAt   *time.Time `json:"purchase_order_synced_at"`
+	Reason                  string     `json:"reason"`
+	ReferenceCode           *string    `json:"reference_code"`
+	ReturnedAt              time.Time  `json:"returned_at"`
+	StockLocationUuid       string     `json:"stock_location_uuid"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+	Uuid                    string     `json:"uuid"`
+}
+
+// Totals defines model for Totals.
+type Totals struct {
+	Created   int `json:"created"`
+	Failed    int `json:"failed"`
+	TotalRows int `json:"total_rows"`
+	Valid     int `json:"valid"`
+}
+
+// UUID defines model for UUID.
+type UUID = string
+
+// UnassignedReplenishment defines model for UnassignedReplenishment.
+type UnassignedReplenishment struct {
+	Available         int64                  `json:"available"`
+	DailyUsage        float64                `json:"daily_usage"`
+	DaysOfCover       *float64               `json:"days_of_cover"`
+	IngredientName    string                 `json:"ingredient_name"`
+	IngredientUuid    string                 `json:"ingredient_uuid"`
+	LeadTimeDays      int                    `json:"lead_time_days"`
+	MaxLevel          int64                  `json:"max_level"`
+	MinLevel          int64                  `json:"min_level"`
+	NeedsReorder      bool                   `json:"needs_reorder"`
+	OnHand            int64                  `json:"on_hand"`
+	OnOrder           int64                  `json:"on_order"`
+	PolicyUuid        string                 `json:"policy_uuid"`
+	Reason            string                 `json:"reason"`
+	ReorderPoint      int64                  `json:"reorder_point"`
+	Reserved          int64                  `json:"reserved"`
+	SafetyStock       int64                  `json:"safety_stock"`
+	StockLocationName *string                `json:"stock_location_name"`
+	StockLocationUuid *string                `json:"stock_location_uuid"`
+	SuggestedQuantity int64                  `json:"suggested_quantity"`
+	Supplier          *ReplenishmentSupplier `json:"supplier"`
+	Unit              string                 `json:"unit"`
+	UsedInWindow      int64                  `json:"used_in_window"`
+}
+
+// UncostedLot defines model for UncostedLot.
+type UncostedLot struct {
+	IngredientLotUuid string `json:"ingredient_lot_uuid"`
+	IngredientName    string `json:"ingredient_name"`
+	IngredientUuid    string `json:"ingredient_uuid"`
+	Quantity          int64  `json:"quantity"`
+	Reason            string `json:"reason"`
+	Unit              string `json:"unit"`
+}
+
+// UpdateBeerLotItemRequest defines model for UpdateBeerLotItemRequest.
+type UpdateBeerLotItemRequest struct {
+	Identifier *string `json:"identifier"`
+	Notes      *string `json:"notes"`
+}
+
+// UpdateBeerLotItemStatusRequest defines model for UpdateBeerLotItemStatusRequest.
+type UpdateBeerLotItemStatusRequest struct {
+	Destination *string    `json:"destination"`
+	Notes       *string    `json:"notes"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+	Status      string     `json:"status"`
+}
+
+// UpdateIngredientLotHopDetailRequest defines model for UpdateIngredientLotHopDetailRequest.
+type UpdateIngredientLotHopDetailRequest struct {
+	AlphaAcid *float64 `json:"alpha_acid"`
+	BetaAcid  *float64 `json:"beta_acid"`
+}
+
+// UpdateIngredientLotMaltDetailRequest defines model for UpdateIngredientLotMaltDetailRequest.
+type UpdateIngredientLotMaltDetailRequest struct {
+	MoisturePercent *float64 `json:"moisture_percent"`
+}
+
+// UpdateIngredientLotYeastDetailRequest defines model for UpdateIngredientLotYeastDetailRequest.
+type UpdateIngredientLotYeastDetailRequest struct {
+	Generation       *int     `json:"generation"`
+	ViabilityPercent *float64 `json:"viability_percent"`
+}
+
+// UpdateKegRequest defines model for UpdateKegRequest.
+type UpdateKegRequest struct {
+	Capacity          *int64     `json:"capacity"`
+	CapacityUnit      *string    `json:"capacity_unit"`
+	Currency          *string    `json:"currency"`
+	Lessor            *string    `json:"lessor"`
+	Notes             *string    `json:"notes"`
+	Ownership         *string    `json:"ownership"`
+	PurchaseCostCents *int64     `json:"purchase_cost_cents"`
+	PurchasedAt       *time.Time `json:"purchased_at"`
+	Serial            *string    `json:"serial"`
+}
+
+// UpdateRemovalRequest defines model for UpdateRemovalRequest.
+type UpdateRemovalRequest struct {
+	Amount        *int64     `json:"amount"`
+	AmountUnit    *string    `json:"amount_unit"`
+	Category      *string    `json:"category"`
+	Destination   *string    `json:"destination"`
+	Notes         *string    `json:"notes"`
+	PerformedBy   *string    `json:"performed_by"`
+	Reason        *string    `json:"reason"`
+	ReferenceCode *string    `json:"reference_code"`
+	RemovedAt     *time.Time `json:"removed_at"`
+}
+
+// UpdateReorderPolicyRequest defines model for UpdateReorderPolicyRequest.
+type UpdateReorderPolicyRequest struct {
+	LeadTimeDays          *int    `json:"lead_time_days"`
+	MaxLevel              *int64  `json:"max_level"`
+	MinLevel              *int64  `json:"min_level"`
+	Notes                 *string `json:"notes"`
+	PreferredSupplierUuid *string `json:"preferred_supplier_uuid"`
+	SafetyStock           *int64  `json:"safety_stock"`
+}
+
+// UpdateStockLocationRequest defines model for UpdateStockLocationRequest.
+type UpdateStockLocationRequest struct {
+	Description  *string `json:"description"`
+	LocationType *string `json:"location_type"`
+	Name         *string `json:"name"`
+}
+
+// UpdateSupplierReturnRequest defines model for UpdateSupplierReturnRequest.
+type UpdateSupplierReturnRequest struct {
+	CreditAmountCents *int64  `json:"credit_amount_cents"`
+	CreditCurrency    *string `json:"credit_currency"`
+	CreditStatus      *string `json:"credit_status"`
+	Notes             *string `json:"notes"`
+	ReferenceCode     *string `json:"reference_code"`
+}
+
+// UpdateValuationSettingsRequest defines model for UpdateValuationSettingsRequest.
+type UpdateValuationSettingsRequest struct {
+	Method *string `json:"method"`
+}
+
+// ValuationCategoryTotal defines model for ValuationCategoryTotal.
+type ValuationCategoryTotal struct {
+	Category   string `json:"category"`
+	ValueCents int64  `json:"value_cents"`
+}
+
+// ValuationItem defines model for ValuationItem.
+type ValuationItem struct {
+	Category          string   `json:"category"`
+	IngredientName    string   `json:"ingredient_name"`
+	IngredientUuid    string   `json:"ingredient_uuid"`
+	Quantity          int64    `json:"quantity"`
+	StockLocationName string   `json:"stock_location_name"`
+	StockLocationUuid string   `json:"stock_location_uuid"`
+	Unit              string   `json:"unit"`
+	UnitCostCents     *float64 `json:"unit_cost_cents"`
+	ValueCents        int64    `json:"value_cents"`
+}
+
+// ValuationLocationTotal defines model for ValuationLocationTotal.
+type ValuationLocationTotal struct {
+	StockLocationName string `json:"stock_location_name"`
+	StockLocationUuid string `json:"stock_location_uuid"`
+	ValueCents        int64  `json:"value_cents"`
+}
+
+// ValuationSettingsResponse defines model for ValuationSettingsResponse.
+type ValuationSettingsResponse struct {
+	Method string `json:"method"`
+}
+
+// Vessel defines model for Vessel.
+type Vessel struct {
+	Capacity     *float64 `json:"capacity"`
+	CapacityUnit *string  `json:"capacity_unit"`
+	Make         *string  `json:"make"`
+	Model        *string  `json:"model"`
+	Name         *string  `json:"name"`
+	Ref          *string  `json:"ref"`
+	Skip         *bool    `json:"skip"`
+	Status       *string  `json:"status"`
+	Type         *string  `json:"type"`
+}
+
+// Warning defines model for Warning.
+type Warning struct {
+	Message *string `json:"message"`
+	Ref     *string `json:"ref"`
+}
+
+// CodePath defines model for CodePath.
+type CodePath = string
+
+// UuidPath defines model for UuidPath.
+type UuidPath = UUID
+
+// GetInventoryJournalParams defines parameters for GetInventoryJournal.
+type GetInventoryJournalParams struct {
+	Period *string `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// ListBeerLotItemsParams defines parameters for ListBeerLotItems.
+type ListBeerLotItemsParams struct {
+	BeerLotUuid *UUID   `form:"beer_lot_uuid,omitempty" json:"beer_lot_uuid,omitempty"`
+	Status      *string `form:"status,omitempty" json:"status,omitempty"`
+	Identifier  *string `form:"identifier,omitempty" json:"identifier,omitempty"`
+}
+
+// ListBeerLotsParams defines parameters for ListBeerLots.
+type ListBeerLotsParams struct {
+	ProductionBatchUuid *UUID `form:"production_batch_uuid,omitempty" json:"production_batch_uuid,omitempty"`
+	IncludeDeleted      *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetBeerLotLabelParams defines parameters for GetBeerLotLabel.
+type GetBeerLotLabelParams struct {
+	Format       *string `form:"format,omitempty" json:"format,omitempty"`
+	Copies       *int    `form:"copies,omitempty" json:"copies,omitempty"`
+	TemplateUuid *UUID   `form:"template_uuid,omitempty" json:"template_uuid,omitempty"`
+}
+
+// GetCycleCountAccuracyParams defines parameters for GetCycleCountAccuracy.
+type GetCycleCountAccuracyParams struct {
+	From              *string `form:"from,omitempty" json:"from,omitempty"`
+	To                *string `form:"to,omitempty" json:"to,omitempty"`
+	StockLocationUuid *UUID   `form:"stock_location_uuid,omitempty" json:"stock_location_uuid,omitempty"`
+}
+
+// ListCycleCountsParams defines parameters for ListCycleCounts.
+type ListCycleCountsParams struct {
+	StockLocationUuid *UUID   `form:"stock_location_uuid,omitempty" json:"stock_location_uuid,omitempty"`
+	Status            *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListIngredientLotHopDetailsParams defines parameters for ListIngredientLotHopDetails.
+type ListIngredientLotHopDetailsParams struct {
+	IngredientLotUuid *UUID `form:"ingredient_lot_uuid,omitempty" json:"ingredient_lot_uuid,omitempty"`
+}
+
+// ListIngredientLotMaltDetailsParams defines parameters for ListIngredientLotMaltDetails.
+type ListIngredientLotMaltDetailsParams struct {
+	IngredientLotUuid *UUID `form:"ingredient_lot_uuid,omitempty" json:"ingredient_lot_uuid,omitempty"`
+}
+
+// ListIngredientLotYeastDetailsParams defines parameters for ListIngredientLotYeastDetails.
+type ListIngredientLotYeastDetailsParams struct {
+	IngredientLotUuid *UUID `form:"ingredient_lot_uuid,omitempty" json:"ingredient_lot_uuid,omitempty"`
+}
+
+// ListIngredientLotsParams defines parameters for ListIngredientLots.
+type ListIngredientLotsParams struct {
+	IngredientUuid        *UUID `form:"ingredient_uuid,omitempty" json:"ingredient_uuid,omitempty"`
+	ReceiptUuid           *UUID `form:"receipt_uuid,omitempty" json:"receipt_uuid,omitempty"`
+	PurchaseOrderLineUuid *UUID `form:"purchase_order_line_uuid,omitempty" json:"purchase_order_line_uuid,omitempty"`
+	IncludeDeleted        *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// ListBatchIngredientLotsParams defines parameters for ListBatchIngredientLots.
+type ListBatchIngredientLotsParams struct {
+	ProductionRefUuid UUID `form:"production_ref_uuid" json:"production_ref_uuid"`
+}
+
+// ImportIngredientLotsMultipartBody defines parameters for ImportIngredientLots.
+type ImportIngredientLotsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportIngredientLotsParams defines parameters for ImportIngredientLots.
+type ImportIngredientLotsParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetIngredientLotLabelParams defines parameters for GetIngredientLotLabel.
+type GetIngredientLotLabelParams struct {
+	Format       *string `form:"format,omitempty" json:"format,omitempty"`
+	Copies       *int    `form:"copies,omitempty" json:"copies,omitempty"`
+	TemplateUuid *UUID   `form:"template_uuid,omitempty" json:"template_uuid,omitempty"`
+}
+
+// ListIngredientReorderPoliciesParams defines parameters for ListIngredientReorderPolicies.
+type ListIngredientReorderPoliciesParams struct {
+	IngredientUuid *UUID `form:"ingredient_uuid,omitempty" json:"ingredient_uuid,omitempty"`
+}
+
+// ListIngredientsParams defines parameters for ListIngredients.
+type ListIngredientsParams struct {
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// ImportIngredientsMultipartBody defines parameters for ImportIngredients.
+type ImportIngredientsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportIngredientsParams defines parameters for ImportIngredients.
+type ImportIngredientsParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListInventoryMovementsParams defines parameters for ListInventoryMovements.
+type ListInventoryMovementsParams struct {
+	IngredientLotUuid *UUID `form:"ingredient_lot_uuid,omitempty" json:"ingredient_lot_uuid,omitempty"`
+	BeerLotUuid       *UUID `form:"beer_lot_uuid,omitempty" json:"beer_lot_uuid,omitempty"`
+}
+
+// ListInventoryReceiptsParams defines parameters for ListInventoryReceipts.
+type ListInventoryReceiptsParams struct {
+	PurchaseOrderUuid *UUID `form:"purchase_order_uuid,omitempty" json:"purchase_order_uuid,omitempty"`
+}
+
+// ListInventoryReservationsParams defines parameters for ListInventoryReservations.
+type ListInventoryReservationsParams struct {
+	ProductionRefUuid *UUID   `form:"production_ref_uuid,omitempty" json:"production_ref_uuid,omitempty"`
+	IngredientLotUuid *UUID   `form:"ingredient_lot_uuid,omitempty" json:"ingredient_lot_uuid,omitempty"`
+	Status            *string `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListBatchUsageMovementsParams defines parameters for ListBatchUsageMovements.
+type ListBatchUsageMovementsParams struct {
+	ProductionRefUuid UUID `form:"production_ref_uuid" json:"production_ref_uuid"`
+}
+
+// GetInventoryValuationParams defines parameters for GetInventoryValuation.
+type GetInventoryValuationParams struct {
+	AsOf   *string `form:"as_of,omitempty" json:"as_of,omitempty"`
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+}
+
+// GetInventoryConsumptionParams defines parameters for GetInventoryConsumption.
+type GetInventoryConsumptionParams struct {
+	From   *string `form:"from,omitempty" json:"from,omitempty"`
+	To     *string `form:"to,omitempty" json:"to,omitempty"`
+	Method *string `form:"method,omitempty" json:"method,omitempty"`
+}
+
+// GetOverdueKegReportParams defines parameters for GetOverdueKegReport.
+type GetOverdueKegReportParams struct {
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetKegTurnTimeReportParams defines parameters for GetKegTurnTimeReport.
+type GetKegTurnTimeReportParams struct {
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+	To   *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetKegWriteOffReportParams defines parameters for GetKegWriteOffReport.
+type GetKegWriteOffReportParams struct {
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+	To   *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListKegsParams defines parameters for ListKegs.
+type ListKegsParams struct {
+	Status    *string `form:"status,omitempty" json:"status,omitempty"`
+	Ownership *string `form:"ownership,omitempty" json:"ownership,omitempty"`
+	Serial    *string `form:"serial,omitempty" json:"serial,omitempty"`
+}
+
+// ListLabelTemplatesParams defines parameters for ListLabelTemplates.
+type ListLabelTemplatesParams struct {
+	Subject *string `form:"subject,omitempty" json:"subject,omitempty"`
+}
+
+// ApplyInventoryMigrationParams defines parameters for ApplyInventoryMigration.
+type ApplyInventoryMigrationParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetRemovalSummaryParams defines parameters for GetRemovalSummary.
+type GetRemovalSummaryParams struct {
+	BatchUuid   *UUID   `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+	BeerLotUuid *UUID   `form:"beer_lot_uuid,omitempty" json:"beer_lot_uuid,omitempty"`
+	Category    *string `form:"category,omitempty" json:"category,omitempty"`
+	From        *string `form:"from,omitempty" json:"from,omitempty"`
+	To          *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListRemovalsParams defines parameters for ListRemovals.
+type ListRemovalsParams struct {
+	BatchUuid   *UUID   `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+	BeerLotUuid *UUID   `form:"beer_lot_uuid,omitempty" json:"beer_lot_uuid,omitempty"`
+	Category    *string `form:"category,omitempty" json:"category,omitempty"`
+	From        *string `form:"from,omitempty" json:"from,omitempty"`
+	To          *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListReplenishmentSuggestionsParams defines parameters for ListReplenishmentSuggestions.
+type ListReplenishmentSuggestionsParams struct {
+	WindowDays *int `form:"window_days,omitempty" json:"window_days,omitempty"`
+}
+
+// ListSupplierReturnsParams defines parameters for ListSupplierReturns.
+type ListSupplierReturnsParams struct {
+	CreditStatus            *string `form:"credit_status,omitempty" json:"credit_status,omitempty"`
+	PurchaseOrderSyncStatus *string `form:"purchase_order_sync_status,omitempty" json:"purchase_order_sync_status,omitempty"`
+}
+
+// ExportInventoryJournalJSONRequestBody defines body for ExportInventoryJournal for application/json ContentType.
+type ExportInventoryJournalJSONRequestBody = CreateJournalExportRequest
+
+// CreateBeerLotItemJSONRequestBody defines body for CreateBeerLotItem for application/json ContentType.
+type CreateBeerLotItemJSONRequestBody = CreateBeerLotItemRequest
+
+// ScanBeerLotItemJSONRequestBody defines body for ScanBeerLotItem for application/json ContentType.
+type ScanBeerLotItemJSONRequestBody = ScanBeerLotItemRequest
+
+// UpdateBeerLotItemJSONRequestBody defines body for UpdateBeerLotItem for application/json ContentType.
+type UpdateBeerLotItemJSONRequestBody = UpdateBeerLotItemRequest
+
+// UpdateBeerLotItemStatusJSONRequestBody defines body for UpdateBeerLotItemStatus for application/json ContentType.
+type UpdateBeerLotItemStatusJSONRequestBody = UpdateBeerLotItemStatusRequest
+
+// CreateBeerLotJSONRequestBody defines body for CreateBeerLot for application/json ContentType.
+type CreateBeerLotJSONRequestBody = CreateBeerLotRequest
+
+// CreateCycleCountJSONRequestBody defines body for CreateCycleCount for application/json ContentType.
+type CreateCycleCountJSONRequestBody = CreateCycleCountRequest
+
+// RecordCycleCountsJSONRequestBody defines body for RecordCycleCounts for application/json ContentType.
+type RecordCycleCountsJSONRequestBody = RecordCycleCountRequest
+
+// CreateIngredientLotHopDetailJSONRequestBody defines body for CreateIngredientLotHopDetail for application/json ContentType.
+type CreateIngredientLotHopDetailJSONRequestBody = CreateIngredientLotHopDetailRequest
+
+// UpdateIngredientLotHopDetailJSONRequestBody defines body for UpdateIngredientLotHopDetail for application/json ContentType.
+type UpdateIngredientLotHopDetailJSONRequestBody = UpdateIngredientLotHopDetailRequest
+
+// CreateIngredientLotMaltDetailJSONRequestBody defines body for CreateIngredientLotMaltDetail for application/json ContentType.
+type CreateIngredientLotMaltDetailJSONRequestBody = CreateIngredientLotMaltDetailRequest
+
+// UpdateIngredientLotMaltDetailJSONRequestBody defines body for UpdateIngredientLotMaltDetail for application/json ContentType.
+type UpdateIngredientLotMaltDetailJSONRequestBody = UpdateIngredientLotMaltDetailRequest
+
+// CreateIngredientLotYeastDetailJSONRequestBody defines body for CreateIngredientLotYeastDetail for application/json ContentType.
+type CreateIngredientLotYeastDetailJSONRequestBody = CreateIngredientLotYeastDetailRequest
+
+// UpdateIngredientLotYeastDetailJSONRequestBody defines body for UpdateIngredientLotYeastDetail for application/json ContentType.
+type UpdateIngredientLotYeastDetailJSONRequestBody = UpdateIngredientLotYeastDetailRequest
+
+// CreateIngredientLotJSONRequestBody defines body for CreateIngredientLot for application/json ContentType.
+type CreateIngredientLotJSONRequestBody = CreateIngredientLotRequest
+
+// ImportIngredientLotsMultipartRequestBody defines body for ImportIngredientLots for multipart/form-data ContentType.
+type ImportIngredientLotsMultipartRequestBody ImportIngredientLotsMultipartBody
+
+// CreateIngredientReorderPolicyJSONRequestBody defines body for CreateIngredientReorderPolicy for application/json ContentType.
+type CreateIngredientReorderPolicyJSONRequestBody = CreateReorderPolicyRequest
+
+// UpdateIngredientReorderPolicyJSONRequestBody defines body for UpdateIngredientReorderPolicy for application/json ContentType.
+type UpdateIngredientReorderPolicyJSONRequestBody = UpdateReorderPolicyRequest
+
+// CreateIngredientJSONRequestBody defines body for CreateIngredient for application/json ContentType.
+type CreateIngredientJSONRequestBody = CreateIngredientRequest
+
+// ImportIngredientsMultipartRequestBody defines body for ImportIngredients for multipart/form-data ContentType.
+type ImportIngredientsMultipartRequestBody ImportIngredientsMultipartBody
+
+// CreateInventoryAdjustmentJSONRequestBody defines body for CreateInventoryAdjustment for application/json ContentType.
+type CreateInventoryAdjustmentJSONRequestBody = CreateInventoryAdjustmentRequest
+
+// CreateInventoryMovementJSONRequestBody defines body for CreateInventoryMovement for application/json ContentType.
+type CreateInventoryMovementJSONRequestBody = CreateInventoryMovementRequest
+
+// CreateInventoryReceiptJSONRequestBody defines body for CreateInventoryReceipt for application/json ContentType.
+type CreateInventoryReceiptJSONRequestBody = CreateInventoryReceiptRequest
+
+// CreateInventoryReservationJSONRequestBody defines body for CreateInventoryReservation for application/json ContentType.
+type CreateInventoryReservationJSONRequestBody = CreateInventoryReservationRequest
+
+// ReleaseInventoryReservationsJSONRequestBody defines body for ReleaseInventoryReservations for application/json ContentType.
+type ReleaseInventoryReservationsJSONRequestBody = ReleaseInventoryReservationsRequest
+
+// CreateInventoryTransferJSONRequestBody defines body for CreateInventoryTransfer for application/json ContentType.
+type CreateInventoryTransferJSONRequestBody = CreateInventoryTransferRequest
+
+// CreateInventoryUsageJSONRequestBody defines body for CreateInventoryUsage for application/json ContentType.
+type CreateInventoryUsageJSONRequestBody = CreateInventoryUsageRequest
+
+// CreateBatchUsageJSONRequestBody defines body for CreateBatchUsage for application/json ContentType.
+type CreateBatchUsageJSONRequestBody = CreateBatchUsageRequest
+
+// UpdateInventoryValuationSettingsJSONRequestBody defines body for UpdateInventoryValuationSettings for application/json ContentType.
+type UpdateInventoryValuationSettingsJSONRequestBody = UpdateValuationSettingsRequest
+
+// CreateKegJSONRequestBody defines body for CreateKeg for application/json ContentType.
+type CreateKegJSONRequestBody = CreateKegRequest
+
+// UpdateKegJSONRequestBody defines body for UpdateKeg for application/json ContentType.
+type UpdateKegJSONRequestBody = UpdateKegRequest
+
+// CreateKegEventJSONRequestBody defines body for CreateKegEvent for application/json ContentType.
+type CreateKegEventJSONRequestBody = CreateKegEventRequest
+
+// CreateLabelTemplateJSONRequestBody defines body for CreateLabelTemplate for application/json ContentType.
+type CreateLabelTemplateJSONRequestBody = LabelTemplateRequest
+
+// UpdateLabelTemplateJSONRequestBody defines body for UpdateLabelTemplate for application/json ContentType.
+type UpdateLabelTemplateJSONRequestBody = LabelTemplateRequest
+
+// ApplyInventoryMigrationJSONRequestBody defines body for ApplyInventoryMigration for application/json ContentType.
+type ApplyInventoryMigrationJSONRequestBody = Plan
+
+// CreateRemovalJSONRequestBody defines body for CreateRemoval for application/json ContentType.
+type CreateRemovalJSONRequestBody = CreateRemovalRequest
+
+// UpdateRemovalJSONRequestBody defines body for UpdateRemoval for application/json ContentType.
+type UpdateRemovalJSONRequestBody = UpdateRemovalRequest
+
+// CreateReplenishmentPurchaseOrdersJSONRequestBody defines body for CreateReplenishmentPurchaseOrders for application/json ContentType.
+type CreateReplenishmentPurchaseOrdersJSONRequestBody = CreateReplenishmentPurchaseOrdersRequest
+
+// CreateStockLocationJSONRequestBody defines body for CreateStockLocation for application/json ContentType.
+type CreateStockLocationJSONRequestBody = CreateStockLocationRequest
+
+// UpdateStockLocationJSONRequestBody defines body for UpdateStockLocation for application/json ContentType.
+type UpdateStockLocationJSONRequestBody = UpdateStockLocationRequest
+
+// CreateSupplierReturnJSONRequestBody defines body for CreateSupplierReturn for application/json ContentType.
+type CreateSupplierReturnJSONRequestBody = CreateSupplierReturnRequest
+
+// UpdateSupplierReturnJSONRequestBody defines body for UpdateSupplierReturn for application/json ContentType.
+type UpdateSupplierReturnJSONRequestBody = UpdateSupplierReturnRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetInventoryJournal request
+	GetInventoryJournal(ctx context.Context, params *GetInventoryJournalParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryJournalExports request
+	ListInventoryJournalExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportInventoryJournalWithBody request with any body
+	ExportInventoryJournalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportInventoryJournal(ctx context.Context, body ExportInventoryJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryJournalExportFile request
+	GetInventoryJournalExportFile(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBeerLotItems request
+	ListBeerLotItems(ctx context.Context, params *ListBeerLotItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBeerLotItemWithBody request with any body
+	CreateBeerLotItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBeerLotItem(ctx context.Context, body CreateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ScanBeerLotItemWithBody request with any body
+	ScanBeerLotItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ScanBeerLotItem(ctx context.Context, body ScanBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBeerLotItem request
+	DeleteBeerLotItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBeerLotItem request
+	GetBeerLotItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBeerLotItemWithBody request with any body
+	UpdateBeerLotItemWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBeerLotItem(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBeerLotItemHistory request
+	ListBeerLotItemHistory(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBeerLotItemStatusWithBody request with any body
+	UpdateBeerLotItemStatusWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBeerLotItemStatus(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBeerLotStockLevels request
+	ListBeerLotStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBeerLots request
+	ListBeerLots(ctx context.Context, params *ListBeerLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBeerLotWithBody request with any body
+	CreateBeerLotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBeerLot(ctx context.Context, body CreateBeerLotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBeerLot request
+	GetBeerLot(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBeerLotLabel request
+	GetBeerLotLabel(ctx context.Context, uuid UuidPath, params *GetBeerLotLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCycleCountAccuracy request
+	GetCycleCountAccuracy(ctx context.Context, params *GetCycleCountAccuracyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCycleCounts request
+	ListCycleCounts(ctx context.Context, params *ListCycleCountsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateCycleCountWithBody request with any body
+	CreateCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateCycleCount(ctx context.Context, body CreateCycleCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCycleCount request
+	GetCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelCycleCount request
+	CancelCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordCycleCountsWithBody request with any body
+	RecordCycleCountsWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordCycleCounts(ctx context.Context, uuid UuidPath, body RecordCycleCountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostCycleCount request
+	PostCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientLotHopDetails request
+	ListIngredientLotHopDetails(ctx context.Context, params *ListIngredientLotHopDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientLotHopDetailWithBody request with any body
+	CreateIngredientLotHopDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredientLotHopDetail(ctx context.Context, body CreateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientLotHopDetail request
+	GetIngredientLotHopDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateIngredientLotHopDetailWithBody request with any body
+	UpdateIngredientLotHopDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateIngredientLotHopDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientLotMaltDetails request
+	ListIngredientLotMaltDetails(ctx context.Context, params *ListIngredientLotMaltDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientLotMaltDetailWithBody request with any body
+	CreateIngredientLotMaltDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredientLotMaltDetail(ctx context.Context, body CreateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientLotMaltDetail request
+	GetIngredientLotMaltDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateIngredientLotMaltDetailWithBody request with any body
+	UpdateIngredientLotMaltDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateIngredientLotMaltDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientLotStockLevels request
+	ListIngredientLotStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientLotYeastDetails request
+	ListIngredientLotYeastDetails(ctx context.Context, params *ListIngredientLotYeastDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientLotYeastDetailWithBody request with any body
+	CreateIngredientLotYeastDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredientLotYeastDetail(ctx context.Context, body CreateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientLotYeastDetail request
+	GetIngredientLotYeastDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateIngredientLotYeastDetailWithBody request with any body
+	UpdateIngredientLotYeastDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateIngredientLotYeastDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientLots request
+	ListIngredientLots(ctx context.Context, params *ListIngredientLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientLotWithBody request with any body
+	CreateIngredientLotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredientLot(ctx context.Context, body CreateIngredientLotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchIngredientLots request
+	ListBatchIngredientLots(ctx context.Context, params *ListBatchIngredientLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportIngredientLotsWithBody request with any body
+	ImportIngredientLotsWithBody(ctx context.Context, params *ImportIngredientLotsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientLot request
+	GetIngredientLot(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientLotLabel request
+	GetIngredientLotLabel(ctx context.Context, uuid UuidPath, params *GetIngredientLotLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredientReorderPolicies request
+	ListIngredientReorderPolicies(ctx context.Context, params *ListIngredientReorderPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientReorderPolicyWithBody request with any body
+	CreateIngredientReorderPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredientReorderPolicy(ctx context.Context, body CreateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteIngredientReorderPolicy request
+	DeleteIngredientReorderPolicy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredientReorderPolicy request
+	GetIngredientReorderPolicy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateIngredientReorderPolicyWithBody request with any body
+	UpdateIngredientReorderPolicyWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateIngredientReorderPolicy(ctx context.Context, uuid UuidPath, body UpdateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListIngredients request
+	ListIngredients(ctx context.Context, params *ListIngredientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateIngredientWithBody request with any body
+	CreateIngredientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateIngredient(ctx context.Context, body CreateIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportIngredientsWithBody request with any body
+	ImportIngredientsWithBody(ctx context.Context, params *ImportIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIngredient request
+	GetIngredient(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryAdjustments request
+	ListInventoryAdjustments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryAdjustmentWithBody request with any body
+	CreateInventoryAdjustmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryAdjustment(ctx context.Context, body CreateInventoryAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryAdjustment request
+	GetInventoryAdjustment(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryMovements request
+	ListInventoryMovements(ctx context.Context, params *ListInventoryMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryMovementWithBody request with any body
+	CreateInventoryMovementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryMovement(ctx context.Context, body CreateInventoryMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryMovement request
+	GetInventoryMovement(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryReceipts request
+	ListInventoryReceipts(ctx context.Context, params *ListInventoryReceiptsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryReceiptWithBody request with any body
+	CreateInventoryReceiptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryReceipt(ctx context.Context, body CreateInventoryReceiptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryReceipt request
+	GetInventoryReceipt(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryReservations request
+	ListInventoryReservations(ctx context.Context, params *ListInventoryReservationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryReservationWithBody request with any body
+	CreateInventoryReservationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryReservation(ctx context.Context, body CreateInventoryReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReleaseInventoryReservationsWithBody request with any body
+	ReleaseInventoryReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReleaseInventoryReservations(ctx context.Context, body ReleaseInventoryReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteInventoryReservation request
+	DeleteInventoryReservation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryReservation request
+	GetInventoryReservation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ScanInventory request
+	ScanInventory(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryTransfers request
+	ListInventoryTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryTransferWithBody request with any body
+	CreateInventoryTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryTransfer(ctx context.Context, body CreateInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryTransfer request
+	GetInventoryTransfer(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListInventoryUsage request
+	ListInventoryUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateInventoryUsageWithBody request with any body
+	CreateInventoryUsageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateInventoryUsage(ctx context.Context, body CreateInventoryUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchUsageMovements request
+	ListBatchUsageMovements(ctx context.Context, params *ListBatchUsageMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchUsageWithBody request with any body
+	CreateBatchUsageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatchUsage(ctx context.Context, body CreateBatchUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryUsage request
+	GetInventoryUsage(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryValuation request
+	GetInventoryValuation(ctx context.Context, params *GetInventoryValuationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryConsumption request
+	GetInventoryConsumption(ctx context.Context, params *GetInventoryConsumptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryValuationSettings request
+	GetInventoryValuationSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateInventoryValuationSettingsWithBody request with any body
+	UpdateInventoryValuationSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateInventoryValuationSettings(ctx context.Context, body UpdateInventoryValuationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOverdueKegReport request
+	GetOverdueKegReport(ctx context.Context, params *GetOverdueKegReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKegTurnTimeReport request
+	GetKegTurnTimeReport(ctx context.Context, params *GetKegTurnTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKegWriteOffReport request
+	GetKegWriteOffReport(ctx context.Context, params *GetKegWriteOffReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListKegs request
+	ListKegs(ctx context.Context, params *ListKegsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateKegWithBody request with any body
+	CreateKegWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateKeg(ctx context.Context, body CreateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteKeg request
+	DeleteKeg(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetKeg request
+	GetKeg(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateKegWithBody request with any body
+	UpdateKegWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateKeg(ctx context.Context, uuid UuidPath, body UpdateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListKegEvents request
+	ListKegEvents(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateKegEventWithBody request with any body
+	CreateKegEventWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateKegEvent(ctx context.Context, uuid UuidPath, body CreateKegEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLabelTemplates request
+	ListLabelTemplates(ctx context.Context, params *ListLabelTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateLabelTemplateWithBody request with any body
+	CreateLabelTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateLabelTemplate(ctx context.Context, body CreateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteLabelTemplate request
+	DeleteLabelTemplate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLabelTemplate request
+	GetLabelTemplate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateLabelTemplateWithBody request with any body
+	UpdateLabelTemplateWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateLabelTemplate(ctx context.Context, uuid UuidPath, body UpdateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyInventoryMigrationWithBody request with any body
+	ApplyInventoryMigrationWithBody(ctx context.Context, params *ApplyInventoryMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyInventoryMigration(ctx context.Context, params *ApplyInventoryMigrationParams, body ApplyInventoryMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRemovalSummary request
+	GetRemovalSummary(ctx context.Context, params *GetRemovalSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRemovals request
+	ListRemovals(ctx context.Context, params *ListRemovalsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRemovalWithBody request with any body
+	CreateRemovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRemoval(ctx context.Context, body CreateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRemoval request
+	DeleteRemoval(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRemoval request
+	GetRemoval(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRemovalWithBody request with any body
+	UpdateRemovalWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRemoval(ctx context.Context, uuid UuidPath, body UpdateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListReplenishmentSuggestions request
+	ListReplenishmentSuggestions(ctx context.Context, params *ListReplenishmentSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateReplenishmentPurchaseOrdersWithBody request with any body
+	CreateReplenishmentPurchaseOrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateReplenishmentPurchaseOrders(ctx context.Context, body CreateReplenishmentPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListStockLevels request
+	ListStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListStockLocations request
+	ListStockLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStockLocationWithBody request with any body
+	CreateStockLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStockLocation(ctx context.Context, body CreateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteStockLocation request
+	DeleteStockLocation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocation request
+	GetStockLocation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateStockLocationWithBody request with any body
+	UpdateStockLocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateStockLocation(ctx context.Context, uuid UuidPath, body UpdateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSupplierReturns request
+	ListSupplierReturns(ctx context.Context, params *ListSupplierReturnsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSupplierReturnWithBody request with any body
+	CreateSupplierReturnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSupplierReturn(ctx context.Context, body CreateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSupplierReturn request
+	GetSupplierReturn(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSupplierReturnWithBody request with any body
+	UpdateSupplierReturnWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSupplierReturn(ctx context.Context, uuid UuidPath, body UpdateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncSupplierReturn request
+	SyncSupplierReturn(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetInventoryJournal(ctx context.Context, params *GetInventoryJournalParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryJournalRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryJournalExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryJournalExportsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInventoryJournalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInventoryJournalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportInventoryJournal(ctx context.Context, body ExportInventoryJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportInventoryJournalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryJournalExportFile(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryJournalExportFileRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBeerLotItems(ctx context.Context, params *ListBeerLotItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBeerLotItemsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBeerLotItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBeerLotItemRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBeerLotItem(ctx context.Context, body CreateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBeerLotItemRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ScanBeerLotItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScanBeerLotItemRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ScanBeerLotItem(ctx context.Context, body ScanBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScanBeerLotItemRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBeerLotItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBeerLotItemRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBeerLotItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBeerLotItemRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBeerLotItemWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBeerLotItemRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBeerLotItem(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBeerLotItemRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBeerLotItemHistory(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBeerLotItemHistoryRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBeerLotItemStatusWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBeerLotItemStatusRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBeerLotItemStatus(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBeerLotItemStatusRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBeerLotStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBeerLotStockLevelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBeerLots(ctx context.Context, params *ListBeerLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBeerLotsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBeerLotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBeerLotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBeerLot(ctx context.Context, body CreateBeerLotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBeerLotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBeerLot(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBeerLotRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBeerLotLabel(ctx context.Context, uuid UuidPath, params *GetBeerLotLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBeerLotLabelRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCycleCountAccuracy(ctx context.Context, params *GetCycleCountAccuracyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCycleCountAccuracyRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCycleCounts(ctx context.Context, params *ListCycleCountsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCycleCountsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCycleCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCycleCountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateCycleCount(ctx context.Context, body CreateCycleCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateCycleCountRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCycleCountRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelCycleCountRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordCycleCountsWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordCycleCountsRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordCycleCounts(ctx context.Context, uuid UuidPath, body RecordCycleCountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordCycleCountsRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostCycleCount(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostCycleCountRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientLotHopDetails(ctx context.Context, params *ListIngredientLotHopDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientLotHopDetailsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotHopDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotHopDetailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotHopDetail(ctx context.Context, body CreateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotHopDetailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientLotHopDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientLotHopDetailRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotHopDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotHopDetailRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotHopDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotHopDetailRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientLotMaltDetails(ctx context.Context, params *ListIngredientLotMaltDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientLotMaltDetailsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotMaltDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotMaltDetailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotMaltDetail(ctx context.Context, body CreateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotMaltDetailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientLotMaltDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientLotMaltDetailRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotMaltDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotMaltDetailRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotMaltDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotMaltDetailRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientLotStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientLotStockLevelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientLotYeastDetails(ctx context.Context, params *ListIngredientLotYeastDetailsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientLotYeastDetailsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotYeastDetailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotYeastDetailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotYeastDetail(ctx context.Context, body CreateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotYeastDetailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientLotYeastDetail(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientLotYeastDetailRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotYeastDetailWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotYeastDetailRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientLotYeastDetail(ctx context.Context, uuid UuidPath, body UpdateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientLotYeastDetailRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientLots(ctx context.Context, params *ListIngredientLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientLotsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientLot(ctx context.Context, body CreateIngredientLotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientLotRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchIngredientLots(ctx context.Context, params *ListBatchIngredientLotsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchIngredientLotsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportIngredientLotsWithBody(ctx context.Context, params *ImportIngredientLotsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportIngredientLotsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientLot(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientLotRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientLotLabel(ctx context.Context, uuid UuidPath, params *GetIngredientLotLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientLotLabelRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredientReorderPolicies(ctx context.Context, params *ListIngredientReorderPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientReorderPoliciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientReorderPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientReorderPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientReorderPolicy(ctx context.Context, body CreateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientReorderPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteIngredientReorderPolicy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteIngredientReorderPolicyRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredientReorderPolicy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientReorderPolicyRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientReorderPolicyWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientReorderPolicyRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIngredientReorderPolicy(ctx context.Context, uuid UuidPath, body UpdateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIngredientReorderPolicyRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListIngredients(ctx context.Context, params *ListIngredientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIngredientsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateIngredient(ctx context.Context, body CreateIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateIngredientRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportIngredientsWithBody(ctx context.Context, params *ImportIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportIngredientsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIngredient(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIngredientRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryAdjustments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryAdjustmentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryAdjustmentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryAdjustmentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryAdjustment(ctx context.Context, body CreateInventoryAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryAdjustmentRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryAdjustment(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryAdjustmentRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryMovements(ctx context.Context, params *ListInventoryMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryMovementsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryMovementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryMovementRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryMovement(ctx context.Context, body CreateInventoryMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryMovementRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryMovement(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryMovementRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryReceipts(ctx context.Context, params *ListInventoryReceiptsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryReceiptsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryReceiptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryReceiptRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryReceipt(ctx context.Context, body CreateInventoryReceiptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryReceiptRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryReceipt(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryReceiptRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryReservations(ctx context.Context, params *ListInventoryReservationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryReservationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryReservationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryReservationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryReservation(ctx context.Context, body CreateInventoryReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryReservationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReleaseInventoryReservationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseInventoryReservationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReleaseInventoryReservations(ctx context.Context, body ReleaseInventoryReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseInventoryReservationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteInventoryReservation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteInventoryReservationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryReservation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryReservationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ScanInventory(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScanInventoryRequest(c.Server, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryTransfers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryTransfersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryTransfer(ctx context.Context, body CreateInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryTransfer(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryTransferRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListInventoryUsage(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListInventoryUsageRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryUsageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryUsageRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateInventoryUsage(ctx context.Context, body CreateInventoryUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateInventoryUsageRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchUsageMovements(ctx context.Context, params *ListBatchUsageMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchUsageMovementsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchUsageWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchUsageRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchUsage(ctx context.Context, body CreateBatchUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchUsageRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryUsage(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryUsageRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryValuation(ctx context.Context, params *GetInventoryValuationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryValuationRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryConsumption(ctx context.Context, params *GetInventoryConsumptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryConsumptionRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryValuationSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryValuationSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInventoryValuationSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInventoryValuationSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInventoryValuationSettings(ctx context.Context, body UpdateInventoryValuationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInventoryValuationSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOverdueKegReport(ctx context.Context, params *GetOverdueKegReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOverdueKegReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetKegTurnTimeReport(ctx context.Context, params *GetKegTurnTimeReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKegTurnTimeReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetKegWriteOffReport(ctx context.Context, params *GetKegWriteOffReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKegWriteOffReportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListKegs(ctx context.Context, params *ListKegsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListKegsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateKegWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateKegRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateKeg(ctx context.Context, body CreateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateKegRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteKeg(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteKegRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetKeg(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetKegRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateKegWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateKegRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateKeg(ctx context.Context, uuid UuidPath, body UpdateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateKegRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListKegEvents(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListKegEventsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateKegEventWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateKegEventRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateKegEvent(ctx context.Context, uuid UuidPath, body CreateKegEventJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateKegEventRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLabelTemplates(ctx context.Context, params *ListLabelTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLabelTemplatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLabelTemplateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLabelTemplateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateLabelTemplate(ctx context.Context, body CreateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateLabelTemplateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteLabelTemplate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteLabelTemplateRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLabelTemplate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLabelTemplateRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLabelTemplateWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLabelTemplateRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateLabelTemplate(ctx context.Context, uuid UuidPath, body UpdateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateLabelTemplateRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyInventoryMigrationWithBody(ctx context.Context, params *ApplyInventoryMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyInventoryMigrationRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyInventoryMigration(ctx context.Context, params *ApplyInventoryMigrationParams, body ApplyInventoryMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyInventoryMigrationRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRemovalSummary(ctx context.Context, params *GetRemovalSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRemovalSummaryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRemovals(ctx context.Context, params *ListRemovalsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRemovalsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRemovalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRemovalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRemoval(ctx context.Context, body CreateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRemovalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRemoval(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRemovalRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRemoval(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRemovalRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRemovalWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRemovalRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRemoval(ctx context.Context, uuid UuidPath, body UpdateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRemovalRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListReplenishmentSuggestions(ctx context.Context, params *ListReplenishmentSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListReplenishmentSuggestionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateReplenishmentPurchaseOrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReplenishmentPurchaseOrdersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateReplenishmentPurchaseOrders(ctx context.Context, body CreateReplenishmentPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReplenishmentPurchaseOrdersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListStockLevels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStockLevelsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListStockLocations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStockLocationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockLocationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStockLocation(ctx context.Context, body CreateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStockLocationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteStockLocation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteStockLocationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateStockLocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStockLocationRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateStockLocation(ctx context.Context, uuid UuidPath, body UpdateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateStockLocationRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSupplierReturns(ctx context.Context, params *ListSupplierReturnsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSupplierReturnsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierReturnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierReturnRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierReturn(ctx context.Context, body CreateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierReturnRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSupplierReturn(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplierReturnRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplierReturnWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierReturnRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplierReturn(ctx context.Context, uuid UuidPath, body UpdateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierReturnRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncSupplierReturn(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncSupplierReturnRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetInventoryJournalRequest generates requests for GetInventoryJournal
+func NewGetInventoryJournalRequest(server string, params *GetInventoryJournalParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/inventory-journal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryJournalExportsRequest generates requests for ListInventoryJournalExports
+func NewListInventoryJournalExportsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/inventory-journal/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportInventoryJournalRequest calls the generic ExportInventoryJournal builder with application/json body
+func NewExportInventoryJournalRequest(server string, body ExportInventoryJournalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportInventoryJournalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportInventoryJournalRequestWithBody generates requests for ExportInventoryJournal with any type of body
+func NewExportInventoryJournalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/inventory-journal/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryJournalExportFileRequest generates requests for GetInventoryJournalExportFile
+func NewGetInventoryJournalExportFileRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/inventory-journal/exports/%s/file", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBeerLotItemsRequest generates requests for ListBeerLotItems
+func NewListBeerLotItemsRequest(server string, params *ListBeerLotItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BeerLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "beer_lot_uuid", runtime.ParamLocationQuery, *params.BeerLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Identifier != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "identifier", runtime.ParamLocationQuery, *params.Identifier); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBeerLotItemRequest calls the generic CreateBeerLotItem builder with application/json body
+func NewCreateBeerLotItemRequest(server string, body CreateBeerLotItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBeerLotItemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBeerLotItemRequestWithBody generates requests for CreateBeerLotItem with any type of body
+func NewCreateBeerLotItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScanBeerLotItemRequest calls the generic ScanBeerLotItem builder with application/json body
+func NewScanBeerLotItemRequest(server string, body ScanBeerLotItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewScanBeerLotItemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewScanBeerLotItemRequestWithBody generates requests for ScanBeerLotItem with any type of body
+func NewScanBeerLotItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/scan")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBeerLotItemRequest generates requests for DeleteBeerLotItem
+func NewDeleteBeerLotItemRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBeerLotItemRequest generates requests for GetBeerLotItem
+func NewGetBeerLotItemRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBeerLotItemRequest calls the generic UpdateBeerLotItem builder with application/json body
+func NewUpdateBeerLotItemRequest(server string, uuid UuidPath, body UpdateBeerLotItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBeerLotItemRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateBeerLotItemRequestWithBody generates requests for UpdateBeerLotItem with any type of body
+func NewUpdateBeerLotItemRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBeerLotItemHistoryRequest generates requests for ListBeerLotItemHistory
+func NewListBeerLotItemHistoryRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBeerLotItemStatusRequest calls the generic UpdateBeerLotItemStatus builder with application/json body
+func NewUpdateBeerLotItemStatusRequest(server string, uuid UuidPath, body UpdateBeerLotItemStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBeerLotItemStatusRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateBeerLotItemStatusRequestWithBody generates requests for UpdateBeerLotItemStatus with any type of body
+func NewUpdateBeerLotItemStatusRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-items/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBeerLotStockLevelsRequest generates requests for ListBeerLotStockLevels
+func NewListBeerLotStockLevelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lot-stock-levels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBeerLotsRequest generates requests for ListBeerLots
+func NewListBeerLotsRequest(server string, params *ListBeerLotsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProductionBatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "production_batch_uuid", runtime.ParamLocationQuery, *params.ProductionBatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBeerLotRequest calls the generic CreateBeerLot builder with application/json body
+func NewCreateBeerLotRequest(server string, body CreateBeerLotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBeerLotRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBeerLotRequestWithBody generates requests for CreateBeerLot with any type of body
+func NewCreateBeerLotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBeerLotRequest generates requests for GetBeerLot
+func NewGetBeerLotRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBeerLotLabelRequest generates requests for GetBeerLotLabel
+func NewGetBeerLotLabelRequest(server string, uuid UuidPath, params *GetBeerLotLabelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/beer-lots/%s/label", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Copies != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "copies", runtime.ParamLocationQuery, *params.Copies); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TemplateUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "template_uuid", runtime.ParamLocationQuery, *params.TemplateUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCycleCountAccuracyRequest generates requests for GetCycleCountAccuracy
+func NewGetCycleCountAccuracyRequest(server string, params *GetCycleCountAccuracyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-count-reports/accuracy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.StockLocationUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stock_location_uuid", runtime.ParamLocationQuery, *params.StockLocationUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCycleCountsRequest generates requests for ListCycleCounts
+func NewListCycleCountsRequest(server string, params *ListCycleCountsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.StockLocationUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "stock_location_uuid", runtime.ParamLocationQuery, *params.StockLocationUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateCycleCountRequest calls the generic CreateCycleCount builder with application/json body
+func NewCreateCycleCountRequest(server string, body CreateCycleCountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateCycleCountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateCycleCountRequestWithBody generates requests for CreateCycleCount with any type of body
+func NewCreateCycleCountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCycleCountRequest generates requests for GetCycleCount
+func NewGetCycleCountRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelCycleCountRequest generates requests for CancelCycleCount
+func NewCancelCycleCountRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordCycleCountsRequest calls the generic RecordCycleCounts builder with application/json body
+func NewRecordCycleCountsRequest(server string, uuid UuidPath, body RecordCycleCountsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordCycleCountsRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewRecordCycleCountsRequestWithBody generates requests for RecordCycleCounts with any type of body
+func NewRecordCycleCountsRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts/%s/counts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostCycleCountRequest generates requests for PostCycleCount
+func NewPostCycleCountRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/cycle-counts/%s/post", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListIngredientLotHopDetailsRequest generates requests for ListIngredientLotHopDetails
+func NewListIngredientLotHopDetailsRequest(server string, params *ListIngredientLotHopDetailsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-hop-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_lot_uuid", runtime.ParamLocationQuery, *params.IngredientLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientLotHopDetailRequest calls the generic CreateIngredientLotHopDetail builder with application/json body
+func NewCreateIngredientLotHopDetailRequest(server string, body CreateIngredientLotHopDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientLotHopDetailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientLotHopDetailRequestWithBody generates requests for CreateIngredientLotHopDetail with any type of body
+func NewCreateIngredientLotHopDetailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-hop-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIngredientLotHopDetailRequest generates requests for GetIngredientLotHopDetail
+func NewGetIngredientLotHopDetailRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-hop-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIngredientLotHopDetailRequest calls the generic UpdateIngredientLotHopDetail builder with application/json body
+func NewUpdateIngredientLotHopDetailRequest(server string, uuid UuidPath, body UpdateIngredientLotHopDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIngredientLotHopDetailRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateIngredientLotHopDetailRequestWithBody generates requests for UpdateIngredientLotHopDetail with any type of body
+func NewUpdateIngredientLotHopDetailRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-hop-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListIngredientLotMaltDetailsRequest generates requests for ListIngredientLotMaltDetails
+func NewListIngredientLotMaltDetailsRequest(server string, params *ListIngredientLotMaltDetailsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-malt-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_lot_uuid", runtime.ParamLocationQuery, *params.IngredientLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientLotMaltDetailRequest calls the generic CreateIngredientLotMaltDetail builder with application/json body
+func NewCreateIngredientLotMaltDetailRequest(server string, body CreateIngredientLotMaltDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientLotMaltDetailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientLotMaltDetailRequestWithBody generates requests for CreateIngredientLotMaltDetail with any type of body
+func NewCreateIngredientLotMaltDetailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-malt-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIngredientLotMaltDetailRequest generates requests for GetIngredientLotMaltDetail
+func NewGetIngredientLotMaltDetailRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-malt-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIngredientLotMaltDetailRequest calls the generic UpdateIngredientLotMaltDetail builder with application/json body
+func NewUpdateIngredientLotMaltDetailRequest(server string, uuid UuidPath, body UpdateIngredientLotMaltDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIngredientLotMaltDetailRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateIngredientLotMaltDetailRequestWithBody generates requests for UpdateIngredientLotMaltDetail with any type of body
+func NewUpdateIngredientLotMaltDetailRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-malt-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListIngredientLotStockLevelsRequest generates requests for ListIngredientLotStockLevels
+func NewListIngredientLotStockLevelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-stock-levels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListIngredientLotYeastDetailsRequest generates requests for ListIngredientLotYeastDetails
+func NewListIngredientLotYeastDetailsRequest(server string, params *ListIngredientLotYeastDetailsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-yeast-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_lot_uuid", runtime.ParamLocationQuery, *params.IngredientLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientLotYeastDetailRequest calls the generic CreateIngredientLotYeastDetail builder with application/json body
+func NewCreateIngredientLotYeastDetailRequest(server string, body CreateIngredientLotYeastDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientLotYeastDetailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientLotYeastDetailRequestWithBody generates requests for CreateIngredientLotYeastDetail with any type of body
+func NewCreateIngredientLotYeastDetailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-yeast-details")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIngredientLotYeastDetailRequest generates requests for GetIngredientLotYeastDetail
+func NewGetIngredientLotYeastDetailRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-yeast-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIngredientLotYeastDetailRequest calls the generic UpdateIngredientLotYeastDetail builder with application/json body
+func NewUpdateIngredientLotYeastDetailRequest(server string, uuid UuidPath, body UpdateIngredientLotYeastDetailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIngredientLotYeastDetailRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateIngredientLotYeastDetailRequestWithBody generates requests for UpdateIngredientLotYeastDetail with any type of body
+func NewUpdateIngredientLotYeastDetailRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lot-yeast-details/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListIngredientLotsRequest generates requests for ListIngredientLots
+func NewListIngredientLotsRequest(server string, params *ListIngredientLotsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_uuid", runtime.ParamLocationQuery, *params.IngredientUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ReceiptUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "receipt_uuid", runtime.ParamLocationQuery, *params.ReceiptUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PurchaseOrderLineUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_line_uuid", runtime.ParamLocationQuery, *params.PurchaseOrderLineUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientLotRequest calls the generic CreateIngredientLot builder with application/json body
+func NewCreateIngredientLotRequest(server string, body CreateIngredientLotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientLotRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientLotRequestWithBody generates requests for CreateIngredientLot with any type of body
+func NewCreateIngredientLotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBatchIngredientLotsRequest generates requests for ListBatchIngredientLots
+func NewListBatchIngredientLotsRequest(server string, params *ListBatchIngredientLotsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "production_ref_uuid", runtime.ParamLocationQuery, params.ProductionRefUuid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportIngredientLotsRequestWithBody generates requests for ImportIngredientLots with any type of body
+func NewImportIngredientLotsRequestWithBody(server string, params *ImportIngredientLotsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIngredientLotRequest generates requests for GetIngredientLot
+func NewGetIngredientLotRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIngredientLotLabelRequest generates requests for GetIngredientLotLabel
+func NewGetIngredientLotLabelRequest(server string, uuid UuidPath, params *GetIngredientLotLabelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-lots/%s/label", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Copies != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "copies", runtime.ParamLocationQuery, *params.Copies); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TemplateUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "template_uuid", runtime.ParamLocationQuery, *params.TemplateUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListIngredientReorderPoliciesRequest generates requests for ListIngredientReorderPolicies
+func NewListIngredientReorderPoliciesRequest(server string, params *ListIngredientReorderPoliciesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-reorder-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_uuid", runtime.ParamLocationQuery, *params.IngredientUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientReorderPolicyRequest calls the generic CreateIngredientReorderPolicy builder with application/json body
+func NewCreateIngredientReorderPolicyRequest(server string, body CreateIngredientReorderPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientReorderPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientReorderPolicyRequestWithBody generates requests for CreateIngredientReorderPolicy with any type of body
+func NewCreateIngredientReorderPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-reorder-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteIngredientReorderPolicyRequest generates requests for DeleteIngredientReorderPolicy
+func NewDeleteIngredientReorderPolicyRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-reorder-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetIngredientReorderPolicyRequest generates requests for GetIngredientReorderPolicy
+func NewGetIngredientReorderPolicyRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-reorder-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIngredientReorderPolicyRequest calls the generic UpdateIngredientReorderPolicy builder with application/json body
+func NewUpdateIngredientReorderPolicyRequest(server string, uuid UuidPath, body UpdateIngredientReorderPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateIngredientReorderPolicyRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateIngredientReorderPolicyRequestWithBody generates requests for UpdateIngredientReorderPolicy with any type of body
+func NewUpdateIngredientReorderPolicyRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredient-reorder-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListIngredientsRequest generates requests for ListIngredients
+func NewListIngredientsRequest(server string, params *ListIngredientsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateIngredientRequest calls the generic CreateIngredient builder with application/json body
+func NewCreateIngredientRequest(server string, body CreateIngredientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateIngredientRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateIngredientRequestWithBody generates requests for CreateIngredient with any type of body
+func NewCreateIngredientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredients")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportIngredientsRequestWithBody generates requests for ImportIngredients with any type of body
+func NewImportIngredientsRequestWithBody(server string, params *ImportIngredientsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredients/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIngredientRequest generates requests for GetIngredient
+func NewGetIngredientRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ingredients/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryAdjustmentsRequest generates requests for ListInventoryAdjustments
+func NewListInventoryAdjustmentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-adjustments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryAdjustmentRequest calls the generic CreateInventoryAdjustment builder with application/json body
+func NewCreateInventoryAdjustmentRequest(server string, body CreateInventoryAdjustmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryAdjustmentRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryAdjustmentRequestWithBody generates requests for CreateInventoryAdjustment with any type of body
+func NewCreateInventoryAdjustmentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-adjustments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryAdjustmentRequest generates requests for GetInventoryAdjustment
+func NewGetInventoryAdjustmentRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-adjustments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryMovementsRequest generates requests for ListInventoryMovements
+func NewListInventoryMovementsRequest(server string, params *ListInventoryMovementsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-movements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IngredientLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_lot_uuid", runtime.ParamLocationQuery, *params.IngredientLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BeerLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "beer_lot_uuid", runtime.ParamLocationQuery, *params.BeerLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryMovementRequest calls the generic CreateInventoryMovement builder with application/json body
+func NewCreateInventoryMovementRequest(server string, body CreateInventoryMovementJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryMovementRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryMovementRequestWithBody generates requests for CreateInventoryMovement with any type of body
+func NewCreateInventoryMovementRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-movements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryMovementRequest generates requests for GetInventoryMovement
+func NewGetInventoryMovementRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-movements/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryReceiptsRequest generates requests for ListInventoryReceipts
+func NewListInventoryReceiptsRequest(server string, params *ListInventoryReceiptsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-receipts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PurchaseOrderUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_uuid", runtime.ParamLocationQuery, *params.PurchaseOrderUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryReceiptRequest calls the generic CreateInventoryReceipt builder with application/json body
+func NewCreateInventoryReceiptRequest(server string, body CreateInventoryReceiptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryReceiptRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryReceiptRequestWithBody generates requests for CreateInventoryReceipt with any type of body
+func NewCreateInventoryReceiptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-receipts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryReceiptRequest generates requests for GetInventoryReceipt
+func NewGetInventoryReceiptRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-receipts/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryReservationsRequest generates requests for ListInventoryReservations
+func NewListInventoryReservationsRequest(server string, params *ListInventoryReservationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProductionRefUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "production_ref_uuid", runtime.ParamLocationQuery, *params.ProductionRefUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IngredientLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ingredient_lot_uuid", runtime.ParamLocationQuery, *params.IngredientLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryReservationRequest calls the generic CreateInventoryReservation builder with application/json body
+func NewCreateInventoryReservationRequest(server string, body CreateInventoryReservationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryReservationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryReservationRequestWithBody generates requests for CreateInventoryReservation with any type of body
+func NewCreateInventoryReservationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReleaseInventoryReservationsRequest calls the generic ReleaseInventoryReservations builder with application/json body
+func NewReleaseInventoryReservationsRequest(server string, body ReleaseInventoryReservationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReleaseInventoryReservationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReleaseInventoryReservationsRequestWithBody generates requests for ReleaseInventoryReservations with any type of body
+func NewReleaseInventoryReservationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-reservations/release")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteInventoryReservationRequest generates requests for DeleteInventoryReservation
+func NewDeleteInventoryReservationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInventoryReservationRequest generates requests for GetInventoryReservation
+func NewGetInventoryReservationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewScanInventoryRequest generates requests for ScanInventory
+func NewScanInventoryRequest(server string, code CodePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-scan/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryTransfersRequest generates requests for ListInventoryTransfers
+func NewListInventoryTransfersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryTransferRequest calls the generic CreateInventoryTransfer builder with application/json body
+func NewCreateInventoryTransferRequest(server string, body CreateInventoryTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryTransferRequestWithBody generates requests for CreateInventoryTransfer with any type of body
+func NewCreateInventoryTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryTransferRequest generates requests for GetInventoryTransfer
+func NewGetInventoryTransferRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListInventoryUsageRequest generates requests for ListInventoryUsage
+func NewListInventoryUsageRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateInventoryUsageRequest calls the generic CreateInventoryUsage builder with application/json body
+func NewCreateInventoryUsageRequest(server string, body CreateInventoryUsageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateInventoryUsageRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateInventoryUsageRequestWithBody generates requests for CreateInventoryUsage with any type of body
+func NewCreateInventoryUsageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-usage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBatchUsageMovementsRequest generates requests for ListBatchUsageMovements
+func NewListBatchUsageMovementsRequest(server string, params *ListBatchUsageMovementsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-usage/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "production_ref_uuid", runtime.ParamLocationQuery, params.ProductionRefUuid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchUsageRequest calls the generic CreateBatchUsage builder with application/json body
+func NewCreateBatchUsageRequest(server string, body CreateBatchUsageJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchUsageRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBatchUsageRequestWithBody generates requests for CreateBatchUsage with any type of body
+func NewCreateBatchUsageRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-usage/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryUsageRequest generates requests for GetInventoryUsage
+func NewGetInventoryUsageRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-usage/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInventoryValuationRequest generates requests for GetInventoryValuation
+func NewGetInventoryValuationRequest(server string, params *GetInventoryValuationParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-valuation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AsOf != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "as_of", runtime.ParamLocationQuery, *params.AsOf); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Method != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInventoryConsumptionRequest generates requests for GetInventoryConsumption
+func NewGetInventoryConsumptionRequest(server string, params *GetInventoryConsumptionParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-valuation/consumption")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Method != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "method", runtime.ParamLocationQuery, *params.Method); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInventoryValuationSettingsRequest generates requests for GetInventoryValuationSettings
+func NewGetInventoryValuationSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-valuation/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInventoryValuationSettingsRequest calls the generic UpdateInventoryValuationSettings builder with application/json body
+func NewUpdateInventoryValuationSettingsRequest(server string, body UpdateInventoryValuationSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInventoryValuationSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateInventoryValuationSettingsRequestWithBody generates requests for UpdateInventoryValuationSettings with any type of body
+func NewUpdateInventoryValuationSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inventory-valuation/settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOverdueKegReportRequest generates requests for GetOverdueKegReport
+func NewGetOverdueKegReportRequest(server string, params *GetOverdueKegReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/keg-reports/overdue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Days != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKegTurnTimeReportRequest generates requests for GetKegTurnTimeReport
+func NewGetKegTurnTimeReportRequest(server string, params *GetKegTurnTimeReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/keg-reports/turn-time")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKegWriteOffReportRequest generates requests for GetKegWriteOffReport
+func NewGetKegWriteOffReportRequest(server string, params *GetKegWriteOffReportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/keg-reports/write-offs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListKegsRequest generates requests for ListKegs
+func NewListKegsRequest(server string, params *ListKegsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ownership != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ownership", runtime.ParamLocationQuery, *params.Ownership); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Serial != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "serial", runtime.ParamLocationQuery, *params.Serial); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateKegRequest calls the generic CreateKeg builder with application/json body
+func NewCreateKegRequest(server string, body CreateKegJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateKegRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateKegRequestWithBody generates requests for CreateKeg with any type of body
+func NewCreateKegRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteKegRequest generates requests for DeleteKeg
+func NewDeleteKegRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetKegRequest generates requests for GetKeg
+func NewGetKegRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateKegRequest calls the generic UpdateKeg builder with application/json body
+func NewUpdateKegRequest(server string, uuid UuidPath, body UpdateKegJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateKegRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateKegRequestWithBody generates requests for UpdateKeg with any type of body
+func NewUpdateKegRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListKegEventsRequest generates requests for ListKegEvents
+func NewListKegEventsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateKegEventRequest calls the generic CreateKegEvent builder with application/json body
+func NewCreateKegEventRequest(server string, uuid UuidPath, body CreateKegEventJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateKegEventRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreateKegEventRequestWithBody generates requests for CreateKegEvent with any type of body
+func NewCreateKegEventRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/kegs/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListLabelTemplatesRequest generates requests for ListLabelTemplates
+func NewListLabelTemplatesRequest(server string, params *ListLabelTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/label-templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Subject != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateLabelTemplateRequest calls the generic CreateLabelTemplate builder with application/json body
+func NewCreateLabelTemplateRequest(server string, body CreateLabelTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateLabelTemplateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateLabelTemplateRequestWithBody generates requests for CreateLabelTemplate with any type of body
+func NewCreateLabelTemplateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/label-templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteLabelTemplateRequest generates requests for DeleteLabelTemplate
+func NewDeleteLabelTemplateRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/label-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLabelTemplateRequest generates requests for GetLabelTemplate
+func NewGetLabelTemplateRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/label-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateLabelTemplateRequest calls the generic UpdateLabelTemplate builder with application/json body
+func NewUpdateLabelTemplateRequest(server string, uuid UuidPath, body UpdateLabelTemplateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateLabelTemplateRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateLabelTemplateRequestWithBody generates requests for UpdateLabelTemplate with any type of body
+func NewUpdateLabelTemplateRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/label-templates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyInventoryMigrationRequest calls the generic ApplyInventoryMigration builder with application/json body
+func NewApplyInventoryMigrationRequest(server string, params *ApplyInventoryMigrationParams, body ApplyInventoryMigrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyInventoryMigrationRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewApplyInventoryMigrationRequestWithBody generates requests for ApplyInventoryMigration with any type of body
+func NewApplyInventoryMigrationRequestWithBody(server string, params *ApplyInventoryMigrationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/migrations/inventory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRemovalSummaryRequest generates requests for GetRemovalSummary
+func NewGetRemovalSummaryRequest(server string, params *GetRemovalSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removal-summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BeerLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "beer_lot_uuid", runtime.ParamLocationQuery, *params.BeerLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRemovalsRequest generates requests for ListRemovals
+func NewListRemovalsRequest(server string, params *ListRemovalsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.BeerLotUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "beer_lot_uuid", runtime.ParamLocationQuery, *params.BeerLotUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRemovalRequest calls the generic CreateRemoval builder with application/json body
+func NewCreateRemovalRequest(server string, body CreateRemovalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRemovalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRemovalRequestWithBody generates requests for CreateRemoval with any type of body
+func NewCreateRemovalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removals")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRemovalRequest generates requests for DeleteRemoval
+func NewDeleteRemovalRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRemovalRequest generates requests for GetRemoval
+func NewGetRemovalRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRemovalRequest calls the generic UpdateRemoval builder with application/json body
+func NewUpdateRemovalRequest(server string, uuid UuidPath, body UpdateRemovalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRemovalRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateRemovalRequestWithBody generates requests for UpdateRemoval with any type of body
+func NewUpdateRemovalRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/removals/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListReplenishmentSuggestionsRequest generates requests for ListReplenishmentSuggestions
+func NewListReplenishmentSuggestionsRequest(server string, params *ListReplenishmentSuggestionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/replenishment-suggestions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.WindowDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "window_days", runtime.ParamLocationQuery, *params.WindowDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateReplenishmentPurchaseOrdersRequest calls the generic CreateReplenishmentPurchaseOrders builder with application/json body
+func NewCreateReplenishmentPurchaseOrdersRequest(server string, body CreateReplenishmentPurchaseOrdersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateReplenishmentPurchaseOrdersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateReplenishmentPurchaseOrdersRequestWithBody generates requests for CreateReplenishmentPurchaseOrders with any type of body
+func NewCreateReplenishmentPurchaseOrdersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/replenishment-suggestions/purchase-orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListStockLevelsRequest generates requests for ListStockLevels
+func NewListStockLevelsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-levels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListStockLocationsRequest generates requests for ListStockLocations
+func NewListStockLocationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateStockLocationRequest calls the generic CreateStockLocation builder with application/json body
+func NewCreateStockLocationRequest(server string, body CreateStockLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStockLocationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateStockLocationRequestWithBody generates requests for CreateStockLocation with any type of body
+func NewCreateStockLocationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteStockLocationRequest generates requests for DeleteStockLocation
+func NewDeleteStockLocationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationRequest generates requests for GetStockLocation
+func NewGetStockLocationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateStockLocationRequest calls the generic UpdateStockLocation builder with application/json body
+func NewUpdateStockLocationRequest(server string, uuid UuidPath, body UpdateStockLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateStockLocationRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateStockLocationRequestWithBody generates requests for UpdateStockLocation with any type of body
+func NewUpdateStockLocationRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock-locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSupplierReturnsRequest generates requests for ListSupplierReturns
+func NewListSupplierReturnsRequest(server string, params *ListSupplierReturnsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-returns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.CreditStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "credit_status", runtime.ParamLocationQuery, *params.CreditStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PurchaseOrderSyncStatus != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_sync_status", runtime.ParamLocationQuery, *params.PurchaseOrderSyncStatus); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSupplierReturnRequest calls the generic CreateSupplierReturn builder with application/json body
+func NewCreateSupplierReturnRequest(server string, body CreateSupplierReturnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSupplierReturnRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSupplierReturnRequestWithBody generates requests for CreateSupplierReturn with any type of body
+func NewCreateSupplierReturnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-returns")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSupplierReturnRequest generates requests for GetSupplierReturn
+func NewGetSupplierReturnRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-returns/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSupplierReturnRequest calls the generic UpdateSupplierReturn builder with application/json body
+func NewUpdateSupplierReturnRequest(server string, uuid UuidPath, body UpdateSupplierReturnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSupplierReturnRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateSupplierReturnRequestWithBody generates requests for UpdateSupplierReturn with any type of body
+func NewUpdateSupplierReturnRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-returns/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSyncSupplierReturnRequest generates requests for SyncSupplierReturn
+func NewSyncSupplierReturnRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-returns/%s/sync", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetInventoryJournalWithResponse request
+	GetInventoryJournalWithResponse(ctx context.Context, params *GetInventoryJournalParams, reqEditors ...RequestEditorFn) (*GetInventoryJournalHTTPResponse, error)
+
+	// ListInventoryJournalExportsWithResponse request
+	ListInventoryJournalExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryJournalExportsHTTPResponse, error)
+
+	// ExportInventoryJournalWithBodyWithResponse request with any body
+	ExportInventoryJournalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportInventoryJournalHTTPResponse, error)
+
+	ExportInventoryJournalWithResponse(ctx context.Context, body ExportInventoryJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportInventoryJournalHTTPResponse, error)
+
+	// GetInventoryJournalExportFileWithResponse request
+	GetInventoryJournalExportFileWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryJournalExportFileHTTPResponse, error)
+
+	// ListBeerLotItemsWithResponse request
+	ListBeerLotItemsWithResponse(ctx context.Context, params *ListBeerLotItemsParams, reqEditors ...RequestEditorFn) (*ListBeerLotItemsHTTPResponse, error)
+
+	// CreateBeerLotItemWithBodyWithResponse request with any body
+	CreateBeerLotItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBeerLotItemHTTPResponse, error)
+
+	CreateBeerLotItemWithResponse(ctx context.Context, body CreateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBeerLotItemHTTPResponse, error)
+
+	// ScanBeerLotItemWithBodyWithResponse request with any body
+	ScanBeerLotItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScanBeerLotItemHTTPResponse, error)
+
+	ScanBeerLotItemWithResponse(ctx context.Context, body ScanBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*ScanBeerLotItemHTTPResponse, error)
+
+	// DeleteBeerLotItemWithResponse request
+	DeleteBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBeerLotItemHTTPResponse, error)
+
+	// GetBeerLotItemWithResponse request
+	GetBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBeerLotItemHTTPResponse, error)
+
+	// UpdateBeerLotItemWithBodyWithResponse request with any body
+	UpdateBeerLotItemWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemHTTPResponse, error)
+
+	UpdateBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemHTTPResponse, error)
+
+	// ListBeerLotItemHistoryWithResponse request
+	ListBeerLotItemHistoryWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListBeerLotItemHistoryHTTPResponse, error)
+
+	// UpdateBeerLotItemStatusWithBodyWithResponse request with any body
+	UpdateBeerLotItemStatusWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemStatusHTTPResponse, error)
+
+	UpdateBeerLotItemStatusWithResponse(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemStatusHTTPResponse, error)
+
+	// ListBeerLotStockLevelsWithResponse request
+	ListBeerLotStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBeerLotStockLevelsHTTPResponse, error)
+
+	// ListBeerLotsWithResponse request
+	ListBeerLotsWithResponse(ctx context.Context, params *ListBeerLotsParams, reqEditors ...RequestEditorFn) (*ListBeerLotsHTTPResponse, error)
+
+	// CreateBeerLotWithBodyWithResponse request with any body
+	CreateBeerLotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBeerLotHTTPResponse, error)
+
+	CreateBeerLotWithResponse(ctx context.Context, body CreateBeerLotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBeerLotHTTPResponse, error)
+
+	// GetBeerLotWithResponse request
+	GetBeerLotWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBeerLotHTTPResponse, error)
+
+	// GetBeerLotLabelWithResponse request
+	GetBeerLotLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetBeerLotLabelParams, reqEditors ...RequestEditorFn) (*GetBeerLotLabelHTTPResponse, error)
+
+	// GetCycleCountAccuracyWithResponse request
+	GetCycleCountAccuracyWithResponse(ctx context.Context, params *GetCycleCountAccuracyParams, reqEditors ...RequestEditorFn) (*GetCycleCountAccuracyHTTPResponse, error)
+
+	// ListCycleCountsWithResponse request
+	ListCycleCountsWithResponse(ctx context.Context, params *ListCycleCountsParams, reqEditors ...RequestEditorFn) (*ListCycleCountsHTTPResponse, error)
+
+	// CreateCycleCountWithBodyWithResponse request with any body
+	CreateCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCycleCountHTTPResponse, error)
+
+	CreateCycleCountWithResponse(ctx context.Context, body CreateCycleCountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCycleCountHTTPResponse, error)
+
+	// GetCycleCountWithResponse request
+	GetCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetCycleCountHTTPResponse, error)
+
+	// CancelCycleCountWithResponse request
+	CancelCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*CancelCycleCountHTTPResponse, error)
+
+	// RecordCycleCountsWithBodyWithResponse request with any body
+	RecordCycleCountsWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordCycleCountsHTTPResponse, error)
+
+	RecordCycleCountsWithResponse(ctx context.Context, uuid UuidPath, body RecordCycleCountsJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordCycleCountsHTTPResponse, error)
+
+	// PostCycleCountWithResponse request
+	PostCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*PostCycleCountHTTPResponse, error)
+
+	// ListIngredientLotHopDetailsWithResponse request
+	ListIngredientLotHopDetailsWithResponse(ctx context.Context, params *ListIngredientLotHopDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotHopDetailsHTTPResponse, error)
+
+	// CreateIngredientLotHopDetailWithBodyWithResponse request with any body
+	CreateIngredientLotHopDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotHopDetailHTTPResponse, error)
+
+	CreateIngredientLotHopDetailWithResponse(ctx context.Context, body CreateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotHopDetailHTTPResponse, error)
+
+	// GetIngredientLotHopDetailWithResponse request
+	GetIngredientLotHopDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotHopDetailHTTPResponse, error)
+
+	// UpdateIngredientLotHopDetailWithBodyWithResponse request with any body
+	UpdateIngredientLotHopDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotHopDetailHTTPResponse, error)
+
+	UpdateIngredientLotHopDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotHopDetailHTTPResponse, error)
+
+	// ListIngredientLotMaltDetailsWithResponse request
+	ListIngredientLotMaltDetailsWithResponse(ctx context.Context, params *ListIngredientLotMaltDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotMaltDetailsHTTPResponse, error)
+
+	// CreateIngredientLotMaltDetailWithBodyWithResponse request with any body
+	CreateIngredientLotMaltDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotMaltDetailHTTPResponse, error)
+
+	CreateIngredientLotMaltDetailWithResponse(ctx context.Context, body CreateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotMaltDetailHTTPResponse, error)
+
+	// GetIngredientLotMaltDetailWithResponse request
+	GetIngredientLotMaltDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotMaltDetailHTTPResponse, error)
+
+	// UpdateIngredientLotMaltDetailWithBodyWithResponse request with any body
+	UpdateIngredientLotMaltDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotMaltDetailHTTPResponse, error)
+
+	UpdateIngredientLotMaltDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotMaltDetailHTTPResponse, error)
+
+	// ListIngredientLotStockLevelsWithResponse request
+	ListIngredientLotStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngredientLotStockLevelsHTTPResponse, error)
+
+	// ListIngredientLotYeastDetailsWithResponse request
+	ListIngredientLotYeastDetailsWithResponse(ctx context.Context, params *ListIngredientLotYeastDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotYeastDetailsHTTPResponse, error)
+
+	// CreateIngredientLotYeastDetailWithBodyWithResponse request with any body
+	CreateIngredientLotYeastDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotYeastDetailHTTPResponse, error)
+
+	CreateIngredientLotYeastDetailWithResponse(ctx context.Context, body CreateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotYeastDetailHTTPResponse, error)
+
+	// GetIngredientLotYeastDetailWithResponse request
+	GetIngredientLotYeastDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotYeastDetailHTTPResponse, error)
+
+	// UpdateIngredientLotYeastDetailWithBodyWithResponse request with any body
+	UpdateIngredientLotYeastDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotYeastDetailHTTPResponse, error)
+
+	UpdateIngredientLotYeastDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotYeastDetailHTTPResponse, error)
+
+	// ListIngredientLotsWithResponse request
+	ListIngredientLotsWithResponse(ctx context.Context, params *ListIngredientLotsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotsHTTPResponse, error)
+
+	// CreateIngredientLotWithBodyWithResponse request with any body
+	CreateIngredientLotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotHTTPResponse, error)
+
+	CreateIngredientLotWithResponse(ctx context.Context, body CreateIngredientLotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotHTTPResponse, error)
+
+	// ListBatchIngredientLotsWithResponse request
+	ListBatchIngredientLotsWithResponse(ctx context.Context, params *ListBatchIngredientLotsParams, reqEditors ...RequestEditorFn) (*ListBatchIngredientLotsHTTPResponse, error)
+
+	// ImportIngredientLotsWithBodyWithResponse request with any body
+	ImportIngredientLotsWithBodyWithResponse(ctx context.Context, params *ImportIngredientLotsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIngredientLotsHTTPResponse, error)
+
+	// GetIngredientLotWithResponse request
+	GetIngredientLotWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotHTTPResponse, error)
+
+	// GetIngredientLotLabelWithResponse request
+	GetIngredientLotLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetIngredientLotLabelParams, reqEditors ...RequestEditorFn) (*GetIngredientLotLabelHTTPResponse, error)
+
+	// ListIngredientReorderPoliciesWithResponse request
+	ListIngredientReorderPoliciesWithResponse(ctx context.Context, params *ListIngredientReorderPoliciesParams, reqEditors ...RequestEditorFn) (*ListIngredientReorderPoliciesHTTPResponse, error)
+
+	// CreateIngredientReorderPolicyWithBodyWithResponse request with any body
+	CreateIngredientReorderPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientReorderPolicyHTTPResponse, error)
+
+	CreateIngredientReorderPolicyWithResponse(ctx context.Context, body CreateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientReorderPolicyHTTPResponse, error)
+
+	// DeleteIngredientReorderPolicyWithResponse request
+	DeleteIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteIngredientReorderPolicyHTTPResponse, error)
+
+	// GetIngredientReorderPolicyWithResponse request
+	GetIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientReorderPolicyHTTPResponse, error)
+
+	// UpdateIngredientReorderPolicyWithBodyWithResponse request with any body
+	UpdateIngredientReorderPolicyWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientReorderPolicyHTTPResponse, error)
+
+	UpdateIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientReorderPolicyHTTPResponse, error)
+
+	// ListIngredientsWithResponse request
+	ListIngredientsWithResponse(ctx context.Context, params *ListIngredientsParams, reqEditors ...RequestEditorFn) (*ListIngredientsHTTPResponse, error)
+
+	// CreateIngredientWithBodyWithResponse request with any body
+	CreateIngredientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientHTTPResponse, error)
+
+	CreateIngredientWithResponse(ctx context.Context, body CreateIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientHTTPResponse, error)
+
+	// ImportIngredientsWithBodyWithResponse request with any body
+	ImportIngredientsWithBodyWithResponse(ctx context.Context, params *ImportIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIngredientsHTTPResponse, error)
+
+	// GetIngredientWithResponse request
+	GetIngredientWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientHTTPResponse, error)
+
+	// ListInventoryAdjustmentsWithResponse request
+	ListInventoryAdjustmentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryAdjustmentsHTTPResponse, error)
+
+	// CreateInventoryAdjustmentWithBodyWithResponse request with any body
+	CreateInventoryAdjustmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryAdjustmentHTTPResponse, error)
+
+	CreateInventoryAdjustmentWithResponse(ctx context.Context, body CreateInventoryAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryAdjustmentHTTPResponse, error)
+
+	// GetInventoryAdjustmentWithResponse request
+	GetInventoryAdjustmentWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryAdjustmentHTTPResponse, error)
+
+	// ListInventoryMovementsWithResponse request
+	ListInventoryMovementsWithResponse(ctx context.Context, params *ListInventoryMovementsParams, reqEditors ...RequestEditorFn) (*ListInventoryMovementsHTTPResponse, error)
+
+	// CreateInventoryMovementWithBodyWithResponse request with any body
+	CreateInventoryMovementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryMovementHTTPResponse, error)
+
+	CreateInventoryMovementWithResponse(ctx context.Context, body CreateInventoryMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryMovementHTTPResponse, error)
+
+	// GetInventoryMovementWithResponse request
+	GetInventoryMovementWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryMovementHTTPResponse, error)
+
+	// ListInventoryReceiptsWithResponse request
+	ListInventoryReceiptsWithResponse(ctx context.Context, params *ListInventoryReceiptsParams, reqEditors ...RequestEditorFn) (*ListInventoryReceiptsHTTPResponse, error)
+
+	// CreateInventoryReceiptWithBodyWithResponse request with any body
+	CreateInventoryReceiptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryReceiptHTTPResponse, error)
+
+	CreateInventoryReceiptWithResponse(ctx context.Context, body CreateInventoryReceiptJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryReceiptHTTPResponse, error)
+
+	// GetInventoryReceiptWithResponse request
+	GetInventoryReceiptWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryReceiptHTTPResponse, error)
+
+	// ListInventoryReservationsWithResponse request
+	ListInventoryReservationsWithResponse(ctx context.Context, params *ListInventoryReservationsParams, reqEditors ...RequestEditorFn) (*ListInventoryReservationsHTTPResponse, error)
+
+	// CreateInventoryReservationWithBodyWithResponse request with any body
+	CreateInventoryReservationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryReservationHTTPResponse, error)
+
+	CreateInventoryReservationWithResponse(ctx context.Context, body CreateInventoryReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryReservationHTTPResponse, error)
+
+	// ReleaseInventoryReservationsWithBodyWithResponse request with any body
+	ReleaseInventoryReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReleaseInventoryReservationsHTTPResponse, error)
+
+	ReleaseInventoryReservationsWithResponse(ctx context.Context, body ReleaseInventoryReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReleaseInventoryReservationsHTTPResponse, error)
+
+	// DeleteInventoryReservationWithResponse request
+	DeleteInventoryReservationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteInventoryReservationHTTPResponse, error)
+
+	// GetInventoryReservationWithResponse request
+	GetInventoryReservationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryReservationHTTPResponse, error)
+
+	// ScanInventoryWithResponse request
+	ScanInventoryWithResponse(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*ScanInventoryHTTPResponse, error)
+
+	// ListInventoryTransfersWithResponse request
+	ListInventoryTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryTransfersHTTPResponse, error)
+
+	// CreateInventoryTransferWithBodyWithResponse request with any body
+	CreateInventoryTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryTransferHTTPResponse, error)
+
+	CreateInventoryTransferWithResponse(ctx context.Context, body CreateInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryTransferHTTPResponse, error)
+
+	// GetInventoryTransferWithResponse request
+	GetInventoryTransferWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryTransferHTTPResponse, error)
+
+	// ListInventoryUsageWithResponse request
+	ListInventoryUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryUsageHTTPResponse, error)
+
+	// CreateInventoryUsageWithBodyWithResponse request with any body
+	CreateInventoryUsageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryUsageHTTPResponse, error)
+
+	CreateInventoryUsageWithResponse(ctx context.Context, body CreateInventoryUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryUsageHTTPResponse, error)
+
+	// ListBatchUsageMovementsWithResponse request
+	ListBatchUsageMovementsWithResponse(ctx context.Context, params *ListBatchUsageMovementsParams, reqEditors ...RequestEditorFn) (*ListBatchUsageMovementsHTTPResponse, error)
+
+	// CreateBatchUsageWithBodyWithResponse request with any body
+	CreateBatchUsageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchUsageHTTPResponse, error)
+
+	CreateBatchUsageWithResponse(ctx context.Context, body CreateBatchUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchUsageHTTPResponse, error)
+
+	// GetInventoryUsageWithResponse request
+	GetInventoryUsageWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryUsageHTTPResponse, error)
+
+	// GetInventoryValuationWithResponse request
+	GetInventoryValuationWithResponse(ctx context.Context, params *GetInventoryValuationParams, reqEditors ...RequestEditorFn) (*GetInventoryValuationHTTPResponse, error)
+
+	// GetInventoryConsumptionWithResponse request
+	GetInventoryConsumptionWithResponse(ctx context.Context, params *GetInventoryConsumptionParams, reqEditors ...RequestEditorFn) (*GetInventoryConsumptionHTTPResponse, error)
+
+	// GetInventoryValuationSettingsWithResponse request
+	GetInventoryValuationSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInventoryValuationSettingsHTTPResponse, error)
+
+	// UpdateInventoryValuationSettingsWithBodyWithResponse request with any body
+	UpdateInventoryValuationSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInventoryValuationSettingsHTTPResponse, error)
+
+	UpdateInventoryValuationSettingsWithResponse(ctx context.Context, body UpdateInventoryValuationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInventoryValuationSettingsHTTPResponse, error)
+
+	// GetOverdueKegReportWithResponse request
+	GetOverdueKegReportWithResponse(ctx context.Context, params *GetOverdueKegReportParams, reqEditors ...RequestEditorFn) (*GetOverdueKegReportHTTPResponse, error)
+
+	// GetKegTurnTimeReportWithResponse request
+	GetKegTurnTimeReportWithResponse(ctx context.Context, params *GetKegTurnTimeReportParams, reqEditors ...RequestEditorFn) (*GetKegTurnTimeReportHTTPResponse, error)
+
+	// GetKegWriteOffReportWithResponse request
+	GetKegWriteOffReportWithResponse(ctx context.Context, params *GetKegWriteOffReportParams, reqEditors ...RequestEditorFn) (*GetKegWriteOffReportHTTPResponse, error)
+
+	// ListKegsWithResponse request
+	ListKegsWithResponse(ctx context.Context, params *ListKegsParams, reqEditors ...RequestEditorFn) (*ListKegsHTTPResponse, error)
+
+	// CreateKegWithBodyWithResponse request with any body
+	CreateKegWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKegHTTPResponse, error)
+
+	CreateKegWithResponse(ctx context.Context, body CreateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKegHTTPResponse, error)
+
+	// DeleteKegWithResponse request
+	DeleteKegWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteKegHTTPResponse, error)
+
+	// GetKegWithResponse request
+	GetKegWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetKegHTTPResponse, error)
+
+	// UpdateKegWithBodyWithResponse request with any body
+	UpdateKegWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKegHTTPResponse, error)
+
+	UpdateKegWithResponse(ctx context.Context, uuid UuidPath, body UpdateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKegHTTPResponse, error)
+
+	// ListKegEventsWithResponse request
+	ListKegEventsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListKegEventsHTTPResponse, error)
+
+	// CreateKegEventWithBodyWithResponse request with any body
+	CreateKegEventWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKegEventHTTPResponse, error)
+
+	CreateKegEventWithResponse(ctx context.Context, uuid UuidPath, body CreateKegEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKegEventHTTPResponse, error)
+
+	// ListLabelTemplatesWithResponse request
+	ListLabelTemplatesWithResponse(ctx context.Context, params *ListLabelTemplatesParams, reqEditors ...RequestEditorFn) (*ListLabelTemplatesHTTPResponse, error)
+
+	// CreateLabelTemplateWithBodyWithResponse request with any body
+	CreateLabelTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLabelTemplateHTTPResponse, error)
+
+	CreateLabelTemplateWithResponse(ctx context.Context, body CreateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLabelTemplateHTTPResponse, error)
+
+	// DeleteLabelTemplateWithResponse request
+	DeleteLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteLabelTemplateHTTPResponse, error)
+
+	// GetLabelTemplateWithResponse request
+	GetLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetLabelTemplateHTTPResponse, error)
+
+	// UpdateLabelTemplateWithBodyWithResponse request with any body
+	UpdateLabelTemplateWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLabelTemplateHTTPResponse, error)
+
+	UpdateLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, body UpdateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLabelTemplateHTTPResponse, error)
+
+	// ApplyInventoryMigrationWithBodyWithResponse request with any body
+	ApplyInventoryMigrationWithBodyWithResponse(ctx context.Context, params *ApplyInventoryMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyInventoryMigrationHTTPResponse, error)
+
+	ApplyInventoryMigrationWithResponse(ctx context.Context, params *ApplyInventoryMigrationParams, body ApplyInventoryMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyInventoryMigrationHTTPResponse, error)
+
+	// GetRemovalSummaryWithResponse request
+	GetRemovalSummaryWithResponse(ctx context.Context, params *GetRemovalSummaryParams, reqEditors ...RequestEditorFn) (*GetRemovalSummaryHTTPResponse, error)
+
+	// ListRemovalsWithResponse request
+	ListRemovalsWithResponse(ctx context.Context, params *ListRemovalsParams, reqEditors ...RequestEditorFn) (*ListRemovalsHTTPResponse, error)
+
+	// CreateRemovalWithBodyWithResponse request with any body
+	CreateRemovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRemovalHTTPResponse, error)
+
+	CreateRemovalWithResponse(ctx context.Context, body CreateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRemovalHTTPResponse, error)
+
+	// DeleteRemovalWithResponse request
+	DeleteRemovalWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteRemovalHTTPResponse, error)
+
+	// GetRemovalWithResponse request
+	GetRemovalWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetRemovalHTTPResponse, error)
+
+	// UpdateRemovalWithBodyWithResponse request with any body
+	UpdateRemovalWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRemovalHTTPResponse, error)
+
+	UpdateRemovalWithResponse(ctx context.Context, uuid UuidPath, body UpdateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRemovalHTTPResponse, error)
+
+	// ListReplenishmentSuggestionsWithResponse request
+	ListReplenishmentSuggestionsWithResponse(ctx context.Context, params *ListReplenishmentSuggestionsParams, reqEditors ...RequestEditorFn) (*ListReplenishmentSuggestionsHTTPResponse, error)
+
+	// CreateReplenishmentPurchaseOrdersWithBodyWithResponse request with any body
+	CreateReplenishmentPurchaseOrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReplenishmentPurchaseOrdersHTTPResponse, error)
+
+	CreateReplenishmentPurchaseOrdersWithResponse(ctx context.Context, body CreateReplenishmentPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReplenishmentPurchaseOrdersHTTPResponse, error)
+
+	// ListStockLevelsWithResponse request
+	ListStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStockLevelsHTTPResponse, error)
+
+	// ListStockLocationsWithResponse request
+	ListStockLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStockLocationsHTTPResponse, error)
+
+	// CreateStockLocationWithBodyWithResponse request with any body
+	CreateStockLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockLocationHTTPResponse, error)
+
+	CreateStockLocationWithResponse(ctx context.Context, body CreateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockLocationHTTPResponse, error)
+
+	// DeleteStockLocationWithResponse request
+	DeleteStockLocationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteStockLocationHTTPResponse, error)
+
+	// GetStockLocationWithResponse request
+	GetStockLocationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetStockLocationHTTPResponse, error)
+
+	// UpdateStockLocationWithBodyWithResponse request with any body
+	UpdateStockLocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStockLocationHTTPResponse, error)
+
+	UpdateStockLocationWithResponse(ctx context.Context, uuid UuidPath, body UpdateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateStockLocationHTTPResponse, error)
+
+	// ListSupplierReturnsWithResponse request
+	ListSupplierReturnsWithResponse(ctx context.Context, params *ListSupplierReturnsParams, reqEditors ...RequestEditorFn) (*ListSupplierReturnsHTTPResponse, error)
+
+	// CreateSupplierReturnWithBodyWithResponse request with any body
+	CreateSupplierReturnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierReturnHTTPResponse, error)
+
+	CreateSupplierReturnWithResponse(ctx context.Context, body CreateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierReturnHTTPResponse, error)
+
+	// GetSupplierReturnWithResponse request
+	GetSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierReturnHTTPResponse, error)
+
+	// UpdateSupplierReturnWithBodyWithResponse request with any body
+	UpdateSupplierReturnWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierReturnHTTPResponse, error)
+
+	UpdateSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierReturnHTTPResponse, error)
+
+	// SyncSupplierReturnWithResponse request
+	SyncSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*SyncSupplierReturnHTTPResponse, error)
+}
+
+type GetInventoryJournalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Journal
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryJournalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryJournalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryJournalExportsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]JournalExportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryJournalExportsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryJournalExportsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportInventoryJournalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *JournalExportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportInventoryJournalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportInventoryJournalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryJournalExportFileHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryJournalExportFileHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryJournalExportFileHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBeerLotItemsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBeerLotItemsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBeerLotItemsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBeerLotItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBeerLotItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBeerLotItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ScanBeerLotItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ScanBeerLotItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScanBeerLotItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBeerLotItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBeerLotItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBeerLotItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBeerLotItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBeerLotItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBeerLotItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBeerLotItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBeerLotItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBeerLotItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBeerLotItemHistoryHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BeerLotItemEventResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBeerLotItemHistoryHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBeerLotItemHistoryHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBeerLotItemStatusHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeerLotItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBeerLotItemStatusHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBeerLotItemStatusHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBeerLotStockLevelsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BeerLotStockLevelResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBeerLotStockLevelsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBeerLotStockLevelsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBeerLotsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BeerLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBeerLotsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBeerLotsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBeerLotHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BeerLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBeerLotHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBeerLotHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBeerLotHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BeerLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBeerLotHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBeerLotHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBeerLotLabelHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBeerLotLabelHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBeerLotLabelHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCycleCountAccuracyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CycleCountAccuracyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCycleCountAccuracyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCycleCountAccuracyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCycleCountsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCycleCountsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCycleCountsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCycleCountHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCycleCountHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCycleCountHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCycleCountHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCycleCountHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCycleCountHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelCycleCountHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelCycleCountHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelCycleCountHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordCycleCountsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordCycleCountsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordCycleCountsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostCycleCountHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CycleCountResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostCycleCountHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostCycleCountHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientLotHopDetailsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotHopDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientLotHopDetailsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientLotHopDetailsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientLotHopDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IngredientLotHopDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientLotHopDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientLotHopDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientLotHopDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotHopDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientLotHopDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientLotHopDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIngredientLotHopDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotHopDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIngredientLotHopDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIngredientLotHopDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientLotMaltDetailsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotMaltDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientLotMaltDetailsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientLotMaltDetailsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientLotMaltDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IngredientLotMaltDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientLotMaltDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientLotMaltDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientLotMaltDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotMaltDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientLotMaltDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientLotMaltDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIngredientLotMaltDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotMaltDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIngredientLotMaltDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIngredientLotMaltDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientLotStockLevelsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IngredientLotStockLevelResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientLotStockLevelsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientLotStockLevelsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientLotYeastDetailsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotYeastDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientLotYeastDetailsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientLotYeastDetailsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientLotYeastDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IngredientLotYeastDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientLotYeastDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientLotYeastDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientLotYeastDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotYeastDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientLotYeastDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientLotYeastDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIngredientLotYeastDetailHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotYeastDetailResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIngredientLotYeastDetailHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIngredientLotYeastDetailHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientLotsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IngredientLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientLotsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientLotsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientLotHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IngredientLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientLotHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientLotHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchIngredientLotsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchIngredientLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchIngredientLotsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchIngredientLotsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportIngredientLotsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Result
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportIngredientLotsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportIngredientLotsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientLotHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientLotResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientLotHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientLotHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientLotLabelHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientLotLabelHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientLotLabelHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientReorderPoliciesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReorderPolicyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientReorderPoliciesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientReorderPoliciesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientReorderPolicyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReorderPolicyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientReorderPolicyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientReorderPolicyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteIngredientReorderPolicyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteIngredientReorderPolicyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteIngredientReorderPolicyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientReorderPolicyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReorderPolicyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientReorderPolicyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientReorderPolicyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIngredientReorderPolicyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReorderPolicyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIngredientReorderPolicyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIngredientReorderPolicyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIngredientsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIngredientsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIngredientsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportIngredientsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Result
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportIngredientsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportIngredientsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryAdjustmentsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryAdjustmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryAdjustmentsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryAdjustmentsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryAdjustmentHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryAdjustmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryAdjustmentHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryAdjustmentHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryAdjustmentHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryAdjustmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryAdjustmentHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryAdjustmentHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryMovementsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryMovementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryMovementsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryMovementsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryMovementHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryMovementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryMovementHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryMovementHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryMovementHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryMovementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryMovementHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryMovementHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryReceiptsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryReceiptResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryReceiptsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryReceiptsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryReceiptHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryReceiptResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryReceiptHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryReceiptHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryReceiptHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryReceiptResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryReceiptHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryReceiptHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryReservationsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryReservationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryReservationsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryReservationsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryReservationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryReservationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryReservationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryReservationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReleaseInventoryReservationsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReleaseInventoryReservationsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReleaseInventoryReservationsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReleaseInventoryReservationsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteInventoryReservationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteInventoryReservationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteInventoryReservationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryReservationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryReservationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryReservationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryReservationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ScanInventoryHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ScanInventoryHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScanInventoryHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryTransfersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryTransferResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryTransfersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryTransfersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryTransferHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryTransferResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryTransferHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryTransferHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryTransferHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryTransferResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryTransferHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryTransferHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListInventoryUsageHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]InventoryUsageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListInventoryUsageHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListInventoryUsageHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateInventoryUsageHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *InventoryUsageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateInventoryUsageHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateInventoryUsageHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchUsageMovementsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchUsageMovementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchUsageMovementsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchUsageMovementsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchUsageHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchUsageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchUsageHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchUsageHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryUsageHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryUsageResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryUsageHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryUsageHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryValuationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryValuationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryValuationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryValuationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryConsumptionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InventoryConsumptionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryConsumptionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryConsumptionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryValuationSettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValuationSettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryValuationSettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryValuationSettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInventoryValuationSettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValuationSettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInventoryValuationSettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInventoryValuationSettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOverdueKegReportHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OverdueKegResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOverdueKegReportHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOverdueKegReportHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKegTurnTimeReportHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KegTurnTimeReportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKegTurnTimeReportHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKegTurnTimeReportHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKegWriteOffReportHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KegWriteOffReportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKegWriteOffReportHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKegWriteOffReportHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListKegsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]KegResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListKegsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListKegsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateKegHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *KegResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateKegHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateKegHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteKegHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteKegHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteKegHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetKegHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KegResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetKegHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetKegHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateKegHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KegResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateKegHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateKegHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListKegEventsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]KegEventResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListKegEventsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListKegEventsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateKegEventHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecordKegEventResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateKegEventHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateKegEventHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLabelTemplatesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]LabelTemplateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLabelTemplatesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLabelTemplatesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateLabelTemplateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *LabelTemplateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateLabelTemplateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateLabelTemplateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteLabelTemplateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteLabelTemplateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteLabelTemplateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLabelTemplateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LabelTemplateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLabelTemplateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLabelTemplateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateLabelTemplateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LabelTemplateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateLabelTemplateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateLabelTemplateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyInventoryMigrationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrewimportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyInventoryMigrationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyInventoryMigrationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRemovalSummaryHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RemovalSummaryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRemovalSummaryHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRemovalSummaryHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRemovalsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RemovalResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRemovalsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRemovalsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRemovalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RemovalResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRemovalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRemovalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRemovalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRemovalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRemovalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRemovalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RemovalResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRemovalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRemovalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRemovalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RemovalResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRemovalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRemovalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListReplenishmentSuggestionsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReplenishmentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListReplenishmentSuggestionsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListReplenishmentSuggestionsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateReplenishmentPurchaseOrdersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReplenishmentPurchaseOrdersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateReplenishmentPurchaseOrdersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateReplenishmentPurchaseOrdersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListStockLevelsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StockLevelResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListStockLevelsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListStockLevelsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListStockLocationsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StockLocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListStockLocationsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListStockLocationsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStockLocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StockLocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStockLocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStockLocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteStockLocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteStockLocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteStockLocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockLocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateStockLocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockLocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateStockLocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateStockLocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSupplierReturnsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SupplierReturnResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSupplierReturnsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSupplierReturnsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSupplierReturnHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SupplierReturnResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSupplierReturnHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSupplierReturnHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSupplierReturnHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierReturnResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplierReturnHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplierReturnHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSupplierReturnHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierReturnResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSupplierReturnHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSupplierReturnHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncSupplierReturnHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierReturnResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncSupplierReturnHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncSupplierReturnHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetInventoryJournalWithResponse request returning *GetInventoryJournalHTTPResponse
+func (c *ClientWithResponses) GetInventoryJournalWithResponse(ctx context.Context, params *GetInventoryJournalParams, reqEditors ...RequestEditorFn) (*GetInventoryJournalHTTPResponse, error) {
+	rsp, err := c.GetInventoryJournal(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryJournalHTTPResponse(rsp)
+}
+
+// ListInventoryJournalExportsWithResponse request returning *ListInventoryJournalExportsHTTPResponse
+func (c *ClientWithResponses) ListInventoryJournalExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryJournalExportsHTTPResponse, error) {
+	rsp, err := c.ListInventoryJournalExports(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryJournalExportsHTTPResponse(rsp)
+}
+
+// ExportInventoryJournalWithBodyWithResponse request with arbitrary body returning *ExportInventoryJournalHTTPResponse
+func (c *ClientWithResponses) ExportInventoryJournalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportInventoryJournalHTTPResponse, error) {
+	rsp, err := c.ExportInventoryJournalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInventoryJournalHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExportInventoryJournalWithResponse(ctx context.Context, body ExportInventoryJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportInventoryJournalHTTPResponse, error) {
+	rsp, err := c.ExportInventoryJournal(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportInventoryJournalHTTPResponse(rsp)
+}
+
+// GetInventoryJournalExportFileWithResponse request returning *GetInventoryJournalExportFileHTTPResponse
+func (c *ClientWithResponses) GetInventoryJournalExportFileWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryJournalExportFileHTTPResponse, error) {
+	rsp, err := c.GetInventoryJournalExportFile(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryJournalExportFileHTTPResponse(rsp)
+}
+
+// ListBeerLotItemsWithResponse request returning *ListBeerLotItemsHTTPResponse
+func (c *ClientWithResponses) ListBeerLotItemsWithResponse(ctx context.Context, params *ListBeerLotItemsParams, reqEditors ...RequestEditorFn) (*ListBeerLotItemsHTTPResponse, error) {
+	rsp, err := c.ListBeerLotItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBeerLotItemsHTTPResponse(rsp)
+}
+
+// CreateBeerLotItemWithBodyWithResponse request with arbitrary body returning *CreateBeerLotItemHTTPResponse
+func (c *ClientWithResponses) CreateBeerLotItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBeerLotItemHTTPResponse, error) {
+	rsp, err := c.CreateBeerLotItemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBeerLotItemHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBeerLotItemWithResponse(ctx context.Context, body CreateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBeerLotItemHTTPResponse, error) {
+	rsp, err := c.CreateBeerLotItem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBeerLotItemHTTPResponse(rsp)
+}
+
+// ScanBeerLotItemWithBodyWithResponse request with arbitrary body returning *ScanBeerLotItemHTTPResponse
+func (c *ClientWithResponses) ScanBeerLotItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ScanBeerLotItemHTTPResponse, error) {
+	rsp, err := c.ScanBeerLotItemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScanBeerLotItemHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ScanBeerLotItemWithResponse(ctx context.Context, body ScanBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*ScanBeerLotItemHTTPResponse, error) {
+	rsp, err := c.ScanBeerLotItem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScanBeerLotItemHTTPResponse(rsp)
+}
+
+// DeleteBeerLotItemWithResponse request returning *DeleteBeerLotItemHTTPResponse
+func (c *ClientWithResponses) DeleteBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBeerLotItemHTTPResponse, error) {
+	rsp, err := c.DeleteBeerLotItem(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBeerLotItemHTTPResponse(rsp)
+}
+
+// GetBeerLotItemWithResponse request returning *GetBeerLotItemHTTPResponse
+func (c *ClientWithResponses) GetBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBeerLotItemHTTPResponse, error) {
+	rsp, err := c.GetBeerLotItem(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBeerLotItemHTTPResponse(rsp)
+}
+
+// UpdateBeerLotItemWithBodyWithResponse request with arbitrary body returning *UpdateBeerLotItemHTTPResponse
+func (c *ClientWithResponses) UpdateBeerLotItemWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemHTTPResponse, error) {
+	rsp, err := c.UpdateBeerLotItemWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBeerLotItemHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBeerLotItemWithResponse(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemHTTPResponse, error) {
+	rsp, err := c.UpdateBeerLotItem(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBeerLotItemHTTPResponse(rsp)
+}
+
+// ListBeerLotItemHistoryWithResponse request returning *ListBeerLotItemHistoryHTTPResponse
+func (c *ClientWithResponses) ListBeerLotItemHistoryWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListBeerLotItemHistoryHTTPResponse, error) {
+	rsp, err := c.ListBeerLotItemHistory(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBeerLotItemHistoryHTTPResponse(rsp)
+}
+
+// UpdateBeerLotItemStatusWithBodyWithResponse request with arbitrary body returning *UpdateBeerLotItemStatusHTTPResponse
+func (c *ClientWithResponses) UpdateBeerLotItemStatusWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemStatusHTTPResponse, error) {
+	rsp, err := c.UpdateBeerLotItemStatusWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBeerLotItemStatusHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBeerLotItemStatusWithResponse(ctx context.Context, uuid UuidPath, body UpdateBeerLotItemStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBeerLotItemStatusHTTPResponse, error) {
+	rsp, err := c.UpdateBeerLotItemStatus(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBeerLotItemStatusHTTPResponse(rsp)
+}
+
+// ListBeerLotStockLevelsWithResponse request returning *ListBeerLotStockLevelsHTTPResponse
+func (c *ClientWithResponses) ListBeerLotStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBeerLotStockLevelsHTTPResponse, error) {
+	rsp, err := c.ListBeerLotStockLevels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBeerLotStockLevelsHTTPResponse(rsp)
+}
+
+// ListBeerLotsWithResponse request returning *ListBeerLotsHTTPResponse
+func (c *ClientWithResponses) ListBeerLotsWithResponse(ctx context.Context, params *ListBeerLotsParams, reqEditors ...RequestEditorFn) (*ListBeerLotsHTTPResponse, error) {
+	rsp, err := c.ListBeerLots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBeerLotsHTTPResponse(rsp)
+}
+
+// CreateBeerLotWithBodyWithResponse request with arbitrary body returning *CreateBeerLotHTTPResponse
+func (c *ClientWithResponses) CreateBeerLotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBeerLotHTTPResponse, error) {
+	rsp, err := c.CreateBeerLotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBeerLotHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBeerLotWithResponse(ctx context.Context, body CreateBeerLotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBeerLotHTTPResponse, error) {
+	rsp, err := c.CreateBeerLot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBeerLotHTTPResponse(rsp)
+}
+
+// GetBeerLotWithResponse request returning *GetBeerLotHTTPResponse
+func (c *ClientWithResponses) GetBeerLotWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBeerLotHTTPResponse, error) {
+	rsp, err := c.GetBeerLot(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBeerLotHTTPResponse(rsp)
+}
+
+// GetBeerLotLabelWithResponse request returning *GetBeerLotLabelHTTPResponse
+func (c *ClientWithResponses) GetBeerLotLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetBeerLotLabelParams, reqEditors ...RequestEditorFn) (*GetBeerLotLabelHTTPResponse, error) {
+	rsp, err := c.GetBeerLotLabel(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBeerLotLabelHTTPResponse(rsp)
+}
+
+// GetCycleCountAccuracyWithResponse request returning *GetCycleCountAccuracyHTTPResponse
+func (c *ClientWithResponses) GetCycleCountAccuracyWithResponse(ctx context.Context, params *GetCycleCountAccuracyParams, reqEditors ...RequestEditorFn) (*GetCycleCountAccuracyHTTPResponse, error) {
+	rsp, err := c.GetCycleCountAccuracy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCycleCountAccuracyHTTPResponse(rsp)
+}
+
+// ListCycleCountsWithResponse request returning *ListCycleCountsHTTPResponse
+func (c *ClientWithResponses) ListCycleCountsWithResponse(ctx context.Context, params *ListCycleCountsParams, reqEditors ...RequestEditorFn) (*ListCycleCountsHTTPResponse, error) {
+	rsp, err := c.ListCycleCounts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCycleCountsHTTPResponse(rsp)
+}
+
+// CreateCycleCountWithBodyWithResponse request with arbitrary body returning *CreateCycleCountHTTPResponse
+func (c *ClientWithResponses) CreateCycleCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCycleCountHTTPResponse, error) {
+	rsp, err := c.CreateCycleCountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCycleCountHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCycleCountWithResponse(ctx context.Context, body CreateCycleCountJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCycleCountHTTPResponse, error) {
+	rsp, err := c.CreateCycleCount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCycleCountHTTPResponse(rsp)
+}
+
+// GetCycleCountWithResponse request returning *GetCycleCountHTTPResponse
+func (c *ClientWithResponses) GetCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetCycleCountHTTPResponse, error) {
+	rsp, err := c.GetCycleCount(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCycleCountHTTPResponse(rsp)
+}
+
+// CancelCycleCountWithResponse request returning *CancelCycleCountHTTPResponse
+func (c *ClientWithResponses) CancelCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*CancelCycleCountHTTPResponse, error) {
+	rsp, err := c.CancelCycleCount(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelCycleCountHTTPResponse(rsp)
+}
+
+// RecordCycleCountsWithBodyWithResponse request with arbitrary body returning *RecordCycleCountsHTTPResponse
+func (c *ClientWithResponses) RecordCycleCountsWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordCycleCountsHTTPResponse, error) {
+	rsp, err := c.RecordCycleCountsWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordCycleCountsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecordCycleCountsWithResponse(ctx context.Context, uuid UuidPath, body RecordCycleCountsJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordCycleCountsHTTPResponse, error) {
+	rsp, err := c.RecordCycleCounts(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordCycleCountsHTTPResponse(rsp)
+}
+
+// PostCycleCountWithResponse request returning *PostCycleCountHTTPResponse
+func (c *ClientWithResponses) PostCycleCountWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*PostCycleCountHTTPResponse, error) {
+	rsp, err := c.PostCycleCount(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostCycleCountHTTPResponse(rsp)
+}
+
+// ListIngredientLotHopDetailsWithResponse request returning *ListIngredientLotHopDetailsHTTPResponse
+func (c *ClientWithResponses) ListIngredientLotHopDetailsWithResponse(ctx context.Context, params *ListIngredientLotHopDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotHopDetailsHTTPResponse, error) {
+	rsp, err := c.ListIngredientLotHopDetails(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientLotHopDetailsHTTPResponse(rsp)
+}
+
+// CreateIngredientLotHopDetailWithBodyWithResponse request with arbitrary body returning *CreateIngredientLotHopDetailHTTPResponse
+func (c *ClientWithResponses) CreateIngredientLotHopDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotHopDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotHopDetailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotHopDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientLotHopDetailWithResponse(ctx context.Context, body CreateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotHopDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotHopDetail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotHopDetailHTTPResponse(rsp)
+}
+
+// GetIngredientLotHopDetailWithResponse request returning *GetIngredientLotHopDetailHTTPResponse
+func (c *ClientWithResponses) GetIngredientLotHopDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotHopDetailHTTPResponse, error) {
+	rsp, err := c.GetIngredientLotHopDetail(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientLotHopDetailHTTPResponse(rsp)
+}
+
+// UpdateIngredientLotHopDetailWithBodyWithResponse request with arbitrary body returning *UpdateIngredientLotHopDetailHTTPResponse
+func (c *ClientWithResponses) UpdateIngredientLotHopDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotHopDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotHopDetailWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotHopDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIngredientLotHopDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotHopDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotHopDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotHopDetail(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotHopDetailHTTPResponse(rsp)
+}
+
+// ListIngredientLotMaltDetailsWithResponse request returning *ListIngredientLotMaltDetailsHTTPResponse
+func (c *ClientWithResponses) ListIngredientLotMaltDetailsWithResponse(ctx context.Context, params *ListIngredientLotMaltDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotMaltDetailsHTTPResponse, error) {
+	rsp, err := c.ListIngredientLotMaltDetails(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientLotMaltDetailsHTTPResponse(rsp)
+}
+
+// CreateIngredientLotMaltDetailWithBodyWithResponse request with arbitrary body returning *CreateIngredientLotMaltDetailHTTPResponse
+func (c *ClientWithResponses) CreateIngredientLotMaltDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotMaltDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotMaltDetailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotMaltDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientLotMaltDetailWithResponse(ctx context.Context, body CreateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotMaltDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotMaltDetail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotMaltDetailHTTPResponse(rsp)
+}
+
+// GetIngredientLotMaltDetailWithResponse request returning *GetIngredientLotMaltDetailHTTPResponse
+func (c *ClientWithResponses) GetIngredientLotMaltDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotMaltDetailHTTPResponse, error) {
+	rsp, err := c.GetIngredientLotMaltDetail(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientLotMaltDetailHTTPResponse(rsp)
+}
+
+// UpdateIngredientLotMaltDetailWithBodyWithResponse request with arbitrary body returning *UpdateIngredientLotMaltDetailHTTPResponse
+func (c *ClientWithResponses) UpdateIngredientLotMaltDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotMaltDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotMaltDetailWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotMaltDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIngredientLotMaltDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotMaltDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotMaltDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotMaltDetail(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotMaltDetailHTTPResponse(rsp)
+}
+
+// ListIngredientLotStockLevelsWithResponse request returning *ListIngredientLotStockLevelsHTTPResponse
+func (c *ClientWithResponses) ListIngredientLotStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIngredientLotStockLevelsHTTPResponse, error) {
+	rsp, err := c.ListIngredientLotStockLevels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientLotStockLevelsHTTPResponse(rsp)
+}
+
+// ListIngredientLotYeastDetailsWithResponse request returning *ListIngredientLotYeastDetailsHTTPResponse
+func (c *ClientWithResponses) ListIngredientLotYeastDetailsWithResponse(ctx context.Context, params *ListIngredientLotYeastDetailsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotYeastDetailsHTTPResponse, error) {
+	rsp, err := c.ListIngredientLotYeastDetails(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientLotYeastDetailsHTTPResponse(rsp)
+}
+
+// CreateIngredientLotYeastDetailWithBodyWithResponse request with arbitrary body returning *CreateIngredientLotYeastDetailHTTPResponse
+func (c *ClientWithResponses) CreateIngredientLotYeastDetailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotYeastDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotYeastDetailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotYeastDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientLotYeastDetailWithResponse(ctx context.Context, body CreateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotYeastDetailHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotYeastDetail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotYeastDetailHTTPResponse(rsp)
+}
+
+// GetIngredientLotYeastDetailWithResponse request returning *GetIngredientLotYeastDetailHTTPResponse
+func (c *ClientWithResponses) GetIngredientLotYeastDetailWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotYeastDetailHTTPResponse, error) {
+	rsp, err := c.GetIngredientLotYeastDetail(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientLotYeastDetailHTTPResponse(rsp)
+}
+
+// UpdateIngredientLotYeastDetailWithBodyWithResponse request with arbitrary body returning *UpdateIngredientLotYeastDetailHTTPResponse
+func (c *ClientWithResponses) UpdateIngredientLotYeastDetailWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientLotYeastDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotYeastDetailWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotYeastDetailHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIngredientLotYeastDetailWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientLotYeastDetailJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientLotYeastDetailHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientLotYeastDetail(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientLotYeastDetailHTTPResponse(rsp)
+}
+
+// ListIngredientLotsWithResponse request returning *ListIngredientLotsHTTPResponse
+func (c *ClientWithResponses) ListIngredientLotsWithResponse(ctx context.Context, params *ListIngredientLotsParams, reqEditors ...RequestEditorFn) (*ListIngredientLotsHTTPResponse, error) {
+	rsp, err := c.ListIngredientLots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientLotsHTTPResponse(rsp)
+}
+
+// CreateIngredientLotWithBodyWithResponse request with arbitrary body returning *CreateIngredientLotHTTPResponse
+func (c *ClientWithResponses) CreateIngredientLotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientLotHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientLotWithResponse(ctx context.Context, body CreateIngredientLotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientLotHTTPResponse, error) {
+	rsp, err := c.CreateIngredientLot(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientLotHTTPResponse(rsp)
+}
+
+// ListBatchIngredientLotsWithResponse request returning *ListBatchIngredientLotsHTTPResponse
+func (c *ClientWithResponses) ListBatchIngredientLotsWithResponse(ctx context.Context, params *ListBatchIngredientLotsParams, reqEditors ...RequestEditorFn) (*ListBatchIngredientLotsHTTPResponse, error) {
+	rsp, err := c.ListBatchIngredientLots(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchIngredientLotsHTTPResponse(rsp)
+}
+
+// ImportIngredientLotsWithBodyWithResponse request with arbitrary body returning *ImportIngredientLotsHTTPResponse
+func (c *ClientWithResponses) ImportIngredientLotsWithBodyWithResponse(ctx context.Context, params *ImportIngredientLotsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIngredientLotsHTTPResponse, error) {
+	rsp, err := c.ImportIngredientLotsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportIngredientLotsHTTPResponse(rsp)
+}
+
+// GetIngredientLotWithResponse request returning *GetIngredientLotHTTPResponse
+func (c *ClientWithResponses) GetIngredientLotWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientLotHTTPResponse, error) {
+	rsp, err := c.GetIngredientLot(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientLotHTTPResponse(rsp)
+}
+
+// GetIngredientLotLabelWithResponse request returning *GetIngredientLotLabelHTTPResponse
+func (c *ClientWithResponses) GetIngredientLotLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetIngredientLotLabelParams, reqEditors ...RequestEditorFn) (*GetIngredientLotLabelHTTPResponse, error) {
+	rsp, err := c.GetIngredientLotLabel(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientLotLabelHTTPResponse(rsp)
+}
+
+// ListIngredientReorderPoliciesWithResponse request returning *ListIngredientReorderPoliciesHTTPResponse
+func (c *ClientWithResponses) ListIngredientReorderPoliciesWithResponse(ctx context.Context, params *ListIngredientReorderPoliciesParams, reqEditors ...RequestEditorFn) (*ListIngredientReorderPoliciesHTTPResponse, error) {
+	rsp, err := c.ListIngredientReorderPolicies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientReorderPoliciesHTTPResponse(rsp)
+}
+
+// CreateIngredientReorderPolicyWithBodyWithResponse request with arbitrary body returning *CreateIngredientReorderPolicyHTTPResponse
+func (c *ClientWithResponses) CreateIngredientReorderPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.CreateIngredientReorderPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientReorderPolicyWithResponse(ctx context.Context, body CreateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.CreateIngredientReorderPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+// DeleteIngredientReorderPolicyWithResponse request returning *DeleteIngredientReorderPolicyHTTPResponse
+func (c *ClientWithResponses) DeleteIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.DeleteIngredientReorderPolicy(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+// GetIngredientReorderPolicyWithResponse request returning *GetIngredientReorderPolicyHTTPResponse
+func (c *ClientWithResponses) GetIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.GetIngredientReorderPolicy(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+// UpdateIngredientReorderPolicyWithBodyWithResponse request with arbitrary body returning *UpdateIngredientReorderPolicyHTTPResponse
+func (c *ClientWithResponses) UpdateIngredientReorderPolicyWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientReorderPolicyWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateIngredientReorderPolicyWithResponse(ctx context.Context, uuid UuidPath, body UpdateIngredientReorderPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateIngredientReorderPolicyHTTPResponse, error) {
+	rsp, err := c.UpdateIngredientReorderPolicy(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIngredientReorderPolicyHTTPResponse(rsp)
+}
+
+// ListIngredientsWithResponse request returning *ListIngredientsHTTPResponse
+func (c *ClientWithResponses) ListIngredientsWithResponse(ctx context.Context, params *ListIngredientsParams, reqEditors ...RequestEditorFn) (*ListIngredientsHTTPResponse, error) {
+	rsp, err := c.ListIngredients(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIngredientsHTTPResponse(rsp)
+}
+
+// CreateIngredientWithBodyWithResponse request with arbitrary body returning *CreateIngredientHTTPResponse
+func (c *ClientWithResponses) CreateIngredientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateIngredientHTTPResponse, error) {
+	rsp, err := c.CreateIngredientWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateIngredientWithResponse(ctx context.Context, body CreateIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateIngredientHTTPResponse, error) {
+	rsp, err := c.CreateIngredient(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateIngredientHTTPResponse(rsp)
+}
+
+// ImportIngredientsWithBodyWithResponse request with arbitrary body returning *ImportIngredientsHTTPResponse
+func (c *ClientWithResponses) ImportIngredientsWithBodyWithResponse(ctx context.Context, params *ImportIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIngredientsHTTPResponse, error) {
+	rsp, err := c.ImportIngredientsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportIngredientsHTTPResponse(rsp)
+}
+
+// GetIngredientWithResponse request returning *GetIngredientHTTPResponse
+func (c *ClientWithResponses) GetIngredientWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetIngredientHTTPResponse, error) {
+	rsp, err := c.GetIngredient(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIngredientHTTPResponse(rsp)
+}
+
+// ListInventoryAdjustmentsWithResponse request returning *ListInventoryAdjustmentsHTTPResponse
+func (c *ClientWithResponses) ListInventoryAdjustmentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryAdjustmentsHTTPResponse, error) {
+	rsp, err := c.ListInventoryAdjustments(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryAdjustmentsHTTPResponse(rsp)
+}
+
+// CreateInventoryAdjustmentWithBodyWithResponse request with arbitrary body returning *CreateInventoryAdjustmentHTTPResponse
+func (c *ClientWithResponses) CreateInventoryAdjustmentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryAdjustmentHTTPResponse, error) {
+	rsp, err := c.CreateInventoryAdjustmentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryAdjustmentHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryAdjustmentWithResponse(ctx context.Context, body CreateInventoryAdjustmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryAdjustmentHTTPResponse, error) {
+	rsp, err := c.CreateInventoryAdjustment(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryAdjustmentHTTPResponse(rsp)
+}
+
+// GetInventoryAdjustmentWithResponse request returning *GetInventoryAdjustmentHTTPResponse
+func (c *ClientWithResponses) GetInventoryAdjustmentWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryAdjustmentHTTPResponse, error) {
+	rsp, err := c.GetInventoryAdjustment(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryAdjustmentHTTPResponse(rsp)
+}
+
+// ListInventoryMovementsWithResponse request returning *ListInventoryMovementsHTTPResponse
+func (c *ClientWithResponses) ListInventoryMovementsWithResponse(ctx context.Context, params *ListInventoryMovementsParams, reqEditors ...RequestEditorFn) (*ListInventoryMovementsHTTPResponse, error) {
+	rsp, err := c.ListInventoryMovements(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryMovementsHTTPResponse(rsp)
+}
+
+// CreateInventoryMovementWithBodyWithResponse request with arbitrary body returning *CreateInventoryMovementHTTPResponse
+func (c *ClientWithResponses) CreateInventoryMovementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryMovementHTTPResponse, error) {
+	rsp, err := c.CreateInventoryMovementWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryMovementHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryMovementWithResponse(ctx context.Context, body CreateInventoryMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryMovementHTTPResponse, error) {
+	rsp, err := c.CreateInventoryMovement(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryMovementHTTPResponse(rsp)
+}
+
+// GetInventoryMovementWithResponse request returning *GetInventoryMovementHTTPResponse
+func (c *ClientWithResponses) GetInventoryMovementWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryMovementHTTPResponse, error) {
+	rsp, err := c.GetInventoryMovement(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryMovementHTTPResponse(rsp)
+}
+
+// ListInventoryReceiptsWithResponse request returning *ListInventoryReceiptsHTTPResponse
+func (c *ClientWithResponses) ListInventoryReceiptsWithResponse(ctx context.Context, params *ListInventoryReceiptsParams, reqEditors ...RequestEditorFn) (*ListInventoryReceiptsHTTPResponse, error) {
+	rsp, err := c.ListInventoryReceipts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryReceiptsHTTPResponse(rsp)
+}
+
+// CreateInventoryReceiptWithBodyWithResponse request with arbitrary body returning *CreateInventoryReceiptHTTPResponse
+func (c *ClientWithResponses) CreateInventoryReceiptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryReceiptHTTPResponse, error) {
+	rsp, err := c.CreateInventoryReceiptWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryReceiptHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryReceiptWithResponse(ctx context.Context, body CreateInventoryReceiptJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryReceiptHTTPResponse, error) {
+	rsp, err := c.CreateInventoryReceipt(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryReceiptHTTPResponse(rsp)
+}
+
+// GetInventoryReceiptWithResponse request returning *GetInventoryReceiptHTTPResponse
+func (c *ClientWithResponses) GetInventoryReceiptWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryReceiptHTTPResponse, error) {
+	rsp, err := c.GetInventoryReceipt(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryReceiptHTTPResponse(rsp)
+}
+
+// ListInventoryReservationsWithResponse request returning *ListInventoryReservationsHTTPResponse
+func (c *ClientWithResponses) ListInventoryReservationsWithResponse(ctx context.Context, params *ListInventoryReservationsParams, reqEditors ...RequestEditorFn) (*ListInventoryReservationsHTTPResponse, error) {
+	rsp, err := c.ListInventoryReservations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryReservationsHTTPResponse(rsp)
+}
+
+// CreateInventoryReservationWithBodyWithResponse request with arbitrary body returning *CreateInventoryReservationHTTPResponse
+func (c *ClientWithResponses) CreateInventoryReservationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryReservationHTTPResponse, error) {
+	rsp, err := c.CreateInventoryReservationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryReservationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryReservationWithResponse(ctx context.Context, body CreateInventoryReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryReservationHTTPResponse, error) {
+	rsp, err := c.CreateInventoryReservation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryReservationHTTPResponse(rsp)
+}
+
+// ReleaseInventoryReservationsWithBodyWithResponse request with arbitrary body returning *ReleaseInventoryReservationsHTTPResponse
+func (c *ClientWithResponses) ReleaseInventoryReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReleaseInventoryReservationsHTTPResponse, error) {
+	rsp, err := c.ReleaseInventoryReservationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseInventoryReservationsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReleaseInventoryReservationsWithResponse(ctx context.Context, body ReleaseInventoryReservationsJSONRequestBody, reqEditors ...RequestEditorFn) (*ReleaseInventoryReservationsHTTPResponse, error) {
+	rsp, err := c.ReleaseInventoryReservations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseInventoryReservationsHTTPResponse(rsp)
+}
+
+// DeleteInventoryReservationWithResponse request returning *DeleteInventoryReservationHTTPResponse
+func (c *ClientWithResponses) DeleteInventoryReservationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteInventoryReservationHTTPResponse, error) {
+	rsp, err := c.DeleteInventoryReservation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteInventoryReservationHTTPResponse(rsp)
+}
+
+// GetInventoryReservationWithResponse request returning *GetInventoryReservationHTTPResponse
+func (c *ClientWithResponses) GetInventoryReservationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryReservationHTTPResponse, error) {
+	rsp, err := c.GetInventoryReservation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryReservationHTTPResponse(rsp)
+}
+
+// ScanInventoryWithResponse request returning *ScanInventoryHTTPResponse
+func (c *ClientWithResponses) ScanInventoryWithResponse(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*ScanInventoryHTTPResponse, error) {
+	rsp, err := c.ScanInventory(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScanInventoryHTTPResponse(rsp)
+}
+
+// ListInventoryTransfersWithResponse request returning *ListInventoryTransfersHTTPResponse
+func (c *ClientWithResponses) ListInventoryTransfersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryTransfersHTTPResponse, error) {
+	rsp, err := c.ListInventoryTransfers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryTransfersHTTPResponse(rsp)
+}
+
+// CreateInventoryTransferWithBodyWithResponse request with arbitrary body returning *CreateInventoryTransferHTTPResponse
+func (c *ClientWithResponses) CreateInventoryTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryTransferHTTPResponse, error) {
+	rsp, err := c.CreateInventoryTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryTransferHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryTransferWithResponse(ctx context.Context, body CreateInventoryTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryTransferHTTPResponse, error) {
+	rsp, err := c.CreateInventoryTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryTransferHTTPResponse(rsp)
+}
+
+// GetInventoryTransferWithResponse request returning *GetInventoryTransferHTTPResponse
+func (c *ClientWithResponses) GetInventoryTransferWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryTransferHTTPResponse, error) {
+	rsp, err := c.GetInventoryTransfer(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryTransferHTTPResponse(rsp)
+}
+
+// ListInventoryUsageWithResponse request returning *ListInventoryUsageHTTPResponse
+func (c *ClientWithResponses) ListInventoryUsageWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListInventoryUsageHTTPResponse, error) {
+	rsp, err := c.ListInventoryUsage(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListInventoryUsageHTTPResponse(rsp)
+}
+
+// CreateInventoryUsageWithBodyWithResponse request with arbitrary body returning *CreateInventoryUsageHTTPResponse
+func (c *ClientWithResponses) CreateInventoryUsageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateInventoryUsageHTTPResponse, error) {
+	rsp, err := c.CreateInventoryUsageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryUsageHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateInventoryUsageWithResponse(ctx context.Context, body CreateInventoryUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateInventoryUsageHTTPResponse, error) {
+	rsp, err := c.CreateInventoryUsage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateInventoryUsageHTTPResponse(rsp)
+}
+
+// ListBatchUsageMovementsWithResponse request returning *ListBatchUsageMovementsHTTPResponse
+func (c *ClientWithResponses) ListBatchUsageMovementsWithResponse(ctx context.Context, params *ListBatchUsageMovementsParams, reqEditors ...RequestEditorFn) (*ListBatchUsageMovementsHTTPResponse, error) {
+	rsp, err := c.ListBatchUsageMovements(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchUsageMovementsHTTPResponse(rsp)
+}
+
+// CreateBatchUsageWithBodyWithResponse request with arbitrary body returning *CreateBatchUsageHTTPResponse
+func (c *ClientWithResponses) CreateBatchUsageWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchUsageHTTPResponse, error) {
+	rsp, err := c.CreateBatchUsageWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchUsageHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchUsageWithResponse(ctx context.Context, body CreateBatchUsageJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchUsageHTTPResponse, error) {
+	rsp, err := c.CreateBatchUsage(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchUsageHTTPResponse(rsp)
+}
+
+// GetInventoryUsageWithResponse request returning *GetInventoryUsageHTTPResponse
+func (c *ClientWithResponses) GetInventoryUsageWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetInventoryUsageHTTPResponse, error) {
+	rsp, err := c.GetInventoryUsage(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryUsageHTTPResponse(rsp)
+}
+
+// GetInventoryValuationWithResponse request returning *GetInventoryValuationHTTPResponse
+func (c *ClientWithResponses) GetInventoryValuationWithResponse(ctx context.Context, params *GetInventoryValuationParams, reqEditors ...RequestEditorFn) (*GetInventoryValuationHTTPResponse, error) {
+	rsp, err := c.GetInventoryValuation(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryValuationHTTPResponse(rsp)
+}
+
+// GetInventoryConsumptionWithResponse request returning *GetInventoryConsumptionHTTPResponse
+func (c *ClientWithResponses) GetInventoryConsumptionWithResponse(ctx context.Context, params *GetInventoryConsumptionParams, reqEditors ...RequestEditorFn) (*GetInventoryConsumptionHTTPResponse, error) {
+	rsp, err := c.GetInventoryConsumption(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryConsumptionHTTPResponse(rsp)
+}
+
+// GetInventoryValuationSettingsWithResponse request returning *GetInventoryValuationSettingsHTTPResponse
+func (c *ClientWithResponses) GetInventoryValuationSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInventoryValuationSettingsHTTPResponse, error) {
+	rsp, err := c.GetInventoryValuationSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryValuationSettingsHTTPResponse(rsp)
+}
+
+// UpdateInventoryValuationSettingsWithBodyWithResponse request with arbitrary body returning *UpdateInventoryValuationSettingsHTTPResponse
+func (c *ClientWithResponses) UpdateInventoryValuationSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInventoryValuationSettingsHTTPResponse, error) {
+	rsp, err := c.UpdateInventoryValuationSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInventoryValuationSettingsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateInventoryValuationSettingsWithResponse(ctx context.Context, body UpdateInventoryValuationSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInventoryValuationSettingsHTTPResponse, error) {
+	rsp, err := c.UpdateInventoryValuationSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInventoryValuationSettingsHTTPResponse(rsp)
+}
+
+// GetOverdueKegReportWithResponse request returning *GetOverdueKegReportHTTPResponse
+func (c *ClientWithResponses) GetOverdueKegReportWithResponse(ctx context.Context, params *GetOverdueKegReportParams, reqEditors ...RequestEditorFn) (*GetOverdueKegReportHTTPResponse, error) {
+	rsp, err := c.GetOverdueKegReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOverdueKegReportHTTPResponse(rsp)
+}
+
+// GetKegTurnTimeReportWithResponse request returning *GetKegTurnTimeReportHTTPResponse
+func (c *ClientWithResponses) GetKegTurnTimeReportWithResponse(ctx context.Context, params *GetKegTurnTimeReportParams, reqEditors ...RequestEditorFn) (*GetKegTurnTimeReportHTTPResponse, error) {
+	rsp, err := c.GetKegTurnTimeReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKegTurnTimeReportHTTPResponse(rsp)
+}
+
+// GetKegWriteOffReportWithResponse request returning *GetKegWriteOffReportHTTPResponse
+func (c *ClientWithResponses) GetKegWriteOffReportWithResponse(ctx context.Context, params *GetKegWriteOffReportParams, reqEditors ...RequestEditorFn) (*GetKegWriteOffReportHTTPResponse, error) {
+	rsp, err := c.GetKegWriteOffReport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKegWriteOffReportHTTPResponse(rsp)
+}
+
+// ListKegsWithResponse request returning *ListKegsHTTPResponse
+func (c *ClientWithResponses) ListKegsWithResponse(ctx context.Context, params *ListKegsParams, reqEditors ...RequestEditorFn) (*ListKegsHTTPResponse, error) {
+	rsp, err := c.ListKegs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListKegsHTTPResponse(rsp)
+}
+
+// CreateKegWithBodyWithResponse request with arbitrary body returning *CreateKegHTTPResponse
+func (c *ClientWithResponses) CreateKegWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKegHTTPResponse, error) {
+	rsp, err := c.CreateKegWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateKegHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateKegWithResponse(ctx context.Context, body CreateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKegHTTPResponse, error) {
+	rsp, err := c.CreateKeg(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateKegHTTPResponse(rsp)
+}
+
+// DeleteKegWithResponse request returning *DeleteKegHTTPResponse
+func (c *ClientWithResponses) DeleteKegWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteKegHTTPResponse, error) {
+	rsp, err := c.DeleteKeg(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteKegHTTPResponse(rsp)
+}
+
+// GetKegWithResponse request returning *GetKegHTTPResponse
+func (c *ClientWithResponses) GetKegWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetKegHTTPResponse, error) {
+	rsp, err := c.GetKeg(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetKegHTTPResponse(rsp)
+}
+
+// UpdateKegWithBodyWithResponse request with arbitrary body returning *UpdateKegHTTPResponse
+func (c *ClientWithResponses) UpdateKegWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateKegHTTPResponse, error) {
+	rsp, err := c.UpdateKegWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateKegHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateKegWithResponse(ctx context.Context, uuid UuidPath, body UpdateKegJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateKegHTTPResponse, error) {
+	rsp, err := c.UpdateKeg(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateKegHTTPResponse(rsp)
+}
+
+// ListKegEventsWithResponse request returning *ListKegEventsHTTPResponse
+func (c *ClientWithResponses) ListKegEventsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListKegEventsHTTPResponse, error) {
+	rsp, err := c.ListKegEvents(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListKegEventsHTTPResponse(rsp)
+}
+
+// CreateKegEventWithBodyWithResponse request with arbitrary body returning *CreateKegEventHTTPResponse
+func (c *ClientWithResponses) CreateKegEventWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateKegEventHTTPResponse, error) {
+	rsp, err := c.CreateKegEventWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateKegEventHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateKegEventWithResponse(ctx context.Context, uuid UuidPath, body CreateKegEventJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateKegEventHTTPResponse, error) {
+	rsp, err := c.CreateKegEvent(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateKegEventHTTPResponse(rsp)
+}
+
+// ListLabelTemplatesWithResponse request returning *ListLabelTemplatesHTTPResponse
+func (c *ClientWithResponses) ListLabelTemplatesWithResponse(ctx context.Context, params *ListLabelTemplatesParams, reqEditors ...RequestEditorFn) (*ListLabelTemplatesHTTPResponse, error) {
+	rsp, err := c.ListLabelTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLabelTemplatesHTTPResponse(rsp)
+}
+
+// CreateLabelTemplateWithBodyWithResponse request with arbitrary body returning *CreateLabelTemplateHTTPResponse
+func (c *ClientWithResponses) CreateLabelTemplateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateLabelTemplateHTTPResponse, error) {
+	rsp, err := c.CreateLabelTemplateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLabelTemplateHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateLabelTemplateWithResponse(ctx context.Context, body CreateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateLabelTemplateHTTPResponse, error) {
+	rsp, err := c.CreateLabelTemplate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateLabelTemplateHTTPResponse(rsp)
+}
+
+// DeleteLabelTemplateWithResponse request returning *DeleteLabelTemplateHTTPResponse
+func (c *ClientWithResponses) DeleteLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteLabelTemplateHTTPResponse, error) {
+	rsp, err := c.DeleteLabelTemplate(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteLabelTemplateHTTPResponse(rsp)
+}
+
+// GetLabelTemplateWithResponse request returning *GetLabelTemplateHTTPResponse
+func (c *ClientWithResponses) GetLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetLabelTemplateHTTPResponse, error) {
+	rsp, err := c.GetLabelTemplate(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLabelTemplateHTTPResponse(rsp)
+}
+
+// UpdateLabelTemplateWithBodyWithResponse request with arbitrary body returning *UpdateLabelTemplateHTTPResponse
+func (c *ClientWithResponses) UpdateLabelTemplateWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateLabelTemplateHTTPResponse, error) {
+	rsp, err := c.UpdateLabelTemplateWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLabelTemplateHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateLabelTemplateWithResponse(ctx context.Context, uuid UuidPath, body UpdateLabelTemplateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLabelTemplateHTTPResponse, error) {
+	rsp, err := c.UpdateLabelTemplate(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateLabelTemplateHTTPResponse(rsp)
+}
+
+// ApplyInventoryMigrationWithBodyWithResponse request with arbitrary body returning *ApplyInventoryMigrationHTTPResponse
+func (c *ClientWithResponses) ApplyInventoryMigrationWithBodyWithResponse(ctx context.Context, params *ApplyInventoryMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyInventoryMigrationHTTPResponse, error) {
+	rsp, err := c.ApplyInventoryMigrationWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyInventoryMigrationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApplyInventoryMigrationWithResponse(ctx context.Context, params *ApplyInventoryMigrationParams, body ApplyInventoryMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyInventoryMigrationHTTPResponse, error) {
+	rsp, err := c.ApplyInventoryMigration(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyInventoryMigrationHTTPResponse(rsp)
+}
+
+// GetRemovalSummaryWithResponse request returning *GetRemovalSummaryHTTPResponse
+func (c *ClientWithResponses) GetRemovalSummaryWithResponse(ctx context.Context, params *GetRemovalSummaryParams, reqEditors ...RequestEditorFn) (*GetRemovalSummaryHTTPResponse, error) {
+	rsp, err := c.GetRemovalSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRemovalSummaryHTTPResponse(rsp)
+}
+
+// ListRemovalsWithResponse request returning *ListRemovalsHTTPResponse
+func (c *ClientWithResponses) ListRemovalsWithResponse(ctx context.Context, params *ListRemovalsParams, reqEditors ...RequestEditorFn) (*ListRemovalsHTTPResponse, error) {
+	rsp, err := c.ListRemovals(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRemovalsHTTPResponse(rsp)
+}
+
+// CreateRemovalWithBodyWithResponse request with arbitrary body returning *CreateRemovalHTTPResponse
+func (c *ClientWithResponses) CreateRemovalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRemovalHTTPResponse, error) {
+	rsp, err := c.CreateRemovalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRemovalHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRemovalWithResponse(ctx context.Context, body CreateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRemovalHTTPResponse, error) {
+	rsp, err := c.CreateRemoval(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRemovalHTTPResponse(rsp)
+}
+
+// DeleteRemovalWithResponse request returning *DeleteRemovalHTTPResponse
+func (c *ClientWithResponses) DeleteRemovalWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteRemovalHTTPResponse, error) {
+	rsp, err := c.DeleteRemoval(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRemovalHTTPResponse(rsp)
+}
+
+// GetRemovalWithResponse request returning *GetRemovalHTTPResponse
+func (c *ClientWithResponses) GetRemovalWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetRemovalHTTPResponse, error) {
+	rsp, err := c.GetRemoval(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRemovalHTTPResponse(rsp)
+}
+
+// UpdateRemovalWithBodyWithResponse request with arbitrary body returning *UpdateRemovalHTTPResponse
+func (c *ClientWithResponses) UpdateRemovalWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRemovalHTTPResponse, error) {
+	rsp, err := c.UpdateRemovalWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRemovalHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRemovalWithResponse(ctx context.Context, uuid UuidPath, body UpdateRemovalJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRemovalHTTPResponse, error) {
+	rsp, err := c.UpdateRemoval(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRemovalHTTPResponse(rsp)
+}
+
+// ListReplenishmentSuggestionsWithResponse request returning *ListReplenishmentSuggestionsHTTPResponse
+func (c *ClientWithResponses) ListReplenishmentSuggestionsWithResponse(ctx context.Context, params *ListReplenishmentSuggestionsParams, reqEditors ...RequestEditorFn) (*ListReplenishmentSuggestionsHTTPResponse, error) {
+	rsp, err := c.ListReplenishmentSuggestions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListReplenishmentSuggestionsHTTPResponse(rsp)
+}
+
+// CreateReplenishmentPurchaseOrdersWithBodyWithResponse request with arbitrary body returning *CreateReplenishmentPurchaseOrdersHTTPResponse
+func (c *ClientWithResponses) CreateReplenishmentPurchaseOrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReplenishmentPurchaseOrdersHTTPResponse, error) {
+	rsp, err := c.CreateReplenishmentPurchaseOrdersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateReplenishmentPurchaseOrdersHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateReplenishmentPurchaseOrdersWithResponse(ctx context.Context, body CreateReplenishmentPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReplenishmentPurchaseOrdersHTTPResponse, error) {
+	rsp, err := c.CreateReplenishmentPurchaseOrders(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateReplenishmentPurchaseOrdersHTTPResponse(rsp)
+}
+
+// ListStockLevelsWithResponse request returning *ListStockLevelsHTTPResponse
+func (c *ClientWithResponses) ListStockLevelsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStockLevelsHTTPResponse, error) {
+	rsp, err := c.ListStockLevels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStockLevelsHTTPResponse(rsp)
+}
+
+// ListStockLocationsWithResponse request returning *ListStockLocationsHTTPResponse
+func (c *ClientWithResponses) ListStockLocationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStockLocationsHTTPResponse, error) {
+	rsp, err := c.ListStockLocations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStockLocationsHTTPResponse(rsp)
+}
+
+// CreateStockLocationWithBodyWithResponse request with arbitrary body returning *CreateStockLocationHTTPResponse
+func (c *ClientWithResponses) CreateStockLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStockLocationHTTPResponse, error) {
+	rsp, err := c.CreateStockLocationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockLocationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStockLocationWithResponse(ctx context.Context, body CreateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStockLocationHTTPResponse, error) {
+	rsp, err := c.CreateStockLocation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStockLocationHTTPResponse(rsp)
+}
+
+// DeleteStockLocationWithResponse request returning *DeleteStockLocationHTTPResponse
+func (c *ClientWithResponses) DeleteStockLocationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteStockLocationHTTPResponse, error) {
+	rsp, err := c.DeleteStockLocation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteStockLocationHTTPResponse(rsp)
+}
+
+// GetStockLocationWithResponse request returning *GetStockLocationHTTPResponse
+func (c *ClientWithResponses) GetStockLocationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetStockLocationHTTPResponse, error) {
+	rsp, err := c.GetStockLocation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationHTTPResponse(rsp)
+}
+
+// UpdateStockLocationWithBodyWithResponse request with arbitrary body returning *UpdateStockLocationHTTPResponse
+func (c *ClientWithResponses) UpdateStockLocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateStockLocationHTTPResponse, error) {
+	rsp, err := c.UpdateStockLocationWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStockLocationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateStockLocationWithResponse(ctx context.Context, uuid UuidPath, body UpdateStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateStockLocationHTTPResponse, error) {
+	rsp, err := c.UpdateStockLocation(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateStockLocationHTTPResponse(rsp)
+}
+
+// ListSupplierReturnsWithResponse request returning *ListSupplierReturnsHTTPResponse
+func (c *ClientWithResponses) ListSupplierReturnsWithResponse(ctx context.Context, params *ListSupplierReturnsParams, reqEditors ...RequestEditorFn) (*ListSupplierReturnsHTTPResponse, error) {
+	rsp, err := c.ListSupplierReturns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSupplierReturnsHTTPResponse(rsp)
+}
+
+// CreateSupplierReturnWithBodyWithResponse request with arbitrary body returning *CreateSupplierReturnHTTPResponse
+func (c *ClientWithResponses) CreateSupplierReturnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierReturnHTTPResponse, error) {
+	rsp, err := c.CreateSupplierReturnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierReturnHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSupplierReturnWithResponse(ctx context.Context, body CreateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierReturnHTTPResponse, error) {
+	rsp, err := c.CreateSupplierReturn(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierReturnHTTPResponse(rsp)
+}
+
+// GetSupplierReturnWithResponse request returning *GetSupplierReturnHTTPResponse
+func (c *ClientWithResponses) GetSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierReturnHTTPResponse, error) {
+	rsp, err := c.GetSupplierReturn(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplierReturnHTTPResponse(rsp)
+}
+
+// UpdateSupplierReturnWithBodyWithResponse request with arbitrary body returning *UpdateSupplierReturnHTTPResponse
+func (c *ClientWithResponses) UpdateSupplierReturnWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierReturnHTTPResponse, error) {
+	rsp, err := c.UpdateSupplierReturnWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierReturnHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierReturnHTTPResponse, error) {
+	rsp, err := c.UpdateSupplierReturn(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierReturnHTTPResponse(rsp)
+}
+
+// SyncSupplierReturnWithResponse request returning *SyncSupplierReturnHTTPResponse
+func (c *ClientWithResponses) SyncSupplierReturnWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*SyncSupplierReturnHTTPResponse, error) {
+	rsp, err := c.SyncSupplierReturn(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncSupplierReturnHTTPResponse(rsp)
+}
+
+// ParseGetInventoryJournalHTTPResponse parses an HTTP response from a GetInventoryJournalWithResponse call
+func ParseGetInventoryJournalHTTPResponse(rsp *http.Response) (*GetInventoryJournalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryJournalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Journal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryJournalExportsHTTPResponse parses an HTTP response from a ListInventoryJournalExportsWithResponse call
+func ParseListInventoryJournalExportsHTTPResponse(rsp *http.Response) (*ListInventoryJournalExportsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryJournalExportsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []JournalExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportInventoryJournalHTTPResponse parses an HTTP response from a ExportInventoryJournalWithResponse call
+func ParseExportInventoryJournalHTTPResponse(rsp *http.Response) (*ExportInventoryJournalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportInventoryJournalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest JournalExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryJournalExportFileHTTPResponse parses an HTTP response from a GetInventoryJournalExportFileWithResponse call
+func ParseGetInventoryJournalExportFileHTTPResponse(rsp *http.Response) (*GetInventoryJournalExportFileHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryJournalExportFileHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListBeerLotItemsHTTPResponse parses an HTTP response from a ListBeerLotItemsWithResponse call
+func ParseListBeerLotItemsHTTPResponse(rsp *http.Response) (*ListBeerLotItemsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBeerLotItemsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBeerLotItemHTTPResponse parses an HTTP response from a CreateBeerLotItemWithResponse call
+func ParseCreateBeerLotItemHTTPResponse(rsp *http.Response) (*CreateBeerLotItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBeerLotItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseScanBeerLotItemHTTPResponse parses an HTTP response from a ScanBeerLotItemWithResponse call
+func ParseScanBeerLotItemHTTPResponse(rsp *http.Response) (*ScanBeerLotItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ScanBeerLotItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBeerLotItemHTTPResponse parses an HTTP response from a DeleteBeerLotItemWithResponse call
+func ParseDeleteBeerLotItemHTTPResponse(rsp *http.Response) (*DeleteBeerLotItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBeerLotItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetBeerLotItemHTTPResponse parses an HTTP response from a GetBeerLotItemWithResponse call
+func ParseGetBeerLotItemHTTPResponse(rsp *http.Response) (*GetBeerLotItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeerLotItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBeerLotItemHTTPResponse parses an HTTP response from a UpdateBeerLotItemWithResponse call
+func ParseUpdateBeerLotItemHTTPResponse(rsp *http.Response) (*UpdateBeerLotItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBeerLotItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBeerLotItemHistoryHTTPResponse parses an HTTP response from a ListBeerLotItemHistoryWithResponse call
+func ParseListBeerLotItemHistoryHTTPResponse(rsp *http.Response) (*ListBeerLotItemHistoryHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBeerLotItemHistoryHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BeerLotItemEventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBeerLotItemStatusHTTPResponse parses an HTTP response from a UpdateBeerLotItemStatusWithResponse call
+func ParseUpdateBeerLotItemStatusHTTPResponse(rsp *http.Response) (*UpdateBeerLotItemStatusHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBeerLotItemStatusHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeerLotItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBeerLotStockLevelsHTTPResponse parses an HTTP response from a ListBeerLotStockLevelsWithResponse call
+func ParseListBeerLotStockLevelsHTTPResponse(rsp *http.Response) (*ListBeerLotStockLevelsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBeerLotStockLevelsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BeerLotStockLevelResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBeerLotsHTTPResponse parses an HTTP response from a ListBeerLotsWithResponse call
+func ParseListBeerLotsHTTPResponse(rsp *http.Response) (*ListBeerLotsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBeerLotsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BeerLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBeerLotHTTPResponse parses an HTTP response from a CreateBeerLotWithResponse call
+func ParseCreateBeerLotHTTPResponse(rsp *http.Response) (*CreateBeerLotHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBeerLotHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BeerLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBeerLotHTTPResponse parses an HTTP response from a GetBeerLotWithResponse call
+func ParseGetBeerLotHTTPResponse(rsp *http.Response) (*GetBeerLotHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeerLotHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BeerLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBeerLotLabelHTTPResponse parses an HTTP response from a GetBeerLotLabelWithResponse call
+func ParseGetBeerLotLabelHTTPResponse(rsp *http.Response) (*GetBeerLotLabelHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBeerLotLabelHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCycleCountAccuracyHTTPResponse parses an HTTP response from a GetCycleCountAccuracyWithResponse call
+func ParseGetCycleCountAccuracyHTTPResponse(rsp *http.Response) (*GetCycleCountAccuracyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCycleCountAccuracyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CycleCountAccuracyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCycleCountsHTTPResponse parses an HTTP response from a ListCycleCountsWithResponse call
+func ParseListCycleCountsHTTPResponse(rsp *http.Response) (*ListCycleCountsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCycleCountsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCycleCountHTTPResponse parses an HTTP response from a CreateCycleCountWithResponse call
+func ParseCreateCycleCountHTTPResponse(rsp *http.Response) (*CreateCycleCountHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCycleCountHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCycleCountHTTPResponse parses an HTTP response from a GetCycleCountWithResponse call
+func ParseGetCycleCountHTTPResponse(rsp *http.Response) (*GetCycleCountHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCycleCountHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelCycleCountHTTPResponse parses an HTTP response from a CancelCycleCountWithResponse call
+func ParseCancelCycleCountHTTPResponse(rsp *http.Response) (*CancelCycleCountHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelCycleCountHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRecordCycleCountsHTTPResponse parses an HTTP response from a RecordCycleCountsWithResponse call
+func ParseRecordCycleCountsHTTPResponse(rsp *http.Response) (*RecordCycleCountsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecordCycleCountsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostCycleCountHTTPResponse parses an HTTP response from a PostCycleCountWithResponse call
+func ParsePostCycleCountHTTPResponse(rsp *http.Response) (*PostCycleCountHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostCycleCountHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CycleCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientLotHopDetailsHTTPResponse parses an HTTP response from a ListIngredientLotHopDetailsWithResponse call
+func ParseListIngredientLotHopDetailsHTTPResponse(rsp *http.Response) (*ListIngredientLotHopDetailsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientLotHopDetailsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotHopDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientLotHopDetailHTTPResponse parses an HTTP response from a CreateIngredientLotHopDetailWithResponse call
+func ParseCreateIngredientLotHopDetailHTTPResponse(rsp *http.Response) (*CreateIngredientLotHopDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientLotHopDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IngredientLotHopDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientLotHopDetailHTTPResponse parses an HTTP response from a GetIngredientLotHopDetailWithResponse call
+func ParseGetIngredientLotHopDetailHTTPResponse(rsp *http.Response) (*GetIngredientLotHopDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientLotHopDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotHopDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateIngredientLotHopDetailHTTPResponse parses an HTTP response from a UpdateIngredientLotHopDetailWithResponse call
+func ParseUpdateIngredientLotHopDetailHTTPResponse(rsp *http.Response) (*UpdateIngredientLotHopDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateIngredientLotHopDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotHopDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientLotMaltDetailsHTTPResponse parses an HTTP response from a ListIngredientLotMaltDetailsWithResponse call
+func ParseListIngredientLotMaltDetailsHTTPResponse(rsp *http.Response) (*ListIngredientLotMaltDetailsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientLotMaltDetailsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotMaltDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientLotMaltDetailHTTPResponse parses an HTTP response from a CreateIngredientLotMaltDetailWithResponse call
+func ParseCreateIngredientLotMaltDetailHTTPResponse(rsp *http.Response) (*CreateIngredientLotMaltDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientLotMaltDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IngredientLotMaltDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientLotMaltDetailHTTPResponse parses an HTTP response from a GetIngredientLotMaltDetailWithResponse call
+func ParseGetIngredientLotMaltDetailHTTPResponse(rsp *http.Response) (*GetIngredientLotMaltDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientLotMaltDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotMaltDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateIngredientLotMaltDetailHTTPResponse parses an HTTP response from a UpdateIngredientLotMaltDetailWithResponse call
+func ParseUpdateIngredientLotMaltDetailHTTPResponse(rsp *http.Response) (*UpdateIngredientLotMaltDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateIngredientLotMaltDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotMaltDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientLotStockLevelsHTTPResponse parses an HTTP response from a ListIngredientLotStockLevelsWithResponse call
+func ParseListIngredientLotStockLevelsHTTPResponse(rsp *http.Response) (*ListIngredientLotStockLevelsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientLotStockLevelsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IngredientLotStockLevelResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientLotYeastDetailsHTTPResponse parses an HTTP response from a ListIngredientLotYeastDetailsWithResponse call
+func ParseListIngredientLotYeastDetailsHTTPResponse(rsp *http.Response) (*ListIngredientLotYeastDetailsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientLotYeastDetailsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotYeastDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientLotYeastDetailHTTPResponse parses an HTTP response from a CreateIngredientLotYeastDetailWithResponse call
+func ParseCreateIngredientLotYeastDetailHTTPResponse(rsp *http.Response) (*CreateIngredientLotYeastDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientLotYeastDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IngredientLotYeastDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientLotYeastDetailHTTPResponse parses an HTTP response from a GetIngredientLotYeastDetailWithResponse call
+func ParseGetIngredientLotYeastDetailHTTPResponse(rsp *http.Response) (*GetIngredientLotYeastDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientLotYeastDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotYeastDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateIngredientLotYeastDetailHTTPResponse parses an HTTP response from a UpdateIngredientLotYeastDetailWithResponse call
+func ParseUpdateIngredientLotYeastDetailHTTPResponse(rsp *http.Response) (*UpdateIngredientLotYeastDetailHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateIngredientLotYeastDetailHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotYeastDetailResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientLotsHTTPResponse parses an HTTP response from a ListIngredientLotsWithResponse call
+func ParseListIngredientLotsHTTPResponse(rsp *http.Response) (*ListIngredientLotsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientLotsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IngredientLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientLotHTTPResponse parses an HTTP response from a CreateIngredientLotWithResponse call
+func ParseCreateIngredientLotHTTPResponse(rsp *http.Response) (*CreateIngredientLotHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientLotHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IngredientLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchIngredientLotsHTTPResponse parses an HTTP response from a ListBatchIngredientLotsWithResponse call
+func ParseListBatchIngredientLotsHTTPResponse(rsp *http.Response) (*ListBatchIngredientLotsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchIngredientLotsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchIngredientLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportIngredientLotsHTTPResponse parses an HTTP response from a ImportIngredientLotsWithResponse call
+func ParseImportIngredientLotsHTTPResponse(rsp *http.Response) (*ImportIngredientLotsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportIngredientLotsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientLotHTTPResponse parses an HTTP response from a GetIngredientLotWithResponse call
+func ParseGetIngredientLotHTTPResponse(rsp *http.Response) (*GetIngredientLotHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientLotHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientLotResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientLotLabelHTTPResponse parses an HTTP response from a GetIngredientLotLabelWithResponse call
+func ParseGetIngredientLotLabelHTTPResponse(rsp *http.Response) (*GetIngredientLotLabelHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientLotLabelHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientReorderPoliciesHTTPResponse parses an HTTP response from a ListIngredientReorderPoliciesWithResponse call
+func ParseListIngredientReorderPoliciesHTTPResponse(rsp *http.Response) (*ListIngredientReorderPoliciesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientReorderPoliciesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ReorderPolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientReorderPolicyHTTPResponse parses an HTTP response from a CreateIngredientReorderPolicyWithResponse call
+func ParseCreateIngredientReorderPolicyHTTPResponse(rsp *http.Response) (*CreateIngredientReorderPolicyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientReorderPolicyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReorderPolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteIngredientReorderPolicyHTTPResponse parses an HTTP response from a DeleteIngredientReorderPolicyWithResponse call
+func ParseDeleteIngredientReorderPolicyHTTPResponse(rsp *http.Response) (*DeleteIngredientReorderPolicyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteIngredientReorderPolicyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientReorderPolicyHTTPResponse parses an HTTP response from a GetIngredientReorderPolicyWithResponse call
+func ParseGetIngredientReorderPolicyHTTPResponse(rsp *http.Response) (*GetIngredientReorderPolicyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientReorderPolicyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReorderPolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateIngredientReorderPolicyHTTPResponse parses an HTTP response from a UpdateIngredientReorderPolicyWithResponse call
+func ParseUpdateIngredientReorderPolicyHTTPResponse(rsp *http.Response) (*UpdateIngredientReorderPolicyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateIngredientReorderPolicyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReorderPolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIngredientsHTTPResponse parses an HTTP response from a ListIngredientsWithResponse call
+func ParseListIngredientsHTTPResponse(rsp *http.Response) (*ListIngredientsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIngredientsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateIngredientHTTPResponse parses an HTTP response from a CreateIngredientWithResponse call
+func ParseCreateIngredientHTTPResponse(rsp *http.Response) (*CreateIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportIngredientsHTTPResponse parses an HTTP response from a ImportIngredientsWithResponse call
+func ParseImportIngredientsHTTPResponse(rsp *http.Response) (*ImportIngredientsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportIngredientsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetIngredientHTTPResponse parses an HTTP response from a GetIngredientWithResponse call
+func ParseGetIngredientHTTPResponse(rsp *http.Response) (*GetIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryAdjustmentsHTTPResponse parses an HTTP response from a ListInventoryAdjustmentsWithResponse call
+func ParseListInventoryAdjustmentsHTTPResponse(rsp *http.Response) (*ListInventoryAdjustmentsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryAdjustmentsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryAdjustmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryAdjustmentHTTPResponse parses an HTTP response from a CreateInventoryAdjustmentWithResponse call
+func ParseCreateInventoryAdjustmentHTTPResponse(rsp *http.Response) (*CreateInventoryAdjustmentHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryAdjustmentHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryAdjustmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryAdjustmentHTTPResponse parses an HTTP response from a GetInventoryAdjustmentWithResponse call
+func ParseGetInventoryAdjustmentHTTPResponse(rsp *http.Response) (*GetInventoryAdjustmentHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryAdjustmentHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryAdjustmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryMovementsHTTPResponse parses an HTTP response from a ListInventoryMovementsWithResponse call
+func ParseListInventoryMovementsHTTPResponse(rsp *http.Response) (*ListInventoryMovementsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryMovementsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryMovementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryMovementHTTPResponse parses an HTTP response from a CreateInventoryMovementWithResponse call
+func ParseCreateInventoryMovementHTTPResponse(rsp *http.Response) (*CreateInventoryMovementHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryMovementHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryMovementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryMovementHTTPResponse parses an HTTP response from a GetInventoryMovementWithResponse call
+func ParseGetInventoryMovementHTTPResponse(rsp *http.Response) (*GetInventoryMovementHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryMovementHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryMovementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryReceiptsHTTPResponse parses an HTTP response from a ListInventoryReceiptsWithResponse call
+func ParseListInventoryReceiptsHTTPResponse(rsp *http.Response) (*ListInventoryReceiptsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryReceiptsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryReceiptResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryReceiptHTTPResponse parses an HTTP response from a CreateInventoryReceiptWithResponse call
+func ParseCreateInventoryReceiptHTTPResponse(rsp *http.Response) (*CreateInventoryReceiptHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryReceiptHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryReceiptResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryReceiptHTTPResponse parses an HTTP response from a GetInventoryReceiptWithResponse call
+func ParseGetInventoryReceiptHTTPResponse(rsp *http.Response) (*GetInventoryReceiptHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryReceiptHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryReceiptResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryReservationsHTTPResponse parses an HTTP response from a ListInventoryReservationsWithResponse call
+func ParseListInventoryReservationsHTTPResponse(rsp *http.Response) (*ListInventoryReservationsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryReservationsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryReservationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryReservationHTTPResponse parses an HTTP response from a CreateInventoryReservationWithResponse call
+func ParseCreateInventoryReservationHTTPResponse(rsp *http.Response) (*CreateInventoryReservationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryReservationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryReservationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReleaseInventoryReservationsHTTPResponse parses an HTTP response from a ReleaseInventoryReservationsWithResponse call
+func ParseReleaseInventoryReservationsHTTPResponse(rsp *http.Response) (*ReleaseInventoryReservationsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReleaseInventoryReservationsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReleaseInventoryReservationsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteInventoryReservationHTTPResponse parses an HTTP response from a DeleteInventoryReservationWithResponse call
+func ParseDeleteInventoryReservationHTTPResponse(rsp *http.Response) (*DeleteInventoryReservationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteInventoryReservationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryReservationHTTPResponse parses an HTTP response from a GetInventoryReservationWithResponse call
+func ParseGetInventoryReservationHTTPResponse(rsp *http.Response) (*GetInventoryReservationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryReservationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryReservationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseScanInventoryHTTPResponse parses an HTTP response from a ScanInventoryWithResponse call
+func ParseScanInventoryHTTPResponse(rsp *http.Response) (*ScanInventoryHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ScanInventoryHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryTransfersHTTPResponse parses an HTTP response from a ListInventoryTransfersWithResponse call
+func ParseListInventoryTransfersHTTPResponse(rsp *http.Response) (*ListInventoryTransfersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryTransfersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryTransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryTransferHTTPResponse parses an HTTP response from a CreateInventoryTransferWithResponse call
+func ParseCreateInventoryTransferHTTPResponse(rsp *http.Response) (*CreateInventoryTransferHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryTransferHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryTransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryTransferHTTPResponse parses an HTTP response from a GetInventoryTransferWithResponse call
+func ParseGetInventoryTransferHTTPResponse(rsp *http.Response) (*GetInventoryTransferHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryTransferHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryTransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListInventoryUsageHTTPResponse parses an HTTP response from a ListInventoryUsageWithResponse call
+func ParseListInventoryUsageHTTPResponse(rsp *http.Response) (*ListInventoryUsageHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListInventoryUsageHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []InventoryUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateInventoryUsageHTTPResponse parses an HTTP response from a CreateInventoryUsageWithResponse call
+func ParseCreateInventoryUsageHTTPResponse(rsp *http.Response) (*CreateInventoryUsageHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateInventoryUsageHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InventoryUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchUsageMovementsHTTPResponse parses an HTTP response from a ListBatchUsageMovementsWithResponse call
+func ParseListBatchUsageMovementsHTTPResponse(rsp *http.Response) (*ListBatchUsageMovementsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchUsageMovementsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchUsageMovementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchUsageHTTPResponse parses an HTTP response from a CreateBatchUsageWithResponse call
+func ParseCreateBatchUsageHTTPResponse(rsp *http.Response) (*CreateBatchUsageHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchUsageHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryUsageHTTPResponse parses an HTTP response from a GetInventoryUsageWithResponse call
+func ParseGetInventoryUsageHTTPResponse(rsp *http.Response) (*GetInventoryUsageHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryUsageHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryUsageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryValuationHTTPResponse parses an HTTP response from a GetInventoryValuationWithResponse call
+func ParseGetInventoryValuationHTTPResponse(rsp *http.Response) (*GetInventoryValuationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryValuationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryValuationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryConsumptionHTTPResponse parses an HTTP response from a GetInventoryConsumptionWithResponse call
+func ParseGetInventoryConsumptionHTTPResponse(rsp *http.Response) (*GetInventoryConsumptionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryConsumptionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InventoryConsumptionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetInventoryValuationSettingsHTTPResponse parses an HTTP response from a GetInventoryValuationSettingsWithResponse call
+func ParseGetInventoryValuationSettingsHTTPResponse(rsp *http.Response) (*GetInventoryValuationSettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInventoryValuationSettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValuationSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateInventoryValuationSettingsHTTPResponse parses an HTTP response from a UpdateInventoryValuationSettingsWithResponse call
+func ParseUpdateInventoryValuationSettingsHTTPResponse(rsp *http.Response) (*UpdateInventoryValuationSettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateInventoryValuationSettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValuationSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOverdueKegReportHTTPResponse parses an HTTP response from a GetOverdueKegReportWithResponse call
+func ParseGetOverdueKegReportHTTPResponse(rsp *http.Response) (*GetOverdueKegReportHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOverdueKegReportHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OverdueKegResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKegTurnTimeReportHTTPResponse parses an HTTP response from a GetKegTurnTimeReportWithResponse call
+func ParseGetKegTurnTimeReportHTTPResponse(rsp *http.Response) (*GetKegTurnTimeReportHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKegTurnTimeReportHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KegTurnTimeReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetKegWriteOffReportHTTPResponse parses an HTTP response from a GetKegWriteOffReportWithResponse call
+func ParseGetKegWriteOffReportHTTPResponse(rsp *http.Response) (*GetKegWriteOffReportHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKegWriteOffReportHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KegWriteOffReportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListKegsHTTPResponse parses an HTTP response from a ListKegsWithResponse call
+func ParseListKegsHTTPResponse(rsp *http.Response) (*ListKegsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListKegsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []KegResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateKegHTTPResponse parses an HTTP response from a CreateKegWithResponse call
+func ParseCreateKegHTTPResponse(rsp *http.Response) (*CreateKegHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateKegHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest KegResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteKegHTTPResponse parses an HTTP response from a DeleteKegWithResponse call
+func ParseDeleteKegHTTPResponse(rsp *http.Response) (*DeleteKegHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteKegHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetKegHTTPResponse parses an HTTP response from a GetKegWithResponse call
+func ParseGetKegHTTPResponse(rsp *http.Response) (*GetKegHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetKegHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KegResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateKegHTTPResponse parses an HTTP response from a UpdateKegWithResponse call
+func ParseUpdateKegHTTPResponse(rsp *http.Response) (*UpdateKegHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateKegHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KegResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListKegEventsHTTPResponse parses an HTTP response from a ListKegEventsWithResponse call
+func ParseListKegEventsHTTPResponse(rsp *http.Response) (*ListKegEventsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListKegEventsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []KegEventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateKegEventHTTPResponse parses an HTTP response from a CreateKegEventWithResponse call
+func ParseCreateKegEventHTTPResponse(rsp *http.Response) (*CreateKegEventHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateKegEventHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecordKegEventResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLabelTemplatesHTTPResponse parses an HTTP response from a ListLabelTemplatesWithResponse call
+func ParseListLabelTemplatesHTTPResponse(rsp *http.Response) (*ListLabelTemplatesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLabelTemplatesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LabelTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateLabelTemplateHTTPResponse parses an HTTP response from a CreateLabelTemplateWithResponse call
+func ParseCreateLabelTemplateHTTPResponse(rsp *http.Response) (*CreateLabelTemplateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateLabelTemplateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest LabelTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteLabelTemplateHTTPResponse parses an HTTP response from a DeleteLabelTemplateWithResponse call
+func ParseDeleteLabelTemplateHTTPResponse(rsp *http.Response) (*DeleteLabelTemplateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteLabelTemplateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetLabelTemplateHTTPResponse parses an HTTP response from a GetLabelTemplateWithResponse call
+func ParseGetLabelTemplateHTTPResponse(rsp *http.Response) (*GetLabelTemplateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLabelTemplateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LabelTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateLabelTemplateHTTPResponse parses an HTTP response from a UpdateLabelTemplateWithResponse call
+func ParseUpdateLabelTemplateHTTPResponse(rsp *http.Response) (*UpdateLabelTemplateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateLabelTemplateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LabelTemplateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyInventoryMigrationHTTPResponse parses an HTTP response from a ApplyInventoryMigrationWithResponse call
+func ParseApplyInventoryMigrationHTTPResponse(rsp *http.Response) (*ApplyInventoryMigrationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplyInventoryMigrationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrewimportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRemovalSummaryHTTPResponse parses an HTTP response from a GetRemovalSummaryWithResponse call
+func ParseGetRemovalSummaryHTTPResponse(rsp *http.Response) (*GetRemovalSummaryHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRemovalSummaryHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RemovalSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRemovalsHTTPResponse parses an HTTP response from a ListRemovalsWithResponse call
+func ParseListRemovalsHTTPResponse(rsp *http.Response) (*ListRemovalsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRemovalsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RemovalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRemovalHTTPResponse parses an HTTP response from a CreateRemovalWithResponse call
+func ParseCreateRemovalHTTPResponse(rsp *http.Response) (*CreateRemovalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRemovalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RemovalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRemovalHTTPResponse parses an HTTP response from a DeleteRemovalWithResponse call
+func ParseDeleteRemovalHTTPResponse(rsp *http.Response) (*DeleteRemovalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRemovalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetRemovalHTTPResponse parses an HTTP response from a GetRemovalWithResponse call
+func ParseGetRemovalHTTPResponse(rsp *http.Response) (*GetRemovalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRemovalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RemovalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRemovalHTTPResponse parses an HTTP response from a UpdateRemovalWithResponse call
+func ParseUpdateRemovalHTTPResponse(rsp *http.Response) (*UpdateRemovalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRemovalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RemovalResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListReplenishmentSuggestionsHTTPResponse parses an HTTP response from a ListReplenishmentSuggestionsWithResponse call
+func ParseListReplenishmentSuggestionsHTTPResponse(rsp *http.Response) (*ListReplenishmentSuggestionsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListReplenishmentSuggestionsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReplenishmentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateReplenishmentPurchaseOrdersHTTPResponse parses an HTTP response from a CreateReplenishmentPurchaseOrdersWithResponse call
+func ParseCreateReplenishmentPurchaseOrdersHTTPResponse(rsp *http.Response) (*CreateReplenishmentPurchaseOrdersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateReplenishmentPurchaseOrdersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReplenishmentPurchaseOrdersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListStockLevelsHTTPResponse parses an HTTP response from a ListStockLevelsWithResponse call
+func ParseListStockLevelsHTTPResponse(rsp *http.Response) (*ListStockLevelsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListStockLevelsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StockLevelResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListStockLocationsHTTPResponse parses an HTTP response from a ListStockLocationsWithResponse call
+func ParseListStockLocationsHTTPResponse(rsp *http.Response) (*ListStockLocationsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListStockLocationsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StockLocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStockLocationHTTPResponse parses an HTTP response from a CreateStockLocationWithResponse call
+func ParseCreateStockLocationHTTPResponse(rsp *http.Response) (*CreateStockLocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStockLocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StockLocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteStockLocationHTTPResponse parses an HTTP response from a DeleteStockLocationWithResponse call
+func ParseDeleteStockLocationHTTPResponse(rsp *http.Response) (*DeleteStockLocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteStockLocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationHTTPResponse parses an HTTP response from a GetStockLocationWithResponse call
+func ParseGetStockLocationHTTPResponse(rsp *http.Response) (*GetStockLocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateStockLocationHTTPResponse parses an HTTP response from a UpdateStockLocationWithResponse call
+func ParseUpdateStockLocationHTTPResponse(rsp *http.Response) (*UpdateStockLocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateStockLocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSupplierReturnsHTTPResponse parses an HTTP response from a ListSupplierReturnsWithResponse call
+func ParseListSupplierReturnsHTTPResponse(rsp *http.Response) (*ListSupplierReturnsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSupplierReturnsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SupplierReturnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSupplierReturnHTTPResponse parses an HTTP response from a CreateSupplierReturnWithResponse call
+func ParseCreateSupplierReturnHTTPResponse(rsp *http.Response) (*CreateSupplierReturnHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSupplierReturnHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SupplierReturnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSupplierReturnHTTPResponse parses an HTTP response from a GetSupplierReturnWithResponse call
+func ParseGetSupplierReturnHTTPResponse(rsp *http.Response) (*GetSupplierReturnHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSupplierReturnHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierReturnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSupplierReturnHTTPResponse parses an HTTP response from a UpdateSupplierReturnWithResponse call
+func ParseUpdateSupplierReturnHTTPResponse(rsp *http.Response) (*UpdateSupplierReturnHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSupplierReturnHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierReturnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncSupplierReturnHTTPResponse parses an HTTP response from a SyncSupplierReturnWithResponse call
+func ParseSyncSupplierReturnHTTPResponse(rsp *http.Response) (*SyncSupplierReturnHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncSupplierReturnHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierReturnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,3 @@
+package inventory
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../../service/inventory/handler/api.yaml
@@ -0,0 +1,7396 @@
+// Package procurement provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package procurement
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Account defines model for Account.
+type Account struct {
+	Account string `json:"account"`
+	Key     string `json:"key"`
+	Kind    string `json:"kind"`
+}
+
+// AccountInput defines model for AccountInput.
+type AccountInput struct {
+	Account *string `json:"account"`
+	Key     *string `json:"key"`
+	Kind    *string `json:"kind"`
+}
+
+// AllocatedPurchaseOrderFeeResponse defines model for AllocatedPurchaseOrderFeeResponse.
+type AllocatedPurchaseOrderFeeResponse struct {
+	AllocatedCents    int64      `json:"allocated_cents"`
+	AllocationBasis   string     `json:"allocation_basis"`
+	AmountCents       int64      `json:"amount_cents"`
+	CreatedAt         time.Time  `json:"created_at"`
+	Currency          string     `json:"currency"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	FeeType           string     `json:"fee_type"`
+	PurchaseOrderUuid string     `json:"purchase_order_uuid"`
+	UnallocatedCents  int64      `json:"unallocated_cents"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// AppliedExchangeRateResponse defines model for AppliedExchangeRateResponse.
+type AppliedExchangeRateResponse struct {
+	Locked   bool    `json:"locked"`
+	Rate     float64 `json:"rate"`
+	RateDate *string `json:"rate_date"`
+}
+
+// BatchLookupPurchaseOrderLinesRequest defines model for BatchLookupPurchaseOrderLinesRequest.
+type BatchLookupPurchaseOrderLinesRequest struct {
+	Uuids []string `json:"uuids"`
+}
+
+// CreateDraftPurchaseOrderLine defines model for CreateDraftPurchaseOrderLine.
+type CreateDraftPurchaseOrderLine struct {
+	Currency          *string `json:"currency"`
+	InventoryItemUuid *string `json:"inventory_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	ItemType          *string `json:"item_type"`
+	Quantity          *int64  `json:"quantity"`
+	QuantityUnit      string  `json:"quantity_unit"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+}
+
+// CreateDraftPurchaseOrderRequest defines model for CreateDraftPurchaseOrderRequest.
+type CreateDraftPurchaseOrderRequest struct {
+	ExpectedAt   *time.Time                     `json:"expected_at"`
+	Lines        []CreateDraftPurchaseOrderLine `json:"lines"`
+	Notes        *string                        `json:"notes"`
+	SupplierUuid string                         `json:"supplier_uuid"`
+}
+
+// CreateExchangeRateRequest defines model for CreateExchangeRateRequest.
+type CreateExchangeRateRequest struct {
+	EffectiveDate *string  `json:"effective_date"`
+	FromCurrency  *string  `json:"from_currency"`
+	Rate          *float64 `json:"rate"`
+	ToCurrency    *string  `json:"to_currency"`
+}
+
+// CreateJournalExportRequest defines model for CreateJournalExportRequest.
+type CreateJournalExportRequest struct {
+	Format   *string `json:"format"`
+	Period   *string `json:"period"`
+	Reexport *bool   `json:"reexport"`
+}
+
+// CreatePurchaseOrderFeeRequest defines model for CreatePurchaseOrderFeeRequest.
+type CreatePurchaseOrderFeeRequest struct {
+	AllocationBasis   *string `json:"allocation_basis"`
+	AmountCents       *int64  `json:"amount_cents"`
+	Currency          *string `json:"currency"`
+	FeeType           string  `json:"fee_type"`
+	PurchaseOrderUuid string  `json:"purchase_order_uuid"`
+}
+
+// CreatePurchaseOrderLineRequest defines model for CreatePurchaseOrderLineRequest.
+type CreatePurchaseOrderLineRequest struct {
+	Currency          *string `json:"currency"`
+	InventoryItemUuid *string `json:"inventory_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	ItemType          *string `json:"item_type"`
+	LineNumber        *int    `json:"line_number"`
+	PurchaseOrderUuid string  `json:"purchase_order_uuid"`
+	Quantity          *int64  `json:"quantity"`
+	QuantityUnit      string  `json:"quantity_unit"`
+	SupplierItemUuid  *string `json:"supplier_item_uuid"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+}
+
+// CreatePurchaseOrderRequest defines model for CreatePurchaseOrderRequest.
+type CreatePurchaseOrderRequest struct {
+	ExpectedAt   *time.Time `json:"expected_at"`
+	Notes        *string    `json:"notes"`
+	OrderNumber  *string    `json:"order_number"`
+	OrderedAt    *time.Time `json:"ordered_at"`
+	Status       *string    `json:"status"`
+	SupplierUuid string     `json:"supplier_uuid"`
+}
+
+// CreateSupplierInvoiceLineRequest defines model for CreateSupplierInvoiceLineRequest.
+type CreateSupplierInvoiceLineRequest struct {
+	Notes                 *string `json:"notes"`
+	PurchaseOrderLineUuid string  `json:"purchase_order_line_uuid"`
+	Quantity              *int64  `json:"quantity"`
+	QuantityUnit          string  `json:"quantity_unit"`
+	UnitPriceCents        *int64  `json:"unit_price_cents"`
+}
+
+// CreateSupplierInvoiceRequest defines model for CreateSupplierInvoiceRequest.
+type CreateSupplierInvoiceRequest struct {
+	Currency          *string                            `json:"currency"`
+	DueDate           *string                            `json:"due_date"`
+	InvoiceDate       *string                            `json:"invoice_date"`
+	InvoiceNumber     string                             `json:"invoice_number"`
+	Lines             []CreateSupplierInvoiceLineRequest `json:"lines"`
+	Notes             *string                            `json:"notes"`
+	PurchaseOrderUuid string                             `json:"purchase_order_uuid"`
+}
+
+// CreateSupplierItemPriceRequest defines model for CreateSupplierItemPriceRequest.
+type CreateSupplierItemPriceRequest struct {
+	Currency      *string    `json:"currency"`
+	EffectiveAt   *time.Time `json:"effective_at"`
+	Notes         *string    `json:"notes"`
+	UnitCostCents *int64     `json:"unit_cost_cents"`
+}
+
+// CreateSupplierItemRequest defines model for CreateSupplierItemRequest.
+type CreateSupplierItemRequest struct {
+	InventoryItemUuid *string `json:"inventory_item_uuid"`
+	ItemType          *string `json:"item_type"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	MinOrderQuantity  *int64  `json:"min_order_quantity"`
+	Name              string  `json:"name"`
+	Notes             *string `json:"notes"`
+	PackSize          *int64  `json:"pack_size"`
+	PackUnit          string  `json:"pack_unit"`
+	Sku               string  `json:"sku"`
+	SupplierUuid      string  `json:"supplier_uuid"`
+}
+
+// CreateSupplierRequest defines model for CreateSupplierRequest.
+type CreateSupplierRequest struct {
+	AddressLine1 *string `json:"address_line1"`
+	AddressLine2 *string `json:"address_line2"`
+	City         *string `json:"city"`
+	ContactName  *string `json:"contact_name"`
+	Country      *string `json:"country"`
+	Email        *string `json:"email"`
+	Name         string  `json:"name"`
+	Phone        *string `json:"phone"`
+	PostalCode   *string `json:"postal_code"`
+	Region       *string `json:"region"`
+}
+
+// CurrencySettingsResponse defines model for CurrencySettingsResponse.
+type CurrencySettingsResponse struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+// DraftPurchaseOrderResponse defines model for DraftPurchaseOrderResponse.
+type DraftPurchaseOrderResponse struct {
+	CreatedAt    time.Time                   `json:"created_at"`
+	DeletedAt    *time.Time                  `json:"deleted_at"`
+	ExpectedAt   *time.Time                  `json:"expected_at"`
+	Lines        []PurchaseOrderLineResponse `json:"lines"`
+	Notes        *string                     `json:"notes"`
+	OrderNumber  string                      `json:"order_number"`
+	OrderedAt    *time.Time                  `json:"ordered_at"`
+	Status       string                      `json:"status"`
+	SupplierUuid string                      `json:"supplier_uuid"`
+	UpdatedAt    time.Time                   `json:"updated_at"`
+	Uuid         string                      `json:"uuid"`
+}
+
+// Entry defines model for Entry.
+type Entry struct {
+	Date   time.Time `json:"date"`
+	Lines  []Line    `json:"lines"`
+	Memo   string    `json:"memo"`
+	Number string    `json:"number"`
+}
+
+// ExchangeRateImportResponse defines model for ExchangeRateImportResponse.
+type ExchangeRateImportResponse struct {
+	Results []ExchangeRateImportRowResult `json:"results"`
+	Totals  ExchangeRateImportTotals      `json:"totals"`
+}
+
+// ExchangeRateImportRowResult defines model for ExchangeRateImportRowResult.
+type ExchangeRateImportRowResult struct {
+	Error        *string               `json:"error"`
+	ExchangeRate *ExchangeRateResponse `json:"exchange_rate"`
+	Row          int                   `json:"row"`
+	Status       string                `json:"status"`
+}
+
+// ExchangeRateImportTotals defines model for ExchangeRateImportTotals.
+type ExchangeRateImportTotals struct {
+	Failed    int `json:"failed"`
+	Imported  int `json:"imported"`
+	TotalRows int `json:"total_rows"`
+}
+
+// ExchangeRateResponse defines model for ExchangeRateResponse.
+type ExchangeRateResponse struct {
+	CreatedAt     time.Time  `json:"created_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+	EffectiveDate string     `json:"effective_date"`
+	FromCurrency  string     `json:"from_currency"`
+	Rate          float64    `json:"rate"`
+	Source        string     `json:"source"`
+	ToCurrency    string     `json:"to_currency"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Uuid          string     `json:"uuid"`
+}
+
+// InvoiceLineMatch defines model for InvoiceLineMatch.
+type InvoiceLineMatch struct {
+	Currency                string `json:"currency"`
+	InvoiceNumber           string `json:"invoice_number"`
+	PriceVarianceCents      int64  `json:"price_variance_cents"`
+	Quantity                int64  `json:"quantity"`
+	QuantityUnit            string `json:"quantity_unit"`
+	SupplierInvoiceLineUuid string `json:"supplier_invoice_line_uuid"`
+	SupplierInvoiceUuid     string `json:"supplier_invoice_uuid"`
+	UnitPriceCents          int64  `json:"unit_price_cents"`
+	WithinTolerance         bool   `json:"within_tolerance"`
+}
+
+// InvoiceMatchSettingsResponse defines model for InvoiceMatchSettingsResponse.
+type InvoiceMatchSettingsResponse struct {
+	PriceTolerancePercent    float64   `json:"price_tolerance_percent"`
+	QuantityTolerancePercent float64   `json:"quantity_tolerance_percent"`
+	UpdatedAt                time.Time `json:"updated_at"`
+}
+
+// ItemCatalogEntryResponse defines model for ItemCatalogEntryResponse.
+type ItemCatalogEntryResponse struct {
+	Currency          *string `json:"currency"`
+	InventoryItemUuid string  `json:"inventory_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	PackUnit          string  `json:"pack_unit"`
+	SupplierItemUuid  string  `json:"supplier_item_uuid"`
+	SupplierName      string  `json:"supplier_name"`
+	SupplierUuid      string  `json:"supplier_uuid"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+}
+
+// ItemSupplierResponse defines model for ItemSupplierResponse.
+type ItemSupplierResponse struct {
+	Currency          string    `json:"currency"`
+	InventoryItemUuid string    `json:"inventory_item_uuid"`
+	ItemName          string    `json:"item_name"`
+	LastOrderedAt     time.Time `json:"last_ordered_at"`
+	QuantityUnit      string    `json:"quantity_unit"`
+	SupplierName      string    `json:"supplier_name"`
+	SupplierUuid      string    `json:"supplier_uuid"`
+	UnitCostCents     int64     `json:"unit_cost_cents"`
+}
+
+// ItemSupplyLookupRequest defines model for ItemSupplyLookupRequest.
+type ItemSupplyLookupRequest struct {
+	InventoryItemUuids []string `json:"inventory_item_uuids"`
+}
+
+// ItemSupplyLookupResponse defines model for ItemSupplyLookupResponse.
+type ItemSupplyLookupResponse struct {
+	CatalogItems []ItemCatalogEntryResponse      `json:"catalog_items"`
+	OpenLines    []OpenPurchaseOrderLineResponse `json:"open_lines"`
+	Suppliers    []ItemSupplierResponse          `json:"suppliers"`
+}
+
+// Journal defines model for Journal.
+type Journal struct {
+	BaseCurrency string    `json:"base_currency"`
+	Entries      []Entry   `json:"entries"`
+	Period       string    `json:"period"`
+	Skipped      []Skipped `json:"skipped"`
+	TotalCents   int64     `json:"total_cents"`
+}
+
+// JournalExportResponse defines model for JournalExportResponse.
+type JournalExportResponse struct {
+	EntryCount int       `json:"entry_count"`
+	ExportedAt time.Time `json:"exported_at"`
+	FileName   string    `json:"file_name"`
+	Format     string    `json:"format"`
+	Period     string    `json:"period"`
+	TotalCents int64     `json:"total_cents"`
+	Uuid       string    `json:"uuid"`
+}
+
+// LandedPurchaseOrderLineResponse defines model for LandedPurchaseOrderLineResponse.
+type LandedPurchaseOrderLineResponse struct {
+	BaseCurrency        string                       `json:"base_currency"`
+	BaseLandedCostCents *int64                       `json:"base_landed_cost_cents"`
+	CreatedAt           time.Time                    `json:"created_at"`
+	Currency            string                       `json:"currency"`
+	DeletedAt           *time.Time                   `json:"deleted_at"`
+	ExchangeRate        *AppliedExchangeRateResponse `json:"exchange_rate"`
+	FeeAllocatedCents   int64                        `json:"fee_allocated_cents"`
+	InventoryItemUuid   *string                      `json:"inventory_item_uuid"`
+	ItemName            string                       `json:"item_name"`
+	ItemType            string                       `json:"item_type"`
+	LandedCostCents     int64                        `json:"landed_cost_cents"`
+	LandedUnitCostCents int64                        `json:"landed_unit_cost_cents"`
+	LineNumber          int                          `json:"line_number"`
+	PurchaseOrderUuid   string                       `json:"purchase_order_uuid"`
+	Quantity            int64                        `json:"quantity"`
+	QuantityUnit        string                       `json:"quantity_unit"`
+	ReturnedQuantity    int64                        `json:"returned_quantity"`
+	UnitCostCents       int64                        `json:"unit_cost_cents"`
+	UpdatedAt           time.Time                    `json:"updated_at"`
+	Uuid                string                       `json:"uuid"`
+}
+
+// Line defines model for Line.
+type Line struct {
+	Account     string  `json:"account"`
+	CreditCents int64   `json:"credit_cents"`
+	DebitCents  int64   `json:"debit_cents"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// Mapping defines model for Mapping.
+type Mapping struct {
+	Accounts    []Account `json:"accounts"`
+	XeroTaxRate string    `json:"xero_tax_rate"`
+}
+
+// OpenPurchaseOrderLineResponse defines model for OpenPurchaseOrderLineResponse.
+type OpenPurchaseOrderLineResponse struct {
+	ExpectedAt            *time.Time `json:"expected_at"`
+	InventoryItemUuid     string     `json:"inventory_item_uuid"`
+	OrderNumber           string     `json:"order_number"`
+	PurchaseOrderLineUuid string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderUuid     string     `json:"purchase_order_uuid"`
+	Quantity              int64      `json:"quantity"`
+	QuantityUnit          string     `json:"quantity_unit"`
+	Status                string     `json:"status"`
+	SupplierUuid          string     `json:"supplier_uuid"`
+}
+
+// PriceTrendPoint defines model for PriceTrendPoint.
+type PriceTrendPoint struct {
+	At               time.Time `json:"at"`
+	ItemName         string    `json:"item_name"`
+	OrderNumber      *string   `json:"order_number"`
+	Sku              *string   `json:"sku"`
+	Source           string    `json:"source"`
+	SupplierItemUuid *string   `json:"supplier_item_uuid"`
+	UnitCostCents    int64     `json:"unit_cost_cents"`
+}
+
+// PriceTrendResponse defines model for PriceTrendResponse.
+type PriceTrendResponse struct {
+	InventoryItemUuid string             `json:"inventory_item_uuid"`
+	Series            []PriceTrendSeries `json:"series"`
+}
+
+// PriceTrendSeries defines model for PriceTrendSeries.
+type PriceTrendSeries struct {
+	ChangePercent       *float64          `json:"change_percent"`
+	Currency            string            `json:"currency"`
+	LatestUnitCostCents int64             `json:"latest_unit_cost_cents"`
+	MaxUnitCostCents    int64             `json:"max_unit_cost_cents"`
+	MinUnitCostCents    int64             `json:"min_unit_cost_cents"`
+	Points              []PriceTrendPoint `json:"points"`
+	QuantityUnit        string            `json:"quantity_unit"`
+	SupplierName        string            `json:"supplier_name"`
+	SupplierUuid        string            `json:"supplier_uuid"`
+}
+
+// PurchaseOrderCurrencyTotal defines model for PurchaseOrderCurrencyTotal.
+type PurchaseOrderCurrencyTotal struct {
+	BaseTotalCents *int64                       `json:"base_total_cents"`
+	Currency       string                       `json:"currency"`
+	ExchangeRate   *AppliedExchangeRateResponse `json:"exchange_rate"`
+	FeeTotalCents  int64                        `json:"fee_total_cents"`
+	LineTotalCents int64                        `json:"line_total_cents"`
+	TotalCents     int64                        `json:"total_cents"`
+}
+
+// PurchaseOrderFeeResponse defines model for PurchaseOrderFeeResponse.
+type PurchaseOrderFeeResponse struct {
+	AllocationBasis   string     `json:"allocation_basis"`
+	AmountCents       int64      `json:"amount_cents"`
+	CreatedAt         time.Time  `json:"created_at"`
+	Currency          string     `json:"currency"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	FeeType           string     `json:"fee_type"`
+	PurchaseOrderUuid string     `json:"purchase_order_uuid"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// PurchaseOrderLandedCostsResponse defines model for PurchaseOrderLandedCostsResponse.
+type PurchaseOrderLandedCostsResponse struct {
+	BaseCurrency      string                              `json:"base_currency"`
+	Fees              []AllocatedPurchaseOrderFeeResponse `json:"fees"`
+	Lines             []LandedPurchaseOrderLineResponse   `json:"lines"`
+	PurchaseOrderUuid string                              `json:"purchase_order_uuid"`
+}
+
+// PurchaseOrderLineMatch defines model for PurchaseOrderLineMatch.
+type PurchaseOrderLineMatch struct {
+	Currency              string             `json:"currency"`
+	InvoicedQuantity      int64              `json:"invoiced_quantity"`
+	Invoices              []InvoiceLineMatch `json:"invoices"`
+	ItemName              string             `json:"item_name"`
+	LineNumber            int                `json:"line_number"`
+	OrderedQuantity       int64              `json:"ordered_quantity"`
+	PriceVariance         bool               `json:"price_variance"`
+	PurchaseOrderLineUuid string             `json:"purchase_order_line_uuid"`
+	QuantityUnit          string             `json:"quantity_unit"`
+	QuantityVariance      int64              `json:"quantity_variance"`
+	ReceivedQuantity      int64              `json:"received_quantity"`
+	ReturnedQuantity      int64              `json:"returned_quantity"`
+	Status                string             `json:"status"`
+	UnitCostCents         int64              `json:"unit_cost_cents"`
+}
+
+// PurchaseOrderLineResponse defines model for PurchaseOrderLineResponse.
+type PurchaseOrderLineResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	Currency          string     `json:"currency"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	InventoryItemUuid *string    `json:"inventory_item_uuid"`
+	ItemName          string     `json:"item_name"`
+	ItemType          string     `json:"item_type"`
+	LineNumber        int        `json:"line_number"`
+	PurchaseOrderUuid string     `json:"purchase_order_uuid"`
+	Quantity          int64      `json:"quantity"`
+	QuantityUnit      string     `json:"quantity_unit"`
+	ReturnedQuantity  int64      `json:"returned_quantity"`
+	UnitCostCents     int64      `json:"unit_cost_cents"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// PurchaseOrderMatchResponse defines model for PurchaseOrderMatchResponse.
+type PurchaseOrderMatchResponse struct {
+	FullyMatched             bool                     `json:"fully_matched"`
+	Lines                    []PurchaseOrderLineMatch `json:"lines"`
+	OrderNumber              string                   `json:"order_number"`
+	PriceTolerancePercent    float64                  `json:"price_tolerance_percent"`
+	PurchaseOrderUuid        string                   `json:"purchase_order_uuid"`
+	QuantityTolerancePercent float64                  `json:"quantity_tolerance_percent"`
+	Status                   string                   `json:"status"`
+}
+
+// PurchaseOrderResponse defines model for PurchaseOrderResponse.
+type PurchaseOrderResponse struct {
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	ExpectedAt   *time.Time `json:"expected_at"`
+	Notes        *string    `json:"notes"`
+	OrderNumber  string     `json:"order_number"`
+	OrderedAt    *time.Time `json:"ordered_at"`
+	Status       string     `json:"status"`
+	SupplierUuid string     `json:"supplier_uuid"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Uuid         string     `json:"uuid"`
+}
+
+// PurchaseOrderTotalsResponse defines model for PurchaseOrderTotalsResponse.
+type PurchaseOrderTotalsResponse struct {
+	BaseCurrency        string                       `json:"base_currency"`
+	BaseFeeTotalCents   *int64                       `json:"base_fee_total_cents"`
+	BaseLineTotalCents  *int64                       `json:"base_line_total_cents"`
+	BaseTotalCents      *int64                       `json:"base_total_cents"`
+	Currencies          []PurchaseOrderCurrencyTotal `json:"currencies"`
+	ExchangeRatesLocked bool                         `json:"exchange_rates_locked"`
+	PurchaseOrderUuid   string                       `json:"purchase_order_uuid"`
+}
+
+// RecordPurchaseOrderLineReturnRequest defines model for RecordPurchaseOrderLineReturnRequest.
+type RecordPurchaseOrderLineReturnRequest struct {
+	Quantity     *int64 `json:"quantity"`
+	QuantityUnit string `json:"quantity_unit"`
+
+	// ReturnUuid Inventory supplier return being recorded. A return already recorded leaves the line unchanged.
+	ReturnUuid string `json:"return_uuid"`
+}
+
+// Result defines model for Result.
+type Result struct {
+	DryRun  bool        `json:"dry_run"`
+	Results []RowResult `json:"results"`
+	Totals  Totals      `json:"totals"`
+}
+
+// RowResult defines model for RowResult.
+type RowResult struct {
+	Error  *string     `json:"error"`
+	Record interface{} `json:"record"`
+	Row    int         `json:"row"`
+	Status string      `json:"status"`
+}
+
+// Skipped defines model for Skipped.
+type Skipped struct {
+	Reason    string `json:"reason"`
+	Reference string `json:"reference"`
+}
+
+// SupplierInvoiceLineResponse defines model for SupplierInvoiceLineResponse.
+type SupplierInvoiceLineResponse struct {
+	CreatedAt             time.Time `json:"created_at"`
+	Notes                 *string   `json:"notes"`
+	PurchaseOrderLineUuid string    `json:"purchase_order_line_uuid"`
+	Quantity              int64     `json:"quantity"`
+	QuantityUnit          string    `json:"quantity_unit"`
+	TotalCents            int64     `json:"total_cents"`
+	UnitPriceCents        int64     `json:"unit_price_cents"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	Uuid                  string    `json:"uuid"`
+}
+
+// SupplierInvoiceResponse defines model for SupplierInvoiceResponse.
+type SupplierInvoiceResponse struct {
+	CreatedAt         time.Time                     `json:"created_at"`
+	Currency          string                        `json:"currency"`
+	DueDate           *string                       `json:"due_date"`
+	InvoiceDate       string                        `json:"invoice_date"`
+	InvoiceNumber     string                        `json:"invoice_number"`
+	Lines             []SupplierInvoiceLineResponse `json:"lines"`
+	Notes             *string                       `json:"notes"`
+	OrderNumber       string                        `json:"order_number"`
+	PurchaseOrderUuid string                        `json:"purchase_order_uuid"`
+	SupplierUuid      string                        `json:"supplier_uuid"`
+	TotalCents        int64                         `json:"total_cents"`
+	UpdatedAt         time.Time                     `json:"updated_at"`
+	Uuid              string                        `json:"uuid"`
+}
+
+// SupplierItemPriceResponse defines model for SupplierItemPriceResponse.
+type SupplierItemPriceResponse struct {
+	CreatedAt        *time.Time `json:"created_at"`
+	Currency         string     `json:"currency"`
+	EffectiveAt      time.Time  `json:"effective_at"`
+	Notes            *string    `json:"notes"`
+	SupplierItemUuid string     `json:"supplier_item_uuid"`
+	UnitCostCents    int64      `json:"unit_cost_cents"`
+	Uuid             string     `json:"uuid"`
+}
+
+// SupplierItemResponse defines model for SupplierItemResponse.
+type SupplierItemResponse struct {
+	CreatedAt         time.Time                  `json:"created_at"`
+	CurrentPrice      *SupplierItemPriceResponse `json:"current_price"`
+	DeletedAt         *time.Time                 `json:"deleted_at"`
+	InventoryItemUuid *string                    `json:"inventory_item_uuid"`
+	ItemType          string                     `json:"item_type"`
+	LeadTimeDays      *int                       `json:"lead_time_days"`
+	MinOrderQuantity  *int64                     `json:"min_order_quantity"`
+	Name              string                     `json:"name"`
+	Notes             *string                    `json:"notes"`
+	PackSize          int64                      `json:"pack_size"`
+	PackUnit          string                     `json:"pack_unit"`
+	Sku               string                     `json:"sku"`
+	SupplierName      string                     `json:"supplier_name"`
+	SupplierUuid      string                     `json:"supplier_uuid"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+	Uuid              string                     `json:"uuid"`
+}
+
+// SupplierResponse defines model for SupplierResponse.
+type SupplierResponse struct {
+	AddressLine1 *string    `json:"address_line1"`
+	AddressLine2 *string    `json:"address_line2"`
+	City         *string    `json:"city"`
+	ContactName  *string    `json:"contact_name"`
+	Country      *string    `json:"country"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	Email        *string    `json:"email"`
+	Name         string     `json:"name"`
+	Phone        *string    `json:"phone"`
+	PostalCode   *string    `json:"postal_code"`
+	Region       *string    `json:"region"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Uuid         string     `json:"uuid"`
+}
+
+// Totals defines model for Totals.
+type Totals struct {
+	Created   int `json:"created"`
+	Failed    int `json:"failed"`
+	TotalRows int `json:"total_rows"`
+	Valid     int `json:"valid"`
+}
+
+// UUID defines model for UUID.
+type UUID = string
+
+// UpdateAccountMappingRequest defines model for UpdateAccountMappingRequest.
+type UpdateAccountMappingRequest struct {
+	Accounts    *[]AccountInput `json:"accounts"`
+	XeroTaxRate *string         `json:"xero_tax_rate"`
+}
+
+// UpdateCurrencySettingsRequest defines model for UpdateCurrencySettingsRequest.
+type UpdateCurrencySettingsRequest struct {
+	BaseCurrency *string `json:"base_currency"`
+}
+
+// UpdateInvoiceMatchSettingsRequest defines model for UpdateInvoiceMatchSettingsRequest.
+type UpdateInvoiceMatchSettingsRequest struct {
+	PriceTolerancePercent    *float64 `json:"price_tolerance_percent"`
+	QuantityTolerancePercent *float64 `json:"quantity_tolerance_percent"`
+}
+
+// UpdatePurchaseOrderFeeRequest defines model for UpdatePurchaseOrderFeeRequest.
+type UpdatePurchaseOrderFeeRequest struct {
+	AllocationBasis *string `json:"allocation_basis"`
+	AmountCents     *int64  `json:"amount_cents"`
+	Currency        *string `json:"currency"`
+	FeeType         *string `json:"fee_type"`
+}
+
+// UpdatePurchaseOrderLineRequest defines model for UpdatePurchaseOrderLineRequest.
+type UpdatePurchaseOrderLineRequest struct {
+	Currency          *string `json:"currency"`
+	InventoryItemUuid *string `json:"inventory_item_uuid"`
+	ItemName          *string `json:"item_name"`
+	ItemType          *string `json:"item_type"`
+	LineNumber        *int    `json:"line_number"`
+	Quantity          *int64  `json:"quantity"`
+	QuantityUnit      *string `json:"quantity_unit"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+}
+
+// UpdatePurchaseOrderRequest defines model for UpdatePurchaseOrderRequest.
+type UpdatePurchaseOrderRequest struct {
+	ExpectedAt  *time.Time `json:"expected_at"`
+	Notes       *string    `json:"notes"`
+	OrderNumber *string    `json:"order_number"`
+	OrderedAt   *time.Time `json:"ordered_at"`
+	ReceivedAt  *time.Time `json:"received_at"`
+	Status      *string    `json:"status"`
+}
+
+// UpdateSupplierItemRequest defines model for UpdateSupplierItemRequest.
+type UpdateSupplierItemRequest struct {
+	InventoryItemUuid *string `json:"inventory_item_uuid"`
+	ItemType          *string `json:"item_type"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	MinOrderQuantity  *int64  `json:"min_order_quantity"`
+	Name              *string `json:"name"`
+	Notes             *string `json:"notes"`
+	PackSize          *int64  `json:"pack_size"`
+	PackUnit          *string `json:"pack_unit"`
+	Sku               *string `json:"sku"`
+}
+
+// UpdateSupplierRequest defines model for UpdateSupplierRequest.
+type UpdateSupplierRequest struct {
+	AddressLine1 *string `json:"address_line1"`
+	AddressLine2 *string `json:"address_line2"`
+	City         *string `json:"city"`
+	ContactName  *string `json:"contact_name"`
+	Country      *string `json:"country"`
+	Email        *string `json:"email"`
+	Name         *string `json:"name"`
+	Phone        *string `json:"phone"`
+	PostalCode   *string `json:"postal_code"`
+	Region       *string `json:"region"`
+}
+
+// UuidPath defines model for UuidPath.
+type UuidPath = UUID
+
+// GetPurchaseJournalParams defines parameters for GetPurchaseJournal.
+type GetPurchaseJournalParams struct {
+	Period *string `form:"period,omitempty" json:"period,omitempty"`
+}
+
+// ListExchangeRatesParams defines parameters for ListExchangeRates.
+type ListExchangeRatesParams struct {
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+}
+
+// ImportExchangeRatesMultipartBody defines parameters for ImportExchangeRates.
+type ImportExchangeRatesMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ListPriceTrendsParams defines parameters for ListPriceTrends.
+type ListPriceTrendsParams struct {
+	InventoryItemUuid UUID    `form:"inventory_item_uuid" json:"inventory_item_uuid"`
+	Since             *string `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// ListPurchaseOrderFeesParams defines parameters for ListPurchaseOrderFees.
+type ListPurchaseOrderFeesParams struct {
+	PurchaseOrderUuid *UUID `form:"purchase_order_uuid,omitempty" json:"purchase_order_uuid,omitempty"`
+}
+
+// ListPurchaseOrderLinesParams defines parameters for ListPurchaseOrderLines.
+type ListPurchaseOrderLinesParams struct {
+	PurchaseOrderUuid *UUID `form:"purchase_order_uuid,omitempty" json:"purchase_order_uuid,omitempty"`
+}
+
+// ListPurchaseOrdersParams defines parameters for ListPurchaseOrders.
+type ListPurchaseOrdersParams struct {
+	SupplierUuid *UUID `form:"supplier_uuid,omitempty" json:"supplier_uuid,omitempty"`
+}
+
+// ListSupplierInvoicesParams defines parameters for ListSupplierInvoices.
+type ListSupplierInvoicesParams struct {
+	PurchaseOrderUuid *UUID `form:"purchase_order_uuid,omitempty" json:"purchase_order_uuid,omitempty"`
+	SupplierUuid      *UUID `form:"supplier_uuid,omitempty" json:"supplier_uuid,omitempty"`
+}
+
+// ListSupplierItemsParams defines parameters for ListSupplierItems.
+type ListSupplierItemsParams struct {
+	SupplierUuid      *UUID `form:"supplier_uuid,omitempty" json:"supplier_uuid,omitempty"`
+	InventoryItemUuid *UUID `form:"inventory_item_uuid,omitempty" json:"inventory_item_uuid,omitempty"`
+}
+
+// ImportSuppliersMultipartBody defines parameters for ImportSuppliers.
+type ImportSuppliersMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportSuppliersParams defines parameters for ImportSuppliers.
+type ImportSuppliersParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// UpdateAccountingAccountsJSONRequestBody defines body for UpdateAccountingAccounts for application/json ContentType.
+type UpdateAccountingAccountsJSONRequestBody = UpdateAccountMappingRequest
+
+// ExportPurchaseJournalJSONRequestBody defines body for ExportPurchaseJournal for application/json ContentType.
+type ExportPurchaseJournalJSONRequestBody = CreateJournalExportRequest
+
+// UpdateCurrencySettingsJSONRequestBody defines body for UpdateCurrencySettings for application/json ContentType.
+type UpdateCurrencySettingsJSONRequestBody = UpdateCurrencySettingsRequest
+
+// CreateExchangeRateJSONRequestBody defines body for CreateExchangeRate for application/json ContentType.
+type CreateExchangeRateJSONRequestBody = CreateExchangeRateRequest
+
+// ImportExchangeRatesMultipartRequestBody defines body for ImportExchangeRates for multipart/form-data ContentType.
+type ImportExchangeRatesMultipartRequestBody ImportExchangeRatesMultipartBody
+
+// UpdateInvoiceMatchSettingsJSONRequestBody defines body for UpdateInvoiceMatchSettings for application/json ContentType.
+type UpdateInvoiceMatchSettingsJSONRequestBody = UpdateInvoiceMatchSettingsRequest
+
+// CreatePurchaseOrderFeeJSONRequestBody defines body for CreatePurchaseOrderFee for application/json ContentType.
+type CreatePurchaseOrderFeeJSONRequestBody = CreatePurchaseOrderFeeRequest
+
+// UpdatePurchaseOrderFeeJSONRequestBody defines body for UpdatePurchaseOrderFee for application/json ContentType.
+type UpdatePurchaseOrderFeeJSONRequestBody = UpdatePurchaseOrderFeeRequest
+
+// CreatePurchaseOrderLineJSONRequestBody defines body for CreatePurchaseOrderLine for application/json ContentType.
+type CreatePurchaseOrderLineJSONRequestBody = CreatePurchaseOrderLineRequest
+
+// BatchLookupPurchaseOrderLinesJSONRequestBody defines body for BatchLookupPurchaseOrderLines for application/json ContentType.
+type BatchLookupPurchaseOrderLinesJSONRequestBody = BatchLookupPurchaseOrderLinesRequest
+
+// LookupItemSupplyJSONRequestBody defines body for LookupItemSupply for application/json ContentType.
+type LookupItemSupplyJSONRequestBody = ItemSupplyLookupRequest
+
+// UpdatePurchaseOrderLineJSONRequestBody defines body for UpdatePurchaseOrderLine for application/json ContentType.
+type UpdatePurchaseOrderLineJSONRequestBody = UpdatePurchaseOrderLineRequest
+
+// CreatePurchaseOrderLineReturnJSONRequestBody defines body for CreatePurchaseOrderLineReturn for application/json ContentType.
+type CreatePurchaseOrderLineReturnJSONRequestBody = RecordPurchaseOrderLineReturnRequest
+
+// CreatePurchaseOrderJSONRequestBody defines body for CreatePurchaseOrder for application/json ContentType.
+type CreatePurchaseOrderJSONRequestBody = CreatePurchaseOrderRequest
+
+// CreateDraftPurchaseOrderJSONRequestBody defines body for CreateDraftPurchaseOrder for application/json ContentType.
+type CreateDraftPurchaseOrderJSONRequestBody = CreateDraftPurchaseOrderRequest
+
+// UpdatePurchaseOrderJSONRequestBody defines body for UpdatePurchaseOrder for application/json ContentType.
+type UpdatePurchaseOrderJSONRequestBody = UpdatePurchaseOrderRequest
+
+// CreateSupplierInvoiceJSONRequestBody defines body for CreateSupplierInvoice for application/json ContentType.
+type CreateSupplierInvoiceJSONRequestBody = CreateSupplierInvoiceRequest
+
+// CreateSupplierItemJSONRequestBody defines body for CreateSupplierItem for application/json ContentType.
+type CreateSupplierItemJSONRequestBody = CreateSupplierItemRequest
+
+// UpdateSupplierItemJSONRequestBody defines body for UpdateSupplierItem for application/json ContentType.
+type UpdateSupplierItemJSONRequestBody = UpdateSupplierItemRequest
+
+// CreateSupplierItemPriceJSONRequestBody defines body for CreateSupplierItemPrice for application/json ContentType.
+type CreateSupplierItemPriceJSONRequestBody = CreateSupplierItemPriceRequest
+
+// CreateSupplierJSONRequestBody defines body for CreateSupplier for application/json ContentType.
+type CreateSupplierJSONRequestBody = CreateSupplierRequest
+
+// ImportSuppliersMultipartRequestBody defines body for ImportSuppliers for multipart/form-data ContentType.
+type ImportSuppliersMultipartRequestBody ImportSuppliersMultipartBody
+
+// UpdateSupplierJSONRequestBody defines body for UpdateSupplier for application/json ContentType.
+type UpdateSupplierJSONRequestBody = UpdateSupplierRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAccountingAccounts request
+	ListAccountingAccounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAccountingAccountsWithBody request with any body
+	UpdateAccountingAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAccountingAccounts(ctx context.Context, body UpdateAccountingAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseJournal request
+	GetPurchaseJournal(ctx context.Context, params *GetPurchaseJournalParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPurchaseJournalExports request
+	ListPurchaseJournalExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportPurchaseJournalWithBody request with any body
+	ExportPurchaseJournalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExportPurchaseJournal(ctx context.Context, body ExportPurchaseJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseJournalExportFile request
+	GetPurchaseJournalExportFile(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCurrencySettings request
+	GetCurrencySettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCurrencySettingsWithBody request with any body
+	UpdateCurrencySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCurrencySettings(ctx context.Context, body UpdateCurrencySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListExchangeRates request
+	ListExchangeRates(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateExchangeRateWithBody request with any body
+	CreateExchangeRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateExchangeRate(ctx context.Context, body CreateExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportExchangeRatesWithBody request with any body
+	ImportExchangeRatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteExchangeRate request
+	DeleteExchangeRate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInvoiceMatchSettings request
+	GetInvoiceMatchSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateInvoiceMatchSettingsWithBody request with any body
+	UpdateInvoiceMatchSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateInvoiceMatchSettings(ctx context.Context, body UpdateInvoiceMatchSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPriceTrends request
+	ListPriceTrends(ctx context.Context, params *ListPriceTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPurchaseOrderFees request
+	ListPurchaseOrderFees(ctx context.Context, params *ListPurchaseOrderFeesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePurchaseOrderFeeWithBody request with any body
+	CreatePurchaseOrderFeeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePurchaseOrderFee(ctx context.Context, body CreatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePurchaseOrderFee request
+	DeletePurchaseOrderFee(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrderFee request
+	GetPurchaseOrderFee(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePurchaseOrderFeeWithBody request with any body
+	UpdatePurchaseOrderFeeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePurchaseOrderFee(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPurchaseOrderLines request
+	ListPurchaseOrderLines(ctx context.Context, params *ListPurchaseOrderLinesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePurchaseOrderLineWithBody request with any body
+	CreatePurchaseOrderLineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePurchaseOrderLine(ctx context.Context, body CreatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BatchLookupPurchaseOrderLinesWithBody request with any body
+	BatchLookupPurchaseOrderLinesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchLookupPurchaseOrderLines(ctx context.Context, body BatchLookupPurchaseOrderLinesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LookupItemSupplyWithBody request with any body
+	LookupItemSupplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LookupItemSupply(ctx context.Context, body LookupItemSupplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePurchaseOrderLine request
+	DeletePurchaseOrderLine(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrderLine request
+	GetPurchaseOrderLine(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePurchaseOrderLineWithBody request with any body
+	UpdatePurchaseOrderLineWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePurchaseOrderLine(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePurchaseOrderLineReturnWithBody request with any body
+	CreatePurchaseOrderLineReturnWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePurchaseOrderLineReturn(ctx context.Context, uuid UuidPath, body CreatePurchaseOrderLineReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPurchaseOrders request
+	ListPurchaseOrders(ctx context.Context, params *ListPurchaseOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePurchaseOrderWithBody request with any body
+	CreatePurchaseOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePurchaseOrder(ctx context.Context, body CreatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDraftPurchaseOrderWithBody request with any body
+	CreateDraftPurchaseOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDraftPurchaseOrder(ctx context.Context, body CreateDraftPurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrder request
+	GetPurchaseOrder(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePurchaseOrderWithBody request with any body
+	UpdatePurchaseOrderWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePurchaseOrder(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrderLandedCosts request
+	GetPurchaseOrderLandedCosts(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrderMatch request
+	GetPurchaseOrderMatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPurchaseOrderTotals request
+	GetPurchaseOrderTotals(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSupplierInvoices request
+	ListSupplierInvoices(ctx context.Context, params *ListSupplierInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSupplierInvoiceWithBody request with any body
+	CreateSupplierInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSupplierInvoice(ctx context.Context, body CreateSupplierInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSupplierInvoice request
+	DeleteSupplierInvoice(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSupplierInvoice request
+	GetSupplierInvoice(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSupplierItems request
+	ListSupplierItems(ctx context.Context, params *ListSupplierItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSupplierItemWithBody request with any body
+	CreateSupplierItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSupplierItem(ctx context.Context, body CreateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSupplierItem request
+	DeleteSupplierItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSupplierItem request
+	GetSupplierItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSupplierItemWithBody request with any body
+	UpdateSupplierItemWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSupplierItem(ctx context.Context, uuid UuidPath, body UpdateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSupplierItemPrices request
+	ListSupplierItemPrices(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSupplierItemPriceWithBody request with any body
+	CreateSupplierItemPriceWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSupplierItemPrice(ctx context.Context, uuid UuidPath, body CreateSupplierItemPriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSuppliers request
+	ListSuppliers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSupplierWithBody request with any body
+	CreateSupplierWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSupplier(ctx context.Context, body CreateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportSuppliersWithBody request with any body
+	ImportSuppliersWithBody(ctx context.Context, params *ImportSuppliersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSupplier request
+	GetSupplier(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSupplierWithBody request with any body
+	UpdateSupplierWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSupplier(ctx context.Context, uuid UuidPath, body UpdateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAccountingAccounts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAccountingAccountsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAccountingAccountsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccountingAccountsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateAccountingAccounts(ctx context.Context, body UpdateAccountingAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAccountingAccountsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseJournal(ctx context.Context, params *GetPurchaseJournalParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseJournalRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPurchaseJournalExports(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPurchaseJournalExportsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPurchaseJournalWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPurchaseJournalRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportPurchaseJournal(ctx context.Context, body ExportPurchaseJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportPurchaseJournalRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseJournalExportFile(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseJournalExportFileRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCurrencySettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCurrencySettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCurrencySettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrencySettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCurrencySettings(ctx context.Context, body UpdateCurrencySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCurrencySettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListExchangeRates(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListExchangeRatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExchangeRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExchangeRateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateExchangeRate(ctx context.Context, body CreateExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateExchangeRateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportExchangeRatesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportExchangeRatesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteExchangeRate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteExchangeRateRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInvoiceMatchSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInvoiceMatchSettingsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInvoiceMatchSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceMatchSettingsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateInvoiceMatchSettings(ctx context.Context, body UpdateInvoiceMatchSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateInvoiceMatchSettingsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPriceTrends(ctx context.Context, params *ListPriceTrendsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPriceTrendsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPurchaseOrderFees(ctx context.Context, params *ListPurchaseOrderFeesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPurchaseOrderFeesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderFeeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderFeeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderFee(ctx context.Context, body CreatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderFeeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePurchaseOrderFee(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePurchaseOrderFeeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrderFee(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderFeeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrderFeeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderFeeRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrderFee(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderFeeRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPurchaseOrderLines(ctx context.Context, params *ListPurchaseOrderLinesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPurchaseOrderLinesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderLineWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderLineRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderLine(ctx context.Context, body CreatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderLineRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchLookupPurchaseOrderLinesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchLookupPurchaseOrderLinesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BatchLookupPurchaseOrderLines(ctx context.Context, body BatchLookupPurchaseOrderLinesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchLookupPurchaseOrderLinesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LookupItemSupplyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupItemSupplyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LookupItemSupply(ctx context.Context, body LookupItemSupplyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLookupItemSupplyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePurchaseOrderLine(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePurchaseOrderLineRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrderLine(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderLineRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrderLineWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderLineRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderLineRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderLineReturnWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderLineReturnRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderLineReturn(ctx context.Context, uuid UuidPath, body CreatePurchaseOrderLineReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderLineReturnRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPurchaseOrders(ctx context.Context, params *ListPurchaseOrdersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPurchaseOrdersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePurchaseOrder(ctx context.Context, body CreatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePurchaseOrderRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDraftPurchaseOrderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDraftPurchaseOrderRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDraftPurchaseOrder(ctx context.Context, body CreateDraftPurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDraftPurchaseOrderRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrder(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrderWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePurchaseOrder(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePurchaseOrderRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrderLandedCosts(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderLandedCostsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrderMatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderMatchRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPurchaseOrderTotals(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPurchaseOrderTotalsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSupplierInvoices(ctx context.Context, params *ListSupplierInvoicesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSupplierInvoicesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierInvoiceWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierInvoiceRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierInvoice(ctx context.Context, body CreateSupplierInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierInvoiceRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSupplierInvoice(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSupplierInvoiceRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSupplierInvoice(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplierInvoiceRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSupplierItems(ctx context.Context, params *ListSupplierItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSupplierItemsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierItemRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierItem(ctx context.Context, body CreateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierItemRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSupplierItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSupplierItemRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSupplierItem(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplierItemRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplierItemWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierItemRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplierItem(ctx context.Context, uuid UuidPath, body UpdateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierItemRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSupplierItemPrices(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSupplierItemPricesRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierItemPriceWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierItemPriceRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierItemPrice(ctx context.Context, uuid UuidPath, body CreateSupplierItemPriceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierItemPriceRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSuppliers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSuppliersRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplierWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSupplier(ctx context.Context, body CreateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSupplierRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportSuppliersWithBody(ctx context.Context, params *ImportSuppliersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportSuppliersRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSupplier(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSupplierRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplierWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSupplier(ctx context.Context, uuid UuidPath, body UpdateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSupplierRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAccountingAccountsRequest generates requests for ListAccountingAccounts
+func NewListAccountingAccountsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateAccountingAccountsRequest calls the generic UpdateAccountingAccounts builder with application/json body
+func NewUpdateAccountingAccountsRequest(server string, body UpdateAccountingAccountsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateAccountingAccountsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateAccountingAccountsRequestWithBody generates requests for UpdateAccountingAccounts with any type of body
+func NewUpdateAccountingAccountsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPurchaseJournalRequest generates requests for GetPurchaseJournal
+func NewGetPurchaseJournalRequest(server string, params *GetPurchaseJournalParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/purchase-journal")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Period != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "period", runtime.ParamLocationQuery, *params.Period); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPurchaseJournalExportsRequest generates requests for ListPurchaseJournalExports
+func NewListPurchaseJournalExportsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/purchase-journal/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportPurchaseJournalRequest calls the generic ExportPurchaseJournal builder with application/json body
+func NewExportPurchaseJournalRequest(server string, body ExportPurchaseJournalJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExportPurchaseJournalRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExportPurchaseJournalRequestWithBody generates requests for ExportPurchaseJournal with any type of body
+func NewExportPurchaseJournalRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/purchase-journal/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPurchaseJournalExportFileRequest generates requests for GetPurchaseJournalExportFile
+func NewGetPurchaseJournalExportFileRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounting/purchase-journal/exports/%s/file", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCurrencySettingsRequest generates requests for GetCurrencySettings
+func NewGetCurrencySettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/currency-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCurrencySettingsRequest calls the generic UpdateCurrencySettings builder with application/json body
+func NewUpdateCurrencySettingsRequest(server string, body UpdateCurrencySettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCurrencySettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateCurrencySettingsRequestWithBody generates requests for UpdateCurrencySettings with any type of body
+func NewUpdateCurrencySettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/currency-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListExchangeRatesRequest generates requests for ListExchangeRates
+func NewListExchangeRatesRequest(server string, params *ListExchangeRatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exchange-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateExchangeRateRequest calls the generic CreateExchangeRate builder with application/json body
+func NewCreateExchangeRateRequest(server string, body CreateExchangeRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateExchangeRateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateExchangeRateRequestWithBody generates requests for CreateExchangeRate with any type of body
+func NewCreateExchangeRateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exchange-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportExchangeRatesRequestWithBody generates requests for ImportExchangeRates with any type of body
+func NewImportExchangeRatesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exchange-rates/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteExchangeRateRequest generates requests for DeleteExchangeRate
+func NewDeleteExchangeRateRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exchange-rates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetInvoiceMatchSettingsRequest generates requests for GetInvoiceMatchSettings
+func NewGetInvoiceMatchSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invoice-match-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateInvoiceMatchSettingsRequest calls the generic UpdateInvoiceMatchSettings builder with application/json body
+func NewUpdateInvoiceMatchSettingsRequest(server string, body UpdateInvoiceMatchSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateInvoiceMatchSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateInvoiceMatchSettingsRequestWithBody generates requests for UpdateInvoiceMatchSettings with any type of body
+func NewUpdateInvoiceMatchSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invoice-match-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPriceTrendsRequest generates requests for ListPriceTrends
+func NewListPriceTrendsRequest(server string, params *ListPriceTrendsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/price-trends")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inventory_item_uuid", runtime.ParamLocationQuery, params.InventoryItemUuid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPurchaseOrderFeesRequest generates requests for ListPurchaseOrderFees
+func NewListPurchaseOrderFeesRequest(server string, params *ListPurchaseOrderFeesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-fees")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PurchaseOrderUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_uuid", runtime.ParamLocationQuery, *params.PurchaseOrderUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePurchaseOrderFeeRequest calls the generic CreatePurchaseOrderFee builder with application/json body
+func NewCreatePurchaseOrderFeeRequest(server string, body CreatePurchaseOrderFeeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePurchaseOrderFeeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePurchaseOrderFeeRequestWithBody generates requests for CreatePurchaseOrderFee with any type of body
+func NewCreatePurchaseOrderFeeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-fees")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePurchaseOrderFeeRequest generates requests for DeletePurchaseOrderFee
+func NewDeletePurchaseOrderFeeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-fees/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderFeeRequest generates requests for GetPurchaseOrderFee
+func NewGetPurchaseOrderFeeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-fees/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePurchaseOrderFeeRequest calls the generic UpdatePurchaseOrderFee builder with application/json body
+func NewUpdatePurchaseOrderFeeRequest(server string, uuid UuidPath, body UpdatePurchaseOrderFeeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePurchaseOrderFeeRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdatePurchaseOrderFeeRequestWithBody generates requests for UpdatePurchaseOrderFee with any type of body
+func NewUpdatePurchaseOrderFeeRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-fees/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPurchaseOrderLinesRequest generates requests for ListPurchaseOrderLines
+func NewListPurchaseOrderLinesRequest(server string, params *ListPurchaseOrderLinesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PurchaseOrderUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_uuid", runtime.ParamLocationQuery, *params.PurchaseOrderUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePurchaseOrderLineRequest calls the generic CreatePurchaseOrderLine builder with application/json body
+func NewCreatePurchaseOrderLineRequest(server string, body CreatePurchaseOrderLineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePurchaseOrderLineRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePurchaseOrderLineRequestWithBody generates requests for CreatePurchaseOrderLine with any type of body
+func NewCreatePurchaseOrderLineRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewBatchLookupPurchaseOrderLinesRequest calls the generic BatchLookupPurchaseOrderLines builder with application/json body
+func NewBatchLookupPurchaseOrderLinesRequest(server string, body BatchLookupPurchaseOrderLinesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchLookupPurchaseOrderLinesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchLookupPurchaseOrderLinesRequestWithBody generates requests for BatchLookupPurchaseOrderLines with any type of body
+func NewBatchLookupPurchaseOrderLinesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/batch-lookup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLookupItemSupplyRequest calls the generic LookupItemSupply builder with application/json body
+func NewLookupItemSupplyRequest(server string, body LookupItemSupplyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLookupItemSupplyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLookupItemSupplyRequestWithBody generates requests for LookupItemSupply with any type of body
+func NewLookupItemSupplyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/supply-lookup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePurchaseOrderLineRequest generates requests for DeletePurchaseOrderLine
+func NewDeletePurchaseOrderLineRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderLineRequest generates requests for GetPurchaseOrderLine
+func NewGetPurchaseOrderLineRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePurchaseOrderLineRequest calls the generic UpdatePurchaseOrderLine builder with application/json body
+func NewUpdatePurchaseOrderLineRequest(server string, uuid UuidPath, body UpdatePurchaseOrderLineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePurchaseOrderLineRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdatePurchaseOrderLineRequestWithBody generates requests for UpdatePurchaseOrderLine with any type of body
+func NewUpdatePurchaseOrderLineRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePurchaseOrderLineReturnRequest calls the generic CreatePurchaseOrderLineReturn builder with application/json body
+func NewCreatePurchaseOrderLineReturnRequest(server string, uuid UuidPath, body CreatePurchaseOrderLineReturnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePurchaseOrderLineReturnRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreatePurchaseOrderLineReturnRequestWithBody generates requests for CreatePurchaseOrderLineReturn with any type of body
+func NewCreatePurchaseOrderLineReturnRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-order-lines/%s/returns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPurchaseOrdersRequest generates requests for ListPurchaseOrders
+func NewListPurchaseOrdersRequest(server string, params *ListPurchaseOrdersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SupplierUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supplier_uuid", runtime.ParamLocationQuery, *params.SupplierUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePurchaseOrderRequest calls the generic CreatePurchaseOrder builder with application/json body
+func NewCreatePurchaseOrderRequest(server string, body CreatePurchaseOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePurchaseOrderRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePurchaseOrderRequestWithBody generates requests for CreatePurchaseOrder with any type of body
+func NewCreatePurchaseOrderRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateDraftPurchaseOrderRequest calls the generic CreateDraftPurchaseOrder builder with application/json body
+func NewCreateDraftPurchaseOrderRequest(server string, body CreateDraftPurchaseOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDraftPurchaseOrderRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateDraftPurchaseOrderRequestWithBody generates requests for CreateDraftPurchaseOrder with any type of body
+func NewCreateDraftPurchaseOrderRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/drafts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderRequest generates requests for GetPurchaseOrder
+func NewGetPurchaseOrderRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePurchaseOrderRequest calls the generic UpdatePurchaseOrder builder with application/json body
+func NewUpdatePurchaseOrderRequest(server string, uuid UuidPath, body UpdatePurchaseOrderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePurchaseOrderRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdatePurchaseOrderRequestWithBody generates requests for UpdatePurchaseOrder with any type of body
+func NewUpdatePurchaseOrderRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderLandedCostsRequest generates requests for GetPurchaseOrderLandedCosts
+func NewGetPurchaseOrderLandedCostsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/%s/landed-costs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderMatchRequest generates requests for GetPurchaseOrderMatch
+func NewGetPurchaseOrderMatchRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/%s/match", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPurchaseOrderTotalsRequest generates requests for GetPurchaseOrderTotals
+func NewGetPurchaseOrderTotalsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/purchase-orders/%s/totals", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSupplierInvoicesRequest generates requests for ListSupplierInvoices
+func NewListSupplierInvoicesRequest(server string, params *ListSupplierInvoicesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PurchaseOrderUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "purchase_order_uuid", runtime.ParamLocationQuery, *params.PurchaseOrderUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SupplierUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supplier_uuid", runtime.ParamLocationQuery, *params.SupplierUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSupplierInvoiceRequest calls the generic CreateSupplierInvoice builder with application/json body
+func NewCreateSupplierInvoiceRequest(server string, body CreateSupplierInvoiceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSupplierInvoiceRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSupplierInvoiceRequestWithBody generates requests for CreateSupplierInvoice with any type of body
+func NewCreateSupplierInvoiceRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-invoices")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSupplierInvoiceRequest generates requests for DeleteSupplierInvoice
+func NewDeleteSupplierInvoiceRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSupplierInvoiceRequest generates requests for GetSupplierInvoice
+func NewGetSupplierInvoiceRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-invoices/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSupplierItemsRequest generates requests for ListSupplierItems
+func NewListSupplierItemsRequest(server string, params *ListSupplierItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.SupplierUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "supplier_uuid", runtime.ParamLocationQuery, *params.SupplierUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.InventoryItemUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "inventory_item_uuid", runtime.ParamLocationQuery, *params.InventoryItemUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSupplierItemRequest calls the generic CreateSupplierItem builder with application/json body
+func NewCreateSupplierItemRequest(server string, body CreateSupplierItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSupplierItemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSupplierItemRequestWithBody generates requests for CreateSupplierItem with any type of body
+func NewCreateSupplierItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSupplierItemRequest generates requests for DeleteSupplierItem
+func NewDeleteSupplierItemRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSupplierItemRequest generates requests for GetSupplierItem
+func NewGetSupplierItemRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSupplierItemRequest calls the generic UpdateSupplierItem builder with application/json body
+func NewUpdateSupplierItemRequest(server string, uuid UuidPath, body UpdateSupplierItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSupplierItemRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateSupplierItemRequestWithBody generates requests for UpdateSupplierItem with any type of body
+func NewUpdateSupplierItemRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSupplierItemPricesRequest generates requests for ListSupplierItemPrices
+func NewListSupplierItemPricesRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items/%s/prices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSupplierItemPriceRequest calls the generic CreateSupplierItemPrice builder with application/json body
+func NewCreateSupplierItemPriceRequest(server string, uuid UuidPath, body CreateSupplierItemPriceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSupplierItemPriceRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreateSupplierItemPriceRequestWithBody generates requests for CreateSupplierItemPrice with any type of body
+func NewCreateSupplierItemPriceRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/supplier-items/%s/prices", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListSuppliersRequest generates requests for ListSuppliers
+func NewListSuppliersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/suppliers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSupplierRequest calls the generic CreateSupplier builder with application/json body
+func NewCreateSupplierRequest(server string, body CreateSupplierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSupplierRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSupplierRequestWithBody generates requests for CreateSupplier with any type of body
+func NewCreateSupplierRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/suppliers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportSuppliersRequestWithBody generates requests for ImportSuppliers with any type of body
+func NewImportSuppliersRequestWithBody(server string, params *ImportSuppliersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/suppliers/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSupplierRequest generates requests for GetSupplier
+func NewGetSupplierRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/suppliers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSupplierRequest calls the generic UpdateSupplier builder with application/json body
+func NewUpdateSupplierRequest(server string, uuid UuidPath, body UpdateSupplierJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSupplierRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateSupplierRequestWithBody generates requests for UpdateSupplier with any type of body
+func NewUpdateSupplierRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/suppliers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAccountingAccountsWithResponse request
+	ListAccountingAccountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccountingAccountsHTTPResponse, error)
+
+	// UpdateAccountingAccountsWithBodyWithResponse request with any body
+	UpdateAccountingAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountingAccountsHTTPResponse, error)
+
+	UpdateAccountingAccountsWithResponse(ctx context.Context, body UpdateAccountingAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountingAccountsHTTPResponse, error)
+
+	// GetPurchaseJournalWithResponse request
+	GetPurchaseJournalWithResponse(ctx context.Context, params *GetPurchaseJournalParams, reqEditors ...RequestEditorFn) (*GetPurchaseJournalHTTPResponse, error)
+
+	// ListPurchaseJournalExportsWithResponse request
+	ListPurchaseJournalExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPurchaseJournalExportsHTTPResponse, error)
+
+	// ExportPurchaseJournalWithBodyWithResponse request with any body
+	ExportPurchaseJournalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportPurchaseJournalHTTPResponse, error)
+
+	ExportPurchaseJournalWithResponse(ctx context.Context, body ExportPurchaseJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportPurchaseJournalHTTPResponse, error)
+
+	// GetPurchaseJournalExportFileWithResponse request
+	GetPurchaseJournalExportFileWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseJournalExportFileHTTPResponse, error)
+
+	// GetCurrencySettingsWithResponse request
+	GetCurrencySettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrencySettingsHTTPResponse, error)
+
+	// UpdateCurrencySettingsWithBodyWithResponse request with any body
+	UpdateCurrencySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrencySettingsHTTPResponse, error)
+
+	UpdateCurrencySettingsWithResponse(ctx context.Context, body UpdateCurrencySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrencySettingsHTTPResponse, error)
+
+	// ListExchangeRatesWithResponse request
+	ListExchangeRatesWithResponse(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*ListExchangeRatesHTTPResponse, error)
+
+	// CreateExchangeRateWithBodyWithResponse request with any body
+	CreateExchangeRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExchangeRateHTTPResponse, error)
+
+	CreateExchangeRateWithResponse(ctx context.Context, body CreateExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExchangeRateHTTPResponse, error)
+
+	// ImportExchangeRatesWithBodyWithResponse request with any body
+	ImportExchangeRatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportExchangeRatesHTTPResponse, error)
+
+	// DeleteExchangeRateWithResponse request
+	DeleteExchangeRateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteExchangeRateHTTPResponse, error)
+
+	// GetInvoiceMatchSettingsWithResponse request
+	GetInvoiceMatchSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceMatchSettingsHTTPResponse, error)
+
+	// UpdateInvoiceMatchSettingsWithBodyWithResponse request with any body
+	UpdateInvoiceMatchSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceMatchSettingsHTTPResponse, error)
+
+	UpdateInvoiceMatchSettingsWithResponse(ctx context.Context, body UpdateInvoiceMatchSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceMatchSettingsHTTPResponse, error)
+
+	// ListPriceTrendsWithResponse request
+	ListPriceTrendsWithResponse(ctx context.Context, params *ListPriceTrendsParams, reqEditors ...RequestEditorFn) (*ListPriceTrendsHTTPResponse, error)
+
+	// ListPurchaseOrderFeesWithResponse request
+	ListPurchaseOrderFeesWithResponse(ctx context.Context, params *ListPurchaseOrderFeesParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrderFeesHTTPResponse, error)
+
+	// CreatePurchaseOrderFeeWithBodyWithResponse request with any body
+	CreatePurchaseOrderFeeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderFeeHTTPResponse, error)
+
+	CreatePurchaseOrderFeeWithResponse(ctx context.Context, body CreatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderFeeHTTPResponse, error)
+
+	// DeletePurchaseOrderFeeWithResponse request
+	DeletePurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePurchaseOrderFeeHTTPResponse, error)
+
+	// GetPurchaseOrderFeeWithResponse request
+	GetPurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderFeeHTTPResponse, error)
+
+	// UpdatePurchaseOrderFeeWithBodyWithResponse request with any body
+	UpdatePurchaseOrderFeeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderFeeHTTPResponse, error)
+
+	UpdatePurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderFeeHTTPResponse, error)
+
+	// ListPurchaseOrderLinesWithResponse request
+	ListPurchaseOrderLinesWithResponse(ctx context.Context, params *ListPurchaseOrderLinesParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrderLinesHTTPResponse, error)
+
+	// CreatePurchaseOrderLineWithBodyWithResponse request with any body
+	CreatePurchaseOrderLineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineHTTPResponse, error)
+
+	CreatePurchaseOrderLineWithResponse(ctx context.Context, body CreatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineHTTPResponse, error)
+
+	// BatchLookupPurchaseOrderLinesWithBodyWithResponse request with any body
+	BatchLookupPurchaseOrderLinesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchLookupPurchaseOrderLinesHTTPResponse, error)
+
+	BatchLookupPurchaseOrderLinesWithResponse(ctx context.Context, body BatchLookupPurchaseOrderLinesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchLookupPurchaseOrderLinesHTTPResponse, error)
+
+	// LookupItemSupplyWithBodyWithResponse request with any body
+	LookupItemSupplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LookupItemSupplyHTTPResponse, error)
+
+	LookupItemSupplyWithResponse(ctx context.Context, body LookupItemSupplyJSONRequestBody, reqEditors ...RequestEditorFn) (*LookupItemSupplyHTTPResponse, error)
+
+	// DeletePurchaseOrderLineWithResponse request
+	DeletePurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePurchaseOrderLineHTTPResponse, error)
+
+	// GetPurchaseOrderLineWithResponse request
+	GetPurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderLineHTTPResponse, error)
+
+	// UpdatePurchaseOrderLineWithBodyWithResponse request with any body
+	UpdatePurchaseOrderLineWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderLineHTTPResponse, error)
+
+	UpdatePurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderLineHTTPResponse, error)
+
+	// CreatePurchaseOrderLineReturnWithBodyWithResponse request with any body
+	CreatePurchaseOrderLineReturnWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineReturnHTTPResponse, error)
+
+	CreatePurchaseOrderLineReturnWithResponse(ctx context.Context, uuid UuidPath, body CreatePurchaseOrderLineReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineReturnHTTPResponse, error)
+
+	// ListPurchaseOrdersWithResponse request
+	ListPurchaseOrdersWithResponse(ctx context.Context, params *ListPurchaseOrdersParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrdersHTTPResponse, error)
+
+	// CreatePurchaseOrderWithBodyWithResponse request with any body
+	CreatePurchaseOrderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderHTTPResponse, error)
+
+	CreatePurchaseOrderWithResponse(ctx context.Context, body CreatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderHTTPResponse, error)
+
+	// CreateDraftPurchaseOrderWithBodyWithResponse request with any body
+	CreateDraftPurchaseOrderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDraftPurchaseOrderHTTPResponse, error)
+
+	CreateDraftPurchaseOrderWithResponse(ctx context.Context, body CreateDraftPurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDraftPurchaseOrderHTTPResponse, error)
+
+	// GetPurchaseOrderWithResponse request
+	GetPurchaseOrderWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderHTTPResponse, error)
+
+	// UpdatePurchaseOrderWithBodyWithResponse request with any body
+	UpdatePurchaseOrderWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderHTTPResponse, error)
+
+	UpdatePurchaseOrderWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderHTTPResponse, error)
+
+	// GetPurchaseOrderLandedCostsWithResponse request
+	GetPurchaseOrderLandedCostsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderLandedCostsHTTPResponse, error)
+
+	// GetPurchaseOrderMatchWithResponse request
+	GetPurchaseOrderMatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderMatchHTTPResponse, error)
+
+	// GetPurchaseOrderTotalsWithResponse request
+	GetPurchaseOrderTotalsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderTotalsHTTPResponse, error)
+
+	// ListSupplierInvoicesWithResponse request
+	ListSupplierInvoicesWithResponse(ctx context.Context, params *ListSupplierInvoicesParams, reqEditors ...RequestEditorFn) (*ListSupplierInvoicesHTTPResponse, error)
+
+	// CreateSupplierInvoiceWithBodyWithResponse request with any body
+	CreateSupplierInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierInvoiceHTTPResponse, error)
+
+	CreateSupplierInvoiceWithResponse(ctx context.Context, body CreateSupplierInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierInvoiceHTTPResponse, error)
+
+	// DeleteSupplierInvoiceWithResponse request
+	DeleteSupplierInvoiceWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteSupplierInvoiceHTTPResponse, error)
+
+	// GetSupplierInvoiceWithResponse request
+	GetSupplierInvoiceWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierInvoiceHTTPResponse, error)
+
+	// ListSupplierItemsWithResponse request
+	ListSupplierItemsWithResponse(ctx context.Context, params *ListSupplierItemsParams, reqEditors ...RequestEditorFn) (*ListSupplierItemsHTTPResponse, error)
+
+	// CreateSupplierItemWithBodyWithResponse request with any body
+	CreateSupplierItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierItemHTTPResponse, error)
+
+	CreateSupplierItemWithResponse(ctx context.Context, body CreateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierItemHTTPResponse, error)
+
+	// DeleteSupplierItemWithResponse request
+	DeleteSupplierItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteSupplierItemHTTPResponse, error)
+
+	// GetSupplierItemWithResponse request
+	GetSupplierItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierItemHTTPResponse, error)
+
+	// UpdateSupplierItemWithBodyWithResponse request with any body
+	UpdateSupplierItemWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierItemHTTPResponse, error)
+
+	UpdateSupplierItemWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierItemHTTPResponse, error)
+
+	// ListSupplierItemPricesWithResponse request
+	ListSupplierItemPricesWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListSupplierItemPricesHTTPResponse, error)
+
+	// CreateSupplierItemPriceWithBodyWithResponse request with any body
+	CreateSupplierItemPriceWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierItemPriceHTTPResponse, error)
+
+	CreateSupplierItemPriceWithResponse(ctx context.Context, uuid UuidPath, body CreateSupplierItemPriceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierItemPriceHTTPResponse, error)
+
+	// ListSuppliersWithResponse request
+	ListSuppliersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSuppliersHTTPResponse, error)
+
+	// CreateSupplierWithBodyWithResponse request with any body
+	CreateSupplierWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierHTTPResponse, error)
+
+	CreateSupplierWithResponse(ctx context.Context, body CreateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierHTTPResponse, error)
+
+	// ImportSuppliersWithBodyWithResponse request with any body
+	ImportSuppliersWithBodyWithResponse(ctx context.Context, params *ImportSuppliersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSuppliersHTTPResponse, error)
+
+	// GetSupplierWithResponse request
+	GetSupplierWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierHTTPResponse, error)
+
+	// UpdateSupplierWithBodyWithResponse request with any body
+	UpdateSupplierWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierHTTPResponse, error)
+
+	UpdateSupplierWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierHTTPResponse, error)
+}
+
+type ListAccountingAccountsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Mapping
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAccountingAccountsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAccountingAccountsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAccountingAccountsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Mapping
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAccountingAccountsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAccountingAccountsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseJournalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Journal
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseJournalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseJournalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPurchaseJournalExportsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]JournalExportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPurchaseJournalExportsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPurchaseJournalExportsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportPurchaseJournalHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *JournalExportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportPurchaseJournalHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportPurchaseJournalHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseJournalExportFileHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseJournalExportFileHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseJournalExportFileHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCurrencySettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CurrencySettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCurrencySettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCurrencySettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCurrencySettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CurrencySettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCurrencySettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCurrencySettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListExchangeRatesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ExchangeRateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListExchangeRatesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListExchangeRatesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateExchangeRateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ExchangeRateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateExchangeRateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateExchangeRateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportExchangeRatesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExchangeRateImportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportExchangeRatesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportExchangeRatesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteExchangeRateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteExchangeRateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteExchangeRateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInvoiceMatchSettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceMatchSettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInvoiceMatchSettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInvoiceMatchSettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateInvoiceMatchSettingsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InvoiceMatchSettingsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateInvoiceMatchSettingsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateInvoiceMatchSettingsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPriceTrendsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PriceTrendResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPriceTrendsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPriceTrendsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPurchaseOrderFeesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PurchaseOrderFeeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPurchaseOrderFeesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPurchaseOrderFeesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePurchaseOrderFeeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PurchaseOrderFeeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePurchaseOrderFeeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePurchaseOrderFeeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePurchaseOrderFeeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderFeeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePurchaseOrderFeeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePurchaseOrderFeeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderFeeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderFeeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderFeeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderFeeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePurchaseOrderFeeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderFeeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePurchaseOrderFeeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePurchaseOrderFeeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPurchaseOrderLinesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPurchaseOrderLinesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPurchaseOrderLinesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePurchaseOrderLineHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePurchaseOrderLineHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePurchaseOrderLineHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchLookupPurchaseOrderLinesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]LandedPurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchLookupPurchaseOrderLinesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchLookupPurchaseOrderLinesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LookupItemSupplyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ItemSupplyLookupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LookupItemSupplyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LookupItemSupplyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePurchaseOrderLineHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePurchaseOrderLineHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePurchaseOrderLineHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderLineHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderLineHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderLineHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePurchaseOrderLineHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePurchaseOrderLineHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePurchaseOrderLineHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePurchaseOrderLineReturnHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderLineResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePurchaseOrderLineReturnHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePurchaseOrderLineReturnHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPurchaseOrdersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PurchaseOrderResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPurchaseOrdersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPurchaseOrdersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePurchaseOrderHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PurchaseOrderResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePurchaseOrderHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePurchaseOrderHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDraftPurchaseOrderHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *DraftPurchaseOrderResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDraftPurchaseOrderHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDraftPurchaseOrderHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePurchaseOrderHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePurchaseOrderHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePurchaseOrderHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderLandedCostsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderLandedCostsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderLandedCostsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderLandedCostsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderMatchHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderMatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderMatchHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderMatchHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPurchaseOrderTotalsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PurchaseOrderTotalsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPurchaseOrderTotalsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPurchaseOrderTotalsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSupplierInvoicesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SupplierInvoiceResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSupplierInvoicesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSupplierInvoicesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSupplierInvoiceHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SupplierInvoiceResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSupplierInvoiceHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSupplierInvoiceHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSupplierInvoiceHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSupplierInvoiceHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSupplierInvoiceHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSupplierInvoiceHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierInvoiceResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplierInvoiceHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplierInvoiceHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSupplierItemsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SupplierItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSupplierItemsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSupplierItemsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSupplierItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SupplierItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSupplierItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSupplierItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSupplierItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSupplierItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSupplierItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSupplierItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplierItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplierItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSupplierItemHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierItemResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSupplierItemHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSupplierItemHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSupplierItemPricesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SupplierItemPriceResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSupplierItemPricesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSupplierItemPricesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSupplierItemPriceHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SupplierItemPriceResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSupplierItemPriceHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSupplierItemPriceHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSuppliersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SupplierResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSuppliersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSuppliersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSupplierHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SupplierResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSupplierHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSupplierHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportSuppliersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Result
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportSuppliersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportSuppliersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSupplierHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSupplierHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSupplierHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSupplierHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SupplierResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSupplierHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSupplierHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAccountingAccountsWithResponse request returning *ListAccountingAccountsHTTPResponse
+func (c *ClientWithResponses) ListAccountingAccountsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccountingAccountsHTTPResponse, error) {
+	rsp, err := c.ListAccountingAccounts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAccountingAccountsHTTPResponse(rsp)
+}
+
+// UpdateAccountingAccountsWithBodyWithResponse request with arbitrary body returning *UpdateAccountingAccountsHTTPResponse
+func (c *ClientWithResponses) UpdateAccountingAccountsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAccountingAccountsHTTPResponse, error) {
+	rsp, err := c.UpdateAccountingAccountsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountingAccountsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAccountingAccountsWithResponse(ctx context.Context, body UpdateAccountingAccountsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAccountingAccountsHTTPResponse, error) {
+	rsp, err := c.UpdateAccountingAccounts(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAccountingAccountsHTTPResponse(rsp)
+}
+
+// GetPurchaseJournalWithResponse request returning *GetPurchaseJournalHTTPResponse
+func (c *ClientWithResponses) GetPurchaseJournalWithResponse(ctx context.Context, params *GetPurchaseJournalParams, reqEditors ...RequestEditorFn) (*GetPurchaseJournalHTTPResponse, error) {
+	rsp, err := c.GetPurchaseJournal(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseJournalHTTPResponse(rsp)
+}
+
+// ListPurchaseJournalExportsWithResponse request returning *ListPurchaseJournalExportsHTTPResponse
+func (c *ClientWithResponses) ListPurchaseJournalExportsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPurchaseJournalExportsHTTPResponse, error) {
+	rsp, err := c.ListPurchaseJournalExports(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPurchaseJournalExportsHTTPResponse(rsp)
+}
+
+// ExportPurchaseJournalWithBodyWithResponse request with arbitrary body returning *ExportPurchaseJournalHTTPResponse
+func (c *ClientWithResponses) ExportPurchaseJournalWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExportPurchaseJournalHTTPResponse, error) {
+	rsp, err := c.ExportPurchaseJournalWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPurchaseJournalHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExportPurchaseJournalWithResponse(ctx context.Context, body ExportPurchaseJournalJSONRequestBody, reqEditors ...RequestEditorFn) (*ExportPurchaseJournalHTTPResponse, error) {
+	rsp, err := c.ExportPurchaseJournal(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportPurchaseJournalHTTPResponse(rsp)
+}
+
+// GetPurchaseJournalExportFileWithResponse request returning *GetPurchaseJournalExportFileHTTPResponse
+func (c *ClientWithResponses) GetPurchaseJournalExportFileWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseJournalExportFileHTTPResponse, error) {
+	rsp, err := c.GetPurchaseJournalExportFile(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseJournalExportFileHTTPResponse(rsp)
+}
+
+// GetCurrencySettingsWithResponse request returning *GetCurrencySettingsHTTPResponse
+func (c *ClientWithResponses) GetCurrencySettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetCurrencySettingsHTTPResponse, error) {
+	rsp, err := c.GetCurrencySettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCurrencySettingsHTTPResponse(rsp)
+}
+
+// UpdateCurrencySettingsWithBodyWithResponse request with arbitrary body returning *UpdateCurrencySettingsHTTPResponse
+func (c *ClientWithResponses) UpdateCurrencySettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCurrencySettingsHTTPResponse, error) {
+	rsp, err := c.UpdateCurrencySettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrencySettingsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCurrencySettingsWithResponse(ctx context.Context, body UpdateCurrencySettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCurrencySettingsHTTPResponse, error) {
+	rsp, err := c.UpdateCurrencySettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCurrencySettingsHTTPResponse(rsp)
+}
+
+// ListExchangeRatesWithResponse request returning *ListExchangeRatesHTTPResponse
+func (c *ClientWithResponses) ListExchangeRatesWithResponse(ctx context.Context, params *ListExchangeRatesParams, reqEditors ...RequestEditorFn) (*ListExchangeRatesHTTPResponse, error) {
+	rsp, err := c.ListExchangeRates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListExchangeRatesHTTPResponse(rsp)
+}
+
+// CreateExchangeRateWithBodyWithResponse request with arbitrary body returning *CreateExchangeRateHTTPResponse
+func (c *ClientWithResponses) CreateExchangeRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateExchangeRateHTTPResponse, error) {
+	rsp, err := c.CreateExchangeRateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExchangeRateHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateExchangeRateWithResponse(ctx context.Context, body CreateExchangeRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateExchangeRateHTTPResponse, error) {
+	rsp, err := c.CreateExchangeRate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateExchangeRateHTTPResponse(rsp)
+}
+
+// ImportExchangeRatesWithBodyWithResponse request with arbitrary body returning *ImportExchangeRatesHTTPResponse
+func (c *ClientWithResponses) ImportExchangeRatesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportExchangeRatesHTTPResponse, error) {
+	rsp, err := c.ImportExchangeRatesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportExchangeRatesHTTPResponse(rsp)
+}
+
+// DeleteExchangeRateWithResponse request returning *DeleteExchangeRateHTTPResponse
+func (c *ClientWithResponses) DeleteExchangeRateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteExchangeRateHTTPResponse, error) {
+	rsp, err := c.DeleteExchangeRate(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteExchangeRateHTTPResponse(rsp)
+}
+
+// GetInvoiceMatchSettingsWithResponse request returning *GetInvoiceMatchSettingsHTTPResponse
+func (c *ClientWithResponses) GetInvoiceMatchSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetInvoiceMatchSettingsHTTPResponse, error) {
+	rsp, err := c.GetInvoiceMatchSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInvoiceMatchSettingsHTTPResponse(rsp)
+}
+
+// UpdateInvoiceMatchSettingsWithBodyWithResponse request with arbitrary body returning *UpdateInvoiceMatchSettingsHTTPResponse
+func (c *ClientWithResponses) UpdateInvoiceMatchSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateInvoiceMatchSettingsHTTPResponse, error) {
+	rsp, err := c.UpdateInvoiceMatchSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceMatchSettingsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateInvoiceMatchSettingsWithResponse(ctx context.Context, body UpdateInvoiceMatchSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateInvoiceMatchSettingsHTTPResponse, error) {
+	rsp, err := c.UpdateInvoiceMatchSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateInvoiceMatchSettingsHTTPResponse(rsp)
+}
+
+// ListPriceTrendsWithResponse request returning *ListPriceTrendsHTTPResponse
+func (c *ClientWithResponses) ListPriceTrendsWithResponse(ctx context.Context, params *ListPriceTrendsParams, reqEditors ...RequestEditorFn) (*ListPriceTrendsHTTPResponse, error) {
+	rsp, err := c.ListPriceTrends(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPriceTrendsHTTPResponse(rsp)
+}
+
+// ListPurchaseOrderFeesWithResponse request returning *ListPurchaseOrderFeesHTTPResponse
+func (c *ClientWithResponses) ListPurchaseOrderFeesWithResponse(ctx context.Context, params *ListPurchaseOrderFeesParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrderFeesHTTPResponse, error) {
+	rsp, err := c.ListPurchaseOrderFees(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPurchaseOrderFeesHTTPResponse(rsp)
+}
+
+// CreatePurchaseOrderFeeWithBodyWithResponse request with arbitrary body returning *CreatePurchaseOrderFeeHTTPResponse
+func (c *ClientWithResponses) CreatePurchaseOrderFeeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderFeeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderFeeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePurchaseOrderFeeWithResponse(ctx context.Context, body CreatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderFee(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderFeeHTTPResponse(rsp)
+}
+
+// DeletePurchaseOrderFeeWithResponse request returning *DeletePurchaseOrderFeeHTTPResponse
+func (c *ClientWithResponses) DeletePurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.DeletePurchaseOrderFee(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePurchaseOrderFeeHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderFeeWithResponse request returning *GetPurchaseOrderFeeHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrderFee(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderFeeHTTPResponse(rsp)
+}
+
+// UpdatePurchaseOrderFeeWithBodyWithResponse request with arbitrary body returning *UpdatePurchaseOrderFeeHTTPResponse
+func (c *ClientWithResponses) UpdatePurchaseOrderFeeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrderFeeWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderFeeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePurchaseOrderFeeWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderFeeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderFeeHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrderFee(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderFeeHTTPResponse(rsp)
+}
+
+// ListPurchaseOrderLinesWithResponse request returning *ListPurchaseOrderLinesHTTPResponse
+func (c *ClientWithResponses) ListPurchaseOrderLinesWithResponse(ctx context.Context, params *ListPurchaseOrderLinesParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrderLinesHTTPResponse, error) {
+	rsp, err := c.ListPurchaseOrderLines(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPurchaseOrderLinesHTTPResponse(rsp)
+}
+
+// CreatePurchaseOrderLineWithBodyWithResponse request with arbitrary body returning *CreatePurchaseOrderLineHTTPResponse
+func (c *ClientWithResponses) CreatePurchaseOrderLineWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderLineWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderLineHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePurchaseOrderLineWithResponse(ctx context.Context, body CreatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderLine(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderLineHTTPResponse(rsp)
+}
+
+// BatchLookupPurchaseOrderLinesWithBodyWithResponse request with arbitrary body returning *BatchLookupPurchaseOrderLinesHTTPResponse
+func (c *ClientWithResponses) BatchLookupPurchaseOrderLinesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchLookupPurchaseOrderLinesHTTPResponse, error) {
+	rsp, err := c.BatchLookupPurchaseOrderLinesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchLookupPurchaseOrderLinesHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) BatchLookupPurchaseOrderLinesWithResponse(ctx context.Context, body BatchLookupPurchaseOrderLinesJSONRequestBody, reqEditors ...RequestEditorFn) (*BatchLookupPurchaseOrderLinesHTTPResponse, error) {
+	rsp, err := c.BatchLookupPurchaseOrderLines(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchLookupPurchaseOrderLinesHTTPResponse(rsp)
+}
+
+// LookupItemSupplyWithBodyWithResponse request with arbitrary body returning *LookupItemSupplyHTTPResponse
+func (c *ClientWithResponses) LookupItemSupplyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LookupItemSupplyHTTPResponse, error) {
+	rsp, err := c.LookupItemSupplyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupItemSupplyHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) LookupItemSupplyWithResponse(ctx context.Context, body LookupItemSupplyJSONRequestBody, reqEditors ...RequestEditorFn) (*LookupItemSupplyHTTPResponse, error) {
+	rsp, err := c.LookupItemSupply(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLookupItemSupplyHTTPResponse(rsp)
+}
+
+// DeletePurchaseOrderLineWithResponse request returning *DeletePurchaseOrderLineHTTPResponse
+func (c *ClientWithResponses) DeletePurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.DeletePurchaseOrderLine(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePurchaseOrderLineHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderLineWithResponse request returning *GetPurchaseOrderLineHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrderLine(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderLineHTTPResponse(rsp)
+}
+
+// UpdatePurchaseOrderLineWithBodyWithResponse request with arbitrary body returning *UpdatePurchaseOrderLineHTTPResponse
+func (c *ClientWithResponses) UpdatePurchaseOrderLineWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrderLineWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderLineHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePurchaseOrderLineWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderLineJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderLineHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrderLine(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderLineHTTPResponse(rsp)
+}
+
+// CreatePurchaseOrderLineReturnWithBodyWithResponse request with arbitrary body returning *CreatePurchaseOrderLineReturnHTTPResponse
+func (c *ClientWithResponses) CreatePurchaseOrderLineReturnWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineReturnHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderLineReturnWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderLineReturnHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePurchaseOrderLineReturnWithResponse(ctx context.Context, uuid UuidPath, body CreatePurchaseOrderLineReturnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderLineReturnHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderLineReturn(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderLineReturnHTTPResponse(rsp)
+}
+
+// ListPurchaseOrdersWithResponse request returning *ListPurchaseOrdersHTTPResponse
+func (c *ClientWithResponses) ListPurchaseOrdersWithResponse(ctx context.Context, params *ListPurchaseOrdersParams, reqEditors ...RequestEditorFn) (*ListPurchaseOrdersHTTPResponse, error) {
+	rsp, err := c.ListPurchaseOrders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPurchaseOrdersHTTPResponse(rsp)
+}
+
+// CreatePurchaseOrderWithBodyWithResponse request with arbitrary body returning *CreatePurchaseOrderHTTPResponse
+func (c *ClientWithResponses) CreatePurchaseOrderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrderWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePurchaseOrderWithResponse(ctx context.Context, body CreatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePurchaseOrderHTTPResponse, error) {
+	rsp, err := c.CreatePurchaseOrder(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePurchaseOrderHTTPResponse(rsp)
+}
+
+// CreateDraftPurchaseOrderWithBodyWithResponse request with arbitrary body returning *CreateDraftPurchaseOrderHTTPResponse
+func (c *ClientWithResponses) CreateDraftPurchaseOrderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDraftPurchaseOrderHTTPResponse, error) {
+	rsp, err := c.CreateDraftPurchaseOrderWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDraftPurchaseOrderHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDraftPurchaseOrderWithResponse(ctx context.Context, body CreateDraftPurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDraftPurchaseOrderHTTPResponse, error) {
+	rsp, err := c.CreateDraftPurchaseOrder(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDraftPurchaseOrderHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderWithResponse request returning *GetPurchaseOrderHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrder(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderHTTPResponse(rsp)
+}
+
+// UpdatePurchaseOrderWithBodyWithResponse request with arbitrary body returning *UpdatePurchaseOrderHTTPResponse
+func (c *ClientWithResponses) UpdatePurchaseOrderWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrderWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePurchaseOrderWithResponse(ctx context.Context, uuid UuidPath, body UpdatePurchaseOrderJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePurchaseOrderHTTPResponse, error) {
+	rsp, err := c.UpdatePurchaseOrder(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePurchaseOrderHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderLandedCostsWithResponse request returning *GetPurchaseOrderLandedCostsHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderLandedCostsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderLandedCostsHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrderLandedCosts(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderLandedCostsHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderMatchWithResponse request returning *GetPurchaseOrderMatchHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderMatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderMatchHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrderMatch(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderMatchHTTPResponse(rsp)
+}
+
+// GetPurchaseOrderTotalsWithResponse request returning *GetPurchaseOrderTotalsHTTPResponse
+func (c *ClientWithResponses) GetPurchaseOrderTotalsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPurchaseOrderTotalsHTTPResponse, error) {
+	rsp, err := c.GetPurchaseOrderTotals(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPurchaseOrderTotalsHTTPResponse(rsp)
+}
+
+// ListSupplierInvoicesWithResponse request returning *ListSupplierInvoicesHTTPResponse
+func (c *ClientWithResponses) ListSupplierInvoicesWithResponse(ctx context.Context, params *ListSupplierInvoicesParams, reqEditors ...RequestEditorFn) (*ListSupplierInvoicesHTTPResponse, error) {
+	rsp, err := c.ListSupplierInvoices(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSupplierInvoicesHTTPResponse(rsp)
+}
+
+// CreateSupplierInvoiceWithBodyWithResponse request with arbitrary body returning *CreateSupplierInvoiceHTTPResponse
+func (c *ClientWithResponses) CreateSupplierInvoiceWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierInvoiceHTTPResponse, error) {
+	rsp, err := c.CreateSupplierInvoiceWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierInvoiceHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSupplierInvoiceWithResponse(ctx context.Context, body CreateSupplierInvoiceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierInvoiceHTTPResponse, error) {
+	rsp, err := c.CreateSupplierInvoice(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierInvoiceHTTPResponse(rsp)
+}
+
+// DeleteSupplierInvoiceWithResponse request returning *DeleteSupplierInvoiceHTTPResponse
+func (c *ClientWithResponses) DeleteSupplierInvoiceWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteSupplierInvoiceHTTPResponse, error) {
+	rsp, err := c.DeleteSupplierInvoice(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSupplierInvoiceHTTPResponse(rsp)
+}
+
+// GetSupplierInvoiceWithResponse request returning *GetSupplierInvoiceHTTPResponse
+func (c *ClientWithResponses) GetSupplierInvoiceWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierInvoiceHTTPResponse, error) {
+	rsp, err := c.GetSupplierInvoice(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplierInvoiceHTTPResponse(rsp)
+}
+
+// ListSupplierItemsWithResponse request returning *ListSupplierItemsHTTPResponse
+func (c *ClientWithResponses) ListSupplierItemsWithResponse(ctx context.Context, params *ListSupplierItemsParams, reqEditors ...RequestEditorFn) (*ListSupplierItemsHTTPResponse, error) {
+	rsp, err := c.ListSupplierItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSupplierItemsHTTPResponse(rsp)
+}
+
+// CreateSupplierItemWithBodyWithResponse request with arbitrary body returning *CreateSupplierItemHTTPResponse
+func (c *ClientWithResponses) CreateSupplierItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierItemHTTPResponse, error) {
+	rsp, err := c.CreateSupplierItemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierItemHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSupplierItemWithResponse(ctx context.Context, body CreateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierItemHTTPResponse, error) {
+	rsp, err := c.CreateSupplierItem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierItemHTTPResponse(rsp)
+}
+
+// DeleteSupplierItemWithResponse request returning *DeleteSupplierItemHTTPResponse
+func (c *ClientWithResponses) DeleteSupplierItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteSupplierItemHTTPResponse, error) {
+	rsp, err := c.DeleteSupplierItem(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSupplierItemHTTPResponse(rsp)
+}
+
+// GetSupplierItemWithResponse request returning *GetSupplierItemHTTPResponse
+func (c *ClientWithResponses) GetSupplierItemWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierItemHTTPResponse, error) {
+	rsp, err := c.GetSupplierItem(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplierItemHTTPResponse(rsp)
+}
+
+// UpdateSupplierItemWithBodyWithResponse request with arbitrary body returning *UpdateSupplierItemHTTPResponse
+func (c *ClientWithResponses) UpdateSupplierItemWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierItemHTTPResponse, error) {
+	rsp, err := c.UpdateSupplierItemWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierItemHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSupplierItemWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierItemJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierItemHTTPResponse, error) {
+	rsp, err := c.UpdateSupplierItem(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierItemHTTPResponse(rsp)
+}
+
+// ListSupplierItemPricesWithResponse request returning *ListSupplierItemPricesHTTPResponse
+func (c *ClientWithResponses) ListSupplierItemPricesWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListSupplierItemPricesHTTPResponse, error) {
+	rsp, err := c.ListSupplierItemPrices(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSupplierItemPricesHTTPResponse(rsp)
+}
+
+// CreateSupplierItemPriceWithBodyWithResponse request with arbitrary body returning *CreateSupplierItemPriceHTTPResponse
+func (c *ClientWithResponses) CreateSupplierItemPriceWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierItemPriceHTTPResponse, error) {
+	rsp, err := c.CreateSupplierItemPriceWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierItemPriceHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSupplierItemPriceWithResponse(ctx context.Context, uuid UuidPath, body CreateSupplierItemPriceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierItemPriceHTTPResponse, error) {
+	rsp, err := c.CreateSupplierItemPrice(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierItemPriceHTTPResponse(rsp)
+}
+
+// ListSuppliersWithResponse request returning *ListSuppliersHTTPResponse
+func (c *ClientWithResponses) ListSuppliersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSuppliersHTTPResponse, error) {
+	rsp, err := c.ListSuppliers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSuppliersHTTPResponse(rsp)
+}
+
+// CreateSupplierWithBodyWithResponse request with arbitrary body returning *CreateSupplierHTTPResponse
+func (c *ClientWithResponses) CreateSupplierWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSupplierHTTPResponse, error) {
+	rsp, err := c.CreateSupplierWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSupplierWithResponse(ctx context.Context, body CreateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSupplierHTTPResponse, error) {
+	rsp, err := c.CreateSupplier(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSupplierHTTPResponse(rsp)
+}
+
+// ImportSuppliersWithBodyWithResponse request with arbitrary body returning *ImportSuppliersHTTPResponse
+func (c *ClientWithResponses) ImportSuppliersWithBodyWithResponse(ctx context.Context, params *ImportSuppliersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportSuppliersHTTPResponse, error) {
+	rsp, err := c.ImportSuppliersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportSuppliersHTTPResponse(rsp)
+}
+
+// GetSupplierWithResponse request returning *GetSupplierHTTPResponse
+func (c *ClientWithResponses) GetSupplierWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetSupplierHTTPResponse, error) {
+	rsp, err := c.GetSupplier(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSupplierHTTPResponse(rsp)
+}
+
+// UpdateSupplierWithBodyWithResponse request with arbitrary body returning *UpdateSupplierHTTPResponse
+func (c *ClientWithResponses) UpdateSupplierWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSupplierHTTPResponse, error) {
+	rsp, err := c.UpdateSupplierWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSupplierWithResponse(ctx context.Context, uuid UuidPath, body UpdateSupplierJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSupplierHTTPResponse, error) {
+	rsp, err := c.UpdateSupplier(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSupplierHTTPResponse(rsp)
+}
+
+// ParseListAccountingAccountsHTTPResponse parses an HTTP response from a ListAccountingAccountsWithResponse call
+func ParseListAccountingAccountsHTTPResponse(rsp *http.Response) (*ListAccountingAccountsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAccountingAccountsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mapping
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAccountingAccountsHTTPResponse parses an HTTP response from a UpdateAccountingAccountsWithResponse call
+func ParseUpdateAccountingAccountsHTTPResponse(rsp *http.Response) (*UpdateAccountingAccountsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAccountingAccountsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Mapping
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseJournalHTTPResponse parses an HTTP response from a GetPurchaseJournalWithResponse call
+func ParseGetPurchaseJournalHTTPResponse(rsp *http.Response) (*GetPurchaseJournalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseJournalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Journal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPurchaseJournalExportsHTTPResponse parses an HTTP response from a ListPurchaseJournalExportsWithResponse call
+func ParseListPurchaseJournalExportsHTTPResponse(rsp *http.Response) (*ListPurchaseJournalExportsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPurchaseJournalExportsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []JournalExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportPurchaseJournalHTTPResponse parses an HTTP response from a ExportPurchaseJournalWithResponse call
+func ParseExportPurchaseJournalHTTPResponse(rsp *http.Response) (*ExportPurchaseJournalHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportPurchaseJournalHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest JournalExportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseJournalExportFileHTTPResponse parses an HTTP response from a GetPurchaseJournalExportFileWithResponse call
+func ParseGetPurchaseJournalExportFileHTTPResponse(rsp *http.Response) (*GetPurchaseJournalExportFileHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseJournalExportFileHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetCurrencySettingsHTTPResponse parses an HTTP response from a GetCurrencySettingsWithResponse call
+func ParseGetCurrencySettingsHTTPResponse(rsp *http.Response) (*GetCurrencySettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCurrencySettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CurrencySettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCurrencySettingsHTTPResponse parses an HTTP response from a UpdateCurrencySettingsWithResponse call
+func ParseUpdateCurrencySettingsHTTPResponse(rsp *http.Response) (*UpdateCurrencySettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCurrencySettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CurrencySettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListExchangeRatesHTTPResponse parses an HTTP response from a ListExchangeRatesWithResponse call
+func ParseListExchangeRatesHTTPResponse(rsp *http.Response) (*ListExchangeRatesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListExchangeRatesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ExchangeRateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateExchangeRateHTTPResponse parses an HTTP response from a CreateExchangeRateWithResponse call
+func ParseCreateExchangeRateHTTPResponse(rsp *http.Response) (*CreateExchangeRateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateExchangeRateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ExchangeRateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportExchangeRatesHTTPResponse parses an HTTP response from a ImportExchangeRatesWithResponse call
+func ParseImportExchangeRatesHTTPResponse(rsp *http.Response) (*ImportExchangeRatesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportExchangeRatesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExchangeRateImportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteExchangeRateHTTPResponse parses an HTTP response from a DeleteExchangeRateWithResponse call
+func ParseDeleteExchangeRateHTTPResponse(rsp *http.Response) (*DeleteExchangeRateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteExchangeRateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetInvoiceMatchSettingsHTTPResponse parses an HTTP response from a GetInvoiceMatchSettingsWithResponse call
+func ParseGetInvoiceMatchSettingsHTTPResponse(rsp *http.Response) (*GetInvoiceMatchSettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetInvoiceMatchSettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceMatchSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateInvoiceMatchSettingsHTTPResponse parses an HTTP response from a UpdateInvoiceMatchSettingsWithResponse call
+func ParseUpdateInvoiceMatchSettingsHTTPResponse(rsp *http.Response) (*UpdateInvoiceMatchSettingsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateInvoiceMatchSettingsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InvoiceMatchSettingsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPriceTrendsHTTPResponse parses an HTTP response from a ListPriceTrendsWithResponse call
+func ParseListPriceTrendsHTTPResponse(rsp *http.Response) (*ListPriceTrendsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPriceTrendsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PriceTrendResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPurchaseOrderFeesHTTPResponse parses an HTTP response from a ListPurchaseOrderFeesWithResponse call
+func ParseListPurchaseOrderFeesHTTPResponse(rsp *http.Response) (*ListPurchaseOrderFeesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPurchaseOrderFeesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PurchaseOrderFeeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePurchaseOrderFeeHTTPResponse parses an HTTP response from a CreatePurchaseOrderFeeWithResponse call
+func ParseCreatePurchaseOrderFeeHTTPResponse(rsp *http.Response) (*CreatePurchaseOrderFeeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePurchaseOrderFeeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PurchaseOrderFeeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePurchaseOrderFeeHTTPResponse parses an HTTP response from a DeletePurchaseOrderFeeWithResponse call
+func ParseDeletePurchaseOrderFeeHTTPResponse(rsp *http.Response) (*DeletePurchaseOrderFeeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePurchaseOrderFeeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderFeeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderFeeHTTPResponse parses an HTTP response from a GetPurchaseOrderFeeWithResponse call
+func ParseGetPurchaseOrderFeeHTTPResponse(rsp *http.Response) (*GetPurchaseOrderFeeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderFeeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderFeeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePurchaseOrderFeeHTTPResponse parses an HTTP response from a UpdatePurchaseOrderFeeWithResponse call
+func ParseUpdatePurchaseOrderFeeHTTPResponse(rsp *http.Response) (*UpdatePurchaseOrderFeeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePurchaseOrderFeeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderFeeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPurchaseOrderLinesHTTPResponse parses an HTTP response from a ListPurchaseOrderLinesWithResponse call
+func ParseListPurchaseOrderLinesHTTPResponse(rsp *http.Response) (*ListPurchaseOrderLinesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPurchaseOrderLinesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePurchaseOrderLineHTTPResponse parses an HTTP response from a CreatePurchaseOrderLineWithResponse call
+func ParseCreatePurchaseOrderLineHTTPResponse(rsp *http.Response) (*CreatePurchaseOrderLineHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePurchaseOrderLineHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBatchLookupPurchaseOrderLinesHTTPResponse parses an HTTP response from a BatchLookupPurchaseOrderLinesWithResponse call
+func ParseBatchLookupPurchaseOrderLinesHTTPResponse(rsp *http.Response) (*BatchLookupPurchaseOrderLinesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BatchLookupPurchaseOrderLinesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []LandedPurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLookupItemSupplyHTTPResponse parses an HTTP response from a LookupItemSupplyWithResponse call
+func ParseLookupItemSupplyHTTPResponse(rsp *http.Response) (*LookupItemSupplyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LookupItemSupplyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ItemSupplyLookupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePurchaseOrderLineHTTPResponse parses an HTTP response from a DeletePurchaseOrderLineWithResponse call
+func ParseDeletePurchaseOrderLineHTTPResponse(rsp *http.Response) (*DeletePurchaseOrderLineHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePurchaseOrderLineHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderLineHTTPResponse parses an HTTP response from a GetPurchaseOrderLineWithResponse call
+func ParseGetPurchaseOrderLineHTTPResponse(rsp *http.Response) (*GetPurchaseOrderLineHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderLineHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePurchaseOrderLineHTTPResponse parses an HTTP response from a UpdatePurchaseOrderLineWithResponse call
+func ParseUpdatePurchaseOrderLineHTTPResponse(rsp *http.Response) (*UpdatePurchaseOrderLineHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePurchaseOrderLineHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePurchaseOrderLineReturnHTTPResponse parses an HTTP response from a CreatePurchaseOrderLineReturnWithResponse call
+func ParseCreatePurchaseOrderLineReturnHTTPResponse(rsp *http.Response) (*CreatePurchaseOrderLineReturnHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePurchaseOrderLineReturnHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderLineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPurchaseOrdersHTTPResponse parses an HTTP response from a ListPurchaseOrdersWithResponse call
+func ParseListPurchaseOrdersHTTPResponse(rsp *http.Response) (*ListPurchaseOrdersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPurchaseOrdersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PurchaseOrderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePurchaseOrderHTTPResponse parses an HTTP response from a CreatePurchaseOrderWithResponse call
+func ParseCreatePurchaseOrderHTTPResponse(rsp *http.Response) (*CreatePurchaseOrderHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePurchaseOrderHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PurchaseOrderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDraftPurchaseOrderHTTPResponse parses an HTTP response from a CreateDraftPurchaseOrderWithResponse call
+func ParseCreateDraftPurchaseOrderHTTPResponse(rsp *http.Response) (*CreateDraftPurchaseOrderHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDraftPurchaseOrderHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest DraftPurchaseOrderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderHTTPResponse parses an HTTP response from a GetPurchaseOrderWithResponse call
+func ParseGetPurchaseOrderHTTPResponse(rsp *http.Response) (*GetPurchaseOrderHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePurchaseOrderHTTPResponse parses an HTTP response from a UpdatePurchaseOrderWithResponse call
+func ParseUpdatePurchaseOrderHTTPResponse(rsp *http.Response) (*UpdatePurchaseOrderHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePurchaseOrderHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderLandedCostsHTTPResponse parses an HTTP response from a GetPurchaseOrderLandedCostsWithResponse call
+func ParseGetPurchaseOrderLandedCostsHTTPResponse(rsp *http.Response) (*GetPurchaseOrderLandedCostsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderLandedCostsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderLandedCostsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderMatchHTTPResponse parses an HTTP response from a GetPurchaseOrderMatchWithResponse call
+func ParseGetPurchaseOrderMatchHTTPResponse(rsp *http.Response) (*GetPurchaseOrderMatchHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderMatchHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderMatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPurchaseOrderTotalsHTTPResponse parses an HTTP response from a GetPurchaseOrderTotalsWithResponse call
+func ParseGetPurchaseOrderTotalsHTTPResponse(rsp *http.Response) (*GetPurchaseOrderTotalsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPurchaseOrderTotalsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PurchaseOrderTotalsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSupplierInvoicesHTTPResponse parses an HTTP response from a ListSupplierInvoicesWithResponse call
+func ParseListSupplierInvoicesHTTPResponse(rsp *http.Response) (*ListSupplierInvoicesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSupplierInvoicesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SupplierInvoiceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSupplierInvoiceHTTPResponse parses an HTTP response from a CreateSupplierInvoiceWithResponse call
+func ParseCreateSupplierInvoiceHTTPResponse(rsp *http.Response) (*CreateSupplierInvoiceHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSupplierInvoiceHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SupplierInvoiceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSupplierInvoiceHTTPResponse parses an HTTP response from a DeleteSupplierInvoiceWithResponse call
+func ParseDeleteSupplierInvoiceHTTPResponse(rsp *http.Response) (*DeleteSupplierInvoiceHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSupplierInvoiceHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetSupplierInvoiceHTTPResponse parses an HTTP response from a GetSupplierInvoiceWithResponse call
+func ParseGetSupplierInvoiceHTTPResponse(rsp *http.Response) (*GetSupplierInvoiceHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSupplierInvoiceHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierInvoiceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSupplierItemsHTTPResponse parses an HTTP response from a ListSupplierItemsWithResponse call
+func ParseListSupplierItemsHTTPResponse(rsp *http.Response) (*ListSupplierItemsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSupplierItemsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SupplierItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSupplierItemHTTPResponse parses an HTTP response from a CreateSupplierItemWithResponse call
+func ParseCreateSupplierItemHTTPResponse(rsp *http.Response) (*CreateSupplierItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSupplierItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SupplierItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteSupplierItemHTTPResponse parses an HTTP response from a DeleteSupplierItemWithResponse call
+func ParseDeleteSupplierItemHTTPResponse(rsp *http.Response) (*DeleteSupplierItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteSupplierItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetSupplierItemHTTPResponse parses an HTTP response from a GetSupplierItemWithResponse call
+func ParseGetSupplierItemHTTPResponse(rsp *http.Response) (*GetSupplierItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSupplierItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSupplierItemHTTPResponse parses an HTTP response from a UpdateSupplierItemWithResponse call
+func ParseUpdateSupplierItemHTTPResponse(rsp *http.Response) (*UpdateSupplierItemHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSupplierItemHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierItemResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSupplierItemPricesHTTPResponse parses an HTTP response from a ListSupplierItemPricesWithResponse call
+func ParseListSupplierItemPricesHTTPResponse(rsp *http.Response) (*ListSupplierItemPricesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSupplierItemPricesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SupplierItemPriceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSupplierItemPriceHTTPResponse parses an HTTP response from a CreateSupplierItemPriceWithResponse call
+func ParseCreateSupplierItemPriceHTTPResponse(rsp *http.Response) (*CreateSupplierItemPriceHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSupplierItemPriceHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SupplierItemPriceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSuppliersHTTPResponse parses an HTTP response from a ListSuppliersWithResponse call
+func ParseListSuppliersHTTPResponse(rsp *http.Response) (*ListSuppliersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSuppliersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SupplierResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateSupplierHTTPResponse parses an HTTP response from a CreateSupplierWithResponse call
+func ParseCreateSupplierHTTPResponse(rsp *http.Response) (*CreateSupplierHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateSupplierHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SupplierResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportSuppliersHTTPResponse parses an HTTP response from a ImportSuppliersWithResponse call
+func ParseImportSuppliersHTTPResponse(rsp *http.Response) (*ImportSuppliersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportSuppliersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSupplierHTTPResponse parses an HTTP response from a GetSupplierWithResponse call
+func ParseGetSupplierHTTPResponse(rsp *http.Response) (*GetSupplierHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSupplierHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateSupplierHTTPResponse parses an HTTP response from a UpdateSupplierWithResponse call
+func ParseUpdateSupplierHTTPResponse(rsp *http.Response) (*UpdateSupplierHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateSupplierHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SupplierResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,13029 @@
+// Package production provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package production
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// AdditionResponse defines model for AdditionResponse.
+type AdditionResponse struct {
+	AddedAt          time.Time  `json:"added_at"`
+	AdditionType     string     `json:"addition_type"`
+	Amount           int64      `json:"amount"`
+	AmountUnit       string     `json:"amount_unit"`
+	BatchUuid        *string    `json:"batch_uuid"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeletedAt        *time.Time `json:"deleted_at"`
+	InventoryLotUuid *string    `json:"inventory_lot_uuid"`
+	Notes            *string    `json:"notes"`
+	OccupancyUuid    *string    `json:"occupancy_uuid"`
+	Stage            *string    `json:"stage"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	Uuid             string     `json:"uuid"`
+	VolumeUuid       *string    `json:"volume_uuid"`
+}
+
+// AllocationLine defines model for AllocationLine.
+type AllocationLine struct {
+	AllocatedAmount      int64            `json:"allocated_amount"`
+	AmountUnit           string           `json:"amount_unit"`
+	IngredientUuid       *string          `json:"ingredient_uuid"`
+	Name                 string           `json:"name"`
+	Picks                []AllocationPick `json:"picks"`
+	Reason               *string          `json:"reason"`
+	RecipeIngredientUuid string           `json:"recipe_ingredient_uuid"`
+	RequiredAmount       int64            `json:"required_amount"`
+	ShortfallAmount      int64            `json:"shortfall_amount"`
+}
+
+// AllocationPick defines model for AllocationPick.
+type AllocationPick struct {
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	AvailableAmount   int64      `json:"available_amount"`
+	BestByAt          *time.Time `json:"best_by_at"`
+	BreweryLotCode    *string    `json:"brewery_lot_code"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	IngredientLotUuid string     `json:"ingredient_lot_uuid"`
+	ReceivedAt        time.Time  `json:"received_at"`
+	StockLocationName string     `json:"stock_location_name"`
+	StockLocationUuid string     `json:"stock_location_uuid"`
+}
+
+// AllocationPickInput defines model for AllocationPickInput.
+type AllocationPickInput struct {
+	Amount            *int64  `json:"amount"`
+	AmountUnit        *string `json:"amount_unit"`
+	IngredientLotUuid *string `json:"ingredient_lot_uuid"`
+	StockLocationUuid *string `json:"stock_location_uuid"`
+}
+
+// Batch defines model for Batch.
+type Batch struct {
+	BrewDate  *time.Time `json:"brew_date"`
+	Notes     *string    `json:"notes"`
+	Recipe    *string    `json:"recipe"`
+	Ref       string     `json:"ref"`
+	ShortName string     `json:"short_name"`
+	Skip      *bool      `json:"skip,omitempty"`
+}
+
+// BatchAllocationRequest defines model for BatchAllocationRequest.
+type BatchAllocationRequest struct {
+	RecipeUuid *string  `json:"recipe_uuid"`
+	Scale      *float64 `json:"scale"`
+}
+
+// BatchAllocationResponse defines model for BatchAllocationResponse.
+type BatchAllocationResponse struct {
+	BatchUuid  string                `json:"batch_uuid"`
+	Complete   bool                  `json:"complete"`
+	Lines      []AllocationLine      `json:"lines"`
+	Picks      []AllocationPickInput `json:"picks"`
+	RecipeUuid string                `json:"recipe_uuid"`
+	Scale      float64               `json:"scale"`
+}
+
+// BatchCostsResponse defines model for BatchCostsResponse.
+type BatchCostsResponse struct {
+	BaseCurrency       *string                 `json:"base_currency"`
+	BatchUuid          string                  `json:"batch_uuid"`
+	Currency           *string                 `json:"currency"`
+	FullCost           FullCostTotals          `json:"full_cost"`
+	Labor              []LaborCost             `json:"labor"`
+	LineItems          []CostLineItem          `json:"line_items"`
+	LossWriteOffs      []LossWriteOff          `json:"loss_write_offs"`
+	Overhead           []OverheadCost          `json:"overhead"`
+	PackageFormats     []PackageFormatCost     `json:"package_formats"`
+	PackagingMaterials []PackagingMaterialCost `json:"packaging_materials"`
+	SnapshotAt         *time.Time              `json:"snapshot_at"`
+	Totals             CostTotals              `json:"totals"`
+	UncostedAdditions  []UncostedAddition      `json:"uncosted_additions"`
+}
+
+// BatchImportResponse defines model for BatchImportResponse.
+type BatchImportResponse struct {
+	DryRun  bool                   `json:"dry_run"`
+	Results []BatchImportRowResult `json:"results"`
+	Totals  BatchImportTotals      `json:"totals"`
+}
+
+// BatchImportRowResult defines model for BatchImportRowResult.
+type BatchImportRowResult struct {
+	Batch  *BatchResponse `json:"batch"`
+	Error  *string        `json:"error"`
+	Row    int            `json:"row"`
+	Status string         `json:"status"`
+}
+
+// BatchImportTotals defines model for BatchImportTotals.
+type BatchImportTotals struct {
+	Created   int `json:"created"`
+	Failed    int `json:"failed"`
+	TotalRows int `json:"total_rows"`
+	Valid     int `json:"valid"`
+}
+
+// BatchInput defines model for BatchInput.
+type BatchInput struct {
+	BrewDate  *time.Time `json:"brew_date"`
+	Notes     *string    `json:"notes"`
+	Recipe    *string    `json:"recipe"`
+	Ref       *string    `json:"ref"`
+	ShortName *string    `json:"short_name"`
+	Skip      *bool      `json:"skip"`
+}
+
+// BatchLaborEntryResponse defines model for BatchLaborEntryResponse.
+type BatchLaborEntryResponse struct {
+	BatchUuid       string     `json:"batch_uuid"`
+	CostCents       int64      `json:"cost_cents"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DeletedAt       *time.Time `json:"deleted_at"`
+	HourlyRateCents int64      `json:"hourly_rate_cents"`
+	Hours           float64    `json:"hours"`
+	Notes           *string    `json:"notes"`
+	Role            string     `json:"role"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	Uuid            string     `json:"uuid"`
+	WorkedAt        time.Time  `json:"worked_at"`
+}
+
+// BatchProcessPhaseResponse defines model for BatchProcessPhaseResponse.
+type BatchProcessPhaseResponse struct {
+	BatchUuid    string     `json:"batch_uuid"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	PhaseAt      time.Time  `json:"phase_at"`
+	ProcessPhase string     `json:"process_phase"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Uuid         string     `json:"uuid"`
+}
+
+// BatchRelationResponse defines model for BatchRelationResponse.
+type BatchRelationResponse struct {
+	ChildBatchUuid  string     `json:"child_batch_uuid"`
+	CreatedAt       time.Time  `json:"created_at"`
+	DeletedAt       *time.Time `json:"deleted_at"`
+	ParentBatchUuid string     `json:"parent_batch_uuid"`
+	RelationType    string     `json:"relation_type"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	Uuid            string     `json:"uuid"`
+	VolumeUuid      *string    `json:"volume_uuid"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	BrewDate          *time.Time `json:"brew_date"`
+	CreatedAt         time.Time  `json:"created_at"`
+	CurrentPhase      *string    `json:"current_phase"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	Notes             *string    `json:"notes"`
+	PlannedVolume     *float32   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
+	RecipeName        *string    `json:"recipe_name"`
+	RecipeUuid        *string    `json:"recipe_uuid"`
+	ShortName         string     `json:"short_name"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// BatchSummaryResponse defines model for BatchSummaryResponse.
+type BatchSummaryResponse struct {
+	Abv                    *float64             `json:"abv"`
+	AbvCalculated          bool                 `json:"abv_calculated"`
+	BrewDate               *time.Time           `json:"brew_date"`
+	BrewSessions           []BrewSessionSummary `json:"brew_sessions"`
+	CreatedAt              time.Time            `json:"created_at"`
+	CurrentOccupancyStatus *string              `json:"current_occupancy_status"`
+	CurrentOccupancyUuid   *string              `json:"current_occupancy_uuid"`
+	CurrentPhase           *string              `json:"current_phase"`
+	CurrentVessel          *string              `json:"current_vessel"`
+	CurrentVolumeBbl       *float64             `json:"current_volume_bbl"`
+	DaysGrainToGlass       *float64             `json:"days_grain_to_glass"`
+	DaysInBrite            *float64             `json:"days_in_brite"`
+	DaysInFermenter        *float64             `json:"days_in_fermenter"`
+	DeletedAt              *time.Time           `json:"deleted_at"`
+	FinalGravity           *float64             `json:"final_gravity"`
+	Ibu                    *float64             `json:"ibu"`
+	LossPercentage         *float64             `json:"loss_percentage"`
+	Notes                  *string              `json:"notes"`
+	OriginalGravity        *float64             `json:"original_gravity"`
+	RecipeName             *string              `json:"recipe_name"`
+	ShortName              string               `json:"short_name"`
+	StartingVolumeBbl      *float64             `json:"starting_volume_bbl"`
+	StyleName              *string              `json:"style_name"`
+	TotalLossBbl           *float64             `json:"total_loss_bbl"`
+	UpdatedAt              time.Time            `json:"updated_at"`
+	Uuid                   string               `json:"uuid"`
+}
+
+// BatchVolumeResponse defines model for BatchVolumeResponse.
+type BatchVolumeResponse struct {
+	BatchUuid   string     `json:"batch_uuid"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	LiquidPhase string     `json:"liquid_phase"`
+	PhaseAt     time.Time  `json:"phase_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Uuid        string     `json:"uuid"`
+	VolumeUuid  string     `json:"volume_uuid"`
+}
+
+// BrewSessionResponse defines model for BrewSessionResponse.
+type BrewSessionResponse struct {
+	BatchUuid      *string    `json:"batch_uuid"`
+	BoilVesselUuid *string    `json:"boil_vessel_uuid"`
+	BrewedAt       time.Time  `json:"brewed_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeletedAt      *time.Time `json:"deleted_at"`
+	MashVesselUuid *string    `json:"mash_vessel_uuid"`
+	Notes          *string    `json:"notes"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	Uuid           string     `json:"uuid"`
+	WortVolumeUuid *string    `json:"wort_volume_uuid"`
+}
+
+// BrewSessionSummary defines model for BrewSessionSummary.
+type BrewSessionSummary struct {
+	BrewedAt time.Time `json:"brewed_at"`
+	Notes    *string   `json:"notes"`
+	Uuid     string    `json:"uuid"`
+}
+
+// BrewimportResult defines model for BrewimportResult.
+type BrewimportResult struct {
+	DryRun  bool             `json:"dry_run"`
+	Results []RecordResult   `json:"results"`
+	Totals  BrewimportTotals `json:"totals"`
+}
+
+// BrewimportTotals defines model for BrewimportTotals.
+type BrewimportTotals struct {
+	Created int `json:"created"`
+	Failed  int `json:"failed"`
+	Matched int `json:"matched"`
+	Records int `json:"records"`
+	Skipped int `json:"skipped"`
+	Valid   int `json:"valid"`
+}
+
+// CloseOccupancyRequest defines model for CloseOccupancyRequest.
+type CloseOccupancyRequest struct {
+	OutAt *time.Time `json:"out_at"`
+}
+
+// CompletePackagingRunRequest defines model for CompletePackagingRunRequest.
+type CompletePackagingRunRequest struct {
+	EndedAt           *time.Time `json:"ended_at"`
+	Notes             *string    `json:"notes"`
+	StockLocationUuid *string    `json:"stock_location_uuid"`
+}
+
+// CompletePackagingRunResponse defines model for CompletePackagingRunResponse.
+type CompletePackagingRunResponse struct {
+	Materials    []PackagingMaterialLine `json:"materials"`
+	PackagingRun PackagingRunResponse    `json:"packaging_run"`
+}
+
+// ConfirmBatchAllocationRequest defines model for ConfirmBatchAllocationRequest.
+type ConfirmBatchAllocationRequest struct {
+	Notes  *string               `json:"notes"`
+	Picks  []AllocationPickInput `json:"picks"`
+	UsedAt *time.Time            `json:"used_at"`
+}
+
+// ConfirmBatchAllocationResponse defines model for ConfirmBatchAllocationResponse.
+type ConfirmBatchAllocationResponse struct {
+	BatchUuid string        `json:"batch_uuid"`
+	Movements []interface{} `json:"movements"`
+	UsageUuid string        `json:"usage_uuid"`
+}
+
+// CostLineItem defines model for CostLineItem.
+type CostLineItem struct {
+	AdditionType          string   `json:"addition_type"`
+	AdditionUuid          string   `json:"addition_uuid"`
+	AmountUnit            string   `json:"amount_unit"`
+	AmountUsed            int64    `json:"amount_used"`
+	BaseCostCents         *int64   `json:"base_cost_cents"`
+	CostCents             *int64   `json:"cost_cents"`
+	CostSource            string   `json:"cost_source"`
+	Currency              *string  `json:"currency"`
+	ExchangeRate          *float64 `json:"exchange_rate"`
+	ExchangeRateLocked    *bool    `json:"exchange_rate_locked"`
+	FeeCostCents          *int64   `json:"fee_cost_cents"`
+	IngredientCategory    *string  `json:"ingredient_category"`
+	IngredientLotUuid     string   `json:"ingredient_lot_uuid"`
+	IngredientName        *string  `json:"ingredient_name"`
+	IngredientUuid        *string  `json:"ingredient_uuid"`
+	LotCode               *string  `json:"lot_code"`
+	MaterialCostCents     *int64   `json:"material_cost_cents"`
+	PurchaseOrderLineUuid *string  `json:"purchase_order_line_uuid"`
+	UnitCostCents         *int64   `json:"unit_cost_cents"`
+	UnitCostUnit          *string  `json:"unit_cost_unit"`
+}
+
+// CostTotals defines model for CostTotals.
+type CostTotals struct {
+	BaseCostPerBblCents *int64   `json:"base_cost_per_bbl_cents"`
+	BaseTotalCostCents  *int64   `json:"base_total_cost_cents"`
+	BatchVolumeBbl      *float64 `json:"batch_volume_bbl"`
+	CostComplete        bool     `json:"cost_complete"`
+	CostPerBblCents     *int64   `json:"cost_per_bbl_cents"`
+	CostedLineCount     int      `json:"costed_line_count"`
+	FeeCostCents        int64    `json:"fee_cost_cents"`
+	MaterialCostCents   int64    `json:"material_cost_cents"`
+	TotalCostCents      int64    `json:"total_cost_cents"`
+	UncostedLineCount   int      `json:"uncosted_line_count"`
+}
+
+// CreateAdditionRequest defines model for CreateAdditionRequest.
+type CreateAdditionRequest struct {
+	AddedAt          *time.Time `json:"added_at"`
+	AdditionType     *string    `json:"addition_type"`
+	Amount           *int64     `json:"amount"`
+	AmountUnit       *string    `json:"amount_unit"`
+	BatchUuid        *string    `json:"batch_uuid"`
+	InventoryLotUuid *string    `json:"inventory_lot_uuid"`
+	Notes            *string    `json:"notes"`
+	OccupancyUuid    *string    `json:"occupancy_uuid"`
+	Stage            *string    `json:"stage"`
+	VolumeUuid       *string    `json:"volume_uuid"`
+}
+
+// CreateBatchLaborEntryRequest defines model for CreateBatchLaborEntryRequest.
+type CreateBatchLaborEntryRequest struct {
+	HourlyRateCents *int64     `json:"hourly_rate_cents"`
+	Hours           *float64   `json:"hours"`
+	Notes           *string    `json:"notes"`
+	Role            string     `json:"role"`
+	WorkedAt        *time.Time `json:"worked_at"`
+}
+
+// CreateBatchProcessPhaseRequest defines model for CreateBatchProcessPhaseRequest.
+type CreateBatchProcessPhaseRequest struct {
+	BatchUuid    string     `json:"batch_uuid"`
+	PhaseAt      *time.Time `json:"phase_at"`
+	ProcessPhase *string    `json:"process_phase"`
+}
+
+// CreateBatchRelationRequest defines model for CreateBatchRelationRequest.
+type CreateBatchRelationRequest struct {
+	ChildBatchUuid  string  `json:"child_batch_uuid"`
+	ParentBatchUuid string  `json:"parent_batch_uuid"`
+	RelationType    *string `json:"relation_type"`
+	VolumeUuid      *string `json:"volume_uuid"`
+}
+
+// CreateBatchRequest defines model for CreateBatchRequest.
+type CreateBatchRequest struct {
+	BrewDate          *time.Time `json:"brew_date"`
+	Notes             *string    `json:"notes"`
+	PlannedVolume     *float32   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
+	RecipeUuid        *string    `json:"recipe_uuid"`
+	ShortName         string     `json:"short_name"`
+}
+
+// CreateBatchVolumeRequest defines model for CreateBatchVolumeRequest.
+type CreateBatchVolumeRequest struct {
+	BatchUuid   string     `json:"batch_uuid"`
+	LiquidPhase *string    `json:"liquid_phase"`
+	PhaseAt     *time.Time `json:"phase_at"`
+	VolumeUuid  string     `json:"volume_uuid"`
+}
+
+// CreateBrewSessionRequest defines model for CreateBrewSessionRequest.
+type CreateBrewSessionRequest struct {
+	BatchUuid      *string    `json:"batch_uuid"`
+	BoilVesselUuid *string    `json:"boil_vessel_uuid"`
+	BrewedAt       *time.Time `json:"brewed_at"`
+	MashVesselUuid *string    `json:"mash_vessel_uuid"`
+	Notes          *string    `json:"notes"`
+	WortVolumeUuid *string    `json:"wort_volume_uuid"`
+}
+
+// CreateMRPPurchaseOrdersRequest defines model for CreateMRPPurchaseOrdersRequest.
+type CreateMRPPurchaseOrdersRequest struct {
+	IngredientUuids *[]string `json:"ingredient_uuids"`
+}
+
+// CreateMeasurementRequest defines model for CreateMeasurementRequest.
+type CreateMeasurementRequest struct {
+	BatchUuid     *string    `json:"batch_uuid"`
+	Kind          string     `json:"kind"`
+	Notes         *string    `json:"notes"`
+	ObservedAt    *time.Time `json:"observed_at"`
+	OccupancyUuid *string    `json:"occupancy_uuid"`
+	Unit          *string    `json:"unit"`
+	Value         *float64   `json:"value"`
+	VolumeUuid    *string    `json:"volume_uuid"`
+}
+
+// CreateOccupancyRequest defines model for CreateOccupancyRequest.
+type CreateOccupancyRequest struct {
+	InAt       *time.Time `json:"in_at"`
+	Status     *string    `json:"status"`
+	VesselUuid string     `json:"vessel_uuid"`
+	VolumeUuid string     `json:"volume_uuid"`
+}
+
+// CreateOverheadRateRequest defines model for CreateOverheadRateRequest.
+type CreateOverheadRateRequest struct {
+	Basis     *string `json:"basis"`
+	IsActive  *bool   `json:"is_active"`
+	Name      string  `json:"name"`
+	RateCents *int64  `json:"rate_cents"`
+}
+
+// CreatePackageFormatMaterialRequest defines model for CreatePackageFormatMaterialRequest.
+type CreatePackageFormatMaterialRequest struct {
+	IngredientUuid *string `json:"ingredient_uuid"`
+	Name           string  `json:"name"`
+	Quantity       *int64  `json:"quantity"`
+	Unit           string  `json:"unit"`
+}
+
+// CreatePackageFormatRequest defines model for CreatePackageFormatRequest.
+type CreatePackageFormatRequest struct {
+	Container         string `json:"container"`
+	Name              string `json:"name"`
+	VolumePerUnit     *int64 `json:"volume_per_unit"`
+	VolumePerUnitUnit string `json:"volume_per_unit_unit"`
+}
+
+// CreatePackagingRunLineRequest defines model for CreatePackagingRunLineRequest.
+type CreatePackagingRunLineRequest struct {
+	PackageFormatUuid string `json:"package_format_uuid"`
+	Quantity          *int   `json:"quantity"`
+}
+
+// CreatePackagingRunRequest defines model for CreatePackagingRunRequest.
+type CreatePackagingRunRequest struct {
+	BatchUuid         string                          `json:"batch_uuid"`
+	CloseSource       *bool                           `json:"close_source"`
+	EndedAt           *time.Time                      `json:"ended_at"`
+	Lines             []CreatePackagingRunLineRequest `json:"lines"`
+	LossAmount        *int64                          `json:"loss_amount"`
+	LossUnit          *string                         `json:"loss_unit"`
+	LotCodePrefix     *string                         `json:"lot_code_prefix"`
+	Notes             *string                         `json:"notes"`
+	OccupancyUuid     string                          `json:"occupancy_uuid"`
+	StartedAt         *time.Time                      `json:"started_at"`
+	StockLocationUuid *string                         `json:"stock_location_uuid"`
+}
+
+// CreateRecipeRequest defines model for CreateRecipeRequest.
+type CreateRecipeRequest struct {
+	BatchSize           *float64 `json:"batch_size"`
+	BatchSizeUnit       *string  `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64 `json:"brewhouse_efficiency"`
+	IbuMethod           *string  `json:"ibu_method"`
+	Name                string   `json:"name"`
+	Notes               *string  `json:"notes"`
+	StyleName           *string  `json:"style_name"`
+	StyleUuid           *string  `json:"style_uuid"`
+	TargetCarbonation   *float64 `json:"target_carbonation"`
+	TargetFg            *float64 `json:"target_fg"`
+	TargetFgMax         *float64 `json:"target_fg_max"`
+	TargetFgMin         *float64 `json:"target_fg_min"`
+	TargetIbu           *float64 `json:"target_ibu"`
+	TargetIbuMax        *float64 `json:"target_ibu_max"`
+	TargetIbuMin        *float64 `json:"target_ibu_min"`
+	TargetOg            *float64 `json:"target_og"`
+	TargetOgMax         *float64 `json:"target_og_max"`
+	TargetOgMin         *float64 `json:"target_og_min"`
+	TargetSrm           *float64 `json:"target_srm"`
+	TargetSrmMax        *float64 `json:"target_srm_max"`
+	TargetSrmMin        *float64 `json:"target_srm_min"`
+}
+
+// CreateStyleRequest defines model for CreateStyleRequest.
+type CreateStyleRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateTransferRequest defines model for CreateTransferRequest.
+type CreateTransferRequest struct {
+	Amount              *int64     `json:"amount"`
+	AmountUnit          *string    `json:"amount_unit"`
+	CloseSource         *bool      `json:"close_source"`
+	DestStatus          *string    `json:"dest_status"`
+	DestVesselUuid      string     `json:"dest_vessel_uuid"`
+	EndedAt             *time.Time `json:"ended_at"`
+	LossAmount          *int64     `json:"loss_amount"`
+	LossUnit            *string    `json:"loss_unit"`
+	SourceOccupancyUuid string     `json:"source_occupancy_uuid"`
+	StartedAt           *time.Time `json:"started_at"`
+	VolumeUuid          string     `json:"volume_uuid"`
+}
+
+// CreateVesselRequest defines model for CreateVesselRequest.
+type CreateVesselRequest struct {
+	Capacity     *int64  `json:"capacity"`
+	CapacityUnit *string `json:"capacity_unit"`
+	Make         *string `json:"make"`
+	Model        *string `json:"model"`
+	Name         string  `json:"name"`
+	Status       *string `json:"status"`
+	Type         string  `json:"type"`
+}
+
+// CreateVolumeRelationRequest defines model for CreateVolumeRelationRequest.
+type CreateVolumeRelationRequest struct {
+	Amount           *int64  `json:"amount"`
+	AmountUnit       *string `json:"amount_unit"`
+	ChildVolumeUuid  string  `json:"child_volume_uuid"`
+	ParentVolumeUuid string  `json:"parent_volume_uuid"`
+	RelationType     *string `json:"relation_type"`
+}
+
+// CreateVolumeRequest defines model for CreateVolumeRequest.
+type CreateVolumeRequest struct {
+	Amount      *int64  `json:"amount"`
+	AmountUnit  *string `json:"amount_unit"`
+	Description *string `json:"description"`
+	Name        *string `json:"name"`
+}
+
+// FullCostTotals defines model for FullCostTotals.
+type FullCostTotals struct {
+	CostPerBblCents                *int64  `json:"cost_per_bbl_cents"`
+	CostPerUnitCents               *int64  `json:"cost_per_unit_cents"`
+	IngredientCostCents            *int64  `json:"ingredient_cost_cents"`
+	LaborCostCents                 int64   `json:"labor_cost_cents"`
+	LiquidCostCents                *int64  `json:"liquid_cost_cents"`
+	LossVolumeBbl                  float64 `json:"loss_volume_bbl"`
+	LossWriteOffCents              *int64  `json:"loss_write_off_cents"`
+	OverheadCostCents              int64   `json:"overhead_cost_cents"`
+	PackagedUnits                  int     `json:"packaged_units"`
+	PackagingMaterialCostCents     int64   `json:"packaging_material_cost_cents"`
+	TotalCostCents                 *int64  `json:"total_cost_cents"`
+	UncostedPackagingMaterialCount int     `json:"uncosted_packaging_material_count"`
+	VesselDays                     float64 `json:"vessel_days"`
+}
+
+// Ingredient defines model for Ingredient.
+type Ingredient struct {
+	Category    string  `json:"category"`
+	DefaultUnit string  `json:"default_unit"`
+	Description *string `json:"description"`
+	Name        string  `json:"name"`
+	Ref         string  `json:"ref"`
+	Skip        *bool   `json:"skip,omitempty"`
+}
+
+// IngredientInput defines model for IngredientInput.
+type IngredientInput struct {
+	Category    *string `json:"category"`
+	DefaultUnit *string `json:"default_unit"`
+	Description *string `json:"description"`
+	Name        *string `json:"name"`
+	Ref         *string `json:"ref"`
+	Skip        *bool   `json:"skip"`
+}
+
+// LaborCost defines model for LaborCost.
+type LaborCost struct {
+	CostCents       int64   `json:"cost_cents"`
+	HourlyRateCents int64   `json:"hourly_rate_cents"`
+	Hours           float64 `json:"hours"`
+	Role            string  `json:"role"`
+}
+
+// LossWriteOff defines model for LossWriteOff.
+type LossWriteOff struct {
+	Amount      int64     `json:"amount"`
+	AmountUnit  string    `json:"amount_unit"`
+	Category    string    `json:"category"`
+	CostCents   *int64    `json:"cost_cents"`
+	Reason      string    `json:"reason"`
+	RemovalUuid string    `json:"removal_uuid"`
+	RemovedAt   time.Time `json:"removed_at"`
+	VolumeBbl   *float64  `json:"volume_bbl"`
+}
+
+// Lot defines model for Lot.
+type Lot struct {
+	Amount            float64    `json:"amount"`
+	BreweryLotCode    *string    `json:"brewery_lot_code"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	Ingredient        string     `json:"ingredient"`
+	Notes             *string    `json:"notes"`
+	OriginatorLotCode *string    `json:"originator_lot_code"`
+	OriginatorName    *string    `json:"originator_name"`
+	ReceivedAt        *time.Time `json:"received_at"`
+	Ref               string     `json:"ref"`
+	Skip              *bool      `json:"skip,omitempty"`
+	StockLocation     *string    `json:"stock_location"`
+	Unit              string     `json:"unit"`
+}
+
+// LotInput defines model for LotInput.
+type LotInput struct {
+	Amount            *float64   `json:"amount"`
+	BreweryLotCode    *string    `json:"brewery_lot_code"`
+	ExpiresAt         *time.Time `json:"expires_at"`
+	Ingredient        *string    `json:"ingredient"`
+	Notes             *string    `json:"notes"`
+	OriginatorLotCode *string    `json:"originator_lot_code"`
+	OriginatorName    *string    `json:"originator_name"`
+	ReceivedAt        *time.Time `json:"received_at"`
+	Ref               *string    `json:"ref"`
+	Skip              *bool      `json:"skip"`
+	StockLocation     *string    `json:"stock_location"`
+	Unit              *string    `json:"unit"`
+}
+
+// MRPBatch defines model for MRPBatch.
+type MRPBatch struct {
+	BatchUuid         string     `json:"batch_uuid"`
+	BrewDate          *time.Time `json:"brew_date"`
+	PlannedVolume     *float32   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
+	RecipeName        *string    `json:"recipe_name"`
+	RecipeUuid        string     `json:"recipe_uuid"`
+	Scale             float32    `json:"scale"`
+	ShortName         string     `json:"short_name"`
+}
+
+// MRPOpenOrder defines model for MRPOpenOrder.
+type MRPOpenOrder struct {
+	ExpectedAt            *time.Time `json:"expected_at"`
+	OrderNumber           string     `json:"order_number"`
+	OutstandingAmount     int64      `json:"outstanding_amount"`
+	PurchaseOrderLineUuid string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderUuid     string     `json:"purchase_order_uuid"`
+	Status                string     `json:"status"`
+}
+
+// MRPPeriod defines model for MRPPeriod.
+type MRPPeriod struct {
+	BatchShortName    string     `json:"batch_short_name"`
+	BatchUuid         string     `json:"batch_uuid"`
+	BrewDate          *time.Time `json:"brew_date"`
+	ProjectedBalance  int64      `json:"projected_balance"`
+	RequiredAmount    int64      `json:"required_amount"`
+	ScheduledReceipts int64      `json:"scheduled_receipts"`
+	ShortageAmount    int64      `json:"shortage_amount"`
+}
+
+// MRPPurchaseOrder defines model for MRPPurchaseOrder.
+type MRPPurchaseOrder struct {
+	ExpectedAt        *time.Time             `json:"expected_at"`
+	Lines             []MRPPurchaseOrderLine `json:"lines"`
+	OrderNumber       string                 `json:"order_number"`
+	PurchaseOrderUuid string                 `json:"purchase_order_uuid"`
+	SupplierName      string                 `json:"supplier_name"`
+	SupplierUuid      string                 `json:"supplier_uuid"`
+}
+
+// MRPPurchaseOrderLine defines model for MRPPurchaseOrderLine.
+type MRPPurchaseOrderLine struct {
+	Currency       string `json:"currency"`
+	IngredientUuid string `json:"ingredient_uuid"`
+	ItemName       string `json:"item_name"`
+	Quantity       int64  `json:"quantity"`
+	QuantityUnit   string `json:"quantity_unit"`
+	UnitCostCents  int64  `json:"unit_cost_cents"`
+}
+
+// MRPPurchaseOrdersResponse defines model for MRPPurchaseOrdersResponse.
+type MRPPurchaseOrdersResponse struct {
+	PurchaseOrders []MRPPurchaseOrder `json:"purchase_orders"`
+	Unassigned     []MRPRequirement   `json:"unassigned"`
+}
+
+// MRPRequirement defines model for MRPRequirement.
+type MRPRequirement struct {
+	AmountUnit     string         `json:"amount_unit"`
+	GrossRequired  int64          `json:"gross_required"`
+	IngredientUuid string         `json:"ingredient_uuid"`
+	Name           string         `json:"name"`
+	NeededBy       *time.Time     `json:"needed_by"`
+	NetShortage    int64          `json:"net_shortage"`
+	OnHand         int64          `json:"on_hand"`
+	OnOrder        int64          `json:"on_order"`
+	OpenOrders     []MRPOpenOrder `json:"open_orders"`
+	Periods        []MRPPeriod    `json:"periods"`
+	Supplier       *MRPSupplier   `json:"supplier"`
+}
+
+// MRPResponse defines model for MRPResponse.
+type MRPResponse struct {
+	Batches      []MRPBatch        `json:"batches"`
+	GeneratedAt  time.Time         `json:"generated_at"`
+	Requirements []MRPRequirement  `json:"requirements"`
+	Unlinked     []MRPUnlinkedLine `json:"unlinked"`
+}
+
+// MRPSupplier defines model for MRPSupplier.
+type MRPSupplier struct {
+	Currency      string `json:"currency"`
+	ItemName      string `json:"item_name"`
+	QuantityUnit  string `json:"quantity_unit"`
+	Source        string `json:"source"`
+	SupplierName  string `json:"supplier_name"`
+	SupplierUuid  string `json:"supplier_uuid"`
+	UnitCostCents int64  `json:"unit_cost_cents"`
+}
+
+// MRPUnlinkedLine defines model for MRPUnlinkedLine.
+type MRPUnlinkedLine struct {
+	AmountUnit           string `json:"amount_unit"`
+	BatchShortName       string `json:"batch_short_name"`
+	BatchUuid            string `json:"batch_uuid"`
+	Name                 string `json:"name"`
+	RecipeIngredientUuid string `json:"recipe_ingredient_uuid"`
+	RequiredAmount       int64  `json:"required_amount"`
+}
+
+// MeasurementResponse defines model for MeasurementResponse.
+type MeasurementResponse struct {
+	BatchUuid     *string    `json:"batch_uuid"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+	Kind          string     `json:"kind"`
+	Notes         *string    `json:"notes"`
+	ObservedAt    time.Time  `json:"observed_at"`
+	OccupancyUuid *string    `json:"occupancy_uuid"`
+	Unit          *string    `json:"unit"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	Uuid          string     `json:"uuid"`
+	Value         float64    `json:"value"`
+	VolumeUuid    *string    `json:"volume_uuid"`
+}
+
+// OccupancyResponse defines model for OccupancyResponse.
+type OccupancyResponse struct {
+	BatchUuid  *string    `json:"batch_uuid"`
+	CreatedAt  time.Time  `json:"created_at"`
+	DeletedAt  *time.Time `json:"deleted_at"`
+	InAt       time.Time  `json:"in_at"`
+	OutAt      *time.Time `json:"out_at"`
+	Status     *string    `json:"status"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	Uuid       string     `json:"uuid"`
+	VesselUuid string     `json:"vessel_uuid"`
+	VolumeUuid string     `json:"volume_uuid"`
+}
+
+// OverheadCost defines model for OverheadCost.
+type OverheadCost struct {
+	Basis            string  `json:"basis"`
+	CostCents        int64   `json:"cost_cents"`
+	Name             string  `json:"name"`
+	OverheadRateUuid string  `json:"overhead_rate_uuid"`
+	Quantity         float64 `json:"quantity"`
+	RateCents        int64   `json:"rate_cents"`
+}
+
+// OverheadRateResponse defines model for OverheadRateResponse.
+type OverheadRateResponse struct {
+	Basis     string     `json:"basis"`
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	IsActive  bool       `json:"is_active"`
+	Name      string     `json:"name"`
+	RateCents int64      `json:"rate_cents"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Uuid      string     `json:"uuid"`
+}
+
+// PackageFormatCost defines model for PackageFormatCost.
+type PackageFormatCost struct {
+	CostPerUnitCents  *int64  `json:"cost_per_unit_cents"`
+	LiquidCostCents   *int64  `json:"liquid_cost_cents"`
+	MaterialCostCents int64   `json:"material_cost_cents"`
+	PackageFormatName string  `json:"package_format_name"`
+	PackageFormatUuid string  `json:"package_format_uuid"`
+	TotalCostCents    *int64  `json:"total_cost_cents"`
+	Units             int     `json:"units"`
+	VolumeBbl         float64 `json:"volume_bbl"`
+}
+
+// PackageFormatMaterialResponse defines model for PackageFormatMaterialResponse.
+type PackageFormatMaterialResponse struct {
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	IngredientUuid    string     `json:"ingredient_uuid"`
+	Name              string     `json:"name"`
+	PackageFormatUuid string     `json:"package_format_uuid"`
+	Quantity          int64      `json:"quantity"`
+	Unit              string     `json:"unit"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+}
+
+// PackageFormatResponse defines model for PackageFormatResponse.
+type PackageFormatResponse struct {
+	Container         string     `json:"container"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DeletedAt         *time.Time `json:"deleted_at"`
+	IsActive          bool       `json:"is_active"`
+	Name              string     `json:"name"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	Uuid              string     `json:"uuid"`
+	VolumePerUnit     int64      `json:"volume_per_unit"`
+	VolumePerUnitUnit string     `json:"volume_per_unit_unit"`
+}
+
+// PackagingMaterialCheckRequest defines model for PackagingMaterialCheckRequest.
+type PackagingMaterialCheckRequest struct {
+	Lines             []CreatePackagingRunLineRequest `json:"lines"`
+	StockLocationUuid *string                         `json:"stock_location_uuid"`
+}
+
+// PackagingMaterialCheckResponse defines model for PackagingMaterialCheckResponse.
+type PackagingMaterialCheckResponse struct {
+	Complete bool                    `json:"complete"`
+	Lines    []PackagingMaterialLine `json:"lines"`
+	Picks    []AllocationPickInput   `json:"picks"`
+}
+
+// PackagingMaterialCost defines model for PackagingMaterialCost.
+type PackagingMaterialCost struct {
+	CostCents             *int64  `json:"cost_cents"`
+	CostSource            string  `json:"cost_source"`
+	Currency              *string `json:"currency"`
+	IngredientLotUuid     string  `json:"ingredient_lot_uuid"`
+	IngredientUuid        string  `json:"ingredient_uuid"`
+	LotCode               *string `json:"lot_code"`
+	MovementUuid          string  `json:"movement_uuid"`
+	Name                  string  `json:"name"`
+	PackageFormatUuid     *string `json:"package_format_uuid"`
+	PackagingRunUuid      string  `json:"packaging_run_uuid"`
+	PurchaseOrderLineUuid *string `json:"purchase_order_line_uuid"`
+	Quantity              int64   `json:"quantity"`
+	Unit                  string  `json:"unit"`
+	UnitCostCents         *int64  `json:"unit_cost_cents"`
+}
+
+// PackagingMaterialLine defines model for PackagingMaterialLine.
+type PackagingMaterialLine struct {
+	AllocatedAmount int64            `json:"allocated_amount"`
+	AmountUnit      string           `json:"amount_unit"`
+	IngredientUuid  string           `json:"ingredient_uuid"`
+	Name            string           `json:"name"`
+	Picks           []AllocationPick `json:"picks"`
+	RequiredAmount  int64            `json:"required_amount"`
+	ShortfallAmount int64            `json:"shortfall_amount"`
+}
+
+// PackagingRunLineResponse defines model for PackagingRunLineResponse.
+type PackagingRunLineResponse struct {
+	CreatedAt                      time.Time `json:"created_at"`
+	PackageFormatName              string    `json:"package_format_name"`
+	PackageFormatUuid              string    `json:"package_format_uuid"`
+	PackageFormatVolumePerUnit     int64     `json:"package_format_volume_per_unit"`
+	PackageFormatVolumePerUnitUnit string    `json:"package_format_volume_per_unit_unit"`
+	PackagingRunUuid               string    `json:"packaging_run_uuid"`
+	Quantity                       int       `json:"quantity"`
+	UpdatedAt                      time.Time `json:"updated_at"`
+	Uuid                           string    `json:"uuid"`
+}
+
+// PackagingRunResponse defines model for PackagingRunResponse.
+type PackagingRunResponse struct {
+	BatchUuid         string                     `json:"batch_uuid"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	EndedAt           *time.Time                 `json:"ended_at"`
+	Lines             []PackagingRunLineResponse `json:"lines"`
+	LossAmount        *int64                     `json:"loss_amount"`
+	LossUnit          *string                    `json:"loss_unit"`
+	MaterialUsageUuid *string                    `json:"material_usage_uuid"`
+	Notes             *string                    `json:"notes"`
+	OccupancyUuid     string                     `json:"occupancy_uuid"`
+	StartedAt         time.Time                  `json:"started_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+	Uuid              string                     `json:"uuid"`
+}
+
+// Plan defines model for Plan.
+type Plan struct {
+	Batches              []Batch      `json:"batches"`
+	DefaultStockLocation *string      `json:"default_stock_location"`
+	Ingredients          []Ingredient `json:"ingredients"`
+	Lots                 []Lot        `json:"lots"`
+	Recipes              []Recipe     `json:"recipes"`
+	Source               string       `json:"source"`
+	Vessels              []Vessel     `json:"vessels"`
+	Warnings             []Warning    `json:"warnings"`
+}
+
+// PlanInput defines model for PlanInput.
+type PlanInput struct {
+	Batches              *[]BatchInput      `json:"batches"`
+	DefaultStockLocation *string            `json:"default_stock_location"`
+	Ingredients          *[]IngredientInput `json:"ingredients"`
+	Lots                 *[]LotInput        `json:"lots"`
+	Recipes              *[]RecipeInput     `json:"recipes"`
+	Source               *string            `json:"source"`
+	Vessels              *[]VesselInput     `json:"vessels"`
+	Warnings             *[]WarningInput    `json:"warnings"`
+}
+
+// Recipe defines model for Recipe.
+type Recipe struct {
+	BatchSize           *float64           `json:"batch_size"`
+	BatchSizeUnit       *string            `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64           `json:"brewhouse_efficiency"`
+	Ingredients         []RecipeIngredient `json:"ingredients"`
+	Name                string             `json:"name"`
+	Notes               *string            `json:"notes"`
+	Ref                 string             `json:"ref"`
+	Skip                *bool              `json:"skip,omitempty"`
+	Style               *string            `json:"style"`
+	TargetFg            *float64           `json:"target_fg"`
+	TargetIbu           *float64           `json:"target_ibu"`
+	TargetOg            *float64           `json:"target_og"`
+}
+
+// RecipeIngredient defines model for RecipeIngredient.
+type RecipeIngredient struct {
+	AlphaAcidAssumed      *float64 `json:"alpha_acid_assumed"`
+	Amount                float64  `json:"amount"`
+	AmountUnit            string   `json:"amount_unit"`
+	IngredientType        string   `json:"ingredient_type"`
+	Name                  string   `json:"name"`
+	TimingDurationMinutes *int     `json:"timing_duration_minutes"`
+	UseStage              string   `json:"use_stage"`
+	UseType               *string  `json:"use_type"`
+}
+
+// RecipeIngredientInput defines model for RecipeIngredientInput.
+type RecipeIngredientInput struct {
+	AlphaAcidAssumed      *float64 `json:"alpha_acid_assumed"`
+	Amount                *float64 `json:"amount"`
+	AmountUnit            *string  `json:"amount_unit"`
+	IngredientType        *string  `json:"ingredient_type"`
+	Name                  *string  `json:"name"`
+	TimingDurationMinutes *int     `json:"timing_duration_minutes"`
+	UseStage              *string  `json:"use_stage"`
+	UseType               *string  `json:"use_type"`
+}
+
+// RecipeIngredientRequest defines model for RecipeIngredientRequest.
+type RecipeIngredientRequest struct {
+	AlphaAcidAssumed      *float64 `json:"alpha_acid_assumed"`
+	Amount                *float64 `json:"amount"`
+	AmountUnit            string   `json:"amount_unit"`
+	IngredientType        *string  `json:"ingredient_type"`
+	IngredientUuid        *string  `json:"ingredient_uuid"`
+	Name                  *string  `json:"name"`
+	Notes                 *string  `json:"notes"`
+	ScalingFactor         *float64 `json:"scaling_factor"`
+	SortOrder             *int     `json:"sort_order"`
+	TimingDurationMinutes *int     `json:"timing_duration_minutes"`
+	TimingTemperatureC    *float64 `json:"timing_temperature_c"`
+	UseStage              *string  `json:"use_stage"`
+	UseType               *string  `json:"use_type"`
+}
+
+// RecipeIngredientResponse defines model for RecipeIngredientResponse.
+type RecipeIngredientResponse struct {
+	AlphaAcidAssumed      *float64  `json:"alpha_acid_assumed"`
+	Amount                float64   `json:"amount"`
+	AmountUnit            string    `json:"amount_unit"`
+	CreatedAt             time.Time `json:"created_at"`
+	IngredientType        string    `json:"ingredient_type"`
+	IngredientUuid        *string   `json:"ingredient_uuid"`
+	Name                  string    `json:"name"`
+	Notes                 *string   `json:"notes"`
+	RecipeUuid            string    `json:"recipe_uuid"`
+	ScalingFactor         float64   `json:"scaling_factor"`
+	SortOrder             int       `json:"sort_order"`
+	TimingDurationMinutes *int      `json:"timing_duration_minutes"`
+	TimingTemperatureC    *float64  `json:"timing_temperature_c"`
+	UpdatedAt             time.Time `json:"updated_at"`
+	UseStage              string    `json:"use_stage"`
+	UseType               *string   `json:"use_type"`
+	Uuid                  string    `json:"uuid"`
+}
+
+// RecipeInput defines model for RecipeInput.
+type RecipeInput struct {
+	BatchSize           *float64                 `json:"batch_size"`
+	BatchSizeUnit       *string                  `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64                 `json:"brewhouse_efficiency"`
+	Ingredients         *[]RecipeIngredientInput `json:"ingredients"`
+	Name                *string                  `json:"name"`
+	Notes               *string                  `json:"notes"`
+	Ref                 *string                  `json:"ref"`
+	Skip                *bool                    `json:"skip"`
+	Style               *string                  `json:"style"`
+	TargetFg            *float64                 `json:"target_fg"`
+	TargetIbu           *float64                 `json:"target_ibu"`
+	TargetOg            *float64                 `json:"target_og"`
+}
+
+// RecipeResponse defines model for RecipeResponse.
+type RecipeResponse struct {
+	BatchSize           *float64   `json:"batch_size"`
+	BatchSizeUnit       *string    `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64   `json:"brewhouse_efficiency"`
+	CreatedAt           time.Time  `json:"created_at"`
+	DeletedAt           *time.Time `json:"deleted_at"`
+	IbuMethod           *string    `json:"ibu_method"`
+	Name                string     `json:"name"`
+	Notes               *string    `json:"notes"`
+	StyleName           *string    `json:"style_name"`
+	StyleUuid           *string    `json:"style_uuid"`
+	TargetAbv           *float64   `json:"target_abv"`
+	TargetCarbonation   *float64   `json:"target_carbonation"`
+	TargetFg            *float64   `json:"target_fg"`
+	TargetFgMax         *float64   `json:"target_fg_max"`
+	TargetFgMin         *float64   `json:"target_fg_min"`
+	TargetIbu           *float64   `json:"target_ibu"`
+	TargetIbuMax        *float64   `json:"target_ibu_max"`
+	TargetIbuMin        *float64   `json:"target_ibu_min"`
+	TargetOg            *float64   `json:"target_og"`
+	TargetOgMax         *float64   `json:"target_og_max"`
+	TargetOgMin         *float64   `json:"target_og_min"`
+	TargetSrm           *float64   `json:"target_srm"`
+	TargetSrmMax        *float64   `json:"target_srm_max"`
+	TargetSrmMin        *float64   `json:"target_srm_min"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	Uuid                string     `json:"uuid"`
+}
+
+// RecordResult defines model for RecordResult.
+type RecordResult struct {
+	Error  *string     `json:"error"`
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	Record interface{} `json:"record"`
+	Ref    string      `json:"ref"`
+	Status string      `json:"status"`
+}
+
+// Result defines model for Result.
+type Result struct {
+	DryRun  bool        `json:"dry_run"`
+	Results []RowResult `json:"results"`
+	Totals  Totals      `json:"totals"`
+}
+
+// RowResult defines model for RowResult.
+type RowResult struct {
+	Error  *string     `json:"error"`
+	Record interface{} `json:"record"`
+	Row    int         `json:"row"`
+	Status string      `json:"status"`
+}
+
+// ScanMatchResponse defines model for ScanMatchResponse.
+type ScanMatchResponse struct {
+	Actions    []string `json:"actions"`
+	EntityType string   `json:"entity_type"`
+	MatchedOn  string   `json:"matched_on"`
+	Name       string   `json:"name"`
+	State      string   `json:"state"`
+	Uuid       string   `json:"uuid"`
+}
+
+// ScanResponse defines model for ScanResponse.
+type ScanResponse struct {
+	Code    string              `json:"code"`
+	Matches []ScanMatchResponse `json:"matches"`
+}
+
+// StyleResponse defines model for StyleResponse.
+type StyleResponse struct {
+	CreatedAt time.Time  `json:"created_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	Name      string     `json:"name"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Uuid      string     `json:"uuid"`
+}
+
+// Totals defines model for Totals.
+type Totals struct {
+	Created   int `json:"created"`
+	Failed    int `json:"failed"`
+	TotalRows int `json:"total_rows"`
+	Valid     int `json:"valid"`
+}
+
+// TransferRecordResponse defines model for TransferRecordResponse.
+type TransferRecordResponse struct {
+	DestOccupancy OccupancyResponse `json:"dest_occupancy"`
+	Transfer      TransferResponse  `json:"transfer"`
+}
+
+// TransferResponse defines model for TransferResponse.
+type TransferResponse struct {
+	Amount              int64      `json:"amount"`
+	AmountUnit          string     `json:"amount_unit"`
+	CreatedAt           time.Time  `json:"created_at"`
+	DeletedAt           *time.Time `json:"deleted_at"`
+	DestOccupancyUuid   string     `json:"dest_occupancy_uuid"`
+	EndedAt             *time.Time `json:"ended_at"`
+	LossAmount          *int64     `json:"loss_amount"`
+	LossUnit            *string    `json:"loss_unit"`
+	SourceOccupancyUuid string     `json:"source_occupancy_uuid"`
+	StartedAt           time.Time  `json:"started_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	Uuid                string     `json:"uuid"`
+}
+
+// UUID defines model for UUID.
+type UUID = string
+
+// UncostedAddition defines model for UncostedAddition.
+type UncostedAddition struct {
+	AdditionType string `json:"addition_type"`
+	AdditionUuid string `json:"addition_uuid"`
+	AmountUnit   string `json:"amount_unit"`
+	AmountUsed   int64  `json:"amount_used"`
+	Reason       string `json:"reason"`
+}
+
+// UpdateBatchRequest defines model for UpdateBatchRequest.
+type UpdateBatchRequest struct {
+	BrewDate          *time.Time `json:"brew_date"`
+	Notes             *string    `json:"notes"`
+	PlannedVolume     *float32   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
+	RecipeUuid        *string    `json:"recipe_uuid"`
+	ShortName         string     `json:"short_name"`
+}
+
+// UpdateBrewSessionRequest defines model for UpdateBrewSessionRequest.
+type UpdateBrewSessionRequest struct {
+	BatchUuid      *string    `json:"batch_uuid"`
+	BoilVesselUuid *string    `json:"boil_vessel_uuid"`
+	BrewedAt       *time.Time `json:"brewed_at"`
+	MashVesselUuid *string    `json:"mash_vessel_uuid"`
+	Notes          *string    `json:"notes"`
+	WortVolumeUuid *string    `json:"wort_volume_uuid"`
+}
+
+// UpdateOccupancyStatusRequest defines model for UpdateOccupancyStatusRequest.
+type UpdateOccupancyStatusRequest struct {
+	Status *string `json:"status"`
+}
+
+// UpdateOverheadRateRequest defines model for UpdateOverheadRateRequest.
+type UpdateOverheadRateRequest struct {
+	IsActive *bool `json:"is_active"`
+}
+
+// UpdatePackageFormatRequest defines model for UpdatePackageFormatRequest.
+type UpdatePackageFormatRequest struct {
+	Container         *string `json:"container"`
+	IsActive          *bool   `json:"is_active"`
+	Name              *string `json:"name"`
+	VolumePerUnit     *int64  `json:"volume_per_unit"`
+	VolumePerUnitUnit *string `json:"volume_per_unit_unit"`
+}
+
+// UpdateRecipeRequest defines model for UpdateRecipeRequest.
+type UpdateRecipeRequest struct {
+	BatchSize           *float64 `json:"batch_size"`
+	BatchSizeUnit       *string  `json:"batch_size_unit"`
+	BrewhouseEfficiency *float64 `json:"brewhouse_efficiency"`
+	IbuMethod           *string  `json:"ibu_method"`
+	Name                string   `json:"name"`
+	Notes               *string  `json:"notes"`
+	StyleName           *string  `json:"style_name"`
+	StyleUuid           *string  `json:"style_uuid"`
+	TargetCarbonation   *float64 `json:"target_carbonation"`
+	TargetFg            *float64 `json:"target_fg"`
+	TargetFgMax         *float64 `json:"target_fg_max"`
+	TargetFgMin         *float64 `json:"target_fg_min"`
+	TargetIbu           *float64 `json:"target_ibu"`
+	TargetIbuMax        *float64 `json:"target_ibu_max"`
+	TargetIbuMin        *float64 `json:"target_ibu_min"`
+	TargetOg            *float64 `json:"target_og"`
+	TargetOgMax         *float64 `json:"target_og_max"`
+	TargetOgMin         *float64 `json:"target_og_min"`
+	TargetSrm           *float64 `json:"target_srm"`
+	TargetSrmMax        *float64 `json:"target_srm_max"`
+	TargetSrmMin        *float64 `json:"target_srm_min"`
+}
+
+// UpdateVesselRequest defines model for UpdateVesselRequest.
+type UpdateVesselRequest struct {
+	Capacity     *int64  `json:"capacity"`
+	CapacityUnit *string `json:"capacity_unit"`
+	Make         *string `json:"make"`
+	Model        *string `json:"model"`
+	Name         string  `json:"name"`
+	Status       *string `json:"status"`
+	Type         string  `json:"type"`
+}
+
+// Vessel defines model for Vessel.
+type Vessel struct {
+	Capacity     float64 `json:"capacity"`
+	CapacityUnit string  `json:"capacity_unit"`
+	Make         *string `json:"make"`
+	Model        *string `json:"model"`
+	Name         string  `json:"name"`
+	Ref          string  `json:"ref"`
+	Skip         *bool   `json:"skip,omitempty"`
+	Status       string  `json:"status"`
+	Type         string  `json:"type"`
+}
+
+// VesselInput defines model for VesselInput.
+type VesselInput struct {
+	Capacity     *float64 `json:"capacity"`
+	CapacityUnit *string  `json:"capacity_unit"`
+	Make         *string  `json:"make"`
+	Model        *string  `json:"model"`
+	Name         *string  `json:"name"`
+	Ref          *string  `json:"ref"`
+	Skip         *bool    `json:"skip"`
+	Status       *string  `json:"status"`
+	Type         *string  `json:"type"`
+}
+
+// VesselResponse defines model for VesselResponse.
+type VesselResponse struct {
+	Capacity     int64      `json:"capacity"`
+	CapacityUnit string     `json:"capacity_unit"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	Make         *string    `json:"make"`
+	Model        *string    `json:"model"`
+	Name         string     `json:"name"`
+	Status       string     `json:"status"`
+	Type         string     `json:"type"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+	Uuid         string     `json:"uuid"`
+}
+
+// VolumeRelationResponse defines model for VolumeRelationResponse.
+type VolumeRelationResponse struct {
+	Amount           int64      `json:"amount"`
+	AmountUnit       string     `json:"amount_unit"`
+	ChildVolumeUuid  string     `json:"child_volume_uuid"`
+	CreatedAt        time.Time  `json:"created_at"`
+	DeletedAt        *time.Time `json:"deleted_at"`
+	ParentVolumeUuid string     `json:"parent_volume_uuid"`
+	RelationType     string     `json:"relation_type"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	Uuid             string     `json:"uuid"`
+}
+
+// VolumeResponse defines model for VolumeResponse.
+type VolumeResponse struct {
+	Amount      int64      `json:"amount"`
+	AmountUnit  string     `json:"amount_unit"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	Description *string    `json:"description"`
+	Name        *string    `json:"name"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	Uuid        string     `json:"uuid"`
+}
+
+// Warning defines model for Warning.
+type Warning struct {
+	Message string `json:"message"`
+	Ref     string `json:"ref"`
+}
+
+// WarningInput defines model for WarningInput.
+type WarningInput struct {
+	Message *string `json:"message"`
+	Ref     *string `json:"ref"`
+}
+
+// CodePath defines model for CodePath.
+type CodePath = string
+
+// IngredientUuidPath defines model for IngredientUuidPath.
+type IngredientUuidPath = UUID
+
+// UuidPath defines model for UuidPath.
+type UuidPath = UUID
+
+// ListAdditionsParams defines parameters for ListAdditions.
+type ListAdditionsParams struct {
+	BatchUuid     *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+	OccupancyUuid *UUID `form:"occupancy_uuid,omitempty" json:"occupancy_uuid,omitempty"`
+	VolumeUuid    *UUID `form:"volume_uuid,omitempty" json:"volume_uuid,omitempty"`
+}
+
+// ListBatchProcessPhasesParams defines parameters for ListBatchProcessPhases.
+type ListBatchProcessPhasesParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// ListBatchRelationsParams defines parameters for ListBatchRelations.
+type ListBatchRelationsParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// ListBatchVolumesParams defines parameters for ListBatchVolumes.
+type ListBatchVolumesParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// ImportBatchesMultipartBody defines parameters for ImportBatches.
+type ImportBatchesMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportBatchesParams defines parameters for ImportBatches.
+type ImportBatchesParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// GetBatchCostsParams defines parameters for GetBatchCosts.
+type GetBatchCostsParams struct {
+	Live *bool `form:"live,omitempty" json:"live,omitempty"`
+}
+
+// ListBrewSessionsParams defines parameters for ListBrewSessions.
+type ListBrewSessionsParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// ListMeasurementsParams defines parameters for ListMeasurements.
+type ListMeasurementsParams struct {
+	BatchUuid     *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+	OccupancyUuid *UUID `form:"occupancy_uuid,omitempty" json:"occupancy_uuid,omitempty"`
+	VolumeUuid    *UUID `form:"volume_uuid,omitempty" json:"volume_uuid,omitempty"`
+}
+
+// PreviewMigrationMultipartBody defines parameters for PreviewMigration.
+type PreviewMigrationMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ApplyProductionMigrationParams defines parameters for ApplyProductionMigration.
+type ApplyProductionMigrationParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListOccupanciesParams defines parameters for ListOccupancies.
+type ListOccupanciesParams struct {
+	Active bool `form:"active" json:"active"`
+}
+
+// GetActiveOccupancyParams defines parameters for GetActiveOccupancy.
+type GetActiveOccupancyParams struct {
+	ActiveVesselUuid *UUID `form:"active_vessel_uuid,omitempty" json:"active_vessel_uuid,omitempty"`
+	ActiveVolumeUuid *UUID `form:"active_volume_uuid,omitempty" json:"active_volume_uuid,omitempty"`
+}
+
+// ListOverheadRatesParams defines parameters for ListOverheadRates.
+type ListOverheadRatesParams struct {
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
+
+// ListPackagingRunsParams defines parameters for ListPackagingRuns.
+type ListPackagingRunsParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// ImportRecipeIngredientsMultipartBody defines parameters for ImportRecipeIngredients.
+type ImportRecipeIngredientsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// ImportRecipeIngredientsParams defines parameters for ImportRecipeIngredients.
+type ImportRecipeIngredientsParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListTransfersParams defines parameters for ListTransfers.
+type ListTransfersParams struct {
+	BatchUuid *UUID `form:"batch_uuid,omitempty" json:"batch_uuid,omitempty"`
+}
+
+// GetVesselLabelParams defines parameters for GetVesselLabel.
+type GetVesselLabelParams struct {
+	Format       *string `form:"format,omitempty" json:"format,omitempty"`
+	Copies       *int    `form:"copies,omitempty" json:"copies,omitempty"`
+	TemplateUuid *UUID   `form:"template_uuid,omitempty" json:"template_uuid,omitempty"`
+}
+
+// ListVolumeRelationsParams defines parameters for ListVolumeRelations.
+type ListVolumeRelationsParams struct {
+	VolumeUuid *UUID `form:"volume_uuid,omitempty" json:"volume_uuid,omitempty"`
+}
+
+// CreateAdditionJSONRequestBody defines body for CreateAddition for application/json ContentType.
+type CreateAdditionJSONRequestBody = CreateAdditionRequest
+
+// CreateBatchProcessPhaseJSONRequestBody defines body for CreateBatchProcessPhase for application/json ContentType.
+type CreateBatchProcessPhaseJSONRequestBody = CreateBatchProcessPhaseRequest
+
+// CreateBatchRelationJSONRequestBody defines body for CreateBatchRelation for application/json ContentType.
+type CreateBatchRelationJSONRequestBody = CreateBatchRelationRequest
+
+// CreateBatchVolumeJSONRequestBody defines body for CreateBatchVolume for application/json ContentType.
+type CreateBatchVolumeJSONRequestBody = CreateBatchVolumeRequest
+
+// CreateBatchJSONRequestBody defines body for CreateBatch for application/json ContentType.
+type CreateBatchJSONRequestBody = CreateBatchRequest
+
+// ImportBatchesMultipartRequestBody defines body for ImportBatches for multipart/form-data ContentType.
+type ImportBatchesMultipartRequestBody ImportBatchesMultipartBody
+
+// UpdateBatchJSONRequestBody defines body for UpdateBatch for application/json ContentType.
+type UpdateBatchJSONRequestBody = UpdateBatchRequest
+
+// ProposeBatchAllocationJSONRequestBody defines body for ProposeBatchAllocation for application/json ContentType.
+type ProposeBatchAllocationJSONRequestBody = BatchAllocationRequest
+
+// ConfirmBatchAllocationJSONRequestBody defines body for ConfirmBatchAllocation for application/json ContentType.
+type ConfirmBatchAllocationJSONRequestBody = ConfirmBatchAllocationRequest
+
+// CreateBatchLaborJSONRequestBody defines body for CreateBatchLabor for application/json ContentType.
+type CreateBatchLaborJSONRequestBody = CreateBatchLaborEntryRequest
+
+// CreateBrewSessionJSONRequestBody defines body for CreateBrewSession for application/json ContentType.
+type CreateBrewSessionJSONRequestBody = CreateBrewSessionRequest
+
+// UpdateBrewSessionJSONRequestBody defines body for UpdateBrewSession for application/json ContentType.
+type UpdateBrewSessionJSONRequestBody = UpdateBrewSessionRequest
+
+// CreateMeasurementJSONRequestBody defines body for CreateMeasurement for application/json ContentType.
+type CreateMeasurementJSONRequestBody = CreateMeasurementRequest
+
+// PreviewMigrationMultipartRequestBody defines body for PreviewMigration for multipart/form-data ContentType.
+type PreviewMigrationMultipartRequestBody PreviewMigrationMultipartBody
+
+// ApplyProductionMigrationJSONRequestBody defines body for ApplyProductionMigration for application/json ContentType.
+type ApplyProductionMigrationJSONRequestBody = PlanInput
+
+// CreateMrpPurchaseOrdersJSONRequestBody defines body for CreateMrpPurchaseOrders for application/json ContentType.
+type CreateMrpPurchaseOrdersJSONRequestBody = CreateMRPPurchaseOrdersRequest
+
+// CreateOccupancyJSONRequestBody defines body for CreateOccupancy for application/json ContentType.
+type CreateOccupancyJSONRequestBody = CreateOccupancyRequest
+
+// CloseOccupancyJSONRequestBody defines body for CloseOccupancy for application/json ContentType.
+type CloseOccupancyJSONRequestBody = CloseOccupancyRequest
+
+// UpdateOccupancyStatusJSONRequestBody defines body for UpdateOccupancyStatus for application/json ContentType.
+type UpdateOccupancyStatusJSONRequestBody = UpdateOccupancyStatusRequest
+
+// CreateOverheadRateJSONRequestBody defines body for CreateOverheadRate for application/json ContentType.
+type CreateOverheadRateJSONRequestBody = CreateOverheadRateRequest
+
+// UpdateOverheadRateJSONRequestBody defines body for UpdateOverheadRate for application/json ContentType.
+type UpdateOverheadRateJSONRequestBody = UpdateOverheadRateRequest
+
+// CreatePackageFormatJSONRequestBody defines body for CreatePackageFormat for application/json ContentType.
+type CreatePackageFormatJSONRequestBody = CreatePackageFormatRequest
+
+// UpdatePackageFormatJSONRequestBody defines body for UpdatePackageFormat for application/json ContentType.
+type UpdatePackageFormatJSONRequestBody = UpdatePackageFormatRequest
+
+// CreatePackageFormatMaterialJSONRequestBody defines body for CreatePackageFormatMaterial for application/json ContentType.
+type CreatePackageFormatMaterialJSONRequestBody = CreatePackageFormatMaterialRequest
+
+// CreatePackagingRunJSONRequestBody defines body for CreatePackagingRun for application/json ContentType.
+type CreatePackagingRunJSONRequestBody = CreatePackagingRunRequest
+
+// CheckPackagingMaterialsJSONRequestBody defines body for CheckPackagingMaterials for application/json ContentType.
+type CheckPackagingMaterialsJSONRequestBody = PackagingMaterialCheckRequest
+
+// CompletePackagingRunJSONRequestBody defines body for CompletePackagingRun for application/json ContentType.
+type CompletePackagingRunJSONRequestBody = CompletePackagingRunRequest
+
+// ImportRecipeIngredientsMultipartRequestBody defines body for ImportRecipeIngredients for multipart/form-data ContentType.
+type ImportRecipeIngredientsMultipartRequestBody ImportRecipeIngredientsMultipartBody
+
+// CreateRecipeJSONRequestBody defines body for CreateRecipe for application/json ContentType.
+type CreateRecipeJSONRequestBody = CreateRecipeRequest
+
+// PatchRecipeJSONRequestBody defines body for PatchRecipe for application/json ContentType.
+type PatchRecipeJSONRequestBody = UpdateRecipeRequest
+
+// UpdateRecipeJSONRequestBody defines body for UpdateRecipe for application/json ContentType.
+type UpdateRecipeJSONRequestBody = UpdateRecipeRequest
+
+// CreateRecipeIngredientJSONRequestBody defines body for CreateRecipeIngredient for application/json ContentType.
+type CreateRecipeIngredientJSONRequestBody = RecipeIngredientRequest
+
+// UpdateRecipeIngredientJSONRequestBody defines body for UpdateRecipeIngredient for application/json ContentType.
+type UpdateRecipeIngredientJSONRequestBody = RecipeIngredientRequest
+
+// CreateStyleJSONRequestBody defines body for CreateStyle for application/json ContentType.
+type CreateStyleJSONRequestBody = CreateStyleRequest
+
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = CreateTransferRequest
+
+// CreateVesselJSONRequestBody defines body for CreateVessel for application/json ContentType.
+type CreateVesselJSONRequestBody = CreateVesselRequest
+
+// UpdateVesselJSONRequestBody defines body for UpdateVessel for application/json ContentType.
+type UpdateVesselJSONRequestBody = UpdateVesselRequest
+
+// CreateVolumeRelationJSONRequestBody defines body for CreateVolumeRelation for application/json ContentType.
+type CreateVolumeRelationJSONRequestBody = CreateVolumeRelationRequest
+
+// CreateVolumeJSONRequestBody defines body for CreateVolume for application/json ContentType.
+type CreateVolumeJSONRequestBody = CreateVolumeRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAdditions request
+	ListAdditions(ctx context.Context, params *ListAdditionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAdditionWithBody request with any body
+	CreateAdditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAddition(ctx context.Context, body CreateAdditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAddition request
+	GetAddition(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBatchLabor request
+	DeleteBatchLabor(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchProcessPhases request
+	ListBatchProcessPhases(ctx context.Context, params *ListBatchProcessPhasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchProcessPhaseWithBody request with any body
+	CreateBatchProcessPhaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatchProcessPhase(ctx context.Context, body CreateBatchProcessPhaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatchProcessPhase request
+	GetBatchProcessPhase(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchRelations request
+	ListBatchRelations(ctx context.Context, params *ListBatchRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchRelationWithBody request with any body
+	CreateBatchRelationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatchRelation(ctx context.Context, body CreateBatchRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatchRelation request
+	GetBatchRelation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchVolumes request
+	ListBatchVolumes(ctx context.Context, params *ListBatchVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchVolumeWithBody request with any body
+	CreateBatchVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatchVolume(ctx context.Context, body CreateBatchVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatchVolume request
+	GetBatchVolume(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatches request
+	ListBatches(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchWithBody request with any body
+	CreateBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatch(ctx context.Context, body CreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportBatchesWithBody request with any body
+	ImportBatchesWithBody(ctx context.Context, params *ImportBatchesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteBatch request
+	DeleteBatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatch request
+	GetBatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBatchWithBody request with any body
+	UpdateBatchWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBatch(ctx context.Context, uuid UuidPath, body UpdateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ProposeBatchAllocationWithBody request with any body
+	ProposeBatchAllocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ProposeBatchAllocation(ctx context.Context, uuid UuidPath, body ProposeBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ConfirmBatchAllocationWithBody request with any body
+	ConfirmBatchAllocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConfirmBatchAllocation(ctx context.Context, uuid UuidPath, body ConfirmBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatchCosts request
+	GetBatchCosts(ctx context.Context, uuid UuidPath, params *GetBatchCostsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SnapshotBatchCosts request
+	SnapshotBatchCosts(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBatchLabor request
+	ListBatchLabor(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBatchLaborWithBody request with any body
+	CreateBatchLaborWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBatchLabor(ctx context.Context, uuid UuidPath, body CreateBatchLaborJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBatchSummary request
+	GetBatchSummary(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBrewSessions request
+	ListBrewSessions(ctx context.Context, params *ListBrewSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBrewSessionWithBody request with any body
+	CreateBrewSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBrewSession(ctx context.Context, body CreateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBrewSession request
+	GetBrewSession(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBrewSessionWithBody request with any body
+	UpdateBrewSessionWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateBrewSession(ctx context.Context, uuid UuidPath, body UpdateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMeasurements request
+	ListMeasurements(ctx context.Context, params *ListMeasurementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMeasurementWithBody request with any body
+	CreateMeasurementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateMeasurement(ctx context.Context, body CreateMeasurementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMeasurement request
+	GetMeasurement(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewMigrationWithBody request with any body
+	PreviewMigrationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApplyProductionMigrationWithBody request with any body
+	ApplyProductionMigrationWithBody(ctx context.Context, params *ApplyProductionMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApplyProductionMigration(ctx context.Context, params *ApplyProductionMigrationParams, body ApplyProductionMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMrpPurchaseOrdersWithBody request with any body
+	CreateMrpPurchaseOrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateMrpPurchaseOrders(ctx context.Context, body CreateMrpPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMrpShortages request
+	ListMrpShortages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOccupancies request
+	ListOccupancies(ctx context.Context, params *ListOccupanciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOccupancyWithBody request with any body
+	CreateOccupancyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOccupancy(ctx context.Context, body CreateOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetActiveOccupancy request
+	GetActiveOccupancy(ctx context.Context, params *GetActiveOccupancyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOccupancy request
+	GetOccupancy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloseOccupancyWithBody request with any body
+	CloseOccupancyWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloseOccupancy(ctx context.Context, uuid UuidPath, body CloseOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOccupancyStatusWithBody request with any body
+	UpdateOccupancyStatusWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOccupancyStatus(ctx context.Context, uuid UuidPath, body UpdateOccupancyStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListOverheadRates request
+	ListOverheadRates(ctx context.Context, params *ListOverheadRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateOverheadRateWithBody request with any body
+	CreateOverheadRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateOverheadRate(ctx context.Context, body CreateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteOverheadRate request
+	DeleteOverheadRate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOverheadRateWithBody request with any body
+	UpdateOverheadRateWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOverheadRate(ctx context.Context, uuid UuidPath, body UpdateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePackageFormatMaterial request
+	DeletePackageFormatMaterial(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPackageFormats request
+	ListPackageFormats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePackageFormatWithBody request with any body
+	CreatePackageFormatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePackageFormat(ctx context.Context, body CreatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePackageFormat request
+	DeletePackageFormat(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPackageFormat request
+	GetPackageFormat(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePackageFormatWithBody request with any body
+	UpdatePackageFormatWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdatePackageFormat(ctx context.Context, uuid UuidPath, body UpdatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPackageFormatMaterials request
+	ListPackageFormatMaterials(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePackageFormatMaterialWithBody request with any body
+	CreatePackageFormatMaterialWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePackageFormatMaterial(ctx context.Context, uuid UuidPath, body CreatePackageFormatMaterialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPackagingRuns request
+	ListPackagingRuns(ctx context.Context, params *ListPackagingRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePackagingRunWithBody request with any body
+	CreatePackagingRunWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePackagingRun(ctx context.Context, body CreatePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckPackagingMaterialsWithBody request with any body
+	CheckPackagingMaterialsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CheckPackagingMaterials(ctx context.Context, body CheckPackagingMaterialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePackagingRun request
+	DeletePackagingRun(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPackagingRun request
+	GetPackagingRun(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompletePackagingRunWithBody request with any body
+	CompletePackagingRunWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompletePackagingRun(ctx context.Context, uuid UuidPath, body CompletePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportRecipeIngredientsWithBody request with any body
+	ImportRecipeIngredientsWithBody(ctx context.Context, params *ImportRecipeIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRecipes request
+	ListRecipes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRecipeWithBody request with any body
+	CreateRecipeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRecipe(ctx context.Context, body CreateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRecipe request
+	DeleteRecipe(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecipe request
+	GetRecipe(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PatchRecipeWithBody request with any body
+	PatchRecipeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchRecipe(ctx context.Context, uuid UuidPath, body PatchRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRecipeWithBody request with any body
+	UpdateRecipeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRecipe(ctx context.Context, uuid UuidPath, body UpdateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRecipeIngredients request
+	ListRecipeIngredients(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRecipeIngredientWithBody request with any body
+	CreateRecipeIngredientWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRecipeIngredient(ctx context.Context, uuid UuidPath, body CreateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRecipeIngredient request
+	DeleteRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRecipeIngredient request
+	GetRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateRecipeIngredientWithBody request with any body
+	UpdateRecipeIngredientWithBody(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, body UpdateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ScanCode request
+	ScanCode(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListStyles request
+	ListStyles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateStyleWithBody request with any body
+	CreateStyleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateStyle(ctx context.Context, body CreateStyleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStyle request
+	GetStyle(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTransfers request
+	ListTransfers(ctx context.Context, params *ListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransferWithBody request with any body
+	CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransfer request
+	GetTransfer(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVessels request
+	ListVessels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVesselWithBody request with any body
+	CreateVesselWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVessel(ctx context.Context, body CreateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVessel request
+	GetVessel(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateVesselWithBody request with any body
+	UpdateVesselWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateVessel(ctx context.Context, uuid UuidPath, body UpdateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVesselLabel request
+	GetVesselLabel(ctx context.Context, uuid UuidPath, params *GetVesselLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVolumeRelations request
+	ListVolumeRelations(ctx context.Context, params *ListVolumeRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVolumeRelationWithBody request with any body
+	CreateVolumeRelationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVolumeRelation(ctx context.Context, body CreateVolumeRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVolumeRelation request
+	GetVolumeRelation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVolumes request
+	ListVolumes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVolumeWithBody request with any body
+	CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVolume(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVolume request
+	GetVolume(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAdditions(ctx context.Context, params *ListAdditionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAdditionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAdditionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdditionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateAddition(ctx context.Context, body CreateAdditionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAdditionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAddition(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdditionRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBatchLabor(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBatchLaborRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchProcessPhases(ctx context.Context, params *ListBatchProcessPhasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchProcessPhasesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchProcessPhaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchProcessPhaseRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchProcessPhase(ctx context.Context, body CreateBatchProcessPhaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchProcessPhaseRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatchProcessPhase(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchProcessPhaseRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchRelations(ctx context.Context, params *ListBatchRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchRelationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchRelationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchRelationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchRelation(ctx context.Context, body CreateBatchRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchRelationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatchRelation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchRelationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchVolumes(ctx context.Context, params *ListBatchVolumesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchVolumesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchVolumeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchVolume(ctx context.Context, body CreateBatchVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchVolumeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatchVolume(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchVolumeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatches(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatch(ctx context.Context, body CreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportBatchesWithBody(ctx context.Context, params *ImportBatchesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBatchesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteBatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBatchRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatch(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBatchWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBatchRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBatch(ctx context.Context, uuid UuidPath, body UpdateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBatchRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProposeBatchAllocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProposeBatchAllocationRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ProposeBatchAllocation(ctx context.Context, uuid UuidPath, body ProposeBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProposeBatchAllocationRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmBatchAllocationWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmBatchAllocationRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConfirmBatchAllocation(ctx context.Context, uuid UuidPath, body ConfirmBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConfirmBatchAllocationRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatchCosts(ctx context.Context, uuid UuidPath, params *GetBatchCostsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchCostsRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SnapshotBatchCosts(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSnapshotBatchCostsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBatchLabor(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBatchLaborRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchLaborWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchLaborRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBatchLabor(ctx context.Context, uuid UuidPath, body CreateBatchLaborJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBatchLaborRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBatchSummary(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBatchSummaryRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBrewSessions(ctx context.Context, params *ListBrewSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBrewSessionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBrewSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBrewSessionRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBrewSession(ctx context.Context, body CreateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBrewSessionRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBrewSession(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBrewSessionRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBrewSessionWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBrewSessionRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBrewSession(ctx context.Context, uuid UuidPath, body UpdateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBrewSessionRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMeasurements(ctx context.Context, params *ListMeasurementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMeasurementsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateMeasurementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMeasurementRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateMeasurement(ctx context.Context, body CreateMeasurementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMeasurementRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMeasurement(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeasurementRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewMigrationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewMigrationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyProductionMigrationWithBody(ctx context.Context, params *ApplyProductionMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyProductionMigrationRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApplyProductionMigration(ctx context.Context, params *ApplyProductionMigrationParams, body ApplyProductionMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApplyProductionMigrationRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateMrpPurchaseOrdersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMrpPurchaseOrdersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateMrpPurchaseOrders(ctx context.Context, body CreateMrpPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMrpPurchaseOrdersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListMrpShortages(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMrpShortagesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOccupancies(ctx context.Context, params *ListOccupanciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOccupanciesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOccupancyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOccupancyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOccupancy(ctx context.Context, body CreateOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOccupancyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetActiveOccupancy(ctx context.Context, params *GetActiveOccupancyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetActiveOccupancyRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOccupancy(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOccupancyRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseOccupancyWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseOccupancyRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloseOccupancy(ctx context.Context, uuid UuidPath, body CloseOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloseOccupancyRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOccupancyStatusWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOccupancyStatusRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOccupancyStatus(ctx context.Context, uuid UuidPath, body UpdateOccupancyStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOccupancyStatusRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListOverheadRates(ctx context.Context, params *ListOverheadRatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListOverheadRatesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOverheadRateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOverheadRateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateOverheadRate(ctx context.Context, body CreateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateOverheadRateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteOverheadRate(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteOverheadRateRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOverheadRateWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOverheadRateRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateOverheadRate(ctx context.Context, uuid UuidPath, body UpdateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOverheadRateRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePackageFormatMaterial(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePackageFormatMaterialRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPackageFormats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPackageFormatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackageFormatWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageFormatRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackageFormat(ctx context.Context, body CreatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageFormatRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePackageFormat(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePackageFormatRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPackageFormat(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackageFormatRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePackageFormatWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePackageFormatRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdatePackageFormat(ctx context.Context, uuid UuidPath, body UpdatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePackageFormatRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPackageFormatMaterials(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPackageFormatMaterialsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackageFormatMaterialWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageFormatMaterialRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackageFormatMaterial(ctx context.Context, uuid UuidPath, body CreatePackageFormatMaterialJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackageFormatMaterialRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPackagingRuns(ctx context.Context, params *ListPackagingRunsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPackagingRunsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackagingRunWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackagingRunRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreatePackagingRun(ctx context.Context, body CreatePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePackagingRunRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckPackagingMaterialsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckPackagingMaterialsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckPackagingMaterials(ctx context.Context, body CheckPackagingMaterialsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckPackagingMaterialsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeletePackagingRun(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePackagingRunRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPackagingRun(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPackagingRunRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePackagingRunWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePackagingRunRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CompletePackagingRun(ctx context.Context, uuid UuidPath, body CompletePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompletePackagingRunRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportRecipeIngredientsWithBody(ctx context.Context, params *ImportRecipeIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportRecipeIngredientsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRecipes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRecipesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRecipeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecipeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRecipe(ctx context.Context, body CreateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecipeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRecipe(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRecipeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRecipe(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecipeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchRecipeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRecipeRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchRecipe(ctx context.Context, uuid UuidPath, body PatchRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchRecipeRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRecipeWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipeRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRecipe(ctx context.Context, uuid UuidPath, body UpdateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipeRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListRecipeIngredients(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRecipeIngredientsRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRecipeIngredientWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecipeIngredientRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateRecipeIngredient(ctx context.Context, uuid UuidPath, body CreateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRecipeIngredientRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRecipeIngredientRequest(c.Server, uuid, ingredientUuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRecipeIngredientRequest(c.Server, uuid, ingredientUuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRecipeIngredientWithBody(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipeIngredientRequestWithBody(c.Server, uuid, ingredientUuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateRecipeIngredient(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, body UpdateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRecipeIngredientRequest(c.Server, uuid, ingredientUuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ScanCode(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewScanCodeRequest(c.Server, code)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListStyles(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStylesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStyleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStyleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateStyle(ctx context.Context, body CreateStyleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateStyleRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStyle(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStyleRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTransfers(ctx context.Context, params *ListTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTransfersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransfer(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransferRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVessels(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVesselsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVesselWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVesselRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVessel(ctx context.Context, body CreateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVesselRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVessel(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVesselRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVesselWithBody(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVesselRequestWithBody(c.Server, uuid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateVessel(ctx context.Context, uuid UuidPath, body UpdateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVesselRequest(c.Server, uuid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVesselLabel(ctx context.Context, uuid UuidPath, params *GetVesselLabelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVesselLabelRequest(c.Server, uuid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVolumeRelations(ctx context.Context, params *ListVolumeRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVolumeRelationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVolumeRelationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRelationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVolumeRelation(ctx context.Context, body CreateVolumeRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRelationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVolumeRelation(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVolumeRelationRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListVolumes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVolumesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVolumeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateVolume(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVolumeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVolume(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVolumeRequest(c.Server, uuid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAdditionsRequest generates requests for ListAdditions
+func NewListAdditionsRequest(server string, params *ListAdditionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/additions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OccupancyUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "occupancy_uuid", runtime.ParamLocationQuery, *params.OccupancyUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.VolumeUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "volume_uuid", runtime.ParamLocationQuery, *params.VolumeUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateAdditionRequest calls the generic CreateAddition builder with application/json body
+func NewCreateAdditionRequest(server string, body CreateAdditionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAdditionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateAdditionRequestWithBody generates requests for CreateAddition with any type of body
+func NewCreateAdditionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/additions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdditionRequest generates requests for GetAddition
+func NewGetAdditionRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/additions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteBatchLaborRequest generates requests for DeleteBatchLabor
+func NewDeleteBatchLaborRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-labor/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBatchProcessPhasesRequest generates requests for ListBatchProcessPhases
+func NewListBatchProcessPhasesRequest(server string, params *ListBatchProcessPhasesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-process-phases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchProcessPhaseRequest calls the generic CreateBatchProcessPhase builder with application/json body
+func NewCreateBatchProcessPhaseRequest(server string, body CreateBatchProcessPhaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchProcessPhaseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBatchProcessPhaseRequestWithBody generates requests for CreateBatchProcessPhase with any type of body
+func NewCreateBatchProcessPhaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-process-phases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBatchProcessPhaseRequest generates requests for GetBatchProcessPhase
+func NewGetBatchProcessPhaseRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-process-phases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBatchRelationsRequest generates requests for ListBatchRelations
+func NewListBatchRelationsRequest(server string, params *ListBatchRelationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-relations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchRelationRequest calls the generic CreateBatchRelation builder with application/json body
+func NewCreateBatchRelationRequest(server string, body CreateBatchRelationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchRelationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBatchRelationRequestWithBody generates requests for CreateBatchRelation with any type of body
+func NewCreateBatchRelationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-relations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBatchRelationRequest generates requests for GetBatchRelation
+func NewGetBatchRelationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-relations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBatchVolumesRequest generates requests for ListBatchVolumes
+func NewListBatchVolumesRequest(server string, params *ListBatchVolumesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchVolumeRequest calls the generic CreateBatchVolume builder with application/json body
+func NewCreateBatchVolumeRequest(server string, body CreateBatchVolumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchVolumeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBatchVolumeRequestWithBody generates requests for CreateBatchVolume with any type of body
+func NewCreateBatchVolumeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBatchVolumeRequest generates requests for GetBatchVolume
+func NewGetBatchVolumeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch-volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBatchesRequest generates requests for ListBatches
+func NewListBatchesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchRequest calls the generic CreateBatch builder with application/json body
+func NewCreateBatchRequest(server string, body CreateBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBatchRequestWithBody generates requests for CreateBatch with any type of body
+func NewCreateBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportBatchesRequestWithBody generates requests for ImportBatches with any type of body
+func NewImportBatchesRequestWithBody(server string, params *ImportBatchesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteBatchRequest generates requests for DeleteBatch
+func NewDeleteBatchRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBatchRequest generates requests for GetBatch
+func NewGetBatchRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBatchRequest calls the generic UpdateBatch builder with application/json body
+func NewUpdateBatchRequest(server string, uuid UuidPath, body UpdateBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBatchRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateBatchRequestWithBody generates requests for UpdateBatch with any type of body
+func NewUpdateBatchRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewProposeBatchAllocationRequest calls the generic ProposeBatchAllocation builder with application/json body
+func NewProposeBatchAllocationRequest(server string, uuid UuidPath, body ProposeBatchAllocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewProposeBatchAllocationRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewProposeBatchAllocationRequestWithBody generates requests for ProposeBatchAllocation with any type of body
+func NewProposeBatchAllocationRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/allocation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewConfirmBatchAllocationRequest calls the generic ConfirmBatchAllocation builder with application/json body
+func NewConfirmBatchAllocationRequest(server string, uuid UuidPath, body ConfirmBatchAllocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConfirmBatchAllocationRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewConfirmBatchAllocationRequestWithBody generates requests for ConfirmBatchAllocation with any type of body
+func NewConfirmBatchAllocationRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/allocation/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBatchCostsRequest generates requests for GetBatchCosts
+func NewGetBatchCostsRequest(server string, uuid UuidPath, params *GetBatchCostsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/costs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Live != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "live", runtime.ParamLocationQuery, *params.Live); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSnapshotBatchCostsRequest generates requests for SnapshotBatchCosts
+func NewSnapshotBatchCostsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/costs/snapshot", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBatchLaborRequest generates requests for ListBatchLabor
+func NewListBatchLaborRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/labor", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBatchLaborRequest calls the generic CreateBatchLabor builder with application/json body
+func NewCreateBatchLaborRequest(server string, uuid UuidPath, body CreateBatchLaborJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBatchLaborRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreateBatchLaborRequestWithBody generates requests for CreateBatchLabor with any type of body
+func NewCreateBatchLaborRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/labor", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBatchSummaryRequest generates requests for GetBatchSummary
+func NewGetBatchSummaryRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batches/%s/summary", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBrewSessionsRequest generates requests for ListBrewSessions
+func NewListBrewSessionsRequest(server string, params *ListBrewSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/brew-sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBrewSessionRequest calls the generic CreateBrewSession builder with application/json body
+func NewCreateBrewSessionRequest(server string, body CreateBrewSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBrewSessionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBrewSessionRequestWithBody generates requests for CreateBrewSession with any type of body
+func NewCreateBrewSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/brew-sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBrewSessionRequest generates requests for GetBrewSession
+func NewGetBrewSessionRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/brew-sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBrewSessionRequest calls the generic UpdateBrewSession builder with application/json body
+func NewUpdateBrewSessionRequest(server string, uuid UuidPath, body UpdateBrewSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateBrewSessionRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateBrewSessionRequestWithBody generates requests for UpdateBrewSession with any type of body
+func NewUpdateBrewSessionRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/brew-sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListMeasurementsRequest generates requests for ListMeasurements
+func NewListMeasurementsRequest(server string, params *ListMeasurementsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/measurements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OccupancyUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "occupancy_uuid", runtime.ParamLocationQuery, *params.OccupancyUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.VolumeUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "volume_uuid", runtime.ParamLocationQuery, *params.VolumeUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateMeasurementRequest calls the generic CreateMeasurement builder with application/json body
+func NewCreateMeasurementRequest(server string, body CreateMeasurementJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMeasurementRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateMeasurementRequestWithBody generates requests for CreateMeasurement with any type of body
+func NewCreateMeasurementRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/measurements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeasurementRequest generates requests for GetMeasurement
+func NewGetMeasurementRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/measurements/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPreviewMigrationRequestWithBody generates requests for PreviewMigration with any type of body
+func NewPreviewMigrationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/migrations/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApplyProductionMigrationRequest calls the generic ApplyProductionMigration builder with application/json body
+func NewApplyProductionMigrationRequest(server string, params *ApplyProductionMigrationParams, body ApplyProductionMigrationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApplyProductionMigrationRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewApplyProductionMigrationRequestWithBody generates requests for ApplyProductionMigration with any type of body
+func NewApplyProductionMigrationRequestWithBody(server string, params *ApplyProductionMigrationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/migrations/production")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateMrpPurchaseOrdersRequest calls the generic CreateMrpPurchaseOrders builder with application/json body
+func NewCreateMrpPurchaseOrdersRequest(server string, body CreateMrpPurchaseOrdersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMrpPurchaseOrdersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateMrpPurchaseOrdersRequestWithBody generates requests for CreateMrpPurchaseOrders with any type of body
+func NewCreateMrpPurchaseOrdersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mrp/purchase-orders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListMrpShortagesRequest generates requests for ListMrpShortages
+func NewListMrpShortagesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mrp/shortages")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListOccupanciesRequest generates requests for ListOccupancies
+func NewListOccupanciesRequest(server string, params *ListOccupanciesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, params.Active); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOccupancyRequest calls the generic CreateOccupancy builder with application/json body
+func NewCreateOccupancyRequest(server string, body CreateOccupancyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOccupancyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOccupancyRequestWithBody generates requests for CreateOccupancy with any type of body
+func NewCreateOccupancyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetActiveOccupancyRequest generates requests for GetActiveOccupancy
+func NewGetActiveOccupancyRequest(server string, params *GetActiveOccupancyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies/active")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ActiveVesselUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active_vessel_uuid", runtime.ParamLocationQuery, *params.ActiveVesselUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActiveVolumeUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active_volume_uuid", runtime.ParamLocationQuery, *params.ActiveVolumeUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOccupancyRequest generates requests for GetOccupancy
+func NewGetOccupancyRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCloseOccupancyRequest calls the generic CloseOccupancy builder with application/json body
+func NewCloseOccupancyRequest(server string, uuid UuidPath, body CloseOccupancyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCloseOccupancyRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCloseOccupancyRequestWithBody generates requests for CloseOccupancy with any type of body
+func NewCloseOccupancyRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies/%s/close", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateOccupancyStatusRequest calls the generic UpdateOccupancyStatus builder with application/json body
+func NewUpdateOccupancyStatusRequest(server string, uuid UuidPath, body UpdateOccupancyStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOccupancyStatusRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateOccupancyStatusRequestWithBody generates requests for UpdateOccupancyStatus with any type of body
+func NewUpdateOccupancyStatusRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/occupancies/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListOverheadRatesRequest generates requests for ListOverheadRates
+func NewListOverheadRatesRequest(server string, params *ListOverheadRatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/overhead-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateOverheadRateRequest calls the generic CreateOverheadRate builder with application/json body
+func NewCreateOverheadRateRequest(server string, body CreateOverheadRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOverheadRateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateOverheadRateRequestWithBody generates requests for CreateOverheadRate with any type of body
+func NewCreateOverheadRateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/overhead-rates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteOverheadRateRequest generates requests for DeleteOverheadRate
+func NewDeleteOverheadRateRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/overhead-rates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOverheadRateRequest calls the generic UpdateOverheadRate builder with application/json body
+func NewUpdateOverheadRateRequest(server string, uuid UuidPath, body UpdateOverheadRateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOverheadRateRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateOverheadRateRequestWithBody generates requests for UpdateOverheadRate with any type of body
+func NewUpdateOverheadRateRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/overhead-rates/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePackageFormatMaterialRequest generates requests for DeletePackageFormatMaterial
+func NewDeletePackageFormatMaterialRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-format-materials/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListPackageFormatsRequest generates requests for ListPackageFormats
+func NewListPackageFormatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePackageFormatRequest calls the generic CreatePackageFormat builder with application/json body
+func NewCreatePackageFormatRequest(server string, body CreatePackageFormatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePackageFormatRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePackageFormatRequestWithBody generates requests for CreatePackageFormat with any type of body
+func NewCreatePackageFormatRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePackageFormatRequest generates requests for DeletePackageFormat
+func NewDeletePackageFormatRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPackageFormatRequest generates requests for GetPackageFormat
+func NewGetPackageFormatRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdatePackageFormatRequest calls the generic UpdatePackageFormat builder with application/json body
+func NewUpdatePackageFormatRequest(server string, uuid UuidPath, body UpdatePackageFormatJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdatePackageFormatRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdatePackageFormatRequestWithBody generates requests for UpdatePackageFormat with any type of body
+func NewUpdatePackageFormatRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPackageFormatMaterialsRequest generates requests for ListPackageFormatMaterials
+func NewListPackageFormatMaterialsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats/%s/materials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePackageFormatMaterialRequest calls the generic CreatePackageFormatMaterial builder with application/json body
+func NewCreatePackageFormatMaterialRequest(server string, uuid UuidPath, body CreatePackageFormatMaterialJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePackageFormatMaterialRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreatePackageFormatMaterialRequestWithBody generates requests for CreatePackageFormatMaterial with any type of body
+func NewCreatePackageFormatMaterialRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/package-formats/%s/materials", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPackagingRunsRequest generates requests for ListPackagingRuns
+func NewListPackagingRunsRequest(server string, params *ListPackagingRunsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePackagingRunRequest calls the generic CreatePackagingRun builder with application/json body
+func NewCreatePackagingRunRequest(server string, body CreatePackagingRunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePackagingRunRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreatePackagingRunRequestWithBody generates requests for CreatePackagingRun with any type of body
+func NewCreatePackagingRunRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCheckPackagingMaterialsRequest calls the generic CheckPackagingMaterials builder with application/json body
+func NewCheckPackagingMaterialsRequest(server string, body CheckPackagingMaterialsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCheckPackagingMaterialsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCheckPackagingMaterialsRequestWithBody generates requests for CheckPackagingMaterials with any type of body
+func NewCheckPackagingMaterialsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs/material-check")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeletePackagingRunRequest generates requests for DeletePackagingRun
+func NewDeletePackagingRunRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPackagingRunRequest generates requests for GetPackagingRun
+func NewGetPackagingRunRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCompletePackagingRunRequest calls the generic CompletePackagingRun builder with application/json body
+func NewCompletePackagingRunRequest(server string, uuid UuidPath, body CompletePackagingRunJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCompletePackagingRunRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCompletePackagingRunRequestWithBody generates requests for CompletePackagingRun with any type of body
+func NewCompletePackagingRunRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/packaging-runs/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewImportRecipeIngredientsRequestWithBody generates requests for ImportRecipeIngredients with any type of body
+func NewImportRecipeIngredientsRequestWithBody(server string, params *ImportRecipeIngredientsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipe-ingredients/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRecipesRequest generates requests for ListRecipes
+func NewListRecipesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRecipeRequest calls the generic CreateRecipe builder with application/json body
+func NewCreateRecipeRequest(server string, body CreateRecipeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRecipeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRecipeRequestWithBody generates requests for CreateRecipe with any type of body
+func NewCreateRecipeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRecipeRequest generates requests for DeleteRecipe
+func NewDeleteRecipeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRecipeRequest generates requests for GetRecipe
+func NewGetRecipeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPatchRecipeRequest calls the generic PatchRecipe builder with application/json body
+func NewPatchRecipeRequest(server string, uuid UuidPath, body PatchRecipeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPatchRecipeRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewPatchRecipeRequestWithBody generates requests for PatchRecipe with any type of body
+func NewPatchRecipeRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateRecipeRequest calls the generic UpdateRecipe builder with application/json body
+func NewUpdateRecipeRequest(server string, uuid UuidPath, body UpdateRecipeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRecipeRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateRecipeRequestWithBody generates requests for UpdateRecipe with any type of body
+func NewUpdateRecipeRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRecipeIngredientsRequest generates requests for ListRecipeIngredients
+func NewListRecipeIngredientsRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s/ingredients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRecipeIngredientRequest calls the generic CreateRecipeIngredient builder with application/json body
+func NewCreateRecipeIngredientRequest(server string, uuid UuidPath, body CreateRecipeIngredientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRecipeIngredientRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewCreateRecipeIngredientRequestWithBody generates requests for CreateRecipeIngredient with any type of body
+func NewCreateRecipeIngredientRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s/ingredients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRecipeIngredientRequest generates requests for DeleteRecipeIngredient
+func NewDeleteRecipeIngredientRequest(server string, uuid UuidPath, ingredientUuid IngredientUuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ingredient_uuid", runtime.ParamLocationPath, ingredientUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s/ingredients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRecipeIngredientRequest generates requests for GetRecipeIngredient
+func NewGetRecipeIngredientRequest(server string, uuid UuidPath, ingredientUuid IngredientUuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ingredient_uuid", runtime.ParamLocationPath, ingredientUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s/ingredients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRecipeIngredientRequest calls the generic UpdateRecipeIngredient builder with application/json body
+func NewUpdateRecipeIngredientRequest(server string, uuid UuidPath, ingredientUuid IngredientUuidPath, body UpdateRecipeIngredientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRecipeIngredientRequestWithBody(server, uuid, ingredientUuid, "application/json", bodyReader)
+}
+
+// NewUpdateRecipeIngredientRequestWithBody generates requests for UpdateRecipeIngredient with any type of body
+func NewUpdateRecipeIngredientRequestWithBody(server string, uuid UuidPath, ingredientUuid IngredientUuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ingredient_uuid", runtime.ParamLocationPath, ingredientUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/recipes/%s/ingredients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewScanCodeRequest generates requests for ScanCode
+func NewScanCodeRequest(server string, code CodePath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "code", runtime.ParamLocationPath, code)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/scan/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListStylesRequest generates requests for ListStyles
+func NewListStylesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/styles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateStyleRequest calls the generic CreateStyle builder with application/json body
+func NewCreateStyleRequest(server string, body CreateStyleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateStyleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateStyleRequestWithBody generates requests for CreateStyle with any type of body
+func NewCreateStyleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/styles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStyleRequest generates requests for GetStyle
+func NewGetStyleRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/styles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListTransfersRequest generates requests for ListTransfers
+func NewListTransfersRequest(server string, params *ListTransfersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BatchUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "batch_uuid", runtime.ParamLocationQuery, *params.BatchUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransferRequest calls the generic CreateTransfer builder with application/json body
+func NewCreateTransferRequest(server string, body CreateTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTransferRequestWithBody generates requests for CreateTransfer with any type of body
+func NewCreateTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransferRequest generates requests for GetTransfer
+func NewGetTransferRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVesselsRequest generates requests for ListVessels
+func NewListVesselsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vessels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVesselRequest calls the generic CreateVessel builder with application/json body
+func NewCreateVesselRequest(server string, body CreateVesselJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVesselRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateVesselRequestWithBody generates requests for CreateVessel with any type of body
+func NewCreateVesselRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vessels")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVesselRequest generates requests for GetVessel
+func NewGetVesselRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vessels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateVesselRequest calls the generic UpdateVessel builder with application/json body
+func NewUpdateVesselRequest(server string, uuid UuidPath, body UpdateVesselJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateVesselRequestWithBody(server, uuid, "application/json", bodyReader)
+}
+
+// NewUpdateVesselRequestWithBody generates requests for UpdateVessel with any type of body
+func NewUpdateVesselRequestWithBody(server string, uuid UuidPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vessels/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVesselLabelRequest generates requests for GetVesselLabel
+func NewGetVesselLabelRequest(server string, uuid UuidPath, params *GetVesselLabelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/vessels/%s/label", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Copies != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "copies", runtime.ParamLocationQuery, *params.Copies); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TemplateUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "template_uuid", runtime.ParamLocationQuery, *params.TemplateUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVolumeRelationsRequest generates requests for ListVolumeRelations
+func NewListVolumeRelationsRequest(server string, params *ListVolumeRelationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volume-relations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.VolumeUuid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "volume_uuid", runtime.ParamLocationQuery, *params.VolumeUuid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVolumeRelationRequest calls the generic CreateVolumeRelation builder with application/json body
+func NewCreateVolumeRelationRequest(server string, body CreateVolumeRelationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVolumeRelationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateVolumeRelationRequestWithBody generates requests for CreateVolumeRelation with any type of body
+func NewCreateVolumeRelationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volume-relations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVolumeRelationRequest generates requests for GetVolumeRelation
+func NewGetVolumeRelationRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volume-relations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListVolumesRequest generates requests for ListVolumes
+func NewListVolumesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateVolumeRequest calls the generic CreateVolume builder with application/json body
+func NewCreateVolumeRequest(server string, body CreateVolumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVolumeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateVolumeRequestWithBody generates requests for CreateVolume with any type of body
+func NewCreateVolumeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volumes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetVolumeRequest generates requests for GetVolume
+func NewGetVolumeRequest(server string, uuid UuidPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "uuid", runtime.ParamLocationPath, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/volumes/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAdditionsWithResponse request
+	ListAdditionsWithResponse(ctx context.Context, params *ListAdditionsParams, reqEditors ...RequestEditorFn) (*ListAdditionsHTTPResponse, error)
+
+	// CreateAdditionWithBodyWithResponse request with any body
+	CreateAdditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdditionHTTPResponse, error)
+
+	CreateAdditionWithResponse(ctx context.Context, body CreateAdditionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdditionHTTPResponse, error)
+
+	// GetAdditionWithResponse request
+	GetAdditionWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetAdditionHTTPResponse, error)
+
+	// DeleteBatchLaborWithResponse request
+	DeleteBatchLaborWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBatchLaborHTTPResponse, error)
+
+	// ListBatchProcessPhasesWithResponse request
+	ListBatchProcessPhasesWithResponse(ctx context.Context, params *ListBatchProcessPhasesParams, reqEditors ...RequestEditorFn) (*ListBatchProcessPhasesHTTPResponse, error)
+
+	// CreateBatchProcessPhaseWithBodyWithResponse request with any body
+	CreateBatchProcessPhaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchProcessPhaseHTTPResponse, error)
+
+	CreateBatchProcessPhaseWithResponse(ctx context.Context, body CreateBatchProcessPhaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchProcessPhaseHTTPResponse, error)
+
+	// GetBatchProcessPhaseWithResponse request
+	GetBatchProcessPhaseWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchProcessPhaseHTTPResponse, error)
+
+	// ListBatchRelationsWithResponse request
+	ListBatchRelationsWithResponse(ctx context.Context, params *ListBatchRelationsParams, reqEditors ...RequestEditorFn) (*ListBatchRelationsHTTPResponse, error)
+
+	// CreateBatchRelationWithBodyWithResponse request with any body
+	CreateBatchRelationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchRelationHTTPResponse, error)
+
+	CreateBatchRelationWithResponse(ctx context.Context, body CreateBatchRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchRelationHTTPResponse, error)
+
+	// GetBatchRelationWithResponse request
+	GetBatchRelationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchRelationHTTPResponse, error)
+
+	// ListBatchVolumesWithResponse request
+	ListBatchVolumesWithResponse(ctx context.Context, params *ListBatchVolumesParams, reqEditors ...RequestEditorFn) (*ListBatchVolumesHTTPResponse, error)
+
+	// CreateBatchVolumeWithBodyWithResponse request with any body
+	CreateBatchVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchVolumeHTTPResponse, error)
+
+	CreateBatchVolumeWithResponse(ctx context.Context, body CreateBatchVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchVolumeHTTPResponse, error)
+
+	// GetBatchVolumeWithResponse request
+	GetBatchVolumeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchVolumeHTTPResponse, error)
+
+	// ListBatchesWithResponse request
+	ListBatchesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBatchesHTTPResponse, error)
+
+	// CreateBatchWithBodyWithResponse request with any body
+	CreateBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchHTTPResponse, error)
+
+	CreateBatchWithResponse(ctx context.Context, body CreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchHTTPResponse, error)
+
+	// ImportBatchesWithBodyWithResponse request with any body
+	ImportBatchesWithBodyWithResponse(ctx context.Context, params *ImportBatchesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBatchesHTTPResponse, error)
+
+	// DeleteBatchWithResponse request
+	DeleteBatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBatchHTTPResponse, error)
+
+	// GetBatchWithResponse request
+	GetBatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchHTTPResponse, error)
+
+	// UpdateBatchWithBodyWithResponse request with any body
+	UpdateBatchWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBatchHTTPResponse, error)
+
+	UpdateBatchWithResponse(ctx context.Context, uuid UuidPath, body UpdateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBatchHTTPResponse, error)
+
+	// ProposeBatchAllocationWithBodyWithResponse request with any body
+	ProposeBatchAllocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProposeBatchAllocationHTTPResponse, error)
+
+	ProposeBatchAllocationWithResponse(ctx context.Context, uuid UuidPath, body ProposeBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*ProposeBatchAllocationHTTPResponse, error)
+
+	// ConfirmBatchAllocationWithBodyWithResponse request with any body
+	ConfirmBatchAllocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmBatchAllocationHTTPResponse, error)
+
+	ConfirmBatchAllocationWithResponse(ctx context.Context, uuid UuidPath, body ConfirmBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmBatchAllocationHTTPResponse, error)
+
+	// GetBatchCostsWithResponse request
+	GetBatchCostsWithResponse(ctx context.Context, uuid UuidPath, params *GetBatchCostsParams, reqEditors ...RequestEditorFn) (*GetBatchCostsHTTPResponse, error)
+
+	// SnapshotBatchCostsWithResponse request
+	SnapshotBatchCostsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*SnapshotBatchCostsHTTPResponse, error)
+
+	// ListBatchLaborWithResponse request
+	ListBatchLaborWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListBatchLaborHTTPResponse, error)
+
+	// CreateBatchLaborWithBodyWithResponse request with any body
+	CreateBatchLaborWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchLaborHTTPResponse, error)
+
+	CreateBatchLaborWithResponse(ctx context.Context, uuid UuidPath, body CreateBatchLaborJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchLaborHTTPResponse, error)
+
+	// GetBatchSummaryWithResponse request
+	GetBatchSummaryWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchSummaryHTTPResponse, error)
+
+	// ListBrewSessionsWithResponse request
+	ListBrewSessionsWithResponse(ctx context.Context, params *ListBrewSessionsParams, reqEditors ...RequestEditorFn) (*ListBrewSessionsHTTPResponse, error)
+
+	// CreateBrewSessionWithBodyWithResponse request with any body
+	CreateBrewSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBrewSessionHTTPResponse, error)
+
+	CreateBrewSessionWithResponse(ctx context.Context, body CreateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBrewSessionHTTPResponse, error)
+
+	// GetBrewSessionWithResponse request
+	GetBrewSessionWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBrewSessionHTTPResponse, error)
+
+	// UpdateBrewSessionWithBodyWithResponse request with any body
+	UpdateBrewSessionWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBrewSessionHTTPResponse, error)
+
+	UpdateBrewSessionWithResponse(ctx context.Context, uuid UuidPath, body UpdateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBrewSessionHTTPResponse, error)
+
+	// ListMeasurementsWithResponse request
+	ListMeasurementsWithResponse(ctx context.Context, params *ListMeasurementsParams, reqEditors ...RequestEditorFn) (*ListMeasurementsHTTPResponse, error)
+
+	// CreateMeasurementWithBodyWithResponse request with any body
+	CreateMeasurementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeasurementHTTPResponse, error)
+
+	CreateMeasurementWithResponse(ctx context.Context, body CreateMeasurementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeasurementHTTPResponse, error)
+
+	// GetMeasurementWithResponse request
+	GetMeasurementWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetMeasurementHTTPResponse, error)
+
+	// PreviewMigrationWithBodyWithResponse request with any body
+	PreviewMigrationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewMigrationHTTPResponse, error)
+
+	// ApplyProductionMigrationWithBodyWithResponse request with any body
+	ApplyProductionMigrationWithBodyWithResponse(ctx context.Context, params *ApplyProductionMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyProductionMigrationHTTPResponse, error)
+
+	ApplyProductionMigrationWithResponse(ctx context.Context, params *ApplyProductionMigrationParams, body ApplyProductionMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyProductionMigrationHTTPResponse, error)
+
+	// CreateMrpPurchaseOrdersWithBodyWithResponse request with any body
+	CreateMrpPurchaseOrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMrpPurchaseOrdersHTTPResponse, error)
+
+	CreateMrpPurchaseOrdersWithResponse(ctx context.Context, body CreateMrpPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMrpPurchaseOrdersHTTPResponse, error)
+
+	// ListMrpShortagesWithResponse request
+	ListMrpShortagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMrpShortagesHTTPResponse, error)
+
+	// ListOccupanciesWithResponse request
+	ListOccupanciesWithResponse(ctx context.Context, params *ListOccupanciesParams, reqEditors ...RequestEditorFn) (*ListOccupanciesHTTPResponse, error)
+
+	// CreateOccupancyWithBodyWithResponse request with any body
+	CreateOccupancyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOccupancyHTTPResponse, error)
+
+	CreateOccupancyWithResponse(ctx context.Context, body CreateOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOccupancyHTTPResponse, error)
+
+	// GetActiveOccupancyWithResponse request
+	GetActiveOccupancyWithResponse(ctx context.Context, params *GetActiveOccupancyParams, reqEditors ...RequestEditorFn) (*GetActiveOccupancyHTTPResponse, error)
+
+	// GetOccupancyWithResponse request
+	GetOccupancyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetOccupancyHTTPResponse, error)
+
+	// CloseOccupancyWithBodyWithResponse request with any body
+	CloseOccupancyWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseOccupancyHTTPResponse, error)
+
+	CloseOccupancyWithResponse(ctx context.Context, uuid UuidPath, body CloseOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseOccupancyHTTPResponse, error)
+
+	// UpdateOccupancyStatusWithBodyWithResponse request with any body
+	UpdateOccupancyStatusWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOccupancyStatusHTTPResponse, error)
+
+	UpdateOccupancyStatusWithResponse(ctx context.Context, uuid UuidPath, body UpdateOccupancyStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOccupancyStatusHTTPResponse, error)
+
+	// ListOverheadRatesWithResponse request
+	ListOverheadRatesWithResponse(ctx context.Context, params *ListOverheadRatesParams, reqEditors ...RequestEditorFn) (*ListOverheadRatesHTTPResponse, error)
+
+	// CreateOverheadRateWithBodyWithResponse request with any body
+	CreateOverheadRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOverheadRateHTTPResponse, error)
+
+	CreateOverheadRateWithResponse(ctx context.Context, body CreateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOverheadRateHTTPResponse, error)
+
+	// DeleteOverheadRateWithResponse request
+	DeleteOverheadRateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteOverheadRateHTTPResponse, error)
+
+	// UpdateOverheadRateWithBodyWithResponse request with any body
+	UpdateOverheadRateWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOverheadRateHTTPResponse, error)
+
+	UpdateOverheadRateWithResponse(ctx context.Context, uuid UuidPath, body UpdateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOverheadRateHTTPResponse, error)
+
+	// DeletePackageFormatMaterialWithResponse request
+	DeletePackageFormatMaterialWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackageFormatMaterialHTTPResponse, error)
+
+	// ListPackageFormatsWithResponse request
+	ListPackageFormatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPackageFormatsHTTPResponse, error)
+
+	// CreatePackageFormatWithBodyWithResponse request with any body
+	CreatePackageFormatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageFormatHTTPResponse, error)
+
+	CreatePackageFormatWithResponse(ctx context.Context, body CreatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackageFormatHTTPResponse, error)
+
+	// DeletePackageFormatWithResponse request
+	DeletePackageFormatWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackageFormatHTTPResponse, error)
+
+	// GetPackageFormatWithResponse request
+	GetPackageFormatWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPackageFormatHTTPResponse, error)
+
+	// UpdatePackageFormatWithBodyWithResponse request with any body
+	UpdatePackageFormatWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePackageFormatHTTPResponse, error)
+
+	UpdatePackageFormatWithResponse(ctx context.Context, uuid UuidPath, body UpdatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePackageFormatHTTPResponse, error)
+
+	// ListPackageFormatMaterialsWithResponse request
+	ListPackageFormatMaterialsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListPackageFormatMaterialsHTTPResponse, error)
+
+	// CreatePackageFormatMaterialWithBodyWithResponse request with any body
+	CreatePackageFormatMaterialWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageFormatMaterialHTTPResponse, error)
+
+	CreatePackageFormatMaterialWithResponse(ctx context.Context, uuid UuidPath, body CreatePackageFormatMaterialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackageFormatMaterialHTTPResponse, error)
+
+	// ListPackagingRunsWithResponse request
+	ListPackagingRunsWithResponse(ctx context.Context, params *ListPackagingRunsParams, reqEditors ...RequestEditorFn) (*ListPackagingRunsHTTPResponse, error)
+
+	// CreatePackagingRunWithBodyWithResponse request with any body
+	CreatePackagingRunWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackagingRunHTTPResponse, error)
+
+	CreatePackagingRunWithResponse(ctx context.Context, body CreatePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackagingRunHTTPResponse, error)
+
+	// CheckPackagingMaterialsWithBodyWithResponse request with any body
+	CheckPackagingMaterialsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckPackagingMaterialsHTTPResponse, error)
+
+	CheckPackagingMaterialsWithResponse(ctx context.Context, body CheckPackagingMaterialsJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckPackagingMaterialsHTTPResponse, error)
+
+	// DeletePackagingRunWithResponse request
+	DeletePackagingRunWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackagingRunHTTPResponse, error)
+
+	// GetPackagingRunWithResponse request
+	GetPackagingRunWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPackagingRunHTTPResponse, error)
+
+	// CompletePackagingRunWithBodyWithResponse request with any body
+	CompletePackagingRunWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePackagingRunHTTPResponse, error)
+
+	CompletePackagingRunWithResponse(ctx context.Context, uuid UuidPath, body CompletePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePackagingRunHTTPResponse, error)
+
+	// ImportRecipeIngredientsWithBodyWithResponse request with any body
+	ImportRecipeIngredientsWithBodyWithResponse(ctx context.Context, params *ImportRecipeIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportRecipeIngredientsHTTPResponse, error)
+
+	// ListRecipesWithResponse request
+	ListRecipesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRecipesHTTPResponse, error)
+
+	// CreateRecipeWithBodyWithResponse request with any body
+	CreateRecipeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecipeHTTPResponse, error)
+
+	CreateRecipeWithResponse(ctx context.Context, body CreateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecipeHTTPResponse, error)
+
+	// DeleteRecipeWithResponse request
+	DeleteRecipeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteRecipeHTTPResponse, error)
+
+	// GetRecipeWithResponse request
+	GetRecipeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetRecipeHTTPResponse, error)
+
+	// PatchRecipeWithBodyWithResponse request with any body
+	PatchRecipeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRecipeHTTPResponse, error)
+
+	PatchRecipeWithResponse(ctx context.Context, uuid UuidPath, body PatchRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRecipeHTTPResponse, error)
+
+	// UpdateRecipeWithBodyWithResponse request with any body
+	UpdateRecipeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipeHTTPResponse, error)
+
+	UpdateRecipeWithResponse(ctx context.Context, uuid UuidPath, body UpdateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipeHTTPResponse, error)
+
+	// ListRecipeIngredientsWithResponse request
+	ListRecipeIngredientsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListRecipeIngredientsHTTPResponse, error)
+
+	// CreateRecipeIngredientWithBodyWithResponse request with any body
+	CreateRecipeIngredientWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecipeIngredientHTTPResponse, error)
+
+	CreateRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, body CreateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecipeIngredientHTTPResponse, error)
+
+	// DeleteRecipeIngredientWithResponse request
+	DeleteRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*DeleteRecipeIngredientHTTPResponse, error)
+
+	// GetRecipeIngredientWithResponse request
+	GetRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*GetRecipeIngredientHTTPResponse, error)
+
+	// UpdateRecipeIngredientWithBodyWithResponse request with any body
+	UpdateRecipeIngredientWithBodyWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipeIngredientHTTPResponse, error)
+
+	UpdateRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, body UpdateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipeIngredientHTTPResponse, error)
+
+	// ScanCodeWithResponse request
+	ScanCodeWithResponse(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*ScanCodeHTTPResponse, error)
+
+	// ListStylesWithResponse request
+	ListStylesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStylesHTTPResponse, error)
+
+	// CreateStyleWithBodyWithResponse request with any body
+	CreateStyleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStyleHTTPResponse, error)
+
+	CreateStyleWithResponse(ctx context.Context, body CreateStyleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStyleHTTPResponse, error)
+
+	// GetStyleWithResponse request
+	GetStyleWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetStyleHTTPResponse, error)
+
+	// ListTransfersWithResponse request
+	ListTransfersWithResponse(ctx context.Context, params *ListTransfersParams, reqEditors ...RequestEditorFn) (*ListTransfersHTTPResponse, error)
+
+	// CreateTransferWithBodyWithResponse request with any body
+	CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferHTTPResponse, error)
+
+	CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferHTTPResponse, error)
+
+	// GetTransferWithResponse request
+	GetTransferWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetTransferHTTPResponse, error)
+
+	// ListVesselsWithResponse request
+	ListVesselsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVesselsHTTPResponse, error)
+
+	// CreateVesselWithBodyWithResponse request with any body
+	CreateVesselWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVesselHTTPResponse, error)
+
+	CreateVesselWithResponse(ctx context.Context, body CreateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVesselHTTPResponse, error)
+
+	// GetVesselWithResponse request
+	GetVesselWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVesselHTTPResponse, error)
+
+	// UpdateVesselWithBodyWithResponse request with any body
+	UpdateVesselWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVesselHTTPResponse, error)
+
+	UpdateVesselWithResponse(ctx context.Context, uuid UuidPath, body UpdateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVesselHTTPResponse, error)
+
+	// GetVesselLabelWithResponse request
+	GetVesselLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetVesselLabelParams, reqEditors ...RequestEditorFn) (*GetVesselLabelHTTPResponse, error)
+
+	// ListVolumeRelationsWithResponse request
+	ListVolumeRelationsWithResponse(ctx context.Context, params *ListVolumeRelationsParams, reqEditors ...RequestEditorFn) (*ListVolumeRelationsHTTPResponse, error)
+
+	// CreateVolumeRelationWithBodyWithResponse request with any body
+	CreateVolumeRelationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeRelationHTTPResponse, error)
+
+	CreateVolumeRelationWithResponse(ctx context.Context, body CreateVolumeRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeRelationHTTPResponse, error)
+
+	// GetVolumeRelationWithResponse request
+	GetVolumeRelationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVolumeRelationHTTPResponse, error)
+
+	// ListVolumesWithResponse request
+	ListVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVolumesHTTPResponse, error)
+
+	// CreateVolumeWithBodyWithResponse request with any body
+	CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeHTTPResponse, error)
+
+	CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeHTTPResponse, error)
+
+	// GetVolumeWithResponse request
+	GetVolumeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVolumeHTTPResponse, error)
+}
+
+type ListAdditionsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AdditionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAdditionsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAdditionsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAdditionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AdditionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAdditionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAdditionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdditionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AdditionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdditionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdditionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBatchLaborHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBatchLaborHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBatchLaborHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchProcessPhasesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchProcessPhaseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchProcessPhasesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchProcessPhasesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchProcessPhaseHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchProcessPhaseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchProcessPhaseHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchProcessPhaseHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchProcessPhaseHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchProcessPhaseResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchProcessPhaseHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchProcessPhaseHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchRelationsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchRelationsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchRelationsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchRelationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchRelationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchRelationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchRelationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchRelationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchRelationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchVolumesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchVolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchVolumesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchVolumesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchVolumeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchVolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchVolumeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchVolumeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchVolumeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchVolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchVolumeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchVolumeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportBatchesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchImportResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportBatchesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportBatchesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBatchHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBatchHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBatchHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBatchHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBatchHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBatchHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProposeBatchAllocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchAllocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ProposeBatchAllocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProposeBatchAllocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConfirmBatchAllocationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ConfirmBatchAllocationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ConfirmBatchAllocationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConfirmBatchAllocationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchCostsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchCostsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchCostsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchCostsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SnapshotBatchCostsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchCostsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SnapshotBatchCostsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SnapshotBatchCostsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBatchLaborHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BatchLaborEntryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBatchLaborHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBatchLaborHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBatchLaborHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BatchLaborEntryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBatchLaborHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBatchLaborHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBatchSummaryHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchSummaryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBatchSummaryHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBatchSummaryHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBrewSessionsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BrewSessionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBrewSessionsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBrewSessionsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBrewSessionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BrewSessionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBrewSessionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBrewSessionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBrewSessionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrewSessionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBrewSessionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBrewSessionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBrewSessionHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrewSessionResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBrewSessionHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBrewSessionHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMeasurementsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MeasurementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMeasurementsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMeasurementsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMeasurementHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *MeasurementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMeasurementHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMeasurementHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeasurementHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MeasurementResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeasurementHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeasurementHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewMigrationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Plan
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewMigrationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewMigrationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApplyProductionMigrationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BrewimportResult
+}
+
+// Status returns HTTPResponse.Status
+func (r ApplyProductionMigrationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApplyProductionMigrationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMrpPurchaseOrdersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *MRPPurchaseOrdersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMrpPurchaseOrdersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMrpPurchaseOrdersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMrpShortagesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MRPResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMrpShortagesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMrpShortagesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOccupanciesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOccupanciesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOccupanciesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOccupancyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOccupancyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOccupancyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetActiveOccupancyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetActiveOccupancyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetActiveOccupancyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOccupancyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOccupancyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOccupancyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloseOccupancyHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CloseOccupancyHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloseOccupancyHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOccupancyStatusHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OccupancyResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOccupancyStatusHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOccupancyStatusHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListOverheadRatesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OverheadRateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListOverheadRatesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListOverheadRatesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOverheadRateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OverheadRateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOverheadRateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOverheadRateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteOverheadRateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteOverheadRateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteOverheadRateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOverheadRateHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OverheadRateResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOverheadRateHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOverheadRateHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePackageFormatMaterialHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePackageFormatMaterialHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePackageFormatMaterialHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPackageFormatsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PackageFormatResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPackageFormatsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPackageFormatsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePackageFormatHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PackageFormatResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePackageFormatHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePackageFormatHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePackageFormatHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePackageFormatHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePackageFormatHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPackageFormatHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackageFormatResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackageFormatHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackageFormatHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdatePackageFormatHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackageFormatResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdatePackageFormatHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdatePackageFormatHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPackageFormatMaterialsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PackageFormatMaterialResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPackageFormatMaterialsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPackageFormatMaterialsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePackageFormatMaterialHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PackageFormatMaterialResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePackageFormatMaterialHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePackageFormatMaterialHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPackagingRunsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PackagingRunResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPackagingRunsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPackagingRunsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePackagingRunHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PackagingRunResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePackagingRunHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePackagingRunHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckPackagingMaterialsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackagingMaterialCheckResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckPackagingMaterialsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckPackagingMaterialsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeletePackagingRunHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeletePackagingRunHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeletePackagingRunHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPackagingRunHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PackagingRunResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPackagingRunHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPackagingRunHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompletePackagingRunHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompletePackagingRunResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CompletePackagingRunHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompletePackagingRunHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportRecipeIngredientsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Result
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportRecipeIngredientsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportRecipeIngredientsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRecipesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RecipeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRecipesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRecipesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRecipeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecipeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRecipeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRecipeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRecipeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRecipeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRecipeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecipeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecipeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecipeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PatchRecipeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PatchRecipeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PatchRecipeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRecipeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRecipeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRecipeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRecipeIngredientsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RecipeIngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRecipeIngredientsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRecipeIngredientsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRecipeIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecipeIngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRecipeIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRecipeIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRecipeIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRecipeIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRecipeIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRecipeIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipeIngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRecipeIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRecipeIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateRecipeIngredientHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecipeIngredientResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateRecipeIngredientHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateRecipeIngredientHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ScanCodeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScanResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ScanCodeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ScanCodeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListStylesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]StyleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListStylesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListStylesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateStyleHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *StyleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateStyleHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateStyleHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStyleHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StyleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStyleHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStyleHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTransfersHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TransferResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTransfersHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTransfersHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransferHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransferRecordResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransferHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransferHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransferHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransferHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransferHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVesselsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VesselResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVesselsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVesselsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVesselHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VesselResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVesselHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVesselHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVesselHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VesselResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVesselHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVesselHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateVesselHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VesselResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateVesselHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateVesselHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVesselLabelHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVesselLabelHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVesselLabelHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVolumeRelationsHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VolumeRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVolumeRelationsHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVolumeRelationsHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVolumeRelationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VolumeRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVolumeRelationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVolumeRelationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVolumeRelationHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VolumeRelationResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVolumeRelationHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVolumeRelationHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVolumesHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVolumesHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVolumesHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVolumeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVolumeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVolumeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVolumeHTTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VolumeResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVolumeHTTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVolumeHTTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAdditionsWithResponse request returning *ListAdditionsHTTPResponse
+func (c *ClientWithResponses) ListAdditionsWithResponse(ctx context.Context, params *ListAdditionsParams, reqEditors ...RequestEditorFn) (*ListAdditionsHTTPResponse, error) {
+	rsp, err := c.ListAdditions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAdditionsHTTPResponse(rsp)
+}
+
+// CreateAdditionWithBodyWithResponse request with arbitrary body returning *CreateAdditionHTTPResponse
+func (c *ClientWithResponses) CreateAdditionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAdditionHTTPResponse, error) {
+	rsp, err := c.CreateAdditionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdditionHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAdditionWithResponse(ctx context.Context, body CreateAdditionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAdditionHTTPResponse, error) {
+	rsp, err := c.CreateAddition(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAdditionHTTPResponse(rsp)
+}
+
+// GetAdditionWithResponse request returning *GetAdditionHTTPResponse
+func (c *ClientWithResponses) GetAdditionWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetAdditionHTTPResponse, error) {
+	rsp, err := c.GetAddition(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdditionHTTPResponse(rsp)
+}
+
+// DeleteBatchLaborWithResponse request returning *DeleteBatchLaborHTTPResponse
+func (c *ClientWithResponses) DeleteBatchLaborWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBatchLaborHTTPResponse, error) {
+	rsp, err := c.DeleteBatchLabor(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBatchLaborHTTPResponse(rsp)
+}
+
+// ListBatchProcessPhasesWithResponse request returning *ListBatchProcessPhasesHTTPResponse
+func (c *ClientWithResponses) ListBatchProcessPhasesWithResponse(ctx context.Context, params *ListBatchProcessPhasesParams, reqEditors ...RequestEditorFn) (*ListBatchProcessPhasesHTTPResponse, error) {
+	rsp, err := c.ListBatchProcessPhases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchProcessPhasesHTTPResponse(rsp)
+}
+
+// CreateBatchProcessPhaseWithBodyWithResponse request with arbitrary body returning *CreateBatchProcessPhaseHTTPResponse
+func (c *ClientWithResponses) CreateBatchProcessPhaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchProcessPhaseHTTPResponse, error) {
+	rsp, err := c.CreateBatchProcessPhaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchProcessPhaseHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchProcessPhaseWithResponse(ctx context.Context, body CreateBatchProcessPhaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchProcessPhaseHTTPResponse, error) {
+	rsp, err := c.CreateBatchProcessPhase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchProcessPhaseHTTPResponse(rsp)
+}
+
+// GetBatchProcessPhaseWithResponse request returning *GetBatchProcessPhaseHTTPResponse
+func (c *ClientWithResponses) GetBatchProcessPhaseWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchProcessPhaseHTTPResponse, error) {
+	rsp, err := c.GetBatchProcessPhase(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchProcessPhaseHTTPResponse(rsp)
+}
+
+// ListBatchRelationsWithResponse request returning *ListBatchRelationsHTTPResponse
+func (c *ClientWithResponses) ListBatchRelationsWithResponse(ctx context.Context, params *ListBatchRelationsParams, reqEditors ...RequestEditorFn) (*ListBatchRelationsHTTPResponse, error) {
+	rsp, err := c.ListBatchRelations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchRelationsHTTPResponse(rsp)
+}
+
+// CreateBatchRelationWithBodyWithResponse request with arbitrary body returning *CreateBatchRelationHTTPResponse
+func (c *ClientWithResponses) CreateBatchRelationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchRelationHTTPResponse, error) {
+	rsp, err := c.CreateBatchRelationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchRelationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchRelationWithResponse(ctx context.Context, body CreateBatchRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchRelationHTTPResponse, error) {
+	rsp, err := c.CreateBatchRelation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchRelationHTTPResponse(rsp)
+}
+
+// GetBatchRelationWithResponse request returning *GetBatchRelationHTTPResponse
+func (c *ClientWithResponses) GetBatchRelationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchRelationHTTPResponse, error) {
+	rsp, err := c.GetBatchRelation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchRelationHTTPResponse(rsp)
+}
+
+// ListBatchVolumesWithResponse request returning *ListBatchVolumesHTTPResponse
+func (c *ClientWithResponses) ListBatchVolumesWithResponse(ctx context.Context, params *ListBatchVolumesParams, reqEditors ...RequestEditorFn) (*ListBatchVolumesHTTPResponse, error) {
+	rsp, err := c.ListBatchVolumes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchVolumesHTTPResponse(rsp)
+}
+
+// CreateBatchVolumeWithBodyWithResponse request with arbitrary body returning *CreateBatchVolumeHTTPResponse
+func (c *ClientWithResponses) CreateBatchVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchVolumeHTTPResponse, error) {
+	rsp, err := c.CreateBatchVolumeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchVolumeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchVolumeWithResponse(ctx context.Context, body CreateBatchVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchVolumeHTTPResponse, error) {
+	rsp, err := c.CreateBatchVolume(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchVolumeHTTPResponse(rsp)
+}
+
+// GetBatchVolumeWithResponse request returning *GetBatchVolumeHTTPResponse
+func (c *ClientWithResponses) GetBatchVolumeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchVolumeHTTPResponse, error) {
+	rsp, err := c.GetBatchVolume(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchVolumeHTTPResponse(rsp)
+}
+
+// ListBatchesWithResponse request returning *ListBatchesHTTPResponse
+func (c *ClientWithResponses) ListBatchesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListBatchesHTTPResponse, error) {
+	rsp, err := c.ListBatches(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchesHTTPResponse(rsp)
+}
+
+// CreateBatchWithBodyWithResponse request with arbitrary body returning *CreateBatchHTTPResponse
+func (c *ClientWithResponses) CreateBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchHTTPResponse, error) {
+	rsp, err := c.CreateBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchWithResponse(ctx context.Context, body CreateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchHTTPResponse, error) {
+	rsp, err := c.CreateBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchHTTPResponse(rsp)
+}
+
+// ImportBatchesWithBodyWithResponse request with arbitrary body returning *ImportBatchesHTTPResponse
+func (c *ClientWithResponses) ImportBatchesWithBodyWithResponse(ctx context.Context, params *ImportBatchesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBatchesHTTPResponse, error) {
+	rsp, err := c.ImportBatchesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBatchesHTTPResponse(rsp)
+}
+
+// DeleteBatchWithResponse request returning *DeleteBatchHTTPResponse
+func (c *ClientWithResponses) DeleteBatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteBatchHTTPResponse, error) {
+	rsp, err := c.DeleteBatch(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBatchHTTPResponse(rsp)
+}
+
+// GetBatchWithResponse request returning *GetBatchHTTPResponse
+func (c *ClientWithResponses) GetBatchWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchHTTPResponse, error) {
+	rsp, err := c.GetBatch(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchHTTPResponse(rsp)
+}
+
+// UpdateBatchWithBodyWithResponse request with arbitrary body returning *UpdateBatchHTTPResponse
+func (c *ClientWithResponses) UpdateBatchWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBatchHTTPResponse, error) {
+	rsp, err := c.UpdateBatchWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBatchHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBatchWithResponse(ctx context.Context, uuid UuidPath, body UpdateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBatchHTTPResponse, error) {
+	rsp, err := c.UpdateBatch(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBatchHTTPResponse(rsp)
+}
+
+// ProposeBatchAllocationWithBodyWithResponse request with arbitrary body returning *ProposeBatchAllocationHTTPResponse
+func (c *ClientWithResponses) ProposeBatchAllocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ProposeBatchAllocationHTTPResponse, error) {
+	rsp, err := c.ProposeBatchAllocationWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProposeBatchAllocationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ProposeBatchAllocationWithResponse(ctx context.Context, uuid UuidPath, body ProposeBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*ProposeBatchAllocationHTTPResponse, error) {
+	rsp, err := c.ProposeBatchAllocation(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProposeBatchAllocationHTTPResponse(rsp)
+}
+
+// ConfirmBatchAllocationWithBodyWithResponse request with arbitrary body returning *ConfirmBatchAllocationHTTPResponse
+func (c *ClientWithResponses) ConfirmBatchAllocationWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConfirmBatchAllocationHTTPResponse, error) {
+	rsp, err := c.ConfirmBatchAllocationWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmBatchAllocationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConfirmBatchAllocationWithResponse(ctx context.Context, uuid UuidPath, body ConfirmBatchAllocationJSONRequestBody, reqEditors ...RequestEditorFn) (*ConfirmBatchAllocationHTTPResponse, error) {
+	rsp, err := c.ConfirmBatchAllocation(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfirmBatchAllocationHTTPResponse(rsp)
+}
+
+// GetBatchCostsWithResponse request returning *GetBatchCostsHTTPResponse
+func (c *ClientWithResponses) GetBatchCostsWithResponse(ctx context.Context, uuid UuidPath, params *GetBatchCostsParams, reqEditors ...RequestEditorFn) (*GetBatchCostsHTTPResponse, error) {
+	rsp, err := c.GetBatchCosts(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchCostsHTTPResponse(rsp)
+}
+
+// SnapshotBatchCostsWithResponse request returning *SnapshotBatchCostsHTTPResponse
+func (c *ClientWithResponses) SnapshotBatchCostsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*SnapshotBatchCostsHTTPResponse, error) {
+	rsp, err := c.SnapshotBatchCosts(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSnapshotBatchCostsHTTPResponse(rsp)
+}
+
+// ListBatchLaborWithResponse request returning *ListBatchLaborHTTPResponse
+func (c *ClientWithResponses) ListBatchLaborWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListBatchLaborHTTPResponse, error) {
+	rsp, err := c.ListBatchLabor(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBatchLaborHTTPResponse(rsp)
+}
+
+// CreateBatchLaborWithBodyWithResponse request with arbitrary body returning *CreateBatchLaborHTTPResponse
+func (c *ClientWithResponses) CreateBatchLaborWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBatchLaborHTTPResponse, error) {
+	rsp, err := c.CreateBatchLaborWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchLaborHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBatchLaborWithResponse(ctx context.Context, uuid UuidPath, body CreateBatchLaborJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBatchLaborHTTPResponse, error) {
+	rsp, err := c.CreateBatchLabor(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBatchLaborHTTPResponse(rsp)
+}
+
+// GetBatchSummaryWithResponse request returning *GetBatchSummaryHTTPResponse
+func (c *ClientWithResponses) GetBatchSummaryWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBatchSummaryHTTPResponse, error) {
+	rsp, err := c.GetBatchSummary(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBatchSummaryHTTPResponse(rsp)
+}
+
+// ListBrewSessionsWithResponse request returning *ListBrewSessionsHTTPResponse
+func (c *ClientWithResponses) ListBrewSessionsWithResponse(ctx context.Context, params *ListBrewSessionsParams, reqEditors ...RequestEditorFn) (*ListBrewSessionsHTTPResponse, error) {
+	rsp, err := c.ListBrewSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBrewSessionsHTTPResponse(rsp)
+}
+
+// CreateBrewSessionWithBodyWithResponse request with arbitrary body returning *CreateBrewSessionHTTPResponse
+func (c *ClientWithResponses) CreateBrewSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBrewSessionHTTPResponse, error) {
+	rsp, err := c.CreateBrewSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBrewSessionHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBrewSessionWithResponse(ctx context.Context, body CreateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBrewSessionHTTPResponse, error) {
+	rsp, err := c.CreateBrewSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBrewSessionHTTPResponse(rsp)
+}
+
+// GetBrewSessionWithResponse request returning *GetBrewSessionHTTPResponse
+func (c *ClientWithResponses) GetBrewSessionWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetBrewSessionHTTPResponse, error) {
+	rsp, err := c.GetBrewSession(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBrewSessionHTTPResponse(rsp)
+}
+
+// UpdateBrewSessionWithBodyWithResponse request with arbitrary body returning *UpdateBrewSessionHTTPResponse
+func (c *ClientWithResponses) UpdateBrewSessionWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateBrewSessionHTTPResponse, error) {
+	rsp, err := c.UpdateBrewSessionWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBrewSessionHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateBrewSessionWithResponse(ctx context.Context, uuid UuidPath, body UpdateBrewSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateBrewSessionHTTPResponse, error) {
+	rsp, err := c.UpdateBrewSession(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBrewSessionHTTPResponse(rsp)
+}
+
+// ListMeasurementsWithResponse request returning *ListMeasurementsHTTPResponse
+func (c *ClientWithResponses) ListMeasurementsWithResponse(ctx context.Context, params *ListMeasurementsParams, reqEditors ...RequestEditorFn) (*ListMeasurementsHTTPResponse, error) {
+	rsp, err := c.ListMeasurements(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMeasurementsHTTPResponse(rsp)
+}
+
+// CreateMeasurementWithBodyWithResponse request with arbitrary body returning *CreateMeasurementHTTPResponse
+func (c *ClientWithResponses) CreateMeasurementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMeasurementHTTPResponse, error) {
+	rsp, err := c.CreateMeasurementWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMeasurementHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMeasurementWithResponse(ctx context.Context, body CreateMeasurementJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMeasurementHTTPResponse, error) {
+	rsp, err := c.CreateMeasurement(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMeasurementHTTPResponse(rsp)
+}
+
+// GetMeasurementWithResponse request returning *GetMeasurementHTTPResponse
+func (c *ClientWithResponses) GetMeasurementWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetMeasurementHTTPResponse, error) {
+	rsp, err := c.GetMeasurement(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeasurementHTTPResponse(rsp)
+}
+
+// PreviewMigrationWithBodyWithResponse request with arbitrary body returning *PreviewMigrationHTTPResponse
+func (c *ClientWithResponses) PreviewMigrationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewMigrationHTTPResponse, error) {
+	rsp, err := c.PreviewMigrationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewMigrationHTTPResponse(rsp)
+}
+
+// ApplyProductionMigrationWithBodyWithResponse request with arbitrary body returning *ApplyProductionMigrationHTTPResponse
+func (c *ClientWithResponses) ApplyProductionMigrationWithBodyWithResponse(ctx context.Context, params *ApplyProductionMigrationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApplyProductionMigrationHTTPResponse, error) {
+	rsp, err := c.ApplyProductionMigrationWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyProductionMigrationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApplyProductionMigrationWithResponse(ctx context.Context, params *ApplyProductionMigrationParams, body ApplyProductionMigrationJSONRequestBody, reqEditors ...RequestEditorFn) (*ApplyProductionMigrationHTTPResponse, error) {
+	rsp, err := c.ApplyProductionMigration(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApplyProductionMigrationHTTPResponse(rsp)
+}
+
+// CreateMrpPurchaseOrdersWithBodyWithResponse request with arbitrary body returning *CreateMrpPurchaseOrdersHTTPResponse
+func (c *ClientWithResponses) CreateMrpPurchaseOrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMrpPurchaseOrdersHTTPResponse, error) {
+	rsp, err := c.CreateMrpPurchaseOrdersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMrpPurchaseOrdersHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMrpPurchaseOrdersWithResponse(ctx context.Context, body CreateMrpPurchaseOrdersJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMrpPurchaseOrdersHTTPResponse, error) {
+	rsp, err := c.CreateMrpPurchaseOrders(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMrpPurchaseOrdersHTTPResponse(rsp)
+}
+
+// ListMrpShortagesWithResponse request returning *ListMrpShortagesHTTPResponse
+func (c *ClientWithResponses) ListMrpShortagesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMrpShortagesHTTPResponse, error) {
+	rsp, err := c.ListMrpShortages(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMrpShortagesHTTPResponse(rsp)
+}
+
+// ListOccupanciesWithResponse request returning *ListOccupanciesHTTPResponse
+func (c *ClientWithResponses) ListOccupanciesWithResponse(ctx context.Context, params *ListOccupanciesParams, reqEditors ...RequestEditorFn) (*ListOccupanciesHTTPResponse, error) {
+	rsp, err := c.ListOccupancies(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOccupanciesHTTPResponse(rsp)
+}
+
+// CreateOccupancyWithBodyWithResponse request with arbitrary body returning *CreateOccupancyHTTPResponse
+func (c *ClientWithResponses) CreateOccupancyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOccupancyHTTPResponse, error) {
+	rsp, err := c.CreateOccupancyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOccupancyHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOccupancyWithResponse(ctx context.Context, body CreateOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOccupancyHTTPResponse, error) {
+	rsp, err := c.CreateOccupancy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOccupancyHTTPResponse(rsp)
+}
+
+// GetActiveOccupancyWithResponse request returning *GetActiveOccupancyHTTPResponse
+func (c *ClientWithResponses) GetActiveOccupancyWithResponse(ctx context.Context, params *GetActiveOccupancyParams, reqEditors ...RequestEditorFn) (*GetActiveOccupancyHTTPResponse, error) {
+	rsp, err := c.GetActiveOccupancy(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetActiveOccupancyHTTPResponse(rsp)
+}
+
+// GetOccupancyWithResponse request returning *GetOccupancyHTTPResponse
+func (c *ClientWithResponses) GetOccupancyWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetOccupancyHTTPResponse, error) {
+	rsp, err := c.GetOccupancy(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOccupancyHTTPResponse(rsp)
+}
+
+// CloseOccupancyWithBodyWithResponse request with arbitrary body returning *CloseOccupancyHTTPResponse
+func (c *ClientWithResponses) CloseOccupancyWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloseOccupancyHTTPResponse, error) {
+	rsp, err := c.CloseOccupancyWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseOccupancyHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloseOccupancyWithResponse(ctx context.Context, uuid UuidPath, body CloseOccupancyJSONRequestBody, reqEditors ...RequestEditorFn) (*CloseOccupancyHTTPResponse, error) {
+	rsp, err := c.CloseOccupancy(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloseOccupancyHTTPResponse(rsp)
+}
+
+// UpdateOccupancyStatusWithBodyWithResponse request with arbitrary body returning *UpdateOccupancyStatusHTTPResponse
+func (c *ClientWithResponses) UpdateOccupancyStatusWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOccupancyStatusHTTPResponse, error) {
+	rsp, err := c.UpdateOccupancyStatusWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOccupancyStatusHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOccupancyStatusWithResponse(ctx context.Context, uuid UuidPath, body UpdateOccupancyStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOccupancyStatusHTTPResponse, error) {
+	rsp, err := c.UpdateOccupancyStatus(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOccupancyStatusHTTPResponse(rsp)
+}
+
+// ListOverheadRatesWithResponse request returning *ListOverheadRatesHTTPResponse
+func (c *ClientWithResponses) ListOverheadRatesWithResponse(ctx context.Context, params *ListOverheadRatesParams, reqEditors ...RequestEditorFn) (*ListOverheadRatesHTTPResponse, error) {
+	rsp, err := c.ListOverheadRates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListOverheadRatesHTTPResponse(rsp)
+}
+
+// CreateOverheadRateWithBodyWithResponse request with arbitrary body returning *CreateOverheadRateHTTPResponse
+func (c *ClientWithResponses) CreateOverheadRateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOverheadRateHTTPResponse, error) {
+	rsp, err := c.CreateOverheadRateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOverheadRateHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOverheadRateWithResponse(ctx context.Context, body CreateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOverheadRateHTTPResponse, error) {
+	rsp, err := c.CreateOverheadRate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOverheadRateHTTPResponse(rsp)
+}
+
+// DeleteOverheadRateWithResponse request returning *DeleteOverheadRateHTTPResponse
+func (c *ClientWithResponses) DeleteOverheadRateWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteOverheadRateHTTPResponse, error) {
+	rsp, err := c.DeleteOverheadRate(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteOverheadRateHTTPResponse(rsp)
+}
+
+// UpdateOverheadRateWithBodyWithResponse request with arbitrary body returning *UpdateOverheadRateHTTPResponse
+func (c *ClientWithResponses) UpdateOverheadRateWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOverheadRateHTTPResponse, error) {
+	rsp, err := c.UpdateOverheadRateWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOverheadRateHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOverheadRateWithResponse(ctx context.Context, uuid UuidPath, body UpdateOverheadRateJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOverheadRateHTTPResponse, error) {
+	rsp, err := c.UpdateOverheadRate(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOverheadRateHTTPResponse(rsp)
+}
+
+// DeletePackageFormatMaterialWithResponse request returning *DeletePackageFormatMaterialHTTPResponse
+func (c *ClientWithResponses) DeletePackageFormatMaterialWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackageFormatMaterialHTTPResponse, error) {
+	rsp, err := c.DeletePackageFormatMaterial(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePackageFormatMaterialHTTPResponse(rsp)
+}
+
+// ListPackageFormatsWithResponse request returning *ListPackageFormatsHTTPResponse
+func (c *ClientWithResponses) ListPackageFormatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListPackageFormatsHTTPResponse, error) {
+	rsp, err := c.ListPackageFormats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPackageFormatsHTTPResponse(rsp)
+}
+
+// CreatePackageFormatWithBodyWithResponse request with arbitrary body returning *CreatePackageFormatHTTPResponse
+func (c *ClientWithResponses) CreatePackageFormatWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageFormatHTTPResponse, error) {
+	rsp, err := c.CreatePackageFormatWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageFormatHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePackageFormatWithResponse(ctx context.Context, body CreatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackageFormatHTTPResponse, error) {
+	rsp, err := c.CreatePackageFormat(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageFormatHTTPResponse(rsp)
+}
+
+// DeletePackageFormatWithResponse request returning *DeletePackageFormatHTTPResponse
+func (c *ClientWithResponses) DeletePackageFormatWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackageFormatHTTPResponse, error) {
+	rsp, err := c.DeletePackageFormat(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePackageFormatHTTPResponse(rsp)
+}
+
+// GetPackageFormatWithResponse request returning *GetPackageFormatHTTPResponse
+func (c *ClientWithResponses) GetPackageFormatWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPackageFormatHTTPResponse, error) {
+	rsp, err := c.GetPackageFormat(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackageFormatHTTPResponse(rsp)
+}
+
+// UpdatePackageFormatWithBodyWithResponse request with arbitrary body returning *UpdatePackageFormatHTTPResponse
+func (c *ClientWithResponses) UpdatePackageFormatWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePackageFormatHTTPResponse, error) {
+	rsp, err := c.UpdatePackageFormatWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePackageFormatHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePackageFormatWithResponse(ctx context.Context, uuid UuidPath, body UpdatePackageFormatJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePackageFormatHTTPResponse, error) {
+	rsp, err := c.UpdatePackageFormat(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePackageFormatHTTPResponse(rsp)
+}
+
+// ListPackageFormatMaterialsWithResponse request returning *ListPackageFormatMaterialsHTTPResponse
+func (c *ClientWithResponses) ListPackageFormatMaterialsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListPackageFormatMaterialsHTTPResponse, error) {
+	rsp, err := c.ListPackageFormatMaterials(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPackageFormatMaterialsHTTPResponse(rsp)
+}
+
+// CreatePackageFormatMaterialWithBodyWithResponse request with arbitrary body returning *CreatePackageFormatMaterialHTTPResponse
+func (c *ClientWithResponses) CreatePackageFormatMaterialWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackageFormatMaterialHTTPResponse, error) {
+	rsp, err := c.CreatePackageFormatMaterialWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageFormatMaterialHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePackageFormatMaterialWithResponse(ctx context.Context, uuid UuidPath, body CreatePackageFormatMaterialJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackageFormatMaterialHTTPResponse, error) {
+	rsp, err := c.CreatePackageFormatMaterial(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackageFormatMaterialHTTPResponse(rsp)
+}
+
+// ListPackagingRunsWithResponse request returning *ListPackagingRunsHTTPResponse
+func (c *ClientWithResponses) ListPackagingRunsWithResponse(ctx context.Context, params *ListPackagingRunsParams, reqEditors ...RequestEditorFn) (*ListPackagingRunsHTTPResponse, error) {
+	rsp, err := c.ListPackagingRuns(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPackagingRunsHTTPResponse(rsp)
+}
+
+// CreatePackagingRunWithBodyWithResponse request with arbitrary body returning *CreatePackagingRunHTTPResponse
+func (c *ClientWithResponses) CreatePackagingRunWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePackagingRunHTTPResponse, error) {
+	rsp, err := c.CreatePackagingRunWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackagingRunHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePackagingRunWithResponse(ctx context.Context, body CreatePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePackagingRunHTTPResponse, error) {
+	rsp, err := c.CreatePackagingRun(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePackagingRunHTTPResponse(rsp)
+}
+
+// CheckPackagingMaterialsWithBodyWithResponse request with arbitrary body returning *CheckPackagingMaterialsHTTPResponse
+func (c *ClientWithResponses) CheckPackagingMaterialsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CheckPackagingMaterialsHTTPResponse, error) {
+	rsp, err := c.CheckPackagingMaterialsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckPackagingMaterialsHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CheckPackagingMaterialsWithResponse(ctx context.Context, body CheckPackagingMaterialsJSONRequestBody, reqEditors ...RequestEditorFn) (*CheckPackagingMaterialsHTTPResponse, error) {
+	rsp, err := c.CheckPackagingMaterials(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckPackagingMaterialsHTTPResponse(rsp)
+}
+
+// DeletePackagingRunWithResponse request returning *DeletePackagingRunHTTPResponse
+func (c *ClientWithResponses) DeletePackagingRunWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeletePackagingRunHTTPResponse, error) {
+	rsp, err := c.DeletePackagingRun(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePackagingRunHTTPResponse(rsp)
+}
+
+// GetPackagingRunWithResponse request returning *GetPackagingRunHTTPResponse
+func (c *ClientWithResponses) GetPackagingRunWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetPackagingRunHTTPResponse, error) {
+	rsp, err := c.GetPackagingRun(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPackagingRunHTTPResponse(rsp)
+}
+
+// CompletePackagingRunWithBodyWithResponse request with arbitrary body returning *CompletePackagingRunHTTPResponse
+func (c *ClientWithResponses) CompletePackagingRunWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompletePackagingRunHTTPResponse, error) {
+	rsp, err := c.CompletePackagingRunWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePackagingRunHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompletePackagingRunWithResponse(ctx context.Context, uuid UuidPath, body CompletePackagingRunJSONRequestBody, reqEditors ...RequestEditorFn) (*CompletePackagingRunHTTPResponse, error) {
+	rsp, err := c.CompletePackagingRun(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompletePackagingRunHTTPResponse(rsp)
+}
+
+// ImportRecipeIngredientsWithBodyWithResponse request with arbitrary body returning *ImportRecipeIngredientsHTTPResponse
+func (c *ClientWithResponses) ImportRecipeIngredientsWithBodyWithResponse(ctx context.Context, params *ImportRecipeIngredientsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportRecipeIngredientsHTTPResponse, error) {
+	rsp, err := c.ImportRecipeIngredientsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportRecipeIngredientsHTTPResponse(rsp)
+}
+
+// ListRecipesWithResponse request returning *ListRecipesHTTPResponse
+func (c *ClientWithResponses) ListRecipesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListRecipesHTTPResponse, error) {
+	rsp, err := c.ListRecipes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRecipesHTTPResponse(rsp)
+}
+
+// CreateRecipeWithBodyWithResponse request with arbitrary body returning *CreateRecipeHTTPResponse
+func (c *ClientWithResponses) CreateRecipeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecipeHTTPResponse, error) {
+	rsp, err := c.CreateRecipeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecipeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRecipeWithResponse(ctx context.Context, body CreateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecipeHTTPResponse, error) {
+	rsp, err := c.CreateRecipe(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecipeHTTPResponse(rsp)
+}
+
+// DeleteRecipeWithResponse request returning *DeleteRecipeHTTPResponse
+func (c *ClientWithResponses) DeleteRecipeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*DeleteRecipeHTTPResponse, error) {
+	rsp, err := c.DeleteRecipe(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRecipeHTTPResponse(rsp)
+}
+
+// GetRecipeWithResponse request returning *GetRecipeHTTPResponse
+func (c *ClientWithResponses) GetRecipeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetRecipeHTTPResponse, error) {
+	rsp, err := c.GetRecipe(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecipeHTTPResponse(rsp)
+}
+
+// PatchRecipeWithBodyWithResponse request with arbitrary body returning *PatchRecipeHTTPResponse
+func (c *ClientWithResponses) PatchRecipeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchRecipeHTTPResponse, error) {
+	rsp, err := c.PatchRecipeWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRecipeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchRecipeWithResponse(ctx context.Context, uuid UuidPath, body PatchRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchRecipeHTTPResponse, error) {
+	rsp, err := c.PatchRecipe(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchRecipeHTTPResponse(rsp)
+}
+
+// UpdateRecipeWithBodyWithResponse request with arbitrary body returning *UpdateRecipeHTTPResponse
+func (c *ClientWithResponses) UpdateRecipeWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipeHTTPResponse, error) {
+	rsp, err := c.UpdateRecipeWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRecipeWithResponse(ctx context.Context, uuid UuidPath, body UpdateRecipeJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipeHTTPResponse, error) {
+	rsp, err := c.UpdateRecipe(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipeHTTPResponse(rsp)
+}
+
+// ListRecipeIngredientsWithResponse request returning *ListRecipeIngredientsHTTPResponse
+func (c *ClientWithResponses) ListRecipeIngredientsWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*ListRecipeIngredientsHTTPResponse, error) {
+	rsp, err := c.ListRecipeIngredients(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRecipeIngredientsHTTPResponse(rsp)
+}
+
+// CreateRecipeIngredientWithBodyWithResponse request with arbitrary body returning *CreateRecipeIngredientHTTPResponse
+func (c *ClientWithResponses) CreateRecipeIngredientWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.CreateRecipeIngredientWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecipeIngredientHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, body CreateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.CreateRecipeIngredient(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRecipeIngredientHTTPResponse(rsp)
+}
+
+// DeleteRecipeIngredientWithResponse request returning *DeleteRecipeIngredientHTTPResponse
+func (c *ClientWithResponses) DeleteRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*DeleteRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.DeleteRecipeIngredient(ctx, uuid, ingredientUuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRecipeIngredientHTTPResponse(rsp)
+}
+
+// GetRecipeIngredientWithResponse request returning *GetRecipeIngredientHTTPResponse
+func (c *ClientWithResponses) GetRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, reqEditors ...RequestEditorFn) (*GetRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.GetRecipeIngredient(ctx, uuid, ingredientUuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRecipeIngredientHTTPResponse(rsp)
+}
+
+// UpdateRecipeIngredientWithBodyWithResponse request with arbitrary body returning *UpdateRecipeIngredientHTTPResponse
+func (c *ClientWithResponses) UpdateRecipeIngredientWithBodyWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.UpdateRecipeIngredientWithBody(ctx, uuid, ingredientUuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipeIngredientHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRecipeIngredientWithResponse(ctx context.Context, uuid UuidPath, ingredientUuid IngredientUuidPath, body UpdateRecipeIngredientJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRecipeIngredientHTTPResponse, error) {
+	rsp, err := c.UpdateRecipeIngredient(ctx, uuid, ingredientUuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRecipeIngredientHTTPResponse(rsp)
+}
+
+// ScanCodeWithResponse request returning *ScanCodeHTTPResponse
+func (c *ClientWithResponses) ScanCodeWithResponse(ctx context.Context, code CodePath, reqEditors ...RequestEditorFn) (*ScanCodeHTTPResponse, error) {
+	rsp, err := c.ScanCode(ctx, code, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScanCodeHTTPResponse(rsp)
+}
+
+// ListStylesWithResponse request returning *ListStylesHTTPResponse
+func (c *ClientWithResponses) ListStylesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListStylesHTTPResponse, error) {
+	rsp, err := c.ListStyles(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStylesHTTPResponse(rsp)
+}
+
+// CreateStyleWithBodyWithResponse request with arbitrary body returning *CreateStyleHTTPResponse
+func (c *ClientWithResponses) CreateStyleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateStyleHTTPResponse, error) {
+	rsp, err := c.CreateStyleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStyleHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateStyleWithResponse(ctx context.Context, body CreateStyleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateStyleHTTPResponse, error) {
+	rsp, err := c.CreateStyle(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateStyleHTTPResponse(rsp)
+}
+
+// GetStyleWithResponse request returning *GetStyleHTTPResponse
+func (c *ClientWithResponses) GetStyleWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetStyleHTTPResponse, error) {
+	rsp, err := c.GetStyle(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStyleHTTPResponse(rsp)
+}
+
+// ListTransfersWithResponse request returning *ListTransfersHTTPResponse
+func (c *ClientWithResponses) ListTransfersWithResponse(ctx context.Context, params *ListTransfersParams, reqEditors ...RequestEditorFn) (*ListTransfersHTTPResponse, error) {
+	rsp, err := c.ListTransfers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTransfersHTTPResponse(rsp)
+}
+
+// CreateTransferWithBodyWithResponse request with arbitrary body returning *CreateTransferHTTPResponse
+func (c *ClientWithResponses) CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferHTTPResponse, error) {
+	rsp, err := c.CreateTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferHTTPResponse, error) {
+	rsp, err := c.CreateTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferHTTPResponse(rsp)
+}
+
+// GetTransferWithResponse request returning *GetTransferHTTPResponse
+func (c *ClientWithResponses) GetTransferWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetTransferHTTPResponse, error) {
+	rsp, err := c.GetTransfer(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransferHTTPResponse(rsp)
+}
+
+// ListVesselsWithResponse request returning *ListVesselsHTTPResponse
+func (c *ClientWithResponses) ListVesselsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVesselsHTTPResponse, error) {
+	rsp, err := c.ListVessels(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVesselsHTTPResponse(rsp)
+}
+
+// CreateVesselWithBodyWithResponse request with arbitrary body returning *CreateVesselHTTPResponse
+func (c *ClientWithResponses) CreateVesselWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVesselHTTPResponse, error) {
+	rsp, err := c.CreateVesselWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVesselHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVesselWithResponse(ctx context.Context, body CreateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVesselHTTPResponse, error) {
+	rsp, err := c.CreateVessel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVesselHTTPResponse(rsp)
+}
+
+// GetVesselWithResponse request returning *GetVesselHTTPResponse
+func (c *ClientWithResponses) GetVesselWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVesselHTTPResponse, error) {
+	rsp, err := c.GetVessel(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVesselHTTPResponse(rsp)
+}
+
+// UpdateVesselWithBodyWithResponse request with arbitrary body returning *UpdateVesselHTTPResponse
+func (c *ClientWithResponses) UpdateVesselWithBodyWithResponse(ctx context.Context, uuid UuidPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVesselHTTPResponse, error) {
+	rsp, err := c.UpdateVesselWithBody(ctx, uuid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVesselHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateVesselWithResponse(ctx context.Context, uuid UuidPath, body UpdateVesselJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVesselHTTPResponse, error) {
+	rsp, err := c.UpdateVessel(ctx, uuid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVesselHTTPResponse(rsp)
+}
+
+// GetVesselLabelWithResponse request returning *GetVesselLabelHTTPResponse
+func (c *ClientWithResponses) GetVesselLabelWithResponse(ctx context.Context, uuid UuidPath, params *GetVesselLabelParams, reqEditors ...RequestEditorFn) (*GetVesselLabelHTTPResponse, error) {
+	rsp, err := c.GetVesselLabel(ctx, uuid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVesselLabelHTTPResponse(rsp)
+}
+
+// ListVolumeRelationsWithResponse request returning *ListVolumeRelationsHTTPResponse
+func (c *ClientWithResponses) ListVolumeRelationsWithResponse(ctx context.Context, params *ListVolumeRelationsParams, reqEditors ...RequestEditorFn) (*ListVolumeRelationsHTTPResponse, error) {
+	rsp, err := c.ListVolumeRelations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVolumeRelationsHTTPResponse(rsp)
+}
+
+// CreateVolumeRelationWithBodyWithResponse request with arbitrary body returning *CreateVolumeRelationHTTPResponse
+func (c *ClientWithResponses) CreateVolumeRelationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeRelationHTTPResponse, error) {
+	rsp, err := c.CreateVolumeRelationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeRelationHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVolumeRelationWithResponse(ctx context.Context, body CreateVolumeRelationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeRelationHTTPResponse, error) {
+	rsp, err := c.CreateVolumeRelation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeRelationHTTPResponse(rsp)
+}
+
+// GetVolumeRelationWithResponse request returning *GetVolumeRelationHTTPResponse
+func (c *ClientWithResponses) GetVolumeRelationWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVolumeRelationHTTPResponse, error) {
+	rsp, err := c.GetVolumeRelation(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVolumeRelationHTTPResponse(rsp)
+}
+
+// ListVolumesWithResponse request returning *ListVolumesHTTPResponse
+func (c *ClientWithResponses) ListVolumesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListVolumesHTTPResponse, error) {
+	rsp, err := c.ListVolumes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVolumesHTTPResponse(rsp)
+}
+
+// CreateVolumeWithBodyWithResponse request with arbitrary body returning *CreateVolumeHTTPResponse
+func (c *ClientWithResponses) CreateVolumeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVolumeHTTPResponse, error) {
+	rsp, err := c.CreateVolumeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeHTTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVolumeWithResponse(ctx context.Context, body CreateVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVolumeHTTPResponse, error) {
+	rsp, err := c.CreateVolume(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVolumeHTTPResponse(rsp)
+}
+
+// GetVolumeWithResponse request returning *GetVolumeHTTPResponse
+func (c *ClientWithResponses) GetVolumeWithResponse(ctx context.Context, uuid UuidPath, reqEditors ...RequestEditorFn) (*GetVolumeHTTPResponse, error) {
+	rsp, err := c.GetVolume(ctx, uuid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVolumeHTTPResponse(rsp)
+}
+
+// ParseListAdditionsHTTPResponse parses an HTTP response from a ListAdditionsWithResponse call
+func ParseListAdditionsHTTPResponse(rsp *http.Response) (*ListAdditionsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAdditionsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AdditionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAdditionHTTPResponse parses an HTTP response from a CreateAdditionWithResponse call
+func ParseCreateAdditionHTTPResponse(rsp *http.Response) (*CreateAdditionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAdditionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AdditionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdditionHTTPResponse parses an HTTP response from a GetAdditionWithResponse call
+func ParseGetAdditionHTTPResponse(rsp *http.Response) (*GetAdditionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdditionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AdditionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBatchLaborHTTPResponse parses an HTTP response from a DeleteBatchLaborWithResponse call
+func ParseDeleteBatchLaborHTTPResponse(rsp *http.Response) (*DeleteBatchLaborHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBatchLaborHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListBatchProcessPhasesHTTPResponse parses an HTTP response from a ListBatchProcessPhasesWithResponse call
+func ParseListBatchProcessPhasesHTTPResponse(rsp *http.Response) (*ListBatchProcessPhasesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchProcessPhasesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchProcessPhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchProcessPhaseHTTPResponse parses an HTTP response from a CreateBatchProcessPhaseWithResponse call
+func ParseCreateBatchProcessPhaseHTTPResponse(rsp *http.Response) (*CreateBatchProcessPhaseHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchProcessPhaseHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchProcessPhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchProcessPhaseHTTPResponse parses an HTTP response from a GetBatchProcessPhaseWithResponse call
+func ParseGetBatchProcessPhaseHTTPResponse(rsp *http.Response) (*GetBatchProcessPhaseHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchProcessPhaseHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchProcessPhaseResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchRelationsHTTPResponse parses an HTTP response from a ListBatchRelationsWithResponse call
+func ParseListBatchRelationsHTTPResponse(rsp *http.Response) (*ListBatchRelationsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchRelationsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchRelationHTTPResponse parses an HTTP response from a CreateBatchRelationWithResponse call
+func ParseCreateBatchRelationHTTPResponse(rsp *http.Response) (*CreateBatchRelationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchRelationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchRelationHTTPResponse parses an HTTP response from a GetBatchRelationWithResponse call
+func ParseGetBatchRelationHTTPResponse(rsp *http.Response) (*GetBatchRelationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchRelationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchVolumesHTTPResponse parses an HTTP response from a ListBatchVolumesWithResponse call
+func ParseListBatchVolumesHTTPResponse(rsp *http.Response) (*ListBatchVolumesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchVolumesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchVolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchVolumeHTTPResponse parses an HTTP response from a CreateBatchVolumeWithResponse call
+func ParseCreateBatchVolumeHTTPResponse(rsp *http.Response) (*CreateBatchVolumeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchVolumeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchVolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchVolumeHTTPResponse parses an HTTP response from a GetBatchVolumeWithResponse call
+func ParseGetBatchVolumeHTTPResponse(rsp *http.Response) (*GetBatchVolumeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchVolumeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchVolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchesHTTPResponse parses an HTTP response from a ListBatchesWithResponse call
+func ParseListBatchesHTTPResponse(rsp *http.Response) (*ListBatchesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchHTTPResponse parses an HTTP response from a CreateBatchWithResponse call
+func ParseCreateBatchHTTPResponse(rsp *http.Response) (*CreateBatchHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportBatchesHTTPResponse parses an HTTP response from a ImportBatchesWithResponse call
+func ParseImportBatchesHTTPResponse(rsp *http.Response) (*ImportBatchesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportBatchesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchImportResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBatchHTTPResponse parses an HTTP response from a DeleteBatchWithResponse call
+func ParseDeleteBatchHTTPResponse(rsp *http.Response) (*DeleteBatchHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBatchHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchHTTPResponse parses an HTTP response from a GetBatchWithResponse call
+func ParseGetBatchHTTPResponse(rsp *http.Response) (*GetBatchHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBatchHTTPResponse parses an HTTP response from a UpdateBatchWithResponse call
+func ParseUpdateBatchHTTPResponse(rsp *http.Response) (*UpdateBatchHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBatchHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseProposeBatchAllocationHTTPResponse parses an HTTP response from a ProposeBatchAllocationWithResponse call
+func ParseProposeBatchAllocationHTTPResponse(rsp *http.Response) (*ProposeBatchAllocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ProposeBatchAllocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchAllocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConfirmBatchAllocationHTTPResponse parses an HTTP response from a ConfirmBatchAllocationWithResponse call
+func ParseConfirmBatchAllocationHTTPResponse(rsp *http.Response) (*ConfirmBatchAllocationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConfirmBatchAllocationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ConfirmBatchAllocationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchCostsHTTPResponse parses an HTTP response from a GetBatchCostsWithResponse call
+func ParseGetBatchCostsHTTPResponse(rsp *http.Response) (*GetBatchCostsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchCostsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchCostsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSnapshotBatchCostsHTTPResponse parses an HTTP response from a SnapshotBatchCostsWithResponse call
+func ParseSnapshotBatchCostsHTTPResponse(rsp *http.Response) (*SnapshotBatchCostsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SnapshotBatchCostsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchCostsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBatchLaborHTTPResponse parses an HTTP response from a ListBatchLaborWithResponse call
+func ParseListBatchLaborHTTPResponse(rsp *http.Response) (*ListBatchLaborHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBatchLaborHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BatchLaborEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBatchLaborHTTPResponse parses an HTTP response from a CreateBatchLaborWithResponse call
+func ParseCreateBatchLaborHTTPResponse(rsp *http.Response) (*CreateBatchLaborHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBatchLaborHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BatchLaborEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBatchSummaryHTTPResponse parses an HTTP response from a GetBatchSummaryWithResponse call
+func ParseGetBatchSummaryHTTPResponse(rsp *http.Response) (*GetBatchSummaryHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBatchSummaryHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchSummaryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBrewSessionsHTTPResponse parses an HTTP response from a ListBrewSessionsWithResponse call
+func ParseListBrewSessionsHTTPResponse(rsp *http.Response) (*ListBrewSessionsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBrewSessionsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BrewSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBrewSessionHTTPResponse parses an HTTP response from a CreateBrewSessionWithResponse call
+func ParseCreateBrewSessionHTTPResponse(rsp *http.Response) (*CreateBrewSessionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBrewSessionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BrewSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBrewSessionHTTPResponse parses an HTTP response from a GetBrewSessionWithResponse call
+func ParseGetBrewSessionHTTPResponse(rsp *http.Response) (*GetBrewSessionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBrewSessionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrewSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBrewSessionHTTPResponse parses an HTTP response from a UpdateBrewSessionWithResponse call
+func ParseUpdateBrewSessionHTTPResponse(rsp *http.Response) (*UpdateBrewSessionHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBrewSessionHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrewSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMeasurementsHTTPResponse parses an HTTP response from a ListMeasurementsWithResponse call
+func ParseListMeasurementsHTTPResponse(rsp *http.Response) (*ListMeasurementsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMeasurementsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MeasurementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMeasurementHTTPResponse parses an HTTP response from a CreateMeasurementWithResponse call
+func ParseCreateMeasurementHTTPResponse(rsp *http.Response) (*CreateMeasurementHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMeasurementHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MeasurementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMeasurementHTTPResponse parses an HTTP response from a GetMeasurementWithResponse call
+func ParseGetMeasurementHTTPResponse(rsp *http.Response) (*GetMeasurementHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeasurementHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MeasurementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewMigrationHTTPResponse parses an HTTP response from a PreviewMigrationWithResponse call
+func ParsePreviewMigrationHTTPResponse(rsp *http.Response) (*PreviewMigrationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewMigrationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Plan
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApplyProductionMigrationHTTPResponse parses an HTTP response from a ApplyProductionMigrationWithResponse call
+func ParseApplyProductionMigrationHTTPResponse(rsp *http.Response) (*ApplyProductionMigrationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApplyProductionMigrationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BrewimportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMrpPurchaseOrdersHTTPResponse parses an HTTP response from a CreateMrpPurchaseOrdersWithResponse call
+func ParseCreateMrpPurchaseOrdersHTTPResponse(rsp *http.Response) (*CreateMrpPurchaseOrdersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMrpPurchaseOrdersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MRPPurchaseOrdersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMrpShortagesHTTPResponse parses an HTTP response from a ListMrpShortagesWithResponse call
+func ParseListMrpShortagesHTTPResponse(rsp *http.Response) (*ListMrpShortagesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMrpShortagesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MRPResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOccupanciesHTTPResponse parses an HTTP response from a ListOccupanciesWithResponse call
+func ParseListOccupanciesHTTPResponse(rsp *http.Response) (*ListOccupanciesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOccupanciesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOccupancyHTTPResponse parses an HTTP response from a CreateOccupancyWithResponse call
+func ParseCreateOccupancyHTTPResponse(rsp *http.Response) (*CreateOccupancyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOccupancyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetActiveOccupancyHTTPResponse parses an HTTP response from a GetActiveOccupancyWithResponse call
+func ParseGetActiveOccupancyHTTPResponse(rsp *http.Response) (*GetActiveOccupancyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetActiveOccupancyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOccupancyHTTPResponse parses an HTTP response from a GetOccupancyWithResponse call
+func ParseGetOccupancyHTTPResponse(rsp *http.Response) (*GetOccupancyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOccupancyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloseOccupancyHTTPResponse parses an HTTP response from a CloseOccupancyWithResponse call
+func ParseCloseOccupancyHTTPResponse(rsp *http.Response) (*CloseOccupancyHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloseOccupancyHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOccupancyStatusHTTPResponse parses an HTTP response from a UpdateOccupancyStatusWithResponse call
+func ParseUpdateOccupancyStatusHTTPResponse(rsp *http.Response) (*UpdateOccupancyStatusHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOccupancyStatusHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OccupancyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListOverheadRatesHTTPResponse parses an HTTP response from a ListOverheadRatesWithResponse call
+func ParseListOverheadRatesHTTPResponse(rsp *http.Response) (*ListOverheadRatesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListOverheadRatesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OverheadRateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOverheadRateHTTPResponse parses an HTTP response from a CreateOverheadRateWithResponse call
+func ParseCreateOverheadRateHTTPResponse(rsp *http.Response) (*CreateOverheadRateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOverheadRateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OverheadRateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteOverheadRateHTTPResponse parses an HTTP response from a DeleteOverheadRateWithResponse call
+func ParseDeleteOverheadRateHTTPResponse(rsp *http.Response) (*DeleteOverheadRateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteOverheadRateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseUpdateOverheadRateHTTPResponse parses an HTTP response from a UpdateOverheadRateWithResponse call
+func ParseUpdateOverheadRateHTTPResponse(rsp *http.Response) (*UpdateOverheadRateHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateOverheadRateHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OverheadRateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePackageFormatMaterialHTTPResponse parses an HTTP response from a DeletePackageFormatMaterialWithResponse call
+func ParseDeletePackageFormatMaterialHTTPResponse(rsp *http.Response) (*DeletePackageFormatMaterialHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePackageFormatMaterialHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListPackageFormatsHTTPResponse parses an HTTP response from a ListPackageFormatsWithResponse call
+func ParseListPackageFormatsHTTPResponse(rsp *http.Response) (*ListPackageFormatsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPackageFormatsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PackageFormatResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePackageFormatHTTPResponse parses an HTTP response from a CreatePackageFormatWithResponse call
+func ParseCreatePackageFormatHTTPResponse(rsp *http.Response) (*CreatePackageFormatHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePackageFormatHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PackageFormatResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePackageFormatHTTPResponse parses an HTTP response from a DeletePackageFormatWithResponse call
+func ParseDeletePackageFormatHTTPResponse(rsp *http.Response) (*DeletePackageFormatHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePackageFormatHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPackageFormatHTTPResponse parses an HTTP response from a GetPackageFormatWithResponse call
+func ParseGetPackageFormatHTTPResponse(rsp *http.Response) (*GetPackageFormatHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackageFormatHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackageFormatResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdatePackageFormatHTTPResponse parses an HTTP response from a UpdatePackageFormatWithResponse call
+func ParseUpdatePackageFormatHTTPResponse(rsp *http.Response) (*UpdatePackageFormatHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdatePackageFormatHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackageFormatResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPackageFormatMaterialsHTTPResponse parses an HTTP response from a ListPackageFormatMaterialsWithResponse call
+func ParseListPackageFormatMaterialsHTTPResponse(rsp *http.Response) (*ListPackageFormatMaterialsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPackageFormatMaterialsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PackageFormatMaterialResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePackageFormatMaterialHTTPResponse parses an HTTP response from a CreatePackageFormatMaterialWithResponse call
+func ParseCreatePackageFormatMaterialHTTPResponse(rsp *http.Response) (*CreatePackageFormatMaterialHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePackageFormatMaterialHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PackageFormatMaterialResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPackagingRunsHTTPResponse parses an HTTP response from a ListPackagingRunsWithResponse call
+func ParseListPackagingRunsHTTPResponse(rsp *http.Response) (*ListPackagingRunsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPackagingRunsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PackagingRunResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePackagingRunHTTPResponse parses an HTTP response from a CreatePackagingRunWithResponse call
+func ParseCreatePackagingRunHTTPResponse(rsp *http.Response) (*CreatePackagingRunHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePackagingRunHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PackagingRunResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCheckPackagingMaterialsHTTPResponse parses an HTTP response from a CheckPackagingMaterialsWithResponse call
+func ParseCheckPackagingMaterialsHTTPResponse(rsp *http.Response) (*CheckPackagingMaterialsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckPackagingMaterialsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackagingMaterialCheckResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeletePackagingRunHTTPResponse parses an HTTP response from a DeletePackagingRunWithResponse call
+func ParseDeletePackagingRunHTTPResponse(rsp *http.Response) (*DeletePackagingRunHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeletePackagingRunHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPackagingRunHTTPResponse parses an HTTP response from a GetPackagingRunWithResponse call
+func ParseGetPackagingRunHTTPResponse(rsp *http.Response) (*GetPackagingRunHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPackagingRunHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PackagingRunResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompletePackagingRunHTTPResponse parses an HTTP response from a CompletePackagingRunWithResponse call
+func ParseCompletePackagingRunHTTPResponse(rsp *http.Response) (*CompletePackagingRunHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompletePackagingRunHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompletePackagingRunResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportRecipeIngredientsHTTPResponse parses an HTTP response from a ImportRecipeIngredientsWithResponse call
+func ParseImportRecipeIngredientsHTTPResponse(rsp *http.Response) (*ImportRecipeIngredientsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportRecipeIngredientsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Result
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRecipesHTTPResponse parses an HTTP response from a ListRecipesWithResponse call
+func ParseListRecipesHTTPResponse(rsp *http.Response) (*ListRecipesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRecipesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RecipeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRecipeHTTPResponse parses an HTTP response from a CreateRecipeWithResponse call
+func ParseCreateRecipeHTTPResponse(rsp *http.Response) (*CreateRecipeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRecipeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecipeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRecipeHTTPResponse parses an HTTP response from a DeleteRecipeWithResponse call
+func ParseDeleteRecipeHTTPResponse(rsp *http.Response) (*DeleteRecipeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRecipeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetRecipeHTTPResponse parses an HTTP response from a GetRecipeWithResponse call
+func ParseGetRecipeHTTPResponse(rsp *http.Response) (*GetRecipeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecipeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePatchRecipeHTTPResponse parses an HTTP response from a PatchRecipeWithResponse call
+func ParsePatchRecipeHTTPResponse(rsp *http.Response) (*PatchRecipeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PatchRecipeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRecipeHTTPResponse parses an HTTP response from a UpdateRecipeWithResponse call
+func ParseUpdateRecipeHTTPResponse(rsp *http.Response) (*UpdateRecipeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRecipeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRecipeIngredientsHTTPResponse parses an HTTP response from a ListRecipeIngredientsWithResponse call
+func ParseListRecipeIngredientsHTTPResponse(rsp *http.Response) (*ListRecipeIngredientsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRecipeIngredientsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RecipeIngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRecipeIngredientHTTPResponse parses an HTTP response from a CreateRecipeIngredientWithResponse call
+func ParseCreateRecipeIngredientHTTPResponse(rsp *http.Response) (*CreateRecipeIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRecipeIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecipeIngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRecipeIngredientHTTPResponse parses an HTTP response from a DeleteRecipeIngredientWithResponse call
+func ParseDeleteRecipeIngredientHTTPResponse(rsp *http.Response) (*DeleteRecipeIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRecipeIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetRecipeIngredientHTTPResponse parses an HTTP response from a GetRecipeIngredientWithResponse call
+func ParseGetRecipeIngredientHTTPResponse(rsp *http.Response) (*GetRecipeIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRecipeIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipeIngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateRecipeIngredientHTTPResponse parses an HTTP response from a UpdateRecipeIngredientWithResponse call
+func ParseUpdateRecipeIngredientHTTPResponse(rsp *http.Response) (*UpdateRecipeIngredientHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateRecipeIngredientHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecipeIngredientResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseScanCodeHTTPResponse parses an HTTP response from a ScanCodeWithResponse call
+func ParseScanCodeHTTPResponse(rsp *http.Response) (*ScanCodeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ScanCodeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListStylesHTTPResponse parses an HTTP response from a ListStylesWithResponse call
+func ParseListStylesHTTPResponse(rsp *http.Response) (*ListStylesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListStylesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []StyleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateStyleHTTPResponse parses an HTTP response from a CreateStyleWithResponse call
+func ParseCreateStyleHTTPResponse(rsp *http.Response) (*CreateStyleHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateStyleHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest StyleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStyleHTTPResponse parses an HTTP response from a GetStyleWithResponse call
+func ParseGetStyleHTTPResponse(rsp *http.Response) (*GetStyleHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStyleHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StyleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTransfersHTTPResponse parses an HTTP response from a ListTransfersWithResponse call
+func ParseListTransfersHTTPResponse(rsp *http.Response) (*ListTransfersHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTransfersHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTransferHTTPResponse parses an HTTP response from a CreateTransferWithResponse call
+func ParseCreateTransferHTTPResponse(rsp *http.Response) (*CreateTransferHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTransferHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransferRecordResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransferHTTPResponse parses an HTTP response from a GetTransferWithResponse call
+func ParseGetTransferHTTPResponse(rsp *http.Response) (*GetTransferHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransferHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVesselsHTTPResponse parses an HTTP response from a ListVesselsWithResponse call
+func ParseListVesselsHTTPResponse(rsp *http.Response) (*ListVesselsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVesselsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VesselResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVesselHTTPResponse parses an HTTP response from a CreateVesselWithResponse call
+func ParseCreateVesselHTTPResponse(rsp *http.Response) (*CreateVesselHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVesselHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VesselResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVesselHTTPResponse parses an HTTP response from a GetVesselWithResponse call
+func ParseGetVesselHTTPResponse(rsp *http.Response) (*GetVesselHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVesselHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VesselResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateVesselHTTPResponse parses an HTTP response from a UpdateVesselWithResponse call
+func ParseUpdateVesselHTTPResponse(rsp *http.Response) (*UpdateVesselHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateVesselHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VesselResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVesselLabelHTTPResponse parses an HTTP response from a GetVesselLabelWithResponse call
+func ParseGetVesselLabelHTTPResponse(rsp *http.Response) (*GetVesselLabelHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVesselLabelHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListVolumeRelationsHTTPResponse parses an HTTP response from a ListVolumeRelationsWithResponse call
+func ParseListVolumeRelationsHTTPResponse(rsp *http.Response) (*ListVolumeRelationsHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVolumeRelationsHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VolumeRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVolumeRelationHTTPResponse parses an HTTP response from a CreateVolumeRelationWithResponse call
+func ParseCreateVolumeRelationHTTPResponse(rsp *http.Response) (*CreateVolumeRelationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVolumeRelationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VolumeRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVolumeRelationHTTPResponse parses an HTTP response from a GetVolumeRelationWithResponse call
+func ParseGetVolumeRelationHTTPResponse(rsp *http.Response) (*GetVolumeRelationHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVolumeRelationHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VolumeRelationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVolumesHTTPResponse parses an HTTP response from a ListVolumesWithResponse call
+func ParseListVolumesHTTPResponse(rsp *http.Response) (*ListVolumesHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVolumesHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVolumeHTTPResponse parses an HTTP response from a CreateVolumeWithResponse call
+func ParseCreateVolumeHTTPResponse(rsp *http.Response) (*CreateVolumeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVolumeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVolumeHTTPResponse parses an HTTP response from a GetVolumeWithResponse call
+func ParseGetVolumeHTTPResponse(rsp *http.Response) (*GetVolumeHTTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVolumeHTTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VolumeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
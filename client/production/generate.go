@@ -0,0 +1,3 @@
+package production
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.1 -config oapi-codegen.yaml ../../service/production/handler/api.yaml
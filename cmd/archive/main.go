@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/brewpipes/brewpipes/cmd"
+	"github.com/brewpipes/brewpipes/internal/archive"
+	identitystorage "github.com/brewpipes/brewpipes/service/identity/storage"
+	inventorystorage "github.com/brewpipes/brewpipes/service/inventory/storage"
+	procurementstorage "github.com/brewpipes/brewpipes/service/procurement/storage"
+	productionstorage "github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const usage = `usage:
+  archive export -o FILE
+  archive restore -initial-password PASSWORD [-replace] FILE`
+
+func main() {
+	cmd.Main(run)
+}
+
+func run(ctx context.Context) error {
+	// Entry point for exporting all service data to an archive and restoring
+	// it into another database.
+
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		dsn = os.Getenv("POSTGRES_DSN")
+	}
+
+	if len(os.Args) < 2 {
+		return usageError(errors.New("missing command"))
+	}
+	switch os.Args[1] {
+	case "export":
+		return runExport(ctx, dsn, os.Args[2:])
+	case "restore":
+		return runRestore(ctx, dsn, os.Args[2:])
+	default:
+		return usageError(fmt.Errorf("unknown command %q", os.Args[1]))
+	}
+}
+
+func runExport(ctx context.Context, dsn string, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	output := flags.String("o", "", "archive file to write")
+	if err := flags.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if *output == "" || flags.NArg() != 0 {
+		return usageError(errors.New("export takes -o FILE"))
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("creating DB connection pool: %w", err)
+	}
+	defer pool.Close()
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("creating archive file: %w", err)
+	}
+	defer file.Close()
+
+	// Passwords are not exported, so the restore hash is irrelevant here.
+	manifest, err := archive.Export(ctx, pool, services(""), file)
+	if err != nil {
+		os.Remove(*output)
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("writing archive file: %w", err)
+	}
+
+	for _, svc := range manifest.Services {
+		rows := 0
+		for _, count := range svc.Tables {
+			rows += count
+		}
+		slog.Info("exported service", "service", svc.Name, "schema_version", svc.SchemaVersion, "rows", rows)
+	}
+	return nil
+}
+
+func runRestore(ctx context.Context, dsn string, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	password := flags.String("initial-password", "", "password given to every restored user")
+	replace := flags.Bool("replace", false, "discard existing data, including seed data, before restoring")
+	if err := flags.Parse(args); err != nil {
+		return usageError(err)
+	}
+	if flags.NArg() != 1 {
+		return usageError(errors.New("restore takes one archive file"))
+	}
+	if *password == "" {
+		return usageError(errors.New("restore needs -initial-password because archives hold no password hashes"))
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("opening archive file: %w", err)
+	}
+	defer file.Close()
+
+	a, err := archive.Read(file)
+	if err != nil {
+		return err
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(*password), 12)
+	if err != nil {
+		return fmt.Errorf("hashing initial password: %w", err)
+	}
+	svcs := services(string(hashed))
+	if err := archive.Validate(a, svcs); err != nil {
+		return err
+	}
+
+	if err := migrate(ctx, dsn); err != nil {
+		return err
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("creating DB connection pool: %w", err)
+	}
+	defer pool.Close()
+
+	if err := archive.Restore(ctx, pool, svcs, a, archive.RestoreOptions{Replace: *replace}); err != nil {
+		return err
+	}
+
+	slog.Info("restored archive", "created_at", a.Manifest.CreatedAt)
+	return nil
+}
+
+func services(passwordHash string) []archive.Service {
+	return []archive.Service{
+		identitystorage.ArchiveService(passwordHash),
+		productionstorage.Archive,
+		inventorystorage.Archive,
+		procurementstorage.Archive,
+	}
+}
+
+// migrate brings every service's schema up to date so the restore target
+// matches this build.
+func migrate(ctx context.Context, dsn string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	identity, err := identitystorage.New(ctx, dsn)
+	if err != nil {
+		return err
+	}
+	if err := identity.Start(ctx); err != nil {
+		return fmt.Errorf("migrating identity: %w", err)
+	}
+	if err := productionstorage.New(dsn).Start(ctx); err != nil {
+		return fmt.Errorf("migrating production: %w", err)
+	}
+	if err := inventorystorage.New(dsn).Start(ctx); err != nil {
+		return fmt.Errorf("migrating inventory: %w", err)
+	}
+	if err := procurementstorage.New(dsn).Start(ctx); err != nil {
+		return fmt.Errorf("migrating procurement: %w", err)
+	}
+	return nil
+}
+
+func usageError(err error) error {
+	return cmd.RunError{Err: fmt.Errorf("%w\n%s", err, usage), ExitCode: 2}
+}
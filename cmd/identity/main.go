@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/brewpipes/brewpipes/cmd"
@@ -20,9 +21,15 @@ func run(ctx context.Context) error {
 		dsn = os.Getenv("POSTGRES_DSN")
 	}
 
+	serviceClients, err := identity.ParseServiceClients(os.Getenv("BREWPIPES_SERVICE_CLIENTS"))
+	if err != nil {
+		return fmt.Errorf("parsing BREWPIPES_SERVICE_CLIENTS: %w", err)
+	}
+
 	svc, err := identity.NewService(ctx, &identity.Config{
-		PostgresDSN: dsn,
-		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
+		PostgresDSN:    dsn,
+		SecretKey:      os.Getenv("BREWPIPES_SECRET_KEY"),
+		ServiceClients: serviceClients,
 	})
 	if err != nil {
 		return err
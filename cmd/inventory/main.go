@@ -21,8 +21,9 @@ func run(ctx context.Context) error {
 	}
 
 	svc := inventory.New(inventory.Config{
-		PostgresDSN: dsn,
-		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
+		PostgresDSN:   dsn,
+		SecretKey:     os.Getenv("BREWPIPES_SECRET_KEY"),
+		ServiceSecret: os.Getenv("BREWPIPES_SERVICE_SECRET"),
 	})
 
 	return cmd.RunServices(ctx, nil, svc)
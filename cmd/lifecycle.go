@@ -9,6 +9,7 @@ import (
 	"os/signal"
 	"time"
 
+	"github.com/brewpipes/brewpipes/internal/telemetry"
 	"github.com/brewpipes/brewpipes/service"
 )
 
@@ -21,10 +22,18 @@ type Service interface {
 // RunServices starts the provided services and manages their lifecycle.
 // If staticHandler is non-nil, it is registered as a catch-all handler for
 // non-API routes to serve static files (e.g., a frontend SPA).
+//
+// Every service route is instrumented with request metrics and tracing, and
+// the metrics are served at /metrics.
 func RunServices(ctx context.Context, staticHandler http.Handler, services ...Service) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	shutdownTracing, err := telemetry.StartTracing(ctx)
+	if err != nil {
+		return fmt.Errorf("starting tracing: %w", err)
+	}
+
 	// Aggregate HTTP routes from all services.
 	mux := http.NewServeMux()
 
@@ -34,13 +43,16 @@ func RunServices(ctx context.Context, staticHandler http.Handler, services ...Se
 		_, _ = w.Write([]byte("ok"))
 	})
 
+	// Prometheus scrape endpoint.
+	mux.Handle("GET /metrics", telemetry.MetricsHandler())
+
 	for _, svc := range services {
 		for _, route := range svc.HTTPRoutes() {
 			pattern := "/api" + route.Path
 			if route.Method != "" {
 				pattern = route.Method + " /api" + route.Path
 			}
-			mux.Handle(pattern, route.Handler)
+			mux.Handle(pattern, telemetry.Handler("/api"+route.Path, route.Serve()))
 		}
 	}
 
@@ -87,6 +99,11 @@ func RunServices(ctx context.Context, staticHandler http.Handler, services ...Se
 	slog.Info("stopping services")
 	cancel()
 
+	// Flush spans still buffered for the collector.
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("error while shutting down tracing", "error", err)
+	}
+
 	slog.Info("application terminated")
 	return nil
 }
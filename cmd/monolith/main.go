@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"os"
 
 	"github.com/brewpipes/brewpipes/cmd"
+	"github.com/brewpipes/brewpipes/internal/inprocess"
 	"github.com/brewpipes/brewpipes/service/identity"
 	"github.com/brewpipes/brewpipes/service/inventory"
 	"github.com/brewpipes/brewpipes/service/procurement"
@@ -24,29 +27,66 @@ func run(ctx context.Context) error {
 		dsn = os.Getenv("POSTGRES_DSN")
 	}
 
+	serviceClients, err := monolithServiceClients()
+	if err != nil {
+		return err
+	}
+
 	// Initialize services.
 	identitySvc, err := identity.NewService(ctx, &identity.Config{
-		PostgresDSN: dsn,
-		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
+		PostgresDSN:    dsn,
+		SecretKey:      os.Getenv("BREWPIPES_SECRET_KEY"),
+		ServiceClients: serviceClients,
 	})
 	if err != nil {
 		return fmt.Errorf("initializing identity service: %w", err)
 	}
 
-	productionSvc := production.New(production.Config{
-		PostgresDSN: dsn,
-		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
-	})
-
 	inventorySvc := inventory.New(inventory.Config{
-		PostgresDSN: dsn,
-		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
+		PostgresDSN:   dsn,
+		SecretKey:     os.Getenv("BREWPIPES_SECRET_KEY"),
+		ServiceSecret: serviceClients["inventory"],
 	})
 
 	procurementSvc := procurement.New(procurement.Config{
+		PostgresDSN:   dsn,
+		SecretKey:     os.Getenv("BREWPIPES_SECRET_KEY"),
+		ServiceSecret: serviceClients["procurement"],
+	})
+
+	// Production calls inventory and procurement directly rather than
+	// over HTTP, which also lets those calls share its transactions.
+	productionSvc := production.New(production.Config{
 		PostgresDSN: dsn,
 		SecretKey:   os.Getenv("BREWPIPES_SECRET_KEY"),
+		Inventory:   inprocess.NewInventory(inventorySvc.Storage()),
+		Procurement: inprocess.NewProcurement(procurementSvc.Storage()),
 	})
 
 	return cmd.RunServices(ctx, www.Handler(), identitySvc, productionSvc, inventorySvc, procurementSvc)
 }
+
+// monolithServiceClients returns the client secrets the services that still
+// call each other over HTTP use to get service tokens from identity. They
+// come from BREWPIPES_SERVICE_CLIENTS when it is set; otherwise, since every
+// service runs in this process, a random secret is generated for each on
+// startup.
+func monolithServiceClients() (map[string]string, error) {
+	if value := os.Getenv("BREWPIPES_SERVICE_CLIENTS"); value != "" {
+		clients, err := identity.ParseServiceClients(value)
+		if err != nil {
+			return nil, fmt.Errorf("parsing BREWPIPES_SERVICE_CLIENTS: %w", err)
+		}
+		return clients, nil
+	}
+
+	clients := make(map[string]string)
+	for _, name := range []string{"inventory", "procurement"} {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, fmt.Errorf("generating service client secret: %w", err)
+		}
+		clients[name] = hex.EncodeToString(secret)
+	}
+	return clients, nil
+}
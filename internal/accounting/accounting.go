@@ -0,0 +1,283 @@
+// Package accounting turns purchasing and inventory activity into journal
+// entries for a bookkeeping system. Procurement journals received purchase
+// orders against accounts payable and Inventory journals what leaves stock:
+// consumption into work in process or cost of goods sold, packaging into
+// finished goods, write-offs, adjustments and supplier returns.
+//
+// Accounts are resolved from a Mapping of ingredient categories, fee types,
+// purchase order item types and control roles to GL account names. Journals
+// cover one calendar month and are written as QuickBooks IIF or as a Xero
+// manual journal CSV.
+package accounting
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Account kinds, the vocabulary each mapping key comes from.
+const (
+	KindRole     = "role"
+	KindCategory = "category"
+	KindFeeType  = "fee_type"
+	KindItemType = "item_type"
+)
+
+// Roles are the control accounts journals post against.
+const (
+	RoleAccountsPayable     = "accounts_payable"
+	RoleWorkInProcess       = "work_in_process"
+	RoleCostOfGoodsSold     = "cost_of_goods_sold"
+	RoleFinishedGoods       = "finished_goods"
+	RoleWriteOff            = "write_off"
+	RoleInventoryAdjustment = "inventory_adjustment"
+	RoleSupplierReturns     = "supplier_returns"
+)
+
+// Roles, Categories and ItemTypes must all be mapped. Categories are the
+// Inventory ingredient categories; ItemTypes are the purchase order item
+// types that are not received into inventory.
+var (
+	Roles = []string{
+		RoleAccountsPayable, RoleWorkInProcess, RoleCostOfGoodsSold, RoleFinishedGoods,
+		RoleWriteOff, RoleInventoryAdjustment, RoleSupplierReturns,
+	}
+	Categories = []string{"fermentable", "hop", "yeast", "adjunct", "salt", "chemical", "gas", "packaging", "other"}
+	ItemTypes  = []string{"service", "equipment", "other"}
+)
+
+// Mapping assigns GL accounts. Fee types are optional: a fee whose type is
+// not mapped is capitalized into the landed cost of the order's lines.
+type Mapping struct {
+	// XeroTaxRate is the tax rate name written on every Xero journal line,
+	// e.g. "Tax Exempt" or "BAS Excluded".
+	XeroTaxRate string    `json:"xero_tax_rate"`
+	Accounts    []Account `json:"accounts"`
+}
+
+// Account maps one key of a kind to a GL account name.
+type Account struct {
+	Kind    string `json:"kind"`
+	Key     string `json:"key"`
+	Account string `json:"account"`
+}
+
+// Validate checks that every account has a known kind and key, each key is
+// mapped once, and every role, category and item type is mapped.
+func (m Mapping) Validate() error {
+	if m.XeroTaxRate == "" {
+		return fmt.Errorf("xero_tax_rate is required")
+	}
+
+	seen := make(map[string]bool, len(m.Accounts))
+	for _, a := range m.Accounts {
+		switch a.Kind {
+		case KindRole:
+			if !slices.Contains(Roles, a.Key) {
+				return fmt.Errorf("invalid role %q", a.Key)
+			}
+		case KindCategory:
+			if !slices.Contains(Categories, a.Key) {
+				return fmt.Errorf("invalid category %q", a.Key)
+			}
+		case KindItemType:
+			if !slices.Contains(ItemTypes, a.Key) {
+				return fmt.Errorf("invalid item_type %q", a.Key)
+			}
+		case KindFeeType:
+			if a.Key == "" {
+				return fmt.Errorf("fee_type key is required")
+			}
+		default:
+			return fmt.Errorf("invalid kind %q", a.Kind)
+		}
+		if a.Account == "" {
+			return fmt.Errorf("account is required for %s %s", a.Kind, a.Key)
+		}
+		if len(a.Account) > 128 {
+			return fmt.Errorf("account for %s %s must be at most 128 characters", a.Kind, a.Key)
+		}
+		id := a.Kind + "/" + a.Key
+		if seen[id] {
+			return fmt.Errorf("%s %s is mapped more than once", a.Kind, a.Key)
+		}
+		seen[id] = true
+	}
+
+	for _, required := range []struct {
+		kind string
+		keys []string
+	}{{KindRole, Roles}, {KindCategory, Categories}, {KindItemType, ItemTypes}} {
+		for _, key := range required.keys {
+			if !seen[required.kind+"/"+key] {
+				return fmt.Errorf("%s %s must be mapped", required.kind, key)
+			}
+		}
+	}
+	return nil
+}
+
+// Lookup returns the account mapped to a key.
+func (m Mapping) Lookup(kind, key string) (string, bool) {
+	for _, a := range m.Accounts {
+		if a.Kind == kind && a.Key == key {
+			return a.Account, true
+		}
+	}
+	return "", false
+}
+
+// Category returns the inventory account of an ingredient category, falling
+// back to the "other" category.
+func (m Mapping) Category(category string) string {
+	if account, ok := m.Lookup(KindCategory, category); ok {
+		return account
+	}
+	account, _ := m.Lookup(KindCategory, "other")
+	return account
+}
+
+// Role returns the account of a control role.
+func (m Mapping) Role(role string) string {
+	account, _ := m.Lookup(KindRole, role)
+	return account
+}
+
+// Period is a calendar month in UTC.
+type Period struct {
+	Start time.Time
+}
+
+// ParsePeriod parses a period written as YYYY-MM.
+func ParsePeriod(value string) (Period, error) {
+	start, err := time.Parse("2006-01", value)
+	if err != nil {
+		return Period{}, fmt.Errorf("period must be formatted as YYYY-MM")
+	}
+	return Period{Start: start}, nil
+}
+
+// End is the first instant after the period.
+func (p Period) End() time.Time {
+	return p.Start.AddDate(0, 1, 0)
+}
+
+// LastDay is the date month-end entries are posted on.
+func (p Period) LastDay() time.Time {
+	return p.End().AddDate(0, 0, -1)
+}
+
+func (p Period) String() string {
+	return p.Start.Format("2006-01")
+}
+
+// Journal is the set of entries for one period.
+type Journal struct {
+	Period       string    `json:"period"`
+	BaseCurrency string    `json:"base_currency"`
+	Entries      []Entry   `json:"entries"`
+	TotalCents   int64     `json:"total_cents"`
+	Skipped      []Skipped `json:"skipped"`
+}
+
+// Skipped is activity left out of a journal, such as an order without an
+// exchange rate or a lot without a cost.
+type Skipped struct {
+	Reference string `json:"reference"`
+	Reason    string `json:"reason"`
+}
+
+// NewJournal returns an empty journal for a period.
+func NewJournal(period Period, baseCurrency string) Journal {
+	return Journal{
+		Period:       period.String(),
+		BaseCurrency: baseCurrency,
+		Entries:      []Entry{},
+		Skipped:      []Skipped{},
+	}
+}
+
+// Add appends an entry, ignoring entries with no amounts.
+func (j *Journal) Add(e Entry) {
+	if len(e.Lines) == 0 {
+		return
+	}
+	j.Entries = append(j.Entries, e)
+	j.TotalCents += e.DebitCents()
+}
+
+// Skip records activity left out of the journal.
+func (j *Journal) Skip(reference, reason string) {
+	j.Skipped = append(j.Skipped, Skipped{Reference: reference, Reason: reason})
+}
+
+// Entry is a balanced journal entry. Amounts are in cents of the base
+// currency.
+type Entry struct {
+	Date   time.Time `json:"date"`
+	Number string    `json:"number"`
+	Memo   string    `json:"memo"`
+	Lines  []Line    `json:"lines"`
+}
+
+// Line debits or credits one account. Name is the vendor of accounts
+// payable lines, which QuickBooks requires.
+type Line struct {
+	Account     string `json:"account"`
+	Name        string `json:"name,omitempty"`
+	DebitCents  int64  `json:"debit_cents"`
+	CreditCents int64  `json:"credit_cents"`
+}
+
+// NewEntry returns an entry with no lines.
+func NewEntry(date time.Time, number, memo string) Entry {
+	return Entry{Date: date, Number: number, Memo: memo, Lines: []Line{}}
+}
+
+// Debit adds cents to the debit of an account, merging lines for the same
+// account and name. Negative amounts credit the account.
+func (e *Entry) Debit(account, name string, cents int64) {
+	e.post(account, name, cents)
+}
+
+// Credit adds cents to the credit of an account.
+func (e *Entry) Credit(account, name string, cents int64) {
+	e.post(account, name, -cents)
+}
+
+func (e *Entry) post(account, name string, cents int64) {
+	if cents == 0 {
+		return
+	}
+	for i := range e.Lines {
+		l := &e.Lines[i]
+		if l.Account == account && l.Name == name {
+			net := l.DebitCents - l.CreditCents + cents
+			l.DebitCents, l.CreditCents = max(net, 0), max(-net, 0)
+			if net == 0 {
+				e.Lines = slices.Delete(e.Lines, i, i+1)
+			}
+			return
+		}
+	}
+	e.Lines = append(e.Lines, Line{Account: account, Name: name, DebitCents: max(cents, 0), CreditCents: max(-cents, 0)})
+}
+
+// DebitCents is the total of the entry's debits.
+func (e Entry) DebitCents() int64 {
+	var total int64
+	for _, l := range e.Lines {
+		total += l.DebitCents
+	}
+	return total
+}
+
+// Balanced reports whether debits equal credits.
+func (e Entry) Balanced() bool {
+	var net int64
+	for _, l := range e.Lines {
+		net += l.DebitCents - l.CreditCents
+	}
+	return net == 0
+}
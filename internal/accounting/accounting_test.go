@@ -0,0 +1,135 @@
+package accounting
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testMapping() Mapping {
+	m := Mapping{XeroTaxRate: "Tax Exempt"}
+	for _, role := range Roles {
+		m.Accounts = append(m.Accounts, Account{Kind: KindRole, Key: role, Account: role})
+	}
+	for _, category := range Categories {
+		m.Accounts = append(m.Accounts, Account{Kind: KindCategory, Key: category, Account: "Inventory:" + category})
+	}
+	for _, itemType := range ItemTypes {
+		m.Accounts = append(m.Accounts, Account{Kind: KindItemType, Key: itemType, Account: itemType})
+	}
+	return m
+}
+
+func TestMappingValidate(t *testing.T) {
+	if err := testMapping().Validate(); err != nil {
+		t.Fatalf("expected a complete mapping to validate, got %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		mutate   func(*Mapping)
+		expected string
+	}{
+		{"missing role", func(m *Mapping) { m.Accounts = m.Accounts[1:] }, "role accounts_payable must be mapped"},
+		{"unknown category", func(m *Mapping) {
+			m.Accounts = append(m.Accounts, Account{Kind: KindCategory, Key: "beer", Account: "x"})
+		}, `invalid category "beer"`},
+		{"duplicate fee type", func(m *Mapping) {
+			fee := Account{Kind: KindFeeType, Key: "freight", Account: "Freight In"}
+			m.Accounts = append(m.Accounts, fee, fee)
+		}, "fee_type freight is mapped more than once"},
+		{"empty account", func(m *Mapping) { m.Accounts[0].Account = "" }, "account is required for role accounts_payable"},
+		{"no tax rate", func(m *Mapping) { m.XeroTaxRate = "" }, "xero_tax_rate is required"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := testMapping()
+			tc.mutate(&m)
+			if err := m.Validate(); err == nil || err.Error() != tc.expected {
+				t.Errorf("expected %q, got %v", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestEntryMergesLines(t *testing.T) {
+	e := NewEntry(time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC), "PO-1", "Hops")
+	e.Debit("Inventory:hop", "", 1000)
+	e.Debit("Inventory:hop", "", 250)
+	e.Debit("Freight In", "", 100)
+	e.Credit("Freight In", "", 100)
+	e.Credit("Accounts Payable", "YCH", 1250)
+
+	if len(e.Lines) != 2 || e.Lines[0].DebitCents != 1250 || e.Lines[1].CreditCents != 1250 {
+		t.Errorf("unexpected lines %+v", e.Lines)
+	}
+	if !e.Balanced() || e.DebitCents() != 1250 {
+		t.Errorf("expected a balanced entry of 1250, got %+v", e)
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	p, err := ParsePeriod("2026-02")
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	if p.String() != "2026-02" || p.LastDay().Format(time.DateOnly) != "2026-02-28" || p.End().Format(time.DateOnly) != "2026-03-01" {
+		t.Errorf("unexpected period %v", p)
+	}
+	if _, err := ParsePeriod("2026-13"); err == nil {
+		t.Error("expected an invalid month to fail")
+	}
+}
+
+func sampleEntries() []Entry {
+	e := NewEntry(time.Date(2026, 9, 14, 0, 0, 0, 0, time.UTC), "PO-1001", "Purchase order PO-1001\tfrom \"YCH\"")
+	e.Debit("Inventory:Hops", "", 123456)
+	e.Debit("Freight In", "", 2500)
+	e.Credit("Accounts Payable", "YCH Hops", 125956)
+	return []Entry{e}
+}
+
+func TestWriteIIF(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteIIF(&sb, sampleEntries()); err != nil {
+		t.Fatalf("writing: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("expected 3 header rows, 3 lines and ENDTRNS, got %q", sb.String())
+	}
+	expected := "TRNS\t\tGENERAL JOURNAL\t09/14/2026\tInventory:Hops\t\t1234.56\tPO-1001\tPurchase order PO-1001 from 'YCH'"
+	if lines[3] != expected {
+		t.Errorf("expected TRNS row %q, got %q", expected, lines[3])
+	}
+	if !strings.HasPrefix(lines[5], "SPL\t\tGENERAL JOURNAL\t09/14/2026\tAccounts Payable\tYCH Hops\t-1259.56\t") {
+		t.Errorf("unexpected SPL row %q", lines[5])
+	}
+	if lines[6] != "ENDTRNS" {
+		t.Errorf("expected ENDTRNS, got %q", lines[6])
+	}
+}
+
+func TestWriteXero(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteXero(&sb, sampleEntries(), "Tax Exempt"); err != nil {
+		t.Fatalf("writing: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "*Narration,*Date,") {
+		t.Fatalf("unexpected csv %q", sb.String())
+	}
+	if !strings.Contains(lines[3], ",2026-09-14,") || !strings.HasSuffix(lines[3], ",Accounts Payable,Tax Exempt,-1259.56,,,,") {
+		t.Errorf("unexpected credit row %q", lines[3])
+	}
+}
+
+func TestWriteRejectsUnbalancedEntry(t *testing.T) {
+	e := NewEntry(time.Now(), "X-1", "broken")
+	e.Debit("Inventory:Hops", "", 100)
+	if err := WriteIIF(&strings.Builder{}, []Entry{e}); err == nil {
+		t.Error("expected an unbalanced entry to fail")
+	}
+}
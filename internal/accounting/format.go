@@ -0,0 +1,137 @@
+package accounting
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Export formats. Mapped accounts are written as they are, so they should be
+// QuickBooks account names (e.g. "Inventory:Hops") for IIF and Xero account
+// codes for Xero.
+const (
+	FormatIIF  = "iif"
+	FormatXero = "xero"
+)
+
+// ValidateFormat checks that format is an export format.
+func ValidateFormat(format string) error {
+	switch format {
+	case FormatIIF, FormatXero:
+		return nil
+	default:
+		return fmt.Errorf("format must be iif or xero")
+	}
+}
+
+// ContentType returns the media type of an export format.
+func ContentType(format string) string {
+	if format == FormatIIF {
+		return "text/plain; charset=utf-8"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// FileName names the export of a journal, e.g. "purchase-journal-2026-09.iif".
+func FileName(journal, period, format string) string {
+	ext := "csv"
+	if format == FormatIIF {
+		ext = "iif"
+	}
+	return fmt.Sprintf("%s-%s.%s", journal, period, ext)
+}
+
+// Write writes the entries of a journal in an export format.
+func Write(w io.Writer, format string, journal Journal, mapping Mapping) error {
+	switch format {
+	case FormatIIF:
+		return WriteIIF(w, journal.Entries)
+	case FormatXero:
+		return WriteXero(w, journal.Entries, mapping.XeroTaxRate)
+	default:
+		return ValidateFormat(format)
+	}
+}
+
+// WriteIIF writes entries as QuickBooks IIF general journal transactions:
+// a TRNS row for the first line, an SPL row for each other line, and
+// ENDTRNS. Debits are positive amounts and credits negative.
+func WriteIIF(w io.Writer, entries []Entry) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("!TRNS\tTRNSID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO\n")
+	bw.WriteString("!SPL\tSPLID\tTRNSTYPE\tDATE\tACCNT\tNAME\tAMOUNT\tDOCNUM\tMEMO\n")
+	bw.WriteString("!ENDTRNS\n")
+	for _, e := range entries {
+		if !e.Balanced() {
+			return fmt.Errorf("entry %s is not balanced", e.Number)
+		}
+		date := e.Date.Format("01/02/2006")
+		for i, l := range e.Lines {
+			kind := "SPL"
+			if i == 0 {
+				kind = "TRNS"
+			}
+			fields := []string{
+				kind, "", "GENERAL JOURNAL", date, iifField(l.Account), iifField(l.Name),
+				formatCents(l.DebitCents - l.CreditCents), iifField(e.Number), iifField(e.Memo),
+			}
+			bw.WriteString(strings.Join(fields, "\t"))
+			bw.WriteString("\n")
+		}
+		bw.WriteString("ENDTRNS\n")
+	}
+	return bw.Flush()
+}
+
+// WriteXero writes entries as a Xero manual journal import. Lines of an
+// entry share its narration and date, which is how Xero groups them into one
+// journal. Debits are positive amounts and credits negative.
+func WriteXero(w io.Writer, entries []Entry, taxRate string) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{
+		"*Narration", "*Date", "Description", "*AccountCode", "*TaxRate", "*Amount",
+		"TrackingName1", "TrackingOption1", "TrackingName2", "TrackingOption2",
+	})
+	for _, e := range entries {
+		if !e.Balanced() {
+			return fmt.Errorf("entry %s is not balanced", e.Number)
+		}
+		narration := e.Number + " " + e.Memo
+		for _, l := range e.Lines {
+			description := e.Memo
+			if l.Name != "" {
+				description += " (" + l.Name + ")"
+			}
+			cw.Write([]string{
+				narration, e.Date.Format("2006-01-02"), description, l.Account, taxRate,
+				formatCents(l.DebitCents - l.CreditCents), "", "", "", "",
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// iifField removes the tabs, quotes and line breaks IIF cannot hold.
+func iifField(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\t', '\n', '\r':
+			return ' '
+		case '"':
+			return '\''
+		}
+		return r
+	}, s)
+}
+
+// formatCents formats cents as a decimal amount, e.g. -1234 as "-12.34".
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
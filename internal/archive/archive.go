@@ -0,0 +1,224 @@
+// Package archive exports the data of every service to a single versioned
+// archive and restores it into another database.
+//
+// An archive is a gzipped tar file holding manifest.json and one JSON lines
+// file per table, <service>/<table>.jsonl, with one row per line keyed by
+// column name. Rows keep their UUIDs and internal IDs, so references inside
+// a service and UUID references between services survive a restore.
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// FormatVersion is the version of the archive layout. It changes only when
+// the layout does; schema changes are tracked per service in the manifest.
+const FormatVersion = 1
+
+const manifestName = "manifest.json"
+
+// Service describes what a service contributes to an archive.
+type Service struct {
+	Name string
+	// MigrationTable is the service's golang-migrate version table.
+	MigrationTable string
+	Tables         []Table
+	// Skip lists tables left out of the archive, such as sessions. They are
+	// emptied on restore.
+	Skip []string
+	// References lists the UUID columns that point into other services.
+	References []Reference
+}
+
+// Table is an archived table.
+type Table struct {
+	// Name is the table name, schema-qualified outside the public schema.
+	Name string
+	// Key is the column rows are exported in order of; it defaults to id.
+	Key string
+	// Omit lists columns left out of the export.
+	Omit []string
+	// Defaults gives restored rows a value for columns the archive does not
+	// hold, such as omitted columns that are NOT NULL.
+	Defaults map[string]any
+}
+
+// Tables lists tables exported in full.
+func Tables(names ...string) []Table {
+	tables := make([]Table, 0, len(names))
+	for _, name := range names {
+		tables = append(tables, Table{Name: name})
+	}
+	return tables
+}
+
+func (t Table) key() string {
+	if t.Key == "" {
+		return "id"
+	}
+	return t.Key
+}
+
+// Reference is a UUID column that points at the uuid of a row in another
+// service's table.
+type Reference struct {
+	Table   string
+	Column  string
+	Service string
+	Target  string
+}
+
+// Manifest describes an archive.
+type Manifest struct {
+	FormatVersion int               `json:"format_version"`
+	CreatedAt     time.Time         `json:"created_at"`
+	Services      []ServiceManifest `json:"services"`
+}
+
+// ServiceManifest records a service's schema version and its row counts per
+// table.
+type ServiceManifest struct {
+	Name          string         `json:"name"`
+	SchemaVersion int64          `json:"schema_version"`
+	Tables        map[string]int `json:"tables"`
+}
+
+// Archive is an archive read into memory.
+type Archive struct {
+	Manifest Manifest
+	rows     map[string][]json.RawMessage
+}
+
+// Rows returns the rows of a service's table.
+func (a *Archive) Rows(service, table string) []json.RawMessage {
+	return a.rows[tablePath(service, table)]
+}
+
+func tablePath(service, table string) string {
+	return service + "/" + table + ".jsonl"
+}
+
+// writer writes table files and then the manifest.
+type writer struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func newWriter(w io.Writer) *writer {
+	gz := gzip.NewWriter(w)
+	return &writer{gz: gz, tw: tar.NewWriter(gz)}
+}
+
+func (w *writer) add(name string, data []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("writing archive header for %s: %w", name, err)
+	}
+	if _, err := w.tw.Write(data); err != nil {
+		return fmt.Errorf("writing archive file %s: %w", name, err)
+	}
+	return nil
+}
+
+func (w *writer) close(manifest Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding manifest: %w", err)
+	}
+	if err := w.add(manifestName, data); err != nil {
+		return err
+	}
+	if err := w.tw.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	if err := w.gz.Close(); err != nil {
+		return fmt.Errorf("closing archive: %w", err)
+	}
+	return nil
+}
+
+// Read reads an archive and checks that it is complete: a manifest of a
+// known format version and every table file with the rows it lists.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %w", err)
+	}
+	defer gz.Close()
+
+	a := &Archive{rows: make(map[string][]json.RawMessage)}
+	var haveManifest bool
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+
+		switch {
+		case header.Name == manifestName:
+			if err := json.NewDecoder(tr).Decode(&a.Manifest); err != nil {
+				return nil, fmt.Errorf("decoding manifest: %w", err)
+			}
+			haveManifest = true
+		case strings.HasSuffix(header.Name, ".jsonl"):
+			rows, err := readRows(tr)
+			if err != nil {
+				return nil, fmt.Errorf("reading %s: %w", header.Name, err)
+			}
+			a.rows[header.Name] = rows
+		default:
+			return nil, fmt.Errorf("unexpected archive file %s", header.Name)
+		}
+	}
+
+	if !haveManifest {
+		return nil, fmt.Errorf("archive has no manifest")
+	}
+	if a.Manifest.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d", a.Manifest.FormatVersion)
+	}
+	for _, svc := range a.Manifest.Services {
+		for table, count := range svc.Tables {
+			if got := len(a.Rows(svc.Name, table)); got != count {
+				return nil, fmt.Errorf("archive is incomplete: %s.%s has %d rows, manifest lists %d", svc.Name, table, got, count)
+			}
+		}
+	}
+
+	return a, nil
+}
+
+func readRows(r io.Reader) ([]json.RawMessage, error) {
+	var rows []json.RawMessage
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if !json.Valid(line) || line[0] != '{' {
+				return nil, fmt.Errorf("row %d is not a JSON object", len(rows)+1)
+			}
+			rows = append(rows, json.RawMessage(line))
+		}
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package archive
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var testServices = []Service{
+	{
+		Name:   "production",
+		Tables: Tables("batch"),
+	},
+	{
+		Name:   "inventory",
+		Tables: Tables("ingredient_lot", "beer_lot"),
+		References: []Reference{
+			{Table: "beer_lot", Column: "production_batch_uuid", Service: "production", Target: "batch"},
+		},
+	},
+}
+
+func writeArchive(t *testing.T, files map[string]string, manifest Manifest) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := newWriter(&buf)
+	for name, data := range files {
+		if err := w.add(name, []byte(data)); err != nil {
+			t.Fatalf("adding %s: %v", name, err)
+		}
+	}
+	if err := w.close(manifest); err != nil {
+		t.Fatalf("closing archive: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func testManifest(beerLots int) Manifest {
+	return Manifest{
+		FormatVersion: FormatVersion,
+		Services: []ServiceManifest{
+			{Name: "production", SchemaVersion: 3, Tables: map[string]int{"batch": 1}},
+			{Name: "inventory", SchemaVersion: 5, Tables: map[string]int{"ingredient_lot": 0, "beer_lot": beerLots}},
+		},
+	}
+}
+
+func TestReadRoundTrip(t *testing.T) {
+	data := writeArchive(t, map[string]string{
+		"production/batch.jsonl":         `{"id": 1, "uuid": "b1", "short_name": "IPA 24-07"}` + "\n",
+		"inventory/ingredient_lot.jsonl": "",
+		"inventory/beer_lot.jsonl":       `{"id": 1, "uuid": "l1", "production_batch_uuid": "b1"}` + "\n",
+	}, testManifest(1))
+
+	a, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("reading archive: %v", err)
+	}
+	if a.Manifest.Services[1].SchemaVersion != 5 {
+		t.Errorf("unexpected manifest %+v", a.Manifest)
+	}
+	if rows := a.Rows("production", "batch"); len(rows) != 1 || !strings.Contains(string(rows[0]), "IPA 24-07") {
+		t.Errorf("unexpected batch rows %s", rows)
+	}
+	if err := Validate(a, testServices); err != nil {
+		t.Errorf("expected a valid archive, got %v", err)
+	}
+}
+
+func TestReadRejectsIncompleteArchive(t *testing.T) {
+	data := writeArchive(t, map[string]string{
+		"production/batch.jsonl":   `{"id": 1, "uuid": "b1"}` + "\n",
+		"inventory/beer_lot.jsonl": "",
+	}, testManifest(2))
+
+	_, err := Read(bytes.NewReader(data))
+	if err == nil || !strings.Contains(err.Error(), "beer_lot has 0 rows, manifest lists 2") {
+		t.Errorf("expected an incomplete archive error, got %v", err)
+	}
+}
+
+func TestValidateReportsDanglingReferences(t *testing.T) {
+	data := writeArchive(t, map[string]string{
+		"production/batch.jsonl": `{"id": 1, "uuid": "b1"}` + "\n",
+		"inventory/beer_lot.jsonl": `{"id": 1, "uuid": "l1", "production_batch_uuid": "b1"}` + "\n" +
+			`{"id": 2, "uuid": "l2", "production_batch_uuid": "b9"}` + "\n" +
+			`{"id": 3, "uuid": "l3", "production_batch_uuid": null}` + "\n",
+	}, testManifest(3))
+	a, err := Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("reading archive: %v", err)
+	}
+
+	err = Validate(a, testServices)
+	if err == nil {
+		t.Fatal("expected a dangling reference error")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "1 unresolved references") || !strings.Contains(msg, "inventory.beer_lot l2: production_batch_uuid b9 not found in production.batch") {
+		t.Errorf("unexpected error %q", msg)
+	}
+
+	err = Validate(a, testServices[:1])
+	if err == nil || err.Error() != "archive has unknown service inventory" {
+		t.Errorf("expected an unknown service error, got %v", err)
+	}
+}
+
+func TestSortTables(t *testing.T) {
+	deps := map[string][]string{
+		"batch_volume": {"batch", "volume"},
+		"volume":       {"volume"},
+		"batch":        {"recipe"},
+		"keg_event":    {"keg", "identity.user"},
+	}
+
+	order, err := sortTables([]string{"batch_volume", "volume", "batch", "recipe", "keg", "keg_event"}, deps)
+	if err != nil {
+		t.Fatalf("sorting tables: %v", err)
+	}
+	want := []string{"volume", "recipe", "keg", "keg_event", "batch", "batch_volume"}
+	if !slices.Equal(order, want) {
+		t.Errorf("expected %v, got %v", want, order)
+	}
+
+	_, err = sortTables([]string{"a", "b"}, map[string][]string{"a": {"b"}, "b": {"a"}})
+	if err == nil || err.Error() != "foreign keys form a cycle between a, b" {
+		t.Errorf("expected a cycle error, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Export writes every service's tables to w from one read-only snapshot,
+// so the archive is consistent across services. It fails if the database
+// has a table no service accounts for.
+func Export(ctx context.Context, db *pgxpool.Pool, services []Service, w io.Writer) (Manifest, error) {
+	tx, err := db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return Manifest{}, fmt.Errorf("starting export transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := checkCoverage(ctx, tx, services); err != nil {
+		return Manifest{}, err
+	}
+
+	manifest := Manifest{FormatVersion: FormatVersion, CreatedAt: time.Now().UTC()}
+	out := newWriter(w)
+	for _, svc := range services {
+		version, err := schemaVersion(ctx, tx, svc.MigrationTable)
+		if err != nil {
+			return Manifest{}, err
+		}
+		sm := ServiceManifest{Name: svc.Name, SchemaVersion: version, Tables: make(map[string]int, len(svc.Tables))}
+
+		for _, table := range svc.Tables {
+			data, count, err := exportTable(ctx, tx, table)
+			if err != nil {
+				return Manifest{}, err
+			}
+			if err := out.add(tablePath(svc.Name, table.Name), data); err != nil {
+				return Manifest{}, err
+			}
+			sm.Tables[table.Name] = count
+		}
+		manifest.Services = append(manifest.Services, sm)
+	}
+
+	if err := out.close(manifest); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+func exportTable(ctx context.Context, tx pgx.Tx, table Table) ([]byte, int, error) {
+	omit := table.Omit
+	if omit == nil {
+		omit = []string{}
+	}
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(`
+		SELECT (to_jsonb(t) - $1::text[])::text
+		FROM %s t
+		ORDER BY t.%s`, identifier(table.Name), pgx.Identifier{table.key()}.Sanitize()),
+		omit,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("exporting %s: %w", table.Name, err)
+	}
+	defer rows.Close()
+
+	var buf bytes.Buffer
+	count := 0
+	for rows.Next() {
+		var row string
+		if err := rows.Scan(&row); err != nil {
+			return nil, 0, fmt.Errorf("scanning %s row: %w", table.Name, err)
+		}
+		buf.WriteString(row)
+		buf.WriteByte('\n')
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("exporting %s: %w", table.Name, err)
+	}
+
+	return buf.Bytes(), count, nil
+}
+
+// schemaVersion reads a service's applied migration version.
+func schemaVersion(ctx context.Context, tx pgx.Tx, migrationTable string) (int64, error) {
+	var version int64
+	var dirty bool
+	err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT version, dirty FROM %s`, identifier(migrationTable))).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("reading schema version from %s: %w", migrationTable, err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("schema in %s is dirty at version %d", migrationTable, version)
+	}
+	return version, nil
+}
+
+// checkCoverage fails if a table in the public or identity schema is
+// neither archived nor skipped by a service, so a table added without
+// updating its service's archive list is not silently lost.
+func checkCoverage(ctx context.Context, tx pgx.Tx, services []Service) error {
+	rows, err := tx.Query(ctx, `
+		SELECT table_schema, table_name
+		FROM information_schema.tables
+		WHERE table_schema IN ('public', 'identity') AND table_type = 'BASE TABLE'`,
+	)
+	if err != nil {
+		return fmt.Errorf("listing tables: %w", err)
+	}
+	defer rows.Close()
+
+	var uncovered []string
+	for rows.Next() {
+		var schema, name string
+		if err := rows.Scan(&schema, &name); err != nil {
+			return fmt.Errorf("scanning table: %w", err)
+		}
+		if strings.HasSuffix(name, "_schema_migrations") {
+			continue
+		}
+		if schema != "public" {
+			name = schema + "." + name
+		}
+		if !covered(services, name) {
+			uncovered = append(uncovered, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("listing tables: %w", err)
+	}
+
+	if len(uncovered) > 0 {
+		slices.Sort(uncovered)
+		return fmt.Errorf("tables not covered by any service archive: %s", strings.Join(uncovered, ", "))
+	}
+	return nil
+}
+
+func covered(services []Service, name string) bool {
+	for _, svc := range services {
+		if slices.Contains(svc.Skip, name) || slices.ContainsFunc(svc.Tables, func(t Table) bool { return t.Name == name }) {
+			return true
+		}
+	}
+	return false
+}
+
+// identifier quotes a possibly schema-qualified name.
+func identifier(name string) string {
+	return pgx.Identifier(strings.Split(name, ".")).Sanitize()
+}
@@ -0,0 +1,378 @@
+package archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// maxProblems caps the reference problems reported by Validate.
+const maxProblems = 20
+
+// RestoreOptions controls a restore.
+type RestoreOptions struct {
+	// Replace allows restoring over a database that already has data, which
+	// is discarded. A freshly migrated database holds seed data, so restoring
+	// into one needs Replace too.
+	Replace bool
+}
+
+// Validate checks an archive against the services before anything is
+// written: every service is present, every table is known, and every
+// reference between services resolves to a row in the archive.
+func Validate(a *Archive, services []Service) error {
+	for _, svc := range services {
+		sm, ok := a.service(svc.Name)
+		if !ok {
+			return fmt.Errorf("archive has no %s data", svc.Name)
+		}
+		for table := range sm.Tables {
+			if !slices.ContainsFunc(svc.Tables, func(t Table) bool { return t.Name == table }) {
+				return fmt.Errorf("archive has unknown %s table %s", svc.Name, table)
+			}
+		}
+	}
+	for _, sm := range a.Manifest.Services {
+		if !slices.ContainsFunc(services, func(s Service) bool { return s.Name == sm.Name }) {
+			return fmt.Errorf("archive has unknown service %s", sm.Name)
+		}
+	}
+
+	uuids := make(map[string]map[string]bool)
+	targetUUIDs := func(service, table string) (map[string]bool, error) {
+		key := service + "." + table
+		if set, ok := uuids[key]; ok {
+			return set, nil
+		}
+		set := make(map[string]bool)
+		for i, raw := range a.Rows(service, table) {
+			var row struct {
+				UUID string `json:"uuid"`
+			}
+			if err := json.Unmarshal(raw, &row); err != nil {
+				return nil, fmt.Errorf("decoding %s row %d: %w", key, i+1, err)
+			}
+			set[row.UUID] = true
+		}
+		uuids[key] = set
+		return set, nil
+	}
+
+	var problems []error
+	for _, svc := range services {
+		for _, ref := range svc.References {
+			targets, err := targetUUIDs(ref.Service, ref.Target)
+			if err != nil {
+				return err
+			}
+			for i, raw := range a.Rows(svc.Name, ref.Table) {
+				var row map[string]any
+				if err := json.Unmarshal(raw, &row); err != nil {
+					return fmt.Errorf("decoding %s.%s row %d: %w", svc.Name, ref.Table, i+1, err)
+				}
+				value, ok := row[ref.Column].(string)
+				if !ok || targets[value] {
+					continue
+				}
+				problems = append(problems, fmt.Errorf("%s.%s %v: %s %s not found in %s.%s",
+					svc.Name, ref.Table, row["uuid"], ref.Column, value, ref.Service, ref.Target))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		count := len(problems)
+		if count > maxProblems {
+			problems = problems[:maxProblems]
+		}
+		return fmt.Errorf("archive has %d unresolved references between services:\n%w", count, errors.Join(problems...))
+	}
+	return nil
+}
+
+func (a *Archive) service(name string) (ServiceManifest, bool) {
+	for _, sm := range a.Manifest.Services {
+		if sm.Name == name {
+			return sm, true
+		}
+	}
+	return ServiceManifest{}, false
+}
+
+// Restore validates the archive and loads it into the database in one
+// transaction. The database must be migrated to at least each service's
+// schema version in the archive; columns added since then take their
+// defaults. Rows keep their IDs and UUIDs, and ID sequences continue after
+// the restored rows.
+func Restore(ctx context.Context, db *pgxpool.Pool, services []Service, a *Archive, opts RestoreOptions) error {
+	if err := Validate(a, services); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting restore transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var tables []string
+	for _, svc := range services {
+		sm, _ := a.service(svc.Name)
+		version, err := schemaVersion(ctx, tx, svc.MigrationTable)
+		if err != nil {
+			return err
+		}
+		if sm.SchemaVersion > version {
+			return fmt.Errorf("archive %s schema version %d is newer than the database's %d", svc.Name, sm.SchemaVersion, version)
+		}
+		for _, table := range svc.Tables {
+			tables = append(tables, table.Name)
+		}
+		tables = append(tables, svc.Skip...)
+	}
+
+	if !opts.Replace {
+		for _, table := range tables {
+			var exists bool
+			if err := tx.QueryRow(ctx, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s)`, identifier(table))).Scan(&exists); err != nil {
+				return fmt.Errorf("checking %s: %w", table, err)
+			}
+			if exists {
+				return fmt.Errorf("database is not empty (%s has rows); restore with replace to discard its data", table)
+			}
+		}
+	}
+
+	quoted := make([]string, 0, len(tables))
+	for _, table := range tables {
+		quoted = append(quoted, identifier(table))
+	}
+	if _, err := tx.Exec(ctx, `TRUNCATE `+strings.Join(quoted, ", ")+` RESTART IDENTITY`); err != nil {
+		return fmt.Errorf("emptying tables: %w", err)
+	}
+
+	deps, err := tableDependencies(ctx, tx)
+	if err != nil {
+		return err
+	}
+	var archived []string
+	byName := make(map[string]Table)
+	owner := make(map[string]string)
+	for _, svc := range services {
+		for _, table := range svc.Tables {
+			archived = append(archived, table.Name)
+			byName[table.Name] = table
+			owner[table.Name] = svc.Name
+		}
+	}
+	order, err := sortTables(archived, deps)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range order {
+		table := byName[name]
+		if err := restoreTable(ctx, tx, table, a.Rows(owner[name], name)); err != nil {
+			return err
+		}
+		if err := resetSequence(ctx, tx, table); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing restore transaction: %w", err)
+	}
+	return nil
+}
+
+func restoreTable(ctx context.Context, tx pgx.Tx, table Table, rows []json.RawMessage) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	columns, err := tableColumns(ctx, tx, table.Name)
+	if err != nil {
+		return err
+	}
+
+	ident := identifier(table.Name)
+	batch := &pgx.Batch{}
+	for i, raw := range rows {
+		var row map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return fmt.Errorf("decoding %s row %d: %w", table.Name, i+1, err)
+		}
+		for column, value := range table.Defaults {
+			if _, ok := row[column]; ok {
+				continue
+			}
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Errorf("encoding %s default for %s: %w", table.Name, column, err)
+			}
+			row[column] = encoded
+		}
+
+		names := make([]string, 0, len(row))
+		for column := range row {
+			if !slices.Contains(columns, column) {
+				return fmt.Errorf("archive column %s.%s does not exist in the database", table.Name, column)
+			}
+			names = append(names, pgx.Identifier{column}.Sanitize())
+		}
+		slices.Sort(names)
+		list := strings.Join(names, ", ")
+
+		data, err := json.Marshal(row)
+		if err != nil {
+			return fmt.Errorf("encoding %s row %d: %w", table.Name, i+1, err)
+		}
+		batch.Queue(fmt.Sprintf(`INSERT INTO %s (%s) SELECT %s FROM jsonb_populate_record(NULL::%s, $1::jsonb)`,
+			ident, list, list, ident), data)
+	}
+
+	results := tx.SendBatch(ctx, batch)
+	for i := range rows {
+		if _, err := results.Exec(); err != nil {
+			results.Close()
+			return fmt.Errorf("restoring %s row %d: %w", table.Name, i+1, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return fmt.Errorf("restoring %s: %w", table.Name, err)
+	}
+	return nil
+}
+
+func tableColumns(ctx context.Context, tx pgx.Tx, table string) ([]string, error) {
+	schema, name := "public", table
+	if before, after, ok := strings.Cut(table, "."); ok {
+		schema, name = before, after
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT column_name
+		FROM information_schema.columns
+		WHERE table_schema = $1 AND table_name = $2`,
+		schema, name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s columns: %w", table, err)
+	}
+	columns, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("listing %s columns: %w", table, err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s does not exist in the database", table)
+	}
+	return columns, nil
+}
+
+// resetSequence moves a table's key sequence past its restored rows. Tables
+// without a serial key, such as singleton settings, are left alone.
+func resetSequence(ctx context.Context, tx pgx.Tx, table Table) error {
+	ident := identifier(table.Name)
+	key := pgx.Identifier{table.key()}.Sanitize()
+	var sequence *string
+	if err := tx.QueryRow(ctx, `SELECT pg_get_serial_sequence($1, $2)`, ident, table.key()).Scan(&sequence); err != nil {
+		return fmt.Errorf("finding %s key sequence: %w", table.Name, err)
+	}
+	if sequence == nil {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, fmt.Sprintf(`SELECT setval($1, COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)`, key, ident), *sequence); err != nil {
+		return fmt.Errorf("resetting %s key sequence: %w", table.Name, err)
+	}
+	return nil
+}
+
+// tableDependencies maps each table to the tables its foreign keys
+// reference, named as in Table.Name.
+func tableDependencies(ctx context.Context, tx pgx.Tx) (map[string][]string, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT cn.nspname, c.relname, fn.nspname, f.relname
+		FROM pg_constraint k
+		JOIN pg_class c ON c.oid = k.conrelid
+		JOIN pg_namespace cn ON cn.oid = c.relnamespace
+		JOIN pg_class f ON f.oid = k.confrelid
+		JOIN pg_namespace fn ON fn.oid = f.relnamespace
+		WHERE k.contype = 'f'`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing foreign keys: %w", err)
+	}
+	defer rows.Close()
+
+	name := func(schema, table string) string {
+		if schema == "public" {
+			return table
+		}
+		return schema + "." + table
+	}
+
+	deps := make(map[string][]string)
+	for rows.Next() {
+		var schema, table, refSchema, refTable string
+		if err := rows.Scan(&schema, &table, &refSchema, &refTable); err != nil {
+			return nil, fmt.Errorf("scanning foreign key: %w", err)
+		}
+		from, to := name(schema, table), name(refSchema, refTable)
+		if !slices.Contains(deps[from], to) {
+			deps[from] = append(deps[from], to)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing foreign keys: %w", err)
+	}
+
+	return deps, nil
+}
+
+// sortTables orders tables so each comes after the tables it references,
+// keeping the given order where it can. References to a table's own rows
+// are satisfied by restoring rows in id order.
+func sortTables(tables []string, deps map[string][]string) ([]string, error) {
+	placed := make(map[string]bool, len(tables))
+	order := make([]string, 0, len(tables))
+	for len(order) < len(tables) {
+		progress := false
+		for _, table := range tables {
+			if placed[table] {
+				continue
+			}
+			ready := true
+			for _, dep := range deps[table] {
+				if dep != table && slices.Contains(tables, dep) && !placed[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				placed[table] = true
+				order = append(order, table)
+				progress = true
+			}
+		}
+		if !progress {
+			var remaining []string
+			for _, table := range tables {
+				if !placed[table] {
+					remaining = append(remaining, table)
+				}
+			}
+			return nil, fmt.Errorf("foreign keys form a cycle between %s", strings.Join(remaining, ", "))
+		}
+	}
+	return order, nil
+}
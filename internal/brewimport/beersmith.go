@@ -0,0 +1,240 @@
+package brewimport
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// BeerSmith .bsmx files are loosely formed XML: HTML entities in notes,
+// Latin-1 text, and recipes nested in folders. Amounts are in ounces, batch
+// volumes in US fluid ounces, and choices such as hop use are numeric codes.
+
+type bsRecipe struct {
+	Name  string `xml:"F_R_NAME"`
+	Notes string `xml:"F_R_NOTES"`
+	Style struct {
+		Name string `xml:"F_S_NAME"`
+	} `xml:"F_R_STYLE"`
+	Equipment struct {
+		BatchVolume string `xml:"F_E_BATCH_VOL"`
+		Efficiency  string `xml:"F_E_EFFICIENCY"`
+	} `xml:"F_R_EQUIPMENT"`
+	Grains []bsGrain `xml:"Ingredients>Data>Grain"`
+	Hops   []bsHop   `xml:"Ingredients>Data>Hops"`
+	Yeasts []bsYeast `xml:"Ingredients>Data>Yeast"`
+	Miscs  []bsMisc  `xml:"Ingredients>Data>Misc"`
+}
+
+type bsGrain struct {
+	Name   string `xml:"F_G_NAME"`
+	Amount string `xml:"F_G_AMOUNT"`
+	Type   string `xml:"F_G_TYPE"`
+}
+
+type bsHop struct {
+	Name     string `xml:"F_H_NAME"`
+	Amount   string `xml:"F_H_AMOUNT"`
+	Alpha    string `xml:"F_H_ALPHA"`
+	Use      string `xml:"F_H_USE"`
+	BoilTime string `xml:"F_H_BOIL_TIME"`
+}
+
+type bsYeast struct {
+	Name      string `xml:"F_Y_NAME"`
+	Lab       string `xml:"F_Y_LAB"`
+	ProductID string `xml:"F_Y_PRODUCT_ID"`
+	Amount    string `xml:"F_Y_AMOUNT"`
+}
+
+type bsMisc struct {
+	Name   string `xml:"F_M_NAME"`
+	Amount string `xml:"F_M_AMOUNT"`
+	Units  string `xml:"F_M_UNITS"`
+	Use    string `xml:"F_M_USE"`
+	Type   string `xml:"F_M_TYPE"`
+	Time   string `xml:"F_M_TIME"`
+}
+
+// BeerSmith codes, indexed by value.
+var (
+	bsGrainTypes = []string{"grain", "extract", "sugar", "adjunct", "dry extract"}
+	bsHopUses    = []string{"boil", "dry hop", "mash", "first wort", "aroma"}
+	bsMiscUses   = []string{"boil", "mash", "primary", "secondary", "bottling"}
+	bsMiscTypes  = []string{"spice", "fining", "water agent", "herb", "flavor", "other"}
+	bsMiscUnits  = []string{"mg", "g", "oz", "lb", "kg", "ml", "tsp", "tbsp", "cup", "pt", "qt", "l", "gal", "each"}
+)
+
+func parseBeerSmith(r io.Reader) (Plan, error) {
+	dec := xml.NewDecoder(r)
+	dec.Strict = false
+	dec.AutoClose = xml.HTMLAutoClose
+	dec.Entity = xml.HTMLEntity
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		switch strings.ToLower(charset) {
+		case "iso-8859-1", "latin1", "windows-1252":
+			return &latin1Reader{r: input}, nil
+		default:
+			return nil, fmt.Errorf("unsupported charset %s", charset)
+		}
+	}
+
+	b := newBuilder(SourceBeerSmith)
+	for {
+		token, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return Plan{}, fmt.Errorf("invalid bsmx file: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "Recipe" {
+			continue
+		}
+		var recipe bsRecipe
+		if err := dec.DecodeElement(&recipe, &start); err != nil {
+			return Plan{}, fmt.Errorf("invalid bsmx recipe: %w", err)
+		}
+		b.beerSmithRecipe(recipe)
+	}
+
+	return b.done()
+}
+
+func (b *builder) beerSmithRecipe(r bsRecipe) {
+	name := strings.TrimSpace(r.Name)
+	ref := "recipe " + name
+	if name == "" {
+		b.warn("recipe", "recipe has no name; skipped")
+		return
+	}
+
+	recipe := Recipe{
+		Ref:   ref,
+		Name:  name,
+		Style: strPtr(r.Style.Name),
+		Notes: strPtr(r.Notes),
+	}
+	if volume := bsNumber(r.Equipment.BatchVolume); volume > 0 {
+		gallons := round(volume/128, 2)
+		unit := "gal"
+		recipe.BatchSize, recipe.BatchSizeUnit = &gallons, &unit
+	}
+	if efficiency := bsNumber(r.Equipment.Efficiency); efficiency > 0 {
+		recipe.BrewhouseEfficiency = &efficiency
+	}
+
+	for _, g := range r.Grains {
+		category, stage := "fermentable", "mash"
+		var useType *string
+		switch bsCode(bsGrainTypes, g.Type) {
+		case "adjunct":
+			category = "adjunct"
+		case "sugar":
+			sugar := "sugar"
+			stage, useType = "boil", &sugar
+		case "extract", "dry extract":
+			stage = "boil"
+		}
+		b.ingredient(Ingredient{Ref: ref, Name: g.Name, Category: category, DefaultUnit: "lb"})
+		recipe.Ingredients = append(recipe.Ingredients, RecipeIngredient{
+			Name: g.Name, IngredientType: category, Amount: round(bsNumber(g.Amount)/16, 3), AmountUnit: "lb", UseStage: stage, UseType: useType,
+		})
+	}
+	for _, h := range r.Hops {
+		stage, useType, timed := hopUse(bsCode(bsHopUses, h.Use))
+		b.ingredient(Ingredient{Ref: ref, Name: h.Name, Category: "hop", DefaultUnit: "oz"})
+		line := RecipeIngredient{
+			Name: h.Name, IngredientType: "hop", Amount: round(bsNumber(h.Amount), 3), AmountUnit: "oz", UseStage: stage, UseType: useType,
+		}
+		if alpha := bsNumber(h.Alpha); alpha > 0 {
+			line.AlphaAcidAssumed = &alpha
+		}
+		if minutes := int(bsNumber(h.BoilTime)); timed && minutes > 0 {
+			line.TimingDurationMinutes = &minutes
+		}
+		recipe.Ingredients = append(recipe.Ingredients, line)
+	}
+	for _, y := range r.Yeasts {
+		b.ingredient(Ingredient{Ref: ref, Name: y.Name, Category: "yeast", DefaultUnit: "pkg", Description: yeastDescription(y.Lab, y.ProductID)})
+		amount := bsNumber(y.Amount)
+		if amount <= 0 {
+			amount = 1
+		}
+		primary := "primary"
+		recipe.Ingredients = append(recipe.Ingredients, RecipeIngredient{
+			Name: y.Name, IngredientType: "yeast", Amount: amount, AmountUnit: "pkg", UseStage: "fermentation", UseType: &primary,
+		})
+	}
+	for _, m := range r.Miscs {
+		category := miscCategory(bsCode(bsMiscTypes, m.Type))
+		unit := bsCode(bsMiscUnits, m.Units)
+		if unit == "" {
+			unit = "each"
+		}
+		b.ingredient(Ingredient{Ref: ref, Name: m.Name, Category: category, DefaultUnit: unit})
+		line := RecipeIngredient{
+			Name: m.Name, IngredientType: category, Amount: round(bsNumber(m.Amount), 3), AmountUnit: unit, UseStage: miscStage(bsCode(bsMiscUses, m.Use)),
+		}
+		if minutes := int(bsNumber(m.Time)); minutes > 0 {
+			line.TimingDurationMinutes = &minutes
+		}
+		recipe.Ingredients = append(recipe.Ingredients, line)
+	}
+
+	if !b.recipe(recipe) {
+		b.warn(ref, "recipe %q appears more than once; the first was kept", name)
+	}
+}
+
+// bsNumber parses a BeerSmith number, such as "80.0000000", as 0 when it is
+// missing or invalid.
+func bsNumber(value string) float64 {
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0
+	}
+	return parsed
+}
+
+// bsCode returns the name of a numeric code, or "" if it is unknown.
+func bsCode(names []string, value string) string {
+	code, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || code < 0 || code >= len(names) {
+		return ""
+	}
+	return names[code]
+}
+
+func round(value float64, places int) float64 {
+	scale := math.Pow10(places)
+	return math.Round(value*scale) / scale
+}
+
+// latin1Reader decodes Latin-1 text to UTF-8.
+type latin1Reader struct {
+	r   io.Reader
+	buf []byte
+}
+
+func (l *latin1Reader) Read(p []byte) (int, error) {
+	if len(l.buf) == 0 {
+		raw := make([]byte, len(p)/2+1)
+		n, err := l.r.Read(raw)
+		for _, c := range raw[:n] {
+			l.buf = utf8.AppendRune(l.buf, rune(c))
+		}
+		if n == 0 {
+			return 0, err
+		}
+	}
+	n := copy(p, l.buf)
+	l.buf = l.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,342 @@
+package brewimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Brewfather exports batches as a JSON array, each batch holding a copy of
+// its recipe; recipes as a JSON array or object; and the inventory as an
+// object of fermentables, hops, yeasts and miscs with an inventory amount.
+// Fermentable amounts are in kilograms, hop amounts in grams and batch sizes
+// in liters.
+
+type bfBatch struct {
+	ID         string    `json:"_id"`
+	Name       string    `json:"name"`
+	BatchNo    *int      `json:"batchNo"`
+	Status     string    `json:"status"`
+	BrewDate   *int64    `json:"brewDate"`
+	BatchNotes string    `json:"batchNotes"`
+	Recipe     *bfRecipe `json:"recipe"`
+}
+
+type bfRecipe struct {
+	ID    string `json:"_id"`
+	Name  string `json:"name"`
+	Style *struct {
+		Name string `json:"name"`
+	} `json:"style"`
+	Notes        string          `json:"notes"`
+	BatchSize    *float64        `json:"batchSize"`
+	OG           *float64        `json:"og"`
+	FG           *float64        `json:"fg"`
+	IBU          *float64        `json:"ibu"`
+	Efficiency   *float64        `json:"efficiency"`
+	Fermentables []bfFermentable `json:"fermentables"`
+	Hops         []bfHop         `json:"hops"`
+	Yeasts       []bfYeast       `json:"yeasts"`
+	Miscs        []bfMisc        `json:"miscs"`
+}
+
+type bfFermentable struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Use       string   `json:"use"`
+	Supplier  string   `json:"supplier"`
+	Amount    float64  `json:"amount"`
+	Inventory *float64 `json:"inventory"`
+}
+
+type bfHop struct {
+	Name      string   `json:"name"`
+	Use       string   `json:"use"`
+	Type      string   `json:"type"`
+	Amount    float64  `json:"amount"`
+	Alpha     *float64 `json:"alpha"`
+	Time      *float64 `json:"time"`
+	Inventory *float64 `json:"inventory"`
+}
+
+type bfYeast struct {
+	Name       string   `json:"name"`
+	Laboratory string   `json:"laboratory"`
+	ProductID  string   `json:"productId"`
+	Unit       string   `json:"unit"`
+	Amount     float64  `json:"amount"`
+	Inventory  *float64 `json:"inventory"`
+}
+
+type bfMisc struct {
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Use       string   `json:"use"`
+	Unit      string   `json:"unit"`
+	Amount    float64  `json:"amount"`
+	Time      *float64 `json:"time"`
+	Inventory *float64 `json:"inventory"`
+}
+
+func parseBrewfather(r io.Reader) (Plan, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Plan{}, fmt.Errorf("reading export: %w", err)
+	}
+	data = bytes.TrimSpace(data)
+
+	var items []json.RawMessage
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return Plan{}, fmt.Errorf("invalid json")
+		}
+	} else {
+		items = []json.RawMessage{data}
+	}
+
+	b := newBuilder(SourceBrewfather)
+	for i, item := range items {
+		var probe map[string]json.RawMessage
+		if err := json.Unmarshal(item, &probe); err != nil {
+			return Plan{}, fmt.Errorf("invalid json")
+		}
+
+		switch {
+		case probe["recipe"] != nil:
+			var batch bfBatch
+			if err := json.Unmarshal(item, &batch); err != nil {
+				return Plan{}, fmt.Errorf("invalid batch %d: %w", i+1, err)
+			}
+			b.brewfatherBatch(batch)
+		case probe["name"] != nil:
+			var recipe bfRecipe
+			if err := json.Unmarshal(item, &recipe); err != nil {
+				return Plan{}, fmt.Errorf("invalid recipe %d: %w", i+1, err)
+			}
+			b.brewfatherRecipe(recipe, "recipe "+recipe.Name)
+		default:
+			var inventory bfRecipe
+			if err := json.Unmarshal(item, &inventory); err != nil {
+				return Plan{}, fmt.Errorf("invalid inventory: %w", err)
+			}
+			b.brewfatherInventory(inventory)
+		}
+	}
+
+	return b.done()
+}
+
+func (b *builder) brewfatherBatch(batch bfBatch) {
+	ref := "batch " + batch.Name
+	if batch.BatchNo != nil {
+		ref = fmt.Sprintf("batch #%d", *batch.BatchNo)
+	}
+
+	var recipeName *string
+	if batch.Recipe != nil && batch.Recipe.Name != "" {
+		recipeName = &batch.Recipe.Name
+		b.brewfatherRecipe(*batch.Recipe, ref)
+	}
+
+	// Batches are named "Batch" unless renamed, so the number and recipe
+	// make a better short name.
+	shortName := batch.Name
+	if batch.BatchNo != nil && (shortName == "" || strings.EqualFold(shortName, "batch")) {
+		shortName = fmt.Sprintf("#%d", *batch.BatchNo)
+		if recipeName != nil {
+			shortName = fmt.Sprintf("%s #%d", *recipeName, *batch.BatchNo)
+		}
+	}
+	if shortName == "" {
+		b.warn(ref, "batch has no name or number; skipped")
+		return
+	}
+
+	var brewDate *time.Time
+	if batch.BrewDate != nil && !strings.EqualFold(batch.Status, "planning") {
+		date := time.UnixMilli(*batch.BrewDate).UTC()
+		brewDate = &date
+	}
+
+	b.plan.Batches = append(b.plan.Batches, Batch{
+		Ref:       ref,
+		ShortName: shortName,
+		Recipe:    recipeName,
+		BrewDate:  brewDate,
+		Notes:     strPtr(batch.BatchNotes),
+	})
+}
+
+func (b *builder) brewfatherRecipe(r bfRecipe, ref string) {
+	recipe := Recipe{
+		Ref:                 ref,
+		Name:                r.Name,
+		Notes:               strPtr(r.Notes),
+		BatchSize:           r.BatchSize,
+		TargetOG:            r.OG,
+		TargetFG:            r.FG,
+		TargetIBU:           r.IBU,
+		BrewhouseEfficiency: r.Efficiency,
+	}
+	if r.Style != nil {
+		recipe.Style = strPtr(r.Style.Name)
+	}
+	if r.BatchSize != nil {
+		unit := "l"
+		recipe.BatchSizeUnit = &unit
+	}
+
+	for _, f := range r.Fermentables {
+		category, stage, useType := brewfatherFermentable(f)
+		b.ingredient(Ingredient{Ref: ref, Name: f.Name, Category: category, DefaultUnit: "kg"})
+		recipe.Ingredients = append(recipe.Ingredients, RecipeIngredient{
+			Name: f.Name, IngredientType: category, Amount: f.Amount, AmountUnit: "kg", UseStage: stage, UseType: useType,
+		})
+	}
+	for _, h := range r.Hops {
+		stage, useType, timed := hopUse(h.Use)
+		b.ingredient(Ingredient{Ref: ref, Name: h.Name, Category: "hop", DefaultUnit: "g"})
+		line := RecipeIngredient{
+			Name: h.Name, IngredientType: "hop", Amount: h.Amount, AmountUnit: "g", UseStage: stage, UseType: useType,
+			AlphaAcidAssumed: h.Alpha,
+		}
+		if timed && h.Time != nil {
+			minutes := int(*h.Time)
+			line.TimingDurationMinutes = &minutes
+		}
+		recipe.Ingredients = append(recipe.Ingredients, line)
+	}
+	for _, y := range r.Yeasts {
+		unit := b.unit(ref, y.Unit, "pkg")
+		b.ingredient(Ingredient{Ref: ref, Name: y.Name, Category: "yeast", DefaultUnit: unit, Description: yeastDescription(y.Laboratory, y.ProductID)})
+		primary := "primary"
+		recipe.Ingredients = append(recipe.Ingredients, RecipeIngredient{
+			Name: y.Name, IngredientType: "yeast", Amount: y.Amount, AmountUnit: unit, UseStage: "fermentation", UseType: &primary,
+		})
+	}
+	for _, m := range r.Miscs {
+		category := miscCategory(m.Type)
+		unit := b.unit(ref, m.Unit, "each")
+		b.ingredient(Ingredient{Ref: ref, Name: m.Name, Category: category, DefaultUnit: unit})
+		line := RecipeIngredient{
+			Name: m.Name, IngredientType: category, Amount: m.Amount, AmountUnit: unit, UseStage: miscStage(m.Use),
+		}
+		if m.Time != nil && *m.Time > 0 {
+			minutes := int(*m.Time)
+			line.TimingDurationMinutes = &minutes
+		}
+		recipe.Ingredients = append(recipe.Ingredients, line)
+	}
+
+	b.recipe(recipe)
+}
+
+func (b *builder) brewfatherInventory(inv bfRecipe) {
+	lot := func(ref, name string, amount *float64, unit string, originator *string) {
+		if amount == nil || *amount <= 0 {
+			return
+		}
+		b.plan.Lots = append(b.plan.Lots, Lot{Ref: ref, Ingredient: name, Amount: *amount, Unit: unit, OriginatorName: originator})
+	}
+
+	for i, f := range inv.Fermentables {
+		ref := fmt.Sprintf("fermentables %d", i+1)
+		category, _, _ := brewfatherFermentable(f)
+		b.ingredient(Ingredient{Ref: ref, Name: f.Name, Category: category, DefaultUnit: "kg"})
+		lot(ref, f.Name, f.Inventory, "kg", strPtr(f.Supplier))
+	}
+	for i, h := range inv.Hops {
+		ref := fmt.Sprintf("hops %d", i+1)
+		b.ingredient(Ingredient{Ref: ref, Name: h.Name, Category: "hop", DefaultUnit: "g"})
+		lot(ref, h.Name, h.Inventory, "g", nil)
+	}
+	for i, y := range inv.Yeasts {
+		ref := fmt.Sprintf("yeasts %d", i+1)
+		unit := b.unit(ref, y.Unit, "pkg")
+		b.ingredient(Ingredient{Ref: ref, Name: y.Name, Category: "yeast", DefaultUnit: unit, Description: yeastDescription(y.Laboratory, y.ProductID)})
+		lot(ref, y.Name, y.Inventory, unit, strPtr(y.Laboratory))
+	}
+	for i, m := range inv.Miscs {
+		ref := fmt.Sprintf("miscs %d", i+1)
+		unit := b.unit(ref, m.Unit, "each")
+		b.ingredient(Ingredient{Ref: ref, Name: m.Name, Category: miscCategory(m.Type), DefaultUnit: unit})
+		lot(ref, m.Name, m.Inventory, unit, nil)
+	}
+}
+
+// brewfatherFermentable maps a fermentable to its category, use stage and
+// use type.
+func brewfatherFermentable(f bfFermentable) (string, string, *string) {
+	kind := strings.ToLower(f.Type)
+	category, stage := "fermentable", "mash"
+	var useType *string
+	switch {
+	case strings.Contains(kind, "adjunct"):
+		category = "adjunct"
+	case strings.Contains(kind, "sugar"):
+		stage = "boil"
+		sugar := "sugar"
+		useType = &sugar
+	case strings.Contains(kind, "extract"):
+		stage = "boil"
+	}
+	switch strings.ToLower(f.Use) {
+	case "boil":
+		stage = "boil"
+	case "fermentation", "primary", "secondary":
+		stage = "fermentation"
+	}
+	return category, stage, useType
+}
+
+// hopUse maps a hop use to a use stage and use type, and reports whether
+// its time is in minutes: dry hop times are in days.
+func hopUse(use string) (string, *string, bool) {
+	kind := func(s string) *string { return &s }
+	switch u := strings.ToLower(use); {
+	case strings.Contains(u, "dry"):
+		return "fermentation", kind("dry_hop"), false
+	case strings.Contains(u, "whirlpool"), strings.Contains(u, "aroma"), strings.Contains(u, "hopstand"):
+		return "whirlpool", kind("aroma"), true
+	case strings.Contains(u, "mash"):
+		return "mash", nil, true
+	case strings.Contains(u, "first wort"):
+		return "boil", kind("bittering"), true
+	default:
+		return "boil", nil, true
+	}
+}
+
+// miscCategory maps a misc type, such as "Water Agent", to a category.
+func miscCategory(kind string) string {
+	switch k := strings.ToLower(kind); {
+	case strings.Contains(k, "water"):
+		return "salt"
+	case strings.Contains(k, "fining"):
+		return "chemical"
+	case strings.Contains(k, "spice"), strings.Contains(k, "herb"), strings.Contains(k, "flavor"):
+		return "adjunct"
+	default:
+		return "other"
+	}
+}
+
+func miscStage(use string) string {
+	switch u := strings.ToLower(use); {
+	case strings.Contains(u, "mash"), strings.Contains(u, "sparge"):
+		return "mash"
+	case strings.Contains(u, "primary"), strings.Contains(u, "secondary"), strings.Contains(u, "ferment"):
+		return "fermentation"
+	case strings.Contains(u, "bottl"), strings.Contains(u, "packag"), strings.Contains(u, "keg"):
+		return "packaging"
+	default:
+		return "boil"
+	}
+}
+
+func yeastDescription(lab, productID string) *string {
+	return strPtr(strings.TrimSpace(lab + " " + productID))
+}
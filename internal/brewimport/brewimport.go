@@ -0,0 +1,379 @@
+// Package brewimport reads data exported from other brewery software (Ekos
+// CSV exports, Brewfather JSON exports and BeerSmith .bsmx files) into a
+// Plan of BrewPipes ingredients, lots, vessels, recipes and batches.
+//
+// Migrating is a review flow. An export is parsed into a Plan, which the
+// brewer reviews and edits: renaming or recategorizing records, skipping
+// them, or filling in what the source lacks, such as stock locations. The
+// edited Plan is then applied, to inventory first and then to production,
+// with a dry run of each that reports what would be created or matched to
+// existing records.
+package brewimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+)
+
+// Sources.
+const (
+	SourceEkos       = "ekos"
+	SourceBrewfather = "brewfather"
+	SourceBeerSmith  = "beersmith"
+)
+
+// MaxUploadSize limits an uploaded export.
+const MaxUploadSize = 20 << 20
+
+// Plan is what an export maps to. Records refer to each other by name:
+// lots to ingredients and batches to recipes, whether created by the same
+// plan or already present.
+type Plan struct {
+	Source      string       `json:"source"`
+	Ingredients []Ingredient `json:"ingredients"`
+	Lots        []Lot        `json:"lots"`
+	Vessels     []Vessel     `json:"vessels"`
+	Recipes     []Recipe     `json:"recipes"`
+	Batches     []Batch      `json:"batches"`
+	// DefaultStockLocation is the stock location, by name or UUID, of lots
+	// that do not name one.
+	DefaultStockLocation *string   `json:"default_stock_location,omitempty"`
+	Warnings             []Warning `json:"warnings"`
+}
+
+// Warning notes a source value that did not map cleanly, such as an unknown
+// category that became "other".
+type Warning struct {
+	Ref     string `json:"ref"`
+	Message string `json:"message"`
+}
+
+// Ingredient is an inventory ingredient.
+type Ingredient struct {
+	// Ref locates the record in the source export, e.g. "row 4".
+	Ref         string  `json:"ref"`
+	Skip        bool    `json:"skip,omitempty"`
+	Name        string  `json:"name"`
+	Category    string  `json:"category"`
+	DefaultUnit string  `json:"default_unit"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Lot is opening stock of an ingredient.
+type Lot struct {
+	Ref               string     `json:"ref"`
+	Skip              bool       `json:"skip,omitempty"`
+	Ingredient        string     `json:"ingredient"`
+	StockLocation     *string    `json:"stock_location,omitempty"`
+	Amount            float64    `json:"amount"`
+	Unit              string     `json:"unit"`
+	BreweryLotCode    *string    `json:"brewery_lot_code,omitempty"`
+	OriginatorLotCode *string    `json:"originator_lot_code,omitempty"`
+	OriginatorName    *string    `json:"originator_name,omitempty"`
+	ReceivedAt        *time.Time `json:"received_at,omitempty"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+	Notes             *string    `json:"notes,omitempty"`
+}
+
+// Vessel is a production vessel. Capacity is in a BrewPipes volume unit.
+type Vessel struct {
+	Ref          string  `json:"ref"`
+	Skip         bool    `json:"skip,omitempty"`
+	Name         string  `json:"name"`
+	Type         string  `json:"type"`
+	Capacity     float64 `json:"capacity"`
+	CapacityUnit string  `json:"capacity_unit"`
+	Status       string  `json:"status"`
+	Make         *string `json:"make,omitempty"`
+	Model        *string `json:"model,omitempty"`
+}
+
+// Recipe is a recipe with its ingredient bill.
+type Recipe struct {
+	Ref                 string             `json:"ref"`
+	Skip                bool               `json:"skip,omitempty"`
+	Name                string             `json:"name"`
+	Style               *string            `json:"style,omitempty"`
+	Notes               *string            `json:"notes,omitempty"`
+	BatchSize           *float64           `json:"batch_size,omitempty"`
+	BatchSizeUnit       *string            `json:"batch_size_unit,omitempty"`
+	TargetOG            *float64           `json:"target_og,omitempty"`
+	TargetFG            *float64           `json:"target_fg,omitempty"`
+	TargetIBU           *float64           `json:"target_ibu,omitempty"`
+	BrewhouseEfficiency *float64           `json:"brewhouse_efficiency,omitempty"`
+	Ingredients         []RecipeIngredient `json:"ingredients"`
+}
+
+// RecipeIngredient is one line of a recipe's bill. Name links it to the
+// inventory ingredient of the same name when there is exactly one.
+type RecipeIngredient struct {
+	Name                  string   `json:"name"`
+	IngredientType        string   `json:"ingredient_type"`
+	Amount                float64  `json:"amount"`
+	AmountUnit            string   `json:"amount_unit"`
+	UseStage              string   `json:"use_stage"`
+	UseType               *string  `json:"use_type,omitempty"`
+	TimingDurationMinutes *int     `json:"timing_duration_minutes,omitempty"`
+	AlphaAcidAssumed      *float64 `json:"alpha_acid_assumed,omitempty"`
+}
+
+// Batch is a historical or planned batch.
+type Batch struct {
+	Ref       string     `json:"ref"`
+	Skip      bool       `json:"skip,omitempty"`
+	ShortName string     `json:"short_name"`
+	Recipe    *string    `json:"recipe,omitempty"`
+	BrewDate  *time.Time `json:"brew_date,omitempty"`
+	Notes     *string    `json:"notes,omitempty"`
+}
+
+// Parse reads an export from source into a Plan.
+func Parse(source string, r io.Reader) (Plan, error) {
+	switch source {
+	case SourceEkos:
+		return parseEkos(r)
+	case SourceBrewfather:
+		return parseBrewfather(r)
+	case SourceBeerSmith:
+		return parseBeerSmith(r)
+	default:
+		return Plan{}, fmt.Errorf("invalid source")
+	}
+}
+
+// builder collects a Plan, keeping one ingredient and one recipe per name.
+type builder struct {
+	plan        Plan
+	ingredients map[string]bool
+	recipes     map[string]bool
+}
+
+func newBuilder(source string) *builder {
+	return &builder{
+		plan: Plan{
+			Source:      source,
+			Ingredients: []Ingredient{},
+			Lots:        []Lot{},
+			Vessels:     []Vessel{},
+			Recipes:     []Recipe{},
+			Batches:     []Batch{},
+			Warnings:    []Warning{},
+		},
+		ingredients: make(map[string]bool),
+		recipes:     make(map[string]bool),
+	}
+}
+
+func (b *builder) ingredient(ing Ingredient) {
+	key := strings.ToLower(ing.Name)
+	if ing.Name == "" || b.ingredients[key] {
+		return
+	}
+	b.ingredients[key] = true
+	b.plan.Ingredients = append(b.plan.Ingredients, ing)
+}
+
+// recipe adds a recipe unless one of the same name was added already, and
+// reports whether it was added.
+func (b *builder) recipe(recipe Recipe) bool {
+	key := strings.ToLower(recipe.Name)
+	if recipe.Name == "" || b.recipes[key] {
+		return false
+	}
+	b.recipes[key] = true
+	if recipe.Ingredients == nil {
+		recipe.Ingredients = []RecipeIngredient{}
+	}
+	b.plan.Recipes = append(b.plan.Recipes, recipe)
+	return true
+}
+
+func (b *builder) warn(ref, format string, args ...any) {
+	b.plan.Warnings = append(b.plan.Warnings, Warning{Ref: ref, Message: fmt.Sprintf(format, args...)})
+}
+
+func (b *builder) done() (Plan, error) {
+	p := b.plan
+	if len(p.Ingredients)+len(p.Lots)+len(p.Vessels)+len(p.Recipes)+len(p.Batches) == 0 {
+		return Plan{}, fmt.Errorf("export has no records")
+	}
+	return p, nil
+}
+
+// Units and the smaller unit each converts to when an amount must be whole.
+var wholeUnits = map[string]struct {
+	unit   string
+	factor float64
+}{
+	"kg":  {"g", 1000},
+	"lb":  {"oz", 16},
+	"l":   {"ml", 1000},
+	"bbl": {"usfloz", 3968},
+	"gal": {"usfloz", 128},
+}
+
+// WholeAmount converts an amount to a whole number, moving to a smaller unit
+// when it has a fraction: 2.5 kg becomes 2500 g. What is still fractional
+// after that is rounded.
+func WholeAmount(amount float64, unit string) (int64, string) {
+	if amount != math.Trunc(amount) {
+		if smaller, ok := wholeUnits[unit]; ok {
+			amount, unit = amount*smaller.factor, smaller.unit
+		}
+	}
+	return int64(math.Round(amount)), unit
+}
+
+// Record statuses.
+const (
+	StatusCreated = "created"
+	StatusValid   = "valid"
+	StatusMatched = "matched"
+	StatusSkipped = "skipped"
+	StatusError   = "error"
+)
+
+// Result is the outcome of applying a plan.
+type Result struct {
+	DryRun  bool           `json:"dry_run"`
+	Totals  Totals         `json:"totals"`
+	Results []RecordResult `json:"results"`
+}
+
+// Totals counts records by outcome. Valid counts records that would be
+// created in a dry run, and Matched those that already exist by name.
+type Totals struct {
+	Records int `json:"records"`
+	Created int `json:"created"`
+	Valid   int `json:"valid"`
+	Matched int `json:"matched"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// RecordResult is the outcome of one plan record.
+type RecordResult struct {
+	Kind   string  `json:"kind"`
+	Ref    string  `json:"ref"`
+	Name   string  `json:"name"`
+	Status string  `json:"status"`
+	Record any     `json:"record,omitempty"`
+	Error  *string `json:"error,omitempty"`
+}
+
+// Applier records the outcome of each record as a plan is applied.
+type Applier struct {
+	result Result
+}
+
+// NewApplier returns an Applier for a run, which writes nothing if dryRun.
+func NewApplier(dryRun bool) *Applier {
+	return &Applier{result: Result{DryRun: dryRun, Results: []RecordResult{}}}
+}
+
+// DryRun reports whether the run writes nothing.
+func (a *Applier) DryRun() bool {
+	return a.result.DryRun
+}
+
+func (a *Applier) add(kind, ref, name, status string, record any, errMsg *string) {
+	a.result.Results = append(a.result.Results, RecordResult{
+		Kind: kind, Ref: ref, Name: name, Status: status, Record: record, Error: errMsg,
+	})
+	a.result.Totals.Records++
+}
+
+// Skipped records a record the reviewer skipped.
+func (a *Applier) Skipped(kind, ref, name string) {
+	a.add(kind, ref, name, StatusSkipped, nil, nil)
+	a.result.Totals.Skipped++
+}
+
+// Matched records a record that already exists and is left as it is.
+func (a *Applier) Matched(kind, ref, name string, record any) {
+	a.add(kind, ref, name, StatusMatched, record, nil)
+	a.result.Totals.Matched++
+}
+
+// Failed records a record that did not validate.
+func (a *Applier) Failed(kind, ref, name string, err error) {
+	msg := err.Error()
+	a.add(kind, ref, name, StatusError, nil, &msg)
+	a.result.Totals.Failed++
+}
+
+// Create writes a validated record with create, or in a dry run only counts
+// it as valid. It reports whether the record was, or would be, created.
+// Errors from create are logged and the record reported as failed.
+func (a *Applier) Create(ctx context.Context, kind, ref, name string, create func(context.Context) (any, error)) bool {
+	if a.result.DryRun {
+		a.add(kind, ref, name, StatusValid, nil, nil)
+		a.result.Totals.Valid++
+		return true
+	}
+
+	created, err := create(ctx)
+	if err != nil {
+		slog.Error("error creating "+kind+" from migration", "error", err, "ref", ref)
+		a.Failed(kind, ref, name, fmt.Errorf("create %s failed", kind))
+		return false
+	}
+	a.add(kind, ref, name, StatusCreated, created, nil)
+	a.result.Totals.Created++
+	return true
+}
+
+// Result returns the outcome of the run.
+func (a *Applier) Result() Result {
+	t := a.result.Totals
+	slog.Info("migration applied", "dry_run", a.result.DryRun, "records", t.Records,
+		"created", t.Created, "valid", t.Valid, "matched", t.Matched, "skipped", t.Skipped, "failed", t.Failed)
+	return a.result
+}
+
+// strPtr returns nil for an empty string.
+func strPtr(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// units maps unit spellings found in exports to BrewPipes units.
+var units = map[string]string{
+	"kg": "kg", "kgs": "kg", "kilogram": "kg", "kilograms": "kg",
+	"g": "g", "gr": "g", "gram": "g", "grams": "g",
+	"mg": "mg",
+	"lb": "lb", "lbs": "lb", "pound": "lb", "pounds": "lb",
+	"oz": "oz", "ounce": "oz", "ounces": "oz",
+	"l": "l", "liter": "l", "liters": "l", "litre": "l", "litres": "l",
+	"ml": "ml", "milliliter": "ml", "milliliters": "ml",
+	"gal": "gal", "gallon": "gal", "gallons": "gal",
+	"bbl": "bbl", "bbls": "bbl", "barrel": "bbl", "barrels": "bbl",
+	"pkg": "pkg", "pkgs": "pkg", "package": "pkg", "packages": "pkg", "pack": "pkg", "packs": "pkg",
+	"each": "each", "ea": "each", "unit": "each", "units": "each", "items": "each", "item": "each",
+	"tsp": "tsp", "tbsp": "tbsp",
+}
+
+// unit normalizes a unit from an export. Unknown units are kept if they fit
+// a BrewPipes unit column and otherwise become "each".
+func (b *builder) unit(ref, value, fallback string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return fallback
+	}
+	if unit, ok := units[value]; ok {
+		return unit
+	}
+	if len(value) <= 7 {
+		return value
+	}
+	b.warn(ref, "unit %q is not known; using each", value)
+	return "each"
+}
@@ -0,0 +1,219 @@
+package brewimport
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseEkosInventory(t *testing.T) {
+	csv := "Item Name,Item Type,Lot #,Quantity On Hand,UOM,Location,Vendor,Date Received\n" +
+		"2-Row Pale,Malt,L-101,\"1,100.5\",lbs,Grain Room,Rahr,3/14/2025\n" +
+		"Citra,Hops,H-7,22,lb,Cooler,YCH,2025-02-01\n" +
+		"2-row pale,Malt,L-102,0,lb,Grain Room,Rahr,\n" +
+		"Keg Collars,Widgets,,40,each,,,yesterday\n"
+
+	plan, err := Parse(SourceEkos, strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+
+	if len(plan.Ingredients) != 3 {
+		t.Fatalf("expected 3 ingredients, got %+v", plan.Ingredients)
+	}
+	if got := plan.Ingredients[0]; got.Name != "2-Row Pale" || got.Category != "fermentable" || got.DefaultUnit != "lb" {
+		t.Errorf("unexpected malt %+v", got)
+	}
+	if got := plan.Ingredients[2]; got.Category != "other" {
+		t.Errorf("expected an unknown item type to be other, got %+v", got)
+	}
+
+	if len(plan.Lots) != 3 {
+		t.Fatalf("expected 3 lots with stock, got %+v", plan.Lots)
+	}
+	lot := plan.Lots[0]
+	if lot.Ref != "row 2" || lot.Amount != 1100.5 || *lot.StockLocation != "Grain Room" || *lot.BreweryLotCode != "L-101" ||
+		*lot.OriginatorName != "Rahr" || lot.ReceivedAt.Format("2006-01-02") != "2025-03-14" {
+		t.Errorf("unexpected lot %+v", lot)
+	}
+
+	if len(plan.Warnings) != 2 {
+		t.Errorf("expected warnings for the item type and date, got %+v", plan.Warnings)
+	}
+}
+
+func TestParseEkosBatchesAndVessels(t *testing.T) {
+	batches := "Batch #,Product,Style,Brew Date\n" +
+		"24-07,Hop Harvest,American IPA,7/12/2024\n" +
+		"24-08,Hop Harvest,American IPA,7/19/2024\n"
+	plan, err := Parse(SourceEkos, strings.NewReader(batches))
+	if err != nil {
+		t.Fatalf("parsing batches: %v", err)
+	}
+	if len(plan.Recipes) != 1 || plan.Recipes[0].Name != "Hop Harvest" || *plan.Recipes[0].Style != "American IPA" {
+		t.Errorf("expected one recipe per product, got %+v", plan.Recipes)
+	}
+	if len(plan.Batches) != 2 || plan.Batches[1].ShortName != "24-08" || *plan.Batches[1].Recipe != "Hop Harvest" {
+		t.Errorf("unexpected batches %+v", plan.Batches)
+	}
+
+	vessels := "Name,Type,Capacity,Capacity Unit,Status\n" +
+		"FV1,Unitank,15,BBL,Active\n" +
+		"BT1,Brite Tank,1000,Liters,Out of Service\n"
+	plan, err = Parse(SourceEkos, strings.NewReader(vessels))
+	if err != nil {
+		t.Fatalf("parsing vessels: %v", err)
+	}
+	if got := plan.Vessels[0]; got.Type != "fermenter" || got.Capacity != 15 || got.CapacityUnit != "bbl" || got.Status != "active" {
+		t.Errorf("unexpected fermenter %+v", got)
+	}
+	if got := plan.Vessels[1]; got.Type != "brite_tank" || got.Capacity != 1000000 || got.CapacityUnit != "ml" || got.Status != "inactive" {
+		t.Errorf("unexpected brite tank %+v", got)
+	}
+
+	_, err = Parse(SourceEkos, strings.NewReader("Customer,Invoice\nAcme,12\n"))
+	if err == nil || !strings.Contains(err.Error(), "unrecognized Ekos export") {
+		t.Errorf("expected an unrecognized export error, got %v", err)
+	}
+}
+
+func TestParseBrewfather(t *testing.T) {
+	batches := `[{
+		"_id": "b1", "name": "Batch", "batchNo": 12, "status": "Completed", "brewDate": 1720742400000,
+		"recipe": {
+			"name": "Hop Harvest", "style": {"name": "American IPA"}, "batchSize": 20, "og": 1.062, "efficiency": 72,
+			"fermentables": [{"name": "Pale Ale Malt", "type": "Grain", "amount": 5.2}, {"name": "Dextrose", "type": "Sugar", "amount": 0.3}],
+			"hops": [{"name": "Citra", "use": "Boil", "amount": 28, "alpha": 12.5, "time": 60}, {"name": "Citra", "use": "Dry Hop", "amount": 100, "time": 3}],
+			"yeasts": [{"name": "US-05", "laboratory": "Fermentis", "productId": "US-05", "unit": "pkg", "amount": 1}],
+			"miscs": [{"name": "Gypsum", "type": "Water Agent", "use": "Mash", "unit": "g", "amount": 5}]
+		}
+	}, {"_id": "b2", "name": "Batch", "batchNo": 13, "status": "Planning", "brewDate": 1720742400000, "recipe": {"name": "Hop Harvest"}}]`
+
+	plan, err := Parse(SourceBrewfather, strings.NewReader(batches))
+	if err != nil {
+		t.Fatalf("parsing batches: %v", err)
+	}
+	if len(plan.Batches) != 2 || plan.Batches[0].ShortName != "Hop Harvest #12" || plan.Batches[0].BrewDate.Format("2006-01-02") != "2024-07-12" {
+		t.Errorf("unexpected batches %+v", plan.Batches)
+	}
+	if plan.Batches[1].BrewDate != nil {
+		t.Errorf("expected a planned batch without a brew date, got %v", plan.Batches[1].BrewDate)
+	}
+	if len(plan.Recipes) != 1 || len(plan.Recipes[0].Ingredients) != 6 || *plan.Recipes[0].BatchSizeUnit != "l" {
+		t.Fatalf("expected one recipe with six lines, got %+v", plan.Recipes)
+	}
+	lines := plan.Recipes[0].Ingredients
+	if lines[1].UseStage != "boil" || *lines[1].UseType != "sugar" {
+		t.Errorf("unexpected sugar line %+v", lines[1])
+	}
+	if lines[3].UseStage != "fermentation" || *lines[3].UseType != "dry_hop" || lines[3].TimingDurationMinutes != nil {
+		t.Errorf("unexpected dry hop line %+v", lines[3])
+	}
+	if len(plan.Ingredients) != 5 || plan.Ingredients[4].Category != "salt" || *plan.Ingredients[3].Description != "Fermentis US-05" {
+		t.Errorf("unexpected ingredients %+v", plan.Ingredients)
+	}
+
+	inventory := `{"hops": [{"name": "Mosaic", "inventory": 450}, {"name": "Simcoe", "inventory": 0}], "yeasts": [{"name": "WLP001", "laboratory": "White Labs", "inventory": 2}]}`
+	plan, err = Parse(SourceBrewfather, strings.NewReader(inventory))
+	if err != nil {
+		t.Fatalf("parsing inventory: %v", err)
+	}
+	if len(plan.Ingredients) != 3 || len(plan.Lots) != 2 {
+		t.Fatalf("expected 3 ingredients and 2 lots, got %+v", plan)
+	}
+	if got := plan.Lots[1]; got.Ingredient != "WLP001" || got.Amount != 2 || got.Unit != "pkg" || *got.OriginatorName != "White Labs" {
+		t.Errorf("unexpected yeast lot %+v", got)
+	}
+}
+
+func TestParseBeerSmith(t *testing.T) {
+	bsmx := `<?xml version="1.0" encoding="ISO-8859-1"?>
+<Recipes><Data><Cloud><Data>
+<Recipe><F_R_NAME>Dry Stout</F_R_NAME><F_R_NOTES>Roast &amp; dry &ndash; serve on nitro</F_R_NOTES>
+<F_R_STYLE><F_S_NAME>Irish Stout</F_S_NAME></F_R_STYLE>
+<F_R_EQUIPMENT><F_E_BATCH_VOL>1408.0000000</F_E_BATCH_VOL><F_E_EFFICIENCY>75.0000000</F_E_EFFICIENCY></F_R_EQUIPMENT>
+<Ingredients><Data>
+<Grain><F_G_NAME>Maris Otter</F_G_NAME><F_G_AMOUNT>120.0000000</F_G_AMOUNT><F_G_TYPE>0</F_G_TYPE></Grain>
+<Hops><F_H_NAME>East Kent Goldings</F_H_NAME><F_H_AMOUNT>2.0000000</F_H_AMOUNT><F_H_ALPHA>5.0000000</F_H_ALPHA><F_H_USE>0</F_H_USE><F_H_BOIL_TIME>60.0000000</F_H_BOIL_TIME></Hops>
+<Yeast><F_Y_NAME>Irish Ale</F_Y_NAME><F_Y_LAB>Wyeast</F_Y_LAB><F_Y_PRODUCT_ID>1084</F_Y_PRODUCT_ID><F_Y_AMOUNT>1.0000000</F_Y_AMOUNT></Yeast>
+<Misc><F_M_NAME>Irish Moss</F_M_NAME><F_M_AMOUNT>1.0000000</F_M_AMOUNT><F_M_UNITS>6</F_M_UNITS><F_M_USE>0</F_M_USE><F_M_TYPE>1</F_M_TYPE><F_M_TIME>15.0000000</F_M_TIME></Misc>
+</Data></Ingredients></Recipe>
+</Data></Cloud></Data></Recipes>`
+
+	plan, err := Parse(SourceBeerSmith, strings.NewReader(bsmx))
+	if err != nil {
+		t.Fatalf("parsing: %v", err)
+	}
+	if len(plan.Recipes) != 1 {
+		t.Fatalf("expected one recipe, got %+v", plan.Recipes)
+	}
+	recipe := plan.Recipes[0]
+	if *recipe.Style != "Irish Stout" || *recipe.BatchSize != 11 || *recipe.BatchSizeUnit != "gal" || *recipe.Notes != "Roast & dry – serve on nitro" {
+		t.Errorf("unexpected recipe %+v", recipe)
+	}
+	if got := recipe.Ingredients[0]; got.Amount != 7.5 || got.AmountUnit != "lb" || got.UseStage != "mash" {
+		t.Errorf("unexpected grain line %+v", got)
+	}
+	if got := recipe.Ingredients[1]; *got.TimingDurationMinutes != 60 || *got.AlphaAcidAssumed != 5 || got.UseStage != "boil" {
+		t.Errorf("unexpected hop line %+v", got)
+	}
+	if got := recipe.Ingredients[3]; got.IngredientType != "chemical" || got.AmountUnit != "tsp" || *got.TimingDurationMinutes != 15 {
+		t.Errorf("unexpected misc line %+v", got)
+	}
+}
+
+func TestParseRejectsUnknownSource(t *testing.T) {
+	if _, err := Parse("untappd", strings.NewReader("")); err == nil || err.Error() != "invalid source" {
+		t.Errorf("expected an invalid source error, got %v", err)
+	}
+}
+
+func TestWholeAmount(t *testing.T) {
+	cases := []struct {
+		amount   float64
+		unit     string
+		expected int64
+		expUnit  string
+	}{
+		{20, "kg", 20, "kg"},
+		{2.5, "kg", 2500, "g"},
+		{1100.5, "lb", 17608, "oz"},
+		{7.5, "bbl", 29760, "usfloz"},
+		{1.4, "pkg", 1, "pkg"},
+	}
+	for _, tc := range cases {
+		got, unit := WholeAmount(tc.amount, tc.unit)
+		if got != tc.expected || unit != tc.expUnit {
+			t.Errorf("WholeAmount(%v, %s) = %d %s, expected %d %s", tc.amount, tc.unit, got, unit, tc.expected, tc.expUnit)
+		}
+	}
+}
+
+func TestApplier(t *testing.T) {
+	ctx := context.Background()
+
+	dry := NewApplier(true)
+	if !dry.Create(ctx, "vessel", "row 2", "FV1", func(context.Context) (any, error) {
+		t.Fatal("dry run must not create")
+		return nil, nil
+	}) {
+		t.Error("expected a dry run create to report valid")
+	}
+	if got := dry.Result(); got.Totals.Valid != 1 || got.Results[0].Status != StatusValid {
+		t.Errorf("unexpected dry run result %+v", got)
+	}
+
+	run := NewApplier(false)
+	run.Create(ctx, "vessel", "row 2", "FV1", func(context.Context) (any, error) { return "created", nil })
+	run.Create(ctx, "vessel", "row 3", "FV2", func(context.Context) (any, error) { return nil, errors.New("boom") })
+	run.Matched("vessel", "row 4", "FV3", "existing")
+	run.Skipped("vessel", "row 5", "FV4")
+	got := run.Result()
+	if got.Totals != (Totals{Records: 4, Created: 1, Matched: 1, Skipped: 1, Failed: 1}) {
+		t.Errorf("unexpected totals %+v", got.Totals)
+	}
+	if *got.Results[1].Error != "create vessel failed" {
+		t.Errorf("expected the create error to be hidden, got %q", *got.Results[1].Error)
+	}
+}
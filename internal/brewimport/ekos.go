@@ -0,0 +1,364 @@
+package brewimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Ekos exports one CSV report at a time. The report is recognized by its
+// columns, which are matched loosely: case, spaces and punctuation are
+// ignored, and each field accepts the names Ekos has used for it.
+var ekosColumns = map[string][]string{
+	// Inventory report.
+	"item":     {"item", "itemname", "ingredient", "material"},
+	"itemtype": {"itemtype", "category", "type", "inventorytype"},
+	"quantity": {"quantity", "qty", "onhand", "quantityonhand", "amount", "available"},
+	"unit":     {"unit", "uom", "unitofmeasure", "units", "capacityunit"},
+	"lot":      {"lot", "lotnumber", "lotcode", "lotno"},
+	"location": {"location", "storagelocation", "warehouse"},
+	"supplier": {"supplier", "vendor"},
+	"received": {"received", "receiveddate", "datereceived", "receivedon"},
+	"expires":  {"expires", "expiration", "expirationdate", "expiry", "bestby"},
+	// Batch report.
+	"batch":    {"batch", "batchnumber", "batchno", "batchid", "batchcode"},
+	"product":  {"product", "productname", "recipe", "beer", "brand"},
+	"style":    {"style"},
+	"brewdate": {"brewdate", "datebrewed", "brewed", "startdate", "date"},
+	// Vessel report.
+	"vessel":   {"vessel", "vesselname", "tank", "tankname", "name"},
+	"capacity": {"capacity", "size", "volume"},
+	"status":   {"status"},
+	"make":     {"make", "manufacturer"},
+	"model":    {"model"},
+	// All reports.
+	"notes": {"notes", "note", "comments"},
+}
+
+// ekosDateLayouts are the date formats found in Ekos exports.
+var ekosDateLayouts = []string{
+	"2006-01-02",
+	"1/2/2006",
+	"1/2/06",
+	"2006-01-02 15:04:05",
+	"1/2/2006 15:04",
+	"1/2/2006 3:04 PM",
+	"1/2/2006 3:04:05 PM",
+	"Jan 2, 2006",
+}
+
+// ekosRow is a CSV row keyed by field.
+type ekosRow struct {
+	ref    string
+	values map[string]string
+}
+
+func (r ekosRow) get(field string) string {
+	return r.values[field]
+}
+
+func parseEkos(r io.Reader) (Plan, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return Plan{}, fmt.Errorf("missing header row")
+		}
+		return Plan{}, fmt.Errorf("invalid csv")
+	}
+
+	// Map each column to a field. The first column to claim a field wins, so
+	// "Name" is the vessel only when there is no more specific column.
+	fields := make([]string, len(header))
+	claimed := make(map[string]bool)
+	for i, name := range header {
+		key := ekosKey(name)
+		for _, field := range ekosFieldOrder {
+			if !claimed[field] && slices.Contains(ekosColumns[field], key) {
+				fields[i] = field
+				claimed[field] = true
+				break
+			}
+		}
+	}
+
+	var rows []ekosRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return Plan{}, fmt.Errorf("invalid csv")
+		}
+		row := ekosRow{ref: fmt.Sprintf("row %d", line), values: make(map[string]string)}
+		empty := true
+		for i, value := range record {
+			if i < len(fields) && fields[i] != "" {
+				row.values[fields[i]] = strings.TrimSpace(value)
+				empty = empty && strings.TrimSpace(value) == ""
+			}
+		}
+		if !empty {
+			rows = append(rows, row)
+		}
+	}
+
+	b := newBuilder(SourceEkos)
+	switch {
+	case claimed["item"]:
+		b.ekosInventory(rows)
+	case claimed["batch"]:
+		b.ekosBatches(rows)
+	case claimed["vessel"] && claimed["capacity"]:
+		b.ekosVessels(rows)
+	default:
+		return Plan{}, fmt.Errorf("unrecognized Ekos export; expected an inventory, batch or vessel report")
+	}
+	return b.done()
+}
+
+// ekosFieldOrder lists fields most specific first, so that a column such as
+// "Type" is taken by the item type before anything more general.
+var ekosFieldOrder = []string{
+	"item", "batch", "itemtype", "quantity", "lot", "location", "supplier", "received", "expires",
+	"product", "style", "brewdate", "capacity", "unit", "status", "make", "model", "notes", "vessel",
+}
+
+// ekosKey reduces a column name to lowercase letters and digits.
+func ekosKey(name string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+func (b *builder) ekosInventory(rows []ekosRow) {
+	for _, row := range rows {
+		name := row.get("item")
+		if name == "" {
+			b.warn(row.ref, "row has no item; skipped")
+			continue
+		}
+
+		unit := b.unit(row.ref, row.get("unit"), "")
+		category := b.ekosCategory(row.ref, row.get("itemtype"))
+		if unit == "" {
+			unit = defaultUnit(category)
+		}
+		b.ingredient(Ingredient{Ref: row.ref, Name: name, Category: category, DefaultUnit: unit})
+
+		quantity, ok := b.ekosNumber(row.ref, "quantity", row.get("quantity"))
+		if !ok || quantity <= 0 {
+			continue
+		}
+		b.plan.Lots = append(b.plan.Lots, Lot{
+			Ref:            row.ref,
+			Ingredient:     name,
+			StockLocation:  strPtr(row.get("location")),
+			Amount:         quantity,
+			Unit:           unit,
+			BreweryLotCode: strPtr(row.get("lot")),
+			OriginatorName: strPtr(row.get("supplier")),
+			ReceivedAt:     b.ekosDate(row.ref, "received date", row.get("received")),
+			ExpiresAt:      b.ekosDate(row.ref, "expiration date", row.get("expires")),
+			Notes:          strPtr(row.get("notes")),
+		})
+	}
+}
+
+func (b *builder) ekosBatches(rows []ekosRow) {
+	for _, row := range rows {
+		shortName := row.get("batch")
+		if shortName == "" {
+			b.warn(row.ref, "row has no batch number; skipped")
+			continue
+		}
+
+		product := strPtr(row.get("product"))
+		if product != nil {
+			b.recipe(Recipe{Ref: row.ref, Name: *product, Style: strPtr(row.get("style"))})
+		}
+		b.plan.Batches = append(b.plan.Batches, Batch{
+			Ref:       row.ref,
+			ShortName: shortName,
+			Recipe:    product,
+			BrewDate:  b.ekosDate(row.ref, "brew date", row.get("brewdate")),
+			Notes:     strPtr(row.get("notes")),
+		})
+	}
+}
+
+func (b *builder) ekosVessels(rows []ekosRow) {
+	for _, row := range rows {
+		name := row.get("vessel")
+		if name == "" {
+			b.warn(row.ref, "row has no vessel name; skipped")
+			continue
+		}
+
+		capacity, _ := b.ekosNumber(row.ref, "capacity", row.get("capacity"))
+		capacity, unit := b.volume(row.ref, capacity, row.get("unit"))
+		b.plan.Vessels = append(b.plan.Vessels, Vessel{
+			Ref:          row.ref,
+			Name:         name,
+			Type:         b.vesselType(row.ref, row.get("itemtype")),
+			Capacity:     capacity,
+			CapacityUnit: unit,
+			Status:       b.vesselStatus(row.ref, row.get("status")),
+			Make:         strPtr(row.get("make")),
+			Model:        strPtr(row.get("model")),
+		})
+	}
+}
+
+// ekosCategory maps an Ekos item type to an ingredient category.
+func (b *builder) ekosCategory(ref, itemType string) string {
+	value := strings.ToLower(itemType)
+	switch {
+	case containsAny(value, "hop"):
+		return "hop"
+	case containsAny(value, "malt", "grain", "fermentable", "extract", "sugar"):
+		return "fermentable"
+	case containsAny(value, "yeast", "culture"):
+		return "yeast"
+	case containsAny(value, "adjunct", "fruit", "spice"):
+		return "adjunct"
+	case containsAny(value, "salt", "water"):
+		return "salt"
+	case containsAny(value, "chemical", "cleaning", "sanit", "fining", "acid"):
+		return "chemical"
+	case containsAny(value, "co2", "gas", "nitrogen"):
+		return "gas"
+	case containsAny(value, "packag", "can", "bottle", "label", "keg", "cap", "carton", "case"):
+		return "packaging"
+	}
+	if itemType != "" {
+		b.warn(ref, "item type %q is not known; using other", itemType)
+	}
+	return "other"
+}
+
+func (b *builder) ekosNumber(ref, field, value string) (float64, bool) {
+	value = strings.ReplaceAll(value, ",", "")
+	if value == "" {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		b.warn(ref, "invalid %s %q", field, value)
+		return 0, false
+	}
+	return parsed, true
+}
+
+func (b *builder) ekosDate(ref, field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range ekosDateLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return &parsed
+		}
+	}
+	b.warn(ref, "invalid %s %q; left empty", field, value)
+	return nil
+}
+
+// volume converts a capacity to a BrewPipes volume unit: barrels stay
+// barrels, liters become milliliters and gallons US fluid ounces.
+func (b *builder) volume(ref string, amount float64, unit string) (float64, string) {
+	switch b.unit(ref, unit, "") {
+	case "bbl":
+		return amount, "bbl"
+	case "l":
+		return amount * 1000, "ml"
+	case "hl":
+		return amount * 100000, "ml"
+	case "ml":
+		return amount, "ml"
+	case "gal":
+		return amount * 128, "usfloz"
+	case "":
+		b.warn(ref, "capacity has no unit; assuming bbl")
+		return amount, "bbl"
+	default:
+		b.warn(ref, "capacity unit %q is not known; assuming bbl", unit)
+		return amount, "bbl"
+	}
+}
+
+// vesselType maps a vessel type name to a BrewPipes vessel type.
+func (b *builder) vesselType(ref, value string) string {
+	v := strings.ToLower(value)
+	switch {
+	case containsAny(v, "brite", "bright", "bbt"):
+		return "brite_tank"
+	case containsAny(v, "serving"):
+		return "serving_tank"
+	case containsAny(v, "ferm", "fv", "unitank", "uni"):
+		return "fermenter"
+	case containsAny(v, "mash"):
+		return "mash_tun"
+	case containsAny(v, "lauter"):
+		return "lauter_tun"
+	case containsAny(v, "kettle", "boil"):
+		return "kettle"
+	case containsAny(v, "whirlpool"):
+		return "whirlpool"
+	}
+	if value != "" {
+		b.warn(ref, "vessel type %q is not known; using other", value)
+	}
+	return "other"
+}
+
+func (b *builder) vesselStatus(ref, value string) string {
+	v := strings.ToLower(value)
+	switch {
+	case v == "":
+		return "active"
+	case containsAny(v, "inactive", "out of service", "maintenance", "offline"):
+		return "inactive"
+	case containsAny(v, "retired", "sold", "decommission"):
+		return "retired"
+	case containsAny(v, "active", "in use", "available", "empty", "full", "clean", "dirty"):
+		return "active"
+	}
+	b.warn(ref, "vessel status %q is not known; using active", value)
+	return "active"
+}
+
+// defaultUnit is the unit an ingredient is counted in when the source does
+// not say.
+func defaultUnit(category string) string {
+	switch category {
+	case "fermentable":
+		return "kg"
+	case "hop":
+		return "g"
+	case "yeast":
+		return "pkg"
+	default:
+		return "each"
+	}
+}
+
+func containsAny(s string, subs ...string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
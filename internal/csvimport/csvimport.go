@@ -0,0 +1,364 @@
+// Package csvimport imports records from an uploaded CSV file row by row.
+// Each row is parsed and written on its own, so one bad row does not reject
+// the file, and every row gets a result. A dry run writes every row the same
+// way inside a transaction that is rolled back, so it rejects the rows the
+// real import would.
+package csvimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Upload limits.
+const (
+	MaxUploadSize = 5 << 20
+	MaxRows       = 1000
+)
+
+// Row statuses.
+const (
+	StatusCreated = "created"
+	StatusValid   = "valid"
+	StatusError   = "error"
+)
+
+// Importer imports one kind of record. T is a parsed row.
+type Importer[T any] struct {
+	// Name names the record in logs and failure messages, e.g. "ingredient".
+	Name string
+	// Headers lists the allowed columns and Required those the file must have.
+	Headers  []string
+	Required []string
+	// Parse validates a row and resolves its references without writing. Its
+	// error is reported as the row's error.
+	Parse func(ctx context.Context, row Row) (T, error)
+	// Create writes a parsed row and returns the created record for the
+	// response. Its error is logged and the row reported as failed.
+	Create func(ctx context.Context, value T) (any, error)
+	// RunInTx runs fn in a transaction, or in a savepoint when ctx already
+	// carries one, and rolls it back when fn fails.
+	RunInTx func(ctx context.Context, fn func(context.Context) error) error
+}
+
+// errDryRun rolls back the transaction a dry run writes its rows in.
+var errDryRun = errors.New("dry run")
+
+// File is the header and rows of an uploaded CSV file.
+type File struct {
+	headers []string
+	records [][]string
+}
+
+// Result is the outcome of an import.
+type Result struct {
+	DryRun  bool        `json:"dry_run"`
+	Totals  Totals      `json:"totals"`
+	Results []RowResult `json:"results"`
+}
+
+// Totals counts rows by outcome. Valid counts rows that passed validation in
+// a dry run.
+type Totals struct {
+	TotalRows int `json:"total_rows"`
+	Created   int `json:"created"`
+	Valid     int `json:"valid"`
+	Failed    int `json:"failed"`
+}
+
+// RowResult is the outcome of one row. Rows are numbered as in the file, so
+// the first row after the header is row 2.
+type RowResult struct {
+	Row    int     `json:"row"`
+	Status string  `json:"status"`
+	Record any     `json:"record,omitempty"`
+	Error  *string `json:"error,omitempty"`
+}
+
+// ParseDryRun parses a dry_run query parameter, defaulting to false.
+func ParseDryRun(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+	dryRun, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid dry_run")
+	}
+	return dryRun, nil
+}
+
+// Read reads the CSV upload in the "file" field of a multipart form and
+// checks its header row against the importer: every header must be allowed,
+// none repeated, and all required headers present. On failure it writes the
+// error response and returns false.
+func Read[T any](w http.ResponseWriter, r *http.Request, imp Importer[T]) (File, bool) {
+	r.Body = http.MaxBytesReader(w, r.Body, MaxUploadSize)
+	if err := r.ParseMultipartForm(MaxUploadSize); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, "file too large", http.StatusRequestEntityTooLarge)
+			return File{}, false
+		}
+		slog.Warn("invalid "+imp.Name+" import form", "error", err)
+		http.Error(w, "invalid multipart form", http.StatusBadRequest)
+		return File{}, false
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return File{}, false
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	rawHeaders, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			http.Error(w, "missing header row", http.StatusBadRequest)
+			return File{}, false
+		}
+		slog.Warn("unable to read "+imp.Name+" import header", "error", err)
+		http.Error(w, "invalid csv", http.StatusBadRequest)
+		return File{}, false
+	}
+
+	headers := make([]string, 0, len(rawHeaders))
+	for _, rawHeader := range rawHeaders {
+		header := strings.TrimSpace(rawHeader)
+		if header == "" {
+			http.Error(w, "header value is required", http.StatusBadRequest)
+			return File{}, false
+		}
+		if !slices.Contains(imp.Headers, header) {
+			http.Error(w, fmt.Sprintf("unknown header: %s", header), http.StatusBadRequest)
+			return File{}, false
+		}
+		if slices.Contains(headers, header) {
+			http.Error(w, fmt.Sprintf("duplicate header: %s", header), http.StatusBadRequest)
+			return File{}, false
+		}
+		headers = append(headers, header)
+	}
+
+	for _, header := range imp.Required {
+		if !slices.Contains(headers, header) {
+			http.Error(w, fmt.Sprintf("%s header is required", header), http.StatusBadRequest)
+			return File{}, false
+		}
+	}
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			slog.Warn("unable to read "+imp.Name+" import row", "error", err)
+			http.Error(w, "invalid csv", http.StatusBadRequest)
+			return File{}, false
+		}
+		records = append(records, record)
+		if len(records) > MaxRows {
+			http.Error(w, "row limit exceeded", http.StatusBadRequest)
+			return File{}, false
+		}
+	}
+
+	return File{headers: headers, records: records}, true
+}
+
+// Run imports the rows of f in order; later rows see what earlier rows
+// created. A dry run creates each row in a savepoint of one transaction and
+// rolls the transaction back, reporting rows that would be created as valid.
+// The error is that of starting or ending the dry run's transaction.
+func Run[T any](ctx context.Context, imp Importer[T], f File, dryRun bool) (Result, error) {
+	if !dryRun {
+		result := run(ctx, imp, f, false)
+		logResult(imp, result)
+		return result, nil
+	}
+
+	var result Result
+	err := imp.RunInTx(ctx, func(ctx context.Context) error {
+		result = run(ctx, imp, f, true)
+		return errDryRun
+	})
+	if !errors.Is(err, errDryRun) {
+		return Result{}, err
+	}
+	logResult(imp, result)
+	return result, nil
+}
+
+func run[T any](ctx context.Context, imp Importer[T], f File, dryRun bool) Result {
+	result := Result{
+		DryRun:  dryRun,
+		Totals:  Totals{TotalRows: len(f.records)},
+		Results: make([]RowResult, 0, len(f.records)),
+	}
+
+	fail := func(rowNumber int, msg string) {
+		result.Results = append(result.Results, RowResult{Row: rowNumber, Status: StatusError, Error: &msg})
+		result.Totals.Failed++
+	}
+
+	for idx, record := range f.records {
+		rowNumber := idx + 2
+		if len(record) != len(f.headers) {
+			fail(rowNumber, "invalid column count")
+			continue
+		}
+
+		row := Row{Number: rowNumber, values: make(map[string]string, len(f.headers))}
+		for i, header := range f.headers {
+			row.values[header] = strings.TrimSpace(record[i])
+		}
+
+		value, err := imp.Parse(ctx, row)
+		if err != nil {
+			fail(rowNumber, err.Error())
+			continue
+		}
+
+		// In a dry run a failed row must not abort the transaction the
+		// rows after it are written in.
+		var created any
+		if dryRun {
+			err = imp.RunInTx(ctx, func(ctx context.Context) error {
+				var err error
+				created, err = imp.Create(ctx, value)
+				return err
+			})
+		} else {
+			created, err = imp.Create(ctx, value)
+		}
+		if err != nil {
+			slog.Error("error creating "+imp.Name+" from import", "error", err, "row", rowNumber, "dry_run", dryRun)
+			fail(rowNumber, "create "+imp.Name+" failed")
+			continue
+		}
+
+		if dryRun {
+			result.Results = append(result.Results, RowResult{Row: rowNumber, Status: StatusValid})
+			result.Totals.Valid++
+			continue
+		}
+		result.Results = append(result.Results, RowResult{Row: rowNumber, Status: StatusCreated, Record: created})
+		result.Totals.Created++
+	}
+
+	return result
+}
+
+func logResult[T any](imp Importer[T], result Result) {
+	slog.Info(imp.Name+" import finished", "dry_run", result.DryRun, "rows", result.Totals.TotalRows,
+		"created", result.Totals.Created, "valid", result.Totals.Valid, "failed", result.Totals.Failed)
+}
+
+// Lookup finds the record a column refers to, by UUID or by case-insensitive
+// name. key returns a record's UUID and name. A name shared by several
+// records is ambiguous; label names the column in errors.
+func Lookup[T any](records []T, value, label string, key func(T) (string, string)) (T, error) {
+	var found []T
+	for _, record := range records {
+		id, name := key(record)
+		if id == value {
+			return record, nil
+		}
+		if strings.EqualFold(name, value) {
+			found = append(found, record)
+		}
+	}
+
+	var zero T
+	switch len(found) {
+	case 0:
+		return zero, fmt.Errorf("%s not found", label)
+	case 1:
+		return found[0], nil
+	default:
+		return zero, fmt.Errorf("%s %q is ambiguous", label, value)
+	}
+}
+
+// Row is one CSV row with its values trimmed. Missing columns read as empty.
+type Row struct {
+	Number int
+	values map[string]string
+}
+
+// Value returns the value of a column, or "" if the column is absent.
+func (r Row) Value(header string) string {
+	return r.values[header]
+}
+
+// Optional returns the value of a column, or nil if it is empty.
+func (r Row) Optional(header string) *string {
+	value := r.values[header]
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// Require checks that each column has a value.
+func (r Row) Require(headers ...string) error {
+	for _, header := range headers {
+		if r.values[header] == "" {
+			return fmt.Errorf("%s is required", header)
+		}
+	}
+	return nil
+}
+
+// Date parses a YYYY-MM-DD column, or returns nil if it is empty.
+func (r Row) Date(header string) (*time.Time, error) {
+	value := r.values[header]
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", header)
+	}
+	return &parsed, nil
+}
+
+// Float parses a decimal column, or returns nil if it is empty.
+func (r Row) Float(header string) (*float64, error) {
+	value := r.values[header]
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", header)
+	}
+	return &parsed, nil
+}
+
+// Int parses an integer column, or returns nil if it is empty.
+func (r Row) Int(header string) (*int, error) {
+	value := r.values[header]
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s", header)
+	}
+	return &parsed, nil
+}
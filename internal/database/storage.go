@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/brewpipes/brewpipes/internal/telemetry"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -30,15 +31,26 @@ func NewBaseClient(dsn string, migrations embed.FS, migrationTable, serviceName
 	}
 }
 
-// Start connects to Postgres, pings the database, runs migrations, and
+// Start connects to Postgres with query tracing, exposes the pool's
+// statistics as metrics, pings the database, runs migrations, and
 // registers a goroutine to close the pool when ctx is cancelled.
 func (c *BaseClient) Start(ctx context.Context) error {
-	pool, err := pgxpool.New(ctx, c.dsn)
+	config, err := pgxpool.ParseConfig(c.dsn)
+	if err != nil {
+		return fmt.Errorf("parsing DB connection string: %w", err)
+	}
+	config.ConnConfig.Tracer = telemetry.QueryTracer{}
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return fmt.Errorf("creating DB connection pool: %w", err)
 	}
 	c.db = pool
 
+	if err := telemetry.RegisterPool(c.serviceName, pool); err != nil {
+		slog.Warn("not exposing "+c.serviceName+" DB pool metrics", "error", err)
+	}
+
 	if err := c.db.Ping(ctx); err != nil {
 		return fmt.Errorf("pinging Postgres: %w", err)
 	}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Conn is what storage methods run their statements against: the client's
+// pool, or a transaction carried by the request context.
+type Conn interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
+type txKey struct{}
+
+// WithTx returns a copy of ctx carrying tx. Storage methods called with the
+// returned context run inside tx, whichever service's client they belong to,
+// so work spread over several services sharing one database commits or rolls
+// back as a unit. Transactions a storage method begins itself become
+// savepoints of tx.
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// TxFromContext returns the transaction carried by ctx, if any.
+func TxFromContext(ctx context.Context) (pgx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(pgx.Tx)
+	return tx, ok
+}
+
+// Conn returns the transaction carried by ctx, or the pool when there is
+// none.
+func (c *BaseClient) Conn(ctx context.Context) Conn {
+	if tx, ok := TxFromContext(ctx); ok {
+		return tx
+	}
+	return c.db
+}
+
+// RunInTx calls fn with a context carrying a transaction, committing it when
+// fn returns nil and rolling it back otherwise. When ctx already carries a
+// transaction, fn runs in a savepoint of it instead.
+func (c *BaseClient) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(WithTx(ctx, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+// Package inprocess implements the calls one service makes to another with
+// direct calls into the other service's storage, for binaries that run
+// several services in one process. It stands in for the HTTP clients each
+// service uses in split deployments, skipping the network round-trip and
+// the service token.
+//
+// Calls run on the caller's context, so when it carries a transaction from
+// database.WithTx (as inside the storage RunInTx method) the callee's writes
+// join it and commit or roll back with the caller's. That only works when
+// the services share one database, as they do in the monolith.
+package inprocess
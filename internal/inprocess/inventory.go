@@ -0,0 +1,418 @@
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/service"
+	inventoryhandler "github.com/brewpipes/brewpipes/service/inventory/handler"
+	inventorydto "github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	inventorystorage "github.com/brewpipes/brewpipes/service/inventory/storage"
+	productionhandler "github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/gofrs/uuid/v5"
+)
+
+// InventoryStore defines the Inventory storage methods production's calls
+// are served from.
+type InventoryStore interface {
+	inventoryhandler.ScanStore
+	GetStockLocationByUUID(context.Context, string) (inventorystorage.StockLocation, error)
+	CreateBeerLotWithMovement(ctx context.Context, lot inventorystorage.BeerLot, items []inventorystorage.BeerLotItem, stockLocationID int64, movementAmount int64, movementAmountUnit string) (inventorystorage.BeerLot, []inventorystorage.BeerLotItem, uuid.UUID, error)
+	ReleaseReservationsForProduction(context.Context, string) (int64, error)
+	ListBatchIngredientLots(context.Context, string) ([]inventorystorage.BatchIngredientLot, error)
+	ListBatchUsageMovements(context.Context, string) ([]inventorystorage.BatchUsageMovement, error)
+	GetIngredientLotStockLevels(context.Context) ([]inventorystorage.IngredientLotStockLevel, error)
+	ListReservations(context.Context, inventorystorage.ReservationListFilter) ([]inventorystorage.InventoryReservation, error)
+	ListRemovals(context.Context, inventorystorage.RemovalListFilter) ([]inventorystorage.InventoryRemoval, error)
+	ListIngredientLots(context.Context) ([]inventorystorage.IngredientLot, error)
+	CreateBatchUsage(context.Context, inventorystorage.BatchUsageRequest) (inventorystorage.BatchUsageResult, error)
+	ListLabelTemplates(context.Context, *string) ([]inventorystorage.LabelTemplate, error)
+	ListIngredients(context.Context) ([]inventorystorage.Ingredient, error)
+	ListReorderPolicies(context.Context, *string) ([]inventorystorage.IngredientReorderPolicy, error)
+}
+
+// Inventory implements production's handler.InventoryAPI with direct calls
+// to the Inventory storage. Requests are validated with the same rules as
+// the corresponding Inventory routes and results carry the same values.
+type Inventory struct {
+	db InventoryStore
+}
+
+var _ productionhandler.InventoryAPI = (*Inventory)(nil)
+
+// NewInventory creates an Inventory backed by db.
+func NewInventory(db InventoryStore) *Inventory {
+	return &Inventory{db: db}
+}
+
+// CreateBeerLot creates a beer lot with its initial movement into the
+// requested stock location, as [POST /beer-lots] does.
+func (i *Inventory) CreateBeerLot(ctx context.Context, req productionhandler.BeerLotRequest) (*productionhandler.BeerLotResponse, error) {
+	createReq := inventorydto.CreateBeerLotRequest{
+		ProductionBatchUUID: req.ProductionBatchUUID,
+		LotCode:             req.LotCode,
+		PackagedAt:          &req.PackagedAt,
+		Notes:               req.Notes,
+		PackagingRunUUID:    &req.PackagingRunUUID,
+		PackageFormatName:   &req.PackageFormatName,
+		Container:           &req.Container,
+		VolumePerUnit:       &req.VolumePerUnit,
+		VolumePerUnitUnit:   &req.VolumePerUnitUnit,
+		Quantity:            &req.Quantity,
+		StockLocationUUID:   &req.StockLocationUUID,
+	}
+	if err := createReq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid beer lot request: %w", err)
+	}
+
+	batchUUID, err := uuid.FromString(req.ProductionBatchUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid production_batch_uuid %q", req.ProductionBatchUUID)
+	}
+	packagingRunUUID, err := uuid.FromString(req.PackagingRunUUID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid packaging_run_uuid %q", req.PackagingRunUUID)
+	}
+
+	location, err := i.db.GetStockLocationByUUID(ctx, req.StockLocationUUID)
+	if errors.Is(err, service.ErrNotFound) {
+		return nil, fmt.Errorf("stock location %s not found", req.StockLocationUUID)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting stock location: %w", err)
+	}
+
+	lot := inventorystorage.BeerLot{
+		ProductionBatchUUID: batchUUID,
+		PackagingRunUUID:    &packagingRunUUID,
+		LotCode:             req.LotCode,
+		PackageFormatName:   createReq.PackageFormatName,
+		Container:           createReq.Container,
+		VolumePerUnit:       createReq.VolumePerUnit,
+		VolumePerUnitUnit:   createReq.VolumePerUnitUnit,
+		Quantity:            createReq.Quantity,
+		PackagedAt:          req.PackagedAt,
+		Notes:               req.Notes,
+	}
+	items := inventoryhandler.BeerLotItemsForCreate(createReq)
+
+	created, _, _, err := i.db.CreateBeerLotWithMovement(ctx, lot, items, location.ID, int64(req.Quantity)*req.VolumePerUnit, req.VolumePerUnitUnit)
+	if err != nil {
+		return nil, fmt.Errorf("creating beer lot: %w", err)
+	}
+
+	return &productionhandler.BeerLotResponse{UUID: created.UUID.String()}, nil
+}
+
+// ReleaseBatchReservations releases every active reservation held for a
+// batch.
+func (i *Inventory) ReleaseBatchReservations(ctx context.Context, batchUUID string) error {
+	req := inventorydto.ReleaseInventoryReservationsRequest{ProductionRefUUID: batchUUID}
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := i.db.ReleaseReservationsForProduction(ctx, batchUUID); err != nil {
+		return fmt.Errorf("releasing reservations: %w", err)
+	}
+	return nil
+}
+
+// GetBatchIngredientLots returns the ingredient lots a batch has consumed.
+func (i *Inventory) GetBatchIngredientLots(ctx context.Context, batchUUID string) ([]productionhandler.BatchIngredientLot, error) {
+	if batchUUID == "" {
+		return nil, errors.New("production_ref_uuid is required")
+	}
+
+	lots, err := i.db.ListBatchIngredientLots(ctx, batchUUID)
+	if err != nil {
+		return nil, fmt.Errorf("listing batch ingredient lots: %w", err)
+	}
+
+	resp := inventorydto.NewBatchIngredientLotsResponse(lots)
+	result := make([]productionhandler.BatchIngredientLot, 0, len(resp))
+	for _, lot := range resp {
+		result = append(result, productionhandler.BatchIngredientLot{
+			IngredientLotUUID:     lot.IngredientLotUUID,
+			IngredientUUID:        lot.IngredientUUID,
+			IngredientName:        lot.IngredientName,
+			IngredientCategory:    lot.IngredientCategory,
+			BreweryLotCode:        lot.BreweryLotCode,
+			PurchaseOrderLineUUID: lot.PurchaseOrderLineUUID,
+			ReceivedUnit:          lot.ReceivedUnit,
+		})
+	}
+	return result, nil
+}
+
+// ListBatchUsageMovements returns the movements deducted for a batch.
+func (i *Inventory) ListBatchUsageMovements(ctx context.Context, batchUUID string) ([]productionhandler.BatchUsageMovement, error) {
+	if batchUUID == "" {
+		return nil, errors.New("production_ref_uuid is required")
+	}
+
+	movements, err := i.db.ListBatchUsageMovements(ctx, batchUUID)
+	if err != nil {
+		return nil, fmt.Errorf("listing batch usage movements: %w", err)
+	}
+
+	resp := inventorydto.NewBatchUsageMovementsResponse(movements)
+	result := make([]productionhandler.BatchUsageMovement, 0, len(resp))
+	for _, m := range resp {
+		result = append(result, productionhandler.BatchUsageMovement{
+			UsageUUID:             m.UsageUUID,
+			MovementUUID:          m.MovementUUID,
+			Reason:                m.Reason,
+			IngredientLotUUID:     m.IngredientLotUUID,
+			IngredientUUID:        m.IngredientUUID,
+			IngredientName:        m.IngredientName,
+			IngredientCategory:    m.IngredientCategory,
+			BreweryLotCode:        m.BreweryLotCode,
+			PurchaseOrderLineUUID: m.PurchaseOrderLineUUID,
+			Amount:                m.Amount,
+			AmountUnit:            m.AmountUnit,
+			OccurredAt:            m.OccurredAt,
+		})
+	}
+	return result, nil
+}
+
+// GetIngredientLotStockLevels returns the on-hand and available balance of
+// every ingredient lot at every location holding it.
+func (i *Inventory) GetIngredientLotStockLevels(ctx context.Context) ([]productionhandler.IngredientLotStockLevel, error) {
+	levels, err := i.db.GetIngredientLotStockLevels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting ingredient lot stock levels: %w", err)
+	}
+
+	result := make([]productionhandler.IngredientLotStockLevel, 0, len(levels))
+	for _, level := range levels {
+		result = append(result, productionhandler.IngredientLotStockLevel{
+			IngredientLotUUID:  level.IngredientLotUUID,
+			IngredientUUID:     level.IngredientUUID,
+			IngredientName:     level.IngredientName,
+			IngredientCategory: level.IngredientCategory,
+			BreweryLotCode:     level.BreweryLotCode,
+			ReceivedAt:         level.ReceivedAt,
+			BestByAt:           level.BestByAt,
+			ExpiresAt:          level.ExpiresAt,
+			StockLocationUUID:  level.LocationUUID,
+			StockLocationName:  level.LocationName,
+			CurrentAmount:      level.CurrentAmount,
+			CurrentUnit:        level.CurrentUnit,
+			AvailableAmount:    level.AvailableAmount,
+		})
+	}
+	return result, nil
+}
+
+// ListBatchReservations returns the active reservations held for a batch.
+func (i *Inventory) ListBatchReservations(ctx context.Context, batchUUID string) ([]productionhandler.BatchReservation, error) {
+	if _, err := uuid.FromString(batchUUID); err != nil {
+		return nil, fmt.Errorf("invalid production_ref_uuid %q", batchUUID)
+	}
+	return i.listReservations(ctx, &batchUUID)
+}
+
+// ListActiveReservations returns every active reservation.
+func (i *Inventory) ListActiveReservations(ctx context.Context) ([]productionhandler.BatchReservation, error) {
+	return i.listReservations(ctx, nil)
+}
+
+func (i *Inventory) listReservations(ctx context.Context, batchUUID *string) ([]productionhandler.BatchReservation, error) {
+	status := inventorystorage.ReservationStatusActive
+	reservations, err := i.db.ListReservations(ctx, inventorystorage.ReservationListFilter{
+		ProductionRefUUID: batchUUID,
+		Status:            &status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing reservations: %w", err)
+	}
+
+	resp := inventorydto.NewInventoryReservationsResponse(reservations)
+	result := make([]productionhandler.BatchReservation, 0, len(resp))
+	for _, res := range resp {
+		result = append(result, productionhandler.BatchReservation{
+			UUID:              res.UUID,
+			ProductionRefUUID: res.ProductionRefUUID,
+			IngredientLotUUID: res.IngredientLotUUID,
+			StockLocationUUID: res.StockLocationUUID,
+			OutstandingAmount: res.OutstandingAmount,
+			AmountUnit:        res.AmountUnit,
+		})
+	}
+	return result, nil
+}
+
+// ListBatchRemovals returns the removals recorded against a batch.
+func (i *Inventory) ListBatchRemovals(ctx context.Context, batchUUID string) ([]productionhandler.BatchRemoval, error) {
+	removals, err := i.db.ListRemovals(ctx, inventorystorage.RemovalListFilter{BatchUUID: &batchUUID})
+	if err != nil {
+		return nil, fmt.Errorf("listing removals: %w", err)
+	}
+
+	resp := inventorydto.NewRemovalsResponse(removals)
+	result := make([]productionhandler.BatchRemoval, 0, len(resp))
+	for _, removal := range resp {
+		result = append(result, productionhandler.BatchRemoval{
+			UUID:       removal.UUID,
+			Category:   removal.Category,
+			Reason:     removal.Reason,
+			Amount:     removal.Amount,
+			AmountUnit: removal.AmountUnit,
+			AmountBBL:  removal.AmountBBL,
+			RemovedAt:  removal.RemovedAt,
+		})
+	}
+	return result, nil
+}
+
+// ListIngredientLotReceipts returns every ingredient lot with its received
+// quantity.
+func (i *Inventory) ListIngredientLotReceipts(ctx context.Context) ([]productionhandler.IngredientLotReceipt, error) {
+	lots, err := i.db.ListIngredientLots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing ingredient lots: %w", err)
+	}
+
+	resp := inventorydto.NewIngredientLotsResponse(lots)
+	result := make([]productionhandler.IngredientLotReceipt, 0, len(resp))
+	for _, lot := range resp {
+		result = append(result, productionhandler.IngredientLotReceipt{
+			UUID:                  lot.UUID,
+			IngredientUUID:        lot.IngredientUUID,
+			PurchaseOrderLineUUID: lot.PurchaseOrderLineUUID,
+			ReceivedAmount:        lot.ReceivedAmount,
+			ReceivedUnit:          lot.ReceivedUnit,
+		})
+	}
+	return result, nil
+}
+
+// CreateBatchUsage deducts the picks from stock in one transaction, as
+// [POST /inventory-usage/batch] does. Requests Inventory refuses are
+// reported as *handler.InventoryRejectedError.
+func (i *Inventory) CreateBatchUsage(ctx context.Context, req productionhandler.BatchUsageRequest) (*productionhandler.BatchUsageResponse, error) {
+	usageReq := inventorydto.CreateBatchUsageRequest{
+		ProductionRefUUID: &req.ProductionRefUUID,
+		UsedAt:            req.UsedAt,
+		Notes:             req.Notes,
+		Picks:             make([]inventorydto.BatchUsagePick, len(req.Picks)),
+	}
+	if req.Reason != "" {
+		usageReq.Reason = &req.Reason
+	}
+	for n, pick := range req.Picks {
+		usageReq.Picks[n] = inventorydto.BatchUsagePick(pick)
+	}
+	if err := usageReq.Validate(); err != nil {
+		return nil, &productionhandler.InventoryRejectedError{Message: err.Error()}
+	}
+
+	result, err := i.db.CreateBatchUsage(ctx, usageReq.Usage())
+	var validationErr *inventorystorage.ErrBatchUsageValidation
+	if errors.As(err, &validationErr) {
+		return nil, &productionhandler.InventoryRejectedError{Message: validationErr.Message}
+	} else if err != nil {
+		return nil, fmt.Errorf("creating batch usage: %w", err)
+	}
+
+	resp := inventorydto.NewBatchUsageResponse(result.Usage, result.Movements)
+	movements := make([]json.RawMessage, 0, len(resp.Movements))
+	for _, movement := range resp.Movements {
+		encoded, err := json.Marshal(movement)
+		if err != nil {
+			return nil, fmt.Errorf("encoding inventory movement: %w", err)
+		}
+		movements = append(movements, encoded)
+	}
+
+	return &productionhandler.BatchUsageResponse{UsageUUID: resp.UsageUUID, Movements: movements}, nil
+}
+
+// ListLabelTemplates returns the label templates of one subject.
+func (i *Inventory) ListLabelTemplates(ctx context.Context, subject string) ([]productionhandler.LabelTemplate, error) {
+	var filter *string
+	if subject != "" {
+		filter = &subject
+	}
+
+	templates, err := i.db.ListLabelTemplates(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("listing label templates: %w", err)
+	}
+
+	resp := inventorydto.NewLabelTemplatesResponse(templates)
+	result := make([]productionhandler.LabelTemplate, 0, len(resp))
+	for _, template := range resp {
+		result = append(result, productionhandler.LabelTemplate{
+			UUID:      template.UUID,
+			Name:      template.Name,
+			Subject:   template.Subject,
+			IsDefault: template.IsDefault,
+			Template:  template.Template,
+		})
+	}
+	return result, nil
+}
+
+// ScanInventory resolves a scanned code against Inventory's lot codes, item
+// identifiers, keg serials and UUIDs.
+func (i *Inventory) ScanInventory(ctx context.Context, code string) ([]productionhandler.ScanMatch, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, errors.New("code is required")
+	}
+
+	matches, err := inventoryhandler.ResolveScannedCode(ctx, i.db, code)
+	if err != nil {
+		return nil, fmt.Errorf("resolving scanned code: %w", err)
+	}
+
+	result := make([]productionhandler.ScanMatch, 0, len(matches))
+	for _, match := range matches {
+		result = append(result, productionhandler.ScanMatch(match))
+	}
+	return result, nil
+}
+
+// ListIngredients returns the ingredient catalog.
+func (i *Inventory) ListIngredients(ctx context.Context) ([]productionhandler.InventoryIngredient, error) {
+	ingredients, err := i.db.ListIngredients(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing ingredients: %w", err)
+	}
+
+	result := make([]productionhandler.InventoryIngredient, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		result = append(result, productionhandler.InventoryIngredient{
+			UUID:     ingredient.UUID.String(),
+			Name:     ingredient.Name,
+			Category: ingredient.Category,
+		})
+	}
+	return result, nil
+}
+
+// ListReorderPolicies returns every reorder policy.
+func (i *Inventory) ListReorderPolicies(ctx context.Context) ([]productionhandler.ReorderPolicy, error) {
+	policies, err := i.db.ListReorderPolicies(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing reorder policies: %w", err)
+	}
+
+	result := make([]productionhandler.ReorderPolicy, 0, len(policies))
+	for _, policy := range policies {
+		resp := inventorydto.NewReorderPolicyResponse(policy)
+		result = append(result, productionhandler.ReorderPolicy{
+			UUID:                  resp.UUID,
+			IngredientUUID:        resp.IngredientUUID,
+			StockLocationUUID:     resp.StockLocationUUID,
+			PreferredSupplierUUID: resp.PreferredSupplierUUID,
+			LeadTimeDays:          resp.LeadTimeDays,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,114 @@
+package inprocess
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	inventorystorage "github.com/brewpipes/brewpipes/service/inventory/storage"
+	productionhandler "github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/gofrs/uuid/v5"
+)
+
+// batchUsageStore records the storage request CreateBatchUsage receives.
+// Other InventoryStore methods are not used by these tests.
+type batchUsageStore struct {
+	InventoryStore
+	got    *inventorystorage.BatchUsageRequest
+	result inventorystorage.BatchUsageResult
+	err    error
+}
+
+func (s *batchUsageStore) CreateBatchUsage(_ context.Context, req inventorystorage.BatchUsageRequest) (inventorystorage.BatchUsageResult, error) {
+	s.got = &req
+	return s.result, s.err
+}
+
+func TestInventoryCreateBatchUsage(t *testing.T) {
+	productionUUID := uuid.Must(uuid.NewV4())
+	validRequest := func() productionhandler.BatchUsageRequest {
+		return productionhandler.BatchUsageRequest{
+			ProductionRefUUID: productionUUID.String(),
+			UsedAt:            "2026-03-01T09:00:00Z",
+			Picks: []productionhandler.BatchUsagePick{{
+				IngredientLotUUID: uuid.Must(uuid.NewV4()).String(),
+				StockLocationUUID: uuid.Must(uuid.NewV4()).String(),
+				Amount:            500,
+				AmountUnit:        "g",
+			}},
+		}
+	}
+
+	t.Run("creates usage", func(t *testing.T) {
+		usageUUID := uuid.Must(uuid.NewV4())
+		movementUUID := uuid.Must(uuid.NewV4())
+		store := &batchUsageStore{}
+		store.result.Usage.UUID = usageUUID
+		store.result.Movements = []inventorystorage.InventoryMovement{{Direction: "out", Reason: "use", Amount: 500, AmountUnit: "g"}}
+		store.result.Movements[0].UUID = movementUUID
+
+		resp, err := NewInventory(store).CreateBatchUsage(context.Background(), validRequest())
+		if err != nil {
+			t.Fatalf("CreateBatchUsage: %v", err)
+		}
+
+		if store.got.Reason != inventorystorage.MovementReasonUse {
+			t.Errorf("reason = %q, want %q", store.got.Reason, inventorystorage.MovementReasonUse)
+		}
+		if store.got.ProductionRefUUID == nil || *store.got.ProductionRefUUID != productionUUID {
+			t.Errorf("production ref = %v, want %s", store.got.ProductionRefUUID, productionUUID)
+		}
+		if resp.UsageUUID != usageUUID.String() {
+			t.Errorf("usage uuid = %q, want %q", resp.UsageUUID, usageUUID)
+		}
+		if len(resp.Movements) != 1 {
+			t.Fatalf("movements = %d, want 1", len(resp.Movements))
+		}
+		var movement struct {
+			UUID   string `json:"uuid"`
+			Amount int64  `json:"amount"`
+		}
+		if err := json.Unmarshal(resp.Movements[0], &movement); err != nil {
+			t.Fatalf("decoding movement: %v", err)
+		}
+		if movement.UUID != movementUUID.String() || movement.Amount != 500 {
+			t.Errorf("movement = %+v", movement)
+		}
+	})
+
+	t.Run("invalid request rejected", func(t *testing.T) {
+		store := &batchUsageStore{}
+		req := validRequest()
+		req.Picks = nil
+
+		_, err := NewInventory(store).CreateBatchUsage(context.Background(), req)
+		var rejected *productionhandler.InventoryRejectedError
+		if !errors.As(err, &rejected) {
+			t.Fatalf("error = %v, want InventoryRejectedError", err)
+		}
+		if store.got != nil {
+			t.Error("storage called for an invalid request")
+		}
+	})
+
+	t.Run("insufficient stock rejected", func(t *testing.T) {
+		store := &batchUsageStore{err: &inventorystorage.ErrBatchUsageValidation{Message: "insufficient stock"}}
+
+		_, err := NewInventory(store).CreateBatchUsage(context.Background(), validRequest())
+		var rejected *productionhandler.InventoryRejectedError
+		if !errors.As(err, &rejected) || rejected.Message != "insufficient stock" {
+			t.Fatalf("error = %v, want InventoryRejectedError with the storage message", err)
+		}
+	})
+
+	t.Run("storage failure not rejected", func(t *testing.T) {
+		store := &batchUsageStore{err: errors.New("connection reset")}
+
+		_, err := NewInventory(store).CreateBatchUsage(context.Background(), validRequest())
+		var rejected *productionhandler.InventoryRejectedError
+		if err == nil || errors.As(err, &rejected) {
+			t.Fatalf("error = %v, want a plain error", err)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package inprocess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service"
+	procurementhandler "github.com/brewpipes/brewpipes/service/procurement/handler"
+	procurementdto "github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	procurementstorage "github.com/brewpipes/brewpipes/service/procurement/storage"
+	productionhandler "github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/gofrs/uuid/v5"
+)
+
+// ProcurementStore defines the Procurement storage methods production's
+// calls are served from.
+type ProcurementStore interface {
+	procurementhandler.PurchaseOrderLineBatchLookupStore
+	procurementhandler.ItemSupplyStore
+	procurementhandler.DraftPurchaseOrderStore
+}
+
+// Procurement implements production's handler.ProcurementAPI with direct
+// calls to the Procurement storage.
+type Procurement struct {
+	db ProcurementStore
+}
+
+var _ productionhandler.ProcurementAPI = (*Procurement)(nil)
+
+// NewProcurement creates a Procurement backed by db.
+func NewProcurement(db ProcurementStore) *Procurement {
+	return &Procurement{db: db}
+}
+
+// BatchLookupPOLines returns the landed cost of each purchase order line,
+// as [POST /purchase-order-lines/batch-lookup] does.
+func (p *Procurement) BatchLookupPOLines(ctx context.Context, uuids []string) ([]productionhandler.PurchaseOrderLineCost, error) {
+	if err := (procurementdto.BatchLookupPurchaseOrderLinesRequest{UUIDs: uuids}).Validate(); err != nil {
+		return nil, err
+	}
+
+	lines, err := procurementhandler.LookupLandedPurchaseOrderLines(ctx, p.db, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]productionhandler.PurchaseOrderLineCost, 0, len(lines))
+	for _, line := range lines {
+		cost := productionhandler.PurchaseOrderLineCost{
+			UUID:              line.UUID,
+			UnitCostCents:     line.UnitCostCents,
+			Quantity:          line.Quantity,
+			QuantityUnit:      line.QuantityUnit,
+			Currency:          line.Currency,
+			FeeAllocatedCents: line.FeeAllocatedCents,
+			BaseCurrency:      line.BaseCurrency,
+		}
+		if line.ExchangeRate != nil {
+			cost.ExchangeRate = &productionhandler.PurchaseOrderExchangeRate{
+				Rate:   line.ExchangeRate.Rate,
+				Locked: line.ExchangeRate.Locked,
+			}
+		}
+		result = append(result, cost)
+	}
+	return result, nil
+}
+
+// LookupItemSupply returns the open order lines, last-used supplier and
+// supplier catalog entries of each inventory item.
+func (p *Procurement) LookupItemSupply(ctx context.Context, itemUUIDs []string) (*productionhandler.ItemSupply, error) {
+	if err := (procurementdto.ItemSupplyLookupRequest{InventoryItemUUIDs: itemUUIDs}).Validate(); err != nil {
+		return nil, err
+	}
+
+	lines, err := p.db.ListOpenPurchaseOrderLinesByItems(ctx, itemUUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("listing open purchase order lines: %w", err)
+	}
+	suppliers, err := p.db.ListLatestItemSuppliers(ctx, itemUUIDs)
+	if err != nil {
+		return nil, fmt.Errorf("listing item suppliers: %w", err)
+	}
+
+	catalog, err := p.db.ListSupplierItems(ctx, procurementstorage.SupplierItemFilter{InventoryItemUUIDs: itemUUIDs})
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier items: %w", err)
+	}
+
+	resp := procurementdto.NewItemSupplyLookupResponse(lines, suppliers, catalog)
+	result := &productionhandler.ItemSupply{
+		OpenLines:    make([]productionhandler.OpenPurchaseOrderLine, 0, len(resp.OpenLines)),
+		Suppliers:    make([]productionhandler.ItemSupplier, 0, len(resp.Suppliers)),
+		CatalogItems: make([]productionhandler.ItemCatalogEntry, 0, len(resp.CatalogItems)),
+	}
+	for _, line := range resp.OpenLines {
+		result.OpenLines = append(result.OpenLines, productionhandler.OpenPurchaseOrderLine(line))
+	}
+	for _, supplier := range resp.Suppliers {
+		result.Suppliers = append(result.Suppliers, productionhandler.ItemSupplier(supplier))
+	}
+	for _, entry := range resp.CatalogItems {
+		result.CatalogItems = append(result.CatalogItems, productionhandler.ItemCatalogEntry(entry))
+	}
+	return result, nil
+}
+
+// CreateDraftPurchaseOrder creates a draft purchase order and its lines, as
+// [POST /purchase-orders/drafts] does.
+func (p *Procurement) CreateDraftPurchaseOrder(ctx context.Context, req productionhandler.DraftPurchaseOrderRequest) (*productionhandler.DraftPurchaseOrder, error) {
+	draftReq := procurementdto.CreateDraftPurchaseOrderRequest{
+		SupplierUUID: req.SupplierUUID,
+		ExpectedAt:   req.ExpectedAt,
+		Notes:        req.Notes,
+		Lines:        make([]procurementdto.CreateDraftPurchaseOrderLine, len(req.Lines)),
+	}
+	for i, line := range req.Lines {
+		draftReq.Lines[i] = procurementdto.CreateDraftPurchaseOrderLine(line)
+	}
+	if err := draftReq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid draft purchase order: %w", err)
+	}
+
+	supplier, err := p.db.GetSupplierByUUID(ctx, req.SupplierUUID)
+	if errors.Is(err, service.ErrNotFound) {
+		return nil, fmt.Errorf("supplier %s not found", req.SupplierUUID)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting supplier: %w", err)
+	}
+
+	lines := make([]procurementstorage.PurchaseOrderLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		var inventoryItemUUID *uuid.UUID
+		if line.InventoryItemUUID != nil {
+			parsed, err := uuid.FromString(*line.InventoryItemUUID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid inventory_item_uuid %q", *line.InventoryItemUUID)
+			}
+			inventoryItemUUID = &parsed
+		}
+		lines = append(lines, procurementstorage.PurchaseOrderLine{
+			ItemType:          line.ItemType,
+			ItemName:          line.ItemName,
+			InventoryItemUUID: inventoryItemUUID,
+			Quantity:          line.Quantity,
+			QuantityUnit:      line.QuantityUnit,
+			UnitCostCents:     line.UnitCostCents,
+			Currency:          line.Currency,
+		})
+	}
+
+	created, err := p.db.CreateDraftPurchaseOrder(ctx, procurementstorage.PurchaseOrder{
+		SupplierID: supplier.ID,
+		ExpectedAt: req.ExpectedAt,
+		Notes:      req.Notes,
+	}, lines)
+	if err != nil {
+		return nil, fmt.Errorf("creating draft purchase order: %w", err)
+	}
+
+	order := procurementdto.NewPurchaseOrderResponse(created)
+	return &productionhandler.DraftPurchaseOrder{
+		UUID:         order.UUID,
+		SupplierUUID: order.SupplierUUID,
+		OrderNumber:  order.OrderNumber,
+		Status:       order.Status,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package label
+
+import "fmt"
+
+// code128Patterns holds the bar and space widths, in modules, of every Code
+// 128 symbol value. Each pattern starts with a bar and is 11 modules wide;
+// the stop pattern has a trailing bar and is 13 modules wide.
+var code128Patterns = [...]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232",
+}
+
+const (
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = "2331112"
+)
+
+// code128Modules encodes data as Code 128 and returns the symbol as a run of
+// modules, true for bar. Quiet zones are not included. Strings of an even
+// number of digits use code set C; everything else uses code set B, which
+// covers printable ASCII.
+func code128Modules(data string) ([]bool, error) {
+	if data == "" {
+		return nil, fmt.Errorf("code128: nothing to encode")
+	}
+
+	var values []int
+	if isCode128C(data) {
+		values = append(values, code128StartC)
+		for i := 0; i < len(data); i += 2 {
+			values = append(values, int(data[i]-'0')*10+int(data[i+1]-'0'))
+		}
+	} else {
+		values = append(values, code128StartB)
+		for _, r := range data {
+			if r < 32 || r > 126 {
+				return nil, fmt.Errorf("code128: cannot encode %q", r)
+			}
+			values = append(values, int(r)-32)
+		}
+	}
+
+	check := values[0]
+	for i, v := range values[1:] {
+		check += (i + 1) * v
+	}
+	values = append(values, check%103)
+
+	var modules []bool
+	appendPattern := func(pattern string) {
+		for i, w := range pattern {
+			for range int(w - '0') {
+				modules = append(modules, i%2 == 0)
+			}
+		}
+	}
+	for _, v := range values {
+		appendPattern(code128Patterns[v])
+	}
+	appendPattern(code128Stop)
+
+	return modules, nil
+}
+
+func isCode128C(data string) bool {
+	if len(data)%2 != 0 {
+		return false
+	}
+	for _, r := range data {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,184 @@
+// Package label renders printable labels as PDF for sheet and desktop
+// printers or as ZPL for Zebra thermal printers. A Template describes the
+// label size, which fields are printed and the barcode; a Label carries the
+// values for one labelled thing.
+package label
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Output formats.
+const (
+	FormatPDF = "pdf"
+	FormatZPL = "zpl"
+)
+
+// Barcode symbologies.
+const (
+	BarcodeCode128 = "code128"
+	BarcodeQR      = "qr"
+	BarcodeNone    = "none"
+)
+
+// What the barcode encodes: the human-readable code (a lot code or vessel
+// name, falling back to the UUID) or the UUID.
+const (
+	BarcodeContentCode = "code"
+	BarcodeContentUUID = "uuid"
+)
+
+// Default template values.
+const (
+	DefaultFontSizePt = 9
+	DefaultPrinterDPI = 203
+)
+
+// Template is the layout of a label. Fields lists the field keys to print in
+// order; the first is printed larger as the label title. Sheet, when set,
+// lays PDF labels out in a grid on each page instead of one label per page.
+type Template struct {
+	WidthMM        float64  `json:"width_mm"`
+	HeightMM       float64  `json:"height_mm"`
+	Fields         []string `json:"fields"`
+	Barcode        string   `json:"barcode"`
+	BarcodeContent string   `json:"barcode_content"`
+	FontSizePt     float64  `json:"font_size_pt"`
+	PrinterDPI     int      `json:"printer_dpi"`
+	Sheet          *Sheet   `json:"sheet,omitempty"`
+}
+
+// Sheet is a page of labels for sheet printers, filled row by row from the
+// top left.
+type Sheet struct {
+	PageWidthMM  float64 `json:"page_width_mm"`
+	PageHeightMM float64 `json:"page_height_mm"`
+	Columns      int     `json:"columns"`
+	Rows         int     `json:"rows"`
+	MarginMM     float64 `json:"margin_mm"`
+	GapMM        float64 `json:"gap_mm"`
+}
+
+// Validate checks the template against the field keys available for the
+// kind of label it prints.
+func (t Template) Validate(available []string) error {
+	if t.WidthMM < 10 || t.WidthMM > 300 || t.HeightMM < 10 || t.HeightMM > 300 {
+		return fmt.Errorf("width_mm and height_mm must be between 10 and 300")
+	}
+	if len(t.Fields) == 0 {
+		return fmt.Errorf("fields must not be empty")
+	}
+	for i, field := range t.Fields {
+		if !slices.Contains(available, field) {
+			return fmt.Errorf("fields[%d]: unknown field %q", i, field)
+		}
+		if slices.Contains(t.Fields[:i], field) {
+			return fmt.Errorf("fields[%d]: duplicate field %q", i, field)
+		}
+	}
+	switch t.Barcode {
+	case BarcodeCode128, BarcodeQR, BarcodeNone:
+	default:
+		return fmt.Errorf("barcode must be one of code128, qr, none")
+	}
+	switch t.BarcodeContent {
+	case BarcodeContentCode, BarcodeContentUUID:
+	default:
+		return fmt.Errorf("barcode_content must be one of code, uuid")
+	}
+	if t.FontSizePt < 4 || t.FontSizePt > 72 {
+		return fmt.Errorf("font_size_pt must be between 4 and 72")
+	}
+	switch t.PrinterDPI {
+	case 203, 300, 600:
+	default:
+		return fmt.Errorf("printer_dpi must be one of 203, 300, 600")
+	}
+
+	if s := t.Sheet; s != nil {
+		if s.Columns < 1 || s.Rows < 1 {
+			return fmt.Errorf("sheet columns and rows must be at least 1")
+		}
+		if s.MarginMM < 0 || s.GapMM < 0 {
+			return fmt.Errorf("sheet margin_mm and gap_mm must not be negative")
+		}
+		width := 2*s.MarginMM + float64(s.Columns)*t.WidthMM + float64(s.Columns-1)*s.GapMM
+		height := 2*s.MarginMM + float64(s.Rows)*t.HeightMM + float64(s.Rows-1)*s.GapMM
+		if width > s.PageWidthMM || height > s.PageHeightMM {
+			return fmt.Errorf("sheet of %dx%d labels does not fit on the page", s.Columns, s.Rows)
+		}
+	}
+	return nil
+}
+
+// Label holds the values for one label. Fields maps field keys to their
+// printed name and value; empty values are left off the label.
+type Label struct {
+	Code   string
+	UUID   string
+	Fields map[string]Field
+}
+
+// Field is one printed line of a label.
+type Field struct {
+	Name  string
+	Value string
+}
+
+// barcodeData returns what the template's barcode encodes for l.
+func (t Template) barcodeData(l Label) string {
+	if t.BarcodeContent == BarcodeContentCode && l.Code != "" {
+		return l.Code
+	}
+	return l.UUID
+}
+
+// Render renders labels in format, one after another.
+func Render(format string, t Template, labels []Label) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return renderPDF(t, labels)
+	case FormatZPL:
+		return renderZPL(t, labels)
+	default:
+		return nil, fmt.Errorf("unsupported label format %q", format)
+	}
+}
+
+// MaxCopies is the most copies of a label rendered in one request.
+const MaxCopies = 100
+
+// ParseFormat parses a format query parameter, defaulting to PDF.
+func ParseFormat(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "", FormatPDF:
+		return FormatPDF, nil
+	case FormatZPL:
+		return FormatZPL, nil
+	default:
+		return "", fmt.Errorf("format must be one of pdf, zpl")
+	}
+}
+
+// ParseCopies parses a copies query parameter, defaulting to 1.
+func ParseCopies(value string) (int, error) {
+	if value == "" {
+		return 1, nil
+	}
+	copies, err := strconv.Atoi(value)
+	if err != nil || copies < 1 || copies > MaxCopies {
+		return 0, fmt.Errorf("copies must be between 1 and %d", MaxCopies)
+	}
+	return copies, nil
+}
+
+// ContentType returns the media type of a rendered format.
+func ContentType(format string) string {
+	if format == FormatZPL {
+		return "application/zpl"
+	}
+	return "application/pdf"
+}
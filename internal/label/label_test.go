@@ -0,0 +1,323 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCode128Patterns(t *testing.T) {
+	seen := make(map[string]bool)
+	for v, pattern := range code128Patterns {
+		width, bars := 0, 0
+		for i, r := range pattern {
+			width += int(r - '0')
+			if i%2 == 0 {
+				bars += int(r - '0')
+			}
+		}
+		if width != 11 {
+			t.Errorf("value %d: pattern %s is %d modules wide", v, pattern, width)
+		}
+		if bars%2 != 0 {
+			t.Errorf("value %d: pattern %s has an odd number of bar modules", v, pattern)
+		}
+		if seen[pattern] {
+			t.Errorf("value %d: duplicate pattern %s", v, pattern)
+		}
+		seen[pattern] = true
+	}
+}
+
+func TestCode128Modules(t *testing.T) {
+	t.Run("code set B", func(t *testing.T) {
+		modules, err := code128Modules("IL-2026-001")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Start, 11 characters and the check symbol, then the stop pattern.
+		if len(modules) != 13*11+13 {
+			t.Errorf("expected %d modules, got %d", 13*11+13, len(modules))
+		}
+	})
+
+	t.Run("code set C for even digits", func(t *testing.T) {
+		modules, err := code128Modules("20260315")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(modules) != 6*11+13 {
+			t.Errorf("expected %d modules, got %d", 6*11+13, len(modules))
+		}
+	})
+
+	t.Run("rejects non-ASCII", func(t *testing.T) {
+		if _, err := code128Modules("Märzen"); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestRSEncode(t *testing.T) {
+	// HELLO WORLD at version 1-Q, from the worked example in the Thonky QR
+	// code tutorial.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236}
+	want := []byte{168, 72, 22, 82, 217, 54, 156, 0, 46, 15, 180, 122, 16}
+
+	if got := rsEncode(data, 13); !bytes.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestEncodeQR(t *testing.T) {
+	for _, data := range []string{
+		"5f0e8400-e29b-41d4-a716-446655440000",
+		"IL-2026-001",
+		strings.Repeat("brewpipes ", 21),
+	} {
+		t.Run(strconv.Itoa(len(data)), func(t *testing.T) {
+			qr, err := encodeQR([]byte(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := decodeQR(qr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != data {
+				t.Errorf("expected %q, got %q", data, got)
+			}
+		})
+	}
+
+	if _, err := encodeQR(bytes.Repeat([]byte("x"), 214)); err == nil {
+		t.Error("expected an error for data longer than version 10")
+	}
+}
+
+// decodeQR reads a symbol back: format bits, unmasking, the codeword zigzag,
+// de-interleaving and the byte mode segment. It checks the error correction
+// codewords rather than correcting errors.
+func decodeQR(qr qrCode) (string, error) {
+	version := (qr.size - 17) / 4
+	q := newQRBuilder(version)
+	q.drawFunctionPatterns()
+
+	format := 0
+	for i := 0; i <= 5; i++ {
+		if qr.modules[i][8] {
+			format |= 1 << i
+		}
+	}
+	for i, pos := range [][2]int{{8, 7}, {8, 8}, {7, 8}} {
+		if qr.modules[pos[1]][pos[0]] {
+			format |= 1 << (6 + i)
+		}
+	}
+	for i := 9; i < 15; i++ {
+		if qr.modules[8][14-i] {
+			format |= 1 << i
+		}
+	}
+	format ^= 0x5412
+	if level := format >> 13; level != qrFormatLevelM {
+		return "", fmt.Errorf("unexpected error correction level %d", level)
+	}
+
+	q.modules = qr.modules
+	q.applyMask(format >> 10 & 7)
+	defer q.applyMask(format >> 10 & 7)
+
+	v := qrVersions[version]
+	total := v.dataCodewords() + len(v.blocks)*v.ecPerBlock
+	codewords := make([]byte, total)
+	i := 0
+	for right := q.size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := range q.size {
+			y := vert
+			if upward {
+				y = q.size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if q.isFunction[y][x] || i >= total*8 {
+					continue
+				}
+				if q.modules[y][x] {
+					codewords[i>>3] |= 1 << (7 - i&7)
+				}
+				i++
+			}
+		}
+	}
+
+	blocks := make([][]byte, len(v.blocks))
+	k := 0
+	for i := range v.blocks[len(v.blocks)-1] {
+		for b, n := range v.blocks {
+			if i < n {
+				blocks[b] = append(blocks[b], codewords[k])
+				k++
+			}
+		}
+	}
+	var payload []byte
+	for b, block := range blocks {
+		var ec []byte
+		for i := range v.ecPerBlock {
+			ec = append(ec, codewords[k+i*len(v.blocks)+b])
+		}
+		if !bytes.Equal(ec, rsEncode(block, v.ecPerBlock)) {
+			return "", fmt.Errorf("block %d: error correction mismatch", b)
+		}
+		payload = append(payload, block...)
+	}
+
+	bit := 0
+	read := func(n int) int {
+		value := 0
+		for range n {
+			value = value<<1 | int(payload[bit>>3]>>(7-bit&7)&1)
+			bit++
+		}
+		return value
+	}
+	if mode := read(4); mode != 0b0100 {
+		return "", fmt.Errorf("unexpected mode %04b", mode)
+	}
+	n := read(qrCountBits(version))
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(read(8))
+	}
+	return string(data), nil
+}
+
+func testTemplate(barcode string) Template {
+	return Template{
+		WidthMM:        100,
+		HeightMM:       50,
+		Fields:         []string{"name", "lot_code", "best_by"},
+		Barcode:        barcode,
+		BarcodeContent: BarcodeContentCode,
+		FontSizePt:     DefaultFontSizePt,
+		PrinterDPI:     DefaultPrinterDPI,
+	}
+}
+
+func testLabel() Label {
+	return Label{
+		Code: "IL-2026-001",
+		UUID: "5f0e8400-e29b-41d4-a716-446655440000",
+		Fields: map[string]Field{
+			"name":     {Name: "Ingredient", Value: "Pale (Maris Otter)"},
+			"lot_code": {Name: "Lot", Value: "IL-2026-001"},
+		},
+	}
+}
+
+func TestTemplateValidate(t *testing.T) {
+	available := []string{"name", "lot_code", "best_by"}
+
+	if err := testTemplate(BarcodeQR).Validate(available); err != nil {
+		t.Errorf("expected valid template, got %v", err)
+	}
+
+	unknown := testTemplate(BarcodeQR)
+	unknown.Fields = []string{"name", "vessel"}
+	if err := unknown.Validate(available); err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+
+	sheet := testTemplate(BarcodeCode128)
+	sheet.Sheet = &Sheet{PageWidthMM: 210, PageHeightMM: 297, Columns: 2, Rows: 6, MarginMM: 5}
+	if err := sheet.Validate(available); err == nil {
+		t.Error("expected an error for a sheet that does not fit")
+	}
+	sheet.Sheet.Rows = 5
+	if err := sheet.Validate(available); err != nil {
+		t.Errorf("expected 2x5 sheet to fit, got %v", err)
+	}
+}
+
+func TestRenderPDF(t *testing.T) {
+	tmpl := testTemplate(BarcodeQR)
+	tmpl.Sheet = &Sheet{PageWidthMM: 210, PageHeightMM: 297, Columns: 2, Rows: 5, MarginMM: 5}
+	labels := slices.Repeat([]Label{testLabel()}, 11)
+
+	out, err := Render(FormatPDF, tmpl, labels)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.HasPrefix(out, []byte("%PDF-1.4")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+		t.Fatal("expected a complete PDF document")
+	}
+	if !bytes.Contains(out, []byte("/Count 2")) {
+		t.Error("expected 11 labels on two pages")
+	}
+	if !bytes.Contains(out, []byte(`(Pale \(Maris Otter\)) Tj`)) {
+		t.Error("expected escaped title text")
+	}
+
+	// Every cross-reference entry must point at its object.
+	xref := bytes.LastIndex(out, []byte("\nxref\n")) + 1
+	entries := regexp.MustCompile(`(\d{10}) 00000 n`).FindAllSubmatch(out[xref:], -1)
+	if len(entries) != 8 {
+		t.Fatalf("expected 8 objects, got %d", len(entries))
+	}
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		if want := fmt.Sprintf("%d 0 obj", i+1); !bytes.HasPrefix(out[offset:], []byte(want)) {
+			t.Errorf("object %d: offset %d does not point at %q", i+1, offset, want)
+		}
+	}
+	if !bytes.Contains(out, []byte(fmt.Sprintf("startxref\n%d\n", xref))) {
+		t.Error("expected startxref to point at the cross-reference table")
+	}
+}
+
+func TestRenderZPL(t *testing.T) {
+	l := testLabel()
+	l.Fields["lot_code"] = Field{Name: "Lot", Value: "A^B~C_D"}
+
+	t.Run("code128", func(t *testing.T) {
+		out, err := Render(FormatZPL, testTemplate(BarcodeCode128), []Label{l, l})
+		if err != nil {
+			t.Fatal(err)
+		}
+		zpl := string(out)
+		if strings.Count(zpl, "^XA") != 2 || strings.Count(zpl, "^XZ") != 2 {
+			t.Errorf("expected two label formats, got %s", zpl)
+		}
+		if !strings.Contains(zpl, "^PW799\n^LL400") {
+			t.Errorf("expected 100x50 mm at 203 dpi, got %s", zpl)
+		}
+		if !strings.Contains(zpl, "^FDLot: A_5EB_7EC_5FD^FS") {
+			t.Errorf("expected escaped field data, got %s", zpl)
+		}
+		if !strings.Contains(zpl, "^BCN,") || !strings.Contains(zpl, "^FDIL-2026-001^FS") {
+			t.Errorf("expected a Code 128 barcode of the lot code, got %s", zpl)
+		}
+	})
+
+	t.Run("qr of uuid", func(t *testing.T) {
+		tmpl := testTemplate(BarcodeQR)
+		tmpl.BarcodeContent = BarcodeContentUUID
+		out, err := Render(FormatZPL, tmpl, []Label{l})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(out), "^BQN,2,") || !strings.Contains(string(out), "^FDMA,"+l.UUID+"^FS") {
+			t.Errorf("expected a QR code of the UUID, got %s", out)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+package label
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	mmPerPt = 25.4 / 72
+	// Average glyph width of Helvetica and the Zebra scalable font, as a
+	// fraction of the font size, used to truncate text that would overflow.
+	avgGlyphWidth = 0.55
+	titleScale    = 1.3
+	lineSpacing   = 1.2
+)
+
+// box is a rectangle in millimetres from the top left of the label.
+type box struct {
+	x, y, w, h float64
+}
+
+// textLine is one line of text; y is the top of the line.
+type textLine struct {
+	x, y   float64
+	sizePt float64
+	bold   bool
+	text   string
+}
+
+// labelLayout is where everything on a label goes.
+type labelLayout struct {
+	lines   []textLine
+	barcode *box
+	data    string // barcode data
+	caption *textLine
+}
+
+// layoutLabel places the template's fields and barcode on a label. The first
+// field printed is the title. A QR code sits in the top right corner; a Code
+// 128 barcode spans the bottom with its data printed underneath. Lines that
+// do not fit are left off.
+func layoutLabel(t Template, l Label) labelLayout {
+	pad := math.Min(2, math.Min(t.WidthMM, t.HeightMM)*0.08)
+	text := box{x: pad, y: pad, w: t.WidthMM - 2*pad, h: t.HeightMM - 2*pad}
+
+	var layout labelLayout
+	if t.Barcode != BarcodeNone {
+		layout.data = t.barcodeData(l)
+	}
+	if layout.data != "" {
+		switch t.Barcode {
+		case BarcodeQR:
+			side := math.Min(text.h, text.w*0.45)
+			layout.barcode = &box{x: t.WidthMM - pad - side, y: pad, w: side, h: side}
+			text.w -= side + pad
+		case BarcodeCode128:
+			captionPt := t.FontSizePt * 0.8
+			captionMM := captionPt * mmPerPt * lineSpacing
+			height := math.Min(text.h, math.Max(8, text.h*0.35))
+			bars := box{x: pad, y: t.HeightMM - pad - height, w: text.w, h: height - captionMM}
+			layout.barcode = &bars
+			layout.caption = &textLine{x: pad, y: bars.y + bars.h, sizePt: captionPt, text: layout.data}
+			text.h -= height + pad
+		}
+	}
+
+	y := text.y
+	for _, key := range t.Fields {
+		field, ok := l.Fields[key]
+		if !ok || field.Value == "" {
+			continue
+		}
+		line := textLine{x: text.x, sizePt: t.FontSizePt, text: field.Name + ": " + field.Value}
+		if len(layout.lines) == 0 {
+			line.sizePt *= titleScale
+			line.bold = true
+			line.text = field.Value
+		}
+		height := line.sizePt * mmPerPt * lineSpacing
+		if y+height > text.y+text.h {
+			break
+		}
+		line.y = y
+		line.text = truncate(line.text, text.w, line.sizePt)
+		layout.lines = append(layout.lines, line)
+		y += height
+	}
+
+	return layout
+}
+
+// truncate shortens s to about the number of characters that fit in widthMM
+// at sizePt, ending it with "..." when cut.
+func truncate(s string, widthMM, sizePt float64) string {
+	s = strings.Join(strings.Fields(s), " ")
+	fit := int(widthMM / (sizePt * mmPerPt * avgGlyphWidth))
+	runes := []rune(s)
+	if len(runes) <= fit {
+		return s
+	}
+	if fit <= 3 {
+		return ""
+	}
+	return string(runes[:fit-3]) + "..."
+}
@@ -0,0 +1,174 @@
+package label
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const ptPerMM = 72 / 25.4
+
+// renderPDF draws labels into a PDF 1.4 document using the standard
+// Helvetica fonts, one label per page or a grid of labels per sheet.
+func renderPDF(t Template, labels []Label) ([]byte, error) {
+	pageW, pageH := t.WidthMM, t.HeightMM
+	perPage, columns := 1, 1
+	var margin, gap float64
+	if t.Sheet != nil {
+		pageW, pageH = t.Sheet.PageWidthMM, t.Sheet.PageHeightMM
+		perPage, columns = t.Sheet.Columns*t.Sheet.Rows, t.Sheet.Columns
+		margin, gap = t.Sheet.MarginMM, t.Sheet.GapMM
+	}
+
+	var pages []string
+	var page strings.Builder
+	for i, l := range labels {
+		slot := i % perPage
+		if slot == 0 && i > 0 {
+			pages = append(pages, page.String())
+			page.Reset()
+		}
+		originX := margin + float64(slot%columns)*(t.WidthMM+gap)
+		originY := margin + float64(slot/columns)*(t.HeightMM+gap)
+		if err := drawPDFLabel(&page, t, l, originX, originY, pageH); err != nil {
+			return nil, err
+		}
+	}
+	pages = append(pages, page.String())
+
+	return writePDF(pages, pageW*ptPerMM, pageH*ptPerMM), nil
+}
+
+func drawPDFLabel(w *strings.Builder, t Template, l Label, originX, originY, pageH float64) error {
+	layout := layoutLabel(t, l)
+
+	// rect fills a rectangle given in millimetres from the label's top left.
+	rect := func(x, y, width, height float64) {
+		fmt.Fprintf(w, "%s %s %s %s re\n",
+			pdfNum((originX+x)*ptPerMM), pdfNum((pageH-originY-y-height)*ptPerMM),
+			pdfNum(width*ptPerMM), pdfNum(height*ptPerMM))
+	}
+	text := func(line textLine) {
+		font := "F1"
+		if line.bold {
+			font = "F2"
+		}
+		baseline := line.y + line.sizePt*mmPerPt*0.9
+		fmt.Fprintf(w, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, pdfNum(line.sizePt),
+			pdfNum((originX+line.x)*ptPerMM), pdfNum((pageH-originY-baseline)*ptPerMM), pdfString(line.text))
+	}
+
+	for _, line := range layout.lines {
+		text(line)
+	}
+
+	if layout.barcode == nil {
+		return nil
+	}
+	b := *layout.barcode
+	switch t.Barcode {
+	case BarcodeCode128:
+		modules, err := code128Modules(layout.data)
+		if err != nil {
+			return err
+		}
+		// Ten module quiet zone on each side.
+		module := b.w / float64(len(modules)+20)
+		for start := 0; start < len(modules); start++ {
+			if !modules[start] {
+				continue
+			}
+			end := start
+			for end+1 < len(modules) && modules[end+1] {
+				end++
+			}
+			rect(b.x+float64(start+10)*module, b.y, float64(end-start+1)*module, b.h)
+			start = end
+		}
+		w.WriteString("f\n")
+		if layout.caption != nil {
+			text(*layout.caption)
+		}
+	case BarcodeQR:
+		qr, err := encodeQR([]byte(layout.data))
+		if err != nil {
+			return err
+		}
+		// Four module quiet zone on each side.
+		module := b.w / float64(qr.size+8)
+		for y, row := range qr.modules {
+			for x := 0; x < qr.size; x++ {
+				if !row[x] {
+					continue
+				}
+				end := x
+				for end+1 < qr.size && row[end+1] {
+					end++
+				}
+				rect(b.x+float64(x+4)*module, b.y+float64(y+4)*module, float64(end-x+1)*module, module)
+				x = end
+			}
+		}
+		w.WriteString("f\n")
+	}
+	return nil
+}
+
+// writePDF assembles the document: catalog, page tree, the two fonts, then a
+// page object and content stream for each page.
+func writePDF(pages []string, widthPt, heightPt float64) []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 5+2*i)
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			pdfNum(widthPt), pdfNum(heightPt), 6+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+func pdfNum(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// pdfString escapes s as a PDF literal string in WinAnsi encoding. Latin-1
+// characters are kept; anything else becomes "?".
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r >= 32 && r < 127:
+			b.WriteByte(byte(r))
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
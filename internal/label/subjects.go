@@ -0,0 +1,51 @@
+package label
+
+// Subjects are the kinds of things labels are printed for.
+const (
+	SubjectIngredientLot = "ingredient_lot"
+	SubjectBeerLot       = "beer_lot"
+	SubjectVessel        = "vessel"
+)
+
+// Fields available on each subject's labels, in their default order.
+var subjectFields = map[string][]string{
+	SubjectIngredientLot: {
+		"ingredient_name", "brewery_lot_code", "originator_lot_code", "originator_name",
+		"received_date", "received_amount", "best_by_date", "expires_date",
+	},
+	SubjectBeerLot: {
+		"lot_code", "package_format", "container", "volume_per_unit", "quantity",
+		"packaged_date", "best_by_date",
+	},
+	SubjectVessel: {
+		"vessel_name", "vessel_type", "capacity", "batch_short_name", "recipe_name",
+		"brew_date", "phase", "filled_date", "volume",
+	},
+}
+
+// SubjectFields returns the field keys available for a subject, or nil if
+// the subject is unknown.
+func SubjectFields(subject string) []string {
+	return subjectFields[subject]
+}
+
+// DefaultTemplate is the layout used for a subject that has no default
+// template: every field, with a Code 128 barcode of the lot code on 100 x 50
+// mm lot labels and a QR code of the vessel UUID on A6 tank cards.
+func DefaultTemplate(subject string) Template {
+	t := Template{
+		WidthMM:        100,
+		HeightMM:       50,
+		Fields:         SubjectFields(subject),
+		Barcode:        BarcodeCode128,
+		BarcodeContent: BarcodeContentCode,
+		FontSizePt:     DefaultFontSizePt,
+		PrinterDPI:     DefaultPrinterDPI,
+	}
+	if subject == SubjectVessel {
+		t.WidthMM, t.HeightMM = 148, 105
+		t.Barcode, t.BarcodeContent = BarcodeQR, BarcodeContentUUID
+		t.FontSizePt = 14
+	}
+	return t
+}
@@ -0,0 +1,59 @@
+package label
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// renderZPL writes one ZPL format per label. Barcodes use the printer's own
+// Code 128 (automatic subset) and QR (level M) commands. Field data is
+// written with ^FH so that ^ and ~ in values cannot end the field.
+func renderZPL(t Template, labels []Label) ([]byte, error) {
+	dpmm := float64(t.PrinterDPI) / 25.4
+	dots := func(mm float64) int { return int(math.Round(mm * dpmm)) }
+
+	var b strings.Builder
+	for _, l := range labels {
+		layout := layoutLabel(t, l)
+
+		b.WriteString("^XA\n^CI28\n")
+		fmt.Fprintf(&b, "^PW%d\n^LL%d\n", dots(t.WidthMM), dots(t.HeightMM))
+
+		for _, line := range layout.lines {
+			height := dots(line.sizePt * mmPerPt)
+			fmt.Fprintf(&b, "^FO%d,%d^A0N,%d,%d^FH^FD%s^FS\n", dots(line.x), dots(line.y), height, height, zplEscape(line.text))
+		}
+
+		if layout.barcode != nil {
+			bc := *layout.barcode
+			switch t.Barcode {
+			case BarcodeCode128:
+				modules, err := code128Modules(layout.data)
+				if err != nil {
+					return nil, err
+				}
+				module := max(1, dots(bc.w)/(len(modules)+20))
+				// The printer writes the interpretation line into the
+				// caption space under the bars.
+				fmt.Fprintf(&b, "^FO%d,%d^BY%d^BCN,%d,Y,N,N,A^FH^FD%s^FS\n",
+					dots(bc.x)+10*module, dots(bc.y), module, dots(bc.h), zplEscape(layout.data))
+			case BarcodeQR:
+				qr, err := encodeQR([]byte(layout.data))
+				if err != nil {
+					return nil, err
+				}
+				magnification := min(10, max(1, dots(bc.w)/(qr.size+8)))
+				fmt.Fprintf(&b, "^FO%d,%d^BQN,2,%d^FH^FDMA,%s^FS\n", dots(bc.x), dots(bc.y), magnification, zplEscape(layout.data))
+			}
+		}
+
+		b.WriteString("^XZ\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// zplEscape hex-escapes the ^FH indicator and the ZPL command prefixes.
+func zplEscape(s string) string {
+	return strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E").Replace(s)
+}
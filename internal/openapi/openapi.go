@@ -0,0 +1,252 @@
+// Package openapi validates requests against the OpenAPI document each
+// service ships as api.yaml, so a request that does not match the spec is
+// rejected before it reaches a handler. Validation runs after a route's
+// authentication, so only authenticated callers see why a request failed.
+//
+// Only the shape of a request is checked: path and query parameters, and
+// the JSON body's types and required properties. Handlers still apply their
+// own rules, such as allowed values and cross-field checks. Multipart
+// uploads are left to the handlers, which read them with their own size
+// limits.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+)
+
+// MaxBodySize is the largest JSON request body the middleware reads. It
+// matches the largest upload a handler accepts, a migration plan.
+const MaxBodySize = 20 << 20
+
+// UUIDPattern is the pattern api.yaml files give UUID parameters and fields.
+const UUIDPattern = "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+
+// ErrorPrefix starts the body of every response the middleware rejects a
+// request with.
+const ErrorPrefix = "invalid request: "
+
+// Spec is a loaded and validated OpenAPI document.
+type Spec struct {
+	doc *openapi3.T
+}
+
+// Load parses and validates an OpenAPI document.
+func Load(data []byte) (*Spec, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("validating OpenAPI document: %w", err)
+	}
+	return &Spec{doc: doc}, nil
+}
+
+// MustLoad is like Load but panics on error. It is meant for documents
+// embedded in the binary, which are checked by the contract tests.
+func MustLoad(data []byte) *Spec {
+	spec, err := Load(data)
+	if err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+// Operation describes one documented operation.
+type Operation struct {
+	Method    string // upper case, e.g. "GET"
+	Path      string // templated as in HTTPRoute, e.g. "/batches/{uuid}"
+	Operation *openapi3.Operation
+}
+
+// Operations returns every operation the document describes.
+func (s *Spec) Operations() []Operation {
+	var ops []Operation
+	for _, path := range s.doc.Paths.InMatchingOrder() {
+		item := s.doc.Paths.Value(path)
+		for method, op := range item.Operations() {
+			ops = append(ops, Operation{Method: method, Path: path, Operation: op})
+		}
+	}
+	return ops
+}
+
+// Find returns the operation documented for method and path, or nil.
+func (s *Spec) Find(method, path string) *openapi3.Operation {
+	item := s.doc.Paths.Value(path)
+	if item == nil {
+		return nil
+	}
+	return item.GetOperation(strings.ToUpper(method))
+}
+
+// Routes returns routes with each handler wrapped in request validation
+// against the operation documented for its method and path. A route's Auth
+// is left in place to run first, so unauthenticated requests are refused
+// before their shape is checked. Routes the document does not describe are
+// returned unchanged.
+func (s *Spec) Routes(routes []service.HTTPRoute) []service.HTTPRoute {
+	wrapped := make([]service.HTTPRoute, len(routes))
+	for i, route := range routes {
+		wrapped[i] = route
+		if handler := s.Validate(route.Method, route.Path, route.Handler); handler != nil {
+			wrapped[i].Handler = handler
+		}
+	}
+	return wrapped
+}
+
+// Validate wraps next in validation of requests against the operation
+// documented for method and path. It returns nil if there is none.
+func (s *Spec) Validate(method, path string, next http.Handler) http.Handler {
+	item := s.doc.Paths.Value(path)
+	if item == nil {
+		return nil
+	}
+	op := item.GetOperation(strings.ToUpper(method))
+	if op == nil {
+		return nil
+	}
+
+	route := &routers.Route{
+		Spec:      s.doc,
+		Path:      path,
+		PathItem:  item,
+		Method:    strings.ToUpper(method),
+		Operation: op,
+	}
+	params := append(openapi3.Parameters{}, item.Parameters...)
+	params = append(params, op.Parameters...)
+	var body *openapi3.RequestBody
+	if op.RequestBody != nil {
+		body = op.RequestBody.Value
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := validateParams(r, route, params); err != nil {
+			http.Error(w, ErrorPrefix+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if body != nil {
+			if err := validateBody(w, r, body); err != nil {
+				var maxErr *http.MaxBytesError
+				if errors.As(err, &maxErr) {
+					http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, ErrorPrefix+err.Error(), http.StatusBadRequest)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func validateParams(r *http.Request, route *routers.Route, params openapi3.Parameters) error {
+	if len(params) == 0 {
+		return nil
+	}
+
+	pathParams := make(map[string]string)
+	for _, ref := range params {
+		if p := ref.Value; p.In == openapi3.ParameterInPath {
+			pathParams[p.Name] = r.PathValue(p.Name)
+		}
+	}
+	input := &openapi3filter.RequestValidationInput{
+		Request:    r,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+	for _, ref := range params {
+		p := ref.Value
+		if err := openapi3filter.ValidateParameter(r.Context(), input, p); err != nil {
+			return fmt.Errorf("%s parameter %q: %s", p.In, p.Name, reason(err))
+		}
+	}
+	return nil
+}
+
+// validateBody checks a JSON body against its schema and puts the body back
+// for the handler. Requests are treated as JSON whatever their Content-Type,
+// as the handlers decode them.
+func validateBody(w http.ResponseWriter, r *http.Request, body *openapi3.RequestBody) error {
+	media := body.Content.Get("application/json")
+	if media == nil || media.Schema == nil {
+		return nil
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodySize))
+	if err != nil {
+		return err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		if body.Required {
+			return errors.New("request body is required")
+		}
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return errors.New("request body is not valid JSON")
+	}
+	if err := media.Schema.Value.VisitJSON(value,
+		openapi3.VisitAsRequest(),
+		openapi3.EnableFormatValidation(),
+	); err != nil {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) {
+			if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+				return fmt.Errorf("%s: %s", strings.Join(pointer, "."), schemaReason(schemaErr))
+			}
+			return errors.New(schemaReason(schemaErr))
+		}
+		return fmt.Errorf("request body: %w", err)
+	}
+	return nil
+}
+
+// reason describes a parameter validation error without repeating the
+// parameter or echoing the schema.
+func reason(err error) string {
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return schemaReason(schemaErr)
+	}
+	var reqErr *openapi3filter.RequestError
+	if errors.As(err, &reqErr) {
+		if errors.Is(reqErr.Err, openapi3filter.ErrInvalidRequired) {
+			return "is required"
+		}
+		if reqErr.Err != nil {
+			return reqErr.Err.Error()
+		}
+		return reqErr.Reason
+	}
+	return err.Error()
+}
+
+func schemaReason(err *openapi3.SchemaError) string {
+	if err.SchemaField == "pattern" && err.Schema != nil && err.Schema.Pattern == UUIDPattern {
+		return "must be a valid UUID"
+	}
+	return err.Reason
+}
@@ -0,0 +1,306 @@
+package openapi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service"
+)
+
+const testSpec = `
+openapi: 3.0.3
+info:
+  title: Test
+  version: 0.1.0
+paths:
+  /widgets:
+    get:
+      parameters:
+        - in: query
+          name: owner_uuid
+          required: true
+          schema:
+            $ref: "#/components/schemas/UUID"
+        - in: query
+          name: limit
+          schema:
+            type: integer
+            minimum: 1
+      responses:
+        "200":
+          description: OK
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/CreateWidgetRequest"
+      responses:
+        "201":
+          description: Created
+  /widgets/{uuid}:
+    get:
+      parameters:
+        - in: path
+          name: uuid
+          required: true
+          schema:
+            $ref: "#/components/schemas/UUID"
+      responses:
+        "200":
+          description: OK
+components:
+  schemas:
+    UUID:
+      type: string
+      pattern: "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+    CreateWidgetRequest:
+      type: object
+      required:
+        - name
+        - owner_uuid
+      properties:
+        name:
+          type: string
+        owner_uuid:
+          $ref: "#/components/schemas/UUID"
+        weight:
+          type: number
+          nullable: true
+`
+
+const testUUID = "9b2f5c1e-0d4a-4f6b-8c3e-1a2b3c4d5e6f"
+
+func newTestMux(t *testing.T) (*http.ServeMux, *string) {
+	t.Helper()
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	var received string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	})
+	routes := spec.Routes([]service.HTTPRoute{
+		{Method: http.MethodGet, Path: "/widgets", Handler: handler},
+		{Method: http.MethodPost, Path: "/widgets", Handler: handler},
+		{Method: http.MethodGet, Path: "/widgets/{uuid}", Handler: handler},
+		{Method: http.MethodDelete, Path: "/widgets/{uuid}", Handler: handler},
+	})
+
+	mux := http.NewServeMux()
+	for _, route := range routes {
+		mux.Handle(route.Method+" "+route.Path, route.Handler)
+	}
+	return mux, &received
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantError  string
+	}{
+		{
+			name:       "valid query",
+			method:     http.MethodGet,
+			target:     "/widgets?owner_uuid=" + testUUID + "&limit=5",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing required query parameter",
+			method:     http.MethodGet,
+			target:     "/widgets",
+			wantStatus: http.StatusBadRequest,
+			wantError:  `query parameter "owner_uuid": is required`,
+		},
+		{
+			name:       "malformed UUID query parameter",
+			method:     http.MethodGet,
+			target:     "/widgets?owner_uuid=abc",
+			wantStatus: http.StatusBadRequest,
+			wantError:  `query parameter "owner_uuid": must be a valid UUID`,
+		},
+		{
+			name:       "query parameter below minimum",
+			method:     http.MethodGet,
+			target:     "/widgets?owner_uuid=" + testUUID + "&limit=0",
+			wantStatus: http.StatusBadRequest,
+			wantError:  `query parameter "limit"`,
+		},
+		{
+			name:       "valid path parameter",
+			method:     http.MethodGet,
+			target:     "/widgets/" + testUUID,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "malformed path parameter",
+			method:     http.MethodGet,
+			target:     "/widgets/not-a-uuid",
+			wantStatus: http.StatusBadRequest,
+			wantError:  `path parameter "uuid": must be a valid UUID`,
+		},
+		{
+			name:       "undocumented operation passes through",
+			method:     http.MethodDelete,
+			target:     "/widgets/not-a-uuid",
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "valid body",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `{"name":"keg","owner_uuid":"` + testUUID + `","weight":null}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing body",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			wantStatus: http.StatusBadRequest,
+			wantError:  "request body is required",
+		},
+		{
+			name:       "body is not JSON",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `{"name":`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "request body is not valid JSON",
+		},
+		{
+			name:       "body is not an object",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `[]`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "invalid request: ",
+		},
+		{
+			name:       "missing required property",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `{"owner_uuid":"` + testUUID + `"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  `"name"`,
+		},
+		{
+			name:       "property of the wrong type",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `{"name":"keg","owner_uuid":"` + testUUID + `","weight":"heavy"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "weight: ",
+		},
+		{
+			name:       "malformed UUID property",
+			method:     http.MethodPost,
+			target:     "/widgets",
+			body:       `{"name":"keg","owner_uuid":"abc"}`,
+			wantStatus: http.StatusBadRequest,
+			wantError:  "owner_uuid: must be a valid UUID",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, _ := newTestMux(t)
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("status = %d, want %d (body %q)", rec.Code, tc.wantStatus, rec.Body.String())
+			}
+			if tc.wantError != "" {
+				body := rec.Body.String()
+				if !strings.HasPrefix(body, ErrorPrefix) {
+					t.Errorf("body %q does not start with %q", body, ErrorPrefix)
+				}
+				if !strings.Contains(body, tc.wantError) {
+					t.Errorf("body %q does not contain %q", body, tc.wantError)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRestoresBody(t *testing.T) {
+	mux, received := newTestMux(t)
+	body := `{"name":"keg","owner_uuid":"` + testUUID + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/widgets", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body %q)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if *received != body {
+		t.Errorf("handler received %q, want %q", *received, body)
+	}
+}
+
+func TestValidateBodyTooLarge(t *testing.T) {
+	mux, _ := newTestMux(t)
+	body := `{"name":"` + strings.Repeat("a", MaxBodySize) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/widgets", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+	}
+}
+
+func TestRoutesAuthenticateBeforeValidating(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	auth := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+	routes := spec.Routes([]service.HTTPRoute{
+		{Method: http.MethodGet, Path: "/widgets/{uuid}", Auth: auth, Handler: http.NotFoundHandler()},
+	})
+	mux := http.NewServeMux()
+	mux.Handle(routes[0].Method+" "+routes[0].Path, routes[0].Serve())
+
+	req := httptest.NewRequest(http.MethodGet, "/widgets/abc", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("without credentials: status = %d, want %d (body %q)", rec.Code, http.StatusUnauthorized, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/widgets/abc", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest || !strings.HasPrefix(rec.Body.String(), ErrorPrefix) {
+		t.Errorf("with credentials: status = %d, body %q, want a validation error", rec.Code, rec.Body.String())
+	}
+}
+
+func TestLoadRejectsInvalidDocument(t *testing.T) {
+	if _, err := Load([]byte("openapi: 3.0.3\npaths: {}\n")); err == nil {
+		t.Error("Load succeeded for a document without info")
+	}
+}
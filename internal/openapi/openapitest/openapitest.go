@@ -0,0 +1,296 @@
+// Package openapitest checks a service's routes against its OpenAPI
+// document.
+package openapitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/openapi"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// sampleUUID stands in for every UUID in generated requests.
+const sampleUUID = "00000000-0000-4000-8000-000000000001"
+
+// Contract checks that routes, as a service's HTTPRoutes returns them, and
+// spec describe the same operations, and exercises each route: a request
+// built from the spec must pass validation, and requests that break it (a
+// malformed UUID path parameter, a missing required query parameter, a
+// missing or mistyped JSON body) must be rejected before reaching the
+// handler.
+//
+// Authenticated routes must refuse requests without credentials before
+// validating them. The broken requests carry token, a user access token the
+// routes accept, so they reach validation; the request built from the spec
+// is checked against the spec alone, so it never reaches a handler. Routes
+// without authentication run their handler, which must cope with a service
+// that was never started.
+func Contract(t *testing.T, spec *openapi.Spec, routes []service.HTTPRoute, token string) {
+	t.Helper()
+
+	documented := make(map[string]bool)
+	for _, op := range spec.Operations() {
+		documented[op.Method+" "+op.Path] = true
+	}
+	for _, route := range routes {
+		key := strings.ToUpper(route.Method) + " " + route.Path
+		if !documented[key] {
+			t.Errorf("%s is not documented in the spec", key)
+			continue
+		}
+		delete(documented, key)
+	}
+	var undocumented []string
+	for key := range documented {
+		undocumented = append(undocumented, key)
+	}
+	sort.Strings(undocumented)
+	for _, key := range undocumented {
+		t.Errorf("%s is documented but has no route", key)
+	}
+
+	for _, route := range routes {
+		op := spec.Find(route.Method, route.Path)
+		if op == nil {
+			continue
+		}
+		t.Run(strings.ToUpper(route.Method)+" "+route.Path, func(t *testing.T) {
+			exercise(t, spec, route, op, token)
+		})
+	}
+}
+
+func exercise(t *testing.T, spec *openapi.Spec, route service.HTTPRoute, op *openapi3.Operation, token string) {
+	mux := http.NewServeMux()
+	mux.Handle(route.Method+" "+route.Path, route.Serve())
+
+	valid := newRequest(route, op)
+	if route.Auth == nil {
+		if body, rejected := valid.send(mux); rejected {
+			t.Fatalf("request built from the spec was rejected: %s", body)
+		}
+	} else {
+		checked := http.NewServeMux()
+		checked.Handle(route.Method+" "+route.Path, spec.Validate(route.Method, route.Path, http.NotFoundHandler()))
+		if body, rejected := valid.send(checked); rejected {
+			t.Fatalf("request built from the spec was rejected: %s", body)
+		}
+
+		// Authentication comes first, whatever the request looks like.
+		unauthenticated := []*request{valid}
+		if req := invalid(route, op); req != nil {
+			unauthenticated = append(unauthenticated, req)
+		}
+		for _, req := range unauthenticated {
+			if code, body := req.serve(mux); code != http.StatusUnauthorized {
+				t.Errorf("request without credentials: expected status 401, got %d (response %q)", code, body)
+			}
+		}
+	}
+
+	for _, ref := range op.Parameters {
+		p := ref.Value
+		switch {
+		case p.In == openapi3.ParameterInPath && isUUID(p.Schema.Value):
+			req := newRequest(route, op)
+			req.token = token
+			req.path[p.Name] = "not-a-uuid"
+			expectRejected(t, mux, req, "malformed path parameter "+p.Name)
+		case p.In == openapi3.ParameterInQuery && p.Required:
+			req := newRequest(route, op)
+			req.token = token
+			req.query.Del(p.Name)
+			expectRejected(t, mux, req, "missing query parameter "+p.Name)
+		case p.In == openapi3.ParameterInQuery && isUUID(p.Schema.Value):
+			req := newRequest(route, op)
+			req.token = token
+			req.query.Set(p.Name, "not-a-uuid")
+			expectRejected(t, mux, req, "malformed query parameter "+p.Name)
+		}
+	}
+
+	if schema := jsonBody(op); schema != nil {
+		if op.RequestBody.Value.Required {
+			req := newRequest(route, op)
+			req.token = token
+			req.body = nil
+			expectRejected(t, mux, req, "missing body")
+		}
+		if schema.Type.Is(openapi3.TypeObject) {
+			req := newRequest(route, op)
+			req.token = token
+			req.body = []byte("[]")
+			expectRejected(t, mux, req, "array body")
+		}
+	}
+}
+
+func expectRejected(t *testing.T, mux http.Handler, req *request, what string) {
+	t.Helper()
+	if body, rejected := req.send(mux); !rejected {
+		t.Errorf("%s: request was not rejected by validation (response %q)", what, body)
+	}
+}
+
+type request struct {
+	method string
+	route  string
+	path   map[string]string
+	query  url.Values
+	body   []byte
+	token  string
+}
+
+func newRequest(route service.HTTPRoute, op *openapi3.Operation) *request {
+	req := &request{
+		method: route.Method,
+		route:  route.Path,
+		path:   make(map[string]string),
+		query:  make(url.Values),
+	}
+	for _, ref := range op.Parameters {
+		p := ref.Value
+		switch p.In {
+		case openapi3.ParameterInPath:
+			req.path[p.Name] = sampleString(p.Schema.Value)
+		case openapi3.ParameterInQuery:
+			if p.Required {
+				req.query.Set(p.Name, sampleString(p.Schema.Value))
+			}
+		}
+	}
+	if schema := jsonBody(op); schema != nil {
+		req.body, _ = json.Marshal(sample(schema))
+	}
+	return req
+}
+
+// invalid returns a request that breaks the spec: its path is malformed
+// where possible, otherwise its body, otherwise its query carries a UUID
+// parameter's value that is not one. It is nil when the operation takes no
+// input to break.
+func invalid(route service.HTTPRoute, op *openapi3.Operation) *request {
+	req := newRequest(route, op)
+	for _, ref := range op.Parameters {
+		if p := ref.Value; p.In == openapi3.ParameterInPath && isUUID(p.Schema.Value) {
+			req.path[p.Name] = "not-a-uuid"
+			return req
+		}
+	}
+	if jsonBody(op) != nil {
+		req.body = []byte("not json")
+		return req
+	}
+	for _, ref := range op.Parameters {
+		if p := ref.Value; p.In == openapi3.ParameterInQuery && isUUID(p.Schema.Value) {
+			req.query.Set(p.Name, "not-a-uuid")
+			return req
+		}
+	}
+	return nil
+}
+
+// send serves the request and reports whether validation rejected it.
+func (r *request) send(mux http.Handler) (string, bool) {
+	code, body := r.serve(mux)
+	return body, code == http.StatusBadRequest && strings.HasPrefix(body, openapi.ErrorPrefix)
+}
+
+// serve serves the request and returns the response's status and body.
+func (r *request) serve(mux http.Handler) (int, string) {
+	path := r.route
+	for name, value := range r.path {
+		path = strings.ReplaceAll(path, "{"+name+"}", url.PathEscape(value))
+	}
+	if len(r.query) > 0 {
+		path += "?" + r.query.Encode()
+	}
+	req := httptest.NewRequest(r.method, path, bytes.NewReader(r.body))
+	if r.body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec.Code, rec.Body.String()
+}
+
+func jsonBody(op *openapi3.Operation) *openapi3.Schema {
+	if op.RequestBody == nil {
+		return nil
+	}
+	media := op.RequestBody.Value.Content.Get("application/json")
+	if media == nil || media.Schema == nil {
+		return nil
+	}
+	return media.Schema.Value
+}
+
+func isUUID(schema *openapi3.Schema) bool {
+	return schema.Pattern == openapi.UUIDPattern
+}
+
+// sample builds the smallest value schema accepts: objects carry only their
+// required properties.
+func sample(schema *openapi3.Schema) any {
+	if len(schema.AllOf) > 0 {
+		return sample(schema.AllOf[0].Value)
+	}
+	if len(schema.Enum) > 0 {
+		return schema.Enum[0]
+	}
+	switch {
+	case schema.Type.Is(openapi3.TypeObject):
+		obj := make(map[string]any)
+		for _, name := range schema.Required {
+			obj[name] = sample(schema.Properties[name].Value)
+		}
+		return obj
+	case schema.Type.Is(openapi3.TypeArray):
+		items := make([]any, 0, schema.MinItems)
+		for range schema.MinItems {
+			items = append(items, sample(schema.Items.Value))
+		}
+		return items
+	case schema.Type.Is(openapi3.TypeString):
+		return sampleString(schema)
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return 1
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return true
+	}
+	return map[string]any{}
+}
+
+func sampleString(schema *openapi3.Schema) string {
+	switch {
+	case len(schema.Enum) > 0:
+		if s, ok := schema.Enum[0].(string); ok {
+			return s
+		}
+	case isUUID(schema):
+		return sampleUUID
+	case schema.Format == "date-time":
+		return "2026-01-02T15:04:05Z"
+	case schema.Format == "date":
+		return "2026-01-02"
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return "true"
+	case schema.Type.Is(openapi3.TypeInteger), schema.Type.Is(openapi3.TypeNumber):
+		return "1"
+	}
+	return "x"
+}
@@ -0,0 +1,80 @@
+package telemetry
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// registry holds every metric the process exposes.
+var registry = prometheus.NewRegistry()
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "brewpipes_http_request_duration_seconds",
+		Help:    "Duration of HTTP requests by route pattern.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	httpClientRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "brewpipes_http_client_request_duration_seconds",
+		Help:    "Duration of HTTP calls to other services by target service.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"peer", "method", "status"})
+
+	batchCostCalculations = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "brewpipes_batch_cost_calculation_duration_seconds",
+		Help:    "Duration of batch cost calculations by outcome.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"outcome"})
+
+	purchaseOrdersReceived = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "brewpipes_purchase_orders_received_total",
+		Help: "Purchase orders marked as received.",
+	})
+
+	journalExports = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "brewpipes_journal_exports_total",
+		Help: "Accounting journal exports by journal and format.",
+	}, []string{"journal", "format"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		httpClientRequestDuration,
+		batchCostCalculations,
+		purchaseOrdersReceived,
+		journalExports,
+	)
+}
+
+// MetricsHandler serves the registry in the Prometheus exposition format.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{Registry: registry})
+}
+
+// ObserveBatchCostCalculation records how long a batch cost calculation took
+// and whether it failed.
+func ObserveBatchCostCalculation(started time.Time, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	batchCostCalculations.WithLabelValues(outcome).Observe(time.Since(started).Seconds())
+}
+
+// PurchaseOrderReceived counts a purchase order marked as received.
+func PurchaseOrderReceived() {
+	purchaseOrdersReceived.Inc()
+}
+
+// JournalExported counts an accounting journal export.
+func JournalExported(journal, format string) {
+	journalExports.WithLabelValues(journal, format).Inc()
+}
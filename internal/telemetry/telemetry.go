@@ -0,0 +1,73 @@
+// Package telemetry instruments the services with Prometheus metrics and
+// OpenTelemetry traces.
+//
+// Metrics are collected in a single registry served by MetricsHandler: HTTP
+// request durations per route pattern, durations of calls between services,
+// pgxpool statistics per service and a handful of business counters.
+//
+// Traces start at the HTTP server, follow the InventoryClient and
+// ProcurementClient calls through W3C trace context headers and end in the
+// pgx queries each request runs. They are exported over OTLP/HTTP when
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set;
+// otherwise spans are not recorded, but incoming trace context is still
+// forwarded to downstream services.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer spans are created with.
+const instrumentationName = "github.com/brewpipes/brewpipes/internal/telemetry"
+
+// tracer returns the tracer of the global provider. It is looked up on use so
+// that spans pick up the provider installed by StartTracing.
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartTracing installs the global trace context propagator and, when an
+// OTLP endpoint is configured, a tracer provider exporting to it. The
+// service name defaults to brewpipes-<executable> and can be overridden with
+// OTEL_SERVICE_NAME. The returned function flushes buffered spans and must
+// be called before the process exits.
+func StartTracing(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName("brewpipes-"+filepath.Base(os.Args[0]))),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
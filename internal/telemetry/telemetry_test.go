@@ -0,0 +1,111 @@
+package telemetry
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans installs a tracer provider that keeps finished spans in memory.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// scrape returns the metrics exposition served by MetricsHandler.
+func scrape(t *testing.T) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200 from the metrics handler, got %d", rec.Code)
+	}
+	return rec.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	recorder := recordSpans(t)
+	route := "/api/test-batches/{uuid}/costs"
+
+	h := Handler(route, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !trace.SpanContextFromContext(r.Context()).IsValid() {
+			t.Error("expected the request context to carry a span")
+		}
+		http.Error(w, "batch not found", http.StatusNotFound)
+	}))
+	for _, id := range []string{"a", "b"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/test-batches/"+id+"/costs", nil))
+	}
+
+	// Both paths are counted under the one route pattern.
+	series := `brewpipes_http_request_duration_seconds_count{method="GET",route="/api/test-batches/{uuid}/costs",status="404"} 2`
+	if metrics := scrape(t); !strings.Contains(metrics, series) {
+		t.Errorf("expected %s in the metrics, got\n%s", series, metrics)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 || spans[0].Name() != "GET "+route || spans[0].SpanKind() != trace.SpanKindServer {
+		t.Fatalf("expected two server spans named after the route, got %+v", spans)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	recorder := recordSpans(t)
+
+	server := httptest.NewServer(Handler("/api/test-lots", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "[]")
+	})))
+	defer server.Close()
+
+	client := &http.Client{Transport: Transport("test-inventory", nil)}
+	resp, err := client.Get(server.URL + "/api/test-lots")
+	if err != nil {
+		t.Fatalf("calling test server: %v", err)
+	}
+	resp.Body.Close()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected a server and a client span, got %d", len(spans))
+	}
+	serverSpan, clientSpan := spans[0], spans[1]
+	if clientSpan.Name() != "GET test-inventory" || clientSpan.SpanKind() != trace.SpanKindClient {
+		t.Errorf("unexpected client span %q", clientSpan.Name())
+	}
+	// The server continues the trace the client started.
+	if serverSpan.Parent().SpanID() != clientSpan.SpanContext().SpanID() || serverSpan.SpanContext().TraceID() != clientSpan.SpanContext().TraceID() {
+		t.Error("expected the server span to be a child of the client span")
+	}
+
+	series := `brewpipes_http_client_request_duration_seconds_count{method="GET",peer="test-inventory",status="200"} 1`
+	if metrics := scrape(t); !strings.Contains(metrics, series) {
+		t.Errorf("expected %s in the metrics, got\n%s", series, metrics)
+	}
+}
+
+func TestQueryOperation(t *testing.T) {
+	tests := map[string]string{
+		"SELECT id FROM batch":                         "SELECT",
+		"\n\t\tinsert INTO batch (name) VALUES ($1)":   "INSERT",
+		"WITH moved AS (SELECT 1) SELECT * FROM moved": "WITH",
+		"   ": "QUERY",
+	}
+	for sql, expected := range tests {
+		if got := queryOperation(sql); got != expected {
+			t.Errorf("queryOperation(%q) = %q, expected %q", sql, got, expected)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/brewpipes/brewpipes/internal/jwt"
@@ -14,6 +16,7 @@ type contextKey string
 const (
 	contextUserIDKey   contextKey = "userID"
 	contextUserRoleKey contextKey = "userRole"
+	contextServiceKey  contextKey = "service"
 )
 
 // UserIDFromContext returns the authenticated user ID when present.
@@ -38,31 +41,74 @@ func UserRoleFromContext(ctx context.Context) (string, bool) {
 	return role, ok
 }
 
+// ServiceFromContext returns the name of the calling service when the
+// request was made with a service token. The user the service acted for, if
+// any, is available from UserIDFromContext.
+func ServiceFromContext(ctx context.Context) (string, bool) {
+	value := ctx.Value(contextServiceKey)
+	if value == nil {
+		return "", false
+	}
+
+	name, ok := value.(string)
+	return name, ok
+}
+
 // RequireAccessToken validates bearer access tokens and populates context.
+// Service tokens are refused; routes other services call use
+// RequireAccessTokenOrService.
 func RequireAccessToken(secret string) func(http.Handler) http.Handler {
+	return RequireAccessTokenOrService(secret)
+}
+
+// RequireAccessTokenOrService validates bearer tokens from users and from the
+// named services. A valid token from any other service is refused with 403.
+// For service tokens the context carries the service name and, when the call
+// was made for a user, that user's ID.
+func RequireAccessTokenOrService(secret string, services ...string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			authHeader := strings.TrimSpace(r.Header.Get("Authorization"))
-			if !strings.HasPrefix(authHeader, "Bearer ") {
+			rawToken, ok := bearerToken(r)
+			if !ok {
 				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			rawToken := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
-			if rawToken == "" {
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
+			if accessToken, err := jwt.DecodeAccessToken(rawToken, secret); err == nil {
+				ctx := context.WithValue(r.Context(), contextUserIDKey, accessToken.UserID)
+				ctx = context.WithValue(ctx, contextUserRoleKey, accessToken.Claims.Role)
+				next.ServeHTTP(w, r.WithContext(ctx))
 				return
 			}
 
-			accessToken, err := jwt.DecodeAccessToken(rawToken, secret)
+			serviceToken, err := jwt.DecodeServiceToken(rawToken, secret)
 			if err != nil {
 				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
+			if !slices.Contains(services, serviceToken.Service) {
+				slog.Warn("service caller refused", "service", serviceToken.Service, "route", r.Pattern)
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
 
-			ctx := context.WithValue(r.Context(), contextUserIDKey, accessToken.UserID)
-			ctx = context.WithValue(ctx, contextUserRoleKey, accessToken.Claims.Role)
+			ctx := context.WithValue(r.Context(), contextServiceKey, serviceToken.Service)
+			if serviceToken.OnBehalfOf != uuid.Nil {
+				ctx = context.WithValue(ctx, contextUserIDKey, serviceToken.OnBehalfOf)
+			}
+			slog.Debug("service call", "service", serviceToken.Service, "on_behalf_of", serviceToken.Claims.OnBehalfOf, "route", r.Pattern)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
+
+// bearerToken returns the token of a bearer Authorization header.
+func bearerToken(r *http.Request) (string, bool) {
+	authHeader := strings.TrimSpace(r.Header.Get("Authorization"))
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return "", false
+	}
+
+	rawToken := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer "))
+	return rawToken, rawToken != ""
+}
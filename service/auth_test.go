@@ -0,0 +1,249 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/identity/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+const testSecret = "test-secret"
+
+func TestRequireAccessTokenOrService(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+	userToken, _, err := storage.User{Identifiers: entity.Identifiers{UUID: userID}}.GenerateTokens(testSecret)
+	if err != nil {
+		t.Fatalf("generating user token: %v", err)
+	}
+	productionToken, err := storage.GenerateServiceToken("production", userID.String(), testSecret)
+	if err != nil {
+		t.Fatalf("generating service token: %v", err)
+	}
+	jobToken, err := storage.GenerateServiceToken("production", "", testSecret)
+	if err != nil {
+		t.Fatalf("generating service token: %v", err)
+	}
+	procurementToken, err := storage.GenerateServiceToken("procurement", "", testSecret)
+	if err != nil {
+		t.Fatalf("generating service token: %v", err)
+	}
+
+	type caller struct {
+		Service string `json:"service"`
+		UserID  string `json:"user_id"`
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var c caller
+		c.Service, _ = service.ServiceFromContext(r.Context())
+		if id, ok := service.UserIDFromContext(r.Context()); ok {
+			c.UserID = id.String()
+		}
+		service.JSON(w, c)
+	})
+	serviceRoute := service.RequireAccessTokenOrService(testSecret, "production")(next)
+	userRoute := service.RequireAccessToken(testSecret)(next)
+
+	tests := []struct {
+		name           string
+		handler        http.Handler
+		token          string
+		expectedStatus int
+		expected       caller
+	}{
+		{"user on a service route", serviceRoute, userToken, http.StatusOK, caller{UserID: userID.String()}},
+		{"allowed service for a user", serviceRoute, productionToken, http.StatusOK, caller{Service: "production", UserID: userID.String()}},
+		{"allowed service without a user", serviceRoute, jobToken, http.StatusOK, caller{Service: "production"}},
+		{"other service", serviceRoute, procurementToken, http.StatusForbidden, caller{}},
+		{"service on a user route", userRoute, productionToken, http.StatusForbidden, caller{}},
+		{"invalid token", serviceRoute, "not-a-token", http.StatusUnauthorized, caller{}},
+		{"missing token", serviceRoute, "", http.StatusUnauthorized, caller{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/beer-lots", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+			var got caller
+			if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected caller %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestServiceTokenSource(t *testing.T) {
+	var requests []map[string]any
+	identity := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/service-tokens" {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+
+		obo, _ := body["on_behalf_of"].(string)
+		token, err := storage.GenerateServiceToken(body["client_id"].(string), obo, testSecret)
+		if err != nil {
+			t.Errorf("generating service token: %v", err)
+		}
+		service.JSON(w, map[string]any{"access_token": token, "token_type": "Bearer", "expires_in": 900})
+	}))
+	defer identity.Close()
+
+	// The inventory side of the call checks the token and reports its caller.
+	inventory := httptest.NewServer(service.RequireAccessTokenOrService(testSecret, "production")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := service.UserIDFromContext(r.Context())
+		w.Write([]byte(userID.String()))
+	})))
+	defer inventory.Close()
+
+	tokens := service.NewServiceTokenSource(identity.URL, "production", "production-secret")
+	client := &http.Client{Transport: tokens.Transport(nil)}
+	call := func(ctx context.Context) string {
+		t.Helper()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, inventory.URL, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("calling inventory: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	// A request authenticated as a user acts for that user.
+	userID := uuid.Must(uuid.NewV4())
+	userToken, _, _ := storage.User{Identifiers: entity.Identifiers{UUID: userID}}.GenerateTokens(testSecret)
+	userReq := httptest.NewRequest(http.MethodGet, "/batches/x/costs", nil)
+	userReq.Header.Set("Authorization", "Bearer "+userToken)
+	var userCtx context.Context
+	service.RequireAccessToken(testSecret)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		userCtx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), userReq)
+
+	if got := call(userCtx); got != userID.String() {
+		t.Errorf("expected the call to act for %s, got %q", userID, got)
+	}
+	call(userCtx)
+	if got := call(context.Background()); got != uuid.Nil.String() {
+		t.Errorf("expected a call outside a request to act for no user, got %q", got)
+	}
+
+	// One token per user, reused until it nears expiry.
+	if len(requests) != 2 {
+		t.Fatalf("expected two token requests, got %d", len(requests))
+	}
+	if requests[0]["client_id"] != "production" || requests[0]["client_secret"] != "production-secret" || requests[0]["on_behalf_of"] != userID.String() {
+		t.Errorf("unexpected token request %+v", requests[0])
+	}
+	if _, ok := requests[1]["on_behalf_of"]; ok {
+		t.Errorf("expected no on_behalf_of without a user, got %+v", requests[1])
+	}
+}
+
+func TestServiceTokenSourceConcurrentFetches(t *testing.T) {
+	userID := uuid.Must(uuid.NewV4())
+	release := make(chan struct{})
+	var mu sync.Mutex
+	fetches := map[string]int{}
+	identity := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		obo, _ := body["on_behalf_of"].(string)
+		mu.Lock()
+		fetches[obo]++
+		mu.Unlock()
+
+		// The user's token is slow to arrive.
+		if obo != "" {
+			<-release
+		}
+		token, _ := storage.GenerateServiceToken("production", obo, testSecret)
+		service.JSON(w, map[string]any{"access_token": token, "token_type": "Bearer", "expires_in": 900})
+	}))
+	defer identity.Close()
+
+	tokens := service.NewServiceTokenSource(identity.URL, "production", "production-secret")
+
+	userToken, _, _ := storage.User{Identifiers: entity.Identifiers{UUID: userID}}.GenerateTokens(testSecret)
+	userReq := httptest.NewRequest(http.MethodGet, "/batches/x/costs", nil)
+	userReq.Header.Set("Authorization", "Bearer "+userToken)
+	var userCtx context.Context
+	service.RequireAccessToken(testSecret)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		userCtx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), userReq)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := tokens.Token(userCtx)
+			errs <- err
+		}()
+	}
+
+	// A token for another caller is not held up by the user's fetch.
+	done := make(chan error, 1)
+	go func() {
+		_, err := tokens.Token(context.Background())
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("getting token: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("token without a user waited for the user's fetch")
+	}
+
+	// A caller that gives up does not wait for the fetch.
+	ctx, cancel := context.WithCancel(userCtx)
+	cancel()
+	if _, err := tokens.Token(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("getting token: %v", err)
+		}
+	}
+
+	// Waiting callers share one fetch per user.
+	if fetches[userID.String()] != 1 || fetches[""] != 1 {
+		t.Errorf("expected one fetch per user, got %v", fetches)
+	}
+}
@@ -0,0 +1,14 @@
+package handler
+
+import (
+	_ "embed"
+
+	"github.com/brewpipes/brewpipes/internal/openapi"
+)
+
+//go:embed api.yaml
+var apiSpec []byte
+
+// API is the identity service's OpenAPI document. Its routes validate requests
+// against it.
+var API = openapi.MustLoad(apiSpec)
@@ -1,6 +1,10 @@
 package dto
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/gofrs/uuid/v5"
+)
 
 // LoginRequest is the request body [POST /login].
 type LoginRequest struct {
@@ -52,3 +56,36 @@ type TokenPair struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
 }
+
+// ServiceTokenRequest is the request body [POST /service-tokens]: the client
+// credentials of a service and, when the service acts for a user, that
+// user's UUID.
+type ServiceTokenRequest struct {
+	ClientID     string  `json:"client_id"`
+	ClientSecret string  `json:"client_secret"`
+	OnBehalfOf   *string `json:"on_behalf_of"`
+}
+
+// Validate validates the request.
+func (r ServiceTokenRequest) Validate() error {
+	if err := validateRequired(r.ClientID, "client_id"); err != nil {
+		return err
+	}
+	if err := validateRequired(r.ClientSecret, "client_secret"); err != nil {
+		return err
+	}
+	if r.OnBehalfOf != nil {
+		if _, err := uuid.FromString(*r.OnBehalfOf); err != nil {
+			return errors.New("on_behalf_of must be a valid UUID")
+		}
+	}
+
+	return nil
+}
+
+// ServiceTokenResponse is the response body [POST /service-tokens].
+type ServiceTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
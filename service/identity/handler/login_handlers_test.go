@@ -195,3 +195,54 @@ func TestHandleLogoutSuccess(t *testing.T) {
 		t.Fatalf("expected refresh token to be consumed")
 	}
 }
+
+func TestHandleServiceToken(t *testing.T) {
+	secret := "test-secret"
+	userUUID := uuid.Must(uuid.NewV4())
+	store := &fakeAuthStore{user: storage.User{Username: "brewer"}}
+	store.user.UUID = userUUID
+	clients := map[string]string{"production": "production-secret"}
+
+	post := func(store *fakeAuthStore, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/service-tokens", strings.NewReader(body))
+		handler.HandleServiceToken(store, clients, secret).ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post(store, `{"client_id":"production","client_secret":"production-secret","on_behalf_of":"`+userUUID.String()+`"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if resp.ExpiresIn != int(storage.DefaultServiceTokenTTL.Seconds()) {
+		t.Errorf("unexpected expires_in %d", resp.ExpiresIn)
+	}
+	token, err := jwt.DecodeServiceToken(resp.AccessToken, secret)
+	if err != nil {
+		t.Fatalf("decode service token: %v", err)
+	}
+	if token.Service != "production" || token.OnBehalfOf != userUUID {
+		t.Errorf("expected a production token for %s, got %s for %s", userUUID, token.Service, token.OnBehalfOf)
+	}
+	if _, err := jwt.DecodeAccessToken(resp.AccessToken, secret); err == nil {
+		t.Error("expected a service token not to decode as a user access token")
+	}
+
+	if rec := post(store, `{"client_id":"production","client_secret":"wrong"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 for a wrong secret, got %d", rec.Code)
+	}
+	if rec := post(store, `{"client_id":"inventory","client_secret":"production-secret"}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected status 401 for an unknown client, got %d", rec.Code)
+	}
+	missingUser := &fakeAuthStore{getUserErr: service.ErrNotFound}
+	if rec := post(missingUser, `{"client_id":"production","client_secret":"production-secret","on_behalf_of":"`+userUUID.String()+`"}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for an unknown user, got %d", rec.Code)
+	}
+}
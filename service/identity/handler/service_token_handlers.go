@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/identity/handler/dto"
+	"github.com/brewpipes/brewpipes/service/identity/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// HandleServiceToken handles [POST /service-tokens], a client credentials
+// grant for services. clients maps each service name to its client secret.
+// The token names the service as its subject and carries the user the
+// service acts for, if any, in a separate claim.
+func HandleServiceToken(db UserGetter, clients map[string]string, secretKey string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req dto.ServiceTokenRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		secret, ok := clients[req.ClientID]
+		if !ok || subtle.ConstantTimeCompare([]byte(secret), []byte(req.ClientSecret)) != 1 {
+			slog.Warn("invalid service client credentials", "client_id", req.ClientID)
+			http.Error(w, "invalid client credentials", http.StatusUnauthorized)
+			return
+		}
+
+		var onBehalfOf string
+		if req.OnBehalfOf != nil {
+			userID := uuid.FromStringOrNil(*req.OnBehalfOf)
+			if _, err := db.GetUser(r.Context(), userID); errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "on_behalf_of user not found", http.StatusBadRequest)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting user", "error", err)
+				return
+			}
+			onBehalfOf = userID.String()
+		}
+
+		token, err := storage.GenerateServiceToken(req.ClientID, onBehalfOf, secretKey)
+		if err != nil {
+			service.InternalError(w, "error generating service token", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.ServiceTokenResponse{
+			AccessToken: token,
+			TokenType:   "Bearer",
+			ExpiresIn:   int(storage.DefaultServiceTokenTTL.Seconds()),
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/identity/handler"
@@ -14,6 +15,28 @@ import (
 type Config struct {
 	PostgresDSN string
 	SecretKey   string
+	// ServiceClients maps the name of each service allowed to request
+	// service tokens to its client secret.
+	ServiceClients map[string]string
+}
+
+// ParseServiceClients parses service client credentials written as
+// comma-separated name=secret pairs, the format of BREWPIPES_SERVICE_CLIENTS.
+func ParseServiceClients(value string) (map[string]string, error) {
+	clients := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, secret, ok := strings.Cut(pair, "=")
+		if !ok || name == "" || secret == "" {
+			return nil, fmt.Errorf("invalid service client %q: expected name=secret", pair)
+		}
+		clients[name] = secret
+	}
+
+	return clients, nil
 }
 
 type startCloser interface {
@@ -35,7 +58,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	return &Service{
 		storage: stg,
 		// inject dependencies for HTTP handlers
-		routes: []service.HTTPRoute{
+		routes: handler.API.Routes([]service.HTTPRoute{
 			{
 				Method:  http.MethodPost,
 				Path:    "/login",
@@ -51,12 +74,17 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 				Path:    "/logout",
 				Handler: handler.HandleLogout(stg, cfg.SecretKey),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/service-tokens",
+				Handler: handler.HandleServiceToken(stg, cfg.ServiceClients, cfg.SecretKey),
+			},
 			{Method: http.MethodGet, Path: "/users", Handler: handler.HandleUsers(stg)},
 			{Method: http.MethodPost, Path: "/users", Handler: handler.HandleUsers(stg)},
 			{Method: http.MethodGet, Path: "/users/{uuid}", Handler: handler.HandleUserByUUID(stg)},
 			{Method: http.MethodPut, Path: "/users/{uuid}", Handler: handler.HandleUserByUUID(stg)},
 			{Method: http.MethodDelete, Path: "/users/{uuid}", Handler: handler.HandleUserByUUID(stg)},
-		},
+		}),
 	}, nil
 }
 
@@ -0,0 +1,24 @@
+package identity_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/openapi/openapitest"
+	"github.com/brewpipes/brewpipes/service/identity"
+	"github.com/brewpipes/brewpipes/service/identity/handler"
+)
+
+func TestHTTPRoutesMatchAPISpec(t *testing.T) {
+	// No route carries authentication, so no token is needed, and requests
+	// that pass validation reach storage, which fails fast against a closed
+	// port.
+	svc, err := identity.NewService(context.Background(), &identity.Config{
+		PostgresDSN: "postgres://localhost:1/brewpipes?connect_timeout=1",
+		SecretKey:   "test-secret",
+	})
+	if err != nil {
+		t.Fatalf("creating service: %v", err)
+	}
+	openapitest.Contract(t, handler.API, svc.HTTPRoutes(), "")
+}
@@ -0,0 +1,19 @@
+package storage
+
+import "github.com/brewpipes/brewpipes/internal/archive"
+
+// ArchiveService describes the identity data included in a full export.
+// Password hashes are never exported; restored users all get passwordHash
+// until they change it. Refresh tokens are dropped, so every session ends.
+func ArchiveService(passwordHash string) archive.Service {
+	return archive.Service{
+		Name:           "identity",
+		MigrationTable: "identity_schema_migrations",
+		Tables: []archive.Table{{
+			Name:     "identity.user",
+			Omit:     []string{"password"},
+			Defaults: map[string]any{"password": passwordHash},
+		}},
+		Skip: []string{"identity.refresh_token"},
+	}
+}
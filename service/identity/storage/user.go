@@ -17,6 +17,10 @@ const (
 	// DefaultRefreshTokenTTL is the default TTL for issued refresh tokens.
 	DefaultRefreshTokenTTL = 30 * Day
 
+	// DefaultServiceTokenTTL is the default TTL for tokens issued to services.
+	// Services fetch new tokens as they expire, so it is kept short.
+	DefaultServiceTokenTTL = 15 * time.Minute
+
 	// Issuer is the JWT issuer.
 	Issuer = "brewpipes"
 )
@@ -71,3 +75,25 @@ func (u User) GenerateTokens(secret string) (string, string, error) {
 
 	return access, refresh, nil
 }
+
+// GenerateServiceToken generates an access token for the named service,
+// acting for the user onBehalfOf when it is not empty.
+func GenerateServiceToken(serviceName, onBehalfOf, secret string) (string, error) {
+	now := time.Now()
+	issuedAt := jwt.NewNumericDate(now)
+
+	claims := &bpjwt.ServiceClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.Must(uuid.NewV4()).String(),
+			Issuer:    Issuer,
+			Subject:   serviceName,
+			ExpiresAt: jwt.NewNumericDate(now.Add(DefaultServiceTokenTTL)),
+			NotBefore: issuedAt,
+			IssuedAt:  issuedAt,
+		},
+		Role:       bpjwt.ServiceRole,
+		OnBehalfOf: onBehalfOf,
+	}
+
+	return bpjwt.EncodeServiceToken(claims, secret)
+}
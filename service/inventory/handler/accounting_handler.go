@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// InventoryJournalStore defines the storage methods needed by the inventory
+// journal handlers.
+type InventoryJournalStore interface {
+	GetValuationMethod(ctx context.Context) (string, error)
+	ListValuationMovements(ctx context.Context, before time.Time) ([]storage.ValuationMovement, error)
+	CreateJournalExport(context.Context, storage.JournalExport) (storage.JournalExport, error)
+	ListJournalExports(context.Context) ([]storage.JournalExport, error)
+	GetJournalExportByUUID(context.Context, string) (storage.JournalExport, error)
+}
+
+// InventoryJournalProcurement abstracts the Procurement service calls the
+// inventory journal needs: lot costs and the GL account mapping.
+type InventoryJournalProcurement interface {
+	POLineFetcher
+	GetAccountMapping(ctx context.Context) (accounting.Mapping, error)
+}
+
+// inventoryJournalEntries are the month-end entries of the inventory journal
+// in the order they are written, keyed by entry code.
+var inventoryJournalEntries = []struct {
+	code string
+	memo string
+}{
+	{"CONS", "Ingredients consumed"},
+	{"PKG", "Packaging materials used"},
+	{"WO", "Inventory written off"},
+	{"ADJ", "Inventory adjustments"},
+	{"RET", "Returns to suppliers"},
+}
+
+// HandleInventoryJournal handles [GET /accounting/inventory-journal],
+// previewing the inventory journal of the month given by the period query
+// parameter.
+func HandleInventoryJournal(db InventoryJournalStore, procClient InventoryJournalProcurement) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		period, err := accounting.ParsePeriod(r.URL.Query().Get("period"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		journal, _, err := buildInventoryJournal(r.Context(), db, procClient, period)
+		if err != nil {
+			service.InternalError(w, "error building inventory journal", "error", err, "period", period.String())
+			return
+		}
+
+		service.JSON(w, journal)
+	}
+}
+
+// HandleInventoryJournalExports handles [GET /accounting/inventory-journal/exports]
+// and [POST /accounting/inventory-journal/exports]. Only periods that have
+// ended can be exported, and a period is exported once unless the request
+// asks to export it again.
+func HandleInventoryJournalExports(db InventoryJournalStore, procClient InventoryJournalProcurement) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			exports, err := db.ListJournalExports(r.Context())
+			if err != nil {
+				service.InternalError(w, "error listing inventory journal exports", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewJournalExportsResponse(exports))
+		case http.MethodPost:
+			var req dto.CreateJournalExportRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			period, _ := accounting.ParsePeriod(req.Period)
+			if period.End().After(time.Now().UTC()) {
+				http.Error(w, "period has not ended", http.StatusBadRequest)
+				return
+			}
+
+			if !req.Reexport {
+				exports, err := db.ListJournalExports(r.Context())
+				if err != nil {
+					service.InternalError(w, "error listing inventory journal exports", "error", err)
+					return
+				}
+				for _, export := range exports {
+					if export.Period == req.Period {
+						http.Error(w, fmt.Sprintf("period %s was already exported", req.Period), http.StatusConflict)
+						return
+					}
+				}
+			}
+
+			journal, mapping, err := buildInventoryJournal(r.Context(), db, procClient, period)
+			if err != nil {
+				service.InternalError(w, "error building inventory journal", "error", err, "period", req.Period)
+				return
+			}
+
+			var content bytes.Buffer
+			if err := accounting.Write(&content, req.Format, journal, mapping); err != nil {
+				service.InternalError(w, "error writing inventory journal", "error", err, "period", req.Period)
+				return
+			}
+
+			export, err := db.CreateJournalExport(r.Context(), storage.JournalExport{
+				Period:     req.Period,
+				Format:     req.Format,
+				EntryCount: len(journal.Entries),
+				TotalCents: journal.TotalCents,
+				Content:    content.String(),
+			})
+			if err != nil {
+				service.InternalError(w, "error creating inventory journal export", "error", err)
+				return
+			}
+
+			slog.Info("inventory journal exported",
+				"period", export.Period,
+				"format", export.Format,
+				"entries", export.EntryCount,
+				"skipped", len(journal.Skipped))
+
+			telemetry.JournalExported("inventory", export.Format)
+
+			service.JSONCreated(w, dto.NewJournalExportResponse(export))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleInventoryJournalExportFile handles [GET /accounting/inventory-journal/exports/{uuid}/file].
+func HandleInventoryJournalExportFile(db InventoryJournalStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		exportUUID := r.PathValue("uuid")
+		if exportUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		export, err := db.GetJournalExportByUUID(r.Context(), exportUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "journal export not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting inventory journal export", "error", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", accounting.ContentType(export.Format))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", accounting.FileName("inventory-journal", export.Period, export.Format)))
+		w.Write([]byte(export.Content))
+	}
+}
+
+// buildInventoryJournal values the movement ledger up to the end of the
+// period with the configured costing method and posts the value of each
+// movement in the period to a month-end entry dated the last day of the
+// period. The ingredient category account is credited for stock going out
+// and debited for stock coming in, against the contra account of the
+// movement's reason. Receipts are journaled by Procurement and transfers
+// carry no value, so neither appears. Lots without a cost are listed as
+// skipped.
+func buildInventoryJournal(ctx context.Context, db InventoryJournalStore, procClient InventoryJournalProcurement, period accounting.Period) (accounting.Journal, accounting.Mapping, error) {
+	mapping, err := procClient.GetAccountMapping(ctx)
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+	method, err := db.GetValuationMethod(ctx)
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+	movements, err := db.ListValuationMovements(ctx, period.End())
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+	costs, err := loadLotCosts(ctx, procClient, movements)
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+
+	date := period.LastDay()
+	label := period.Start.Format("January 2006")
+	entries := make(map[string]*accounting.Entry, len(inventoryJournalEntries))
+	for _, e := range inventoryJournalEntries {
+		entry := accounting.NewEntry(date, e.code+"-"+period.String(), e.memo+", "+label)
+		entries[e.code] = &entry
+	}
+
+	engine := newValuationEngine(method, costs)
+	periodLots := make(map[string]bool)
+	for _, m := range movements {
+		value, costed := engine.apply(m)
+		if m.OccurredAt.Before(period.Start) {
+			continue
+		}
+		code, role := inventoryContra(m)
+		if code == "" {
+			continue
+		}
+		periodLots[m.IngredientLotUUID] = true
+		if !costed {
+			continue
+		}
+
+		entry := entries[code]
+		inventoryAccount := mapping.Category(m.IngredientCategory)
+		contraAccount := mapping.Role(role)
+		if m.Direction == storage.MovementDirectionIn {
+			entry.Debit(inventoryAccount, "", value)
+			entry.Credit(contraAccount, "", value)
+		} else {
+			entry.Debit(contraAccount, "", value)
+			entry.Credit(inventoryAccount, "", value)
+		}
+	}
+
+	journal := accounting.NewJournal(period, costs.baseCurrency)
+	for _, e := range inventoryJournalEntries {
+		journal.Add(*entries[e.code])
+	}
+	for _, lot := range engine.uncostedLots(periodLots) {
+		journal.Skip(fmt.Sprintf("%s lot %s", lot.IngredientName, lot.IngredientLotUUID), lot.Reason)
+	}
+
+	return journal, mapping, nil
+}
+
+// inventoryContra returns the entry a movement is posted to and the role of
+// its contra account. Consumption recorded against production goes to work
+// in process and any other consumption straight to cost of goods sold.
+// Receipts and transfers return an empty code.
+func inventoryContra(m storage.ValuationMovement) (code, role string) {
+	switch m.Reason {
+	case storage.MovementReasonUse:
+		if m.ProductionRefUUID != nil {
+			return "CONS", accounting.RoleWorkInProcess
+		}
+		return "CONS", accounting.RoleCostOfGoodsSold
+	case storage.MovementReasonPackage:
+		return "PKG", accounting.RoleFinishedGoods
+	case storage.MovementReasonWaste, storage.MovementReasonRemoval:
+		return "WO", accounting.RoleWriteOff
+	case storage.MovementReasonAdjust:
+		return "ADJ", accounting.RoleInventoryAdjustment
+	case storage.MovementReasonReturn:
+		return "RET", accounting.RoleSupplierReturns
+	default:
+		return "", ""
+	}
+}
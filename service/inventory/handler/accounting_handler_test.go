@@ -0,0 +1,322 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockInventoryJournalStore implements handler.InventoryJournalStore for testing.
+type mockInventoryJournalStore struct {
+	*mockValuationStore
+	exports []storage.JournalExport
+}
+
+func (m *mockInventoryJournalStore) CreateJournalExport(_ context.Context, export storage.JournalExport) (storage.JournalExport, error) {
+	export.UUID = uuid.Must(uuid.NewV4()).String()
+	export.CreatedAt = time.Now().UTC()
+	m.exports = append(m.exports, export)
+	return export, nil
+}
+
+func (m *mockInventoryJournalStore) ListJournalExports(context.Context) ([]storage.JournalExport, error) {
+	return m.exports, nil
+}
+
+func (m *mockInventoryJournalStore) GetJournalExportByUUID(_ context.Context, exportUUID string) (storage.JournalExport, error) {
+	for _, export := range m.exports {
+		if export.UUID == exportUUID {
+			return export, nil
+		}
+	}
+	return storage.JournalExport{}, service.ErrNotFound
+}
+
+// mockJournalProcurement implements handler.InventoryJournalProcurement for testing.
+type mockJournalProcurement struct {
+	*mockPOLineFetcher
+	mapping accounting.Mapping
+}
+
+func (m *mockJournalProcurement) GetAccountMapping(context.Context) (accounting.Mapping, error) {
+	return m.mapping, nil
+}
+
+func TestHandleInventoryJournal(t *testing.T) {
+	batchUUID := "550e8400-e29b-41d4-a716-446655440001"
+	lotAUUID := "220e8400-e29b-41d4-a716-446655440001"
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	lotCUUID := "220e8400-e29b-41d4-a716-446655440003"
+	poLineAUUID := "330e8400-e29b-41d4-a716-446655440001"
+	poLineBUUID := "330e8400-e29b-41d4-a716-446655440002"
+	coldRoomUUID := "440e8400-e29b-41d4-a716-446655440001"
+
+	// In March, 50 kg of lot A is used by a batch and 10 kg outside
+	// production, 10 kg of lot B is wasted, and 1 kg of lot C, which has no
+	// order line, is adjusted out.
+	brewed := valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonUse, 50, 3)
+	brewed.ProductionRefUUID = &batchUUID
+	movements := []storage.ValuationMovement{
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 1),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 2),
+		brewed,
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonWaste, 10, 5),
+		valuationMovement(lotCUUID, nil, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 5, 5),
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonUse, 10, 6),
+		valuationMovement(lotCUUID, nil, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonAdjust, 1, 6),
+	}
+
+	// Lot A costs $1.00/kg; lot B costs $1.40/kg plus $10 freight.
+	lines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineAUUID, UnitCostCents: 100, Quantity: 100, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+		{UUID: poLineBUUID, UnitCostCents: 140, Quantity: 100, QuantityUnit: "kg", Currency: "USD", FeeAllocatedCents: 1000, BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	// Every role maps to an account of the same name and every category to
+	// an Inventory sub-account.
+	mapping := accounting.Mapping{XeroTaxRate: "Tax Exempt"}
+	for _, role := range accounting.Roles {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindRole, Key: role, Account: role})
+	}
+	for _, category := range accounting.Categories {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindCategory, Key: category, Account: "Inventory:" + category})
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockInventoryJournalStore
+		procClient     *mockJournalProcurement
+		expectedStatus int
+		validate       func(t *testing.T, journal accounting.Journal)
+	}{
+		{
+			name:           "posts consumption and write-offs at month end",
+			query:          "?period=2026-03",
+			store:          &mockInventoryJournalStore{mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements}},
+			procClient:     &mockJournalProcurement{mockPOLineFetcher: &mockPOLineFetcher{lines: lines}, mapping: mapping},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, journal accounting.Journal) {
+				if len(journal.Entries) != 2 || journal.TotalCents != 7500 {
+					t.Fatalf("expected two entries totalling 7500, got %+v", journal)
+				}
+
+				// 50 kg of lot A at $1.00 into production, 10 kg straight to
+				// cost of goods sold, and 10 kg of lot B at $1.50 landed
+				// written off.
+				consumption := journal.Entries[0]
+				expected := []accounting.Line{
+					{Account: "work_in_process", DebitCents: 5000},
+					{Account: "Inventory:fermentable", CreditCents: 6000},
+					{Account: "cost_of_goods_sold", DebitCents: 1000},
+				}
+				if consumption.Number != "CONS-2026-03" || consumption.Date.Format(time.DateOnly) != "2026-03-31" || len(consumption.Lines) != len(expected) {
+					t.Fatalf("unexpected consumption entry %+v", consumption)
+				}
+				for i, line := range expected {
+					if consumption.Lines[i] != line {
+						t.Errorf("expected line %d to be %+v, got %+v", i, line, consumption.Lines[i])
+					}
+				}
+				writeOff := journal.Entries[1]
+				if writeOff.Number != "WO-2026-03" || writeOff.DebitCents() != 1500 || writeOff.Lines[0].Account != "write_off" {
+					t.Errorf("unexpected write-off entry %+v", writeOff)
+				}
+
+				if len(journal.Skipped) != 1 || !strings.Contains(journal.Skipped[0].Reference, lotCUUID) || journal.Skipped[0].Reason != "no_purchase_order_line" {
+					t.Errorf("expected the uncosted adjustment to be skipped, got %+v", journal.Skipped)
+				}
+			},
+		},
+		{
+			name:           "malformed period",
+			query:          "?period=2026-3",
+			store:          &mockInventoryJournalStore{mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements}},
+			procClient:     &mockJournalProcurement{mockPOLineFetcher: &mockPOLineFetcher{lines: lines}, mapping: mapping},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/accounting/inventory-journal"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryJournal(tt.store, tt.procClient).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var journal accounting.Journal
+			if err := json.NewDecoder(rec.Body).Decode(&journal); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, journal)
+		})
+	}
+}
+
+func TestHandleInventoryJournalExports(t *testing.T) {
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	poLineBUUID := "330e8400-e29b-41d4-a716-446655440002"
+	coldRoomUUID := "440e8400-e29b-41d4-a716-446655440001"
+
+	// 10 kg of lot B, landed at $1.50/kg, is wasted in March.
+	movements := []storage.ValuationMovement{
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 2),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonWaste, 10, 5),
+	}
+	lines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineBUUID, UnitCostCents: 140, Quantity: 100, QuantityUnit: "kg", Currency: "USD", FeeAllocatedCents: 1000, BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	mapping := accounting.Mapping{XeroTaxRate: "Tax Exempt"}
+	for _, role := range accounting.Roles {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindRole, Key: role, Account: role})
+	}
+	for _, category := range accounting.Categories {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindCategory, Key: category, Account: "Inventory:" + category})
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		store          *mockInventoryJournalStore
+		expectedStatus int
+		validate       func(t *testing.T, store *mockInventoryJournalStore, resp dto.JournalExportResponse)
+	}{
+		{
+			name:           "exports the period",
+			body:           `{"period":"2026-03","format":"xero"}`,
+			store:          &mockInventoryJournalStore{mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements}},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockInventoryJournalStore, resp dto.JournalExportResponse) {
+				if resp.EntryCount != 1 || resp.FileName != "inventory-journal-2026-03.csv" {
+					t.Errorf("unexpected export %+v", resp)
+				}
+				if len(store.exports) != 1 {
+					t.Fatalf("expected one stored export, got %d", len(store.exports))
+				}
+				if content := store.exports[0].Content; !strings.Contains(content, ",2026-03-31,") || !strings.Contains(content, ",write_off,Tax Exempt,15.00,") {
+					t.Errorf("unexpected file %s", content)
+				}
+			},
+		},
+		{
+			name: "period already exported",
+			body: `{"period":"2026-03","format":"iif"}`,
+			store: &mockInventoryJournalStore{
+				mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+				exports:            []storage.JournalExport{{UUID: uuid.Must(uuid.NewV4()).String(), Period: "2026-03", Format: "xero"}},
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "re-export of an exported period",
+			body: `{"period":"2026-03","format":"iif","reexport":true}`,
+			store: &mockInventoryJournalStore{
+				mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+				exports:            []storage.JournalExport{{UUID: uuid.Must(uuid.NewV4()).String(), Period: "2026-03", Format: "xero"}},
+			},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockInventoryJournalStore, _ dto.JournalExportResponse) {
+				if len(store.exports) != 2 {
+					t.Errorf("expected a second export, got %d", len(store.exports))
+				}
+			},
+		},
+		{
+			name:           "malformed period",
+			body:           `{"period":"2026-3","format":"iif"}`,
+			store:          &mockInventoryJournalStore{mockValuationStore: &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements}},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			procClient := &mockJournalProcurement{mockPOLineFetcher: &mockPOLineFetcher{lines: lines}, mapping: mapping}
+
+			req := httptest.NewRequest(http.MethodPost, "/accounting/inventory-journal/exports", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryJournalExports(tt.store, procClient).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.JournalExportResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, tt.store, resp)
+		})
+	}
+}
+
+func TestHandleInventoryJournalExportFile(t *testing.T) {
+	exportUUID := "660e8400-e29b-41d4-a716-446655440001"
+
+	tests := []struct {
+		name                string
+		store               *mockInventoryJournalStore
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name: "serves the stored file",
+			store: &mockInventoryJournalStore{exports: []storage.JournalExport{
+				{UUID: exportUUID, Period: "2026-03", Format: "xero", Content: "*JournalNumber,*Date\nWO-2026-03,2026-03-31\n"},
+			}},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "WO-2026-03,2026-03-31",
+		},
+		{
+			name:           "unknown export",
+			store:          &mockInventoryJournalStore{},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "journal export not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/accounting/inventory-journal/exports/"+exportUUID+"/file", nil)
+			req.SetPathValue("uuid", exportUUID)
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryJournalExportFile(tt.store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.expectedContentType != "" && rec.Header().Get("Content-Type") != tt.expectedContentType {
+				t.Errorf("expected content type %q, got %q", tt.expectedContentType, rec.Header().Get("Content-Type"))
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedBody) {
+				t.Errorf("expected body to contain %q, got %q", tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
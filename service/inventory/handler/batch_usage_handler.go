@@ -5,12 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
 	"github.com/brewpipes/brewpipes/service/inventory/storage"
-	"github.com/gofrs/uuid/v5"
 )
 
 // BatchUsageStore is the storage interface for batch usage operations.
@@ -36,34 +34,7 @@ func HandleCreateBatchUsage(db BatchUsageStore) http.HandlerFunc {
 			return
 		}
 
-		usedAt, _ := time.Parse(time.RFC3339, req.UsedAt) // already validated
-
-		var productionUUID *uuid.UUID
-		if req.ProductionRefUUID != nil {
-			parsed, err := uuid.FromString(*req.ProductionRefUUID)
-			if err != nil {
-				http.Error(w, "invalid production_ref_uuid", http.StatusBadRequest)
-				return
-			}
-			productionUUID = &parsed
-		}
-
-		picks := make([]storage.BatchUsagePick, len(req.Picks))
-		for i, p := range req.Picks {
-			picks[i] = storage.BatchUsagePick{
-				IngredientLotUUID: p.IngredientLotUUID,
-				StockLocationUUID: p.StockLocationUUID,
-				Amount:            p.Amount,
-				AmountUnit:        p.AmountUnit,
-			}
-		}
-
-		result, err := db.CreateBatchUsage(r.Context(), storage.BatchUsageRequest{
-			ProductionRefUUID: productionUUID,
-			UsedAt:            usedAt,
-			Picks:             picks,
-			Notes:             req.Notes,
-		})
+		result, err := db.CreateBatchUsage(r.Context(), req.Usage())
 		if err != nil {
 			var validationErr *storage.ErrBatchUsageValidation
 			if errors.As(err, &validationErr) {
@@ -77,3 +48,33 @@ func HandleCreateBatchUsage(db BatchUsageStore) http.HandlerFunc {
 		service.JSONCreated(w, dto.NewBatchUsageResponse(result.Usage, result.Movements))
 	}
 }
+
+// BatchUsageMovementStore is the storage interface for listing a batch's
+// usage movements.
+type BatchUsageMovementStore interface {
+	ListBatchUsageMovements(context.Context, string) ([]storage.BatchUsageMovement, error)
+}
+
+// HandleBatchUsageMovements handles [GET /inventory-usage/batch].
+func HandleBatchUsageMovements(db BatchUsageMovementStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		productionRefUUID := r.URL.Query().Get("production_ref_uuid")
+		if productionRefUUID == "" {
+			http.Error(w, "production_ref_uuid is required", http.StatusBadRequest)
+			return
+		}
+
+		movements, err := db.ListBatchUsageMovements(r.Context(), productionRefUUID)
+		if err != nil {
+			service.InternalError(w, "error listing batch usage movements", "error", err, "production_ref_uuid", productionRefUUID)
+			return
+		}
+
+		service.JSON(w, dto.NewBatchUsageMovementsResponse(movements))
+	}
+}
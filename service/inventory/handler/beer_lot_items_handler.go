@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// BeerLotItemStore defines the storage interface for beer lot item handlers.
+type BeerLotItemStore interface {
+	CreateBeerLotItem(context.Context, storage.BeerLot, storage.BeerLotItem) (storage.BeerLotItem, error)
+	GetBeerLotItemByUUID(context.Context, string) (storage.BeerLotItem, error)
+	GetBeerLotItemByIdentifier(context.Context, string) (storage.BeerLotItem, error)
+	ListBeerLotItems(context.Context, storage.BeerLotItemListFilter) ([]storage.BeerLotItem, error)
+	UpdateBeerLotItem(context.Context, string, storage.UpdateBeerLotItemRequest) (storage.BeerLotItem, error)
+	TransitionBeerLotItem(context.Context, string, storage.BeerLotItemTransition) (storage.BeerLotItem, error)
+	SoftDeleteBeerLotItem(context.Context, string) error
+	ListBeerLotItemEvents(context.Context, string) ([]storage.BeerLotItemEvent, error)
+	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
+	ListKegs(context.Context, storage.KegListFilter) ([]storage.Keg, error)
+}
+
+// HandleBeerLotItems handles [GET /beer-lot-items] and [POST /beer-lot-items].
+func HandleBeerLotItems(db BeerLotItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			filter := storage.BeerLotItemListFilter{}
+			q := r.URL.Query()
+
+			if v := q.Get("beer_lot_uuid"); v != "" {
+				filter.BeerLotUUID = &v
+			}
+			if v := q.Get("status"); v != "" {
+				filter.Status = &v
+			}
+			if v := q.Get("identifier"); v != "" {
+				filter.Identifier = &v
+			}
+
+			items, err := db.ListBeerLotItems(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing beer lot items", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewBeerLotItemsResponse(items))
+
+		case http.MethodPost:
+			var req dto.CreateBeerLotItemRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			lot, ok := service.ResolveFK(r.Context(), w, req.BeerLotUUID, "beer lot", db.GetBeerLotByUUID)
+			if !ok {
+				return
+			}
+
+			item := storage.BeerLotItem{
+				Identifier: req.Identifier,
+				Notes:      req.Notes,
+			}
+			if req.Status != nil {
+				item.Status = *req.Status
+			}
+
+			created, err := db.CreateBeerLotItem(r.Context(), lot, item)
+			if errors.Is(err, storage.ErrDuplicateBeerLotItemIdentifier) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating beer lot item", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewBeerLotItemResponse(created))
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleBeerLotItemByUUID handles [GET /beer-lot-items/{uuid}],
+// [PATCH /beer-lot-items/{uuid}], and [DELETE /beer-lot-items/{uuid}].
+func HandleBeerLotItemByUUID(db BeerLotItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		itemUUID := r.PathValue("uuid")
+		if itemUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			item, err := db.GetBeerLotItemByUUID(r.Context(), itemUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "beer lot item not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting beer lot item", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewBeerLotItemResponse(item))
+
+		case http.MethodPatch:
+			var req dto.UpdateBeerLotItemRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			updated, err := db.UpdateBeerLotItem(r.Context(), itemUUID, storage.UpdateBeerLotItemRequest{
+				Identifier: req.Identifier,
+				Notes:      req.Notes,
+			})
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "beer lot item not found", http.StatusNotFound)
+				return
+			} else if errors.Is(err, storage.ErrDuplicateBeerLotItemIdentifier) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating beer lot item", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewBeerLotItemResponse(updated))
+
+		case http.MethodDelete:
+			err := db.SoftDeleteBeerLotItem(r.Context(), itemUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "beer lot item not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting beer lot item", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleBeerLotItemStatus handles [PATCH /beer-lot-items/{uuid}/status].
+func HandleBeerLotItemStatus(db BeerLotItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		itemUUID := r.PathValue("uuid")
+		if itemUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		var req dto.UpdateBeerLotItemStatusRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		transitionBeerLotItem(w, r, db, itemUUID, storage.BeerLotItemTransition{
+			Status:      req.Status,
+			Destination: req.Destination,
+			OccurredAt:  optionalTime(req.OccurredAt),
+			Notes:       req.Notes,
+		})
+	}
+}
+
+// HandleBeerLotItemScan handles [POST /beer-lot-items/scan]. It resolves the
+// item currently carrying the scanned identifier and applies the status change.
+func HandleBeerLotItemScan(db BeerLotItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.ScanBeerLotItemRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		item, err := beerLotItemByIdentifier(r.Context(), db, req.Identifier)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "beer lot item not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error resolving beer lot item identifier", "error", err)
+			return
+		}
+
+		transitionBeerLotItem(w, r, db, item.UUID.String(), storage.BeerLotItemTransition{
+			Status:      req.Status,
+			Destination: req.Destination,
+			OccurredAt:  optionalTime(req.OccurredAt),
+			Notes:       req.Notes,
+		})
+	}
+}
+
+// beerLotItemIdentifierStore defines the storage methods needed to resolve
+// a beer lot item identifier.
+type beerLotItemIdentifierStore interface {
+	GetBeerLotItemByIdentifier(context.Context, string) (storage.BeerLotItem, error)
+	GetBeerLotItemByUUID(context.Context, string) (storage.BeerLotItem, error)
+	ListKegs(context.Context, storage.KegListFilter) ([]storage.Keg, error)
+}
+
+// beerLotItemByIdentifier returns the beer lot item an identifier refers to.
+// Every fill claims an item under the keg serial, so items from several lots
+// can carry the same identifier. The item a keg with that serial currently
+// holds wins; otherwise the most recently updated item does.
+func beerLotItemByIdentifier(ctx context.Context, db beerLotItemIdentifierStore, identifier string) (storage.BeerLotItem, error) {
+	kegs, err := db.ListKegs(ctx, storage.KegListFilter{Serial: &identifier})
+	if err != nil {
+		return storage.BeerLotItem{}, err
+	}
+	for _, keg := range kegs {
+		if keg.CurrentBeerLotItemUUID != nil {
+			return db.GetBeerLotItemByUUID(ctx, *keg.CurrentBeerLotItemUUID)
+		}
+	}
+	return db.GetBeerLotItemByIdentifier(ctx, identifier)
+}
+
+// HandleBeerLotItemHistory handles [GET /beer-lot-items/{uuid}/history].
+func HandleBeerLotItemHistory(db BeerLotItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		itemUUID := r.PathValue("uuid")
+		if itemUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		if _, err := db.GetBeerLotItemByUUID(r.Context(), itemUUID); errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "beer lot item not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting beer lot item", "error", err)
+			return
+		}
+
+		events, err := db.ListBeerLotItemEvents(r.Context(), itemUUID)
+		if err != nil {
+			service.InternalError(w, "error listing beer lot item history", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewBeerLotItemEventsResponse(events))
+	}
+}
+
+// transitionBeerLotItem applies a status change and writes the response.
+func transitionBeerLotItem(w http.ResponseWriter, r *http.Request, db BeerLotItemStore, itemUUID string, transition storage.BeerLotItemTransition) {
+	item, err := db.TransitionBeerLotItem(r.Context(), itemUUID, transition)
+	if errors.Is(err, service.ErrNotFound) {
+		http.Error(w, "beer lot item not found", http.StatusNotFound)
+		return
+	} else if errors.Is(err, storage.ErrInvalidBeerLotItemTransition) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	} else if err != nil {
+		service.InternalError(w, "error updating beer lot item status", "error", err, "beer_lot_item_uuid", itemUUID)
+		return
+	}
+
+	slog.Info("beer lot item status updated", "beer_lot_item_uuid", itemUUID, "status", transition.Status)
+
+	service.JSON(w, dto.NewBeerLotItemResponse(item))
+}
+
+// optionalTime dereferences an optional timestamp, returning the zero time when nil.
+func optionalTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
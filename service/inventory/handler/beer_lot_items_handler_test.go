@@ -0,0 +1,273 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockBeerLotItemStore implements handler.BeerLotItemStore for testing.
+type mockBeerLotItemStore struct {
+	items                     map[string]storage.BeerLotItem
+	kegs                      []storage.Keg
+	TransitionBeerLotItemFunc func(context.Context, string, storage.BeerLotItemTransition) (storage.BeerLotItem, error)
+}
+
+func (m *mockBeerLotItemStore) CreateBeerLotItem(_ context.Context, lot storage.BeerLot, item storage.BeerLotItem) (storage.BeerLotItem, error) {
+	item.UUID = uuid.Must(uuid.NewV4())
+	item.BeerLotUUID = lot.UUID.String()
+	return item, nil
+}
+
+func (m *mockBeerLotItemStore) GetBeerLotItemByUUID(_ context.Context, itemUUID string) (storage.BeerLotItem, error) {
+	for _, item := range m.items {
+		if item.UUID.String() == itemUUID {
+			return item, nil
+		}
+	}
+	return storage.BeerLotItem{}, service.ErrNotFound
+}
+
+func (m *mockBeerLotItemStore) GetBeerLotItemByIdentifier(_ context.Context, identifier string) (storage.BeerLotItem, error) {
+	if item, ok := m.items[identifier]; ok {
+		return item, nil
+	}
+	return storage.BeerLotItem{}, service.ErrNotFound
+}
+
+func (m *mockBeerLotItemStore) ListBeerLotItems(context.Context, storage.BeerLotItemListFilter) ([]storage.BeerLotItem, error) {
+	return nil, nil
+}
+
+func (m *mockBeerLotItemStore) UpdateBeerLotItem(ctx context.Context, itemUUID string, _ storage.UpdateBeerLotItemRequest) (storage.BeerLotItem, error) {
+	return m.GetBeerLotItemByUUID(ctx, itemUUID)
+}
+
+func (m *mockBeerLotItemStore) TransitionBeerLotItem(ctx context.Context, itemUUID string, transition storage.BeerLotItemTransition) (storage.BeerLotItem, error) {
+	if m.TransitionBeerLotItemFunc != nil {
+		return m.TransitionBeerLotItemFunc(ctx, itemUUID, transition)
+	}
+	item, err := m.GetBeerLotItemByUUID(ctx, itemUUID)
+	if err != nil {
+		return storage.BeerLotItem{}, err
+	}
+	if !storage.CanTransitionBeerLotItem(item.Status, transition.Status) {
+		return storage.BeerLotItem{}, fmt.Errorf("%w: %s to %s", storage.ErrInvalidBeerLotItemTransition, item.Status, transition.Status)
+	}
+	item.Status = transition.Status
+	return item, nil
+}
+
+func (m *mockBeerLotItemStore) SoftDeleteBeerLotItem(context.Context, string) error {
+	return nil
+}
+
+func (m *mockBeerLotItemStore) ListBeerLotItemEvents(context.Context, string) ([]storage.BeerLotItemEvent, error) {
+	return nil, nil
+}
+
+func (m *mockBeerLotItemStore) GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error) {
+	return storage.BeerLot{}, service.ErrNotFound
+}
+
+func (m *mockBeerLotItemStore) ListKegs(_ context.Context, filter storage.KegListFilter) ([]storage.Keg, error) {
+	var kegs []storage.Keg
+	for _, keg := range m.kegs {
+		if filter.Serial == nil || keg.Serial == *filter.Serial {
+			kegs = append(kegs, keg)
+		}
+	}
+	return kegs, nil
+}
+
+func newMockBeerLotItemStore(status string) (*mockBeerLotItemStore, storage.BeerLotItem) {
+	identifier := "KEG-0042"
+	item := storage.BeerLotItem{
+		Identifiers: entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())},
+		BeerLotUUID: uuid.Must(uuid.NewV4()).String(),
+		Status:      status,
+		Identifier:  &identifier,
+	}
+	return &mockBeerLotItemStore{items: map[string]storage.BeerLotItem{identifier: item}}, item
+}
+
+func TestHandleBeerLotItemStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		itemUUID   string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "available to sold returns 200",
+			status:     storage.BeerLotItemStatusAvailable,
+			body:       `{"status": "sold", "destination": "The Rusty Tap"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"status":"sold"`,
+		},
+		{
+			name:       "invalid status returns 400",
+			status:     storage.BeerLotItemStatusAvailable,
+			body:       `{"status": "lost"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "invalid status",
+		},
+		{
+			name:       "missing status returns 400",
+			status:     storage.BeerLotItemStatusAvailable,
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "status is required",
+		},
+		{
+			name:       "destroyed is terminal and returns 409",
+			status:     storage.BeerLotItemStatusDestroyed,
+			body:       `{"status": "available"}`,
+			wantStatus: http.StatusConflict,
+			wantBody:   "destroyed to available",
+		},
+		{
+			name:       "sold cannot be reserved and returns 409",
+			status:     storage.BeerLotItemStatusSold,
+			body:       `{"status": "reserved"}`,
+			wantStatus: http.StatusConflict,
+			wantBody:   "invalid beer lot item status transition",
+		},
+		{
+			name:       "unknown item returns 404",
+			status:     storage.BeerLotItemStatusAvailable,
+			itemUUID:   "cccccccc-cccc-cccc-cccc-cccccccccccc",
+			body:       `{"status": "sold"}`,
+			wantStatus: http.StatusNotFound,
+			wantBody:   "beer lot item not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, item := newMockBeerLotItemStore(tt.status)
+			itemUUID := item.UUID.String()
+			if tt.itemUUID != "" {
+				itemUUID = tt.itemUUID
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle("PATCH /beer-lot-items/{uuid}/status", handler.HandleBeerLotItemStatus(store))
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/beer-lot-items/"+itemUUID+"/status", bytes.NewBufferString(tt.body))
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body does not contain %q; got: %s", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandleBeerLotItemScan(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "returned keg scanned back in returns 200",
+			status:     storage.BeerLotItemStatusSold,
+			body:       `{"identifier": "KEG-0042", "status": "returned"}`,
+			wantStatus: http.StatusOK,
+			wantBody:   `"status":"returned"`,
+		},
+		{
+			name:       "unknown identifier returns 404",
+			status:     storage.BeerLotItemStatusSold,
+			body:       `{"identifier": "KEG-9999", "status": "returned"}`,
+			wantStatus: http.StatusNotFound,
+			wantBody:   "beer lot item not found",
+		},
+		{
+			name:       "missing identifier returns 400",
+			status:     storage.BeerLotItemStatusSold,
+			body:       `{"status": "returned"}`,
+			wantStatus: http.StatusBadRequest,
+			wantBody:   "identifier is required",
+		},
+		{
+			name:       "invalid transition returns 409",
+			status:     storage.BeerLotItemStatusAvailable,
+			body:       `{"identifier": "KEG-0042", "status": "returned"}`,
+			wantStatus: http.StatusConflict,
+			wantBody:   "available to returned",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, _ := newMockBeerLotItemStore(tt.status)
+
+			h := handler.HandleBeerLotItemScan(store)
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/beer-lot-items/scan", bytes.NewBufferString(tt.body))
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body does not contain %q; got: %s", tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandleBeerLotItemScanRefilledKeg(t *testing.T) {
+	// Lot B's item claimed KEG-0042 first; the keg was then cleaned and
+	// refilled from the older lot A. Both items carry the serial, and the
+	// identifier lookup alone returns lot B's.
+	serial := "KEG-0042"
+	newer := storage.BeerLotItem{
+		Identifiers: entity.Identifiers{ID: 2, UUID: uuid.Must(uuid.NewV4())},
+		BeerLotUUID: "lot-b",
+		Status:      storage.BeerLotItemStatusReturned,
+		Identifier:  &serial,
+	}
+	older := storage.BeerLotItem{
+		Identifiers: entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())},
+		BeerLotUUID: "lot-a",
+		Status:      storage.BeerLotItemStatusSold,
+		Identifier:  &serial,
+	}
+	current := older.UUID.String()
+	store := &mockBeerLotItemStore{
+		items: map[string]storage.BeerLotItem{serial: newer, "lot-a-item": older},
+		kegs:  []storage.Keg{{Serial: serial, Status: storage.KegStatusDeployed, CurrentBeerLotItemUUID: &current}},
+	}
+
+	h := handler.HandleBeerLotItemScan(store)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/beer-lot-items/scan", bytes.NewBufferString(`{"identifier": "KEG-0042", "status": "returned"}`))
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if !strings.Contains(rec.Body.String(), current) {
+		t.Errorf("expected the keg's current item %s, got: %s", current, rec.Body.String())
+	}
+}
@@ -14,8 +14,8 @@ import (
 )
 
 type BeerLotStore interface {
-	CreateBeerLot(context.Context, storage.BeerLot) (storage.BeerLot, error)
-	CreateBeerLotWithMovement(ctx context.Context, lot storage.BeerLot, stockLocationID int64, movementAmount int64, movementAmountUnit string) (storage.BeerLot, uuid.UUID, error)
+	CreateBeerLot(context.Context, storage.BeerLot, []storage.BeerLotItem) (storage.BeerLot, []storage.BeerLotItem, error)
+	CreateBeerLotWithMovement(ctx context.Context, lot storage.BeerLot, items []storage.BeerLotItem, stockLocationID int64, movementAmount int64, movementAmountUnit string) (storage.BeerLot, []storage.BeerLotItem, uuid.UUID, error)
 	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
 	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
 	ListBeerLots(context.Context) ([]storage.BeerLot, error)
@@ -118,6 +118,8 @@ func HandleBeerLots(db BeerLotStore) http.HandlerFunc {
 				Notes:               req.Notes,
 			}
 
+			items := BeerLotItemsForCreate(req)
+
 			// If stock_location_uuid is provided, create lot with movement atomically.
 			if req.StockLocationUUID != nil {
 				location, ok := service.ResolveFK(r.Context(), w, *req.StockLocationUUID, "stock location", db.GetStockLocationByUUID)
@@ -128,29 +130,58 @@ func HandleBeerLots(db BeerLotStore) http.HandlerFunc {
 				movementAmount := int64(*req.Quantity) * *req.VolumePerUnit
 				movementAmountUnit := *req.VolumePerUnitUnit
 
-				created, _, err := db.CreateBeerLotWithMovement(r.Context(), lot, location.ID, movementAmount, movementAmountUnit)
-				if err != nil {
+				created, createdItems, _, err := db.CreateBeerLotWithMovement(r.Context(), lot, items, location.ID, movementAmount, movementAmountUnit)
+				if errors.Is(err, storage.ErrDuplicateBeerLotItemIdentifier) {
+					http.Error(w, err.Error(), http.StatusConflict)
+					return
+				} else if err != nil {
 					service.InternalError(w, "error creating beer lot with movement", "error", err)
 					return
 				}
 
-				service.JSONCreated(w, dto.NewBeerLotResponse(created))
+				resp := dto.NewBeerLotResponse(created)
+				resp.Items = dto.NewBeerLotItemsResponse(createdItems)
+				service.JSONCreated(w, resp)
 				return
 			}
 
-			created, err := db.CreateBeerLot(r.Context(), lot)
-			if err != nil {
+			created, createdItems, err := db.CreateBeerLot(r.Context(), lot, items)
+			if errors.Is(err, storage.ErrDuplicateBeerLotItemIdentifier) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
 				service.InternalError(w, "error creating beer lot", "error", err)
 				return
 			}
 
-			service.JSONCreated(w, dto.NewBeerLotResponse(created))
+			resp := dto.NewBeerLotResponse(created)
+			resp.Items = dto.NewBeerLotItemsResponse(createdItems)
+			service.JSONCreated(w, resp)
 		default:
 			service.MethodNotAllowed(w)
 		}
 	}
 }
 
+// BeerLotItemsForCreate returns the trackable items to create alongside a new
+// beer lot. Keg lots get one item per unit so each keg can be followed
+// individually; other containers are tracked at the lot level only.
+func BeerLotItemsForCreate(req dto.CreateBeerLotRequest) []storage.BeerLotItem {
+	if req.Container == nil || *req.Container != storage.BeerLotContainerKeg || req.Quantity == nil {
+		return nil
+	}
+
+	items := make([]storage.BeerLotItem, *req.Quantity)
+	for i := range items {
+		items[i].Status = storage.BeerLotItemStatusAvailable
+		if len(req.ItemIdentifiers) > 0 {
+			identifier := req.ItemIdentifiers[i]
+			items[i].Identifier = &identifier
+		}
+	}
+	return items
+}
+
 // HandleBeerLotByUUID handles [GET /beer-lots/{uuid}].
 func HandleBeerLotByUUID(db BeerLotStore) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
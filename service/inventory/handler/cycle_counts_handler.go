@@ -0,0 +1,486 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CycleCountStore defines the storage methods needed by the cycle count handlers.
+type CycleCountStore interface {
+	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
+	GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error)
+	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
+	CreateCycleCount(context.Context, int64, bool, *string) (storage.CycleCount, error)
+	GetCycleCountByUUID(context.Context, string) (storage.CycleCount, error)
+	ListCycleCounts(context.Context, storage.CycleCountFilter) ([]storage.CycleCount, error)
+	ListCycleCountLines(context.Context, int64) ([]storage.CycleCountLine, error)
+	RecordCycleCountEntries(context.Context, int64, []storage.CycleCountEntry) error
+	PostCycleCount(context.Context, int64, map[int64]storage.CycleCountLineValue) error
+	CancelCycleCount(context.Context, int64) error
+	ListCycleCountAccuracy(context.Context, time.Time, time.Time, *string) ([]storage.CycleCountAccuracy, error)
+}
+
+// HandleCycleCounts handles [GET /cycle-counts] and [POST /cycle-counts].
+// Sessions can be listed by stock_location_uuid or status. Opening a session
+// freezes the expected balance of every lot at the location.
+func HandleCycleCounts(db CycleCountStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var filter storage.CycleCountFilter
+			q := r.URL.Query()
+			if v := q.Get("stock_location_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid stock_location_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.StockLocationUUID = &v
+			}
+			if v := q.Get("status"); v != "" {
+				filter.Status = &v
+			}
+
+			counts, err := db.ListCycleCounts(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing cycle counts", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewCycleCountsResponse(counts))
+		case http.MethodPost:
+			var req dto.CreateCycleCountRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			location, ok := service.ResolveFK(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID)
+			if !ok {
+				return
+			}
+
+			count, err := db.CreateCycleCount(r.Context(), location.ID, req.Blind, req.Notes)
+			if errors.Is(err, storage.ErrCycleCountAlreadyOpen) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating cycle count", "error", err)
+				return
+			}
+
+			lines, err := db.ListCycleCountLines(r.Context(), count.ID)
+			if err != nil {
+				service.InternalError(w, "error listing cycle count lines", "error", err)
+				return
+			}
+
+			slog.Info("cycle count opened",
+				"cycle_count_uuid", count.UUID,
+				"stock_location_uuid", location.UUID,
+				"lines", len(lines),
+				"blind", count.Blind)
+
+			service.JSONCreated(w, dto.NewCycleCountSheetResponse(count, lines, nil))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleCycleCountByUUID handles [GET /cycle-counts/{uuid}], the count sheet
+// with variances and their value.
+func HandleCycleCountByUUID(db CycleCountStore, procClient POLineFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		count, ok := getCycleCount(w, r, db)
+		if !ok {
+			return
+		}
+		writeCycleCountSheet(w, r, db, procClient, count, http.StatusOK)
+	}
+}
+
+// HandleCycleCountEntries handles [PUT /cycle-counts/{uuid}/counts], which
+// records counted quantities on an open session and returns the sheet.
+func HandleCycleCountEntries(db CycleCountStore, procClient POLineFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.RecordCycleCountRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		count, ok := getCycleCount(w, r, db)
+		if !ok {
+			return
+		}
+		if count.Status != storage.CycleCountStatusOpen {
+			http.Error(w, storage.ErrCycleCountNotOpen.Error(), http.StatusConflict)
+			return
+		}
+
+		lines, err := db.ListCycleCountLines(r.Context(), count.ID)
+		if err != nil {
+			service.InternalError(w, "error listing cycle count lines", "error", err)
+			return
+		}
+		entries, ok := resolveCycleCountEntries(w, r, db, lines, req.Counts)
+		if !ok {
+			return
+		}
+
+		err = db.RecordCycleCountEntries(r.Context(), count.ID, entries)
+		if errors.Is(err, storage.ErrCycleCountNotOpen) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error recording cycle count", "error", err)
+			return
+		}
+
+		writeCycleCountSheet(w, r, db, procClient, count, http.StatusOK)
+	}
+}
+
+// HandleCycleCountPost handles [POST /cycle-counts/{uuid}/post]. Every line
+// must have been counted. Each variance becomes a cycle_count adjustment with
+// its movement, and its value is recorded on the line.
+func HandleCycleCountPost(db CycleCountStore, procClient POLineFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		count, ok := getCycleCount(w, r, db)
+		if !ok {
+			return
+		}
+		if count.Status != storage.CycleCountStatusOpen {
+			http.Error(w, storage.ErrCycleCountNotOpen.Error(), http.StatusConflict)
+			return
+		}
+
+		lines, err := db.ListCycleCountLines(r.Context(), count.ID)
+		if err != nil {
+			service.InternalError(w, "error listing cycle count lines", "error", err)
+			return
+		}
+		for _, line := range lines {
+			if line.CountedAmount == nil {
+				http.Error(w, storage.ErrCycleCountIncomplete.Error(), http.StatusConflict)
+				return
+			}
+		}
+
+		values, err := valueCycleCountLines(r.Context(), procClient, lines)
+		if err != nil {
+			slog.Warn("failed to value cycle count variances",
+				"cycle_count_uuid", count.UUID,
+				"error", err)
+		}
+
+		err = db.PostCycleCount(r.Context(), count.ID, values)
+		if errors.Is(err, storage.ErrCycleCountNotOpen) || errors.Is(err, storage.ErrCycleCountIncomplete) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error posting cycle count", "error", err)
+			return
+		}
+
+		slog.Info("cycle count posted", "cycle_count_uuid", count.UUID)
+
+		posted, err := db.GetCycleCountByUUID(r.Context(), count.UUID.String())
+		if err != nil {
+			service.InternalError(w, "error getting cycle count", "error", err)
+			return
+		}
+		writeCycleCountSheet(w, r, db, procClient, posted, http.StatusOK)
+	}
+}
+
+// HandleCycleCountCancel handles [POST /cycle-counts/{uuid}/cancel], which
+// abandons an open session without adjusting stock.
+func HandleCycleCountCancel(db CycleCountStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		count, ok := getCycleCount(w, r, db)
+		if !ok {
+			return
+		}
+
+		err := db.CancelCycleCount(r.Context(), count.ID)
+		if errors.Is(err, storage.ErrCycleCountNotOpen) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error cancelling cycle count", "error", err)
+			return
+		}
+
+		slog.Info("cycle count cancelled", "cycle_count_uuid", count.UUID)
+
+		count.Status = storage.CycleCountStatusCancelled
+		service.JSON(w, dto.NewCycleCountResponse(count))
+	}
+}
+
+// HandleCycleCountAccuracy handles [GET /cycle-count-reports/accuracy]. The
+// from and to query parameters (YYYY-MM-DD, inclusive) default to the start
+// of the month eleven months ago and today; stock_location_uuid limits the
+// report to one location.
+func HandleCycleCountAccuracy(db CycleCountStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		q := r.URL.Query()
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		from := time.Date(today.Year(), today.Month()-11, 1, 0, 0, 0, 0, time.UTC)
+		to := today
+		if v := q.Get("from"); v != "" {
+			parsed, err := dto.ParseValuationDate("from", v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			from = parsed
+		}
+		if v := q.Get("to"); v != "" {
+			parsed, err := dto.ParseValuationDate("to", v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			to = parsed
+		}
+		if to.Before(from) {
+			http.Error(w, "to must not be before from", http.StatusBadRequest)
+			return
+		}
+
+		var locationUUID *string
+		if v := q.Get("stock_location_uuid"); v != "" {
+			if _, err := uuid.FromString(v); err != nil {
+				http.Error(w, "invalid stock_location_uuid", http.StatusBadRequest)
+				return
+			}
+			locationUUID = &v
+		}
+
+		counts, err := db.ListCycleCountAccuracy(r.Context(), from, to.AddDate(0, 0, 1), locationUUID)
+		if err != nil {
+			service.InternalError(w, "error listing cycle count accuracy", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewCycleCountAccuracyResponse(from, to, counts))
+	}
+}
+
+func getCycleCount(w http.ResponseWriter, r *http.Request, db CycleCountStore) (storage.CycleCount, bool) {
+	countUUID := r.PathValue("uuid")
+	if countUUID == "" {
+		http.Error(w, "invalid uuid", http.StatusBadRequest)
+		return storage.CycleCount{}, false
+	}
+
+	count, err := db.GetCycleCountByUUID(r.Context(), countUUID)
+	if errors.Is(err, service.ErrNotFound) {
+		http.Error(w, "cycle count not found", http.StatusNotFound)
+		return storage.CycleCount{}, false
+	} else if err != nil {
+		service.InternalError(w, "error getting cycle count", "error", err)
+		return storage.CycleCount{}, false
+	}
+
+	return count, true
+}
+
+// writeCycleCountSheet responds with a session and its count sheet. Variances
+// of an open session that is not blind are valued at current lot costs; a
+// failed cost lookup leaves them unvalued.
+func writeCycleCountSheet(w http.ResponseWriter, r *http.Request, db CycleCountStore, procClient POLineFetcher, count storage.CycleCount, status int) {
+	lines, err := db.ListCycleCountLines(r.Context(), count.ID)
+	if err != nil {
+		service.InternalError(w, "error listing cycle count lines", "error", err)
+		return
+	}
+
+	var values map[int64]storage.CycleCountLineValue
+	if count.Status == storage.CycleCountStatusOpen && !count.Blind {
+		values, err = valueCycleCountLines(r.Context(), procClient, lines)
+		if err != nil {
+			slog.Warn("failed to value cycle count variances",
+				"cycle_count_uuid", count.UUID,
+				"error", err)
+		}
+	}
+
+	resp := dto.NewCycleCountSheetResponse(count, lines, values)
+	if status == http.StatusCreated {
+		service.JSONCreated(w, resp)
+		return
+	}
+	service.JSON(w, resp)
+}
+
+// resolveCycleCountEntries maps counted entries onto the count sheet. Lots
+// counted by UUID are matched to their line in the same unit, or added to
+// the sheet. It writes an error response and returns false if an entry
+// cannot be resolved.
+func resolveCycleCountEntries(w http.ResponseWriter, r *http.Request, db CycleCountStore, lines []storage.CycleCountLine, counts []dto.CycleCountEntryRequest) ([]storage.CycleCountEntry, bool) {
+	type lotKey struct {
+		ingredientLotID int64
+		beerLotID       int64
+		unit            string
+	}
+	linesByUUID := make(map[string]storage.CycleCountLine, len(lines))
+	linesByLot := make(map[lotKey]int64, len(lines))
+	for _, line := range lines {
+		linesByUUID[line.UUID.String()] = line
+		key := lotKey{unit: line.AmountUnit}
+		if line.IngredientLotID != nil {
+			key.ingredientLotID = *line.IngredientLotID
+		}
+		if line.BeerLotID != nil {
+			key.beerLotID = *line.BeerLotID
+		}
+		linesByLot[key] = line.ID
+	}
+
+	entries := make([]storage.CycleCountEntry, 0, len(counts))
+	seen := make(map[lotKey]bool, len(counts))
+	for _, c := range counts {
+		entry := storage.CycleCountEntry{CountedAmount: *c.CountedAmount, Notes: c.Notes}
+
+		if c.LineUUID != nil {
+			line, ok := linesByUUID[*c.LineUUID]
+			if !ok {
+				http.Error(w, "cycle count line "+*c.LineUUID+" is not on this count sheet", http.StatusBadRequest)
+				return nil, false
+			}
+			entry.LineID = &line.ID
+			entries = append(entries, entry)
+			continue
+		}
+
+		key := lotKey{unit: strings.TrimSpace(*c.AmountUnit)}
+		if c.IngredientLotUUID != nil {
+			lot, ok := service.ResolveFK(r.Context(), w, *c.IngredientLotUUID, "ingredient lot", db.GetIngredientLotByUUID)
+			if !ok {
+				return nil, false
+			}
+			key.ingredientLotID = lot.ID
+			entry.IngredientLotID = &lot.ID
+		} else {
+			lot, ok := service.ResolveFK(r.Context(), w, *c.BeerLotUUID, "beer lot", db.GetBeerLotByUUID)
+			if !ok {
+				return nil, false
+			}
+			key.beerLotID = lot.ID
+			entry.BeerLotID = &lot.ID
+		}
+
+		if lineID, ok := linesByLot[key]; ok {
+			entry.LineID = &lineID
+			entry.IngredientLotID, entry.BeerLotID = nil, nil
+		} else if seen[key] {
+			http.Error(w, "a lot is counted more than once in the same unit", http.StatusBadRequest)
+			return nil, false
+		} else {
+			seen[key] = true
+			entry.AmountUnit = key.unit
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, true
+}
+
+// valueCycleCountLines values the variance of each counted ingredient lot
+// line at the lot's landed unit cost in the base currency, as inventory
+// valuation does, keyed by line ID. Lines that cannot be costed and beer lot
+// lines are left out.
+func valueCycleCountLines(ctx context.Context, procClient POLineFetcher, lines []storage.CycleCountLine) (map[int64]storage.CycleCountLineValue, error) {
+	var lots []storage.ValuationMovement
+	for _, line := range lines {
+		variance := line.Variance()
+		if line.IngredientLotUUID == nil || variance == nil || *variance == 0 {
+			continue
+		}
+		lot := storage.ValuationMovement{
+			IngredientLotUUID:     *line.IngredientLotUUID,
+			PurchaseOrderLineUUID: line.PurchaseOrderLineUUID,
+			AmountUnit:            line.AmountUnit,
+		}
+		if line.LotReceivedUnit != nil {
+			lot.LotReceivedUnit = *line.LotReceivedUnit
+		}
+		lots = append(lots, lot)
+	}
+	if len(lots) == 0 {
+		return nil, nil
+	}
+
+	costs, err := loadLotCosts(ctx, procClient, lots)
+	if err != nil {
+		return nil, err
+	}
+	if costs.baseCurrency == "" {
+		return nil, nil
+	}
+
+	values := make(map[int64]storage.CycleCountLineValue)
+	for _, line := range lines {
+		if line.IngredientLotUUID == nil {
+			continue
+		}
+		unitCost, ok := costs.unitCosts[*line.IngredientLotUUID]
+		variance := line.Variance()
+		if !ok || variance == nil || *variance == 0 {
+			continue
+		}
+		values[line.ID] = storage.CycleCountLineValue{
+			VarianceValueCents: int64(math.Round(float64(*variance) * unitCost)),
+			Currency:           costs.baseCurrency,
+		}
+	}
+
+	return values, nil
+}
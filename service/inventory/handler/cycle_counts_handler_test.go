@@ -0,0 +1,393 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockCycleCountStore implements handler.CycleCountStore for testing.
+type mockCycleCountStore struct {
+	count    storage.CycleCount
+	lines    []storage.CycleCountLine
+	recorded []storage.CycleCountEntry
+	posted   map[int64]storage.CycleCountLineValue
+	accuracy []storage.CycleCountAccuracy
+}
+
+func (m *mockCycleCountStore) GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error) {
+	return storage.StockLocation{}, service.ErrNotFound
+}
+
+func (m *mockCycleCountStore) GetIngredientLotByUUID(_ context.Context, lotUUID string) (storage.IngredientLot, error) {
+	var lot storage.IngredientLot
+	lot.ID = 30
+	lot.UUID = uuid.Must(uuid.FromString(lotUUID))
+	return lot, nil
+}
+
+func (m *mockCycleCountStore) GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error) {
+	return storage.BeerLot{}, service.ErrNotFound
+}
+
+func (m *mockCycleCountStore) CreateCycleCount(context.Context, int64, bool, *string) (storage.CycleCount, error) {
+	return storage.CycleCount{}, storage.ErrCycleCountAlreadyOpen
+}
+
+func (m *mockCycleCountStore) GetCycleCountByUUID(context.Context, string) (storage.CycleCount, error) {
+	return m.count, nil
+}
+
+func (m *mockCycleCountStore) ListCycleCounts(context.Context, storage.CycleCountFilter) ([]storage.CycleCount, error) {
+	return []storage.CycleCount{m.count}, nil
+}
+
+func (m *mockCycleCountStore) ListCycleCountLines(context.Context, int64) ([]storage.CycleCountLine, error) {
+	return m.lines, nil
+}
+
+func (m *mockCycleCountStore) RecordCycleCountEntries(_ context.Context, _ int64, entries []storage.CycleCountEntry) error {
+	m.recorded = append(m.recorded, entries...)
+	return nil
+}
+
+func (m *mockCycleCountStore) PostCycleCount(_ context.Context, _ int64, values map[int64]storage.CycleCountLineValue) error {
+	m.posted = values
+	m.count.Status = storage.CycleCountStatusPosted
+	for i, line := range m.lines {
+		if value, ok := values[line.ID]; ok {
+			m.lines[i].VarianceValueCents = &value.VarianceValueCents
+			m.lines[i].ValueCurrency = &value.Currency
+		}
+	}
+	return nil
+}
+
+func (m *mockCycleCountStore) CancelCycleCount(context.Context, int64) error {
+	return nil
+}
+
+func (m *mockCycleCountStore) ListCycleCountAccuracy(context.Context, time.Time, time.Time, *string) ([]storage.CycleCountAccuracy, error) {
+	return m.accuracy, nil
+}
+
+func TestHandleCycleCountByUUID(t *testing.T) {
+	lotAUUID := "220e8400-e29b-41d4-a716-446655440001"
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	poLineAUUID := "330e8400-e29b-41d4-a716-446655440001"
+	lotAID, lotBID := int64(10), int64(11)
+	kg := "kg"
+	counted := int64(96)
+
+	count := storage.CycleCount{StockLocationName: "Cold room", Status: storage.CycleCountStatusOpen}
+	count.ID = 1
+	count.UUID = uuid.Must(uuid.NewV4())
+	blindCount := count
+	blindCount.Blind = true
+
+	// Lot A, bought at $1.00/kg, was counted at 96 of 100 kg; lot B is
+	// still to be counted.
+	lineA := storage.CycleCountLine{IngredientLotID: &lotAID, IngredientLotUUID: &lotAUUID, PurchaseOrderLineUUID: &poLineAUUID, LotReceivedUnit: &kg, AmountUnit: "kg", ExpectedAmount: 100, CountedAmount: &counted}
+	lineA.ID = 101
+	lineA.UUID = uuid.Must(uuid.NewV4())
+	lineB := storage.CycleCountLine{IngredientLotID: &lotBID, IngredientLotUUID: &lotBUUID, LotReceivedUnit: &kg, AmountUnit: "kg", ExpectedAmount: 50}
+	lineB.ID = 102
+	lineB.UUID = uuid.Must(uuid.NewV4())
+
+	poLines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineAUUID, UnitCostCents: 100, Quantity: 100, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	tests := []struct {
+		name     string
+		store    *mockCycleCountStore
+		validate func(t *testing.T, resp dto.CycleCountResponse)
+	}{
+		{
+			name:  "values variances of an open count",
+			store: &mockCycleCountStore{count: count, lines: []storage.CycleCountLine{lineA, lineB}},
+			validate: func(t *testing.T, resp dto.CycleCountResponse) {
+				line := resp.Lines[0]
+				if line.Variance == nil || *line.Variance != -4 {
+					t.Fatalf("expected variance -4, got %v", line.Variance)
+				}
+				if line.VarianceValueCents == nil || *line.VarianceValueCents != -400 {
+					t.Errorf("expected variance value -400, got %v", line.VarianceValueCents)
+				}
+				if resp.Summary.LinesTotal != 2 || resp.Summary.LinesCounted != 1 || resp.Summary.LinesWithVariance != 1 {
+					t.Errorf("unexpected summary %+v", resp.Summary)
+				}
+				if resp.Lines[1].ExpectedAmount == nil || *resp.Lines[1].ExpectedAmount != 50 {
+					t.Errorf("expected 50 kg expected on the uncounted line, got %v", resp.Lines[1].ExpectedAmount)
+				}
+			},
+		},
+		{
+			name:  "blind count hides expected amounts",
+			store: &mockCycleCountStore{count: blindCount, lines: []storage.CycleCountLine{lineA, lineB}},
+			validate: func(t *testing.T, resp dto.CycleCountResponse) {
+				for _, line := range resp.Lines {
+					if line.ExpectedAmount != nil || line.Variance != nil || line.VarianceValueCents != nil {
+						t.Errorf("expected hidden amounts on a blind count, got %+v", line)
+					}
+				}
+				if resp.Lines[0].CountedAmount == nil || *resp.Lines[0].CountedAmount != 96 {
+					t.Errorf("expected counted amount 96, got %v", resp.Lines[0].CountedAmount)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/cycle-counts/"+count.UUID.String(), nil)
+			req.SetPathValue("uuid", count.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleCycleCountByUUID(tt.store, &mockPOLineFetcher{lines: poLines}).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var resp dto.CycleCountResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandleCycleCountEntries(t *testing.T) {
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	lotCUUID := "220e8400-e29b-41d4-a716-446655440003"
+	lotBID := int64(11)
+	kg := "kg"
+
+	count := storage.CycleCount{StockLocationName: "Cold room", Status: storage.CycleCountStatusOpen}
+	count.ID = 1
+	count.UUID = uuid.Must(uuid.NewV4())
+	postedCount := count
+	postedCount.Status = storage.CycleCountStatusPosted
+
+	// Lot B is expected at 50 kg and not yet counted.
+	lineB := storage.CycleCountLine{IngredientLotID: &lotBID, IngredientLotUUID: &lotBUUID, LotReceivedUnit: &kg, AmountUnit: "kg", ExpectedAmount: 50}
+	lineB.ID = 102
+	lineB.UUID = uuid.Must(uuid.NewV4())
+
+	tests := []struct {
+		name           string
+		store          *mockCycleCountStore
+		body           string
+		expectedStatus int
+		validate       func(t *testing.T, store *mockCycleCountStore)
+	}{
+		{
+			name:           "records existing and new lines",
+			store:          &mockCycleCountStore{count: count, lines: []storage.CycleCountLine{lineB}},
+			body:           `{"counts":[{"line_uuid":"` + lineB.UUID.String() + `","counted_amount":50},{"ingredient_lot_uuid":"` + lotCUUID + `","amount_unit":"kg","counted_amount":12}]}`,
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, store *mockCycleCountStore) {
+				if len(store.recorded) != 2 {
+					t.Fatalf("expected 2 entries recorded, got %d", len(store.recorded))
+				}
+				if got := store.recorded[0]; got.LineID == nil || *got.LineID != 102 || got.CountedAmount != 50 {
+					t.Errorf("expected line 102 counted at 50, got %+v", got)
+				}
+				if got := store.recorded[1]; got.LineID != nil || got.IngredientLotID == nil || *got.IngredientLotID != 30 || got.AmountUnit != "kg" {
+					t.Errorf("expected new line for lot 30 in kg, got %+v", got)
+				}
+			},
+		},
+		{
+			name:           "unknown line",
+			store:          &mockCycleCountStore{count: count, lines: []storage.CycleCountLine{lineB}},
+			body:           `{"counts":[{"line_uuid":"` + uuid.Must(uuid.NewV4()).String() + `","counted_amount":1}]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "posted count",
+			store:          &mockCycleCountStore{count: postedCount, lines: []storage.CycleCountLine{lineB}},
+			body:           `{"counts":[{"line_uuid":"` + lineB.UUID.String() + `","counted_amount":50}]}`,
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/cycle-counts/"+count.UUID.String()+"/counts", strings.NewReader(tt.body))
+			req.SetPathValue("uuid", count.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleCycleCountEntries(tt.store, &mockPOLineFetcher{}).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate != nil {
+				tt.validate(t, tt.store)
+			}
+		})
+	}
+}
+
+func TestHandleCycleCountPost(t *testing.T) {
+	lotAUUID := "220e8400-e29b-41d4-a716-446655440001"
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	poLineAUUID := "330e8400-e29b-41d4-a716-446655440001"
+	lotAID, lotBID := int64(10), int64(11)
+	kg := "kg"
+	countedA, countedB := int64(96), int64(53)
+
+	count := storage.CycleCount{StockLocationName: "Cold room", Status: storage.CycleCountStatusOpen, Blind: true}
+	count.ID = 1
+	count.UUID = uuid.Must(uuid.NewV4())
+
+	// Lot A, bought at $1.00/kg, was counted at 96 of 100 kg. Lot B has no
+	// order line and is either uncounted or counted at 53 of 50 kg.
+	lineA := storage.CycleCountLine{IngredientLotID: &lotAID, IngredientLotUUID: &lotAUUID, PurchaseOrderLineUUID: &poLineAUUID, LotReceivedUnit: &kg, AmountUnit: "kg", ExpectedAmount: 100, CountedAmount: &countedA}
+	lineA.ID = 101
+	lineA.UUID = uuid.Must(uuid.NewV4())
+	uncountedLineB := storage.CycleCountLine{IngredientLotID: &lotBID, IngredientLotUUID: &lotBUUID, LotReceivedUnit: &kg, AmountUnit: "kg", ExpectedAmount: 50}
+	uncountedLineB.ID = 102
+	uncountedLineB.UUID = uuid.Must(uuid.NewV4())
+	countedLineB := uncountedLineB
+	countedLineB.CountedAmount = &countedB
+
+	poLines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineAUUID, UnitCostCents: 100, Quantity: 100, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	tests := []struct {
+		name           string
+		store          *mockCycleCountStore
+		expectedStatus int
+		validate       func(t *testing.T, store *mockCycleCountStore, resp dto.CycleCountResponse)
+	}{
+		{
+			name:           "incomplete count",
+			store:          &mockCycleCountStore{count: count, lines: []storage.CycleCountLine{lineA, uncountedLineB}},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "posts with variance values",
+			store:          &mockCycleCountStore{count: count, lines: []storage.CycleCountLine{lineA, countedLineB}},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, store *mockCycleCountStore, resp dto.CycleCountResponse) {
+				if value, ok := store.posted[101]; !ok || value.VarianceValueCents != -400 || value.Currency != "USD" {
+					t.Errorf("expected line 101 valued at -400 USD, got %+v", store.posted)
+				}
+				if _, ok := store.posted[102]; ok {
+					t.Error("expected lot without a purchase order line to be unvalued")
+				}
+				if resp.Status != storage.CycleCountStatusPosted {
+					t.Errorf("expected status posted, got %s", resp.Status)
+				}
+				if resp.Summary.LinesWithVariance != 2 || resp.Summary.VarianceValueCents != -400 || resp.Summary.UnvaluedVariances != 1 {
+					t.Errorf("unexpected summary %+v", resp.Summary)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/cycle-counts/"+count.UUID.String()+"/post", nil)
+			req.SetPathValue("uuid", count.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleCycleCountPost(tt.store, &mockPOLineFetcher{lines: poLines}).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				if tt.store.posted != nil {
+					t.Error("expected count not to be posted")
+				}
+				return
+			}
+
+			var resp dto.CycleCountResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, tt.store, resp)
+		})
+	}
+}
+
+func TestHandleCycleCountAccuracy(t *testing.T) {
+	usd := "USD"
+
+	// Two January counts with variances and a clean February count.
+	accuracy := []storage.CycleCountAccuracy{
+		{CycleCountUUID: "a", PostedAt: time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC), LinesCounted: 10, LinesAccurate: 9, NetVarianceValueCents: -500, AbsoluteVarianceValueCents: 500, ValueCurrency: &usd},
+		{CycleCountUUID: "b", PostedAt: time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC), LinesCounted: 10, LinesAccurate: 7, NetVarianceValueCents: 200, AbsoluteVarianceValueCents: 800, ValueCurrency: &usd},
+		{CycleCountUUID: "c", PostedAt: time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC), LinesCounted: 5, LinesAccurate: 5},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockCycleCountStore
+		expectedStatus int
+		validate       func(t *testing.T, resp dto.CycleCountAccuracyResponse)
+	}{
+		{
+			name:           "totals by month",
+			query:          "?from=2026-01-01&to=2026-02-28",
+			store:          &mockCycleCountStore{accuracy: accuracy},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.CycleCountAccuracyResponse) {
+				if len(resp.Months) != 2 || resp.Months[0].Month != "2026-01" || resp.Months[0].Counts != 2 {
+					t.Fatalf("expected two months with two counts in January, got %+v", resp.Months)
+				}
+				if got := resp.Months[0]; got.AccuracyPercent != 80 || got.NetVarianceValueCents != -300 || got.AbsoluteVarianceValueCents != 1300 {
+					t.Errorf("unexpected January totals %+v", got)
+				}
+				if resp.Total.Counts != 3 || resp.Total.LinesCounted != 25 || resp.Total.AccuracyPercent != 84 {
+					t.Errorf("unexpected total %+v", resp.Total)
+				}
+			},
+		},
+		{
+			name:           "to before from",
+			query:          "?from=2026-02-01&to=2026-01-01",
+			store:          &mockCycleCountStore{accuracy: accuracy},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/cycle-count-reports/accuracy"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleCycleCountAccuracy(tt.store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.CycleCountAccuracyResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
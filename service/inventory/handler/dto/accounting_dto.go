@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// CreateJournalExportRequest exports the inventory journal of a closed
+// period. A period that was already exported is only exported again when
+// Reexport is set.
+type CreateJournalExportRequest struct {
+	Period   string `json:"period"`
+	Format   string `json:"format"`
+	Reexport bool   `json:"reexport"`
+}
+
+func (r CreateJournalExportRequest) Validate() error {
+	if _, err := accounting.ParsePeriod(r.Period); err != nil {
+		return err
+	}
+	return accounting.ValidateFormat(r.Format)
+}
+
+type JournalExportResponse struct {
+	UUID       string    `json:"uuid"`
+	Period     string    `json:"period"`
+	Format     string    `json:"format"`
+	EntryCount int       `json:"entry_count"`
+	TotalCents int64     `json:"total_cents"`
+	FileName   string    `json:"file_name"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+func NewJournalExportResponse(export storage.JournalExport) JournalExportResponse {
+	return JournalExportResponse{
+		UUID:       export.UUID,
+		Period:     export.Period,
+		Format:     export.Format,
+		EntryCount: export.EntryCount,
+		TotalCents: export.TotalCents,
+		FileName:   accounting.FileName("inventory-journal", export.Period, export.Format),
+		ExportedAt: export.CreatedAt,
+	}
+}
+
+func NewJournalExportsResponse(exports []storage.JournalExport) []JournalExportResponse {
+	resp := make([]JournalExportResponse, 0, len(exports))
+	for _, export := range exports {
+		resp = append(resp, NewJournalExportResponse(export))
+	}
+	return resp
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/brewpipes/brewpipes/internal/uuidutil"
 	"github.com/brewpipes/brewpipes/internal/validate"
 	"github.com/brewpipes/brewpipes/service/inventory/storage"
 	"github.com/gofrs/uuid/v5"
@@ -18,9 +19,12 @@ type BatchUsagePick struct {
 }
 
 // CreateBatchUsageRequest is the request body for creating a batch usage deduction.
+// Reason is the movement reason recorded for every pick: use (the default)
+// for brewing ingredients, or package for packaging materials.
 type CreateBatchUsageRequest struct {
 	ProductionRefUUID *string          `json:"production_ref_uuid"`
 	UsedAt            string           `json:"used_at"`
+	Reason            *string          `json:"reason"`
 	Picks             []BatchUsagePick `json:"picks"`
 	Notes             *string          `json:"notes"`
 }
@@ -35,6 +39,9 @@ func (r CreateBatchUsageRequest) Validate() error {
 	if _, err := time.Parse(time.RFC3339, r.UsedAt); err != nil {
 		return fmt.Errorf("used_at must be a valid RFC3339 timestamp")
 	}
+	if r.Reason != nil && *r.Reason != storage.MovementReasonUse && *r.Reason != storage.MovementReasonPackage {
+		return fmt.Errorf("reason must be one of: use, package")
+	}
 	if len(r.Picks) == 0 {
 		return fmt.Errorf("picks must not be empty")
 	}
@@ -61,6 +68,40 @@ func (r CreateBatchUsageRequest) Validate() error {
 	return nil
 }
 
+// Usage returns the storage request for a validated request.
+func (r CreateBatchUsageRequest) Usage() storage.BatchUsageRequest {
+	usedAt, _ := time.Parse(time.RFC3339, r.UsedAt) // already validated
+
+	var productionUUID *uuid.UUID
+	if r.ProductionRefUUID != nil {
+		parsed := uuid.FromStringOrNil(*r.ProductionRefUUID) // already validated
+		productionUUID = &parsed
+	}
+
+	picks := make([]storage.BatchUsagePick, len(r.Picks))
+	for i, p := range r.Picks {
+		picks[i] = storage.BatchUsagePick{
+			IngredientLotUUID: p.IngredientLotUUID,
+			StockLocationUUID: p.StockLocationUUID,
+			Amount:            p.Amount,
+			AmountUnit:        p.AmountUnit,
+		}
+	}
+
+	reason := storage.MovementReasonUse
+	if r.Reason != nil {
+		reason = *r.Reason
+	}
+
+	return storage.BatchUsageRequest{
+		ProductionRefUUID: productionUUID,
+		UsedAt:            usedAt,
+		Reason:            reason,
+		Picks:             picks,
+		Notes:             r.Notes,
+	}
+}
+
 // BatchUsageResponse is the response body for a batch usage deduction.
 type BatchUsageResponse struct {
 	UsageUUID string                      `json:"usage_uuid"`
@@ -74,3 +115,42 @@ func NewBatchUsageResponse(usage storage.InventoryUsage, movements []storage.Inv
 		Movements: NewInventoryMovementsResponse(movements),
 	}
 }
+
+// BatchUsageMovementResponse is one lot deducted by a usage that references a
+// production batch.
+type BatchUsageMovementResponse struct {
+	UsageUUID             string    `json:"usage_uuid"`
+	MovementUUID          string    `json:"movement_uuid"`
+	Reason                string    `json:"reason"`
+	IngredientLotUUID     string    `json:"ingredient_lot_uuid"`
+	IngredientUUID        string    `json:"ingredient_uuid"`
+	IngredientName        string    `json:"ingredient_name"`
+	IngredientCategory    string    `json:"ingredient_category"`
+	BreweryLotCode        *string   `json:"brewery_lot_code,omitempty"`
+	PurchaseOrderLineUUID *string   `json:"purchase_order_line_uuid,omitempty"`
+	Amount                int64     `json:"amount"`
+	AmountUnit            string    `json:"amount_unit"`
+	OccurredAt            time.Time `json:"occurred_at"`
+}
+
+// NewBatchUsageMovementsResponse converts batch usage movements to response DTOs.
+func NewBatchUsageMovementsResponse(movements []storage.BatchUsageMovement) []BatchUsageMovementResponse {
+	resp := make([]BatchUsageMovementResponse, 0, len(movements))
+	for _, m := range movements {
+		resp = append(resp, BatchUsageMovementResponse{
+			UsageUUID:             m.UsageUUID.String(),
+			MovementUUID:          m.MovementUUID.String(),
+			Reason:                m.Reason,
+			IngredientLotUUID:     m.IngredientLotUUID.String(),
+			IngredientUUID:        m.IngredientUUID.String(),
+			IngredientName:        m.IngredientName,
+			IngredientCategory:    m.IngredientCategory,
+			BreweryLotCode:        m.BreweryLotCode,
+			PurchaseOrderLineUUID: uuidutil.ToStringPointer(m.PurchaseOrderLineUUID),
+			Amount:                m.Amount,
+			AmountUnit:            m.AmountUnit,
+			OccurredAt:            m.OccurredAt,
+		})
+	}
+	return resp
+}
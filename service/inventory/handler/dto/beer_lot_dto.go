@@ -31,6 +31,9 @@ type CreateBeerLotRequest struct {
 	VolumePerUnitUnit   *string    `json:"volume_per_unit_unit"`
 	Quantity            *int       `json:"quantity"`
 	StockLocationUUID   *string    `json:"stock_location_uuid"`
+	// ItemIdentifiers optionally assigns serials to the items created for a
+	// keg lot, one per unit of quantity.
+	ItemIdentifiers []string `json:"item_identifiers"`
 }
 
 func (r CreateBeerLotRequest) Validate() error {
@@ -65,6 +68,25 @@ func (r CreateBeerLotRequest) Validate() error {
 		}
 	}
 
+	if len(r.ItemIdentifiers) > 0 {
+		if r.Container == nil || *r.Container != storage.BeerLotContainerKeg {
+			return fmt.Errorf("item_identifiers are only supported for keg lots")
+		}
+		if r.Quantity == nil || len(r.ItemIdentifiers) != *r.Quantity {
+			return fmt.Errorf("item_identifiers must contain one identifier per unit of quantity")
+		}
+		seen := make(map[string]bool, len(r.ItemIdentifiers))
+		for i, identifier := range r.ItemIdentifiers {
+			if err := validate.Required(identifier, fmt.Sprintf("item_identifiers[%d]", i)); err != nil {
+				return err
+			}
+			if seen[identifier] {
+				return fmt.Errorf("item_identifiers[%d]: duplicate identifier %q", i, identifier)
+			}
+			seen[identifier] = true
+		}
+	}
+
 	return nil
 }
 
@@ -84,6 +106,8 @@ type BeerLotResponse struct {
 	CreatedAt           time.Time  `json:"created_at"`
 	UpdatedAt           time.Time  `json:"updated_at"`
 	DeletedAt           *time.Time `json:"deleted_at,omitempty"`
+	// Items is populated on creation when trackable items were created.
+	Items []BeerLotItemResponse `json:"items,omitempty"`
 }
 
 func NewBeerLotResponse(lot storage.BeerLot) BeerLotResponse {
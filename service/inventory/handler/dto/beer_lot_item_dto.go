@@ -0,0 +1,144 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// CreateBeerLotItemRequest is the request body for POST /beer-lot-items.
+type CreateBeerLotItemRequest struct {
+	BeerLotUUID string  `json:"beer_lot_uuid"`
+	Status      *string `json:"status"`
+	Identifier  *string `json:"identifier"`
+	Notes       *string `json:"notes"`
+}
+
+func (r CreateBeerLotItemRequest) Validate() error {
+	if err := validate.Required(r.BeerLotUUID, "beer_lot_uuid"); err != nil {
+		return err
+	}
+	if r.Status != nil {
+		if err := validateBeerLotItemStatus(*r.Status); err != nil {
+			return err
+		}
+	}
+	if r.Identifier != nil {
+		if err := validate.Required(*r.Identifier, "identifier"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateBeerLotItemRequest is the request body for PATCH /beer-lot-items/{uuid}.
+// Status is changed through the status endpoint so that history is recorded.
+type UpdateBeerLotItemRequest struct {
+	Identifier *string `json:"identifier"`
+	Notes      *string `json:"notes"`
+}
+
+func (r UpdateBeerLotItemRequest) Validate() error {
+	if r.Identifier != nil {
+		if err := validate.Required(*r.Identifier, "identifier"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateBeerLotItemStatusRequest is the request body for
+// PATCH /beer-lot-items/{uuid}/status.
+type UpdateBeerLotItemStatusRequest struct {
+	Status      string     `json:"status"`
+	Destination *string    `json:"destination"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+	Notes       *string    `json:"notes"`
+}
+
+func (r UpdateBeerLotItemStatusRequest) Validate() error {
+	if err := validate.Required(r.Status, "status"); err != nil {
+		return err
+	}
+	return validateBeerLotItemStatus(r.Status)
+}
+
+// ScanBeerLotItemRequest is the request body for POST /beer-lot-items/scan.
+type ScanBeerLotItemRequest struct {
+	Identifier  string     `json:"identifier"`
+	Status      string     `json:"status"`
+	Destination *string    `json:"destination"`
+	OccurredAt  *time.Time `json:"occurred_at"`
+	Notes       *string    `json:"notes"`
+}
+
+func (r ScanBeerLotItemRequest) Validate() error {
+	if err := validate.Required(r.Identifier, "identifier"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.Status, "status"); err != nil {
+		return err
+	}
+	return validateBeerLotItemStatus(r.Status)
+}
+
+type BeerLotItemResponse struct {
+	UUID        string     `json:"uuid"`
+	BeerLotUUID string     `json:"beer_lot_uuid"`
+	Status      string     `json:"status"`
+	Identifier  *string    `json:"identifier,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewBeerLotItemResponse(item storage.BeerLotItem) BeerLotItemResponse {
+	return BeerLotItemResponse{
+		UUID:        item.UUID.String(),
+		BeerLotUUID: item.BeerLotUUID,
+		Status:      item.Status,
+		Identifier:  item.Identifier,
+		Notes:       item.Notes,
+		CreatedAt:   item.CreatedAt,
+		UpdatedAt:   item.UpdatedAt,
+		DeletedAt:   item.DeletedAt,
+	}
+}
+
+func NewBeerLotItemsResponse(items []storage.BeerLotItem) []BeerLotItemResponse {
+	resp := make([]BeerLotItemResponse, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, NewBeerLotItemResponse(item))
+	}
+	return resp
+}
+
+type BeerLotItemEventResponse struct {
+	UUID            string    `json:"uuid"`
+	BeerLotItemUUID string    `json:"beer_lot_item_uuid"`
+	FromStatus      *string   `json:"from_status,omitempty"`
+	ToStatus        string    `json:"to_status"`
+	Destination     *string   `json:"destination,omitempty"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	Notes           *string   `json:"notes,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func NewBeerLotItemEventsResponse(events []storage.BeerLotItemEvent) []BeerLotItemEventResponse {
+	resp := make([]BeerLotItemEventResponse, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, BeerLotItemEventResponse{
+			UUID:            event.UUID.String(),
+			BeerLotItemUUID: event.BeerLotItemUUID,
+			FromStatus:      event.FromStatus,
+			ToStatus:        event.ToStatus,
+			Destination:     event.Destination,
+			OccurredAt:      event.OccurredAt,
+			Notes:           event.Notes,
+			CreatedAt:       event.CreatedAt,
+		})
+	}
+	return resp
+}
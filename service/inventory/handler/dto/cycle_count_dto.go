@@ -0,0 +1,288 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// CreateCycleCountRequest opens a count session for a stock location. In a
+// blind count the expected amounts are hidden until the session is posted.
+type CreateCycleCountRequest struct {
+	StockLocationUUID string  `json:"stock_location_uuid"`
+	Blind             bool    `json:"blind"`
+	Notes             *string `json:"notes"`
+}
+
+func (r CreateCycleCountRequest) Validate() error {
+	return validate.Required(r.StockLocationUUID, "stock_location_uuid")
+}
+
+// RecordCycleCountRequest is the request body for
+// PUT /cycle-counts/{uuid}/counts.
+type RecordCycleCountRequest struct {
+	Counts []CycleCountEntryRequest `json:"counts"`
+}
+
+// CycleCountEntryRequest counts a line on the sheet by line_uuid, or a lot
+// found at the location by ingredient_lot_uuid or beer_lot_uuid. A lot
+// already on the sheet in the same unit counts that line.
+type CycleCountEntryRequest struct {
+	LineUUID          *string `json:"line_uuid"`
+	IngredientLotUUID *string `json:"ingredient_lot_uuid"`
+	BeerLotUUID       *string `json:"beer_lot_uuid"`
+	AmountUnit        *string `json:"amount_unit"`
+	CountedAmount     *int64  `json:"counted_amount"`
+	Notes             *string `json:"notes"`
+}
+
+func (r RecordCycleCountRequest) Validate() error {
+	if len(r.Counts) == 0 {
+		return fmt.Errorf("counts must not be empty")
+	}
+	for i, entry := range r.Counts {
+		targets := 0
+		for _, target := range []*string{entry.LineUUID, entry.IngredientLotUUID, entry.BeerLotUUID} {
+			if target != nil {
+				targets++
+			}
+		}
+		if targets != 1 {
+			return fmt.Errorf("counts[%d]: exactly one of line_uuid, ingredient_lot_uuid or beer_lot_uuid is required", i)
+		}
+		if entry.LineUUID == nil {
+			if entry.AmountUnit == nil {
+				return fmt.Errorf("counts[%d]: amount_unit is required for a lot not on the count sheet", i)
+			}
+			if err := validate.Required(*entry.AmountUnit, fmt.Sprintf("counts[%d].amount_unit", i)); err != nil {
+				return err
+			}
+		}
+		if entry.CountedAmount == nil {
+			return fmt.Errorf("counts[%d]: counted_amount is required", i)
+		}
+		if *entry.CountedAmount < 0 {
+			return fmt.Errorf("counts[%d]: counted_amount must not be negative", i)
+		}
+	}
+	return nil
+}
+
+// CycleCountResponse is a count session. Lines and the summary are only
+// included for a single session.
+type CycleCountResponse struct {
+	UUID              string                   `json:"uuid"`
+	StockLocationUUID string                   `json:"stock_location_uuid"`
+	StockLocationName string                   `json:"stock_location_name"`
+	Status            string                   `json:"status"`
+	Blind             bool                     `json:"blind"`
+	FrozenAt          time.Time                `json:"frozen_at"`
+	PostedAt          *time.Time               `json:"posted_at,omitempty"`
+	Notes             *string                  `json:"notes,omitempty"`
+	Summary           *CycleCountSummary       `json:"summary,omitempty"`
+	Lines             []CycleCountLineResponse `json:"lines,omitempty"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
+}
+
+// CycleCountSummary totals a count sheet. VarianceValueCents nets the valued
+// variances; UnvaluedVariances counts variances that could not be valued.
+type CycleCountSummary struct {
+	LinesTotal         int     `json:"lines_total"`
+	LinesCounted       int     `json:"lines_counted"`
+	LinesWithVariance  int     `json:"lines_with_variance"`
+	VarianceValueCents int64   `json:"variance_value_cents"`
+	UnvaluedVariances  int     `json:"unvalued_variances"`
+	ValueCurrency      *string `json:"value_currency,omitempty"`
+}
+
+// CycleCountLineResponse is one lot on a count sheet. Variance is counted
+// minus expected. In an open blind count the expected amount, variance and
+// its value are withheld.
+type CycleCountLineResponse struct {
+	UUID               string     `json:"uuid"`
+	IngredientLotUUID  *string    `json:"ingredient_lot_uuid,omitempty"`
+	BeerLotUUID        *string    `json:"beer_lot_uuid,omitempty"`
+	LotCode            *string    `json:"lot_code,omitempty"`
+	ItemName           *string    `json:"item_name,omitempty"`
+	AmountUnit         string     `json:"amount_unit"`
+	ExpectedAmount     *int64     `json:"expected_amount,omitempty"`
+	CountedAmount      *int64     `json:"counted_amount,omitempty"`
+	CountedAt          *time.Time `json:"counted_at,omitempty"`
+	Variance           *int64     `json:"variance,omitempty"`
+	VarianceValueCents *int64     `json:"variance_value_cents,omitempty"`
+	ValueCurrency      *string    `json:"value_currency,omitempty"`
+	AdjustmentUUID     *string    `json:"adjustment_uuid,omitempty"`
+	Notes              *string    `json:"notes,omitempty"`
+}
+
+func NewCycleCountResponse(count storage.CycleCount) CycleCountResponse {
+	return CycleCountResponse{
+		UUID:              count.UUID.String(),
+		StockLocationUUID: count.StockLocationUUID,
+		StockLocationName: count.StockLocationName,
+		Status:            count.Status,
+		Blind:             count.Blind,
+		FrozenAt:          count.FrozenAt,
+		PostedAt:          count.PostedAt,
+		Notes:             count.Notes,
+		CreatedAt:         count.CreatedAt,
+		UpdatedAt:         count.UpdatedAt,
+	}
+}
+
+func NewCycleCountsResponse(counts []storage.CycleCount) []CycleCountResponse {
+	resp := make([]CycleCountResponse, 0, len(counts))
+	for _, count := range counts {
+		resp = append(resp, NewCycleCountResponse(count))
+	}
+	return resp
+}
+
+// NewCycleCountSheetResponse builds a session with its count sheet. Posted
+// lines carry the variance values recorded at posting; values for lines of
+// an open session come from values, keyed by line ID.
+func NewCycleCountSheetResponse(count storage.CycleCount, lines []storage.CycleCountLine, values map[int64]storage.CycleCountLineValue) CycleCountResponse {
+	resp := NewCycleCountResponse(count)
+	hidden := count.Blind && count.Status == storage.CycleCountStatusOpen
+
+	summary := CycleCountSummary{LinesTotal: len(lines)}
+	resp.Lines = make([]CycleCountLineResponse, 0, len(lines))
+	for _, line := range lines {
+		lineResp := CycleCountLineResponse{
+			UUID:              line.UUID.String(),
+			IngredientLotUUID: line.IngredientLotUUID,
+			BeerLotUUID:       line.BeerLotUUID,
+			LotCode:           line.LotCode,
+			ItemName:          line.ItemName,
+			AmountUnit:        line.AmountUnit,
+			CountedAmount:     line.CountedAmount,
+			CountedAt:         line.CountedAt,
+			AdjustmentUUID:    line.AdjustmentUUID,
+			Notes:             line.Notes,
+		}
+		if line.CountedAmount != nil {
+			summary.LinesCounted++
+		}
+
+		if !hidden {
+			expected := line.ExpectedAmount
+			lineResp.ExpectedAmount = &expected
+			lineResp.Variance = line.Variance()
+			lineResp.VarianceValueCents = line.VarianceValueCents
+			lineResp.ValueCurrency = line.ValueCurrency
+			if value, ok := values[line.ID]; ok && lineResp.VarianceValueCents == nil {
+				cents, currency := value.VarianceValueCents, value.Currency
+				lineResp.VarianceValueCents = &cents
+				lineResp.ValueCurrency = &currency
+			}
+
+			if lineResp.Variance != nil && *lineResp.Variance != 0 {
+				summary.LinesWithVariance++
+				if lineResp.VarianceValueCents == nil {
+					summary.UnvaluedVariances++
+				} else {
+					summary.VarianceValueCents += *lineResp.VarianceValueCents
+					summary.ValueCurrency = lineResp.ValueCurrency
+				}
+			}
+		}
+
+		resp.Lines = append(resp.Lines, lineResp)
+	}
+	resp.Summary = &summary
+
+	return resp
+}
+
+// CycleCountAccuracyResponse reports count accuracy over a period: each
+// posted count, a roll-up per calendar month, and the period total. A line
+// is accurate when it was counted at exactly its expected amount.
+type CycleCountAccuracyResponse struct {
+	From          string                     `json:"from"`
+	To            string                     `json:"to"`
+	ValueCurrency *string                    `json:"value_currency,omitempty"`
+	Counts        []CycleCountAccuracyEntry  `json:"counts"`
+	Months        []CycleCountAccuracyPeriod `json:"months"`
+	Total         CycleCountAccuracyPeriod   `json:"total"`
+}
+
+// CycleCountAccuracyEntry is the accuracy of one posted count.
+type CycleCountAccuracyEntry struct {
+	CycleCountUUID    string    `json:"cycle_count_uuid"`
+	StockLocationUUID string    `json:"stock_location_uuid"`
+	StockLocationName string    `json:"stock_location_name"`
+	PostedAt          time.Time `json:"posted_at"`
+	CycleCountAccuracyTotals
+}
+
+// CycleCountAccuracyPeriod rolls up the counts posted in a month, or in the
+// whole period when Month is empty.
+type CycleCountAccuracyPeriod struct {
+	Month  string `json:"month,omitempty"`
+	Counts int    `json:"counts"`
+	CycleCountAccuracyTotals
+}
+
+// CycleCountAccuracyTotals are the line totals shared by accuracy entries.
+// AbsoluteVarianceValueCents adds up the size of every valued variance,
+// so gains and losses do not cancel out.
+type CycleCountAccuracyTotals struct {
+	LinesCounted               int     `json:"lines_counted"`
+	LinesAccurate              int     `json:"lines_accurate"`
+	AccuracyPercent            float64 `json:"accuracy_percent"`
+	NetVarianceValueCents      int64   `json:"net_variance_value_cents"`
+	AbsoluteVarianceValueCents int64   `json:"absolute_variance_value_cents"`
+	UnvaluedVariances          int     `json:"unvalued_variances"`
+}
+
+func (t *CycleCountAccuracyTotals) add(a storage.CycleCountAccuracy) {
+	t.LinesCounted += a.LinesCounted
+	t.LinesAccurate += a.LinesAccurate
+	t.NetVarianceValueCents += a.NetVarianceValueCents
+	t.AbsoluteVarianceValueCents += a.AbsoluteVarianceValueCents
+	t.UnvaluedVariances += a.UnvaluedVariances
+	t.AccuracyPercent = 100
+	if t.LinesCounted > 0 {
+		t.AccuracyPercent = float64(t.LinesAccurate) * 100 / float64(t.LinesCounted)
+	}
+}
+
+// NewCycleCountAccuracyResponse builds the accuracy report for the counts
+// posted between from and to, which are ordered oldest first.
+func NewCycleCountAccuracyResponse(from, to time.Time, counts []storage.CycleCountAccuracy) CycleCountAccuracyResponse {
+	resp := CycleCountAccuracyResponse{
+		From:   from.Format(time.DateOnly),
+		To:     to.Format(time.DateOnly),
+		Counts: make([]CycleCountAccuracyEntry, 0, len(counts)),
+		Months: make([]CycleCountAccuracyPeriod, 0),
+	}
+
+	for _, a := range counts {
+		entry := CycleCountAccuracyEntry{
+			CycleCountUUID:    a.CycleCountUUID,
+			StockLocationUUID: a.StockLocationUUID,
+			StockLocationName: a.StockLocationName,
+			PostedAt:          a.PostedAt,
+		}
+		entry.add(a)
+		resp.Counts = append(resp.Counts, entry)
+
+		month := a.PostedAt.UTC().Format("2006-01")
+		if len(resp.Months) == 0 || resp.Months[len(resp.Months)-1].Month != month {
+			resp.Months = append(resp.Months, CycleCountAccuracyPeriod{Month: month})
+		}
+		period := &resp.Months[len(resp.Months)-1]
+		period.Counts++
+		period.add(a)
+
+		resp.Total.Counts++
+		resp.Total.add(a)
+		if a.ValueCurrency != nil {
+			resp.ValueCurrency = a.ValueCurrency
+		}
+	}
+	return resp
+}
@@ -21,6 +21,13 @@ func (r CreateIngredientMaltDetailRequest) Validate() error {
 	if err := validate.Required(r.IngredientUUID, "ingredient_uuid"); err != nil {
 		return err
 	}
+
+	return r.validateFields()
+}
+
+// validateFields validates everything but the ingredient, which an import
+// row does not have yet.
+func (r CreateIngredientMaltDetailRequest) validateFields() error {
 	if r.Lovibond != nil && *r.Lovibond < 0 {
 		return fmt.Errorf("lovibond must be greater than or equal to zero")
 	}
@@ -76,6 +83,11 @@ func (r CreateIngredientHopDetailRequest) Validate() error {
 	if err := validate.Required(r.IngredientUUID, "ingredient_uuid"); err != nil {
 		return err
 	}
+
+	return r.validateFields()
+}
+
+func (r CreateIngredientHopDetailRequest) validateFields() error {
 	if r.CropYear != nil && *r.CropYear < 1900 {
 		return fmt.Errorf("crop_year must be 1900 or later")
 	}
@@ -135,6 +147,11 @@ func (r CreateIngredientYeastDetailRequest) Validate() error {
 	if err := validate.Required(r.IngredientUUID, "ingredient_uuid"); err != nil {
 		return err
 	}
+
+	return r.validateFields()
+}
+
+func (r CreateIngredientYeastDetailRequest) validateFields() error {
 	if r.Form != nil {
 		if err := validateYeastForm(*r.Form); err != nil {
 			return err
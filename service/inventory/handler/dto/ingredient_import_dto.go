@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// ImportIngredientRequest is one row of an ingredient import: an ingredient
+// and at most one detail, which must match its category.
+type ImportIngredientRequest struct {
+	Ingredient  CreateIngredientRequest
+	MaltDetail  *CreateIngredientMaltDetailRequest
+	HopDetail   *CreateIngredientHopDetailRequest
+	YeastDetail *CreateIngredientYeastDetailRequest
+}
+
+func (r ImportIngredientRequest) Validate() error {
+	if err := r.Ingredient.Validate(); err != nil {
+		return err
+	}
+
+	category := r.Ingredient.Category
+	switch {
+	case r.MaltDetail != nil:
+		if category != storage.IngredientCategoryFermentable {
+			return fmt.Errorf("malt details require category %s", storage.IngredientCategoryFermentable)
+		}
+		return r.MaltDetail.validateFields()
+	case r.HopDetail != nil:
+		if category != storage.IngredientCategoryHop {
+			return fmt.Errorf("hop details require category %s", storage.IngredientCategoryHop)
+		}
+		return r.HopDetail.validateFields()
+	case r.YeastDetail != nil:
+		if category != storage.IngredientCategoryYeast {
+			return fmt.Errorf("yeast details require category %s", storage.IngredientCategoryYeast)
+		}
+		return r.YeastDetail.validateFields()
+	}
+
+	return nil
+}
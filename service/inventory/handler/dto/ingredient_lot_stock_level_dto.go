@@ -9,18 +9,22 @@ import (
 // IngredientLotStockLevelResponse represents the current stock level for an
 // ingredient lot at a specific location.
 type IngredientLotStockLevelResponse struct {
-	IngredientLotUUID  string  `json:"ingredient_lot_uuid"`
-	IngredientUUID     string  `json:"ingredient_uuid"`
-	IngredientName     string  `json:"ingredient_name"`
-	IngredientCategory string  `json:"ingredient_category"`
-	BreweryLotCode     *string `json:"brewery_lot_code,omitempty"`
-	ReceivedAt         string  `json:"received_at"`
-	ReceivedAmount     int64   `json:"received_amount"`
-	ReceivedUnit       string  `json:"received_unit"`
-	StockLocationUUID  string  `json:"stock_location_uuid"`
-	StockLocationName  string  `json:"stock_location_name"`
-	CurrentAmount      int64   `json:"current_amount"`
-	CurrentUnit        string  `json:"current_unit"`
+	IngredientLotUUID  string     `json:"ingredient_lot_uuid"`
+	IngredientUUID     string     `json:"ingredient_uuid"`
+	IngredientName     string     `json:"ingredient_name"`
+	IngredientCategory string     `json:"ingredient_category"`
+	BreweryLotCode     *string    `json:"brewery_lot_code,omitempty"`
+	ReceivedAt         string     `json:"received_at"`
+	ReceivedAmount     int64      `json:"received_amount"`
+	ReceivedUnit       string     `json:"received_unit"`
+	BestByAt           *time.Time `json:"best_by_at,omitempty"`
+	ExpiresAt          *time.Time `json:"expires_at,omitempty"`
+	StockLocationUUID  string     `json:"stock_location_uuid"`
+	StockLocationName  string     `json:"stock_location_name"`
+	CurrentAmount      int64      `json:"current_amount"`
+	CurrentUnit        string     `json:"current_unit"`
+	ReservedAmount     int64      `json:"reserved_amount"`
+	AvailableAmount    int64      `json:"available_amount"`
 }
 
 // NewIngredientLotStockLevelResponse converts a storage IngredientLotStockLevel to a response DTO.
@@ -34,10 +38,14 @@ func NewIngredientLotStockLevelResponse(level storage.IngredientLotStockLevel) I
 		ReceivedAt:         level.ReceivedAt.Format(time.RFC3339),
 		ReceivedAmount:     level.ReceivedAmount,
 		ReceivedUnit:       level.ReceivedUnit,
+		BestByAt:           level.BestByAt,
+		ExpiresAt:          level.ExpiresAt,
 		StockLocationUUID:  level.LocationUUID,
 		StockLocationName:  level.LocationName,
 		CurrentAmount:      level.CurrentAmount,
 		CurrentUnit:        level.CurrentUnit,
+		ReservedAmount:     level.ReservedAmount,
+		AvailableAmount:    level.AvailableAmount,
 	}
 }
 
@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/uuidutil"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateReorderPolicyRequest is the request body for POST /ingredient-reorder-policies.
+// Levels are in the ingredient's default unit. Omit stock_location_uuid for a
+// policy covering all locations.
+type CreateReorderPolicyRequest struct {
+	IngredientUUID        string  `json:"ingredient_uuid"`
+	StockLocationUUID     *string `json:"stock_location_uuid"`
+	MinLevel              int64   `json:"min_level"`
+	MaxLevel              int64   `json:"max_level"`
+	SafetyStock           *int64  `json:"safety_stock"`
+	PreferredSupplierUUID *string `json:"preferred_supplier_uuid"`
+	LeadTimeDays          *int    `json:"lead_time_days"`
+	Notes                 *string `json:"notes"`
+}
+
+func (r CreateReorderPolicyRequest) Validate() error {
+	if _, err := uuid.FromString(r.IngredientUUID); err != nil {
+		return fmt.Errorf("ingredient_uuid must be a valid UUID")
+	}
+	if r.StockLocationUUID != nil {
+		if _, err := uuid.FromString(*r.StockLocationUUID); err != nil {
+			return fmt.Errorf("stock_location_uuid must be a valid UUID")
+		}
+	}
+	if r.MinLevel < 0 {
+		return fmt.Errorf("min_level must be zero or greater")
+	}
+	if r.MaxLevel < r.MinLevel {
+		return fmt.Errorf("max_level must be greater than or equal to min_level")
+	}
+	if r.SafetyStock != nil && *r.SafetyStock < 0 {
+		return fmt.Errorf("safety_stock must be zero or greater")
+	}
+	return validateReorderSupplierAndLeadTime(r.PreferredSupplierUUID, r.LeadTimeDays)
+}
+
+// UpdateReorderPolicyRequest is the request body for PATCH /ingredient-reorder-policies/{uuid}.
+type UpdateReorderPolicyRequest struct {
+	MinLevel              *int64  `json:"min_level"`
+	MaxLevel              *int64  `json:"max_level"`
+	SafetyStock           *int64  `json:"safety_stock"`
+	PreferredSupplierUUID *string `json:"preferred_supplier_uuid"`
+	LeadTimeDays          *int    `json:"lead_time_days"`
+	Notes                 *string `json:"notes"`
+}
+
+func (r UpdateReorderPolicyRequest) Validate() error {
+	if r.MinLevel == nil && r.MaxLevel == nil && r.SafetyStock == nil &&
+		r.PreferredSupplierUUID == nil && r.LeadTimeDays == nil && r.Notes == nil {
+		return fmt.Errorf("at least one field must be provided")
+	}
+	if r.MinLevel != nil && *r.MinLevel < 0 {
+		return fmt.Errorf("min_level must be zero or greater")
+	}
+	if r.MinLevel != nil && r.MaxLevel != nil && *r.MaxLevel < *r.MinLevel {
+		return fmt.Errorf("max_level must be greater than or equal to min_level")
+	}
+	if r.SafetyStock != nil && *r.SafetyStock < 0 {
+		return fmt.Errorf("safety_stock must be zero or greater")
+	}
+	return validateReorderSupplierAndLeadTime(r.PreferredSupplierUUID, r.LeadTimeDays)
+}
+
+func validateReorderSupplierAndLeadTime(supplierUUID *string, leadTimeDays *int) error {
+	if supplierUUID != nil {
+		if _, err := uuid.FromString(*supplierUUID); err != nil {
+			return fmt.Errorf("preferred_supplier_uuid must be a valid UUID")
+		}
+	}
+	if leadTimeDays != nil && *leadTimeDays < 0 {
+		return fmt.Errorf("lead_time_days must be zero or greater")
+	}
+	return nil
+}
+
+type ReorderPolicyResponse struct {
+	UUID                  string     `json:"uuid"`
+	IngredientUUID        string     `json:"ingredient_uuid"`
+	IngredientName        string     `json:"ingredient_name"`
+	Unit                  string     `json:"unit"`
+	StockLocationUUID     *string    `json:"stock_location_uuid,omitempty"`
+	StockLocationName     *string    `json:"stock_location_name,omitempty"`
+	MinLevel              int64      `json:"min_level"`
+	MaxLevel              int64      `json:"max_level"`
+	SafetyStock           int64      `json:"safety_stock"`
+	PreferredSupplierUUID *string    `json:"preferred_supplier_uuid,omitempty"`
+	LeadTimeDays          *int       `json:"lead_time_days,omitempty"`
+	Notes                 *string    `json:"notes,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at"`
+	DeletedAt             *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewReorderPolicyResponse(p storage.IngredientReorderPolicy) ReorderPolicyResponse {
+	return ReorderPolicyResponse{
+		UUID:                  p.UUID.String(),
+		IngredientUUID:        p.IngredientUUID,
+		IngredientName:        p.IngredientName,
+		Unit:                  p.DefaultUnit,
+		StockLocationUUID:     p.StockLocationUUID,
+		StockLocationName:     p.StockLocationName,
+		MinLevel:              p.MinLevel,
+		MaxLevel:              p.MaxLevel,
+		SafetyStock:           p.SafetyStock,
+		PreferredSupplierUUID: uuidutil.ToStringPointer(p.PreferredSupplierUUID),
+		LeadTimeDays:          p.LeadTimeDays,
+		Notes:                 p.Notes,
+		CreatedAt:             p.CreatedAt,
+		UpdatedAt:             p.UpdatedAt,
+		DeletedAt:             p.DeletedAt,
+	}
+}
+
+func NewReorderPoliciesResponse(policies []storage.IngredientReorderPolicy) []ReorderPolicyResponse {
+	resp := make([]ReorderPolicyResponse, 0, len(policies))
+	for _, p := range policies {
+		resp = append(resp, NewReorderPolicyResponse(p))
+	}
+	return resp
+}
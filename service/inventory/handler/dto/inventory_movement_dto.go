@@ -120,6 +120,7 @@ type InventoryMovementResponse struct {
 	AdjustmentUUID    *string    `json:"adjustment_uuid,omitempty"`
 	TransferUUID      *string    `json:"transfer_uuid,omitempty"`
 	RemovalUUID       *string    `json:"removal_uuid,omitempty"`
+	ReturnUUID        *string    `json:"return_uuid,omitempty"`
 	Notes             *string    `json:"notes,omitempty"`
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
@@ -142,6 +143,7 @@ func NewInventoryMovementResponse(movement storage.InventoryMovement) InventoryM
 		AdjustmentUUID:    movement.AdjustmentUUID,
 		TransferUUID:      movement.TransferUUID,
 		RemovalUUID:       movement.RemovalUUID,
+		ReturnUUID:        movement.ReturnUUID,
 		Notes:             movement.Notes,
 		CreatedAt:         movement.CreatedAt,
 		UpdatedAt:         movement.UpdatedAt,
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateInventoryReservationRequest is the request body for POST /inventory-reservations.
+type CreateInventoryReservationRequest struct {
+	ProductionRefUUID string  `json:"production_ref_uuid"`
+	IngredientLotUUID string  `json:"ingredient_lot_uuid"`
+	StockLocationUUID string  `json:"stock_location_uuid"`
+	Amount            int64   `json:"amount"`
+	AmountUnit        string  `json:"amount_unit"`
+	Notes             *string `json:"notes"`
+}
+
+func (r CreateInventoryReservationRequest) Validate() error {
+	if _, err := uuid.FromString(r.ProductionRefUUID); err != nil {
+		return fmt.Errorf("production_ref_uuid must be a valid UUID")
+	}
+	if err := validate.Required(r.IngredientLotUUID, "ingredient_lot_uuid"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.StockLocationUUID, "stock_location_uuid"); err != nil {
+		return err
+	}
+	if r.Amount <= 0 {
+		return fmt.Errorf("amount must be greater than 0")
+	}
+	return validate.Required(r.AmountUnit, "amount_unit")
+}
+
+// ReleaseInventoryReservationsRequest is the request body for
+// POST /inventory-reservations/release.
+type ReleaseInventoryReservationsRequest struct {
+	ProductionRefUUID string `json:"production_ref_uuid"`
+}
+
+func (r ReleaseInventoryReservationsRequest) Validate() error {
+	if _, err := uuid.FromString(r.ProductionRefUUID); err != nil {
+		return fmt.Errorf("production_ref_uuid must be a valid UUID")
+	}
+	return nil
+}
+
+// ReleaseInventoryReservationsResponse reports how many reservations were released.
+type ReleaseInventoryReservationsResponse struct {
+	ProductionRefUUID string `json:"production_ref_uuid"`
+	Released          int64  `json:"released"`
+}
+
+type InventoryReservationResponse struct {
+	UUID              string     `json:"uuid"`
+	ProductionRefUUID string     `json:"production_ref_uuid"`
+	IngredientLotUUID string     `json:"ingredient_lot_uuid"`
+	StockLocationUUID string     `json:"stock_location_uuid"`
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	ConsumedAmount    int64      `json:"consumed_amount"`
+	OutstandingAmount int64      `json:"outstanding_amount"`
+	Status            string     `json:"status"`
+	ReleasedAt        *time.Time `json:"released_at,omitempty"`
+	Notes             *string    `json:"notes,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewInventoryReservationResponse(res storage.InventoryReservation) InventoryReservationResponse {
+	return InventoryReservationResponse{
+		UUID:              res.UUID.String(),
+		ProductionRefUUID: res.ProductionRefUUID.String(),
+		IngredientLotUUID: res.IngredientLotUUID,
+		StockLocationUUID: res.StockLocationUUID,
+		Amount:            res.Amount,
+		AmountUnit:        res.AmountUnit,
+		ConsumedAmount:    res.ConsumedAmount,
+		OutstandingAmount: res.OutstandingAmount(),
+		Status:            res.Status,
+		ReleasedAt:        res.ReleasedAt,
+		Notes:             res.Notes,
+		CreatedAt:         res.CreatedAt,
+		UpdatedAt:         res.UpdatedAt,
+		DeletedAt:         res.DeletedAt,
+	}
+}
+
+func NewInventoryReservationsResponse(reservations []storage.InventoryReservation) []InventoryReservationResponse {
+	resp := make([]InventoryReservationResponse, 0, len(reservations))
+	for _, res := range reservations {
+		resp = append(resp, NewInventoryReservationResponse(res))
+	}
+	return resp
+}
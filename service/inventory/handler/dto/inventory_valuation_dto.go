@@ -0,0 +1,141 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// ValidateValuationMethod checks that a costing method is supported.
+func ValidateValuationMethod(method string) error {
+	switch method {
+	case storage.ValuationMethodFIFO, storage.ValuationMethodWeightedAverage:
+		return nil
+	default:
+		return fmt.Errorf("method must be one of: fifo, weighted_average")
+	}
+}
+
+// ParseValuationDate parses a YYYY-MM-DD report date for the named query
+// parameter.
+func ParseValuationDate(name, value string) (time.Time, error) {
+	parsed, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s", name)
+	}
+	return parsed, nil
+}
+
+type UpdateValuationSettingsRequest struct {
+	Method string `json:"method"`
+}
+
+func (r UpdateValuationSettingsRequest) Validate() error {
+	return ValidateValuationMethod(r.Method)
+}
+
+type ValuationSettingsResponse struct {
+	Method string `json:"method"`
+}
+
+// UncostedLot is an ingredient lot left out of valuation because its cost
+// cannot be determined. Quantity is the lot's on-hand quantity at the end of
+// the report.
+type UncostedLot struct {
+	IngredientLotUUID string `json:"ingredient_lot_uuid"`
+	IngredientUUID    string `json:"ingredient_uuid"`
+	IngredientName    string `json:"ingredient_name"`
+	Quantity          int64  `json:"quantity"`
+	Unit              string `json:"unit"`
+	Reason            string `json:"reason"`
+}
+
+// ValuationItem is the value of one ingredient held at one location, in the
+// base currency.
+type ValuationItem struct {
+	IngredientUUID    string   `json:"ingredient_uuid"`
+	IngredientName    string   `json:"ingredient_name"`
+	Category          string   `json:"category"`
+	StockLocationUUID string   `json:"stock_location_uuid"`
+	StockLocationName string   `json:"stock_location_name"`
+	Quantity          int64    `json:"quantity"`
+	Unit              string   `json:"unit"`
+	ValueCents        int64    `json:"value_cents"`
+	UnitCostCents     *float64 `json:"unit_cost_cents,omitempty"`
+}
+
+type ValuationCategoryTotal struct {
+	Category   string `json:"category"`
+	ValueCents int64  `json:"value_cents"`
+}
+
+type ValuationLocationTotal struct {
+	StockLocationUUID string `json:"stock_location_uuid"`
+	StockLocationName string `json:"stock_location_name"`
+	ValueCents        int64  `json:"value_cents"`
+}
+
+// InventoryValuationResponse is the response for GET /inventory-valuation.
+// Values are as of the end of AsOf.
+type InventoryValuationResponse struct {
+	AsOf            string                   `json:"as_of"`
+	Method          string                   `json:"method"`
+	BaseCurrency    string                   `json:"base_currency,omitempty"`
+	TotalValueCents int64                    `json:"total_value_cents"`
+	Categories      []ValuationCategoryTotal `json:"categories"`
+	Locations       []ValuationLocationTotal `json:"locations"`
+	Items           []ValuationItem          `json:"items"`
+	UncostedLots    []UncostedLot            `json:"uncosted_lots"`
+}
+
+// ConsumptionReasonTotal is the cost of movements out of inventory for one
+// movement reason.
+type ConsumptionReasonTotal struct {
+	Reason    string `json:"reason"`
+	CostCents int64  `json:"cost_cents"`
+	Movements int    `json:"movements"`
+}
+
+type ConsumptionCategoryTotal struct {
+	Category  string `json:"category"`
+	CostCents int64  `json:"cost_cents"`
+}
+
+// CostedMovement is a movement valued in the base currency. Movements into
+// inventory carry their added value; movements out carry their cost.
+type CostedMovement struct {
+	MovementUUID      string    `json:"movement_uuid"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	IngredientLotUUID string    `json:"ingredient_lot_uuid"`
+	IngredientUUID    string    `json:"ingredient_uuid"`
+	IngredientName    string    `json:"ingredient_name"`
+	Category          string    `json:"category"`
+	StockLocationUUID string    `json:"stock_location_uuid"`
+	Direction         string    `json:"direction"`
+	Reason            string    `json:"reason"`
+	Amount            int64     `json:"amount"`
+	AmountUnit        string    `json:"amount_unit"`
+	ValueCents        int64     `json:"value_cents"`
+}
+
+// InventoryConsumptionResponse is the response for
+// GET /inventory-valuation/consumption. The period covers From through To
+// inclusive, and ClosingValueCents always equals OpeningValueCents plus
+// ReceiptsCents and AdjustmentsInCents less ConsumptionCents.
+type InventoryConsumptionResponse struct {
+	From               string                     `json:"from"`
+	To                 string                     `json:"to"`
+	Method             string                     `json:"method"`
+	BaseCurrency       string                     `json:"base_currency,omitempty"`
+	OpeningValueCents  int64                      `json:"opening_value_cents"`
+	ReceiptsCents      int64                      `json:"receipts_cents"`
+	AdjustmentsInCents int64                      `json:"adjustments_in_cents"`
+	ConsumptionCents   int64                      `json:"consumption_cents"`
+	ClosingValueCents  int64                      `json:"closing_value_cents"`
+	ByReason           []ConsumptionReasonTotal   `json:"by_reason"`
+	ByCategory         []ConsumptionCategoryTotal `json:"by_category"`
+	Movements          []CostedMovement           `json:"movements"`
+	UncostedLots       []UncostedLot              `json:"uncosted_lots"`
+}
@@ -0,0 +1,259 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// CreateKegRequest is the request body for POST /kegs.
+type CreateKegRequest struct {
+	Serial            string     `json:"serial"`
+	Capacity          int64      `json:"capacity"`
+	CapacityUnit      string     `json:"capacity_unit"`
+	Ownership         *string    `json:"ownership"`
+	Lessor            *string    `json:"lessor"`
+	PurchaseCostCents *int64     `json:"purchase_cost_cents"`
+	Currency          *string    `json:"currency"`
+	PurchasedAt       *time.Time `json:"purchased_at"`
+	StockLocationUUID *string    `json:"stock_location_uuid"`
+	Notes             *string    `json:"notes"`
+}
+
+func (r CreateKegRequest) Validate() error {
+	if err := validate.Required(r.Serial, "serial"); err != nil {
+		return err
+	}
+	if r.Capacity <= 0 {
+		return fmt.Errorf("capacity must be greater than 0")
+	}
+	if err := validate.Required(r.CapacityUnit, "capacity_unit"); err != nil {
+		return err
+	}
+	if r.Ownership != nil {
+		if err := validateKegOwnership(*r.Ownership); err != nil {
+			return err
+		}
+	}
+	return validateKegPurchaseCost(r.PurchaseCostCents, r.Currency)
+}
+
+// UpdateKegRequest is the request body for PATCH /kegs/{uuid}.
+type UpdateKegRequest struct {
+	Serial            *string    `json:"serial"`
+	Capacity          *int64     `json:"capacity"`
+	CapacityUnit      *string    `json:"capacity_unit"`
+	Ownership         *string    `json:"ownership"`
+	Lessor            *string    `json:"lessor"`
+	PurchaseCostCents *int64     `json:"purchase_cost_cents"`
+	Currency          *string    `json:"currency"`
+	PurchasedAt       *time.Time `json:"purchased_at"`
+	Notes             *string    `json:"notes"`
+}
+
+func (r UpdateKegRequest) Validate() error {
+	if r.Serial != nil {
+		if err := validate.Required(*r.Serial, "serial"); err != nil {
+			return err
+		}
+	}
+	if r.Capacity != nil && *r.Capacity <= 0 {
+		return fmt.Errorf("capacity must be greater than 0")
+	}
+	if r.CapacityUnit != nil {
+		if err := validate.Required(*r.CapacityUnit, "capacity_unit"); err != nil {
+			return err
+		}
+	}
+	if r.Ownership != nil {
+		if err := validateKegOwnership(*r.Ownership); err != nil {
+			return err
+		}
+	}
+	if r.PurchaseCostCents != nil && *r.PurchaseCostCents < 0 {
+		return fmt.Errorf("purchase_cost_cents must be greater than or equal to 0")
+	}
+	if r.Currency != nil {
+		if err := validateCurrency(*r.Currency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateKegPurchaseCost(costCents *int64, currency *string) error {
+	if costCents == nil {
+		return nil
+	}
+	if *costCents < 0 {
+		return fmt.Errorf("purchase_cost_cents must be greater than or equal to 0")
+	}
+	if currency == nil {
+		return fmt.Errorf("currency is required when purchase_cost_cents is provided")
+	}
+	return validateCurrency(*currency)
+}
+
+// CreateKegEventRequest is the request body for POST /kegs/{uuid}/events.
+type CreateKegEventRequest struct {
+	EventType         string     `json:"event_type"`
+	BeerLotUUID       *string    `json:"beer_lot_uuid"`
+	StockLocationUUID *string    `json:"stock_location_uuid"`
+	Custodian         *string    `json:"custodian"`
+	DepositCents      *int64     `json:"deposit_cents"`
+	WriteOffCents     *int64     `json:"write_off_cents"`
+	OccurredAt        *time.Time `json:"occurred_at"`
+	Notes             *string    `json:"notes"`
+}
+
+func (r CreateKegEventRequest) Validate() error {
+	if err := validate.Required(r.EventType, "event_type"); err != nil {
+		return err
+	}
+	if err := validateKegEventType(r.EventType); err != nil {
+		return err
+	}
+
+	switch r.EventType {
+	case storage.KegEventFill:
+		if r.BeerLotUUID == nil {
+			return fmt.Errorf("beer_lot_uuid is required for fill events")
+		}
+	case storage.KegEventShip:
+		if r.Custodian == nil {
+			return fmt.Errorf("custodian is required for ship events")
+		}
+		if err := validate.Required(*r.Custodian, "custodian"); err != nil {
+			return err
+		}
+	case storage.KegEventMove:
+		if r.StockLocationUUID == nil {
+			return fmt.Errorf("stock_location_uuid is required for move events")
+		}
+	}
+
+	if r.BeerLotUUID != nil && r.EventType != storage.KegEventFill {
+		return fmt.Errorf("beer_lot_uuid is only allowed for fill events")
+	}
+	if r.DepositCents != nil {
+		if r.EventType != storage.KegEventShip && r.EventType != storage.KegEventReturn {
+			return fmt.Errorf("deposit_cents is only allowed for ship and return events")
+		}
+		if *r.DepositCents < 0 {
+			return fmt.Errorf("deposit_cents must be greater than or equal to 0")
+		}
+	}
+	if r.WriteOffCents != nil {
+		if r.EventType != storage.KegEventWriteOff {
+			return fmt.Errorf("write_off_cents is only allowed for write_off events")
+		}
+		if *r.WriteOffCents < 0 {
+			return fmt.Errorf("write_off_cents must be greater than or equal to 0")
+		}
+	}
+	return nil
+}
+
+type KegResponse struct {
+	UUID                   string     `json:"uuid"`
+	Serial                 string     `json:"serial"`
+	Capacity               int64      `json:"capacity"`
+	CapacityUnit           string     `json:"capacity_unit"`
+	Ownership              string     `json:"ownership"`
+	Lessor                 *string    `json:"lessor,omitempty"`
+	PurchaseCostCents      *int64     `json:"purchase_cost_cents,omitempty"`
+	Currency               *string    `json:"currency,omitempty"`
+	PurchasedAt            *time.Time `json:"purchased_at,omitempty"`
+	Status                 string     `json:"status"`
+	StockLocationUUID      *string    `json:"stock_location_uuid,omitempty"`
+	Custodian              *string    `json:"custodian,omitempty"`
+	CurrentBeerLotItemUUID *string    `json:"current_beer_lot_item_uuid,omitempty"`
+	CurrentBeerLotUUID     *string    `json:"current_beer_lot_uuid,omitempty"`
+	DepositBalanceCents    int64      `json:"deposit_balance_cents"`
+	Notes                  *string    `json:"notes,omitempty"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+	DeletedAt              *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewKegResponse(keg storage.Keg) KegResponse {
+	return KegResponse{
+		UUID:                   keg.UUID.String(),
+		Serial:                 keg.Serial,
+		Capacity:               keg.Capacity,
+		CapacityUnit:           keg.CapacityUnit,
+		Ownership:              keg.Ownership,
+		Lessor:                 keg.Lessor,
+		PurchaseCostCents:      keg.PurchaseCostCents,
+		Currency:               keg.Currency,
+		PurchasedAt:            keg.PurchasedAt,
+		Status:                 keg.Status,
+		StockLocationUUID:      keg.StockLocationUUID,
+		Custodian:              keg.Custodian,
+		CurrentBeerLotItemUUID: keg.CurrentBeerLotItemUUID,
+		CurrentBeerLotUUID:     keg.CurrentBeerLotUUID,
+		DepositBalanceCents:    keg.DepositBalanceCents,
+		Notes:                  keg.Notes,
+		CreatedAt:              keg.CreatedAt,
+		UpdatedAt:              keg.UpdatedAt,
+		DeletedAt:              keg.DeletedAt,
+	}
+}
+
+func NewKegsResponse(kegs []storage.Keg) []KegResponse {
+	resp := make([]KegResponse, 0, len(kegs))
+	for _, keg := range kegs {
+		resp = append(resp, NewKegResponse(keg))
+	}
+	return resp
+}
+
+type KegEventResponse struct {
+	UUID              string    `json:"uuid"`
+	KegUUID           string    `json:"keg_uuid"`
+	EventType         string    `json:"event_type"`
+	FromStatus        string    `json:"from_status"`
+	ToStatus          string    `json:"to_status"`
+	StockLocationUUID *string   `json:"stock_location_uuid,omitempty"`
+	Custodian         *string   `json:"custodian,omitempty"`
+	BeerLotItemUUID   *string   `json:"beer_lot_item_uuid,omitempty"`
+	DepositCents      *int64    `json:"deposit_cents,omitempty"`
+	WriteOffCents     *int64    `json:"write_off_cents,omitempty"`
+	OccurredAt        time.Time `json:"occurred_at"`
+	Notes             *string   `json:"notes,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func NewKegEventResponse(event storage.KegEvent) KegEventResponse {
+	return KegEventResponse{
+		UUID:              event.UUID.String(),
+		KegUUID:           event.KegUUID,
+		EventType:         event.EventType,
+		FromStatus:        event.FromStatus,
+		ToStatus:          event.ToStatus,
+		StockLocationUUID: event.StockLocationUUID,
+		Custodian:         event.Custodian,
+		BeerLotItemUUID:   event.BeerLotItemUUID,
+		DepositCents:      event.DepositCents,
+		WriteOffCents:     event.WriteOffCents,
+		OccurredAt:        event.OccurredAt,
+		Notes:             event.Notes,
+		CreatedAt:         event.CreatedAt,
+	}
+}
+
+func NewKegEventsResponse(events []storage.KegEvent) []KegEventResponse {
+	resp := make([]KegEventResponse, 0, len(events))
+	for _, event := range events {
+		resp = append(resp, NewKegEventResponse(event))
+	}
+	return resp
+}
+
+// RecordKegEventResponse is the response body for POST /kegs/{uuid}/events.
+type RecordKegEventResponse struct {
+	Keg   KegResponse      `json:"keg"`
+	Event KegEventResponse `json:"event"`
+}
@@ -0,0 +1,84 @@
+package dto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+)
+
+func TestCreateKegEventRequest_Validate(t *testing.T) {
+	deposit := int64(3000)
+
+	tests := []struct {
+		name    string
+		req     dto.CreateKegEventRequest
+		wantErr string // substring expected in error; empty means no error
+	}{
+		{
+			name: "valid fill",
+			req:  dto.CreateKegEventRequest{EventType: "fill", BeerLotUUID: strPtr("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")},
+		},
+		{
+			name: "valid ship with deposit",
+			req:  dto.CreateKegEventRequest{EventType: "ship", Custodian: strPtr("The Rusty Tap"), DepositCents: &deposit},
+		},
+		{
+			name: "valid return with deposit refund",
+			req:  dto.CreateKegEventRequest{EventType: "return", DepositCents: &deposit},
+		},
+		{
+			name:    "missing event_type",
+			req:     dto.CreateKegEventRequest{},
+			wantErr: "event_type is required",
+		},
+		{
+			name:    "unknown event_type",
+			req:     dto.CreateKegEventRequest{EventType: "refill"},
+			wantErr: "invalid event_type",
+		},
+		{
+			name:    "fill without beer lot",
+			req:     dto.CreateKegEventRequest{EventType: "fill"},
+			wantErr: "beer_lot_uuid is required",
+		},
+		{
+			name:    "ship without custodian",
+			req:     dto.CreateKegEventRequest{EventType: "ship"},
+			wantErr: "custodian is required",
+		},
+		{
+			name:    "move without stock location",
+			req:     dto.CreateKegEventRequest{EventType: "move"},
+			wantErr: "stock_location_uuid is required",
+		},
+		{
+			name:    "deposit on clean",
+			req:     dto.CreateKegEventRequest{EventType: "clean", DepositCents: &deposit},
+			wantErr: "deposit_cents is only allowed",
+		},
+		{
+			name:    "write_off_cents on lost",
+			req:     dto.CreateKegEventRequest{EventType: "lost", WriteOffCents: &deposit},
+			wantErr: "write_off_cents is only allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
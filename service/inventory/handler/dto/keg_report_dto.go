@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// KegTurnTimeReportResponse is the response body for GET /keg-reports/turn-time.
+type KegTurnTimeReportResponse struct {
+	Cycles      int     `json:"cycles"`
+	AvgTurnDays float64 `json:"avg_turn_days"`
+	Trips       int     `json:"trips"`
+	AvgDaysOut  float64 `json:"avg_days_out"`
+}
+
+func NewKegTurnTimeReportResponse(report storage.KegTurnTimeReport) KegTurnTimeReportResponse {
+	return KegTurnTimeReportResponse{
+		Cycles:      report.Cycles,
+		AvgTurnDays: roundDays(report.AvgTurnDays),
+		Trips:       report.Trips,
+		AvgDaysOut:  roundDays(report.AvgDaysOut),
+	}
+}
+
+// OverdueKegResponse is a single entry in GET /keg-reports/overdue.
+type OverdueKegResponse struct {
+	KegUUID     string    `json:"keg_uuid"`
+	Serial      string    `json:"serial"`
+	Custodian   *string   `json:"custodian,omitempty"`
+	ShippedAt   time.Time `json:"shipped_at"`
+	DaysOut     int       `json:"days_out"`
+	BeerLotUUID *string   `json:"beer_lot_uuid,omitempty"`
+}
+
+// NewOverdueKegsResponse builds overdue entries with days out measured to now.
+func NewOverdueKegsResponse(overdue []storage.OverdueKeg, now time.Time) []OverdueKegResponse {
+	resp := make([]OverdueKegResponse, 0, len(overdue))
+	for _, o := range overdue {
+		resp = append(resp, OverdueKegResponse{
+			KegUUID:     o.Keg.UUID.String(),
+			Serial:      o.Keg.Serial,
+			Custodian:   o.Keg.Custodian,
+			ShippedAt:   o.ShippedAt,
+			DaysOut:     int(now.Sub(o.ShippedAt).Hours() / 24),
+			BeerLotUUID: o.Keg.CurrentBeerLotUUID,
+		})
+	}
+	return resp
+}
+
+// KegWriteOffResponse is a single lost-keg write-off.
+type KegWriteOffResponse struct {
+	KegUUID       string    `json:"keg_uuid"`
+	Serial        string    `json:"serial"`
+	Custodian     *string   `json:"custodian,omitempty"`
+	WriteOffCents *int64    `json:"write_off_cents,omitempty"`
+	Currency      *string   `json:"currency,omitempty"`
+	WrittenOffAt  time.Time `json:"written_off_at"`
+	Notes         *string   `json:"notes,omitempty"`
+}
+
+// KegWriteOffReportResponse is the response body for GET /keg-reports/write-offs.
+type KegWriteOffReportResponse struct {
+	Count int `json:"count"`
+	// TotalCentsByCurrency sums write-off values per currency code.
+	TotalCentsByCurrency map[string]int64      `json:"total_cents_by_currency"`
+	WriteOffs            []KegWriteOffResponse `json:"write_offs"`
+}
+
+func NewKegWriteOffReportResponse(writeOffs []storage.KegWriteOff) KegWriteOffReportResponse {
+	resp := KegWriteOffReportResponse{
+		Count:                len(writeOffs),
+		TotalCentsByCurrency: map[string]int64{},
+		WriteOffs:            make([]KegWriteOffResponse, 0, len(writeOffs)),
+	}
+	for _, w := range writeOffs {
+		if w.WriteOffCents != nil && w.Currency != nil {
+			resp.TotalCentsByCurrency[*w.Currency] += *w.WriteOffCents
+		}
+		resp.WriteOffs = append(resp.WriteOffs, KegWriteOffResponse{
+			KegUUID:       w.KegUUID,
+			Serial:        w.Serial,
+			Custodian:     w.Custodian,
+			WriteOffCents: w.WriteOffCents,
+			Currency:      w.Currency,
+			WrittenOffAt:  w.WrittenOffAt,
+			Notes:         w.Notes,
+		})
+	}
+	return resp
+}
+
+// roundDays rounds a day count to one decimal place.
+func roundDays(days float64) float64 {
+	return math.Round(days*10) / 10
+}
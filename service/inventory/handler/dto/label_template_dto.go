@@ -0,0 +1,96 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// LabelTemplateRequest is the request payload for POST /label-templates and
+// PUT /label-templates/{uuid}. Barcode, barcode content, font size and
+// printer DPI default to a Code 128 barcode of the lot code, 9 pt and 203
+// dpi. The subject of an existing template cannot change.
+type LabelTemplateRequest struct {
+	Name           string       `json:"name"`
+	Subject        string       `json:"subject"`
+	IsDefault      bool         `json:"is_default"`
+	WidthMM        float64      `json:"width_mm"`
+	HeightMM       float64      `json:"height_mm"`
+	Fields         []string     `json:"fields"`
+	Barcode        *string      `json:"barcode"`
+	BarcodeContent *string      `json:"barcode_content"`
+	FontSizePt     *float64     `json:"font_size_pt"`
+	PrinterDPI     *int         `json:"printer_dpi"`
+	Sheet          *label.Sheet `json:"sheet"`
+}
+
+func (r LabelTemplateRequest) Validate() error {
+	if err := validate.Required(r.Name, "name"); err != nil {
+		return err
+	}
+	available := label.SubjectFields(r.Subject)
+	if available == nil {
+		return fmt.Errorf("subject must be one of ingredient_lot, beer_lot, vessel")
+	}
+	return r.Layout().Validate(available)
+}
+
+// Layout returns the requested layout with defaults applied.
+func (r LabelTemplateRequest) Layout() label.Template {
+	t := label.Template{
+		WidthMM:        r.WidthMM,
+		HeightMM:       r.HeightMM,
+		Fields:         r.Fields,
+		Barcode:        label.BarcodeCode128,
+		BarcodeContent: label.BarcodeContentCode,
+		FontSizePt:     label.DefaultFontSizePt,
+		PrinterDPI:     label.DefaultPrinterDPI,
+		Sheet:          r.Sheet,
+	}
+	if r.Barcode != nil {
+		t.Barcode = *r.Barcode
+	}
+	if r.BarcodeContent != nil {
+		t.BarcodeContent = *r.BarcodeContent
+	}
+	if r.FontSizePt != nil {
+		t.FontSizePt = *r.FontSizePt
+	}
+	if r.PrinterDPI != nil {
+		t.PrinterDPI = *r.PrinterDPI
+	}
+	return t
+}
+
+type LabelTemplateResponse struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name"`
+	Subject   string `json:"subject"`
+	IsDefault bool   `json:"is_default"`
+	label.Template
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewLabelTemplateResponse(t storage.LabelTemplate) LabelTemplateResponse {
+	return LabelTemplateResponse{
+		UUID:      t.UUID.String(),
+		Name:      t.Name,
+		Subject:   t.Subject,
+		IsDefault: t.IsDefault,
+		Template:  t.Layout,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+func NewLabelTemplatesResponse(templates []storage.LabelTemplate) []LabelTemplateResponse {
+	resp := make([]LabelTemplateResponse, 0, len(templates))
+	for _, t := range templates {
+		resp = append(resp, NewLabelTemplateResponse(t))
+	}
+	return resp
+}
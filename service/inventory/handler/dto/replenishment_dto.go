@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// DefaultReplenishmentWindowDays is the trailing usage window used when the
+// caller does not specify one.
+const DefaultReplenishmentWindowDays = 90
+
+// ValidateReplenishmentWindowDays checks a trailing usage window in days.
+func ValidateReplenishmentWindowDays(days int) error {
+	if days < 1 || days > 365 {
+		return fmt.Errorf("window_days must be between 1 and 365")
+	}
+	return nil
+}
+
+// ReplenishmentResponse is the response for GET /replenishment-suggestions.
+type ReplenishmentResponse struct {
+	GeneratedAt time.Time                 `json:"generated_at"`
+	WindowDays  int                       `json:"window_days"`
+	Suggestions []ReplenishmentSuggestion `json:"suggestions"`
+}
+
+// ReplenishmentSuggestion is the computed replenishment need for one reorder
+// policy. Quantities are in the ingredient's default unit.
+type ReplenishmentSuggestion struct {
+	PolicyUUID        string  `json:"policy_uuid"`
+	IngredientUUID    string  `json:"ingredient_uuid"`
+	IngredientName    string  `json:"ingredient_name"`
+	Unit              string  `json:"unit"`
+	StockLocationUUID *string `json:"stock_location_uuid,omitempty"`
+	StockLocationName *string `json:"stock_location_name,omitempty"`
+	// OtherUnits lists units stock in scope is held in besides Unit. The
+	// policy's levels are in Unit, so that stock is not counted below.
+	OtherUnits []string `json:"other_units,omitempty"`
+	OnHand     int64    `json:"on_hand"`
+	Reserved   int64    `json:"reserved"`
+	Available  int64    `json:"available"`
+	// OnOrder is only counted for policies covering all locations, since
+	// purchase orders are not tied to a stock location.
+	OnOrder      int64    `json:"on_order"`
+	UsedInWindow int64    `json:"used_in_window"`
+	DailyUsage   float64  `json:"daily_usage"`
+	DaysOfCover  *float64 `json:"days_of_cover,omitempty"`
+	LeadTimeDays int      `json:"lead_time_days"`
+	MinLevel     int64    `json:"min_level"`
+	MaxLevel     int64    `json:"max_level"`
+	SafetyStock  int64    `json:"safety_stock"`
+	// ReorderPoint is the larger of min_level and safety stock plus expected
+	// usage over the lead time.
+	ReorderPoint      int64                  `json:"reorder_point"`
+	SuggestedQuantity int64                  `json:"suggested_quantity"`
+	NeedsReorder      bool                   `json:"needs_reorder"`
+	Supplier          *ReplenishmentSupplier `json:"supplier,omitempty"`
+}
+
+// ReplenishmentSupplier is the supplier a suggestion would be ordered from.
+// Source is "preferred" when taken from the policy and "last_order" when
+// taken from purchase order history. Item and cost details come from the
+// last order when it was placed with this supplier, and otherwise from the
+// supplier's catalog.
+type ReplenishmentSupplier struct {
+	SupplierUUID  string  `json:"supplier_uuid"`
+	SupplierName  *string `json:"supplier_name,omitempty"`
+	Source        string  `json:"source"`
+	ItemName      *string `json:"item_name,omitempty"`
+	QuantityUnit  *string `json:"quantity_unit,omitempty"`
+	UnitCostCents *int64  `json:"unit_cost_cents,omitempty"`
+	Currency      *string `json:"currency,omitempty"`
+}
+
+// CreateReplenishmentPurchaseOrdersRequest is the request body for
+// POST /replenishment-suggestions/purchase-orders. When PolicyUUIDs is empty,
+// every suggestion that needs reordering is ordered. Currency is used for
+// lines whose supplier has no price history.
+type CreateReplenishmentPurchaseOrdersRequest struct {
+	PolicyUUIDs []string `json:"policy_uuids"`
+	WindowDays  *int     `json:"window_days"`
+	Currency    *string  `json:"currency"`
+}
+
+func (r CreateReplenishmentPurchaseOrdersRequest) Validate() error {
+	for i, id := range r.PolicyUUIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return fmt.Errorf("policy_uuids[%d] must be a valid UUID", i)
+		}
+	}
+	if r.WindowDays != nil {
+		if err := ValidateReplenishmentWindowDays(*r.WindowDays); err != nil {
+			return err
+		}
+	}
+	if r.Currency != nil && len(strings.TrimSpace(*r.Currency)) != 3 {
+		return fmt.Errorf("currency must be a 3-letter code")
+	}
+	return nil
+}
+
+// ReplenishmentPurchaseOrdersResponse lists the draft purchase orders created
+// from suggestions, one per supplier, and the suggestions left unordered.
+type ReplenishmentPurchaseOrdersResponse struct {
+	PurchaseOrders []ReplenishmentPurchaseOrder `json:"purchase_orders"`
+	Unassigned     []UnassignedReplenishment    `json:"unassigned"`
+}
+
+type ReplenishmentPurchaseOrder struct {
+	PurchaseOrderUUID string                           `json:"purchase_order_uuid"`
+	OrderNumber       string                           `json:"order_number"`
+	SupplierUUID      string                           `json:"supplier_uuid"`
+	ExpectedAt        *time.Time                       `json:"expected_at,omitempty"`
+	Lines             []ReplenishmentPurchaseOrderLine `json:"lines"`
+}
+
+type ReplenishmentPurchaseOrderLine struct {
+	PolicyUUID     string `json:"policy_uuid"`
+	IngredientUUID string `json:"ingredient_uuid"`
+	ItemName       string `json:"item_name"`
+	Quantity       int64  `json:"quantity"`
+	QuantityUnit   string `json:"quantity_unit"`
+	UnitCostCents  int64  `json:"unit_cost_cents"`
+	Currency       string `json:"currency"`
+}
+
+// UnassignedReplenishment is a suggestion that could not be put on a draft
+// order. Reason is "no_supplier" or "no_currency".
+type UnassignedReplenishment struct {
+	ReplenishmentSuggestion
+	Reason string `json:"reason"`
+}
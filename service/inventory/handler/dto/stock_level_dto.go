@@ -9,6 +9,8 @@ type StockLevelLocationResponse struct {
 	LocationUUID string  `json:"location_uuid"`
 	LocationName string  `json:"location_name"`
 	Quantity     float64 `json:"quantity"`
+	Reserved     float64 `json:"reserved"`
+	Available    float64 `json:"available"`
 }
 
 // StockLevelResponse represents the aggregated stock level for an ingredient.
@@ -18,6 +20,8 @@ type StockLevelResponse struct {
 	Category       string                       `json:"category"`
 	DefaultUnit    string                       `json:"default_unit"`
 	TotalOnHand    float64                      `json:"total_on_hand"`
+	TotalReserved  float64                      `json:"total_reserved"`
+	TotalAvailable float64                      `json:"total_available"`
 	Locations      []StockLevelLocationResponse `json:"locations"`
 }
 
@@ -29,6 +33,8 @@ func NewStockLevelResponse(sl storage.StockLevel) StockLevelResponse {
 			LocationUUID: loc.LocationUUID,
 			LocationName: loc.LocationName,
 			Quantity:     loc.Quantity,
+			Reserved:     loc.Reserved,
+			Available:    loc.Available,
 		})
 	}
 
@@ -38,6 +44,8 @@ func NewStockLevelResponse(sl storage.StockLevel) StockLevelResponse {
 		Category:       sl.Category,
 		DefaultUnit:    sl.DefaultUnit,
 		TotalOnHand:    sl.TotalOnHand,
+		TotalReserved:  sl.TotalReserved,
+		TotalAvailable: sl.TotalAvailable,
 		Locations:      locations,
 	}
 }
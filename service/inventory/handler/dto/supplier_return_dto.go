@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// CreateSupplierReturnRequest is the request body for POST /supplier-returns.
+// The amount is in the lot's received unit. CreditAmountCents defaults to the
+// returned amount at the purchase order line's unit cost.
+type CreateSupplierReturnRequest struct {
+	IngredientLotUUID string     `json:"ingredient_lot_uuid"`
+	StockLocationUUID string     `json:"stock_location_uuid"`
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	Reason            string     `json:"reason"`
+	ReferenceCode     *string    `json:"reference_code"`
+	ReturnedAt        *time.Time `json:"returned_at"`
+	CreditAmountCents *int64     `json:"credit_amount_cents"`
+	CreditCurrency    *string    `json:"credit_currency"`
+	Notes             *string    `json:"notes"`
+}
+
+func (r CreateSupplierReturnRequest) Validate() error {
+	if err := validate.Required(r.IngredientLotUUID, "ingredient_lot_uuid"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.StockLocationUUID, "stock_location_uuid"); err != nil {
+		return err
+	}
+	if r.Amount <= 0 {
+		return fmt.Errorf("amount must be greater than zero")
+	}
+	if err := validate.Required(r.AmountUnit, "amount_unit"); err != nil {
+		return err
+	}
+	if err := validateSupplierReturnReason(r.Reason); err != nil {
+		return err
+	}
+	return validateCreditAmount(r.CreditAmountCents, r.CreditCurrency)
+}
+
+// UpdateSupplierReturnRequest is the request body for
+// PATCH /supplier-returns/{uuid}. It tracks the credit through to credited or
+// refused.
+type UpdateSupplierReturnRequest struct {
+	CreditStatus      *string `json:"credit_status"`
+	CreditAmountCents *int64  `json:"credit_amount_cents"`
+	CreditCurrency    *string `json:"credit_currency"`
+	ReferenceCode     *string `json:"reference_code"`
+	Notes             *string `json:"notes"`
+}
+
+func (r UpdateSupplierReturnRequest) Validate() error {
+	if r.CreditStatus != nil {
+		if err := validateCreditStatus(*r.CreditStatus); err != nil {
+			return err
+		}
+	}
+	if r.CreditAmountCents != nil && *r.CreditAmountCents < 0 {
+		return fmt.Errorf("credit_amount_cents must be greater than or equal to 0")
+	}
+	if r.CreditCurrency != nil {
+		return validateCurrency(*r.CreditCurrency)
+	}
+	return nil
+}
+
+func validateCreditAmount(amountCents *int64, currency *string) error {
+	if amountCents == nil {
+		return nil
+	}
+	if *amountCents < 0 {
+		return fmt.Errorf("credit_amount_cents must be greater than or equal to 0")
+	}
+	if currency == nil {
+		return fmt.Errorf("credit_currency is required when credit_amount_cents is provided")
+	}
+	return validateCurrency(*currency)
+}
+
+// SupplierReturnResponse is the response body for a single supplier return.
+type SupplierReturnResponse struct {
+	UUID                  string     `json:"uuid"`
+	IngredientLotUUID     string     `json:"ingredient_lot_uuid"`
+	BreweryLotCode        *string    `json:"brewery_lot_code,omitempty"`
+	IngredientUUID        string     `json:"ingredient_uuid"`
+	IngredientName        string     `json:"ingredient_name"`
+	StockLocationUUID     string     `json:"stock_location_uuid"`
+	PurchaseOrderLineUUID string     `json:"purchase_order_line_uuid"`
+	Amount                int64      `json:"amount"`
+	AmountUnit            string     `json:"amount_unit"`
+	Reason                string     `json:"reason"`
+	ReferenceCode         *string    `json:"reference_code,omitempty"`
+	ReturnedAt            time.Time  `json:"returned_at"`
+	CreditAmountCents     *int64     `json:"credit_amount_cents,omitempty"`
+	CreditCurrency        *string    `json:"credit_currency,omitempty"`
+	CreditStatus          string     `json:"credit_status"`
+	CreditResolvedAt      *time.Time `json:"credit_resolved_at,omitempty"`
+	// PurchaseOrderSyncStatus is pending until the return is recorded against
+	// its purchase order line in Procurement, then synced or failed.
+	PurchaseOrderSyncStatus string     `json:"purchase_order_sync_status"`
+	PurchaseOrderSyncedAt   *time.Time `json:"purchase_order_synced_at,omitempty"`
+	PurchaseOrderSyncError  *string    `json:"purchase_order_sync_error,omitempty"`
+	Notes                   *string    `json:"notes,omitempty"`
+	MovementUUID            *string    `json:"movement_uuid,omitempty"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+}
+
+func NewSupplierReturnResponse(sr storage.SupplierReturn) SupplierReturnResponse {
+	return SupplierReturnResponse{
+		UUID:                    sr.UUID.String(),
+		IngredientLotUUID:       sr.IngredientLotUUID,
+		BreweryLotCode:          sr.BreweryLotCode,
+		IngredientUUID:          sr.IngredientUUID,
+		IngredientName:          sr.IngredientName,
+		StockLocationUUID:       sr.StockLocationUUID,
+		PurchaseOrderLineUUID:   sr.PurchaseOrderLineUUID,
+		Amount:                  sr.Amount,
+		AmountUnit:              sr.AmountUnit,
+		Reason:                  sr.Reason,
+		ReferenceCode:           sr.ReferenceCode,
+		ReturnedAt:              sr.ReturnedAt,
+		CreditAmountCents:       sr.CreditAmountCents,
+		CreditCurrency:          sr.CreditCurrency,
+		CreditStatus:            sr.CreditStatus,
+		CreditResolvedAt:        sr.CreditResolvedAt,
+		PurchaseOrderSyncStatus: sr.PurchaseOrderSyncStatus,
+		PurchaseOrderSyncedAt:   sr.PurchaseOrderSyncedAt,
+		PurchaseOrderSyncError:  sr.PurchaseOrderSyncError,
+		Notes:                   sr.Notes,
+		MovementUUID:            sr.MovementUUID,
+		CreatedAt:               sr.CreatedAt,
+		UpdatedAt:               sr.UpdatedAt,
+	}
+}
+
+func NewSupplierReturnsResponse(returns []storage.SupplierReturn) []SupplierReturnResponse {
+	resp := make([]SupplierReturnResponse, 0, len(returns))
+	for _, sr := range returns {
+		resp = append(resp, NewSupplierReturnResponse(sr))
+	}
+	return resp
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/brewpipes/brewpipes/service/inventory/storage"
 )
@@ -15,6 +16,7 @@ func validateIngredientCategory(category string) error {
 		storage.IngredientCategorySalt,
 		storage.IngredientCategoryChemical,
 		storage.IngredientCategoryGas,
+		storage.IngredientCategoryPackaging,
 		storage.IngredientCategoryOther:
 		return nil
 	default:
@@ -133,6 +135,20 @@ func validateRemovalReason(reason string) error {
 	}
 }
 
+func validateBeerLotItemStatus(status string) error {
+	switch status {
+	case storage.BeerLotItemStatusAvailable,
+		storage.BeerLotItemStatusReserved,
+		storage.BeerLotItemStatusSold,
+		storage.BeerLotItemStatusReturned,
+		storage.BeerLotItemStatusDamaged,
+		storage.BeerLotItemStatusDestroyed:
+		return nil
+	default:
+		return fmt.Errorf("invalid status")
+	}
+}
+
 func validateAdjustmentReason(reason string) error {
 	switch reason {
 	case storage.AdjustmentReasonCycleCount,
@@ -146,3 +162,65 @@ func validateAdjustmentReason(reason string) error {
 		return fmt.Errorf("invalid reason")
 	}
 }
+
+func validateSupplierReturnReason(reason string) error {
+	switch reason {
+	case storage.SupplierReturnReasonDamaged,
+		storage.SupplierReturnReasonDeadOnArrival,
+		storage.SupplierReturnReasonQualityReject,
+		storage.SupplierReturnReasonWrongItem,
+		storage.SupplierReturnReasonExpired,
+		storage.SupplierReturnReasonOther:
+		return nil
+	default:
+		return fmt.Errorf("invalid reason")
+	}
+}
+
+func validateCreditStatus(status string) error {
+	switch status {
+	case storage.CreditStatusRequested,
+		storage.CreditStatusCredited,
+		storage.CreditStatusRefused:
+		return nil
+	default:
+		return fmt.Errorf("invalid credit_status")
+	}
+}
+
+func validateKegOwnership(ownership string) error {
+	switch ownership {
+	case storage.KegOwnershipOwned, storage.KegOwnershipLeased:
+		return nil
+	default:
+		return fmt.Errorf("invalid ownership")
+	}
+}
+
+func validateKegEventType(eventType string) error {
+	switch eventType {
+	case storage.KegEventFill,
+		storage.KegEventShip,
+		storage.KegEventReturn,
+		storage.KegEventClean,
+		storage.KegEventMove,
+		storage.KegEventLost,
+		storage.KegEventWriteOff,
+		storage.KegEventRetire:
+		return nil
+	default:
+		return fmt.Errorf("invalid event_type")
+	}
+}
+
+func validateCurrency(code string) error {
+	value := strings.TrimSpace(code)
+	if value == "" {
+		return fmt.Errorf("currency is required")
+	}
+	if len(value) != 3 {
+		return fmt.Errorf("currency must be a 3-letter code")
+	}
+
+	return nil
+}
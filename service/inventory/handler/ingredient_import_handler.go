@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+type IngredientImportStore interface {
+	ListIngredients(context.Context) ([]storage.Ingredient, error)
+	ImportIngredient(context.Context, storage.IngredientImport) (storage.Ingredient, error)
+	RunInTx(context.Context, func(context.Context) error) error
+}
+
+// HandleIngredientImport handles [POST /ingredients/import]. The upload is a
+// CSV file with name, category and default_unit columns, an optional
+// description, and optional detail columns: maltster_name, variety, lovibond,
+// srm and diastatic_power for fermentables; producer_name, variety,
+// crop_year, form, alpha_acid and beta_acid for hops; lab_name, strain and
+// form for yeast. Names already in use, here or earlier in the file, are
+// rejected. With dry_run=true rows are created in a transaction that is
+// rolled back.
+func HandleIngredientImport(db IngredientImportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		existing, err := db.ListIngredients(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing ingredients", "error", err)
+			return
+		}
+		names := make(map[string]bool, len(existing))
+		for _, ingredient := range existing {
+			names[strings.ToLower(ingredient.Name)] = true
+		}
+
+		importer := csvimport.Importer[dto.ImportIngredientRequest]{
+			Name: "ingredient",
+			Headers: []string{
+				"name", "category", "default_unit", "description",
+				"maltster_name", "variety", "lovibond", "srm", "diastatic_power",
+				"producer_name", "crop_year", "form", "alpha_acid", "beta_acid",
+				"lab_name", "strain",
+			},
+			Required: []string{"name", "category", "default_unit"},
+			Parse: func(_ context.Context, row csvimport.Row) (dto.ImportIngredientRequest, error) {
+				req, err := parseIngredientImportRow(row)
+				if err != nil {
+					return req, err
+				}
+				key := strings.ToLower(req.Ingredient.Name)
+				if names[key] {
+					return req, fmt.Errorf("ingredient %q already exists", req.Ingredient.Name)
+				}
+				names[key] = true
+				return req, nil
+			},
+			Create: func(ctx context.Context, req dto.ImportIngredientRequest) (any, error) {
+				created, err := db.ImportIngredient(ctx, newIngredientImport(req))
+				if err != nil {
+					return nil, err
+				}
+				return dto.NewIngredientResponse(created), nil
+			},
+			RunInTx: db.RunInTx,
+		}
+
+		file, ok := csvimport.Read(w, r, importer)
+		if !ok {
+			return
+		}
+
+		result, err := csvimport.Run(r.Context(), importer, file, dryRun)
+		if err != nil {
+			service.InternalError(w, "error importing ingredients", "error", err)
+			return
+		}
+		service.JSON(w, result)
+	}
+}
+
+// parseIngredientImportRow reads a row into a request. A detail is built
+// from the columns specific to it; variety and form go to the detail of the
+// row's category.
+func parseIngredientImportRow(row csvimport.Row) (dto.ImportIngredientRequest, error) {
+	req := dto.ImportIngredientRequest{
+		Ingredient: dto.CreateIngredientRequest{
+			Name:        row.Value("name"),
+			Category:    row.Value("category"),
+			DefaultUnit: row.Value("default_unit"),
+			Description: row.Optional("description"),
+		},
+	}
+	category := req.Ingredient.Category
+
+	lovibond, err := row.Float("lovibond")
+	if err != nil {
+		return req, err
+	}
+	srm, err := row.Float("srm")
+	if err != nil {
+		return req, err
+	}
+	diastaticPower, err := row.Float("diastatic_power")
+	if err != nil {
+		return req, err
+	}
+	cropYear, err := row.Int("crop_year")
+	if err != nil {
+		return req, err
+	}
+	alphaAcid, err := row.Float("alpha_acid")
+	if err != nil {
+		return req, err
+	}
+	betaAcid, err := row.Float("beta_acid")
+	if err != nil {
+		return req, err
+	}
+
+	malt := dto.CreateIngredientMaltDetailRequest{
+		MaltsterName:   row.Optional("maltster_name"),
+		Lovibond:       lovibond,
+		SRM:            srm,
+		DiastaticPower: diastaticPower,
+	}
+	hop := dto.CreateIngredientHopDetailRequest{
+		ProducerName: row.Optional("producer_name"),
+		CropYear:     cropYear,
+		AlphaAcid:    alphaAcid,
+		BetaAcid:     betaAcid,
+	}
+	yeast := dto.CreateIngredientYeastDetailRequest{
+		LabName: row.Optional("lab_name"),
+		Strain:  row.Optional("strain"),
+	}
+
+	variety, form := row.Optional("variety"), row.Optional("form")
+	switch {
+	case variety != nil && category == storage.IngredientCategoryFermentable:
+		malt.Variety = variety
+	case variety != nil && category == storage.IngredientCategoryHop:
+		hop.Variety = variety
+	case variety != nil:
+		return req, fmt.Errorf("variety applies to fermentable and hop ingredients only")
+	}
+	switch {
+	case form != nil && category == storage.IngredientCategoryHop:
+		hop.Form = form
+	case form != nil && category == storage.IngredientCategoryYeast:
+		yeast.Form = form
+	case form != nil:
+		return req, fmt.Errorf("form applies to hop and yeast ingredients only")
+	}
+
+	details := 0
+	if malt != (dto.CreateIngredientMaltDetailRequest{}) {
+		req.MaltDetail = &malt
+		details++
+	}
+	if hop != (dto.CreateIngredientHopDetailRequest{}) {
+		req.HopDetail = &hop
+		details++
+	}
+	if yeast != (dto.CreateIngredientYeastDetailRequest{}) {
+		req.YeastDetail = &yeast
+		details++
+	}
+	if details > 1 {
+		return req, fmt.Errorf("row mixes malt, hop and yeast detail columns")
+	}
+
+	return req, req.Validate()
+}
+
+func newIngredientImport(req dto.ImportIngredientRequest) storage.IngredientImport {
+	imp := storage.IngredientImport{
+		Ingredient: storage.Ingredient{
+			Name:        req.Ingredient.Name,
+			Category:    req.Ingredient.Category,
+			DefaultUnit: req.Ingredient.DefaultUnit,
+			Description: req.Ingredient.Description,
+		},
+	}
+	if d := req.MaltDetail; d != nil {
+		imp.MaltDetail = &storage.IngredientMaltDetail{
+			MaltsterName:   d.MaltsterName,
+			Variety:        d.Variety,
+			Lovibond:       d.Lovibond,
+			SRM:            d.SRM,
+			DiastaticPower: d.DiastaticPower,
+		}
+	}
+	if d := req.HopDetail; d != nil {
+		imp.HopDetail = &storage.IngredientHopDetail{
+			ProducerName: d.ProducerName,
+			Variety:      d.Variety,
+			CropYear:     d.CropYear,
+			Form:         d.Form,
+			AlphaAcid:    d.AlphaAcid,
+			BetaAcid:     d.BetaAcid,
+		}
+	}
+	if d := req.YeastDetail; d != nil {
+		imp.YeastDetail = &storage.IngredientYeastDetail{
+			LabName: d.LabName,
+			Strain:  d.Strain,
+			Form:    d.Form,
+		}
+	}
+	return imp
+}
@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockIngredientImportStore implements handler.IngredientImportStore for
+// testing.
+type mockIngredientImportStore struct {
+	ingredients []storage.Ingredient
+	imported    []storage.IngredientImport
+	failName    string
+}
+
+// RunInTx discards the imports fn recorded when fn fails.
+func (m *mockIngredientImportStore) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	imported := len(m.imported)
+	if err := fn(ctx); err != nil {
+		m.imported = m.imported[:imported]
+		return err
+	}
+	return nil
+}
+
+func (m *mockIngredientImportStore) ListIngredients(context.Context) ([]storage.Ingredient, error) {
+	return m.ingredients, nil
+}
+
+func (m *mockIngredientImportStore) ImportIngredient(_ context.Context, req storage.IngredientImport) (storage.Ingredient, error) {
+	if req.Ingredient.Name == m.failName {
+		return storage.Ingredient{}, errors.New("insert failed")
+	}
+	m.imported = append(m.imported, req)
+	ingredient := req.Ingredient
+	ingredient.UUID = uuid.Must(uuid.NewV4())
+	return ingredient, nil
+}
+
+func importIngredients(t *testing.T, store *mockIngredientImportStore, target, csv string) csvimport.Result {
+	t.Helper()
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", "ingredients.csv")
+	if err != nil {
+		t.Fatalf("creating form file: %v", err)
+	}
+	part.Write([]byte(csv))
+	form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, target, &buf)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.HandleIngredientImport(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp csvimport.Result
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return resp
+}
+
+func TestHandleIngredientImport(t *testing.T) {
+	csv := "name,category,default_unit,variety,lovibond,alpha_acid,form,lab_name\n" +
+		"Pale Malt,fermentable,kg,Maris Otter,3,,,\n" +
+		"Citra,hop,g,,,12.5,pellet,\n" +
+		"Chico,yeast,pkg,,,,dry,Fermentis\n" +
+		"citra,hop,g,,,,,\n" +
+		"Lactose,adjunct,kg,,,,pellet,\n" +
+		"Mixed,fermentable,kg,,2,11,,\n" +
+		"Cascade,hop,g,,,101,,\n" +
+		"Magnum,hop,g,,,,,\n"
+
+	t.Run("creates ingredients with details", func(t *testing.T) {
+		store := &mockIngredientImportStore{ingredients: []storage.Ingredient{{Name: "Magnum"}}}
+
+		resp := importIngredients(t, store, "/ingredients/import", csv)
+
+		if resp.DryRun || resp.Totals.TotalRows != 8 || resp.Totals.Created != 3 || resp.Totals.Failed != 5 {
+			t.Errorf("unexpected totals %+v", resp.Totals)
+		}
+		expectedErrors := map[int]string{
+			5: `ingredient "citra" already exists`,
+			6: "form applies to hop and yeast ingredients only",
+			7: "row mixes malt, hop and yeast detail columns",
+			8: "alpha_acid must be between 0 and 100",
+			9: `ingredient "Magnum" already exists`,
+		}
+		for _, result := range resp.Results {
+			want, failed := expectedErrors[result.Row]
+			switch {
+			case failed && (result.Error == nil || *result.Error != want):
+				t.Errorf("row %d: expected error %q, got %+v", result.Row, want, result)
+			case !failed && result.Status != csvimport.StatusCreated:
+				t.Errorf("row %d: expected created, got %+v", result.Row, result)
+			}
+		}
+
+		if len(store.imported) != 3 {
+			t.Fatalf("expected 3 imports, got %d", len(store.imported))
+		}
+		if malt := store.imported[0].MaltDetail; malt == nil || *malt.Variety != "Maris Otter" || *malt.Lovibond != 3 {
+			t.Errorf("unexpected malt detail %+v", malt)
+		}
+		if hop := store.imported[1].HopDetail; hop == nil || *hop.AlphaAcid != 12.5 || *hop.Form != "pellet" {
+			t.Errorf("unexpected hop detail %+v", hop)
+		}
+		if yeast := store.imported[2].YeastDetail; yeast == nil || *yeast.LabName != "Fermentis" || *yeast.Form != "dry" {
+			t.Errorf("unexpected yeast detail %+v", yeast)
+		}
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		store := &mockIngredientImportStore{ingredients: []storage.Ingredient{{Name: "Magnum"}}}
+
+		resp := importIngredients(t, store, "/ingredients/import?dry_run=true", csv)
+
+		if !resp.DryRun || resp.Totals.Valid != 3 || resp.Totals.Created != 0 || resp.Totals.Failed != 5 {
+			t.Errorf("unexpected totals %+v", resp.Totals)
+		}
+		if resp.Results[0].Status != csvimport.StatusValid || resp.Results[0].Record != nil {
+			t.Errorf("expected a valid row without a record, got %+v", resp.Results[0])
+		}
+		if len(store.imported) != 0 {
+			t.Errorf("expected no imports, got %d", len(store.imported))
+		}
+	})
+
+	t.Run("dry run reports rows the store rejects", func(t *testing.T) {
+		store := &mockIngredientImportStore{ingredients: []storage.Ingredient{{Name: "Magnum"}}, failName: "Citra"}
+
+		resp := importIngredients(t, store, "/ingredients/import?dry_run=true", csv)
+
+		if resp.Totals.Valid != 2 || resp.Totals.Failed != 6 {
+			t.Errorf("unexpected totals %+v", resp.Totals)
+		}
+		if result := resp.Results[1]; result.Status != csvimport.StatusError || result.Error == nil || *result.Error != "create ingredient failed" {
+			t.Errorf("expected row 3 to fail, got %+v", result)
+		}
+		if len(store.imported) != 0 {
+			t.Errorf("expected no imports, got %d", len(store.imported))
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+type IngredientLotImportStore interface {
+	ListIngredients(context.Context) ([]storage.Ingredient, error)
+	ListStockLocations(context.Context) ([]storage.StockLocation, error)
+	ImportOpeningIngredientLot(context.Context, storage.OpeningLotImport) (storage.IngredientLot, error)
+	RunInTx(context.Context, func(context.Context) error) error
+}
+
+// HandleIngredientLotImport handles [POST /ingredient-lots/import], which
+// loads opening stock. The upload is a CSV file with ingredient,
+// stock_location and amount columns, where ingredient and stock_location are
+// a name or UUID. Optional columns are unit (defaulting to the ingredient's
+// default unit), brewery_lot_code, originator_lot_code, originator_name,
+// originator_type, received_date, best_by_date, expires_date, reference_code
+// and notes. Each row creates a receipt, the lot and its receive movement.
+// With dry_run=true rows are created in a transaction that is rolled back.
+func HandleIngredientLotImport(db IngredientLotImportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ingredients, err := db.ListIngredients(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing ingredients", "error", err)
+			return
+		}
+		locations, err := db.ListStockLocations(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing stock locations", "error", err)
+			return
+		}
+
+		importer := csvimport.Importer[storage.OpeningLotImport]{
+			Name: "ingredient lot",
+			Headers: []string{
+				"ingredient", "stock_location", "amount", "unit",
+				"brewery_lot_code", "originator_lot_code", "originator_name", "originator_type",
+				"received_date", "best_by_date", "expires_date", "reference_code", "notes",
+			},
+			Required: []string{"ingredient", "stock_location", "amount"},
+			Parse: func(_ context.Context, row csvimport.Row) (storage.OpeningLotImport, error) {
+				return parseIngredientLotImportRow(row, ingredients, locations)
+			},
+			Create: func(ctx context.Context, imp storage.OpeningLotImport) (any, error) {
+				created, err := db.ImportOpeningIngredientLot(ctx, imp)
+				if err != nil {
+					return nil, err
+				}
+				return dto.NewIngredientLotResponse(created), nil
+			},
+			RunInTx: db.RunInTx,
+		}
+
+		file, ok := csvimport.Read(w, r, importer)
+		if !ok {
+			return
+		}
+
+		result, err := csvimport.Run(r.Context(), importer, file, dryRun)
+		if err != nil {
+			service.InternalError(w, "error importing ingredient lots", "error", err)
+			return
+		}
+		service.JSON(w, result)
+	}
+}
+
+func parseIngredientLotImportRow(row csvimport.Row, ingredients []storage.Ingredient, locations []storage.StockLocation) (storage.OpeningLotImport, error) {
+	if err := row.Require("ingredient", "stock_location", "amount"); err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+
+	ingredient, err := csvimport.Lookup(ingredients, row.Value("ingredient"), "ingredient", func(i storage.Ingredient) (string, string) {
+		return i.UUID.String(), i.Name
+	})
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+	location, err := csvimport.Lookup(locations, row.Value("stock_location"), "stock_location", func(l storage.StockLocation) (string, string) {
+		return l.UUID.String(), l.Name
+	})
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+
+	amount, err := row.Int("amount")
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+	receivedAt, err := row.Date("received_date")
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+	bestByAt, err := row.Date("best_by_date")
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+	expiresAt, err := row.Date("expires_date")
+	if err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+
+	unit := row.Value("unit")
+	if unit == "" {
+		unit = ingredient.DefaultUnit
+	}
+
+	req := dto.CreateIngredientLotRequest{
+		IngredientUUID:    ingredient.UUID.String(),
+		BreweryLotCode:    row.Optional("brewery_lot_code"),
+		OriginatorLotCode: row.Optional("originator_lot_code"),
+		OriginatorName:    row.Optional("originator_name"),
+		OriginatorType:    row.Optional("originator_type"),
+		ReceivedAt:        receivedAt,
+		ReceivedAmount:    int64(*amount),
+		ReceivedUnit:      unit,
+		BestByAt:          bestByAt,
+		ExpiresAt:         expiresAt,
+		Notes:             row.Optional("notes"),
+	}
+	if err := req.Validate(); err != nil {
+		return storage.OpeningLotImport{}, err
+	}
+
+	lot := storage.IngredientLot{
+		IngredientID:      ingredient.ID,
+		BreweryLotCode:    req.BreweryLotCode,
+		OriginatorLotCode: req.OriginatorLotCode,
+		OriginatorName:    req.OriginatorName,
+		OriginatorType:    req.OriginatorType,
+		ReceivedAmount:    req.ReceivedAmount,
+		ReceivedUnit:      req.ReceivedUnit,
+		BestByAt:          req.BestByAt,
+		ExpiresAt:         req.ExpiresAt,
+		Notes:             req.Notes,
+	}
+	if receivedAt != nil {
+		lot.ReceivedAt = *receivedAt
+	}
+
+	return storage.OpeningLotImport{
+		Lot:             lot,
+		StockLocationID: location.ID,
+		ReferenceCode:   row.Optional("reference_code"),
+	}, nil
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// ReorderPolicyStore defines the storage interface for reorder policy handlers.
+type ReorderPolicyStore interface {
+	CreateReorderPolicy(context.Context, storage.IngredientReorderPolicy) (storage.IngredientReorderPolicy, error)
+	GetReorderPolicyByUUID(context.Context, string) (storage.IngredientReorderPolicy, error)
+	ListReorderPolicies(context.Context, *string) ([]storage.IngredientReorderPolicy, error)
+	UpdateReorderPolicy(context.Context, string, storage.UpdateReorderPolicyRequest) (storage.IngredientReorderPolicy, error)
+	SoftDeleteReorderPolicy(context.Context, string) error
+	GetIngredientByUUID(context.Context, string) (storage.Ingredient, error)
+	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
+}
+
+// HandleReorderPolicies handles [GET /ingredient-reorder-policies] and
+// [POST /ingredient-reorder-policies].
+func HandleReorderPolicies(db ReorderPolicyStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var ingredientUUID *string
+			if v := r.URL.Query().Get("ingredient_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid ingredient_uuid", http.StatusBadRequest)
+					return
+				}
+				ingredientUUID = &v
+			}
+
+			policies, err := db.ListReorderPolicies(r.Context(), ingredientUUID)
+			if err != nil {
+				service.InternalError(w, "error listing reorder policies", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewReorderPoliciesResponse(policies))
+
+		case http.MethodPost:
+			var req dto.CreateReorderPolicyRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			ingredient, ok := service.ResolveFK(r.Context(), w, req.IngredientUUID, "ingredient", db.GetIngredientByUUID)
+			if !ok {
+				return
+			}
+			location, ok := service.ResolveFKOptional(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID)
+			if !ok {
+				return
+			}
+
+			policy := storage.IngredientReorderPolicy{
+				IngredientID: ingredient.ID,
+				MinLevel:     req.MinLevel,
+				MaxLevel:     req.MaxLevel,
+				LeadTimeDays: req.LeadTimeDays,
+				Notes:        req.Notes,
+			}
+			if req.StockLocationUUID != nil {
+				policy.StockLocationID = &location.ID
+			}
+			if req.SafetyStock != nil {
+				policy.SafetyStock = *req.SafetyStock
+			}
+			if req.PreferredSupplierUUID != nil {
+				supplierUUID := uuid.FromStringOrNil(*req.PreferredSupplierUUID)
+				policy.PreferredSupplierUUID = &supplierUUID
+			}
+
+			created, err := db.CreateReorderPolicy(r.Context(), policy)
+			if errors.Is(err, storage.ErrDuplicateReorderPolicy) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating reorder policy", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewReorderPolicyResponse(created))
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleReorderPolicyByUUID handles [GET /ingredient-reorder-policies/{uuid}],
+// [PATCH /ingredient-reorder-policies/{uuid}], and
+// [DELETE /ingredient-reorder-policies/{uuid}].
+func HandleReorderPolicyByUUID(db ReorderPolicyStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		policyUUID := r.PathValue("uuid")
+		if policyUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			policy, err := db.GetReorderPolicyByUUID(r.Context(), policyUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "reorder policy not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting reorder policy", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewReorderPolicyResponse(policy))
+
+		case http.MethodPatch:
+			var req dto.UpdateReorderPolicyRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			existing, err := db.GetReorderPolicyByUUID(r.Context(), policyUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "reorder policy not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting reorder policy", "error", err)
+				return
+			}
+
+			minLevel, maxLevel := existing.MinLevel, existing.MaxLevel
+			if req.MinLevel != nil {
+				minLevel = *req.MinLevel
+			}
+			if req.MaxLevel != nil {
+				maxLevel = *req.MaxLevel
+			}
+			if maxLevel < minLevel {
+				http.Error(w, "max_level must be greater than or equal to min_level", http.StatusBadRequest)
+				return
+			}
+
+			updated, err := db.UpdateReorderPolicy(r.Context(), policyUUID, storage.UpdateReorderPolicyRequest{
+				MinLevel:              req.MinLevel,
+				MaxLevel:              req.MaxLevel,
+				SafetyStock:           req.SafetyStock,
+				PreferredSupplierUUID: req.PreferredSupplierUUID,
+				LeadTimeDays:          req.LeadTimeDays,
+				Notes:                 req.Notes,
+			})
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "reorder policy not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating reorder policy", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewReorderPolicyResponse(updated))
+
+		case http.MethodDelete:
+			err := db.SoftDeleteReorderPolicy(r.Context(), policyUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "reorder policy not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting reorder policy", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// InventoryReservationStore defines the storage interface for reservation handlers.
+type InventoryReservationStore interface {
+	CreateReservation(context.Context, storage.InventoryReservation) (storage.InventoryReservation, error)
+	GetReservationByUUID(context.Context, string) (storage.InventoryReservation, error)
+	ListReservations(context.Context, storage.ReservationListFilter) ([]storage.InventoryReservation, error)
+	ReleaseReservation(context.Context, string) (storage.InventoryReservation, error)
+	ReleaseReservationsForProduction(context.Context, string) (int64, error)
+	GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error)
+	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
+}
+
+// HandleInventoryReservations handles [GET /inventory-reservations] and
+// [POST /inventory-reservations].
+func HandleInventoryReservations(db InventoryReservationStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			filter := storage.ReservationListFilter{}
+			q := r.URL.Query()
+
+			if v := q.Get("production_ref_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid production_ref_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.ProductionRefUUID = &v
+			}
+			if v := q.Get("ingredient_lot_uuid"); v != "" {
+				filter.IngredientLotUUID = &v
+			}
+			if v := q.Get("status"); v != "" {
+				filter.Status = &v
+			}
+
+			reservations, err := db.ListReservations(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing inventory reservations", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewInventoryReservationsResponse(reservations))
+
+		case http.MethodPost:
+			var req dto.CreateInventoryReservationRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			lot, ok := service.ResolveFK(r.Context(), w, req.IngredientLotUUID, "ingredient lot", db.GetIngredientLotByUUID)
+			if !ok {
+				return
+			}
+			location, ok := service.ResolveFK(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID)
+			if !ok {
+				return
+			}
+
+			// Available stock is summed in the lot's received unit, so a
+			// reservation in any other unit cannot be checked against it.
+			if req.AmountUnit != lot.ReceivedUnit {
+				http.Error(w, fmt.Sprintf("amount_unit must be the lot's received unit %q", lot.ReceivedUnit), http.StatusBadRequest)
+				return
+			}
+
+			created, err := db.CreateReservation(r.Context(), storage.InventoryReservation{
+				ProductionRefUUID: uuid.FromStringOrNil(req.ProductionRefUUID),
+				IngredientLotID:   lot.ID,
+				StockLocationID:   location.ID,
+				Amount:            req.Amount,
+				AmountUnit:        req.AmountUnit,
+				Notes:             req.Notes,
+			})
+			if errors.Is(err, storage.ErrReservationExceedsAvailable) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating inventory reservation", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewInventoryReservationResponse(created))
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleInventoryReservationByUUID handles [GET /inventory-reservations/{uuid}]
+// and [DELETE /inventory-reservations/{uuid}]. Deleting releases the reservation.
+func HandleInventoryReservationByUUID(db InventoryReservationStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		resUUID := r.PathValue("uuid")
+		if resUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			res, err := db.GetReservationByUUID(r.Context(), resUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "inventory reservation not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting inventory reservation", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewInventoryReservationResponse(res))
+
+		case http.MethodDelete:
+			_, err := db.ReleaseReservation(r.Context(), resUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "inventory reservation not found", http.StatusNotFound)
+				return
+			} else if errors.Is(err, storage.ErrReservationNotActive) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error releasing inventory reservation", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleReleaseInventoryReservations handles [POST /inventory-reservations/release].
+// It releases every active reservation held for a production batch.
+func HandleReleaseInventoryReservations(db InventoryReservationStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.ReleaseInventoryReservationsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		released, err := db.ReleaseReservationsForProduction(r.Context(), req.ProductionRefUUID)
+		if err != nil {
+			service.InternalError(w, "error releasing inventory reservations", "error", err, "production_ref_uuid", req.ProductionRefUUID)
+			return
+		}
+
+		if released > 0 {
+			slog.Info("inventory reservations released", "production_ref_uuid", req.ProductionRefUUID, "released", released)
+		}
+
+		service.JSON(w, dto.ReleaseInventoryReservationsResponse{
+			ProductionRefUUID: req.ProductionRefUUID,
+			Released:          released,
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockReservationStore implements handler.InventoryReservationStore for
+// testing reservation creation.
+type mockReservationStore struct {
+	handler.InventoryReservationStore
+	lot      storage.IngredientLot
+	location storage.StockLocation
+	created  []storage.InventoryReservation
+}
+
+func (m *mockReservationStore) GetIngredientLotByUUID(_ context.Context, lotUUID string) (storage.IngredientLot, error) {
+	if lotUUID != m.lot.UUID.String() {
+		return storage.IngredientLot{}, service.ErrNotFound
+	}
+	return m.lot, nil
+}
+
+func (m *mockReservationStore) GetStockLocationByUUID(_ context.Context, locationUUID string) (storage.StockLocation, error) {
+	if locationUUID != m.location.UUID.String() {
+		return storage.StockLocation{}, service.ErrNotFound
+	}
+	return m.location, nil
+}
+
+func (m *mockReservationStore) CreateReservation(_ context.Context, res storage.InventoryReservation) (storage.InventoryReservation, error) {
+	res.UUID = uuid.Must(uuid.NewV4())
+	m.created = append(m.created, res)
+	return res, nil
+}
+
+func TestHandleInventoryReservationsCreateUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		unit       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "lot's received unit is accepted",
+			unit:       "kg",
+			wantStatus: http.StatusCreated,
+			wantBody:   `"amount_unit":"kg"`,
+		},
+		{
+			name:       "other unit returns 400",
+			unit:       "lb",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `amount_unit must be the lot's received unit "kg"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockReservationStore{
+				lot: storage.IngredientLot{
+					Identifiers:  entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())},
+					ReceivedUnit: "kg",
+				},
+				location: storage.StockLocation{
+					Identifiers: entity.Identifiers{ID: 2, UUID: uuid.Must(uuid.NewV4())},
+				},
+			}
+
+			body := `{
+				"production_ref_uuid": "` + uuid.Must(uuid.NewV4()).String() + `",
+				"ingredient_lot_uuid": "` + store.lot.UUID.String() + `",
+				"stock_location_uuid": "` + store.location.UUID.String() + `",
+				"amount": 5,
+				"amount_unit": "` + tt.unit + `"
+			}`
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/inventory-reservations", bytes.NewBufferString(body))
+			handler.HandleInventoryReservations(store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body does not contain %q; got: %s", tt.wantBody, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusCreated && len(store.created) != 0 {
+				t.Errorf("reservation was created despite the unit mismatch")
+			}
+		})
+	}
+}
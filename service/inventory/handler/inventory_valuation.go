@@ -0,0 +1,361 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// Reasons an ingredient lot cannot be valued.
+const (
+	uncostedNoPurchaseOrderLine       = "no_purchase_order_line"
+	uncostedPurchaseOrderLineNotFound = "purchase_order_line_not_found"
+	uncostedUnitMismatch              = "unit_mismatch"
+	uncostedNoExchangeRate            = "no_exchange_rate"
+)
+
+// lotCosts holds the landed unit cost of each ingredient lot in the base
+// currency, in cents per unit of the lot's received unit.
+type lotCosts struct {
+	baseCurrency string
+	unitCosts    map[string]float64
+	uncosted     map[string]string
+}
+
+// loadLotCosts resolves the landed unit cost of every lot referenced by the
+// movements from the purchase order lines the lots were received against.
+// A lot is left uncosted when it has no order line, the order line is in a
+// different unit, any movement is in a different unit, or the order line's
+// currency has no exchange rate.
+func loadLotCosts(ctx context.Context, procClient POLineFetcher, movements []storage.ValuationMovement) (lotCosts, error) {
+	costs := lotCosts{
+		unitCosts: make(map[string]float64),
+		uncosted:  make(map[string]string),
+	}
+
+	lots := make(map[string]storage.ValuationMovement)
+	var lotOrder []string
+	unitMismatch := make(map[string]bool)
+	poLineUUIDSet := make(map[string]struct{})
+	for _, m := range movements {
+		if _, ok := lots[m.IngredientLotUUID]; !ok {
+			lots[m.IngredientLotUUID] = m
+			lotOrder = append(lotOrder, m.IngredientLotUUID)
+			if m.PurchaseOrderLineUUID != nil {
+				poLineUUIDSet[*m.PurchaseOrderLineUUID] = struct{}{}
+			}
+		}
+		if m.AmountUnit != m.LotReceivedUnit {
+			unitMismatch[m.IngredientLotUUID] = true
+		}
+	}
+
+	poLineMap := make(map[string]PurchaseOrderLineCost)
+	if len(poLineUUIDSet) > 0 {
+		poLineUUIDs := make([]string, 0, len(poLineUUIDSet))
+		for uuid := range poLineUUIDSet {
+			poLineUUIDs = append(poLineUUIDs, uuid)
+		}
+		slices.Sort(poLineUUIDs)
+
+		poLines, err := procClient.BatchLookupPOLines(ctx, poLineUUIDs)
+		if err != nil {
+			return lotCosts{}, err
+		}
+		for _, line := range poLines {
+			poLineMap[line.UUID] = line
+			if costs.baseCurrency == "" {
+				costs.baseCurrency = line.BaseCurrency
+			}
+		}
+	}
+
+	for _, lotUUID := range lotOrder {
+		lot := lots[lotUUID]
+		if lot.PurchaseOrderLineUUID == nil {
+			costs.uncosted[lotUUID] = uncostedNoPurchaseOrderLine
+			continue
+		}
+		line, ok := poLineMap[*lot.PurchaseOrderLineUUID]
+		if !ok || line.Quantity <= 0 {
+			costs.uncosted[lotUUID] = uncostedPurchaseOrderLineNotFound
+			continue
+		}
+		if line.QuantityUnit != lot.LotReceivedUnit || unitMismatch[lotUUID] {
+			costs.uncosted[lotUUID] = uncostedUnitMismatch
+			continue
+		}
+		if line.ExchangeRate == nil {
+			costs.uncosted[lotUUID] = uncostedNoExchangeRate
+			continue
+		}
+
+		landedCents := float64(line.Quantity*line.UnitCostCents+line.FeeAllocatedCents) * line.ExchangeRate.Rate
+		costs.unitCosts[lotUUID] = landedCents / float64(line.Quantity)
+	}
+
+	return costs, nil
+}
+
+// valuationHolding is the quantity of one lot held at one location.
+type valuationHolding struct {
+	movement storage.ValuationMovement
+	quantity int64
+}
+
+type holdingKey struct {
+	lotUUID      string
+	locationUUID string
+}
+
+// valuationLayer is a pool of inventory carried at a single cost: one lot
+// under FIFO, or every lot of an ingredient under weighted average. Value is
+// kept in whole cents so that the cost of every movement out is exactly the
+// value it removes.
+type valuationLayer struct {
+	quantity      int64
+	valueCents    int64
+	unitCostCents float64
+	holdings      map[holdingKey]*valuationHolding
+	holdingOrder  []holdingKey
+	last          holdingKey
+}
+
+// currentUnitCost is the layer's average cost per unit, or the last known
+// cost when the layer is empty.
+func (l *valuationLayer) currentUnitCost() float64 {
+	if l.quantity > 0 {
+		return float64(l.valueCents) / float64(l.quantity)
+	}
+	return l.unitCostCents
+}
+
+func (l *valuationLayer) hold(m storage.ValuationMovement, delta int64) {
+	key := holdingKey{lotUUID: m.IngredientLotUUID, locationUUID: m.StockLocationUUID}
+	h, ok := l.holdings[key]
+	if !ok {
+		h = &valuationHolding{movement: m}
+		l.holdings[key] = h
+		l.holdingOrder = append(l.holdingOrder, key)
+	}
+	h.quantity += delta
+	l.last = key
+}
+
+// valuationEngine replays the movement ledger in order and values it with
+// the selected method. FIFO carries each lot at its own landed cost, so
+// movements out of a lot are costed at the cost of that lot. Weighted average
+// pools every lot of an ingredient and costs movements out at the moving
+// average. Transfers change where stock is held but not its value.
+type valuationEngine struct {
+	method        string
+	costs         lotCosts
+	layers        map[string]*valuationLayer
+	layerOrder    []string
+	totalCents    int64
+	uncosted      map[string]*dto.UncostedLot
+	uncostedOrder []string
+}
+
+func newValuationEngine(method string, costs lotCosts) *valuationEngine {
+	return &valuationEngine{
+		method:   method,
+		costs:    costs,
+		layers:   make(map[string]*valuationLayer),
+		uncosted: make(map[string]*dto.UncostedLot),
+	}
+}
+
+func (e *valuationEngine) layer(m storage.ValuationMovement, unitCost float64) *valuationLayer {
+	key := m.IngredientLotUUID
+	if e.method == storage.ValuationMethodWeightedAverage {
+		key = m.IngredientUUID + "/" + m.LotReceivedUnit
+	}
+	l, ok := e.layers[key]
+	if !ok {
+		l = &valuationLayer{
+			unitCostCents: unitCost,
+			holdings:      make(map[holdingKey]*valuationHolding),
+		}
+		e.layers[key] = l
+		e.layerOrder = append(e.layerOrder, key)
+	}
+	return l
+}
+
+// apply values a movement and returns the value it added to or removed from
+// inventory. Movements of uncosted lots return false.
+func (e *valuationEngine) apply(m storage.ValuationMovement) (int64, bool) {
+	delta := m.Amount
+	if m.Direction == storage.MovementDirectionOut {
+		delta = -m.Amount
+	}
+
+	unitCost, ok := e.costs.unitCosts[m.IngredientLotUUID]
+	if !ok {
+		lot, exists := e.uncosted[m.IngredientLotUUID]
+		if !exists {
+			lot = &dto.UncostedLot{
+				IngredientLotUUID: m.IngredientLotUUID,
+				IngredientUUID:    m.IngredientUUID,
+				IngredientName:    m.IngredientName,
+				Unit:              m.LotReceivedUnit,
+				Reason:            e.costs.uncosted[m.IngredientLotUUID],
+			}
+			e.uncosted[m.IngredientLotUUID] = lot
+			e.uncostedOrder = append(e.uncostedOrder, m.IngredientLotUUID)
+		}
+		lot.Quantity += delta
+		return 0, false
+	}
+
+	l := e.layer(m, unitCost)
+	l.hold(m, delta)
+
+	var value int64
+	switch {
+	case m.Reason == storage.MovementReasonTransfer:
+		return 0, true
+	case m.Direction == storage.MovementDirectionIn:
+		cost := l.currentUnitCost()
+		if m.Reason == storage.MovementReasonReceive {
+			cost = unitCost
+		}
+		value = int64(math.Round(float64(m.Amount) * cost))
+		l.quantity += m.Amount
+		l.valueCents += value
+		e.totalCents += value
+	default:
+		switch {
+		case l.quantity > 0 && m.Amount >= l.quantity:
+			value = l.valueCents
+		case l.quantity > 0:
+			value = int64(math.Round(float64(m.Amount) * float64(l.valueCents) / float64(l.quantity)))
+		default:
+			value = int64(math.Round(float64(m.Amount) * l.unitCostCents))
+		}
+		l.quantity -= m.Amount
+		l.valueCents -= value
+		e.totalCents -= value
+	}
+	if l.quantity > 0 {
+		l.unitCostCents = float64(l.valueCents) / float64(l.quantity)
+	}
+
+	return value, true
+}
+
+// items splits each layer's value over the lots and locations holding it in
+// proportion to quantity, then totals it per ingredient and location. The
+// split uses the largest remainder so item values add up to the layer value
+// exactly.
+func (e *valuationEngine) items() []dto.ValuationItem {
+	type itemKey struct {
+		ingredientUUID string
+		locationUUID   string
+		unit           string
+	}
+	items := make(map[itemKey]*dto.ValuationItem)
+	var order []itemKey
+	add := func(h *valuationHolding, quantity, value int64) {
+		key := itemKey{ingredientUUID: h.movement.IngredientUUID, locationUUID: h.movement.StockLocationUUID, unit: h.movement.LotReceivedUnit}
+		item, ok := items[key]
+		if !ok {
+			item = &dto.ValuationItem{
+				IngredientUUID:    h.movement.IngredientUUID,
+				IngredientName:    h.movement.IngredientName,
+				Category:          h.movement.IngredientCategory,
+				StockLocationUUID: h.movement.StockLocationUUID,
+				StockLocationName: h.movement.StockLocationName,
+				Unit:              h.movement.LotReceivedUnit,
+			}
+			items[key] = item
+			order = append(order, key)
+		}
+		item.Quantity += quantity
+		item.ValueCents += value
+	}
+
+	for _, layerKey := range e.layerOrder {
+		l := e.layers[layerKey]
+
+		var held []*valuationHolding
+		var heldQuantity int64
+		for _, key := range l.holdingOrder {
+			if h := l.holdings[key]; h.quantity > 0 {
+				held = append(held, h)
+				heldQuantity += h.quantity
+			}
+		}
+		if len(held) == 0 {
+			if l.valueCents != 0 {
+				add(l.holdings[l.last], 0, l.valueCents)
+			}
+			continue
+		}
+
+		shares := make([]int64, len(held))
+		remainders := make([]float64, len(held))
+		var allocated int64
+		for i, h := range held {
+			exact := float64(l.valueCents) * float64(h.quantity) / float64(heldQuantity)
+			shares[i] = int64(math.Floor(exact))
+			remainders[i] = exact - float64(shares[i])
+			allocated += shares[i]
+		}
+		byRemainder := make([]int, len(held))
+		for i := range byRemainder {
+			byRemainder[i] = i
+		}
+		slices.SortStableFunc(byRemainder, func(a, b int) int {
+			return cmp.Compare(remainders[b], remainders[a])
+		})
+		for i := 0; allocated < l.valueCents; i = (i + 1) % len(held) {
+			shares[byRemainder[i]]++
+			allocated++
+		}
+
+		for i, h := range held {
+			add(h, h.quantity, shares[i])
+		}
+	}
+
+	result := make([]dto.ValuationItem, 0, len(order))
+	for _, key := range order {
+		item := items[key]
+		if item.Quantity == 0 && item.ValueCents == 0 {
+			continue
+		}
+		if item.Quantity > 0 {
+			unitCost := float64(item.ValueCents) / float64(item.Quantity)
+			item.UnitCostCents = &unitCost
+		}
+		result = append(result, *item)
+	}
+	slices.SortFunc(result, func(a, b dto.ValuationItem) int {
+		return cmp.Or(
+			cmp.Compare(a.Category, b.Category),
+			cmp.Compare(a.IngredientName, b.IngredientName),
+			cmp.Compare(a.StockLocationName, b.StockLocationName),
+			cmp.Compare(a.Unit, b.Unit),
+		)
+	})
+	return result
+}
+
+// uncostedLots returns the lots left out of valuation. When lotUUIDs is not
+// nil only those lots are returned.
+func (e *valuationEngine) uncostedLots(lotUUIDs map[string]bool) []dto.UncostedLot {
+	lots := make([]dto.UncostedLot, 0)
+	for _, lotUUID := range e.uncostedOrder {
+		if lotUUIDs != nil && !lotUUIDs[lotUUID] {
+			continue
+		}
+		lots = append(lots, *e.uncosted[lotUUID])
+	}
+	return lots
+}
@@ -0,0 +1,329 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// InventoryValuationStore defines the storage interface for inventory
+// valuation handlers.
+type InventoryValuationStore interface {
+	GetValuationMethod(ctx context.Context) (string, error)
+	SetValuationMethod(ctx context.Context, method string) (string, error)
+	ListValuationMovements(ctx context.Context, before time.Time) ([]storage.ValuationMovement, error)
+}
+
+// POLineFetcher abstracts the inter-service call to the Procurement service
+// for looking up purchase order line costs.
+type POLineFetcher interface {
+	BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error)
+}
+
+// HandleInventoryValuationSettings handles [GET /inventory-valuation/settings]
+// and [PUT /inventory-valuation/settings].
+func HandleInventoryValuationSettings(db InventoryValuationStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			method, err := db.GetValuationMethod(r.Context())
+			if err != nil {
+				service.InternalError(w, "error getting valuation method", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.ValuationSettingsResponse{Method: method})
+		case http.MethodPut:
+			var req dto.UpdateValuationSettingsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			method, err := db.SetValuationMethod(r.Context(), req.Method)
+			if err != nil {
+				service.InternalError(w, "error setting valuation method", "error", err)
+				return
+			}
+
+			slog.Info("inventory valuation method changed", "method", method)
+
+			service.JSON(w, dto.ValuationSettingsResponse{Method: method})
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleInventoryValuation handles [GET /inventory-valuation]. The optional
+// as_of query parameter (YYYY-MM-DD, default today) values inventory at the
+// end of that day, and method overrides the configured costing method.
+func HandleInventoryValuation(db InventoryValuationStore, procClient POLineFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		asOf := time.Now().UTC().Truncate(24 * time.Hour)
+		if v := r.URL.Query().Get("as_of"); v != "" {
+			parsed, err := dto.ParseValuationDate("as_of", v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			asOf = parsed
+		}
+
+		ctx := r.Context()
+		method, ok := resolveValuationMethod(w, r, db)
+		if !ok {
+			return
+		}
+
+		movements, err := db.ListValuationMovements(ctx, asOf.AddDate(0, 0, 1))
+		if err != nil {
+			service.InternalError(w, "error listing inventory movements", "error", err)
+			return
+		}
+
+		costs, err := loadLotCosts(ctx, procClient, movements)
+		if err != nil {
+			service.InternalError(w, "error fetching purchase order line data", "error", err)
+			return
+		}
+
+		engine := newValuationEngine(method, costs)
+		for _, m := range movements {
+			engine.apply(m)
+		}
+
+		resp := dto.InventoryValuationResponse{
+			AsOf:            asOf.Format(time.DateOnly),
+			Method:          method,
+			BaseCurrency:    costs.baseCurrency,
+			TotalValueCents: engine.totalCents,
+			Categories:      make([]dto.ValuationCategoryTotal, 0),
+			Locations:       make([]dto.ValuationLocationTotal, 0),
+			Items:           engine.items(),
+			UncostedLots:    make([]dto.UncostedLot, 0),
+		}
+
+		categories := make(map[string]*dto.ValuationCategoryTotal)
+		locations := make(map[string]*dto.ValuationLocationTotal)
+		for _, item := range resp.Items {
+			category, ok := categories[item.Category]
+			if !ok {
+				category = &dto.ValuationCategoryTotal{Category: item.Category}
+				categories[item.Category] = category
+			}
+			category.ValueCents += item.ValueCents
+
+			location, ok := locations[item.StockLocationUUID]
+			if !ok {
+				location = &dto.ValuationLocationTotal{StockLocationUUID: item.StockLocationUUID, StockLocationName: item.StockLocationName}
+				locations[item.StockLocationUUID] = location
+			}
+			location.ValueCents += item.ValueCents
+		}
+		for _, category := range categories {
+			resp.Categories = append(resp.Categories, *category)
+		}
+		slices.SortFunc(resp.Categories, func(a, b dto.ValuationCategoryTotal) int {
+			return cmp.Compare(a.Category, b.Category)
+		})
+		for _, location := range locations {
+			resp.Locations = append(resp.Locations, *location)
+		}
+		slices.SortFunc(resp.Locations, func(a, b dto.ValuationLocationTotal) int {
+			return cmp.Or(cmp.Compare(a.StockLocationName, b.StockLocationName), cmp.Compare(a.StockLocationUUID, b.StockLocationUUID))
+		})
+
+		for _, lot := range engine.uncostedLots(nil) {
+			if lot.Quantity != 0 {
+				resp.UncostedLots = append(resp.UncostedLots, lot)
+			}
+		}
+
+		service.JSON(w, resp)
+	}
+}
+
+// HandleInventoryConsumption handles [GET /inventory-valuation/consumption].
+// The from and to query parameters (YYYY-MM-DD, inclusive) default to the
+// start of the current month and today, and method overrides the configured
+// costing method. Opening value plus receipts and adjustments in, less
+// consumption, equals the closing value.
+func HandleInventoryConsumption(db InventoryValuationStore, procClient POLineFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		from := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+		to := today
+		if v := r.URL.Query().Get("from"); v != "" {
+			parsed, err := dto.ParseValuationDate("from", v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			from = parsed
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			parsed, err := dto.ParseValuationDate("to", v)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			to = parsed
+		}
+		if to.Before(from) {
+			http.Error(w, "to must not be before from", http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		method, ok := resolveValuationMethod(w, r, db)
+		if !ok {
+			return
+		}
+
+		movements, err := db.ListValuationMovements(ctx, to.AddDate(0, 0, 1))
+		if err != nil {
+			service.InternalError(w, "error listing inventory movements", "error", err)
+			return
+		}
+
+		costs, err := loadLotCosts(ctx, procClient, movements)
+		if err != nil {
+			service.InternalError(w, "error fetching purchase order line data", "error", err)
+			return
+		}
+
+		resp := dto.InventoryConsumptionResponse{
+			From:         from.Format(time.DateOnly),
+			To:           to.Format(time.DateOnly),
+			Method:       method,
+			BaseCurrency: costs.baseCurrency,
+			ByReason:     make([]dto.ConsumptionReasonTotal, 0),
+			ByCategory:   make([]dto.ConsumptionCategoryTotal, 0),
+			Movements:    make([]dto.CostedMovement, 0),
+		}
+
+		engine := newValuationEngine(method, costs)
+		opened := false
+		periodLots := make(map[string]bool)
+		reasons := make(map[string]*dto.ConsumptionReasonTotal)
+		categories := make(map[string]*dto.ConsumptionCategoryTotal)
+		for _, m := range movements {
+			inPeriod := !m.OccurredAt.Before(from)
+			if inPeriod && !opened {
+				resp.OpeningValueCents = engine.totalCents
+				opened = true
+			}
+
+			value, costed := engine.apply(m)
+			if !inPeriod {
+				continue
+			}
+			periodLots[m.IngredientLotUUID] = true
+			if !costed || m.Reason == storage.MovementReasonTransfer {
+				continue
+			}
+
+			resp.Movements = append(resp.Movements, dto.CostedMovement{
+				MovementUUID:      m.MovementUUID,
+				OccurredAt:        m.OccurredAt,
+				IngredientLotUUID: m.IngredientLotUUID,
+				IngredientUUID:    m.IngredientUUID,
+				IngredientName:    m.IngredientName,
+				Category:          m.IngredientCategory,
+				StockLocationUUID: m.StockLocationUUID,
+				Direction:         m.Direction,
+				Reason:            m.Reason,
+				Amount:            m.Amount,
+				AmountUnit:        m.AmountUnit,
+				ValueCents:        value,
+			})
+
+			switch {
+			case m.Direction == storage.MovementDirectionIn && m.Reason == storage.MovementReasonReceive:
+				resp.ReceiptsCents += value
+			case m.Direction == storage.MovementDirectionIn:
+				resp.AdjustmentsInCents += value
+			default:
+				resp.ConsumptionCents += value
+
+				reason, ok := reasons[m.Reason]
+				if !ok {
+					reason = &dto.ConsumptionReasonTotal{Reason: m.Reason}
+					reasons[m.Reason] = reason
+				}
+				reason.CostCents += value
+				reason.Movements++
+
+				category, ok := categories[m.IngredientCategory]
+				if !ok {
+					category = &dto.ConsumptionCategoryTotal{Category: m.IngredientCategory}
+					categories[m.IngredientCategory] = category
+				}
+				category.CostCents += value
+			}
+		}
+		if !opened {
+			resp.OpeningValueCents = engine.totalCents
+		}
+		resp.ClosingValueCents = engine.totalCents
+
+		for _, reason := range reasons {
+			resp.ByReason = append(resp.ByReason, *reason)
+		}
+		slices.SortFunc(resp.ByReason, func(a, b dto.ConsumptionReasonTotal) int {
+			return cmp.Compare(a.Reason, b.Reason)
+		})
+		for _, category := range categories {
+			resp.ByCategory = append(resp.ByCategory, *category)
+		}
+		slices.SortFunc(resp.ByCategory, func(a, b dto.ConsumptionCategoryTotal) int {
+			return cmp.Compare(a.Category, b.Category)
+		})
+		resp.UncostedLots = engine.uncostedLots(periodLots)
+
+		service.JSON(w, resp)
+	}
+}
+
+// resolveValuationMethod returns the method query parameter when given, or
+// the configured costing method. It writes the error response and returns
+// false on failure.
+func resolveValuationMethod(w http.ResponseWriter, r *http.Request, db InventoryValuationStore) (string, bool) {
+	if v := r.URL.Query().Get("method"); v != "" {
+		if err := dto.ValidateValuationMethod(v); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return "", false
+		}
+		return v, true
+	}
+
+	method, err := db.GetValuationMethod(r.Context())
+	if err != nil {
+		service.InternalError(w, "error getting valuation method", "error", err)
+		return "", false
+	}
+	return method, true
+}
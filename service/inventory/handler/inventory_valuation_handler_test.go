@@ -0,0 +1,358 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// mockValuationStore implements handler.InventoryValuationStore for testing.
+type mockValuationStore struct {
+	method    string
+	movements []storage.ValuationMovement
+}
+
+func (m *mockValuationStore) GetValuationMethod(_ context.Context) (string, error) {
+	return m.method, nil
+}
+
+func (m *mockValuationStore) SetValuationMethod(_ context.Context, method string) (string, error) {
+	m.method = method
+	return method, nil
+}
+
+func (m *mockValuationStore) ListValuationMovements(_ context.Context, before time.Time) ([]storage.ValuationMovement, error) {
+	var result []storage.ValuationMovement
+	for _, mv := range m.movements {
+		if mv.OccurredAt.Before(before) {
+			result = append(result, mv)
+		}
+	}
+	return result, nil
+}
+
+// mockPOLineFetcher implements handler.POLineFetcher for testing.
+type mockPOLineFetcher struct {
+	lines []handler.PurchaseOrderLineCost
+}
+
+func (m *mockPOLineFetcher) BatchLookupPOLines(_ context.Context, _ []string) ([]handler.PurchaseOrderLineCost, error) {
+	return m.lines, nil
+}
+
+// helper to create a pale malt movement in kg at noon on the given day of
+// March 2026.
+func valuationMovement(lotUUID string, poLineUUID *string, locationUUID, direction, reason string, amount int64, day int) storage.ValuationMovement {
+	return storage.ValuationMovement{
+		MovementUUID:          lotUUID[:8] + "-" + reason + "-" + direction,
+		IngredientLotUUID:     lotUUID,
+		PurchaseOrderLineUUID: poLineUUID,
+		LotReceivedUnit:       "kg",
+		IngredientUUID:        "110e8400-e29b-41d4-a716-446655440001",
+		IngredientName:        "Pale Malt",
+		IngredientCategory:    "fermentable",
+		StockLocationUUID:     locationUUID,
+		Direction:             direction,
+		Reason:                reason,
+		Amount:                amount,
+		AmountUnit:            "kg",
+		OccurredAt:            time.Date(2026, time.March, day, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestHandleInventoryValuation(t *testing.T) {
+	lotAUUID := "220e8400-e29b-41d4-a716-446655440001"
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	lotCUUID := "220e8400-e29b-41d4-a716-446655440003"
+	poLineAUUID := "330e8400-e29b-41d4-a716-446655440001"
+	poLineBUUID := "330e8400-e29b-41d4-a716-446655440002"
+	coldRoomUUID := "440e8400-e29b-41d4-a716-446655440001"
+	brewhouseUUID := "440e8400-e29b-41d4-a716-446655440002"
+
+	// Lot A is 100 kg received on day 1 and lot B is 100 kg received on day
+	// 2. 50 kg of lot A is used on day 3, 20 kg of lot B moves to the
+	// brewhouse on day 4 and 10 kg of lot B is wasted on day 5, when 5 kg of
+	// lot C arrives without an order line.
+	movements := []storage.ValuationMovement{
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 1),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 2),
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonUse, 50, 3),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonTransfer, 20, 4),
+		valuationMovement(lotBUUID, &poLineBUUID, brewhouseUUID, storage.MovementDirectionIn, storage.MovementReasonTransfer, 20, 4),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonWaste, 10, 5),
+		valuationMovement(lotCUUID, nil, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 5, 5),
+	}
+
+	// Lot A costs $1.00/kg; lot B costs $1.40/kg plus $10 freight.
+	lines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineAUUID, UnitCostCents: 100, Quantity: 100, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+		{UUID: poLineBUUID, UnitCostCents: 140, Quantity: 100, QuantityUnit: "kg", Currency: "USD", FeeAllocatedCents: 1000, BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockValuationStore
+		procClient     *mockPOLineFetcher
+		expectedStatus int
+		validate       func(t *testing.T, resp dto.InventoryValuationResponse)
+	}{
+		{
+			name:           "fifo values remaining layers by location",
+			query:          "?as_of=2026-03-05",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+			procClient:     &mockPOLineFetcher{lines: lines},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.InventoryValuationResponse) {
+				// Lot A: 50 kg left at $1.00. Lot B: 90 kg left at $1.50.
+				if resp.TotalValueCents != 18500 {
+					t.Errorf("expected total value 18500, got %d", resp.TotalValueCents)
+				}
+				if resp.BaseCurrency != "USD" {
+					t.Errorf("expected base currency USD, got %q", resp.BaseCurrency)
+				}
+				if len(resp.Categories) != 1 || resp.Categories[0].ValueCents != 18500 {
+					t.Errorf("expected one category worth 18500, got %+v", resp.Categories)
+				}
+
+				locations := make(map[string]int64)
+				for _, l := range resp.Locations {
+					locations[l.StockLocationUUID] = l.ValueCents
+				}
+				if locations[coldRoomUUID] != 15500 {
+					t.Errorf("expected cold room value 15500, got %d", locations[coldRoomUUID])
+				}
+				if locations[brewhouseUUID] != 3000 {
+					t.Errorf("expected brewhouse value 3000, got %d", locations[brewhouseUUID])
+				}
+
+				if len(resp.UncostedLots) != 1 {
+					t.Fatalf("expected 1 uncosted lot, got %d", len(resp.UncostedLots))
+				}
+				if got := resp.UncostedLots[0]; got.IngredientLotUUID != lotCUUID || got.Reason != "no_purchase_order_line" || got.Quantity != 5 {
+					t.Errorf("unexpected uncosted lot %+v", got)
+				}
+			},
+		},
+		{
+			name:           "method parameter overrides the configured method",
+			query:          "?as_of=2026-03-05&method=weighted_average",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+			procClient:     &mockPOLineFetcher{lines: lines},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.InventoryValuationResponse) {
+				if resp.Method != storage.ValuationMethodWeightedAverage {
+					t.Errorf("expected method weighted_average, got %q", resp.Method)
+				}
+				// 200 kg worth 25000 averages $1.25; 50 kg used leaves 18750,
+				// and 10 kg wasted at $1.25 leaves 17500 for 140 kg.
+				if resp.TotalValueCents != 17500 {
+					t.Errorf("expected total value 17500, got %d", resp.TotalValueCents)
+				}
+
+				var sum int64
+				for _, item := range resp.Items {
+					sum += item.ValueCents
+					if item.StockLocationUUID == brewhouseUUID && item.ValueCents != 2500 {
+						t.Errorf("expected brewhouse value 2500, got %d", item.ValueCents)
+					}
+				}
+				if sum != resp.TotalValueCents {
+					t.Errorf("expected items to sum to %d, got %d", resp.TotalValueCents, sum)
+				}
+			},
+		},
+		{
+			name:           "as_of excludes later movements",
+			query:          "?as_of=2026-03-02",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+			procClient:     &mockPOLineFetcher{lines: lines},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.InventoryValuationResponse) {
+				if resp.TotalValueCents != 25000 {
+					t.Errorf("expected total value 25000, got %d", resp.TotalValueCents)
+				}
+				if len(resp.UncostedLots) != 0 {
+					t.Errorf("expected no uncosted lots, got %d", len(resp.UncostedLots))
+				}
+			},
+		},
+		{
+			name:           "unsupported method",
+			query:          "?method=lifo",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO},
+			procClient:     &mockPOLineFetcher{},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/inventory-valuation"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryValuation(tt.store, tt.procClient).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.InventoryValuationResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandleInventoryConsumption(t *testing.T) {
+	lotAUUID := "220e8400-e29b-41d4-a716-446655440001"
+	lotBUUID := "220e8400-e29b-41d4-a716-446655440002"
+	lotCUUID := "220e8400-e29b-41d4-a716-446655440003"
+	poLineAUUID := "330e8400-e29b-41d4-a716-446655440001"
+	poLineBUUID := "330e8400-e29b-41d4-a716-446655440002"
+	coldRoomUUID := "440e8400-e29b-41d4-a716-446655440001"
+
+	// Both malt lots are received before the period. In it, 50 kg of lot A
+	// is used, 10 kg of lot B is wasted and 5 kg of lot C arrives without an
+	// order line.
+	movements := []storage.ValuationMovement{
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 1),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 100, 2),
+		valuationMovement(lotAUUID, &poLineAUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonUse, 50, 3),
+		valuationMovement(lotBUUID, &poLineBUUID, coldRoomUUID, storage.MovementDirectionOut, storage.MovementReasonWaste, 10, 5),
+		valuationMovement(lotCUUID, nil, coldRoomUUID, storage.MovementDirectionIn, storage.MovementReasonReceive, 5, 5),
+	}
+
+	// Lot A costs $1.00/kg; lot B costs $1.40/kg plus $10 freight.
+	lines := []handler.PurchaseOrderLineCost{
+		{UUID: poLineAUUID, UnitCostCents: 100, Quantity: 100, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+		{UUID: poLineBUUID, UnitCostCents: 140, Quantity: 100, QuantityUnit: "kg", Currency: "USD", FeeAllocatedCents: 1000, BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockValuationStore
+		procClient     *mockPOLineFetcher
+		expectedStatus int
+		validate       func(t *testing.T, resp dto.InventoryConsumptionResponse)
+	}{
+		{
+			name:           "reconciles opening, consumption and closing value",
+			query:          "?from=2026-03-03&to=2026-03-05",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+			procClient:     &mockPOLineFetcher{lines: lines},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.InventoryConsumptionResponse) {
+				if resp.OpeningValueCents != 25000 {
+					t.Errorf("expected opening value 25000, got %d", resp.OpeningValueCents)
+				}
+				if resp.ConsumptionCents != 6500 {
+					t.Errorf("expected consumption 6500, got %d", resp.ConsumptionCents)
+				}
+				if resp.ClosingValueCents != 18500 {
+					t.Errorf("expected closing value 18500, got %d", resp.ClosingValueCents)
+				}
+				if resp.OpeningValueCents+resp.ReceiptsCents+resp.AdjustmentsInCents-resp.ConsumptionCents != resp.ClosingValueCents {
+					t.Errorf("report does not reconcile: %+v", resp)
+				}
+
+				reasons := make(map[string]int64)
+				for _, r := range resp.ByReason {
+					reasons[r.Reason] = r.CostCents
+				}
+				if reasons[storage.MovementReasonUse] != 5000 || reasons[storage.MovementReasonWaste] != 1500 {
+					t.Errorf("unexpected reason totals %+v", resp.ByReason)
+				}
+				if len(resp.Movements) != 2 {
+					t.Errorf("expected 2 costed movements, got %d", len(resp.Movements))
+				}
+				if len(resp.UncostedLots) != 1 {
+					t.Errorf("expected 1 uncosted lot, got %d", len(resp.UncostedLots))
+				}
+			},
+		},
+		{
+			name:           "to before from",
+			query:          "?from=2026-03-05&to=2026-03-01",
+			store:          &mockValuationStore{method: storage.ValuationMethodFIFO, movements: movements},
+			procClient:     &mockPOLineFetcher{lines: lines},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/inventory-valuation/consumption"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryConsumption(tt.store, tt.procClient).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.InventoryConsumptionResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandleInventoryValuationSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedMethod string
+	}{
+		{
+			name:           "switches to weighted average",
+			body:           `{"method":"weighted_average"}`,
+			expectedStatus: http.StatusOK,
+			expectedMethod: storage.ValuationMethodWeightedAverage,
+		},
+		{
+			name:           "unsupported method",
+			body:           `{"method":"lifo"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedMethod: storage.ValuationMethodFIFO,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockValuationStore{method: storage.ValuationMethodFIFO}
+
+			req := httptest.NewRequest(http.MethodPut, "/inventory-valuation/settings", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleInventoryValuationSettings(store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if store.method != tt.expectedMethod {
+				t.Errorf("expected stored method %s, got %s", tt.expectedMethod, store.method)
+			}
+		})
+	}
+}
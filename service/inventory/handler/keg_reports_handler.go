@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// defaultOverdueKegDays is the days-out threshold used when none is given.
+const defaultOverdueKegDays = 60
+
+// KegReportStore defines the storage interface for keg report handlers.
+type KegReportStore interface {
+	GetKegTurnTimeReport(context.Context, storage.KegReportFilter) (storage.KegTurnTimeReport, error)
+	ListOverdueKegs(context.Context, time.Time) ([]storage.OverdueKeg, error)
+	ListKegWriteOffs(context.Context, storage.KegReportFilter) ([]storage.KegWriteOff, error)
+}
+
+// HandleKegTurnTimeReport handles [GET /keg-reports/turn-time].
+func HandleKegTurnTimeReport(db KegReportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		filter, ok := parseKegReportFilter(w, r)
+		if !ok {
+			return
+		}
+
+		report, err := db.GetKegTurnTimeReport(r.Context(), filter)
+		if err != nil {
+			service.InternalError(w, "error getting keg turn time report", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewKegTurnTimeReportResponse(report))
+	}
+}
+
+// HandleOverdueKegsReport handles [GET /keg-reports/overdue]. The days query
+// parameter sets how long a keg may be out before it is reported.
+func HandleOverdueKegsReport(db KegReportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		days := defaultOverdueKegDays
+		if v := r.URL.Query().Get("days"); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil || parsed < 0 {
+				http.Error(w, "invalid days: must be a non-negative integer", http.StatusBadRequest)
+				return
+			}
+			days = parsed
+		}
+
+		now := time.Now().UTC()
+		overdue, err := db.ListOverdueKegs(r.Context(), now.AddDate(0, 0, -days))
+		if err != nil {
+			service.InternalError(w, "error listing overdue kegs", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewOverdueKegsResponse(overdue, now))
+	}
+}
+
+// HandleKegWriteOffsReport handles [GET /keg-reports/write-offs].
+func HandleKegWriteOffsReport(db KegReportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		filter, ok := parseKegReportFilter(w, r)
+		if !ok {
+			return
+		}
+
+		writeOffs, err := db.ListKegWriteOffs(r.Context(), filter)
+		if err != nil {
+			service.InternalError(w, "error listing keg write-offs", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewKegWriteOffReportResponse(writeOffs))
+	}
+}
+
+// parseKegReportFilter reads the optional from/to query parameters.
+func parseKegReportFilter(w http.ResponseWriter, r *http.Request) (storage.KegReportFilter, bool) {
+	filter := storage.KegReportFilter{}
+	q := r.URL.Query()
+
+	if v := q.Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "invalid from: must be RFC3339 format", http.StatusBadRequest)
+			return storage.KegReportFilter{}, false
+		}
+		filter.From = &t
+	}
+	if v := q.Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "invalid to: must be RFC3339 format", http.StatusBadRequest)
+			return storage.KegReportFilter{}, false
+		}
+		filter.To = &t
+	}
+
+	return filter, true
+}
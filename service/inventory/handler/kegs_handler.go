@@ -0,0 +1,313 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// KegStore defines the storage interface for keg handlers.
+type KegStore interface {
+	CreateKeg(context.Context, storage.Keg) (storage.Keg, error)
+	GetKegByUUID(context.Context, string) (storage.Keg, error)
+	ListKegs(context.Context, storage.KegListFilter) ([]storage.Keg, error)
+	UpdateKeg(context.Context, string, storage.UpdateKegRequest) (storage.Keg, error)
+	SoftDeleteKeg(context.Context, string) error
+	RecordKegEvent(context.Context, string, storage.KegEventRequest) (storage.Keg, storage.KegEvent, error)
+	ListKegEvents(context.Context, string) ([]storage.KegEvent, error)
+	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
+	ListBeerLotItems(context.Context, storage.BeerLotItemListFilter) ([]storage.BeerLotItem, error)
+	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
+}
+
+// HandleKegs handles [GET /kegs] and [POST /kegs].
+func HandleKegs(db KegStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			filter := storage.KegListFilter{}
+			q := r.URL.Query()
+
+			if v := q.Get("status"); v != "" {
+				filter.Status = &v
+			}
+			if v := q.Get("ownership"); v != "" {
+				filter.Ownership = &v
+			}
+			if v := q.Get("serial"); v != "" {
+				filter.Serial = &v
+			}
+
+			kegs, err := db.ListKegs(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing kegs", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewKegsResponse(kegs))
+
+		case http.MethodPost:
+			var req dto.CreateKegRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			keg := storage.Keg{
+				Serial:            strings.TrimSpace(req.Serial),
+				Capacity:          req.Capacity,
+				CapacityUnit:      req.CapacityUnit,
+				Lessor:            req.Lessor,
+				PurchaseCostCents: req.PurchaseCostCents,
+				Currency:          req.Currency,
+				PurchasedAt:       req.PurchasedAt,
+				Notes:             req.Notes,
+			}
+			if req.Ownership != nil {
+				keg.Ownership = *req.Ownership
+			}
+
+			if loc, ok := service.ResolveFKOptional(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID); !ok {
+				return
+			} else if req.StockLocationUUID != nil {
+				keg.StockLocationID = &loc.ID
+			}
+
+			created, err := db.CreateKeg(r.Context(), keg)
+			if errors.Is(err, storage.ErrDuplicateKegSerial) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating keg", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewKegResponse(created))
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleKegByUUID handles [GET /kegs/{uuid}], [PATCH /kegs/{uuid}], and
+// [DELETE /kegs/{uuid}].
+func HandleKegByUUID(db KegStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		kegUUID := r.PathValue("uuid")
+		if kegUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			keg, err := db.GetKegByUUID(r.Context(), kegUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "keg not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting keg", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewKegResponse(keg))
+
+		case http.MethodPatch:
+			var req dto.UpdateKegRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			updated, err := db.UpdateKeg(r.Context(), kegUUID, storage.UpdateKegRequest{
+				Serial:            req.Serial,
+				Capacity:          req.Capacity,
+				CapacityUnit:      req.CapacityUnit,
+				Ownership:         req.Ownership,
+				Lessor:            req.Lessor,
+				PurchaseCostCents: req.PurchaseCostCents,
+				Currency:          req.Currency,
+				PurchasedAt:       req.PurchasedAt,
+				Notes:             req.Notes,
+			})
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "keg not found", http.StatusNotFound)
+				return
+			} else if errors.Is(err, storage.ErrDuplicateKegSerial) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating keg", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewKegResponse(updated))
+
+		case http.MethodDelete:
+			err := db.SoftDeleteKeg(r.Context(), kegUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "keg not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting keg", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleKegEvents handles [GET /kegs/{uuid}/events] and [POST /kegs/{uuid}/events].
+func HandleKegEvents(db KegStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		kegUUID := r.PathValue("uuid")
+		if kegUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			if _, err := db.GetKegByUUID(r.Context(), kegUUID); errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "keg not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting keg", "error", err)
+				return
+			}
+
+			events, err := db.ListKegEvents(r.Context(), kegUUID)
+			if err != nil {
+				service.InternalError(w, "error listing keg events", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewKegEventsResponse(events))
+
+		case http.MethodPost:
+			var req dto.CreateKegEventRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			event := storage.KegEventRequest{
+				EventType:     req.EventType,
+				Custodian:     req.Custodian,
+				DepositCents:  req.DepositCents,
+				WriteOffCents: req.WriteOffCents,
+				OccurredAt:    optionalTime(req.OccurredAt),
+				Notes:         req.Notes,
+			}
+
+			if lot, ok := service.ResolveFKOptional(r.Context(), w, req.BeerLotUUID, "beer lot", db.GetBeerLotByUUID); !ok {
+				return
+			} else if req.BeerLotUUID != nil {
+				item, ok := fillableBeerLotItem(w, r, db, kegUUID, lot)
+				if !ok {
+					return
+				}
+				event.BeerLotItem = &item
+			}
+
+			if loc, ok := service.ResolveFKOptional(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID); !ok {
+				return
+			} else if req.StockLocationUUID != nil {
+				event.StockLocationID = &loc.ID
+			}
+
+			keg, recorded, err := db.RecordKegEvent(r.Context(), kegUUID, event)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "keg not found", http.StatusNotFound)
+				return
+			} else if errors.Is(err, storage.ErrInvalidKegEvent) || errors.Is(err, storage.ErrDuplicateBeerLotItemIdentifier) || errors.Is(err, storage.ErrBeerLotItemClaimed) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error recording keg event", "error", err, "keg_uuid", kegUUID)
+				return
+			}
+
+			slog.Info("keg event recorded", "keg_uuid", kegUUID, "event_type", req.EventType, "status", keg.Status)
+
+			service.JSONCreated(w, dto.RecordKegEventResponse{
+				Keg:   dto.NewKegResponse(keg),
+				Event: dto.NewKegEventResponse(recorded),
+			})
+
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// fillableBeerLotItem picks the item of a keg lot that a fill turns into the
+// keg: an available item already carrying the keg's serial, otherwise one
+// without an identifier. Keg lots get one item per unit when they are
+// created, so a fill claims one of those rather than adding another. On
+// failure it writes the error response and returns false.
+func fillableBeerLotItem(w http.ResponseWriter, r *http.Request, db KegStore, kegUUID string, lot storage.BeerLot) (storage.BeerLotItem, bool) {
+	if lot.Container == nil || *lot.Container != storage.BeerLotContainerKeg {
+		http.Error(w, "beer lot is not a keg lot", http.StatusConflict)
+		return storage.BeerLotItem{}, false
+	}
+
+	keg, err := db.GetKegByUUID(r.Context(), kegUUID)
+	if errors.Is(err, service.ErrNotFound) {
+		http.Error(w, "keg not found", http.StatusNotFound)
+		return storage.BeerLotItem{}, false
+	} else if err != nil {
+		service.InternalError(w, "error getting keg", "error", err)
+		return storage.BeerLotItem{}, false
+	}
+
+	lotUUID := lot.UUID.String()
+	status := storage.BeerLotItemStatusAvailable
+	items, err := db.ListBeerLotItems(r.Context(), storage.BeerLotItemListFilter{
+		BeerLotUUID: &lotUUID,
+		Status:      &status,
+	})
+	if err != nil {
+		service.InternalError(w, "error listing beer lot items", "error", err)
+		return storage.BeerLotItem{}, false
+	}
+
+	var free *storage.BeerLotItem
+	for i := range items {
+		switch {
+		case items[i].Identifier != nil && *items[i].Identifier == keg.Serial:
+			return items[i], true
+		case items[i].Identifier == nil && free == nil:
+			free = &items[i]
+		}
+	}
+	if free == nil {
+		http.Error(w, "beer lot has no unfilled kegs left", http.StatusConflict)
+		return storage.BeerLotItem{}, false
+	}
+	return *free, true
+}
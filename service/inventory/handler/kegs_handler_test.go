@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockKegStore implements handler.KegStore for fill tests. RecordKegEvent
+// claims the requested item the way storage does: by giving it the keg's
+// serial, never by adding an item.
+type mockKegStore struct {
+	handler.KegStore
+	keg   storage.Keg
+	lot   storage.BeerLot
+	items []storage.BeerLotItem
+}
+
+func (m *mockKegStore) GetKegByUUID(_ context.Context, kegUUID string) (storage.Keg, error) {
+	if kegUUID != m.keg.UUID.String() {
+		return storage.Keg{}, service.ErrNotFound
+	}
+	return m.keg, nil
+}
+
+func (m *mockKegStore) GetBeerLotByUUID(_ context.Context, lotUUID string) (storage.BeerLot, error) {
+	if lotUUID != m.lot.UUID.String() {
+		return storage.BeerLot{}, service.ErrNotFound
+	}
+	return m.lot, nil
+}
+
+func (m *mockKegStore) ListBeerLotItems(_ context.Context, filter storage.BeerLotItemListFilter) ([]storage.BeerLotItem, error) {
+	var items []storage.BeerLotItem
+	for _, item := range m.items {
+		if filter.Status != nil && item.Status != *filter.Status {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func (m *mockKegStore) RecordKegEvent(_ context.Context, _ string, req storage.KegEventRequest) (storage.Keg, storage.KegEvent, error) {
+	if req.EventType == storage.KegEventFill {
+		claimed := false
+		for i := range m.items {
+			item := &m.items[i]
+			if item.ID != req.BeerLotItem.ID {
+				continue
+			}
+			if item.Status != storage.BeerLotItemStatusAvailable || (item.Identifier != nil && *item.Identifier != m.keg.Serial) {
+				return storage.Keg{}, storage.KegEvent{}, storage.ErrBeerLotItemClaimed
+			}
+			serial := m.keg.Serial
+			item.Identifier = &serial
+			claimed = true
+		}
+		if !claimed {
+			return storage.Keg{}, storage.KegEvent{}, storage.ErrBeerLotItemClaimed
+		}
+		m.keg.Status = storage.KegStatusFilled
+	}
+	return m.keg, storage.KegEvent{EventType: req.EventType, ToStatus: m.keg.Status}, nil
+}
+
+func newMockKegStore(container string, items ...storage.BeerLotItem) *mockKegStore {
+	for i := range items {
+		items[i].ID = int64(i + 1)
+		items[i].UUID = uuid.Must(uuid.NewV4())
+	}
+	return &mockKegStore{
+		keg: storage.Keg{
+			Identifiers: entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())},
+			Serial:      "KEG-0042",
+			Status:      storage.KegStatusClean,
+		},
+		lot: storage.BeerLot{
+			Identifiers: entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())},
+			Container:   &container,
+		},
+		items: items,
+	}
+}
+
+func identifier(s string) *string {
+	return &s
+}
+
+func TestHandleKegEventsFill(t *testing.T) {
+	available := storage.BeerLotItemStatusAvailable
+	tests := []struct {
+		name       string
+		store      *mockKegStore
+		wantStatus int
+		wantBody   string
+		wantItem   int // index of the item expected to carry the serial
+	}{
+		{
+			name: "claims an unfilled item",
+			store: newMockKegStore(storage.BeerLotContainerKeg,
+				storage.BeerLotItem{Status: available, Identifier: identifier("KEG-0007")},
+				storage.BeerLotItem{Status: available},
+				storage.BeerLotItem{Status: available},
+			),
+			wantStatus: http.StatusCreated,
+			wantItem:   1,
+		},
+		{
+			name: "prefers the item already tagged with the serial",
+			store: newMockKegStore(storage.BeerLotContainerKeg,
+				storage.BeerLotItem{Status: available},
+				storage.BeerLotItem{Status: available, Identifier: identifier("KEG-0042")},
+			),
+			wantStatus: http.StatusCreated,
+			wantItem:   1,
+		},
+		{
+			name: "no unfilled items left returns 409",
+			store: newMockKegStore(storage.BeerLotContainerKeg,
+				storage.BeerLotItem{Status: available, Identifier: identifier("KEG-0007")},
+				storage.BeerLotItem{Status: storage.BeerLotItemStatusSold},
+			),
+			wantStatus: http.StatusConflict,
+			wantBody:   "no unfilled kegs left",
+			wantItem:   -1,
+		},
+		{
+			name:       "non-keg lot returns 409",
+			store:      newMockKegStore(storage.BeerLotContainerCan),
+			wantStatus: http.StatusConflict,
+			wantBody:   "not a keg lot",
+			wantItem:   -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := tt.store
+			itemCount := len(store.items)
+
+			mux := http.NewServeMux()
+			mux.Handle("POST /kegs/{uuid}/events", handler.HandleKegEvents(store))
+			body := `{"event_type": "fill", "beer_lot_uuid": "` + store.lot.UUID.String() + `"}`
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/kegs/"+store.keg.UUID.String()+"/events", bytes.NewBufferString(body))
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body does not contain %q; got: %s", tt.wantBody, rec.Body.String())
+			}
+			if len(store.items) != itemCount {
+				t.Errorf("lot has %d items after the fill, want %d", len(store.items), itemCount)
+			}
+			tagged := 0
+			for i, item := range store.items {
+				if item.Identifier == nil || *item.Identifier != store.keg.Serial {
+					continue
+				}
+				tagged++
+				if i != tt.wantItem {
+					t.Errorf("item %d carries the keg serial, want item %d", i, tt.wantItem)
+				}
+			}
+			if tt.wantItem >= 0 && tagged != 1 {
+				t.Errorf("%d items carry the keg serial, want 1", tagged)
+			}
+		})
+	}
+}
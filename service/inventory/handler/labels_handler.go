@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// LabelTemplateStore defines the storage methods needed by the label template handlers.
+type LabelTemplateStore interface {
+	CreateLabelTemplate(context.Context, storage.LabelTemplate) (storage.LabelTemplate, error)
+	GetLabelTemplateByUUID(context.Context, string) (storage.LabelTemplate, error)
+	ListLabelTemplates(context.Context, *string) ([]storage.LabelTemplate, error)
+	UpdateLabelTemplate(context.Context, storage.LabelTemplate) (storage.LabelTemplate, error)
+	DeleteLabelTemplate(context.Context, string) error
+}
+
+// HandleLabelTemplates handles [GET /label-templates] and [POST /label-templates].
+// Templates can be listed for one subject with the subject query parameter.
+func HandleLabelTemplates(db LabelTemplateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var subject *string
+			if v := r.URL.Query().Get("subject"); v != "" {
+				subject = &v
+			}
+
+			templates, err := db.ListLabelTemplates(r.Context(), subject)
+			if err != nil {
+				service.InternalError(w, "error listing label templates", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewLabelTemplatesResponse(templates))
+		case http.MethodPost:
+			var req dto.LabelTemplateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			created, err := db.CreateLabelTemplate(r.Context(), storage.LabelTemplate{
+				Name:      req.Name,
+				Subject:   req.Subject,
+				IsDefault: req.IsDefault,
+				Layout:    req.Layout(),
+			})
+			if err != nil {
+				service.InternalError(w, "error creating label template", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewLabelTemplateResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleLabelTemplateByUUID handles [GET /label-templates/{uuid}],
+// [PUT /label-templates/{uuid}] and [DELETE /label-templates/{uuid}].
+// PUT replaces the whole template.
+func HandleLabelTemplateByUUID(db LabelTemplateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		templateUUID := r.PathValue("uuid")
+		if templateUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			t, err := db.GetLabelTemplateByUUID(r.Context(), templateUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "label template not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting label template", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewLabelTemplateResponse(t))
+		case http.MethodPut:
+			var req dto.LabelTemplateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+
+			existing, err := db.GetLabelTemplateByUUID(r.Context(), templateUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "label template not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting label template", "error", err)
+				return
+			}
+			if req.Subject == "" {
+				req.Subject = existing.Subject
+			} else if req.Subject != existing.Subject {
+				http.Error(w, "subject cannot be changed", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			existing.Name = req.Name
+			existing.IsDefault = req.IsDefault
+			existing.Layout = req.Layout()
+			updated, err := db.UpdateLabelTemplate(r.Context(), existing)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "label template not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating label template", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewLabelTemplateResponse(updated))
+		case http.MethodDelete:
+			err := db.DeleteLabelTemplate(r.Context(), templateUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "label template not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting label template", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// LabelStore defines the storage methods needed to print lot labels.
+type LabelStore interface {
+	GetLabelTemplateByUUID(context.Context, string) (storage.LabelTemplate, error)
+	GetDefaultLabelTemplate(context.Context, string) (storage.LabelTemplate, error)
+	GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error)
+	GetIngredientByUUID(context.Context, string) (storage.Ingredient, error)
+	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
+}
+
+// HandleIngredientLotLabel handles [GET /ingredient-lots/{uuid}/label].
+// The label shows the ingredient, brewery lot code and dates, with a barcode
+// of the brewery lot code or the lot UUID. Query parameters: format (pdf or
+// zpl, default pdf), copies (default 1) and template_uuid (default: the
+// subject's default template).
+func HandleIngredientLotLabel(db LabelStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		lot, err := db.GetIngredientLotByUUID(r.Context(), r.PathValue("uuid"))
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "ingredient lot not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting ingredient lot", "error", err)
+			return
+		}
+		ingredient, err := db.GetIngredientByUUID(r.Context(), lot.IngredientUUID)
+		if err != nil {
+			service.InternalError(w, "error getting ingredient", "error", err)
+			return
+		}
+
+		l := label.Label{
+			UUID: lot.UUID.String(),
+			Fields: map[string]label.Field{
+				"ingredient_name":     {Name: "Ingredient", Value: ingredient.Name},
+				"brewery_lot_code":    {Name: "Lot", Value: labelText(lot.BreweryLotCode)},
+				"originator_lot_code": {Name: "Supplier lot", Value: labelText(lot.OriginatorLotCode)},
+				"originator_name":     {Name: "Supplier", Value: labelText(lot.OriginatorName)},
+				"received_date":       {Name: "Received", Value: lot.ReceivedAt.Format("2006-01-02")},
+				"received_amount":     {Name: "Amount", Value: strconv.FormatInt(lot.ReceivedAmount, 10) + " " + lot.ReceivedUnit},
+				"best_by_date":        {Name: "Best by", Value: labelDate(lot.BestByAt)},
+				"expires_date":        {Name: "Expires", Value: labelDate(lot.ExpiresAt)},
+			},
+		}
+		if lot.BreweryLotCode != nil {
+			l.Code = *lot.BreweryLotCode
+		}
+
+		writeLabel(w, r, db, label.SubjectIngredientLot, l, "ingredient-lot-"+labelFilename(l))
+	}
+}
+
+// HandleBeerLotLabel handles [GET /beer-lots/{uuid}/label]. The label shows
+// the lot code, package format and best-by date, with a barcode of the lot
+// code or the lot UUID. It takes the same query parameters as
+// HandleIngredientLotLabel.
+func HandleBeerLotLabel(db LabelStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		lot, err := db.GetBeerLotByUUID(r.Context(), r.PathValue("uuid"))
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "beer lot not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting beer lot", "error", err)
+			return
+		}
+
+		var volume, quantity string
+		if lot.VolumePerUnit != nil && lot.VolumePerUnitUnit != nil {
+			volume = strconv.FormatInt(*lot.VolumePerUnit, 10) + " " + *lot.VolumePerUnitUnit
+		}
+		if lot.Quantity != nil {
+			quantity = strconv.Itoa(*lot.Quantity)
+		}
+
+		l := label.Label{
+			UUID: lot.UUID.String(),
+			Fields: map[string]label.Field{
+				"lot_code":        {Name: "Lot", Value: labelText(lot.LotCode)},
+				"package_format":  {Name: "Format", Value: labelText(lot.PackageFormatName)},
+				"container":       {Name: "Container", Value: labelText(lot.Container)},
+				"volume_per_unit": {Name: "Volume", Value: volume},
+				"quantity":        {Name: "Units", Value: quantity},
+				"packaged_date":   {Name: "Packaged", Value: lot.PackagedAt.Format("2006-01-02")},
+				"best_by_date":    {Name: "Best by", Value: labelDate(lot.BestBy)},
+			},
+		}
+		if lot.LotCode != nil {
+			l.Code = *lot.LotCode
+		}
+
+		writeLabel(w, r, db, label.SubjectBeerLot, l, "beer-lot-"+labelFilename(l))
+	}
+}
+
+// writeLabel renders copies of l with the requested or default template of
+// subject and writes it as an attachment.
+func writeLabel(w http.ResponseWriter, r *http.Request, db LabelStore, subject string, l label.Label, filename string) {
+	q := r.URL.Query()
+	format, err := label.ParseFormat(q.Get("format"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	copies, err := label.ParseCopies(q.Get("copies"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	layout := label.DefaultTemplate(subject)
+	if templateUUID := q.Get("template_uuid"); templateUUID != "" {
+		t, ok := service.ResolveFK(r.Context(), w, templateUUID, "label template", db.GetLabelTemplateByUUID)
+		if !ok {
+			return
+		}
+		if t.Subject != subject {
+			http.Error(w, "label template is for "+t.Subject+" labels", http.StatusBadRequest)
+			return
+		}
+		layout = t.Layout
+	} else {
+		t, err := db.GetDefaultLabelTemplate(r.Context(), subject)
+		if err == nil {
+			layout = t.Layout
+		} else if !errors.Is(err, service.ErrNotFound) {
+			service.InternalError(w, "error getting default label template", "error", err)
+			return
+		}
+	}
+
+	out, err := label.Render(format, layout, slices.Repeat([]label.Label{l}, copies))
+	if err != nil {
+		// Data the barcode cannot encode, such as a non-ASCII lot code in
+		// Code 128.
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", label.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+format))
+	w.Write(out)
+}
+
+func labelText(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func labelDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// labelFilename returns a filename-safe version of the label's code, or its
+// UUID if it has none.
+func labelFilename(l label.Label) string {
+	name := []rune(l.Code)
+	for i, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+			name[i] = '_'
+		}
+	}
+	if len(name) == 0 {
+		return l.UUID
+	}
+	return string(name)
+}
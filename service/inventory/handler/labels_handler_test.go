@@ -0,0 +1,218 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockLabelStore implements handler.LabelStore and handler.LabelTemplateStore for testing.
+type mockLabelStore struct {
+	lot       storage.IngredientLot
+	templates []storage.LabelTemplate
+	created   []storage.LabelTemplate
+}
+
+func (m *mockLabelStore) GetLabelTemplateByUUID(_ context.Context, templateUUID string) (storage.LabelTemplate, error) {
+	for _, t := range m.templates {
+		if t.UUID.String() == templateUUID {
+			return t, nil
+		}
+	}
+	return storage.LabelTemplate{}, service.ErrNotFound
+}
+
+func (m *mockLabelStore) GetDefaultLabelTemplate(_ context.Context, subject string) (storage.LabelTemplate, error) {
+	for _, t := range m.templates {
+		if t.Subject == subject && t.IsDefault {
+			return t, nil
+		}
+	}
+	return storage.LabelTemplate{}, service.ErrNotFound
+}
+
+func (m *mockLabelStore) GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error) {
+	return m.lot, nil
+}
+
+func (m *mockLabelStore) GetIngredientByUUID(context.Context, string) (storage.Ingredient, error) {
+	return storage.Ingredient{Name: "Maris Otter"}, nil
+}
+
+func (m *mockLabelStore) GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error) {
+	return storage.BeerLot{}, service.ErrNotFound
+}
+
+func (m *mockLabelStore) CreateLabelTemplate(_ context.Context, t storage.LabelTemplate) (storage.LabelTemplate, error) {
+	m.created = append(m.created, t)
+	return t, nil
+}
+
+func (m *mockLabelStore) ListLabelTemplates(context.Context, *string) ([]storage.LabelTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockLabelStore) UpdateLabelTemplate(_ context.Context, t storage.LabelTemplate) (storage.LabelTemplate, error) {
+	return t, nil
+}
+
+func (m *mockLabelStore) DeleteLabelTemplate(context.Context, string) error {
+	return nil
+}
+
+func TestHandleIngredientLotLabel(t *testing.T) {
+	code := "IL-2026-014"
+	bestBy := time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+	lot := storage.IngredientLot{
+		BreweryLotCode: &code,
+		ReceivedAt:     time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		ReceivedAmount: 500,
+		ReceivedUnit:   "kg",
+		BestByAt:       &bestBy,
+	}
+	lot.UUID = uuid.Must(uuid.NewV4())
+
+	kegCollar := storage.LabelTemplate{Name: "Keg collar", Subject: label.SubjectBeerLot, Layout: label.DefaultTemplate(label.SubjectBeerLot)}
+	kegCollar.UUID = uuid.Must(uuid.NewV4())
+
+	// A default ingredient lot template titled by lot code with the best-by
+	// date and a QR code of the lot UUID.
+	sackLayout := label.DefaultTemplate(label.SubjectIngredientLot)
+	sackLayout.Fields = []string{"brewery_lot_code", "best_by_date"}
+	sackLayout.Barcode, sackLayout.BarcodeContent = label.BarcodeQR, label.BarcodeContentUUID
+	sackLabel := storage.LabelTemplate{Name: "Sack label", Subject: label.SubjectIngredientLot, IsDefault: true, Layout: sackLayout}
+	sackLabel.UUID = uuid.Must(uuid.NewV4())
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockLabelStore
+		expectedStatus int
+		validate       func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "pdf with the built-in layout",
+			store:          &mockLabelStore{lot: lot, templates: []storage.LabelTemplate{kegCollar}},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/pdf" {
+					t.Errorf("expected application/pdf, got %s", ct)
+				}
+				if cd := rec.Header().Get("Content-Disposition"); !strings.Contains(cd, "ingredient-lot-IL-2026-014.pdf") {
+					t.Errorf("unexpected content disposition %s", cd)
+				}
+				if !bytes.Contains(rec.Body.Bytes(), []byte("(Maris Otter) Tj")) {
+					t.Error("expected the ingredient name as the title")
+				}
+			},
+		},
+		{
+			name:           "zpl copies with the default template",
+			query:          "?format=zpl&copies=3",
+			store:          &mockLabelStore{lot: lot, templates: []storage.LabelTemplate{kegCollar, sackLabel}},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				zpl := rec.Body.String()
+				if strings.Count(zpl, "^XA") != 3 {
+					t.Errorf("expected 3 labels, got %s", zpl)
+				}
+				if !strings.Contains(zpl, "^FDIL-2026-014^FS") || !strings.Contains(zpl, "^FDBest by: 2027-03-01^FS") {
+					t.Errorf("expected lot code title and best-by date, got %s", zpl)
+				}
+				if !strings.Contains(zpl, "^FDMA,"+lot.UUID.String()+"^FS") {
+					t.Errorf("expected a QR code of the lot UUID, got %s", zpl)
+				}
+			},
+		},
+		{
+			name:           "template for another subject",
+			query:          "?template_uuid=" + kegCollar.UUID.String(),
+			store:          &mockLabelStore{lot: lot, templates: []storage.LabelTemplate{kegCollar}},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid copies",
+			query:          "?copies=0",
+			store:          &mockLabelStore{lot: lot, templates: []storage.LabelTemplate{kegCollar}},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/ingredient-lots/"+lot.UUID.String()+"/label"+tt.query, nil)
+			req.SetPathValue("uuid", lot.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleIngredientLotLabel(tt.store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate != nil {
+				tt.validate(t, rec)
+			}
+		})
+	}
+}
+
+func TestHandleLabelTemplates_Create(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		store          *mockLabelStore
+		expectedStatus int
+		validate       func(t *testing.T, store *mockLabelStore, body string)
+	}{
+		{
+			name:           "applies defaults",
+			body:           `{"name":"Sack label","subject":"ingredient_lot","is_default":true,"width_mm":100,"height_mm":150,"fields":["ingredient_name","brewery_lot_code"]}`,
+			store:          &mockLabelStore{},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockLabelStore, body string) {
+				got := store.created[0].Layout
+				if got.Barcode != label.BarcodeCode128 || got.BarcodeContent != label.BarcodeContentCode || got.PrinterDPI != 203 {
+					t.Errorf("expected default barcode and DPI, got %+v", got)
+				}
+				if !strings.Contains(body, `"width_mm":100`) {
+					t.Errorf("expected layout in response, got %s", body)
+				}
+			},
+		},
+		{
+			name:           "field of another subject",
+			body:           `{"name":"Sack label","subject":"ingredient_lot","width_mm":100,"height_mm":50,"fields":["vessel_name"]}`,
+			store:          &mockLabelStore{},
+			expectedStatus: http.StatusBadRequest,
+			validate: func(t *testing.T, store *mockLabelStore, _ string) {
+				if len(store.created) != 0 {
+					t.Errorf("expected no template created, got %d", len(store.created))
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/label-templates", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleLabelTemplates(tt.store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			tt.validate(t, tt.store, rec.Body.String())
+		})
+	}
+}
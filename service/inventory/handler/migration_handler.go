@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/internal/brewimport"
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+type MigrationStore interface {
+	ListIngredients(context.Context) ([]storage.Ingredient, error)
+	ImportIngredient(context.Context, storage.IngredientImport) (storage.Ingredient, error)
+	ListStockLocations(context.Context) ([]storage.StockLocation, error)
+	ImportOpeningIngredientLot(context.Context, storage.OpeningLotImport) (storage.IngredientLot, error)
+}
+
+// HandleInventoryMigration handles [POST /migrations/inventory], which
+// applies the ingredients and lots of a reviewed migration plan (see
+// [POST /migrations/preview]). Ingredients that already exist by name are
+// matched rather than created, and each lot is loaded as opening stock at
+// its stock location or the plan's default. With dry_run=true the plan is
+// validated without writing anything.
+func HandleInventoryMigration(db MigrationStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var plan brewimport.Plan
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, brewimport.MaxUploadSize)).Decode(&plan); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+
+		ingredients, err := db.ListIngredients(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing ingredients", "error", err)
+			return
+		}
+		locations, err := db.ListStockLocations(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing stock locations", "error", err)
+			return
+		}
+
+		byName := make(map[string]storage.Ingredient, len(ingredients))
+		for _, ingredient := range ingredients {
+			byName[strings.ToLower(ingredient.Name)] = ingredient
+		}
+
+		applier := brewimport.NewApplier(dryRun)
+		for _, ing := range plan.Ingredients {
+			migrateIngredient(r.Context(), db, applier, byName, ing)
+		}
+		for _, lot := range plan.Lots {
+			migrateLot(r.Context(), db, applier, byName, locations, plan.DefaultStockLocation, lot)
+		}
+
+		service.JSON(w, applier.Result())
+	}
+}
+
+func migrateIngredient(ctx context.Context, db MigrationStore, applier *brewimport.Applier, byName map[string]storage.Ingredient, ing brewimport.Ingredient) {
+	const kind = "ingredient"
+	if ing.Skip {
+		applier.Skipped(kind, ing.Ref, ing.Name)
+		return
+	}
+	key := strings.ToLower(strings.TrimSpace(ing.Name))
+	if existing, ok := byName[key]; ok {
+		applier.Matched(kind, ing.Ref, ing.Name, dto.NewIngredientResponse(existing))
+		return
+	}
+
+	req := dto.CreateIngredientRequest{
+		Name:        strings.TrimSpace(ing.Name),
+		Category:    ing.Category,
+		DefaultUnit: ing.DefaultUnit,
+		Description: ing.Description,
+	}
+	if err := req.Validate(); err != nil {
+		applier.Failed(kind, ing.Ref, ing.Name, err)
+		return
+	}
+
+	ingredient := storage.Ingredient{
+		Name:        req.Name,
+		Category:    req.Category,
+		DefaultUnit: req.DefaultUnit,
+		Description: req.Description,
+	}
+	applier.Create(ctx, kind, ing.Ref, ing.Name, func(ctx context.Context) (any, error) {
+		created, err := db.ImportIngredient(ctx, storage.IngredientImport{Ingredient: ingredient})
+		if err != nil {
+			return nil, err
+		}
+		ingredient = created
+		return dto.NewIngredientResponse(created), nil
+	})
+	// Lots of a failed ingredient find nothing and fail in turn. In a dry run
+	// the ingredient stands in for the one that would be created.
+	if applier.DryRun() || ingredient.ID != 0 {
+		byName[key] = ingredient
+	}
+}
+
+func migrateLot(ctx context.Context, db MigrationStore, applier *brewimport.Applier, byName map[string]storage.Ingredient, locations []storage.StockLocation, defaultLocation *string, lot brewimport.Lot) {
+	const kind = "ingredient lot"
+	if lot.Skip {
+		applier.Skipped(kind, lot.Ref, lot.Ingredient)
+		return
+	}
+
+	ingredient, ok := byName[strings.ToLower(strings.TrimSpace(lot.Ingredient))]
+	if !ok {
+		applier.Failed(kind, lot.Ref, lot.Ingredient, fmt.Errorf("ingredient %q not found", lot.Ingredient))
+		return
+	}
+
+	locationName := lot.StockLocation
+	if locationName == nil {
+		locationName = defaultLocation
+	}
+	if locationName == nil {
+		applier.Failed(kind, lot.Ref, lot.Ingredient, fmt.Errorf("stock_location is required"))
+		return
+	}
+	location, err := csvimport.Lookup(locations, *locationName, "stock_location", func(l storage.StockLocation) (string, string) {
+		return l.UUID.String(), l.Name
+	})
+	if err != nil {
+		applier.Failed(kind, lot.Ref, lot.Ingredient, err)
+		return
+	}
+
+	unit := lot.Unit
+	if unit == "" {
+		unit = ingredient.DefaultUnit
+	}
+	amount, unit := brewimport.WholeAmount(lot.Amount, unit)
+
+	req := dto.CreateIngredientLotRequest{
+		IngredientUUID:    ingredient.UUID.String(),
+		BreweryLotCode:    lot.BreweryLotCode,
+		OriginatorLotCode: lot.OriginatorLotCode,
+		OriginatorName:    lot.OriginatorName,
+		ReceivedAt:        lot.ReceivedAt,
+		ReceivedAmount:    amount,
+		ReceivedUnit:      unit,
+		ExpiresAt:         lot.ExpiresAt,
+		Notes:             lot.Notes,
+	}
+	if err := req.Validate(); err != nil {
+		applier.Failed(kind, lot.Ref, lot.Ingredient, err)
+		return
+	}
+
+	imp := storage.OpeningLotImport{
+		Lot: storage.IngredientLot{
+			IngredientID:      ingredient.ID,
+			BreweryLotCode:    req.BreweryLotCode,
+			OriginatorLotCode: req.OriginatorLotCode,
+			OriginatorName:    req.OriginatorName,
+			ReceivedAmount:    req.ReceivedAmount,
+			ReceivedUnit:      req.ReceivedUnit,
+			ExpiresAt:         req.ExpiresAt,
+			Notes:             req.Notes,
+		},
+		StockLocationID: location.ID,
+	}
+	if req.ReceivedAt != nil {
+		imp.Lot.ReceivedAt = *req.ReceivedAt
+	}
+	applier.Create(ctx, kind, lot.Ref, lot.Ingredient, func(ctx context.Context) (any, error) {
+		created, err := db.ImportOpeningIngredientLot(ctx, imp)
+		if err != nil {
+			return nil, err
+		}
+		return dto.NewIngredientLotResponse(created), nil
+	})
+}
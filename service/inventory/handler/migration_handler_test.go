@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/brewimport"
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockMigrationStore implements handler.MigrationStore for testing.
+type mockMigrationStore struct {
+	ingredients []storage.Ingredient
+	locations   []storage.StockLocation
+	imported    []storage.IngredientImport
+	lots        []storage.OpeningLotImport
+}
+
+func (m *mockMigrationStore) ListIngredients(context.Context) ([]storage.Ingredient, error) {
+	return m.ingredients, nil
+}
+
+func (m *mockMigrationStore) ImportIngredient(_ context.Context, req storage.IngredientImport) (storage.Ingredient, error) {
+	m.imported = append(m.imported, req)
+	ingredient := req.Ingredient
+	ingredient.ID = int64(100 + len(m.imported))
+	ingredient.UUID = uuid.Must(uuid.NewV4())
+	return ingredient, nil
+}
+
+func (m *mockMigrationStore) ListStockLocations(context.Context) ([]storage.StockLocation, error) {
+	return m.locations, nil
+}
+
+func (m *mockMigrationStore) ImportOpeningIngredientLot(_ context.Context, req storage.OpeningLotImport) (storage.IngredientLot, error) {
+	m.lots = append(m.lots, req)
+	lot := req.Lot
+	lot.UUID = uuid.Must(uuid.NewV4())
+	return lot, nil
+}
+
+func TestHandleInventoryMigration(t *testing.T) {
+	cooler := "Cooler"
+	attic := "Attic"
+	grainRoom := "Grain Room"
+	plan := brewimport.Plan{
+		Source: brewimport.SourceEkos,
+		Ingredients: []brewimport.Ingredient{
+			{Ref: "row 2", Name: "2-Row Pale", Category: "fermentable", DefaultUnit: "lb"},
+			{Ref: "row 3", Name: "citra", Category: "hop", DefaultUnit: "lb"},
+			{Ref: "row 4", Name: "Keg Collars", Category: "widgets", DefaultUnit: "each"},
+			{Ref: "row 5", Name: "Rice Hulls", Category: "adjunct", DefaultUnit: "lb", Skip: true},
+		},
+		Lots: []brewimport.Lot{
+			{Ref: "row 2", Ingredient: "2-Row Pale", Amount: 1100.5, Unit: "lb"},
+			{Ref: "row 3", Ingredient: "Citra", StockLocation: &cooler, Amount: 22},
+			{Ref: "row 4", Ingredient: "Keg Collars", Amount: 40, Unit: "each"},
+			{Ref: "row 6", Ingredient: "Citra", StockLocation: &attic, Amount: 1, Unit: "lb"},
+		},
+		DefaultStockLocation: &grainRoom,
+	}
+	body, _ := json.Marshal(plan)
+
+	newStore := func() *mockMigrationStore {
+		return &mockMigrationStore{
+			ingredients: []storage.Ingredient{{Identifiers: entity.Identifiers{ID: 7, UUID: uuid.Must(uuid.NewV4())}, Name: "Citra", DefaultUnit: "g"}},
+			locations: []storage.StockLocation{
+				{Identifiers: entity.Identifiers{ID: 1, UUID: uuid.Must(uuid.NewV4())}, Name: "Grain Room"},
+				{Identifiers: entity.Identifiers{ID: 2, UUID: uuid.Must(uuid.NewV4())}, Name: "Cooler"},
+			},
+		}
+	}
+	apply := func(t *testing.T, store *mockMigrationStore, target string) brewimport.Result {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.HandleInventoryMigration(store).ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var result brewimport.Result
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		return result
+	}
+
+	t.Run("creates ingredients and opening lots", func(t *testing.T) {
+		store := newStore()
+
+		result := apply(t, store, "/migrations/inventory")
+
+		expected := brewimport.Totals{Records: 8, Created: 3, Matched: 1, Skipped: 1, Failed: 3}
+		if result.Totals != expected {
+			t.Errorf("expected totals %+v, got %+v", expected, result.Totals)
+		}
+		if len(store.imported) != 1 || store.imported[0].Ingredient.Name != "2-Row Pale" {
+			t.Errorf("unexpected ingredients %+v", store.imported)
+		}
+
+		if len(store.lots) != 2 {
+			t.Fatalf("expected 2 lots, got %+v", store.lots)
+		}
+		malt := store.lots[0]
+		if malt.Lot.IngredientID != 101 || malt.StockLocationID != 1 || malt.Lot.ReceivedAmount != 17608 || malt.Lot.ReceivedUnit != "oz" {
+			t.Errorf("unexpected malt lot %+v", malt)
+		}
+		hop := store.lots[1]
+		if hop.Lot.IngredientID != 7 || hop.StockLocationID != 2 || hop.Lot.ReceivedAmount != 22 || hop.Lot.ReceivedUnit != "g" {
+			t.Errorf("unexpected hop lot %+v", hop)
+		}
+
+		if got := result.Results[6]; got.Status != brewimport.StatusError || *got.Error != `ingredient "Keg Collars" not found` {
+			t.Errorf("expected the lot of a failed ingredient to fail, got %+v", got)
+		}
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		store := newStore()
+
+		result := apply(t, store, "/migrations/inventory?dry_run=true")
+
+		if !result.DryRun || result.Totals.Valid != 3 || result.Totals.Created != 0 {
+			t.Errorf("unexpected totals %+v", result.Totals)
+		}
+		if len(store.imported)+len(store.lots) != 0 {
+			t.Error("expected a dry run to write nothing")
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	procurementapi "github.com/brewpipes/brewpipes/client/procurement"
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
+)
+
+// ProcurementClient handles inter-service communication with the Procurement service.
+type ProcurementClient struct {
+	api *procurementapi.Client
+}
+
+// NewProcurementClient creates a new ProcurementClient with the given base URL. Requests are
+// authenticated with service tokens from tokens.
+func NewProcurementClient(baseURL string, tokens *service.ServiceTokenSource) *ProcurementClient {
+	return &ProcurementClient{
+		api: &procurementapi.Client{
+			Server: strings.TrimSuffix(baseURL, "/") + "/",
+			Client: &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: tokens.Transport(telemetry.Transport("procurement", nil)),
+			},
+		},
+	}
+}
+
+// batchLookupMaxUUIDs is the most PO lines the Procurement service returns
+// per batch-lookup call.
+const batchLookupMaxUUIDs = 100
+
+// PurchaseOrderLineCost holds the cost-relevant fields from a procurement PO line.
+// FeeAllocatedCents is the line's share of the order's fees across its whole
+// quantity. ExchangeRate converts the line's currency into BaseCurrency and is
+// nil when no rate is available.
+type PurchaseOrderLineCost struct {
+	UUID              string                     `json:"uuid"`
+	UnitCostCents     int64                      `json:"unit_cost_cents"`
+	Quantity          int64                      `json:"quantity"`
+	QuantityUnit      string                     `json:"quantity_unit"`
+	Currency          string                     `json:"currency"`
+	FeeAllocatedCents int64                      `json:"fee_allocated_cents"`
+	BaseCurrency      string                     `json:"base_currency"`
+	ExchangeRate      *PurchaseOrderExchangeRate `json:"exchange_rate"`
+}
+
+// PurchaseOrderExchangeRate is the rate applied to a PO line. Locked rates
+// were fixed when the order was received.
+type PurchaseOrderExchangeRate struct {
+	Rate   float64 `json:"rate"`
+	Locked bool    `json:"locked"`
+}
+
+// BatchLookupPOLines calls the Procurement service to look up PO lines by
+// UUID, splitting the lookup into as many calls as needed.
+func (c *ProcurementClient) BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error) {
+	var result []PurchaseOrderLineCost
+	for start := 0; start < len(uuids); start += batchLookupMaxUUIDs {
+		end := min(start+batchLookupMaxUUIDs, len(uuids))
+		lines, err := c.batchLookupPOLines(ctx, uuids[start:end])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, lines...)
+	}
+
+	return result, nil
+}
+
+func (c *ProcurementClient) batchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error) {
+	var result []PurchaseOrderLineCost
+	resp, err := c.api.BatchLookupPurchaseOrderLines(ctx, procurementapi.BatchLookupPurchaseOrderLinesRequest{Uuids: uuids})
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// OpenPurchaseOrderLine is an order line for an inventory item that has not
+// been fully received or cancelled.
+type OpenPurchaseOrderLine struct {
+	PurchaseOrderLineUUID string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderUUID     string     `json:"purchase_order_uuid"`
+	OrderNumber           string     `json:"order_number"`
+	Status                string     `json:"status"`
+	SupplierUUID          string     `json:"supplier_uuid"`
+	InventoryItemUUID     string     `json:"inventory_item_uuid"`
+	Quantity              int64      `json:"quantity"`
+	QuantityUnit          string     `json:"quantity_unit"`
+	ExpectedAt            *time.Time `json:"expected_at"`
+}
+
+// ItemSupplier is the supplier an inventory item was last ordered from.
+type ItemSupplier struct {
+	InventoryItemUUID string    `json:"inventory_item_uuid"`
+	SupplierUUID      string    `json:"supplier_uuid"`
+	SupplierName      string    `json:"supplier_name"`
+	ItemName          string    `json:"item_name"`
+	QuantityUnit      string    `json:"quantity_unit"`
+	UnitCostCents     int64     `json:"unit_cost_cents"`
+	Currency          string    `json:"currency"`
+	LastOrderedAt     time.Time `json:"last_ordered_at"`
+}
+
+// ItemCatalogEntry is a supplier catalog item linked to an inventory item,
+// with the price currently in effect if there is one.
+type ItemCatalogEntry struct {
+	InventoryItemUUID string  `json:"inventory_item_uuid"`
+	SupplierUUID      string  `json:"supplier_uuid"`
+	SupplierName      string  `json:"supplier_name"`
+	SupplierItemUUID  string  `json:"supplier_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	PackUnit          string  `json:"pack_unit"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+	Currency          *string `json:"currency"`
+}
+
+// ItemSupply is the supply-lookup response from the Procurement service.
+type ItemSupply struct {
+	OpenLines    []OpenPurchaseOrderLine `json:"open_lines"`
+	Suppliers    []ItemSupplier          `json:"suppliers"`
+	CatalogItems []ItemCatalogEntry      `json:"catalog_items"`
+}
+
+// LookupItemSupply calls the Procurement service to get open order lines, the
+// last-used supplier and the supplier catalog entries for the given inventory
+// items.
+func (c *ProcurementClient) LookupItemSupply(ctx context.Context, itemUUIDs []string) (*ItemSupply, error) {
+	var result ItemSupply
+	resp, err := c.api.LookupItemSupply(ctx, procurementapi.ItemSupplyLookupRequest{InventoryItemUuids: itemUUIDs})
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DraftPurchaseOrderLine is a line on a draft purchase order sent to the
+// Procurement service.
+type DraftPurchaseOrderLine struct {
+	ItemType          string  `json:"item_type"`
+	ItemName          string  `json:"item_name"`
+	InventoryItemUUID *string `json:"inventory_item_uuid,omitempty"`
+	Quantity          int64   `json:"quantity"`
+	QuantityUnit      string  `json:"quantity_unit"`
+	UnitCostCents     int64   `json:"unit_cost_cents"`
+	Currency          string  `json:"currency"`
+}
+
+// DraftPurchaseOrderRequest is the payload for creating a draft purchase order
+// with its lines.
+type DraftPurchaseOrderRequest struct {
+	SupplierUUID string                   `json:"supplier_uuid"`
+	ExpectedAt   *time.Time               `json:"expected_at,omitempty"`
+	Notes        *string                  `json:"notes,omitempty"`
+	Lines        []DraftPurchaseOrderLine `json:"lines"`
+}
+
+// DraftPurchaseOrder holds the identifying fields of a created draft order.
+type DraftPurchaseOrder struct {
+	UUID         string `json:"uuid"`
+	SupplierUUID string `json:"supplier_uuid"`
+	OrderNumber  string `json:"order_number"`
+	Status       string `json:"status"`
+}
+
+// CreateDraftPurchaseOrder calls the Procurement service to create a draft
+// purchase order and its lines in one request.
+func (c *ProcurementClient) CreateDraftPurchaseOrder(ctx context.Context, req DraftPurchaseOrderRequest) (*DraftPurchaseOrder, error) {
+	body := procurementapi.CreateDraftPurchaseOrderRequest{
+		SupplierUuid: req.SupplierUUID,
+		ExpectedAt:   req.ExpectedAt,
+		Notes:        req.Notes,
+		Lines:        make([]procurementapi.CreateDraftPurchaseOrderLine, 0, len(req.Lines)),
+	}
+	for _, line := range req.Lines {
+		body.Lines = append(body.Lines, procurementapi.CreateDraftPurchaseOrderLine{
+			ItemType:          &line.ItemType,
+			ItemName:          line.ItemName,
+			InventoryItemUuid: line.InventoryItemUUID,
+			Quantity:          &line.Quantity,
+			QuantityUnit:      line.QuantityUnit,
+			UnitCostCents:     &line.UnitCostCents,
+			Currency:          &line.Currency,
+		})
+	}
+
+	var result DraftPurchaseOrder
+	resp, err := c.api.CreateDraftPurchaseOrder(ctx, body)
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusCreated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// PurchaseOrderLineReturnRequest is the quantity of a purchase order line
+// returned to the supplier by a supplier return.
+type PurchaseOrderLineReturnRequest struct {
+	ReturnUUID   string `json:"return_uuid"`
+	Quantity     int64  `json:"quantity"`
+	QuantityUnit string `json:"quantity_unit"`
+}
+
+// RecordPurchaseOrderLineReturn calls the Procurement service to deduct a
+// return from the quantity received against a purchase order line.
+// Procurement records each return UUID once, so a failed call can be retried.
+func (c *ProcurementClient) RecordPurchaseOrderLineReturn(ctx context.Context, lineUUID string, req PurchaseOrderLineReturnRequest) error {
+	resp, err := c.api.CreatePurchaseOrderLineReturn(ctx, lineUUID, procurementapi.RecordPurchaseOrderLineReturnRequest{
+		ReturnUuid:   req.ReturnUUID,
+		Quantity:     &req.Quantity,
+		QuantityUnit: req.QuantityUnit,
+	})
+	return service.DecodeResponse("procurement", resp, err, http.StatusOK, nil)
+}
+
+// GetAccountMapping calls the Procurement service for the GL accounts that
+// journals post to.
+func (c *ProcurementClient) GetAccountMapping(ctx context.Context) (accounting.Mapping, error) {
+	var result accounting.Mapping
+	resp, err := c.api.ListAccountingAccounts(ctx)
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusOK, &result); err != nil {
+		return accounting.Mapping{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,387 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+)
+
+// ReplenishmentStore defines the storage interface for replenishment handlers.
+type ReplenishmentStore interface {
+	ListReorderPositions(ctx context.Context, usedSince time.Time) ([]storage.ReorderPosition, error)
+	SumReceivedByPurchaseOrderLine(ctx context.Context, lineUUIDs []string) (map[string]int64, error)
+}
+
+// ItemSupplyFetcher abstracts the inter-service call to the Procurement
+// service for open order lines and last-used suppliers.
+type ItemSupplyFetcher interface {
+	LookupItemSupply(ctx context.Context, itemUUIDs []string) (*ItemSupply, error)
+}
+
+// DraftPurchaseOrderCreator adds draft purchase order creation to ItemSupplyFetcher.
+type DraftPurchaseOrderCreator interface {
+	ItemSupplyFetcher
+	CreateDraftPurchaseOrder(ctx context.Context, req DraftPurchaseOrderRequest) (*DraftPurchaseOrder, error)
+}
+
+// HandleReplenishmentSuggestions handles [GET /replenishment-suggestions].
+// The optional window_days query parameter sets the trailing usage window.
+func HandleReplenishmentSuggestions(db ReplenishmentStore, procClient ItemSupplyFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		windowDays := dto.DefaultReplenishmentWindowDays
+		if v := r.URL.Query().Get("window_days"); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil {
+				http.Error(w, "invalid window_days", http.StatusBadRequest)
+				return
+			}
+			if err := dto.ValidateReplenishmentWindowDays(parsed); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			windowDays = parsed
+		}
+
+		resp, err := buildReplenishment(r.Context(), db, procClient, windowDays, time.Now().UTC())
+		if err != nil {
+			service.InternalError(w, "error computing replenishment suggestions", "error", err)
+			return
+		}
+
+		service.JSON(w, resp)
+	}
+}
+
+// HandleReplenishmentPurchaseOrders handles
+// [POST /replenishment-suggestions/purchase-orders]. It recomputes the
+// suggestions and creates one draft purchase order per supplier for those that
+// need reordering.
+func HandleReplenishmentPurchaseOrders(db ReplenishmentStore, procClient DraftPurchaseOrderCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.CreateReplenishmentPurchaseOrdersRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		windowDays := dto.DefaultReplenishmentWindowDays
+		if req.WindowDays != nil {
+			windowDays = *req.WindowDays
+		}
+
+		ctx := r.Context()
+		now := time.Now().UTC()
+
+		plan, err := buildReplenishment(ctx, db, procClient, windowDays, now)
+		if err != nil {
+			service.InternalError(w, "error computing replenishment suggestions", "error", err)
+			return
+		}
+
+		selected := make(map[string]bool, len(req.PolicyUUIDs))
+		for _, id := range req.PolicyUUIDs {
+			selected[id] = true
+		}
+
+		resp := dto.ReplenishmentPurchaseOrdersResponse{
+			PurchaseOrders: make([]dto.ReplenishmentPurchaseOrder, 0),
+			Unassigned:     make([]dto.UnassignedReplenishment, 0),
+		}
+
+		var supplierOrder []string
+		bySupplier := make(map[string]*dto.ReplenishmentPurchaseOrder)
+		for _, s := range plan.Suggestions {
+			if !s.NeedsReorder {
+				continue
+			}
+			if len(selected) > 0 && !selected[s.PolicyUUID] {
+				continue
+			}
+			if s.Supplier == nil {
+				resp.Unassigned = append(resp.Unassigned, dto.UnassignedReplenishment{ReplenishmentSuggestion: s, Reason: "no_supplier"})
+				continue
+			}
+
+			currency := s.Supplier.Currency
+			if currency == nil {
+				currency = req.Currency
+			}
+			if currency == nil {
+				resp.Unassigned = append(resp.Unassigned, dto.UnassignedReplenishment{ReplenishmentSuggestion: s, Reason: "no_currency"})
+				continue
+			}
+
+			po, ok := bySupplier[s.Supplier.SupplierUUID]
+			if !ok {
+				po = &dto.ReplenishmentPurchaseOrder{SupplierUUID: s.Supplier.SupplierUUID}
+				bySupplier[s.Supplier.SupplierUUID] = po
+				supplierOrder = append(supplierOrder, s.Supplier.SupplierUUID)
+			}
+			if s.LeadTimeDays > 0 {
+				expected := now.AddDate(0, 0, s.LeadTimeDays)
+				if po.ExpectedAt == nil || expected.After(*po.ExpectedAt) {
+					po.ExpectedAt = &expected
+				}
+			}
+
+			itemName := s.IngredientName
+			if s.Supplier.ItemName != nil {
+				itemName = *s.Supplier.ItemName
+			}
+			// The last order's cost only carries over when it was priced in
+			// the ingredient's default unit.
+			var unitCost int64
+			if s.Supplier.UnitCostCents != nil && s.Supplier.QuantityUnit != nil && *s.Supplier.QuantityUnit == s.Unit {
+				unitCost = *s.Supplier.UnitCostCents
+			}
+			po.Lines = append(po.Lines, dto.ReplenishmentPurchaseOrderLine{
+				PolicyUUID:     s.PolicyUUID,
+				IngredientUUID: s.IngredientUUID,
+				ItemName:       itemName,
+				Quantity:       s.SuggestedQuantity,
+				QuantityUnit:   s.Unit,
+				UnitCostCents:  unitCost,
+				Currency:       strings.ToUpper(strings.TrimSpace(*currency)),
+			})
+		}
+
+		notes := "Generated from replenishment suggestions"
+		for _, supplierUUID := range supplierOrder {
+			po := bySupplier[supplierUUID]
+
+			draft := DraftPurchaseOrderRequest{
+				SupplierUUID: po.SupplierUUID,
+				ExpectedAt:   po.ExpectedAt,
+				Notes:        &notes,
+				Lines:        make([]DraftPurchaseOrderLine, 0, len(po.Lines)),
+			}
+			for _, line := range po.Lines {
+				ingredientUUID := line.IngredientUUID
+				draft.Lines = append(draft.Lines, DraftPurchaseOrderLine{
+					ItemType:          "ingredient",
+					ItemName:          line.ItemName,
+					InventoryItemUUID: &ingredientUUID,
+					Quantity:          line.Quantity,
+					QuantityUnit:      line.QuantityUnit,
+					UnitCostCents:     line.UnitCostCents,
+					Currency:          line.Currency,
+				})
+			}
+
+			created, err := procClient.CreateDraftPurchaseOrder(ctx, draft)
+			if err != nil {
+				service.InternalError(w, "error creating draft purchase order", "error", err, "supplier_uuid", po.SupplierUUID)
+				return
+			}
+			po.PurchaseOrderUUID = created.UUID
+			po.OrderNumber = created.OrderNumber
+
+			slog.Info("replenishment draft purchase order created", "purchase_order_uuid", created.UUID, "supplier_uuid", po.SupplierUUID, "lines", len(po.Lines))
+			resp.PurchaseOrders = append(resp.PurchaseOrders, *po)
+		}
+
+		service.JSONCreated(w, resp)
+	}
+}
+
+// buildReplenishment loads reorder positions and the procurement supply
+// picture, then computes a suggestion for every policy.
+func buildReplenishment(ctx context.Context, db ReplenishmentStore, procClient ItemSupplyFetcher, windowDays int, now time.Time) (dto.ReplenishmentResponse, error) {
+	positions, err := db.ListReorderPositions(ctx, now.AddDate(0, 0, -windowDays))
+	if err != nil {
+		return dto.ReplenishmentResponse{}, fmt.Errorf("listing reorder positions: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var itemUUIDs []string
+	for _, pos := range positions {
+		if !seen[pos.Policy.IngredientUUID] {
+			seen[pos.Policy.IngredientUUID] = true
+			itemUUIDs = append(itemUUIDs, pos.Policy.IngredientUUID)
+		}
+	}
+
+	supply := &ItemSupply{}
+	received := map[string]int64{}
+	if len(itemUUIDs) > 0 {
+		supply, err = procClient.LookupItemSupply(ctx, itemUUIDs)
+		if err != nil {
+			return dto.ReplenishmentResponse{}, fmt.Errorf("looking up item supply: %w", err)
+		}
+
+		lineUUIDs := make([]string, 0, len(supply.OpenLines))
+		for _, line := range supply.OpenLines {
+			lineUUIDs = append(lineUUIDs, line.PurchaseOrderLineUUID)
+		}
+		if len(lineUUIDs) > 0 {
+			received, err = db.SumReceivedByPurchaseOrderLine(ctx, lineUUIDs)
+			if err != nil {
+				return dto.ReplenishmentResponse{}, fmt.Errorf("summing received amounts: %w", err)
+			}
+		}
+	}
+
+	return dto.ReplenishmentResponse{
+		GeneratedAt: now,
+		WindowDays:  windowDays,
+		Suggestions: computeReplenishment(positions, supply, received, windowDays),
+	}, nil
+}
+
+// computeReplenishment applies an order-up-to rule to each policy. The reorder
+// point is the larger of min_level and safety stock plus the trailing daily
+// usage over the lead time. When available stock plus open orders is at or
+// below the reorder point, the suggestion orders back up to max_level. The
+// lead time is the policy's, or else the supplier's catalog lead time.
+func computeReplenishment(positions []storage.ReorderPosition, supply *ItemSupply, received map[string]int64, windowDays int) []dto.ReplenishmentSuggestion {
+	onOrder := make(map[string]int64)
+	for _, line := range supply.OpenLines {
+		// Only receipts in the line's unit count against it.
+		if outstanding := line.Quantity - received[line.PurchaseOrderLineUUID+"|"+line.QuantityUnit]; outstanding > 0 {
+			onOrder[line.InventoryItemUUID+"|"+line.QuantityUnit] += outstanding
+		}
+	}
+	lastSupplier := make(map[string]ItemSupplier, len(supply.Suppliers))
+	for _, s := range supply.Suppliers {
+		lastSupplier[s.InventoryItemUUID] = s
+	}
+	catalog := make(map[string]ItemCatalogEntry, len(supply.CatalogItems))
+	for _, entry := range supply.CatalogItems {
+		key := entry.InventoryItemUUID + "|" + entry.SupplierUUID
+		if _, ok := catalog[key]; !ok {
+			catalog[key] = entry
+		}
+	}
+
+	suggestions := make([]dto.ReplenishmentSuggestion, 0, len(positions))
+	for _, pos := range positions {
+		p := pos.Policy
+
+		// Policy levels are in the ingredient's default unit; stock held in
+		// any other unit is reported but not counted.
+		var qty storage.ReorderQuantity
+		var otherUnits []string
+		for _, q := range pos.Quantities {
+			switch {
+			case q.Unit == p.DefaultUnit:
+				qty = q
+			case q.OnHand != 0 || q.Reserved != 0 || q.Used != 0:
+				otherUnits = append(otherUnits, q.Unit)
+			}
+		}
+
+		s := dto.ReplenishmentSuggestion{
+			PolicyUUID:        p.UUID.String(),
+			IngredientUUID:    p.IngredientUUID,
+			IngredientName:    p.IngredientName,
+			Unit:              p.DefaultUnit,
+			StockLocationUUID: p.StockLocationUUID,
+			StockLocationName: p.StockLocationName,
+			OtherUnits:        otherUnits,
+			OnHand:            qty.OnHand,
+			Reserved:          qty.Reserved,
+			Available:         qty.OnHand - qty.Reserved,
+			UsedInWindow:      qty.Used,
+			DailyUsage:        float64(qty.Used) / float64(windowDays),
+			MinLevel:          p.MinLevel,
+			MaxLevel:          p.MaxLevel,
+			SafetyStock:       p.SafetyStock,
+		}
+		if p.StockLocationID == nil {
+			s.OnOrder = onOrder[p.IngredientUUID+"|"+p.DefaultUnit]
+		}
+
+		last, hasLast := lastSupplier[p.IngredientUUID]
+		switch {
+		case p.PreferredSupplierUUID != nil:
+			s.Supplier = &dto.ReplenishmentSupplier{
+				SupplierUUID: p.PreferredSupplierUUID.String(),
+				Source:       "preferred",
+			}
+			if hasLast && last.SupplierUUID == s.Supplier.SupplierUUID {
+				fillSupplierHistory(s.Supplier, last)
+			}
+		case hasLast:
+			s.Supplier = &dto.ReplenishmentSupplier{
+				SupplierUUID: last.SupplierUUID,
+				Source:       "last_order",
+			}
+			fillSupplierHistory(s.Supplier, last)
+		}
+
+		var entry ItemCatalogEntry
+		inCatalog := false
+		if s.Supplier != nil {
+			entry, inCatalog = catalog[p.IngredientUUID+"|"+s.Supplier.SupplierUUID]
+			if inCatalog && s.Supplier.SupplierName == nil {
+				fillSupplierCatalog(s.Supplier, entry)
+			}
+		}
+		switch {
+		case p.LeadTimeDays != nil:
+			s.LeadTimeDays = *p.LeadTimeDays
+		case inCatalog && entry.LeadTimeDays != nil:
+			s.LeadTimeDays = *entry.LeadTimeDays
+		}
+
+		if s.DailyUsage > 0 {
+			cover := math.Round(float64(s.Available)/s.DailyUsage*10) / 10
+			s.DaysOfCover = &cover
+		}
+
+		leadTimeDemand := int64(math.Ceil(s.DailyUsage*float64(s.LeadTimeDays) - 1e-9))
+		s.ReorderPoint = max(p.MinLevel, p.SafetyStock+leadTimeDemand)
+
+		position := s.Available + s.OnOrder
+		if position <= s.ReorderPoint {
+			s.SuggestedQuantity = max(p.MaxLevel, s.ReorderPoint) - position
+		}
+		s.NeedsReorder = s.SuggestedQuantity > 0
+
+		suggestions = append(suggestions, s)
+	}
+
+	return suggestions
+}
+
+func fillSupplierHistory(dst *dto.ReplenishmentSupplier, last ItemSupplier) {
+	dst.SupplierName = &last.SupplierName
+	dst.ItemName = &last.ItemName
+	dst.QuantityUnit = &last.QuantityUnit
+	dst.UnitCostCents = &last.UnitCostCents
+	dst.Currency = &last.Currency
+}
+
+// fillSupplierCatalog fills in a supplier the item has not been ordered from
+// with its catalog entry.
+func fillSupplierCatalog(dst *dto.ReplenishmentSupplier, entry ItemCatalogEntry) {
+	dst.SupplierName = &entry.SupplierName
+	dst.ItemName = &entry.ItemName
+	dst.QuantityUnit = &entry.PackUnit
+	dst.UnitCostCents = entry.UnitCostCents
+	dst.Currency = entry.Currency
+}
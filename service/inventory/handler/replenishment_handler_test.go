@@ -0,0 +1,337 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockReplenishmentStore implements handler.ReplenishmentStore for testing.
+type mockReplenishmentStore struct {
+	positions []storage.ReorderPosition
+	received  map[string]int64
+	usedSince time.Time
+}
+
+func (m *mockReplenishmentStore) ListReorderPositions(_ context.Context, usedSince time.Time) ([]storage.ReorderPosition, error) {
+	m.usedSince = usedSince
+	return m.positions, nil
+}
+
+func (m *mockReplenishmentStore) SumReceivedByPurchaseOrderLine(_ context.Context, _ []string) (map[string]int64, error) {
+	return m.received, nil
+}
+
+// mockProcurement implements handler.DraftPurchaseOrderCreator for testing.
+type mockProcurement struct {
+	supply handler.ItemSupply
+	drafts []handler.DraftPurchaseOrderRequest
+}
+
+func (m *mockProcurement) LookupItemSupply(_ context.Context, _ []string) (*handler.ItemSupply, error) {
+	return &m.supply, nil
+}
+
+func (m *mockProcurement) CreateDraftPurchaseOrder(_ context.Context, req handler.DraftPurchaseOrderRequest) (*handler.DraftPurchaseOrder, error) {
+	m.drafts = append(m.drafts, req)
+	return &handler.DraftPurchaseOrder{UUID: uuid.Must(uuid.NewV4()).String(), OrderNumber: "20261019001", Status: "draft"}, nil
+}
+
+func newReorderPolicy(ingredientUUID, name string, minLevel, maxLevel, safety int64) storage.IngredientReorderPolicy {
+	p := storage.IngredientReorderPolicy{
+		IngredientUUID: ingredientUUID,
+		IngredientName: name,
+		DefaultUnit:    "kg",
+		MinLevel:       minLevel,
+		MaxLevel:       maxLevel,
+		SafetyStock:    safety,
+	}
+	p.UUID = uuid.Must(uuid.NewV4())
+	return p
+}
+
+func TestHandleReplenishmentSuggestions(t *testing.T) {
+	maltUUID := "110e8400-e29b-41d4-a716-446655440001"
+	hopUUID := "110e8400-e29b-41d4-a716-446655440002"
+	yeastUUID := "110e8400-e29b-41d4-a716-446655440003"
+	preferredUUID := "990e8400-e29b-41d4-a716-446655440002"
+
+	// Malt is tracked across all locations with a 10 day lead time.
+	leadTime := 10
+	malt := newReorderPolicy(maltUUID, "Pale Malt", 100, 500, 50)
+	malt.LeadTimeDays = &leadTime
+
+	// Hops are tracked at one location and prefer a supplier with no
+	// order history.
+	locationID := int64(7)
+	locationUUID := "880e8400-e29b-41d4-a716-446655440001"
+	preferred := uuid.Must(uuid.FromString(preferredUUID))
+	hops := newReorderPolicy(hopUUID, "Citra", 10, 40, 0)
+	hops.StockLocationID = &locationID
+	hops.StockLocationUUID = &locationUUID
+	hops.PreferredSupplierUUID = &preferred
+
+	yeast := newReorderPolicy(yeastUUID, "US-05", 1, 2, 0)
+
+	// Malt has 120 kg on hand, 50 kg reserved and 900 kg used in the window.
+	positions := []storage.ReorderPosition{
+		{Policy: malt, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 120, Reserved: 50, Used: 900}}},
+		{Policy: hops, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 5}}},
+		{Policy: yeast},
+	}
+	// A lot of malt received in pounds, which must not count towards the kg
+	// policy.
+	positionsWithPounds := []storage.ReorderPosition{
+		{Policy: malt, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 120, Reserved: 50, Used: 900}, {Unit: "lb", OnHand: 500, Reserved: 100, Used: 450}}},
+		{Policy: hops, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 5}}},
+		{Policy: yeast},
+	}
+	// 40 of the 100 kg malt line has arrived; pounds received against it do
+	// not count.
+	received := map[string]int64{"pol-malt|kg": 40, "pol-malt|lb": 15}
+
+	supply := handler.ItemSupply{
+		OpenLines: []handler.OpenPurchaseOrderLine{
+			{PurchaseOrderLineUUID: "pol-malt", InventoryItemUUID: maltUUID, Quantity: 100, QuantityUnit: "kg"},
+			{PurchaseOrderLineUUID: "pol-hops", InventoryItemUUID: hopUUID, Quantity: 20, QuantityUnit: "kg"},
+		},
+		Suppliers: []handler.ItemSupplier{
+			{InventoryItemUUID: maltUUID, SupplierUUID: "sup-1", SupplierName: "Malt Co", ItemName: "Pale Malt 25kg", QuantityUnit: "kg", UnitCostCents: 150, Currency: "USD"},
+		},
+	}
+	catalogLeadTime, catalogCost, catalogCurrency := 14, int64(900), "EUR"
+	supplyWithCatalog := supply
+	supplyWithCatalog.CatalogItems = []handler.ItemCatalogEntry{
+		{InventoryItemUUID: hopUUID, SupplierUUID: preferredUUID, SupplierName: "Hop Farm", ItemName: "Citra T90 5kg", PackUnit: "kg", LeadTimeDays: &catalogLeadTime, UnitCostCents: &catalogCost, Currency: &catalogCurrency},
+		{InventoryItemUUID: maltUUID, SupplierUUID: "sup-1", SupplierName: "Malt Co", ItemName: "Pale Malt 25kg", PackUnit: "kg", LeadTimeDays: &catalogLeadTime},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockReplenishmentStore
+		proc           *mockProcurement
+		expectedStatus int
+		validate       func(t *testing.T, store *mockReplenishmentStore, resp dto.ReplenishmentResponse)
+	}{
+		{
+			name:           "suggests quantities and suppliers",
+			store:          &mockReplenishmentStore{positions: positions, received: received},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, store *mockReplenishmentStore, resp dto.ReplenishmentResponse) {
+				if resp.WindowDays != dto.DefaultReplenishmentWindowDays {
+					t.Errorf("expected default window, got %d", resp.WindowDays)
+				}
+				if days := resp.GeneratedAt.Sub(store.usedSince).Hours() / 24; days < 89.9 || days > 90.1 {
+					t.Errorf("expected usage window of 90 days, got %.1f", days)
+				}
+				if len(resp.Suggestions) != 3 {
+					t.Fatalf("expected 3 suggestions, got %d", len(resp.Suggestions))
+				}
+
+				malt := resp.Suggestions[0]
+				if malt.Available != 70 || malt.OnOrder != 60 || malt.DailyUsage != 10 {
+					t.Errorf("malt: expected available 70, on order 60, 10/day, got %d/%d/%v", malt.Available, malt.OnOrder, malt.DailyUsage)
+				}
+				if malt.ReorderPoint != 150 || malt.SuggestedQuantity != 370 || !malt.NeedsReorder {
+					t.Errorf("malt: expected reorder point 150 and 370 suggested, got %d/%d", malt.ReorderPoint, malt.SuggestedQuantity)
+				}
+				if malt.Supplier == nil || malt.Supplier.Source != "last_order" || malt.Supplier.SupplierUUID != "sup-1" {
+					t.Errorf("malt: expected last order supplier, got %+v", malt.Supplier)
+				}
+
+				hops := resp.Suggestions[1]
+				if hops.OnOrder != 0 || hops.SuggestedQuantity != 35 {
+					t.Errorf("hops: expected location policy to ignore open orders and suggest 35, got %d/%d", hops.OnOrder, hops.SuggestedQuantity)
+				}
+				if hops.Supplier == nil || hops.Supplier.Source != "preferred" || hops.Supplier.Currency != nil {
+					t.Errorf("hops: expected preferred supplier without history, got %+v", hops.Supplier)
+				}
+			},
+		},
+		{
+			name:           "supplier catalog lead time",
+			store:          &mockReplenishmentStore{positions: positions, received: received},
+			proc:           &mockProcurement{supply: supplyWithCatalog},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, _ *mockReplenishmentStore, resp dto.ReplenishmentResponse) {
+				if malt := resp.Suggestions[0]; malt.LeadTimeDays != 10 {
+					t.Errorf("malt: expected the policy's lead time of 10, got %d", malt.LeadTimeDays)
+				}
+				hops := resp.Suggestions[1]
+				if hops.LeadTimeDays != 14 {
+					t.Errorf("hops: expected the catalog lead time of 14, got %d", hops.LeadTimeDays)
+				}
+				if hops.Supplier == nil || hops.Supplier.SupplierName == nil || *hops.Supplier.SupplierName != "Hop Farm" ||
+					hops.Supplier.UnitCostCents == nil || *hops.Supplier.UnitCostCents != 900 {
+					t.Errorf("hops: expected the preferred supplier's catalog details, got %+v", hops.Supplier)
+				}
+			},
+		},
+		{
+			name:           "lot in another unit",
+			store:          &mockReplenishmentStore{positions: positionsWithPounds, received: received},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, _ *mockReplenishmentStore, resp dto.ReplenishmentResponse) {
+				malt := resp.Suggestions[0]
+				if malt.OnHand != 120 || malt.Reserved != 50 || malt.UsedInWindow != 900 {
+					t.Errorf("malt: expected only kg stock counted, got %d/%d/%d", malt.OnHand, malt.Reserved, malt.UsedInWindow)
+				}
+				if malt.SuggestedQuantity != 370 {
+					t.Errorf("malt: expected 370 suggested, got %d", malt.SuggestedQuantity)
+				}
+				if len(malt.OtherUnits) != 1 || malt.OtherUnits[0] != "lb" {
+					t.Errorf("malt: expected lb reported as another unit, got %v", malt.OtherUnits)
+				}
+			},
+		},
+		{
+			name:           "invalid window",
+			query:          "?window_days=0",
+			store:          &mockReplenishmentStore{positions: positions, received: received},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/replenishment-suggestions"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleReplenishmentSuggestions(tt.store, tt.proc).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.ReplenishmentResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, tt.store, resp)
+		})
+	}
+}
+
+func TestHandleReplenishmentPurchaseOrders(t *testing.T) {
+	maltUUID := "110e8400-e29b-41d4-a716-446655440001"
+	hopUUID := "110e8400-e29b-41d4-a716-446655440002"
+	yeastUUID := "110e8400-e29b-41d4-a716-446655440003"
+
+	// Malt needs 370 kg and was last ordered from sup-1 in USD. Hops prefer
+	// a supplier with no history, so have no currency. Yeast has no
+	// supplier at all.
+	leadTime := 10
+	malt := newReorderPolicy(maltUUID, "Pale Malt", 100, 500, 50)
+	malt.LeadTimeDays = &leadTime
+	preferred := uuid.Must(uuid.FromString("990e8400-e29b-41d4-a716-446655440002"))
+	hops := newReorderPolicy(hopUUID, "Citra", 10, 40, 0)
+	hops.PreferredSupplierUUID = &preferred
+	yeast := newReorderPolicy(yeastUUID, "US-05", 1, 2, 0)
+
+	positions := []storage.ReorderPosition{
+		{Policy: malt, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 120, Reserved: 50, Used: 900}}},
+		{Policy: hops, Quantities: []storage.ReorderQuantity{{Unit: "kg", OnHand: 5}}},
+		{Policy: yeast},
+	}
+	supply := handler.ItemSupply{
+		OpenLines: []handler.OpenPurchaseOrderLine{
+			{PurchaseOrderLineUUID: "pol-malt", InventoryItemUUID: maltUUID, Quantity: 100, QuantityUnit: "kg"},
+		},
+		Suppliers: []handler.ItemSupplier{
+			{InventoryItemUUID: maltUUID, SupplierUUID: "sup-1", SupplierName: "Malt Co", ItemName: "Pale Malt 25kg", QuantityUnit: "kg", UnitCostCents: 150, Currency: "USD"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		store          *mockReplenishmentStore
+		proc           *mockProcurement
+		expectedStatus int
+		expectedDrafts int
+		unassigned     map[string]string
+	}{
+		{
+			name:           "without fallback currency",
+			body:           `{}`,
+			store:          &mockReplenishmentStore{positions: positions, received: map[string]int64{"pol-malt|kg": 40}},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusCreated,
+			expectedDrafts: 1,
+			unassigned:     map[string]string{hopUUID: "no_currency", yeastUUID: "no_supplier"},
+		},
+		{
+			name:           "with fallback currency",
+			body:           `{"currency":"EUR"}`,
+			store:          &mockReplenishmentStore{positions: positions, received: map[string]int64{"pol-malt|kg": 40}},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusCreated,
+			expectedDrafts: 2,
+			unassigned:     map[string]string{yeastUUID: "no_supplier"},
+		},
+		{
+			name:           "invalid currency",
+			body:           `{"currency":"EURO"}`,
+			store:          &mockReplenishmentStore{positions: positions},
+			proc:           &mockProcurement{supply: supply},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/replenishment-suggestions/purchase-orders", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleReplenishmentPurchaseOrders(tt.store, tt.proc).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			var resp dto.ReplenishmentPurchaseOrdersResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+
+			if len(tt.proc.drafts) != tt.expectedDrafts {
+				t.Fatalf("expected %d drafts, got %d", tt.expectedDrafts, len(tt.proc.drafts))
+			}
+			malt := tt.proc.drafts[0]
+			if malt.SupplierUUID != "sup-1" || malt.Lines[0].Quantity != 370 || malt.Lines[0].UnitCostCents != 150 || malt.Lines[0].Currency != "USD" {
+				t.Errorf("unexpected malt draft %+v", malt)
+			}
+			if malt.ExpectedAt == nil {
+				t.Error("expected malt draft to carry an expected date from the lead time")
+			}
+
+			if len(resp.Unassigned) != len(tt.unassigned) {
+				t.Fatalf("expected %d unassigned, got %d", len(tt.unassigned), len(resp.Unassigned))
+			}
+			for _, u := range resp.Unassigned {
+				if tt.unassigned[u.IngredientUUID] != u.Reason {
+					t.Errorf("%s: expected reason %q, got %q", u.IngredientName, tt.unassigned[u.IngredientUUID], u.Reason)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// ScanStore defines the storage methods needed to resolve scanned codes.
+type ScanStore interface {
+	ListIngredientLotsByLotCode(context.Context, string) ([]storage.IngredientLot, error)
+	GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error)
+	GetIngredientByUUID(context.Context, string) (storage.Ingredient, error)
+	ListBeerLotsByLotCode(context.Context, string) ([]storage.BeerLot, error)
+	GetBeerLotByUUID(context.Context, string) (storage.BeerLot, error)
+	ListInventoryMovementsByBeerLot(context.Context, string) ([]storage.InventoryMovement, error)
+	GetBeerLotItemByIdentifier(context.Context, string) (storage.BeerLotItem, error)
+	GetBeerLotItemByUUID(context.Context, string) (storage.BeerLotItem, error)
+	ListKegs(context.Context, storage.KegListFilter) ([]storage.Keg, error)
+	GetKegByUUID(context.Context, string) (storage.Keg, error)
+}
+
+// HandleInventoryScan handles [GET /inventory-scan/{code}]. It resolves a
+// scanned value against ingredient lot brewery and originator lot codes,
+// beer lot codes, beer lot item identifiers, keg serials and the UUIDs of
+// those entities. Every match is returned with the actions allowed in its
+// current state; no match is an empty list. Production's [GET /scan/{code}]
+// merges these matches with its own.
+func HandleInventoryScan(db ScanStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		code := strings.TrimSpace(r.PathValue("code"))
+		if code == "" {
+			http.Error(w, "code is required", http.StatusBadRequest)
+			return
+		}
+
+		matches, err := ResolveScannedCode(r.Context(), db, code)
+		if err != nil {
+			service.InternalError(w, "error resolving scanned code", "error", err, "code", code)
+			return
+		}
+
+		service.JSON(w, dto.ScanResponse{Code: code, Matches: matches})
+	}
+}
+
+// ResolveScannedCode returns the inventory entities a scanned code matches,
+// looking it up by UUID when it parses as one and by lot code, item
+// identifier and keg serial otherwise.
+func ResolveScannedCode(ctx context.Context, db ScanStore, code string) ([]dto.ScanMatchResponse, error) {
+	if _, err := uuid.FromString(code); err == nil {
+		return scanInventoryUUID(ctx, db, code)
+	}
+	return scanInventoryCode(ctx, db, code)
+}
+
+// scanInventoryCode resolves a human-readable code. Keg serials are also
+// used as beer lot item identifiers, so a keg scan matches both the keg and
+// the item it currently carries.
+func scanInventoryCode(ctx context.Context, db ScanStore, code string) ([]dto.ScanMatchResponse, error) {
+	matches := []dto.ScanMatchResponse{}
+
+	lots, err := db.ListIngredientLotsByLotCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	for _, lot := range lots {
+		matchedOn := "originator_lot_code"
+		if lot.BreweryLotCode != nil && *lot.BreweryLotCode == code {
+			matchedOn = "brewery_lot_code"
+		}
+		match, err := ingredientLotScanMatch(ctx, db, lot, matchedOn)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	beerLots, err := db.ListBeerLotsByLotCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	for _, lot := range beerLots {
+		match, err := beerLotScanMatch(ctx, db, lot, "lot_code")
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	item, err := beerLotItemByIdentifier(ctx, db, code)
+	if err == nil {
+		matches = append(matches, beerLotItemScanMatch(item, "identifier"))
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, err
+	}
+
+	kegs, err := db.ListKegs(ctx, storage.KegListFilter{Serial: &code})
+	if err != nil {
+		return nil, err
+	}
+	for _, keg := range kegs {
+		matches = append(matches, kegScanMatch(keg, "serial"))
+	}
+
+	return matches, nil
+}
+
+// scanInventoryUUID resolves the UUID of an ingredient lot, beer lot, beer
+// lot item or keg.
+func scanInventoryUUID(ctx context.Context, db ScanStore, code string) ([]dto.ScanMatchResponse, error) {
+	matches := []dto.ScanMatchResponse{}
+
+	if lot, err := db.GetIngredientLotByUUID(ctx, code); err == nil {
+		match, err := ingredientLotScanMatch(ctx, db, lot, "uuid")
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, err
+	}
+
+	if lot, err := db.GetBeerLotByUUID(ctx, code); err == nil {
+		match, err := beerLotScanMatch(ctx, db, lot, "uuid")
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, err
+	}
+
+	if item, err := db.GetBeerLotItemByUUID(ctx, code); err == nil {
+		matches = append(matches, beerLotItemScanMatch(item, "uuid"))
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, err
+	}
+
+	if keg, err := db.GetKegByUUID(ctx, code); err == nil {
+		matches = append(matches, kegScanMatch(keg, "uuid"))
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// ingredientLotScanMatch describes an ingredient lot by its stock on hand.
+// Expired lots can still be moved, adjusted or returned but not used.
+func ingredientLotScanMatch(ctx context.Context, db ScanStore, lot storage.IngredientLot, matchedOn string) (dto.ScanMatchResponse, error) {
+	ingredient, err := db.GetIngredientByUUID(ctx, lot.IngredientUUID)
+	if err != nil {
+		return dto.ScanMatchResponse{}, fmt.Errorf("getting ingredient for lot %s: %w", lot.UUID, err)
+	}
+
+	state := "in_stock"
+	var actions []string
+	switch {
+	case lot.CurrentAmount <= 0:
+		state = "depleted"
+		actions = []string{"adjust"}
+	case lot.ExpiresAt != nil && lot.ExpiresAt.Before(time.Now()):
+		state = "expired"
+		actions = []string{"move", "adjust"}
+	default:
+		actions = []string{"record_usage", "move", "adjust"}
+	}
+	if lot.CurrentAmount > 0 && lot.PurchaseOrderLineUUID != nil {
+		actions = append(actions, "return_to_supplier")
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityIngredientLot,
+		UUID:       lot.UUID.String(),
+		Name:       ingredient.Name,
+		MatchedOn:  matchedOn,
+		State:      state,
+		Actions:    append(actions, "print_label"),
+	}, nil
+}
+
+// beerLotScanMatch describes a beer lot by its units on hand in the
+// movement ledger.
+func beerLotScanMatch(ctx context.Context, db ScanStore, lot storage.BeerLot, matchedOn string) (dto.ScanMatchResponse, error) {
+	movements, err := db.ListInventoryMovementsByBeerLot(ctx, lot.UUID.String())
+	if err != nil {
+		return dto.ScanMatchResponse{}, fmt.Errorf("listing movements for beer lot %s: %w", lot.UUID, err)
+	}
+	var onHand int64
+	for _, m := range movements {
+		if m.Direction == "in" {
+			onHand += m.Amount
+		} else {
+			onHand -= m.Amount
+		}
+	}
+
+	state, actions := "in_stock", []string{"move", "remove", "adjust", "print_label"}
+	if onHand <= 0 {
+		state, actions = "depleted", []string{"adjust", "print_label"}
+	}
+
+	var name string
+	if lot.LotCode != nil {
+		name = *lot.LotCode
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityBeerLot,
+		UUID:       lot.UUID.String(),
+		Name:       name,
+		MatchedOn:  matchedOn,
+		State:      state,
+		Actions:    actions,
+	}, nil
+}
+
+// beerLotItemStatusActions names the action that moves a beer lot item to
+// each status, in the order they are offered.
+var beerLotItemStatusActions = []struct{ status, action string }{
+	{storage.BeerLotItemStatusAvailable, "mark_available"},
+	{storage.BeerLotItemStatusReserved, "reserve"},
+	{storage.BeerLotItemStatusSold, "sell"},
+	{storage.BeerLotItemStatusReturned, "mark_returned"},
+	{storage.BeerLotItemStatusDamaged, "mark_damaged"},
+	{storage.BeerLotItemStatusDestroyed, "destroy"},
+}
+
+// beerLotItemScanMatch offers the status changes allowed from the item's
+// current status.
+func beerLotItemScanMatch(item storage.BeerLotItem, matchedOn string) dto.ScanMatchResponse {
+	actions := []string{}
+	for _, sa := range beerLotItemStatusActions {
+		if storage.CanTransitionBeerLotItem(item.Status, sa.status) {
+			actions = append(actions, sa.action)
+		}
+	}
+
+	var name string
+	if item.Identifier != nil {
+		name = *item.Identifier
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityBeerLotItem,
+		UUID:       item.UUID.String(),
+		Name:       name,
+		MatchedOn:  matchedOn,
+		State:      item.Status,
+		Actions:    actions,
+	}
+}
+
+// kegScanEvents is the order keg events are offered in.
+var kegScanEvents = []string{
+	storage.KegEventFill,
+	storage.KegEventShip,
+	storage.KegEventReturn,
+	storage.KegEventClean,
+	storage.KegEventMove,
+	storage.KegEventLost,
+	storage.KegEventWriteOff,
+	storage.KegEventRetire,
+}
+
+// kegScanMatch offers the keg events allowed from the keg's current status.
+func kegScanMatch(keg storage.Keg, matchedOn string) dto.ScanMatchResponse {
+	actions := []string{}
+	for _, event := range kegScanEvents {
+		if _, ok := storage.NextKegStatus(keg.Status, event); ok {
+			actions = append(actions, event)
+		}
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityKeg,
+		UUID:       keg.UUID.String(),
+		Name:       keg.Serial,
+		MatchedOn:  matchedOn,
+		State:      keg.Status,
+		Actions:    actions,
+	}
+}
@@ -0,0 +1,234 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockScanStore implements handler.ScanStore for testing.
+type mockScanStore struct {
+	ingredientLots []storage.IngredientLot
+	beerLots       []storage.BeerLot
+	movements      []storage.InventoryMovement
+	items          []storage.BeerLotItem
+	kegs           []storage.Keg
+}
+
+func (m *mockScanStore) ListIngredientLotsByLotCode(_ context.Context, code string) ([]storage.IngredientLot, error) {
+	var lots []storage.IngredientLot
+	for _, lot := range m.ingredientLots {
+		if (lot.BreweryLotCode != nil && *lot.BreweryLotCode == code) || (lot.OriginatorLotCode != nil && *lot.OriginatorLotCode == code) {
+			lots = append(lots, lot)
+		}
+	}
+	return lots, nil
+}
+
+func (m *mockScanStore) GetIngredientLotByUUID(_ context.Context, lotUUID string) (storage.IngredientLot, error) {
+	for _, lot := range m.ingredientLots {
+		if lot.UUID.String() == lotUUID {
+			return lot, nil
+		}
+	}
+	return storage.IngredientLot{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) GetIngredientByUUID(context.Context, string) (storage.Ingredient, error) {
+	return storage.Ingredient{Name: "Citra"}, nil
+}
+
+func (m *mockScanStore) ListBeerLotsByLotCode(_ context.Context, code string) ([]storage.BeerLot, error) {
+	var lots []storage.BeerLot
+	for _, lot := range m.beerLots {
+		if lot.LotCode != nil && *lot.LotCode == code {
+			lots = append(lots, lot)
+		}
+	}
+	return lots, nil
+}
+
+func (m *mockScanStore) GetBeerLotByUUID(_ context.Context, lotUUID string) (storage.BeerLot, error) {
+	for _, lot := range m.beerLots {
+		if lot.UUID.String() == lotUUID {
+			return lot, nil
+		}
+	}
+	return storage.BeerLot{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) ListInventoryMovementsByBeerLot(context.Context, string) ([]storage.InventoryMovement, error) {
+	return m.movements, nil
+}
+
+func (m *mockScanStore) GetBeerLotItemByIdentifier(_ context.Context, identifier string) (storage.BeerLotItem, error) {
+	for _, item := range m.items {
+		if item.Identifier != nil && *item.Identifier == identifier {
+			return item, nil
+		}
+	}
+	return storage.BeerLotItem{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) GetBeerLotItemByUUID(_ context.Context, itemUUID string) (storage.BeerLotItem, error) {
+	for _, item := range m.items {
+		if item.UUID.String() == itemUUID {
+			return item, nil
+		}
+	}
+	return storage.BeerLotItem{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) ListKegs(_ context.Context, filter storage.KegListFilter) ([]storage.Keg, error) {
+	var kegs []storage.Keg
+	for _, keg := range m.kegs {
+		if filter.Serial == nil || keg.Serial == *filter.Serial {
+			kegs = append(kegs, keg)
+		}
+	}
+	return kegs, nil
+}
+
+func (m *mockScanStore) GetKegByUUID(_ context.Context, kegUUID string) (storage.Keg, error) {
+	for _, keg := range m.kegs {
+		if keg.UUID.String() == kegUUID {
+			return keg, nil
+		}
+	}
+	return storage.Keg{}, service.ErrNotFound
+}
+
+func scanInventory(t *testing.T, store *mockScanStore, code string) dto.ScanResponse {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/inventory-scan/x", nil)
+	req.SetPathValue("code", code)
+	rec := httptest.NewRecorder()
+	handler.HandleInventoryScan(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.ScanResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	return resp
+}
+
+func TestHandleInventoryScan(t *testing.T) {
+	t.Run("ingredient lot by originator lot code", func(t *testing.T) {
+		breweryCode, originatorCode := "IL-2026-020", "YCH-P21-4411"
+		poLine := uuid.Must(uuid.NewV4())
+		lot := storage.IngredientLot{BreweryLotCode: &breweryCode, OriginatorLotCode: &originatorCode, CurrentAmount: 20, PurchaseOrderLineUUID: &poLine}
+		lot.UUID = uuid.Must(uuid.NewV4())
+
+		resp := scanInventory(t, &mockScanStore{ingredientLots: []storage.IngredientLot{lot}}, originatorCode)
+
+		if len(resp.Matches) != 1 {
+			t.Fatalf("expected 1 match, got %+v", resp.Matches)
+		}
+		got := resp.Matches[0]
+		if got.EntityType != dto.ScanEntityIngredientLot || got.UUID != lot.UUID.String() || got.Name != "Citra" || got.MatchedOn != "originator_lot_code" {
+			t.Errorf("unexpected match %+v", got)
+		}
+		want := []string{"record_usage", "move", "adjust", "return_to_supplier", "print_label"}
+		if got.State != "in_stock" || !slices.Equal(got.Actions, want) {
+			t.Errorf("expected in_stock with %v, got %s with %v", want, got.State, got.Actions)
+		}
+	})
+
+	t.Run("expired lot cannot be used", func(t *testing.T) {
+		code := "IL-2025-101"
+		expired := time.Now().AddDate(0, -1, 0)
+		lot := storage.IngredientLot{BreweryLotCode: &code, CurrentAmount: 5, ExpiresAt: &expired}
+		lot.UUID = uuid.Must(uuid.NewV4())
+
+		resp := scanInventory(t, &mockScanStore{ingredientLots: []storage.IngredientLot{lot}}, code)
+
+		if got := resp.Matches[0]; got.State != "expired" || slices.Contains(got.Actions, "record_usage") {
+			t.Errorf("expected an expired lot without record_usage, got %+v", got)
+		}
+	})
+
+	t.Run("keg serial matches the keg and its item", func(t *testing.T) {
+		serial := "K-0042"
+		keg := storage.Keg{Serial: serial, Status: storage.KegStatusFilled}
+		keg.UUID = uuid.Must(uuid.NewV4())
+		item := storage.BeerLotItem{Identifier: &serial, Status: storage.BeerLotItemStatusSold}
+		item.UUID = uuid.Must(uuid.NewV4())
+
+		resp := scanInventory(t, &mockScanStore{items: []storage.BeerLotItem{item}, kegs: []storage.Keg{keg}}, serial)
+
+		if len(resp.Matches) != 2 {
+			t.Fatalf("expected 2 matches, got %+v", resp.Matches)
+		}
+		if got := resp.Matches[0]; got.EntityType != dto.ScanEntityBeerLotItem || !slices.Equal(got.Actions, []string{"mark_returned", "mark_damaged", "destroy"}) {
+			t.Errorf("unexpected item match %+v", got)
+		}
+		if got := resp.Matches[1]; got.EntityType != dto.ScanEntityKeg || !slices.Equal(got.Actions, []string{"ship", "move", "lost"}) {
+			t.Errorf("unexpected keg match %+v", got)
+		}
+	})
+
+	t.Run("refilled keg serial matches the keg's current item", func(t *testing.T) {
+		// Filled from lot B, cleaned, then filled from the older lot A: both
+		// items carry the serial and lot B's is listed first.
+		serial := "K-0042"
+		newer := storage.BeerLotItem{Identifier: &serial, BeerLotUUID: "lot-b", Status: storage.BeerLotItemStatusReturned}
+		newer.UUID = uuid.Must(uuid.NewV4())
+		older := storage.BeerLotItem{Identifier: &serial, BeerLotUUID: "lot-a", Status: storage.BeerLotItemStatusAvailable}
+		older.UUID = uuid.Must(uuid.NewV4())
+		current := older.UUID.String()
+		keg := storage.Keg{Serial: serial, Status: storage.KegStatusFilled, CurrentBeerLotItemUUID: &current}
+		keg.UUID = uuid.Must(uuid.NewV4())
+
+		resp := scanInventory(t, &mockScanStore{items: []storage.BeerLotItem{newer, older}, kegs: []storage.Keg{keg}}, serial)
+
+		if len(resp.Matches) != 2 {
+			t.Fatalf("expected 2 matches, got %+v", resp.Matches)
+		}
+		if got := resp.Matches[0]; got.EntityType != dto.ScanEntityBeerLotItem || got.UUID != current {
+			t.Errorf("expected lot A's item %s, got %+v", current, got)
+		}
+	})
+
+	t.Run("beer lot by uuid with no stock left", func(t *testing.T) {
+		code := "24-IPA-07-K"
+		lot := storage.BeerLot{LotCode: &code}
+		lot.UUID = uuid.Must(uuid.NewV4())
+		store := &mockScanStore{
+			beerLots: []storage.BeerLot{lot},
+			movements: []storage.InventoryMovement{
+				{Direction: "in", Amount: 12},
+				{Direction: "out", Amount: 12},
+			},
+		}
+
+		resp := scanInventory(t, store, lot.UUID.String())
+
+		if len(resp.Matches) != 1 {
+			t.Fatalf("expected 1 match, got %+v", resp.Matches)
+		}
+		if got := resp.Matches[0]; got.EntityType != dto.ScanEntityBeerLot || got.MatchedOn != "uuid" || got.State != "depleted" || slices.Contains(got.Actions, "remove") {
+			t.Errorf("unexpected beer lot match %+v", got)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		resp := scanInventory(t, &mockScanStore{}, "FV-3")
+
+		if resp.Matches == nil || len(resp.Matches) != 0 {
+			t.Errorf("expected an empty list, got %+v", resp.Matches)
+		}
+	})
+}
@@ -0,0 +1,324 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// SupplierReturnStore defines the storage methods needed by the supplier return handlers.
+type SupplierReturnStore interface {
+	GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error)
+	GetStockLocationByUUID(context.Context, string) (storage.StockLocation, error)
+	CreateSupplierReturnWithMovement(context.Context, storage.SupplierReturnRequest) (storage.SupplierReturn, error)
+	GetSupplierReturnByUUID(context.Context, string) (storage.SupplierReturn, error)
+	ListSupplierReturns(context.Context, storage.SupplierReturnFilter) ([]storage.SupplierReturn, error)
+	UpdateSupplierReturnCredit(context.Context, string, storage.SupplierReturnCreditUpdate) (storage.SupplierReturn, error)
+	RecordSupplierReturnSync(context.Context, string, error) (storage.SupplierReturn, error)
+}
+
+// SupplierReturnProcurement is the Procurement service API used by supplier
+// returns: the purchase order line cost for the default credit, and recording
+// the return against the line.
+type SupplierReturnProcurement interface {
+	BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error)
+	RecordPurchaseOrderLineReturn(ctx context.Context, lineUUID string, req PurchaseOrderLineReturnRequest) error
+}
+
+// HandleSupplierReturns handles [GET /supplier-returns] and [POST /supplier-returns].
+// Returns can be listed by ingredient_lot_uuid, purchase_order_line_uuid,
+// credit_status or purchase_order_sync_status. Creating a return takes the
+// goods out of stock with a return movement, then records the return against
+// the lot's purchase order line in Procurement. The outcome of that second
+// step is kept on the return as purchase_order_sync_status; a failed return
+// is retried with [POST /supplier-returns/{uuid}/sync].
+func HandleSupplierReturns(db SupplierReturnStore, procurement SupplierReturnProcurement) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var filter storage.SupplierReturnFilter
+			q := r.URL.Query()
+			for _, param := range []struct {
+				name string
+				dest **string
+			}{
+				{"ingredient_lot_uuid", &filter.IngredientLotUUID},
+				{"purchase_order_line_uuid", &filter.PurchaseOrderLineUUID},
+			} {
+				if v := q.Get(param.name); v != "" {
+					if _, err := uuid.FromString(v); err != nil {
+						http.Error(w, "invalid "+param.name, http.StatusBadRequest)
+						return
+					}
+					*param.dest = &v
+				}
+			}
+			if v := q.Get("credit_status"); v != "" {
+				filter.CreditStatus = &v
+			}
+			if v := q.Get("purchase_order_sync_status"); v != "" {
+				filter.PurchaseOrderSyncStatus = &v
+			}
+
+			returns, err := db.ListSupplierReturns(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing supplier returns", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierReturnsResponse(returns))
+		case http.MethodPost:
+			var req dto.CreateSupplierReturnRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			lot, ok := service.ResolveFK(r.Context(), w, req.IngredientLotUUID, "ingredient lot", db.GetIngredientLotByUUID)
+			if !ok {
+				return
+			}
+			if lot.PurchaseOrderLineUUID == nil {
+				http.Error(w, "ingredient lot was not received against a purchase order line", http.StatusBadRequest)
+				return
+			}
+			amountUnit := strings.TrimSpace(req.AmountUnit)
+			if amountUnit != lot.ReceivedUnit {
+				http.Error(w, "amount_unit must match the lot's received unit ("+lot.ReceivedUnit+")", http.StatusBadRequest)
+				return
+			}
+
+			location, ok := service.ResolveFK(r.Context(), w, req.StockLocationUUID, "stock location", db.GetStockLocationByUUID)
+			if !ok {
+				return
+			}
+
+			lineUUID := lot.PurchaseOrderLineUUID.String()
+
+			creditAmount, creditCurrency := req.CreditAmountCents, req.CreditCurrency
+			if creditCurrency != nil {
+				value := strings.ToUpper(strings.TrimSpace(*creditCurrency))
+				creditCurrency = &value
+			}
+			if creditAmount == nil {
+				creditAmount, creditCurrency = defaultReturnCredit(r.Context(), procurement, lineUUID, req.Amount, amountUnit)
+			}
+
+			returnedAt := time.Time{}
+			if req.ReturnedAt != nil {
+				returnedAt = *req.ReturnedAt
+			}
+
+			created, err := db.CreateSupplierReturnWithMovement(r.Context(), storage.SupplierReturnRequest{
+				IngredientLotID:       lot.ID,
+				StockLocationID:       location.ID,
+				PurchaseOrderLineUUID: lineUUID,
+				Amount:                req.Amount,
+				AmountUnit:            amountUnit,
+				Reason:                req.Reason,
+				ReferenceCode:         req.ReferenceCode,
+				ReturnedAt:            returnedAt,
+				CreditAmountCents:     creditAmount,
+				CreditCurrency:        creditCurrency,
+				Notes:                 req.Notes,
+			})
+			var insufficient *storage.ErrInsufficientReturnStock
+			if errors.As(err, &insufficient) {
+				http.Error(w, insufficient.Message, http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating supplier return", "error", err)
+				return
+			}
+
+			slog.Info("supplier return recorded",
+				"supplier_return_uuid", created.UUID,
+				"ingredient_lot_uuid", created.IngredientLotUUID,
+				"amount", created.Amount,
+				"amount_unit", created.AmountUnit)
+
+			// The return stands whether or not Procurement is updated; a
+			// failure is recorded on it to be retried.
+			synced, err := syncSupplierReturn(r.Context(), db, procurement, created)
+			if err != nil {
+				slog.Error("error recording supplier return sync",
+					"supplier_return_uuid", created.UUID,
+					"error", err)
+			} else {
+				created = synced
+			}
+
+			service.JSONCreated(w, dto.NewSupplierReturnResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleSupplierReturnSync handles [POST /supplier-returns/{uuid}/sync]. It
+// records a pending or failed return against its purchase order line in
+// Procurement again and returns the return with the outcome. Procurement
+// records each return once, so retrying a return it already has is safe; a
+// synced return is returned as is.
+func HandleSupplierReturnSync(db SupplierReturnStore, procurement SupplierReturnProcurement) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		returnUUID := r.PathValue("uuid")
+		if returnUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		sr, err := db.GetSupplierReturnByUUID(r.Context(), returnUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "supplier return not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting supplier return", "error", err)
+			return
+		}
+
+		if sr.PurchaseOrderSyncStatus != storage.PurchaseOrderSyncSynced {
+			sr, err = syncSupplierReturn(r.Context(), db, procurement, sr)
+			if err != nil {
+				service.InternalError(w, "error recording supplier return sync", "error", err, "supplier_return_uuid", returnUUID)
+				return
+			}
+		}
+
+		service.JSON(w, dto.NewSupplierReturnResponse(sr))
+	}
+}
+
+// syncSupplierReturn records a supplier return against its purchase order
+// line in Procurement, keyed by the return UUID, and stores the outcome on
+// the return. The returned error is only for failing to store the outcome.
+func syncSupplierReturn(ctx context.Context, db SupplierReturnStore, procurement SupplierReturnProcurement, sr storage.SupplierReturn) (storage.SupplierReturn, error) {
+	syncErr := procurement.RecordPurchaseOrderLineReturn(ctx, sr.PurchaseOrderLineUUID, PurchaseOrderLineReturnRequest{
+		ReturnUUID:   sr.UUID.String(),
+		Quantity:     sr.Amount,
+		QuantityUnit: sr.AmountUnit,
+	})
+	if syncErr != nil {
+		slog.Warn("failed to record supplier return against purchase order line",
+			"supplier_return_uuid", sr.UUID,
+			"purchase_order_line_uuid", sr.PurchaseOrderLineUUID,
+			"error", syncErr)
+	}
+	return db.RecordSupplierReturnSync(ctx, sr.UUID.String(), syncErr)
+}
+
+// defaultReturnCredit values a return at its purchase order line's unit cost.
+// It returns nil when the line cannot be looked up or is in another unit.
+func defaultReturnCredit(ctx context.Context, procurement SupplierReturnProcurement, lineUUID string, amount int64, unit string) (*int64, *string) {
+	lines, err := procurement.BatchLookupPOLines(ctx, []string{lineUUID})
+	if err != nil {
+		slog.Warn("failed to look up purchase order line for return credit",
+			"purchase_order_line_uuid", lineUUID,
+			"error", err)
+		return nil, nil
+	}
+	for _, line := range lines {
+		if line.UUID == lineUUID && line.QuantityUnit == unit {
+			credit := amount * line.UnitCostCents
+			currency := line.Currency
+			return &credit, &currency
+		}
+	}
+	return nil, nil
+}
+
+// HandleSupplierReturnByUUID handles [GET /supplier-returns/{uuid}] and [PATCH /supplier-returns/{uuid}].
+func HandleSupplierReturnByUUID(db SupplierReturnStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		returnUUID := r.PathValue("uuid")
+		if returnUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			sr, err := db.GetSupplierReturnByUUID(r.Context(), returnUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier return not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting supplier return", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierReturnResponse(sr))
+		case http.MethodPatch:
+			var req dto.UpdateSupplierReturnRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			existing, err := db.GetSupplierReturnByUUID(r.Context(), returnUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier return not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting supplier return", "error", err)
+				return
+			}
+			if req.CreditAmountCents != nil && req.CreditCurrency == nil && existing.CreditCurrency == nil {
+				http.Error(w, "credit_currency is required when credit_amount_cents is provided", http.StatusBadRequest)
+				return
+			}
+
+			update := storage.SupplierReturnCreditUpdate{
+				CreditAmountCents: req.CreditAmountCents,
+				CreditStatus:      req.CreditStatus,
+				ReferenceCode:     req.ReferenceCode,
+				Notes:             req.Notes,
+			}
+			if req.CreditCurrency != nil {
+				value := strings.ToUpper(strings.TrimSpace(*req.CreditCurrency))
+				update.CreditCurrency = &value
+			}
+
+			sr, err := db.UpdateSupplierReturnCredit(r.Context(), returnUUID, update)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier return not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating supplier return", "error", err)
+				return
+			}
+
+			if sr.CreditStatus != existing.CreditStatus {
+				slog.Info("supplier return credit status changed",
+					"supplier_return_uuid", returnUUID,
+					"credit_status", sr.CreditStatus)
+			}
+
+			service.JSON(w, dto.NewSupplierReturnResponse(sr))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
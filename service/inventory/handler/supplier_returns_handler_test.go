@@ -0,0 +1,322 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+	"github.com/brewpipes/brewpipes/service/inventory/handler/dto"
+	"github.com/brewpipes/brewpipes/service/inventory/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockSupplierReturnStore implements handler.SupplierReturnStore for testing.
+type mockSupplierReturnStore struct {
+	lot       storage.IngredientLot
+	available int64
+	created   []storage.SupplierReturnRequest
+	returns   map[string]storage.SupplierReturn
+}
+
+func (m *mockSupplierReturnStore) GetIngredientLotByUUID(context.Context, string) (storage.IngredientLot, error) {
+	return m.lot, nil
+}
+
+func (m *mockSupplierReturnStore) GetStockLocationByUUID(_ context.Context, locationUUID string) (storage.StockLocation, error) {
+	var location storage.StockLocation
+	location.ID = 7
+	location.UUID = uuid.Must(uuid.FromString(locationUUID))
+	return location, nil
+}
+
+func (m *mockSupplierReturnStore) CreateSupplierReturnWithMovement(_ context.Context, req storage.SupplierReturnRequest) (storage.SupplierReturn, error) {
+	if req.Amount > m.available {
+		return storage.SupplierReturn{}, &storage.ErrInsufficientReturnStock{Message: "insufficient stock to return"}
+	}
+	m.created = append(m.created, req)
+	sr := storage.SupplierReturn{
+		IngredientLotID:         req.IngredientLotID,
+		IngredientLotUUID:       m.lot.UUID.String(),
+		PurchaseOrderLineUUID:   req.PurchaseOrderLineUUID,
+		Amount:                  req.Amount,
+		AmountUnit:              req.AmountUnit,
+		Reason:                  req.Reason,
+		CreditAmountCents:       req.CreditAmountCents,
+		CreditCurrency:          req.CreditCurrency,
+		CreditStatus:            storage.CreditStatusRequested,
+		PurchaseOrderSyncStatus: storage.PurchaseOrderSyncPending,
+	}
+	sr.UUID = uuid.Must(uuid.NewV4())
+	if m.returns == nil {
+		m.returns = make(map[string]storage.SupplierReturn)
+	}
+	m.returns[sr.UUID.String()] = sr
+	return sr, nil
+}
+
+func (m *mockSupplierReturnStore) GetSupplierReturnByUUID(_ context.Context, returnUUID string) (storage.SupplierReturn, error) {
+	sr, ok := m.returns[returnUUID]
+	if !ok {
+		return storage.SupplierReturn{}, service.ErrNotFound
+	}
+	return sr, nil
+}
+
+func (m *mockSupplierReturnStore) ListSupplierReturns(context.Context, storage.SupplierReturnFilter) ([]storage.SupplierReturn, error) {
+	return nil, nil
+}
+
+func (m *mockSupplierReturnStore) UpdateSupplierReturnCredit(context.Context, string, storage.SupplierReturnCreditUpdate) (storage.SupplierReturn, error) {
+	return storage.SupplierReturn{}, service.ErrNotFound
+}
+
+func (m *mockSupplierReturnStore) RecordSupplierReturnSync(_ context.Context, returnUUID string, syncErr error) (storage.SupplierReturn, error) {
+	sr, ok := m.returns[returnUUID]
+	if !ok {
+		return storage.SupplierReturn{}, service.ErrNotFound
+	}
+	sr.PurchaseOrderSyncStatus, sr.PurchaseOrderSyncError = storage.PurchaseOrderSyncSynced, nil
+	if syncErr != nil {
+		message := syncErr.Error()
+		sr.PurchaseOrderSyncStatus, sr.PurchaseOrderSyncError = storage.PurchaseOrderSyncFailed, &message
+	}
+	m.returns[returnUUID] = sr
+	return sr, nil
+}
+
+// mockReturnProcurement implements handler.SupplierReturnProcurement for testing.
+type mockReturnProcurement struct {
+	lines     []handler.PurchaseOrderLineCost
+	recordErr error
+	recorded  []handler.PurchaseOrderLineReturnRequest
+}
+
+func (m *mockReturnProcurement) BatchLookupPOLines(context.Context, []string) ([]handler.PurchaseOrderLineCost, error) {
+	return m.lines, nil
+}
+
+func (m *mockReturnProcurement) RecordPurchaseOrderLineReturn(_ context.Context, _ string, req handler.PurchaseOrderLineReturnRequest) error {
+	m.recorded = append(m.recorded, req)
+	return m.recordErr
+}
+
+func TestHandleSupplierReturnsCreate(t *testing.T) {
+	locationUUID := "880e8400-e29b-41d4-a716-446655440009"
+	lotUUID := "880e8400-e29b-41d4-a716-446655440003"
+	lineUUID := uuid.Must(uuid.FromString("880e8400-e29b-41d4-a716-446655440004"))
+
+	// A 100 kg malt lot received against a purchase order line at $1.50/kg.
+	lot := storage.IngredientLot{PurchaseOrderLineUUID: &lineUUID, ReceivedAmount: 100, ReceivedUnit: "kg"}
+	lot.ID = 3
+	lot.UUID = uuid.Must(uuid.FromString(lotUUID))
+	lotWithoutLine := lot
+	lotWithoutLine.PurchaseOrderLineUUID = nil
+	maltLine := handler.PurchaseOrderLineCost{UUID: lineUUID.String(), UnitCostCents: 150, Quantity: 100, QuantityUnit: "kg", Currency: "USD"}
+
+	tests := []struct {
+		name           string
+		store          *mockSupplierReturnStore
+		proc           *mockReturnProcurement
+		body           string
+		expectedStatus int
+		validate       func(t *testing.T, store *mockSupplierReturnStore, proc *mockReturnProcurement, resp dto.SupplierReturnResponse)
+	}{
+		{
+			name:           "defaults credit to purchase order line cost",
+			store:          &mockSupplierReturnStore{lot: lot, available: 40},
+			proc:           &mockReturnProcurement{lines: []handler.PurchaseOrderLineCost{maltLine}},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":25,"amount_unit":"kg","reason":"damaged"}`,
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockSupplierReturnStore, proc *mockReturnProcurement, resp dto.SupplierReturnResponse) {
+				if resp.CreditAmountCents == nil || *resp.CreditAmountCents != 3750 || resp.CreditCurrency == nil || *resp.CreditCurrency != "USD" {
+					t.Errorf("expected default credit of 3750 USD, got %v %v", resp.CreditAmountCents, resp.CreditCurrency)
+				}
+				if resp.CreditStatus != storage.CreditStatusRequested {
+					t.Errorf("expected credit status requested, got %s", resp.CreditStatus)
+				}
+				if resp.PurchaseOrderSyncStatus != storage.PurchaseOrderSyncSynced {
+					t.Errorf("expected purchase order sync status synced, got %s", resp.PurchaseOrderSyncStatus)
+				}
+				if len(proc.recorded) != 1 || proc.recorded[0].ReturnUUID != resp.UUID || proc.recorded[0].Quantity != 25 || proc.recorded[0].QuantityUnit != "kg" {
+					t.Errorf("expected return %s of 25 kg recorded against the line, got %+v", resp.UUID, proc.recorded)
+				}
+				if len(store.created) != 1 || store.created[0].StockLocationID != 7 {
+					t.Errorf("expected return created at location 7, got %+v", store.created)
+				}
+			},
+		},
+		{
+			name:           "explicit credit is kept",
+			store:          &mockSupplierReturnStore{lot: lot, available: 40},
+			proc:           &mockReturnProcurement{lines: []handler.PurchaseOrderLineCost{maltLine}},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":10,"amount_unit":"kg","reason":"damaged","credit_amount_cents":1000,"credit_currency":"usd"}`,
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockSupplierReturnStore, _ *mockReturnProcurement, _ dto.SupplierReturnResponse) {
+				if got := store.created[0]; *got.CreditAmountCents != 1000 || *got.CreditCurrency != "USD" {
+					t.Errorf("expected credit of 1000 USD, got %d %s", *got.CreditAmountCents, *got.CreditCurrency)
+				}
+			},
+		},
+		{
+			name:           "insufficient stock",
+			store:          &mockSupplierReturnStore{lot: lot, available: 40},
+			proc:           &mockReturnProcurement{lines: []handler.PurchaseOrderLineCost{maltLine}},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":50,"amount_unit":"kg","reason":"damaged"}`,
+			expectedStatus: http.StatusConflict,
+			validate: func(t *testing.T, _ *mockSupplierReturnStore, proc *mockReturnProcurement, _ dto.SupplierReturnResponse) {
+				if len(proc.recorded) != 0 {
+					t.Error("expected nothing recorded against the purchase order line")
+				}
+			},
+		},
+		{
+			name:           "procurement failure keeps the return and records the failure",
+			store:          &mockSupplierReturnStore{lot: lot, available: 40},
+			proc:           &mockReturnProcurement{lines: []handler.PurchaseOrderLineCost{maltLine}, recordErr: errors.New("procurement unavailable")},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":25,"amount_unit":"kg","reason":"damaged"}`,
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, store *mockSupplierReturnStore, _ *mockReturnProcurement, resp dto.SupplierReturnResponse) {
+				if resp.PurchaseOrderSyncStatus != storage.PurchaseOrderSyncFailed || resp.PurchaseOrderSyncError == nil || *resp.PurchaseOrderSyncError != "procurement unavailable" {
+					t.Errorf("expected a failed sync with its error, got %s %v", resp.PurchaseOrderSyncStatus, resp.PurchaseOrderSyncError)
+				}
+				if got := store.returns[resp.UUID]; got.PurchaseOrderSyncStatus != storage.PurchaseOrderSyncFailed {
+					t.Errorf("expected the failure stored on the return, got %s", got.PurchaseOrderSyncStatus)
+				}
+			},
+		},
+		{
+			name:           "lot without purchase order line",
+			store:          &mockSupplierReturnStore{lot: lotWithoutLine, available: 40},
+			proc:           &mockReturnProcurement{},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":25,"amount_unit":"kg","reason":"damaged"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unit must match the lot",
+			store:          &mockSupplierReturnStore{lot: lot, available: 40},
+			proc:           &mockReturnProcurement{lines: []handler.PurchaseOrderLineCost{maltLine}},
+			body:           `{"ingredient_lot_uuid":"` + lotUUID + `","stock_location_uuid":"` + locationUUID + `","amount":25,"amount_unit":"lb","reason":"damaged"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/supplier-returns", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleSupplierReturns(tt.store, tt.proc).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.SupplierReturnResponse
+			if rec.Code == http.StatusCreated {
+				if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+					t.Fatalf("decoding response: %v", err)
+				}
+			}
+			tt.validate(t, tt.store, tt.proc, resp)
+		})
+	}
+}
+
+func TestHandleSupplierReturnSync(t *testing.T) {
+	returnUUID := "880e8400-e29b-41d4-a716-446655440005"
+	lineUUID := "880e8400-e29b-41d4-a716-446655440004"
+
+	failedMessage := "procurement unavailable"
+	failed := storage.SupplierReturn{
+		PurchaseOrderLineUUID:   lineUUID,
+		Amount:                  25,
+		AmountUnit:              "kg",
+		PurchaseOrderSyncStatus: storage.PurchaseOrderSyncFailed,
+		PurchaseOrderSyncError:  &failedMessage,
+	}
+	failed.UUID = uuid.Must(uuid.FromString(returnUUID))
+	synced := failed
+	synced.PurchaseOrderSyncStatus, synced.PurchaseOrderSyncError = storage.PurchaseOrderSyncSynced, nil
+
+	tests := []struct {
+		name           string
+		existing       storage.SupplierReturn
+		recordErr      error
+		expectedStatus int
+		expectedSync   string
+		expectedCalls  int
+	}{
+		{
+			name:           "failed return is recorded again",
+			existing:       failed,
+			expectedStatus: http.StatusOK,
+			expectedSync:   storage.PurchaseOrderSyncSynced,
+			expectedCalls:  1,
+		},
+		{
+			name:           "procurement still failing keeps the return failed",
+			existing:       failed,
+			recordErr:      errors.New("procurement unavailable"),
+			expectedStatus: http.StatusOK,
+			expectedSync:   storage.PurchaseOrderSyncFailed,
+			expectedCalls:  1,
+		},
+		{
+			name:           "synced return is not sent again",
+			existing:       synced,
+			expectedStatus: http.StatusOK,
+			expectedSync:   storage.PurchaseOrderSyncSynced,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockSupplierReturnStore{returns: map[string]storage.SupplierReturn{returnUUID: tt.existing}}
+			proc := &mockReturnProcurement{recordErr: tt.recordErr}
+
+			req := httptest.NewRequest(http.MethodPost, "/supplier-returns/"+returnUUID+"/sync", nil)
+			req.SetPathValue("uuid", returnUUID)
+			rec := httptest.NewRecorder()
+
+			handler.HandleSupplierReturnSync(store, proc).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			var resp dto.SupplierReturnResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			if resp.PurchaseOrderSyncStatus != tt.expectedSync {
+				t.Errorf("expected purchase order sync status %s, got %s", tt.expectedSync, resp.PurchaseOrderSyncStatus)
+			}
+			if len(proc.recorded) != tt.expectedCalls {
+				t.Fatalf("expected %d procurement calls, got %d", tt.expectedCalls, len(proc.recorded))
+			}
+			if tt.expectedCalls > 0 && (proc.recorded[0].ReturnUUID != returnUUID || proc.recorded[0].Quantity != 25) {
+				t.Errorf("expected return %s of 25 recorded, got %+v", returnUUID, proc.recorded[0])
+			}
+		})
+	}
+
+	t.Run("unknown return", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/supplier-returns/"+returnUUID+"/sync", nil)
+		req.SetPathValue("uuid", returnUUID)
+		rec := httptest.NewRecorder()
+
+		handler.HandleSupplierReturnSync(&mockSupplierReturnStore{}, &mockReturnProcurement{}).ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/inventory/handler"
@@ -14,77 +15,169 @@ import (
 type Config struct {
 	PostgresDSN string
 	SecretKey   string
+	// ServiceSecret is the client secret the service presents to identity
+	// for the service tokens it calls other services with.
+	ServiceSecret string
 }
 
 type Service struct {
-	storage   *storage.Client
-	secretKey string
+	storage           *storage.Client
+	secretKey         string
+	serviceSecret     string
+	procurementClient *handler.ProcurementClient
 }
 
 // New creates and initializes a new inventory service instance.
 func New(cfg Config) *Service {
+	procurementURL := os.Getenv("PROCUREMENT_API_URL")
+	if procurementURL == "" {
+		procurementURL = "http://localhost:8080/api"
+	}
+	slog.Info("procurement client configured", "procurement_api_url", procurementURL)
+
+	identityURL := os.Getenv("IDENTITY_API_URL")
+	if identityURL == "" {
+		identityURL = "http://localhost:8080/api"
+	}
+	slog.Info("identity client configured", "identity_api_url", identityURL)
+	tokens := service.NewServiceTokenSource(identityURL, "inventory", cfg.ServiceSecret)
+
 	return &Service{
-		storage:   storage.New(cfg.PostgresDSN),
-		secretKey: cfg.SecretKey,
+		storage:           storage.New(cfg.PostgresDSN),
+		secretKey:         cfg.SecretKey,
+		serviceSecret:     cfg.ServiceSecret,
+		procurementClient: handler.NewProcurementClient(procurementURL, tokens),
 	}
 }
 
+// Storage returns the service's storage client so that other services
+// running in the same process can call it directly. It is connected once
+// Start has run.
+func (s *Service) Storage() *storage.Client {
+	return s.storage
+}
+
 func (s *Service) HTTPRoutes() []service.HTTPRoute {
 	auth := service.RequireAccessToken(s.secretKey)
-	return []service.HTTPRoute{
-		{Method: http.MethodGet, Path: "/ingredients", Handler: auth(handler.HandleIngredients(s.storage))},
-		{Method: http.MethodPost, Path: "/ingredients", Handler: auth(handler.HandleIngredients(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredients/{uuid}", Handler: auth(handler.HandleIngredientByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/stock-locations", Handler: auth(handler.HandleStockLocations(s.storage))},
-		{Method: http.MethodPost, Path: "/stock-locations", Handler: auth(handler.HandleStockLocations(s.storage))},
-		{Method: http.MethodGet, Path: "/stock-locations/{uuid}", Handler: auth(handler.HandleStockLocationByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/stock-locations/{uuid}", Handler: auth(handler.HandleStockLocationByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/stock-locations/{uuid}", Handler: auth(handler.HandleStockLocationByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-receipts", Handler: auth(handler.HandleInventoryReceipts(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-receipts", Handler: auth(handler.HandleInventoryReceipts(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-receipts/{uuid}", Handler: auth(handler.HandleInventoryReceiptByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lots/batch", Handler: auth(handler.HandleBatchIngredientLots(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lots", Handler: auth(handler.HandleIngredientLots(s.storage))},
-		{Method: http.MethodPost, Path: "/ingredient-lots", Handler: auth(handler.HandleIngredientLots(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lots/{uuid}", Handler: auth(handler.HandleIngredientLotByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-movements", Handler: auth(handler.HandleInventoryMovements(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-movements", Handler: auth(handler.HandleInventoryMovements(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-movements/{uuid}", Handler: auth(handler.HandleInventoryMovementByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-usage", Handler: auth(handler.HandleInventoryUsage(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-usage", Handler: auth(handler.HandleInventoryUsage(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-usage/batch", Handler: auth(handler.HandleCreateBatchUsage(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-usage/{uuid}", Handler: auth(handler.HandleInventoryUsageByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-adjustments", Handler: auth(handler.HandleInventoryAdjustments(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-adjustments", Handler: auth(handler.HandleInventoryAdjustments(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-adjustments/{uuid}", Handler: auth(handler.HandleInventoryAdjustmentByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-transfers", Handler: auth(handler.HandleInventoryTransfers(s.storage))},
-		{Method: http.MethodPost, Path: "/inventory-transfers", Handler: auth(handler.HandleInventoryTransfers(s.storage))},
-		{Method: http.MethodGet, Path: "/inventory-transfers/{uuid}", Handler: auth(handler.HandleInventoryTransferByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/beer-lots", Handler: auth(handler.HandleBeerLots(s.storage))},
-		{Method: http.MethodPost, Path: "/beer-lots", Handler: auth(handler.HandleBeerLots(s.storage))},
-		{Method: http.MethodGet, Path: "/beer-lots/{uuid}", Handler: auth(handler.HandleBeerLotByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/stock-levels", Handler: auth(handler.HandleStockLevels(s.storage))},
-		{Method: http.MethodGet, Path: "/beer-lot-stock-levels", Handler: auth(handler.HandleBeerLotStockLevels(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-stock-levels", Handler: auth(handler.HandleIngredientLotStockLevels(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-malt-details", Handler: auth(handler.HandleIngredientLotMaltDetails(s.storage))},
-		{Method: http.MethodPost, Path: "/ingredient-lot-malt-details", Handler: auth(handler.HandleIngredientLotMaltDetails(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-malt-details/{uuid}", Handler: auth(handler.HandleIngredientLotMaltDetailByUUID(s.storage))},
-		{Method: http.MethodPut, Path: "/ingredient-lot-malt-details/{uuid}", Handler: auth(handler.HandleIngredientLotMaltDetailByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-hop-details", Handler: auth(handler.HandleIngredientLotHopDetails(s.storage))},
-		{Method: http.MethodPost, Path: "/ingredient-lot-hop-details", Handler: auth(handler.HandleIngredientLotHopDetails(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-hop-details/{uuid}", Handler: auth(handler.HandleIngredientLotHopDetailByUUID(s.storage))},
-		{Method: http.MethodPut, Path: "/ingredient-lot-hop-details/{uuid}", Handler: auth(handler.HandleIngredientLotHopDetailByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-yeast-details", Handler: auth(handler.HandleIngredientLotYeastDetails(s.storage))},
-		{Method: http.MethodPost, Path: "/ingredient-lot-yeast-details", Handler: auth(handler.HandleIngredientLotYeastDetails(s.storage))},
-		{Method: http.MethodGet, Path: "/ingredient-lot-yeast-details/{uuid}", Handler: auth(handler.HandleIngredientLotYeastDetailByUUID(s.storage))},
-		{Method: http.MethodPut, Path: "/ingredient-lot-yeast-details/{uuid}", Handler: auth(handler.HandleIngredientLotYeastDetailByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/removals", Handler: auth(handler.HandleRemovals(s.storage))},
-		{Method: http.MethodPost, Path: "/removals", Handler: auth(handler.HandleRemovals(s.storage))},
-		{Method: http.MethodGet, Path: "/removals/{uuid}", Handler: auth(handler.HandleRemovalByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/removals/{uuid}", Handler: auth(handler.HandleRemovalByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/removals/{uuid}", Handler: auth(handler.HandleRemovalByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/removal-summary", Handler: auth(handler.HandleRemovalSummary(s.storage))},
-	}
+	// Routes the other services call also accept their service tokens.
+	fromProduction := service.RequireAccessTokenOrService(s.secretKey, "production")
+	fromProductionOrProcurement := service.RequireAccessTokenOrService(s.secretKey, "production", "procurement")
+	return handler.API.Routes([]service.HTTPRoute{
+		{Method: http.MethodGet, Path: "/ingredients", Auth: fromProductionOrProcurement, Handler: handler.HandleIngredients(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredients", Auth: auth, Handler: handler.HandleIngredients(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredients/import", Auth: auth, Handler: handler.HandleIngredientImport(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredients/{uuid}", Auth: auth, Handler: handler.HandleIngredientByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/stock-locations", Auth: auth, Handler: handler.HandleStockLocations(s.storage)},
+		{Method: http.MethodPost, Path: "/stock-locations", Auth: auth, Handler: handler.HandleStockLocations(s.storage)},
+		{Method: http.MethodGet, Path: "/stock-locations/{uuid}", Auth: auth, Handler: handler.HandleStockLocationByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/stock-locations/{uuid}", Auth: auth, Handler: handler.HandleStockLocationByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/stock-locations/{uuid}", Auth: auth, Handler: handler.HandleStockLocationByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-receipts", Auth: auth, Handler: handler.HandleInventoryReceipts(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-receipts", Auth: auth, Handler: handler.HandleInventoryReceipts(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-receipts/{uuid}", Auth: auth, Handler: handler.HandleInventoryReceiptByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lots/batch", Auth: fromProduction, Handler: handler.HandleBatchIngredientLots(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lots", Auth: fromProductionOrProcurement, Handler: handler.HandleIngredientLots(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-lots", Auth: auth, Handler: handler.HandleIngredientLots(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-lots/import", Auth: auth, Handler: handler.HandleIngredientLotImport(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lots/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lots/{uuid}/label", Auth: auth, Handler: handler.HandleIngredientLotLabel(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-movements", Auth: auth, Handler: handler.HandleInventoryMovements(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-movements", Auth: auth, Handler: handler.HandleInventoryMovements(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-movements/{uuid}", Auth: auth, Handler: handler.HandleInventoryMovementByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-usage", Auth: auth, Handler: handler.HandleInventoryUsage(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-usage", Auth: auth, Handler: handler.HandleInventoryUsage(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-usage/batch", Auth: fromProduction, Handler: handler.HandleBatchUsageMovements(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-usage/batch", Auth: fromProduction, Handler: handler.HandleCreateBatchUsage(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-usage/{uuid}", Auth: auth, Handler: handler.HandleInventoryUsageByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-reservations", Auth: fromProduction, Handler: handler.HandleInventoryReservations(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-reservations", Auth: auth, Handler: handler.HandleInventoryReservations(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-reservations/release", Auth: fromProduction, Handler: handler.HandleReleaseInventoryReservations(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-reservations/{uuid}", Auth: auth, Handler: handler.HandleInventoryReservationByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/inventory-reservations/{uuid}", Auth: auth, Handler: handler.HandleInventoryReservationByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-reorder-policies", Auth: fromProduction, Handler: handler.HandleReorderPolicies(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-reorder-policies", Auth: auth, Handler: handler.HandleReorderPolicies(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-reorder-policies/{uuid}", Auth: auth, Handler: handler.HandleReorderPolicyByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/ingredient-reorder-policies/{uuid}", Auth: auth, Handler: handler.HandleReorderPolicyByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/ingredient-reorder-policies/{uuid}", Auth: auth, Handler: handler.HandleReorderPolicyByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/replenishment-suggestions", Auth: auth, Handler: handler.HandleReplenishmentSuggestions(s.storage, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/replenishment-suggestions/purchase-orders", Auth: auth, Handler: handler.HandleReplenishmentPurchaseOrders(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/inventory-valuation", Auth: auth, Handler: handler.HandleInventoryValuation(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/inventory-valuation/consumption", Auth: auth, Handler: handler.HandleInventoryConsumption(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/inventory-valuation/settings", Auth: auth, Handler: handler.HandleInventoryValuationSettings(s.storage)},
+		{Method: http.MethodPut, Path: "/inventory-valuation/settings", Auth: auth, Handler: handler.HandleInventoryValuationSettings(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-adjustments", Auth: auth, Handler: handler.HandleInventoryAdjustments(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-adjustments", Auth: auth, Handler: handler.HandleInventoryAdjustments(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-adjustments/{uuid}", Auth: auth, Handler: handler.HandleInventoryAdjustmentByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-transfers", Auth: auth, Handler: handler.HandleInventoryTransfers(s.storage)},
+		{Method: http.MethodPost, Path: "/inventory-transfers", Auth: auth, Handler: handler.HandleInventoryTransfers(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-transfers/{uuid}", Auth: auth, Handler: handler.HandleInventoryTransferByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-returns", Auth: auth, Handler: handler.HandleSupplierReturns(s.storage, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/supplier-returns", Auth: auth, Handler: handler.HandleSupplierReturns(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/supplier-returns/{uuid}", Auth: auth, Handler: handler.HandleSupplierReturnByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/supplier-returns/{uuid}", Auth: auth, Handler: handler.HandleSupplierReturnByUUID(s.storage)},
+		{Method: http.MethodPost, Path: "/supplier-returns/{uuid}/sync", Auth: auth, Handler: handler.HandleSupplierReturnSync(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/cycle-counts", Auth: auth, Handler: handler.HandleCycleCounts(s.storage)},
+		{Method: http.MethodPost, Path: "/cycle-counts", Auth: auth, Handler: handler.HandleCycleCounts(s.storage)},
+		{Method: http.MethodGet, Path: "/cycle-counts/{uuid}", Auth: auth, Handler: handler.HandleCycleCountByUUID(s.storage, s.procurementClient)},
+		{Method: http.MethodPut, Path: "/cycle-counts/{uuid}/counts", Auth: auth, Handler: handler.HandleCycleCountEntries(s.storage, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/cycle-counts/{uuid}/post", Auth: auth, Handler: handler.HandleCycleCountPost(s.storage, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/cycle-counts/{uuid}/cancel", Auth: auth, Handler: handler.HandleCycleCountCancel(s.storage)},
+		{Method: http.MethodGet, Path: "/cycle-count-reports/accuracy", Auth: auth, Handler: handler.HandleCycleCountAccuracy(s.storage)},
+		{Method: http.MethodGet, Path: "/label-templates", Auth: fromProduction, Handler: handler.HandleLabelTemplates(s.storage)},
+		{Method: http.MethodPost, Path: "/label-templates", Auth: auth, Handler: handler.HandleLabelTemplates(s.storage)},
+		{Method: http.MethodGet, Path: "/label-templates/{uuid}", Auth: auth, Handler: handler.HandleLabelTemplateByUUID(s.storage)},
+		{Method: http.MethodPut, Path: "/label-templates/{uuid}", Auth: auth, Handler: handler.HandleLabelTemplateByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/label-templates/{uuid}", Auth: auth, Handler: handler.HandleLabelTemplateByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/inventory-scan/{code}", Auth: fromProduction, Handler: handler.HandleInventoryScan(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lots", Auth: auth, Handler: handler.HandleBeerLots(s.storage)},
+		{Method: http.MethodPost, Path: "/beer-lots", Auth: fromProduction, Handler: handler.HandleBeerLots(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lots/{uuid}", Auth: auth, Handler: handler.HandleBeerLotByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lots/{uuid}/label", Auth: auth, Handler: handler.HandleBeerLotLabel(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lot-items", Auth: auth, Handler: handler.HandleBeerLotItems(s.storage)},
+		{Method: http.MethodPost, Path: "/beer-lot-items", Auth: auth, Handler: handler.HandleBeerLotItems(s.storage)},
+		{Method: http.MethodPost, Path: "/beer-lot-items/scan", Auth: auth, Handler: handler.HandleBeerLotItemScan(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lot-items/{uuid}", Auth: auth, Handler: handler.HandleBeerLotItemByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/beer-lot-items/{uuid}", Auth: auth, Handler: handler.HandleBeerLotItemByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/beer-lot-items/{uuid}", Auth: auth, Handler: handler.HandleBeerLotItemByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/beer-lot-items/{uuid}/status", Auth: auth, Handler: handler.HandleBeerLotItemStatus(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lot-items/{uuid}/history", Auth: auth, Handler: handler.HandleBeerLotItemHistory(s.storage)},
+		{Method: http.MethodGet, Path: "/kegs", Auth: auth, Handler: handler.HandleKegs(s.storage)},
+		{Method: http.MethodPost, Path: "/kegs", Auth: auth, Handler: handler.HandleKegs(s.storage)},
+		{Method: http.MethodGet, Path: "/kegs/{uuid}", Auth: auth, Handler: handler.HandleKegByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/kegs/{uuid}", Auth: auth, Handler: handler.HandleKegByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/kegs/{uuid}", Auth: auth, Handler: handler.HandleKegByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/kegs/{uuid}/events", Auth: auth, Handler: handler.HandleKegEvents(s.storage)},
+		{Method: http.MethodPost, Path: "/kegs/{uuid}/events", Auth: auth, Handler: handler.HandleKegEvents(s.storage)},
+		{Method: http.MethodGet, Path: "/keg-reports/turn-time", Auth: auth, Handler: handler.HandleKegTurnTimeReport(s.storage)},
+		{Method: http.MethodGet, Path: "/keg-reports/overdue", Auth: auth, Handler: handler.HandleOverdueKegsReport(s.storage)},
+		{Method: http.MethodGet, Path: "/keg-reports/write-offs", Auth: auth, Handler: handler.HandleKegWriteOffsReport(s.storage)},
+		{Method: http.MethodGet, Path: "/stock-levels", Auth: auth, Handler: handler.HandleStockLevels(s.storage)},
+		{Method: http.MethodGet, Path: "/beer-lot-stock-levels", Auth: auth, Handler: handler.HandleBeerLotStockLevels(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-stock-levels", Auth: fromProduction, Handler: handler.HandleIngredientLotStockLevels(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-malt-details", Auth: auth, Handler: handler.HandleIngredientLotMaltDetails(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-lot-malt-details", Auth: auth, Handler: handler.HandleIngredientLotMaltDetails(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-malt-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotMaltDetailByUUID(s.storage)},
+		{Method: http.MethodPut, Path: "/ingredient-lot-malt-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotMaltDetailByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-hop-details", Auth: auth, Handler: handler.HandleIngredientLotHopDetails(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-lot-hop-details", Auth: auth, Handler: handler.HandleIngredientLotHopDetails(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-hop-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotHopDetailByUUID(s.storage)},
+		{Method: http.MethodPut, Path: "/ingredient-lot-hop-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotHopDetailByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-yeast-details", Auth: auth, Handler: handler.HandleIngredientLotYeastDetails(s.storage)},
+		{Method: http.MethodPost, Path: "/ingredient-lot-yeast-details", Auth: auth, Handler: handler.HandleIngredientLotYeastDetails(s.storage)},
+		{Method: http.MethodGet, Path: "/ingredient-lot-yeast-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotYeastDetailByUUID(s.storage)},
+		{Method: http.MethodPut, Path: "/ingredient-lot-yeast-details/{uuid}", Auth: auth, Handler: handler.HandleIngredientLotYeastDetailByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/removals", Auth: fromProduction, Handler: handler.HandleRemovals(s.storage)},
+		{Method: http.MethodPost, Path: "/removals", Auth: auth, Handler: handler.HandleRemovals(s.storage)},
+		{Method: http.MethodGet, Path: "/removals/{uuid}", Auth: auth, Handler: handler.HandleRemovalByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/removals/{uuid}", Auth: auth, Handler: handler.HandleRemovalByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/removals/{uuid}", Auth: auth, Handler: handler.HandleRemovalByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/removal-summary", Auth: auth, Handler: handler.HandleRemovalSummary(s.storage)},
+		{Method: http.MethodPost, Path: "/migrations/inventory", Auth: auth, Handler: handler.HandleInventoryMigration(s.storage)},
+		{Method: http.MethodGet, Path: "/accounting/inventory-journal", Auth: auth, Handler: handler.HandleInventoryJournal(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/accounting/inventory-journal/exports", Auth: auth, Handler: handler.HandleInventoryJournalExports(s.storage, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/accounting/inventory-journal/exports", Auth: auth, Handler: handler.HandleInventoryJournalExports(s.storage, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/accounting/inventory-journal/exports/{uuid}/file", Auth: auth, Handler: handler.HandleInventoryJournalExportFile(s.storage)},
+	})
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -92,6 +185,9 @@ func (s *Service) Start(ctx context.Context) error {
 	if s.secretKey == "" {
 		return fmt.Errorf("missing BREWPIPES_SECRET_KEY for access token verification")
 	}
+	if s.serviceSecret == "" {
+		return fmt.Errorf("missing BREWPIPES_SERVICE_SECRET for calls to other services")
+	}
 	if err := s.storage.Start(ctx); err != nil {
 		return fmt.Errorf("starting storage: %w", err)
 	}
@@ -0,0 +1,22 @@
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/openapi/openapitest"
+	identity "github.com/brewpipes/brewpipes/service/identity/storage"
+	"github.com/brewpipes/brewpipes/service/inventory"
+	"github.com/brewpipes/brewpipes/service/inventory/handler"
+)
+
+func TestHTTPRoutesMatchAPISpec(t *testing.T) {
+	svc := inventory.New(inventory.Config{
+		PostgresDSN: "postgres://localhost:1/brewpipes",
+		SecretKey:   "test-secret",
+	})
+	token, _, err := identity.User{}.GenerateTokens("test-secret")
+	if err != nil {
+		t.Fatalf("generating token: %v", err)
+	}
+	openapitest.Contract(t, handler.API, svc.HTTPRoutes(), token)
+}
@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+// JournalExport is an inventory journal file sent to the books for a period.
+type JournalExport struct {
+	ID         int64
+	UUID       string
+	Period     string
+	Format     string
+	EntryCount int
+	TotalCents int64
+	Content    string
+	CreatedAt  time.Time
+}
+
+// CreateJournalExport records an inventory journal file.
+func (c *Client) CreateJournalExport(ctx context.Context, export JournalExport) (JournalExport, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO inventory_journal_export (
+			period,
+			format,
+			entry_count,
+			total_cents,
+			content
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, uuid, created_at`,
+		export.Period,
+		export.Format,
+		export.EntryCount,
+		export.TotalCents,
+		export.Content,
+	).Scan(
+		&export.ID,
+		&export.UUID,
+		&export.CreatedAt,
+	)
+	if err != nil {
+		return JournalExport{}, fmt.Errorf("creating inventory journal export: %w", err)
+	}
+
+	return export, nil
+}
+
+// ListJournalExports lists inventory journal exports, newest first, without
+// their content.
+func (c *Client) ListJournalExports(ctx context.Context) ([]JournalExport, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT id, uuid, period, format, entry_count, total_cents, created_at
+		FROM inventory_journal_export
+		ORDER BY created_at DESC, id DESC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing inventory journal exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []JournalExport
+	for rows.Next() {
+		var export JournalExport
+		if err := rows.Scan(
+			&export.ID,
+			&export.UUID,
+			&export.Period,
+			&export.Format,
+			&export.EntryCount,
+			&export.TotalCents,
+			&export.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning inventory journal export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing inventory journal exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// GetJournalExportByUUID returns an inventory journal export with its content.
+func (c *Client) GetJournalExportByUUID(ctx context.Context, exportUUID string) (JournalExport, error) {
+	var export JournalExport
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT id, uuid, period, format, entry_count, total_cents, content, created_at
+		FROM inventory_journal_export
+		WHERE uuid = $1`,
+		exportUUID,
+	).Scan(
+		&export.ID,
+		&export.UUID,
+		&export.Period,
+		&export.Format,
+		&export.EntryCount,
+		&export.TotalCents,
+		&export.Content,
+		&export.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return JournalExport{}, service.ErrNotFound
+		}
+		return JournalExport{}, fmt.Errorf("getting inventory journal export: %w", err)
+	}
+
+	return export, nil
+}
@@ -0,0 +1,33 @@
+package storage
+
+import "github.com/brewpipes/brewpipes/internal/archive"
+
+// Archive describes the inventory data included in a full export.
+var Archive = archive.Service{
+	Name:           "inventory",
+	MigrationTable: "inventory_schema_migrations",
+	Tables: archive.Tables(
+		"ingredient", "ingredient_malt_detail", "ingredient_hop_detail", "ingredient_yeast_detail",
+		"stock_location", "inventory_receipt", "ingredient_lot", "ingredient_lot_malt_detail",
+		"ingredient_lot_hop_detail", "ingredient_lot_yeast_detail", "inventory_usage",
+		"inventory_adjustment", "inventory_transfer", "beer_lot", "inventory_movement",
+		"beer_lot_item", "inventory_removal", "beer_lot_item_event", "keg", "keg_event",
+		"inventory_reservation", "ingredient_reorder_policy", "inventory_valuation_setting",
+		"supplier_return", "cycle_count", "cycle_count_line", "label_template",
+		"inventory_journal_export",
+	),
+	References: []archive.Reference{
+		{Table: "beer_lot", Column: "packaging_run_uuid", Service: "production", Target: "packaging_run"},
+		{Table: "beer_lot", Column: "production_batch_uuid", Service: "production", Target: "batch"},
+		{Table: "ingredient_lot", Column: "purchase_order_line_uuid", Service: "procurement", Target: "purchase_order_line"},
+		{Table: "ingredient_lot", Column: "supplier_uuid", Service: "procurement", Target: "supplier"},
+		{Table: "ingredient_reorder_policy", Column: "preferred_supplier_uuid", Service: "procurement", Target: "supplier"},
+		{Table: "inventory_receipt", Column: "purchase_order_uuid", Service: "procurement", Target: "purchase_order"},
+		{Table: "inventory_receipt", Column: "supplier_uuid", Service: "procurement", Target: "supplier"},
+		{Table: "inventory_removal", Column: "batch_uuid", Service: "production", Target: "batch"},
+		{Table: "inventory_removal", Column: "occupancy_uuid", Service: "production", Target: "occupancy"},
+		{Table: "inventory_reservation", Column: "production_ref_uuid", Service: "production", Target: "batch"},
+		{Table: "inventory_usage", Column: "production_ref_uuid", Service: "production", Target: "batch"},
+		{Table: "supplier_return", Column: "purchase_order_line_uuid", Service: "procurement", Target: "purchase_order_line"},
+	},
+}
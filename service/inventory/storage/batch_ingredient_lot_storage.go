@@ -23,7 +23,7 @@ type BatchIngredientLot struct {
 
 // ListBatchIngredientLots returns all ingredient lots consumed by a specific production batch.
 func (c *Client) ListBatchIngredientLots(ctx context.Context, productionRefUUID string) ([]BatchIngredientLot, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT DISTINCT ON (il.uuid)
 			il.uuid AS ingredient_lot_uuid,
 			i.uuid AS ingredient_uuid,
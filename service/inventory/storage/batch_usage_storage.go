@@ -18,10 +18,12 @@ type BatchUsagePick struct {
 	AmountUnit        string
 }
 
-// BatchUsageRequest describes the full batch usage deduction request.
+// BatchUsageRequest describes the full batch usage deduction request. Reason
+// is the movement reason for every pick and defaults to use.
 type BatchUsageRequest struct {
 	ProductionRefUUID *uuid.UUID
 	UsedAt            time.Time
+	Reason            string
 	Picks             []BatchUsagePick
 	Notes             *string
 }
@@ -44,9 +46,11 @@ func (e *ErrBatchUsageValidation) Error() string {
 
 // CreateBatchUsage atomically creates an InventoryUsage record and one
 // InventoryMovement per pick within a single transaction. It validates that
-// each lot and location exist and that sufficient stock is available.
+// each lot and location exist and that sufficient stock is available. When the
+// usage references a production batch, that batch's reservations on each
+// picked lot and location are drawn down by the picked amount.
 func (c *Client) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (BatchUsageResult, error) {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return BatchUsageResult{}, fmt.Errorf("starting batch usage transaction: %w", err)
 	}
@@ -168,6 +172,11 @@ func (c *Client) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (B
 	}
 	database.AssignUUIDPointer(&usage.ProductionRefUUID, productionUUID)
 
+	reason := req.Reason
+	if reason == "" {
+		reason = MovementReasonUse
+	}
+
 	// Create one movement per pick.
 	movements := make([]InventoryMovement, len(resolved))
 	for i, rp := range resolved {
@@ -188,7 +197,7 @@ func (c *Client) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (B
 			rp.lotID,
 			rp.locationID,
 			MovementDirectionOut,
-			MovementReasonUse,
+			reason,
 			rp.amount,
 			rp.amountUnit,
 			req.UsedAt,
@@ -223,6 +232,12 @@ func (c *Client) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (B
 		m.UsageUUID = &usageUUIDStr
 
 		movements[i] = m
+
+		if req.ProductionRefUUID != nil {
+			if err := consumeReservations(ctx, tx, req.ProductionRefUUID.String(), rp.lotID, rp.locationID, rp.amount); err != nil {
+				return BatchUsageResult{}, fmt.Errorf("consuming reservations for pick %d: %w", i, err)
+			}
+		}
 	}
 
 	if err := tx.Commit(ctx); err != nil {
@@ -234,3 +249,83 @@ func (c *Client) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (B
 		Movements: movements,
 	}, nil
 }
+
+// BatchUsageMovement is one lot deducted by a usage that references a
+// production batch, with the lot data needed to cost it.
+type BatchUsageMovement struct {
+	UsageUUID             uuid.UUID
+	MovementUUID          uuid.UUID
+	Reason                string
+	IngredientLotUUID     uuid.UUID
+	IngredientUUID        uuid.UUID
+	IngredientName        string
+	IngredientCategory    string
+	BreweryLotCode        *string
+	PurchaseOrderLineUUID *uuid.UUID
+	Amount                int64
+	AmountUnit            string
+	OccurredAt            time.Time
+}
+
+// ListBatchUsageMovements returns the movements deducted by usages that
+// reference a production batch, whatever their reason, oldest first.
+func (c *Client) ListBatchUsageMovements(ctx context.Context, productionRefUUID string) ([]BatchUsageMovement, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT
+			iu.uuid,
+			im.uuid,
+			im.reason,
+			il.uuid,
+			i.uuid,
+			i.name,
+			i.category,
+			il.brewery_lot_code,
+			il.purchase_order_line_uuid,
+			im.amount,
+			im.amount_unit,
+			im.occurred_at
+		FROM inventory_usage iu
+		JOIN inventory_movement im ON im.usage_id = iu.id
+		JOIN ingredient_lot il ON il.id = im.ingredient_lot_id
+		JOIN ingredient i ON i.id = il.ingredient_id
+		WHERE iu.production_ref_uuid = $1
+		  AND iu.deleted_at IS NULL
+		  AND im.deleted_at IS NULL
+		  AND im.direction = 'out'
+		ORDER BY im.occurred_at ASC, im.id ASC`,
+		productionRefUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing batch usage movements: %w", err)
+	}
+	defer rows.Close()
+
+	var movements []BatchUsageMovement
+	for rows.Next() {
+		var m BatchUsageMovement
+		var poLineUUID pgtype.UUID
+		if err := rows.Scan(
+			&m.UsageUUID,
+			&m.MovementUUID,
+			&m.Reason,
+			&m.IngredientLotUUID,
+			&m.IngredientUUID,
+			&m.IngredientName,
+			&m.IngredientCategory,
+			&m.BreweryLotCode,
+			&poLineUUID,
+			&m.Amount,
+			&m.AmountUnit,
+			&m.OccurredAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning batch usage movement: %w", err)
+		}
+		database.AssignUUIDPointer(&m.PurchaseOrderLineUUID, poLineUUID)
+		movements = append(movements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing batch usage movements: %w", err)
+	}
+
+	return movements, nil
+}
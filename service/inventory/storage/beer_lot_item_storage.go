@@ -0,0 +1,463 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrInvalidBeerLotItemTransition is returned when a status change is not
+// allowed from the item's current status.
+var ErrInvalidBeerLotItemTransition = fmt.Errorf("invalid beer lot item status transition")
+
+// ErrDuplicateBeerLotItemIdentifier is returned when an identifier is already
+// used by another item in the same beer lot.
+var ErrDuplicateBeerLotItemIdentifier = fmt.Errorf("identifier already exists in this beer lot")
+
+// BeerLotItemListFilter describes optional filters for listing beer lot items.
+type BeerLotItemListFilter struct {
+	BeerLotUUID *string
+	Status      *string
+	Identifier  *string
+}
+
+// UpdateBeerLotItemRequest describes the mutable fields for a PATCH update.
+type UpdateBeerLotItemRequest struct {
+	Identifier *string
+	Notes      *string
+}
+
+// BeerLotItemTransition describes a status change for a beer lot item.
+type BeerLotItemTransition struct {
+	Status      string
+	Destination *string
+	OccurredAt  time.Time
+	Notes       *string
+}
+
+// beerLotItemColumns is the column list shared by beer lot item queries.
+const beerLotItemColumns = `i.id, i.uuid, i.beer_lot_id, bl.uuid, i.status, i.identifier, i.notes,
+	i.created_at, i.updated_at, i.deleted_at`
+
+// beerLotItemJoins is the JOIN clause shared by beer lot item queries.
+const beerLotItemJoins = `
+	FROM beer_lot_item i
+	JOIN beer_lot bl ON bl.id = i.beer_lot_id`
+
+func scanBeerLotItem(row pgx.Row) (BeerLotItem, error) {
+	var item BeerLotItem
+	err := row.Scan(
+		&item.ID,
+		&item.UUID,
+		&item.BeerLotID,
+		&item.BeerLotUUID,
+		&item.Status,
+		&item.Identifier,
+		&item.Notes,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.DeletedAt,
+	)
+	return item, err
+}
+
+func scanBeerLotItemRows(rows pgx.Rows) ([]BeerLotItem, error) {
+	var items []BeerLotItem
+	for rows.Next() {
+		item, err := scanBeerLotItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning beer lot item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// isBeerLotItemIdentifierConflict reports whether err is a unique violation on
+// the per-lot identifier index.
+func isBeerLotItemIdentifierConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "beer_lot_item_lot_identifier_idx"
+}
+
+// ErrBeerLotItemClaimed is returned when a keg fill claims a beer lot item
+// that is no longer available or already has an identifier.
+var ErrBeerLotItemClaimed = fmt.Errorf("beer lot item is already filled")
+
+// claimBeerLotItem gives an available item without an identifier the serial
+// of the keg it was filled into, within tx. Claiming an existing item keeps
+// the lot's item count equal to its packaged quantity.
+func claimBeerLotItem(ctx context.Context, tx pgx.Tx, itemID int64, identifier string) error {
+	tag, err := tx.Exec(ctx, `
+		UPDATE beer_lot_item SET
+			identifier = $1,
+			updated_at = timezone('utc', now())
+		WHERE id = $2
+			AND status = $3
+			AND (identifier IS NULL OR identifier = $1)
+			AND deleted_at IS NULL`,
+		identifier,
+		itemID,
+		BeerLotItemStatusAvailable,
+	)
+	if err != nil {
+		if isBeerLotItemIdentifierConflict(err) {
+			return ErrDuplicateBeerLotItemIdentifier
+		}
+		return fmt.Errorf("claiming beer lot item: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrBeerLotItemClaimed
+	}
+	return nil
+}
+
+// insertBeerLotItems inserts items for a beer lot within tx, recording an
+// initial status event for each. Items without a status default to available.
+func insertBeerLotItems(ctx context.Context, tx pgx.Tx, lot BeerLot, items []BeerLotItem) ([]BeerLotItem, error) {
+	created := make([]BeerLotItem, 0, len(items))
+	for i, item := range items {
+		status := item.Status
+		if status == "" {
+			status = BeerLotItemStatusAvailable
+		}
+
+		err := tx.QueryRow(ctx, `
+			INSERT INTO beer_lot_item (
+				beer_lot_id,
+				status,
+				identifier,
+				notes
+			) VALUES ($1, $2, $3, $4)
+			RETURNING id, uuid, beer_lot_id, status, identifier, notes, created_at, updated_at, deleted_at`,
+			lot.ID,
+			status,
+			item.Identifier,
+			item.Notes,
+		).Scan(
+			&item.ID,
+			&item.UUID,
+			&item.BeerLotID,
+			&item.Status,
+			&item.Identifier,
+			&item.Notes,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+			&item.DeletedAt,
+		)
+		if err != nil {
+			if isBeerLotItemIdentifierConflict(err) {
+				return nil, ErrDuplicateBeerLotItemIdentifier
+			}
+			return nil, fmt.Errorf("creating beer lot item %d: %w", i, err)
+		}
+		item.BeerLotUUID = lot.UUID.String()
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO beer_lot_item_event (
+				beer_lot_item_id,
+				to_status,
+				occurred_at
+			) VALUES ($1, $2, $3)`,
+			item.ID,
+			item.Status,
+			item.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("creating initial event for beer lot item %d: %w", i, err)
+		}
+
+		created = append(created, item)
+	}
+
+	return created, nil
+}
+
+// CreateBeerLotItem creates a single beer lot item and its initial status event.
+func (c *Client) CreateBeerLotItem(ctx context.Context, lot BeerLot, item BeerLotItem) (BeerLotItem, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return BeerLotItem{}, fmt.Errorf("starting beer lot item transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	created, err := insertBeerLotItems(ctx, tx, lot, []BeerLotItem{item})
+	if err != nil {
+		return BeerLotItem{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return BeerLotItem{}, fmt.Errorf("committing beer lot item transaction: %w", err)
+	}
+
+	return created[0], nil
+}
+
+func (c *Client) GetBeerLotItemByUUID(ctx context.Context, itemUUID string) (BeerLotItem, error) {
+	item, err := scanBeerLotItem(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+beerLotItemColumns+beerLotItemJoins+`
+		WHERE i.uuid = $1 AND i.deleted_at IS NULL`,
+		itemUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return BeerLotItem{}, service.ErrNotFound
+		}
+		return BeerLotItem{}, fmt.Errorf("getting beer lot item by uuid: %w", err)
+	}
+
+	return item, nil
+}
+
+// GetBeerLotItemByIdentifier returns the most recently updated item carrying
+// the given identifier. Keg serials are claimed by each fill, so an older
+// lot's item can carry the serial after a newer lot's; callers resolving a
+// keg serial should prefer the keg's current item.
+func (c *Client) GetBeerLotItemByIdentifier(ctx context.Context, identifier string) (BeerLotItem, error) {
+	item, err := scanBeerLotItem(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+beerLotItemColumns+beerLotItemJoins+`
+		WHERE i.identifier = $1 AND i.deleted_at IS NULL AND bl.deleted_at IS NULL
+		ORDER BY i.updated_at DESC, i.id DESC
+		LIMIT 1`,
+		identifier,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return BeerLotItem{}, service.ErrNotFound
+		}
+		return BeerLotItem{}, fmt.Errorf("getting beer lot item by identifier: %w", err)
+	}
+
+	return item, nil
+}
+
+// ListBeerLotItems returns beer lot items matching the given filters.
+func (c *Client) ListBeerLotItems(ctx context.Context, filter BeerLotItemListFilter) ([]BeerLotItem, error) {
+	query := `SELECT ` + beerLotItemColumns + beerLotItemJoins + `
+		WHERE i.deleted_at IS NULL`
+	args := []any{}
+	argIdx := 1
+
+	if filter.BeerLotUUID != nil {
+		query += fmt.Sprintf(` AND bl.uuid = $%d`, argIdx)
+		args = append(args, *filter.BeerLotUUID)
+		argIdx++
+	}
+	if filter.Status != nil {
+		query += fmt.Sprintf(` AND i.status = $%d`, argIdx)
+		args = append(args, *filter.Status)
+		argIdx++
+	}
+	if filter.Identifier != nil {
+		query += fmt.Sprintf(` AND i.identifier = $%d`, argIdx)
+		args = append(args, *filter.Identifier)
+		argIdx++
+	}
+
+	query += ` ORDER BY i.created_at DESC, i.id`
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing beer lot items: %w", err)
+	}
+	defer rows.Close()
+
+	items, err := scanBeerLotItemRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("listing beer lot items: %w", err)
+	}
+
+	return items, nil
+}
+
+// UpdateBeerLotItem updates the identifier and notes of a beer lot item.
+// Status changes go through TransitionBeerLotItem so they are recorded in history.
+func (c *Client) UpdateBeerLotItem(ctx context.Context, itemUUID string, req UpdateBeerLotItemRequest) (BeerLotItem, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE beer_lot_item SET
+			identifier = COALESCE($1, identifier),
+			notes = COALESCE($2, notes),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $3 AND deleted_at IS NULL`,
+		req.Identifier,
+		req.Notes,
+		itemUUID,
+	)
+	if err != nil {
+		if isBeerLotItemIdentifierConflict(err) {
+			return BeerLotItem{}, ErrDuplicateBeerLotItemIdentifier
+		}
+		return BeerLotItem{}, fmt.Errorf("updating beer lot item: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return BeerLotItem{}, service.ErrNotFound
+	}
+
+	return c.GetBeerLotItemByUUID(ctx, itemUUID)
+}
+
+// TransitionBeerLotItem changes the status of a beer lot item and records the
+// change in the item's history. It returns ErrInvalidBeerLotItemTransition when
+// the new status is not reachable from the current one.
+func (c *Client) TransitionBeerLotItem(ctx context.Context, itemUUID string, transition BeerLotItemTransition) (BeerLotItem, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return BeerLotItem{}, fmt.Errorf("starting beer lot item transition transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var itemID int64
+	err = tx.QueryRow(ctx, `
+		SELECT id FROM beer_lot_item
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		itemUUID,
+	).Scan(&itemID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return BeerLotItem{}, service.ErrNotFound
+		}
+		return BeerLotItem{}, fmt.Errorf("resolving beer lot item: %w", err)
+	}
+
+	if err := applyBeerLotItemTransition(ctx, tx, itemID, transition); err != nil {
+		return BeerLotItem{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return BeerLotItem{}, fmt.Errorf("committing beer lot item transition transaction: %w", err)
+	}
+
+	return c.GetBeerLotItemByUUID(ctx, itemUUID)
+}
+
+// applyBeerLotItemTransition locks the item, validates the status change, and
+// writes the new status and a history event within tx.
+func applyBeerLotItemTransition(ctx context.Context, tx pgx.Tx, itemID int64, transition BeerLotItemTransition) error {
+	var current string
+	err := tx.QueryRow(ctx, `
+		SELECT status
+		FROM beer_lot_item
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`,
+		itemID,
+	).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrNotFound
+		}
+		return fmt.Errorf("locking beer lot item: %w", err)
+	}
+
+	if !CanTransitionBeerLotItem(current, transition.Status) {
+		return fmt.Errorf("%w: %s to %s", ErrInvalidBeerLotItemTransition, current, transition.Status)
+	}
+
+	occurredAt := transition.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE beer_lot_item SET status = $1, updated_at = timezone('utc', now())
+		WHERE id = $2`,
+		transition.Status,
+		itemID,
+	)
+	if err != nil {
+		return fmt.Errorf("updating beer lot item status: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO beer_lot_item_event (
+			beer_lot_item_id,
+			from_status,
+			to_status,
+			destination,
+			occurred_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6)`,
+		itemID,
+		current,
+		transition.Status,
+		transition.Destination,
+		occurredAt,
+		transition.Notes,
+	)
+	if err != nil {
+		return fmt.Errorf("recording beer lot item event: %w", err)
+	}
+
+	return nil
+}
+
+// SoftDeleteBeerLotItem soft-deletes a beer lot item. Its history is retained.
+func (c *Client) SoftDeleteBeerLotItem(ctx context.Context, itemUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE beer_lot_item SET deleted_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		itemUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting beer lot item: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
+
+// ListBeerLotItemEvents returns the status history of a beer lot item, oldest first.
+func (c *Client) ListBeerLotItemEvents(ctx context.Context, itemUUID string) ([]BeerLotItemEvent, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT e.id, e.uuid, e.beer_lot_item_id, i.uuid, e.from_status, e.to_status,
+			e.destination, e.occurred_at, e.notes, e.created_at, e.updated_at, e.deleted_at
+		FROM beer_lot_item_event e
+		JOIN beer_lot_item i ON i.id = e.beer_lot_item_id
+		WHERE i.uuid = $1 AND e.deleted_at IS NULL
+		ORDER BY e.occurred_at, e.id`,
+		itemUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing beer lot item events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []BeerLotItemEvent
+	for rows.Next() {
+		var event BeerLotItemEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.UUID,
+			&event.BeerLotItemID,
+			&event.BeerLotItemUUID,
+			&event.FromStatus,
+			&event.ToStatus,
+			&event.Destination,
+			&event.OccurredAt,
+			&event.Notes,
+			&event.CreatedAt,
+			&event.UpdatedAt,
+			&event.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning beer lot item event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing beer lot item events: %w", err)
+	}
+
+	return events, nil
+}
@@ -30,7 +30,7 @@ type BeerLotStockLevel struct {
 // from the inventory movement ledger. Stock is grouped by beer lot and location.
 // Only lots with positive stock are included.
 func (c *Client) GetBeerLotStockLevels(ctx context.Context) ([]BeerLotStockLevel, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT
 			bl.uuid AS beer_lot_uuid,
 			bl.production_batch_uuid,
@@ -72,13 +72,14 @@ func scanBeerLotRows(rows pgx.Rows) ([]BeerLot, error) {
 	return lots, nil
 }
 
-func (c *Client) CreateBeerLot(ctx context.Context, lot BeerLot) (BeerLot, error) {
+// insertBeerLot inserts a beer lot within tx and returns it with generated fields.
+func insertBeerLot(ctx context.Context, tx pgx.Tx, lot BeerLot) (BeerLot, error) {
 	packagedAt := lot.PackagedAt
 	if packagedAt.IsZero() {
 		packagedAt = time.Now().UTC()
 	}
 
-	err := c.DB().QueryRow(ctx, `
+	err := tx.QueryRow(ctx, `
 		INSERT INTO beer_lot (
 			production_batch_uuid,
 			packaging_run_uuid,
@@ -123,75 +124,60 @@ func (c *Client) CreateBeerLot(ctx context.Context, lot BeerLot) (BeerLot, error
 		&lot.DeletedAt,
 	)
 	if err != nil {
-		return BeerLot{}, fmt.Errorf("creating beer lot: %w", err)
+		return BeerLot{}, err
 	}
 
 	return lot, nil
 }
 
-// CreateBeerLotWithMovement atomically creates a beer lot and an initial
-// inventory movement within a single transaction. It returns the created lot
-// and the UUID of the movement.
-func (c *Client) CreateBeerLotWithMovement(ctx context.Context, lot BeerLot, stockLocationID int64, movementAmount int64, movementAmountUnit string) (BeerLot, uuid.UUID, error) {
-	tx, err := c.DB().Begin(ctx)
+// CreateBeerLot creates a beer lot and any trackable items (e.g. kegs) within
+// a single transaction.
+func (c *Client) CreateBeerLot(ctx context.Context, lot BeerLot, items []BeerLotItem) (BeerLot, []BeerLotItem, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
-		return BeerLot{}, uuid.UUID{}, fmt.Errorf("starting beer lot transaction: %w", err)
+		return BeerLot{}, nil, fmt.Errorf("starting beer lot transaction: %w", err)
 	}
 	defer func() {
 		_ = tx.Rollback(ctx)
 	}()
 
-	packagedAt := lot.PackagedAt
-	if packagedAt.IsZero() {
-		packagedAt = time.Now().UTC()
+	lot, err = insertBeerLot(ctx, tx, lot)
+	if err != nil {
+		return BeerLot{}, nil, fmt.Errorf("creating beer lot: %w", err)
 	}
 
-	err = tx.QueryRow(ctx, `
-		INSERT INTO beer_lot (
-			production_batch_uuid,
-			packaging_run_uuid,
-			lot_code,
-			best_by,
-			package_format_name,
-			container,
-			volume_per_unit,
-			volume_per_unit_unit,
-			quantity,
-			packaged_at,
-			notes
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-		RETURNING `+beerLotColumns,
-		lot.ProductionBatchUUID,
-		database.UUIDParam(lot.PackagingRunUUID),
-		lot.LotCode,
-		lot.BestBy,
-		lot.PackageFormatName,
-		lot.Container,
-		lot.VolumePerUnit,
-		lot.VolumePerUnitUnit,
-		lot.Quantity,
-		packagedAt,
-		lot.Notes,
-	).Scan(
-		&lot.ID,
-		&lot.UUID,
-		&lot.ProductionBatchUUID,
-		&lot.PackagingRunUUID,
-		&lot.LotCode,
-		&lot.BestBy,
-		&lot.PackageFormatName,
-		&lot.Container,
-		&lot.VolumePerUnit,
-		&lot.VolumePerUnitUnit,
-		&lot.Quantity,
-		&lot.PackagedAt,
-		&lot.Notes,
-		&lot.CreatedAt,
-		&lot.UpdatedAt,
-		&lot.DeletedAt,
-	)
+	createdItems, err := insertBeerLotItems(ctx, tx, lot, items)
+	if err != nil {
+		return BeerLot{}, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return BeerLot{}, nil, fmt.Errorf("committing beer lot transaction: %w", err)
+	}
+
+	return lot, createdItems, nil
+}
+
+// CreateBeerLotWithMovement atomically creates a beer lot, its trackable items,
+// and an initial inventory movement within a single transaction. It returns the
+// created lot, the created items, and the UUID of the movement.
+func (c *Client) CreateBeerLotWithMovement(ctx context.Context, lot BeerLot, items []BeerLotItem, stockLocationID int64, movementAmount int64, movementAmountUnit string) (BeerLot, []BeerLotItem, uuid.UUID, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return BeerLot{}, nil, uuid.UUID{}, fmt.Errorf("starting beer lot transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	lot, err = insertBeerLot(ctx, tx, lot)
+	if err != nil {
+		return BeerLot{}, nil, uuid.UUID{}, fmt.Errorf("creating beer lot in transaction: %w", err)
+	}
+
+	createdItems, err := insertBeerLotItems(ctx, tx, lot, items)
 	if err != nil {
-		return BeerLot{}, uuid.UUID{}, fmt.Errorf("creating beer lot in transaction: %w", err)
+		return BeerLot{}, nil, uuid.UUID{}, err
 	}
 
 	var movementUUID uuid.UUID
@@ -215,18 +201,18 @@ func (c *Client) CreateBeerLotWithMovement(ctx context.Context, lot BeerLot, sto
 		lot.PackagedAt,
 	).Scan(&movementUUID)
 	if err != nil {
-		return BeerLot{}, uuid.UUID{}, fmt.Errorf("creating package movement in transaction: %w", err)
+		return BeerLot{}, nil, uuid.UUID{}, fmt.Errorf("creating package movement in transaction: %w", err)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
-		return BeerLot{}, uuid.UUID{}, fmt.Errorf("committing beer lot transaction: %w", err)
+		return BeerLot{}, nil, uuid.UUID{}, fmt.Errorf("committing beer lot transaction: %w", err)
 	}
 
-	return lot, movementUUID, nil
+	return lot, createdItems, movementUUID, nil
 }
 
 func (c *Client) GetBeerLot(ctx context.Context, id int64) (BeerLot, error) {
-	lot, err := scanBeerLot(c.DB().QueryRow(ctx, `
+	lot, err := scanBeerLot(c.Conn(ctx).QueryRow(ctx, `
 		SELECT `+beerLotColumns+`
 		FROM beer_lot
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -243,7 +229,7 @@ func (c *Client) GetBeerLot(ctx context.Context, id int64) (BeerLot, error) {
 }
 
 func (c *Client) GetBeerLotByUUID(ctx context.Context, lotUUID string) (BeerLot, error) {
-	lot, err := scanBeerLot(c.DB().QueryRow(ctx, `
+	lot, err := scanBeerLot(c.Conn(ctx).QueryRow(ctx, `
 		SELECT `+beerLotColumns+`
 		FROM beer_lot
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -260,7 +246,7 @@ func (c *Client) GetBeerLotByUUID(ctx context.Context, lotUUID string) (BeerLot,
 }
 
 func (c *Client) ListBeerLots(ctx context.Context) ([]BeerLot, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT `+beerLotColumns+`
 		FROM beer_lot
 		WHERE deleted_at IS NULL
@@ -283,7 +269,7 @@ func (c *Client) ListBeerLots(ctx context.Context) ([]BeerLot, error) {
 // This is used by the activity page to resolve lot references for movements that may
 // reference lots that have since been deleted.
 func (c *Client) ListBeerLotsIncludingDeleted(ctx context.Context) ([]BeerLot, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT `+beerLotColumns+`
 		FROM beer_lot
 		ORDER BY packaged_at DESC`,
@@ -302,7 +288,7 @@ func (c *Client) ListBeerLotsIncludingDeleted(ctx context.Context) ([]BeerLot, e
 }
 
 func (c *Client) ListBeerLotsByBatchUUID(ctx context.Context, batchUUID uuid.UUID) ([]BeerLot, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT `+beerLotColumns+`
 		FROM beer_lot
 		WHERE production_batch_uuid = $1 AND deleted_at IS NULL
@@ -321,3 +307,25 @@ func (c *Client) ListBeerLotsByBatchUUID(ctx context.Context, batchUUID uuid.UUI
 
 	return lots, nil
 }
+
+// ListBeerLotsByLotCode returns beer lots with the given lot code.
+func (c *Client) ListBeerLotsByLotCode(ctx context.Context, lotCode string) ([]BeerLot, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+beerLotColumns+`
+		FROM beer_lot
+		WHERE lot_code = $1 AND deleted_at IS NULL
+		ORDER BY packaged_at DESC`,
+		lotCode,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing beer lots by lot code: %w", err)
+	}
+	defer rows.Close()
+
+	lots, err := scanBeerLotRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("listing beer lots by lot code: %w", err)
+	}
+
+	return lots, nil
+}
@@ -0,0 +1,595 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrCycleCountAlreadyOpen is returned when a count session is opened for a
+// location that already has one open.
+var ErrCycleCountAlreadyOpen = fmt.Errorf("stock location already has an open cycle count")
+
+// ErrCycleCountNotOpen is returned when counting, posting or cancelling a
+// session that has already been posted or cancelled.
+var ErrCycleCountNotOpen = fmt.Errorf("cycle count is not open")
+
+// ErrCycleCountIncomplete is returned when posting a session with lines that
+// have not been counted.
+var ErrCycleCountIncomplete = fmt.Errorf("every line must be counted before the cycle count can be posted")
+
+// CycleCountEntry is a counted quantity for a line on the count sheet, or,
+// when LineID is nil, for a lot found at the location that is not on it.
+type CycleCountEntry struct {
+	LineID          *int64
+	IngredientLotID *int64
+	BeerLotID       *int64
+	AmountUnit      string
+	CountedAmount   int64
+	Notes           *string
+}
+
+// CycleCountLineValue is the value of a line's variance in the base currency.
+type CycleCountLineValue struct {
+	VarianceValueCents int64
+	Currency           string
+}
+
+// CycleCountAccuracy summarizes the lines of one posted cycle count. A line
+// is accurate when it was counted at exactly its expected amount. Variance
+// values only include lines that could be valued.
+type CycleCountAccuracy struct {
+	CycleCountUUID             string
+	StockLocationUUID          string
+	StockLocationName          string
+	PostedAt                   time.Time
+	LinesCounted               int
+	LinesAccurate              int
+	UnvaluedVariances          int
+	NetVarianceValueCents      int64
+	AbsoluteVarianceValueCents int64
+	ValueCurrency              *string
+}
+
+// cycleCountColumns is the column list shared by cycle count queries.
+const cycleCountColumns = `
+	cc.id, cc.uuid, cc.stock_location_id, sl.uuid, sl.name,
+	cc.status, cc.blind, cc.frozen_at, cc.posted_at, cc.notes,
+	cc.created_at, cc.updated_at, cc.deleted_at`
+
+func scanCycleCount(row pgx.Row) (CycleCount, error) {
+	var count CycleCount
+	err := row.Scan(
+		&count.ID,
+		&count.UUID,
+		&count.StockLocationID,
+		&count.StockLocationUUID,
+		&count.StockLocationName,
+		&count.Status,
+		&count.Blind,
+		&count.FrozenAt,
+		&count.PostedAt,
+		&count.Notes,
+		&count.CreatedAt,
+		&count.UpdatedAt,
+		&count.DeletedAt,
+	)
+	return count, err
+}
+
+// CreateCycleCount opens a count session for a stock location and freezes
+// the count sheet: one line per lot and unit with a non-zero balance at the
+// location in the movement ledger.
+func (c *Client) CreateCycleCount(ctx context.Context, stockLocationID int64, blind bool, notes *string) (CycleCount, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return CycleCount{}, fmt.Errorf("starting cycle count transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var countID int64
+	var frozenAt time.Time
+	err = tx.QueryRow(ctx, `
+		INSERT INTO cycle_count (stock_location_id, blind, notes)
+		VALUES ($1, $2, $3)
+		RETURNING id, frozen_at`,
+		stockLocationID,
+		blind,
+		notes,
+	).Scan(&countID, &frozenAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return CycleCount{}, ErrCycleCountAlreadyOpen
+		}
+		return CycleCount{}, fmt.Errorf("creating cycle count: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO cycle_count_line (
+			cycle_count_id,
+			ingredient_lot_id,
+			beer_lot_id,
+			amount_unit,
+			expected_amount
+		)
+		SELECT $1, m.ingredient_lot_id, m.beer_lot_id, m.amount_unit,
+			SUM(CASE m.direction WHEN 'in' THEN m.amount WHEN 'out' THEN -m.amount END)
+		FROM inventory_movement m
+		LEFT JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
+		LEFT JOIN beer_lot bl ON bl.id = m.beer_lot_id
+		WHERE m.stock_location_id = $2
+		  AND m.occurred_at <= $3
+		  AND m.deleted_at IS NULL
+		  AND il.deleted_at IS NULL
+		  AND bl.deleted_at IS NULL
+		GROUP BY m.ingredient_lot_id, m.beer_lot_id, m.amount_unit
+		HAVING SUM(CASE m.direction WHEN 'in' THEN m.amount WHEN 'out' THEN -m.amount END) <> 0`,
+		countID,
+		stockLocationID,
+		frozenAt,
+	)
+	if err != nil {
+		return CycleCount{}, fmt.Errorf("freezing cycle count sheet: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CycleCount{}, fmt.Errorf("committing cycle count transaction: %w", err)
+	}
+
+	return c.getCycleCount(ctx, "cc.id = $1", countID)
+}
+
+func (c *Client) GetCycleCountByUUID(ctx context.Context, countUUID string) (CycleCount, error) {
+	return c.getCycleCount(ctx, "cc.uuid = $1", countUUID)
+}
+
+func (c *Client) getCycleCount(ctx context.Context, where string, arg any) (CycleCount, error) {
+	count, err := scanCycleCount(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+cycleCountColumns+`
+		FROM cycle_count cc
+		JOIN stock_location sl ON sl.id = cc.stock_location_id
+		WHERE `+where+` AND cc.deleted_at IS NULL`,
+		arg,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return CycleCount{}, service.ErrNotFound
+		}
+		return CycleCount{}, fmt.Errorf("getting cycle count: %w", err)
+	}
+
+	return count, nil
+}
+
+// ListCycleCounts returns cycle counts, newest first, matching the filter.
+func (c *Client) ListCycleCounts(ctx context.Context, filter CycleCountFilter) ([]CycleCount, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+cycleCountColumns+`
+		FROM cycle_count cc
+		JOIN stock_location sl ON sl.id = cc.stock_location_id
+		WHERE cc.deleted_at IS NULL
+		  AND ($1::uuid IS NULL OR sl.uuid = $1)
+		  AND ($2::text IS NULL OR cc.status = $2)
+		ORDER BY cc.frozen_at DESC, cc.id DESC`,
+		filter.StockLocationUUID,
+		filter.Status,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing cycle counts: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []CycleCount
+	for rows.Next() {
+		count, err := scanCycleCount(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning cycle count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing cycle counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// ListCycleCountLines returns the count sheet of a session, ordered by item
+// and lot code.
+func (c *Client) ListCycleCountLines(ctx context.Context, countID int64) ([]CycleCountLine, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT ccl.id, ccl.uuid, ccl.cycle_count_id,
+			ccl.ingredient_lot_id, il.uuid, ccl.beer_lot_id, bl.uuid,
+			COALESCE(il.brewery_lot_code, bl.lot_code), COALESCE(i.name, bl.package_format_name),
+			il.purchase_order_line_uuid, il.received_unit,
+			ccl.amount_unit, ccl.expected_amount, ccl.counted_amount, ccl.counted_at,
+			ccl.variance_value_cents, ccl.value_currency, ccl.adjustment_id, adj.uuid,
+			ccl.notes, ccl.created_at, ccl.updated_at, ccl.deleted_at
+		FROM cycle_count_line ccl
+		LEFT JOIN ingredient_lot il ON il.id = ccl.ingredient_lot_id
+		LEFT JOIN ingredient i ON i.id = il.ingredient_id
+		LEFT JOIN beer_lot bl ON bl.id = ccl.beer_lot_id
+		LEFT JOIN inventory_adjustment adj ON adj.id = ccl.adjustment_id
+		WHERE ccl.cycle_count_id = $1 AND ccl.deleted_at IS NULL
+		ORDER BY COALESCE(i.name, bl.package_format_name) NULLS LAST,
+			COALESCE(il.brewery_lot_code, bl.lot_code) NULLS LAST, ccl.id`,
+		countID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing cycle count lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []CycleCountLine
+	for rows.Next() {
+		var line CycleCountLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.UUID,
+			&line.CycleCountID,
+			&line.IngredientLotID,
+			&line.IngredientLotUUID,
+			&line.BeerLotID,
+			&line.BeerLotUUID,
+			&line.LotCode,
+			&line.ItemName,
+			&line.PurchaseOrderLineUUID,
+			&line.LotReceivedUnit,
+			&line.AmountUnit,
+			&line.ExpectedAmount,
+			&line.CountedAmount,
+			&line.CountedAt,
+			&line.VarianceValueCents,
+			&line.ValueCurrency,
+			&line.AdjustmentID,
+			&line.AdjustmentUUID,
+			&line.Notes,
+			&line.CreatedAt,
+			&line.UpdatedAt,
+			&line.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning cycle count line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing cycle count lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// lockOpenCycleCount locks a session for the rest of the transaction and
+// checks that it is still open.
+func lockOpenCycleCount(ctx context.Context, tx pgx.Tx, countID int64) error {
+	var status string
+	err := tx.QueryRow(ctx, `
+		SELECT status FROM cycle_count
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`,
+		countID,
+	).Scan(&status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return service.ErrNotFound
+		}
+		return fmt.Errorf("locking cycle count: %w", err)
+	}
+	if status != CycleCountStatusOpen {
+		return ErrCycleCountNotOpen
+	}
+	return nil
+}
+
+// RecordCycleCountEntries records counted quantities on an open session.
+// Entries for lots not on the sheet add a line with an expected amount of
+// zero. Counting a line again replaces its counted amount.
+func (c *Client) RecordCycleCountEntries(ctx context.Context, countID int64, entries []CycleCountEntry) error {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting cycle count entry transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := lockOpenCycleCount(ctx, tx, countID); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.LineID != nil {
+			tag, err := tx.Exec(ctx, `
+				UPDATE cycle_count_line
+				SET counted_amount = $3,
+					counted_at = timezone('utc', now()),
+					notes = COALESCE($4, notes),
+					updated_at = timezone('utc', now())
+				WHERE id = $1 AND cycle_count_id = $2 AND deleted_at IS NULL`,
+				*entry.LineID,
+				countID,
+				entry.CountedAmount,
+				entry.Notes,
+			)
+			if err != nil {
+				return fmt.Errorf("recording cycle count line: %w", err)
+			}
+			if tag.RowsAffected() == 0 {
+				return service.ErrNotFound
+			}
+			continue
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO cycle_count_line (
+				cycle_count_id,
+				ingredient_lot_id,
+				beer_lot_id,
+				amount_unit,
+				expected_amount,
+				counted_amount,
+				counted_at,
+				notes
+			) VALUES ($1, $2, $3, $4, 0, $5, timezone('utc', now()), $6)`,
+			countID,
+			entry.IngredientLotID,
+			entry.BeerLotID,
+			entry.AmountUnit,
+			entry.CountedAmount,
+			entry.Notes,
+		)
+		if err != nil {
+			return fmt.Errorf("adding cycle count line: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE cycle_count SET updated_at = timezone('utc', now()) WHERE id = $1`, countID)
+	if err != nil {
+		return fmt.Errorf("touching cycle count: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing cycle count entry transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PostCycleCount closes an open session by creating, for every line whose
+// counted amount differs from the frozen expected amount, a cycle_count
+// adjustment and its movement for the variance. Movements recorded at the
+// location since the freeze are left in place. Variance values, keyed by
+// line ID, are stored on the lines.
+func (c *Client) PostCycleCount(ctx context.Context, countID int64, values map[int64]CycleCountLineValue) error {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting cycle count posting transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := lockOpenCycleCount(ctx, tx, countID); err != nil {
+		return err
+	}
+
+	var stockLocationID int64
+	err = tx.QueryRow(ctx, `SELECT stock_location_id FROM cycle_count WHERE id = $1`, countID).Scan(&stockLocationID)
+	if err != nil {
+		return fmt.Errorf("getting cycle count location: %w", err)
+	}
+
+	type postingLine struct {
+		id              int64
+		ingredientLotID *int64
+		beerLotID       *int64
+		amountUnit      string
+		expected        int64
+		counted         *int64
+	}
+	rows, err := tx.Query(ctx, `
+		SELECT id, ingredient_lot_id, beer_lot_id, amount_unit, expected_amount, counted_amount
+		FROM cycle_count_line
+		WHERE cycle_count_id = $1 AND deleted_at IS NULL
+		ORDER BY id`,
+		countID,
+	)
+	if err != nil {
+		return fmt.Errorf("listing cycle count lines for posting: %w", err)
+	}
+	var lines []postingLine
+	for rows.Next() {
+		var l postingLine
+		if err := rows.Scan(&l.id, &l.ingredientLotID, &l.beerLotID, &l.amountUnit, &l.expected, &l.counted); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning cycle count line for posting: %w", err)
+		}
+		lines = append(lines, l)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("listing cycle count lines for posting: %w", err)
+	}
+
+	for _, line := range lines {
+		if line.counted == nil {
+			return ErrCycleCountIncomplete
+		}
+	}
+
+	postedAt := time.Now().UTC()
+	for _, line := range lines {
+		var adjustmentID *int64
+		if variance := *line.counted - line.expected; variance != 0 {
+			var id int64
+			err := tx.QueryRow(ctx, `
+				INSERT INTO inventory_adjustment (reason, adjusted_at, notes)
+				VALUES ($1, $2, 'Cycle count variance')
+				RETURNING id`,
+				AdjustmentReasonCycleCount,
+				postedAt,
+			).Scan(&id)
+			if err != nil {
+				return fmt.Errorf("creating cycle count adjustment: %w", err)
+			}
+			adjustmentID = &id
+
+			direction := MovementDirectionIn
+			if variance < 0 {
+				direction = MovementDirectionOut
+				variance = -variance
+			}
+			_, err = tx.Exec(ctx, `
+				INSERT INTO inventory_movement (
+					ingredient_lot_id,
+					beer_lot_id,
+					stock_location_id,
+					direction,
+					reason,
+					amount,
+					amount_unit,
+					occurred_at,
+					adjustment_id
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+				line.ingredientLotID,
+				line.beerLotID,
+				stockLocationID,
+				direction,
+				MovementReasonAdjust,
+				variance,
+				line.amountUnit,
+				postedAt,
+				id,
+			)
+			if err != nil {
+				return fmt.Errorf("creating cycle count movement: %w", err)
+			}
+		}
+
+		var valueCents *int64
+		var currency *string
+		if value, ok := values[line.id]; ok {
+			valueCents = &value.VarianceValueCents
+			currency = &value.Currency
+		}
+		_, err := tx.Exec(ctx, `
+			UPDATE cycle_count_line
+			SET adjustment_id = $2,
+				variance_value_cents = $3,
+				value_currency = $4,
+				updated_at = timezone('utc', now())
+			WHERE id = $1`,
+			line.id,
+			adjustmentID,
+			valueCents,
+			currency,
+		)
+		if err != nil {
+			return fmt.Errorf("updating posted cycle count line: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE cycle_count
+		SET status = $2,
+			posted_at = $3,
+			updated_at = timezone('utc', now())
+		WHERE id = $1`,
+		countID,
+		CycleCountStatusPosted,
+		postedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("posting cycle count: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing cycle count posting transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CancelCycleCount cancels an open session without adjusting stock.
+func (c *Client) CancelCycleCount(ctx context.Context, countID int64) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE cycle_count
+		SET status = $2,
+			updated_at = timezone('utc', now())
+		WHERE id = $1 AND status = $3 AND deleted_at IS NULL`,
+		countID,
+		CycleCountStatusCancelled,
+		CycleCountStatusOpen,
+	)
+	if err != nil {
+		return fmt.Errorf("cancelling cycle count: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrCycleCountNotOpen
+	}
+
+	return nil
+}
+
+// ListCycleCountAccuracy summarizes the cycle counts posted in [from, to),
+// oldest first, optionally for one stock location.
+func (c *Client) ListCycleCountAccuracy(ctx context.Context, from, to time.Time, stockLocationUUID *string) ([]CycleCountAccuracy, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT cc.uuid, sl.uuid, sl.name, cc.posted_at,
+			COUNT(ccl.id),
+			COUNT(ccl.id) FILTER (WHERE ccl.counted_amount = ccl.expected_amount),
+			COUNT(ccl.id) FILTER (WHERE ccl.counted_amount <> ccl.expected_amount AND ccl.variance_value_cents IS NULL),
+			COALESCE(SUM(ccl.variance_value_cents), 0),
+			COALESCE(SUM(ABS(ccl.variance_value_cents)), 0),
+			MAX(ccl.value_currency)
+		FROM cycle_count cc
+		JOIN stock_location sl ON sl.id = cc.stock_location_id
+		LEFT JOIN cycle_count_line ccl ON ccl.cycle_count_id = cc.id AND ccl.deleted_at IS NULL
+		WHERE cc.status = 'posted'
+		  AND cc.deleted_at IS NULL
+		  AND cc.posted_at >= $1 AND cc.posted_at < $2
+		  AND ($3::uuid IS NULL OR sl.uuid = $3)
+		GROUP BY cc.id, cc.uuid, sl.uuid, sl.name, cc.posted_at
+		ORDER BY cc.posted_at, cc.id`,
+		from,
+		to,
+		stockLocationUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing cycle count accuracy: %w", err)
+	}
+	defer rows.Close()
+
+	var results []CycleCountAccuracy
+	for rows.Next() {
+		var a CycleCountAccuracy
+		if err := rows.Scan(
+			&a.CycleCountUUID,
+			&a.StockLocationUUID,
+			&a.StockLocationName,
+			&a.PostedAt,
+			&a.LinesCounted,
+			&a.LinesAccurate,
+			&a.UnvaluedVariances,
+			&a.NetVarianceValueCents,
+			&a.AbsoluteVarianceValueCents,
+			&a.ValueCurrency,
+		); err != nil {
+			return nil, fmt.Errorf("scanning cycle count accuracy: %w", err)
+		}
+		results = append(results, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing cycle count accuracy: %w", err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,241 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// IngredientImport is an ingredient with at most one category detail, as
+// read from an import file.
+type IngredientImport struct {
+	Ingredient  Ingredient
+	MaltDetail  *IngredientMaltDetail
+	HopDetail   *IngredientHopDetail
+	YeastDetail *IngredientYeastDetail
+}
+
+// ImportIngredient atomically creates an ingredient and its malt, hop or
+// yeast detail.
+func (c *Client) ImportIngredient(ctx context.Context, req IngredientImport) (Ingredient, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return Ingredient{}, fmt.Errorf("starting ingredient import transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	ingredient := req.Ingredient
+	err = tx.QueryRow(ctx, `
+		INSERT INTO ingredient (
+			name,
+			category,
+			default_unit,
+			description
+		) VALUES ($1, $2, $3, $4)
+		RETURNING id, uuid, name, category, default_unit, description, created_at, updated_at, deleted_at`,
+		ingredient.Name,
+		ingredient.Category,
+		ingredient.DefaultUnit,
+		ingredient.Description,
+	).Scan(
+		&ingredient.ID,
+		&ingredient.UUID,
+		&ingredient.Name,
+		&ingredient.Category,
+		&ingredient.DefaultUnit,
+		&ingredient.Description,
+		&ingredient.CreatedAt,
+		&ingredient.UpdatedAt,
+		&ingredient.DeletedAt,
+	)
+	if err != nil {
+		return Ingredient{}, fmt.Errorf("creating ingredient in transaction: %w", err)
+	}
+
+	if err := insertIngredientImportDetail(ctx, tx, ingredient.ID, req); err != nil {
+		return Ingredient{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Ingredient{}, fmt.Errorf("committing ingredient import transaction: %w", err)
+	}
+
+	return ingredient, nil
+}
+
+func insertIngredientImportDetail(ctx context.Context, tx pgx.Tx, ingredientID int64, req IngredientImport) error {
+	switch {
+	case req.MaltDetail != nil:
+		d := req.MaltDetail
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO ingredient_malt_detail (
+				ingredient_id,
+				maltster_name,
+				variety,
+				lovibond,
+				srm,
+				diastatic_power
+			) VALUES ($1, $2, $3, $4, $5, $6)`,
+			ingredientID,
+			d.MaltsterName,
+			d.Variety,
+			d.Lovibond,
+			d.SRM,
+			d.DiastaticPower,
+		); err != nil {
+			return fmt.Errorf("creating ingredient malt detail in transaction: %w", err)
+		}
+	case req.HopDetail != nil:
+		d := req.HopDetail
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO ingredient_hop_detail (
+				ingredient_id,
+				producer_name,
+				variety,
+				crop_year,
+				form,
+				alpha_acid,
+				beta_acid
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			ingredientID,
+			d.ProducerName,
+			d.Variety,
+			d.CropYear,
+			d.Form,
+			d.AlphaAcid,
+			d.BetaAcid,
+		); err != nil {
+			return fmt.Errorf("creating ingredient hop detail in transaction: %w", err)
+		}
+	case req.YeastDetail != nil:
+		d := req.YeastDetail
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO ingredient_yeast_detail (
+				ingredient_id,
+				lab_name,
+				strain,
+				form
+			) VALUES ($1, $2, $3, $4)`,
+			ingredientID,
+			d.LabName,
+			d.Strain,
+			d.Form,
+		); err != nil {
+			return fmt.Errorf("creating ingredient yeast detail in transaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// OpeningLotImport is an ingredient lot already on hand when the brewery
+// starts using the system. ReferenceCode and Notes go on its receipt.
+type OpeningLotImport struct {
+	Lot             IngredientLot
+	StockLocationID int64
+	ReferenceCode   *string
+}
+
+// ImportOpeningIngredientLot atomically creates a receipt, the lot on it and
+// the receive movement that puts the lot's amount into the stock location.
+// The lot has no purchase order line, so it carries no cost. The lot is
+// returned with its current amount.
+func (c *Client) ImportOpeningIngredientLot(ctx context.Context, req OpeningLotImport) (IngredientLot, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return IngredientLot{}, fmt.Errorf("starting opening lot import transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	lot := req.Lot
+	receivedAt := lot.ReceivedAt
+	if receivedAt.IsZero() {
+		receivedAt = time.Now().UTC()
+	}
+
+	var receiptID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO inventory_receipt (
+			reference_code,
+			received_at,
+			notes
+		) VALUES ($1, $2, $3)
+		RETURNING id`,
+		req.ReferenceCode,
+		receivedAt,
+		lot.Notes,
+	).Scan(&receiptID)
+	if err != nil {
+		return IngredientLot{}, fmt.Errorf("creating opening lot receipt in transaction: %w", err)
+	}
+
+	var lotID int64
+	var lotUUID string
+	err = tx.QueryRow(ctx, `
+		INSERT INTO ingredient_lot (
+			ingredient_id,
+			receipt_id,
+			brewery_lot_code,
+			originator_lot_code,
+			originator_name,
+			originator_type,
+			received_at,
+			received_amount,
+			received_unit,
+			best_by_at,
+			expires_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, uuid`,
+		lot.IngredientID,
+		receiptID,
+		lot.BreweryLotCode,
+		lot.OriginatorLotCode,
+		lot.OriginatorName,
+		lot.OriginatorType,
+		receivedAt,
+		lot.ReceivedAmount,
+		lot.ReceivedUnit,
+		lot.BestByAt,
+		lot.ExpiresAt,
+		lot.Notes,
+	).Scan(&lotID, &lotUUID)
+	if err != nil {
+		return IngredientLot{}, fmt.Errorf("creating opening lot in transaction: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO inventory_movement (
+			ingredient_lot_id,
+			stock_location_id,
+			direction,
+			reason,
+			amount,
+			amount_unit,
+			occurred_at,
+			receipt_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		lotID,
+		req.StockLocationID,
+		MovementDirectionIn,
+		MovementReasonReceive,
+		lot.ReceivedAmount,
+		lot.ReceivedUnit,
+		receivedAt,
+		receiptID,
+	); err != nil {
+		return IngredientLot{}, fmt.Errorf("creating opening lot movement in transaction: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return IngredientLot{}, fmt.Errorf("committing opening lot import transaction: %w", err)
+	}
+
+	return c.GetIngredientLotByUUID(ctx, lotUUID)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (c *Client) CreateIngredientLotMaltDetail(ctx context.Context, detail IngredientLotMaltDetail) (IngredientLotMaltDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO ingredient_lot_malt_detail (
 			ingredient_lot_id,
 			moisture_percent
@@ -32,14 +32,14 @@ func (c *Client) CreateIngredientLotMaltDetail(ctx context.Context, detail Ingre
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) GetIngredientLotMaltDetail(ctx context.Context, id int64) (IngredientLotMaltDetail, error) {
 	var detail IngredientLotMaltDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.moisture_percent, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_malt_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -67,7 +67,7 @@ func (c *Client) GetIngredientLotMaltDetail(ctx context.Context, id int64) (Ingr
 
 func (c *Client) GetIngredientLotMaltDetailByUUID(ctx context.Context, detailUUID string) (IngredientLotMaltDetail, error) {
 	var detail IngredientLotMaltDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.moisture_percent, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_malt_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -95,7 +95,7 @@ func (c *Client) GetIngredientLotMaltDetailByUUID(ctx context.Context, detailUUI
 
 func (c *Client) GetIngredientLotMaltDetailByLot(ctx context.Context, lotUUID string) (IngredientLotMaltDetail, error) {
 	var detail IngredientLotMaltDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.moisture_percent, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_malt_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -122,7 +122,7 @@ func (c *Client) GetIngredientLotMaltDetailByLot(ctx context.Context, lotUUID st
 }
 
 func (c *Client) UpdateIngredientLotMaltDetail(ctx context.Context, detailUUID string, detail IngredientLotMaltDetail) (IngredientLotMaltDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE ingredient_lot_malt_detail
 		SET moisture_percent = $1, updated_at = timezone('utc', now())
 		WHERE uuid = $2 AND deleted_at IS NULL
@@ -146,13 +146,13 @@ func (c *Client) UpdateIngredientLotMaltDetail(ctx context.Context, detailUUID s
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) CreateIngredientLotHopDetail(ctx context.Context, detail IngredientLotHopDetail) (IngredientLotHopDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO ingredient_lot_hop_detail (
 			ingredient_lot_id,
 			alpha_acid,
@@ -177,14 +177,14 @@ func (c *Client) CreateIngredientLotHopDetail(ctx context.Context, detail Ingred
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) GetIngredientLotHopDetail(ctx context.Context, id int64) (IngredientLotHopDetail, error) {
 	var detail IngredientLotHopDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.alpha_acid, d.beta_acid, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_hop_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -213,7 +213,7 @@ func (c *Client) GetIngredientLotHopDetail(ctx context.Context, id int64) (Ingre
 
 func (c *Client) GetIngredientLotHopDetailByUUID(ctx context.Context, detailUUID string) (IngredientLotHopDetail, error) {
 	var detail IngredientLotHopDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.alpha_acid, d.beta_acid, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_hop_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -242,7 +242,7 @@ func (c *Client) GetIngredientLotHopDetailByUUID(ctx context.Context, detailUUID
 
 func (c *Client) GetIngredientLotHopDetailByLot(ctx context.Context, lotUUID string) (IngredientLotHopDetail, error) {
 	var detail IngredientLotHopDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.alpha_acid, d.beta_acid, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_hop_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -270,7 +270,7 @@ func (c *Client) GetIngredientLotHopDetailByLot(ctx context.Context, lotUUID str
 }
 
 func (c *Client) UpdateIngredientLotHopDetail(ctx context.Context, detailUUID string, detail IngredientLotHopDetail) (IngredientLotHopDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE ingredient_lot_hop_detail
 		SET alpha_acid = $1, beta_acid = $2, updated_at = timezone('utc', now())
 		WHERE uuid = $3 AND deleted_at IS NULL
@@ -296,13 +296,13 @@ func (c *Client) UpdateIngredientLotHopDetail(ctx context.Context, detailUUID st
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) CreateIngredientLotYeastDetail(ctx context.Context, detail IngredientLotYeastDetail) (IngredientLotYeastDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO ingredient_lot_yeast_detail (
 			ingredient_lot_id,
 			viability_percent,
@@ -327,14 +327,14 @@ func (c *Client) CreateIngredientLotYeastDetail(ctx context.Context, detail Ingr
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) GetIngredientLotYeastDetail(ctx context.Context, id int64) (IngredientLotYeastDetail, error) {
 	var detail IngredientLotYeastDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.viability_percent, d.generation, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_yeast_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -363,7 +363,7 @@ func (c *Client) GetIngredientLotYeastDetail(ctx context.Context, id int64) (Ing
 
 func (c *Client) GetIngredientLotYeastDetailByUUID(ctx context.Context, detailUUID string) (IngredientLotYeastDetail, error) {
 	var detail IngredientLotYeastDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.viability_percent, d.generation, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_yeast_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
@@ -391,7 +391,7 @@ func (c *Client) GetIngredientLotYeastDetailByUUID(ctx context.Context, detailUU
 }
 
 func (c *Client) UpdateIngredientLotYeastDetail(ctx context.Context, detailUUID string, detail IngredientLotYeastDetail) (IngredientLotYeastDetail, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE ingredient_lot_yeast_detail
 		SET viability_percent = $1, generation = $2, updated_at = timezone('utc', now())
 		WHERE uuid = $3 AND deleted_at IS NULL
@@ -417,14 +417,14 @@ func (c *Client) UpdateIngredientLotYeastDetail(ctx context.Context, detailUUID
 	}
 
 	// Resolve ingredient lot UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, detail.IngredientLotID).Scan(&detail.IngredientLotUUID)
 
 	return detail, nil
 }
 
 func (c *Client) GetIngredientLotYeastDetailByLot(ctx context.Context, lotUUID string) (IngredientLotYeastDetail, error) {
 	var detail IngredientLotYeastDetail
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT d.id, d.uuid, d.ingredient_lot_id, il.uuid, d.viability_percent, d.generation, d.created_at, d.updated_at, d.deleted_at
 		FROM ingredient_lot_yeast_detail d
 		JOIN ingredient_lot il ON il.id = d.ingredient_lot_id
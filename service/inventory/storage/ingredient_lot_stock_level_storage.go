@@ -17,17 +17,23 @@ type IngredientLotStockLevel struct {
 	ReceivedAt         time.Time
 	ReceivedAmount     int64
 	ReceivedUnit       string
+	BestByAt           *time.Time
+	ExpiresAt          *time.Time
 	LocationUUID       string
 	LocationName       string
 	CurrentAmount      int64
 	CurrentUnit        string
+	ReservedAmount     int64
+	AvailableAmount    int64
 }
 
 // GetIngredientLotStockLevels returns current stock levels for all ingredient
 // lots, computed from the inventory movement ledger. Stock is grouped by
-// ingredient lot and location. Only lots with positive stock are included.
+// ingredient lot, location and unit. Only lots with positive stock are
+// included. Available is on-hand less active reservations, which are held in
+// the lot's received unit and so only reduce the row in that unit.
 func (c *Client) GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT
 			il.uuid AS ingredient_lot_uuid,
 			i.uuid AS ingredient_uuid,
@@ -37,13 +43,24 @@ func (c *Client) GetIngredientLotStockLevels(ctx context.Context) ([]IngredientL
 			il.received_at,
 			il.received_amount,
 			il.received_unit,
+			il.best_by_at,
+			il.expires_at,
 			sl.uuid AS location_uuid,
 			sl.name AS location_name,
 			SUM(CASE m.direction
 				WHEN 'in' THEN m.amount
 				WHEN 'out' THEN -m.amount
 			END) AS current_amount,
-			m.amount_unit AS current_unit
+			m.amount_unit AS current_unit,
+			COALESCE((
+				SELECT SUM(r.amount - r.consumed_amount)
+				FROM inventory_reservation r
+				WHERE r.ingredient_lot_id = il.id
+				  AND r.stock_location_id = sl.id
+				  AND r.status = 'active'
+				  AND r.deleted_at IS NULL
+				  AND m.amount_unit = il.received_unit
+			), 0) AS reserved_amount
 		FROM inventory_movement m
 		JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
 		JOIN ingredient i ON i.id = il.ingredient_id
@@ -54,6 +71,7 @@ func (c *Client) GetIngredientLotStockLevels(ctx context.Context) ([]IngredientL
 		  AND sl.deleted_at IS NULL
 		GROUP BY il.id, il.uuid, i.uuid, i.name, i.category,
 			il.brewery_lot_code, il.received_at, il.received_amount, il.received_unit,
+			il.best_by_at, il.expires_at,
 			sl.id, sl.uuid, sl.name, m.amount_unit
 		HAVING SUM(CASE m.direction
 			WHEN 'in' THEN m.amount
@@ -78,13 +96,17 @@ func (c *Client) GetIngredientLotStockLevels(ctx context.Context) ([]IngredientL
 			&level.ReceivedAt,
 			&level.ReceivedAmount,
 			&level.ReceivedUnit,
+			&level.BestByAt,
+			&level.ExpiresAt,
 			&level.LocationUUID,
 			&level.LocationName,
 			&level.CurrentAmount,
 			&level.CurrentUnit,
+			&level.ReservedAmount,
 		); err != nil {
 			return nil, fmt.Errorf("scanning ingredient lot stock level: %w", err)
 		}
+		level.AvailableAmount = level.CurrentAmount - level.ReservedAmount
 		levels = append(levels, level)
 	}
 	if err := rows.Err(); err != nil {
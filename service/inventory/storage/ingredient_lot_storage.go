@@ -25,7 +25,7 @@ func (c *Client) CreateIngredientLot(ctx context.Context, lot IngredientLot) (In
 
 	var supplierUUID pgtype.UUID
 	var purchaseOrderLineUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO ingredient_lot (
 			ingredient_id,
 			receipt_id,
@@ -86,12 +86,12 @@ func (c *Client) CreateIngredientLot(ctx context.Context, lot IngredientLot) (In
 	database.AssignUUIDPointer(&lot.PurchaseOrderLineUUID, purchaseOrderLineUUID)
 
 	// Resolve ingredient UUID
-	c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient WHERE id = $1`, lot.IngredientID).Scan(&lot.IngredientUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient WHERE id = $1`, lot.IngredientID).Scan(&lot.IngredientUUID)
 
 	// Resolve receipt UUID if set
 	if lot.ReceiptID != nil {
 		var receiptUUID string
-		err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_receipt WHERE id = $1`, *lot.ReceiptID).Scan(&receiptUUID)
+		err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_receipt WHERE id = $1`, *lot.ReceiptID).Scan(&receiptUUID)
 		if err == nil {
 			lot.ReceiptUUID = &receiptUUID
 		}
@@ -101,17 +101,17 @@ func (c *Client) CreateIngredientLot(ctx context.Context, lot IngredientLot) (In
 }
 
 func (c *Client) GetIngredientLot(ctx context.Context, id int64) (IngredientLot, error) {
-	return c.scanIngredientLotRow(c.DB().QueryRow(ctx, ingredientLotSelectSQL+`
+	return c.scanIngredientLotRow(c.Conn(ctx).QueryRow(ctx, ingredientLotSelectSQL+`
 		WHERE il.id = $1 AND il.deleted_at IS NULL`, id))
 }
 
 func (c *Client) GetIngredientLotByUUID(ctx context.Context, lotUUID string) (IngredientLot, error) {
-	return c.scanIngredientLotRow(c.DB().QueryRow(ctx, ingredientLotSelectSQL+`
+	return c.scanIngredientLotRow(c.Conn(ctx).QueryRow(ctx, ingredientLotSelectSQL+`
 		WHERE il.uuid = $1 AND il.deleted_at IS NULL`, lotUUID))
 }
 
 func (c *Client) ListIngredientLots(ctx context.Context) ([]IngredientLot, error) {
-	rows, err := c.DB().Query(ctx, ingredientLotSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
 		WHERE il.deleted_at IS NULL
 		ORDER BY il.received_at DESC`)
 	if err != nil {
@@ -126,7 +126,7 @@ func (c *Client) ListIngredientLots(ctx context.Context) ([]IngredientLot, error
 // This is used by the activity page to resolve lot references for movements that may
 // reference lots that have since been deleted.
 func (c *Client) ListIngredientLotsIncludingDeleted(ctx context.Context) ([]IngredientLot, error) {
-	rows, err := c.DB().Query(ctx, ingredientLotSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
 		ORDER BY il.received_at DESC`)
 	if err != nil {
 		return nil, fmt.Errorf("listing ingredient lots including deleted: %w", err)
@@ -137,7 +137,7 @@ func (c *Client) ListIngredientLotsIncludingDeleted(ctx context.Context) ([]Ingr
 }
 
 func (c *Client) ListIngredientLotsByIngredient(ctx context.Context, ingredientUUID string) ([]IngredientLot, error) {
-	rows, err := c.DB().Query(ctx, ingredientLotSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
 		WHERE i.uuid = $1 AND i.deleted_at IS NULL AND il.deleted_at IS NULL
 		ORDER BY il.received_at ASC`,
 		ingredientUUID,
@@ -151,7 +151,7 @@ func (c *Client) ListIngredientLotsByIngredient(ctx context.Context, ingredientU
 }
 
 func (c *Client) ListIngredientLotsByReceipt(ctx context.Context, receiptUUID string) ([]IngredientLot, error) {
-	rows, err := c.DB().Query(ctx, ingredientLotSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
 		WHERE r.uuid = $1 AND il.deleted_at IS NULL
 		ORDER BY il.received_at DESC`,
 		receiptUUID,
@@ -165,7 +165,7 @@ func (c *Client) ListIngredientLotsByReceipt(ctx context.Context, receiptUUID st
 }
 
 func (c *Client) ListIngredientLotsByPurchaseOrderLineUUID(ctx context.Context, purchaseOrderLineUUID string) ([]IngredientLot, error) {
-	rows, err := c.DB().Query(ctx, ingredientLotSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
 		WHERE il.purchase_order_line_uuid = $1 AND il.deleted_at IS NULL
 		ORDER BY il.received_at DESC`,
 		purchaseOrderLineUUID,
@@ -178,6 +178,22 @@ func (c *Client) ListIngredientLotsByPurchaseOrderLineUUID(ctx context.Context,
 	return c.scanIngredientLotRows(rows)
 }
 
+// ListIngredientLotsByLotCode returns lots whose brewery or originator lot
+// code is code.
+func (c *Client) ListIngredientLotsByLotCode(ctx context.Context, code string) ([]IngredientLot, error) {
+	rows, err := c.Conn(ctx).Query(ctx, ingredientLotSelectSQL+`
+		WHERE (il.brewery_lot_code = $1 OR il.originator_lot_code = $1) AND il.deleted_at IS NULL
+		ORDER BY il.received_at DESC`,
+		code,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing ingredient lots by lot code: %w", err)
+	}
+	defer rows.Close()
+
+	return c.scanIngredientLotRows(rows)
+}
+
 const ingredientLotSelectSQL = `
 	SELECT il.id, il.uuid, il.ingredient_id, i.uuid, il.receipt_id, r.uuid,
 	       il.supplier_uuid, il.purchase_order_line_uuid,
@@ -0,0 +1,319 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/database"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrDuplicateReorderPolicy is returned when the ingredient already has a
+// policy for the same scope (all locations, or the same stock location).
+var ErrDuplicateReorderPolicy = fmt.Errorf("reorder policy already exists for this ingredient and location")
+
+// UpdateReorderPolicyRequest describes the mutable fields for a PATCH update.
+// The ingredient and location scope cannot change.
+type UpdateReorderPolicyRequest struct {
+	MinLevel              *int64
+	MaxLevel              *int64
+	SafetyStock           *int64
+	PreferredSupplierUUID *string
+	LeadTimeDays          *int
+	Notes                 *string
+}
+
+// ReorderPosition is a policy together with the current stock position and
+// recent usage in its scope, computed from the movement ledger. Stock is kept
+// in the unit it was received in, so there is one quantity per unit.
+type ReorderPosition struct {
+	Policy     IngredientReorderPolicy
+	Quantities []ReorderQuantity
+}
+
+// ReorderQuantity is the stock position and recent usage in one unit.
+type ReorderQuantity struct {
+	Unit     string
+	OnHand   int64
+	Reserved int64
+	// Used is the quantity consumed by production ('use' movements) since the
+	// start of the usage window.
+	Used int64
+}
+
+// reorderPolicyColumns is the column list shared by reorder policy queries.
+const reorderPolicyColumns = `p.id, p.uuid, p.ingredient_id, i.uuid, i.name, i.default_unit,
+	p.stock_location_id, sl.uuid, sl.name, p.min_level, p.max_level, p.safety_stock,
+	p.preferred_supplier_uuid, p.lead_time_days, p.notes, p.created_at, p.updated_at, p.deleted_at`
+
+// reorderPolicyJoins is the JOIN clause shared by reorder policy queries.
+const reorderPolicyJoins = `
+	FROM ingredient_reorder_policy p
+	JOIN ingredient i ON i.id = p.ingredient_id
+	LEFT JOIN stock_location sl ON sl.id = p.stock_location_id`
+
+func scanReorderPolicy(row pgx.Row, extra ...any) (IngredientReorderPolicy, error) {
+	var p IngredientReorderPolicy
+	var supplierUUID pgtype.UUID
+	dest := []any{
+		&p.ID,
+		&p.UUID,
+		&p.IngredientID,
+		&p.IngredientUUID,
+		&p.IngredientName,
+		&p.DefaultUnit,
+		&p.StockLocationID,
+		&p.StockLocationUUID,
+		&p.StockLocationName,
+		&p.MinLevel,
+		&p.MaxLevel,
+		&p.SafetyStock,
+		&supplierUUID,
+		&p.LeadTimeDays,
+		&p.Notes,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return IngredientReorderPolicy{}, err
+	}
+	database.AssignUUIDPointer(&p.PreferredSupplierUUID, supplierUUID)
+	return p, nil
+}
+
+func isReorderPolicyConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" &&
+		(pgErr.ConstraintName == "ingredient_reorder_policy_ingredient_idx" ||
+			pgErr.ConstraintName == "ingredient_reorder_policy_ingredient_location_idx")
+}
+
+func (c *Client) CreateReorderPolicy(ctx context.Context, policy IngredientReorderPolicy) (IngredientReorderPolicy, error) {
+	var policyUUID string
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO ingredient_reorder_policy (
+			ingredient_id,
+			stock_location_id,
+			min_level,
+			max_level,
+			safety_stock,
+			preferred_supplier_uuid,
+			lead_time_days,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING uuid`,
+		policy.IngredientID,
+		policy.StockLocationID,
+		policy.MinLevel,
+		policy.MaxLevel,
+		policy.SafetyStock,
+		database.UUIDParam(policy.PreferredSupplierUUID),
+		policy.LeadTimeDays,
+		policy.Notes,
+	).Scan(&policyUUID)
+	if err != nil {
+		if isReorderPolicyConflict(err) {
+			return IngredientReorderPolicy{}, ErrDuplicateReorderPolicy
+		}
+		return IngredientReorderPolicy{}, fmt.Errorf("creating reorder policy: %w", err)
+	}
+
+	return c.GetReorderPolicyByUUID(ctx, policyUUID)
+}
+
+func (c *Client) GetReorderPolicyByUUID(ctx context.Context, policyUUID string) (IngredientReorderPolicy, error) {
+	policy, err := scanReorderPolicy(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+reorderPolicyColumns+reorderPolicyJoins+`
+		WHERE p.uuid = $1 AND p.deleted_at IS NULL`,
+		policyUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return IngredientReorderPolicy{}, service.ErrNotFound
+		}
+		return IngredientReorderPolicy{}, fmt.Errorf("getting reorder policy by uuid: %w", err)
+	}
+
+	return policy, nil
+}
+
+// ListReorderPolicies returns reorder policies ordered by ingredient name,
+// with the all-locations policy before location-specific ones. When
+// ingredientUUID is non-nil only that ingredient's policies are returned.
+func (c *Client) ListReorderPolicies(ctx context.Context, ingredientUUID *string) ([]IngredientReorderPolicy, error) {
+	query := `SELECT ` + reorderPolicyColumns + reorderPolicyJoins + `
+		WHERE p.deleted_at IS NULL`
+	args := []any{}
+	if ingredientUUID != nil {
+		query += ` AND i.uuid = $1`
+		args = append(args, *ingredientUUID)
+	}
+	query += ` ORDER BY i.name, sl.name NULLS FIRST`
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing reorder policies: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []IngredientReorderPolicy
+	for rows.Next() {
+		policy, err := scanReorderPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning reorder policy: %w", err)
+		}
+		policies = append(policies, policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing reorder policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+func (c *Client) UpdateReorderPolicy(ctx context.Context, policyUUID string, req UpdateReorderPolicyRequest) (IngredientReorderPolicy, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE ingredient_reorder_policy SET
+			min_level = COALESCE($1, min_level),
+			max_level = COALESCE($2, max_level),
+			safety_stock = COALESCE($3, safety_stock),
+			preferred_supplier_uuid = COALESCE($4::uuid, preferred_supplier_uuid),
+			lead_time_days = COALESCE($5, lead_time_days),
+			notes = COALESCE($6, notes),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $7 AND deleted_at IS NULL`,
+		req.MinLevel,
+		req.MaxLevel,
+		req.SafetyStock,
+		req.PreferredSupplierUUID,
+		req.LeadTimeDays,
+		req.Notes,
+		policyUUID,
+	)
+	if err != nil {
+		return IngredientReorderPolicy{}, fmt.Errorf("updating reorder policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return IngredientReorderPolicy{}, service.ErrNotFound
+	}
+
+	return c.GetReorderPolicyByUUID(ctx, policyUUID)
+}
+
+func (c *Client) SoftDeleteReorderPolicy(ctx context.Context, policyUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE ingredient_reorder_policy SET deleted_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		policyUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting reorder policy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
+
+// ListReorderPositions returns every reorder policy with the on-hand,
+// reserved, and used quantities in its scope, per unit. A policy without a
+// stock location covers all locations. Usage counts 'use' and 'package'
+// movements on or after usedSince.
+func (c *Client) ListReorderPositions(ctx context.Context, usedSince time.Time) ([]ReorderPosition, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		WITH quantity AS (
+			SELECT il.ingredient_id, m.stock_location_id, m.amount_unit AS unit,
+				SUM(CASE m.direction WHEN 'in' THEN m.amount WHEN 'out' THEN -m.amount END) AS on_hand,
+				0 AS reserved,
+				SUM(CASE WHEN m.direction = 'out' AND m.reason IN ('use', 'package') AND m.occurred_at >= $1 THEN m.amount ELSE 0 END) AS used
+			FROM inventory_movement m
+			JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
+			WHERE m.deleted_at IS NULL
+			  AND il.deleted_at IS NULL
+			GROUP BY il.ingredient_id, m.stock_location_id, m.amount_unit
+			UNION ALL
+			SELECT il.ingredient_id, r.stock_location_id, r.amount_unit,
+				0, SUM(r.amount - r.consumed_amount), 0
+			FROM inventory_reservation r
+			JOIN ingredient_lot il ON il.id = r.ingredient_lot_id
+			WHERE r.status = 'active'
+			  AND r.deleted_at IS NULL
+			GROUP BY il.ingredient_id, r.stock_location_id, r.amount_unit
+		)
+		SELECT `+reorderPolicyColumns+`,
+			q.unit, COALESCE(SUM(q.on_hand), 0), COALESCE(SUM(q.reserved), 0), COALESCE(SUM(q.used), 0)`+reorderPolicyJoins+`
+		LEFT JOIN quantity q ON q.ingredient_id = p.ingredient_id
+			AND (p.stock_location_id IS NULL OR q.stock_location_id = p.stock_location_id)
+		WHERE p.deleted_at IS NULL
+		GROUP BY p.id, i.id, sl.id, q.unit
+		ORDER BY i.name, sl.name NULLS FIRST, p.id, q.unit`,
+		usedSince,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing reorder positions: %w", err)
+	}
+	defer rows.Close()
+
+	var positions []ReorderPosition
+	for rows.Next() {
+		var unit *string
+		var qty ReorderQuantity
+		policy, err := scanReorderPolicy(rows, &unit, &qty.OnHand, &qty.Reserved, &qty.Used)
+		if err != nil {
+			return nil, fmt.Errorf("scanning reorder position: %w", err)
+		}
+		if n := len(positions); n == 0 || positions[n-1].Policy.ID != policy.ID {
+			positions = append(positions, ReorderPosition{Policy: policy})
+		}
+		if unit != nil {
+			qty.Unit = *unit
+			pos := &positions[len(positions)-1]
+			pos.Quantities = append(pos.Quantities, qty)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing reorder positions: %w", err)
+	}
+
+	return positions, nil
+}
+
+// SumReceivedByPurchaseOrderLine returns the total received amount of
+// ingredient lots for each of the given purchase order lines and each unit
+// they were received in, keyed by "line UUID|unit". Lines with no receipts
+// are omitted.
+func (c *Client) SumReceivedByPurchaseOrderLine(ctx context.Context, lineUUIDs []string) (map[string]int64, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT purchase_order_line_uuid, received_unit, SUM(received_amount)
+		FROM ingredient_lot
+		WHERE purchase_order_line_uuid = ANY($1::uuid[])
+		  AND deleted_at IS NULL
+		GROUP BY purchase_order_line_uuid, received_unit`,
+		lineUUIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("summing received amounts: %w", err)
+	}
+	defer rows.Close()
+
+	received := make(map[string]int64)
+	for rows.Next() {
+		var lineUUID, unit string
+		var amount int64
+		if err := rows.Scan(&lineUUID, &unit, &amount); err != nil {
+			return nil, fmt.Errorf("scanning received amount: %w", err)
+		}
+		received[lineUUID+"|"+unit] = amount
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("summing received amounts: %w", err)
+	}
+
+	return received, nil
+}
@@ -38,7 +38,7 @@ type AdjustmentWithMovementResult struct {
 // adjustment record and a corresponding inventory movement within a single
 // transaction.
 func (c *Client) CreateInventoryAdjustmentWithMovement(ctx context.Context, req AdjustmentWithMovementRequest) (AdjustmentWithMovementResult, error) {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return AdjustmentWithMovementResult{}, fmt.Errorf("starting adjustment transaction: %w", err)
 	}
@@ -153,7 +153,7 @@ func (c *Client) CreateInventoryAdjustmentWithMovement(ctx context.Context, req
 
 func (c *Client) GetInventoryAdjustment(ctx context.Context, id int64) (InventoryAdjustment, error) {
 	var adjustment InventoryAdjustment
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, reason, adjusted_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_adjustment
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -180,7 +180,7 @@ func (c *Client) GetInventoryAdjustment(ctx context.Context, id int64) (Inventor
 
 func (c *Client) GetInventoryAdjustmentByUUID(ctx context.Context, adjustmentUUID string) (InventoryAdjustment, error) {
 	var adjustment InventoryAdjustment
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, reason, adjusted_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_adjustment
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -206,7 +206,7 @@ func (c *Client) GetInventoryAdjustmentByUUID(ctx context.Context, adjustmentUUI
 }
 
 func (c *Client) ListInventoryAdjustments(ctx context.Context) ([]InventoryAdjustment, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, reason, adjusted_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_adjustment
 		WHERE deleted_at IS NULL
@@ -31,6 +31,9 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 	if movement.RemovalID != nil {
 		referenceCount++
 	}
+	if movement.ReturnID != nil {
+		referenceCount++
+	}
 	if referenceCount > 1 {
 		return InventoryMovement{}, fmt.Errorf("inventory movement must have at most one reference")
 	}
@@ -56,6 +59,10 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 		if movement.RemovalID == nil {
 			return InventoryMovement{}, fmt.Errorf("removal movement must reference removal")
 		}
+	case MovementReasonReturn:
+		if movement.ReturnID == nil {
+			return InventoryMovement{}, fmt.Errorf("return movement must reference supplier return")
+		}
 	}
 
 	occurredAt := movement.OccurredAt
@@ -63,7 +70,7 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 		occurredAt = time.Now().UTC()
 	}
 
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO inventory_movement (
 			ingredient_lot_id,
 			beer_lot_id,
@@ -78,9 +85,10 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 			adjustment_id,
 			transfer_id,
 			removal_id,
+			return_id,
 			notes
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
-		RETURNING id, uuid, ingredient_lot_id, beer_lot_id, stock_location_id, direction, reason, amount, amount_unit, occurred_at, receipt_id, usage_id, adjustment_id, transfer_id, removal_id, notes, created_at, updated_at, deleted_at`,
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, uuid, ingredient_lot_id, beer_lot_id, stock_location_id, direction, reason, amount, amount_unit, occurred_at, receipt_id, usage_id, adjustment_id, transfer_id, removal_id, return_id, notes, created_at, updated_at, deleted_at`,
 		movement.IngredientLotID,
 		movement.BeerLotID,
 		movement.StockLocationID,
@@ -94,6 +102,7 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 		movement.AdjustmentID,
 		movement.TransferID,
 		movement.RemovalID,
+		movement.ReturnID,
 		movement.Notes,
 	).Scan(
 		&movement.ID,
@@ -111,6 +120,7 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 		&movement.AdjustmentID,
 		&movement.TransferID,
 		&movement.RemovalID,
+		&movement.ReturnID,
 		&movement.Notes,
 		&movement.CreatedAt,
 		&movement.UpdatedAt,
@@ -126,17 +136,17 @@ func (c *Client) CreateInventoryMovement(ctx context.Context, movement Inventory
 }
 
 func (c *Client) GetInventoryMovement(ctx context.Context, id int64) (InventoryMovement, error) {
-	return c.scanMovementRow(c.DB().QueryRow(ctx, movementSelectSQL+`
+	return c.scanMovementRow(c.Conn(ctx).QueryRow(ctx, movementSelectSQL+`
 		WHERE m.id = $1 AND m.deleted_at IS NULL`, id))
 }
 
 func (c *Client) GetInventoryMovementByUUID(ctx context.Context, movementUUID string) (InventoryMovement, error) {
-	return c.scanMovementRow(c.DB().QueryRow(ctx, movementSelectSQL+`
+	return c.scanMovementRow(c.Conn(ctx).QueryRow(ctx, movementSelectSQL+`
 		WHERE m.uuid = $1 AND m.deleted_at IS NULL`, movementUUID))
 }
 
 func (c *Client) ListInventoryMovements(ctx context.Context) ([]InventoryMovement, error) {
-	rows, err := c.DB().Query(ctx, movementSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, movementSelectSQL+`
 		WHERE m.deleted_at IS NULL
 		ORDER BY m.occurred_at DESC`)
 	if err != nil {
@@ -148,7 +158,7 @@ func (c *Client) ListInventoryMovements(ctx context.Context) ([]InventoryMovemen
 }
 
 func (c *Client) ListInventoryMovementsByIngredientLot(ctx context.Context, lotUUID string) ([]InventoryMovement, error) {
-	rows, err := c.DB().Query(ctx, movementSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, movementSelectSQL+`
 		WHERE il.uuid = $1 AND m.deleted_at IS NULL
 		ORDER BY m.occurred_at ASC`,
 		lotUUID,
@@ -162,7 +172,7 @@ func (c *Client) ListInventoryMovementsByIngredientLot(ctx context.Context, lotU
 }
 
 func (c *Client) ListInventoryMovementsByBeerLot(ctx context.Context, lotUUID string) ([]InventoryMovement, error) {
-	rows, err := c.DB().Query(ctx, movementSelectSQL+`
+	rows, err := c.Conn(ctx).Query(ctx, movementSelectSQL+`
 		WHERE bl.uuid = $1 AND m.deleted_at IS NULL
 		ORDER BY m.occurred_at ASC`,
 		lotUUID,
@@ -186,6 +196,7 @@ const movementSelectSQL = `
 	       m.adjustment_id, aj.uuid,
 	       m.transfer_id, tr.uuid,
 	       m.removal_id, rm.uuid,
+	       m.return_id, sr.uuid,
 	       m.notes, m.created_at, m.updated_at, m.deleted_at
 	FROM inventory_movement m
 	LEFT JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
@@ -196,6 +207,7 @@ const movementSelectSQL = `
 	LEFT JOIN inventory_adjustment aj ON aj.id = m.adjustment_id
 	LEFT JOIN inventory_transfer tr ON tr.id = m.transfer_id
 	LEFT JOIN inventory_removal rm ON rm.id = m.removal_id
+	LEFT JOIN supplier_return sr ON sr.id = m.return_id
 `
 
 func (c *Client) scanMovementRow(row pgx.Row) (InventoryMovement, error) {
@@ -224,6 +236,8 @@ func (c *Client) scanMovementRow(row pgx.Row) (InventoryMovement, error) {
 		&movement.TransferUUID,
 		&movement.RemovalID,
 		&movement.RemovalUUID,
+		&movement.ReturnID,
+		&movement.ReturnUUID,
 		&movement.Notes,
 		&movement.CreatedAt,
 		&movement.UpdatedAt,
@@ -267,6 +281,8 @@ func (c *Client) scanMovementRows(rows pgx.Rows) ([]InventoryMovement, error) {
 			&movement.TransferUUID,
 			&movement.RemovalID,
 			&movement.RemovalUUID,
+			&movement.ReturnID,
+			&movement.ReturnUUID,
 			&movement.Notes,
 			&movement.CreatedAt,
 			&movement.UpdatedAt,
@@ -287,14 +303,14 @@ func (c *Client) scanMovementRows(rows pgx.Rows) ([]InventoryMovement, error) {
 func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement) {
 	// Stock location UUID (required)
 	var slUUID string
-	if err := c.DB().QueryRow(ctx, `SELECT uuid FROM stock_location WHERE id = $1`, m.StockLocationID).Scan(&slUUID); err == nil {
+	if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM stock_location WHERE id = $1`, m.StockLocationID).Scan(&slUUID); err == nil {
 		m.StockLocationUUID = slUUID
 	}
 
 	// Ingredient lot UUID (optional)
 	if m.IngredientLotID != nil {
 		var ilUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, *m.IngredientLotID).Scan(&ilUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM ingredient_lot WHERE id = $1`, *m.IngredientLotID).Scan(&ilUUID); err == nil {
 			m.IngredientLotUUID = &ilUUID
 		}
 	}
@@ -302,7 +318,7 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Beer lot UUID (optional)
 	if m.BeerLotID != nil {
 		var blUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM beer_lot WHERE id = $1`, *m.BeerLotID).Scan(&blUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM beer_lot WHERE id = $1`, *m.BeerLotID).Scan(&blUUID); err == nil {
 			m.BeerLotUUID = &blUUID
 		}
 	}
@@ -310,7 +326,7 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Receipt UUID (optional)
 	if m.ReceiptID != nil {
 		var rcUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_receipt WHERE id = $1`, *m.ReceiptID).Scan(&rcUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_receipt WHERE id = $1`, *m.ReceiptID).Scan(&rcUUID); err == nil {
 			m.ReceiptUUID = &rcUUID
 		}
 	}
@@ -318,7 +334,7 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Usage UUID (optional)
 	if m.UsageID != nil {
 		var usUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_usage WHERE id = $1`, *m.UsageID).Scan(&usUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_usage WHERE id = $1`, *m.UsageID).Scan(&usUUID); err == nil {
 			m.UsageUUID = &usUUID
 		}
 	}
@@ -326,7 +342,7 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Adjustment UUID (optional)
 	if m.AdjustmentID != nil {
 		var ajUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_adjustment WHERE id = $1`, *m.AdjustmentID).Scan(&ajUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_adjustment WHERE id = $1`, *m.AdjustmentID).Scan(&ajUUID); err == nil {
 			m.AdjustmentUUID = &ajUUID
 		}
 	}
@@ -334,7 +350,7 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Transfer UUID (optional)
 	if m.TransferID != nil {
 		var trUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_transfer WHERE id = $1`, *m.TransferID).Scan(&trUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_transfer WHERE id = $1`, *m.TransferID).Scan(&trUUID); err == nil {
 			m.TransferUUID = &trUUID
 		}
 	}
@@ -342,8 +358,16 @@ func (c *Client) resolveMovementUUIDs(ctx context.Context, m *InventoryMovement)
 	// Removal UUID (optional)
 	if m.RemovalID != nil {
 		var rmUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM inventory_removal WHERE id = $1`, *m.RemovalID).Scan(&rmUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM inventory_removal WHERE id = $1`, *m.RemovalID).Scan(&rmUUID); err == nil {
 			m.RemovalUUID = &rmUUID
 		}
 	}
+
+	// Supplier return UUID (optional)
+	if m.ReturnID != nil {
+		var srUUID string
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM supplier_return WHERE id = $1`, *m.ReturnID).Scan(&srUUID); err == nil {
+			m.ReturnUUID = &srUUID
+		}
+	}
 }
@@ -20,7 +20,7 @@ func (c *Client) CreateInventoryReceipt(ctx context.Context, receipt InventoryRe
 
 	var supplierUUID pgtype.UUID
 	var purchaseOrderUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO inventory_receipt (
 			supplier_uuid,
 			purchase_order_uuid,
@@ -59,7 +59,7 @@ func (c *Client) GetInventoryReceipt(ctx context.Context, id int64) (InventoryRe
 	var receipt InventoryReceipt
 	var supplierUUID pgtype.UUID
 	var purchaseOrderUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, supplier_uuid, purchase_order_uuid, reference_code, received_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_receipt
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -92,7 +92,7 @@ func (c *Client) GetInventoryReceiptByUUID(ctx context.Context, receiptUUID stri
 	var receipt InventoryReceipt
 	var supplierUUID pgtype.UUID
 	var purchaseOrderUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, supplier_uuid, purchase_order_uuid, reference_code, received_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_receipt
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -122,7 +122,7 @@ func (c *Client) GetInventoryReceiptByUUID(ctx context.Context, receiptUUID stri
 }
 
 func (c *Client) ListInventoryReceipts(ctx context.Context) ([]InventoryReceipt, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, supplier_uuid, purchase_order_uuid, reference_code, received_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_receipt
 		WHERE deleted_at IS NULL
@@ -164,7 +164,7 @@ func (c *Client) ListInventoryReceipts(ctx context.Context) ([]InventoryReceipt,
 }
 
 func (c *Client) ListInventoryReceiptsByPurchaseOrderUUID(ctx context.Context, purchaseOrderUUID string) ([]InventoryReceipt, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, supplier_uuid, purchase_order_uuid, reference_code, received_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_receipt
 		WHERE purchase_order_uuid = $1 AND deleted_at IS NULL
@@ -157,7 +157,7 @@ func scanRemovalRows(rows pgx.Rows) ([]InventoryRemoval, error) {
 // when a beer lot is referenced, a corresponding inventory movement within a
 // single transaction.
 func (c *Client) CreateRemovalWithMovement(ctx context.Context, req RemovalWithMovementRequest) (RemovalWithMovementResult, error) {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return RemovalWithMovementResult{}, fmt.Errorf("starting removal transaction: %w", err)
 	}
@@ -278,7 +278,7 @@ func (c *Client) CreateRemovalWithMovement(ctx context.Context, req RemovalWithM
 
 // GetRemovalByUUID returns a single removal by UUID.
 func (c *Client) GetRemovalByUUID(ctx context.Context, removalUUID string) (InventoryRemoval, error) {
-	removal, err := scanRemoval(c.DB().QueryRow(ctx, `
+	removal, err := scanRemoval(c.Conn(ctx).QueryRow(ctx, `
 		SELECT `+removalColumns+removalJoins+`
 		WHERE r.uuid = $1 AND r.deleted_at IS NULL`,
 		removalUUID,
@@ -327,7 +327,7 @@ func (c *Client) ListRemovals(ctx context.Context, filter RemovalListFilter) ([]
 
 	query += ` ORDER BY r.removed_at DESC`
 
-	rows, err := c.DB().Query(ctx, query, args...)
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("listing removals: %w", err)
 	}
@@ -383,7 +383,7 @@ func (c *Client) UpdateRemoval(ctx context.Context, removalUUID string, req Upda
 		existing.Notes = req.Notes
 	}
 
-	err = c.DB().QueryRow(ctx, `
+	err = c.Conn(ctx).QueryRow(ctx, `
 		UPDATE inventory_removal SET
 			category = $1, reason = $2, amount = $3, amount_unit = $4,
 			amount_bbl = $5, is_taxable = $6, reference_code = $7,
@@ -455,7 +455,7 @@ type UpdateRemovalRequest struct {
 
 // SoftDeleteRemoval soft-deletes a removal and its linked movement.
 func (c *Client) SoftDeleteRemoval(ctx context.Context, removalUUID string) error {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("starting removal delete transaction: %w", err)
 	}
@@ -535,7 +535,7 @@ func (c *Client) GetRemovalSummary(ctx context.Context, filter RemovalListFilter
 	}
 
 	var summary RemovalSummary
-	err := c.DB().QueryRow(ctx, query, args...).Scan(
+	err := c.Conn(ctx).QueryRow(ctx, query, args...).Scan(
 		&summary.TotalBBL,
 		&summary.TaxableBBL,
 		&summary.TaxFreeBBL,
@@ -584,7 +584,7 @@ func (c *Client) GetRemovalSummary(ctx context.Context, filter RemovalListFilter
 
 	catQuery += ` GROUP BY category ORDER BY category`
 
-	rows, err := c.DB().Query(ctx, catQuery, catArgs...)
+	rows, err := c.Conn(ctx).Query(ctx, catQuery, catArgs...)
 	if err != nil {
 		return RemovalSummary{}, fmt.Errorf("getting removal category summary: %w", err)
 	}
@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrReservationExceedsAvailable is returned when a reservation asks for more
+// than the lot's available-to-promise quantity at the location.
+var ErrReservationExceedsAvailable = fmt.Errorf("reservation exceeds available stock")
+
+// ErrReservationNotActive is returned when releasing a reservation that has
+// already been consumed or released.
+var ErrReservationNotActive = fmt.Errorf("reservation is not active")
+
+// ReservationListFilter describes optional filters for listing reservations.
+type ReservationListFilter struct {
+	ProductionRefUUID *string
+	IngredientLotUUID *string
+	Status            *string
+}
+
+// reservationColumns is the column list shared by reservation queries.
+const reservationColumns = `r.id, r.uuid, r.production_ref_uuid, r.ingredient_lot_id, il.uuid,
+	r.stock_location_id, sl.uuid, r.amount, r.amount_unit, r.consumed_amount, r.status,
+	r.released_at, r.notes, r.created_at, r.updated_at, r.deleted_at`
+
+// reservationJoins is the JOIN clause shared by reservation queries.
+const reservationJoins = `
+	FROM inventory_reservation r
+	JOIN ingredient_lot il ON il.id = r.ingredient_lot_id
+	JOIN stock_location sl ON sl.id = r.stock_location_id`
+
+func scanReservation(row pgx.Row) (InventoryReservation, error) {
+	var res InventoryReservation
+	err := row.Scan(
+		&res.ID,
+		&res.UUID,
+		&res.ProductionRefUUID,
+		&res.IngredientLotID,
+		&res.IngredientLotUUID,
+		&res.StockLocationID,
+		&res.StockLocationUUID,
+		&res.Amount,
+		&res.AmountUnit,
+		&res.ConsumedAmount,
+		&res.Status,
+		&res.ReleasedAt,
+		&res.Notes,
+		&res.CreatedAt,
+		&res.UpdatedAt,
+		&res.DeletedAt,
+	)
+	return res, err
+}
+
+// CreateReservation places a soft hold on lot stock at a location. The lot row
+// is locked so concurrent reservations cannot promise the same stock twice.
+func (c *Client) CreateReservation(ctx context.Context, res InventoryReservation) (InventoryReservation, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return InventoryReservation{}, fmt.Errorf("starting reservation transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `SELECT id FROM ingredient_lot WHERE id = $1 FOR UPDATE`, res.IngredientLotID); err != nil {
+		return InventoryReservation{}, fmt.Errorf("locking ingredient lot: %w", err)
+	}
+
+	var onHand, reserved int64
+	err = tx.QueryRow(ctx, `
+		SELECT
+			COALESCE((
+				SELECT SUM(CASE direction WHEN 'in' THEN amount WHEN 'out' THEN -amount END)
+				FROM inventory_movement
+				WHERE ingredient_lot_id = $1 AND stock_location_id = $2 AND deleted_at IS NULL
+			), 0),
+			COALESCE((
+				SELECT SUM(amount - consumed_amount)
+				FROM inventory_reservation
+				WHERE ingredient_lot_id = $1 AND stock_location_id = $2
+				  AND status = 'active' AND deleted_at IS NULL
+			), 0)`,
+		res.IngredientLotID,
+		res.StockLocationID,
+	).Scan(&onHand, &reserved)
+	if err != nil {
+		return InventoryReservation{}, fmt.Errorf("checking available stock: %w", err)
+	}
+
+	if available := onHand - reserved; res.Amount > available {
+		return InventoryReservation{}, fmt.Errorf("%w: available %d %s, requested %d %s",
+			ErrReservationExceedsAvailable, max(available, 0), res.AmountUnit, res.Amount, res.AmountUnit)
+	}
+
+	var resUUID string
+	err = tx.QueryRow(ctx, `
+		INSERT INTO inventory_reservation (
+			production_ref_uuid,
+			ingredient_lot_id,
+			stock_location_id,
+			amount,
+			amount_unit,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING uuid`,
+		res.ProductionRefUUID,
+		res.IngredientLotID,
+		res.StockLocationID,
+		res.Amount,
+		res.AmountUnit,
+		res.Notes,
+	).Scan(&resUUID)
+	if err != nil {
+		return InventoryReservation{}, fmt.Errorf("creating reservation: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return InventoryReservation{}, fmt.Errorf("committing reservation: %w", err)
+	}
+
+	return c.GetReservationByUUID(ctx, resUUID)
+}
+
+func (c *Client) GetReservationByUUID(ctx context.Context, resUUID string) (InventoryReservation, error) {
+	res, err := scanReservation(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+reservationColumns+reservationJoins+`
+		WHERE r.uuid = $1 AND r.deleted_at IS NULL`,
+		resUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return InventoryReservation{}, service.ErrNotFound
+		}
+		return InventoryReservation{}, fmt.Errorf("getting reservation by uuid: %w", err)
+	}
+
+	return res, nil
+}
+
+// ListReservations returns reservations matching the given filters, newest first.
+func (c *Client) ListReservations(ctx context.Context, filter ReservationListFilter) ([]InventoryReservation, error) {
+	query := `SELECT ` + reservationColumns + reservationJoins + `
+		WHERE r.deleted_at IS NULL`
+	args := []any{}
+	argIdx := 1
+
+	if filter.ProductionRefUUID != nil {
+		query += fmt.Sprintf(` AND r.production_ref_uuid = $%d`, argIdx)
+		args = append(args, *filter.ProductionRefUUID)
+		argIdx++
+	}
+	if filter.IngredientLotUUID != nil {
+		query += fmt.Sprintf(` AND il.uuid = $%d`, argIdx)
+		args = append(args, *filter.IngredientLotUUID)
+		argIdx++
+	}
+	if filter.Status != nil {
+		query += fmt.Sprintf(` AND r.status = $%d`, argIdx)
+		args = append(args, *filter.Status)
+		argIdx++
+	}
+
+	query += ` ORDER BY r.created_at DESC`
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing reservations: %w", err)
+	}
+	defer rows.Close()
+
+	var reservations []InventoryReservation
+	for rows.Next() {
+		res, err := scanReservation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning reservation: %w", err)
+		}
+		reservations = append(reservations, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing reservations: %w", err)
+	}
+
+	return reservations, nil
+}
+
+// ReleaseReservation releases an active reservation, returning its quantity to
+// available-to-promise.
+func (c *Client) ReleaseReservation(ctx context.Context, resUUID string) (InventoryReservation, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE inventory_reservation SET
+			status = 'released',
+			released_at = timezone('utc', now()),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND status = 'active' AND deleted_at IS NULL`,
+		resUUID,
+	)
+	if err != nil {
+		return InventoryReservation{}, fmt.Errorf("releasing reservation: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		res, err := c.GetReservationByUUID(ctx, resUUID)
+		if err != nil {
+			return InventoryReservation{}, err
+		}
+		return InventoryReservation{}, fmt.Errorf("%w: reservation is %s", ErrReservationNotActive, res.Status)
+	}
+
+	return c.GetReservationByUUID(ctx, resUUID)
+}
+
+// ReleaseReservationsForProduction releases every active reservation held for
+// a production batch and returns how many were released.
+func (c *Client) ReleaseReservationsForProduction(ctx context.Context, productionRefUUID string) (int64, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE inventory_reservation SET
+			status = 'released',
+			released_at = timezone('utc', now()),
+			updated_at = timezone('utc', now())
+		WHERE production_ref_uuid = $1 AND status = 'active' AND deleted_at IS NULL`,
+		productionRefUUID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("releasing reservations for production: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// consumeReservations draws down the batch's active reservations on a lot at a
+// location by amount, oldest first. Reservations that are fully drawn down are
+// marked consumed. Usage beyond the reserved quantity is not an error.
+func consumeReservations(ctx context.Context, tx pgx.Tx, productionRefUUID string, lotID, locationID, amount int64) error {
+	rows, err := tx.Query(ctx, `
+		SELECT id, amount - consumed_amount
+		FROM inventory_reservation
+		WHERE production_ref_uuid = $1
+		  AND ingredient_lot_id = $2
+		  AND stock_location_id = $3
+		  AND status = 'active'
+		  AND deleted_at IS NULL
+		ORDER BY created_at, id
+		FOR UPDATE`,
+		productionRefUUID,
+		lotID,
+		locationID,
+	)
+	if err != nil {
+		return fmt.Errorf("locking reservations: %w", err)
+	}
+
+	type outstanding struct {
+		id     int64
+		amount int64
+	}
+	var held []outstanding
+	for rows.Next() {
+		var o outstanding
+		if err := rows.Scan(&o.id, &o.amount); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning reservation: %w", err)
+		}
+		held = append(held, o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("listing reservations to consume: %w", err)
+	}
+
+	for _, o := range held {
+		if amount <= 0 {
+			break
+		}
+		take := min(amount, o.amount)
+		amount -= take
+
+		_, err := tx.Exec(ctx, `
+			UPDATE inventory_reservation SET
+				consumed_amount = consumed_amount + $1,
+				status = CASE WHEN consumed_amount + $1 >= amount THEN 'consumed' ELSE status END,
+				updated_at = timezone('utc', now())
+			WHERE id = $2`,
+			take,
+			o.id,
+		)
+		if err != nil {
+			return fmt.Errorf("consuming reservation: %w", err)
+		}
+	}
+
+	return nil
+}
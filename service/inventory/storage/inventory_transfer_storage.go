@@ -40,7 +40,7 @@ type TransferWithMovementsResult struct {
 // record and two corresponding inventory movements (out from source, in to
 // destination) within a single transaction.
 func (c *Client) CreateInventoryTransferWithMovements(ctx context.Context, req TransferWithMovementsRequest) (TransferWithMovementsResult, error) {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return TransferWithMovementsResult{}, fmt.Errorf("starting transfer transaction: %w", err)
 	}
@@ -204,7 +204,7 @@ func (c *Client) CreateInventoryTransferWithMovements(ctx context.Context, req T
 
 func (c *Client) GetInventoryTransfer(ctx context.Context, id int64) (InventoryTransfer, error) {
 	var transfer InventoryTransfer
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT t.id, t.uuid, t.source_location_id, sl.uuid, t.dest_location_id, dl.uuid,
 		       t.transferred_at, t.notes, t.created_at, t.updated_at, t.deleted_at
 		FROM inventory_transfer t
@@ -237,7 +237,7 @@ func (c *Client) GetInventoryTransfer(ctx context.Context, id int64) (InventoryT
 
 func (c *Client) GetInventoryTransferByUUID(ctx context.Context, transferUUID string) (InventoryTransfer, error) {
 	var transfer InventoryTransfer
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT t.id, t.uuid, t.source_location_id, sl.uuid, t.dest_location_id, dl.uuid,
 		       t.transferred_at, t.notes, t.created_at, t.updated_at, t.deleted_at
 		FROM inventory_transfer t
@@ -269,7 +269,7 @@ func (c *Client) GetInventoryTransferByUUID(ctx context.Context, transferUUID st
 }
 
 func (c *Client) ListInventoryTransfers(ctx context.Context) ([]InventoryTransfer, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT t.id, t.uuid, t.source_location_id, sl.uuid, t.dest_location_id, dl.uuid,
 		       t.transferred_at, t.notes, t.created_at, t.updated_at, t.deleted_at
 		FROM inventory_transfer t
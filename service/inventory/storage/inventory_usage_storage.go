@@ -19,7 +19,7 @@ func (c *Client) CreateInventoryUsage(ctx context.Context, usage InventoryUsage)
 	}
 
 	var productionUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO inventory_usage (
 			production_ref_uuid,
 			used_at,
@@ -50,7 +50,7 @@ func (c *Client) CreateInventoryUsage(ctx context.Context, usage InventoryUsage)
 func (c *Client) GetInventoryUsage(ctx context.Context, id int64) (InventoryUsage, error) {
 	var usage InventoryUsage
 	var productionUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, production_ref_uuid, used_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_usage
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -79,7 +79,7 @@ func (c *Client) GetInventoryUsage(ctx context.Context, id int64) (InventoryUsag
 func (c *Client) GetInventoryUsageByUUID(ctx context.Context, usageUUID string) (InventoryUsage, error) {
 	var usage InventoryUsage
 	var productionUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, production_ref_uuid, used_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_usage
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -106,7 +106,7 @@ func (c *Client) GetInventoryUsageByUUID(ctx context.Context, usageUUID string)
 }
 
 func (c *Client) ListInventoryUsage(ctx context.Context) ([]InventoryUsage, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, production_ref_uuid, used_at, notes, created_at, updated_at, deleted_at
 		FROM inventory_usage
 		WHERE deleted_at IS NULL
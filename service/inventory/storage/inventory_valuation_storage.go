@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ValuationMovement is an ingredient lot movement together with the lot,
+// ingredient and location details needed to value it.
+type ValuationMovement struct {
+	MovementUUID          string
+	IngredientLotUUID     string
+	PurchaseOrderLineUUID *string
+	LotReceivedUnit       string
+	IngredientUUID        string
+	IngredientName        string
+	IngredientCategory    string
+	StockLocationUUID     string
+	StockLocationName     string
+	Direction             string
+	Reason                string
+	Amount                int64
+	AmountUnit            string
+	OccurredAt            time.Time
+	ProductionRefUUID     *string // Set on use and package movements recorded against production
+}
+
+// GetValuationMethod returns the costing method used to value inventory.
+func (c *Client) GetValuationMethod(ctx context.Context) (string, error) {
+	var method string
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT method
+		FROM inventory_valuation_setting
+		WHERE id`,
+	).Scan(&method)
+	if err != nil {
+		return "", fmt.Errorf("getting valuation method: %w", err)
+	}
+
+	return method, nil
+}
+
+// SetValuationMethod changes the costing method used to value inventory.
+// Reports are recomputed from the ledger, so the change applies to past
+// periods as well.
+func (c *Client) SetValuationMethod(ctx context.Context, method string) (string, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO inventory_valuation_setting (id, method)
+		VALUES (true, $1)
+		ON CONFLICT (id) DO UPDATE
+		SET method = EXCLUDED.method,
+			updated_at = timezone('utc', now())
+		RETURNING method`,
+		method,
+	).Scan(&method)
+	if err != nil {
+		return "", fmt.Errorf("setting valuation method: %w", err)
+	}
+
+	return method, nil
+}
+
+// ListValuationMovements returns every ingredient lot movement that occurred
+// before the given time, oldest first. Beer lot movements carry no cost and
+// are not included.
+func (c *Client) ListValuationMovements(ctx context.Context, before time.Time) ([]ValuationMovement, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT m.uuid, il.uuid, il.purchase_order_line_uuid, il.received_unit,
+		       i.uuid, i.name, i.category,
+		       sl.uuid, sl.name,
+		       m.direction, m.reason, m.amount, m.amount_unit, m.occurred_at,
+		       iu.production_ref_uuid
+		FROM inventory_movement m
+		JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
+		JOIN ingredient i ON i.id = il.ingredient_id
+		JOIN stock_location sl ON sl.id = m.stock_location_id
+		LEFT JOIN inventory_usage iu ON iu.id = m.usage_id
+		WHERE m.deleted_at IS NULL
+		  AND il.deleted_at IS NULL
+		  AND m.occurred_at < $1
+		ORDER BY m.occurred_at ASC, m.id ASC`,
+		before,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing valuation movements: %w", err)
+	}
+	defer rows.Close()
+
+	var movements []ValuationMovement
+	for rows.Next() {
+		var m ValuationMovement
+		if err := rows.Scan(
+			&m.MovementUUID,
+			&m.IngredientLotUUID,
+			&m.PurchaseOrderLineUUID,
+			&m.LotReceivedUnit,
+			&m.IngredientUUID,
+			&m.IngredientName,
+			&m.IngredientCategory,
+			&m.StockLocationUUID,
+			&m.StockLocationName,
+			&m.Direction,
+			&m.Reason,
+			&m.Amount,
+			&m.AmountUnit,
+			&m.OccurredAt,
+			&m.ProductionRefUUID,
+		); err != nil {
+			return nil, fmt.Errorf("scanning valuation movement: %w", err)
+		}
+		movements = append(movements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing valuation movements: %w", err)
+	}
+
+	return movements, nil
+}
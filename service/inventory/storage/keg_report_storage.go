@@ -0,0 +1,195 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// KegReportFilter bounds keg reports to events within an optional time range.
+type KegReportFilter struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// KegTurnTimeReport summarizes how quickly kegs cycle through the fleet.
+type KegTurnTimeReport struct {
+	// Cycles is the number of completed fill-to-refill cycles.
+	Cycles      int
+	AvgTurnDays float64
+	// Trips is the number of completed ship-to-return trips.
+	Trips      int
+	AvgDaysOut float64
+}
+
+// OverdueKeg is a deployed keg together with when it last shipped.
+type OverdueKeg struct {
+	Keg       Keg
+	ShippedAt time.Time
+}
+
+// KegWriteOff is a lost keg that was written off.
+type KegWriteOff struct {
+	KegUUID       string
+	Serial        string
+	Custodian     *string
+	WriteOffCents *int64
+	Currency      *string
+	WrittenOffAt  time.Time
+	Notes         *string
+}
+
+// GetKegTurnTimeReport returns average fill-to-refill turn time and average
+// ship-to-return days out. Cycles and trips are attributed to the time they
+// completed.
+func (c *Client) GetKegTurnTimeReport(ctx context.Context, filter KegReportFilter) (KegTurnTimeReport, error) {
+	var report KegTurnTimeReport
+
+	err := c.Conn(ctx).QueryRow(ctx, `
+		WITH fills AS (
+			SELECT e.occurred_at,
+				LEAD(e.occurred_at) OVER (PARTITION BY e.keg_id ORDER BY e.occurred_at, e.id) AS next_fill_at
+			FROM keg_event e
+			JOIN keg k ON k.id = e.keg_id
+			WHERE e.event_type = 'fill' AND e.deleted_at IS NULL AND k.deleted_at IS NULL
+		)
+		SELECT COUNT(*),
+			COALESCE(AVG(EXTRACT(EPOCH FROM next_fill_at - occurred_at) / 86400), 0)::float8
+		FROM fills
+		WHERE next_fill_at IS NOT NULL
+		  AND ($1::timestamptz IS NULL OR next_fill_at >= $1)
+		  AND ($2::timestamptz IS NULL OR next_fill_at <= $2)`,
+		filter.From,
+		filter.To,
+	).Scan(&report.Cycles, &report.AvgTurnDays)
+	if err != nil {
+		return KegTurnTimeReport{}, fmt.Errorf("getting keg turn time: %w", err)
+	}
+
+	err = c.Conn(ctx).QueryRow(ctx, `
+		WITH trips AS (
+			SELECT e.event_type, e.occurred_at,
+				LAG(e.event_type) OVER w AS prev_type,
+				LAG(e.occurred_at) OVER w AS prev_at
+			FROM keg_event e
+			JOIN keg k ON k.id = e.keg_id
+			WHERE e.event_type IN ('ship', 'return') AND e.deleted_at IS NULL AND k.deleted_at IS NULL
+			WINDOW w AS (PARTITION BY e.keg_id ORDER BY e.occurred_at, e.id)
+		)
+		SELECT COUNT(*),
+			COALESCE(AVG(EXTRACT(EPOCH FROM occurred_at - prev_at) / 86400), 0)::float8
+		FROM trips
+		WHERE event_type = 'return' AND prev_type = 'ship'
+		  AND ($1::timestamptz IS NULL OR occurred_at >= $1)
+		  AND ($2::timestamptz IS NULL OR occurred_at <= $2)`,
+		filter.From,
+		filter.To,
+	).Scan(&report.Trips, &report.AvgDaysOut)
+	if err != nil {
+		return KegTurnTimeReport{}, fmt.Errorf("getting keg days out: %w", err)
+	}
+
+	return report, nil
+}
+
+// ListOverdueKegs returns deployed kegs that shipped at or before shippedBefore,
+// longest out first.
+func (c *Client) ListOverdueKegs(ctx context.Context, shippedBefore time.Time) ([]OverdueKeg, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+kegColumns+`, s.shipped_at`+kegJoins+`
+		JOIN LATERAL (
+			SELECT occurred_at AS shipped_at
+			FROM keg_event
+			WHERE keg_id = k.id AND event_type = 'ship' AND deleted_at IS NULL
+			ORDER BY occurred_at DESC, id DESC
+			LIMIT 1
+		) s ON true
+		WHERE k.deleted_at IS NULL AND k.status = 'deployed' AND s.shipped_at <= $1
+		ORDER BY s.shipped_at`,
+		shippedBefore,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing overdue kegs: %w", err)
+	}
+	defer rows.Close()
+
+	var overdue []OverdueKeg
+	for rows.Next() {
+		var o OverdueKeg
+		if err := rows.Scan(
+			&o.Keg.ID,
+			&o.Keg.UUID,
+			&o.Keg.Serial,
+			&o.Keg.Capacity,
+			&o.Keg.CapacityUnit,
+			&o.Keg.Ownership,
+			&o.Keg.Lessor,
+			&o.Keg.PurchaseCostCents,
+			&o.Keg.Currency,
+			&o.Keg.PurchasedAt,
+			&o.Keg.Status,
+			&o.Keg.StockLocationID,
+			&o.Keg.StockLocationUUID,
+			&o.Keg.Custodian,
+			&o.Keg.CurrentBeerLotItemID,
+			&o.Keg.CurrentBeerLotItemUUID,
+			&o.Keg.CurrentBeerLotUUID,
+			&o.Keg.DepositBalanceCents,
+			&o.Keg.Notes,
+			&o.Keg.CreatedAt,
+			&o.Keg.UpdatedAt,
+			&o.Keg.DeletedAt,
+			&o.ShippedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning overdue keg: %w", err)
+		}
+		overdue = append(overdue, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing overdue kegs: %w", err)
+	}
+
+	return overdue, nil
+}
+
+// ListKegWriteOffs returns lost-keg write-offs within the filter range, most
+// recent first.
+func (c *Client) ListKegWriteOffs(ctx context.Context, filter KegReportFilter) ([]KegWriteOff, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT k.uuid, k.serial, k.custodian, e.write_off_cents, k.currency, e.occurred_at, e.notes
+		FROM keg_event e
+		JOIN keg k ON k.id = e.keg_id
+		WHERE e.event_type = 'write_off' AND e.deleted_at IS NULL
+		  AND ($1::timestamptz IS NULL OR e.occurred_at >= $1)
+		  AND ($2::timestamptz IS NULL OR e.occurred_at <= $2)
+		ORDER BY e.occurred_at DESC`,
+		filter.From,
+		filter.To,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing keg write-offs: %w", err)
+	}
+	defer rows.Close()
+
+	var writeOffs []KegWriteOff
+	for rows.Next() {
+		var w KegWriteOff
+		if err := rows.Scan(
+			&w.KegUUID,
+			&w.Serial,
+			&w.Custodian,
+			&w.WriteOffCents,
+			&w.Currency,
+			&w.WrittenOffAt,
+			&w.Notes,
+		); err != nil {
+			return nil, fmt.Errorf("scanning keg write-off: %w", err)
+		}
+		writeOffs = append(writeOffs, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing keg write-offs: %w", err)
+	}
+
+	return writeOffs, nil
+}
@@ -0,0 +1,543 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrInvalidKegEvent is returned when an event cannot be applied to a keg in
+// its current state.
+var ErrInvalidKegEvent = fmt.Errorf("invalid keg event")
+
+// ErrDuplicateKegSerial is returned when a keg serial is already registered.
+var ErrDuplicateKegSerial = fmt.Errorf("keg serial already exists")
+
+// KegListFilter describes optional filters for listing kegs.
+type KegListFilter struct {
+	Status    *string
+	Ownership *string
+	Serial    *string
+}
+
+// UpdateKegRequest describes the mutable registry fields for a PATCH update.
+// Status, location, and custody change only through keg events.
+type UpdateKegRequest struct {
+	Serial            *string
+	Capacity          *int64
+	CapacityUnit      *string
+	Ownership         *string
+	Lessor            *string
+	PurchaseCostCents *int64
+	Currency          *string
+	PurchasedAt       *time.Time
+	Notes             *string
+}
+
+// KegEventRequest describes a lifecycle event to apply to a keg.
+type KegEventRequest struct {
+	EventType       string
+	StockLocationID *int64
+	Custodian       *string
+	// BeerLotItem is the lot's available, unfilled item the keg becomes on a
+	// fill; required for fill events. It is claimed by setting its
+	// identifier to the keg serial.
+	BeerLotItem   *BeerLotItem
+	DepositCents  *int64
+	WriteOffCents *int64
+	OccurredAt    time.Time
+	Notes         *string
+}
+
+// kegColumns is the column list shared by keg queries.
+const kegColumns = `k.id, k.uuid, k.serial, k.capacity, k.capacity_unit, k.ownership, k.lessor,
+	k.purchase_cost_cents, k.currency, k.purchased_at, k.status,
+	k.stock_location_id, sl.uuid, k.custodian,
+	k.current_beer_lot_item_id, bli.uuid, bl.uuid,
+	k.deposit_balance_cents, k.notes, k.created_at, k.updated_at, k.deleted_at`
+
+// kegJoins is the JOIN clause shared by keg queries.
+const kegJoins = `
+	FROM keg k
+	LEFT JOIN stock_location sl ON sl.id = k.stock_location_id
+	LEFT JOIN beer_lot_item bli ON bli.id = k.current_beer_lot_item_id
+	LEFT JOIN beer_lot bl ON bl.id = bli.beer_lot_id`
+
+func scanKeg(row pgx.Row) (Keg, error) {
+	var keg Keg
+	err := row.Scan(
+		&keg.ID,
+		&keg.UUID,
+		&keg.Serial,
+		&keg.Capacity,
+		&keg.CapacityUnit,
+		&keg.Ownership,
+		&keg.Lessor,
+		&keg.PurchaseCostCents,
+		&keg.Currency,
+		&keg.PurchasedAt,
+		&keg.Status,
+		&keg.StockLocationID,
+		&keg.StockLocationUUID,
+		&keg.Custodian,
+		&keg.CurrentBeerLotItemID,
+		&keg.CurrentBeerLotItemUUID,
+		&keg.CurrentBeerLotUUID,
+		&keg.DepositBalanceCents,
+		&keg.Notes,
+		&keg.CreatedAt,
+		&keg.UpdatedAt,
+		&keg.DeletedAt,
+	)
+	return keg, err
+}
+
+func isKegSerialConflict(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "keg_serial_idx"
+}
+
+// CreateKeg registers a new keg. New kegs start clean unless a status is given.
+func (c *Client) CreateKeg(ctx context.Context, keg Keg) (Keg, error) {
+	status := keg.Status
+	if status == "" {
+		status = KegStatusClean
+	}
+	ownership := keg.Ownership
+	if ownership == "" {
+		ownership = KegOwnershipOwned
+	}
+
+	var kegUUID string
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO keg (
+			serial,
+			capacity,
+			capacity_unit,
+			ownership,
+			lessor,
+			purchase_cost_cents,
+			currency,
+			purchased_at,
+			status,
+			stock_location_id,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING uuid`,
+		keg.Serial,
+		keg.Capacity,
+		keg.CapacityUnit,
+		ownership,
+		keg.Lessor,
+		keg.PurchaseCostCents,
+		keg.Currency,
+		keg.PurchasedAt,
+		status,
+		keg.StockLocationID,
+		keg.Notes,
+	).Scan(&kegUUID)
+	if err != nil {
+		if isKegSerialConflict(err) {
+			return Keg{}, ErrDuplicateKegSerial
+		}
+		return Keg{}, fmt.Errorf("creating keg: %w", err)
+	}
+
+	return c.GetKegByUUID(ctx, kegUUID)
+}
+
+func (c *Client) GetKegByUUID(ctx context.Context, kegUUID string) (Keg, error) {
+	keg, err := scanKeg(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+kegColumns+kegJoins+`
+		WHERE k.uuid = $1 AND k.deleted_at IS NULL`,
+		kegUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Keg{}, service.ErrNotFound
+		}
+		return Keg{}, fmt.Errorf("getting keg by uuid: %w", err)
+	}
+
+	return keg, nil
+}
+
+// ListKegs returns kegs matching the given filters, ordered by serial.
+func (c *Client) ListKegs(ctx context.Context, filter KegListFilter) ([]Keg, error) {
+	query := `SELECT ` + kegColumns + kegJoins + `
+		WHERE k.deleted_at IS NULL`
+	args := []any{}
+	argIdx := 1
+
+	if filter.Status != nil {
+		query += fmt.Sprintf(` AND k.status = $%d`, argIdx)
+		args = append(args, *filter.Status)
+		argIdx++
+	}
+	if filter.Ownership != nil {
+		query += fmt.Sprintf(` AND k.ownership = $%d`, argIdx)
+		args = append(args, *filter.Ownership)
+		argIdx++
+	}
+	if filter.Serial != nil {
+		query += fmt.Sprintf(` AND k.serial = $%d`, argIdx)
+		args = append(args, *filter.Serial)
+		argIdx++
+	}
+
+	query += ` ORDER BY k.serial`
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing kegs: %w", err)
+	}
+	defer rows.Close()
+
+	var kegs []Keg
+	for rows.Next() {
+		keg, err := scanKeg(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning keg: %w", err)
+		}
+		kegs = append(kegs, keg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing kegs: %w", err)
+	}
+
+	return kegs, nil
+}
+
+// UpdateKeg updates registry fields on a keg.
+func (c *Client) UpdateKeg(ctx context.Context, kegUUID string, req UpdateKegRequest) (Keg, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE keg SET
+			serial = COALESCE($1, serial),
+			capacity = COALESCE($2, capacity),
+			capacity_unit = COALESCE($3, capacity_unit),
+			ownership = COALESCE($4, ownership),
+			lessor = COALESCE($5, lessor),
+			purchase_cost_cents = COALESCE($6, purchase_cost_cents),
+			currency = COALESCE($7, currency),
+			purchased_at = COALESCE($8, purchased_at),
+			notes = COALESCE($9, notes),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $10 AND deleted_at IS NULL`,
+		req.Serial,
+		req.Capacity,
+		req.CapacityUnit,
+		req.Ownership,
+		req.Lessor,
+		req.PurchaseCostCents,
+		req.Currency,
+		req.PurchasedAt,
+		req.Notes,
+		kegUUID,
+	)
+	if err != nil {
+		if isKegSerialConflict(err) {
+			return Keg{}, ErrDuplicateKegSerial
+		}
+		return Keg{}, fmt.Errorf("updating keg: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return Keg{}, service.ErrNotFound
+	}
+
+	return c.GetKegByUUID(ctx, kegUUID)
+}
+
+// SoftDeleteKeg soft-deletes a keg registry entry. Its event history is retained.
+func (c *Client) SoftDeleteKeg(ctx context.Context, kegUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE keg SET deleted_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		kegUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting keg: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+	return nil
+}
+
+// RecordKegEvent applies a lifecycle event to a keg within a single
+// transaction: it validates the event against the keg's current status, updates
+// location, custody, and deposit balance, keeps the linked beer lot item in
+// step (fill creates it, ship marks it sold, return marks it returned), and
+// appends the event to the keg's history.
+func (c *Client) RecordKegEvent(ctx context.Context, kegUUID string, req KegEventRequest) (Keg, KegEvent, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return Keg{}, KegEvent{}, fmt.Errorf("starting keg event transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		kegID             int64
+		serial            string
+		status            string
+		stockLocationID   *int64
+		custodian         *string
+		currentItemID     *int64
+		purchaseCostCents *int64
+		depositBalance    int64
+	)
+	err = tx.QueryRow(ctx, `
+		SELECT id, serial, status, stock_location_id, custodian,
+			current_beer_lot_item_id, purchase_cost_cents, deposit_balance_cents
+		FROM keg
+		WHERE uuid = $1 AND deleted_at IS NULL
+		FOR UPDATE`,
+		kegUUID,
+	).Scan(&kegID, &serial, &status, &stockLocationID, &custodian, &currentItemID, &purchaseCostCents, &depositBalance)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return Keg{}, KegEvent{}, service.ErrNotFound
+		}
+		return Keg{}, KegEvent{}, fmt.Errorf("locking keg: %w", err)
+	}
+
+	nextStatus, ok := NextKegStatus(status, req.EventType)
+	if !ok {
+		return Keg{}, KegEvent{}, fmt.Errorf("%w: cannot %s a keg that is %s", ErrInvalidKegEvent, req.EventType, status)
+	}
+
+	occurredAt := req.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now().UTC()
+	}
+
+	var eventItemID *int64
+	var writeOffCents *int64
+
+	switch req.EventType {
+	case KegEventFill:
+		if req.BeerLotItem == nil {
+			return Keg{}, KegEvent{}, fmt.Errorf("%w: beer lot item is required to fill a keg", ErrInvalidKegEvent)
+		}
+		if err := claimBeerLotItem(ctx, tx, req.BeerLotItem.ID, serial); err != nil {
+			return Keg{}, KegEvent{}, err
+		}
+		currentItemID = &req.BeerLotItem.ID
+		eventItemID = currentItemID
+		if req.StockLocationID != nil {
+			stockLocationID = req.StockLocationID
+		}
+
+	case KegEventShip:
+		custodian = req.Custodian
+		stockLocationID = nil
+		eventItemID = currentItemID
+		if err := syncKegBeerLotItem(ctx, tx, currentItemID, BeerLotItemStatusSold, custodian, occurredAt); err != nil {
+			return Keg{}, KegEvent{}, err
+		}
+		if req.DepositCents != nil {
+			depositBalance += *req.DepositCents
+		}
+
+	case KegEventReturn:
+		eventItemID = currentItemID
+		if err := syncKegBeerLotItem(ctx, tx, currentItemID, BeerLotItemStatusReturned, custodian, occurredAt); err != nil {
+			return Keg{}, KegEvent{}, err
+		}
+		custodian = nil
+		stockLocationID = req.StockLocationID
+		if req.DepositCents != nil {
+			if *req.DepositCents > depositBalance {
+				return Keg{}, KegEvent{}, fmt.Errorf("%w: deposit refund exceeds held deposit of %d", ErrInvalidKegEvent, depositBalance)
+			}
+			depositBalance -= *req.DepositCents
+		}
+
+	case KegEventClean:
+		eventItemID = currentItemID
+		currentItemID = nil
+		if req.StockLocationID != nil {
+			stockLocationID = req.StockLocationID
+		}
+
+	case KegEventMove:
+		stockLocationID = req.StockLocationID
+
+	case KegEventLost:
+		stockLocationID = nil
+
+	case KegEventWriteOff:
+		writeOffCents = req.WriteOffCents
+		if writeOffCents == nil {
+			writeOffCents = purchaseCostCents
+		}
+		currentItemID = nil
+
+	case KegEventRetire:
+		stockLocationID = nil
+		currentItemID = nil
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE keg SET
+			status = $1,
+			stock_location_id = $2,
+			custodian = $3,
+			current_beer_lot_item_id = $4,
+			deposit_balance_cents = $5,
+			updated_at = timezone('utc', now())
+		WHERE id = $6`,
+		nextStatus,
+		stockLocationID,
+		custodian,
+		currentItemID,
+		depositBalance,
+		kegID,
+	)
+	if err != nil {
+		return Keg{}, KegEvent{}, fmt.Errorf("updating keg state: %w", err)
+	}
+
+	var eventUUID string
+	err = tx.QueryRow(ctx, `
+		INSERT INTO keg_event (
+			keg_id,
+			event_type,
+			from_status,
+			to_status,
+			stock_location_id,
+			custodian,
+			beer_lot_item_id,
+			deposit_cents,
+			write_off_cents,
+			occurred_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING uuid`,
+		kegID,
+		req.EventType,
+		status,
+		nextStatus,
+		stockLocationID,
+		req.Custodian,
+		eventItemID,
+		req.DepositCents,
+		writeOffCents,
+		occurredAt,
+		req.Notes,
+	).Scan(&eventUUID)
+	if err != nil {
+		return Keg{}, KegEvent{}, fmt.Errorf("recording keg event: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Keg{}, KegEvent{}, fmt.Errorf("committing keg event transaction: %w", err)
+	}
+
+	keg, err := c.GetKegByUUID(ctx, kegUUID)
+	if err != nil {
+		return Keg{}, KegEvent{}, err
+	}
+
+	event, err := scanKegEvent(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+kegEventColumns+kegEventJoins+`
+		WHERE e.uuid = $1`,
+		eventUUID,
+	))
+	if err != nil {
+		return Keg{}, KegEvent{}, fmt.Errorf("getting recorded keg event: %w", err)
+	}
+
+	return keg, event, nil
+}
+
+// syncKegBeerLotItem moves the beer lot item a keg currently holds to status.
+// Items that were already moved past that status by hand are left alone.
+func syncKegBeerLotItem(ctx context.Context, tx pgx.Tx, itemID *int64, status string, destination *string, occurredAt time.Time) error {
+	if itemID == nil {
+		return nil
+	}
+
+	err := applyBeerLotItemTransition(ctx, tx, *itemID, BeerLotItemTransition{
+		Status:      status,
+		Destination: destination,
+		OccurredAt:  occurredAt,
+	})
+	if errors.Is(err, ErrInvalidBeerLotItemTransition) || errors.Is(err, service.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// kegEventColumns is the column list shared by keg event queries.
+const kegEventColumns = `e.id, e.uuid, e.keg_id, k.uuid, e.event_type, e.from_status, e.to_status,
+	e.stock_location_id, sl.uuid, e.custodian, e.beer_lot_item_id, bli.uuid,
+	e.deposit_cents, e.write_off_cents, e.occurred_at, e.notes,
+	e.created_at, e.updated_at, e.deleted_at`
+
+// kegEventJoins is the JOIN clause shared by keg event queries.
+const kegEventJoins = `
+	FROM keg_event e
+	JOIN keg k ON k.id = e.keg_id
+	LEFT JOIN stock_location sl ON sl.id = e.stock_location_id
+	LEFT JOIN beer_lot_item bli ON bli.id = e.beer_lot_item_id`
+
+func scanKegEvent(row pgx.Row) (KegEvent, error) {
+	var event KegEvent
+	err := row.Scan(
+		&event.ID,
+		&event.UUID,
+		&event.KegID,
+		&event.KegUUID,
+		&event.EventType,
+		&event.FromStatus,
+		&event.ToStatus,
+		&event.StockLocationID,
+		&event.StockLocationUUID,
+		&event.Custodian,
+		&event.BeerLotItemID,
+		&event.BeerLotItemUUID,
+		&event.DepositCents,
+		&event.WriteOffCents,
+		&event.OccurredAt,
+		&event.Notes,
+		&event.CreatedAt,
+		&event.UpdatedAt,
+		&event.DeletedAt,
+	)
+	return event, err
+}
+
+// ListKegEvents returns the lifecycle history of a keg, oldest first.
+func (c *Client) ListKegEvents(ctx context.Context, kegUUID string) ([]KegEvent, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+kegEventColumns+kegEventJoins+`
+		WHERE k.uuid = $1 AND e.deleted_at IS NULL
+		ORDER BY e.occurred_at, e.id`,
+		kegUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing keg events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []KegEvent
+	for rows.Next() {
+		event, err := scanKegEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning keg event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing keg events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+const labelTemplateColumns = `
+	id, uuid, name, subject, is_default,
+	width_mm, height_mm, fields, barcode, barcode_content, font_size_pt, printer_dpi,
+	sheet_page_width_mm, sheet_page_height_mm, sheet_columns, sheet_rows, sheet_margin_mm, sheet_gap_mm,
+	created_at, updated_at, deleted_at`
+
+func scanLabelTemplate(row pgx.Row) (LabelTemplate, error) {
+	var t LabelTemplate
+	var sheet struct {
+		pageWidth, pageHeight, margin, gap *float64
+		columns, rows                      *int
+	}
+	err := row.Scan(
+		&t.ID,
+		&t.UUID,
+		&t.Name,
+		&t.Subject,
+		&t.IsDefault,
+		&t.Layout.WidthMM,
+		&t.Layout.HeightMM,
+		&t.Layout.Fields,
+		&t.Layout.Barcode,
+		&t.Layout.BarcodeContent,
+		&t.Layout.FontSizePt,
+		&t.Layout.PrinterDPI,
+		&sheet.pageWidth,
+		&sheet.pageHeight,
+		&sheet.columns,
+		&sheet.rows,
+		&sheet.margin,
+		&sheet.gap,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+		&t.DeletedAt,
+	)
+	if err != nil {
+		return LabelTemplate{}, err
+	}
+	if sheet.pageWidth != nil {
+		t.Layout.Sheet = &label.Sheet{
+			PageWidthMM:  *sheet.pageWidth,
+			PageHeightMM: *sheet.pageHeight,
+			Columns:      *sheet.columns,
+			Rows:         *sheet.rows,
+			MarginMM:     *sheet.margin,
+			GapMM:        *sheet.gap,
+		}
+	}
+	return t, nil
+}
+
+// labelTemplateArgs returns the layout column values in labelTemplateColumns
+// order, from width_mm to sheet_gap_mm.
+func labelTemplateArgs(layout label.Template) []any {
+	args := []any{
+		layout.WidthMM, layout.HeightMM, layout.Fields, layout.Barcode, layout.BarcodeContent,
+		layout.FontSizePt, layout.PrinterDPI,
+	}
+	if s := layout.Sheet; s != nil {
+		return append(args, s.PageWidthMM, s.PageHeightMM, s.Columns, s.Rows, s.MarginMM, s.GapMM)
+	}
+	return append(args, nil, nil, nil, nil, nil, nil)
+}
+
+// CreateLabelTemplate creates a label template. A new default template
+// replaces the subject's previous default.
+func (c *Client) CreateLabelTemplate(ctx context.Context, t LabelTemplate) (LabelTemplate, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return LabelTemplate{}, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if t.IsDefault {
+		if err := clearDefaultLabelTemplate(ctx, tx, t.Subject); err != nil {
+			return LabelTemplate{}, err
+		}
+	}
+
+	args := append([]any{t.Name, t.Subject, t.IsDefault}, labelTemplateArgs(t.Layout)...)
+	created, err := scanLabelTemplate(tx.QueryRow(ctx, `
+		INSERT INTO label_template (
+			name, subject, is_default,
+			width_mm, height_mm, fields, barcode, barcode_content, font_size_pt, printer_dpi,
+			sheet_page_width_mm, sheet_page_height_mm, sheet_columns, sheet_rows, sheet_margin_mm, sheet_gap_mm
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING `+labelTemplateColumns,
+		args...,
+	))
+	if err != nil {
+		return LabelTemplate{}, fmt.Errorf("creating label template: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return LabelTemplate{}, fmt.Errorf("committing label template: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetLabelTemplateByUUID(ctx context.Context, templateUUID string) (LabelTemplate, error) {
+	t, err := scanLabelTemplate(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+labelTemplateColumns+`
+		FROM label_template
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		templateUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return LabelTemplate{}, service.ErrNotFound
+		}
+		return LabelTemplate{}, fmt.Errorf("getting label template by uuid: %w", err)
+	}
+
+	return t, nil
+}
+
+// GetDefaultLabelTemplate returns the default template of a subject, or
+// service.ErrNotFound if it has none.
+func (c *Client) GetDefaultLabelTemplate(ctx context.Context, subject string) (LabelTemplate, error) {
+	t, err := scanLabelTemplate(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+labelTemplateColumns+`
+		FROM label_template
+		WHERE subject = $1 AND is_default AND deleted_at IS NULL`,
+		subject,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return LabelTemplate{}, service.ErrNotFound
+		}
+		return LabelTemplate{}, fmt.Errorf("getting default label template: %w", err)
+	}
+
+	return t, nil
+}
+
+// ListLabelTemplates lists label templates, optionally for one subject.
+func (c *Client) ListLabelTemplates(ctx context.Context, subject *string) ([]LabelTemplate, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+labelTemplateColumns+`
+		FROM label_template
+		WHERE deleted_at IS NULL AND ($1::text IS NULL OR subject = $1)
+		ORDER BY subject, name`,
+		subject,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing label templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []LabelTemplate
+	for rows.Next() {
+		t, err := scanLabelTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning label template: %w", err)
+		}
+		templates = append(templates, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing label templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// UpdateLabelTemplate replaces the name, default flag and layout of a
+// template. Its subject cannot change.
+func (c *Client) UpdateLabelTemplate(ctx context.Context, t LabelTemplate) (LabelTemplate, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return LabelTemplate{}, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if t.IsDefault {
+		if err := clearDefaultLabelTemplate(ctx, tx, t.Subject); err != nil {
+			return LabelTemplate{}, err
+		}
+	}
+
+	args := append([]any{t.ID, t.Name, t.IsDefault}, labelTemplateArgs(t.Layout)...)
+	updated, err := scanLabelTemplate(tx.QueryRow(ctx, `
+		UPDATE label_template
+		SET name = $2, is_default = $3,
+			width_mm = $4, height_mm = $5, fields = $6, barcode = $7, barcode_content = $8,
+			font_size_pt = $9, printer_dpi = $10,
+			sheet_page_width_mm = $11, sheet_page_height_mm = $12, sheet_columns = $13,
+			sheet_rows = $14, sheet_margin_mm = $15, sheet_gap_mm = $16,
+			updated_at = timezone('utc', now())
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+labelTemplateColumns,
+		args...,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return LabelTemplate{}, service.ErrNotFound
+		}
+		return LabelTemplate{}, fmt.Errorf("updating label template: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return LabelTemplate{}, fmt.Errorf("committing label template: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (c *Client) DeleteLabelTemplate(ctx context.Context, templateUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE label_template
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		templateUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting label template: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
+
+func clearDefaultLabelTemplate(ctx context.Context, tx pgx.Tx, subject string) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE label_template
+		SET is_default = false, updated_at = timezone('utc', now())
+		WHERE subject = $1 AND is_default AND deleted_at IS NULL`,
+		subject,
+	)
+	if err != nil {
+		return fmt.Errorf("clearing default label template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS beer_lot_item_event CASCADE;
+DROP INDEX IF EXISTS beer_lot_item_lot_identifier_idx;
+COMMIT;
@@ -0,0 +1,43 @@
+-- Beer lot item tracking: status history for individual items (kegs) and
+-- per-lot uniqueness of item identifiers.
+BEGIN;
+
+-- ==============================================================================
+-- 1. Identifiers are unique within a lot (a keg serial may be refilled into a
+--    later lot, so uniqueness is not global)
+-- ==============================================================================
+
+CREATE UNIQUE INDEX IF NOT EXISTS beer_lot_item_lot_identifier_idx
+    ON beer_lot_item(beer_lot_id, identifier)
+    WHERE deleted_at IS NULL AND identifier IS NOT NULL;
+
+-- ==============================================================================
+-- 2. Status history for beer lot items
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS beer_lot_item_event (
+    id                serial PRIMARY KEY,
+    uuid              uuid NOT NULL DEFAULT gen_random_uuid(),
+    beer_lot_item_id  int NOT NULL REFERENCES beer_lot_item(id),
+    from_status       varchar(32),
+    to_status         varchar(32) NOT NULL,
+    destination       varchar(255),
+    occurred_at       timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    notes             text,
+    created_at        timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at        timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at        timestamptz,
+    CONSTRAINT beer_lot_item_event_from_status_check CHECK (from_status IS NULL OR from_status IN ('available', 'reserved', 'sold', 'returned', 'damaged', 'destroyed')),
+    CONSTRAINT beer_lot_item_event_to_status_check CHECK (to_status IN ('available', 'reserved', 'sold', 'returned', 'damaged', 'destroyed'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS beer_lot_item_event_uuid_idx ON beer_lot_item_event(uuid);
+CREATE INDEX IF NOT EXISTS beer_lot_item_event_item_id_idx ON beer_lot_item_event(beer_lot_item_id, occurred_at) WHERE deleted_at IS NULL;
+
+-- Backfill an initial event for any existing items so every item has a history.
+INSERT INTO beer_lot_item_event (beer_lot_item_id, from_status, to_status, occurred_at)
+SELECT id, NULL, status, created_at
+FROM beer_lot_item
+WHERE deleted_at IS NULL;
+
+COMMIT;
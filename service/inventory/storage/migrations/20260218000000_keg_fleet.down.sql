@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS keg_event CASCADE;
+DROP TABLE IF EXISTS keg CASCADE;
+COMMIT;
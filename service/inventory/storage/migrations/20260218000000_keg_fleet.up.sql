@@ -0,0 +1,76 @@
+-- Keg fleet: kegs as capital assets that cycle through fill, ship, return and
+-- clean, with an event log linking each fill to a beer lot item.
+BEGIN;
+
+-- ==============================================================================
+-- 1. keg table
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS keg (
+    id                        serial PRIMARY KEY,
+    uuid                      uuid NOT NULL DEFAULT gen_random_uuid(),
+    serial                    varchar(64) NOT NULL,
+    capacity                  bigint NOT NULL,
+    capacity_unit             varchar(7) NOT NULL,
+    ownership                 varchar(16) NOT NULL DEFAULT 'owned',
+    lessor                    varchar(255),
+    purchase_cost_cents       bigint,
+    currency                  char(3),
+    purchased_at              timestamptz,
+
+    -- Current state
+    status                    varchar(16) NOT NULL DEFAULT 'clean',
+    stock_location_id         int REFERENCES stock_location(id),
+    custodian                 varchar(255),
+    current_beer_lot_item_id  int REFERENCES beer_lot_item(id),
+    deposit_balance_cents     bigint NOT NULL DEFAULT 0,
+
+    notes                     text,
+    created_at                timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at                timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at                timestamptz,
+
+    CONSTRAINT keg_capacity_check CHECK (capacity > 0),
+    CONSTRAINT keg_ownership_check CHECK (ownership IN ('owned', 'leased')),
+    CONSTRAINT keg_status_check CHECK (status IN ('clean', 'filled', 'deployed', 'dirty', 'lost', 'retired')),
+    CONSTRAINT keg_purchase_cost_check CHECK (purchase_cost_cents IS NULL OR purchase_cost_cents >= 0),
+    CONSTRAINT keg_purchase_cost_currency_check CHECK (purchase_cost_cents IS NULL OR currency IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keg_uuid_idx ON keg(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS keg_serial_idx ON keg(serial) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS keg_status_idx ON keg(status) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS keg_stock_location_id_idx ON keg(stock_location_id) WHERE deleted_at IS NULL;
+
+-- ==============================================================================
+-- 2. keg_event table
+-- ==============================================================================
+
+CREATE TABLE IF NOT EXISTS keg_event (
+    id                 serial PRIMARY KEY,
+    uuid               uuid NOT NULL DEFAULT gen_random_uuid(),
+    keg_id             int NOT NULL REFERENCES keg(id),
+    event_type         varchar(16) NOT NULL,
+    from_status        varchar(16) NOT NULL,
+    to_status          varchar(16) NOT NULL,
+    stock_location_id  int REFERENCES stock_location(id),
+    custodian          varchar(255),
+    beer_lot_item_id   int REFERENCES beer_lot_item(id),
+    deposit_cents      bigint,
+    write_off_cents    bigint,
+    occurred_at        timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    notes              text,
+    created_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at         timestamptz,
+
+    CONSTRAINT keg_event_type_check CHECK (event_type IN ('fill', 'ship', 'return', 'clean', 'move', 'lost', 'write_off', 'retire')),
+    CONSTRAINT keg_event_deposit_check CHECK (deposit_cents IS NULL OR deposit_cents >= 0),
+    CONSTRAINT keg_event_write_off_check CHECK (write_off_cents IS NULL OR write_off_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS keg_event_uuid_idx ON keg_event(uuid);
+CREATE INDEX IF NOT EXISTS keg_event_keg_id_idx ON keg_event(keg_id, occurred_at) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS keg_event_type_idx ON keg_event(event_type, occurred_at) WHERE deleted_at IS NULL;
+
+COMMIT;
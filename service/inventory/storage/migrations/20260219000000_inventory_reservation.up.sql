@@ -0,0 +1,33 @@
+-- Inventory reservations: soft holds on ingredient lot stock for planned
+-- production batches. Reserved stock stays on hand but is excluded from
+-- available-to-promise until it is consumed or released.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS inventory_reservation (
+    id                   serial PRIMARY KEY,
+    uuid                 uuid NOT NULL DEFAULT gen_random_uuid(),
+    production_ref_uuid  uuid NOT NULL,
+    ingredient_lot_id    int NOT NULL REFERENCES ingredient_lot(id),
+    stock_location_id    int NOT NULL REFERENCES stock_location(id),
+    amount               bigint NOT NULL,
+    amount_unit          varchar(7) NOT NULL,
+    consumed_amount      bigint NOT NULL DEFAULT 0,
+    status               varchar(16) NOT NULL DEFAULT 'active',
+    released_at          timestamptz,
+    notes                text,
+    created_at           timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at           timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at           timestamptz,
+
+    CONSTRAINT inventory_reservation_amount_check CHECK (amount > 0),
+    CONSTRAINT inventory_reservation_consumed_check CHECK (consumed_amount >= 0 AND consumed_amount <= amount),
+    CONSTRAINT inventory_reservation_status_check CHECK (status IN ('active', 'consumed', 'released'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS inventory_reservation_uuid_idx ON inventory_reservation(uuid);
+CREATE INDEX IF NOT EXISTS inventory_reservation_production_ref_idx ON inventory_reservation(production_ref_uuid);
+CREATE INDEX IF NOT EXISTS inventory_reservation_lot_location_active_idx
+    ON inventory_reservation(ingredient_lot_id, stock_location_id)
+    WHERE status = 'active' AND deleted_at IS NULL;
+
+COMMIT;
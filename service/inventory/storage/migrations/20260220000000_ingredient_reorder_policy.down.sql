@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE IF EXISTS ingredient_reorder_policy CASCADE;
+COMMIT;
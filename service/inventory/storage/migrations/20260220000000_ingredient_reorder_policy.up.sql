@@ -0,0 +1,35 @@
+-- Ingredient reorder policies: min/max levels, safety stock, and preferred
+-- supplier per ingredient, optionally scoped to a single stock location.
+-- Levels are in the ingredient's default unit.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS ingredient_reorder_policy (
+    id                       serial PRIMARY KEY,
+    uuid                     uuid NOT NULL DEFAULT gen_random_uuid(),
+    ingredient_id            int NOT NULL REFERENCES ingredient(id),
+    stock_location_id        int REFERENCES stock_location(id),
+    min_level                bigint NOT NULL,
+    max_level                bigint NOT NULL,
+    safety_stock             bigint NOT NULL DEFAULT 0,
+    preferred_supplier_uuid  uuid,
+    lead_time_days           int,
+    notes                    text,
+    created_at               timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at               timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at               timestamptz,
+
+    CONSTRAINT ingredient_reorder_policy_min_check CHECK (min_level >= 0),
+    CONSTRAINT ingredient_reorder_policy_max_check CHECK (max_level >= min_level),
+    CONSTRAINT ingredient_reorder_policy_safety_check CHECK (safety_stock >= 0),
+    CONSTRAINT ingredient_reorder_policy_lead_time_check CHECK (lead_time_days IS NULL OR lead_time_days >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS ingredient_reorder_policy_uuid_idx ON ingredient_reorder_policy(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS ingredient_reorder_policy_ingredient_idx
+    ON ingredient_reorder_policy(ingredient_id)
+    WHERE stock_location_id IS NULL AND deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS ingredient_reorder_policy_ingredient_location_idx
+    ON ingredient_reorder_policy(ingredient_id, stock_location_id)
+    WHERE stock_location_id IS NOT NULL AND deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE IF EXISTS inventory_valuation_setting CASCADE;
+COMMIT;
@@ -0,0 +1,16 @@
+-- Inventory valuation: the costing method used to value ingredient lots and
+-- the movements that draw them down.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS inventory_valuation_setting (
+    id         boolean PRIMARY KEY DEFAULT true,
+    method     varchar(32) NOT NULL DEFAULT 'fifo',
+
+    updated_at timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT inventory_valuation_setting_singleton_check CHECK (id),
+    CONSTRAINT inventory_valuation_setting_method_check CHECK (method IN ('fifo', 'weighted_average'))
+);
+
+INSERT INTO inventory_valuation_setting (id) VALUES (true) ON CONFLICT (id) DO NOTHING;
+
+COMMIT;
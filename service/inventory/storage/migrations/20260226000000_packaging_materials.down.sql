@@ -0,0 +1,14 @@
+BEGIN;
+UPDATE ingredient SET category = 'other' WHERE category = 'packaging';
+ALTER TABLE ingredient DROP CONSTRAINT ingredient_category_check;
+ALTER TABLE ingredient ADD CONSTRAINT ingredient_category_check CHECK (category IN (
+    'fermentable',
+    'hop',
+    'yeast',
+    'adjunct',
+    'salt',
+    'chemical',
+    'gas',
+    'other'
+));
+COMMIT;
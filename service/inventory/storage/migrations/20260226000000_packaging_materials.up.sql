@@ -0,0 +1,19 @@
+-- Packaging materials (cans, lids, labels, carriers, keg caps) are stocked
+-- as ingredients in the packaging category so they get lots, receipts and
+-- movements like any other ingredient.
+BEGIN;
+
+ALTER TABLE ingredient DROP CONSTRAINT ingredient_category_check;
+ALTER TABLE ingredient ADD CONSTRAINT ingredient_category_check CHECK (category IN (
+    'fermentable',
+    'hop',
+    'yeast',
+    'adjunct',
+    'salt',
+    'chemical',
+    'gas',
+    'packaging',
+    'other'
+));
+
+COMMIT;
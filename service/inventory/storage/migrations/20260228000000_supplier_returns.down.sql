@@ -0,0 +1,32 @@
+BEGIN;
+
+ALTER TABLE supplier_return DROP CONSTRAINT IF EXISTS supplier_return_movement_id_fk;
+
+DELETE FROM inventory_movement WHERE reason = 'return';
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reference_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reference_check CHECK (
+    num_nonnulls(receipt_id, usage_id, adjustment_id, transfer_id, removal_id) <= 1
+);
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reason_reference_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reason_reference_check CHECK (
+    (reason = 'receive' AND receipt_id IS NOT NULL) OR
+    (reason = 'use' AND usage_id IS NOT NULL) OR
+    (reason = 'transfer' AND transfer_id IS NOT NULL) OR
+    (reason IN ('adjust', 'waste') AND adjustment_id IS NOT NULL) OR
+    (reason = 'package') OR
+    (reason = 'removal' AND removal_id IS NOT NULL)
+);
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reason_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reason_check CHECK (reason IN (
+    'receive', 'use', 'transfer', 'adjust', 'waste', 'package', 'removal'
+));
+
+DROP INDEX IF EXISTS inventory_movement_return_id_idx;
+ALTER TABLE inventory_movement DROP COLUMN IF EXISTS return_id;
+
+DROP TABLE IF EXISTS supplier_return;
+
+COMMIT;
@@ -0,0 +1,94 @@
+BEGIN;
+
+-- ==============================================================================
+-- 1. supplier_return table
+-- ==============================================================================
+
+-- A return to vendor of part of an ingredient lot received against a purchase
+-- order line. The credit expected from the supplier is tracked until it is
+-- credited or refused.
+CREATE TABLE IF NOT EXISTS supplier_return (
+    id                        serial PRIMARY KEY,
+    uuid                      uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    ingredient_lot_id         int NOT NULL REFERENCES ingredient_lot(id),
+    stock_location_id         int NOT NULL REFERENCES stock_location(id),
+    purchase_order_line_uuid  uuid NOT NULL,
+
+    amount                    bigint NOT NULL,
+    amount_unit               varchar(7) NOT NULL,
+    reason                    varchar(32) NOT NULL,
+    reference_code            varchar(64),
+    returned_at               timestamptz NOT NULL DEFAULT timezone('utc', now()),
+
+    -- Credit expected from the supplier
+    credit_amount_cents       bigint,
+    credit_currency           char(3),
+    credit_status             varchar(16) NOT NULL DEFAULT 'requested',
+    credit_resolved_at        timestamptz,
+
+    -- Recording the return against the purchase order line in Procurement
+    purchase_order_sync_status  varchar(16) NOT NULL DEFAULT 'pending',
+    purchase_order_synced_at    timestamptz,
+    purchase_order_sync_error   text,
+
+    notes                     text,
+    movement_id               int,
+
+    created_at                timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at                timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at                timestamptz,
+
+    CONSTRAINT supplier_return_amount_check CHECK (amount > 0),
+    CONSTRAINT supplier_return_reason_check CHECK (reason IN (
+        'damaged', 'dead_on_arrival', 'quality_reject', 'wrong_item', 'expired', 'other'
+    )),
+    CONSTRAINT supplier_return_credit_status_check CHECK (credit_status IN (
+        'requested', 'credited', 'refused'
+    )),
+    CONSTRAINT supplier_return_purchase_order_sync_status_check CHECK (purchase_order_sync_status IN (
+        'pending', 'synced', 'failed'
+    )),
+    CONSTRAINT supplier_return_credit_amount_check CHECK (
+        credit_amount_cents IS NULL OR (credit_amount_cents >= 0 AND credit_currency IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_return_uuid_idx ON supplier_return(uuid);
+CREATE INDEX IF NOT EXISTS supplier_return_ingredient_lot_id_idx ON supplier_return(ingredient_lot_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_return_purchase_order_line_uuid_idx ON supplier_return(purchase_order_line_uuid) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_return_credit_status_idx ON supplier_return(credit_status) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_return_purchase_order_sync_status_idx ON supplier_return(purchase_order_sync_status) WHERE deleted_at IS NULL;
+
+-- ==============================================================================
+-- 2. inventory_movement modifications
+-- ==============================================================================
+
+ALTER TABLE inventory_movement ADD COLUMN return_id int REFERENCES supplier_return(id);
+CREATE INDEX IF NOT EXISTS inventory_movement_return_id_idx ON inventory_movement(return_id);
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reason_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reason_check CHECK (reason IN (
+    'receive', 'use', 'transfer', 'adjust', 'waste', 'package', 'removal', 'return'
+));
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reason_reference_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reason_reference_check CHECK (
+    (reason = 'receive' AND receipt_id IS NOT NULL) OR
+    (reason = 'use' AND usage_id IS NOT NULL) OR
+    (reason = 'transfer' AND transfer_id IS NOT NULL) OR
+    (reason IN ('adjust', 'waste') AND adjustment_id IS NOT NULL) OR
+    (reason = 'package') OR
+    (reason = 'removal' AND removal_id IS NOT NULL) OR
+    (reason = 'return' AND return_id IS NOT NULL)
+);
+
+ALTER TABLE inventory_movement DROP CONSTRAINT inventory_movement_reference_check;
+ALTER TABLE inventory_movement ADD CONSTRAINT inventory_movement_reference_check CHECK (
+    num_nonnulls(receipt_id, usage_id, adjustment_id, transfer_id, removal_id, return_id) <= 1
+);
+
+ALTER TABLE supplier_return ADD CONSTRAINT supplier_return_movement_id_fk
+    FOREIGN KEY (movement_id) REFERENCES inventory_movement(id);
+
+COMMIT;
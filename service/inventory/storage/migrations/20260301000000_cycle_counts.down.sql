@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS cycle_count_line CASCADE;
+DROP TABLE IF EXISTS cycle_count CASCADE;
+COMMIT;
@@ -0,0 +1,74 @@
+BEGIN;
+
+-- ==============================================================================
+-- 1. cycle_count table
+-- ==============================================================================
+
+-- A count session for one stock location. Expected balances are frozen from
+-- the movement ledger when the session is opened; posting turns the
+-- variances into cycle_count adjustments. Only one session per location may
+-- be open at a time.
+CREATE TABLE IF NOT EXISTS cycle_count (
+    id                 serial PRIMARY KEY,
+    uuid               uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    stock_location_id  int NOT NULL REFERENCES stock_location(id),
+    status             varchar(16) NOT NULL DEFAULT 'open',
+    blind              boolean NOT NULL DEFAULT false,
+    frozen_at          timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    posted_at          timestamptz,
+    notes              text,
+
+    created_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at         timestamptz,
+
+    CONSTRAINT cycle_count_status_check CHECK (status IN ('open', 'posted', 'cancelled'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS cycle_count_uuid_idx ON cycle_count(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS cycle_count_open_location_idx
+    ON cycle_count(stock_location_id)
+    WHERE status = 'open' AND deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS cycle_count_posted_at_idx ON cycle_count(posted_at);
+
+-- ==============================================================================
+-- 2. cycle_count_line table
+-- ==============================================================================
+
+-- One lot on the count sheet. Lots found during the count that were not in
+-- the snapshot are added with an expected amount of zero. The variance value
+-- is recorded in the base currency when the session is posted.
+CREATE TABLE IF NOT EXISTS cycle_count_line (
+    id                     serial PRIMARY KEY,
+    uuid                   uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    cycle_count_id         int NOT NULL REFERENCES cycle_count(id) ON DELETE CASCADE,
+    ingredient_lot_id      int REFERENCES ingredient_lot(id),
+    beer_lot_id            int REFERENCES beer_lot(id),
+    amount_unit            varchar(7) NOT NULL,
+    expected_amount        bigint NOT NULL,
+    counted_amount         bigint,
+    counted_at             timestamptz,
+    variance_value_cents   bigint,
+    value_currency         char(3),
+    adjustment_id          int REFERENCES inventory_adjustment(id),
+    notes                  text,
+
+    created_at             timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at             timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at             timestamptz,
+
+    CONSTRAINT cycle_count_line_target_check CHECK (num_nonnulls(ingredient_lot_id, beer_lot_id) = 1),
+    CONSTRAINT cycle_count_line_counted_amount_check CHECK (counted_amount IS NULL OR counted_amount >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS cycle_count_line_uuid_idx ON cycle_count_line(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS cycle_count_line_ingredient_lot_idx
+    ON cycle_count_line(cycle_count_id, ingredient_lot_id, amount_unit)
+    WHERE ingredient_lot_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS cycle_count_line_beer_lot_idx
+    ON cycle_count_line(cycle_count_id, beer_lot_id, amount_unit)
+    WHERE beer_lot_id IS NOT NULL;
+
+COMMIT;
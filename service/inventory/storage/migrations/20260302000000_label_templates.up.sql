@@ -0,0 +1,50 @@
+BEGIN;
+
+-- ==============================================================================
+-- 1. label_template table
+-- ==============================================================================
+
+-- A configurable layout for printed labels of ingredient lots, beer lots or
+-- vessel tank cards. At most one template per subject is the default.
+CREATE TABLE IF NOT EXISTS label_template (
+    id                    serial PRIMARY KEY,
+    uuid                  uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    name                  varchar(100) NOT NULL,
+    subject               varchar(16) NOT NULL,
+    is_default            boolean NOT NULL DEFAULT false,
+
+    width_mm              numeric(5,1) NOT NULL,
+    height_mm             numeric(5,1) NOT NULL,
+    fields                text[] NOT NULL,
+    barcode               varchar(8) NOT NULL DEFAULT 'code128',
+    barcode_content       varchar(8) NOT NULL DEFAULT 'code',
+    font_size_pt          numeric(4,1) NOT NULL DEFAULT 9,
+    printer_dpi           int NOT NULL DEFAULT 203,
+
+    -- Grid of labels per page for sheet printers; NULL prints one label per page
+    sheet_page_width_mm   numeric(5,1),
+    sheet_page_height_mm  numeric(5,1),
+    sheet_columns         int,
+    sheet_rows            int,
+    sheet_margin_mm       numeric(4,1),
+    sheet_gap_mm          numeric(4,1),
+
+    created_at            timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at            timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at            timestamptz,
+
+    CONSTRAINT label_template_subject_check CHECK (subject IN ('ingredient_lot', 'beer_lot', 'vessel')),
+    CONSTRAINT label_template_barcode_check CHECK (barcode IN ('code128', 'qr', 'none')),
+    CONSTRAINT label_template_barcode_content_check CHECK (barcode_content IN ('code', 'uuid')),
+    CONSTRAINT label_template_size_check CHECK (width_mm > 0 AND height_mm > 0),
+    CONSTRAINT label_template_sheet_check CHECK (
+        num_nulls(sheet_page_width_mm, sheet_page_height_mm, sheet_columns, sheet_rows, sheet_margin_mm, sheet_gap_mm) IN (0, 6)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS label_template_uuid_idx ON label_template(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS label_template_default_idx ON label_template(subject)
+    WHERE is_default AND deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE IF EXISTS inventory_journal_export CASCADE;
+COMMIT;
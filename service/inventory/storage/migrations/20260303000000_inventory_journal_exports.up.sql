@@ -0,0 +1,22 @@
+-- Inventory journal exports. Journals are recomputed from the movement
+-- ledger, so each export keeps the file exactly as it was sent to the books.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS inventory_journal_export (
+    id           serial PRIMARY KEY,
+    uuid         uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    period       char(7) NOT NULL,
+    format       varchar(8) NOT NULL,
+    entry_count  int NOT NULL,
+    total_cents  bigint NOT NULL,
+    content      text NOT NULL,
+
+    created_at   timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT inventory_journal_export_format_check CHECK (format IN ('iif', 'xero'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS inventory_journal_export_uuid_idx ON inventory_journal_export(uuid);
+CREATE INDEX IF NOT EXISTS inventory_journal_export_period_idx ON inventory_journal_export(period);
+
+COMMIT;
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/internal/label"
 	"github.com/gofrs/uuid/v5"
 )
 
@@ -15,6 +16,7 @@ const (
 	IngredientCategorySalt        = "salt"
 	IngredientCategoryChemical    = "chemical"
 	IngredientCategoryGas         = "gas"
+	IngredientCategoryPackaging   = "packaging"
 	IngredientCategoryOther       = "other"
 )
 
@@ -64,6 +66,38 @@ const (
 	MovementReasonWaste    = "waste"
 	MovementReasonPackage  = "package"
 	MovementReasonRemoval  = "removal"
+	MovementReasonReturn   = "return"
+)
+
+// Reasons for returning goods to a supplier.
+const (
+	SupplierReturnReasonDamaged       = "damaged"
+	SupplierReturnReasonDeadOnArrival = "dead_on_arrival"
+	SupplierReturnReasonQualityReject = "quality_reject"
+	SupplierReturnReasonWrongItem     = "wrong_item"
+	SupplierReturnReasonExpired       = "expired"
+	SupplierReturnReasonOther         = "other"
+)
+
+// Status of the credit expected from a supplier for a return.
+const (
+	CreditStatusRequested = "requested"
+	CreditStatusCredited  = "credited"
+	CreditStatusRefused   = "refused"
+)
+
+// Status of recording a supplier return against its purchase order line in
+// Procurement. A failed return keeps the error and can be retried.
+const (
+	PurchaseOrderSyncPending = "pending"
+	PurchaseOrderSyncSynced  = "synced"
+	PurchaseOrderSyncFailed  = "failed"
+)
+
+// Inventory valuation methods.
+const (
+	ValuationMethodFIFO            = "fifo"
+	ValuationMethodWeightedAverage = "weighted_average"
 )
 
 // Removal categories.
@@ -112,6 +146,101 @@ const (
 	BeerLotItemStatusDestroyed = "destroyed"
 )
 
+// beerLotItemTransitions lists the statuses each beer lot item status may move to.
+// Destroyed is terminal.
+var beerLotItemTransitions = map[string][]string{
+	BeerLotItemStatusAvailable: {BeerLotItemStatusReserved, BeerLotItemStatusSold, BeerLotItemStatusDamaged, BeerLotItemStatusDestroyed},
+	BeerLotItemStatusReserved:  {BeerLotItemStatusAvailable, BeerLotItemStatusSold, BeerLotItemStatusDamaged, BeerLotItemStatusDestroyed},
+	BeerLotItemStatusSold:      {BeerLotItemStatusReturned, BeerLotItemStatusDamaged, BeerLotItemStatusDestroyed},
+	BeerLotItemStatusReturned:  {BeerLotItemStatusAvailable, BeerLotItemStatusDamaged, BeerLotItemStatusDestroyed},
+	BeerLotItemStatusDamaged:   {BeerLotItemStatusAvailable, BeerLotItemStatusDestroyed},
+	BeerLotItemStatusDestroyed: {},
+}
+
+// CanTransitionBeerLotItem reports whether a beer lot item may move from one
+// status to another.
+func CanTransitionBeerLotItem(from, to string) bool {
+	for _, allowed := range beerLotItemTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Keg ownership types.
+const (
+	KegOwnershipOwned  = "owned"
+	KegOwnershipLeased = "leased"
+)
+
+// Keg statuses.
+const (
+	KegStatusClean    = "clean"
+	KegStatusFilled   = "filled"
+	KegStatusDeployed = "deployed"
+	KegStatusDirty    = "dirty"
+	KegStatusLost     = "lost"
+	KegStatusRetired  = "retired"
+)
+
+// Keg event types.
+const (
+	KegEventFill     = "fill"
+	KegEventShip     = "ship"
+	KegEventReturn   = "return"
+	KegEventClean    = "clean"
+	KegEventMove     = "move"
+	KegEventLost     = "lost"
+	KegEventWriteOff = "write_off"
+	KegEventRetire   = "retire"
+)
+
+// kegEventRule describes which statuses an event may be applied from and the
+// status it leaves the keg in. An empty To leaves the status unchanged.
+type kegEventRule struct {
+	From []string
+	To   string
+}
+
+// kegEventRules defines the keg lifecycle: fill, ship, return, clean, refill.
+// Kegs must be cleaned between fills.
+var kegEventRules = map[string]kegEventRule{
+	KegEventFill:     {From: []string{KegStatusClean}, To: KegStatusFilled},
+	KegEventShip:     {From: []string{KegStatusFilled}, To: KegStatusDeployed},
+	KegEventReturn:   {From: []string{KegStatusDeployed, KegStatusLost}, To: KegStatusDirty},
+	KegEventClean:    {From: []string{KegStatusDirty}, To: KegStatusClean},
+	KegEventMove:     {From: []string{KegStatusClean, KegStatusFilled, KegStatusDirty}},
+	KegEventLost:     {From: []string{KegStatusClean, KegStatusFilled, KegStatusDeployed, KegStatusDirty}, To: KegStatusLost},
+	KegEventWriteOff: {From: []string{KegStatusLost}, To: KegStatusRetired},
+	KegEventRetire:   {From: []string{KegStatusClean, KegStatusDirty}, To: KegStatusRetired},
+}
+
+// NextKegStatus returns the status a keg moves to when eventType is applied
+// from status, and false if the event is not allowed from that status.
+func NextKegStatus(status, eventType string) (string, bool) {
+	rule, ok := kegEventRules[eventType]
+	if !ok {
+		return "", false
+	}
+	for _, from := range rule.From {
+		if from == status {
+			if rule.To == "" {
+				return status, true
+			}
+			return rule.To, true
+		}
+	}
+	return "", false
+}
+
+// Inventory reservation statuses.
+const (
+	ReservationStatusActive   = "active"
+	ReservationStatusConsumed = "consumed"
+	ReservationStatusReleased = "released"
+)
+
 const (
 	AdjustmentReasonCycleCount = "cycle_count"
 	AdjustmentReasonSpoilage   = "spoilage"
@@ -121,6 +250,13 @@ const (
 	AdjustmentReasonOther      = "other"
 )
 
+// Cycle count statuses.
+const (
+	CycleCountStatusOpen      = "open"
+	CycleCountStatusPosted    = "posted"
+	CycleCountStatusCancelled = "cancelled"
+)
+
 type Ingredient struct {
 	entity.Identifiers
 	Name        string
@@ -285,6 +421,109 @@ type BeerLotItem struct {
 	entity.Timestamps
 }
 
+// BeerLotItemEvent records a single status change of a beer lot item.
+type BeerLotItemEvent struct {
+	entity.Identifiers
+	BeerLotItemID   int64
+	BeerLotItemUUID string // Joined from beer_lot_item table
+	FromStatus      *string
+	ToStatus        string
+	Destination     *string
+	OccurredAt      time.Time
+	Notes           *string
+	entity.Timestamps
+}
+
+// Keg is a returnable keg tracked as a capital asset across fills.
+type Keg struct {
+	entity.Identifiers
+	Serial                 string
+	Capacity               int64
+	CapacityUnit           string
+	Ownership              string
+	Lessor                 *string
+	PurchaseCostCents      *int64
+	Currency               *string
+	PurchasedAt            *time.Time
+	Status                 string
+	StockLocationID        *int64
+	StockLocationUUID      *string // Joined from stock_location table
+	Custodian              *string
+	CurrentBeerLotItemID   *int64
+	CurrentBeerLotItemUUID *string // Joined from beer_lot_item table
+	CurrentBeerLotUUID     *string // Joined from beer_lot table
+	DepositBalanceCents    int64
+	Notes                  *string
+	entity.Timestamps
+}
+
+// KegEvent records a single step in a keg's lifecycle.
+type KegEvent struct {
+	entity.Identifiers
+	KegID             int64
+	KegUUID           string // Joined from keg table
+	EventType         string
+	FromStatus        string
+	ToStatus          string
+	StockLocationID   *int64
+	StockLocationUUID *string // Joined from stock_location table
+	Custodian         *string
+	BeerLotItemID     *int64
+	BeerLotItemUUID   *string // Joined from beer_lot_item table
+	DepositCents      *int64
+	WriteOffCents     *int64
+	OccurredAt        time.Time
+	Notes             *string
+	entity.Timestamps
+}
+
+// InventoryReservation is a soft hold on ingredient lot stock at a location
+// for a planned production batch.
+type InventoryReservation struct {
+	entity.Identifiers
+	ProductionRefUUID uuid.UUID
+	IngredientLotID   int64
+	IngredientLotUUID string // Joined from ingredient_lot table
+	StockLocationID   int64
+	StockLocationUUID string // Joined from stock_location table
+	Amount            int64
+	AmountUnit        string
+	ConsumedAmount    int64
+	Status            string
+	ReleasedAt        *time.Time
+	Notes             *string
+	entity.Timestamps
+}
+
+// OutstandingAmount is the portion of the reservation still held.
+func (r InventoryReservation) OutstandingAmount() int64 {
+	if r.Status != ReservationStatusActive {
+		return 0
+	}
+	return r.Amount - r.ConsumedAmount
+}
+
+// IngredientReorderPolicy holds the replenishment levels for an ingredient,
+// either across all locations or at a single stock location. Levels are in
+// the ingredient's default unit.
+type IngredientReorderPolicy struct {
+	entity.Identifiers
+	IngredientID          int64
+	IngredientUUID        string // Joined from ingredient table
+	IngredientName        string // Joined from ingredient table
+	DefaultUnit           string // Joined from ingredient table
+	StockLocationID       *int64
+	StockLocationUUID     *string // Joined from stock_location table
+	StockLocationName     *string // Joined from stock_location table
+	MinLevel              int64
+	MaxLevel              int64
+	SafetyStock           int64
+	PreferredSupplierUUID *uuid.UUID // Cross-service ref to procurement.supplier
+	LeadTimeDays          *int
+	Notes                 *string
+	entity.Timestamps
+}
+
 type InventoryRemoval struct {
 	entity.Identifiers
 	Category      string
@@ -309,6 +548,55 @@ type InventoryRemoval struct {
 	entity.Timestamps
 }
 
+// SupplierReturn is goods from an ingredient lot sent back to the supplier,
+// with the credit expected for them.
+type SupplierReturn struct {
+	entity.Identifiers
+	IngredientLotID       int64
+	IngredientLotUUID     string  // Joined from ingredient_lot table
+	BreweryLotCode        *string // Joined from ingredient_lot table
+	IngredientUUID        string  // Joined from ingredient table
+	IngredientName        string  // Joined from ingredient table
+	StockLocationID       int64
+	StockLocationUUID     string // Joined from stock_location table
+	PurchaseOrderLineUUID string
+	Amount                int64
+	AmountUnit            string
+	Reason                string
+	ReferenceCode         *string
+	ReturnedAt            time.Time
+	CreditAmountCents     *int64
+	CreditCurrency        *string
+	CreditStatus          string
+	CreditResolvedAt      *time.Time
+	// Recording the return against the purchase order line in Procurement
+	PurchaseOrderSyncStatus string
+	PurchaseOrderSyncedAt   *time.Time
+	PurchaseOrderSyncError  *string
+	Notes                   *string
+	MovementID              *int64
+	MovementUUID            *string // Joined from inventory_movement table
+	entity.Timestamps
+}
+
+// SupplierReturnFilter narrows a supplier return listing. Unset fields do not
+// filter.
+type SupplierReturnFilter struct {
+	IngredientLotUUID       *string
+	PurchaseOrderLineUUID   *string
+	CreditStatus            *string
+	PurchaseOrderSyncStatus *string
+}
+
+// SupplierReturnCreditUpdate changes the credit of a supplier return.
+type SupplierReturnCreditUpdate struct {
+	CreditAmountCents *int64
+	CreditCurrency    *string
+	CreditStatus      *string
+	ReferenceCode     *string
+	Notes             *string
+}
+
 type InventoryMovement struct {
 	entity.Identifiers
 	IngredientLotID   *int64
@@ -332,6 +620,75 @@ type InventoryMovement struct {
 	TransferUUID      *string // Joined from inventory_transfer table
 	RemovalID         *int64
 	RemovalUUID       *string // Joined from inventory_removal table
+	ReturnID          *int64
+	ReturnUUID        *string // Joined from supplier_return table
 	Notes             *string
 	entity.Timestamps
 }
+
+// CycleCount is a count session for one stock location. Expected balances
+// are frozen from the ledger at FrozenAt.
+type CycleCount struct {
+	entity.Identifiers
+	StockLocationID   int64
+	StockLocationUUID string // Joined from stock_location table
+	StockLocationName string // Joined from stock_location table
+	Status            string
+	Blind             bool
+	FrozenAt          time.Time
+	PostedAt          *time.Time
+	Notes             *string
+	entity.Timestamps
+}
+
+// CycleCountFilter narrows a cycle count listing. Unset fields do not filter.
+type CycleCountFilter struct {
+	StockLocationUUID *string
+	Status            *string
+}
+
+// CycleCountLine is one lot on a count sheet. CountedAmount is nil until the
+// lot has been counted; VarianceValueCents is recorded when the session is
+// posted.
+type CycleCountLine struct {
+	entity.Identifiers
+	CycleCountID          int64
+	IngredientLotID       *int64
+	IngredientLotUUID     *string // Joined from ingredient_lot table
+	BeerLotID             *int64
+	BeerLotUUID           *string // Joined from beer_lot table
+	LotCode               *string // Brewery lot code of the ingredient or beer lot
+	ItemName              *string // Joined from ingredient table for ingredient lots
+	PurchaseOrderLineUUID *string // Joined from ingredient_lot table
+	LotReceivedUnit       *string // Joined from ingredient_lot table
+	AmountUnit            string
+	ExpectedAmount        int64
+	CountedAmount         *int64
+	CountedAt             *time.Time
+	VarianceValueCents    *int64
+	ValueCurrency         *string
+	AdjustmentID          *int64
+	AdjustmentUUID        *string // Joined from inventory_adjustment table
+	Notes                 *string
+	entity.Timestamps
+}
+
+// Variance is counted minus expected, or nil until the line is counted.
+func (l CycleCountLine) Variance() *int64 {
+	if l.CountedAmount == nil {
+		return nil
+	}
+	variance := *l.CountedAmount - l.ExpectedAmount
+	return &variance
+}
+
+// LabelTemplate is a named label layout for one label.Subject. Vessel
+// templates are stored here for the Production service's tank cards.
+type LabelTemplate struct {
+	entity.Identifiers
+	Name      string
+	Subject   string
+	IsDefault bool
+	Layout    label.Template
+	entity.Timestamps
+}
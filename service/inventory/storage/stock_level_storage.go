@@ -10,6 +10,8 @@ type StockLevelLocation struct {
 	LocationUUID string
 	LocationName string
 	Quantity     float64
+	Reserved     float64
+	Available    float64
 }
 
 // StockLevel represents the aggregated stock level for an ingredient.
@@ -19,6 +21,8 @@ type StockLevel struct {
 	Category       string
 	DefaultUnit    string
 	TotalOnHand    float64
+	TotalReserved  float64
+	TotalAvailable float64
 	Locations      []StockLevelLocation
 }
 
@@ -31,13 +35,17 @@ type stockLevelRow struct {
 	LocationUUID   string
 	LocationName   string
 	Quantity       float64
+	Reserved       float64
 }
 
 // GetStockLevels returns current stock levels for all ingredients, computed
 // from the inventory movement ledger. Stock is grouped by ingredient and
-// location. Only ingredient lots are included (not beer lots).
+// location. Only ingredient lots are included (not beer lots). Available is
+// on-hand less active reservations. Reservations are held in their lot's
+// received unit, so only those on lots received in the ingredient's default
+// unit are counted.
 func (c *Client) GetStockLevels(ctx context.Context) ([]StockLevel, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT 
 			i.uuid as ingredient_uuid,
 			i.name as ingredient_name,
@@ -48,7 +56,17 @@ func (c *Client) GetStockLevels(ctx context.Context) ([]StockLevel, error) {
 			SUM(CASE m.direction 
 				WHEN 'in' THEN m.amount 
 				WHEN 'out' THEN -m.amount 
-			END) as quantity
+			END) as quantity,
+			COALESCE((
+				SELECT SUM(r.amount - r.consumed_amount)
+				FROM inventory_reservation r
+				JOIN ingredient_lot ril ON ril.id = r.ingredient_lot_id
+				WHERE ril.ingredient_id = i.id
+				  AND ril.received_unit = i.default_unit
+				  AND r.stock_location_id = sl.id
+				  AND r.status = 'active'
+				  AND r.deleted_at IS NULL
+			), 0) as reserved
 		FROM inventory_movement m
 		JOIN ingredient_lot il ON il.id = m.ingredient_lot_id
 		JOIN ingredient i ON i.id = il.ingredient_id
@@ -77,6 +95,7 @@ func (c *Client) GetStockLevels(ctx context.Context) ([]StockLevel, error) {
 			&row.LocationUUID,
 			&row.LocationName,
 			&row.Quantity,
+			&row.Reserved,
 		); err != nil {
 			return nil, fmt.Errorf("scanning stock level row: %w", err)
 		}
@@ -116,8 +135,12 @@ func aggregateStockLevels(rows []stockLevelRow) []StockLevel {
 			LocationUUID: row.LocationUUID,
 			LocationName: row.LocationName,
 			Quantity:     row.Quantity,
+			Reserved:     row.Reserved,
+			Available:    row.Quantity - row.Reserved,
 		})
 		sl.TotalOnHand += row.Quantity
+		sl.TotalReserved += row.Reserved
+		sl.TotalAvailable += row.Quantity - row.Reserved
 	}
 
 	// Build result in original order (preserves ORDER BY from SQL)
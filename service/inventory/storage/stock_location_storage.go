@@ -15,7 +15,7 @@ import (
 var ErrStockLocationHasInventory = fmt.Errorf("stock location has inventory and cannot be deleted")
 
 func (c *Client) CreateStockLocation(ctx context.Context, location StockLocation) (StockLocation, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO stock_location (
 			name,
 			location_type,
@@ -44,7 +44,7 @@ func (c *Client) CreateStockLocation(ctx context.Context, location StockLocation
 
 func (c *Client) GetStockLocation(ctx context.Context, id int64) (StockLocation, error) {
 	var location StockLocation
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, name, location_type, description, created_at, updated_at, deleted_at
 		FROM stock_location
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -71,7 +71,7 @@ func (c *Client) GetStockLocation(ctx context.Context, id int64) (StockLocation,
 
 func (c *Client) GetStockLocationByUUID(ctx context.Context, locationUUID string) (StockLocation, error) {
 	var location StockLocation
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, name, location_type, description, created_at, updated_at, deleted_at
 		FROM stock_location
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -134,7 +134,7 @@ func (c *Client) UpdateStockLocation(ctx context.Context, locationUUID string, r
 		strings.Join(setClauses, ", "), argIdx)
 
 	var location StockLocation
-	err := c.DB().QueryRow(ctx, query, args...).Scan(
+	err := c.Conn(ctx).QueryRow(ctx, query, args...).Scan(
 		&location.ID,
 		&location.UUID,
 		&location.Name,
@@ -157,7 +157,7 @@ func (c *Client) UpdateStockLocation(ctx context.Context, locationUUID string, r
 func (c *Client) DeleteStockLocation(ctx context.Context, locationUUID string) error {
 	// Check if any non-deleted inventory movements reference this location.
 	var location StockLocation
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id FROM stock_location
 		WHERE uuid = $1 AND deleted_at IS NULL`,
 		locationUUID,
@@ -170,7 +170,7 @@ func (c *Client) DeleteStockLocation(ctx context.Context, locationUUID string) e
 	}
 
 	var refCount int
-	err = c.DB().QueryRow(ctx, `
+	err = c.Conn(ctx).QueryRow(ctx, `
 		SELECT COUNT(*)
 		FROM inventory_movement
 		WHERE stock_location_id = $1 AND deleted_at IS NULL`,
@@ -183,7 +183,7 @@ func (c *Client) DeleteStockLocation(ctx context.Context, locationUUID string) e
 		return ErrStockLocationHasInventory
 	}
 
-	tag, err := c.DB().Exec(ctx, `
+	tag, err := c.Conn(ctx).Exec(ctx, `
 		UPDATE stock_location
 		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -200,7 +200,7 @@ func (c *Client) DeleteStockLocation(ctx context.Context, locationUUID string) e
 }
 
 func (c *Client) ListStockLocations(ctx context.Context) ([]StockLocation, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, name, location_type, description, created_at, updated_at, deleted_at
 		FROM stock_location
 		WHERE deleted_at IS NULL
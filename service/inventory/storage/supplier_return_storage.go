@@ -0,0 +1,331 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+// SupplierReturnRequest describes a return of part of an ingredient lot to
+// its supplier, created together with its inventory movement.
+type SupplierReturnRequest struct {
+	IngredientLotID       int64
+	StockLocationID       int64
+	PurchaseOrderLineUUID string
+	Amount                int64
+	AmountUnit            string
+	Reason                string
+	ReferenceCode         *string
+	ReturnedAt            time.Time
+	CreditAmountCents     *int64
+	CreditCurrency        *string
+	Notes                 *string
+}
+
+// ErrInsufficientReturnStock is returned when a return is larger than the
+// lot's stock at the location. The message is safe to return to clients.
+type ErrInsufficientReturnStock struct {
+	Message string
+}
+
+func (e *ErrInsufficientReturnStock) Error() string {
+	return e.Message
+}
+
+// supplierReturnColumns is the column list shared by supplier return queries.
+const supplierReturnColumns = `
+	sr.id, sr.uuid,
+	sr.ingredient_lot_id, il.uuid, il.brewery_lot_code, i.uuid, i.name,
+	sr.stock_location_id, sl.uuid,
+	sr.purchase_order_line_uuid,
+	sr.amount, sr.amount_unit, sr.reason, sr.reference_code, sr.returned_at,
+	sr.credit_amount_cents, sr.credit_currency, sr.credit_status, sr.credit_resolved_at,
+	sr.purchase_order_sync_status, sr.purchase_order_synced_at, sr.purchase_order_sync_error,
+	sr.notes, sr.movement_id, mv.uuid,
+	sr.created_at, sr.updated_at, sr.deleted_at`
+
+// supplierReturnJoins is the JOIN clause shared by supplier return queries.
+const supplierReturnJoins = `
+	FROM supplier_return sr
+	JOIN ingredient_lot il ON il.id = sr.ingredient_lot_id
+	JOIN ingredient i ON i.id = il.ingredient_id
+	JOIN stock_location sl ON sl.id = sr.stock_location_id
+	LEFT JOIN inventory_movement mv ON mv.id = sr.movement_id`
+
+func scanSupplierReturn(row pgx.Row) (SupplierReturn, error) {
+	var sr SupplierReturn
+	err := row.Scan(
+		&sr.ID,
+		&sr.UUID,
+		&sr.IngredientLotID,
+		&sr.IngredientLotUUID,
+		&sr.BreweryLotCode,
+		&sr.IngredientUUID,
+		&sr.IngredientName,
+		&sr.StockLocationID,
+		&sr.StockLocationUUID,
+		&sr.PurchaseOrderLineUUID,
+		&sr.Amount,
+		&sr.AmountUnit,
+		&sr.Reason,
+		&sr.ReferenceCode,
+		&sr.ReturnedAt,
+		&sr.CreditAmountCents,
+		&sr.CreditCurrency,
+		&sr.CreditStatus,
+		&sr.CreditResolvedAt,
+		&sr.PurchaseOrderSyncStatus,
+		&sr.PurchaseOrderSyncedAt,
+		&sr.PurchaseOrderSyncError,
+		&sr.Notes,
+		&sr.MovementID,
+		&sr.MovementUUID,
+		&sr.CreatedAt,
+		&sr.UpdatedAt,
+		&sr.DeletedAt,
+	)
+	return sr, err
+}
+
+// CreateSupplierReturnWithMovement atomically records a supplier return and
+// the `out` movement with reason return that takes the goods out of stock.
+// The return may not exceed the lot's stock at the location.
+func (c *Client) CreateSupplierReturnWithMovement(ctx context.Context, req SupplierReturnRequest) (SupplierReturn, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("starting supplier return transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	returnedAt := req.ReturnedAt
+	if returnedAt.IsZero() {
+		returnedAt = time.Now().UTC()
+	}
+
+	// NOTE: like batch usage, this stock check is not protected by row-level
+	// locks; acceptable for V1 (single-user).
+	var available int64
+	err = tx.QueryRow(ctx, `
+		SELECT COALESCE(SUM(CASE direction
+			WHEN 'in' THEN amount
+			WHEN 'out' THEN -amount
+		END), 0)
+		FROM inventory_movement
+		WHERE ingredient_lot_id = $1
+		  AND stock_location_id = $2
+		  AND deleted_at IS NULL`,
+		req.IngredientLotID,
+		req.StockLocationID,
+	).Scan(&available)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("checking stock for supplier return: %w", err)
+	}
+	if req.Amount > available {
+		return SupplierReturn{}, &ErrInsufficientReturnStock{
+			Message: fmt.Sprintf("insufficient stock to return: available %d %s, requested %d %s",
+				available, req.AmountUnit, req.Amount, req.AmountUnit),
+		}
+	}
+
+	var returnID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO supplier_return (
+			ingredient_lot_id,
+			stock_location_id,
+			purchase_order_line_uuid,
+			amount,
+			amount_unit,
+			reason,
+			reference_code,
+			returned_at,
+			credit_amount_cents,
+			credit_currency,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		req.IngredientLotID,
+		req.StockLocationID,
+		req.PurchaseOrderLineUUID,
+		req.Amount,
+		req.AmountUnit,
+		req.Reason,
+		req.ReferenceCode,
+		returnedAt,
+		req.CreditAmountCents,
+		req.CreditCurrency,
+		req.Notes,
+	).Scan(&returnID)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("creating supplier return: %w", err)
+	}
+
+	var movementID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO inventory_movement (
+			ingredient_lot_id,
+			stock_location_id,
+			direction,
+			reason,
+			amount,
+			amount_unit,
+			occurred_at,
+			return_id,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id`,
+		req.IngredientLotID,
+		req.StockLocationID,
+		MovementDirectionOut,
+		MovementReasonReturn,
+		req.Amount,
+		req.AmountUnit,
+		returnedAt,
+		returnID,
+		req.Notes,
+	).Scan(&movementID)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("creating supplier return movement: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE supplier_return SET movement_id = $1 WHERE id = $2`, movementID, returnID)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("linking supplier return movement: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return SupplierReturn{}, fmt.Errorf("committing supplier return transaction: %w", err)
+	}
+
+	created, err := scanSupplierReturn(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+supplierReturnColumns+supplierReturnJoins+`
+		WHERE sr.id = $1`, returnID))
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("getting created supplier return: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetSupplierReturnByUUID(ctx context.Context, returnUUID string) (SupplierReturn, error) {
+	sr, err := scanSupplierReturn(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+supplierReturnColumns+supplierReturnJoins+`
+		WHERE sr.uuid = $1 AND sr.deleted_at IS NULL`,
+		returnUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SupplierReturn{}, service.ErrNotFound
+		}
+		return SupplierReturn{}, fmt.Errorf("getting supplier return by uuid: %w", err)
+	}
+
+	return sr, nil
+}
+
+// ListSupplierReturns returns supplier returns, newest first, matching the
+// filter.
+func (c *Client) ListSupplierReturns(ctx context.Context, filter SupplierReturnFilter) ([]SupplierReturn, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT `+supplierReturnColumns+supplierReturnJoins+`
+		WHERE sr.deleted_at IS NULL
+		  AND ($1::uuid IS NULL OR il.uuid = $1)
+		  AND ($2::uuid IS NULL OR sr.purchase_order_line_uuid = $2)
+		  AND ($3::text IS NULL OR sr.credit_status = $3)
+		  AND ($4::text IS NULL OR sr.purchase_order_sync_status = $4)
+		ORDER BY sr.returned_at DESC, sr.id DESC`,
+		filter.IngredientLotUUID,
+		filter.PurchaseOrderLineUUID,
+		filter.CreditStatus,
+		filter.PurchaseOrderSyncStatus,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier returns: %w", err)
+	}
+	defer rows.Close()
+
+	var returns []SupplierReturn
+	for rows.Next() {
+		sr, err := scanSupplierReturn(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning supplier return: %w", err)
+		}
+		returns = append(returns, sr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing supplier returns: %w", err)
+	}
+
+	return returns, nil
+}
+
+// UpdateSupplierReturnCredit changes the credit of a supplier return. Moving
+// the credit to credited or refused records when it was resolved; moving it
+// back to requested clears that.
+func (c *Client) UpdateSupplierReturnCredit(ctx context.Context, returnUUID string, update SupplierReturnCreditUpdate) (SupplierReturn, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE supplier_return
+		SET credit_amount_cents = COALESCE($2, credit_amount_cents),
+		    credit_currency = COALESCE($3, credit_currency),
+		    credit_resolved_at = CASE
+		        WHEN $4::text IS NULL OR $4 = credit_status THEN credit_resolved_at
+		        WHEN $4 = 'requested' THEN NULL
+		        ELSE timezone('utc', now())
+		    END,
+		    credit_status = COALESCE($4, credit_status),
+		    reference_code = COALESCE($5, reference_code),
+		    notes = COALESCE($6, notes),
+		    updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		returnUUID,
+		update.CreditAmountCents,
+		update.CreditCurrency,
+		update.CreditStatus,
+		update.ReferenceCode,
+		update.Notes,
+	)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("updating supplier return credit: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return SupplierReturn{}, service.ErrNotFound
+	}
+
+	return c.GetSupplierReturnByUUID(ctx, returnUUID)
+}
+
+// RecordSupplierReturnSync records the outcome of recording a supplier return
+// against its purchase order line in Procurement: synced when syncErr is nil,
+// failed with its message otherwise.
+func (c *Client) RecordSupplierReturnSync(ctx context.Context, returnUUID string, syncErr error) (SupplierReturn, error) {
+	status, message := PurchaseOrderSyncSynced, (*string)(nil)
+	if syncErr != nil {
+		text := syncErr.Error()
+		status, message = PurchaseOrderSyncFailed, &text
+	}
+
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE supplier_return
+		SET purchase_order_sync_status = $2,
+		    purchase_order_synced_at = CASE WHEN $2 = 'synced' THEN timezone('utc', now()) END,
+		    purchase_order_sync_error = $3,
+		    updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		returnUUID,
+		status,
+		message,
+	)
+	if err != nil {
+		return SupplierReturn{}, fmt.Errorf("recording supplier return sync: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return SupplierReturn{}, service.ErrNotFound
+	}
+
+	return c.GetSupplierReturnByUUID(ctx, returnUUID)
+}
@@ -0,0 +1,375 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// AccountingStore defines the storage methods needed by the accounting handlers.
+type AccountingStore interface {
+	GetAccountMapping(context.Context) (accounting.Mapping, error)
+	ReplaceAccountMapping(context.Context, accounting.Mapping) (accounting.Mapping, error)
+	ListReceivedPurchaseOrders(context.Context, time.Time, time.Time) ([]storage.ReceivedPurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	GetBaseCurrency(context.Context) (string, error)
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+	ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error)
+	CreateJournalExport(context.Context, storage.JournalExport) (storage.JournalExport, error)
+	ListJournalExports(context.Context) ([]storage.JournalExport, error)
+	GetJournalExportByUUID(context.Context, string) (storage.JournalExport, error)
+}
+
+// IngredientCatalog looks up the category of the ingredients purchase order
+// lines are received as.
+type IngredientCatalog interface {
+	ListIngredients(ctx context.Context) ([]InventoryIngredient, error)
+}
+
+// HandleAccountMapping handles [GET /accounting/accounts] and [PUT /accounting/accounts].
+func HandleAccountMapping(db AccountingStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mapping, err := db.GetAccountMapping(r.Context())
+			if err != nil {
+				service.InternalError(w, "error getting gl account mapping", "error", err)
+				return
+			}
+
+			service.JSON(w, mapping)
+		case http.MethodPut:
+			var req dto.UpdateAccountMappingRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			mapping, err := db.ReplaceAccountMapping(r.Context(), req.Mapping())
+			if err != nil {
+				service.InternalError(w, "error replacing gl account mapping", "error", err)
+				return
+			}
+
+			slog.Info("gl account mapping changed", "accounts", len(mapping.Accounts))
+
+			service.JSON(w, mapping)
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandlePurchaseJournal handles [GET /accounting/purchase-journal], previewing
+// the purchase journal of the month given by the period query parameter.
+func HandlePurchaseJournal(db AccountingStore, catalog IngredientCatalog) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		period, err := accounting.ParsePeriod(r.URL.Query().Get("period"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		journal, _, err := buildPurchaseJournal(r.Context(), db, catalog, period)
+		if err != nil {
+			service.InternalError(w, "error building purchase journal", "error", err, "period", period.String())
+			return
+		}
+
+		service.JSON(w, journal)
+	}
+}
+
+// HandlePurchaseJournalExports handles [GET /accounting/purchase-journal/exports]
+// and [POST /accounting/purchase-journal/exports]. Only periods that have
+// ended can be exported, and a period is exported once unless the request
+// asks to export it again.
+func HandlePurchaseJournalExports(db AccountingStore, catalog IngredientCatalog) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			exports, err := db.ListJournalExports(r.Context())
+			if err != nil {
+				service.InternalError(w, "error listing purchase journal exports", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewJournalExportsResponse(exports))
+		case http.MethodPost:
+			var req dto.CreateJournalExportRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			period, _ := accounting.ParsePeriod(req.Period)
+			if period.End().After(time.Now().UTC()) {
+				http.Error(w, "period has not ended", http.StatusBadRequest)
+				return
+			}
+
+			if !req.Reexport {
+				exports, err := db.ListJournalExports(r.Context())
+				if err != nil {
+					service.InternalError(w, "error listing purchase journal exports", "error", err)
+					return
+				}
+				for _, export := range exports {
+					if export.Period == req.Period {
+						http.Error(w, fmt.Sprintf("period %s was already exported", req.Period), http.StatusConflict)
+						return
+					}
+				}
+			}
+
+			journal, mapping, err := buildPurchaseJournal(r.Context(), db, catalog, period)
+			if err != nil {
+				service.InternalError(w, "error building purchase journal", "error", err, "period", req.Period)
+				return
+			}
+
+			var content bytes.Buffer
+			if err := accounting.Write(&content, req.Format, journal, mapping); err != nil {
+				service.InternalError(w, "error writing purchase journal", "error", err, "period", req.Period)
+				return
+			}
+
+			export, err := db.CreateJournalExport(r.Context(), storage.JournalExport{
+				Period:     req.Period,
+				Format:     req.Format,
+				EntryCount: len(journal.Entries),
+				TotalCents: journal.TotalCents,
+				Content:    content.String(),
+			})
+			if err != nil {
+				service.InternalError(w, "error creating purchase journal export", "error", err)
+				return
+			}
+
+			slog.Info("purchase journal exported",
+				"period", export.Period,
+				"format", export.Format,
+				"entries", export.EntryCount,
+				"skipped", len(journal.Skipped))
+
+			telemetry.JournalExported("purchase", export.Format)
+
+			service.JSONCreated(w, dto.NewJournalExportResponse(export))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandlePurchaseJournalExportFile handles [GET /accounting/purchase-journal/exports/{uuid}/file].
+func HandlePurchaseJournalExportFile(db AccountingStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		exportUUID := r.PathValue("uuid")
+		if exportUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		export, err := db.GetJournalExportByUUID(r.Context(), exportUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "journal export not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting purchase journal export", "error", err)
+			return
+		}
+
+		w.Header().Set("Content-Type", accounting.ContentType(export.Format))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", accounting.FileName("purchase-journal", export.Period, export.Format)))
+		w.Write([]byte(export.Content))
+	}
+}
+
+// buildPurchaseJournal journals each purchase order received in the period
+// on the date it was received. Lines are debited to the account of their
+// ingredient category, or of their item type when they are not received into
+// inventory, and the supplier is credited in accounts payable. Fees with a
+// mapped account are debited to it; other fees are capitalized into the
+// landed cost of the lines. Orders with an amount that cannot be converted
+// into the base currency are skipped.
+func buildPurchaseJournal(ctx context.Context, db AccountingStore, catalog IngredientCatalog, period accounting.Period) (accounting.Journal, accounting.Mapping, error) {
+	mapping, err := db.GetAccountMapping(ctx)
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+	orders, err := db.ListReceivedPurchaseOrders(ctx, period.Start, period.End())
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+
+	orderIDs := make([]int64, 0, len(orders))
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.ID)
+	}
+	var lines []storage.PurchaseOrderLine
+	var fees []storage.PurchaseOrderFee
+	if len(orderIDs) > 0 {
+		if lines, err = db.ListPurchaseOrderLinesByOrderIDs(ctx, orderIDs); err != nil {
+			return accounting.Journal{}, accounting.Mapping{}, err
+		}
+		if fees, err = db.ListPurchaseOrderFeesByOrderIDs(ctx, orderIDs); err != nil {
+			return accounting.Journal{}, accounting.Mapping{}, err
+		}
+	}
+
+	conversions, err := loadOrderConversions(ctx, db, orderIDs, orderCurrencies(lines, fees))
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+
+	categories, err := lineCategories(ctx, catalog, lines)
+	if err != nil {
+		return accounting.Journal{}, accounting.Mapping{}, err
+	}
+
+	var capitalized []storage.PurchaseOrderFee
+	for _, fee := range fees {
+		if _, ok := mapping.Lookup(accounting.KindFeeType, fee.FeeType); !ok {
+			capitalized = append(capitalized, fee)
+		}
+	}
+	allocation := allocateFees(lines, capitalized)
+
+	linesByOrder := make(map[int64][]storage.PurchaseOrderLine)
+	for _, line := range lines {
+		linesByOrder[line.PurchaseOrderID] = append(linesByOrder[line.PurchaseOrderID], line)
+	}
+	feesByOrder := make(map[int64][]storage.PurchaseOrderFee)
+	for _, fee := range fees {
+		feesByOrder[fee.PurchaseOrderID] = append(feesByOrder[fee.PurchaseOrderID], fee)
+	}
+
+	journal := accounting.NewJournal(period, conversions.baseCurrency)
+	for _, order := range orders {
+		var postings []purchasePosting
+		for _, line := range linesByOrder[order.ID] {
+			postings = append(postings, purchasePosting{
+				account:  lineAccount(mapping, categories, line),
+				currency: line.Currency,
+				cents:    line.Quantity*line.UnitCostCents + allocation.byLine[line.ID],
+			})
+		}
+		for _, fee := range feesByOrder[order.ID] {
+			if account, ok := mapping.Lookup(accounting.KindFeeType, fee.FeeType); ok {
+				postings = append(postings, purchasePosting{account: account, currency: fee.Currency, cents: fee.AmountCents})
+			} else if unallocated := allocation.unallocated[fee.ID]; unallocated > 0 {
+				account, _ := mapping.Lookup(accounting.KindItemType, storage.PurchaseOrderItemTypeOther)
+				postings = append(postings, purchasePosting{account: account, currency: fee.Currency, cents: unallocated})
+			}
+		}
+
+		entry := accounting.NewEntry(order.ReceivedAt, order.OrderNumber,
+			fmt.Sprintf("Purchase order %s from %s", order.OrderNumber, order.SupplierName))
+		var total int64
+		var missing error
+		for _, p := range postings {
+			rate := conversions.lookup(order.ID, p.currency)
+			if rate == nil {
+				missing = missingExchangeRateError{currency: p.currency, baseCurrency: conversions.baseCurrency, at: order.ReceivedAt}
+				break
+			}
+			base := dto.ConvertCents(p.cents, rate.Rate)
+			entry.Debit(p.account, "", base)
+			total += base
+		}
+		if missing != nil {
+			journal.Skip(order.OrderNumber, missing.Error())
+			continue
+		}
+
+		entry.Credit(mapping.Role(accounting.RoleAccountsPayable), order.SupplierName, total)
+		journal.Add(entry)
+	}
+
+	return journal, mapping, nil
+}
+
+// purchasePosting is an amount in an order currency to debit to an account.
+type purchasePosting struct {
+	account  string
+	currency string
+	cents    int64
+}
+
+// lineCategories returns the ingredient category of each inventory item
+// received on the lines, keyed by inventory item UUID.
+func lineCategories(ctx context.Context, catalog IngredientCatalog, lines []storage.PurchaseOrderLine) (map[string]string, error) {
+	categories := make(map[string]string)
+	needed := false
+	for _, line := range lines {
+		if receivedIntoInventory(line) && line.InventoryItemUUID != nil {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return categories, nil
+	}
+
+	ingredients, err := catalog.ListIngredients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, ingredient := range ingredients {
+		categories[ingredient.UUID] = ingredient.Category
+	}
+	return categories, nil
+}
+
+// lineAccount returns the account a purchase order line is debited to. A
+// line that is not linked to an inventory ingredient falls back to the
+// packaging category for packaging and the other category for ingredients.
+func lineAccount(mapping accounting.Mapping, categories map[string]string, line storage.PurchaseOrderLine) string {
+	if !receivedIntoInventory(line) {
+		account, ok := mapping.Lookup(accounting.KindItemType, line.ItemType)
+		if !ok {
+			account, _ = mapping.Lookup(accounting.KindItemType, storage.PurchaseOrderItemTypeOther)
+		}
+		return account
+	}
+
+	if line.InventoryItemUUID != nil {
+		if category, ok := categories[line.InventoryItemUUID.String()]; ok {
+			return mapping.Category(category)
+		}
+	}
+	if line.ItemType == storage.PurchaseOrderItemTypePackaging {
+		return mapping.Category("packaging")
+	}
+	return mapping.Category("other")
+}
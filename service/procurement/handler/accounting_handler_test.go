@@ -0,0 +1,252 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type AccountingStore struct {
+	mapping accounting.Mapping
+	orders  []storage.ReceivedPurchaseOrder
+	lines   []storage.PurchaseOrderLine
+	fees    []storage.PurchaseOrderFee
+	exports []storage.JournalExport
+	CurrencyStore
+}
+
+func (s *AccountingStore) GetAccountMapping(context.Context) (accounting.Mapping, error) {
+	return s.mapping, nil
+}
+
+func (s *AccountingStore) ReplaceAccountMapping(_ context.Context, mapping accounting.Mapping) (accounting.Mapping, error) {
+	s.mapping = mapping
+	return mapping, nil
+}
+
+func (s *AccountingStore) ListReceivedPurchaseOrders(_ context.Context, from, to time.Time) ([]storage.ReceivedPurchaseOrder, error) {
+	var orders []storage.ReceivedPurchaseOrder
+	for _, order := range s.orders {
+		if !order.ReceivedAt.Before(from) && order.ReceivedAt.Before(to) {
+			orders = append(orders, order)
+		}
+	}
+	return orders, nil
+}
+
+func (s *AccountingStore) ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error) {
+	return s.lines, nil
+}
+
+func (s *AccountingStore) ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error) {
+	return s.fees, nil
+}
+
+func (s *AccountingStore) CreateJournalExport(_ context.Context, export storage.JournalExport) (storage.JournalExport, error) {
+	export.UUID = uuid.Must(uuid.NewV4()).String()
+	export.CreatedAt = time.Now().UTC()
+	s.exports = append(s.exports, export)
+	return export, nil
+}
+
+func (s *AccountingStore) ListJournalExports(context.Context) ([]storage.JournalExport, error) {
+	return s.exports, nil
+}
+
+func (s *AccountingStore) GetJournalExportByUUID(_ context.Context, exportUUID string) (storage.JournalExport, error) {
+	for _, export := range s.exports {
+		if export.UUID == exportUUID {
+			return export, nil
+		}
+	}
+	return storage.JournalExport{}, service.ErrNotFound
+}
+
+type IngredientCatalog struct {
+	ingredients []handler.InventoryIngredient
+}
+
+func (c IngredientCatalog) ListIngredients(context.Context) ([]handler.InventoryIngredient, error) {
+	return c.ingredients, nil
+}
+
+func newAccountMapping() accounting.Mapping {
+	mapping := accounting.Mapping{XeroTaxRate: "Tax Exempt"}
+	for _, role := range accounting.Roles {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindRole, Key: role, Account: role})
+	}
+	for _, category := range accounting.Categories {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindCategory, Key: category, Account: "Inventory:" + category})
+	}
+	for _, itemType := range accounting.ItemTypes {
+		mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindItemType, Key: itemType, Account: "Purchases:" + itemType})
+	}
+	mapping.Accounts = append(mapping.Accounts, accounting.Account{Kind: accounting.KindFeeType, Key: "freight", Account: "Freight In"})
+	return mapping
+}
+
+// newAccountingStore has two orders received in September 2026: PO-1 in USD
+// with a hop line, an unlinked packaging line, a mapped freight fee and an
+// unmapped handling fee, and PO-2 in EUR, which has no exchange rate.
+func newAccountingStore() (*AccountingStore, IngredientCatalog) {
+	hopUUID := uuid.Must(uuid.NewV4())
+
+	hops := newLandedCostLine(1, 10, "kg", 2000, "USD")
+	hops.ItemType = storage.PurchaseOrderItemTypeIngredient
+	hops.InventoryItemUUID = &hopUUID
+	cans := newLandedCostLine(2, 100, "each", 50, "USD")
+	cans.ItemType = storage.PurchaseOrderItemTypePackaging
+	euroLine := newLandedCostLine(3, 1, "kg", 1000, "EUR")
+	euroLine.PurchaseOrderID = 2
+	euroLine.ItemType = storage.PurchaseOrderItemTypeIngredient
+
+	handling := newLandedCostFee(2, 500, "USD", storage.FeeAllocationBasisQuantity)
+	handling.FeeType = "handling"
+
+	store := &AccountingStore{
+		mapping: newAccountMapping(),
+		orders: []storage.ReceivedPurchaseOrder{
+			{ID: 1, OrderNumber: "PO-1", SupplierName: "YCH Hops", ReceivedAt: time.Date(2026, 9, 14, 15, 0, 0, 0, time.UTC)},
+			{ID: 2, OrderNumber: "PO-2", SupplierName: "Weyermann", ReceivedAt: time.Date(2026, 9, 20, 9, 0, 0, 0, time.UTC)},
+			{ID: 3, OrderNumber: "PO-3", SupplierName: "YCH Hops", ReceivedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+		},
+		lines: []storage.PurchaseOrderLine{hops, cans, euroLine},
+		fees:  []storage.PurchaseOrderFee{newLandedCostFee(1, 1500, "USD", storage.FeeAllocationBasisValue), handling},
+	}
+	catalog := IngredientCatalog{ingredients: []handler.InventoryIngredient{{UUID: hopUUID.String(), Name: "Citra", Category: "hop"}}}
+	return store, catalog
+}
+
+func TestHandleAccountMapping(t *testing.T) {
+	store := &AccountingStore{mapping: newAccountMapping()}
+	h := handler.HandleAccountMapping(store)
+
+	incomplete, _ := json.Marshal(dto.UpdateAccountMappingRequest{XeroTaxRate: "Tax Exempt", Accounts: store.mapping.Accounts[1:]})
+	req := httptest.NewRequest(http.MethodPut, "/accounting/accounts", bytes.NewReader(incomplete))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "role accounts_payable must be mapped") {
+		t.Errorf("expected an incomplete mapping to be rejected, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	mapping := newAccountMapping()
+	mapping.Accounts[0].Account = "2000 Trade Creditors"
+	body, _ := json.Marshal(dto.UpdateAccountMappingRequest{XeroTaxRate: "BAS Excluded", Accounts: mapping.Accounts})
+	req = httptest.NewRequest(http.MethodPut, "/accounting/accounts", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.mapping.XeroTaxRate != "BAS Excluded" || store.mapping.Role(accounting.RoleAccountsPayable) != "2000 Trade Creditors" {
+		t.Errorf("unexpected stored mapping %+v", store.mapping)
+	}
+}
+
+func TestHandlePurchaseJournal(t *testing.T) {
+	store, catalog := newAccountingStore()
+
+	req := httptest.NewRequest(http.MethodGet, "/accounting/purchase-journal?period=2026-09", nil)
+	rec := httptest.NewRecorder()
+	handler.HandlePurchaseJournal(store, catalog).ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var journal accounting.Journal
+	if err := json.NewDecoder(rec.Body).Decode(&journal); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(journal.Entries) != 1 || journal.TotalCents != 27000 {
+		t.Fatalf("expected one entry of 27000, got %+v", journal)
+	}
+
+	// The 500 handling fee is spread by quantity: 45 onto the hops and 455
+	// onto the cans.
+	expected := []accounting.Line{
+		{Account: "Inventory:hop", DebitCents: 20045},
+		{Account: "Inventory:packaging", DebitCents: 5455},
+		{Account: "Freight In", DebitCents: 1500},
+		{Account: "accounts_payable", Name: "YCH Hops", CreditCents: 27000},
+	}
+	entry := journal.Entries[0]
+	if entry.Number != "PO-1" || !entry.Date.Equal(store.orders[0].ReceivedAt) || len(entry.Lines) != len(expected) {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	for i, line := range expected {
+		if entry.Lines[i] != line {
+			t.Errorf("expected line %d to be %+v, got %+v", i, line, entry.Lines[i])
+		}
+	}
+
+	if len(journal.Skipped) != 1 || journal.Skipped[0].Reference != "PO-2" {
+		t.Errorf("expected the EUR order to be skipped, got %+v", journal.Skipped)
+	}
+}
+
+func TestHandlePurchaseJournalExports(t *testing.T) {
+	post := func(t *testing.T, store *AccountingStore, catalog IngredientCatalog, req dto.CreateJournalExportRequest) *httptest.ResponseRecorder {
+		t.Helper()
+		body, _ := json.Marshal(req)
+		rec := httptest.NewRecorder()
+		handler.HandlePurchaseJournalExports(store, catalog).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/accounting/purchase-journal/exports", bytes.NewReader(body)))
+		return rec
+	}
+
+	t.Run("exports a closed period once", func(t *testing.T) {
+		store, catalog := newAccountingStore()
+
+		rec := post(t, store, catalog, dto.CreateJournalExportRequest{Period: "2026-09", Format: "iif"})
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var export dto.JournalExportResponse
+		if err := json.NewDecoder(rec.Body).Decode(&export); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if export.EntryCount != 1 || export.TotalCents != 27000 || export.FileName != "purchase-journal-2026-09.iif" {
+			t.Errorf("unexpected export %+v", export)
+		}
+
+		rec = post(t, store, catalog, dto.CreateJournalExportRequest{Period: "2026-09", Format: "xero"})
+		if rec.Code != http.StatusConflict {
+			t.Errorf("expected status 409 for a period already exported, got %d", rec.Code)
+		}
+		rec = post(t, store, catalog, dto.CreateJournalExportRequest{Period: "2026-09", Format: "xero", Reexport: true})
+		if rec.Code != http.StatusCreated || len(store.exports) != 2 {
+			t.Errorf("expected a re-export to be recorded, got %d", rec.Code)
+		}
+
+		fileReq := httptest.NewRequest(http.MethodGet, "/accounting/purchase-journal/exports/x/file", nil)
+		fileReq.SetPathValue("uuid", export.UUID)
+		fileRec := httptest.NewRecorder()
+		handler.HandlePurchaseJournalExportFile(store).ServeHTTP(fileRec, fileReq)
+		if fileRec.Code != http.StatusOK || !strings.HasPrefix(fileRec.Body.String(), "!TRNS") {
+			t.Fatalf("unexpected file %d: %s", fileRec.Code, fileRec.Body.String())
+		}
+		if !strings.Contains(fileRec.Body.String(), "\taccounts_payable\tYCH Hops\t-270.00\tPO-1\t") {
+			t.Errorf("expected the accounts payable split in the file, got %s", fileRec.Body.String())
+		}
+	})
+
+	t.Run("rejects an open period", func(t *testing.T) {
+		store, catalog := newAccountingStore()
+
+		rec := post(t, store, catalog, dto.CreateJournalExportRequest{Period: time.Now().UTC().Format("2006-01"), Format: "iif"})
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
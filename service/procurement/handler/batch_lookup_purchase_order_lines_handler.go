@@ -3,7 +3,9 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
@@ -13,9 +15,17 @@ import (
 // PurchaseOrderLineBatchLookupStore defines the storage methods needed by the batch lookup handler.
 type PurchaseOrderLineBatchLookupStore interface {
 	ListPurchaseOrderLinesByUUIDs(context.Context, []string) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	GetBaseCurrency(context.Context) (string, error)
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+	ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error)
 }
 
 // HandleBatchLookupPurchaseOrderLines handles [POST /purchase-order-lines/batch-lookup].
+// Each line carries its landed cost: the line value plus its share of the
+// order's fees, together with the rate that converts it into the base
+// currency.
 func HandleBatchLookupPurchaseOrderLines(db PurchaseOrderLineBatchLookupStore) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -33,12 +43,51 @@ func HandleBatchLookupPurchaseOrderLines(db PurchaseOrderLineBatchLookupStore) h
 			return
 		}
 
-		lines, err := db.ListPurchaseOrderLinesByUUIDs(r.Context(), req.UUIDs)
+		resp, err := LookupLandedPurchaseOrderLines(r.Context(), db, req.UUIDs)
 		if err != nil {
 			service.InternalError(w, "error looking up purchase order lines", "error", err)
 			return
 		}
 
-		service.JSON(w, dto.NewPurchaseOrderLinesResponse(lines))
+		service.JSON(w, resp)
 	}
 }
+
+// LookupLandedPurchaseOrderLines returns the purchase order lines with the
+// given UUIDs, each with its landed cost and the rate into the base
+// currency. Unknown UUIDs are skipped.
+func LookupLandedPurchaseOrderLines(ctx context.Context, db PurchaseOrderLineBatchLookupStore, uuids []string) ([]dto.LandedPurchaseOrderLineResponse, error) {
+	lines, err := db.ListPurchaseOrderLinesByUUIDs(ctx, uuids)
+	if err != nil {
+		return nil, fmt.Errorf("listing purchase order lines: %w", err)
+	}
+
+	orderIDSet := make(map[int64]struct{}, len(lines))
+	for _, line := range lines {
+		orderIDSet[line.PurchaseOrderID] = struct{}{}
+	}
+	orderIDs := make([]int64, 0, len(orderIDSet))
+	for id := range orderIDSet {
+		orderIDs = append(orderIDs, id)
+	}
+
+	allocation := feeAllocation{}
+	if len(orderIDs) > 0 {
+		allocation, err = loadFeeAllocation(ctx, db, orderIDs)
+		if err != nil {
+			return nil, fmt.Errorf("allocating purchase order fees: %w", err)
+		}
+	}
+
+	conversions, err := loadOrderConversions(ctx, db, orderIDs, orderCurrencies(lines, nil))
+	if err != nil {
+		return nil, fmt.Errorf("resolving exchange rates: %w", err)
+	}
+
+	resp := make([]dto.LandedPurchaseOrderLineResponse, 0, len(lines))
+	for _, line := range lines {
+		landed := dto.NewLandedPurchaseOrderLineResponse(line, allocation.byLine[line.ID])
+		resp = append(resp, landed.WithExchangeRate(conversions.baseCurrency, conversions.lookup(line.PurchaseOrderID, line.Currency)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// exchangeRateStore is the storage needed to resolve a rate on a date.
+type exchangeRateStore interface {
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+}
+
+// conversionStore is the storage needed to report purchase order amounts in
+// the base currency.
+type conversionStore interface {
+	exchangeRateStore
+	GetBaseCurrency(context.Context) (string, error)
+	ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error)
+}
+
+// missingExchangeRateError reports that no rate was recorded for a currency
+// on or before the date it was needed.
+type missingExchangeRateError struct {
+	currency     string
+	baseCurrency string
+	at           time.Time
+}
+
+func (e missingExchangeRateError) Error() string {
+	return fmt.Sprintf("no exchange rate from %s to %s on or before %s", e.currency, e.baseCurrency, e.at.Format(time.DateOnly))
+}
+
+// resolveExchangeRate returns the rate converting currency into baseCurrency
+// on the given date. A rate recorded for the opposite pair is inverted when
+// no direct rate exists.
+func resolveExchangeRate(ctx context.Context, db exchangeRateStore, currency, baseCurrency string, at time.Time) (dto.AppliedExchangeRateResponse, error) {
+	if currency == baseCurrency {
+		return dto.AppliedExchangeRateResponse{Rate: 1, Locked: true}, nil
+	}
+
+	rate, err := db.GetExchangeRateAt(ctx, currency, baseCurrency, at)
+	if err == nil {
+		return dto.NewAppliedExchangeRate(rate.Rate, rate.EffectiveDate, false), nil
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return dto.AppliedExchangeRateResponse{}, err
+	}
+
+	inverse, err := db.GetExchangeRateAt(ctx, baseCurrency, currency, at)
+	if errors.Is(err, service.ErrNotFound) {
+		return dto.AppliedExchangeRateResponse{}, missingExchangeRateError{currency: currency, baseCurrency: baseCurrency, at: at}
+	} else if err != nil {
+		return dto.AppliedExchangeRateResponse{}, err
+	}
+	return dto.NewAppliedExchangeRate(1/inverse.Rate, inverse.EffectiveDate, false), nil
+}
+
+// orderConversions converts purchase order amounts into the base currency.
+// Rates locked when an order was received take precedence; other amounts are
+// converted at the latest rate and reported as not locked.
+type orderConversions struct {
+	baseCurrency string
+	locked       map[int64]map[string]dto.AppliedExchangeRateResponse
+	current      map[string]*dto.AppliedExchangeRateResponse
+}
+
+// loadOrderConversions resolves conversions for every currency used on the
+// given orders. A currency without any recorded rate converts to nil.
+func loadOrderConversions(ctx context.Context, db conversionStore, orderIDs []int64, currencies []string) (orderConversions, error) {
+	baseCurrency, err := db.GetBaseCurrency(ctx)
+	if err != nil {
+		return orderConversions{}, err
+	}
+
+	conversions := orderConversions{
+		baseCurrency: baseCurrency,
+		locked:       make(map[int64]map[string]dto.AppliedExchangeRateResponse),
+		current:      make(map[string]*dto.AppliedExchangeRateResponse),
+	}
+
+	if len(orderIDs) > 0 {
+		locked, err := db.ListPurchaseOrderExchangeRates(ctx, orderIDs)
+		if err != nil {
+			return orderConversions{}, err
+		}
+		for _, rate := range locked {
+			// Rates locked against a previous base currency no longer apply.
+			if rate.BaseCurrency != baseCurrency {
+				continue
+			}
+			if conversions.locked[rate.PurchaseOrderID] == nil {
+				conversions.locked[rate.PurchaseOrderID] = make(map[string]dto.AppliedExchangeRateResponse)
+			}
+			conversions.locked[rate.PurchaseOrderID][rate.Currency] = dto.NewAppliedExchangeRate(rate.Rate, rate.RateDate, true)
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, currency := range currencies {
+		if _, ok := conversions.current[currency]; ok {
+			continue
+		}
+		rate, err := resolveExchangeRate(ctx, db, currency, baseCurrency, now)
+		var missing missingExchangeRateError
+		if errors.As(err, &missing) {
+			conversions.current[currency] = nil
+			continue
+		} else if err != nil {
+			return orderConversions{}, err
+		}
+		conversions.current[currency] = &rate
+	}
+
+	return conversions, nil
+}
+
+// lookup returns the conversion for an amount in currency on the given order,
+// or nil when no rate is available.
+func (c orderConversions) lookup(orderID int64, currency string) *dto.AppliedExchangeRateResponse {
+	if rate, ok := c.locked[orderID][currency]; ok {
+		return &rate
+	}
+	return c.current[currency]
+}
+
+// orderCurrencies returns the distinct currencies used by lines and fees.
+func orderCurrencies(lines []storage.PurchaseOrderLine, fees []storage.PurchaseOrderFee) []string {
+	seen := make(map[string]struct{})
+	var currencies []string
+	add := func(currency string) {
+		if _, ok := seen[currency]; ok {
+			return
+		}
+		seen[currency] = struct{}{}
+		currencies = append(currencies, currency)
+	}
+	for _, line := range lines {
+		add(line.Currency)
+	}
+	for _, fee := range fees {
+		add(fee.Currency)
+	}
+	return currencies
+}
+
+// receiptLockStore is the storage needed to lock exchange rates when a
+// purchase order is received.
+type receiptLockStore interface {
+	exchangeRateStore
+	GetBaseCurrency(context.Context) (string, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	LockPurchaseOrderExchangeRates(context.Context, []storage.PurchaseOrderExchangeRate) error
+}
+
+// lockReceiptExchangeRates locks the conversion of every foreign currency on
+// an order at the rate in effect on the receipt date. Nothing is locked when
+// any currency lacks a rate, so the receipt can be retried once it is
+// recorded.
+func lockReceiptExchangeRates(ctx context.Context, db receiptLockStore, orderID int64, receivedAt time.Time) error {
+	orderIDs := []int64{orderID}
+	lines, err := db.ListPurchaseOrderLinesByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return err
+	}
+	fees, err := db.ListPurchaseOrderFeesByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return err
+	}
+	baseCurrency, err := db.GetBaseCurrency(ctx)
+	if err != nil {
+		return err
+	}
+
+	var locks []storage.PurchaseOrderExchangeRate
+	for _, currency := range orderCurrencies(lines, fees) {
+		if currency == baseCurrency {
+			continue
+		}
+		rate, err := resolveExchangeRate(ctx, db, currency, baseCurrency, receivedAt)
+		if err != nil {
+			return err
+		}
+		locks = append(locks, storage.PurchaseOrderExchangeRate{
+			PurchaseOrderID: orderID,
+			Currency:        currency,
+			BaseCurrency:    baseCurrency,
+			Rate:            rate.Rate,
+			RateDate:        receivedAt,
+		})
+	}
+	if len(locks) == 0 {
+		return nil
+	}
+
+	return db.LockPurchaseOrderExchangeRates(ctx, locks)
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// DraftPurchaseOrderStore defines the storage methods needed by the draft
+// purchase order handler.
+type DraftPurchaseOrderStore interface {
+	GetSupplierByUUID(context.Context, string) (storage.Supplier, error)
+	CreateDraftPurchaseOrder(context.Context, storage.PurchaseOrder, []storage.PurchaseOrderLine) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderUUID(context.Context, string) ([]storage.PurchaseOrderLine, error)
+}
+
+// HandleCreateDraftPurchaseOrder handles [POST /purchase-orders/drafts].
+func HandleCreateDraftPurchaseOrder(db DraftPurchaseOrderStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.CreateDraftPurchaseOrderRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		supplier, ok := service.ResolveFK(r.Context(), w, req.SupplierUUID, "supplier", db.GetSupplierByUUID)
+		if !ok {
+			return
+		}
+
+		lines := make([]storage.PurchaseOrderLine, 0, len(req.Lines))
+		for _, l := range req.Lines {
+			inventoryItemUUID, err := parseUUIDPointer(l.InventoryItemUUID)
+			if err != nil {
+				http.Error(w, "invalid inventory_item_uuid", http.StatusBadRequest)
+				return
+			}
+			lines = append(lines, storage.PurchaseOrderLine{
+				ItemType:          l.ItemType,
+				ItemName:          l.ItemName,
+				InventoryItemUUID: inventoryItemUUID,
+				Quantity:          l.Quantity,
+				QuantityUnit:      l.QuantityUnit,
+				UnitCostCents:     l.UnitCostCents,
+				Currency:          l.Currency,
+			})
+		}
+
+		order := storage.PurchaseOrder{
+			SupplierID: supplier.ID,
+			ExpectedAt: req.ExpectedAt,
+			Notes:      req.Notes,
+		}
+
+		created, err := db.CreateDraftPurchaseOrder(r.Context(), order, lines)
+		if err != nil {
+			service.InternalError(w, "error creating draft purchase order", "error", err)
+			return
+		}
+
+		createdLines, err := db.ListPurchaseOrderLinesByOrderUUID(r.Context(), created.UUID.String())
+		if err != nil {
+			service.InternalError(w, "error listing draft purchase order lines", "error", err)
+			return
+		}
+
+		slog.Info("draft purchase order created", "purchase_order_uuid", created.UUID, "order_number", created.OrderNumber, "lines", len(createdLines))
+
+		service.JSONCreated(w, dto.NewDraftPurchaseOrderResponse(created, createdLines))
+	}
+}
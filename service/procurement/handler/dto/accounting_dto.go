@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// UpdateAccountMappingRequest replaces the GL account mapping. Every role,
+// ingredient category and non-inventory item type must be mapped; fee types
+// are optional.
+type UpdateAccountMappingRequest struct {
+	XeroTaxRate string               `json:"xero_tax_rate"`
+	Accounts    []accounting.Account `json:"accounts"`
+}
+
+func (r UpdateAccountMappingRequest) Validate() error {
+	return r.Mapping().Validate()
+}
+
+// Mapping returns the requested mapping.
+func (r UpdateAccountMappingRequest) Mapping() accounting.Mapping {
+	return accounting.Mapping{XeroTaxRate: r.XeroTaxRate, Accounts: r.Accounts}
+}
+
+// CreateJournalExportRequest exports the journal of a closed period. A period
+// that was already exported is only exported again when Reexport is set.
+type CreateJournalExportRequest struct {
+	Period   string `json:"period"`
+	Format   string `json:"format"`
+	Reexport bool   `json:"reexport"`
+}
+
+func (r CreateJournalExportRequest) Validate() error {
+	if _, err := accounting.ParsePeriod(r.Period); err != nil {
+		return err
+	}
+	return accounting.ValidateFormat(r.Format)
+}
+
+type JournalExportResponse struct {
+	UUID       string    `json:"uuid"`
+	Period     string    `json:"period"`
+	Format     string    `json:"format"`
+	EntryCount int       `json:"entry_count"`
+	TotalCents int64     `json:"total_cents"`
+	FileName   string    `json:"file_name"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+func NewJournalExportResponse(export storage.JournalExport) JournalExportResponse {
+	return JournalExportResponse{
+		UUID:       export.UUID,
+		Period:     export.Period,
+		Format:     export.Format,
+		EntryCount: export.EntryCount,
+		TotalCents: export.TotalCents,
+		FileName:   accounting.FileName("purchase-journal", export.Period, export.Format),
+		ExportedAt: export.CreatedAt,
+	}
+}
+
+func NewJournalExportsResponse(exports []storage.JournalExport) []JournalExportResponse {
+	resp := make([]JournalExportResponse, 0, len(exports))
+	for _, export := range exports {
+		resp = append(resp, NewJournalExportResponse(export))
+	}
+	return resp
+}
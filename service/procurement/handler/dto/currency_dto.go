@@ -0,0 +1,200 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// ConvertCents converts an amount into the base currency at the given rate,
+// rounded to whole cents.
+func ConvertCents(cents int64, rate float64) int64 {
+	return int64(math.Round(float64(cents) * rate))
+}
+
+type UpdateCurrencySettingsRequest struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+func (r UpdateCurrencySettingsRequest) Validate() error {
+	if err := validateCurrency(r.BaseCurrency); err != nil {
+		return fmt.Errorf("base_%w", err)
+	}
+
+	return nil
+}
+
+type CurrencySettingsResponse struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+// CreateExchangeRateRequest records how many units of ToCurrency one unit of
+// FromCurrency buys. ToCurrency defaults to the base currency and
+// EffectiveDate (YYYY-MM-DD) to today.
+type CreateExchangeRateRequest struct {
+	FromCurrency  string  `json:"from_currency"`
+	ToCurrency    *string `json:"to_currency"`
+	Rate          float64 `json:"rate"`
+	EffectiveDate *string `json:"effective_date"`
+}
+
+func (r CreateExchangeRateRequest) Validate() error {
+	if err := validateCurrency(r.FromCurrency); err != nil {
+		return fmt.Errorf("from_%w", err)
+	}
+	if r.ToCurrency != nil {
+		if err := validateCurrency(*r.ToCurrency); err != nil {
+			return fmt.Errorf("to_%w", err)
+		}
+		if NormalizeCurrency(*r.ToCurrency) == NormalizeCurrency(r.FromCurrency) {
+			return fmt.Errorf("to_currency must differ from from_currency")
+		}
+	}
+	if err := ValidateExchangeRate(r.Rate); err != nil {
+		return err
+	}
+	if r.EffectiveDate != nil {
+		if _, err := ParseEffectiveDate(*r.EffectiveDate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NormalizeCurrency trims and upper-cases a currency code.
+func NormalizeCurrency(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// ValidateExchangeRate checks that a rate is a finite positive number.
+func ValidateExchangeRate(rate float64) error {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate <= 0 {
+		return fmt.Errorf("rate must be greater than zero")
+	}
+
+	return nil
+}
+
+// ParseExchangeRate parses a rate from an imported value.
+func ParseExchangeRate(value string) (float64, error) {
+	rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate")
+	}
+	if err := ValidateExchangeRate(rate); err != nil {
+		return 0, err
+	}
+
+	return rate, nil
+}
+
+// ParseEffectiveDate parses a YYYY-MM-DD date.
+func ParseEffectiveDate(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid effective_date")
+	}
+
+	return parsed, nil
+}
+
+type ExchangeRateResponse struct {
+	UUID          string     `json:"uuid"`
+	FromCurrency  string     `json:"from_currency"`
+	ToCurrency    string     `json:"to_currency"`
+	Rate          float64    `json:"rate"`
+	EffectiveDate string     `json:"effective_date"`
+	Source        string     `json:"source"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewExchangeRateResponse(rate storage.ExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		UUID:          rate.UUID.String(),
+		FromCurrency:  rate.FromCurrency,
+		ToCurrency:    rate.ToCurrency,
+		Rate:          rate.Rate,
+		EffectiveDate: rate.EffectiveDate.Format(time.DateOnly),
+		Source:        rate.Source,
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+		DeletedAt:     rate.DeletedAt,
+	}
+}
+
+func NewExchangeRatesResponse(rates []storage.ExchangeRate) []ExchangeRateResponse {
+	resp := make([]ExchangeRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		resp = append(resp, NewExchangeRateResponse(rate))
+	}
+	return resp
+}
+
+type ExchangeRateImportRowResult struct {
+	Row          int                   `json:"row"`
+	Status       string                `json:"status"`
+	ExchangeRate *ExchangeRateResponse `json:"exchange_rate,omitempty"`
+	Error        *string               `json:"error,omitempty"`
+}
+
+type ExchangeRateImportTotals struct {
+	TotalRows int `json:"total_rows"`
+	Imported  int `json:"imported"`
+	Failed    int `json:"failed"`
+}
+
+type ExchangeRateImportResponse struct {
+	Totals  ExchangeRateImportTotals      `json:"totals"`
+	Results []ExchangeRateImportRowResult `json:"results"`
+}
+
+// AppliedExchangeRateResponse is the rate used to report an amount in the
+// base currency. Locked rates were fixed when the purchase order was received
+// and RateDate is the receipt date; other rates are the latest available and
+// RateDate is the date the rate took effect.
+type AppliedExchangeRateResponse struct {
+	Rate     float64 `json:"rate"`
+	RateDate *string `json:"rate_date,omitempty"`
+	Locked   bool    `json:"locked"`
+}
+
+func NewAppliedExchangeRate(rate float64, rateDate time.Time, locked bool) AppliedExchangeRateResponse {
+	date := rateDate.Format(time.DateOnly)
+	return AppliedExchangeRateResponse{
+		Rate:     rate,
+		RateDate: &date,
+		Locked:   locked,
+	}
+}
+
+// PurchaseOrderCurrencyTotal is the value of a purchase order's lines and
+// fees in one currency, and that value in the base currency when a rate is
+// available.
+type PurchaseOrderCurrencyTotal struct {
+	Currency       string                       `json:"currency"`
+	LineTotalCents int64                        `json:"line_total_cents"`
+	FeeTotalCents  int64                        `json:"fee_total_cents"`
+	TotalCents     int64                        `json:"total_cents"`
+	ExchangeRate   *AppliedExchangeRateResponse `json:"exchange_rate,omitempty"`
+	BaseTotalCents *int64                       `json:"base_total_cents,omitempty"`
+}
+
+// PurchaseOrderTotalsResponse is the response for
+// GET /purchase-orders/{uuid}/totals. The base totals are omitted when any
+// currency on the order has no exchange rate.
+type PurchaseOrderTotalsResponse struct {
+	PurchaseOrderUUID   string                       `json:"purchase_order_uuid"`
+	BaseCurrency        string                       `json:"base_currency"`
+	Currencies          []PurchaseOrderCurrencyTotal `json:"currencies"`
+	BaseLineTotalCents  *int64                       `json:"base_line_total_cents,omitempty"`
+	BaseFeeTotalCents   *int64                       `json:"base_fee_total_cents,omitempty"`
+	BaseTotalCents      *int64                       `json:"base_total_cents,omitempty"`
+	ExchangeRatesLocked bool                         `json:"exchange_rates_locked"`
+}
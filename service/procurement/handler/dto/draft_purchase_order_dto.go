@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreateDraftPurchaseOrderRequest is the request body for
+// POST /purchase-orders/drafts. The order and its lines are created together;
+// the order number is generated and lines are numbered in the order given.
+type CreateDraftPurchaseOrderRequest struct {
+	SupplierUUID string                         `json:"supplier_uuid"`
+	ExpectedAt   *time.Time                     `json:"expected_at"`
+	Notes        *string                        `json:"notes"`
+	Lines        []CreateDraftPurchaseOrderLine `json:"lines"`
+}
+
+type CreateDraftPurchaseOrderLine struct {
+	ItemType          string  `json:"item_type"`
+	ItemName          string  `json:"item_name"`
+	InventoryItemUUID *string `json:"inventory_item_uuid"`
+	Quantity          int64   `json:"quantity"`
+	QuantityUnit      string  `json:"quantity_unit"`
+	UnitCostCents     int64   `json:"unit_cost_cents"`
+	Currency          string  `json:"currency"`
+}
+
+func (r CreateDraftPurchaseOrderRequest) Validate() error {
+	if strings.TrimSpace(r.SupplierUUID) == "" {
+		return fmt.Errorf("supplier_uuid is required")
+	}
+	if len(r.Lines) == 0 {
+		return fmt.Errorf("lines must not be empty")
+	}
+	for i, line := range r.Lines {
+		if err := validateLineItemType(line.ItemType); err != nil {
+			return fmt.Errorf("lines[%d]: %w", i, err)
+		}
+		if err := validate.Required(line.ItemName, fmt.Sprintf("lines[%d].item_name", i)); err != nil {
+			return err
+		}
+		if line.InventoryItemUUID != nil {
+			if _, err := uuid.FromString(*line.InventoryItemUUID); err != nil {
+				return fmt.Errorf("lines[%d].inventory_item_uuid must be a valid UUID", i)
+			}
+		}
+		if line.Quantity <= 0 {
+			return fmt.Errorf("lines[%d].quantity must be greater than zero", i)
+		}
+		if err := validate.Required(line.QuantityUnit, fmt.Sprintf("lines[%d].quantity_unit", i)); err != nil {
+			return err
+		}
+		if line.UnitCostCents < 0 {
+			return fmt.Errorf("lines[%d].unit_cost_cents must be zero or greater", i)
+		}
+		if err := validateCurrency(line.Currency); err != nil {
+			return fmt.Errorf("lines[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// DraftPurchaseOrderResponse is a created draft purchase order with its lines.
+type DraftPurchaseOrderResponse struct {
+	PurchaseOrderResponse
+	Lines []PurchaseOrderLineResponse `json:"lines"`
+}
+
+func NewDraftPurchaseOrderResponse(order storage.PurchaseOrder, lines []storage.PurchaseOrderLine) DraftPurchaseOrderResponse {
+	return DraftPurchaseOrderResponse{
+		PurchaseOrderResponse: NewPurchaseOrderResponse(order),
+		Lines:                 NewPurchaseOrderLinesResponse(lines),
+	}
+}
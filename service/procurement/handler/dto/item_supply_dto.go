@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// ItemSupplyLookupRequest is the request body for
+// POST /purchase-order-lines/supply-lookup.
+type ItemSupplyLookupRequest struct {
+	InventoryItemUUIDs []string `json:"inventory_item_uuids"`
+}
+
+// Validate checks that the request is well-formed.
+func (r ItemSupplyLookupRequest) Validate() error {
+	if len(r.InventoryItemUUIDs) == 0 {
+		return fmt.Errorf("inventory_item_uuids must not be empty")
+	}
+	if len(r.InventoryItemUUIDs) > 500 {
+		return fmt.Errorf("inventory_item_uuids must not exceed 500 items")
+	}
+	for i, id := range r.InventoryItemUUIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return fmt.Errorf("inventory_item_uuids[%d] must be a valid UUID", i)
+		}
+	}
+	return nil
+}
+
+// ItemSupplyLookupResponse lists the open purchase order lines, the most
+// recent supplier and the supplier catalog entries for the requested
+// inventory items.
+type ItemSupplyLookupResponse struct {
+	OpenLines    []OpenPurchaseOrderLineResponse `json:"open_lines"`
+	Suppliers    []ItemSupplierResponse          `json:"suppliers"`
+	CatalogItems []ItemCatalogEntryResponse      `json:"catalog_items"`
+}
+
+type OpenPurchaseOrderLineResponse struct {
+	PurchaseOrderLineUUID string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderUUID     string     `json:"purchase_order_uuid"`
+	OrderNumber           string     `json:"order_number"`
+	Status                string     `json:"status"`
+	SupplierUUID          string     `json:"supplier_uuid"`
+	InventoryItemUUID     string     `json:"inventory_item_uuid"`
+	Quantity              int64      `json:"quantity"`
+	QuantityUnit          string     `json:"quantity_unit"`
+	ExpectedAt            *time.Time `json:"expected_at,omitempty"`
+}
+
+type ItemSupplierResponse struct {
+	InventoryItemUUID string    `json:"inventory_item_uuid"`
+	SupplierUUID      string    `json:"supplier_uuid"`
+	SupplierName      string    `json:"supplier_name"`
+	ItemName          string    `json:"item_name"`
+	QuantityUnit      string    `json:"quantity_unit"`
+	UnitCostCents     int64     `json:"unit_cost_cents"`
+	Currency          string    `json:"currency"`
+	LastOrderedAt     time.Time `json:"last_ordered_at"`
+}
+
+// ItemCatalogEntryResponse is a supplier catalog item linked to an inventory
+// item, with the price currently in effect if there is one.
+type ItemCatalogEntryResponse struct {
+	InventoryItemUUID string  `json:"inventory_item_uuid"`
+	SupplierUUID      string  `json:"supplier_uuid"`
+	SupplierName      string  `json:"supplier_name"`
+	SupplierItemUUID  string  `json:"supplier_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	PackUnit          string  `json:"pack_unit"`
+	LeadTimeDays      *int    `json:"lead_time_days,omitempty"`
+	UnitCostCents     *int64  `json:"unit_cost_cents,omitempty"`
+	Currency          *string `json:"currency,omitempty"`
+}
+
+func NewItemSupplyLookupResponse(lines []storage.OpenPurchaseOrderLine, suppliers []storage.ItemSupplier, catalog []storage.SupplierItem) ItemSupplyLookupResponse {
+	resp := ItemSupplyLookupResponse{
+		OpenLines:    make([]OpenPurchaseOrderLineResponse, 0, len(lines)),
+		Suppliers:    make([]ItemSupplierResponse, 0, len(suppliers)),
+		CatalogItems: make([]ItemCatalogEntryResponse, 0, len(catalog)),
+	}
+	for _, line := range lines {
+		resp.OpenLines = append(resp.OpenLines, OpenPurchaseOrderLineResponse{
+			PurchaseOrderLineUUID: line.PurchaseOrderLineUUID,
+			PurchaseOrderUUID:     line.PurchaseOrderUUID,
+			OrderNumber:           line.OrderNumber,
+			Status:                line.Status,
+			SupplierUUID:          line.SupplierUUID,
+			InventoryItemUUID:     line.InventoryItemUUID,
+			Quantity:              line.Quantity,
+			QuantityUnit:          line.QuantityUnit,
+			ExpectedAt:            line.ExpectedAt,
+		})
+	}
+	for _, s := range suppliers {
+		resp.Suppliers = append(resp.Suppliers, ItemSupplierResponse{
+			InventoryItemUUID: s.InventoryItemUUID,
+			SupplierUUID:      s.SupplierUUID,
+			SupplierName:      s.SupplierName,
+			ItemName:          s.ItemName,
+			QuantityUnit:      s.QuantityUnit,
+			UnitCostCents:     s.UnitCostCents,
+			Currency:          s.Currency,
+			LastOrderedAt:     s.LastOrderedAt,
+		})
+	}
+	for _, item := range catalog {
+		if item.InventoryItemUUID == nil {
+			continue
+		}
+		entry := ItemCatalogEntryResponse{
+			InventoryItemUUID: item.InventoryItemUUID.String(),
+			SupplierUUID:      item.SupplierUUID,
+			SupplierName:      item.SupplierName,
+			SupplierItemUUID:  item.UUID.String(),
+			ItemName:          item.Name,
+			PackUnit:          item.PackUnit,
+			LeadTimeDays:      item.LeadTimeDays,
+		}
+		if item.CurrentPrice != nil {
+			entry.UnitCostCents = &item.CurrentPrice.UnitCostCents
+			entry.Currency = &item.CurrentPrice.Currency
+		}
+		resp.CatalogItems = append(resp.CatalogItems, entry)
+	}
+	return resp
+}
@@ -0,0 +1,62 @@
+package dto
+
+import (
+	"math"
+
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// LandedPurchaseOrderLineResponse is a purchase order line with its share of
+// the order's fees. LandedUnitCostCents is rounded to whole cents; consumers
+// that need exact figures should spread FeeAllocatedCents over the quantity.
+// The base currency figures are omitted when no exchange rate is available;
+// consumers that need exact figures should apply ExchangeRate themselves.
+type LandedPurchaseOrderLineResponse struct {
+	PurchaseOrderLineResponse
+	FeeAllocatedCents   int64                        `json:"fee_allocated_cents"`
+	LandedCostCents     int64                        `json:"landed_cost_cents"`
+	LandedUnitCostCents int64                        `json:"landed_unit_cost_cents"`
+	BaseCurrency        string                       `json:"base_currency"`
+	ExchangeRate        *AppliedExchangeRateResponse `json:"exchange_rate,omitempty"`
+	BaseLandedCostCents *int64                       `json:"base_landed_cost_cents,omitempty"`
+}
+
+func NewLandedPurchaseOrderLineResponse(line storage.PurchaseOrderLine, feeAllocatedCents int64) LandedPurchaseOrderLineResponse {
+	landedCost := line.Quantity*line.UnitCostCents + feeAllocatedCents
+	return LandedPurchaseOrderLineResponse{
+		PurchaseOrderLineResponse: NewPurchaseOrderLineResponse(line),
+		FeeAllocatedCents:         feeAllocatedCents,
+		LandedCostCents:           landedCost,
+		LandedUnitCostCents:       int64(math.Round(float64(landedCost) / float64(line.Quantity))),
+	}
+}
+
+// WithExchangeRate adds the line's landed cost in the base currency. A nil
+// rate leaves the base figures empty.
+func (r LandedPurchaseOrderLineResponse) WithExchangeRate(baseCurrency string, rate *AppliedExchangeRateResponse) LandedPurchaseOrderLineResponse {
+	r.BaseCurrency = baseCurrency
+	r.ExchangeRate = rate
+	if rate != nil {
+		baseCost := ConvertCents(r.LandedCostCents, rate.Rate)
+		r.BaseLandedCostCents = &baseCost
+	}
+	return r
+}
+
+// AllocatedPurchaseOrderFeeResponse is a purchase order fee with the amount
+// spread over lines. UnallocatedCents is non-zero when the order has no line
+// in the fee's currency.
+type AllocatedPurchaseOrderFeeResponse struct {
+	PurchaseOrderFeeResponse
+	AllocatedCents   int64 `json:"allocated_cents"`
+	UnallocatedCents int64 `json:"unallocated_cents"`
+}
+
+// PurchaseOrderLandedCostsResponse is the response for
+// GET /purchase-orders/{uuid}/landed-costs.
+type PurchaseOrderLandedCostsResponse struct {
+	PurchaseOrderUUID string                              `json:"purchase_order_uuid"`
+	BaseCurrency      string                              `json:"base_currency"`
+	Lines             []LandedPurchaseOrderLineResponse   `json:"lines"`
+	Fees              []AllocatedPurchaseOrderFeeResponse `json:"fees"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "time"
+
+// PriceTrendResponse is the response for GET /price-trends. Prices are
+// grouped into one series per supplier, currency, and unit, since prices in
+// different currencies or units are not comparable.
+type PriceTrendResponse struct {
+	InventoryItemUUID string             `json:"inventory_item_uuid"`
+	Series            []PriceTrendSeries `json:"series"`
+}
+
+type PriceTrendSeries struct {
+	SupplierUUID        string `json:"supplier_uuid"`
+	SupplierName        string `json:"supplier_name"`
+	Currency            string `json:"currency"`
+	QuantityUnit        string `json:"quantity_unit"`
+	LatestUnitCostCents int64  `json:"latest_unit_cost_cents"`
+	MinUnitCostCents    int64  `json:"min_unit_cost_cents"`
+	MaxUnitCostCents    int64  `json:"max_unit_cost_cents"`
+	// ChangePercent is the change from the first to the latest price, omitted
+	// when the first price is zero.
+	ChangePercent *float64          `json:"change_percent,omitempty"`
+	Points        []PriceTrendPoint `json:"points"`
+}
+
+// PriceTrendPoint is one observed price. Source is "catalog" for supplier
+// catalog prices and "purchase_order" for purchase order line costs.
+type PriceTrendPoint struct {
+	At               time.Time `json:"at"`
+	Source           string    `json:"source"`
+	UnitCostCents    int64     `json:"unit_cost_cents"`
+	ItemName         string    `json:"item_name"`
+	SupplierItemUUID *string   `json:"supplier_item_uuid,omitempty"`
+	SKU              *string   `json:"sku,omitempty"`
+	OrderNumber      *string   `json:"order_number,omitempty"`
+}
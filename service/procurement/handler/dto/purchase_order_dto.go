@@ -32,12 +32,18 @@ func (r CreatePurchaseOrderRequest) Validate() error {
 	return nil
 }
 
+// UpdatePurchaseOrderRequest updates a purchase order. ReceivedAt is only
+// used when Status moves the order to partially_received or received: foreign
+// currency amounts are locked at the exchange rate in effect on that date,
+// which defaults to now. A received order is posted to the purchase journal
+// on that date too.
 type UpdatePurchaseOrderRequest struct {
 	OrderNumber *string    `json:"order_number"`
 	Status      *string    `json:"status"`
 	OrderedAt   *time.Time `json:"ordered_at"`
 	ExpectedAt  *time.Time `json:"expected_at"`
 	Notes       *string    `json:"notes"`
+	ReceivedAt  *time.Time `json:"received_at"`
 }
 
 func (r UpdatePurchaseOrderRequest) Validate() error {
@@ -58,6 +64,9 @@ func (r UpdatePurchaseOrderRequest) Validate() error {
 			return err
 		}
 	}
+	if r.ReceivedAt != nil && r.Status == nil {
+		return fmt.Errorf("received_at requires status")
+	}
 
 	return nil
 }
@@ -8,11 +8,14 @@ import (
 	"github.com/brewpipes/brewpipes/service/procurement/storage"
 )
 
+// CreatePurchaseOrderFeeRequest creates a purchase order fee. AllocationBasis
+// defaults to "value".
 type CreatePurchaseOrderFeeRequest struct {
-	PurchaseOrderUUID string `json:"purchase_order_uuid"`
-	FeeType           string `json:"fee_type"`
-	AmountCents       int64  `json:"amount_cents"`
-	Currency          string `json:"currency"`
+	PurchaseOrderUUID string  `json:"purchase_order_uuid"`
+	FeeType           string  `json:"fee_type"`
+	AmountCents       int64   `json:"amount_cents"`
+	Currency          string  `json:"currency"`
+	AllocationBasis   *string `json:"allocation_basis"`
 }
 
 func (r CreatePurchaseOrderFeeRequest) Validate() error {
@@ -28,18 +31,24 @@ func (r CreatePurchaseOrderFeeRequest) Validate() error {
 	if err := validateCurrency(r.Currency); err != nil {
 		return err
 	}
+	if r.AllocationBasis != nil {
+		if err := validateFeeAllocationBasis(*r.AllocationBasis); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
 type UpdatePurchaseOrderFeeRequest struct {
-	FeeType     *string `json:"fee_type"`
-	AmountCents *int64  `json:"amount_cents"`
-	Currency    *string `json:"currency"`
+	FeeType         *string `json:"fee_type"`
+	AmountCents     *int64  `json:"amount_cents"`
+	Currency        *string `json:"currency"`
+	AllocationBasis *string `json:"allocation_basis"`
 }
 
 func (r UpdatePurchaseOrderFeeRequest) Validate() error {
-	if r.FeeType == nil && r.AmountCents == nil && r.Currency == nil {
+	if r.FeeType == nil && r.AmountCents == nil && r.Currency == nil && r.AllocationBasis == nil {
 		return fmt.Errorf("at least one field must be provided")
 	}
 	if r.FeeType != nil {
@@ -55,6 +64,11 @@ func (r UpdatePurchaseOrderFeeRequest) Validate() error {
 			return err
 		}
 	}
+	if r.AllocationBasis != nil {
+		if err := validateFeeAllocationBasis(*r.AllocationBasis); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -65,6 +79,7 @@ type PurchaseOrderFeeResponse struct {
 	FeeType           string     `json:"fee_type"`
 	AmountCents       int64      `json:"amount_cents"`
 	Currency          string     `json:"currency"`
+	AllocationBasis   string     `json:"allocation_basis"`
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
 	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
@@ -81,6 +96,7 @@ func NewPurchaseOrderFeeResponse(fee storage.PurchaseOrderFee) PurchaseOrderFeeR
 		FeeType:           fee.FeeType,
 		AmountCents:       fee.AmountCents,
 		Currency:          fee.Currency,
+		AllocationBasis:   fee.AllocationBasis,
 		CreatedAt:         fee.CreatedAt,
 		UpdatedAt:         fee.UpdatedAt,
 		DeletedAt:         fee.DeletedAt,
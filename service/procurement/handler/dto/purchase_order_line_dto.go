@@ -7,17 +7,23 @@ import (
 	"github.com/brewpipes/brewpipes/internal/uuidutil"
 	"github.com/brewpipes/brewpipes/internal/validate"
 	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
 )
 
+// CreatePurchaseOrderLineRequest creates a purchase order line. When
+// SupplierItemUUID is set, item type, name, inventory item, quantity unit,
+// unit cost, and currency default from the supplier catalog and may be
+// omitted.
 type CreatePurchaseOrderLineRequest struct {
 	PurchaseOrderUUID string  `json:"purchase_order_uuid"`
+	SupplierItemUUID  *string `json:"supplier_item_uuid"`
 	LineNumber        int     `json:"line_number"`
 	ItemType          string  `json:"item_type"`
 	ItemName          string  `json:"item_name"`
 	InventoryItemUUID *string `json:"inventory_item_uuid"`
 	Quantity          int64   `json:"quantity"`
 	QuantityUnit      string  `json:"quantity_unit"`
-	UnitCostCents     int64   `json:"unit_cost_cents"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
 	Currency          string  `json:"currency"`
 }
 
@@ -28,21 +34,40 @@ func (r CreatePurchaseOrderLineRequest) Validate() error {
 	if r.LineNumber <= 0 {
 		return fmt.Errorf("line_number must be greater than zero")
 	}
+	if r.Quantity <= 0 {
+		return fmt.Errorf("quantity must be greater than zero")
+	}
+	if r.UnitCostCents != nil && *r.UnitCostCents < 0 {
+		return fmt.Errorf("unit_cost_cents must be zero or greater")
+	}
+
+	if r.SupplierItemUUID != nil {
+		// Catalog defaults fill in whatever is omitted; only check what was sent.
+		if _, err := uuid.FromString(*r.SupplierItemUUID); err != nil {
+			return fmt.Errorf("supplier_item_uuid must be a valid UUID")
+		}
+		if r.ItemType != "" {
+			if err := validateLineItemType(r.ItemType); err != nil {
+				return err
+			}
+		}
+		if r.Currency != "" {
+			if err := validateCurrency(r.Currency); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	if err := validateLineItemType(r.ItemType); err != nil {
 		return err
 	}
 	if err := validate.Required(r.ItemName, "item_name"); err != nil {
 		return err
 	}
-	if r.Quantity <= 0 {
-		return fmt.Errorf("quantity must be greater than zero")
-	}
 	if err := validate.Required(r.QuantityUnit, "quantity_unit"); err != nil {
 		return err
 	}
-	if r.UnitCostCents < 0 {
-		return fmt.Errorf("unit_cost_cents must be zero or greater")
-	}
 	if err := validateCurrency(r.Currency); err != nil {
 		return err
 	}
@@ -98,6 +123,28 @@ func (r UpdatePurchaseOrderLineRequest) Validate() error {
 	return nil
 }
 
+// RecordPurchaseOrderLineReturnRequest records goods sent back to the
+// supplier against a purchase order line. ReturnUUID is the Inventory
+// supplier return; a return is recorded once however often it is sent.
+type RecordPurchaseOrderLineReturnRequest struct {
+	ReturnUUID   string `json:"return_uuid"`
+	Quantity     int64  `json:"quantity"`
+	QuantityUnit string `json:"quantity_unit"`
+}
+
+func (r RecordPurchaseOrderLineReturnRequest) Validate() error {
+	if err := validate.Required(r.ReturnUUID, "return_uuid"); err != nil {
+		return err
+	}
+	if _, err := uuid.FromString(r.ReturnUUID); err != nil {
+		return fmt.Errorf("return_uuid must be a valid UUID")
+	}
+	if r.Quantity <= 0 {
+		return fmt.Errorf("quantity must be greater than zero")
+	}
+	return validate.Required(r.QuantityUnit, "quantity_unit")
+}
+
 type PurchaseOrderLineResponse struct {
 	UUID              string     `json:"uuid"`
 	PurchaseOrderUUID string     `json:"purchase_order_uuid"`
@@ -109,6 +156,7 @@ type PurchaseOrderLineResponse struct {
 	QuantityUnit      string     `json:"quantity_unit"`
 	UnitCostCents     int64      `json:"unit_cost_cents"`
 	Currency          string     `json:"currency"`
+	ReturnedQuantity  int64      `json:"returned_quantity"`
 	CreatedAt         time.Time  `json:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at"`
 	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
@@ -130,6 +178,7 @@ func NewPurchaseOrderLineResponse(line storage.PurchaseOrderLine) PurchaseOrderL
 		QuantityUnit:      line.QuantityUnit,
 		UnitCostCents:     line.UnitCostCents,
 		Currency:          line.Currency,
+		ReturnedQuantity:  line.ReturnedQuantity,
 		CreatedAt:         line.CreatedAt,
 		UpdatedAt:         line.UpdatedAt,
 		DeletedAt:         line.DeletedAt,
@@ -0,0 +1,256 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// Match statuses of a purchase order line in the three-way match.
+const (
+	// MatchStatusMatched: invoiced quantity and price agree with the receipt
+	// and the order within tolerance.
+	MatchStatusMatched = "matched"
+	// MatchStatusNotReceived: nothing received and nothing invoiced yet.
+	MatchStatusNotReceived = "not_received"
+	// MatchStatusAwaitingInvoice: less has been invoiced than received.
+	MatchStatusAwaitingInvoice = "awaiting_invoice"
+	// MatchStatusQuantityVariance: more has been invoiced than received.
+	MatchStatusQuantityVariance = "quantity_variance"
+	// MatchStatusPriceVariance: an invoice line's unit price or currency
+	// differs from the order.
+	MatchStatusPriceVariance = "price_variance"
+	// MatchStatusUnitMismatch: an invoice line or receipt is in a different
+	// unit from the order line, so quantities cannot be compared.
+	MatchStatusUnitMismatch = "unit_mismatch"
+)
+
+type CreateSupplierInvoiceLineRequest struct {
+	PurchaseOrderLineUUID string  `json:"purchase_order_line_uuid"`
+	Quantity              int64   `json:"quantity"`
+	QuantityUnit          string  `json:"quantity_unit"`
+	UnitPriceCents        int64   `json:"unit_price_cents"`
+	Notes                 *string `json:"notes"`
+}
+
+// CreateSupplierInvoiceRequest records a supplier invoice against a purchase
+// order. InvoiceDate and DueDate are YYYY-MM-DD; Currency defaults to the
+// currency of the order's lines.
+type CreateSupplierInvoiceRequest struct {
+	PurchaseOrderUUID string                             `json:"purchase_order_uuid"`
+	InvoiceNumber     string                             `json:"invoice_number"`
+	InvoiceDate       string                             `json:"invoice_date"`
+	DueDate           *string                            `json:"due_date"`
+	Currency          *string                            `json:"currency"`
+	Notes             *string                            `json:"notes"`
+	Lines             []CreateSupplierInvoiceLineRequest `json:"lines"`
+}
+
+func (r CreateSupplierInvoiceRequest) Validate() error {
+	if err := validate.Required(r.PurchaseOrderUUID, "purchase_order_uuid"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.InvoiceNumber, "invoice_number"); err != nil {
+		return err
+	}
+	invoiceDate, err := time.Parse(time.DateOnly, r.InvoiceDate)
+	if err != nil {
+		return fmt.Errorf("invoice_date must be a date (YYYY-MM-DD)")
+	}
+	if r.DueDate != nil {
+		dueDate, err := time.Parse(time.DateOnly, *r.DueDate)
+		if err != nil {
+			return fmt.Errorf("due_date must be a date (YYYY-MM-DD)")
+		}
+		if dueDate.Before(invoiceDate) {
+			return fmt.Errorf("due_date must be on or after invoice_date")
+		}
+	}
+	if r.Currency != nil {
+		if err := validateCurrency(*r.Currency); err != nil {
+			return err
+		}
+	}
+	if len(r.Lines) == 0 {
+		return fmt.Errorf("lines is required and must contain at least 1 line")
+	}
+	for i, line := range r.Lines {
+		if err := validate.Required(line.PurchaseOrderLineUUID, fmt.Sprintf("lines[%d].purchase_order_line_uuid", i)); err != nil {
+			return err
+		}
+		if line.Quantity <= 0 {
+			return fmt.Errorf("lines[%d].quantity must be greater than zero", i)
+		}
+		if err := validate.Required(line.QuantityUnit, fmt.Sprintf("lines[%d].quantity_unit", i)); err != nil {
+			return err
+		}
+		if line.UnitPriceCents < 0 {
+			return fmt.Errorf("lines[%d].unit_price_cents must be zero or greater", i)
+		}
+	}
+
+	return nil
+}
+
+type SupplierInvoiceLineResponse struct {
+	UUID                  string    `json:"uuid"`
+	PurchaseOrderLineUUID string    `json:"purchase_order_line_uuid"`
+	Quantity              int64     `json:"quantity"`
+	QuantityUnit          string    `json:"quantity_unit"`
+	UnitPriceCents        int64     `json:"unit_price_cents"`
+	TotalCents            int64     `json:"total_cents"`
+	Notes                 *string   `json:"notes,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+type SupplierInvoiceResponse struct {
+	UUID              string                        `json:"uuid"`
+	SupplierUUID      string                        `json:"supplier_uuid"`
+	PurchaseOrderUUID string                        `json:"purchase_order_uuid"`
+	OrderNumber       string                        `json:"order_number"`
+	InvoiceNumber     string                        `json:"invoice_number"`
+	InvoiceDate       string                        `json:"invoice_date"`
+	DueDate           *string                       `json:"due_date,omitempty"`
+	Currency          string                        `json:"currency"`
+	TotalCents        int64                         `json:"total_cents"`
+	Notes             *string                       `json:"notes,omitempty"`
+	Lines             []SupplierInvoiceLineResponse `json:"lines"`
+	CreatedAt         time.Time                     `json:"created_at"`
+	UpdatedAt         time.Time                     `json:"updated_at"`
+}
+
+func NewSupplierInvoiceLineResponse(line storage.SupplierInvoiceLine) SupplierInvoiceLineResponse {
+	return SupplierInvoiceLineResponse{
+		UUID:                  line.UUID.String(),
+		PurchaseOrderLineUUID: line.PurchaseOrderLineUUID,
+		Quantity:              line.Quantity,
+		QuantityUnit:          line.QuantityUnit,
+		UnitPriceCents:        line.UnitPriceCents,
+		TotalCents:            line.Quantity * line.UnitPriceCents,
+		Notes:                 line.Notes,
+		CreatedAt:             line.CreatedAt,
+		UpdatedAt:             line.UpdatedAt,
+	}
+}
+
+func NewSupplierInvoiceResponse(invoice storage.SupplierInvoice, lines []storage.SupplierInvoiceLine) SupplierInvoiceResponse {
+	resp := SupplierInvoiceResponse{
+		UUID:              invoice.UUID.String(),
+		SupplierUUID:      invoice.SupplierUUID,
+		PurchaseOrderUUID: invoice.PurchaseOrderUUID,
+		OrderNumber:       invoice.OrderNumber,
+		InvoiceNumber:     invoice.InvoiceNumber,
+		InvoiceDate:       invoice.InvoiceDate.Format(time.DateOnly),
+		Currency:          invoice.Currency,
+		Notes:             invoice.Notes,
+		Lines:             make([]SupplierInvoiceLineResponse, 0, len(lines)),
+		CreatedAt:         invoice.CreatedAt,
+		UpdatedAt:         invoice.UpdatedAt,
+	}
+	if invoice.DueDate != nil {
+		dueDate := invoice.DueDate.Format(time.DateOnly)
+		resp.DueDate = &dueDate
+	}
+	for _, line := range lines {
+		lineResp := NewSupplierInvoiceLineResponse(line)
+		resp.TotalCents += lineResp.TotalCents
+		resp.Lines = append(resp.Lines, lineResp)
+	}
+	return resp
+}
+
+func NewSupplierInvoicesResponse(invoices []storage.SupplierInvoice, linesByInvoiceID map[int64][]storage.SupplierInvoiceLine) []SupplierInvoiceResponse {
+	resp := make([]SupplierInvoiceResponse, 0, len(invoices))
+	for _, invoice := range invoices {
+		resp = append(resp, NewSupplierInvoiceResponse(invoice, linesByInvoiceID[invoice.ID]))
+	}
+	return resp
+}
+
+// UpdateInvoiceMatchSettingsRequest sets the three-way match tolerances, as
+// percentages of the received quantity and the ordered unit cost.
+type UpdateInvoiceMatchSettingsRequest struct {
+	QuantityTolerancePercent float64 `json:"quantity_tolerance_percent"`
+	PriceTolerancePercent    float64 `json:"price_tolerance_percent"`
+}
+
+func (r UpdateInvoiceMatchSettingsRequest) Validate() error {
+	if err := validateTolerance(r.QuantityTolerancePercent, "quantity_tolerance_percent"); err != nil {
+		return err
+	}
+	return validateTolerance(r.PriceTolerancePercent, "price_tolerance_percent")
+}
+
+func validateTolerance(value float64, field string) error {
+	if math.IsNaN(value) || value < 0 || value > 100 {
+		return fmt.Errorf("%s must be between 0 and 100", field)
+	}
+	return nil
+}
+
+type InvoiceMatchSettingsResponse struct {
+	QuantityTolerancePercent float64   `json:"quantity_tolerance_percent"`
+	PriceTolerancePercent    float64   `json:"price_tolerance_percent"`
+	UpdatedAt                time.Time `json:"updated_at"`
+}
+
+func NewInvoiceMatchSettingsResponse(settings storage.InvoiceMatchSettings) InvoiceMatchSettingsResponse {
+	return InvoiceMatchSettingsResponse{
+		QuantityTolerancePercent: settings.QuantityTolerancePercent,
+		PriceTolerancePercent:    settings.PriceTolerancePercent,
+		UpdatedAt:                settings.UpdatedAt,
+	}
+}
+
+// PurchaseOrderMatchResponse is the three-way match of a purchase order: each
+// line's ordered price against what was received and what was invoiced.
+type PurchaseOrderMatchResponse struct {
+	PurchaseOrderUUID        string                   `json:"purchase_order_uuid"`
+	OrderNumber              string                   `json:"order_number"`
+	Status                   string                   `json:"status"`
+	QuantityTolerancePercent float64                  `json:"quantity_tolerance_percent"`
+	PriceTolerancePercent    float64                  `json:"price_tolerance_percent"`
+	Lines                    []PurchaseOrderLineMatch `json:"lines"`
+	// FullyMatched is true when no line has a variance or is awaiting an
+	// invoice. Only fully matched orders can be closed.
+	FullyMatched bool `json:"fully_matched"`
+}
+
+// PurchaseOrderLineMatch compares one purchase order line with its receipts
+// and invoice lines. ReceivedQuantity is net of ReturnedQuantity, the
+// quantity sent back to the supplier. QuantityVariance is invoiced minus
+// received.
+type PurchaseOrderLineMatch struct {
+	PurchaseOrderLineUUID string             `json:"purchase_order_line_uuid"`
+	LineNumber            int                `json:"line_number"`
+	ItemName              string             `json:"item_name"`
+	QuantityUnit          string             `json:"quantity_unit"`
+	Currency              string             `json:"currency"`
+	UnitCostCents         int64              `json:"unit_cost_cents"`
+	OrderedQuantity       int64              `json:"ordered_quantity"`
+	ReceivedQuantity      int64              `json:"received_quantity"`
+	ReturnedQuantity      int64              `json:"returned_quantity"`
+	InvoicedQuantity      int64              `json:"invoiced_quantity"`
+	QuantityVariance      int64              `json:"quantity_variance"`
+	PriceVariance         bool               `json:"price_variance"`
+	Status                string             `json:"status"`
+	Invoices              []InvoiceLineMatch `json:"invoices"`
+}
+
+// InvoiceLineMatch is one invoice line billed against a purchase order line.
+// PriceVarianceCents is the invoiced unit price minus the ordered unit cost.
+type InvoiceLineMatch struct {
+	SupplierInvoiceUUID     string `json:"supplier_invoice_uuid"`
+	SupplierInvoiceLineUUID string `json:"supplier_invoice_line_uuid"`
+	InvoiceNumber           string `json:"invoice_number"`
+	Quantity                int64  `json:"quantity"`
+	QuantityUnit            string `json:"quantity_unit"`
+	UnitPriceCents          int64  `json:"unit_price_cents"`
+	Currency                string `json:"currency"`
+	PriceVarianceCents      int64  `json:"price_variance_cents"`
+	WithinTolerance         bool   `json:"within_tolerance"`
+}
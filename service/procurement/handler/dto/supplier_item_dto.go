@@ -0,0 +1,201 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/uuidutil"
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type CreateSupplierItemRequest struct {
+	SupplierUUID      string  `json:"supplier_uuid"`
+	SKU               string  `json:"sku"`
+	Name              string  `json:"name"`
+	ItemType          string  `json:"item_type"`
+	InventoryItemUUID *string `json:"inventory_item_uuid"`
+	PackSize          *int64  `json:"pack_size"`
+	PackUnit          string  `json:"pack_unit"`
+	MinOrderQuantity  *int64  `json:"min_order_quantity"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	Notes             *string `json:"notes"`
+}
+
+func (r CreateSupplierItemRequest) Validate() error {
+	if err := validate.Required(r.SupplierUUID, "supplier_uuid"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.SKU, "sku"); err != nil {
+		return err
+	}
+	if err := validate.Required(r.Name, "name"); err != nil {
+		return err
+	}
+	if err := validateLineItemType(r.ItemType); err != nil {
+		return err
+	}
+	if err := validate.Required(r.PackUnit, "pack_unit"); err != nil {
+		return err
+	}
+
+	return validateSupplierItemFields(r.InventoryItemUUID, r.PackSize, r.MinOrderQuantity, r.LeadTimeDays)
+}
+
+type UpdateSupplierItemRequest struct {
+	SKU               *string `json:"sku"`
+	Name              *string `json:"name"`
+	ItemType          *string `json:"item_type"`
+	InventoryItemUUID *string `json:"inventory_item_uuid"`
+	PackSize          *int64  `json:"pack_size"`
+	PackUnit          *string `json:"pack_unit"`
+	MinOrderQuantity  *int64  `json:"min_order_quantity"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	Notes             *string `json:"notes"`
+}
+
+func (r UpdateSupplierItemRequest) Validate() error {
+	if r.SKU == nil && r.Name == nil && r.ItemType == nil && r.InventoryItemUUID == nil && r.PackSize == nil &&
+		r.PackUnit == nil && r.MinOrderQuantity == nil && r.LeadTimeDays == nil && r.Notes == nil {
+		return fmt.Errorf("at least one field must be provided")
+	}
+	if r.SKU != nil && strings.TrimSpace(*r.SKU) == "" {
+		return fmt.Errorf("sku cannot be empty")
+	}
+	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if r.ItemType != nil {
+		if err := validateLineItemType(*r.ItemType); err != nil {
+			return err
+		}
+	}
+	if r.PackUnit != nil && strings.TrimSpace(*r.PackUnit) == "" {
+		return fmt.Errorf("pack_unit cannot be empty")
+	}
+
+	return validateSupplierItemFields(r.InventoryItemUUID, r.PackSize, r.MinOrderQuantity, r.LeadTimeDays)
+}
+
+func validateSupplierItemFields(inventoryItemUUID *string, packSize, minOrderQuantity *int64, leadTimeDays *int) error {
+	if inventoryItemUUID != nil {
+		if _, err := uuid.FromString(*inventoryItemUUID); err != nil {
+			return fmt.Errorf("inventory_item_uuid must be a valid UUID")
+		}
+	}
+	if packSize != nil && *packSize <= 0 {
+		return fmt.Errorf("pack_size must be greater than zero")
+	}
+	if minOrderQuantity != nil && *minOrderQuantity <= 0 {
+		return fmt.Errorf("min_order_quantity must be greater than zero")
+	}
+	if leadTimeDays != nil && *leadTimeDays < 0 {
+		return fmt.Errorf("lead_time_days must be zero or greater")
+	}
+
+	return nil
+}
+
+type SupplierItemResponse struct {
+	UUID              string                     `json:"uuid"`
+	SupplierUUID      string                     `json:"supplier_uuid"`
+	SupplierName      string                     `json:"supplier_name"`
+	SKU               string                     `json:"sku"`
+	Name              string                     `json:"name"`
+	ItemType          string                     `json:"item_type"`
+	InventoryItemUUID *string                    `json:"inventory_item_uuid,omitempty"`
+	PackSize          int64                      `json:"pack_size"`
+	PackUnit          string                     `json:"pack_unit"`
+	MinOrderQuantity  *int64                     `json:"min_order_quantity,omitempty"`
+	LeadTimeDays      *int                       `json:"lead_time_days,omitempty"`
+	Notes             *string                    `json:"notes,omitempty"`
+	CurrentPrice      *SupplierItemPriceResponse `json:"current_price,omitempty"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
+	DeletedAt         *time.Time                 `json:"deleted_at,omitempty"`
+}
+
+func NewSupplierItemResponse(item storage.SupplierItem) SupplierItemResponse {
+	resp := SupplierItemResponse{
+		UUID:              item.UUID.String(),
+		SupplierUUID:      item.SupplierUUID,
+		SupplierName:      item.SupplierName,
+		SKU:               item.SKU,
+		Name:              item.Name,
+		ItemType:          item.ItemType,
+		InventoryItemUUID: uuidutil.ToStringPointer(item.InventoryItemUUID),
+		PackSize:          item.PackSize,
+		PackUnit:          item.PackUnit,
+		MinOrderQuantity:  item.MinOrderQuantity,
+		LeadTimeDays:      item.LeadTimeDays,
+		Notes:             item.Notes,
+		CreatedAt:         item.CreatedAt,
+		UpdatedAt:         item.UpdatedAt,
+		DeletedAt:         item.DeletedAt,
+	}
+	if item.CurrentPrice != nil {
+		price := NewSupplierItemPriceResponse(*item.CurrentPrice)
+		resp.CurrentPrice = &price
+	}
+	return resp
+}
+
+func NewSupplierItemsResponse(items []storage.SupplierItem) []SupplierItemResponse {
+	resp := make([]SupplierItemResponse, 0, len(items))
+	for _, item := range items {
+		resp = append(resp, NewSupplierItemResponse(item))
+	}
+	return resp
+}
+
+// CreateSupplierItemPriceRequest records a price for a catalog item, quoted
+// per pack unit. EffectiveAt defaults to now.
+type CreateSupplierItemPriceRequest struct {
+	UnitCostCents int64      `json:"unit_cost_cents"`
+	Currency      string     `json:"currency"`
+	EffectiveAt   *time.Time `json:"effective_at"`
+	Notes         *string    `json:"notes"`
+}
+
+func (r CreateSupplierItemPriceRequest) Validate() error {
+	if r.UnitCostCents < 0 {
+		return fmt.Errorf("unit_cost_cents must be zero or greater")
+	}
+
+	return validateCurrency(r.Currency)
+}
+
+type SupplierItemPriceResponse struct {
+	UUID             string     `json:"uuid"`
+	SupplierItemUUID string     `json:"supplier_item_uuid"`
+	UnitCostCents    int64      `json:"unit_cost_cents"`
+	Currency         string     `json:"currency"`
+	EffectiveAt      time.Time  `json:"effective_at"`
+	Notes            *string    `json:"notes,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+}
+
+func NewSupplierItemPriceResponse(price storage.SupplierItemPrice) SupplierItemPriceResponse {
+	resp := SupplierItemPriceResponse{
+		UUID:             price.UUID.String(),
+		SupplierItemUUID: price.SupplierItemUUID,
+		UnitCostCents:    price.UnitCostCents,
+		Currency:         price.Currency,
+		EffectiveAt:      price.EffectiveAt,
+		Notes:            price.Notes,
+	}
+	if !price.CreatedAt.IsZero() {
+		resp.CreatedAt = &price.CreatedAt
+	}
+	return resp
+}
+
+func NewSupplierItemPricesResponse(prices []storage.SupplierItemPrice) []SupplierItemPriceResponse {
+	resp := make([]SupplierItemPriceResponse, 0, len(prices))
+	for _, price := range prices {
+		resp = append(resp, NewSupplierItemPriceResponse(price))
+	}
+	return resp
+}
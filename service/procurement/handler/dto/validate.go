@@ -14,6 +14,7 @@ func validatePurchaseOrderStatus(status string) error {
 		storage.PurchaseOrderStatusConfirmed,
 		storage.PurchaseOrderStatusPartiallyReceived,
 		storage.PurchaseOrderStatusReceived,
+		storage.PurchaseOrderStatusClosed,
 		storage.PurchaseOrderStatusCancelled:
 		return nil
 	default:
@@ -22,13 +23,15 @@ func validatePurchaseOrderStatus(status string) error {
 }
 
 // validPurchaseOrderTransitions defines the allowed status transitions for purchase orders.
-// Terminal states (received, cancelled) have no valid transitions.
+// Received orders can only be closed, once fully matched against invoices.
+// Terminal states (closed, cancelled) have no valid transitions.
 var validPurchaseOrderTransitions = map[string][]string{
 	storage.PurchaseOrderStatusDraft:             {storage.PurchaseOrderStatusSubmitted, storage.PurchaseOrderStatusCancelled},
 	storage.PurchaseOrderStatusSubmitted:         {storage.PurchaseOrderStatusConfirmed, storage.PurchaseOrderStatusCancelled},
 	storage.PurchaseOrderStatusConfirmed:         {storage.PurchaseOrderStatusPartiallyReceived, storage.PurchaseOrderStatusReceived, storage.PurchaseOrderStatusCancelled},
-	storage.PurchaseOrderStatusPartiallyReceived: {storage.PurchaseOrderStatusReceived, storage.PurchaseOrderStatusCancelled},
-	storage.PurchaseOrderStatusReceived:          {},
+	storage.PurchaseOrderStatusPartiallyReceived: {storage.PurchaseOrderStatusReceived, storage.PurchaseOrderStatusClosed, storage.PurchaseOrderStatusCancelled},
+	storage.PurchaseOrderStatusReceived:          {storage.PurchaseOrderStatusClosed},
+	storage.PurchaseOrderStatusClosed:            {},
 	storage.PurchaseOrderStatusCancelled:         {},
 }
 
@@ -66,6 +69,8 @@ func terminalStatusReason(status string) string {
 	switch status {
 	case storage.PurchaseOrderStatusReceived:
 		return "purchase order is already complete"
+	case storage.PurchaseOrderStatusClosed:
+		return "purchase order is closed"
 	case storage.PurchaseOrderStatusCancelled:
 		return "purchase order is cancelled"
 	default:
@@ -86,6 +91,17 @@ func validateLineItemType(itemType string) error {
 	}
 }
 
+func validateFeeAllocationBasis(basis string) error {
+	switch basis {
+	case storage.FeeAllocationBasisValue,
+		storage.FeeAllocationBasisQuantity,
+		storage.FeeAllocationBasisWeight:
+		return nil
+	default:
+		return fmt.Errorf("invalid allocation_basis")
+	}
+}
+
 func validateCurrency(code string) error {
 	value := strings.TrimSpace(code)
 	if value == "" {
@@ -91,6 +91,18 @@ func TestValidatePurchaseOrderStatusTransition(t *testing.T) {
 			newStatus:     storage.PurchaseOrderStatusCancelled,
 			wantErr:       false,
 		},
+		{
+			name:          "partially_received to closed is valid",
+			currentStatus: storage.PurchaseOrderStatusPartiallyReceived,
+			newStatus:     storage.PurchaseOrderStatusClosed,
+			wantErr:       false,
+		},
+		{
+			name:          "received to closed is valid",
+			currentStatus: storage.PurchaseOrderStatusReceived,
+			newStatus:     storage.PurchaseOrderStatusClosed,
+			wantErr:       false,
+		},
 
 		// Invalid transitions from draft
 		{
@@ -163,6 +175,15 @@ func TestValidatePurchaseOrderStatusTransition(t *testing.T) {
 			errContains:   "purchase order is already complete",
 		},
 
+		// Terminal state: closed
+		{
+			name:          "closed to received is invalid",
+			currentStatus: storage.PurchaseOrderStatusClosed,
+			newStatus:     storage.PurchaseOrderStatusReceived,
+			wantErr:       true,
+			errContains:   "purchase order is closed",
+		},
+
 		// Terminal state: cancelled
 		{
 			name:          "cancelled to draft is invalid",
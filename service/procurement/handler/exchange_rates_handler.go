@@ -0,0 +1,366 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+const (
+	exchangeRateImportMaxUploadSize = 5 << 20
+	exchangeRateImportMaxRows       = 5000
+)
+
+var exchangeRateImportAllowedHeaders = map[string]struct{}{
+	"from_currency":  {},
+	"to_currency":    {},
+	"rate":           {},
+	"effective_date": {},
+}
+
+type ExchangeRateStore interface {
+	GetBaseCurrency(context.Context) (string, error)
+	SetBaseCurrency(context.Context, string) (string, error)
+	ListExchangeRates(context.Context, storage.ExchangeRateFilter) ([]storage.ExchangeRate, error)
+	UpsertExchangeRate(context.Context, storage.ExchangeRate) (storage.ExchangeRate, error)
+	DeleteExchangeRateByUUID(context.Context, string) error
+}
+
+// HandleCurrencySettings handles [GET /currency-settings] and [PUT /currency-settings].
+func HandleCurrencySettings(db ExchangeRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			baseCurrency, err := db.GetBaseCurrency(r.Context())
+			if err != nil {
+				service.InternalError(w, "error getting base currency", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.CurrencySettingsResponse{BaseCurrency: baseCurrency})
+		case http.MethodPut:
+			var req dto.UpdateCurrencySettingsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			baseCurrency, err := db.SetBaseCurrency(r.Context(), dto.NormalizeCurrency(req.BaseCurrency))
+			if err != nil {
+				service.InternalError(w, "error setting base currency", "error", err)
+				return
+			}
+
+			slog.Info("base currency changed", "base_currency", baseCurrency)
+
+			service.JSON(w, dto.CurrencySettingsResponse{BaseCurrency: baseCurrency})
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleExchangeRates handles [GET /exchange-rates] and [POST /exchange-rates].
+// Recording a rate for a pair and date that already has one replaces it.
+func HandleExchangeRates(db ExchangeRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var filter storage.ExchangeRateFilter
+			if v := r.URL.Query().Get("currency"); v != "" {
+				currency := dto.NormalizeCurrency(v)
+				filter.Currency = &currency
+			}
+
+			rates, err := db.ListExchangeRates(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing exchange rates", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewExchangeRatesResponse(rates))
+		case http.MethodPost:
+			var req dto.CreateExchangeRateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			var toCurrency string
+			if req.ToCurrency != nil {
+				toCurrency = dto.NormalizeCurrency(*req.ToCurrency)
+			} else {
+				baseCurrency, err := db.GetBaseCurrency(r.Context())
+				if err != nil {
+					service.InternalError(w, "error getting base currency", "error", err)
+					return
+				}
+				toCurrency = baseCurrency
+			}
+			fromCurrency := dto.NormalizeCurrency(req.FromCurrency)
+			if fromCurrency == toCurrency {
+				http.Error(w, "from_currency must differ from the base currency", http.StatusBadRequest)
+				return
+			}
+
+			effectiveDate := time.Now().UTC().Truncate(24 * time.Hour)
+			if req.EffectiveDate != nil {
+				effectiveDate, _ = dto.ParseEffectiveDate(*req.EffectiveDate)
+			}
+
+			rate, err := db.UpsertExchangeRate(r.Context(), storage.ExchangeRate{
+				FromCurrency:  fromCurrency,
+				ToCurrency:    toCurrency,
+				Rate:          req.Rate,
+				EffectiveDate: effectiveDate,
+				Source:        storage.ExchangeRateSourceManual,
+			})
+			if err != nil {
+				service.InternalError(w, "error recording exchange rate", "error", err)
+				return
+			}
+
+			slog.Info("exchange rate recorded", "from_currency", rate.FromCurrency, "to_currency", rate.ToCurrency, "rate", rate.Rate, "effective_date", rate.EffectiveDate)
+
+			service.JSONCreated(w, dto.NewExchangeRateResponse(rate))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleExchangeRateByUUID handles [DELETE /exchange-rates/{uuid}]. Rates
+// already locked on received purchase orders are not affected.
+func HandleExchangeRateByUUID(db ExchangeRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		rateUUID := r.PathValue("uuid")
+		if _, err := uuid.FromString(rateUUID); err != nil {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		err := db.DeleteExchangeRateByUUID(r.Context(), rateUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "exchange rate not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error deleting exchange rate", "error", err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// HandleExchangeRateImport handles [POST /exchange-rates/import]. The upload
+// is a CSV file with from_currency, rate and effective_date columns and an
+// optional to_currency column that defaults to the base currency. Each row is
+// imported on its own, so one bad row does not reject the file.
+func HandleExchangeRateImport(db ExchangeRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, exchangeRateImportMaxUploadSize)
+		if err := r.ParseMultipartForm(exchangeRateImportMaxUploadSize); err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				http.Error(w, "file too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			slog.Warn("invalid exchange rate import form", "error", err)
+			http.Error(w, "invalid multipart form", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+
+		headers, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				http.Error(w, "missing header row", http.StatusBadRequest)
+				return
+			}
+			slog.Warn("unable to read exchange rate import header", "error", err)
+			http.Error(w, "invalid csv", http.StatusBadRequest)
+			return
+		}
+
+		headerIndex := make(map[string]int, len(headers))
+		for idx, rawHeader := range headers {
+			header := strings.TrimSpace(rawHeader)
+			if header == "" {
+				http.Error(w, "header value is required", http.StatusBadRequest)
+				return
+			}
+			if _, ok := exchangeRateImportAllowedHeaders[header]; !ok {
+				http.Error(w, fmt.Sprintf("unknown header: %s", header), http.StatusBadRequest)
+				return
+			}
+			if _, exists := headerIndex[header]; exists {
+				http.Error(w, fmt.Sprintf("duplicate header: %s", header), http.StatusBadRequest)
+				return
+			}
+			headerIndex[header] = idx
+		}
+
+		for _, required := range []string{"from_currency", "rate", "effective_date"} {
+			if _, ok := headerIndex[required]; !ok {
+				http.Error(w, fmt.Sprintf("%s header is required", required), http.StatusBadRequest)
+				return
+			}
+		}
+
+		var records [][]string
+		for {
+			record, err := reader.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				slog.Warn("unable to read exchange rate import row", "error", err)
+				http.Error(w, "invalid csv", http.StatusBadRequest)
+				return
+			}
+			records = append(records, record)
+			if len(records) > exchangeRateImportMaxRows {
+				http.Error(w, "row limit exceeded", http.StatusBadRequest)
+				return
+			}
+		}
+
+		baseCurrency, err := db.GetBaseCurrency(r.Context())
+		if err != nil {
+			service.InternalError(w, "error getting base currency", "error", err)
+			return
+		}
+
+		results := make([]dto.ExchangeRateImportRowResult, 0, len(records))
+		importedCount := 0
+		failedCount := 0
+
+		for idx, record := range records {
+			rowNumber := idx + 2
+			rate, err := parseExchangeRateRecord(record, len(headers), headerIndex, baseCurrency)
+			if err != nil {
+				msg := err.Error()
+				results = append(results, dto.ExchangeRateImportRowResult{
+					Row:    rowNumber,
+					Status: "error",
+					Error:  &msg,
+				})
+				failedCount++
+				continue
+			}
+
+			saved, err := db.UpsertExchangeRate(r.Context(), rate)
+			if err != nil {
+				slog.Error("error recording exchange rate from import", "error", err, "row", rowNumber)
+				msg := "record exchange rate failed"
+				results = append(results, dto.ExchangeRateImportRowResult{
+					Row:    rowNumber,
+					Status: "error",
+					Error:  &msg,
+				})
+				failedCount++
+				continue
+			}
+
+			importedCount++
+			rateResponse := dto.NewExchangeRateResponse(saved)
+			results = append(results, dto.ExchangeRateImportRowResult{
+				Row:          rowNumber,
+				Status:       "imported",
+				ExchangeRate: &rateResponse,
+			})
+		}
+
+		slog.Info("exchange rates imported", "imported", importedCount, "failed", failedCount)
+
+		service.JSON(w, dto.ExchangeRateImportResponse{
+			Totals: dto.ExchangeRateImportTotals{
+				TotalRows: len(records),
+				Imported:  importedCount,
+				Failed:    failedCount,
+			},
+			Results: results,
+		})
+	}
+}
+
+func parseExchangeRateRecord(record []string, columns int, headerIndex map[string]int, baseCurrency string) (storage.ExchangeRate, error) {
+	if len(record) != columns {
+		return storage.ExchangeRate{}, fmt.Errorf("invalid column count")
+	}
+
+	fromCurrency := dto.NormalizeCurrency(record[headerIndex["from_currency"]])
+	if len(fromCurrency) != 3 {
+		return storage.ExchangeRate{}, fmt.Errorf("from_currency must be a 3-letter code")
+	}
+
+	toCurrency := baseCurrency
+	if idx, ok := headerIndex["to_currency"]; ok {
+		if value := dto.NormalizeCurrency(record[idx]); value != "" {
+			toCurrency = value
+		}
+	}
+	if len(toCurrency) != 3 {
+		return storage.ExchangeRate{}, fmt.Errorf("to_currency must be a 3-letter code")
+	}
+	if fromCurrency == toCurrency {
+		return storage.ExchangeRate{}, fmt.Errorf("to_currency must differ from from_currency")
+	}
+
+	rate, err := dto.ParseExchangeRate(record[headerIndex["rate"]])
+	if err != nil {
+		return storage.ExchangeRate{}, err
+	}
+
+	effectiveDate, err := dto.ParseEffectiveDate(record[headerIndex["effective_date"]])
+	if err != nil {
+		return storage.ExchangeRate{}, err
+	}
+
+	return storage.ExchangeRate{
+		FromCurrency:  fromCurrency,
+		ToCurrency:    toCurrency,
+		Rate:          rate,
+		EffectiveDate: effectiveDate,
+		Source:        storage.ExchangeRateSourceImport,
+	}, nil
+}
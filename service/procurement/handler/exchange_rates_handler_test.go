@@ -0,0 +1,303 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CurrencyStore is an in-memory base currency setting and exchange rate
+// table. The base currency defaults to USD.
+type CurrencyStore struct {
+	baseCurrency string
+	rates        []storage.ExchangeRate
+	locked       []storage.PurchaseOrderExchangeRate
+}
+
+func (s CurrencyStore) GetBaseCurrency(context.Context) (string, error) {
+	if s.baseCurrency == "" {
+		return "USD", nil
+	}
+	return s.baseCurrency, nil
+}
+
+func (s *CurrencyStore) SetBaseCurrency(_ context.Context, baseCurrency string) (string, error) {
+	s.baseCurrency = baseCurrency
+	return baseCurrency, nil
+}
+
+func (s CurrencyStore) ListExchangeRates(context.Context, storage.ExchangeRateFilter) ([]storage.ExchangeRate, error) {
+	return s.rates, nil
+}
+
+func (s *CurrencyStore) UpsertExchangeRate(_ context.Context, rate storage.ExchangeRate) (storage.ExchangeRate, error) {
+	rate.UUID = uuid.Must(uuid.NewV4())
+	s.rates = append(s.rates, rate)
+	return rate, nil
+}
+
+func (s CurrencyStore) DeleteExchangeRateByUUID(context.Context, string) error {
+	return nil
+}
+
+func (s CurrencyStore) GetExchangeRateAt(_ context.Context, from, to string, at time.Time) (storage.ExchangeRate, error) {
+	var found *storage.ExchangeRate
+	for i, rate := range s.rates {
+		if rate.FromCurrency != from || rate.ToCurrency != to || rate.EffectiveDate.After(at) {
+			continue
+		}
+		if found == nil || rate.EffectiveDate.After(found.EffectiveDate) {
+			found = &s.rates[i]
+		}
+	}
+	if found == nil {
+		return storage.ExchangeRate{}, service.ErrNotFound
+	}
+	return *found, nil
+}
+
+func (s CurrencyStore) ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error) {
+	return s.locked, nil
+}
+
+func (s *CurrencyStore) LockPurchaseOrderExchangeRates(_ context.Context, rates []storage.PurchaseOrderExchangeRate) error {
+	s.locked = append(s.locked, rates...)
+	return nil
+}
+
+func newExchangeRate(from, to string, rate float64, effectiveDate string) storage.ExchangeRate {
+	date, _ := time.Parse(time.DateOnly, effectiveDate)
+	return storage.ExchangeRate{
+		FromCurrency:  from,
+		ToCurrency:    to,
+		Rate:          rate,
+		EffectiveDate: date,
+		Source:        storage.ExchangeRateSourceManual,
+	}
+}
+
+func TestHandleExchangeRates_DefaultsToBaseCurrency(t *testing.T) {
+	store := &CurrencyStore{baseCurrency: "NZD"}
+
+	body := `{"from_currency":"eur","rate":1.7825,"effective_date":"2026-02-10"}`
+	req := httptest.NewRequest(http.MethodPost, "/exchange-rates", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.HandleExchangeRates(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.ExchangeRateResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.FromCurrency != "EUR" || resp.ToCurrency != "NZD" || resp.Rate != 1.7825 || resp.EffectiveDate != "2026-02-10" {
+		t.Errorf("unexpected exchange rate %+v", resp)
+	}
+	if resp.Source != storage.ExchangeRateSourceManual {
+		t.Errorf("expected source %q, got %q", storage.ExchangeRateSourceManual, resp.Source)
+	}
+}
+
+func TestHandleExchangeRates_RejectsBaseCurrencyPair(t *testing.T) {
+	store := &CurrencyStore{}
+
+	body := `{"from_currency":"USD","rate":1}`
+	req := httptest.NewRequest(http.MethodPost, "/exchange-rates", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.HandleExchangeRates(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if len(store.rates) != 0 {
+		t.Errorf("expected no rate to be recorded, got %d", len(store.rates))
+	}
+}
+
+func TestHandleExchangeRateImport(t *testing.T) {
+	store := &CurrencyStore{}
+
+	csv := "from_currency,to_currency,rate,effective_date\n" +
+		"EUR,,1.0850,2026-02-01\n" +
+		"nzd,USD,0.6012,2026-02-01\n" +
+		"EUR,,-1,2026-02-02\n" +
+		"GBP,,1.27,02/03/2026\n" +
+		"USD,,1,2026-02-01\n"
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", "rates.csv")
+	if err != nil {
+		t.Fatalf("creating form file: %v", err)
+	}
+	part.Write([]byte(csv))
+	form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/exchange-rates/import", &buf)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rec := httptest.NewRecorder()
+
+	handler.HandleExchangeRateImport(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.ExchangeRateImportResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.Totals.TotalRows != 5 || resp.Totals.Imported != 2 || resp.Totals.Failed != 3 {
+		t.Errorf("unexpected totals %+v", resp.Totals)
+	}
+
+	expectedErrors := map[int]string{
+		4: "rate must be greater than zero",
+		5: "invalid effective_date",
+		6: "to_currency must differ from from_currency",
+	}
+	for _, result := range resp.Results {
+		expected, failed := expectedErrors[result.Row]
+		if !failed {
+			if result.Status != "imported" {
+				t.Errorf("row %d: expected imported, got %s", result.Row, result.Status)
+			}
+			continue
+		}
+		if result.Error == nil || *result.Error != expected {
+			t.Errorf("row %d: expected error %q, got %v", result.Row, expected, result.Error)
+		}
+	}
+
+	if len(store.rates) != 2 {
+		t.Fatalf("expected 2 rates recorded, got %d", len(store.rates))
+	}
+	if store.rates[0].ToCurrency != "USD" || store.rates[1].FromCurrency != "NZD" {
+		t.Errorf("unexpected rates %+v", store.rates)
+	}
+	if store.rates[0].Source != storage.ExchangeRateSourceImport {
+		t.Errorf("expected source %q, got %q", storage.ExchangeRateSourceImport, store.rates[0].Source)
+	}
+}
+
+func TestHandlePurchaseOrderTotals_BaseCurrency(t *testing.T) {
+	// Hops from Germany in EUR, locked at receipt; hops from New Zealand in
+	// NZD, converted at the latest rate recorded against USD the other way
+	// round; freight in USD.
+	lines := []storage.PurchaseOrderLine{
+		newLandedCostLine(1, 10, "kg", 2500, "EUR"),
+		newLandedCostLine(2, 5, "kg", 4000, "NZD"),
+	}
+	fees := []storage.PurchaseOrderFee{
+		newLandedCostFee(1, 3000, "USD", storage.FeeAllocationBasisWeight),
+		newLandedCostFee(2, 1000, "EUR", storage.FeeAllocationBasisValue),
+	}
+	lockedDate, _ := time.Parse(time.DateOnly, "2026-02-03")
+	store := LandedCostStore{
+		lines: lines,
+		fees:  fees,
+		CurrencyStore: CurrencyStore{
+			rates: []storage.ExchangeRate{
+				newExchangeRate("EUR", "USD", 1.20, "2026-02-10"),
+				newExchangeRate("USD", "NZD", 1.60, "2026-02-01"),
+			},
+			locked: []storage.PurchaseOrderExchangeRate{
+				{PurchaseOrderID: 1, Currency: "EUR", BaseCurrency: "USD", Rate: 1.10, RateDate: lockedDate},
+			},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/purchase-orders/x/totals", nil)
+	req.SetPathValue("uuid", uuid.Must(uuid.NewV4()).String())
+	rec := httptest.NewRecorder()
+
+	handler.HandlePurchaseOrderTotals(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.PurchaseOrderTotalsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	if resp.BaseCurrency != "USD" || len(resp.Currencies) != 3 {
+		t.Fatalf("unexpected totals %+v", resp)
+	}
+	expected := map[string]struct {
+		total     int64
+		baseTotal int64
+		locked    bool
+	}{
+		"EUR": {total: 26000, baseTotal: 28600, locked: true},
+		"NZD": {total: 20000, baseTotal: 12500, locked: false},
+		"USD": {total: 3000, baseTotal: 3000, locked: true},
+	}
+	for _, total := range resp.Currencies {
+		want := expected[total.Currency]
+		if total.TotalCents != want.total {
+			t.Errorf("%s: expected total %d, got %d", total.Currency, want.total, total.TotalCents)
+		}
+		if total.BaseTotalCents == nil || *total.BaseTotalCents != want.baseTotal {
+			t.Errorf("%s: expected base total %d, got %v", total.Currency, want.baseTotal, total.BaseTotalCents)
+		}
+		if total.ExchangeRate == nil || total.ExchangeRate.Locked != want.locked {
+			t.Errorf("%s: expected locked %v, got %+v", total.Currency, want.locked, total.ExchangeRate)
+		}
+	}
+	if resp.BaseLineTotalCents == nil || *resp.BaseLineTotalCents != 27500+12500 {
+		t.Errorf("expected base line total 40000, got %v", resp.BaseLineTotalCents)
+	}
+	if resp.BaseFeeTotalCents == nil || *resp.BaseFeeTotalCents != 3000+1100 {
+		t.Errorf("expected base fee total 4100, got %v", resp.BaseFeeTotalCents)
+	}
+	if resp.BaseTotalCents == nil || *resp.BaseTotalCents != 44100 {
+		t.Errorf("expected base total 44100, got %v", resp.BaseTotalCents)
+	}
+	if resp.ExchangeRatesLocked {
+		t.Error("expected exchange rates not to be fully locked")
+	}
+}
+
+func TestHandlePurchaseOrderTotals_MissingRate(t *testing.T) {
+	store := LandedCostStore{
+		lines: []storage.PurchaseOrderLine{
+			newLandedCostLine(1, 10, "kg", 2500, "EUR"),
+			newLandedCostLine(2, 10, "kg", 100, "USD"),
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/purchase-orders/x/totals", nil)
+	req.SetPathValue("uuid", uuid.Must(uuid.NewV4()).String())
+	rec := httptest.NewRecorder()
+
+	handler.HandlePurchaseOrderTotals(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.PurchaseOrderTotalsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.BaseTotalCents != nil {
+		t.Errorf("expected no base total without an EUR rate, got %d", *resp.BaseTotalCents)
+	}
+	if resp.Currencies[0].TotalCents != 25000 || resp.Currencies[0].ExchangeRate != nil {
+		t.Errorf("unexpected EUR total %+v", resp.Currencies[0])
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	inventoryapi "github.com/brewpipes/brewpipes/client/inventory"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
+)
+
+// InventoryClient handles inter-service communication with the Inventory service.
+type InventoryClient struct {
+	api *inventoryapi.Client
+}
+
+// NewInventoryClient creates a new InventoryClient with the given base URL. Requests are
+// authenticated with service tokens from tokens.
+func NewInventoryClient(baseURL string, tokens *service.ServiceTokenSource) *InventoryClient {
+	return &InventoryClient{
+		api: &inventoryapi.Client{
+			Server: strings.TrimSuffix(baseURL, "/") + "/",
+			Client: &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: tokens.Transport(telemetry.Transport("inventory", nil)),
+			},
+		},
+	}
+}
+
+// LotReceipt is the received quantity of an ingredient lot, as recorded by
+// the Inventory service against a purchase order line.
+type LotReceipt struct {
+	UUID                  string  `json:"uuid"`
+	PurchaseOrderLineUUID *string `json:"purchase_order_line_uuid"`
+	ReceivedAmount        int64   `json:"received_amount"`
+	ReceivedUnit          string  `json:"received_unit"`
+}
+
+// ListPurchaseOrderLineReceipts calls the Inventory service to list the
+// ingredient lots received against a purchase order line.
+func (c *InventoryClient) ListPurchaseOrderLineReceipts(ctx context.Context, lineUUID string) ([]LotReceipt, error) {
+	var result []LotReceipt
+	resp, err := c.api.ListIngredientLots(ctx, &inventoryapi.ListIngredientLotsParams{PurchaseOrderLineUuid: &lineUUID})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// InventoryIngredient is an ingredient in the Inventory service catalog.
+// Purchase order lines reference it by InventoryItemUUID.
+type InventoryIngredient struct {
+	UUID     string `json:"uuid"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// ListIngredients calls the Inventory service to list the ingredient catalog.
+func (c *InventoryClient) ListIngredients(ctx context.Context) ([]InventoryIngredient, error) {
+	var result []InventoryIngredient
+	resp, err := c.api.ListIngredients(ctx, nil)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// PurchaseOrderReceipts looks up what the Inventory service has received
+// against purchase order lines.
+type PurchaseOrderReceipts interface {
+	ListPurchaseOrderLineReceipts(ctx context.Context, lineUUID string) ([]LotReceipt, error)
+}
+
+// invoiceMatchStore is the storage needed to match a purchase order against
+// its receipts and invoices.
+type invoiceMatchStore interface {
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListSupplierInvoiceLinesByOrderID(context.Context, int64) ([]storage.SupplierInvoiceLine, error)
+	GetInvoiceMatchSettings(context.Context) (storage.InvoiceMatchSettings, error)
+}
+
+// receivedQuantity is the quantity received against a purchase order line.
+// unitMismatch is set when a lot was received in a different unit from the
+// line, so it could not be counted.
+type receivedQuantity struct {
+	quantity     int64
+	unitMismatch bool
+}
+
+// matchPurchaseOrder performs the three-way match of an order: each line's
+// ordered unit cost against its invoiced unit prices, and its received
+// quantity against its invoiced quantity. Receipts of ingredient and
+// packaging lines come from the Inventory service; other lines are not
+// received into inventory, so their ordered quantity counts as received.
+func matchPurchaseOrder(ctx context.Context, db invoiceMatchStore, receipts PurchaseOrderReceipts, order storage.PurchaseOrder) (dto.PurchaseOrderMatchResponse, error) {
+	lines, err := db.ListPurchaseOrderLinesByOrderIDs(ctx, []int64{order.ID})
+	if err != nil {
+		return dto.PurchaseOrderMatchResponse{}, fmt.Errorf("listing purchase order lines: %w", err)
+	}
+	invoiceLines, err := db.ListSupplierInvoiceLinesByOrderID(ctx, order.ID)
+	if err != nil {
+		return dto.PurchaseOrderMatchResponse{}, fmt.Errorf("listing supplier invoice lines: %w", err)
+	}
+	settings, err := db.GetInvoiceMatchSettings(ctx)
+	if err != nil {
+		return dto.PurchaseOrderMatchResponse{}, fmt.Errorf("getting invoice match settings: %w", err)
+	}
+
+	received := make(map[int64]receivedQuantity, len(lines))
+	for _, line := range lines {
+		if !receivedIntoInventory(line) {
+			received[line.ID] = receivedQuantity{quantity: line.Quantity}
+			continue
+		}
+
+		lots, err := receipts.ListPurchaseOrderLineReceipts(ctx, line.UUID.String())
+		if err != nil {
+			return dto.PurchaseOrderMatchResponse{}, fmt.Errorf("listing receipts of line %d: %w", line.LineNumber, err)
+		}
+		var rq receivedQuantity
+		for _, lot := range lots {
+			if lot.ReceivedUnit != line.QuantityUnit {
+				rq.unitMismatch = true
+				continue
+			}
+			rq.quantity += lot.ReceivedAmount
+		}
+		received[line.ID] = rq
+	}
+
+	return buildPurchaseOrderMatch(order, lines, invoiceLines, received, settings), nil
+}
+
+// receivedIntoInventory reports whether receipts of the line are recorded as
+// ingredient lots in the Inventory service.
+func receivedIntoInventory(line storage.PurchaseOrderLine) bool {
+	return line.ItemType == storage.PurchaseOrderItemTypeIngredient ||
+		line.ItemType == storage.PurchaseOrderItemTypePackaging
+}
+
+// buildPurchaseOrderMatch compares each line with its receipts and invoice
+// lines. Quantity returned to the supplier is netted off the received
+// quantity. A line's status reports the most serious problem found: a unit
+// mismatch, then a price variance, then a quantity variance. Invoicing less
+// than was received leaves the line awaiting an invoice.
+func buildPurchaseOrderMatch(
+	order storage.PurchaseOrder,
+	lines []storage.PurchaseOrderLine,
+	invoiceLines []storage.SupplierInvoiceLine,
+	received map[int64]receivedQuantity,
+	settings storage.InvoiceMatchSettings,
+) dto.PurchaseOrderMatchResponse {
+	invoicedByLine := make(map[int64][]storage.SupplierInvoiceLine)
+	for _, il := range invoiceLines {
+		invoicedByLine[il.PurchaseOrderLineID] = append(invoicedByLine[il.PurchaseOrderLineID], il)
+	}
+
+	resp := dto.PurchaseOrderMatchResponse{
+		PurchaseOrderUUID:        order.UUID.String(),
+		OrderNumber:              order.OrderNumber,
+		Status:                   order.Status,
+		QuantityTolerancePercent: settings.QuantityTolerancePercent,
+		PriceTolerancePercent:    settings.PriceTolerancePercent,
+		Lines:                    make([]dto.PurchaseOrderLineMatch, 0, len(lines)),
+		FullyMatched:             true,
+	}
+
+	priceTolerance := settings.PriceTolerancePercent / 100
+	for _, line := range lines {
+		rq := received[line.ID]
+		match := dto.PurchaseOrderLineMatch{
+			PurchaseOrderLineUUID: line.UUID.String(),
+			LineNumber:            line.LineNumber,
+			ItemName:              line.ItemName,
+			QuantityUnit:          line.QuantityUnit,
+			Currency:              line.Currency,
+			UnitCostCents:         line.UnitCostCents,
+			OrderedQuantity:       line.Quantity,
+			ReceivedQuantity:      max(rq.quantity-line.ReturnedQuantity, 0),
+			ReturnedQuantity:      line.ReturnedQuantity,
+			Invoices:              make([]dto.InvoiceLineMatch, 0, len(invoicedByLine[line.ID])),
+		}
+
+		unitMismatch := rq.unitMismatch
+		for _, il := range invoicedByLine[line.ID] {
+			if il.QuantityUnit != line.QuantityUnit {
+				unitMismatch = true
+			} else {
+				match.InvoicedQuantity += il.Quantity
+			}
+
+			diff := il.UnitPriceCents - line.UnitCostCents
+			withinTolerance := il.Currency == line.Currency &&
+				math.Abs(float64(diff)) <= float64(line.UnitCostCents)*priceTolerance
+			if !withinTolerance {
+				match.PriceVariance = true
+			}
+
+			match.Invoices = append(match.Invoices, dto.InvoiceLineMatch{
+				SupplierInvoiceUUID:     il.SupplierInvoiceUUID,
+				SupplierInvoiceLineUUID: il.UUID.String(),
+				InvoiceNumber:           il.InvoiceNumber,
+				Quantity:                il.Quantity,
+				QuantityUnit:            il.QuantityUnit,
+				UnitPriceCents:          il.UnitPriceCents,
+				Currency:                il.Currency,
+				PriceVarianceCents:      diff,
+				WithinTolerance:         withinTolerance,
+			})
+		}
+		match.QuantityVariance = match.InvoicedQuantity - match.ReceivedQuantity
+
+		quantityTolerance := float64(match.ReceivedQuantity) * settings.QuantityTolerancePercent / 100
+		switch {
+		case unitMismatch:
+			match.Status = dto.MatchStatusUnitMismatch
+		case match.PriceVariance:
+			match.Status = dto.MatchStatusPriceVariance
+		case match.ReceivedQuantity == 0 && match.InvoicedQuantity == 0:
+			match.Status = dto.MatchStatusNotReceived
+		case float64(match.QuantityVariance) > quantityTolerance:
+			match.Status = dto.MatchStatusQuantityVariance
+		case float64(-match.QuantityVariance) > quantityTolerance:
+			match.Status = dto.MatchStatusAwaitingInvoice
+		default:
+			match.Status = dto.MatchStatusMatched
+		}
+
+		if match.Status != dto.MatchStatusMatched && match.Status != dto.MatchStatusNotReceived {
+			resp.FullyMatched = false
+		}
+		resp.Lines = append(resp.Lines, match)
+	}
+
+	return resp
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// InvoiceMatchStore defines the storage methods needed by the invoice match handlers.
+type InvoiceMatchStore interface {
+	GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListSupplierInvoiceLinesByOrderID(context.Context, int64) ([]storage.SupplierInvoiceLine, error)
+	GetInvoiceMatchSettings(context.Context) (storage.InvoiceMatchSettings, error)
+	SetInvoiceMatchSettings(context.Context, storage.InvoiceMatchSettings) (storage.InvoiceMatchSettings, error)
+}
+
+// HandlePurchaseOrderMatch handles [GET /purchase-orders/{uuid}/match].
+func HandlePurchaseOrderMatch(db InvoiceMatchStore, receipts PurchaseOrderReceipts) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		orderUUID := r.PathValue("uuid")
+		if orderUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		order, err := db.GetPurchaseOrderByUUID(r.Context(), orderUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "purchase order not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting purchase order", "error", err)
+			return
+		}
+
+		match, err := matchPurchaseOrder(r.Context(), db, receipts, order)
+		if err != nil {
+			service.InternalError(w, "error matching purchase order", "error", err, "purchase_order_uuid", orderUUID)
+			return
+		}
+
+		service.JSON(w, match)
+	}
+}
+
+// HandleInvoiceMatchSettings handles [GET /invoice-match-settings] and [PUT /invoice-match-settings].
+func HandleInvoiceMatchSettings(db InvoiceMatchStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			settings, err := db.GetInvoiceMatchSettings(r.Context())
+			if err != nil {
+				service.InternalError(w, "error getting invoice match settings", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewInvoiceMatchSettingsResponse(settings))
+		case http.MethodPut:
+			var req dto.UpdateInvoiceMatchSettingsRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			settings, err := db.SetInvoiceMatchSettings(r.Context(), storage.InvoiceMatchSettings{
+				QuantityTolerancePercent: req.QuantityTolerancePercent,
+				PriceTolerancePercent:    req.PriceTolerancePercent,
+			})
+			if err != nil {
+				service.InternalError(w, "error setting invoice match settings", "error", err)
+				return
+			}
+
+			slog.Info("invoice match settings changed",
+				"quantity_tolerance_percent", settings.QuantityTolerancePercent,
+				"price_tolerance_percent", settings.PriceTolerancePercent)
+
+			service.JSON(w, dto.NewInvoiceMatchSettingsResponse(settings))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
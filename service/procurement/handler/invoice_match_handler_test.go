@@ -0,0 +1,288 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// InvoiceMatchStore implements handler.InvoiceMatchStore on top of the
+// purchase order mock.
+type InvoiceMatchStore struct {
+	PurchaseOrderStore
+}
+
+func (s InvoiceMatchStore) SetInvoiceMatchSettings(_ context.Context, settings storage.InvoiceMatchSettings) (storage.InvoiceMatchSettings, error) {
+	return settings, nil
+}
+
+// Receipts implements handler.PurchaseOrderReceipts, keyed by purchase order
+// line UUID.
+type Receipts map[string][]handler.LotReceipt
+
+func (r Receipts) ListPurchaseOrderLineReceipts(_ context.Context, lineUUID string) ([]handler.LotReceipt, error) {
+	return r[lineUUID], nil
+}
+
+func newInvoiceLine(orderLine storage.PurchaseOrderLine, quantity, unitPrice int64, currency string) storage.SupplierInvoiceLine {
+	line := storage.SupplierInvoiceLine{
+		SupplierInvoiceUUID:   uuid.Must(uuid.NewV4()).String(),
+		InvoiceNumber:         "INV-1",
+		Currency:              currency,
+		PurchaseOrderLineID:   orderLine.ID,
+		PurchaseOrderLineUUID: orderLine.UUID.String(),
+		Quantity:              quantity,
+		QuantityUnit:          orderLine.QuantityUnit,
+		UnitPriceCents:        unitPrice,
+	}
+	line.UUID = uuid.Must(uuid.NewV4())
+	return line
+}
+
+func TestHandlePurchaseOrderMatch(t *testing.T) {
+	orderUUID := uuid.Must(uuid.NewV4())
+
+	// A received order of 100 kg malt at $2.00/kg, 50 kg hops at $20.00/kg
+	// and a freight service line.
+	order := storage.PurchaseOrder{OrderNumber: "PO-100", Status: storage.PurchaseOrderStatusReceived}
+	order.ID = 1
+	order.UUID = orderUUID
+	malt := newLandedCostLine(1, 100, "kg", 200, "USD")
+	malt.ItemType = storage.PurchaseOrderItemTypeIngredient
+	hops := newLandedCostLine(2, 50, "kg", 2000, "USD")
+	hops.ItemType = storage.PurchaseOrderItemTypeIngredient
+	freight := newLandedCostLine(3, 1, "each", 5000, "USD")
+	freight.ItemType = storage.PurchaseOrderItemTypeService
+	maltReturned := malt
+	maltReturned.ReturnedQuantity = 10
+
+	// All 100 kg of malt and 45 kg of hops were received.
+	received := Receipts{
+		malt.UUID.String(): {{UUID: "lot-1", ReceivedAmount: 60, ReceivedUnit: "kg"}, {UUID: "lot-2", ReceivedAmount: 40, ReceivedUnit: "kg"}},
+		hops.UUID.String(): {{UUID: "lot-3", ReceivedAmount: 45, ReceivedUnit: "kg"}},
+	}
+
+	tests := []struct {
+		name         string
+		orderLines   []storage.PurchaseOrderLine
+		invoiceLines []storage.SupplierInvoiceLine
+		receipts     Receipts
+		settings     storage.InvoiceMatchSettings
+		validate     func(t *testing.T, resp dto.PurchaseOrderMatchResponse)
+	}{
+		{
+			name:       "flags overbilling and price variance",
+			orderLines: []storage.PurchaseOrderLine{malt, hops, freight},
+			invoiceLines: []storage.SupplierInvoiceLine{
+				newInvoiceLine(malt, 100, 210, "USD"),
+				newInvoiceLine(hops, 50, 2000, "USD"),
+				newInvoiceLine(freight, 1, 5000, "USD"),
+			},
+			receipts: received,
+			validate: func(t *testing.T, resp dto.PurchaseOrderMatchResponse) {
+				if resp.FullyMatched {
+					t.Error("expected order not to be fully matched")
+				}
+				if len(resp.Lines) != 3 {
+					t.Fatalf("expected 3 lines, got %d", len(resp.Lines))
+				}
+				malt, hops, freight := resp.Lines[0], resp.Lines[1], resp.Lines[2]
+				if malt.Status != dto.MatchStatusPriceVariance || malt.Invoices[0].PriceVarianceCents != 10 {
+					t.Errorf("malt: expected price variance of 10, got %s %+v", malt.Status, malt.Invoices)
+				}
+				if hops.Status != dto.MatchStatusQuantityVariance || hops.ReceivedQuantity != 45 || hops.QuantityVariance != 5 {
+					t.Errorf("hops: expected 5 kg overbilled, got %+v", hops)
+				}
+				if freight.Status != dto.MatchStatusMatched || freight.ReceivedQuantity != 1 {
+					t.Errorf("freight: expected service line matched against ordered quantity, got %+v", freight)
+				}
+			},
+		},
+		{
+			name:       "within tolerance",
+			orderLines: []storage.PurchaseOrderLine{malt, hops, freight},
+			invoiceLines: []storage.SupplierInvoiceLine{
+				newInvoiceLine(malt, 100, 210, "USD"),
+				newInvoiceLine(hops, 47, 2000, "USD"),
+				newInvoiceLine(freight, 1, 5000, "USD"),
+			},
+			receipts: received,
+			settings: storage.InvoiceMatchSettings{QuantityTolerancePercent: 5, PriceTolerancePercent: 5},
+			validate: func(t *testing.T, resp dto.PurchaseOrderMatchResponse) {
+				if !resp.FullyMatched {
+					t.Errorf("expected order fully matched within tolerance, got %+v", resp.Lines)
+				}
+			},
+		},
+		{
+			name:       "awaiting invoice and currency mismatch",
+			orderLines: []storage.PurchaseOrderLine{malt, hops, freight},
+			invoiceLines: []storage.SupplierInvoiceLine{
+				newInvoiceLine(malt, 60, 200, "USD"),
+				newInvoiceLine(freight, 1, 5000, "EUR"),
+			},
+			receipts: received,
+			validate: func(t *testing.T, resp dto.PurchaseOrderMatchResponse) {
+				if resp.Lines[0].Status != dto.MatchStatusAwaitingInvoice {
+					t.Errorf("malt: expected awaiting_invoice, got %s", resp.Lines[0].Status)
+				}
+				if resp.Lines[1].Status != dto.MatchStatusAwaitingInvoice || resp.Lines[1].InvoicedQuantity != 0 {
+					t.Errorf("hops: expected awaiting_invoice, got %+v", resp.Lines[1])
+				}
+				if resp.Lines[2].Status != dto.MatchStatusPriceVariance {
+					t.Errorf("freight: expected price_variance for another currency, got %s", resp.Lines[2].Status)
+				}
+			},
+		},
+		{
+			name:       "returns are netted off received",
+			orderLines: []storage.PurchaseOrderLine{maltReturned, hops, freight},
+			invoiceLines: []storage.SupplierInvoiceLine{
+				newInvoiceLine(malt, 90, 200, "USD"),
+				newInvoiceLine(hops, 45, 2000, "USD"),
+				newInvoiceLine(freight, 1, 5000, "USD"),
+			},
+			receipts: received,
+			validate: func(t *testing.T, resp dto.PurchaseOrderMatchResponse) {
+				malt := resp.Lines[0]
+				if malt.ReceivedQuantity != 90 || malt.ReturnedQuantity != 10 || malt.Status != dto.MatchStatusMatched {
+					t.Errorf("malt: expected 90 kg received net of 10 kg returned and matched, got %+v", malt)
+				}
+				if !resp.FullyMatched {
+					t.Errorf("expected order fully matched, got %+v", resp.Lines)
+				}
+			},
+		},
+		{
+			name:       "unit mismatch",
+			orderLines: []storage.PurchaseOrderLine{malt, hops, freight},
+			receipts: Receipts{
+				malt.UUID.String(): {{UUID: "lot-1", ReceivedAmount: 100, ReceivedUnit: "kg"}},
+				hops.UUID.String(): {{UUID: "lot-3", ReceivedAmount: 99, ReceivedUnit: "lb"}},
+			},
+			validate: func(t *testing.T, resp dto.PurchaseOrderMatchResponse) {
+				if resp.Lines[1].Status != dto.MatchStatusUnitMismatch || resp.Lines[1].ReceivedQuantity != 0 {
+					t.Errorf("hops: expected unit_mismatch, got %+v", resp.Lines[1])
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := InvoiceMatchStore{PurchaseOrderStore{
+				GetPurchaseOrderByUUIDFunc: func(context.Context, string) (storage.PurchaseOrder, error) {
+					return order, nil
+				},
+				ListPurchaseOrderLinesByOrderIDsFunc: func(context.Context, []int64) ([]storage.PurchaseOrderLine, error) {
+					return tt.orderLines, nil
+				},
+				ListSupplierInvoiceLinesByOrderIDFunc: func(context.Context, int64) ([]storage.SupplierInvoiceLine, error) {
+					return tt.invoiceLines, nil
+				},
+				InvoiceMatchSettings: tt.settings,
+			}}
+
+			req := httptest.NewRequest(http.MethodGet, "/purchase-orders/"+orderUUID.String()+"/match", nil)
+			req.SetPathValue("uuid", orderUUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandlePurchaseOrderMatch(store, tt.receipts).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var resp dto.PurchaseOrderMatchResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandlePurchaseOrderByUUID_Close(t *testing.T) {
+	orderUUID := uuid.Must(uuid.NewV4())
+
+	// A received order of 100 kg malt, 50 kg hops and freight, of which
+	// 100 kg malt and 45 kg hops arrived.
+	order := storage.PurchaseOrder{OrderNumber: "PO-100", Status: storage.PurchaseOrderStatusReceived}
+	order.ID = 1
+	order.UUID = orderUUID
+	malt := newLandedCostLine(1, 100, "kg", 200, "USD")
+	malt.ItemType = storage.PurchaseOrderItemTypeIngredient
+	hops := newLandedCostLine(2, 50, "kg", 2000, "USD")
+	hops.ItemType = storage.PurchaseOrderItemTypeIngredient
+	freight := newLandedCostLine(3, 1, "each", 5000, "USD")
+	freight.ItemType = storage.PurchaseOrderItemTypeService
+	received := Receipts{
+		malt.UUID.String(): {{UUID: "lot-1", ReceivedAmount: 100, ReceivedUnit: "kg"}},
+		hops.UUID.String(): {{UUID: "lot-3", ReceivedAmount: 45, ReceivedUnit: "kg"}},
+	}
+
+	tests := []struct {
+		name           string
+		invoiceLines   []storage.SupplierInvoiceLine
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "blocked until fully matched",
+			invoiceLines:   []storage.SupplierInvoiceLine{newInvoiceLine(malt, 100, 200, "USD")},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "not fully matched",
+		},
+		{
+			name: "closes a fully matched order",
+			invoiceLines: []storage.SupplierInvoiceLine{
+				newInvoiceLine(malt, 100, 200, "USD"),
+				newInvoiceLine(hops, 45, 2000, "USD"),
+				newInvoiceLine(freight, 1, 5000, "USD"),
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"status":"closed"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := PurchaseOrderStore{
+				GetPurchaseOrderByUUIDFunc: func(context.Context, string) (storage.PurchaseOrder, error) {
+					return order, nil
+				},
+				UpdatePurchaseOrderByUUIDFunc: func(_ context.Context, _ string, update storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error) {
+					updated := order
+					updated.Status = *update.Status
+					return updated, nil
+				},
+				ListPurchaseOrderLinesByOrderIDsFunc: func(context.Context, []int64) ([]storage.PurchaseOrderLine, error) {
+					return []storage.PurchaseOrderLine{malt, hops, freight}, nil
+				},
+				ListSupplierInvoiceLinesByOrderIDFunc: func(context.Context, int64) ([]storage.SupplierInvoiceLine, error) {
+					return tt.invoiceLines, nil
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodPatch, "/purchase-orders/"+orderUUID.String(), strings.NewReader(`{"status":"closed"}`))
+			req.SetPathValue("uuid", orderUUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandlePurchaseOrderByUUID(store, received).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedBody) {
+				t.Errorf("expected body to contain %q, got %s", tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
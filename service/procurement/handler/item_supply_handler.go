@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// ItemSupplyStore defines the storage methods needed by the supply lookup handler.
+type ItemSupplyStore interface {
+	ListOpenPurchaseOrderLinesByItems(context.Context, []string) ([]storage.OpenPurchaseOrderLine, error)
+	ListLatestItemSuppliers(context.Context, []string) ([]storage.ItemSupplier, error)
+	ListSupplierItems(context.Context, storage.SupplierItemFilter) ([]storage.SupplierItem, error)
+}
+
+// HandleItemSupplyLookup handles [POST /purchase-order-lines/supply-lookup].
+// It returns open order lines, the last-used supplier and the supplier
+// catalog entries for each inventory item, which production uses for
+// material requirements planning and inventory for replenishment.
+func HandleItemSupplyLookup(db ItemSupplyStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.ItemSupplyLookupRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		lines, err := db.ListOpenPurchaseOrderLinesByItems(r.Context(), req.InventoryItemUUIDs)
+		if err != nil {
+			service.InternalError(w, "error listing open purchase order lines", "error", err)
+			return
+		}
+
+		suppliers, err := db.ListLatestItemSuppliers(r.Context(), req.InventoryItemUUIDs)
+		if err != nil {
+			service.InternalError(w, "error listing item suppliers", "error", err)
+			return
+		}
+
+		catalog, err := db.ListSupplierItems(r.Context(), storage.SupplierItemFilter{InventoryItemUUIDs: req.InventoryItemUUIDs})
+		if err != nil {
+			service.InternalError(w, "error listing supplier items", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.NewItemSupplyLookupResponse(lines, suppliers, catalog))
+	}
+}
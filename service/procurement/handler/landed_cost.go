@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// gramsPerUnit converts mass quantity units to grams for weight-based fee
+// allocation. Lines in any other unit carry no weight.
+var gramsPerUnit = map[string]float64{
+	"g":  1,
+	"kg": 1000,
+	"oz": 28.349523125,
+	"lb": 453.59237,
+}
+
+// feeAllocation is the result of spreading purchase order fees over lines.
+type feeAllocation struct {
+	// byLine is the total fee share in cents per line ID.
+	byLine map[int64]int64
+	// unallocated is the amount in cents per fee ID that could not be spread
+	// because the order has no line in the fee's currency.
+	unallocated map[int64]int64
+}
+
+// feeAllocationStore is the storage needed to allocate fees over whole orders.
+type feeAllocationStore interface {
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+}
+
+// loadFeeAllocation allocates the fees of the given orders over all of their
+// lines, not just the lines being looked up.
+func loadFeeAllocation(ctx context.Context, db feeAllocationStore, orderIDs []int64) (feeAllocation, error) {
+	lines, err := db.ListPurchaseOrderLinesByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return feeAllocation{}, err
+	}
+	fees, err := db.ListPurchaseOrderFeesByOrderIDs(ctx, orderIDs)
+	if err != nil {
+		return feeAllocation{}, err
+	}
+	return allocateFees(lines, fees), nil
+}
+
+// allocateFees spreads each fee over the lines of its purchase order that
+// share its currency, in proportion to the fee's allocation basis. Shares are
+// rounded with the largest remainder method so that they add up to the fee
+// amount exactly. A weight-based fee falls back to quantity when none of the
+// lines are in a mass unit, and a value-based fee falls back to quantity when
+// every line is free.
+func allocateFees(lines []storage.PurchaseOrderLine, fees []storage.PurchaseOrderFee) feeAllocation {
+	result := feeAllocation{
+		byLine:      make(map[int64]int64, len(lines)),
+		unallocated: make(map[int64]int64),
+	}
+
+	linesByOrder := make(map[int64][]storage.PurchaseOrderLine)
+	for _, line := range lines {
+		linesByOrder[line.PurchaseOrderID] = append(linesByOrder[line.PurchaseOrderID], line)
+	}
+
+	for _, fee := range fees {
+		var eligible []storage.PurchaseOrderLine
+		for _, line := range linesByOrder[fee.PurchaseOrderID] {
+			if line.Currency == fee.Currency {
+				eligible = append(eligible, line)
+			}
+		}
+		if len(eligible) == 0 {
+			result.unallocated[fee.ID] = fee.AmountCents
+			continue
+		}
+
+		weights := allocationWeights(eligible, fee.AllocationBasis)
+		for i, share := range splitByWeights(fee.AmountCents, weights) {
+			result.byLine[eligible[i].ID] += share
+		}
+	}
+
+	return result
+}
+
+func allocationWeights(lines []storage.PurchaseOrderLine, basis string) []float64 {
+	weights := make([]float64, len(lines))
+	var total float64
+	for i, line := range lines {
+		switch basis {
+		case storage.FeeAllocationBasisValue:
+			weights[i] = float64(line.Quantity) * float64(line.UnitCostCents)
+		case storage.FeeAllocationBasisWeight:
+			weights[i] = float64(line.Quantity) * gramsPerUnit[line.QuantityUnit]
+		default:
+			weights[i] = float64(line.Quantity)
+		}
+		total += weights[i]
+	}
+
+	if total == 0 {
+		for i, line := range lines {
+			weights[i] = float64(line.Quantity)
+		}
+	}
+	return weights
+}
+
+// splitByWeights divides amount into integer parts proportional to weights,
+// handing leftover cents to the parts with the largest fractional remainders.
+func splitByWeights(amount int64, weights []float64) []int64 {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+
+	shares := make([]int64, len(weights))
+	remainders := make([]float64, len(weights))
+	var assigned int64
+	for i, w := range weights {
+		exact := float64(amount) * w / total
+		shares[i] = int64(math.Floor(exact))
+		remainders[i] = exact - float64(shares[i])
+		assigned += shares[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; assigned < amount; i++ {
+		shares[order[i%len(order)]]++
+		assigned++
+	}
+
+	return shares
+}
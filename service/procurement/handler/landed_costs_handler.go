@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// LandedCostStore defines the storage methods needed by the landed cost handler.
+type LandedCostStore interface {
+	GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	GetBaseCurrency(context.Context) (string, error)
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+	ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error)
+}
+
+// HandlePurchaseOrderLandedCosts handles [GET /purchase-orders/{uuid}/landed-costs].
+func HandlePurchaseOrderLandedCosts(db LandedCostStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		orderUUID := r.PathValue("uuid")
+		if orderUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		order, err := db.GetPurchaseOrderByUUID(r.Context(), orderUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "purchase order not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting purchase order", "error", err)
+			return
+		}
+
+		orderIDs := []int64{order.ID}
+		lines, err := db.ListPurchaseOrderLinesByOrderIDs(r.Context(), orderIDs)
+		if err != nil {
+			service.InternalError(w, "error listing purchase order lines", "error", err)
+			return
+		}
+		fees, err := db.ListPurchaseOrderFeesByOrderIDs(r.Context(), orderIDs)
+		if err != nil {
+			service.InternalError(w, "error listing purchase order fees", "error", err)
+			return
+		}
+
+		allocation := allocateFees(lines, fees)
+
+		conversions, err := loadOrderConversions(r.Context(), db, orderIDs, orderCurrencies(lines, nil))
+		if err != nil {
+			service.InternalError(w, "error resolving exchange rates", "error", err)
+			return
+		}
+
+		resp := dto.PurchaseOrderLandedCostsResponse{
+			PurchaseOrderUUID: orderUUID,
+			BaseCurrency:      conversions.baseCurrency,
+			Lines:             make([]dto.LandedPurchaseOrderLineResponse, 0, len(lines)),
+			Fees:              make([]dto.AllocatedPurchaseOrderFeeResponse, 0, len(fees)),
+		}
+		for _, line := range lines {
+			landed := dto.NewLandedPurchaseOrderLineResponse(line, allocation.byLine[line.ID])
+			resp.Lines = append(resp.Lines, landed.WithExchangeRate(conversions.baseCurrency, conversions.lookup(line.PurchaseOrderID, line.Currency)))
+		}
+		for _, fee := range fees {
+			unallocated := allocation.unallocated[fee.ID]
+			resp.Fees = append(resp.Fees, dto.AllocatedPurchaseOrderFeeResponse{
+				PurchaseOrderFeeResponse: dto.NewPurchaseOrderFeeResponse(fee),
+				AllocatedCents:           fee.AmountCents - unallocated,
+				UnallocatedCents:         unallocated,
+			})
+		}
+
+		service.JSON(w, resp)
+	}
+}
@@ -0,0 +1,224 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type LandedCostStore struct {
+	lines []storage.PurchaseOrderLine
+	fees  []storage.PurchaseOrderFee
+	CurrencyStore
+}
+
+func (s LandedCostStore) GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error) {
+	order := storage.PurchaseOrder{}
+	order.ID = 1
+	return order, nil
+}
+
+func (s LandedCostStore) ListPurchaseOrderLinesByUUIDs(_ context.Context, uuids []string) ([]storage.PurchaseOrderLine, error) {
+	var lines []storage.PurchaseOrderLine
+	for _, line := range s.lines {
+		for _, id := range uuids {
+			if line.UUID.String() == id {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines, nil
+}
+
+func (s LandedCostStore) ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error) {
+	return s.lines, nil
+}
+
+func (s LandedCostStore) ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error) {
+	return s.fees, nil
+}
+
+func newLandedCostLine(id int64, quantity int64, unit string, unitCost int64, currency string) storage.PurchaseOrderLine {
+	line := storage.PurchaseOrderLine{
+		PurchaseOrderID: 1,
+		LineNumber:      int(id),
+		Quantity:        quantity,
+		QuantityUnit:    unit,
+		UnitCostCents:   unitCost,
+		Currency:        currency,
+	}
+	line.ID = id
+	line.UUID = uuid.Must(uuid.NewV4())
+	return line
+}
+
+func newLandedCostFee(id int64, amount int64, currency, basis string) storage.PurchaseOrderFee {
+	fee := storage.PurchaseOrderFee{
+		PurchaseOrderID: 1,
+		FeeType:         "freight",
+		AmountCents:     amount,
+		Currency:        currency,
+		AllocationBasis: basis,
+	}
+	fee.ID = id
+	fee.UUID = uuid.Must(uuid.NewV4())
+	return fee
+}
+
+func TestHandlePurchaseOrderLandedCosts(t *testing.T) {
+	// Malt: 1000 kg at 0.50 (value 50000), hops: 20 kg at 20.00 (value 40000),
+	// cans: 10000 ea at 0.10 (value 100000).
+	lines := []storage.PurchaseOrderLine{
+		newLandedCostLine(1, 1000, "kg", 50, "USD"),
+		newLandedCostLine(2, 20, "kg", 2000, "USD"),
+		newLandedCostLine(3, 10000, "ea", 10, "USD"),
+	}
+
+	tests := []struct {
+		name        string
+		fees        []storage.PurchaseOrderFee
+		expected    []int64
+		unallocated int64
+	}{
+		{
+			name:     "value basis",
+			fees:     []storage.PurchaseOrderFee{newLandedCostFee(1, 1900, "USD", storage.FeeAllocationBasisValue)},
+			expected: []int64{500, 400, 1000},
+		},
+		{
+			name:     "quantity basis rounds to the exact fee amount",
+			fees:     []storage.PurchaseOrderFee{newLandedCostFee(1, 1000, "USD", storage.FeeAllocationBasisQuantity)},
+			expected: []int64{91, 2, 907},
+		},
+		{
+			name:     "weight basis skips lines without a mass unit",
+			fees:     []storage.PurchaseOrderFee{newLandedCostFee(1, 1020, "USD", storage.FeeAllocationBasisWeight)},
+			expected: []int64{1000, 20, 0},
+		},
+		{
+			name:        "fee in another currency is left unallocated",
+			fees:        []storage.PurchaseOrderFee{newLandedCostFee(1, 700, "EUR", storage.FeeAllocationBasisValue)},
+			expected:    []int64{0, 0, 0},
+			unallocated: 700,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := LandedCostStore{lines: lines, fees: tc.fees}
+
+			req := httptest.NewRequest(http.MethodGet, "/purchase-orders/x/landed-costs", nil)
+			req.SetPathValue("uuid", uuid.Must(uuid.NewV4()).String())
+			rec := httptest.NewRecorder()
+
+			handler.HandlePurchaseOrderLandedCosts(store).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var resp dto.PurchaseOrderLandedCostsResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+
+			for i, line := range resp.Lines {
+				if line.FeeAllocatedCents != tc.expected[i] {
+					t.Errorf("line %d: expected fee share %d, got %d", i+1, tc.expected[i], line.FeeAllocatedCents)
+				}
+				if line.LandedCostCents != line.Quantity*line.UnitCostCents+line.FeeAllocatedCents {
+					t.Errorf("line %d: landed cost %d does not include fee share", i+1, line.LandedCostCents)
+				}
+			}
+			if resp.Fees[0].UnallocatedCents != tc.unallocated {
+				t.Errorf("expected %d unallocated, got %d", tc.unallocated, resp.Fees[0].UnallocatedCents)
+			}
+		})
+	}
+}
+
+func TestHandleBatchLookupPurchaseOrderLines_LandedCost(t *testing.T) {
+	lines := []storage.PurchaseOrderLine{
+		newLandedCostLine(1, 1000, "kg", 50, "USD"),
+		newLandedCostLine(2, 20, "kg", 2000, "USD"),
+	}
+	store := LandedCostStore{
+		lines: lines,
+		fees:  []storage.PurchaseOrderFee{newLandedCostFee(1, 900, "USD", storage.FeeAllocationBasisValue)},
+	}
+
+	body := `{"uuids":["` + lines[0].UUID.String() + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/purchase-order-lines/batch-lookup", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.HandleBatchLookupPurchaseOrderLines(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp []dto.LandedPurchaseOrderLineResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("expected 1 line, got %d", len(resp))
+	}
+	// The fee is shared with the line that was not looked up: 50000 of 90000.
+	if resp[0].FeeAllocatedCents != 500 || resp[0].LandedCostCents != 50500 || resp[0].LandedUnitCostCents != 51 {
+		t.Errorf("unexpected landed cost %+v", resp[0])
+	}
+}
+
+func TestHandleBatchLookupPurchaseOrderLines_BaseCurrency(t *testing.T) {
+	lines := []storage.PurchaseOrderLine{
+		newLandedCostLine(1, 20, "kg", 2000, "EUR"),
+		newLandedCostLine(2, 10, "kg", 3000, "NZD"),
+	}
+	store := LandedCostStore{
+		lines: lines,
+		fees:  []storage.PurchaseOrderFee{newLandedCostFee(1, 4000, "EUR", storage.FeeAllocationBasisValue)},
+		CurrencyStore: CurrencyStore{
+			rates: []storage.ExchangeRate{newExchangeRate("EUR", "USD", 1.25, "2026-01-15")},
+		},
+	}
+
+	body := `{"uuids":["` + lines[0].UUID.String() + `","` + lines[1].UUID.String() + `"]}`
+	req := httptest.NewRequest(http.MethodPost, "/purchase-order-lines/batch-lookup", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.HandleBatchLookupPurchaseOrderLines(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp []dto.LandedPurchaseOrderLineResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(resp))
+	}
+
+	eur := resp[0]
+	if eur.Currency != "EUR" || eur.LandedCostCents != 44000 || eur.BaseCurrency != "USD" {
+		t.Errorf("unexpected EUR line %+v", eur)
+	}
+	if eur.ExchangeRate == nil || eur.ExchangeRate.Rate != 1.25 || eur.ExchangeRate.Locked {
+		t.Errorf("expected unlocked rate 1.25, got %+v", eur.ExchangeRate)
+	}
+	if eur.BaseLandedCostCents == nil || *eur.BaseLandedCostCents != 55000 {
+		t.Errorf("expected base landed cost 55000, got %v", eur.BaseLandedCostCents)
+	}
+
+	nzd := resp[1]
+	if nzd.ExchangeRate != nil || nzd.BaseLandedCostCents != nil {
+		t.Errorf("expected no conversion without an NZD rate, got %+v", nzd)
+	}
+}
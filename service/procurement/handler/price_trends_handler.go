@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type PriceTrendStore interface {
+	ListPricePointsByInventoryItem(context.Context, string) ([]storage.PricePoint, error)
+}
+
+// HandlePriceTrends handles [GET /price-trends?inventory_item_uuid=...&since=...].
+// It reports catalog and purchase order prices for an inventory item across
+// all suppliers.
+func HandlePriceTrends(db PriceTrendStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		itemUUID := r.URL.Query().Get("inventory_item_uuid")
+		if itemUUID == "" {
+			http.Error(w, "inventory_item_uuid is required", http.StatusBadRequest)
+			return
+		}
+		if _, err := uuid.FromString(itemUUID); err != nil {
+			http.Error(w, "invalid inventory_item_uuid", http.StatusBadRequest)
+			return
+		}
+
+		var since *time.Time
+		if v := r.URL.Query().Get("since"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				http.Error(w, "invalid since: must be RFC3339", http.StatusBadRequest)
+				return
+			}
+			since = &t
+		}
+
+		points, err := db.ListPricePointsByInventoryItem(r.Context(), itemUUID)
+		if err != nil {
+			service.InternalError(w, "error listing price points", "error", err)
+			return
+		}
+
+		service.JSON(w, dto.PriceTrendResponse{
+			InventoryItemUUID: itemUUID,
+			Series:            buildPriceTrendSeries(points, since),
+		})
+	}
+}
+
+// buildPriceTrendSeries groups chronologically ordered price points into one
+// series per supplier, currency, and unit, keeping series in order of first
+// appearance.
+func buildPriceTrendSeries(points []storage.PricePoint, since *time.Time) []dto.PriceTrendSeries {
+	type seriesKey struct {
+		supplierUUID string
+		currency     string
+		unit         string
+	}
+
+	series := []dto.PriceTrendSeries{}
+	index := make(map[seriesKey]int)
+	for _, p := range points {
+		if since != nil && p.At.Before(*since) {
+			continue
+		}
+
+		key := seriesKey{p.SupplierUUID, p.Currency, p.QuantityUnit}
+		i, ok := index[key]
+		if !ok {
+			i = len(series)
+			index[key] = i
+			series = append(series, dto.PriceTrendSeries{
+				SupplierUUID:     p.SupplierUUID,
+				SupplierName:     p.SupplierName,
+				Currency:         p.Currency,
+				QuantityUnit:     p.QuantityUnit,
+				MinUnitCostCents: p.UnitCostCents,
+				MaxUnitCostCents: p.UnitCostCents,
+			})
+		}
+
+		s := &series[i]
+		s.Points = append(s.Points, dto.PriceTrendPoint{
+			At:               p.At,
+			Source:           p.Source,
+			UnitCostCents:    p.UnitCostCents,
+			ItemName:         p.ItemName,
+			SupplierItemUUID: p.SupplierItemUUID,
+			SKU:              p.SKU,
+			OrderNumber:      p.OrderNumber,
+		})
+		s.LatestUnitCostCents = p.UnitCostCents
+		s.MinUnitCostCents = min(s.MinUnitCostCents, p.UnitCostCents)
+		s.MaxUnitCostCents = max(s.MaxUnitCostCents, p.UnitCostCents)
+	}
+
+	for i := range series {
+		first := series[i].Points[0].UnitCostCents
+		if first == 0 {
+			continue
+		}
+		change := float64(series[i].LatestUnitCostCents-first) / float64(first) * 100
+		change = math.Round(change*100) / 100
+		series[i].ChangePercent = &change
+	}
+
+	return series
+}
@@ -67,6 +67,10 @@ func HandlePurchaseOrderFees(db PurchaseOrderFeeStore) http.HandlerFunc {
 				FeeType:         req.FeeType,
 				AmountCents:     req.AmountCents,
 				Currency:        req.Currency,
+				AllocationBasis: storage.FeeAllocationBasisValue,
+			}
+			if req.AllocationBasis != nil {
+				fee.AllocationBasis = *req.AllocationBasis
 			}
 
 			created, err := db.CreatePurchaseOrderFee(r.Context(), fee)
@@ -115,9 +119,10 @@ func HandlePurchaseOrderFeeByUUID(db PurchaseOrderFeeStore) http.HandlerFunc {
 			}
 
 			update := storage.PurchaseOrderFeeUpdate{
-				FeeType:     req.FeeType,
-				AmountCents: req.AmountCents,
-				Currency:    req.Currency,
+				FeeType:         req.FeeType,
+				AmountCents:     req.AmountCents,
+				Currency:        req.Currency,
+				AllocationBasis: req.AllocationBasis,
 			}
 
 			fee, err := db.UpdatePurchaseOrderFeeByUUID(r.Context(), feeUUID, update)
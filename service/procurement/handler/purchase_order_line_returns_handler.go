@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// PurchaseOrderLineReturnStore defines the storage methods needed to record
+// returns against purchase order lines.
+type PurchaseOrderLineReturnStore interface {
+	GetPurchaseOrderLineByUUID(context.Context, string) (storage.PurchaseOrderLine, error)
+	GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error)
+	RecordPurchaseOrderLineReturn(context.Context, int64, string, int64) (storage.PurchaseOrderLine, error)
+}
+
+// HandlePurchaseOrderLineReturns handles [POST /purchase-order-lines/{uuid}/returns].
+// Inventory calls it when goods received against the line are returned to
+// the supplier. The returned quantity is netted off the received quantity in
+// the three-way match, and a received order goes back to partially received.
+// Each return_uuid is recorded once, so Inventory can retry a return safely.
+func HandlePurchaseOrderLineReturns(db PurchaseOrderLineReturnStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		lineUUID := r.PathValue("uuid")
+		if lineUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		var req dto.RecordPurchaseOrderLineReturnRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		line, err := db.GetPurchaseOrderLineByUUID(r.Context(), lineUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "purchase order line not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting purchase order line", "error", err)
+			return
+		}
+		if strings.TrimSpace(req.QuantityUnit) != line.QuantityUnit {
+			http.Error(w, "quantity_unit must match the purchase order line unit ("+line.QuantityUnit+")", http.StatusConflict)
+			return
+		}
+
+		if line.PurchaseOrderUUID != nil {
+			order, err := db.GetPurchaseOrderByUUID(r.Context(), *line.PurchaseOrderUUID)
+			if err != nil {
+				service.InternalError(w, "error getting purchase order", "error", err)
+				return
+			}
+			switch order.Status {
+			case storage.PurchaseOrderStatusClosed, storage.PurchaseOrderStatusCancelled:
+				http.Error(w, "cannot record a return against a "+order.Status+" purchase order", http.StatusConflict)
+				return
+			}
+		}
+
+		updated, err := db.RecordPurchaseOrderLineReturn(r.Context(), line.ID, req.ReturnUUID, req.Quantity)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "purchase order line not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error recording purchase order line return", "error", err)
+			return
+		}
+
+		slog.Info("purchase order line return recorded",
+			"purchase_order_line_uuid", lineUUID,
+			"return_uuid", req.ReturnUUID,
+			"quantity", req.Quantity,
+			"returned_quantity", updated.ReturnedQuantity)
+
+		service.JSON(w, dto.NewPurchaseOrderLineResponse(updated))
+	}
+}
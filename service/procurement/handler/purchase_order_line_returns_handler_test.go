@@ -0,0 +1,138 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockLineReturnStore implements handler.PurchaseOrderLineReturnStore for
+// testing. Like storage, it records each return UUID once.
+type mockLineReturnStore struct {
+	line     storage.PurchaseOrderLine
+	order    storage.PurchaseOrder
+	recorded map[string]int64
+}
+
+func (m *mockLineReturnStore) GetPurchaseOrderLineByUUID(context.Context, string) (storage.PurchaseOrderLine, error) {
+	return m.line, nil
+}
+
+func (m *mockLineReturnStore) GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error) {
+	return m.order, nil
+}
+
+func (m *mockLineReturnStore) RecordPurchaseOrderLineReturn(_ context.Context, _ int64, returnUUID string, quantity int64) (storage.PurchaseOrderLine, error) {
+	if m.recorded == nil {
+		m.recorded = make(map[string]int64)
+	}
+	if _, ok := m.recorded[returnUUID]; !ok {
+		m.recorded[returnUUID] = quantity
+		m.line.ReturnedQuantity += quantity
+	}
+	return m.line, nil
+}
+
+func TestHandlePurchaseOrderLineReturns(t *testing.T) {
+	returnUUID := "990e8400-e29b-41d4-a716-446655440001"
+
+	order := storage.PurchaseOrder{Status: storage.PurchaseOrderStatusReceived}
+	order.UUID = uuid.Must(uuid.FromString("990e8400-e29b-41d4-a716-446655440000"))
+	orderUUID := order.UUID.String()
+	closed := order
+	closed.Status = storage.PurchaseOrderStatusClosed
+
+	// 100 kg ordered, 5 kg already returned.
+	line := newLandedCostLine(1, 100, "kg", 200, "USD")
+	line.PurchaseOrderUUID = &orderUUID
+	line.ReturnedQuantity = 5
+
+	tests := []struct {
+		name             string
+		order            storage.PurchaseOrder
+		recorded         map[string]int64
+		body             string
+		expectedStatus   int
+		expectedBody     string
+		expectedRecorded map[string]int64
+	}{
+		{
+			name:             "records return",
+			order:            order,
+			body:             `{"return_uuid":"` + returnUUID + `","quantity":20,"quantity_unit":"kg"}`,
+			expectedStatus:   http.StatusOK,
+			expectedBody:     `"returned_quantity":25`,
+			expectedRecorded: map[string]int64{returnUUID: 20},
+		},
+		{
+			name:             "return sent again is recorded once",
+			order:            order,
+			recorded:         map[string]int64{returnUUID: 20},
+			body:             `{"return_uuid":"` + returnUUID + `","quantity":20,"quantity_unit":"kg"}`,
+			expectedStatus:   http.StatusOK,
+			expectedBody:     `"returned_quantity":5`,
+			expectedRecorded: map[string]int64{returnUUID: 20},
+		},
+		{
+			name:           "unit mismatch",
+			order:          order,
+			body:           `{"return_uuid":"` + returnUUID + `","quantity":20,"quantity_unit":"lb"}`,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "quantity_unit must match",
+		},
+		{
+			name:           "closed order",
+			order:          closed,
+			body:           `{"return_uuid":"` + returnUUID + `","quantity":20,"quantity_unit":"kg"}`,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "closed purchase order",
+		},
+		{
+			name:           "invalid quantity",
+			order:          order,
+			body:           `{"return_uuid":"` + returnUUID + `","quantity":0,"quantity_unit":"kg"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "quantity must be greater than zero",
+		},
+		{
+			name:           "missing return uuid",
+			order:          order,
+			body:           `{"quantity":20,"quantity_unit":"kg"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "return_uuid is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockLineReturnStore{line: line, order: tt.order, recorded: tt.recorded}
+
+			req := httptest.NewRequest(http.MethodPost, "/purchase-order-lines/"+line.UUID.String()+"/returns", strings.NewReader(tt.body))
+			req.SetPathValue("uuid", line.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandlePurchaseOrderLineReturns(store).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedBody) {
+				t.Errorf("expected body to contain %q, got %s", tt.expectedBody, rec.Body.String())
+			}
+			if len(store.recorded) != len(tt.expectedRecorded) {
+				t.Fatalf("expected recorded returns %v, got %v", tt.expectedRecorded, store.recorded)
+			}
+			for id, quantity := range tt.expectedRecorded {
+				if store.recorded[id] != quantity {
+					t.Errorf("expected %s recorded with %d, got %d", id, quantity, store.recorded[id])
+				}
+			}
+		})
+	}
+}
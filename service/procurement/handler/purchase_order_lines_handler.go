@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
@@ -20,6 +21,8 @@ type PurchaseOrderLineStore interface {
 	CreatePurchaseOrderLine(context.Context, storage.PurchaseOrderLine) (storage.PurchaseOrderLine, error)
 	UpdatePurchaseOrderLineByUUID(context.Context, string, storage.PurchaseOrderLineUpdate) (storage.PurchaseOrderLine, error)
 	DeletePurchaseOrderLineByUUID(context.Context, string) (storage.PurchaseOrderLine, error)
+	GetSupplierItemByUUID(context.Context, string) (storage.SupplierItem, error)
+	GetSupplierItemPriceAt(context.Context, int64, time.Time) (storage.SupplierItemPrice, error)
 }
 
 // HandlePurchaseOrderLines handles [GET /purchase-order-lines] and [POST /purchase-order-lines].
@@ -77,9 +80,17 @@ func HandlePurchaseOrderLines(db PurchaseOrderLineStore) http.HandlerFunc {
 				InventoryItemUUID: inventoryItemUUID,
 				Quantity:          req.Quantity,
 				QuantityUnit:      req.QuantityUnit,
-				UnitCostCents:     req.UnitCostCents,
 				Currency:          req.Currency,
 			}
+			if req.UnitCostCents != nil {
+				line.UnitCostCents = *req.UnitCostCents
+			}
+
+			if req.SupplierItemUUID != nil {
+				if !applySupplierItemDefaults(r.Context(), w, db, order, *req.SupplierItemUUID, req.UnitCostCents != nil, &line) {
+					return
+				}
+			}
 
 			created, err := db.CreatePurchaseOrderLine(r.Context(), line)
 			if err != nil {
@@ -173,3 +184,55 @@ func HandlePurchaseOrderLineByUUID(db PurchaseOrderLineStore) http.HandlerFunc {
 		}
 	}
 }
+
+// applySupplierItemDefaults fills in the fields of a new line that were left
+// empty from a supplier catalog item and the catalog price in effect on the
+// order date. It writes an error response and returns false if the catalog
+// item cannot be used for the order.
+func applySupplierItemDefaults(ctx context.Context, w http.ResponseWriter, db PurchaseOrderLineStore, order storage.PurchaseOrder, supplierItemUUID string, hasUnitCost bool, line *storage.PurchaseOrderLine) bool {
+	item, ok := service.ResolveFK(ctx, w, supplierItemUUID, "supplier item", db.GetSupplierItemByUUID)
+	if !ok {
+		return false
+	}
+	if item.SupplierID != order.SupplierID {
+		http.Error(w, "supplier item does not belong to the purchase order's supplier", http.StatusBadRequest)
+		return false
+	}
+
+	if line.ItemType == "" {
+		line.ItemType = item.ItemType
+	}
+	if line.ItemName == "" {
+		line.ItemName = item.Name
+	}
+	if line.InventoryItemUUID == nil {
+		line.InventoryItemUUID = item.InventoryItemUUID
+	}
+	if line.QuantityUnit == "" {
+		line.QuantityUnit = item.PackUnit
+	}
+	if hasUnitCost && line.Currency != "" {
+		return true
+	}
+
+	priceAt := time.Now().UTC()
+	if order.OrderedAt != nil {
+		priceAt = *order.OrderedAt
+	}
+	price, err := db.GetSupplierItemPriceAt(ctx, item.ID, priceAt)
+	if errors.Is(err, service.ErrNotFound) {
+		http.Error(w, "supplier item has no price on the order date; unit_cost_cents and currency are required", http.StatusBadRequest)
+		return false
+	} else if err != nil {
+		service.InternalError(w, "error getting supplier item price", "error", err)
+		return false
+	}
+
+	if !hasUnitCost {
+		line.UnitCostCents = price.UnitCostCents
+	}
+	if line.Currency == "" {
+		line.Currency = price.Currency
+	}
+	return true
+}
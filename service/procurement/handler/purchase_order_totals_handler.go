@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+// PurchaseOrderTotalsStore defines the storage methods needed by the purchase
+// order totals handler.
+type PurchaseOrderTotalsStore interface {
+	GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	GetBaseCurrency(context.Context) (string, error)
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+	ListPurchaseOrderExchangeRates(context.Context, []int64) ([]storage.PurchaseOrderExchangeRate, error)
+}
+
+// HandlePurchaseOrderTotals handles [GET /purchase-orders/{uuid}/totals].
+// Totals are reported per original currency and, when every currency has a
+// rate, in the base currency.
+func HandlePurchaseOrderTotals(db PurchaseOrderTotalsStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		orderUUID := r.PathValue("uuid")
+		if orderUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		order, err := db.GetPurchaseOrderByUUID(r.Context(), orderUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "purchase order not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting purchase order", "error", err)
+			return
+		}
+
+		orderIDs := []int64{order.ID}
+		lines, err := db.ListPurchaseOrderLinesByOrderIDs(r.Context(), orderIDs)
+		if err != nil {
+			service.InternalError(w, "error listing purchase order lines", "error", err)
+			return
+		}
+		fees, err := db.ListPurchaseOrderFeesByOrderIDs(r.Context(), orderIDs)
+		if err != nil {
+			service.InternalError(w, "error listing purchase order fees", "error", err)
+			return
+		}
+
+		currencies := orderCurrencies(lines, fees)
+		conversions, err := loadOrderConversions(r.Context(), db, orderIDs, currencies)
+		if err != nil {
+			service.InternalError(w, "error resolving exchange rates", "error", err)
+			return
+		}
+
+		totals := make(map[string]*dto.PurchaseOrderCurrencyTotal, len(currencies))
+		resp := dto.PurchaseOrderTotalsResponse{
+			PurchaseOrderUUID:   orderUUID,
+			BaseCurrency:        conversions.baseCurrency,
+			Currencies:          make([]dto.PurchaseOrderCurrencyTotal, len(currencies)),
+			ExchangeRatesLocked: true,
+		}
+		for i, currency := range currencies {
+			resp.Currencies[i].Currency = currency
+			totals[currency] = &resp.Currencies[i]
+		}
+		for _, line := range lines {
+			totals[line.Currency].LineTotalCents += line.Quantity * line.UnitCostCents
+		}
+		for _, fee := range fees {
+			totals[fee.Currency].FeeTotalCents += fee.AmountCents
+		}
+
+		var baseLineTotal, baseFeeTotal int64
+		converted := true
+		for i := range resp.Currencies {
+			total := &resp.Currencies[i]
+			total.TotalCents = total.LineTotalCents + total.FeeTotalCents
+
+			rate := conversions.lookup(order.ID, total.Currency)
+			if rate == nil {
+				converted = false
+				resp.ExchangeRatesLocked = false
+				continue
+			}
+			if !rate.Locked {
+				resp.ExchangeRatesLocked = false
+			}
+			total.ExchangeRate = rate
+			baseLines := dto.ConvertCents(total.LineTotalCents, rate.Rate)
+			baseFees := dto.ConvertCents(total.FeeTotalCents, rate.Rate)
+			baseTotal := baseLines + baseFees
+			total.BaseTotalCents = &baseTotal
+			baseLineTotal += baseLines
+			baseFeeTotal += baseFees
+		}
+
+		if converted {
+			baseTotal := baseLineTotal + baseFeeTotal
+			resp.BaseLineTotalCents = &baseLineTotal
+			resp.BaseFeeTotalCents = &baseFeeTotal
+			resp.BaseTotalCents = &baseTotal
+		}
+
+		service.JSON(w, resp)
+	}
+}
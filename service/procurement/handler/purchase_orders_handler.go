@@ -6,7 +6,9 @@ import (
 	"errors"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/brewpipes/brewpipes/internal/telemetry"
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
 	"github.com/brewpipes/brewpipes/service/procurement/storage"
@@ -19,6 +21,14 @@ type PurchaseOrderStore interface {
 	GetSupplierByUUID(context.Context, string) (storage.Supplier, error)
 	CreatePurchaseOrder(context.Context, storage.PurchaseOrder) (storage.PurchaseOrder, error)
 	UpdatePurchaseOrderByUUID(context.Context, string, storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error)
+	GetBaseCurrency(context.Context) (string, error)
+	GetExchangeRateAt(context.Context, string, string, time.Time) (storage.ExchangeRate, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error)
+	LockPurchaseOrderExchangeRates(context.Context, []storage.PurchaseOrderExchangeRate) error
+	ListSupplierInvoiceLinesByOrderID(context.Context, int64) ([]storage.SupplierInvoiceLine, error)
+	GetInvoiceMatchSettings(context.Context) (storage.InvoiceMatchSettings, error)
+	RunInTx(context.Context, func(context.Context) error) error
 }
 
 // HandlePurchaseOrders handles [GET /purchase-orders] and [POST /purchase-orders].
@@ -91,7 +101,11 @@ func HandlePurchaseOrders(db PurchaseOrderStore) http.HandlerFunc {
 }
 
 // HandlePurchaseOrderByUUID handles [GET /purchase-orders/{uuid}] and [PATCH /purchase-orders/{uuid}].
-func HandlePurchaseOrderByUUID(db PurchaseOrderStore) http.HandlerFunc {
+// Receiving an order locks the exchange rates of its foreign currencies; the
+// status change is rejected if a rate is missing. Fully receiving an order
+// also records the date it is journaled on. Closing an order is
+// rejected unless it is fully matched against its receipts and invoices.
+func HandlePurchaseOrderByUUID(db PurchaseOrderStore, receipts PurchaseOrderReceipts) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		orderUUID := r.PathValue("uuid")
 		if orderUUID == "" {
@@ -133,6 +147,10 @@ func HandlePurchaseOrderByUUID(db PurchaseOrderStore) http.HandlerFunc {
 				value := strings.TrimSpace(*update.OrderNumber)
 				update.OrderNumber = &value
 			}
+			// A status change into receipt locks the order's exchange rates
+			// as of the receipt date.
+			var lockOrderID int64
+			var lockAt *time.Time
 			if update.Status != nil {
 				value := strings.TrimSpace(*update.Status)
 				update.Status = &value
@@ -151,16 +169,60 @@ func HandlePurchaseOrderByUUID(db PurchaseOrderStore) http.HandlerFunc {
 					http.Error(w, err.Error(), http.StatusConflict)
 					return
 				}
+
+				if *update.Status != currentOrder.Status && *update.Status == storage.PurchaseOrderStatusClosed {
+					match, err := matchPurchaseOrder(r.Context(), db, receipts, currentOrder)
+					if err != nil {
+						service.InternalError(w, "error matching purchase order", "error", err, "purchase_order_uuid", orderUUID)
+						return
+					}
+					if !match.FullyMatched {
+						http.Error(w, "cannot close purchase order: not fully matched against receipts and invoices", http.StatusConflict)
+						return
+					}
+				}
+
+				if *update.Status != currentOrder.Status &&
+					(*update.Status == storage.PurchaseOrderStatusPartiallyReceived || *update.Status == storage.PurchaseOrderStatusReceived) {
+					receivedAt := time.Now().UTC()
+					if req.ReceivedAt != nil {
+						receivedAt = req.ReceivedAt.UTC()
+					}
+					lockOrderID = currentOrder.ID
+					lockAt = &receivedAt
+					if *update.Status == storage.PurchaseOrderStatusReceived {
+						update.ReceivedAt = &receivedAt
+					}
+				}
 			}
 
-			order, err := db.UpdatePurchaseOrderByUUID(r.Context(), orderUUID, update)
-			if errors.Is(err, service.ErrNotFound) {
+			// The rate locks and the status change commit together, so a failed
+			// update never leaves an unreceived order with locked rates.
+			var order storage.PurchaseOrder
+			err := db.RunInTx(r.Context(), func(ctx context.Context) error {
+				if lockAt != nil {
+					if err := lockReceiptExchangeRates(ctx, db, lockOrderID, *lockAt); err != nil {
+						return err
+					}
+				}
+				var err error
+				order, err = db.UpdatePurchaseOrderByUUID(ctx, orderUUID, update)
+				return err
+			})
+			var missing missingExchangeRateError
+			if errors.As(err, &missing) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if errors.Is(err, service.ErrNotFound) {
 				http.Error(w, "purchase order not found", http.StatusNotFound)
 				return
 			} else if err != nil {
 				service.InternalError(w, "error updating purchase order", "error", err)
 				return
 			}
+			if update.ReceivedAt != nil {
+				telemetry.PurchaseOrderReceived()
+			}
 
 			service.JSON(w, dto.NewPurchaseOrderResponse(order))
 		default:
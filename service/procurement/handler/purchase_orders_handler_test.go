@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/brewpipes/brewpipes/service/procurement/handler"
 	"github.com/brewpipes/brewpipes/service/procurement/storage"
@@ -14,12 +17,16 @@ import (
 )
 
 type PurchaseOrderStore struct {
-	ListPurchaseOrdersFunc               func(context.Context) ([]storage.PurchaseOrder, error)
-	ListPurchaseOrdersBySupplierUUIDFunc func(context.Context, string) ([]storage.PurchaseOrder, error)
-	GetPurchaseOrderByUUIDFunc           func(context.Context, string) (storage.PurchaseOrder, error)
-	GetSupplierByUUIDFunc                func(context.Context, string) (storage.Supplier, error)
-	CreatePurchaseOrderFunc              func(context.Context, storage.PurchaseOrder) (storage.PurchaseOrder, error)
-	UpdatePurchaseOrderByUUIDFunc        func(context.Context, string, storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error)
+	ListPurchaseOrdersFunc                func(context.Context) ([]storage.PurchaseOrder, error)
+	ListPurchaseOrdersBySupplierUUIDFunc  func(context.Context, string) ([]storage.PurchaseOrder, error)
+	GetPurchaseOrderByUUIDFunc            func(context.Context, string) (storage.PurchaseOrder, error)
+	GetSupplierByUUIDFunc                 func(context.Context, string) (storage.Supplier, error)
+	CreatePurchaseOrderFunc               func(context.Context, storage.PurchaseOrder) (storage.PurchaseOrder, error)
+	UpdatePurchaseOrderByUUIDFunc         func(context.Context, string, storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDsFunc  func(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	ListSupplierInvoiceLinesByOrderIDFunc func(context.Context, int64) ([]storage.SupplierInvoiceLine, error)
+	InvoiceMatchSettings                  storage.InvoiceMatchSettings
+	CurrencyStore                         *CurrencyStore
 }
 
 func (s PurchaseOrderStore) ListPurchaseOrders(ctx context.Context) ([]storage.PurchaseOrder, error) {
@@ -64,6 +71,59 @@ func (s PurchaseOrderStore) UpdatePurchaseOrderByUUID(ctx context.Context, order
 	return s.UpdatePurchaseOrderByUUIDFunc(ctx, orderUUID, update)
 }
 
+func (s PurchaseOrderStore) currencies() *CurrencyStore {
+	if s.CurrencyStore == nil {
+		return &CurrencyStore{}
+	}
+	return s.CurrencyStore
+}
+
+func (s PurchaseOrderStore) GetBaseCurrency(ctx context.Context) (string, error) {
+	return s.currencies().GetBaseCurrency(ctx)
+}
+
+func (s PurchaseOrderStore) GetExchangeRateAt(ctx context.Context, from, to string, at time.Time) (storage.ExchangeRate, error) {
+	return s.currencies().GetExchangeRateAt(ctx, from, to, at)
+}
+
+func (s PurchaseOrderStore) LockPurchaseOrderExchangeRates(ctx context.Context, rates []storage.PurchaseOrderExchangeRate) error {
+	return s.currencies().LockPurchaseOrderExchangeRates(ctx, rates)
+}
+
+func (s PurchaseOrderStore) ListPurchaseOrderLinesByOrderIDs(ctx context.Context, orderIDs []int64) ([]storage.PurchaseOrderLine, error) {
+	if s.ListPurchaseOrderLinesByOrderIDsFunc == nil {
+		return nil, nil
+	}
+	return s.ListPurchaseOrderLinesByOrderIDsFunc(ctx, orderIDs)
+}
+
+func (s PurchaseOrderStore) ListPurchaseOrderFeesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderFee, error) {
+	return nil, nil
+}
+
+func (s PurchaseOrderStore) ListSupplierInvoiceLinesByOrderID(ctx context.Context, orderID int64) ([]storage.SupplierInvoiceLine, error) {
+	if s.ListSupplierInvoiceLinesByOrderIDFunc == nil {
+		return nil, nil
+	}
+	return s.ListSupplierInvoiceLinesByOrderIDFunc(ctx, orderID)
+}
+
+func (s PurchaseOrderStore) GetInvoiceMatchSettings(context.Context) (storage.InvoiceMatchSettings, error) {
+	return s.InvoiceMatchSettings, nil
+}
+
+// RunInTx discards the exchange rates fn locked when it fails, the way a
+// rolled back transaction would.
+func (s PurchaseOrderStore) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	currencies := s.currencies()
+	locked := len(currencies.locked)
+	if err := fn(ctx); err != nil {
+		currencies.locked = currencies.locked[:locked]
+		return err
+	}
+	return nil
+}
+
 func TestHandlePurchaseOrderByUUID_StatusTransition(t *testing.T) {
 	testUUID := uuid.Must(uuid.NewV4())
 
@@ -157,7 +217,7 @@ func TestHandlePurchaseOrderByUUID_StatusTransition(t *testing.T) {
 				},
 			}
 
-			h := handler.HandlePurchaseOrderByUUID(store)
+			h := handler.HandlePurchaseOrderByUUID(store, nil)
 
 			body, _ := json.Marshal(map[string]string{"status": tt.newStatus})
 			req := httptest.NewRequest(http.MethodPatch, "/purchase-orders/"+testUUID.String(), bytes.NewReader(body))
@@ -196,7 +256,7 @@ func TestHandlePurchaseOrderByUUID_NoStatusChange(t *testing.T) {
 		},
 	}
 
-	h := handler.HandlePurchaseOrderByUUID(store)
+	h := handler.HandlePurchaseOrderByUUID(store, nil)
 
 	// Update only order_number, not status
 	body, _ := json.Marshal(map[string]string{"order_number": "PO-002"})
@@ -228,7 +288,7 @@ func TestHandlePurchaseOrderByUUID_SameStatus(t *testing.T) {
 		},
 	}
 
-	h := handler.HandlePurchaseOrderByUUID(store)
+	h := handler.HandlePurchaseOrderByUUID(store, nil)
 
 	// Update to same status (no-op)
 	body, _ := json.Marshal(map[string]string{"status": storage.PurchaseOrderStatusReceived})
@@ -242,3 +302,105 @@ func TestHandlePurchaseOrderByUUID_SameStatus(t *testing.T) {
 		t.Errorf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
 	}
 }
+
+func TestHandlePurchaseOrderByUUID_ReceiptLocksExchangeRates(t *testing.T) {
+	testUUID := uuid.Must(uuid.NewV4())
+
+	newStore := func(currencies *CurrencyStore) (PurchaseOrderStore, **storage.PurchaseOrderUpdate) {
+		var updated *storage.PurchaseOrderUpdate
+		return PurchaseOrderStore{
+			GetPurchaseOrderByUUIDFunc: func(ctx context.Context, orderUUID string) (storage.PurchaseOrder, error) {
+				order := storage.PurchaseOrder{Status: storage.PurchaseOrderStatusConfirmed}
+				order.ID = 1
+				return order, nil
+			},
+			UpdatePurchaseOrderByUUIDFunc: func(ctx context.Context, orderUUID string, update storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error) {
+				updated = &update
+				return storage.PurchaseOrder{Status: *update.Status}, nil
+			},
+			ListPurchaseOrderLinesByOrderIDsFunc: func(context.Context, []int64) ([]storage.PurchaseOrderLine, error) {
+				return []storage.PurchaseOrderLine{
+					newLandedCostLine(1, 10, "kg", 2500, "EUR"),
+					newLandedCostLine(2, 10, "kg", 100, "USD"),
+				}, nil
+			},
+			CurrencyStore: currencies,
+		}, &updated
+	}
+
+	receive := func(store PurchaseOrderStore) *httptest.ResponseRecorder {
+		body := `{"status":"received","received_at":"2026-02-12T15:04:05Z"}`
+		req := httptest.NewRequest(http.MethodPatch, "/purchase-orders/"+testUUID.String(), strings.NewReader(body))
+		req.SetPathValue("uuid", testUUID.String())
+		rec := httptest.NewRecorder()
+		handler.HandlePurchaseOrderByUUID(store, nil).ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("locks the rate in effect on the receipt date", func(t *testing.T) {
+		currencies := &CurrencyStore{
+			rates: []storage.ExchangeRate{
+				newExchangeRate("EUR", "USD", 1.08, "2026-02-10"),
+				newExchangeRate("EUR", "USD", 1.11, "2026-02-13"),
+			},
+		}
+		store, updated := newStore(currencies)
+
+		rec := receive(store)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if *updated == nil {
+			t.Fatal("expected purchase order to be updated")
+		}
+		if receivedAt := (*updated).ReceivedAt; receivedAt == nil || receivedAt.Format(time.RFC3339) != "2026-02-12T15:04:05Z" {
+			t.Errorf("expected the receipt date to be recorded, got %v", receivedAt)
+		}
+		if len(currencies.locked) != 1 {
+			t.Fatalf("expected 1 locked rate, got %d", len(currencies.locked))
+		}
+		locked := currencies.locked[0]
+		if locked.PurchaseOrderID != 1 || locked.Currency != "EUR" || locked.BaseCurrency != "USD" || locked.Rate != 1.08 {
+			t.Errorf("unexpected locked rate %+v", locked)
+		}
+		if locked.RateDate.Format(time.DateOnly) != "2026-02-12" {
+			t.Errorf("expected rate date 2026-02-12, got %s", locked.RateDate.Format(time.DateOnly))
+		}
+	})
+
+	t.Run("rejects the receipt when a rate is missing", func(t *testing.T) {
+		currencies := &CurrencyStore{}
+		store, updated := newStore(currencies)
+
+		rec := receive(store)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if !strings.Contains(rec.Body.String(), "no exchange rate from EUR to USD on or before 2026-02-12") {
+			t.Errorf("unexpected error %q", rec.Body.String())
+		}
+		if *updated != nil || len(currencies.locked) != 0 {
+			t.Error("expected nothing to change")
+		}
+	})
+	t.Run("releases the locks when the update fails", func(t *testing.T) {
+		currencies := &CurrencyStore{
+			rates: []storage.ExchangeRate{newExchangeRate("EUR", "USD", 1.08, "2026-02-10")},
+		}
+		store, _ := newStore(currencies)
+		store.UpdatePurchaseOrderByUUIDFunc = func(context.Context, string, storage.PurchaseOrderUpdate) (storage.PurchaseOrder, error) {
+			return storage.PurchaseOrder{}, errors.New("connection reset")
+		}
+
+		rec := receive(store)
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status 500, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if len(currencies.locked) != 0 {
+			t.Errorf("expected no locked rates, got %d", len(currencies.locked))
+		}
+	})
+}
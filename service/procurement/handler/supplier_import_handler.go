@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+)
+
+type SupplierImportStore interface {
+	ListSuppliers(context.Context) ([]storage.Supplier, error)
+	CreateSupplier(context.Context, storage.Supplier) (storage.Supplier, error)
+	RunInTx(context.Context, func(context.Context) error) error
+}
+
+// HandleSupplierImport handles [POST /suppliers/import]. The upload is a CSV
+// file with a name column and optional contact_name, email, phone,
+// address_line1, address_line2, city, region, postal_code and country
+// columns. Names already in use, here or earlier in the file, are rejected.
+// With dry_run=true rows are created in a transaction that is rolled back.
+func HandleSupplierImport(db SupplierImportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		existing, err := db.ListSuppliers(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing suppliers", "error", err)
+			return
+		}
+		names := make(map[string]bool, len(existing))
+		for _, supplier := range existing {
+			names[strings.ToLower(supplier.Name)] = true
+		}
+
+		importer := csvimport.Importer[storage.Supplier]{
+			Name: "supplier",
+			Headers: []string{
+				"name", "contact_name", "email", "phone", "address_line1", "address_line2",
+				"city", "region", "postal_code", "country",
+			},
+			Required: []string{"name"},
+			Parse: func(_ context.Context, row csvimport.Row) (storage.Supplier, error) {
+				req := dto.CreateSupplierRequest{
+					Name:         row.Value("name"),
+					ContactName:  row.Optional("contact_name"),
+					Email:        row.Optional("email"),
+					Phone:        row.Optional("phone"),
+					AddressLine1: row.Optional("address_line1"),
+					AddressLine2: row.Optional("address_line2"),
+					City:         row.Optional("city"),
+					Region:       row.Optional("region"),
+					PostalCode:   row.Optional("postal_code"),
+					Country:      row.Optional("country"),
+				}
+				if err := req.Validate(); err != nil {
+					return storage.Supplier{}, err
+				}
+				key := strings.ToLower(req.Name)
+				if names[key] {
+					return storage.Supplier{}, fmt.Errorf("supplier %q already exists", req.Name)
+				}
+				names[key] = true
+
+				return storage.Supplier{
+					Name:         req.Name,
+					ContactName:  req.ContactName,
+					Email:        req.Email,
+					Phone:        req.Phone,
+					AddressLine1: req.AddressLine1,
+					AddressLine2: req.AddressLine2,
+					City:         req.City,
+					Region:       req.Region,
+					PostalCode:   req.PostalCode,
+					Country:      req.Country,
+				}, nil
+			},
+			Create: func(ctx context.Context, supplier storage.Supplier) (any, error) {
+				created, err := db.CreateSupplier(ctx, supplier)
+				if err != nil {
+					return nil, err
+				}
+				return dto.NewSupplierResponse(created), nil
+			},
+			RunInTx: db.RunInTx,
+		}
+
+		file, ok := csvimport.Read(w, r, importer)
+		if !ok {
+			return
+		}
+
+		result, err := csvimport.Run(r.Context(), importer, file, dryRun)
+		if err != nil {
+			service.InternalError(w, "error importing suppliers", "error", err)
+			return
+		}
+		service.JSON(w, result)
+	}
+}
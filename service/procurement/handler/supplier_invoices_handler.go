@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// SupplierInvoiceStore defines the storage methods needed by the supplier invoice handlers.
+type SupplierInvoiceStore interface {
+	GetPurchaseOrderByUUID(context.Context, string) (storage.PurchaseOrder, error)
+	ListPurchaseOrderLinesByOrderIDs(context.Context, []int64) ([]storage.PurchaseOrderLine, error)
+	CreateSupplierInvoiceWithLines(context.Context, storage.SupplierInvoice, []storage.SupplierInvoiceLine) (storage.SupplierInvoice, []storage.SupplierInvoiceLine, error)
+	GetSupplierInvoiceByUUID(context.Context, string) (storage.SupplierInvoice, error)
+	ListSupplierInvoices(context.Context, storage.SupplierInvoiceFilter) ([]storage.SupplierInvoice, error)
+	ListSupplierInvoiceLinesByInvoiceIDs(context.Context, []int64) ([]storage.SupplierInvoiceLine, error)
+	DeleteSupplierInvoice(context.Context, int64) error
+}
+
+// HandleSupplierInvoices handles [GET /supplier-invoices] and [POST /supplier-invoices].
+// Invoices can be listed by purchase_order_uuid or supplier_uuid. An invoice
+// is recorded against the supplier of its purchase order, and its currency
+// defaults to that of the first invoiced line.
+func HandleSupplierInvoices(db SupplierInvoiceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var filter storage.SupplierInvoiceFilter
+			if v := r.URL.Query().Get("purchase_order_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid purchase_order_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.PurchaseOrderUUID = &v
+			}
+			if v := r.URL.Query().Get("supplier_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid supplier_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.SupplierUUID = &v
+			}
+
+			invoices, err := db.ListSupplierInvoices(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing supplier invoices", "error", err)
+				return
+			}
+
+			ids := make([]int64, 0, len(invoices))
+			for _, invoice := range invoices {
+				ids = append(ids, invoice.ID)
+			}
+			lines, err := db.ListSupplierInvoiceLinesByInvoiceIDs(r.Context(), ids)
+			if err != nil {
+				service.InternalError(w, "error listing supplier invoice lines", "error", err)
+				return
+			}
+			linesByInvoiceID := make(map[int64][]storage.SupplierInvoiceLine, len(invoices))
+			for _, line := range lines {
+				linesByInvoiceID[line.SupplierInvoiceID] = append(linesByInvoiceID[line.SupplierInvoiceID], line)
+			}
+
+			service.JSON(w, dto.NewSupplierInvoicesResponse(invoices, linesByInvoiceID))
+		case http.MethodPost:
+			var req dto.CreateSupplierInvoiceRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			// Resolve purchase order UUID to internal ID
+			order, ok := service.ResolveFK(r.Context(), w, req.PurchaseOrderUUID, "purchase order", db.GetPurchaseOrderByUUID)
+			if !ok {
+				return
+			}
+			switch order.Status {
+			case storage.PurchaseOrderStatusDraft, storage.PurchaseOrderStatusCancelled, storage.PurchaseOrderStatusClosed:
+				http.Error(w, "cannot invoice a "+order.Status+" purchase order", http.StatusConflict)
+				return
+			}
+
+			orderLines, err := db.ListPurchaseOrderLinesByOrderIDs(r.Context(), []int64{order.ID})
+			if err != nil {
+				service.InternalError(w, "error listing purchase order lines", "error", err)
+				return
+			}
+			orderLinesByUUID := make(map[string]storage.PurchaseOrderLine, len(orderLines))
+			for _, line := range orderLines {
+				orderLinesByUUID[line.UUID.String()] = line
+			}
+
+			lines := make([]storage.SupplierInvoiceLine, 0, len(req.Lines))
+			for _, reqLine := range req.Lines {
+				orderLine, ok := orderLinesByUUID[reqLine.PurchaseOrderLineUUID]
+				if !ok {
+					http.Error(w, "purchase order line "+reqLine.PurchaseOrderLineUUID+" is not on this purchase order", http.StatusBadRequest)
+					return
+				}
+				lines = append(lines, storage.SupplierInvoiceLine{
+					PurchaseOrderLineID: orderLine.ID,
+					Quantity:            reqLine.Quantity,
+					QuantityUnit:        strings.TrimSpace(reqLine.QuantityUnit),
+					UnitPriceCents:      reqLine.UnitPriceCents,
+					Notes:               reqLine.Notes,
+				})
+			}
+
+			currency := orderLinesByUUID[req.Lines[0].PurchaseOrderLineUUID].Currency
+			if req.Currency != nil {
+				currency = dto.NormalizeCurrency(*req.Currency)
+			}
+
+			// Dates were checked by Validate.
+			invoiceDate, _ := time.Parse(time.DateOnly, req.InvoiceDate)
+			invoice := storage.SupplierInvoice{
+				SupplierID:      order.SupplierID,
+				PurchaseOrderID: order.ID,
+				InvoiceNumber:   strings.TrimSpace(req.InvoiceNumber),
+				InvoiceDate:     invoiceDate,
+				Currency:        currency,
+				Notes:           req.Notes,
+			}
+			if req.DueDate != nil {
+				dueDate, _ := time.Parse(time.DateOnly, *req.DueDate)
+				invoice.DueDate = &dueDate
+			}
+
+			created, createdLines, err := db.CreateSupplierInvoiceWithLines(r.Context(), invoice, lines)
+			if errors.Is(err, storage.ErrDuplicateSupplierInvoice) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating supplier invoice", "error", err)
+				return
+			}
+
+			slog.Info("supplier invoice recorded",
+				"supplier_invoice_uuid", created.UUID,
+				"purchase_order_uuid", order.UUID,
+				"invoice_number", created.InvoiceNumber)
+
+			service.JSONCreated(w, dto.NewSupplierInvoiceResponse(created, createdLines))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleSupplierInvoiceByUUID handles [GET /supplier-invoices/{uuid}] and [DELETE /supplier-invoices/{uuid}].
+func HandleSupplierInvoiceByUUID(db SupplierInvoiceStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		invoiceUUID := r.PathValue("uuid")
+		if invoiceUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			invoice, err := db.GetSupplierInvoiceByUUID(r.Context(), invoiceUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier invoice not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting supplier invoice", "error", err)
+				return
+			}
+
+			lines, err := db.ListSupplierInvoiceLinesByInvoiceIDs(r.Context(), []int64{invoice.ID})
+			if err != nil {
+				service.InternalError(w, "error listing supplier invoice lines", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierInvoiceResponse(invoice, lines))
+		case http.MethodDelete:
+			invoice, err := db.GetSupplierInvoiceByUUID(r.Context(), invoiceUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier invoice not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting supplier invoice", "error", err)
+				return
+			}
+
+			order, err := db.GetPurchaseOrderByUUID(r.Context(), invoice.PurchaseOrderUUID)
+			if err != nil {
+				service.InternalError(w, "error getting purchase order", "error", err)
+				return
+			}
+			if order.Status == storage.PurchaseOrderStatusClosed {
+				http.Error(w, "cannot delete an invoice of a closed purchase order", http.StatusConflict)
+				return
+			}
+
+			if err := db.DeleteSupplierInvoice(r.Context(), invoice.ID); errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier invoice not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting supplier invoice", "error", err)
+				return
+			}
+
+			slog.Info("supplier invoice deleted", "supplier_invoice_uuid", invoiceUUID)
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type SupplierItemStore interface {
+	ListSupplierItems(context.Context, storage.SupplierItemFilter) ([]storage.SupplierItem, error)
+	GetSupplierItemByUUID(context.Context, string) (storage.SupplierItem, error)
+	GetSupplierByUUID(context.Context, string) (storage.Supplier, error)
+	CreateSupplierItem(context.Context, storage.SupplierItem) (storage.SupplierItem, error)
+	UpdateSupplierItemByUUID(context.Context, string, storage.SupplierItemUpdate) (storage.SupplierItem, error)
+	DeleteSupplierItemByUUID(context.Context, string) error
+	ListSupplierItemPrices(context.Context, string) ([]storage.SupplierItemPrice, error)
+	CreateSupplierItemPrice(context.Context, storage.SupplierItemPrice) (storage.SupplierItemPrice, error)
+}
+
+// HandleSupplierItems handles [GET /supplier-items] and [POST /supplier-items].
+func HandleSupplierItems(db SupplierItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			var filter storage.SupplierItemFilter
+			if v := r.URL.Query().Get("supplier_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid supplier_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.SupplierUUID = &v
+			}
+			if v := r.URL.Query().Get("inventory_item_uuid"); v != "" {
+				if _, err := uuid.FromString(v); err != nil {
+					http.Error(w, "invalid inventory_item_uuid", http.StatusBadRequest)
+					return
+				}
+				filter.InventoryItemUUID = &v
+			}
+
+			items, err := db.ListSupplierItems(r.Context(), filter)
+			if err != nil {
+				service.InternalError(w, "error listing supplier items", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierItemsResponse(items))
+		case http.MethodPost:
+			var req dto.CreateSupplierItemRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			supplier, ok := service.ResolveFK(r.Context(), w, req.SupplierUUID, "supplier", db.GetSupplierByUUID)
+			if !ok {
+				return
+			}
+
+			inventoryItemUUID, err := parseUUIDPointer(req.InventoryItemUUID)
+			if err != nil {
+				http.Error(w, "invalid inventory_item_uuid", http.StatusBadRequest)
+				return
+			}
+
+			item := storage.SupplierItem{
+				SupplierID:        supplier.ID,
+				SKU:               strings.TrimSpace(req.SKU),
+				Name:              req.Name,
+				ItemType:          req.ItemType,
+				InventoryItemUUID: inventoryItemUUID,
+				PackSize:          1,
+				PackUnit:          req.PackUnit,
+				MinOrderQuantity:  req.MinOrderQuantity,
+				LeadTimeDays:      req.LeadTimeDays,
+				Notes:             req.Notes,
+			}
+			if req.PackSize != nil {
+				item.PackSize = *req.PackSize
+			}
+
+			created, err := db.CreateSupplierItem(r.Context(), item)
+			if errors.Is(err, storage.ErrDuplicateSupplierSKU) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating supplier item", "error", err)
+				return
+			}
+
+			service.JSONCreated(w, dto.NewSupplierItemResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleSupplierItemByUUID handles [GET /supplier-items/{uuid}], [PATCH /supplier-items/{uuid}], and [DELETE /supplier-items/{uuid}].
+func HandleSupplierItemByUUID(db SupplierItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		itemUUID := r.PathValue("uuid")
+		if itemUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			item, err := db.GetSupplierItemByUUID(r.Context(), itemUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier item not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error getting supplier item", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierItemResponse(item))
+		case http.MethodPatch:
+			var req dto.UpdateSupplierItemRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			inventoryItemUUID, err := parseUUIDPointer(req.InventoryItemUUID)
+			if err != nil {
+				http.Error(w, "invalid inventory_item_uuid", http.StatusBadRequest)
+				return
+			}
+			if req.SKU != nil {
+				sku := strings.TrimSpace(*req.SKU)
+				req.SKU = &sku
+			}
+
+			item, err := db.UpdateSupplierItemByUUID(r.Context(), itemUUID, storage.SupplierItemUpdate{
+				SKU:               req.SKU,
+				Name:              req.Name,
+				ItemType:          req.ItemType,
+				InventoryItemUUID: inventoryItemUUID,
+				PackSize:          req.PackSize,
+				PackUnit:          req.PackUnit,
+				MinOrderQuantity:  req.MinOrderQuantity,
+				LeadTimeDays:      req.LeadTimeDays,
+				Notes:             req.Notes,
+			})
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier item not found", http.StatusNotFound)
+				return
+			} else if errors.Is(err, storage.ErrDuplicateSupplierSKU) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating supplier item", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierItemResponse(item))
+		case http.MethodDelete:
+			err := db.DeleteSupplierItemByUUID(r.Context(), itemUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "supplier item not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting supplier item", "error", err)
+				return
+			}
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleSupplierItemPrices handles [GET /supplier-items/{uuid}/prices] and [POST /supplier-items/{uuid}/prices].
+func HandleSupplierItemPrices(db SupplierItemStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		itemUUID := r.PathValue("uuid")
+		if itemUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		item, err := db.GetSupplierItemByUUID(r.Context(), itemUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "supplier item not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting supplier item", "error", err)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			prices, err := db.ListSupplierItemPrices(r.Context(), itemUUID)
+			if err != nil {
+				service.InternalError(w, "error listing supplier item prices", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewSupplierItemPricesResponse(prices))
+		case http.MethodPost:
+			var req dto.CreateSupplierItemPriceRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			effectiveAt := time.Now().UTC()
+			if req.EffectiveAt != nil {
+				effectiveAt = *req.EffectiveAt
+			}
+
+			created, err := db.CreateSupplierItemPrice(r.Context(), storage.SupplierItemPrice{
+				SupplierItemID:   item.ID,
+				SupplierItemUUID: item.UUID.String(),
+				UnitCostCents:    req.UnitCostCents,
+				Currency:         req.Currency,
+				EffectiveAt:      effectiveAt,
+				Notes:            req.Notes,
+			})
+			if err != nil {
+				service.InternalError(w, "error creating supplier item price", "error", err)
+				return
+			}
+
+			slog.Info("supplier item price recorded", "supplier_item_uuid", itemUUID, "unit_cost_cents", created.UnitCostCents, "currency", created.Currency, "effective_at", created.EffectiveAt)
+
+			service.JSONCreated(w, dto.NewSupplierItemPriceResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/procurement/handler"
+	"github.com/brewpipes/brewpipes/service/procurement/handler/dto"
+	"github.com/brewpipes/brewpipes/service/procurement/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+type PurchaseOrderLineStore struct {
+	GetPurchaseOrderByUUIDFunc  func(context.Context, string) (storage.PurchaseOrder, error)
+	GetSupplierItemByUUIDFunc   func(context.Context, string) (storage.SupplierItem, error)
+	GetSupplierItemPriceAtFunc  func(context.Context, int64, time.Time) (storage.SupplierItemPrice, error)
+	CreatePurchaseOrderLineFunc func(context.Context, storage.PurchaseOrderLine) (storage.PurchaseOrderLine, error)
+}
+
+func (s PurchaseOrderLineStore) ListPurchaseOrderLines(context.Context) ([]storage.PurchaseOrderLine, error) {
+	return nil, nil
+}
+
+func (s PurchaseOrderLineStore) ListPurchaseOrderLinesByOrderUUID(context.Context, string) ([]storage.PurchaseOrderLine, error) {
+	return nil, nil
+}
+
+func (s PurchaseOrderLineStore) GetPurchaseOrderLineByUUID(context.Context, string) (storage.PurchaseOrderLine, error) {
+	return storage.PurchaseOrderLine{}, nil
+}
+
+func (s PurchaseOrderLineStore) GetPurchaseOrderByUUID(ctx context.Context, orderUUID string) (storage.PurchaseOrder, error) {
+	if s.GetPurchaseOrderByUUIDFunc == nil {
+		return storage.PurchaseOrder{}, nil
+	}
+	return s.GetPurchaseOrderByUUIDFunc(ctx, orderUUID)
+}
+
+func (s PurchaseOrderLineStore) CreatePurchaseOrderLine(ctx context.Context, line storage.PurchaseOrderLine) (storage.PurchaseOrderLine, error) {
+	if s.CreatePurchaseOrderLineFunc == nil {
+		return line, nil
+	}
+	return s.CreatePurchaseOrderLineFunc(ctx, line)
+}
+
+func (s PurchaseOrderLineStore) UpdatePurchaseOrderLineByUUID(context.Context, string, storage.PurchaseOrderLineUpdate) (storage.PurchaseOrderLine, error) {
+	return storage.PurchaseOrderLine{}, nil
+}
+
+func (s PurchaseOrderLineStore) DeletePurchaseOrderLineByUUID(context.Context, string) (storage.PurchaseOrderLine, error) {
+	return storage.PurchaseOrderLine{}, nil
+}
+
+func (s PurchaseOrderLineStore) GetSupplierItemByUUID(ctx context.Context, itemUUID string) (storage.SupplierItem, error) {
+	if s.GetSupplierItemByUUIDFunc == nil {
+		return storage.SupplierItem{}, service.ErrNotFound
+	}
+	return s.GetSupplierItemByUUIDFunc(ctx, itemUUID)
+}
+
+func (s PurchaseOrderLineStore) GetSupplierItemPriceAt(ctx context.Context, itemID int64, at time.Time) (storage.SupplierItemPrice, error) {
+	if s.GetSupplierItemPriceAtFunc == nil {
+		return storage.SupplierItemPrice{}, service.ErrNotFound
+	}
+	return s.GetSupplierItemPriceAtFunc(ctx, itemID, at)
+}
+
+func TestHandlePurchaseOrderLines_SupplierItemDefaults(t *testing.T) {
+	orderedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	inventoryItemUUID := uuid.Must(uuid.NewV4())
+	supplierItemUUID := uuid.Must(uuid.NewV4()).String()
+
+	catalogItem := storage.SupplierItem{
+		SupplierID:        1,
+		Name:              "Pale Malt 25kg",
+		ItemType:          storage.PurchaseOrderItemTypeIngredient,
+		InventoryItemUUID: &inventoryItemUUID,
+		PackSize:          25,
+		PackUnit:          "kg",
+	}
+	catalogItem.ID = 42
+
+	tests := []struct {
+		name           string
+		body           string
+		orderSupplier  int64
+		price          *storage.SupplierItemPrice
+		expectedStatus int
+		expectedCost   int64
+		expectedName   string
+		errContains    string
+	}{
+		{
+			name:           "defaults from catalog",
+			body:           `,"supplier_item_uuid":"` + supplierItemUUID + `"`,
+			orderSupplier:  1,
+			price:          &storage.SupplierItemPrice{UnitCostCents: 95, Currency: "USD"},
+			expectedStatus: http.StatusCreated,
+			expectedCost:   95,
+			expectedName:   "Pale Malt 25kg",
+		},
+		{
+			name:           "explicit fields win",
+			body:           `,"supplier_item_uuid":"` + supplierItemUUID + `","item_name":"Pale Malt (promo)","unit_cost_cents":80`,
+			orderSupplier:  1,
+			price:          &storage.SupplierItemPrice{UnitCostCents: 95, Currency: "USD"},
+			expectedStatus: http.StatusCreated,
+			expectedCost:   80,
+			expectedName:   "Pale Malt (promo)",
+		},
+		{
+			name:           "no price on order date",
+			body:           `,"supplier_item_uuid":"` + supplierItemUUID + `"`,
+			orderSupplier:  1,
+			expectedStatus: http.StatusBadRequest,
+			errContains:    "no price",
+		},
+		{
+			name:           "item from another supplier",
+			body:           `,"supplier_item_uuid":"` + supplierItemUUID + `"`,
+			orderSupplier:  2,
+			price:          &storage.SupplierItemPrice{UnitCostCents: 95, Currency: "USD"},
+			expectedStatus: http.StatusBadRequest,
+			errContains:    "does not belong",
+		},
+		{
+			name:           "no catalog item requires all fields",
+			body:           "",
+			orderSupplier:  1,
+			expectedStatus: http.StatusBadRequest,
+			errContains:    "item_type",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var priceAt time.Time
+			store := PurchaseOrderLineStore{
+				GetPurchaseOrderByUUIDFunc: func(context.Context, string) (storage.PurchaseOrder, error) {
+					return storage.PurchaseOrder{SupplierID: tc.orderSupplier, OrderedAt: &orderedAt}, nil
+				},
+				GetSupplierItemByUUIDFunc: func(context.Context, string) (storage.SupplierItem, error) {
+					return catalogItem, nil
+				},
+				GetSupplierItemPriceAtFunc: func(_ context.Context, itemID int64, at time.Time) (storage.SupplierItemPrice, error) {
+					priceAt = at
+					if tc.price == nil || itemID != catalogItem.ID {
+						return storage.SupplierItemPrice{}, service.ErrNotFound
+					}
+					return *tc.price, nil
+				},
+			}
+
+			body := `{"purchase_order_uuid":"` + uuid.Must(uuid.NewV4()).String() + `","line_number":1,"quantity":100` + tc.body + `}`
+			req := httptest.NewRequest(http.MethodPost, "/purchase-order-lines", strings.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler.HandlePurchaseOrderLines(store).ServeHTTP(rec, req)
+
+			if rec.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tc.errContains != "" {
+				if !strings.Contains(rec.Body.String(), tc.errContains) {
+					t.Errorf("expected error containing %q, got %q", tc.errContains, rec.Body.String())
+				}
+				return
+			}
+
+			var resp dto.PurchaseOrderLineResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			if resp.UnitCostCents != tc.expectedCost || resp.Currency != "USD" || resp.ItemName != tc.expectedName {
+				t.Errorf("unexpected line %+v", resp)
+			}
+			if resp.QuantityUnit != "kg" || resp.ItemType != storage.PurchaseOrderItemTypeIngredient {
+				t.Errorf("expected catalog unit and type, got %q/%q", resp.QuantityUnit, resp.ItemType)
+			}
+			if resp.InventoryItemUUID == nil || *resp.InventoryItemUUID != inventoryItemUUID.String() {
+				t.Errorf("expected catalog inventory item, got %v", resp.InventoryItemUUID)
+			}
+			if !priceAt.Equal(orderedAt) {
+				t.Errorf("expected price lookup on the order date, got %v", priceAt)
+			}
+		})
+	}
+}
+
+type PriceTrendStore struct {
+	points []storage.PricePoint
+}
+
+func (s PriceTrendStore) ListPricePointsByInventoryItem(context.Context, string) ([]storage.PricePoint, error) {
+	return s.points, nil
+}
+
+func TestHandlePriceTrends(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	orderNumber := "PO-1001"
+	store := PriceTrendStore{points: []storage.PricePoint{
+		{Source: storage.PricePointSourceCatalog, SupplierUUID: "sup-1", SupplierName: "Malt Co", QuantityUnit: "kg", Currency: "USD", UnitCostCents: 80, At: day(1)},
+		{Source: storage.PricePointSourcePurchaseOrder, SupplierUUID: "sup-2", SupplierName: "Grain Inc", QuantityUnit: "kg", Currency: "USD", UnitCostCents: 90, At: day(2), OrderNumber: &orderNumber},
+		{Source: storage.PricePointSourcePurchaseOrder, SupplierUUID: "sup-1", SupplierName: "Malt Co", QuantityUnit: "kg", Currency: "USD", UnitCostCents: 70, At: day(5)},
+		{Source: storage.PricePointSourceCatalog, SupplierUUID: "sup-1", SupplierName: "Malt Co", QuantityUnit: "kg", Currency: "USD", UnitCostCents: 100, At: day(10)},
+		{Source: storage.PricePointSourceCatalog, SupplierUUID: "sup-1", SupplierName: "Malt Co", QuantityUnit: "kg", Currency: "EUR", UnitCostCents: 85, At: day(12)},
+	}}
+	itemUUID := uuid.Must(uuid.NewV4()).String()
+
+	t.Run("groups by supplier, currency and unit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/price-trends?inventory_item_uuid="+itemUUID, nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandlePriceTrends(store).ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp dto.PriceTrendResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if len(resp.Series) != 3 {
+			t.Fatalf("expected 3 series, got %d", len(resp.Series))
+		}
+
+		malt := resp.Series[0]
+		if malt.SupplierUUID != "sup-1" || malt.Currency != "USD" || len(malt.Points) != 3 {
+			t.Fatalf("unexpected first series %+v", malt)
+		}
+		if malt.LatestUnitCostCents != 100 || malt.MinUnitCostCents != 70 || malt.MaxUnitCostCents != 100 {
+			t.Errorf("expected latest 100, min 70, max 100, got %d/%d/%d", malt.LatestUnitCostCents, malt.MinUnitCostCents, malt.MaxUnitCostCents)
+		}
+		if malt.ChangePercent == nil || *malt.ChangePercent != 25 {
+			t.Errorf("expected 25%% change, got %v", malt.ChangePercent)
+		}
+	})
+
+	t.Run("since filter", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/price-trends?inventory_item_uuid="+itemUUID+"&since=2026-01-04T00:00:00Z", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandlePriceTrends(store).ServeHTTP(rec, req)
+
+		var resp dto.PriceTrendResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if len(resp.Series) != 2 || len(resp.Series[0].Points) != 2 {
+			t.Errorf("expected points before since to be dropped, got %+v", resp.Series)
+		}
+	})
+
+	t.Run("missing inventory item", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/price-trends", nil)
+		rec := httptest.NewRecorder()
+
+		handler.HandlePriceTrends(store).ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"os"
 
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/procurement/handler"
@@ -14,44 +15,109 @@ import (
 type Config struct {
 	PostgresDSN string
 	SecretKey   string
+	// ServiceSecret is the client secret the service presents to identity
+	// for the service tokens it calls other services with.
+	ServiceSecret string
 }
 
 type Service struct {
-	storage   *storage.Client
-	secretKey string
+	storage         *storage.Client
+	secretKey       string
+	serviceSecret   string
+	inventoryClient *handler.InventoryClient
 }
 
 // New creates and initializes a new procurement service instance.
 func New(cfg Config) *Service {
+	// Initialize inter-service clients at construction time so they are
+	// available when HTTPRoutes() is called (which happens before Start()).
+	inventoryURL := os.Getenv("INVENTORY_API_URL")
+	if inventoryURL == "" {
+		inventoryURL = "http://localhost:8080/api"
+	}
+	slog.Info("inventory client configured", "inventory_api_url", inventoryURL)
+
+	identityURL := os.Getenv("IDENTITY_API_URL")
+	if identityURL == "" {
+		identityURL = "http://localhost:8080/api"
+	}
+	slog.Info("identity client configured", "identity_api_url", identityURL)
+	tokens := service.NewServiceTokenSource(identityURL, "procurement", cfg.ServiceSecret)
+
 	return &Service{
-		storage:   storage.New(cfg.PostgresDSN),
-		secretKey: cfg.SecretKey,
+		storage:         storage.New(cfg.PostgresDSN),
+		secretKey:       cfg.SecretKey,
+		serviceSecret:   cfg.ServiceSecret,
+		inventoryClient: handler.NewInventoryClient(inventoryURL, tokens),
 	}
 }
 
+// Storage returns the service's storage client so that other services
+// running in the same process can call it directly. It is connected once
+// Start has run.
+func (s *Service) Storage() *storage.Client {
+	return s.storage
+}
+
 func (s *Service) HTTPRoutes() []service.HTTPRoute {
 	auth := service.RequireAccessToken(s.secretKey)
-	return []service.HTTPRoute{
-		{Method: http.MethodGet, Path: "/suppliers", Handler: auth(handler.HandleSuppliers(s.storage))},
-		{Method: http.MethodPost, Path: "/suppliers", Handler: auth(handler.HandleSuppliers(s.storage))},
-		{Method: http.MethodGet, Path: "/suppliers/{uuid}", Handler: auth(handler.HandleSupplierByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/suppliers/{uuid}", Handler: auth(handler.HandleSupplierByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-orders", Handler: auth(handler.HandlePurchaseOrders(s.storage))},
-		{Method: http.MethodPost, Path: "/purchase-orders", Handler: auth(handler.HandlePurchaseOrders(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-orders/{uuid}", Handler: auth(handler.HandlePurchaseOrderByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/purchase-orders/{uuid}", Handler: auth(handler.HandlePurchaseOrderByUUID(s.storage))},
-		{Method: http.MethodPost, Path: "/purchase-order-lines/batch-lookup", Handler: auth(handler.HandleBatchLookupPurchaseOrderLines(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-order-lines", Handler: auth(handler.HandlePurchaseOrderLines(s.storage))},
-		{Method: http.MethodPost, Path: "/purchase-order-lines", Handler: auth(handler.HandlePurchaseOrderLines(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-order-lines/{uuid}", Handler: auth(handler.HandlePurchaseOrderLineByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/purchase-order-lines/{uuid}", Handler: auth(handler.HandlePurchaseOrderLineByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/purchase-order-lines/{uuid}", Handler: auth(handler.HandlePurchaseOrderLineByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-order-fees", Handler: auth(handler.HandlePurchaseOrderFees(s.storage))},
-		{Method: http.MethodPost, Path: "/purchase-order-fees", Handler: auth(handler.HandlePurchaseOrderFees(s.storage))},
-		{Method: http.MethodGet, Path: "/purchase-order-fees/{uuid}", Handler: auth(handler.HandlePurchaseOrderFeeByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/purchase-order-fees/{uuid}", Handler: auth(handler.HandlePurchaseOrderFeeByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/purchase-order-fees/{uuid}", Handler: auth(handler.HandlePurchaseOrderFeeByUUID(s.storage))},
-	}
+	// Routes the other services call also accept their service tokens.
+	fromInventory := service.RequireAccessTokenOrService(s.secretKey, "inventory")
+	fromInventoryOrProduction := service.RequireAccessTokenOrService(s.secretKey, "inventory", "production")
+	return handler.API.Routes([]service.HTTPRoute{
+		{Method: http.MethodGet, Path: "/suppliers", Auth: auth, Handler: handler.HandleSuppliers(s.storage)},
+		{Method: http.MethodPost, Path: "/suppliers", Auth: auth, Handler: handler.HandleSuppliers(s.storage)},
+		{Method: http.MethodPost, Path: "/suppliers/import", Auth: auth, Handler: handler.HandleSupplierImport(s.storage)},
+		{Method: http.MethodGet, Path: "/suppliers/{uuid}", Auth: auth, Handler: handler.HandleSupplierByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/suppliers/{uuid}", Auth: auth, Handler: handler.HandleSupplierByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-items", Auth: auth, Handler: handler.HandleSupplierItems(s.storage)},
+		{Method: http.MethodPost, Path: "/supplier-items", Auth: auth, Handler: handler.HandleSupplierItems(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-items/{uuid}", Auth: auth, Handler: handler.HandleSupplierItemByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/supplier-items/{uuid}", Auth: auth, Handler: handler.HandleSupplierItemByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/supplier-items/{uuid}", Auth: auth, Handler: handler.HandleSupplierItemByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-items/{uuid}/prices", Auth: auth, Handler: handler.HandleSupplierItemPrices(s.storage)},
+		{Method: http.MethodPost, Path: "/supplier-items/{uuid}/prices", Auth: auth, Handler: handler.HandleSupplierItemPrices(s.storage)},
+		{Method: http.MethodGet, Path: "/price-trends", Auth: auth, Handler: handler.HandlePriceTrends(s.storage)},
+		{Method: http.MethodGet, Path: "/currency-settings", Auth: auth, Handler: handler.HandleCurrencySettings(s.storage)},
+		{Method: http.MethodPut, Path: "/currency-settings", Auth: auth, Handler: handler.HandleCurrencySettings(s.storage)},
+		{Method: http.MethodGet, Path: "/exchange-rates", Auth: auth, Handler: handler.HandleExchangeRates(s.storage)},
+		{Method: http.MethodPost, Path: "/exchange-rates", Auth: auth, Handler: handler.HandleExchangeRates(s.storage)},
+		{Method: http.MethodPost, Path: "/exchange-rates/import", Auth: auth, Handler: handler.HandleExchangeRateImport(s.storage)},
+		{Method: http.MethodDelete, Path: "/exchange-rates/{uuid}", Auth: auth, Handler: handler.HandleExchangeRateByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-orders", Auth: auth, Handler: handler.HandlePurchaseOrders(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-orders", Auth: auth, Handler: handler.HandlePurchaseOrders(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-orders/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderByUUID(s.storage, s.inventoryClient)},
+		{Method: http.MethodPatch, Path: "/purchase-orders/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderByUUID(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/purchase-orders/{uuid}/landed-costs", Auth: auth, Handler: handler.HandlePurchaseOrderLandedCosts(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-orders/{uuid}/totals", Auth: auth, Handler: handler.HandlePurchaseOrderTotals(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-orders/{uuid}/match", Auth: auth, Handler: handler.HandlePurchaseOrderMatch(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/purchase-orders/drafts", Auth: fromInventoryOrProduction, Handler: handler.HandleCreateDraftPurchaseOrder(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-order-lines/batch-lookup", Auth: fromInventoryOrProduction, Handler: handler.HandleBatchLookupPurchaseOrderLines(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-order-lines/supply-lookup", Auth: fromInventoryOrProduction, Handler: handler.HandleItemSupplyLookup(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-order-lines", Auth: auth, Handler: handler.HandlePurchaseOrderLines(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-order-lines", Auth: auth, Handler: handler.HandlePurchaseOrderLines(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-order-lines/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderLineByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/purchase-order-lines/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderLineByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/purchase-order-lines/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderLineByUUID(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-order-lines/{uuid}/returns", Auth: fromInventory, Handler: handler.HandlePurchaseOrderLineReturns(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-order-fees", Auth: auth, Handler: handler.HandlePurchaseOrderFees(s.storage)},
+		{Method: http.MethodPost, Path: "/purchase-order-fees", Auth: auth, Handler: handler.HandlePurchaseOrderFees(s.storage)},
+		{Method: http.MethodGet, Path: "/purchase-order-fees/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderFeeByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/purchase-order-fees/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderFeeByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/purchase-order-fees/{uuid}", Auth: auth, Handler: handler.HandlePurchaseOrderFeeByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-invoices", Auth: auth, Handler: handler.HandleSupplierInvoices(s.storage)},
+		{Method: http.MethodPost, Path: "/supplier-invoices", Auth: auth, Handler: handler.HandleSupplierInvoices(s.storage)},
+		{Method: http.MethodGet, Path: "/supplier-invoices/{uuid}", Auth: auth, Handler: handler.HandleSupplierInvoiceByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/supplier-invoices/{uuid}", Auth: auth, Handler: handler.HandleSupplierInvoiceByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/invoice-match-settings", Auth: auth, Handler: handler.HandleInvoiceMatchSettings(s.storage)},
+		{Method: http.MethodPut, Path: "/invoice-match-settings", Auth: auth, Handler: handler.HandleInvoiceMatchSettings(s.storage)},
+		{Method: http.MethodGet, Path: "/accounting/accounts", Auth: fromInventory, Handler: handler.HandleAccountMapping(s.storage)},
+		{Method: http.MethodPut, Path: "/accounting/accounts", Auth: auth, Handler: handler.HandleAccountMapping(s.storage)},
+		{Method: http.MethodGet, Path: "/accounting/purchase-journal", Auth: auth, Handler: handler.HandlePurchaseJournal(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/accounting/purchase-journal/exports", Auth: auth, Handler: handler.HandlePurchaseJournalExports(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/accounting/purchase-journal/exports", Auth: auth, Handler: handler.HandlePurchaseJournalExports(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/accounting/purchase-journal/exports/{uuid}/file", Auth: auth, Handler: handler.HandlePurchaseJournalExportFile(s.storage)},
+	})
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -59,6 +125,9 @@ func (s *Service) Start(ctx context.Context) error {
 	if s.secretKey == "" {
 		return fmt.Errorf("missing BREWPIPES_SECRET_KEY for access token verification")
 	}
+	if s.serviceSecret == "" {
+		return fmt.Errorf("missing BREWPIPES_SERVICE_SECRET for calls to other services")
+	}
 	if err := s.storage.Start(ctx); err != nil {
 		return fmt.Errorf("starting storage: %w", err)
 	}
@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/accounting"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReceivedPurchaseOrder is a purchase order as it is journaled: the date it
+// was received and the supplier owed for it.
+type ReceivedPurchaseOrder struct {
+	ID           int64
+	UUID         string
+	OrderNumber  string
+	SupplierName string
+	ReceivedAt   time.Time
+}
+
+// JournalExport is a journal file sent to the books for a period.
+type JournalExport struct {
+	ID         int64
+	UUID       string
+	Period     string
+	Format     string
+	EntryCount int
+	TotalCents int64
+	Content    string
+	CreatedAt  time.Time
+}
+
+// GetAccountMapping returns the GL accounts journals post to.
+func (c *Client) GetAccountMapping(ctx context.Context) (accounting.Mapping, error) {
+	var mapping accounting.Mapping
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT xero_tax_rate
+		FROM accounting_setting
+		WHERE id`,
+	).Scan(&mapping.XeroTaxRate)
+	if err != nil {
+		return accounting.Mapping{}, fmt.Errorf("getting accounting settings: %w", err)
+	}
+
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT kind, key, account
+		FROM gl_account_mapping
+		ORDER BY kind, key`,
+	)
+	if err != nil {
+		return accounting.Mapping{}, fmt.Errorf("listing gl account mapping: %w", err)
+	}
+	defer rows.Close()
+
+	mapping.Accounts = []accounting.Account{}
+	for rows.Next() {
+		var account accounting.Account
+		if err := rows.Scan(&account.Kind, &account.Key, &account.Account); err != nil {
+			return accounting.Mapping{}, fmt.Errorf("scanning gl account mapping: %w", err)
+		}
+		mapping.Accounts = append(mapping.Accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return accounting.Mapping{}, fmt.Errorf("listing gl account mapping: %w", err)
+	}
+
+	return mapping, nil
+}
+
+// ReplaceAccountMapping replaces every GL account and the Xero tax rate.
+func (c *Client) ReplaceAccountMapping(ctx context.Context, mapping accounting.Mapping) (accounting.Mapping, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return accounting.Mapping{}, fmt.Errorf("starting gl account mapping transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO accounting_setting (id, xero_tax_rate)
+		VALUES (true, $1)
+		ON CONFLICT (id) DO UPDATE
+		SET xero_tax_rate = EXCLUDED.xero_tax_rate,
+			updated_at = timezone('utc', now())`,
+		mapping.XeroTaxRate,
+	); err != nil {
+		return accounting.Mapping{}, fmt.Errorf("setting accounting settings: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM gl_account_mapping`); err != nil {
+		return accounting.Mapping{}, fmt.Errorf("clearing gl account mapping: %w", err)
+	}
+	for _, account := range mapping.Accounts {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO gl_account_mapping (kind, key, account)
+			VALUES ($1, $2, $3)`,
+			account.Kind,
+			account.Key,
+			account.Account,
+		); err != nil {
+			return accounting.Mapping{}, fmt.Errorf("inserting gl account mapping: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return accounting.Mapping{}, fmt.Errorf("committing gl account mapping: %w", err)
+	}
+
+	return c.GetAccountMapping(ctx)
+}
+
+// ListReceivedPurchaseOrders lists the purchase orders received in
+// [from, to), oldest first.
+func (c *Client) ListReceivedPurchaseOrders(ctx context.Context, from, to time.Time) ([]ReceivedPurchaseOrder, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT po.id, po.uuid, po.order_number, s.name, po.received_at
+		FROM purchase_order po
+		JOIN supplier s ON s.id = po.supplier_id
+		WHERE po.deleted_at IS NULL
+		  AND po.status IN ('received', 'closed')
+		  AND po.received_at >= $1 AND po.received_at < $2
+		ORDER BY po.received_at, po.id`,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing received purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []ReceivedPurchaseOrder
+	for rows.Next() {
+		var order ReceivedPurchaseOrder
+		if err := rows.Scan(
+			&order.ID,
+			&order.UUID,
+			&order.OrderNumber,
+			&order.SupplierName,
+			&order.ReceivedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning received purchase order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing received purchase orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+// CreateJournalExport records a purchase journal file.
+func (c *Client) CreateJournalExport(ctx context.Context, export JournalExport) (JournalExport, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO purchase_journal_export (
+			period,
+			format,
+			entry_count,
+			total_cents,
+			content
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, uuid, created_at`,
+		export.Period,
+		export.Format,
+		export.EntryCount,
+		export.TotalCents,
+		export.Content,
+	).Scan(
+		&export.ID,
+		&export.UUID,
+		&export.CreatedAt,
+	)
+	if err != nil {
+		return JournalExport{}, fmt.Errorf("creating purchase journal export: %w", err)
+	}
+
+	return export, nil
+}
+
+// ListJournalExports lists purchase journal exports, newest first, without
+// their content.
+func (c *Client) ListJournalExports(ctx context.Context) ([]JournalExport, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT id, uuid, period, format, entry_count, total_cents, created_at
+		FROM purchase_journal_export
+		ORDER BY created_at DESC, id DESC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing purchase journal exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []JournalExport
+	for rows.Next() {
+		var export JournalExport
+		if err := rows.Scan(
+			&export.ID,
+			&export.UUID,
+			&export.Period,
+			&export.Format,
+			&export.EntryCount,
+			&export.TotalCents,
+			&export.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning purchase journal export: %w", err)
+		}
+		exports = append(exports, export)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing purchase journal exports: %w", err)
+	}
+
+	return exports, nil
+}
+
+// GetJournalExportByUUID returns a purchase journal export with its content.
+func (c *Client) GetJournalExportByUUID(ctx context.Context, exportUUID string) (JournalExport, error) {
+	var export JournalExport
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT id, uuid, period, format, entry_count, total_cents, content, created_at
+		FROM purchase_journal_export
+		WHERE uuid = $1`,
+		exportUUID,
+	).Scan(
+		&export.ID,
+		&export.UUID,
+		&export.Period,
+		&export.Format,
+		&export.EntryCount,
+		&export.TotalCents,
+		&export.Content,
+		&export.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return JournalExport{}, service.ErrNotFound
+		}
+		return JournalExport{}, fmt.Errorf("getting purchase journal export: %w", err)
+	}
+
+	return export, nil
+}
@@ -0,0 +1,20 @@
+package storage
+
+import "github.com/brewpipes/brewpipes/internal/archive"
+
+// Archive describes the procurement data included in a full export.
+var Archive = archive.Service{
+	Name:           "procurement",
+	MigrationTable: "procurement_schema_migrations",
+	Tables: archive.Tables(
+		"supplier", "purchase_order", "purchase_order_line", "purchase_order_fee",
+		"supplier_item", "supplier_item_price", "currency_setting", "exchange_rate",
+		"purchase_order_exchange_rate", "supplier_invoice", "supplier_invoice_line",
+		"invoice_match_setting", "gl_account_mapping", "accounting_setting",
+		"purchase_journal_export",
+	),
+	References: []archive.Reference{
+		{Table: "purchase_order_line", Column: "inventory_item_uuid", Service: "inventory", Target: "ingredient"},
+		{Table: "supplier_item", Column: "inventory_item_uuid", Service: "inventory", Target: "ingredient"},
+	},
+}
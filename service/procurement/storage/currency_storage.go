@@ -0,0 +1,260 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetBaseCurrency returns the currency that costs are reported in.
+func (c *Client) GetBaseCurrency(ctx context.Context) (string, error) {
+	var baseCurrency string
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT base_currency
+		FROM currency_setting
+		WHERE id`,
+	).Scan(&baseCurrency)
+	if err != nil {
+		return "", fmt.Errorf("getting base currency: %w", err)
+	}
+
+	return baseCurrency, nil
+}
+
+// SetBaseCurrency changes the currency that costs are reported in. Rates
+// already locked on purchase orders keep the base currency they were locked
+// against.
+func (c *Client) SetBaseCurrency(ctx context.Context, baseCurrency string) (string, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO currency_setting (id, base_currency)
+		VALUES (true, $1)
+		ON CONFLICT (id) DO UPDATE
+		SET base_currency = EXCLUDED.base_currency,
+			updated_at = timezone('utc', now())
+		RETURNING base_currency`,
+		baseCurrency,
+	).Scan(&baseCurrency)
+	if err != nil {
+		return "", fmt.Errorf("setting base currency: %w", err)
+	}
+
+	return baseCurrency, nil
+}
+
+// UpsertExchangeRate records a rate for a currency pair on a date, replacing
+// any rate already recorded for that pair and date.
+func (c *Client) UpsertExchangeRate(ctx context.Context, rate ExchangeRate) (ExchangeRate, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO exchange_rate (
+			from_currency,
+			to_currency,
+			rate,
+			effective_date,
+			source
+		) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (from_currency, to_currency, effective_date) WHERE deleted_at IS NULL DO UPDATE
+		SET rate = EXCLUDED.rate,
+			source = EXCLUDED.source,
+			updated_at = timezone('utc', now())
+		RETURNING id, uuid, from_currency, to_currency, rate, effective_date, source, created_at, updated_at, deleted_at`,
+		rate.FromCurrency,
+		rate.ToCurrency,
+		rate.Rate,
+		rate.EffectiveDate,
+		rate.Source,
+	).Scan(
+		&rate.ID,
+		&rate.UUID,
+		&rate.FromCurrency,
+		&rate.ToCurrency,
+		&rate.Rate,
+		&rate.EffectiveDate,
+		&rate.Source,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+		&rate.DeletedAt,
+	)
+	if err != nil {
+		return ExchangeRate{}, fmt.Errorf("upserting exchange rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+// ListExchangeRates returns recorded rates, most recent first. A currency
+// filter matches either side of the pair.
+func (c *Client) ListExchangeRates(ctx context.Context, filter ExchangeRateFilter) ([]ExchangeRate, error) {
+	query := `
+		SELECT id, uuid, from_currency, to_currency, rate, effective_date, source, created_at, updated_at, deleted_at
+		FROM exchange_rate
+		WHERE deleted_at IS NULL`
+	var args []any
+	if filter.Currency != nil {
+		query += " AND (from_currency = $1 OR to_currency = $1)"
+		args = append(args, *filter.Currency)
+	}
+	query += " ORDER BY effective_date DESC, from_currency, to_currency"
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []ExchangeRate
+	for rows.Next() {
+		var rate ExchangeRate
+		if err := rows.Scan(
+			&rate.ID,
+			&rate.UUID,
+			&rate.FromCurrency,
+			&rate.ToCurrency,
+			&rate.Rate,
+			&rate.EffectiveDate,
+			&rate.Source,
+			&rate.CreatedAt,
+			&rate.UpdatedAt,
+			&rate.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing exchange rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+func (c *Client) DeleteExchangeRateByUUID(ctx context.Context, rateUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE exchange_rate
+		SET deleted_at = timezone('utc', now()),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		rateUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting exchange rate by uuid: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetExchangeRateAt returns the rate from one currency into another in effect
+// on the given date, or service.ErrNotFound if none was recorded yet.
+func (c *Client) GetExchangeRateAt(ctx context.Context, fromCurrency, toCurrency string, at time.Time) (ExchangeRate, error) {
+	var rate ExchangeRate
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT id, uuid, from_currency, to_currency, rate, effective_date, source, created_at, updated_at, deleted_at
+		FROM exchange_rate
+		WHERE from_currency = $1 AND to_currency = $2 AND effective_date <= $3::date AND deleted_at IS NULL
+		ORDER BY effective_date DESC
+		LIMIT 1`,
+		fromCurrency,
+		toCurrency,
+		at,
+	).Scan(
+		&rate.ID,
+		&rate.UUID,
+		&rate.FromCurrency,
+		&rate.ToCurrency,
+		&rate.Rate,
+		&rate.EffectiveDate,
+		&rate.Source,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+		&rate.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ExchangeRate{}, service.ErrNotFound
+		}
+		return ExchangeRate{}, fmt.Errorf("getting exchange rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+// ListPurchaseOrderExchangeRates returns the rates locked on the given
+// purchase orders.
+func (c *Client) ListPurchaseOrderExchangeRates(ctx context.Context, orderIDs []int64) ([]PurchaseOrderExchangeRate, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT purchase_order_id, currency, base_currency, rate, rate_date, locked_at
+		FROM purchase_order_exchange_rate
+		WHERE purchase_order_id = ANY($1::int[])
+		ORDER BY purchase_order_id, currency`,
+		orderIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing purchase order exchange rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []PurchaseOrderExchangeRate
+	for rows.Next() {
+		var rate PurchaseOrderExchangeRate
+		if err := rows.Scan(
+			&rate.PurchaseOrderID,
+			&rate.Currency,
+			&rate.BaseCurrency,
+			&rate.Rate,
+			&rate.RateDate,
+			&rate.LockedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning purchase order exchange rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing purchase order exchange rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// LockPurchaseOrderExchangeRates stores the conversion of each currency on a
+// purchase order. A currency that is already locked keeps its original rate.
+func (c *Client) LockPurchaseOrderExchangeRates(ctx context.Context, rates []PurchaseOrderExchangeRate) error {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting exchange rate lock transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	for _, rate := range rates {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO purchase_order_exchange_rate (
+				purchase_order_id,
+				currency,
+				base_currency,
+				rate,
+				rate_date
+			) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (purchase_order_id, currency) DO NOTHING`,
+			rate.PurchaseOrderID,
+			rate.Currency,
+			rate.BaseCurrency,
+			rate.Rate,
+			rate.RateDate,
+		); err != nil {
+			return fmt.Errorf("locking purchase order exchange rate: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing exchange rate lock: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OpenPurchaseOrderLine is a purchase order line for an inventory item on an
+// order that has not been fully received or cancelled.
+type OpenPurchaseOrderLine struct {
+	PurchaseOrderLineUUID string
+	PurchaseOrderUUID     string
+	OrderNumber           string
+	Status                string
+	SupplierUUID          string
+	InventoryItemUUID     string
+	Quantity              int64
+	QuantityUnit          string
+	ExpectedAt            *time.Time
+}
+
+// ItemSupplier is the supplier an inventory item was most recently ordered
+// from, with the unit cost of that order.
+type ItemSupplier struct {
+	InventoryItemUUID string
+	SupplierUUID      string
+	SupplierName      string
+	ItemName          string
+	QuantityUnit      string
+	UnitCostCents     int64
+	Currency          string
+	LastOrderedAt     time.Time
+}
+
+// ListOpenPurchaseOrderLinesByItems returns lines for the given inventory items
+// on draft, submitted, confirmed, or partially received orders, earliest
+// expected first.
+func (c *Client) ListOpenPurchaseOrderLinesByItems(ctx context.Context, itemUUIDs []string) ([]OpenPurchaseOrderLine, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pol.uuid, po.uuid, po.order_number, po.status, s.uuid,
+			pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, po.expected_at
+		FROM purchase_order_line pol
+		JOIN purchase_order po ON po.id = pol.purchase_order_id
+		JOIN supplier s ON s.id = po.supplier_id
+		WHERE pol.inventory_item_uuid = ANY($1::uuid[])
+		  AND po.status IN ('draft', 'submitted', 'confirmed', 'partially_received')
+		  AND pol.deleted_at IS NULL
+		  AND po.deleted_at IS NULL
+		ORDER BY po.expected_at NULLS LAST, po.order_number, pol.line_number`,
+		itemUUIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing open purchase order lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []OpenPurchaseOrderLine
+	for rows.Next() {
+		var line OpenPurchaseOrderLine
+		if err := rows.Scan(
+			&line.PurchaseOrderLineUUID,
+			&line.PurchaseOrderUUID,
+			&line.OrderNumber,
+			&line.Status,
+			&line.SupplierUUID,
+			&line.InventoryItemUUID,
+			&line.Quantity,
+			&line.QuantityUnit,
+			&line.ExpectedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning open purchase order line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing open purchase order lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// ListLatestItemSuppliers returns, for each of the given inventory items that
+// has been ordered before, the supplier and unit cost of its most recent
+// non-cancelled order.
+func (c *Client) ListLatestItemSuppliers(ctx context.Context, itemUUIDs []string) ([]ItemSupplier, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT DISTINCT ON (pol.inventory_item_uuid)
+			pol.inventory_item_uuid, s.uuid, s.name, pol.item_name, pol.quantity_unit,
+			pol.unit_cost_cents, pol.currency, COALESCE(po.ordered_at, po.created_at)
+		FROM purchase_order_line pol
+		JOIN purchase_order po ON po.id = pol.purchase_order_id
+		JOIN supplier s ON s.id = po.supplier_id
+		WHERE pol.inventory_item_uuid = ANY($1::uuid[])
+		  AND po.status <> 'cancelled'
+		  AND pol.deleted_at IS NULL
+		  AND po.deleted_at IS NULL
+		  AND s.deleted_at IS NULL
+		ORDER BY pol.inventory_item_uuid, COALESCE(po.ordered_at, po.created_at) DESC, pol.id DESC`,
+		itemUUIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing latest item suppliers: %w", err)
+	}
+	defer rows.Close()
+
+	var suppliers []ItemSupplier
+	for rows.Next() {
+		var s ItemSupplier
+		if err := rows.Scan(
+			&s.InventoryItemUUID,
+			&s.SupplierUUID,
+			&s.SupplierName,
+			&s.ItemName,
+			&s.QuantityUnit,
+			&s.UnitCostCents,
+			&s.Currency,
+			&s.LastOrderedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning item supplier: %w", err)
+		}
+		suppliers = append(suppliers, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing latest item suppliers: %w", err)
+	}
+
+	return suppliers, nil
+}
@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/internal/database"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ListPurchaseOrderLinesByOrderIDs returns every line on the given purchase
+// orders, ordered by order and line number.
+func (c *Client) ListPurchaseOrderLinesByOrderIDs(ctx context.Context, orderIDs []int64) ([]PurchaseOrderLine, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
+		FROM purchase_order_line pol
+		JOIN purchase_order po ON po.id = pol.purchase_order_id
+		WHERE pol.purchase_order_id = ANY($1::int[]) AND pol.deleted_at IS NULL
+		ORDER BY pol.purchase_order_id, pol.line_number`,
+		orderIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing purchase order lines by order ids: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []PurchaseOrderLine
+	for rows.Next() {
+		var line PurchaseOrderLine
+		var inventoryItemUUID pgtype.UUID
+		if err := rows.Scan(
+			&line.ID,
+			&line.UUID,
+			&line.PurchaseOrderID,
+			&line.PurchaseOrderUUID,
+			&line.LineNumber,
+			&line.ItemType,
+			&line.ItemName,
+			&inventoryItemUUID,
+			&line.Quantity,
+			&line.QuantityUnit,
+			&line.UnitCostCents,
+			&line.Currency,
+			&line.ReturnedQuantity,
+			&line.CreatedAt,
+			&line.UpdatedAt,
+			&line.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning purchase order line: %w", err)
+		}
+		database.AssignUUIDPointer(&line.InventoryItemUUID, inventoryItemUUID)
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing purchase order lines by order ids: %w", err)
+	}
+
+	return lines, nil
+}
+
+// ListPurchaseOrderFeesByOrderIDs returns every fee on the given purchase
+// orders.
+func (c *Client) ListPurchaseOrderFeesByOrderIDs(ctx context.Context, orderIDs []int64) ([]PurchaseOrderFee, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.allocation_basis, pof.created_at, pof.updated_at, pof.deleted_at
+		FROM purchase_order_fee pof
+		JOIN purchase_order po ON po.id = pof.purchase_order_id
+		WHERE pof.purchase_order_id = ANY($1::int[]) AND pof.deleted_at IS NULL
+		ORDER BY pof.purchase_order_id, pof.id`,
+		orderIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing purchase order fees by order ids: %w", err)
+	}
+	defer rows.Close()
+
+	var fees []PurchaseOrderFee
+	for rows.Next() {
+		var fee PurchaseOrderFee
+		if err := rows.Scan(
+			&fee.ID,
+			&fee.UUID,
+			&fee.PurchaseOrderID,
+			&fee.PurchaseOrderUUID,
+			&fee.FeeType,
+			&fee.AmountCents,
+			&fee.Currency,
+			&fee.AllocationBasis,
+			&fee.CreatedAt,
+			&fee.UpdatedAt,
+			&fee.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning purchase order fee: %w", err)
+		}
+		fees = append(fees, fee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing purchase order fees by order ids: %w", err)
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS supplier_item_price CASCADE;
+DROP TABLE IF EXISTS supplier_item CASCADE;
+COMMIT;
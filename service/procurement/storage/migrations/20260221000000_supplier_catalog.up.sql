@@ -0,0 +1,58 @@
+-- Supplier catalog: the items each supplier sells, with pack size, minimum
+-- order quantity, lead time, and a dated price history.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS supplier_item (
+    id                  serial PRIMARY KEY,
+    uuid                uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    supplier_id         int NOT NULL REFERENCES supplier(id),
+    sku                 varchar(64) NOT NULL,
+    name                varchar(255) NOT NULL,
+    item_type           varchar(32) NOT NULL,
+    inventory_item_uuid uuid,
+    pack_size           bigint NOT NULL DEFAULT 1,
+    pack_unit           varchar(7) NOT NULL,
+    min_order_quantity  bigint,
+    lead_time_days      int,
+    notes               text,
+
+    created_at          timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at          timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at          timestamptz,
+    CONSTRAINT supplier_item_item_type_check CHECK (item_type IN (
+        'ingredient',
+        'packaging',
+        'service',
+        'equipment',
+        'other'
+    )),
+    CONSTRAINT supplier_item_pack_size_check CHECK (pack_size > 0),
+    CONSTRAINT supplier_item_min_order_quantity_check CHECK (min_order_quantity IS NULL OR min_order_quantity > 0),
+    CONSTRAINT supplier_item_lead_time_days_check CHECK (lead_time_days IS NULL OR lead_time_days >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_item_uuid_idx ON supplier_item(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_item_sku_idx ON supplier_item(supplier_id, sku) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_item_inventory_item_uuid_idx ON supplier_item(inventory_item_uuid);
+
+CREATE TABLE IF NOT EXISTS supplier_item_price (
+    id               serial PRIMARY KEY,
+    uuid             uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    supplier_item_id int NOT NULL REFERENCES supplier_item(id),
+    unit_cost_cents  bigint NOT NULL,
+    currency         char(3) NOT NULL,
+    effective_at     timestamptz NOT NULL,
+    notes            text,
+
+    created_at       timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at       timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at       timestamptz,
+    CONSTRAINT supplier_item_price_cost_check CHECK (unit_cost_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_item_price_uuid_idx ON supplier_item_price(uuid);
+CREATE INDEX IF NOT EXISTS supplier_item_price_effective_idx ON supplier_item_price(supplier_item_id, effective_at DESC);
+
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE purchase_order_fee DROP CONSTRAINT IF EXISTS purchase_order_fee_allocation_basis_check;
+ALTER TABLE purchase_order_fee DROP COLUMN IF EXISTS allocation_basis;
+COMMIT;
@@ -0,0 +1,15 @@
+-- Fees are allocated across purchase order lines to compute landed costs.
+-- The basis decides each line's share: line value, quantity, or weight.
+BEGIN;
+
+ALTER TABLE purchase_order_fee
+    ADD COLUMN IF NOT EXISTS allocation_basis varchar(16) NOT NULL DEFAULT 'value';
+
+ALTER TABLE purchase_order_fee
+    ADD CONSTRAINT purchase_order_fee_allocation_basis_check CHECK (allocation_basis IN (
+        'value',
+        'quantity',
+        'weight'
+    ));
+
+COMMIT;
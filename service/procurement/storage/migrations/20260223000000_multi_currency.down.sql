@@ -0,0 +1,5 @@
+BEGIN;
+DROP TABLE IF EXISTS purchase_order_exchange_rate CASCADE;
+DROP TABLE IF EXISTS exchange_rate CASCADE;
+DROP TABLE IF EXISTS currency_setting CASCADE;
+COMMIT;
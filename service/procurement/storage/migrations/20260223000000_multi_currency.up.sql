@@ -0,0 +1,55 @@
+-- Multi-currency purchasing: a single base currency for reporting, a dated
+-- exchange rate table, and the rates locked in on each purchase order when it
+-- is received.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS currency_setting (
+    id            boolean PRIMARY KEY DEFAULT true,
+    base_currency char(3) NOT NULL DEFAULT 'USD',
+
+    updated_at    timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT currency_setting_singleton_check CHECK (id)
+);
+
+INSERT INTO currency_setting (id) VALUES (true) ON CONFLICT (id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS exchange_rate (
+    id             serial PRIMARY KEY,
+    uuid           uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    from_currency  char(3) NOT NULL,
+    to_currency    char(3) NOT NULL,
+    rate           numeric(20, 10) NOT NULL,
+    effective_date date NOT NULL,
+    source         varchar(16) NOT NULL DEFAULT 'manual',
+
+    created_at     timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at     timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at     timestamptz,
+    CONSTRAINT exchange_rate_rate_check CHECK (rate > 0),
+    CONSTRAINT exchange_rate_pair_check CHECK (from_currency <> to_currency),
+    CONSTRAINT exchange_rate_source_check CHECK (source IN (
+        'manual',
+        'import'
+    ))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS exchange_rate_uuid_idx ON exchange_rate(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS exchange_rate_pair_date_idx ON exchange_rate(from_currency, to_currency, effective_date) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS purchase_order_exchange_rate (
+    id                serial PRIMARY KEY,
+
+    purchase_order_id int NOT NULL REFERENCES purchase_order(id),
+    currency          char(3) NOT NULL,
+    base_currency     char(3) NOT NULL,
+    rate              numeric(20, 10) NOT NULL,
+    rate_date         date NOT NULL,
+
+    locked_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT purchase_order_exchange_rate_rate_check CHECK (rate > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS purchase_order_exchange_rate_currency_idx ON purchase_order_exchange_rate(purchase_order_id, currency);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+DROP TABLE IF EXISTS invoice_match_setting CASCADE;
+DROP TABLE IF EXISTS supplier_invoice_line CASCADE;
+DROP TABLE IF EXISTS supplier_invoice CASCADE;
+UPDATE purchase_order SET status = 'received' WHERE status = 'closed';
+ALTER TABLE purchase_order DROP CONSTRAINT IF EXISTS purchase_order_status_check;
+ALTER TABLE purchase_order ADD CONSTRAINT purchase_order_status_check CHECK (status IN (
+    'draft',
+    'submitted',
+    'confirmed',
+    'partially_received',
+    'received',
+    'cancelled'
+));
+COMMIT;
@@ -0,0 +1,81 @@
+-- Supplier invoices and three-way match. Invoice lines are matched against
+-- the purchase order line price and the quantity received in Inventory (lots
+-- linked by purchase_order_line_uuid). A purchase order can only be closed
+-- once every line is matched within the configured tolerances.
+BEGIN;
+
+ALTER TABLE purchase_order DROP CONSTRAINT IF EXISTS purchase_order_status_check;
+ALTER TABLE purchase_order ADD CONSTRAINT purchase_order_status_check CHECK (status IN (
+    'draft',
+    'submitted',
+    'confirmed',
+    'partially_received',
+    'received',
+    'closed',
+    'cancelled'
+));
+
+CREATE TABLE IF NOT EXISTS supplier_invoice (
+    id                 serial PRIMARY KEY,
+    uuid               uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    supplier_id        int NOT NULL REFERENCES supplier(id),
+    purchase_order_id  int NOT NULL REFERENCES purchase_order(id),
+    invoice_number     varchar(64) NOT NULL,
+    invoice_date       date NOT NULL,
+    due_date           date,
+    currency           char(3) NOT NULL,
+    notes              text,
+
+    created_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at         timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_invoice_uuid_idx ON supplier_invoice(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_invoice_number_idx
+    ON supplier_invoice(supplier_id, invoice_number) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_invoice_purchase_order_id_idx
+    ON supplier_invoice(purchase_order_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS supplier_invoice_line (
+    id                      serial PRIMARY KEY,
+    uuid                    uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    supplier_invoice_id     int NOT NULL REFERENCES supplier_invoice(id),
+    purchase_order_line_id  int NOT NULL REFERENCES purchase_order_line(id),
+    quantity                bigint NOT NULL,
+    quantity_unit           varchar(7) NOT NULL,
+    unit_price_cents        bigint NOT NULL,
+    notes                   text,
+
+    created_at              timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at              timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at              timestamptz,
+
+    CONSTRAINT supplier_invoice_line_quantity_check CHECK (quantity > 0),
+    CONSTRAINT supplier_invoice_line_unit_price_check CHECK (unit_price_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS supplier_invoice_line_uuid_idx ON supplier_invoice_line(uuid);
+CREATE INDEX IF NOT EXISTS supplier_invoice_line_invoice_id_idx
+    ON supplier_invoice_line(supplier_invoice_id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS supplier_invoice_line_purchase_order_line_id_idx
+    ON supplier_invoice_line(purchase_order_line_id) WHERE deleted_at IS NULL;
+
+-- Tolerances are percentages: quantity of the received quantity, price of
+-- the purchase order line's unit cost.
+CREATE TABLE IF NOT EXISTS invoice_match_setting (
+    id                          boolean PRIMARY KEY DEFAULT true,
+    quantity_tolerance_percent  numeric(5, 2) NOT NULL DEFAULT 0,
+    price_tolerance_percent     numeric(5, 2) NOT NULL DEFAULT 0,
+
+    updated_at                  timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT invoice_match_setting_singleton_check CHECK (id),
+    CONSTRAINT invoice_match_setting_quantity_check CHECK (quantity_tolerance_percent >= 0),
+    CONSTRAINT invoice_match_setting_price_check CHECK (price_tolerance_percent >= 0)
+);
+
+INSERT INTO invoice_match_setting (id) VALUES (true) ON CONFLICT (id) DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS purchase_order_line_return;
+ALTER TABLE purchase_order_line DROP COLUMN IF EXISTS returned_quantity;
+COMMIT;
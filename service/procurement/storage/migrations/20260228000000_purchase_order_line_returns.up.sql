@@ -0,0 +1,24 @@
+-- Goods returned to the supplier from Inventory. The returned quantity is
+-- netted off what Inventory received against the line when matching invoices.
+BEGIN;
+
+ALTER TABLE purchase_order_line
+    ADD COLUMN IF NOT EXISTS returned_quantity bigint NOT NULL DEFAULT 0
+    CHECK (returned_quantity >= 0);
+
+-- One row per Inventory supplier return recorded against a line, so a return
+-- Inventory sends again is not counted twice.
+CREATE TABLE IF NOT EXISTS purchase_order_line_return (
+    id                      serial PRIMARY KEY,
+    purchase_order_line_id  int NOT NULL REFERENCES purchase_order_line(id),
+    return_uuid             uuid NOT NULL,
+    quantity                bigint NOT NULL,
+    created_at              timestamptz NOT NULL DEFAULT timezone('utc', now()),
+
+    CONSTRAINT purchase_order_line_return_quantity_check CHECK (quantity > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS purchase_order_line_return_return_uuid_idx ON purchase_order_line_return(return_uuid);
+CREATE INDEX IF NOT EXISTS purchase_order_line_return_line_id_idx ON purchase_order_line_return(purchase_order_line_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+DROP TABLE IF EXISTS purchase_journal_export CASCADE;
+DROP TABLE IF EXISTS accounting_setting CASCADE;
+DROP TABLE IF EXISTS gl_account_mapping CASCADE;
+DROP INDEX IF EXISTS purchase_order_received_at_idx;
+ALTER TABLE purchase_order DROP COLUMN IF EXISTS received_at;
+COMMIT;
@@ -0,0 +1,84 @@
+-- Accounting export: GL accounts for ingredient categories, fee types, item
+-- types and control roles, the date each purchase order was received, and a
+-- record of every purchase journal exported to the books.
+BEGIN;
+
+ALTER TABLE purchase_order ADD COLUMN IF NOT EXISTS received_at timestamptz;
+
+UPDATE purchase_order
+SET received_at = updated_at
+WHERE received_at IS NULL AND status IN ('received', 'closed');
+
+CREATE INDEX IF NOT EXISTS purchase_order_received_at_idx
+    ON purchase_order(received_at) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS gl_account_mapping (
+    id          serial PRIMARY KEY,
+
+    kind        varchar(16) NOT NULL,
+    key         varchar(64) NOT NULL,
+    account     varchar(128) NOT NULL,
+
+    updated_at  timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT gl_account_mapping_kind_check CHECK (kind IN (
+        'role',
+        'category',
+        'fee_type',
+        'item_type'
+    ))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS gl_account_mapping_key_idx ON gl_account_mapping(kind, key);
+
+INSERT INTO gl_account_mapping (kind, key, account) VALUES
+    ('role', 'accounts_payable', 'Accounts Payable'),
+    ('role', 'work_in_process', 'Inventory:Work in Process'),
+    ('role', 'cost_of_goods_sold', 'Cost of Goods Sold'),
+    ('role', 'finished_goods', 'Inventory:Finished Goods'),
+    ('role', 'write_off', 'Inventory Write-offs'),
+    ('role', 'inventory_adjustment', 'Inventory Adjustments'),
+    ('role', 'supplier_returns', 'Supplier Returns'),
+    ('category', 'fermentable', 'Inventory:Raw Materials'),
+    ('category', 'hop', 'Inventory:Raw Materials'),
+    ('category', 'yeast', 'Inventory:Raw Materials'),
+    ('category', 'adjunct', 'Inventory:Raw Materials'),
+    ('category', 'salt', 'Inventory:Raw Materials'),
+    ('category', 'chemical', 'Inventory:Supplies'),
+    ('category', 'gas', 'Inventory:Supplies'),
+    ('category', 'packaging', 'Inventory:Packaging'),
+    ('category', 'other', 'Inventory:Raw Materials'),
+    ('item_type', 'service', 'Purchased Services'),
+    ('item_type', 'equipment', 'Equipment'),
+    ('item_type', 'other', 'Purchases')
+ON CONFLICT (kind, key) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS accounting_setting (
+    id             boolean PRIMARY KEY DEFAULT true,
+    xero_tax_rate  varchar(64) NOT NULL DEFAULT 'Tax Exempt',
+
+    updated_at     timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT accounting_setting_singleton_check CHECK (id)
+);
+
+INSERT INTO accounting_setting (id) VALUES (true) ON CONFLICT (id) DO NOTHING;
+
+-- An export keeps the file as it was sent so it can be downloaded again. A
+-- period can be exported more than once; each export is kept.
+CREATE TABLE IF NOT EXISTS purchase_journal_export (
+    id           serial PRIMARY KEY,
+    uuid         uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    period       char(7) NOT NULL,
+    format       varchar(8) NOT NULL,
+    entry_count  int NOT NULL,
+    total_cents  bigint NOT NULL,
+    content      text NOT NULL,
+
+    created_at   timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    CONSTRAINT purchase_journal_export_format_check CHECK (format IN ('iif', 'xero'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS purchase_journal_export_uuid_idx ON purchase_journal_export(uuid);
+CREATE INDEX IF NOT EXISTS purchase_journal_export_period_idx ON purchase_journal_export(period);
+
+COMMIT;
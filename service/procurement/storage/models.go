@@ -13,9 +13,18 @@ const (
 	PurchaseOrderStatusConfirmed         = "confirmed"
 	PurchaseOrderStatusPartiallyReceived = "partially_received"
 	PurchaseOrderStatusReceived          = "received"
+	PurchaseOrderStatusClosed            = "closed"
 	PurchaseOrderStatusCancelled         = "cancelled"
 )
 
+// Fee allocation bases decide how a purchase order fee is split across the
+// order's lines when computing landed costs.
+const (
+	FeeAllocationBasisValue    = "value"
+	FeeAllocationBasisQuantity = "quantity"
+	FeeAllocationBasisWeight   = "weight"
+)
+
 const (
 	PurchaseOrderItemTypeIngredient = "ingredient"
 	PurchaseOrderItemTypePackaging  = "packaging"
@@ -70,6 +79,7 @@ type PurchaseOrderUpdate struct {
 	OrderedAt   *time.Time
 	ExpectedAt  *time.Time
 	Notes       *string
+	ReceivedAt  *time.Time // Set when the order is fully received
 }
 
 type PurchaseOrderLine struct {
@@ -84,6 +94,7 @@ type PurchaseOrderLine struct {
 	QuantityUnit      string
 	UnitCostCents     int64
 	Currency          string
+	ReturnedQuantity  int64 // Quantity sent back to the supplier, in QuantityUnit
 	entity.Timestamps
 }
 
@@ -105,11 +116,138 @@ type PurchaseOrderFee struct {
 	FeeType           string
 	AmountCents       int64
 	Currency          string
+	AllocationBasis   string
 	entity.Timestamps
 }
 
 type PurchaseOrderFeeUpdate struct {
-	FeeType     *string
-	AmountCents *int64
-	Currency    *string
+	FeeType         *string
+	AmountCents     *int64
+	Currency        *string
+	AllocationBasis *string
+}
+
+// SupplierItem is an item in a supplier's catalog. Prices are quoted per
+// PackUnit; PackSize is how many PackUnits the supplier ships per pack.
+type SupplierItem struct {
+	entity.Identifiers
+	SupplierID        int64
+	SupplierUUID      string
+	SupplierName      string
+	SKU               string
+	Name              string
+	ItemType          string
+	InventoryItemUUID *uuid.UUID
+	PackSize          int64
+	PackUnit          string
+	MinOrderQuantity  *int64
+	LeadTimeDays      *int
+	Notes             *string
+	CurrentPrice      *SupplierItemPrice
+	entity.Timestamps
+}
+
+type SupplierItemUpdate struct {
+	SKU               *string
+	Name              *string
+	ItemType          *string
+	InventoryItemUUID *uuid.UUID
+	PackSize          *int64
+	PackUnit          *string
+	MinOrderQuantity  *int64
+	LeadTimeDays      *int
+	Notes             *string
+}
+
+// SupplierItemPrice is a dated price for a supplier catalog item. The price in
+// effect at a given time is the latest entry effective at or before it.
+type SupplierItemPrice struct {
+	entity.Identifiers
+	SupplierItemID   int64
+	SupplierItemUUID string
+	UnitCostCents    int64
+	Currency         string
+	EffectiveAt      time.Time
+	Notes            *string
+	entity.Timestamps
+}
+
+const (
+	ExchangeRateSourceManual = "manual"
+	ExchangeRateSourceImport = "import"
+)
+
+// ExchangeRate is a dated rate converting one unit of FromCurrency into Rate
+// units of ToCurrency. The rate in effect on a date is the latest entry
+// effective on or before it.
+type ExchangeRate struct {
+	entity.Identifiers
+	FromCurrency  string
+	ToCurrency    string
+	Rate          float64
+	EffectiveDate time.Time
+	Source        string
+	entity.Timestamps
+}
+
+type ExchangeRateFilter struct {
+	Currency *string
+}
+
+// PurchaseOrderExchangeRate is the conversion of one currency on a purchase
+// order into the base currency, locked in when the order was received.
+type PurchaseOrderExchangeRate struct {
+	PurchaseOrderID int64
+	Currency        string
+	BaseCurrency    string
+	Rate            float64
+	RateDate        time.Time
+	LockedAt        time.Time
+}
+
+// SupplierInvoice is a bill from a supplier against one purchase order.
+// InvoiceDate and DueDate are calendar dates.
+type SupplierInvoice struct {
+	entity.Identifiers
+	SupplierID        int64
+	SupplierUUID      string // Joined from supplier table
+	PurchaseOrderID   int64
+	PurchaseOrderUUID string // Joined from purchase_order table
+	OrderNumber       string // Joined from purchase_order table
+	InvoiceNumber     string
+	InvoiceDate       time.Time
+	DueDate           *time.Time
+	Currency          string
+	Notes             *string
+	entity.Timestamps
+}
+
+// SupplierInvoiceLine bills a quantity of one purchase order line at a unit
+// price in the invoice currency.
+type SupplierInvoiceLine struct {
+	entity.Identifiers
+	SupplierInvoiceID     int64
+	SupplierInvoiceUUID   string // Joined from supplier_invoice table
+	InvoiceNumber         string // Joined from supplier_invoice table
+	Currency              string // Joined from supplier_invoice table
+	PurchaseOrderLineID   int64
+	PurchaseOrderLineUUID string // Joined from purchase_order_line table
+	Quantity              int64
+	QuantityUnit          string
+	UnitPriceCents        int64
+	Notes                 *string
+	entity.Timestamps
+}
+
+type SupplierInvoiceFilter struct {
+	PurchaseOrderUUID *string
+	SupplierUUID      *string
+}
+
+// InvoiceMatchSettings are the tolerances used by the three-way match, as
+// percentages of the received quantity and the ordered unit cost.
+type InvoiceMatchSettings struct {
+	QuantityTolerancePercent float64
+	PriceTolerancePercent    float64
+	UpdatedAt                time.Time
 }
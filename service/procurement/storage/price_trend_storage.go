@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	PricePointSourceCatalog       = "catalog"
+	PricePointSourcePurchaseOrder = "purchase_order"
+)
+
+// PricePoint is a single observed price for an inventory item: either a dated
+// catalog price or the unit cost on a purchase order line.
+type PricePoint struct {
+	Source           string
+	SupplierUUID     string
+	SupplierName     string
+	SupplierItemUUID *string
+	SKU              *string
+	ItemName         string
+	QuantityUnit     string
+	UnitCostCents    int64
+	Currency         string
+	At               time.Time
+	// OrderNumber is set for purchase order prices.
+	OrderNumber *string
+}
+
+// ListPricePointsByInventoryItem returns every catalog price and non-cancelled
+// purchase order line price for an inventory item, oldest first. Purchase
+// order prices are dated by the order date, falling back to creation time.
+func (c *Client) ListPricePointsByInventoryItem(ctx context.Context, inventoryItemUUID string) ([]PricePoint, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT 'catalog', s.uuid, s.name, si.uuid::text, si.sku, si.name, si.pack_unit,
+			sip.unit_cost_cents, sip.currency, sip.effective_at, NULL::varchar
+		FROM supplier_item_price sip
+		JOIN supplier_item si ON si.id = sip.supplier_item_id
+		JOIN supplier s ON s.id = si.supplier_id
+		WHERE si.inventory_item_uuid = $1
+		  AND sip.deleted_at IS NULL
+		  AND si.deleted_at IS NULL
+		UNION ALL
+		SELECT 'purchase_order', s.uuid, s.name, NULL, NULL, pol.item_name, pol.quantity_unit,
+			pol.unit_cost_cents, pol.currency, COALESCE(po.ordered_at, po.created_at), po.order_number
+		FROM purchase_order_line pol
+		JOIN purchase_order po ON po.id = pol.purchase_order_id
+		JOIN supplier s ON s.id = po.supplier_id
+		WHERE pol.inventory_item_uuid = $1
+		  AND po.status <> 'cancelled'
+		  AND pol.deleted_at IS NULL
+		  AND po.deleted_at IS NULL
+		ORDER BY 10, 1`,
+		inventoryItemUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing price points: %w", err)
+	}
+	defer rows.Close()
+
+	var points []PricePoint
+	for rows.Next() {
+		var p PricePoint
+		if err := rows.Scan(
+			&p.Source,
+			&p.SupplierUUID,
+			&p.SupplierName,
+			&p.SupplierItemUUID,
+			&p.SKU,
+			&p.ItemName,
+			&p.QuantityUnit,
+			&p.UnitCostCents,
+			&p.Currency,
+			&p.At,
+			&p.OrderNumber,
+		); err != nil {
+			return nil, fmt.Errorf("scanning price point: %w", err)
+		}
+		points = append(points, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing price points: %w", err)
+	}
+
+	return points, nil
+}
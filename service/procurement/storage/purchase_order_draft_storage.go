@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/database"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// CreateDraftPurchaseOrder creates a draft purchase order together with its
+// lines in a single transaction, so a generated order is never left without
+// lines. The order number is always generated and lines are numbered in order.
+func (c *Client) CreateDraftPurchaseOrder(ctx context.Context, order PurchaseOrder, lines []PurchaseOrderLine) (PurchaseOrder, error) {
+	order.Status = PurchaseOrderStatusDraft
+
+	for attempt := 0; attempt < 5; attempt++ {
+		created, err := c.createDraftPurchaseOrder(ctx, order, lines)
+		if err == nil {
+			return created, nil
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" &&
+			(pgErr.ConstraintName == "purchase_order_order_number_idx" || pgErr.ConstraintName == "purchase_order_order_number_key") {
+			continue
+		}
+
+		return PurchaseOrder{}, err
+	}
+
+	return PurchaseOrder{}, fmt.Errorf("creating draft purchase order: could not generate unique order number")
+}
+
+func (c *Client) createDraftPurchaseOrder(ctx context.Context, order PurchaseOrder, lines []PurchaseOrderLine) (PurchaseOrder, error) {
+	orderNumber, err := c.nextPurchaseOrderNumber(ctx, time.Now().UTC())
+	if err != nil {
+		return PurchaseOrder{}, fmt.Errorf("creating draft purchase order: %w", err)
+	}
+	order.OrderNumber = orderNumber
+
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return PurchaseOrder{}, fmt.Errorf("starting draft purchase order transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO purchase_order (
+			supplier_id,
+			order_number,
+			status,
+			expected_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		order.SupplierID,
+		order.OrderNumber,
+		order.Status,
+		order.ExpectedAt,
+		order.Notes,
+	).Scan(&order.ID)
+	if err != nil {
+		return PurchaseOrder{}, fmt.Errorf("creating draft purchase order: %w", err)
+	}
+
+	for i, line := range lines {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO purchase_order_line (
+				purchase_order_id,
+				line_number,
+				item_type,
+				item_name,
+				inventory_item_uuid,
+				quantity,
+				quantity_unit,
+				unit_cost_cents,
+				currency
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+			order.ID,
+			i+1,
+			line.ItemType,
+			line.ItemName,
+			database.UUIDParam(line.InventoryItemUUID),
+			line.Quantity,
+			line.QuantityUnit,
+			line.UnitCostCents,
+			line.Currency,
+		)
+		if err != nil {
+			return PurchaseOrder{}, fmt.Errorf("creating draft purchase order line %d: %w", i+1, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PurchaseOrder{}, fmt.Errorf("committing draft purchase order: %w", err)
+	}
+
+	return c.GetPurchaseOrder(ctx, order.ID)
+}
@@ -10,18 +10,20 @@ import (
 )
 
 func (c *Client) CreatePurchaseOrderFee(ctx context.Context, fee PurchaseOrderFee) (PurchaseOrderFee, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO purchase_order_fee (
 			purchase_order_id,
 			fee_type,
 			amount_cents,
-			currency
-		) VALUES ($1, $2, $3, $4)
-		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, created_at, updated_at, deleted_at`,
+			currency,
+			allocation_basis
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, allocation_basis, created_at, updated_at, deleted_at`,
 		fee.PurchaseOrderID,
 		fee.FeeType,
 		fee.AmountCents,
 		fee.Currency,
+		fee.AllocationBasis,
 	).Scan(
 		&fee.ID,
 		&fee.UUID,
@@ -29,6 +31,7 @@ func (c *Client) CreatePurchaseOrderFee(ctx context.Context, fee PurchaseOrderFe
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -39,7 +42,7 @@ func (c *Client) CreatePurchaseOrderFee(ctx context.Context, fee PurchaseOrderFe
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		fee.PurchaseOrderUUID = &poUUID
 	}
@@ -49,18 +52,20 @@ func (c *Client) CreatePurchaseOrderFee(ctx context.Context, fee PurchaseOrderFe
 
 func (c *Client) UpdatePurchaseOrderFee(ctx context.Context, id int64, update PurchaseOrderFeeUpdate) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_fee
 		SET
 			fee_type = COALESCE($1, fee_type),
 			amount_cents = COALESCE($2, amount_cents),
 			currency = COALESCE($3, currency),
+			allocation_basis = COALESCE($4, allocation_basis),
 			updated_at = timezone('utc', now())
-		WHERE id = $4 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, created_at, updated_at, deleted_at`,
+		WHERE id = $5 AND deleted_at IS NULL
+		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, allocation_basis, created_at, updated_at, deleted_at`,
 		update.FeeType,
 		update.AmountCents,
 		update.Currency,
+		update.AllocationBasis,
 		id,
 	).Scan(
 		&fee.ID,
@@ -69,6 +74,7 @@ func (c *Client) UpdatePurchaseOrderFee(ctx context.Context, id int64, update Pu
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -82,7 +88,7 @@ func (c *Client) UpdatePurchaseOrderFee(ctx context.Context, id int64, update Pu
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		fee.PurchaseOrderUUID = &poUUID
 	}
@@ -92,18 +98,20 @@ func (c *Client) UpdatePurchaseOrderFee(ctx context.Context, id int64, update Pu
 
 func (c *Client) UpdatePurchaseOrderFeeByUUID(ctx context.Context, feeUUID string, update PurchaseOrderFeeUpdate) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_fee
 		SET
 			fee_type = COALESCE($1, fee_type),
 			amount_cents = COALESCE($2, amount_cents),
 			currency = COALESCE($3, currency),
+			allocation_basis = COALESCE($4, allocation_basis),
 			updated_at = timezone('utc', now())
-		WHERE uuid = $4 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, created_at, updated_at, deleted_at`,
+		WHERE uuid = $5 AND deleted_at IS NULL
+		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, allocation_basis, created_at, updated_at, deleted_at`,
 		update.FeeType,
 		update.AmountCents,
 		update.Currency,
+		update.AllocationBasis,
 		feeUUID,
 	).Scan(
 		&fee.ID,
@@ -112,6 +120,7 @@ func (c *Client) UpdatePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -125,7 +134,7 @@ func (c *Client) UpdatePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		fee.PurchaseOrderUUID = &poUUID
 	}
@@ -135,12 +144,12 @@ func (c *Client) UpdatePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 
 func (c *Client) DeletePurchaseOrderFee(ctx context.Context, id int64) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_fee
 		SET deleted_at = timezone('utc', now()),
 			updated_at = timezone('utc', now())
 		WHERE id = $1 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, allocation_basis, created_at, updated_at, deleted_at`,
 		id,
 	).Scan(
 		&fee.ID,
@@ -149,6 +158,7 @@ func (c *Client) DeletePurchaseOrderFee(ctx context.Context, id int64) (Purchase
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -162,7 +172,7 @@ func (c *Client) DeletePurchaseOrderFee(ctx context.Context, id int64) (Purchase
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		fee.PurchaseOrderUUID = &poUUID
 	}
@@ -172,12 +182,12 @@ func (c *Client) DeletePurchaseOrderFee(ctx context.Context, id int64) (Purchase
 
 func (c *Client) DeletePurchaseOrderFeeByUUID(ctx context.Context, feeUUID string) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_fee
 		SET deleted_at = timezone('utc', now()),
 			updated_at = timezone('utc', now())
 		WHERE uuid = $1 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, fee_type, amount_cents, currency, allocation_basis, created_at, updated_at, deleted_at`,
 		feeUUID,
 	).Scan(
 		&fee.ID,
@@ -186,6 +196,7 @@ func (c *Client) DeletePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -199,7 +210,7 @@ func (c *Client) DeletePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, fee.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		fee.PurchaseOrderUUID = &poUUID
 	}
@@ -209,8 +220,8 @@ func (c *Client) DeletePurchaseOrderFeeByUUID(ctx context.Context, feeUUID strin
 
 func (c *Client) GetPurchaseOrderFee(ctx context.Context, id int64) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
-		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.created_at, pof.updated_at, pof.deleted_at
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.allocation_basis, pof.created_at, pof.updated_at, pof.deleted_at
 		FROM purchase_order_fee pof
 		JOIN purchase_order po ON po.id = pof.purchase_order_id
 		WHERE pof.id = $1 AND pof.deleted_at IS NULL`,
@@ -223,6 +234,7 @@ func (c *Client) GetPurchaseOrderFee(ctx context.Context, id int64) (PurchaseOrd
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -239,8 +251,8 @@ func (c *Client) GetPurchaseOrderFee(ctx context.Context, id int64) (PurchaseOrd
 
 func (c *Client) GetPurchaseOrderFeeByUUID(ctx context.Context, feeUUID string) (PurchaseOrderFee, error) {
 	var fee PurchaseOrderFee
-	err := c.DB().QueryRow(ctx, `
-		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.created_at, pof.updated_at, pof.deleted_at
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.allocation_basis, pof.created_at, pof.updated_at, pof.deleted_at
 		FROM purchase_order_fee pof
 		JOIN purchase_order po ON po.id = pof.purchase_order_id
 		WHERE pof.uuid = $1 AND pof.deleted_at IS NULL`,
@@ -253,6 +265,7 @@ func (c *Client) GetPurchaseOrderFeeByUUID(ctx context.Context, feeUUID string)
 		&fee.FeeType,
 		&fee.AmountCents,
 		&fee.Currency,
+		&fee.AllocationBasis,
 		&fee.CreatedAt,
 		&fee.UpdatedAt,
 		&fee.DeletedAt,
@@ -268,8 +281,8 @@ func (c *Client) GetPurchaseOrderFeeByUUID(ctx context.Context, feeUUID string)
 }
 
 func (c *Client) ListPurchaseOrderFees(ctx context.Context) ([]PurchaseOrderFee, error) {
-	rows, err := c.DB().Query(ctx, `
-		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.created_at, pof.updated_at, pof.deleted_at
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.allocation_basis, pof.created_at, pof.updated_at, pof.deleted_at
 		FROM purchase_order_fee pof
 		JOIN purchase_order po ON po.id = pof.purchase_order_id
 		WHERE pof.deleted_at IS NULL
@@ -291,6 +304,7 @@ func (c *Client) ListPurchaseOrderFees(ctx context.Context) ([]PurchaseOrderFee,
 			&fee.FeeType,
 			&fee.AmountCents,
 			&fee.Currency,
+			&fee.AllocationBasis,
 			&fee.CreatedAt,
 			&fee.UpdatedAt,
 			&fee.DeletedAt,
@@ -307,8 +321,8 @@ func (c *Client) ListPurchaseOrderFees(ctx context.Context) ([]PurchaseOrderFee,
 }
 
 func (c *Client) ListPurchaseOrderFeesByOrderUUID(ctx context.Context, orderUUID string) ([]PurchaseOrderFee, error) {
-	rows, err := c.DB().Query(ctx, `
-		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.created_at, pof.updated_at, pof.deleted_at
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pof.id, pof.uuid, pof.purchase_order_id, po.uuid, pof.fee_type, pof.amount_cents, pof.currency, pof.allocation_basis, pof.created_at, pof.updated_at, pof.deleted_at
 		FROM purchase_order_fee pof
 		JOIN purchase_order po ON po.id = pof.purchase_order_id
 		WHERE po.uuid = $1 AND pof.deleted_at IS NULL
@@ -331,6 +345,7 @@ func (c *Client) ListPurchaseOrderFeesByOrderUUID(ctx context.Context, orderUUID
 			&fee.FeeType,
 			&fee.AmountCents,
 			&fee.Currency,
+			&fee.AllocationBasis,
 			&fee.CreatedAt,
 			&fee.UpdatedAt,
 			&fee.DeletedAt,
@@ -10,8 +10,8 @@ import (
 
 // ListPurchaseOrderLinesByUUIDs returns purchase order lines matching any of the given UUIDs.
 func (c *Client) ListPurchaseOrderLinesByUUIDs(ctx context.Context, uuids []string) ([]PurchaseOrderLine, error) {
-	rows, err := c.DB().Query(ctx, `
-		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.created_at, pol.updated_at, pol.deleted_at
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
 		FROM purchase_order_line pol
 		JOIN purchase_order po ON po.id = pol.purchase_order_id
 		WHERE pol.uuid = ANY($1::uuid[]) AND pol.deleted_at IS NULL`,
@@ -39,6 +39,7 @@ func (c *Client) ListPurchaseOrderLinesByUUIDs(ctx context.Context, uuids []stri
 			&line.QuantityUnit,
 			&line.UnitCostCents,
 			&line.Currency,
+			&line.ReturnedQuantity,
 			&line.CreatedAt,
 			&line.UpdatedAt,
 			&line.DeletedAt,
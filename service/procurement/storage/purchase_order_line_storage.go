@@ -13,7 +13,7 @@ import (
 
 func (c *Client) CreatePurchaseOrderLine(ctx context.Context, line PurchaseOrderLine) (PurchaseOrderLine, error) {
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO purchase_order_line (
 			purchase_order_id,
 			line_number,
@@ -25,7 +25,7 @@ func (c *Client) CreatePurchaseOrderLine(ctx context.Context, line PurchaseOrder
 			unit_cost_cents,
 			currency
 		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, returned_quantity, created_at, updated_at, deleted_at`,
 		line.PurchaseOrderID,
 		line.LineNumber,
 		line.ItemType,
@@ -47,6 +47,7 @@ func (c *Client) CreatePurchaseOrderLine(ctx context.Context, line PurchaseOrder
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -59,7 +60,7 @@ func (c *Client) CreatePurchaseOrderLine(ctx context.Context, line PurchaseOrder
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		line.PurchaseOrderUUID = &poUUID
 	}
@@ -70,7 +71,7 @@ func (c *Client) CreatePurchaseOrderLine(ctx context.Context, line PurchaseOrder
 func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, id int64, update PurchaseOrderLineUpdate) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_line
 		SET
 			line_number = COALESCE($1, line_number),
@@ -83,7 +84,7 @@ func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, id int64, update P
 			currency = COALESCE($8, currency),
 			updated_at = timezone('utc', now())
 		WHERE id = $9 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, returned_quantity, created_at, updated_at, deleted_at`,
 		update.LineNumber,
 		update.ItemType,
 		update.ItemName,
@@ -105,6 +106,7 @@ func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, id int64, update P
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -120,7 +122,7 @@ func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, id int64, update P
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		line.PurchaseOrderUUID = &poUUID
 	}
@@ -131,7 +133,7 @@ func (c *Client) UpdatePurchaseOrderLine(ctx context.Context, id int64, update P
 func (c *Client) UpdatePurchaseOrderLineByUUID(ctx context.Context, lineUUID string, update PurchaseOrderLineUpdate) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_line
 		SET
 			line_number = COALESCE($1, line_number),
@@ -144,7 +146,7 @@ func (c *Client) UpdatePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 			currency = COALESCE($8, currency),
 			updated_at = timezone('utc', now())
 		WHERE uuid = $9 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, returned_quantity, created_at, updated_at, deleted_at`,
 		update.LineNumber,
 		update.ItemType,
 		update.ItemName,
@@ -166,6 +168,7 @@ func (c *Client) UpdatePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -181,7 +184,7 @@ func (c *Client) UpdatePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		line.PurchaseOrderUUID = &poUUID
 	}
@@ -192,12 +195,12 @@ func (c *Client) UpdatePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 func (c *Client) DeletePurchaseOrderLine(ctx context.Context, id int64) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_line
 		SET deleted_at = timezone('utc', now()),
 			updated_at = timezone('utc', now())
 		WHERE id = $1 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, returned_quantity, created_at, updated_at, deleted_at`,
 		id,
 	).Scan(
 		&line.ID,
@@ -211,6 +214,7 @@ func (c *Client) DeletePurchaseOrderLine(ctx context.Context, id int64) (Purchas
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -226,7 +230,7 @@ func (c *Client) DeletePurchaseOrderLine(ctx context.Context, id int64) (Purchas
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		line.PurchaseOrderUUID = &poUUID
 	}
@@ -237,12 +241,12 @@ func (c *Client) DeletePurchaseOrderLine(ctx context.Context, id int64) (Purchas
 func (c *Client) DeletePurchaseOrderLineByUUID(ctx context.Context, lineUUID string) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order_line
 		SET deleted_at = timezone('utc', now()),
 			updated_at = timezone('utc', now())
 		WHERE uuid = $1 AND deleted_at IS NULL
-		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, purchase_order_id, line_number, item_type, item_name, inventory_item_uuid, quantity, quantity_unit, unit_cost_cents, currency, returned_quantity, created_at, updated_at, deleted_at`,
 		lineUUID,
 	).Scan(
 		&line.ID,
@@ -256,6 +260,7 @@ func (c *Client) DeletePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -271,7 +276,7 @@ func (c *Client) DeletePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 
 	// Resolve purchase order UUID
 	var poUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM purchase_order WHERE id = $1`, line.PurchaseOrderID).Scan(&poUUID)
 	if sErr == nil {
 		line.PurchaseOrderUUID = &poUUID
 	}
@@ -282,8 +287,8 @@ func (c *Client) DeletePurchaseOrderLineByUUID(ctx context.Context, lineUUID str
 func (c *Client) GetPurchaseOrderLine(ctx context.Context, id int64) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
-		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.created_at, pol.updated_at, pol.deleted_at
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
 		FROM purchase_order_line pol
 		JOIN purchase_order po ON po.id = pol.purchase_order_id
 		WHERE pol.id = $1 AND pol.deleted_at IS NULL`,
@@ -301,6 +306,7 @@ func (c *Client) GetPurchaseOrderLine(ctx context.Context, id int64) (PurchaseOr
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -319,8 +325,8 @@ func (c *Client) GetPurchaseOrderLine(ctx context.Context, id int64) (PurchaseOr
 func (c *Client) GetPurchaseOrderLineByUUID(ctx context.Context, lineUUID string) (PurchaseOrderLine, error) {
 	var line PurchaseOrderLine
 	var inventoryItemUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
-		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.created_at, pol.updated_at, pol.deleted_at
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
 		FROM purchase_order_line pol
 		JOIN purchase_order po ON po.id = pol.purchase_order_id
 		WHERE pol.uuid = $1 AND pol.deleted_at IS NULL`,
@@ -338,6 +344,7 @@ func (c *Client) GetPurchaseOrderLineByUUID(ctx context.Context, lineUUID string
 		&line.QuantityUnit,
 		&line.UnitCostCents,
 		&line.Currency,
+		&line.ReturnedQuantity,
 		&line.CreatedAt,
 		&line.UpdatedAt,
 		&line.DeletedAt,
@@ -354,8 +361,8 @@ func (c *Client) GetPurchaseOrderLineByUUID(ctx context.Context, lineUUID string
 }
 
 func (c *Client) ListPurchaseOrderLines(ctx context.Context) ([]PurchaseOrderLine, error) {
-	rows, err := c.DB().Query(ctx, `
-		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.created_at, pol.updated_at, pol.deleted_at
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
 		FROM purchase_order_line pol
 		JOIN purchase_order po ON po.id = pol.purchase_order_id
 		WHERE pol.deleted_at IS NULL
@@ -383,6 +390,7 @@ func (c *Client) ListPurchaseOrderLines(ctx context.Context) ([]PurchaseOrderLin
 			&line.QuantityUnit,
 			&line.UnitCostCents,
 			&line.Currency,
+			&line.ReturnedQuantity,
 			&line.CreatedAt,
 			&line.UpdatedAt,
 			&line.DeletedAt,
@@ -400,8 +408,8 @@ func (c *Client) ListPurchaseOrderLines(ctx context.Context) ([]PurchaseOrderLin
 }
 
 func (c *Client) ListPurchaseOrderLinesByOrderUUID(ctx context.Context, orderUUID string) ([]PurchaseOrderLine, error) {
-	rows, err := c.DB().Query(ctx, `
-		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.created_at, pol.updated_at, pol.deleted_at
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT pol.id, pol.uuid, pol.purchase_order_id, po.uuid, pol.line_number, pol.item_type, pol.item_name, pol.inventory_item_uuid, pol.quantity, pol.quantity_unit, pol.unit_cost_cents, pol.currency, pol.returned_quantity, pol.created_at, pol.updated_at, pol.deleted_at
 		FROM purchase_order_line pol
 		JOIN purchase_order po ON po.id = pol.purchase_order_id
 		WHERE po.uuid = $1 AND pol.deleted_at IS NULL
@@ -430,6 +438,7 @@ func (c *Client) ListPurchaseOrderLinesByOrderUUID(ctx context.Context, orderUUI
 			&line.QuantityUnit,
 			&line.UnitCostCents,
 			&line.Currency,
+			&line.ReturnedQuantity,
 			&line.CreatedAt,
 			&line.UpdatedAt,
 			&line.DeletedAt,
@@ -445,3 +454,70 @@ func (c *Client) ListPurchaseOrderLinesByOrderUUID(ctx context.Context, orderUUI
 
 	return lines, nil
 }
+
+// RecordPurchaseOrderLineReturn adds quantity returned to the supplier to a
+// line. A received order goes back to partially received, since the returned
+// goods are no longer on hand. returnUUID identifies the Inventory supplier
+// return; recording the same return again leaves the line unchanged, so
+// Inventory can retry.
+func (c *Client) RecordPurchaseOrderLineReturn(ctx context.Context, lineID int64, returnUUID string, quantity int64) (PurchaseOrderLine, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return PurchaseOrderLine{}, fmt.Errorf("starting purchase order line return transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var orderID int64
+	err = tx.QueryRow(ctx, `
+		UPDATE purchase_order_line
+		SET returned_quantity = returned_quantity + $2,
+			updated_at = timezone('utc', now())
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING purchase_order_id`,
+		lineID,
+		quantity,
+	).Scan(&orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PurchaseOrderLine{}, service.ErrNotFound
+		}
+		return PurchaseOrderLine{}, fmt.Errorf("recording purchase order line return: %w", err)
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO purchase_order_line_return (purchase_order_line_id, return_uuid, quantity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (return_uuid) DO NOTHING`,
+		lineID,
+		returnUUID,
+		quantity,
+	)
+	if err != nil {
+		return PurchaseOrderLine{}, fmt.Errorf("recording purchase order line return: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		// Already recorded; the deferred rollback undoes the increment.
+		return c.GetPurchaseOrderLine(ctx, lineID)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE purchase_order
+		SET status = $2,
+			updated_at = timezone('utc', now())
+		WHERE id = $1 AND status = $3`,
+		orderID,
+		PurchaseOrderStatusPartiallyReceived,
+		PurchaseOrderStatusReceived,
+	)
+	if err != nil {
+		return PurchaseOrderLine{}, fmt.Errorf("reopening purchase order after return: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PurchaseOrderLine{}, fmt.Errorf("committing purchase order line return transaction: %w", err)
+	}
+
+	return c.GetPurchaseOrderLine(ctx, lineID)
+}
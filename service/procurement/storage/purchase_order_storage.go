@@ -26,7 +26,7 @@ func (c *Client) CreatePurchaseOrder(ctx context.Context, order PurchaseOrder) (
 			order.OrderNumber = orderNumber
 		}
 
-		err := c.DB().QueryRow(ctx, `
+		err := c.Conn(ctx).QueryRow(ctx, `
 			INSERT INTO purchase_order (
 				supplier_id,
 				order_number,
@@ -58,7 +58,7 @@ func (c *Client) CreatePurchaseOrder(ctx context.Context, order PurchaseOrder) (
 		if err == nil {
 			// Resolve supplier UUID
 			var supplierUUID string
-			sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
+			sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
 			if sErr == nil {
 				order.SupplierUUID = &supplierUUID
 			}
@@ -83,7 +83,7 @@ func (c *Client) CreatePurchaseOrder(ctx context.Context, order PurchaseOrder) (
 
 func (c *Client) UpdatePurchaseOrder(ctx context.Context, id int64, update PurchaseOrderUpdate) (PurchaseOrder, error) {
 	var order PurchaseOrder
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order
 		SET
 			order_number = COALESCE($1, order_number),
@@ -91,6 +91,7 @@ func (c *Client) UpdatePurchaseOrder(ctx context.Context, id int64, update Purch
 			ordered_at = COALESCE($3, ordered_at),
 			expected_at = COALESCE($4, expected_at),
 			notes = COALESCE($5, notes),
+			received_at = COALESCE($7, received_at),
 			updated_at = timezone('utc', now())
 		WHERE id = $6 AND deleted_at IS NULL
 		RETURNING id, uuid, supplier_id, order_number, status, ordered_at, expected_at, notes, created_at, updated_at, deleted_at`,
@@ -100,6 +101,7 @@ func (c *Client) UpdatePurchaseOrder(ctx context.Context, id int64, update Purch
 		update.ExpectedAt,
 		update.Notes,
 		id,
+		update.ReceivedAt,
 	).Scan(
 		&order.ID,
 		&order.UUID,
@@ -122,7 +124,7 @@ func (c *Client) UpdatePurchaseOrder(ctx context.Context, id int64, update Purch
 
 	// Resolve supplier UUID
 	var supplierUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
 	if sErr == nil {
 		order.SupplierUUID = &supplierUUID
 	}
@@ -132,7 +134,7 @@ func (c *Client) UpdatePurchaseOrder(ctx context.Context, id int64, update Purch
 
 func (c *Client) UpdatePurchaseOrderByUUID(ctx context.Context, orderUUID string, update PurchaseOrderUpdate) (PurchaseOrder, error) {
 	var order PurchaseOrder
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE purchase_order
 		SET
 			order_number = COALESCE($1, order_number),
@@ -140,6 +142,7 @@ func (c *Client) UpdatePurchaseOrderByUUID(ctx context.Context, orderUUID string
 			ordered_at = COALESCE($3, ordered_at),
 			expected_at = COALESCE($4, expected_at),
 			notes = COALESCE($5, notes),
+			received_at = COALESCE($7, received_at),
 			updated_at = timezone('utc', now())
 		WHERE uuid = $6 AND deleted_at IS NULL
 		RETURNING id, uuid, supplier_id, order_number, status, ordered_at, expected_at, notes, created_at, updated_at, deleted_at`,
@@ -149,6 +152,7 @@ func (c *Client) UpdatePurchaseOrderByUUID(ctx context.Context, orderUUID string
 		update.ExpectedAt,
 		update.Notes,
 		orderUUID,
+		update.ReceivedAt,
 	).Scan(
 		&order.ID,
 		&order.UUID,
@@ -171,7 +175,7 @@ func (c *Client) UpdatePurchaseOrderByUUID(ctx context.Context, orderUUID string
 
 	// Resolve supplier UUID
 	var supplierUUID string
-	sErr := c.DB().QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
+	sErr := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM supplier WHERE id = $1`, order.SupplierID).Scan(&supplierUUID)
 	if sErr == nil {
 		order.SupplierUUID = &supplierUUID
 	}
@@ -183,7 +187,7 @@ func (c *Client) nextPurchaseOrderNumber(ctx context.Context, now time.Time) (st
 	prefix := now.Format("20060102")
 	pattern := fmt.Sprintf("^%s[0-9]{3}$", prefix)
 	var maxSuffix int
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT COALESCE(MAX(CAST(SUBSTRING(order_number FROM 9 FOR 3) AS int)), 0)
 		FROM purchase_order
 		WHERE order_number LIKE $1 AND order_number ~ $2`,
@@ -202,7 +206,7 @@ func (c *Client) nextPurchaseOrderNumber(ctx context.Context, now time.Time) (st
 
 func (c *Client) GetPurchaseOrder(ctx context.Context, id int64) (PurchaseOrder, error) {
 	var order PurchaseOrder
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT po.id, po.uuid, po.supplier_id, s.uuid, po.order_number, po.status, po.ordered_at, po.expected_at, po.notes, po.created_at, po.updated_at, po.deleted_at
 		FROM purchase_order po
 		JOIN supplier s ON s.id = po.supplier_id
@@ -234,7 +238,7 @@ func (c *Client) GetPurchaseOrder(ctx context.Context, id int64) (PurchaseOrder,
 
 func (c *Client) GetPurchaseOrderByUUID(ctx context.Context, orderUUID string) (PurchaseOrder, error) {
 	var order PurchaseOrder
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT po.id, po.uuid, po.supplier_id, s.uuid, po.order_number, po.status, po.ordered_at, po.expected_at, po.notes, po.created_at, po.updated_at, po.deleted_at
 		FROM purchase_order po
 		JOIN supplier s ON s.id = po.supplier_id
@@ -265,7 +269,7 @@ func (c *Client) GetPurchaseOrderByUUID(ctx context.Context, orderUUID string) (
 }
 
 func (c *Client) ListPurchaseOrders(ctx context.Context) ([]PurchaseOrder, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT po.id, po.uuid, po.supplier_id, s.uuid, po.order_number, po.status, po.ordered_at, po.expected_at, po.notes, po.created_at, po.updated_at, po.deleted_at
 		FROM purchase_order po
 		JOIN supplier s ON s.id = po.supplier_id
@@ -306,7 +310,7 @@ func (c *Client) ListPurchaseOrders(ctx context.Context) ([]PurchaseOrder, error
 }
 
 func (c *Client) ListPurchaseOrdersBySupplierUUID(ctx context.Context, supplierUUID string) ([]PurchaseOrder, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT po.id, po.uuid, po.supplier_id, s.uuid, po.order_number, po.status, po.ordered_at, po.expected_at, po.notes, po.created_at, po.updated_at, po.deleted_at
 		FROM purchase_order po
 		JOIN supplier s ON s.id = po.supplier_id
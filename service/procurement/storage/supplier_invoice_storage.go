@@ -0,0 +1,324 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrDuplicateSupplierInvoice is returned when the supplier already has an
+// invoice with the same number.
+var ErrDuplicateSupplierInvoice = fmt.Errorf("supplier invoice with this number already exists")
+
+// supplierInvoiceSelectSQL is the column list and joins shared by supplier
+// invoice queries.
+const supplierInvoiceSelectSQL = `
+	SELECT si.id, si.uuid, si.supplier_id, s.uuid, si.purchase_order_id, po.uuid, po.order_number,
+	       si.invoice_number, si.invoice_date, si.due_date, si.currency, si.notes,
+	       si.created_at, si.updated_at, si.deleted_at
+	FROM supplier_invoice si
+	JOIN supplier s ON s.id = si.supplier_id
+	JOIN purchase_order po ON po.id = si.purchase_order_id`
+
+// supplierInvoiceLineSelectSQL is the column list and joins shared by
+// supplier invoice line queries.
+const supplierInvoiceLineSelectSQL = `
+	SELECT sil.id, sil.uuid, sil.supplier_invoice_id, si.uuid, si.invoice_number, si.currency,
+	       sil.purchase_order_line_id, pol.uuid,
+	       sil.quantity, sil.quantity_unit, sil.unit_price_cents, sil.notes,
+	       sil.created_at, sil.updated_at, sil.deleted_at
+	FROM supplier_invoice_line sil
+	JOIN supplier_invoice si ON si.id = sil.supplier_invoice_id
+	JOIN purchase_order_line pol ON pol.id = sil.purchase_order_line_id`
+
+func scanSupplierInvoice(row pgx.Row) (SupplierInvoice, error) {
+	var inv SupplierInvoice
+	err := row.Scan(
+		&inv.ID,
+		&inv.UUID,
+		&inv.SupplierID,
+		&inv.SupplierUUID,
+		&inv.PurchaseOrderID,
+		&inv.PurchaseOrderUUID,
+		&inv.OrderNumber,
+		&inv.InvoiceNumber,
+		&inv.InvoiceDate,
+		&inv.DueDate,
+		&inv.Currency,
+		&inv.Notes,
+		&inv.CreatedAt,
+		&inv.UpdatedAt,
+		&inv.DeletedAt,
+	)
+	return inv, err
+}
+
+func scanSupplierInvoiceLine(row pgx.Row) (SupplierInvoiceLine, error) {
+	var line SupplierInvoiceLine
+	err := row.Scan(
+		&line.ID,
+		&line.UUID,
+		&line.SupplierInvoiceID,
+		&line.SupplierInvoiceUUID,
+		&line.InvoiceNumber,
+		&line.Currency,
+		&line.PurchaseOrderLineID,
+		&line.PurchaseOrderLineUUID,
+		&line.Quantity,
+		&line.QuantityUnit,
+		&line.UnitPriceCents,
+		&line.Notes,
+		&line.CreatedAt,
+		&line.UpdatedAt,
+		&line.DeletedAt,
+	)
+	return line, err
+}
+
+// CreateSupplierInvoiceWithLines atomically creates a supplier invoice and its
+// lines.
+func (c *Client) CreateSupplierInvoiceWithLines(ctx context.Context, invoice SupplierInvoice, lines []SupplierInvoiceLine) (SupplierInvoice, []SupplierInvoiceLine, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return SupplierInvoice{}, nil, fmt.Errorf("starting supplier invoice transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var invoiceID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO supplier_invoice (
+			supplier_id,
+			purchase_order_id,
+			invoice_number,
+			invoice_date,
+			due_date,
+			currency,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		invoice.SupplierID,
+		invoice.PurchaseOrderID,
+		invoice.InvoiceNumber,
+		invoice.InvoiceDate,
+		invoice.DueDate,
+		invoice.Currency,
+		invoice.Notes,
+	).Scan(&invoiceID)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return SupplierInvoice{}, nil, ErrDuplicateSupplierInvoice
+		}
+		return SupplierInvoice{}, nil, fmt.Errorf("creating supplier invoice: %w", err)
+	}
+
+	for _, line := range lines {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO supplier_invoice_line (
+				supplier_invoice_id,
+				purchase_order_line_id,
+				quantity,
+				quantity_unit,
+				unit_price_cents,
+				notes
+			) VALUES ($1, $2, $3, $4, $5, $6)`,
+			invoiceID,
+			line.PurchaseOrderLineID,
+			line.Quantity,
+			line.QuantityUnit,
+			line.UnitPriceCents,
+			line.Notes,
+		)
+		if err != nil {
+			return SupplierInvoice{}, nil, fmt.Errorf("creating supplier invoice line: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return SupplierInvoice{}, nil, fmt.Errorf("committing supplier invoice transaction: %w", err)
+	}
+
+	created, err := scanSupplierInvoice(c.Conn(ctx).QueryRow(ctx, supplierInvoiceSelectSQL+`
+		WHERE si.id = $1`, invoiceID))
+	if err != nil {
+		return SupplierInvoice{}, nil, fmt.Errorf("getting created supplier invoice: %w", err)
+	}
+
+	createdLines, err := c.ListSupplierInvoiceLinesByInvoiceIDs(ctx, []int64{invoiceID})
+	if err != nil {
+		return SupplierInvoice{}, nil, err
+	}
+
+	return created, createdLines, nil
+}
+
+func (c *Client) GetSupplierInvoiceByUUID(ctx context.Context, invoiceUUID string) (SupplierInvoice, error) {
+	invoice, err := scanSupplierInvoice(c.Conn(ctx).QueryRow(ctx, supplierInvoiceSelectSQL+`
+		WHERE si.uuid = $1 AND si.deleted_at IS NULL`, invoiceUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SupplierInvoice{}, service.ErrNotFound
+		}
+		return SupplierInvoice{}, fmt.Errorf("getting supplier invoice by uuid: %w", err)
+	}
+
+	return invoice, nil
+}
+
+// ListSupplierInvoices returns supplier invoices, newest first, optionally
+// limited to one purchase order or supplier.
+func (c *Client) ListSupplierInvoices(ctx context.Context, filter SupplierInvoiceFilter) ([]SupplierInvoice, error) {
+	rows, err := c.Conn(ctx).Query(ctx, supplierInvoiceSelectSQL+`
+		WHERE si.deleted_at IS NULL
+		  AND ($1::uuid IS NULL OR po.uuid = $1)
+		  AND ($2::uuid IS NULL OR s.uuid = $2)
+		ORDER BY si.invoice_date DESC, si.id DESC`,
+		filter.PurchaseOrderUUID,
+		filter.SupplierUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier invoices: %w", err)
+	}
+	defer rows.Close()
+
+	var invoices []SupplierInvoice
+	for rows.Next() {
+		invoice, err := scanSupplierInvoice(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning supplier invoice: %w", err)
+		}
+		invoices = append(invoices, invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing supplier invoices: %w", err)
+	}
+
+	return invoices, nil
+}
+
+// ListSupplierInvoiceLinesByInvoiceIDs returns the lines of the given
+// invoices.
+func (c *Client) ListSupplierInvoiceLinesByInvoiceIDs(ctx context.Context, invoiceIDs []int64) ([]SupplierInvoiceLine, error) {
+	return c.listSupplierInvoiceLines(ctx, `
+		WHERE sil.supplier_invoice_id = ANY($1::int[]) AND sil.deleted_at IS NULL
+		ORDER BY sil.supplier_invoice_id, sil.id`, invoiceIDs)
+}
+
+// ListSupplierInvoiceLinesByOrderID returns every invoice line billed against
+// the lines of a purchase order.
+func (c *Client) ListSupplierInvoiceLinesByOrderID(ctx context.Context, orderID int64) ([]SupplierInvoiceLine, error) {
+	return c.listSupplierInvoiceLines(ctx, `
+		WHERE pol.purchase_order_id = $1 AND sil.deleted_at IS NULL AND si.deleted_at IS NULL
+		ORDER BY si.invoice_date, sil.id`, orderID)
+}
+
+func (c *Client) listSupplierInvoiceLines(ctx context.Context, where string, arg any) ([]SupplierInvoiceLine, error) {
+	rows, err := c.Conn(ctx).Query(ctx, supplierInvoiceLineSelectSQL+where, arg)
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier invoice lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []SupplierInvoiceLine
+	for rows.Next() {
+		line, err := scanSupplierInvoiceLine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning supplier invoice line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing supplier invoice lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// DeleteSupplierInvoice soft-deletes a supplier invoice and its lines.
+func (c *Client) DeleteSupplierInvoice(ctx context.Context, id int64) error {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("starting supplier invoice delete transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE supplier_invoice
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE id = $1 AND deleted_at IS NULL`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting supplier invoice: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE supplier_invoice_line
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE supplier_invoice_id = $1 AND deleted_at IS NULL`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("soft-deleting supplier invoice lines: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing supplier invoice delete transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetInvoiceMatchSettings returns the three-way match tolerances.
+func (c *Client) GetInvoiceMatchSettings(ctx context.Context) (InvoiceMatchSettings, error) {
+	var settings InvoiceMatchSettings
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT quantity_tolerance_percent, price_tolerance_percent, updated_at
+		FROM invoice_match_setting
+		WHERE id`,
+	).Scan(
+		&settings.QuantityTolerancePercent,
+		&settings.PriceTolerancePercent,
+		&settings.UpdatedAt,
+	)
+	if err != nil {
+		return InvoiceMatchSettings{}, fmt.Errorf("getting invoice match settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// SetInvoiceMatchSettings changes the three-way match tolerances.
+func (c *Client) SetInvoiceMatchSettings(ctx context.Context, settings InvoiceMatchSettings) (InvoiceMatchSettings, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO invoice_match_setting (id, quantity_tolerance_percent, price_tolerance_percent)
+		VALUES (true, $1, $2)
+		ON CONFLICT (id) DO UPDATE
+		SET quantity_tolerance_percent = EXCLUDED.quantity_tolerance_percent,
+			price_tolerance_percent = EXCLUDED.price_tolerance_percent,
+			updated_at = timezone('utc', now())
+		RETURNING quantity_tolerance_percent, price_tolerance_percent, updated_at`,
+		settings.QuantityTolerancePercent,
+		settings.PriceTolerancePercent,
+	).Scan(
+		&settings.QuantityTolerancePercent,
+		&settings.PriceTolerancePercent,
+		&settings.UpdatedAt,
+	)
+	if err != nil {
+		return InvoiceMatchSettings{}, fmt.Errorf("setting invoice match settings: %w", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,369 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/database"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrDuplicateSupplierSKU is returned when a supplier already has a catalog
+// item with the same SKU.
+var ErrDuplicateSupplierSKU = fmt.Errorf("supplier already has a catalog item with this sku")
+
+// SupplierItemFilter narrows ListSupplierItems. Nil fields are ignored.
+type SupplierItemFilter struct {
+	SupplierUUID      *string
+	InventoryItemUUID *string
+	// InventoryItemUUIDs matches items linked to any of the given inventory
+	// items.
+	InventoryItemUUIDs []string
+}
+
+// supplierItemColumns is the column list shared by supplier item queries. The
+// trailing columns are the price currently in effect, if any.
+const supplierItemColumns = `si.id, si.uuid, si.supplier_id, s.uuid, s.name, si.sku, si.name, si.item_type,
+	si.inventory_item_uuid, si.pack_size, si.pack_unit, si.min_order_quantity, si.lead_time_days, si.notes,
+	si.created_at, si.updated_at, si.deleted_at,
+	cp.id, cp.uuid, cp.unit_cost_cents, cp.currency, cp.effective_at`
+
+// supplierItemJoins is the JOIN clause shared by supplier item queries.
+const supplierItemJoins = `
+	FROM supplier_item si
+	JOIN supplier s ON s.id = si.supplier_id
+	LEFT JOIN LATERAL (
+		SELECT sip.id, sip.uuid, sip.unit_cost_cents, sip.currency, sip.effective_at
+		FROM supplier_item_price sip
+		WHERE sip.supplier_item_id = si.id
+		  AND sip.deleted_at IS NULL
+		  AND sip.effective_at <= timezone('utc', now())
+		ORDER BY sip.effective_at DESC, sip.id DESC
+		LIMIT 1
+	) cp ON true`
+
+func scanSupplierItem(row pgx.Row) (SupplierItem, error) {
+	var item SupplierItem
+	var inventoryItemUUID pgtype.UUID
+	var priceID *int64
+	var priceUUID pgtype.UUID
+	var priceCents *int64
+	var priceCurrency *string
+	var priceEffectiveAt *time.Time
+	if err := row.Scan(
+		&item.ID,
+		&item.UUID,
+		&item.SupplierID,
+		&item.SupplierUUID,
+		&item.SupplierName,
+		&item.SKU,
+		&item.Name,
+		&item.ItemType,
+		&inventoryItemUUID,
+		&item.PackSize,
+		&item.PackUnit,
+		&item.MinOrderQuantity,
+		&item.LeadTimeDays,
+		&item.Notes,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.DeletedAt,
+		&priceID,
+		&priceUUID,
+		&priceCents,
+		&priceCurrency,
+		&priceEffectiveAt,
+	); err != nil {
+		return SupplierItem{}, err
+	}
+	database.AssignUUIDPointer(&item.InventoryItemUUID, inventoryItemUUID)
+
+	if priceID != nil {
+		price := SupplierItemPrice{
+			SupplierItemID:   item.ID,
+			SupplierItemUUID: item.UUID.String(),
+			UnitCostCents:    *priceCents,
+			Currency:         *priceCurrency,
+			EffectiveAt:      *priceEffectiveAt,
+		}
+		price.ID = *priceID
+		price.UUID = uuid.UUID(priceUUID.Bytes)
+		item.CurrentPrice = &price
+	}
+
+	return item, nil
+}
+
+func isDuplicateSupplierSKU(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "supplier_item_sku_idx"
+}
+
+func (c *Client) CreateSupplierItem(ctx context.Context, item SupplierItem) (SupplierItem, error) {
+	var itemUUID string
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO supplier_item (
+			supplier_id,
+			sku,
+			name,
+			item_type,
+			inventory_item_uuid,
+			pack_size,
+			pack_unit,
+			min_order_quantity,
+			lead_time_days,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING uuid`,
+		item.SupplierID,
+		item.SKU,
+		item.Name,
+		item.ItemType,
+		database.UUIDParam(item.InventoryItemUUID),
+		item.PackSize,
+		item.PackUnit,
+		item.MinOrderQuantity,
+		item.LeadTimeDays,
+		item.Notes,
+	).Scan(&itemUUID)
+	if err != nil {
+		if isDuplicateSupplierSKU(err) {
+			return SupplierItem{}, ErrDuplicateSupplierSKU
+		}
+		return SupplierItem{}, fmt.Errorf("creating supplier item: %w", err)
+	}
+
+	return c.GetSupplierItemByUUID(ctx, itemUUID)
+}
+
+func (c *Client) GetSupplierItemByUUID(ctx context.Context, itemUUID string) (SupplierItem, error) {
+	item, err := scanSupplierItem(c.Conn(ctx).QueryRow(ctx, `
+		SELECT `+supplierItemColumns+supplierItemJoins+`
+		WHERE si.uuid = $1 AND si.deleted_at IS NULL`,
+		itemUUID,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SupplierItem{}, service.ErrNotFound
+		}
+		return SupplierItem{}, fmt.Errorf("getting supplier item by uuid: %w", err)
+	}
+
+	return item, nil
+}
+
+func (c *Client) ListSupplierItems(ctx context.Context, filter SupplierItemFilter) ([]SupplierItem, error) {
+	query := `SELECT ` + supplierItemColumns + supplierItemJoins + `
+		WHERE si.deleted_at IS NULL`
+	var args []any
+	argIdx := 1
+
+	if filter.SupplierUUID != nil {
+		query += fmt.Sprintf(" AND s.uuid = $%d", argIdx)
+		args = append(args, *filter.SupplierUUID)
+		argIdx++
+	}
+	if filter.InventoryItemUUID != nil {
+		query += fmt.Sprintf(" AND si.inventory_item_uuid = $%d", argIdx)
+		args = append(args, *filter.InventoryItemUUID)
+		argIdx++
+	}
+	if filter.InventoryItemUUIDs != nil {
+		query += fmt.Sprintf(" AND si.inventory_item_uuid = ANY($%d::uuid[])", argIdx)
+		args = append(args, filter.InventoryItemUUIDs)
+	}
+	query += " ORDER BY s.name, si.sku"
+
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []SupplierItem
+	for rows.Next() {
+		item, err := scanSupplierItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning supplier item: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing supplier items: %w", err)
+	}
+
+	return items, nil
+}
+
+func (c *Client) UpdateSupplierItemByUUID(ctx context.Context, itemUUID string, update SupplierItemUpdate) (SupplierItem, error) {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE supplier_item
+		SET
+			sku = COALESCE($1, sku),
+			name = COALESCE($2, name),
+			item_type = COALESCE($3, item_type),
+			inventory_item_uuid = COALESCE($4, inventory_item_uuid),
+			pack_size = COALESCE($5, pack_size),
+			pack_unit = COALESCE($6, pack_unit),
+			min_order_quantity = COALESCE($7, min_order_quantity),
+			lead_time_days = COALESCE($8, lead_time_days),
+			notes = COALESCE($9, notes),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $10 AND deleted_at IS NULL`,
+		update.SKU,
+		update.Name,
+		update.ItemType,
+		database.UUIDParam(update.InventoryItemUUID),
+		update.PackSize,
+		update.PackUnit,
+		update.MinOrderQuantity,
+		update.LeadTimeDays,
+		update.Notes,
+		itemUUID,
+	)
+	if err != nil {
+		if isDuplicateSupplierSKU(err) {
+			return SupplierItem{}, ErrDuplicateSupplierSKU
+		}
+		return SupplierItem{}, fmt.Errorf("updating supplier item by uuid: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return SupplierItem{}, service.ErrNotFound
+	}
+
+	return c.GetSupplierItemByUUID(ctx, itemUUID)
+}
+
+func (c *Client) DeleteSupplierItemByUUID(ctx context.Context, itemUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE supplier_item
+		SET deleted_at = timezone('utc', now()),
+			updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		itemUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting supplier item by uuid: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
+
+func (c *Client) CreateSupplierItemPrice(ctx context.Context, price SupplierItemPrice) (SupplierItemPrice, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO supplier_item_price (
+			supplier_item_id,
+			unit_cost_cents,
+			currency,
+			effective_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, uuid, created_at, updated_at, deleted_at`,
+		price.SupplierItemID,
+		price.UnitCostCents,
+		price.Currency,
+		price.EffectiveAt,
+		price.Notes,
+	).Scan(
+		&price.ID,
+		&price.UUID,
+		&price.CreatedAt,
+		&price.UpdatedAt,
+		&price.DeletedAt,
+	)
+	if err != nil {
+		return SupplierItemPrice{}, fmt.Errorf("creating supplier item price: %w", err)
+	}
+
+	return price, nil
+}
+
+// ListSupplierItemPrices returns the price history of a catalog item, most
+// recent first.
+func (c *Client) ListSupplierItemPrices(ctx context.Context, itemUUID string) ([]SupplierItemPrice, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT sip.id, sip.uuid, sip.supplier_item_id, si.uuid, sip.unit_cost_cents, sip.currency,
+			sip.effective_at, sip.notes, sip.created_at, sip.updated_at, sip.deleted_at
+		FROM supplier_item_price sip
+		JOIN supplier_item si ON si.id = sip.supplier_item_id
+		WHERE si.uuid = $1 AND sip.deleted_at IS NULL
+		ORDER BY sip.effective_at DESC, sip.id DESC`,
+		itemUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing supplier item prices: %w", err)
+	}
+	defer rows.Close()
+
+	var prices []SupplierItemPrice
+	for rows.Next() {
+		var price SupplierItemPrice
+		if err := rows.Scan(
+			&price.ID,
+			&price.UUID,
+			&price.SupplierItemID,
+			&price.SupplierItemUUID,
+			&price.UnitCostCents,
+			&price.Currency,
+			&price.EffectiveAt,
+			&price.Notes,
+			&price.CreatedAt,
+			&price.UpdatedAt,
+			&price.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning supplier item price: %w", err)
+		}
+		prices = append(prices, price)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing supplier item prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+// GetSupplierItemPriceAt returns the price of a catalog item in effect at the
+// given time, or service.ErrNotFound if no price was effective yet.
+func (c *Client) GetSupplierItemPriceAt(ctx context.Context, itemID int64, at time.Time) (SupplierItemPrice, error) {
+	var price SupplierItemPrice
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT sip.id, sip.uuid, sip.supplier_item_id, si.uuid, sip.unit_cost_cents, sip.currency,
+			sip.effective_at, sip.notes, sip.created_at, sip.updated_at, sip.deleted_at
+		FROM supplier_item_price sip
+		JOIN supplier_item si ON si.id = sip.supplier_item_id
+		WHERE sip.supplier_item_id = $1 AND sip.effective_at <= $2 AND sip.deleted_at IS NULL
+		ORDER BY sip.effective_at DESC, sip.id DESC
+		LIMIT 1`,
+		itemID,
+		at,
+	).Scan(
+		&price.ID,
+		&price.UUID,
+		&price.SupplierItemID,
+		&price.SupplierItemUUID,
+		&price.UnitCostCents,
+		&price.Currency,
+		&price.EffectiveAt,
+		&price.Notes,
+		&price.CreatedAt,
+		&price.UpdatedAt,
+		&price.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SupplierItemPrice{}, service.ErrNotFound
+		}
+		return SupplierItemPrice{}, fmt.Errorf("getting supplier item price: %w", err)
+	}
+
+	return price, nil
+}
@@ -0,0 +1,397 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// BatchAllocationStore defines the storage operations needed to allocate
+// ingredient lots to a batch.
+type BatchAllocationStore interface {
+	GetBatchByUUID(context.Context, string) (storage.Batch, error)
+	GetRecipe(ctx context.Context, recipeUUID string, opts *storage.RecipeQueryOpts) (storage.Recipe, error)
+	ListRecipeIngredients(ctx context.Context, recipeUUID string) ([]storage.RecipeIngredient, error)
+}
+
+// IngredientStockFetcher abstracts the inter-service calls to the Inventory
+// service for live ingredient lot balances and the batch's own reservations.
+type IngredientStockFetcher interface {
+	GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error)
+	ListBatchReservations(ctx context.Context, batchUUID string) ([]BatchReservation, error)
+}
+
+// BatchUsageCreator abstracts the inter-service call to the Inventory service
+// that deducts picked stock for a batch.
+type BatchUsageCreator interface {
+	CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (*BatchUsageResponse, error)
+}
+
+// HandleBatchAllocation handles [POST /batches/{uuid}/allocation]. It proposes
+// lot picks for every recipe ingredient, first-expired-first-out and then
+// first-in-first-out, without changing any stock.
+func HandleBatchAllocation(db BatchAllocationStore, invClient IngredientStockFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		batchUUID := r.PathValue("uuid")
+		if batchUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		var req dto.BatchAllocationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+
+		batch, err := db.GetBatchByUUID(ctx, batchUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "batch not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting batch", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		recipeUUID := batch.RecipeUUID
+		if req.RecipeUUID != nil {
+			recipeUUID = req.RecipeUUID
+		}
+		if recipeUUID == nil {
+			http.Error(w, "batch has no recipe; recipe_uuid is required", http.StatusBadRequest)
+			return
+		}
+
+		if _, err := db.GetRecipe(ctx, *recipeUUID, nil); errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "recipe not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting recipe", "error", err, "recipe_uuid", *recipeUUID)
+			return
+		}
+
+		ingredients, err := db.ListRecipeIngredients(ctx, *recipeUUID)
+		if err != nil {
+			service.InternalError(w, "error listing recipe ingredients", "error", err, "recipe_uuid", *recipeUUID)
+			return
+		}
+
+		levels, err := invClient.GetIngredientLotStockLevels(ctx)
+		if err != nil {
+			service.InternalError(w, "error fetching ingredient lot stock levels", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		reservations, err := invClient.ListBatchReservations(ctx, batchUUID)
+		if err != nil {
+			service.InternalError(w, "error fetching batch reservations", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		// Stock reserved for this batch is available to it, so add it back
+		// to the available-to-promise figure.
+		held := make(map[string]int64, len(reservations))
+		for _, res := range reservations {
+			held[res.IngredientLotUUID+"|"+res.StockLocationUUID] += res.OutstandingAmount
+		}
+		for i := range levels {
+			levels[i].AvailableAmount += held[levels[i].IngredientLotUUID+"|"+levels[i].StockLocationUUID]
+		}
+
+		scale := 1.0
+		if req.Scale != nil {
+			scale = *req.Scale
+		}
+
+		resp := allocateRecipe(ingredients, levels, scale, time.Now().UTC())
+		resp.BatchUUID = batchUUID
+		resp.RecipeUUID = *recipeUUID
+
+		service.JSON(w, resp)
+	}
+}
+
+// HandleConfirmBatchAllocation handles [POST /batches/{uuid}/allocation/confirm].
+// The confirmed picks are deducted from inventory in one usage record that
+// references the batch.
+func HandleConfirmBatchAllocation(db BatchAllocationStore, invClient BatchUsageCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		batchUUID := r.PathValue("uuid")
+		if batchUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		var req dto.ConfirmBatchAllocationRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+
+		if _, err := db.GetBatchByUUID(ctx, batchUUID); errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "batch not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting batch", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		usedAt := time.Now().UTC()
+		if req.UsedAt != nil {
+			usedAt = *req.UsedAt
+		}
+
+		picks := make([]BatchUsagePick, len(req.Picks))
+		for i, p := range req.Picks {
+			picks[i] = BatchUsagePick{
+				IngredientLotUUID: p.IngredientLotUUID,
+				StockLocationUUID: p.StockLocationUUID,
+				Amount:            p.Amount,
+				AmountUnit:        p.AmountUnit,
+			}
+		}
+
+		usage, err := invClient.CreateBatchUsage(ctx, BatchUsageRequest{
+			ProductionRefUUID: batchUUID,
+			UsedAt:            usedAt.Format(time.RFC3339),
+			Picks:             picks,
+			Notes:             req.Notes,
+		})
+		var rejected *InventoryRejectedError
+		if errors.As(err, &rejected) {
+			http.Error(w, rejected.Message, http.StatusConflict)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error creating batch usage", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		slog.Info("batch allocation confirmed", "batch_uuid", batchUUID, "usage_uuid", usage.UsageUUID, "picks", len(picks))
+
+		service.JSONCreated(w, dto.ConfirmBatchAllocationResponse{
+			BatchUUID: batchUUID,
+			UsageUUID: usage.UsageUUID,
+			Movements: usage.Movements,
+		})
+	}
+}
+
+// allocateRecipe proposes picks for each recipe ingredient from the given
+// stock levels. Required amounts are scaled the same way the recipe view
+// scales them and rounded up to whole units, then picked with pickFEFO.
+func allocateRecipe(ingredients []storage.RecipeIngredient, levels []IngredientLotStockLevel, scale float64, now time.Time) dto.BatchAllocationResponse {
+	requirements := make([]lotRequirement, len(ingredients))
+	for i, ri := range ingredients {
+		requirements[i] = lotRequirement{
+			amount: scaledAmount(ri.Amount, ri.ScalingFactor, scale),
+			unit:   ri.AmountUnit,
+		}
+		if ri.IngredientUUID != nil {
+			requirements[i].ingredientUUID = ri.IngredientUUID.String()
+		}
+	}
+	allocations := pickFEFO(requirements, levels, nil, now)
+
+	resp := dto.BatchAllocationResponse{
+		Scale:    scale,
+		Lines:    make([]dto.AllocationLine, 0, len(ingredients)),
+		Picks:    make([]dto.AllocationPickInput, 0),
+		Complete: true,
+	}
+
+	for i, ri := range ingredients {
+		allocation := allocations[i]
+		line := dto.AllocationLine{
+			RecipeIngredientUUID: ri.UUID.String(),
+			Name:                 ri.Name,
+			RequiredAmount:       requirements[i].amount,
+			AmountUnit:           ri.AmountUnit,
+			AllocatedAmount:      requirements[i].amount - allocation.shortfall,
+			ShortfallAmount:      allocation.shortfall,
+			Picks:                allocation.picks,
+		}
+		if ri.IngredientUUID == nil {
+			reason := "no_inventory_ingredient"
+			line.Reason = &reason
+			resp.Complete = false
+		} else {
+			line.IngredientUUID = &requirements[i].ingredientUUID
+		}
+		if allocation.shortfall > 0 {
+			resp.Complete = false
+		}
+
+		resp.Lines = append(resp.Lines, line)
+		resp.Picks = append(resp.Picks, allocation.inputs...)
+	}
+
+	return resp
+}
+
+// lotRequirement is an amount of one inventory ingredient, in one unit, to
+// pick from ingredient lot stock.
+type lotRequirement struct {
+	ingredientUUID string
+	amount         int64
+	unit           string
+}
+
+// lotAllocation is the stock picked for one lotRequirement: the picks as
+// shown to the user, the same picks as inventory usage inputs, and the
+// amount that could not be covered.
+type lotAllocation struct {
+	picks     []dto.AllocationPick
+	inputs    []dto.AllocationPickInput
+	shortfall int64
+}
+
+// pickFEFO picks stock for each requirement from the given stock levels,
+// first-expired-first-out and then first-in-first-out. Only lots stocked in
+// the requirement's unit are considered, expired lots are skipped, and stock
+// reserved for other batches is left alone. Balances are shared across
+// requirements so an ingredient listed twice is not double-allocated. When
+// locationUUID is set only stock at that location is picked.
+func pickFEFO(requirements []lotRequirement, levels []IngredientLotStockLevel, locationUUID *string, now time.Time) []lotAllocation {
+	byIngredient := make(map[string][]*IngredientLotStockLevel)
+	for i := range levels {
+		level := &levels[i]
+		if level.ExpiresAt != nil && level.ExpiresAt.Before(now) {
+			continue
+		}
+		if locationUUID != nil && level.StockLocationUUID != *locationUUID {
+			continue
+		}
+		byIngredient[level.IngredientUUID] = append(byIngredient[level.IngredientUUID], level)
+	}
+	for _, candidates := range byIngredient {
+		sortFEFO(candidates)
+	}
+
+	remaining := make(map[*IngredientLotStockLevel]int64)
+	allocations := make([]lotAllocation, len(requirements))
+
+	for i, req := range requirements {
+		allocation := lotAllocation{
+			picks:  make([]dto.AllocationPick, 0),
+			inputs: make([]dto.AllocationPickInput, 0),
+		}
+
+		need := req.amount
+		for _, level := range byIngredient[req.ingredientUUID] {
+			if need == 0 {
+				break
+			}
+			if level.CurrentUnit != req.unit {
+				continue
+			}
+
+			available, seen := remaining[level]
+			if !seen {
+				available = min(level.AvailableAmount, level.CurrentAmount)
+			}
+			if available <= 0 {
+				continue
+			}
+
+			take := min(need, available)
+			remaining[level] = available - take
+			need -= take
+
+			allocation.picks = append(allocation.picks, dto.AllocationPick{
+				IngredientLotUUID: level.IngredientLotUUID,
+				BreweryLotCode:    level.BreweryLotCode,
+				StockLocationUUID: level.StockLocationUUID,
+				StockLocationName: level.StockLocationName,
+				Amount:            take,
+				AmountUnit:        level.CurrentUnit,
+				AvailableAmount:   available,
+				ReceivedAt:        level.ReceivedAt,
+				BestByAt:          level.BestByAt,
+				ExpiresAt:         level.ExpiresAt,
+			})
+			allocation.inputs = append(allocation.inputs, dto.AllocationPickInput{
+				IngredientLotUUID: level.IngredientLotUUID,
+				StockLocationUUID: level.StockLocationUUID,
+				Amount:            take,
+				AmountUnit:        level.CurrentUnit,
+			})
+		}
+
+		allocation.shortfall = need
+		allocations[i] = allocation
+	}
+
+	return allocations
+}
+
+// scaledAmount blends between the unscaled and fully scaled amount according
+// to the ingredient's scaling factor, rounding up to a whole unit.
+func scaledAmount(amount, scalingFactor, scale float64) int64 {
+	scaled := amount + (amount*scale-amount)*scalingFactor
+	if scaled <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(scaled - 1e-9))
+}
+
+// sortFEFO orders candidate stock by earliest expiry (expires_at, falling
+// back to best_by_at), then earliest receipt. Lots without either date sort
+// after dated lots.
+func sortFEFO(levels []*IngredientLotStockLevel) {
+	sort.SliceStable(levels, func(i, j int) bool {
+		a, b := expiryOf(levels[i]), expiryOf(levels[j])
+		switch {
+		case a != nil && b != nil && !a.Equal(*b):
+			return a.Before(*b)
+		case a != nil && b == nil:
+			return true
+		case a == nil && b != nil:
+			return false
+		}
+		if !levels[i].ReceivedAt.Equal(levels[j].ReceivedAt) {
+			return levels[i].ReceivedAt.Before(levels[j].ReceivedAt)
+		}
+		if levels[i].IngredientLotUUID != levels[j].IngredientLotUUID {
+			return levels[i].IngredientLotUUID < levels[j].IngredientLotUUID
+		}
+		return levels[i].StockLocationName < levels[j].StockLocationName
+	})
+}
+
+func expiryOf(level *IngredientLotStockLevel) *time.Time {
+	if level.ExpiresAt != nil {
+		return level.ExpiresAt
+	}
+	return level.BestByAt
+}
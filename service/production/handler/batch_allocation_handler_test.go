@@ -0,0 +1,181 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockBatchAllocationStore implements handler.BatchAllocationStore for testing.
+type mockBatchAllocationStore struct {
+	batch       storage.Batch
+	ingredients []storage.RecipeIngredient
+}
+
+func (m *mockBatchAllocationStore) GetBatchByUUID(_ context.Context, _ string) (storage.Batch, error) {
+	return m.batch, nil
+}
+
+func (m *mockBatchAllocationStore) GetRecipe(_ context.Context, _ string, _ *storage.RecipeQueryOpts) (storage.Recipe, error) {
+	return storage.Recipe{}, nil
+}
+
+func (m *mockBatchAllocationStore) ListRecipeIngredients(_ context.Context, _ string) ([]storage.RecipeIngredient, error) {
+	return m.ingredients, nil
+}
+
+// mockInventoryAllocator implements handler.IngredientStockFetcher and
+// handler.BatchUsageCreator for testing.
+type mockInventoryAllocator struct {
+	levels       []handler.IngredientLotStockLevel
+	reservations []handler.BatchReservation
+	usageErr     error
+	usageReq     handler.BatchUsageRequest
+}
+
+func (m *mockInventoryAllocator) GetIngredientLotStockLevels(_ context.Context) ([]handler.IngredientLotStockLevel, error) {
+	return m.levels, nil
+}
+
+func (m *mockInventoryAllocator) ListBatchReservations(_ context.Context, _ string) ([]handler.BatchReservation, error) {
+	return m.reservations, nil
+}
+
+func (m *mockInventoryAllocator) CreateBatchUsage(_ context.Context, req handler.BatchUsageRequest) (*handler.BatchUsageResponse, error) {
+	m.usageReq = req
+	if m.usageErr != nil {
+		return nil, m.usageErr
+	}
+	return &handler.BatchUsageResponse{UsageUUID: "990e8400-e29b-41d4-a716-446655440000"}, nil
+}
+
+func TestHandleBatchAllocation(t *testing.T) {
+	batchUUID := "550e8400-e29b-41d4-a716-446655440000"
+	maltUUID := "110e8400-e29b-41d4-a716-446655440001"
+	hopUUID := "110e8400-e29b-41d4-a716-446655440002"
+
+	batch := storage.Batch{RecipeUUID: sp("220e8400-e29b-41d4-a716-446655440000")}
+	batch.UUID = uuid.Must(uuid.FromString(batchUUID))
+
+	malt := storage.RecipeIngredient{Name: "Pale Malt", IngredientUUID: uuidPtr(maltUUID), Amount: 100, AmountUnit: "kg", ScalingFactor: 1}
+	malt.UUID = uuid.Must(uuid.NewV4())
+	hop := storage.RecipeIngredient{Name: "Citra", IngredientUUID: uuidPtr(hopUUID), Amount: 2, AmountUnit: "kg", ScalingFactor: 1}
+	hop.UUID = uuid.Must(uuid.NewV4())
+	salt := storage.RecipeIngredient{Name: "Gypsum", Amount: 1, AmountUnit: "kg", ScalingFactor: 1}
+	salt.UUID = uuid.Must(uuid.NewV4())
+
+	received := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	soon := time.Now().Add(30 * 24 * time.Hour)
+	later := time.Now().Add(90 * 24 * time.Hour)
+	expired := time.Now().Add(-24 * time.Hour)
+
+	levels := []handler.IngredientLotStockLevel{
+		// Oldest receipt but latest expiry: picked last.
+		{IngredientLotUUID: "lot-late", IngredientUUID: maltUUID, ReceivedAt: received, ExpiresAt: &later, StockLocationUUID: "loc-a", CurrentAmount: 500, CurrentUnit: "kg", AvailableAmount: 500},
+		{IngredientLotUUID: "lot-soon", IngredientUUID: maltUUID, ReceivedAt: received.AddDate(0, 1, 0), BestByAt: &soon, StockLocationUUID: "loc-a", CurrentAmount: 120, CurrentUnit: "kg", AvailableAmount: 120},
+		{IngredientLotUUID: "lot-expired", IngredientUUID: maltUUID, ReceivedAt: received, ExpiresAt: &expired, StockLocationUUID: "loc-a", CurrentAmount: 500, CurrentUnit: "kg", AvailableAmount: 500},
+		// Fully reserved, but the reservation belongs to this batch.
+		{IngredientLotUUID: "lot-hop", IngredientUUID: hopUUID, ReceivedAt: received, StockLocationUUID: "loc-b", CurrentAmount: 3, CurrentUnit: "kg", AvailableAmount: 0},
+	}
+
+	store := &mockBatchAllocationStore{batch: batch, ingredients: []storage.RecipeIngredient{malt, hop, salt}}
+	inv := &mockInventoryAllocator{
+		levels:       levels,
+		reservations: []handler.BatchReservation{{IngredientLotUUID: "lot-hop", StockLocationUUID: "loc-b", OutstandingAmount: 3, AmountUnit: "kg"}},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/batches/"+batchUUID+"/allocation", strings.NewReader(`{"scale": 1.5}`))
+	req.SetPathValue("uuid", batchUUID)
+	rec := httptest.NewRecorder()
+
+	handler.HandleBatchAllocation(store, inv).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp dto.BatchAllocationResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	if resp.Complete {
+		t.Error("expected incomplete allocation")
+	}
+	if len(resp.Lines) != 3 {
+		t.Fatalf("expected 3 lines, got %d", len(resp.Lines))
+	}
+
+	maltLine := resp.Lines[0]
+	if maltLine.RequiredAmount != 150 || maltLine.ShortfallAmount != 0 {
+		t.Errorf("malt: expected 150 required and no shortfall, got %d/%d", maltLine.RequiredAmount, maltLine.ShortfallAmount)
+	}
+	if len(maltLine.Picks) != 2 || maltLine.Picks[0].IngredientLotUUID != "lot-soon" || maltLine.Picks[0].Amount != 120 ||
+		maltLine.Picks[1].IngredientLotUUID != "lot-late" || maltLine.Picks[1].Amount != 30 {
+		t.Errorf("malt: unexpected picks %+v", maltLine.Picks)
+	}
+
+	hopLine := resp.Lines[1]
+	if hopLine.AllocatedAmount != 3 || hopLine.ShortfallAmount != 0 {
+		t.Errorf("hop: expected 3 allocated, got %d (shortfall %d)", hopLine.AllocatedAmount, hopLine.ShortfallAmount)
+	}
+
+	saltLine := resp.Lines[2]
+	if saltLine.Reason == nil || *saltLine.Reason != "no_inventory_ingredient" || saltLine.ShortfallAmount != 2 {
+		t.Errorf("salt: expected unlinked shortfall of 2, got %+v", saltLine)
+	}
+
+	if len(resp.Picks) != 3 {
+		t.Errorf("expected 3 flattened picks, got %d", len(resp.Picks))
+	}
+}
+
+func TestHandleConfirmBatchAllocation(t *testing.T) {
+	batchUUID := "550e8400-e29b-41d4-a716-446655440000"
+	body := `{"picks":[{"ingredient_lot_uuid":"770e8400-e29b-41d4-a716-446655440001","stock_location_uuid":"880e8400-e29b-41d4-a716-446655440001","amount":10,"amount_unit":"kg"}]}`
+
+	tests := []struct {
+		name           string
+		body           string
+		usageErr       error
+		expectedStatus int
+	}{
+		{name: "confirmed", body: body, expectedStatus: http.StatusCreated},
+		{name: "empty picks", body: `{"picks":[]}`, expectedStatus: http.StatusBadRequest},
+		{
+			name:           "inventory rejects shortfall",
+			body:           body,
+			usageErr:       &handler.InventoryRejectedError{Message: "picks[0]: insufficient stock"},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockBatchAllocationStore{}
+			inv := &mockInventoryAllocator{usageErr: tc.usageErr}
+
+			req := httptest.NewRequest(http.MethodPost, "/batches/"+batchUUID+"/allocation/confirm", strings.NewReader(tc.body))
+			req.SetPathValue("uuid", batchUUID)
+			rec := httptest.NewRecorder()
+
+			handler.HandleConfirmBatchAllocation(store, inv).ServeHTTP(rec, req)
+
+			if rec.Code != tc.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tc.expectedStatus == http.StatusCreated && inv.usageReq.ProductionRefUUID != batchUUID {
+				t.Errorf("expected production_ref_uuid %s, got %s", batchUUID, inv.usageReq.ProductionRefUUID)
+			}
+		})
+	}
+}
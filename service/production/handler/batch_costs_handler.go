@@ -2,11 +2,14 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"net/http"
-	"strings"
+	"time"
 
+	"github.com/brewpipes/brewpipes/internal/telemetry"
 	"github.com/brewpipes/brewpipes/service"
 	"github.com/brewpipes/brewpipes/service/production/handler/dto"
 	"github.com/brewpipes/brewpipes/service/production/storage"
@@ -17,22 +20,55 @@ type BatchCostsStore interface {
 	GetBatchByUUID(context.Context, string) (storage.Batch, error)
 	GetBatchSummaryByUUID(context.Context, string) (storage.BatchSummary, error)
 	ListAdditionsByBatchUUID(context.Context, string) ([]storage.Addition, error)
+	ListPackagingRunLinesByBatchUUID(context.Context, string) ([]storage.PackagingRunLine, error)
+	ListPackagingRunsByBatchUUID(context.Context, string) ([]storage.PackagingRun, error)
+	ListPackageFormatMaterials(context.Context, int64) ([]storage.PackageFormatMaterial, error)
+	ListBatchLaborEntriesByBatchUUID(context.Context, string) ([]storage.BatchLaborEntry, error)
+	ListOverheadRates(context.Context, bool) ([]storage.OverheadRate, error)
+	ListOccupanciesByBatchUUID(context.Context, string) ([]storage.Occupancy, error)
+	GetBatchCostSnapshot(context.Context, string) (storage.BatchCostSnapshot, error)
+	SaveBatchCostSnapshot(context.Context, int64, []byte, time.Time) error
 }
 
-// IngredientLotFetcher abstracts the inter-service call to the Inventory service
-// for retrieving ingredient lot data consumed by a batch.
-type IngredientLotFetcher interface {
-	GetBatchIngredientLots(ctx context.Context, authToken string, batchUUID string) ([]BatchIngredientLot, error)
+// BatchCostsInventory abstracts the inter-service calls to the Inventory
+// service for retrieving the ingredient lots consumed by a batch, the
+// packaging materials deducted for it and the beer removed from it.
+type BatchCostsInventory interface {
+	GetBatchIngredientLots(ctx context.Context, batchUUID string) ([]BatchIngredientLot, error)
+	ListBatchRemovals(ctx context.Context, batchUUID string) ([]BatchRemoval, error)
+	ListBatchUsageMovements(ctx context.Context, batchUUID string) ([]BatchUsageMovement, error)
 }
 
 // POLineFetcher abstracts the inter-service call to the Procurement service
 // for looking up purchase order line costs.
 type POLineFetcher interface {
-	BatchLookupPOLines(ctx context.Context, authToken string, uuids []string) ([]PurchaseOrderLineCost, error)
+	BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error)
 }
 
-// HandleBatchCosts handles [GET /batches/{uuid}/costs].
-func HandleBatchCosts(db BatchCostsStore, invClient IngredientLotFetcher, procClient POLineFetcher) http.HandlerFunc {
+// BatchCostCalculator computes the full cost of a batch: ingredients,
+// packaging materials, labor, overhead and loss write-offs.
+type BatchCostCalculator struct {
+	db         BatchCostsStore
+	invClient  BatchCostsInventory
+	procClient POLineFetcher
+	now        func() time.Time
+}
+
+// NewBatchCostCalculator creates a BatchCostCalculator.
+func NewBatchCostCalculator(db BatchCostsStore, invClient BatchCostsInventory, procClient POLineFetcher) *BatchCostCalculator {
+	return &BatchCostCalculator{
+		db:         db,
+		invClient:  invClient,
+		procClient: procClient,
+		now:        time.Now,
+	}
+}
+
+// HandleBatchCosts handles [GET /batches/{uuid}/costs]. A finished batch
+// returns the costs snapshotted when it finished; pass live=true to
+// recompute them.
+func HandleBatchCosts(db BatchCostsStore, invClient BatchCostsInventory, procClient POLineFetcher) http.HandlerFunc {
+	calculator := NewBatchCostCalculator(db, invClient, procClient)
 	return func(w http.ResponseWriter, r *http.Request) {
 		batchUUID := r.PathValue("uuid")
 		if batchUUID == "" {
@@ -42,7 +78,7 @@ func HandleBatchCosts(db BatchCostsStore, invClient IngredientLotFetcher, procCl
 
 		ctx := r.Context()
 
-		// 1. Verify batch exists.
+		// Verify batch exists.
 		_, err := db.GetBatchByUUID(ctx, batchUUID)
 		if errors.Is(err, service.ErrNotFound) {
 			http.Error(w, "batch not found", http.StatusNotFound)
@@ -52,201 +88,711 @@ func HandleBatchCosts(db BatchCostsStore, invClient IngredientLotFetcher, procCl
 			return
 		}
 
-		// 2. Get batch summary for volume metrics.
-		summary, err := db.GetBatchSummaryByUUID(ctx, batchUUID)
-		if err != nil {
-			service.InternalError(w, "error getting batch summary", "error", err, "batch_uuid", batchUUID)
-			return
+		if r.URL.Query().Get("live") != "true" {
+			snapshot, err := db.GetBatchCostSnapshot(ctx, batchUUID)
+			if err == nil {
+				var resp dto.BatchCostsResponse
+				if err := json.Unmarshal(snapshot.Costs, &resp); err != nil {
+					service.InternalError(w, "error decoding batch cost snapshot", "error", err, "batch_uuid", batchUUID)
+					return
+				}
+				resp.SnapshotAt = &snapshot.SnapshottedAt
+				service.JSON(w, resp)
+				return
+			} else if !errors.Is(err, service.ErrNotFound) {
+				service.InternalError(w, "error getting batch cost snapshot", "error", err, "batch_uuid", batchUUID)
+				return
+			}
 		}
 
-		// 3. List all additions for the batch.
-		additions, err := db.ListAdditionsByBatchUUID(ctx, batchUUID)
+		resp, err := calculator.Calculate(ctx, batchUUID)
 		if err != nil {
-			service.InternalError(w, "error listing additions", "error", err, "batch_uuid", batchUUID)
+			service.InternalError(w, "error calculating batch costs", "error", err, "batch_uuid", batchUUID)
 			return
 		}
 
-		// 4. Early return if no additions.
-		if len(additions) == 0 {
-			service.JSON(w, dto.BatchCostsResponse{
-				BatchUUID:         batchUUID,
-				LineItems:         []dto.CostLineItem{},
-				UncostedAdditions: []dto.UncostedAddition{},
-				Totals: dto.CostTotals{
-					CostComplete: true,
-				},
-			})
+		service.JSON(w, resp)
+	}
+}
+
+// HandleBatchCostSnapshot handles [POST /batches/{uuid}/costs/snapshot]. It
+// recomputes the batch costs and replaces the stored snapshot.
+func HandleBatchCostSnapshot(db BatchCostsStore, invClient BatchCostsInventory, procClient POLineFetcher) http.HandlerFunc {
+	calculator := NewBatchCostCalculator(db, invClient, procClient)
+	return func(w http.ResponseWriter, r *http.Request) {
+		batchUUID := r.PathValue("uuid")
+		if batchUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
 			return
 		}
 
-		// 5. Extract auth token for inter-service calls.
-		authToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		batch, err := db.GetBatchByUUID(r.Context(), batchUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "batch not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting batch", "error", err, "batch_uuid", batchUUID)
+			return
+		}
 
-		// 6. Fetch ingredient lot data from Inventory service.
-		lots, err := invClient.GetBatchIngredientLots(ctx, authToken, batchUUID)
+		resp, err := calculator.Snapshot(r.Context(), batch)
 		if err != nil {
-			service.InternalError(w, "error fetching ingredient lot data", "error", err, "batch_uuid", batchUUID)
+			service.InternalError(w, "error snapshotting batch costs", "error", err, "batch_uuid", batchUUID)
 			return
 		}
 
-		// 7. Build lot lookup map: lotUUID → *BatchIngredientLot.
-		lotMap := make(map[string]*BatchIngredientLot, len(lots))
-		for i := range lots {
-			lotMap[lots[i].IngredientLotUUID] = &lots[i]
+		service.JSONCreated(w, resp)
+	}
+}
+
+// Snapshot computes the batch's costs and stores them as its cost snapshot.
+func (c *BatchCostCalculator) Snapshot(ctx context.Context, batch storage.Batch) (dto.BatchCostsResponse, error) {
+	resp, err := c.Calculate(ctx, batch.UUID.String())
+	if err != nil {
+		return dto.BatchCostsResponse{}, err
+	}
+
+	costs, err := json.Marshal(resp)
+	if err != nil {
+		return dto.BatchCostsResponse{}, fmt.Errorf("encoding batch costs: %w", err)
+	}
+
+	snapshotAt := c.now().UTC()
+	if err := c.db.SaveBatchCostSnapshot(ctx, batch.ID, costs, snapshotAt); err != nil {
+		return dto.BatchCostsResponse{}, err
+	}
+
+	resp.SnapshotAt = &snapshotAt
+	return resp, nil
+}
+
+// Calculate computes the live costs of a batch that is known to exist and
+// records how long the calculation took.
+func (c *BatchCostCalculator) Calculate(ctx context.Context, batchUUID string) (dto.BatchCostsResponse, error) {
+	started := time.Now()
+	resp, err := c.calculate(ctx, batchUUID)
+	telemetry.ObserveBatchCostCalculation(started, err)
+	return resp, err
+}
+
+func (c *BatchCostCalculator) calculate(ctx context.Context, batchUUID string) (dto.BatchCostsResponse, error) {
+	resp, err := c.ingredientCosts(ctx, batchUUID)
+	if err != nil {
+		return dto.BatchCostsResponse{}, err
+	}
+
+	if err := c.addCostPools(ctx, batchUUID, &resp); err != nil {
+		return dto.BatchCostsResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// ingredientCosts costs the batch's ingredient additions from the purchase
+// order lines their lots were received against.
+func (c *BatchCostCalculator) ingredientCosts(ctx context.Context, batchUUID string) (dto.BatchCostsResponse, error) {
+	// 1. Get batch summary for volume metrics.
+	summary, err := c.db.GetBatchSummaryByUUID(ctx, batchUUID)
+	if err != nil {
+		return dto.BatchCostsResponse{}, fmt.Errorf("getting batch summary: %w", err)
+	}
+
+	// 2. Compute batch volume in BBL from the starting volume.
+	var batchVolumeBBL *float64
+	var startingVolume *storage.BatchVolumeWithAmount
+	for i := range summary.Volumes {
+		v := &summary.Volumes[i]
+		if startingVolume == nil || v.BatchVolume.PhaseAt.Before(startingVolume.BatchVolume.PhaseAt) {
+			startingVolume = v
 		}
+	}
+	if startingVolume != nil {
+		batchVolumeBBL = dto.ConvertToBBL(startingVolume.Volume.Amount, startingVolume.Volume.AmountUnit)
+	}
 
-		// 8. Collect unique PO line UUIDs from lot data.
-		poLineUUIDSet := make(map[string]struct{})
-		for _, lot := range lots {
-			if lot.PurchaseOrderLineUUID != nil {
-				poLineUUIDSet[*lot.PurchaseOrderLineUUID] = struct{}{}
-			}
+	// 3. List all additions for the batch.
+	additions, err := c.db.ListAdditionsByBatchUUID(ctx, batchUUID)
+	if err != nil {
+		return dto.BatchCostsResponse{}, fmt.Errorf("listing additions: %w", err)
+	}
+
+	// 4. Nothing to cost without additions.
+	if len(additions) == 0 {
+		return dto.BatchCostsResponse{
+			BatchUUID:         batchUUID,
+			LineItems:         []dto.CostLineItem{},
+			UncostedAdditions: []dto.UncostedAddition{},
+			Totals: dto.CostTotals{
+				CostComplete:   true,
+				BatchVolumeBBL: batchVolumeBBL,
+			},
+		}, nil
+	}
+
+	// 5. Fetch ingredient lot data from Inventory service.
+	lots, err := c.invClient.GetBatchIngredientLots(ctx, batchUUID)
+	if err != nil {
+		return dto.BatchCostsResponse{}, fmt.Errorf("fetching ingredient lot data: %w", err)
+	}
+
+	// 6. Build lot lookup map: lotUUID → *BatchIngredientLot.
+	lotMap := make(map[string]*BatchIngredientLot, len(lots))
+	for i := range lots {
+		lotMap[lots[i].IngredientLotUUID] = &lots[i]
+	}
+
+	// 7. Collect unique PO line UUIDs from lot data.
+	poLineUUIDSet := make(map[string]struct{})
+	for _, lot := range lots {
+		if lot.PurchaseOrderLineUUID != nil {
+			poLineUUIDSet[*lot.PurchaseOrderLineUUID] = struct{}{}
 		}
+	}
 
-		// 9. Fetch PO line costs from Procurement service (if any).
-		poLineMap := make(map[string]*PurchaseOrderLineCost)
-		if len(poLineUUIDSet) > 0 {
-			poLineUUIDs := make([]string, 0, len(poLineUUIDSet))
-			for uuid := range poLineUUIDSet {
-				poLineUUIDs = append(poLineUUIDs, uuid)
-			}
+	// 8. Fetch PO line costs from Procurement service (if any).
+	poLineMap := make(map[string]*PurchaseOrderLineCost)
+	if len(poLineUUIDSet) > 0 {
+		poLineUUIDs := make([]string, 0, len(poLineUUIDSet))
+		for uuid := range poLineUUIDSet {
+			poLineUUIDs = append(poLineUUIDs, uuid)
+		}
 
-			poLines, err := procClient.BatchLookupPOLines(ctx, authToken, poLineUUIDs)
-			if err != nil {
-				service.InternalError(w, "error fetching purchase order line data", "error", err, "batch_uuid", batchUUID)
-				return
-			}
+		poLines, err := c.procClient.BatchLookupPOLines(ctx, poLineUUIDs)
+		if err != nil {
+			return dto.BatchCostsResponse{}, fmt.Errorf("fetching purchase order line data: %w", err)
+		}
 
-			for i := range poLines {
-				poLineMap[poLines[i].UUID] = &poLines[i]
-			}
+		for i := range poLines {
+			poLineMap[poLines[i].UUID] = &poLines[i]
 		}
+	}
 
-		// 10. Build response line items and uncosted additions.
-		lineItems := make([]dto.CostLineItem, 0, len(additions))
-		uncostedAdditions := make([]dto.UncostedAddition, 0)
+	// 9. Build response line items and uncosted additions.
+	lineItems := make([]dto.CostLineItem, 0, len(additions))
+	uncostedAdditions := make([]dto.UncostedAddition, 0)
+
+	for _, addition := range additions {
+		additionUUID := addition.UUID.String()
+
+		// No inventory lot → uncosted.
+		if addition.InventoryLotUUID == nil {
+			uncostedAdditions = append(uncostedAdditions, dto.UncostedAddition{
+				AdditionUUID: additionUUID,
+				AdditionType: addition.AdditionType,
+				AmountUsed:   addition.Amount,
+				AmountUnit:   addition.AmountUnit,
+				Reason:       "no_inventory_lot",
+			})
+			continue
+		}
 
-		for _, addition := range additions {
-			additionUUID := addition.UUID.String()
+		lotUUID := addition.InventoryLotUUID.String()
+		lot := lotMap[lotUUID]
 
-			// No inventory lot → uncosted.
-			if addition.InventoryLotUUID == nil {
-				uncostedAdditions = append(uncostedAdditions, dto.UncostedAddition{
-					AdditionUUID: additionUUID,
-					AdditionType: addition.AdditionType,
-					AmountUsed:   addition.Amount,
-					AmountUnit:   addition.AmountUnit,
-					Reason:       "no_inventory_lot",
-				})
-				continue
-			}
+		item := dto.CostLineItem{
+			AdditionUUID:      additionUUID,
+			IngredientLotUUID: lotUUID,
+			AdditionType:      addition.AdditionType,
+			AmountUsed:        addition.Amount,
+			AmountUnit:        addition.AmountUnit,
+			CostSource:        "unavailable",
+		}
 
-			lotUUID := addition.InventoryLotUUID.String()
-			lot := lotMap[lotUUID]
+		if lot != nil {
+			item.IngredientUUID = &lot.IngredientUUID
+			item.IngredientName = &lot.IngredientName
+			item.IngredientCategory = &lot.IngredientCategory
+			item.LotCode = lot.BreweryLotCode
 
-			item := dto.CostLineItem{
-				AdditionUUID:      additionUUID,
-				IngredientLotUUID: lotUUID,
-				AdditionType:      addition.AdditionType,
-				AmountUsed:        addition.Amount,
-				AmountUnit:        addition.AmountUnit,
-				CostSource:        "unavailable",
+			if lot.PurchaseOrderLineUUID != nil {
+				poLine := poLineMap[*lot.PurchaseOrderLineUUID]
+				if poLine != nil && addition.AmountUnit == poLine.QuantityUnit {
+					materialCents, feeCents := landedCostCents(addition.Amount, poLine)
+					costCents := materialCents + feeCents
+					item.CostCents = &costCents
+					item.MaterialCostCents = &materialCents
+					item.FeeCostCents = &feeCents
+					item.UnitCostCents = &poLine.UnitCostCents
+					item.UnitCostUnit = &poLine.QuantityUnit
+					item.PurchaseOrderLineUUID = lot.PurchaseOrderLineUUID
+					item.CostSource = "purchase_order"
+					item.Currency = &poLine.Currency
+					if poLine.ExchangeRate != nil {
+						baseCents := int64(math.Round(float64(costCents) * poLine.ExchangeRate.Rate))
+						item.ExchangeRate = &poLine.ExchangeRate.Rate
+						item.ExchangeRateLocked = &poLine.ExchangeRate.Locked
+						item.BaseCostCents = &baseCents
+					}
+				}
 			}
+		}
 
-			if lot != nil {
-				item.IngredientUUID = &lot.IngredientUUID
-				item.IngredientName = &lot.IngredientName
-				item.IngredientCategory = &lot.IngredientCategory
-				item.LotCode = lot.BreweryLotCode
-
-				if lot.PurchaseOrderLineUUID != nil {
-					poLine := poLineMap[*lot.PurchaseOrderLineUUID]
-					if poLine != nil && addition.AmountUnit == poLine.QuantityUnit {
-						costCents := addition.Amount * poLine.UnitCostCents
-						item.CostCents = &costCents
-						item.UnitCostCents = &poLine.UnitCostCents
-						item.UnitCostUnit = &poLine.QuantityUnit
-						item.PurchaseOrderLineUUID = lot.PurchaseOrderLineUUID
-						item.CostSource = "purchase_order"
-					}
+		lineItems = append(lineItems, item)
+	}
+
+	// 10. Compute totals.
+	var totalCostCents int64
+	var materialCostCents int64
+	var feeCostCents int64
+	var baseTotalCostCents int64
+	baseConverted := true
+	var costedLineCount int
+	var uncostedLineCount int
+
+	for _, item := range lineItems {
+		if item.CostCents != nil {
+			totalCostCents += *item.CostCents
+			materialCostCents += *item.MaterialCostCents
+			feeCostCents += *item.FeeCostCents
+			if item.BaseCostCents != nil {
+				baseTotalCostCents += *item.BaseCostCents
+			} else {
+				baseConverted = false
+			}
+			costedLineCount++
+		} else {
+			uncostedLineCount++
+		}
+	}
+	uncostedLineCount += len(uncostedAdditions)
+
+	costComplete := uncostedLineCount == 0
+
+	// 11. Determine currency from costed PO lines.
+	var currency *string
+	var baseCurrency *string
+	currencies := make(map[string]struct{})
+	for _, item := range lineItems {
+		if item.CostCents != nil && item.PurchaseOrderLineUUID != nil {
+			poLine := poLineMap[*item.PurchaseOrderLineUUID]
+			if poLine != nil {
+				currencies[poLine.Currency] = struct{}{}
+				if baseCurrency == nil && poLine.BaseCurrency != "" {
+					baseCurrency = &poLine.BaseCurrency
 				}
 			}
+		}
+	}
+	if len(currencies) == 1 {
+		for c := range currencies {
+			currency = &c
+		}
+	} else if len(currencies) > 1 {
+		mixed := "MIXED"
+		currency = &mixed
+	}
 
-			lineItems = append(lineItems, item)
+	// 12. Compute cost per BBL.
+	var costPerBBLCents *int64
+	if batchVolumeBBL != nil && *batchVolumeBBL > 0 && (currency == nil || *currency != "MIXED") {
+		v := int64(math.Round(float64(totalCostCents) / *batchVolumeBBL))
+		costPerBBLCents = &v
+	}
+
+	// 13. Report totals in the base currency when every costed line converts.
+	var baseTotalCost *int64
+	var baseCostPerBBLCents *int64
+	if baseCurrency != nil && baseConverted {
+		baseTotalCost = &baseTotalCostCents
+		if batchVolumeBBL != nil && *batchVolumeBBL > 0 {
+			v := int64(math.Round(float64(baseTotalCostCents) / *batchVolumeBBL))
+			baseCostPerBBLCents = &v
 		}
+	}
 
-		// 11. Compute totals.
-		var totalCostCents int64
-		var costedLineCount int
-		var uncostedLineCount int
+	return dto.BatchCostsResponse{
+		BatchUUID:         batchUUID,
+		Currency:          currency,
+		BaseCurrency:      baseCurrency,
+		LineItems:         lineItems,
+		UncostedAdditions: uncostedAdditions,
+		Totals: dto.CostTotals{
+			TotalCostCents:      totalCostCents,
+			MaterialCostCents:   materialCostCents,
+			FeeCostCents:        feeCostCents,
+			CostedLineCount:     costedLineCount,
+			UncostedLineCount:   uncostedLineCount,
+			CostComplete:        costComplete,
+			CostPerBBLCents:     costPerBBLCents,
+			BatchVolumeBBL:      batchVolumeBBL,
+			BaseTotalCostCents:  baseTotalCost,
+			BaseCostPerBBLCents: baseCostPerBBLCents,
+		},
+	}, nil
+}
 
-		for _, item := range lineItems {
-			if item.CostCents != nil {
-				totalCostCents += *item.CostCents
-				costedLineCount++
-			} else {
-				uncostedLineCount++
+// addCostPools adds packaging materials, labor, overhead and loss write-offs
+// to the ingredient costs and derives the full cost per barrel, package
+// format and unit. All pools are in the base currency.
+func (c *BatchCostCalculator) addCostPools(ctx context.Context, batchUUID string, resp *dto.BatchCostsResponse) error {
+	var batchVolumeBBL float64
+	if resp.Totals.BatchVolumeBBL != nil {
+		batchVolumeBBL = *resp.Totals.BatchVolumeBBL
+	}
+	full := &resp.FullCost
+
+	// Packaging materials: the lots each completed run deducted.
+	runLines, err := c.db.ListPackagingRunLinesByBatchUUID(ctx, batchUUID)
+	if err != nil {
+		return fmt.Errorf("listing packaging run lines: %w", err)
+	}
+	charges, err := c.packagingMaterialCosts(ctx, batchUUID, runLines, resp)
+	if err != nil {
+		return err
+	}
+
+	// Labor, grouped by role and hourly rate.
+	entries, err := c.db.ListBatchLaborEntriesByBatchUUID(ctx, batchUUID)
+	if err != nil {
+		return fmt.Errorf("listing batch labor: %w", err)
+	}
+	type laborKey struct {
+		role string
+		rate int64
+	}
+	labor := make(map[laborKey]int)
+	resp.Labor = make([]dto.LaborCost, 0)
+	for _, entry := range entries {
+		key := laborKey{role: entry.Role, rate: entry.HourlyRateCents}
+		i, ok := labor[key]
+		if !ok {
+			i = len(resp.Labor)
+			labor[key] = i
+			resp.Labor = append(resp.Labor, dto.LaborCost{Role: entry.Role, HourlyRateCents: entry.HourlyRateCents})
+		}
+		resp.Labor[i].Hours += entry.Hours
+	}
+	for i := range resp.Labor {
+		resp.Labor[i].CostCents = dto.LaborCostCents(resp.Labor[i].Hours, resp.Labor[i].HourlyRateCents)
+		full.LaborCostCents += resp.Labor[i].CostCents
+	}
+
+	// Overhead, per barrel brewed or per day in a vessel.
+	occupancies, err := c.db.ListOccupanciesByBatchUUID(ctx, batchUUID)
+	if err != nil {
+		return fmt.Errorf("listing occupancies: %w", err)
+	}
+	now := c.now()
+	for _, occupancy := range occupancies {
+		outAt := now
+		if occupancy.OutAt != nil {
+			outAt = *occupancy.OutAt
+		}
+		if outAt.After(occupancy.InAt) {
+			full.VesselDays += outAt.Sub(occupancy.InAt).Hours() / 24
+		}
+	}
+	full.VesselDays = math.Round(full.VesselDays*100) / 100
+
+	rates, err := c.db.ListOverheadRates(ctx, true)
+	if err != nil {
+		return fmt.Errorf("listing overhead rates: %w", err)
+	}
+	resp.Overhead = make([]dto.OverheadCost, 0, len(rates))
+	for _, rate := range rates {
+		quantity := batchVolumeBBL
+		if rate.Basis == storage.OverheadBasisPerVesselDay {
+			quantity = full.VesselDays
+		}
+		item := dto.OverheadCost{
+			OverheadRateUUID: rate.UUID.String(),
+			Name:             rate.Name,
+			Basis:            rate.Basis,
+			RateCents:        rate.RateCents,
+			Quantity:         quantity,
+			CostCents:        int64(math.Round(quantity * float64(rate.RateCents))),
+		}
+		full.OverheadCostCents += item.CostCents
+		resp.Overhead = append(resp.Overhead, item)
+	}
+
+	// Ingredients in the base currency. A batch with no costed ingredient
+	// lines has no ingredient cost; otherwise every line must convert.
+	var liquidCostCents *int64
+	switch {
+	case resp.Totals.CostedLineCount == 0:
+		var zero int64
+		full.IngredientCostCents = &zero
+	case resp.Totals.BaseTotalCostCents != nil:
+		v := *resp.Totals.BaseTotalCostCents
+		full.IngredientCostCents = &v
+	}
+	if full.IngredientCostCents != nil {
+		liquid := *full.IngredientCostCents + full.LaborCostCents + full.OverheadCostCents
+		liquidCostCents = &liquid
+		total := liquid + full.PackagingMaterialCostCents
+		full.LiquidCostCents = liquidCostCents
+		full.TotalCostCents = &total
+		if batchVolumeBBL > 0 {
+			v := int64(math.Round(float64(total) / batchVolumeBBL))
+			full.CostPerBBLCents = &v
+		}
+	}
+
+	// Loss write-offs, valued at the liquid cost per barrel.
+	removals, err := c.invClient.ListBatchRemovals(ctx, batchUUID)
+	if err != nil {
+		return fmt.Errorf("fetching batch removals: %w", err)
+	}
+	resp.LossWriteOffs = make([]dto.LossWriteOff, 0, len(removals))
+	var lossCents int64
+	lossCosted := liquidCostCents != nil && batchVolumeBBL > 0
+	for _, removal := range removals {
+		item := dto.LossWriteOff{
+			RemovalUUID: removal.UUID,
+			Category:    removal.Category,
+			Reason:      removal.Reason,
+			Amount:      removal.Amount,
+			AmountUnit:  removal.AmountUnit,
+			VolumeBBL:   removal.AmountBBL,
+			RemovedAt:   removal.RemovedAt,
+		}
+		if removal.AmountBBL != nil {
+			full.LossVolumeBBL += *removal.AmountBBL
+			if lossCosted {
+				v := int64(math.Round(*removal.AmountBBL * float64(*liquidCostCents) / batchVolumeBBL))
+				item.CostCents = &v
+				lossCents += v
 			}
 		}
-		uncostedLineCount += len(uncostedAdditions)
+		resp.LossWriteOffs = append(resp.LossWriteOffs, item)
+	}
+	full.LossVolumeBBL = math.Round(full.LossVolumeBBL*100) / 100
+	if lossCosted {
+		full.LossWriteOffCents = &lossCents
+	}
 
-		costComplete := uncostedLineCount == 0
+	// Package formats: the liquid cost is shared by packaged volume, so the
+	// packaged units carry the cost of the beer lost along the way.
+	packageFormatCosts(runLines, charges, liquidCostCents, resp)
 
-		// 12. Determine currency from costed PO lines.
-		var currency *string
-		currencies := make(map[string]struct{})
-		for _, item := range lineItems {
-			if item.CostCents != nil && item.PurchaseOrderLineUUID != nil {
-				poLine := poLineMap[*item.PurchaseOrderLineUUID]
-				if poLine != nil {
-					currencies[poLine.Currency] = struct{}{}
+	return nil
+}
+
+// materialCharge is the base cost of a packaging material and the weight of
+// each package format of its run in it. A charge without weights is shared by
+// the run's formats in proportion to their packaged volume.
+type materialCharge struct {
+	runUUID   string
+	costCents float64
+	formats   map[string]float64
+}
+
+// packagingMaterialCosts costs the lots deducted by the batch's completed
+// packaging runs at the landed cost of the purchase order lines they were
+// received against, in the base currency. Each material is charged to the
+// run's formats whose bill of materials calls for it, by the amount each
+// needed.
+func (c *BatchCostCalculator) packagingMaterialCosts(ctx context.Context, batchUUID string, runLines []storage.PackagingRunLine, resp *dto.BatchCostsResponse) ([]materialCharge, error) {
+	full := &resp.FullCost
+	resp.PackagingMaterials = make([]dto.PackagingMaterialCost, 0)
+
+	runs, err := c.db.ListPackagingRunsByBatchUUID(ctx, batchUUID)
+	if err != nil {
+		return nil, fmt.Errorf("listing packaging runs: %w", err)
+	}
+	usageRuns := make(map[string]string)
+	for _, run := range runs {
+		if run.MaterialUsageUUID != nil {
+			usageRuns[*run.MaterialUsageUUID] = run.UUID.String()
+		}
+	}
+	if len(usageRuns) == 0 {
+		return nil, nil
+	}
+
+	movements, err := c.invClient.ListBatchUsageMovements(ctx, batchUUID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching packaging material usage: %w", err)
+	}
+
+	poLineUUIDSet := make(map[string]struct{})
+	for _, m := range movements {
+		if _, ok := usageRuns[m.UsageUUID]; ok && m.PurchaseOrderLineUUID != nil {
+			poLineUUIDSet[*m.PurchaseOrderLineUUID] = struct{}{}
+		}
+	}
+	poLineMap := make(map[string]*PurchaseOrderLineCost)
+	if len(poLineUUIDSet) > 0 {
+		poLineUUIDs := make([]string, 0, len(poLineUUIDSet))
+		for uuid := range poLineUUIDSet {
+			poLineUUIDs = append(poLineUUIDs, uuid)
+		}
+		poLines, err := c.procClient.BatchLookupPOLines(ctx, poLineUUIDs)
+		if err != nil {
+			return nil, fmt.Errorf("fetching purchase order line data: %w", err)
+		}
+		for i := range poLines {
+			poLineMap[poLines[i].UUID] = &poLines[i]
+		}
+	}
+
+	// Bills of materials of the formats each run packaged.
+	boms := make(map[int64][]storage.PackageFormatMaterial)
+	runFormats := make(map[string][]storage.PackagingRunLine)
+	for _, line := range runLines {
+		runFormats[line.PackagingRunUUID] = append(runFormats[line.PackagingRunUUID], line)
+		if _, ok := boms[line.PackageFormatID]; ok {
+			continue
+		}
+		bom, err := c.db.ListPackageFormatMaterials(ctx, line.PackageFormatID)
+		if err != nil {
+			return nil, fmt.Errorf("listing package format materials: %w", err)
+		}
+		boms[line.PackageFormatID] = bom
+	}
+
+	var charges []materialCharge
+	for _, m := range movements {
+		runUUID, ok := usageRuns[m.UsageUUID]
+		if !ok {
+			continue
+		}
+
+		item := dto.PackagingMaterialCost{
+			MovementUUID:          m.MovementUUID,
+			PackagingRunUUID:      runUUID,
+			IngredientLotUUID:     m.IngredientLotUUID,
+			IngredientUUID:        m.IngredientUUID,
+			Name:                  m.IngredientName,
+			LotCode:               m.BreweryLotCode,
+			Quantity:              m.Amount,
+			Unit:                  m.AmountUnit,
+			PurchaseOrderLineUUID: m.PurchaseOrderLineUUID,
+			CostSource:            "unavailable",
+		}
+
+		formats := make(map[string]float64)
+		for _, line := range runFormats[runUUID] {
+			for _, material := range boms[line.PackageFormatID] {
+				if material.IngredientUUID.String() == m.IngredientUUID && material.Unit == m.AmountUnit {
+					formats[line.PackageFormatUUID] += float64(material.Quantity) * float64(line.Quantity)
 				}
 			}
 		}
-		if len(currencies) == 1 {
-			for c := range currencies {
-				currency = &c
+		if len(formats) == 1 {
+			for formatUUID := range formats {
+				item.PackageFormatUUID = &formatUUID
 			}
-		} else if len(currencies) > 1 {
-			mixed := "MIXED"
-			currency = &mixed
-		}
-
-		// 13. Compute batch volume in BBL from the starting volume.
-		var batchVolumeBBL *float64
-		var startingVolume *storage.BatchVolumeWithAmount
-		for i := range summary.Volumes {
-			v := &summary.Volumes[i]
-			if startingVolume == nil || v.BatchVolume.PhaseAt.Before(startingVolume.BatchVolume.PhaseAt) {
-				startingVolume = v
+		}
+
+		if m.PurchaseOrderLineUUID != nil {
+			poLine := poLineMap[*m.PurchaseOrderLineUUID]
+			if poLine != nil && m.AmountUnit == poLine.QuantityUnit {
+				item.UnitCostCents = &poLine.UnitCostCents
+				item.Currency = &poLine.Currency
+				if poLine.ExchangeRate != nil {
+					material, fee := landedCostCents(m.Amount, poLine)
+					baseCents := int64(math.Round(float64(material+fee) * poLine.ExchangeRate.Rate))
+					item.CostCents = &baseCents
+					item.CostSource = "purchase_order"
+				}
 			}
 		}
-		if startingVolume != nil {
-			batchVolumeBBL = dto.ConvertToBBL(startingVolume.Volume.Amount, startingVolume.Volume.AmountUnit)
+
+		if item.CostCents == nil {
+			full.UncostedPackagingMaterialCount++
+		} else {
+			full.PackagingMaterialCostCents += *item.CostCents
+			charges = append(charges, materialCharge{runUUID: runUUID, costCents: float64(*item.CostCents), formats: formats})
 		}
+		resp.PackagingMaterials = append(resp.PackagingMaterials, item)
+	}
+
+	return charges, nil
+}
 
-		// 14. Compute cost per BBL.
-		var costPerBBLCents *int64
-		if batchVolumeBBL != nil && *batchVolumeBBL > 0 && (currency == nil || *currency != "MIXED") {
-			v := int64(math.Round(float64(totalCostCents) / *batchVolumeBBL))
-			costPerBBLCents = &v
+// landedCostCents is the purchase price of amount units of a purchase order
+// line and their share of the order's allocated fees.
+func landedCostCents(amount int64, poLine *PurchaseOrderLineCost) (materialCents, feeCents int64) {
+	materialCents = amount * poLine.UnitCostCents
+	if poLine.Quantity > 0 {
+		feeCents = int64(math.Round(float64(amount) * float64(poLine.FeeAllocatedCents) / float64(poLine.Quantity)))
+	}
+	return materialCents, feeCents
+}
+
+// packageFormatCosts splits the liquid cost and the packaging materials over
+// the package formats the batch was packaged into.
+func packageFormatCosts(runLines []storage.PackagingRunLine, charges []materialCharge, liquidCostCents *int64, resp *dto.BatchCostsResponse) {
+	full := &resp.FullCost
+	formats := make(map[string]int)
+	resp.PackageFormats = make([]dto.PackageFormatCost, 0)
+
+	runVolumes := make(map[string]float64)
+	runFormatVolumes := make(map[string]map[string]float64)
+	var packagedBBL float64
+	for _, line := range runLines {
+		i, ok := formats[line.PackageFormatUUID]
+		if !ok {
+			i = len(resp.PackageFormats)
+			formats[line.PackageFormatUUID] = i
+			resp.PackageFormats = append(resp.PackageFormats, dto.PackageFormatCost{
+				PackageFormatUUID: line.PackageFormatUUID,
+				PackageFormatName: line.PackageFormatName,
+			})
 		}
 
-		resp := dto.BatchCostsResponse{
-			BatchUUID:         batchUUID,
-			Currency:          currency,
-			LineItems:         lineItems,
-			UncostedAdditions: uncostedAdditions,
-			Totals: dto.CostTotals{
-				TotalCostCents:    totalCostCents,
-				CostedLineCount:   costedLineCount,
-				UncostedLineCount: uncostedLineCount,
-				CostComplete:      costComplete,
-				CostPerBBLCents:   costPerBBLCents,
-				BatchVolumeBBL:    batchVolumeBBL,
-			},
+		var bbl float64
+		if v := dto.ConvertToBBL(int64(line.Quantity)*line.PackageFormatVolumePerUnit, line.PackageFormatVolumePerUnitUnit); v != nil {
+			bbl = *v
+		}
+		resp.PackageFormats[i].Units += line.Quantity
+		resp.PackageFormats[i].VolumeBBL += bbl
+		full.PackagedUnits += line.Quantity
+		packagedBBL += bbl
+
+		runVolumes[line.PackagingRunUUID] += bbl
+		if runFormatVolumes[line.PackagingRunUUID] == nil {
+			runFormatVolumes[line.PackagingRunUUID] = make(map[string]float64)
 		}
+		runFormatVolumes[line.PackagingRunUUID][line.PackageFormatUUID] += bbl
+	}
 
-		service.JSON(w, resp)
+	// Materials go to the formats whose bills of materials called for them;
+	// any others are shared by the run's formats in proportion to their
+	// packaged volume.
+	materialCents := make([]float64, len(resp.PackageFormats))
+	for _, charge := range charges {
+		weights, total := charge.formats, 0.0
+		for _, w := range weights {
+			total += w
+		}
+		if total <= 0 {
+			weights, total = runFormatVolumes[charge.runUUID], runVolumes[charge.runUUID]
+		}
+		if total <= 0 {
+			continue
+		}
+		for formatUUID, w := range weights {
+			materialCents[formats[formatUUID]] += charge.costCents * w / total
+		}
+	}
+
+	for i := range resp.PackageFormats {
+		format := &resp.PackageFormats[i]
+		volumeBBL := format.VolumeBBL
+		format.VolumeBBL = math.Round(volumeBBL*100) / 100
+		format.MaterialCostCents = int64(math.Round(materialCents[i]))
+		if liquidCostCents == nil {
+			continue
+		}
+
+		var liquid int64
+		if packagedBBL > 0 {
+			liquid = int64(math.Round(float64(*liquidCostCents) * volumeBBL / packagedBBL))
+		}
+		total := liquid + format.MaterialCostCents
+		format.LiquidCostCents = &liquid
+		format.TotalCostCents = &total
+		if format.Units > 0 {
+			v := int64(math.Round(float64(total) / float64(format.Units)))
+			format.CostPerUnitCents = &v
+		}
+	}
+
+	if full.TotalCostCents != nil && full.PackagedUnits > 0 {
+		v := int64(math.Round(float64(*full.TotalCostCents) / float64(full.PackagedUnits)))
+		full.CostPerUnitCents = &v
 	}
 }
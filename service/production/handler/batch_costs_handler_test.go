@@ -19,12 +19,20 @@ import (
 
 // mockBatchCostsStore implements handler.BatchCostsStore for testing.
 type mockBatchCostsStore struct {
-	batch        storage.Batch
-	batchErr     error
-	summary      storage.BatchSummary
-	summaryErr   error
-	additions    []storage.Addition
-	additionsErr error
+	batch         storage.Batch
+	batchErr      error
+	summary       storage.BatchSummary
+	summaryErr    error
+	additions     []storage.Addition
+	additionsErr  error
+	runLines      []storage.PackagingRunLine
+	runs          []storage.PackagingRun
+	boms          map[int64][]storage.PackageFormatMaterial
+	labor         []storage.BatchLaborEntry
+	overheadRates []storage.OverheadRate
+	occupancies   []storage.Occupancy
+	snapshot      *storage.BatchCostSnapshot
+	savedCosts    []byte
 }
 
 func (m *mockBatchCostsStore) GetBatchByUUID(_ context.Context, _ string) (storage.Batch, error) {
@@ -39,23 +47,70 @@ func (m *mockBatchCostsStore) ListAdditionsByBatchUUID(_ context.Context, _ stri
 	return m.additions, m.additionsErr
 }
 
-// mockIngredientLotFetcher implements handler.IngredientLotFetcher for testing.
-type mockIngredientLotFetcher struct {
-	lots []handler.BatchIngredientLot
-	err  error
+func (m *mockBatchCostsStore) ListPackagingRunLinesByBatchUUID(_ context.Context, _ string) ([]storage.PackagingRunLine, error) {
+	return m.runLines, nil
 }
 
-func (m *mockIngredientLotFetcher) GetBatchIngredientLots(_ context.Context, _ string, _ string) ([]handler.BatchIngredientLot, error) {
+func (m *mockBatchCostsStore) ListPackagingRunsByBatchUUID(_ context.Context, _ string) ([]storage.PackagingRun, error) {
+	return m.runs, nil
+}
+
+func (m *mockBatchCostsStore) ListPackageFormatMaterials(_ context.Context, formatID int64) ([]storage.PackageFormatMaterial, error) {
+	return m.boms[formatID], nil
+}
+
+func (m *mockBatchCostsStore) ListBatchLaborEntriesByBatchUUID(_ context.Context, _ string) ([]storage.BatchLaborEntry, error) {
+	return m.labor, nil
+}
+
+func (m *mockBatchCostsStore) ListOverheadRates(_ context.Context, _ bool) ([]storage.OverheadRate, error) {
+	return m.overheadRates, nil
+}
+
+func (m *mockBatchCostsStore) ListOccupanciesByBatchUUID(_ context.Context, _ string) ([]storage.Occupancy, error) {
+	return m.occupancies, nil
+}
+
+func (m *mockBatchCostsStore) GetBatchCostSnapshot(_ context.Context, _ string) (storage.BatchCostSnapshot, error) {
+	if m.snapshot == nil {
+		return storage.BatchCostSnapshot{}, service.ErrNotFound
+	}
+	return *m.snapshot, nil
+}
+
+func (m *mockBatchCostsStore) SaveBatchCostSnapshot(_ context.Context, batchID int64, costs []byte, snapshottedAt time.Time) error {
+	m.savedCosts = costs
+	m.snapshot = &storage.BatchCostSnapshot{BatchID: batchID, Costs: costs, SnapshottedAt: snapshottedAt}
+	return nil
+}
+
+// mockBatchCostsInventory implements handler.BatchCostsInventory for testing.
+type mockBatchCostsInventory struct {
+	lots      []handler.BatchIngredientLot
+	movements []handler.BatchUsageMovement
+	removals  []handler.BatchRemoval
+	err       error
+}
+
+func (m *mockBatchCostsInventory) GetBatchIngredientLots(_ context.Context, _ string) ([]handler.BatchIngredientLot, error) {
 	return m.lots, m.err
 }
 
+func (m *mockBatchCostsInventory) ListBatchUsageMovements(_ context.Context, _ string) ([]handler.BatchUsageMovement, error) {
+	return m.movements, nil
+}
+
+func (m *mockBatchCostsInventory) ListBatchRemovals(_ context.Context, _ string) ([]handler.BatchRemoval, error) {
+	return m.removals, nil
+}
+
 // mockPOLineFetcher implements handler.POLineFetcher for testing.
 type mockPOLineFetcher struct {
 	lines []handler.PurchaseOrderLineCost
 	err   error
 }
 
-func (m *mockPOLineFetcher) BatchLookupPOLines(_ context.Context, _ string, _ []string) ([]handler.PurchaseOrderLineCost, error) {
+func (m *mockPOLineFetcher) BatchLookupPOLines(_ context.Context, _ []string) ([]handler.PurchaseOrderLineCost, error) {
 	return m.lines, m.err
 }
 
@@ -75,6 +130,11 @@ func i64p(v int64) *int64 {
 	return &v
 }
 
+// helper to create a *float64.
+func f64p(v float64) *float64 {
+	return &v
+}
+
 func TestHandleBatchCosts(t *testing.T) {
 	batchUUID := "550e8400-e29b-41d4-a716-446655440000"
 	additionUUID1 := uuid.Must(uuid.FromString("660e8400-e29b-41d4-a716-446655440001"))
@@ -110,7 +170,7 @@ func TestHandleBatchCosts(t *testing.T) {
 		name           string
 		batchUUID      string
 		store          *mockBatchCostsStore
-		invClient      *mockIngredientLotFetcher
+		invClient      *mockBatchCostsInventory
 		procClient     *mockPOLineFetcher
 		expectedStatus int
 		validate       func(t *testing.T, resp dto.BatchCostsResponse)
@@ -121,7 +181,7 @@ func TestHandleBatchCosts(t *testing.T) {
 			store: &mockBatchCostsStore{
 				batchErr: service.ErrNotFound,
 			},
-			invClient:      &mockIngredientLotFetcher{},
+			invClient:      &mockBatchCostsInventory{},
 			procClient:     &mockPOLineFetcher{},
 			expectedStatus: http.StatusNotFound,
 		},
@@ -133,7 +193,7 @@ func TestHandleBatchCosts(t *testing.T) {
 				summary:   storage.BatchSummary{Batch: baseBatch},
 				additions: []storage.Addition{},
 			},
-			invClient:      &mockIngredientLotFetcher{},
+			invClient:      &mockBatchCostsInventory{},
 			procClient:     &mockPOLineFetcher{},
 			expectedStatus: http.StatusOK,
 			validate: func(t *testing.T, resp dto.BatchCostsResponse) {
@@ -169,7 +229,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -221,6 +281,58 @@ func TestHandleBatchCosts(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:      "allocated fees are added as a separate share",
+			batchUUID: batchUUID,
+			store: &mockBatchCostsStore{
+				batch:   baseBatch,
+				summary: summaryWith10BBL,
+				additions: []storage.Addition{
+					{
+						AdditionType:     "malt",
+						Amount:           100,
+						AmountUnit:       "kg",
+						InventoryLotUUID: uuidPtr(lotUUID1),
+					},
+				},
+			},
+			invClient: &mockBatchCostsInventory{
+				lots: []handler.BatchIngredientLot{
+					{
+						IngredientLotUUID:     lotUUID1,
+						IngredientUUID:        "aaa00000-0000-0000-0000-000000000001",
+						IngredientName:        "Pale Malt",
+						IngredientCategory:    "fermentable",
+						PurchaseOrderLineUUID: sp(poLineUUID1),
+					},
+				},
+			},
+			procClient: &mockPOLineFetcher{
+				lines: []handler.PurchaseOrderLineCost{
+					{UUID: poLineUUID1, UnitCostCents: 50, Quantity: 1000, QuantityUnit: "kg", Currency: "USD", FeeAllocatedCents: 2500},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.BatchCostsResponse) {
+				if len(resp.LineItems) != 1 {
+					t.Fatalf("expected 1 line item, got %d", len(resp.LineItems))
+				}
+				item := resp.LineItems[0]
+				// 100 of 1000 kg carries a tenth of the 2500 fee share.
+				if item.MaterialCostCents == nil || *item.MaterialCostCents != 5000 {
+					t.Errorf("expected material_cost_cents=5000, got %v", item.MaterialCostCents)
+				}
+				if item.FeeCostCents == nil || *item.FeeCostCents != 250 {
+					t.Errorf("expected fee_cost_cents=250, got %v", item.FeeCostCents)
+				}
+				if item.CostCents == nil || *item.CostCents != 5250 {
+					t.Errorf("expected cost_cents=5250, got %v", item.CostCents)
+				}
+				if resp.Totals.TotalCostCents != 5250 || resp.Totals.MaterialCostCents != 5000 || resp.Totals.FeeCostCents != 250 {
+					t.Errorf("expected totals 5250 = 5000 + 250, got %+v", resp.Totals)
+				}
+			},
+		},
 		{
 			name:      "addition without inventory lot UUID",
 			batchUUID: batchUUID,
@@ -236,7 +348,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient:      &mockIngredientLotFetcher{lots: []handler.BatchIngredientLot{}},
+			invClient:      &mockBatchCostsInventory{lots: []handler.BatchIngredientLot{}},
 			procClient:     &mockPOLineFetcher{},
 			expectedStatus: http.StatusOK,
 			validate: func(t *testing.T, resp dto.BatchCostsResponse) {
@@ -269,7 +381,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -309,7 +421,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -359,7 +471,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -391,6 +503,74 @@ func TestHandleBatchCosts(t *testing.T) {
 				if resp.Totals.CostPerBBLCents != nil {
 					t.Errorf("expected cost_per_bbl_cents=nil for mixed currencies, got %d", *resp.Totals.CostPerBBLCents)
 				}
+				if resp.Totals.BaseTotalCostCents != nil {
+					t.Errorf("expected base_total_cost_cents=nil without exchange rates, got %d", *resp.Totals.BaseTotalCostCents)
+				}
+			},
+		},
+		{
+			name:      "mixed currencies convert to the base currency",
+			batchUUID: batchUUID,
+			store: &mockBatchCostsStore{
+				batch:   baseBatch,
+				summary: summaryWith10BBL,
+				additions: []storage.Addition{
+					{
+						AdditionType:     "malt",
+						Amount:           100,
+						AmountUnit:       "kg",
+						InventoryLotUUID: uuidPtr(lotUUID1),
+					},
+					{
+						AdditionType:     "hop",
+						Amount:           5,
+						AmountUnit:       "kg",
+						InventoryLotUUID: uuidPtr(lotUUID2),
+					},
+				},
+			},
+			invClient: &mockBatchCostsInventory{
+				lots: []handler.BatchIngredientLot{
+					{
+						IngredientLotUUID:     lotUUID1,
+						IngredientUUID:        "aaa00000-0000-0000-0000-000000000001",
+						IngredientName:        "Pale Malt",
+						IngredientCategory:    "fermentable",
+						PurchaseOrderLineUUID: sp(poLineUUID1),
+					},
+					{
+						IngredientLotUUID:     lotUUID2,
+						IngredientUUID:        "aaa00000-0000-0000-0000-000000000002",
+						IngredientName:        "Hallertau Mittelfrueh",
+						IngredientCategory:    "hop",
+						PurchaseOrderLineUUID: sp(poLineUUID2),
+					},
+				},
+			},
+			procClient: &mockPOLineFetcher{
+				lines: []handler.PurchaseOrderLineCost{
+					{UUID: poLineUUID1, UnitCostCents: 50, Quantity: 1000, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+					{UUID: poLineUUID2, UnitCostCents: 200, Quantity: 50, QuantityUnit: "kg", Currency: "EUR", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1.1, Locked: true}},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.BatchCostsResponse) {
+				if resp.Currency == nil || *resp.Currency != "MIXED" {
+					t.Errorf("expected currency=MIXED, got %v", resp.Currency)
+				}
+				if resp.BaseCurrency == nil || *resp.BaseCurrency != "USD" {
+					t.Errorf("expected base_currency=USD, got %v", resp.BaseCurrency)
+				}
+				hop := resp.LineItems[1]
+				if hop.CostCents == nil || *hop.CostCents != 1000 || hop.BaseCostCents == nil || *hop.BaseCostCents != 1100 {
+					t.Errorf("expected EUR 1000 to convert to USD 1100, got %v and %v", hop.CostCents, hop.BaseCostCents)
+				}
+				if resp.Totals.BaseTotalCostCents == nil || *resp.Totals.BaseTotalCostCents != 6100 {
+					t.Errorf("expected base_total_cost_cents=6100, got %v", resp.Totals.BaseTotalCostCents)
+				}
+				if resp.Totals.BaseCostPerBBLCents == nil || *resp.Totals.BaseCostPerBBLCents != 610 {
+					t.Errorf("expected base_cost_per_bbl_cents=610, got %v", resp.Totals.BaseCostPerBBLCents)
+				}
 			},
 		},
 		{
@@ -408,7 +588,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				err: errors.New("inventory service unavailable"),
 			},
 			procClient:     &mockPOLineFetcher{},
@@ -429,7 +609,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -460,7 +640,7 @@ func TestHandleBatchCosts(t *testing.T) {
 					},
 				},
 			},
-			invClient: &mockIngredientLotFetcher{
+			invClient: &mockBatchCostsInventory{
 				lots: []handler.BatchIngredientLot{
 					{
 						IngredientLotUUID:     lotUUID1,
@@ -518,7 +698,6 @@ func TestHandleBatchCosts(t *testing.T) {
 
 			req := httptest.NewRequest(http.MethodGet, "/batches/"+tt.batchUUID+"/costs", nil)
 			req.SetPathValue("uuid", tt.batchUUID)
-			req.Header.Set("Authorization", "Bearer test-token")
 			rec := httptest.NewRecorder()
 
 			h.ServeHTTP(rec, req)
@@ -537,3 +716,247 @@ func TestHandleBatchCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleBatchCostsFullCost(t *testing.T) {
+	batchUUID := "550e8400-e29b-41d4-a716-446655440000"
+	lotUUID := "770e8400-e29b-41d4-a716-446655440001"
+	poLineUUID := "880e8400-e29b-41d4-a716-446655440001"
+	runUUID := "990e8400-e29b-41d4-a716-446655440001"
+	kegLineUUID := uuid.Must(uuid.FromString("990e8400-e29b-41d4-a716-446655440011"))
+	canLineUUID := uuid.Must(uuid.FromString("990e8400-e29b-41d4-a716-446655440012"))
+
+	batch := storage.Batch{ShortName: "IPA 24-07"}
+	batch.ID = 7
+	batch.UUID = uuid.Must(uuid.FromString(batchUUID))
+
+	addition := storage.Addition{AdditionType: "malt", Amount: 100, AmountUnit: "kg", InventoryLotUUID: uuidPtr(lotUUID)}
+	addition.UUID = uuid.Must(uuid.FromString("660e8400-e29b-41d4-a716-446655440001"))
+
+	kegLine := storage.PackagingRunLine{
+		PackagingRunUUID:               runUUID,
+		PackageFormatID:                1,
+		PackageFormatUUID:              "keg-format",
+		PackageFormatName:              "1/2 bbl keg",
+		PackageFormatVolumePerUnit:     1984,
+		PackageFormatVolumePerUnitUnit: "usfloz",
+		Quantity:                       10,
+	}
+	kegLine.UUID = kegLineUUID
+	canLine := storage.PackagingRunLine{
+		PackagingRunUUID:               runUUID,
+		PackageFormatID:                2,
+		PackageFormatUUID:              "can-format",
+		PackageFormatName:              "16oz can",
+		PackageFormatVolumePerUnit:     16,
+		PackageFormatVolumePerUnitUnit: "usfloz",
+		Quantity:                       992,
+	}
+	canLine.UUID = canLineUUID
+
+	// The run deducted the lids its can format calls for, a label roll no
+	// format's bill of materials names, and carriers from a lot with no
+	// purchase order line. The malt was used in the brew, not by the run.
+	run := storage.PackagingRun{MaterialUsageUUID: sp("usage-package")}
+	run.UUID = uuid.Must(uuid.FromString(runUUID))
+	lidsUUID := "aaa00000-0000-0000-0000-000000000002"
+	movements := []handler.BatchUsageMovement{
+		{UsageUUID: "usage-brew", MovementUUID: "movement-malt", Reason: "use", IngredientLotUUID: lotUUID, IngredientUUID: "aaa00000-0000-0000-0000-000000000001", IngredientName: "Pale Malt", PurchaseOrderLineUUID: sp(poLineUUID), Amount: 100, AmountUnit: "kg"},
+		{UsageUUID: "usage-package", MovementUUID: "movement-lids", Reason: "package", IngredientLotUUID: "lot-lids", IngredientUUID: lidsUUID, IngredientName: "Can lids", PurchaseOrderLineUUID: sp("po-line-lids"), Amount: 992, AmountUnit: "each"},
+		{UsageUUID: "usage-package", MovementUUID: "movement-labels", Reason: "package", IngredientLotUUID: "lot-labels", IngredientUUID: "aaa00000-0000-0000-0000-000000000003", IngredientName: "Label roll", PurchaseOrderLineUUID: sp("po-line-labels"), Amount: 1, AmountUnit: "each"},
+		{UsageUUID: "usage-package", MovementUUID: "movement-carriers", Reason: "package", IngredientLotUUID: "lot-carriers", IngredientUUID: "aaa00000-0000-0000-0000-000000000004", IngredientName: "Carriers", Amount: 248, AmountUnit: "each"},
+	}
+
+	inAt := time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC)
+	outAt := inAt.AddDate(0, 0, 10)
+
+	store := &mockBatchCostsStore{
+		batch: batch,
+		summary: storage.BatchSummary{
+			Batch: batch,
+			Volumes: []storage.BatchVolumeWithAmount{
+				{BatchVolume: storage.BatchVolume{PhaseAt: inAt}, Volume: storage.Volume{Amount: 10, AmountUnit: "bbl"}},
+			},
+		},
+		additions: []storage.Addition{addition},
+		runLines:  []storage.PackagingRunLine{kegLine, canLine},
+		runs:      []storage.PackagingRun{run},
+		boms: map[int64][]storage.PackageFormatMaterial{
+			2: {{IngredientUUID: uuid.Must(uuid.FromString(lidsUUID)), Name: "Can lids", Quantity: 1, Unit: "each"}},
+		},
+		labor: []storage.BatchLaborEntry{
+			{Role: "brewer", Hours: 4, HourlyRateCents: 2500},
+			{Role: "brewer", Hours: 2, HourlyRateCents: 2500},
+		},
+		overheadRates: []storage.OverheadRate{
+			{Name: "Utilities", Basis: storage.OverheadBasisPerBBL, RateCents: 300, IsActive: true},
+			{Name: "Tank rent", Basis: storage.OverheadBasisPerVesselDay, RateCents: 100, IsActive: true},
+		},
+		occupancies: []storage.Occupancy{{InAt: inAt, OutAt: &outAt}},
+	}
+	invClient := &mockBatchCostsInventory{
+		lots: []handler.BatchIngredientLot{
+			{IngredientLotUUID: lotUUID, IngredientUUID: "aaa00000-0000-0000-0000-000000000001", IngredientName: "Pale Malt", IngredientCategory: "fermentable", PurchaseOrderLineUUID: sp(poLineUUID)},
+		},
+		movements: movements,
+		removals: []handler.BatchRemoval{
+			{UUID: "removal-1", Category: "dump", Reason: "spillage", Amount: 1, AmountUnit: "bbl", AmountBBL: f64p(1)},
+		},
+	}
+	procClient := &mockPOLineFetcher{
+		lines: []handler.PurchaseOrderLineCost{
+			{UUID: poLineUUID, UnitCostCents: 50, Quantity: 1000, QuantityUnit: "kg", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+			{UUID: "po-line-lids", UnitCostCents: 5, Quantity: 5000, QuantityUnit: "each", Currency: "USD", BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+			{UUID: "po-line-labels", UnitCostCents: 800, Quantity: 10, QuantityUnit: "each", Currency: "USD", FeeAllocatedCents: 1000, BaseCurrency: "USD", ExchangeRate: &handler.PurchaseOrderExchangeRate{Rate: 1, Locked: true}},
+		},
+	}
+
+	h := handler.HandleBatchCosts(store, invClient, procClient)
+	req := httptest.NewRequest(http.MethodGet, "/batches/"+batchUUID+"/costs", nil)
+	req.SetPathValue("uuid", batchUUID)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp dto.BatchCostsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	full := resp.FullCost
+	// Ingredients 5000, labor 6h × 2500 = 15000, overhead 10 bbl × 300 +
+	// 10 days × 100 = 4000: liquid cost 24000. Materials 4960 + 900.
+	if full.IngredientCostCents == nil || *full.IngredientCostCents != 5000 {
+		t.Errorf("expected ingredient_cost_cents=5000, got %v", full.IngredientCostCents)
+	}
+	if full.LaborCostCents != 15000 {
+		t.Errorf("expected labor_cost_cents=15000, got %d", full.LaborCostCents)
+	}
+	if len(resp.Labor) != 1 || resp.Labor[0].Hours != 6 {
+		t.Errorf("expected one brewer labor line of 6 hours, got %+v", resp.Labor)
+	}
+	if full.VesselDays != 10 {
+		t.Errorf("expected vessel_days=10, got %f", full.VesselDays)
+	}
+	if full.OverheadCostCents != 4000 {
+		t.Errorf("expected overhead_cost_cents=4000, got %d", full.OverheadCostCents)
+	}
+	if full.PackagingMaterialCostCents != 5860 {
+		t.Errorf("expected packaging_material_cost_cents=5860, got %d", full.PackagingMaterialCostCents)
+	}
+	if full.UncostedPackagingMaterialCount != 1 {
+		t.Errorf("expected uncosted_packaging_material_count=1, got %d", full.UncostedPackagingMaterialCount)
+	}
+	if len(resp.PackagingMaterials) != 3 {
+		t.Fatalf("expected 3 packaging materials, got %d", len(resp.PackagingMaterials))
+	}
+	lids, labels, carriers := resp.PackagingMaterials[0], resp.PackagingMaterials[1], resp.PackagingMaterials[2]
+	if lids.CostCents == nil || *lids.CostCents != 4960 || lids.PackageFormatUUID == nil || *lids.PackageFormatUUID != "can-format" {
+		t.Errorf("unexpected lids cost: %+v", lids)
+	}
+	// 800 plus a tenth of the 1000 fee.
+	if labels.CostCents == nil || *labels.CostCents != 900 || labels.PackageFormatUUID != nil {
+		t.Errorf("unexpected label roll cost: %+v", labels)
+	}
+	if carriers.CostCents != nil || carriers.CostSource != "unavailable" {
+		t.Errorf("expected carriers to be uncosted, got %+v", carriers)
+	}
+	if full.TotalCostCents == nil || *full.TotalCostCents != 29860 {
+		t.Fatalf("expected total_cost_cents=29860, got %v", full.TotalCostCents)
+	}
+	if full.CostPerBBLCents == nil || *full.CostPerBBLCents != 2986 {
+		t.Errorf("expected cost_per_bbl_cents=2986, got %v", full.CostPerBBLCents)
+	}
+	// 1 bbl lost at 24000 / 10 bbl.
+	if full.LossWriteOffCents == nil || *full.LossWriteOffCents != 2400 {
+		t.Errorf("expected loss_write_off_cents=2400, got %v", full.LossWriteOffCents)
+	}
+	if full.PackagedUnits != 1002 {
+		t.Errorf("expected packaged_units=1002, got %d", full.PackagedUnits)
+	}
+
+	// 9 bbl packaged: kegs 5 bbl, cans 4 bbl. The label roll is shared by
+	// volume; the lids go to the cans.
+	if len(resp.PackageFormats) != 2 {
+		t.Fatalf("expected 2 package formats, got %d", len(resp.PackageFormats))
+	}
+	keg, can := resp.PackageFormats[0], resp.PackageFormats[1]
+	if keg.LiquidCostCents == nil || *keg.LiquidCostCents != 13333 || keg.MaterialCostCents != 500 {
+		t.Errorf("unexpected keg costs: %+v", keg)
+	}
+	if keg.CostPerUnitCents == nil || *keg.CostPerUnitCents != 1383 {
+		t.Errorf("expected keg cost_per_unit_cents=1383, got %v", keg.CostPerUnitCents)
+	}
+	if can.LiquidCostCents == nil || *can.LiquidCostCents != 10667 || can.MaterialCostCents != 5360 {
+		t.Errorf("unexpected can costs: %+v", can)
+	}
+	if can.CostPerUnitCents == nil || *can.CostPerUnitCents != 16 {
+		t.Errorf("expected can cost_per_unit_cents=16, got %v", can.CostPerUnitCents)
+	}
+}
+
+func TestHandleBatchCostSnapshot(t *testing.T) {
+	batchUUID := "550e8400-e29b-41d4-a716-446655440000"
+	batch := storage.Batch{ShortName: "IPA 24-07"}
+	batch.ID = 7
+	batch.UUID = uuid.Must(uuid.FromString(batchUUID))
+
+	store := &mockBatchCostsStore{
+		batch:   batch,
+		summary: storage.BatchSummary{Batch: batch},
+		labor:   []storage.BatchLaborEntry{{Role: "brewer", Hours: 2, HourlyRateCents: 3000}},
+	}
+	invClient := &mockBatchCostsInventory{}
+	procClient := &mockPOLineFetcher{}
+
+	get := func(query string) dto.BatchCostsResponse {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/batches/"+batchUUID+"/costs"+query, nil)
+		req.SetPathValue("uuid", batchUUID)
+		rec := httptest.NewRecorder()
+		handler.HandleBatchCosts(store, invClient, procClient).ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp dto.BatchCostsResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return resp
+	}
+
+	if resp := get(""); resp.SnapshotAt != nil {
+		t.Fatal("expected live costs before a snapshot is taken")
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/batches/"+batchUUID+"/costs/snapshot", nil)
+	req.SetPathValue("uuid", batchUUID)
+	rec := httptest.NewRecorder()
+	handler.HandleBatchCostSnapshot(store, invClient, procClient).ServeHTTP(rec, req)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if store.savedCosts == nil {
+		t.Fatal("expected snapshot to be saved")
+	}
+
+	// Labor recorded after the snapshot only shows in live costs.
+	store.labor = append(store.labor, storage.BatchLaborEntry{Role: "packager", Hours: 1, HourlyRateCents: 2000})
+
+	snapshot := get("")
+	if snapshot.SnapshotAt == nil {
+		t.Fatal("expected snapshot_at to be set")
+	}
+	if snapshot.FullCost.LaborCostCents != 6000 {
+		t.Errorf("expected snapshot labor_cost_cents=6000, got %d", snapshot.FullCost.LaborCostCents)
+	}
+
+	live := get("?live=true")
+	if live.SnapshotAt != nil {
+		t.Error("expected live costs to have no snapshot_at")
+	}
+	if live.FullCost.LaborCostCents != 8000 {
+		t.Errorf("expected live labor_cost_cents=8000, got %d", live.FullCost.LaborCostCents)
+	}
+}
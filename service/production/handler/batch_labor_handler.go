@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// BatchLaborStore defines the storage methods needed by batch labor handlers.
+type BatchLaborStore interface {
+	GetBatchByUUID(context.Context, string) (storage.Batch, error)
+	CreateBatchLaborEntry(context.Context, storage.BatchLaborEntry) (storage.BatchLaborEntry, error)
+	ListBatchLaborEntriesByBatchUUID(context.Context, string) ([]storage.BatchLaborEntry, error)
+	DeleteBatchLaborEntryByUUID(context.Context, string) error
+}
+
+// HandleBatchLabor handles [GET /batches/{uuid}/labor] and
+// [POST /batches/{uuid}/labor].
+func HandleBatchLabor(db BatchLaborStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		batchUUID := r.PathValue("uuid")
+		if batchUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		batch, err := db.GetBatchByUUID(r.Context(), batchUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "batch not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting batch", "error", err, "batch_uuid", batchUUID)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			entries, err := db.ListBatchLaborEntriesByBatchUUID(r.Context(), batchUUID)
+			if err != nil {
+				service.InternalError(w, "error listing batch labor", "error", err, "batch_uuid", batchUUID)
+				return
+			}
+
+			service.JSON(w, dto.NewBatchLaborEntriesResponse(entries))
+		case http.MethodPost:
+			var req dto.CreateBatchLaborEntryRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			workedAt := time.Time{}
+			if req.WorkedAt != nil {
+				workedAt = *req.WorkedAt
+			}
+
+			created, err := db.CreateBatchLaborEntry(r.Context(), storage.BatchLaborEntry{
+				BatchID:         batch.ID,
+				Role:            req.Role,
+				Hours:           req.Hours,
+				HourlyRateCents: req.HourlyRateCents,
+				WorkedAt:        workedAt,
+				Notes:           req.Notes,
+			})
+			if err != nil {
+				service.InternalError(w, "error creating batch labor entry", "error", err, "batch_uuid", batchUUID)
+				return
+			}
+
+			slog.Info("batch labor entry created", "labor_uuid", created.UUID, "batch_uuid", batchUUID)
+
+			service.JSONCreated(w, dto.NewBatchLaborEntryResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleBatchLaborByUUID handles [DELETE /batch-labor/{uuid}].
+func HandleBatchLaborByUUID(db BatchLaborStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		entryUUID := r.PathValue("uuid")
+		if entryUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		err := db.DeleteBatchLaborEntryByUUID(r.Context(), entryUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "batch labor entry not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error deleting batch labor entry", "error", err, "labor_uuid", entryUUID)
+			return
+		}
+
+		slog.Info("batch labor entry deleted", "labor_uuid", entryUUID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"log/slog"
 	"net/http"
 	"time"
 
@@ -19,8 +20,14 @@ type BatchProcessPhaseStore interface {
 	GetBatchByUUID(context.Context, string) (storage.Batch, error)
 }
 
+// BatchCostSnapshotter freezes a batch's costs when it finishes.
+type BatchCostSnapshotter interface {
+	Snapshot(ctx context.Context, batch storage.Batch) (dto.BatchCostsResponse, error)
+}
+
 // HandleBatchProcessPhases handles [GET /batch-process-phases] and [POST /batch-process-phases].
-func HandleBatchProcessPhases(db BatchProcessPhaseStore) http.HandlerFunc {
+// Recording the finished phase snapshots the batch's costs.
+func HandleBatchProcessPhases(db BatchProcessPhaseStore, costs BatchCostSnapshotter) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
@@ -74,6 +81,14 @@ func HandleBatchProcessPhases(db BatchProcessPhaseStore) http.HandlerFunc {
 				return
 			}
 
+			// A failed snapshot does not undo the phase; it can be retaken
+			// with POST /batches/{uuid}/costs/snapshot.
+			if created.ProcessPhase == storage.ProcessPhaseFinished {
+				if _, err := costs.Snapshot(r.Context(), batch); err != nil {
+					slog.Warn("error snapshotting batch costs", "error", err, "batch_uuid", req.BatchUUID)
+				}
+			}
+
 			service.JSONCreated(w, dto.NewBatchProcessPhaseResponse(created))
 		default:
 			service.MethodNotAllowed(w)
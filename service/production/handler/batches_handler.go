@@ -49,9 +49,11 @@ func HandleBatches(db BatchStore) http.HandlerFunc {
 			}
 
 			batch := storage.Batch{
-				ShortName: req.ShortName,
-				BrewDate:  req.BrewDate,
-				Notes:     req.Notes,
+				ShortName:         req.ShortName,
+				BrewDate:          req.BrewDate,
+				Notes:             req.Notes,
+				PlannedVolume:     req.PlannedVolume,
+				PlannedVolumeUnit: req.PlannedVolumeUnit,
 			}
 
 			// Resolve recipe UUID to internal ID if provided
@@ -78,8 +80,14 @@ func HandleBatches(db BatchStore) http.HandlerFunc {
 	}
 }
 
+// ReservationReleaser abstracts the inter-service call to the Inventory service
+// that releases ingredient reservations held for a batch.
+type ReservationReleaser interface {
+	ReleaseBatchReservations(ctx context.Context, batchUUID string) error
+}
+
 // HandleBatchByUUID handles [GET /batches/{uuid}], [PATCH /batches/{uuid}], and [DELETE /batches/{uuid}].
-func HandleBatchByUUID(db BatchStore) http.HandlerFunc {
+func HandleBatchByUUID(db BatchStore, invClient ReservationReleaser) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		batchUUID := r.PathValue("uuid")
 		if batchUUID == "" {
@@ -111,9 +119,11 @@ func HandleBatchByUUID(db BatchStore) http.HandlerFunc {
 			}
 
 			batch := storage.Batch{
-				ShortName: req.ShortName,
-				BrewDate:  req.BrewDate,
-				Notes:     req.Notes,
+				ShortName:         req.ShortName,
+				BrewDate:          req.BrewDate,
+				Notes:             req.Notes,
+				PlannedVolume:     req.PlannedVolume,
+				PlannedVolumeUnit: req.PlannedVolumeUnit,
 			}
 
 			// Resolve recipe UUID to internal ID if provided
@@ -166,6 +176,12 @@ func HandleBatchByUUID(db BatchStore) http.HandlerFunc {
 
 			slog.Info("batch deleted", "batch_uuid", batchUUID)
 
+			// Free any stock reserved for the batch. The batch is already gone,
+			// so a failure here is logged rather than surfaced.
+			if err := invClient.ReleaseBatchReservations(r.Context(), batchUUID); err != nil {
+				slog.Warn("could not release inventory reservations for deleted batch", "batch_uuid", batchUUID, "error", err)
+			}
+
 			w.WriteHeader(http.StatusNoContent)
 		default:
 			service.MethodNotAllowed(w)
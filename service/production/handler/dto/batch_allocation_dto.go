@@ -0,0 +1,115 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/gofrs/uuid/v5"
+)
+
+// BatchAllocationRequest is the request body for POST /batches/{uuid}/allocation.
+// RecipeUUID defaults to the batch's recipe and Scale defaults to 1.
+type BatchAllocationRequest struct {
+	RecipeUUID *string  `json:"recipe_uuid"`
+	Scale      *float64 `json:"scale"`
+}
+
+func (r BatchAllocationRequest) Validate() error {
+	if r.RecipeUUID != nil {
+		if _, err := uuid.FromString(*r.RecipeUUID); err != nil {
+			return fmt.Errorf("recipe_uuid must be a valid UUID")
+		}
+	}
+	if r.Scale != nil && *r.Scale <= 0 {
+		return fmt.Errorf("scale must be greater than zero")
+	}
+	return nil
+}
+
+// BatchAllocationResponse is a proposed set of lot picks covering a recipe.
+type BatchAllocationResponse struct {
+	BatchUUID  string                `json:"batch_uuid"`
+	RecipeUUID string                `json:"recipe_uuid"`
+	Scale      float64               `json:"scale"`
+	Lines      []AllocationLine      `json:"lines"`
+	Picks      []AllocationPickInput `json:"picks"`
+	// Complete is true when every line is fully allocated.
+	Complete bool `json:"complete"`
+}
+
+// AllocationLine is the allocation for a single recipe ingredient.
+type AllocationLine struct {
+	RecipeIngredientUUID string           `json:"recipe_ingredient_uuid"`
+	IngredientUUID       *string          `json:"ingredient_uuid,omitempty"`
+	Name                 string           `json:"name"`
+	RequiredAmount       int64            `json:"required_amount"`
+	AllocatedAmount      int64            `json:"allocated_amount"`
+	ShortfallAmount      int64            `json:"shortfall_amount"`
+	AmountUnit           string           `json:"amount_unit"`
+	Picks                []AllocationPick `json:"picks"`
+	// Reason explains why a line could not be allocated at all, e.g.
+	// "no_inventory_ingredient" when the recipe ingredient is not linked.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AllocationPick is a proposed deduction from one lot at one location.
+type AllocationPick struct {
+	IngredientLotUUID string     `json:"ingredient_lot_uuid"`
+	BreweryLotCode    *string    `json:"brewery_lot_code,omitempty"`
+	StockLocationUUID string     `json:"stock_location_uuid"`
+	StockLocationName string     `json:"stock_location_name"`
+	Amount            int64      `json:"amount"`
+	AmountUnit        string     `json:"amount_unit"`
+	AvailableAmount   int64      `json:"available_amount"`
+	ReceivedAt        time.Time  `json:"received_at"`
+	BestByAt          *time.Time `json:"best_by_at,omitempty"`
+	ExpiresAt         *time.Time `json:"expires_at,omitempty"`
+}
+
+// AllocationPickInput is a confirmed pick. The proposal's picks can be sent
+// back as-is or edited before confirming.
+type AllocationPickInput struct {
+	IngredientLotUUID string `json:"ingredient_lot_uuid"`
+	StockLocationUUID string `json:"stock_location_uuid"`
+	Amount            int64  `json:"amount"`
+	AmountUnit        string `json:"amount_unit"`
+}
+
+// ConfirmBatchAllocationRequest is the request body for
+// POST /batches/{uuid}/allocation/confirm.
+type ConfirmBatchAllocationRequest struct {
+	Picks  []AllocationPickInput `json:"picks"`
+	UsedAt *time.Time            `json:"used_at"`
+	Notes  *string               `json:"notes"`
+}
+
+func (r ConfirmBatchAllocationRequest) Validate() error {
+	if len(r.Picks) == 0 {
+		return fmt.Errorf("picks must not be empty")
+	}
+	for i, pick := range r.Picks {
+		if _, err := uuid.FromString(pick.IngredientLotUUID); err != nil {
+			return fmt.Errorf("picks[%d].ingredient_lot_uuid must be a valid UUID", i)
+		}
+		if _, err := uuid.FromString(pick.StockLocationUUID); err != nil {
+			return fmt.Errorf("picks[%d].stock_location_uuid must be a valid UUID", i)
+		}
+		if pick.Amount <= 0 {
+			return fmt.Errorf("picks[%d].amount must be greater than zero", i)
+		}
+		if err := validate.Required(pick.AmountUnit, fmt.Sprintf("picks[%d].amount_unit", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConfirmBatchAllocationResponse reports the inventory usage recorded for a
+// confirmed allocation.
+type ConfirmBatchAllocationResponse struct {
+	BatchUUID string            `json:"batch_uuid"`
+	UsageUUID string            `json:"usage_uuid"`
+	Movements []json.RawMessage `json:"movements"`
+}
@@ -0,0 +1,145 @@
+package dto
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// CreateBatchLaborEntryRequest records time worked on a batch. The hourly
+// rate is in the base currency.
+type CreateBatchLaborEntryRequest struct {
+	Role            string     `json:"role"`
+	Hours           float64    `json:"hours"`
+	HourlyRateCents int64      `json:"hourly_rate_cents"`
+	WorkedAt        *time.Time `json:"worked_at"`
+	Notes           *string    `json:"notes"`
+}
+
+func (r CreateBatchLaborEntryRequest) Validate() error {
+	if err := validate.Required(r.Role, "role"); err != nil {
+		return err
+	}
+	if math.IsNaN(r.Hours) || r.Hours <= 0 || r.Hours > 10000 {
+		return fmt.Errorf("hours must be greater than zero")
+	}
+	if r.HourlyRateCents < 0 {
+		return fmt.Errorf("hourly_rate_cents must be zero or greater")
+	}
+	return nil
+}
+
+type BatchLaborEntryResponse struct {
+	UUID            string     `json:"uuid"`
+	BatchUUID       string     `json:"batch_uuid"`
+	Role            string     `json:"role"`
+	Hours           float64    `json:"hours"`
+	HourlyRateCents int64      `json:"hourly_rate_cents"`
+	CostCents       int64      `json:"cost_cents"`
+	WorkedAt        time.Time  `json:"worked_at"`
+	Notes           *string    `json:"notes,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	DeletedAt       *time.Time `json:"deleted_at,omitempty"`
+}
+
+// LaborCostCents is the cost of hours at an hourly rate, rounded to whole
+// cents.
+func LaborCostCents(hours float64, hourlyRateCents int64) int64 {
+	return int64(math.Round(hours * float64(hourlyRateCents)))
+}
+
+func NewBatchLaborEntryResponse(entry storage.BatchLaborEntry) BatchLaborEntryResponse {
+	return BatchLaborEntryResponse{
+		UUID:            entry.UUID.String(),
+		BatchUUID:       entry.BatchUUID,
+		Role:            entry.Role,
+		Hours:           entry.Hours,
+		HourlyRateCents: entry.HourlyRateCents,
+		CostCents:       LaborCostCents(entry.Hours, entry.HourlyRateCents),
+		WorkedAt:        entry.WorkedAt,
+		Notes:           entry.Notes,
+		CreatedAt:       entry.CreatedAt,
+		UpdatedAt:       entry.UpdatedAt,
+		DeletedAt:       entry.DeletedAt,
+	}
+}
+
+func NewBatchLaborEntriesResponse(entries []storage.BatchLaborEntry) []BatchLaborEntryResponse {
+	resp := make([]BatchLaborEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		resp = append(resp, NewBatchLaborEntryResponse(entry))
+	}
+	return resp
+}
+
+// CreateOverheadRateRequest defines an overhead charged to every batch. Basis
+// is per_bbl (RateCents per barrel brewed) or per_vessel_day (RateCents per
+// day the batch occupies a vessel); the rate is in the base currency.
+type CreateOverheadRateRequest struct {
+	Name      string `json:"name"`
+	Basis     string `json:"basis"`
+	RateCents int64  `json:"rate_cents"`
+	IsActive  *bool  `json:"is_active"`
+}
+
+func (r CreateOverheadRateRequest) Validate() error {
+	if err := validate.Required(r.Name, "name"); err != nil {
+		return err
+	}
+	switch r.Basis {
+	case storage.OverheadBasisPerBBL, storage.OverheadBasisPerVesselDay:
+	default:
+		return fmt.Errorf("invalid basis: must be one of per_bbl, per_vessel_day")
+	}
+	if r.RateCents < 0 {
+		return fmt.Errorf("rate_cents must be zero or greater")
+	}
+	return nil
+}
+
+type UpdateOverheadRateRequest struct {
+	IsActive *bool `json:"is_active"`
+}
+
+func (r UpdateOverheadRateRequest) Validate() error {
+	if r.IsActive == nil {
+		return fmt.Errorf("is_active is required")
+	}
+	return nil
+}
+
+type OverheadRateResponse struct {
+	UUID      string     `json:"uuid"`
+	Name      string     `json:"name"`
+	Basis     string     `json:"basis"`
+	RateCents int64      `json:"rate_cents"`
+	IsActive  bool       `json:"is_active"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewOverheadRateResponse(rate storage.OverheadRate) OverheadRateResponse {
+	return OverheadRateResponse{
+		UUID:      rate.UUID.String(),
+		Name:      rate.Name,
+		Basis:     rate.Basis,
+		RateCents: rate.RateCents,
+		IsActive:  rate.IsActive,
+		CreatedAt: rate.CreatedAt,
+		UpdatedAt: rate.UpdatedAt,
+		DeletedAt: rate.DeletedAt,
+	}
+}
+
+func NewOverheadRatesResponse(rates []storage.OverheadRate) []OverheadRateResponse {
+	resp := make([]OverheadRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		resp = append(resp, NewOverheadRateResponse(rate))
+	}
+	return resp
+}
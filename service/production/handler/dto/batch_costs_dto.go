@@ -1,30 +1,55 @@
 package dto
 
-// BatchCostsResponse is the response for GET /batches/{uuid}/costs.
+import "time"
+
+// BatchCostsResponse is the response for GET /batches/{uuid}/costs. Ingredient
+// costs are in the purchase order currency, Currency, which is "MIXED" when
+// the batch used lots bought in several currencies; the base currency totals
+// are comparable either way. Packaging materials, labor, overhead and the
+// full cost are in the base currency. SnapshotAt is set when the figures come
+// from the snapshot taken when the batch finished.
 type BatchCostsResponse struct {
-	BatchUUID         string             `json:"batch_uuid"`
-	Currency          *string            `json:"currency,omitempty"`
-	LineItems         []CostLineItem     `json:"line_items"`
-	UncostedAdditions []UncostedAddition `json:"uncosted_additions"`
-	Totals            CostTotals         `json:"totals"`
+	BatchUUID          string                  `json:"batch_uuid"`
+	Currency           *string                 `json:"currency,omitempty"`
+	BaseCurrency       *string                 `json:"base_currency,omitempty"`
+	LineItems          []CostLineItem          `json:"line_items"`
+	UncostedAdditions  []UncostedAddition      `json:"uncosted_additions"`
+	Totals             CostTotals              `json:"totals"`
+	PackagingMaterials []PackagingMaterialCost `json:"packaging_materials"`
+	Labor              []LaborCost             `json:"labor"`
+	Overhead           []OverheadCost          `json:"overhead"`
+	LossWriteOffs      []LossWriteOff          `json:"loss_write_offs"`
+	PackageFormats     []PackageFormatCost     `json:"package_formats"`
+	FullCost           FullCostTotals          `json:"full_cost"`
+	SnapshotAt         *time.Time              `json:"snapshot_at,omitempty"`
 }
 
-// CostLineItem represents a single costed ingredient addition.
+// CostLineItem represents a single costed ingredient addition. CostCents is
+// the landed cost: MaterialCostCents at the purchase price plus FeeCostCents,
+// the addition's share of the purchase order's allocated fees. BaseCostCents
+// is CostCents converted at ExchangeRate, the rate locked when the lot's
+// purchase order was received or the latest rate otherwise.
 type CostLineItem struct {
-	AdditionUUID          string  `json:"addition_uuid"`
-	IngredientLotUUID     string  `json:"ingredient_lot_uuid"`
-	IngredientUUID        *string `json:"ingredient_uuid,omitempty"`
-	IngredientName        *string `json:"ingredient_name,omitempty"`
-	IngredientCategory    *string `json:"ingredient_category,omitempty"`
-	LotCode               *string `json:"lot_code,omitempty"`
-	AdditionType          string  `json:"addition_type"`
-	AmountUsed            int64   `json:"amount_used"`
-	AmountUnit            string  `json:"amount_unit"`
-	UnitCostCents         *int64  `json:"unit_cost_cents,omitempty"`
-	UnitCostUnit          *string `json:"unit_cost_unit,omitempty"`
-	CostCents             *int64  `json:"cost_cents,omitempty"`
-	CostSource            string  `json:"cost_source"`
-	PurchaseOrderLineUUID *string `json:"purchase_order_line_uuid,omitempty"`
+	AdditionUUID          string   `json:"addition_uuid"`
+	IngredientLotUUID     string   `json:"ingredient_lot_uuid"`
+	IngredientUUID        *string  `json:"ingredient_uuid,omitempty"`
+	IngredientName        *string  `json:"ingredient_name,omitempty"`
+	IngredientCategory    *string  `json:"ingredient_category,omitempty"`
+	LotCode               *string  `json:"lot_code,omitempty"`
+	AdditionType          string   `json:"addition_type"`
+	AmountUsed            int64    `json:"amount_used"`
+	AmountUnit            string   `json:"amount_unit"`
+	UnitCostCents         *int64   `json:"unit_cost_cents,omitempty"`
+	UnitCostUnit          *string  `json:"unit_cost_unit,omitempty"`
+	CostCents             *int64   `json:"cost_cents,omitempty"`
+	MaterialCostCents     *int64   `json:"material_cost_cents,omitempty"`
+	FeeCostCents          *int64   `json:"fee_cost_cents,omitempty"`
+	Currency              *string  `json:"currency,omitempty"`
+	ExchangeRate          *float64 `json:"exchange_rate,omitempty"`
+	ExchangeRateLocked    *bool    `json:"exchange_rate_locked,omitempty"`
+	BaseCostCents         *int64   `json:"base_cost_cents,omitempty"`
+	CostSource            string   `json:"cost_source"`
+	PurchaseOrderLineUUID *string  `json:"purchase_order_line_uuid,omitempty"`
 }
 
 // UncostedAddition represents an addition that cannot be costed.
@@ -36,12 +61,114 @@ type UncostedAddition struct {
 	Reason       string `json:"reason"`
 }
 
-// CostTotals holds aggregated cost metrics for a batch.
+// CostTotals holds aggregated cost metrics for a batch. TotalCostCents is the
+// sum of MaterialCostCents and FeeCostCents. The base currency totals are
+// omitted when any costed line has no exchange rate.
 type CostTotals struct {
-	TotalCostCents    int64    `json:"total_cost_cents"`
-	CostedLineCount   int      `json:"costed_line_count"`
-	UncostedLineCount int      `json:"uncosted_line_count"`
-	CostComplete      bool     `json:"cost_complete"`
-	CostPerBBLCents   *int64   `json:"cost_per_bbl_cents,omitempty"`
-	BatchVolumeBBL    *float64 `json:"batch_volume_bbl,omitempty"`
+	TotalCostCents      int64    `json:"total_cost_cents"`
+	MaterialCostCents   int64    `json:"material_cost_cents"`
+	FeeCostCents        int64    `json:"fee_cost_cents"`
+	CostedLineCount     int      `json:"costed_line_count"`
+	UncostedLineCount   int      `json:"uncosted_line_count"`
+	CostComplete        bool     `json:"cost_complete"`
+	CostPerBBLCents     *int64   `json:"cost_per_bbl_cents,omitempty"`
+	BatchVolumeBBL      *float64 `json:"batch_volume_bbl,omitempty"`
+	BaseTotalCostCents  *int64   `json:"base_total_cost_cents,omitempty"`
+	BaseCostPerBBLCents *int64   `json:"base_cost_per_bbl_cents,omitempty"`
+}
+
+// PackagingMaterialCost is a lot of packaging material deducted when one of
+// the batch's packaging runs completed. CostCents is the landed cost of the
+// lot's purchase order line in the base currency; it is omitted, and
+// CostSource is unavailable, when the lot has no purchase order line in its
+// unit or the line's currency has no exchange rate. PackageFormatUUID is set
+// when only one of the run's formats calls for the material; otherwise it is
+// shared across them.
+type PackagingMaterialCost struct {
+	MovementUUID          string  `json:"movement_uuid"`
+	PackagingRunUUID      string  `json:"packaging_run_uuid"`
+	PackageFormatUUID     *string `json:"package_format_uuid,omitempty"`
+	IngredientLotUUID     string  `json:"ingredient_lot_uuid"`
+	IngredientUUID        string  `json:"ingredient_uuid"`
+	Name                  string  `json:"name"`
+	LotCode               *string `json:"lot_code,omitempty"`
+	Quantity              int64   `json:"quantity"`
+	Unit                  string  `json:"unit"`
+	UnitCostCents         *int64  `json:"unit_cost_cents,omitempty"`
+	Currency              *string `json:"currency,omitempty"`
+	PurchaseOrderLineUUID *string `json:"purchase_order_line_uuid,omitempty"`
+	CostSource            string  `json:"cost_source"`
+	CostCents             *int64  `json:"cost_cents,omitempty"`
+}
+
+// LaborCost totals the labor entries of one role at one hourly rate.
+type LaborCost struct {
+	Role            string  `json:"role"`
+	HourlyRateCents int64   `json:"hourly_rate_cents"`
+	Hours           float64 `json:"hours"`
+	CostCents       int64   `json:"cost_cents"`
+}
+
+// OverheadCost is one active overhead rate applied to the batch. Quantity is
+// the batch volume in barrels for per_bbl rates and the vessel-days the batch
+// occupied for per_vessel_day rates.
+type OverheadCost struct {
+	OverheadRateUUID string  `json:"overhead_rate_uuid"`
+	Name             string  `json:"name"`
+	Basis            string  `json:"basis"`
+	RateCents        int64   `json:"rate_cents"`
+	Quantity         float64 `json:"quantity"`
+	CostCents        int64   `json:"cost_cents"`
+}
+
+// LossWriteOff is the cost of beer removed from the batch, valued at the
+// batch's liquid cost per barrel. CostCents is omitted when the removal has
+// no barrel equivalent or the batch has no volume.
+type LossWriteOff struct {
+	RemovalUUID string    `json:"removal_uuid"`
+	Category    string    `json:"category"`
+	Reason      string    `json:"reason"`
+	Amount      int64     `json:"amount"`
+	AmountUnit  string    `json:"amount_unit"`
+	VolumeBBL   *float64  `json:"volume_bbl,omitempty"`
+	RemovedAt   time.Time `json:"removed_at"`
+	CostCents   *int64    `json:"cost_cents,omitempty"`
+}
+
+// PackageFormatCost is the cost of the units packaged in one format: the
+// format's share of the liquid cost by packaged volume, the materials charged
+// to it, and its volume share of the run-level materials. The liquid and
+// total figures are omitted when the batch's liquid cost is unknown.
+type PackageFormatCost struct {
+	PackageFormatUUID string  `json:"package_format_uuid"`
+	PackageFormatName string  `json:"package_format_name"`
+	Units             int     `json:"units"`
+	VolumeBBL         float64 `json:"volume_bbl"`
+	LiquidCostCents   *int64  `json:"liquid_cost_cents,omitempty"`
+	MaterialCostCents int64   `json:"material_cost_cents"`
+	TotalCostCents    *int64  `json:"total_cost_cents,omitempty"`
+	CostPerUnitCents  *int64  `json:"cost_per_unit_cents,omitempty"`
+}
+
+// FullCostTotals is the full cost of the batch in the base currency.
+// LiquidCostCents is the ingredient, labor and overhead cost of the beer
+// itself, and TotalCostCents adds packaging materials. Ingredient and total
+// figures are omitted when the ingredient costs cannot be converted to the
+// base currency. Packaging materials that cannot be costed are counted in
+// UncostedPackagingMaterialCount and left out of the totals. Loss write-offs
+// are part of the liquid cost and are reported separately, not added to it.
+type FullCostTotals struct {
+	IngredientCostCents            *int64  `json:"ingredient_cost_cents,omitempty"`
+	PackagingMaterialCostCents     int64   `json:"packaging_material_cost_cents"`
+	UncostedPackagingMaterialCount int     `json:"uncosted_packaging_material_count"`
+	LaborCostCents                 int64   `json:"labor_cost_cents"`
+	OverheadCostCents              int64   `json:"overhead_cost_cents"`
+	LiquidCostCents                *int64  `json:"liquid_cost_cents,omitempty"`
+	TotalCostCents                 *int64  `json:"total_cost_cents,omitempty"`
+	CostPerBBLCents                *int64  `json:"cost_per_bbl_cents,omitempty"`
+	LossVolumeBBL                  float64 `json:"loss_volume_bbl"`
+	LossWriteOffCents              *int64  `json:"loss_write_off_cents,omitempty"`
+	VesselDays                     float64 `json:"vessel_days"`
+	PackagedUnits                  int     `json:"packaged_units"`
+	CostPerUnitCents               *int64  `json:"cost_per_unit_cents,omitempty"`
 }
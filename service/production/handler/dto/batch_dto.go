@@ -1,59 +1,116 @@
 package dto
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/brewpipes/brewpipes/internal/validate"
 	"github.com/brewpipes/brewpipes/service/production/storage"
 )
 
+// litresPerVolumeUnit converts the volume units a batch can be planned in to
+// litres. Recipe batch sizes are free text, so lookups ignore case.
+var litresPerVolumeUnit = map[string]float64{
+	storage.VolumeUnitML:     0.001,
+	"l":                      1,
+	"hl":                     100,
+	"gal":                    3.785411784,
+	"usgal":                  3.785411784,
+	"ukgal":                  4.54609,
+	storage.VolumeUnitBBL:    117.347765,
+	"ukbbl":                  163.65924,
+	storage.VolumeUnitUSFlOz: 0.0295735295625,
+	storage.VolumeUnitUKFlOz: 0.0284130625,
+}
+
+// VolumeInLitres converts amount in unit to litres. It reports false for
+// units it does not know.
+func VolumeInLitres(amount float64, unit string) (float64, bool) {
+	factor, ok := litresPerVolumeUnit[strings.ToLower(strings.TrimSpace(unit))]
+	if !ok {
+		return 0, false
+	}
+	return amount * factor, true
+}
+
+func validatePlannedVolume(volume *float64, unit *string) error {
+	if (volume == nil) != (unit == nil) {
+		return fmt.Errorf("planned_volume and planned_volume_unit must be provided together")
+	}
+	if volume == nil {
+		return nil
+	}
+	if *volume <= 0 {
+		return errPositiveRequired("planned_volume")
+	}
+	if _, ok := VolumeInLitres(*volume, *unit); !ok {
+		return fmt.Errorf("invalid planned_volume_unit")
+	}
+	return nil
+}
+
 type CreateBatchRequest struct {
-	ShortName  string     `json:"short_name"`
-	BrewDate   *time.Time `json:"brew_date"`
-	Notes      *string    `json:"notes"`
-	RecipeUUID *string    `json:"recipe_uuid"`
+	ShortName         string     `json:"short_name"`
+	BrewDate          *time.Time `json:"brew_date"`
+	Notes             *string    `json:"notes"`
+	RecipeUUID        *string    `json:"recipe_uuid"`
+	PlannedVolume     *float64   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
 }
 
 func (r CreateBatchRequest) Validate() error {
-	return validate.Required(r.ShortName, "short_name")
+	if err := validate.Required(r.ShortName, "short_name"); err != nil {
+		return err
+	}
+	return validatePlannedVolume(r.PlannedVolume, r.PlannedVolumeUnit)
 }
 
 type UpdateBatchRequest struct {
-	ShortName  string     `json:"short_name"`
-	BrewDate   *time.Time `json:"brew_date"`
-	Notes      *string    `json:"notes"`
-	RecipeUUID *string    `json:"recipe_uuid"`
+	ShortName         string     `json:"short_name"`
+	BrewDate          *time.Time `json:"brew_date"`
+	Notes             *string    `json:"notes"`
+	RecipeUUID        *string    `json:"recipe_uuid"`
+	PlannedVolume     *float64   `json:"planned_volume"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit"`
 }
 
 func (r UpdateBatchRequest) Validate() error {
-	return validate.Required(r.ShortName, "short_name")
+	if err := validate.Required(r.ShortName, "short_name"); err != nil {
+		return err
+	}
+	return validatePlannedVolume(r.PlannedVolume, r.PlannedVolumeUnit)
 }
 
 type BatchResponse struct {
-	UUID         string     `json:"uuid"`
-	ShortName    string     `json:"short_name"`
-	BrewDate     *time.Time `json:"brew_date,omitempty"`
-	Notes        *string    `json:"notes,omitempty"`
-	RecipeUUID   *string    `json:"recipe_uuid,omitempty"`
-	RecipeName   *string    `json:"recipe_name,omitempty"`
-	CurrentPhase *string    `json:"current_phase,omitempty"`
-	CreatedAt    time.Time  `json:"created_at"`
-	UpdatedAt    time.Time  `json:"updated_at"`
-	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	UUID              string     `json:"uuid"`
+	ShortName         string     `json:"short_name"`
+	BrewDate          *time.Time `json:"brew_date,omitempty"`
+	Notes             *string    `json:"notes,omitempty"`
+	RecipeUUID        *string    `json:"recipe_uuid,omitempty"`
+	RecipeName        *string    `json:"recipe_name,omitempty"`
+	CurrentPhase      *string    `json:"current_phase,omitempty"`
+	PlannedVolume     *float64   `json:"planned_volume,omitempty"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
 }
 
 func NewBatchResponse(batch storage.Batch) BatchResponse {
 	return BatchResponse{
-		UUID:         batch.UUID.String(),
-		ShortName:    batch.ShortName,
-		BrewDate:     batch.BrewDate,
-		Notes:        batch.Notes,
-		RecipeUUID:   batch.RecipeUUID,
-		RecipeName:   batch.RecipeName,
-		CurrentPhase: batch.CurrentPhase,
-		CreatedAt:    batch.CreatedAt,
-		UpdatedAt:    batch.UpdatedAt,
-		DeletedAt:    batch.DeletedAt,
+		UUID:              batch.UUID.String(),
+		ShortName:         batch.ShortName,
+		BrewDate:          batch.BrewDate,
+		Notes:             batch.Notes,
+		RecipeUUID:        batch.RecipeUUID,
+		RecipeName:        batch.RecipeName,
+		CurrentPhase:      batch.CurrentPhase,
+		PlannedVolume:     batch.PlannedVolume,
+		PlannedVolumeUnit: batch.PlannedVolumeUnit,
+		CreatedAt:         batch.CreatedAt,
+		UpdatedAt:         batch.UpdatedAt,
+		DeletedAt:         batch.DeletedAt,
 	}
 }
 
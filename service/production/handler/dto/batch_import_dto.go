@@ -1,5 +1,7 @@
 package dto
 
+import "github.com/brewpipes/brewpipes/internal/csvimport"
+
 type BatchImportRowResult struct {
 	Row    int            `json:"row"`
 	Status string         `json:"status"`
@@ -10,10 +12,36 @@ type BatchImportRowResult struct {
 type BatchImportTotals struct {
 	TotalRows int `json:"total_rows"`
 	Created   int `json:"created"`
+	Valid     int `json:"valid"`
 	Failed    int `json:"failed"`
 }
 
 type BatchImportResponse struct {
+	DryRun  bool                   `json:"dry_run"`
 	Totals  BatchImportTotals      `json:"totals"`
 	Results []BatchImportRowResult `json:"results"`
 }
+
+// NewBatchImportResponse keeps the batch import response shape, with each
+// created batch under "batch".
+func NewBatchImportResponse(result csvimport.Result) BatchImportResponse {
+	results := make([]BatchImportRowResult, 0, len(result.Results))
+	for _, r := range result.Results {
+		row := BatchImportRowResult{Row: r.Row, Status: r.Status, Error: r.Error}
+		if batch, ok := r.Record.(BatchResponse); ok {
+			row.Batch = &batch
+		}
+		results = append(results, row)
+	}
+
+	return BatchImportResponse{
+		DryRun: result.DryRun,
+		Totals: BatchImportTotals{
+			TotalRows: result.Totals.TotalRows,
+			Created:   result.Totals.Created,
+			Valid:     result.Totals.Valid,
+			Failed:    result.Totals.Failed,
+		},
+		Results: results,
+	}
+}
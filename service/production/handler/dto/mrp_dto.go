@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// MRPResponse is the result of a material requirements planning run across
+// every batch that has not started brewing.
+type MRPResponse struct {
+	GeneratedAt  time.Time         `json:"generated_at"`
+	Batches      []MRPBatch        `json:"batches"`
+	Requirements []MRPRequirement  `json:"requirements"`
+	Unlinked     []MRPUnlinkedLine `json:"unlinked"`
+}
+
+// MRPBatch is a planned batch included in the run.
+type MRPBatch struct {
+	BatchUUID         string     `json:"batch_uuid"`
+	ShortName         string     `json:"short_name"`
+	BrewDate          *time.Time `json:"brew_date,omitempty"`
+	RecipeUUID        string     `json:"recipe_uuid"`
+	RecipeName        *string    `json:"recipe_name,omitempty"`
+	PlannedVolume     *float64   `json:"planned_volume,omitempty"`
+	PlannedVolumeUnit *string    `json:"planned_volume_unit,omitempty"`
+	// Scale is the factor the recipe's bill was scaled by to the batch's
+	// planned volume; 1 when the batch is planned at the recipe's size.
+	Scale float64 `json:"scale"`
+}
+
+// MRPRequirement nets the demand for one inventory ingredient, in one unit,
+// against on-hand stock and open purchase orders.
+type MRPRequirement struct {
+	IngredientUUID string `json:"ingredient_uuid"`
+	Name           string `json:"name"`
+	AmountUnit     string `json:"amount_unit"`
+	GrossRequired  int64  `json:"gross_required"`
+	// OnHand is available-to-promise stock plus stock already reserved for
+	// the planned batches in this run.
+	OnHand  int64 `json:"on_hand"`
+	OnOrder int64 `json:"on_order"`
+	// NetShortage is the quantity still to be ordered once all on-hand stock
+	// and open orders are counted.
+	NetShortage int64 `json:"net_shortage"`
+	// NeededBy is the brew date of the first batch that goes short.
+	NeededBy   *time.Time     `json:"needed_by,omitempty"`
+	Periods    []MRPPeriod    `json:"periods"`
+	OpenOrders []MRPOpenOrder `json:"open_orders"`
+	Supplier   *MRPSupplier   `json:"supplier,omitempty"`
+}
+
+// MRPPeriod is one batch's draw on an ingredient, in brew date order.
+type MRPPeriod struct {
+	BatchUUID         string     `json:"batch_uuid"`
+	BatchShortName    string     `json:"batch_short_name"`
+	BrewDate          *time.Time `json:"brew_date,omitempty"`
+	RequiredAmount    int64      `json:"required_amount"`
+	ScheduledReceipts int64      `json:"scheduled_receipts"`
+	ProjectedBalance  int64      `json:"projected_balance"`
+	ShortageAmount    int64      `json:"shortage_amount"`
+}
+
+// MRPOpenOrder is the outstanding quantity on an open purchase order line.
+type MRPOpenOrder struct {
+	PurchaseOrderUUID     string     `json:"purchase_order_uuid"`
+	PurchaseOrderLineUUID string     `json:"purchase_order_line_uuid"`
+	OrderNumber           string     `json:"order_number"`
+	Status                string     `json:"status"`
+	OutstandingAmount     int64      `json:"outstanding_amount"`
+	ExpectedAt            *time.Time `json:"expected_at,omitempty"`
+}
+
+// MRPSupplier is the supplier a shortage would be ordered from. Source is
+// "preferred" when taken from the ingredient's reorder policy and
+// "last_order" when taken from purchase order history. Item and cost details
+// come from the last order when it was placed with this supplier, and
+// otherwise from the supplier's catalog.
+type MRPSupplier struct {
+	SupplierUUID  string `json:"supplier_uuid"`
+	SupplierName  string `json:"supplier_name"`
+	Source        string `json:"source"`
+	ItemName      string `json:"item_name"`
+	QuantityUnit  string `json:"quantity_unit"`
+	UnitCostCents int64  `json:"unit_cost_cents"`
+	Currency      string `json:"currency"`
+}
+
+// MRPUnlinkedLine is a recipe ingredient that cannot be planned because it is
+// not linked to an inventory ingredient.
+type MRPUnlinkedLine struct {
+	BatchUUID            string `json:"batch_uuid"`
+	BatchShortName       string `json:"batch_short_name"`
+	RecipeIngredientUUID string `json:"recipe_ingredient_uuid"`
+	Name                 string `json:"name"`
+	RequiredAmount       int64  `json:"required_amount"`
+	AmountUnit           string `json:"amount_unit"`
+}
+
+// CreateMRPPurchaseOrdersRequest is the request body for
+// POST /mrp/purchase-orders. When IngredientUUIDs is empty, every shortage
+// is ordered.
+type CreateMRPPurchaseOrdersRequest struct {
+	IngredientUUIDs []string `json:"ingredient_uuids"`
+}
+
+func (r CreateMRPPurchaseOrdersRequest) Validate() error {
+	for i, id := range r.IngredientUUIDs {
+		if _, err := uuid.FromString(id); err != nil {
+			return fmt.Errorf("ingredient_uuids[%d] must be a valid UUID", i)
+		}
+	}
+	return nil
+}
+
+// MRPPurchaseOrdersResponse lists the draft purchase orders generated from
+// shortages, one per supplier, and the shortages that had no known supplier.
+type MRPPurchaseOrdersResponse struct {
+	PurchaseOrders []MRPPurchaseOrder `json:"purchase_orders"`
+	Unassigned     []MRPRequirement   `json:"unassigned"`
+}
+
+type MRPPurchaseOrder struct {
+	PurchaseOrderUUID string                 `json:"purchase_order_uuid"`
+	OrderNumber       string                 `json:"order_number"`
+	SupplierUUID      string                 `json:"supplier_uuid"`
+	SupplierName      string                 `json:"supplier_name"`
+	ExpectedAt        *time.Time             `json:"expected_at,omitempty"`
+	Lines             []MRPPurchaseOrderLine `json:"lines"`
+}
+
+type MRPPurchaseOrderLine struct {
+	IngredientUUID string `json:"ingredient_uuid"`
+	ItemName       string `json:"item_name"`
+	Quantity       int64  `json:"quantity"`
+	QuantityUnit   string `json:"quantity_unit"`
+	UnitCostCents  int64  `json:"unit_cost_cents"`
+	Currency       string `json:"currency"`
+}
@@ -0,0 +1,143 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/validate"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// CreatePackageFormatMaterialRequest adds a packaging material to a package
+// format's bill of materials. IngredientUUID is the Inventory ingredient
+// (category packaging) and Quantity is how many are used per package unit.
+type CreatePackageFormatMaterialRequest struct {
+	IngredientUUID string `json:"ingredient_uuid"`
+	Name           string `json:"name"`
+	Quantity       int64  `json:"quantity"`
+	Unit           string `json:"unit"`
+}
+
+func (r CreatePackageFormatMaterialRequest) Validate() error {
+	if _, err := uuid.FromString(r.IngredientUUID); err != nil {
+		return fmt.Errorf("ingredient_uuid must be a valid UUID")
+	}
+	if err := validate.Required(r.Name, "name"); err != nil {
+		return err
+	}
+	if r.Quantity <= 0 {
+		return errPositiveRequired("quantity")
+	}
+	if err := validate.Required(r.Unit, "unit"); err != nil {
+		return err
+	}
+	return nil
+}
+
+type PackageFormatMaterialResponse struct {
+	UUID              string     `json:"uuid"`
+	PackageFormatUUID string     `json:"package_format_uuid"`
+	IngredientUUID    string     `json:"ingredient_uuid"`
+	Name              string     `json:"name"`
+	Quantity          int64      `json:"quantity"`
+	Unit              string     `json:"unit"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+	DeletedAt         *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewPackageFormatMaterialResponse(material storage.PackageFormatMaterial) PackageFormatMaterialResponse {
+	return PackageFormatMaterialResponse{
+		UUID:              material.UUID.String(),
+		PackageFormatUUID: material.PackageFormatUUID,
+		IngredientUUID:    material.IngredientUUID.String(),
+		Name:              material.Name,
+		Quantity:          material.Quantity,
+		Unit:              material.Unit,
+		CreatedAt:         material.CreatedAt,
+		UpdatedAt:         material.UpdatedAt,
+		DeletedAt:         material.DeletedAt,
+	}
+}
+
+func NewPackageFormatMaterialsResponse(materials []storage.PackageFormatMaterial) []PackageFormatMaterialResponse {
+	resp := make([]PackageFormatMaterialResponse, 0, len(materials))
+	for _, material := range materials {
+		resp = append(resp, NewPackageFormatMaterialResponse(material))
+	}
+	return resp
+}
+
+// PackagingMaterialCheckRequest is the request body for
+// POST /packaging-runs/material-check. StockLocationUUID limits picks to one
+// location; by default any location holding the material is used.
+type PackagingMaterialCheckRequest struct {
+	Lines             []CreatePackagingRunLineRequest `json:"lines"`
+	StockLocationUUID *string                         `json:"stock_location_uuid"`
+}
+
+func (r PackagingMaterialCheckRequest) Validate() error {
+	if len(r.Lines) == 0 {
+		return fmt.Errorf("lines is required and must contain at least 1 line")
+	}
+	for i, line := range r.Lines {
+		if err := validate.Required(line.PackageFormatUUID, fmt.Sprintf("lines[%d].package_format_uuid", i)); err != nil {
+			return err
+		}
+		if line.Quantity <= 0 {
+			return fmt.Errorf("lines[%d].quantity must be greater than zero", i)
+		}
+	}
+	return validateStockLocationUUID(r.StockLocationUUID)
+}
+
+// CompletePackagingRunRequest is the request body for
+// POST /packaging-runs/{uuid}/complete. EndedAt defaults to now.
+type CompletePackagingRunRequest struct {
+	EndedAt           *time.Time `json:"ended_at"`
+	StockLocationUUID *string    `json:"stock_location_uuid"`
+	Notes             *string    `json:"notes"`
+}
+
+func (r CompletePackagingRunRequest) Validate() error {
+	return validateStockLocationUUID(r.StockLocationUUID)
+}
+
+func validateStockLocationUUID(locationUUID *string) error {
+	if locationUUID == nil {
+		return nil
+	}
+	if _, err := uuid.FromString(*locationUUID); err != nil {
+		return fmt.Errorf("stock_location_uuid must be a valid UUID")
+	}
+	return nil
+}
+
+// PackagingMaterialCheckResponse lists the packaging materials a run needs
+// and the lots they would be picked from.
+type PackagingMaterialCheckResponse struct {
+	Lines []PackagingMaterialLine `json:"lines"`
+	Picks []AllocationPickInput   `json:"picks"`
+	// Complete is true when every material is fully covered by stock.
+	Complete bool `json:"complete"`
+}
+
+// PackagingMaterialLine is the requirement for one packaging material across
+// every format in the run.
+type PackagingMaterialLine struct {
+	IngredientUUID  string           `json:"ingredient_uuid"`
+	Name            string           `json:"name"`
+	RequiredAmount  int64            `json:"required_amount"`
+	AllocatedAmount int64            `json:"allocated_amount"`
+	ShortfallAmount int64            `json:"shortfall_amount"`
+	AmountUnit      string           `json:"amount_unit"`
+	Picks           []AllocationPick `json:"picks"`
+}
+
+// CompletePackagingRunResponse is the completed run together with the
+// packaging materials deducted for it.
+type CompletePackagingRunResponse struct {
+	PackagingRun PackagingRunResponse    `json:"packaging_run"`
+	Materials    []PackagingMaterialLine `json:"materials"`
+}
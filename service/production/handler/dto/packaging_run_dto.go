@@ -78,17 +78,18 @@ type PackagingRunLineResponse struct {
 }
 
 type PackagingRunResponse struct {
-	UUID          string                     `json:"uuid"`
-	BatchUUID     string                     `json:"batch_uuid"`
-	OccupancyUUID string                     `json:"occupancy_uuid"`
-	StartedAt     time.Time                  `json:"started_at"`
-	EndedAt       *time.Time                 `json:"ended_at,omitempty"`
-	LossAmount    *int64                     `json:"loss_amount,omitempty"`
-	LossUnit      *string                    `json:"loss_unit,omitempty"`
-	Notes         *string                    `json:"notes,omitempty"`
-	Lines         []PackagingRunLineResponse `json:"lines"`
-	CreatedAt     time.Time                  `json:"created_at"`
-	UpdatedAt     time.Time                  `json:"updated_at"`
+	UUID              string                     `json:"uuid"`
+	BatchUUID         string                     `json:"batch_uuid"`
+	OccupancyUUID     string                     `json:"occupancy_uuid"`
+	StartedAt         time.Time                  `json:"started_at"`
+	EndedAt           *time.Time                 `json:"ended_at,omitempty"`
+	LossAmount        *int64                     `json:"loss_amount,omitempty"`
+	LossUnit          *string                    `json:"loss_unit,omitempty"`
+	Notes             *string                    `json:"notes,omitempty"`
+	MaterialUsageUUID *string                    `json:"material_usage_uuid,omitempty"`
+	Lines             []PackagingRunLineResponse `json:"lines"`
+	CreatedAt         time.Time                  `json:"created_at"`
+	UpdatedAt         time.Time                  `json:"updated_at"`
 }
 
 func NewPackagingRunLineResponse(line storage.PackagingRunLine) PackagingRunLineResponse {
@@ -115,17 +116,18 @@ func NewPackagingRunLinesResponse(lines []storage.PackagingRunLine) []PackagingR
 
 func NewPackagingRunResponse(run storage.PackagingRun, lines []storage.PackagingRunLine) PackagingRunResponse {
 	return PackagingRunResponse{
-		UUID:          run.UUID.String(),
-		BatchUUID:     run.BatchUUID,
-		OccupancyUUID: run.OccupancyUUID,
-		StartedAt:     run.StartedAt,
-		EndedAt:       run.EndedAt,
-		LossAmount:    run.LossAmount,
-		LossUnit:      run.LossUnit,
-		Notes:         run.Notes,
-		Lines:         NewPackagingRunLinesResponse(lines),
-		CreatedAt:     run.CreatedAt,
-		UpdatedAt:     run.UpdatedAt,
+		UUID:              run.UUID.String(),
+		BatchUUID:         run.BatchUUID,
+		OccupancyUUID:     run.OccupancyUUID,
+		StartedAt:         run.StartedAt,
+		EndedAt:           run.EndedAt,
+		LossAmount:        run.LossAmount,
+		LossUnit:          run.LossUnit,
+		Notes:             run.Notes,
+		MaterialUsageUUID: run.MaterialUsageUUID,
+		Lines:             NewPackagingRunLinesResponse(lines),
+		CreatedAt:         run.CreatedAt,
+		UpdatedAt:         run.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,24 @@
+package dto
+
+// Scanned entity types resolved by the Production service.
+const (
+	ScanEntityVessel = "vessel"
+	ScanEntityBatch  = "batch"
+)
+
+// ScanMatchResponse is one entity a scanned code resolved to, with the
+// actions allowed in its current state.
+type ScanMatchResponse struct {
+	EntityType string   `json:"entity_type"`
+	UUID       string   `json:"uuid"`
+	Name       string   `json:"name"`
+	MatchedOn  string   `json:"matched_on"`
+	State      string   `json:"state"`
+	Actions    []string `json:"actions"`
+}
+
+// ScanResponse is the response body for GET /scan/{code}.
+type ScanResponse struct {
+	Code    string              `json:"code"`
+	Matches []ScanMatchResponse `json:"matches"`
+}
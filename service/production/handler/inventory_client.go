@@ -1,66 +1,87 @@
 package handler
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
+	"errors"
 	"net/http"
+	"strings"
 	"time"
+
+	inventoryapi "github.com/brewpipes/brewpipes/client/inventory"
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
 )
 
+// InventoryAPI is everything production calls on the Inventory service.
+// InventoryClient implements it over HTTP; a monolith that runs both
+// services in one process can implement it with direct calls instead.
+type InventoryAPI interface {
+	CreateBeerLot(ctx context.Context, req BeerLotRequest) (*BeerLotResponse, error)
+	ReleaseBatchReservations(ctx context.Context, batchUUID string) error
+	GetBatchIngredientLots(ctx context.Context, batchUUID string) ([]BatchIngredientLot, error)
+	ListBatchUsageMovements(ctx context.Context, batchUUID string) ([]BatchUsageMovement, error)
+	GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error)
+	ListBatchReservations(ctx context.Context, batchUUID string) ([]BatchReservation, error)
+	ListBatchRemovals(ctx context.Context, batchUUID string) ([]BatchRemoval, error)
+	ListActiveReservations(ctx context.Context) ([]BatchReservation, error)
+	ListIngredientLotReceipts(ctx context.Context) ([]IngredientLotReceipt, error)
+	CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (*BatchUsageResponse, error)
+	ListLabelTemplates(ctx context.Context, subject string) ([]LabelTemplate, error)
+	ScanInventory(ctx context.Context, code string) ([]ScanMatch, error)
+	ListIngredients(ctx context.Context) ([]InventoryIngredient, error)
+	ListReorderPolicies(ctx context.Context) ([]ReorderPolicy, error)
+}
+
 // InventoryClient handles inter-service communication with the Inventory service.
 type InventoryClient struct {
-	baseURL    string
-	httpClient *http.Client
+	api *inventoryapi.Client
 }
 
-// NewInventoryClient creates a new InventoryClient with the given base URL.
-func NewInventoryClient(baseURL string) *InventoryClient {
+// NewInventoryClient creates a new InventoryClient with the given base URL. Requests are
+// authenticated with service tokens from tokens.
+func NewInventoryClient(baseURL string, tokens *service.ServiceTokenSource) *InventoryClient {
 	return &InventoryClient{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+		api: &inventoryapi.Client{
+			Server: strings.TrimSuffix(baseURL, "/") + "/",
+			Client: &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: tokens.Transport(telemetry.Transport("inventory", nil)),
+			},
 		},
 	}
 }
 
 // CreateBeerLot calls the Inventory service to create a beer lot with an initial inventory movement.
-// The authToken is passed through from the original request (JWT forwarding).
-func (c *InventoryClient) CreateBeerLot(ctx context.Context, authToken string, req BeerLotRequest) (*BeerLotResponse, error) {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return nil, fmt.Errorf("marshaling beer lot request: %w", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/beer-lots", bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating beer lot request: %w", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+authToken)
-
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("calling inventory service: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusCreated {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("inventory service returned %d: %s", resp.StatusCode, string(respBody))
-	}
-
+func (c *InventoryClient) CreateBeerLot(ctx context.Context, req BeerLotRequest) (*BeerLotResponse, error) {
 	var result BeerLotResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("decoding beer lot response: %w", err)
+	resp, err := c.api.CreateBeerLot(ctx, inventoryapi.CreateBeerLotRequest{
+		ProductionBatchUuid: req.ProductionBatchUUID,
+		PackagingRunUuid:    &req.PackagingRunUUID,
+		LotCode:             req.LotCode,
+		PackageFormatName:   &req.PackageFormatName,
+		Container:           &req.Container,
+		VolumePerUnit:       &req.VolumePerUnit,
+		VolumePerUnitUnit:   &req.VolumePerUnitUnit,
+		Quantity:            &req.Quantity,
+		PackagedAt:          &req.PackagedAt,
+		StockLocationUuid:   &req.StockLocationUUID,
+		Notes:               req.Notes,
+	})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusCreated, &result); err != nil {
+		return nil, err
 	}
-
 	return &result, nil
 }
 
+// ReleaseBatchReservations calls the Inventory service to release every active
+// ingredient reservation held for a production batch.
+func (c *InventoryClient) ReleaseBatchReservations(ctx context.Context, batchUUID string) error {
+	resp, err := c.api.ReleaseInventoryReservations(ctx, inventoryapi.ReleaseInventoryReservationsRequest{ProductionRefUuid: &batchUUID})
+	return service.DecodeResponse("inventory", resp, err, http.StatusOK, nil)
+}
+
 // BatchIngredientLot holds lot and ingredient data returned by the Inventory service.
 type BatchIngredientLot struct {
 	IngredientLotUUID     string  `json:"ingredient_lot_uuid"`
@@ -74,31 +95,299 @@ type BatchIngredientLot struct {
 
 // GetBatchIngredientLots calls the Inventory service to get all ingredient lots
 // consumed by a production batch.
-func (c *InventoryClient) GetBatchIngredientLots(ctx context.Context, authToken string, batchUUID string) ([]BatchIngredientLot, error) {
-	url := c.baseURL + "/ingredient-lots/batch?production_ref_uuid=" + batchUUID
+func (c *InventoryClient) GetBatchIngredientLots(ctx context.Context, batchUUID string) ([]BatchIngredientLot, error) {
+	var result []BatchIngredientLot
+	resp, err := c.api.ListBatchIngredientLots(ctx, &inventoryapi.ListBatchIngredientLotsParams{ProductionRefUuid: batchUUID})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BatchUsageMovement is one lot deducted for a production batch, as returned
+// by the Inventory service. UsageUUID is the usage the deduction belongs to.
+type BatchUsageMovement struct {
+	UsageUUID             string    `json:"usage_uuid"`
+	MovementUUID          string    `json:"movement_uuid"`
+	Reason                string    `json:"reason"`
+	IngredientLotUUID     string    `json:"ingredient_lot_uuid"`
+	IngredientUUID        string    `json:"ingredient_uuid"`
+	IngredientName        string    `json:"ingredient_name"`
+	IngredientCategory    string    `json:"ingredient_category"`
+	BreweryLotCode        *string   `json:"brewery_lot_code"`
+	PurchaseOrderLineUUID *string   `json:"purchase_order_line_uuid"`
+	Amount                int64     `json:"amount"`
+	AmountUnit            string    `json:"amount_unit"`
+	OccurredAt            time.Time `json:"occurred_at"`
+}
+
+// ListBatchUsageMovements calls the Inventory service to get the movements
+// deducted for a production batch, including its packaging materials.
+func (c *InventoryClient) ListBatchUsageMovements(ctx context.Context, batchUUID string) ([]BatchUsageMovement, error) {
+	var result []BatchUsageMovement
+	resp, err := c.api.ListBatchUsageMovements(ctx, &inventoryapi.ListBatchUsageMovementsParams{ProductionRefUuid: batchUUID})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("creating batch ingredient lots request: %w", err)
+// IngredientLotStockLevel holds the live on-hand balance of an ingredient lot
+// at one stock location, as computed by the Inventory movement ledger.
+type IngredientLotStockLevel struct {
+	IngredientLotUUID  string     `json:"ingredient_lot_uuid"`
+	IngredientUUID     string     `json:"ingredient_uuid"`
+	IngredientName     string     `json:"ingredient_name"`
+	IngredientCategory string     `json:"ingredient_category"`
+	BreweryLotCode     *string    `json:"brewery_lot_code"`
+	ReceivedAt         time.Time  `json:"received_at"`
+	BestByAt           *time.Time `json:"best_by_at"`
+	ExpiresAt          *time.Time `json:"expires_at"`
+	StockLocationUUID  string     `json:"stock_location_uuid"`
+	StockLocationName  string     `json:"stock_location_name"`
+	CurrentAmount      int64      `json:"current_amount"`
+	CurrentUnit        string     `json:"current_unit"`
+	AvailableAmount    int64      `json:"available_amount"`
+}
+
+// GetIngredientLotStockLevels calls the Inventory service to get the current
+// stock level of every ingredient lot at every location holding it.
+func (c *InventoryClient) GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error) {
+	var result []IngredientLotStockLevel
+	resp, err := c.api.ListIngredientLotStockLevels(ctx)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
+
+// BatchReservation is an active ingredient reservation held for a batch.
+type BatchReservation struct {
+	UUID              string `json:"uuid"`
+	ProductionRefUUID string `json:"production_ref_uuid"`
+	IngredientLotUUID string `json:"ingredient_lot_uuid"`
+	StockLocationUUID string `json:"stock_location_uuid"`
+	OutstandingAmount int64  `json:"outstanding_amount"`
+	AmountUnit        string `json:"amount_unit"`
+}
+
+// ListBatchReservations calls the Inventory service to get the active
+// ingredient reservations held for a production batch.
+func (c *InventoryClient) ListBatchReservations(ctx context.Context, batchUUID string) ([]BatchReservation, error) {
+	return c.listActiveReservations(ctx, &batchUUID)
+}
 
-	httpReq.Header.Set("Authorization", "Bearer "+authToken)
+// BatchRemoval is beer removed from a batch (dumped, spilled, sampled or
+// otherwise written off), as recorded by the Inventory service.
+type BatchRemoval struct {
+	UUID       string    `json:"uuid"`
+	Category   string    `json:"category"`
+	Reason     string    `json:"reason"`
+	Amount     int64     `json:"amount"`
+	AmountUnit string    `json:"amount_unit"`
+	AmountBBL  *float64  `json:"amount_bbl"`
+	RemovedAt  time.Time `json:"removed_at"`
+}
 
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("calling inventory service: %w", err)
+// ListBatchRemovals calls the Inventory service to get the removals recorded
+// against a production batch.
+func (c *InventoryClient) ListBatchRemovals(ctx context.Context, batchUUID string) ([]BatchRemoval, error) {
+	var result []BatchRemoval
+	resp, err := c.api.ListRemovals(ctx, &inventoryapi.ListRemovalsParams{BatchUuid: &batchUUID})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
+	return result, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("inventory service returned %d: %s", resp.StatusCode, string(respBody))
+// ListActiveReservations calls the Inventory service to get every active
+// ingredient reservation, across all production batches.
+func (c *InventoryClient) ListActiveReservations(ctx context.Context) ([]BatchReservation, error) {
+	return c.listActiveReservations(ctx, nil)
+}
+
+// listActiveReservations lists the active reservations of one production
+// batch, or of all batches when batchUUID is nil.
+func (c *InventoryClient) listActiveReservations(ctx context.Context, batchUUID *string) ([]BatchReservation, error) {
+	status := "active"
+	var result []BatchReservation
+	resp, err := c.api.ListInventoryReservations(ctx, &inventoryapi.ListInventoryReservationsParams{
+		Status:            &status,
+		ProductionRefUuid: batchUUID,
+	})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	var result []BatchIngredientLot
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("decoding batch ingredient lots response: %w", err)
+// IngredientLotReceipt holds the receiving fields of an ingredient lot, used to
+// work out how much of a purchase order line has already arrived.
+type IngredientLotReceipt struct {
+	UUID                  string  `json:"uuid"`
+	IngredientUUID        string  `json:"ingredient_uuid"`
+	PurchaseOrderLineUUID *string `json:"purchase_order_line_uuid"`
+	ReceivedAmount        int64   `json:"received_amount"`
+	ReceivedUnit          string  `json:"received_unit"`
+}
+
+// ListIngredientLotReceipts calls the Inventory service to list every
+// ingredient lot with its received quantity.
+func (c *InventoryClient) ListIngredientLotReceipts(ctx context.Context) ([]IngredientLotReceipt, error) {
+	var result []IngredientLotReceipt
+	resp, err := c.api.ListIngredientLots(ctx, nil)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// BatchUsagePick is a single lot/location deduction sent to the Inventory service.
+type BatchUsagePick struct {
+	IngredientLotUUID string `json:"ingredient_lot_uuid"`
+	StockLocationUUID string `json:"stock_location_uuid"`
+	Amount            int64  `json:"amount"`
+	AmountUnit        string `json:"amount_unit"`
+}
+
+// BatchUsageRequest is the payload for deducting ingredient stock for a batch.
+// Reason is the movement reason: empty for brewing use, or "package" for
+// packaging materials.
+type BatchUsageRequest struct {
+	ProductionRefUUID string           `json:"production_ref_uuid"`
+	UsedAt            string           `json:"used_at"`
+	Reason            string           `json:"reason,omitempty"`
+	Picks             []BatchUsagePick `json:"picks"`
+	Notes             *string          `json:"notes,omitempty"`
+}
+
+// BatchUsageResponse holds the usage record created by the Inventory service.
+type BatchUsageResponse struct {
+	UsageUUID string            `json:"usage_uuid"`
+	Movements []json.RawMessage `json:"movements"`
+}
+
+// InventoryRejectedError is returned when the Inventory service refuses a
+// request it considers invalid, such as a pick exceeding the lot's balance.
+// The message is safe to return to clients.
+type InventoryRejectedError struct {
+	Message string
+}
+
+func (e *InventoryRejectedError) Error() string {
+	return e.Message
+}
+
+// CreateBatchUsage calls the Inventory service to deduct the given picks from
+// stock in a single transaction.
+func (c *InventoryClient) CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (*BatchUsageResponse, error) {
+	body := inventoryapi.CreateBatchUsageRequest{
+		ProductionRefUuid: &req.ProductionRefUUID,
+		UsedAt:            &req.UsedAt,
+		Notes:             req.Notes,
+		Picks:             make([]inventoryapi.BatchUsagePick, 0, len(req.Picks)),
+	}
+	if req.Reason != "" {
+		body.Reason = &req.Reason
+	}
+	for _, pick := range req.Picks {
+		body.Picks = append(body.Picks, inventoryapi.BatchUsagePick{
+			IngredientLotUuid: pick.IngredientLotUUID,
+			StockLocationUuid: pick.StockLocationUUID,
+			Amount:            &pick.Amount,
+			AmountUnit:        pick.AmountUnit,
+		})
+	}
+
+	var result BatchUsageResponse
+	resp, err := c.api.CreateBatchUsage(ctx, body)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusCreated, &result); err != nil {
+		var statusErr *service.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusBadRequest {
+			return nil, &InventoryRejectedError{Message: strings.TrimSpace(statusErr.Body)}
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LabelTemplate is a label layout stored in the Inventory service.
+type LabelTemplate struct {
+	UUID      string `json:"uuid"`
+	Name      string `json:"name"`
+	Subject   string `json:"subject"`
+	IsDefault bool   `json:"is_default"`
+	label.Template
+}
+
+// ListLabelTemplates calls the Inventory service to list the label templates
+// of one subject.
+func (c *InventoryClient) ListLabelTemplates(ctx context.Context, subject string) ([]LabelTemplate, error) {
+	var result []LabelTemplate
+	resp, err := c.api.ListLabelTemplates(ctx, &inventoryapi.ListLabelTemplatesParams{Subject: &subject})
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ScanMatch is an inventory entity a scanned code resolved to.
+type ScanMatch struct {
+	EntityType string   `json:"entity_type"`
+	UUID       string   `json:"uuid"`
+	Name       string   `json:"name"`
+	MatchedOn  string   `json:"matched_on"`
+	State      string   `json:"state"`
+	Actions    []string `json:"actions"`
+}
+
+// ScanInventory calls the Inventory service to resolve a scanned code against
+// lot codes, beer lot item identifiers, keg serials and inventory UUIDs.
+func (c *InventoryClient) ScanInventory(ctx context.Context, code string) ([]ScanMatch, error) {
+	var result struct {
+		Matches []ScanMatch `json:"matches"`
+	}
+	resp, err := c.api.ScanInventory(ctx, code)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
+	return result.Matches, nil
+}
+
+// InventoryIngredient is an ingredient in the Inventory service catalog.
+type InventoryIngredient struct {
+	UUID     string `json:"uuid"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
 
+// ListIngredients calls the Inventory service to list the ingredient catalog.
+func (c *InventoryClient) ListIngredients(ctx context.Context) ([]InventoryIngredient, error) {
+	var result []InventoryIngredient
+	resp, err := c.api.ListIngredients(ctx, nil)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReorderPolicy is an ingredient's reorder policy in the Inventory service.
+// A policy without a stock location covers all locations.
+type ReorderPolicy struct {
+	UUID                  string  `json:"uuid"`
+	IngredientUUID        string  `json:"ingredient_uuid"`
+	StockLocationUUID     *string `json:"stock_location_uuid"`
+	PreferredSupplierUUID *string `json:"preferred_supplier_uuid"`
+	LeadTimeDays          *int    `json:"lead_time_days"`
+}
+
+// ListReorderPolicies calls the Inventory service to list every reorder
+// policy.
+func (c *InventoryClient) ListReorderPolicies(ctx context.Context) ([]ReorderPolicy, error) {
+	var result []ReorderPolicy
+	resp, err := c.api.ListIngredientReorderPolicies(ctx, nil)
+	if err = service.DecodeResponse("inventory", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
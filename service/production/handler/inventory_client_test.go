@@ -0,0 +1,93 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+)
+
+func newTestInventoryClient(t *testing.T, inventory http.HandlerFunc) *handler.InventoryClient {
+	t.Helper()
+	identity := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		service.JSON(w, map[string]any{"access_token": "service-token", "token_type": "Bearer", "expires_in": 900})
+	}))
+	t.Cleanup(identity.Close)
+	server := httptest.NewServer(inventory)
+	t.Cleanup(server.Close)
+
+	return handler.NewInventoryClient(server.URL+"/api", service.NewServiceTokenSource(identity.URL, "production", "secret"))
+}
+
+func TestInventoryClient(t *testing.T) {
+	const batchUUID = "00000000-0000-4000-8000-000000000001"
+
+	t.Run("sends the generated request with a service token", func(t *testing.T) {
+		var got *http.Request
+		client := newTestInventoryClient(t, func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			service.JSON(w, []handler.BatchReservation{{UUID: "r1", ProductionRefUUID: batchUUID}})
+		})
+
+		reservations, err := client.ListBatchReservations(context.Background(), batchUUID)
+		if err != nil {
+			t.Fatalf("ListBatchReservations: %v", err)
+		}
+		if len(reservations) != 1 || reservations[0].UUID != "r1" {
+			t.Errorf("unexpected reservations %+v", reservations)
+		}
+		if got.URL.Path != "/api/inventory-reservations" || got.URL.Query().Get("status") != "active" || got.URL.Query().Get("production_ref_uuid") != batchUUID {
+			t.Errorf("unexpected request %s", got.URL)
+		}
+		if got.Header.Get("Authorization") != "Bearer service-token" {
+			t.Errorf("unexpected Authorization %q", got.Header.Get("Authorization"))
+		}
+	})
+
+	t.Run("escapes path parameters", func(t *testing.T) {
+		var path string
+		client := newTestInventoryClient(t, func(w http.ResponseWriter, r *http.Request) {
+			path = r.URL.EscapedPath()
+			service.JSON(w, map[string]any{"matches": []handler.ScanMatch{{EntityType: "keg", UUID: "k1"}}})
+		})
+
+		matches, err := client.ScanInventory(context.Background(), "KEG 1/2")
+		if err != nil {
+			t.Fatalf("ScanInventory: %v", err)
+		}
+		if len(matches) != 1 || matches[0].UUID != "k1" {
+			t.Errorf("unexpected matches %+v", matches)
+		}
+		if path != "/api/inventory-scan/KEG%201%2F2" {
+			t.Errorf("unexpected path %q", path)
+		}
+	})
+
+	t.Run("reports a rejected batch usage", func(t *testing.T) {
+		client := newTestInventoryClient(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "insufficient stock", http.StatusBadRequest)
+		})
+
+		_, err := client.CreateBatchUsage(context.Background(), handler.BatchUsageRequest{ProductionRefUUID: batchUUID})
+		var rejected *handler.InventoryRejectedError
+		if !errors.As(err, &rejected) || rejected.Message != "insufficient stock" {
+			t.Errorf("expected a rejection, got %v", err)
+		}
+	})
+
+	t.Run("returns other statuses as errors", func(t *testing.T) {
+		client := newTestInventoryClient(t, func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "boom", http.StatusInternalServerError)
+		})
+
+		err := client.ReleaseBatchReservations(context.Background(), batchUUID)
+		var statusErr *service.StatusError
+		if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("expected a status error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/internal/brewimport"
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// HandleMigrationPreview handles [POST /migrations/preview]. The upload is a
+// multipart form with a source (ekos, brewfather or beersmith) and the
+// exported file. It returns the migration plan for review; nothing is
+// written. The reviewed plan is applied with [POST /migrations/inventory]
+// and then [POST /migrations/production].
+func HandleMigrationPreview() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, brewimport.MaxUploadSize)
+		if err := r.ParseMultipartForm(brewimport.MaxUploadSize); err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				http.Error(w, "file too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, "invalid multipart form", http.StatusBadRequest)
+			return
+		}
+
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+
+		plan, err := brewimport.Parse(r.FormValue("source"), file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		slog.Info("migration previewed", "source", plan.Source,
+			"ingredients", len(plan.Ingredients), "lots", len(plan.Lots), "vessels", len(plan.Vessels),
+			"recipes", len(plan.Recipes), "batches", len(plan.Batches), "warnings", len(plan.Warnings))
+		service.JSON(w, plan)
+	}
+}
+
+type MigrationStore interface {
+	ListVessels(context.Context) ([]storage.Vessel, error)
+	CreateVessel(context.Context, storage.Vessel) (storage.Vessel, error)
+	ListStyles(context.Context) ([]storage.Style, error)
+	ListRecipes(context.Context) ([]storage.Recipe, error)
+	ImportRecipe(context.Context, storage.RecipeImport) (storage.Recipe, error)
+	ListBatches(context.Context) ([]storage.Batch, error)
+	CreateBatch(context.Context, storage.Batch) (storage.Batch, error)
+}
+
+// MigrationInventory lists the inventory ingredients recipe lines link to.
+type MigrationInventory interface {
+	ListIngredients(ctx context.Context) ([]InventoryIngredient, error)
+}
+
+// HandleProductionMigration handles [POST /migrations/production], which
+// applies the vessels, recipes and batches of a reviewed migration plan.
+// Records that already exist by name are matched rather than created.
+// Recipe lines link to the inventory ingredient of the same name when there
+// is exactly one, so inventory is migrated first. With dry_run=true the plan
+// is validated without writing anything.
+func HandleProductionMigration(db MigrationStore, inventory MigrationInventory) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var plan brewimport.Plan
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, brewimport.MaxUploadSize)).Decode(&plan); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+
+		vessels, err := db.ListVessels(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing vessels", "error", err)
+			return
+		}
+		styles, err := db.ListStyles(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing styles", "error", err)
+			return
+		}
+		recipes, err := db.ListRecipes(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing recipes", "error", err)
+			return
+		}
+		batches, err := db.ListBatches(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing batches", "error", err)
+			return
+		}
+
+		var ingredients []InventoryIngredient
+		if len(plan.Recipes) > 0 {
+			ingredients, err = inventory.ListIngredients(r.Context())
+			if err != nil {
+				service.InternalError(w, "error listing inventory ingredients", "error", err)
+				return
+			}
+		}
+
+		m := productionMigration{
+			db:          db,
+			applier:     brewimport.NewApplier(dryRun),
+			vessels:     make(map[string]storage.Vessel, len(vessels)),
+			styles:      make(map[string]storage.Style, len(styles)),
+			recipes:     make(map[string]storage.Recipe, len(recipes)),
+			batches:     make(map[string]storage.Batch, len(batches)),
+			ingredients: make(map[string][]InventoryIngredient, len(ingredients)),
+		}
+		for _, v := range vessels {
+			m.vessels[strings.ToLower(v.Name)] = v
+		}
+		for _, s := range styles {
+			m.styles[strings.ToLower(s.Name)] = s
+		}
+		for _, rec := range recipes {
+			m.recipes[strings.ToLower(rec.Name)] = rec
+		}
+		for _, b := range batches {
+			m.batches[strings.ToLower(b.ShortName)] = b
+		}
+		for _, ing := range ingredients {
+			key := strings.ToLower(ing.Name)
+			m.ingredients[key] = append(m.ingredients[key], ing)
+		}
+
+		for _, v := range plan.Vessels {
+			m.vessel(r.Context(), v)
+		}
+		for _, rec := range plan.Recipes {
+			m.recipe(r.Context(), rec)
+		}
+		for _, b := range plan.Batches {
+			m.batch(r.Context(), b)
+		}
+
+		service.JSON(w, m.applier.Result())
+	}
+}
+
+// productionMigration applies a plan, keeping existing and created records
+// by lowercase name so later records can match or refer to them.
+type productionMigration struct {
+	db          MigrationStore
+	applier     *brewimport.Applier
+	vessels     map[string]storage.Vessel
+	styles      map[string]storage.Style
+	recipes     map[string]storage.Recipe
+	batches     map[string]storage.Batch
+	ingredients map[string][]InventoryIngredient
+}
+
+func (m *productionMigration) vessel(ctx context.Context, v brewimport.Vessel) {
+	const kind = "vessel"
+	if v.Skip {
+		m.applier.Skipped(kind, v.Ref, v.Name)
+		return
+	}
+	key := strings.ToLower(strings.TrimSpace(v.Name))
+	if existing, ok := m.vessels[key]; ok {
+		m.applier.Matched(kind, v.Ref, v.Name, dto.NewVesselResponse(existing))
+		return
+	}
+
+	capacity, unit := brewimport.WholeAmount(v.Capacity, v.CapacityUnit)
+	status := v.Status
+	if status == "" {
+		status = storage.VesselStatusActive
+	}
+	req := dto.CreateVesselRequest{
+		Type:         v.Type,
+		Name:         strings.TrimSpace(v.Name),
+		Capacity:     capacity,
+		CapacityUnit: unit,
+		Make:         v.Make,
+		Model:        v.Model,
+		Status:       &status,
+	}
+	if err := req.Validate(); err != nil {
+		m.applier.Failed(kind, v.Ref, v.Name, err)
+		return
+	}
+
+	vessel := storage.Vessel{
+		Type:         req.Type,
+		Name:         req.Name,
+		Capacity:     req.Capacity,
+		CapacityUnit: req.CapacityUnit,
+		Make:         req.Make,
+		Model:        req.Model,
+		Status:       status,
+	}
+	if m.applier.Create(ctx, kind, v.Ref, v.Name, func(ctx context.Context) (any, error) {
+		created, err := m.db.CreateVessel(ctx, vessel)
+		if err != nil {
+			return nil, err
+		}
+		vessel = created
+		return dto.NewVesselResponse(created), nil
+	}) {
+		m.vessels[key] = vessel
+	}
+}
+
+func (m *productionMigration) recipe(ctx context.Context, rec brewimport.Recipe) {
+	const kind = "recipe"
+	if rec.Skip {
+		m.applier.Skipped(kind, rec.Ref, rec.Name)
+		return
+	}
+	key := strings.ToLower(strings.TrimSpace(rec.Name))
+	if existing, ok := m.recipes[key]; ok {
+		m.applier.Matched(kind, rec.Ref, rec.Name, dto.NewRecipeResponse(existing))
+		return
+	}
+
+	req := dto.CreateRecipeRequest{
+		Name:                strings.TrimSpace(rec.Name),
+		StyleName:           rec.Style,
+		Notes:               rec.Notes,
+		BatchSize:           rec.BatchSize,
+		BatchSizeUnit:       rec.BatchSizeUnit,
+		TargetOG:            rec.TargetOG,
+		TargetFG:            rec.TargetFG,
+		TargetIBU:           rec.TargetIBU,
+		BrewhouseEfficiency: rec.BrewhouseEfficiency,
+	}
+	if err := req.Validate(); err != nil {
+		m.applier.Failed(kind, rec.Ref, rec.Name, err)
+		return
+	}
+
+	imp := storage.RecipeImport{
+		Recipe: storage.Recipe{
+			Name:                req.Name,
+			StyleName:           req.StyleName,
+			Notes:               req.Notes,
+			BatchSize:           req.BatchSize,
+			BatchSizeUnit:       req.BatchSizeUnit,
+			TargetOG:            req.TargetOG,
+			TargetFG:            req.TargetFG,
+			TargetIBU:           req.TargetIBU,
+			BrewhouseEfficiency: req.BrewhouseEfficiency,
+		},
+	}
+	if req.StyleName != nil {
+		if style, ok := m.styles[strings.ToLower(*req.StyleName)]; ok {
+			imp.Recipe.StyleID = &style.ID
+			imp.Recipe.StyleName = &style.Name
+		}
+	}
+
+	for i, line := range rec.Ingredients {
+		ri := dto.RecipeIngredientRequest{
+			Name:                  strings.TrimSpace(line.Name),
+			IngredientType:        line.IngredientType,
+			Amount:                line.Amount,
+			AmountUnit:            line.AmountUnit,
+			UseStage:              line.UseStage,
+			UseType:               line.UseType,
+			TimingDurationMinutes: line.TimingDurationMinutes,
+			AlphaAcidAssumed:      line.AlphaAcidAssumed,
+		}
+		if err := ri.Validate(); err != nil {
+			m.applier.Failed(kind, rec.Ref, rec.Name, fmt.Errorf("ingredient %d (%s): %w", i+1, line.Name, err))
+			return
+		}
+
+		ingredient := storage.RecipeIngredient{
+			Name:                  ri.Name,
+			IngredientType:        ri.IngredientType,
+			Amount:                ri.Amount,
+			AmountUnit:            ri.AmountUnit,
+			UseStage:              ri.UseStage,
+			UseType:               ri.UseType,
+			TimingDurationMinutes: ri.TimingDurationMinutes,
+			AlphaAcidAssumed:      ri.AlphaAcidAssumed,
+			ScalingFactor:         1,
+			SortOrder:             i,
+		}
+		if matches := m.ingredients[strings.ToLower(ri.Name)]; len(matches) == 1 {
+			if id, err := uuid.FromString(matches[0].UUID); err == nil {
+				ingredient.IngredientUUID = &id
+			}
+		}
+		imp.Ingredients = append(imp.Ingredients, ingredient)
+	}
+
+	recipe := imp.Recipe
+	if m.applier.Create(ctx, kind, rec.Ref, rec.Name, func(ctx context.Context) (any, error) {
+		created, err := m.db.ImportRecipe(ctx, imp)
+		if err != nil {
+			return nil, err
+		}
+		recipe = created
+		return dto.NewRecipeResponse(created), nil
+	}) {
+		m.recipes[key] = recipe
+	}
+}
+
+func (m *productionMigration) batch(ctx context.Context, b brewimport.Batch) {
+	const kind = "batch"
+	if b.Skip {
+		m.applier.Skipped(kind, b.Ref, b.ShortName)
+		return
+	}
+	key := strings.ToLower(strings.TrimSpace(b.ShortName))
+	if existing, ok := m.batches[key]; ok {
+		m.applier.Matched(kind, b.Ref, b.ShortName, dto.NewBatchResponse(existing))
+		return
+	}
+	if key == "" {
+		m.applier.Failed(kind, b.Ref, b.ShortName, fmt.Errorf("short_name is required"))
+		return
+	}
+
+	batch := storage.Batch{
+		ShortName: strings.TrimSpace(b.ShortName),
+		BrewDate:  b.BrewDate,
+		Notes:     b.Notes,
+	}
+	if b.Recipe != nil {
+		recipe, ok := m.recipes[strings.ToLower(strings.TrimSpace(*b.Recipe))]
+		if !ok {
+			m.applier.Failed(kind, b.Ref, b.ShortName, fmt.Errorf("recipe %q not found", *b.Recipe))
+			return
+		}
+		// A recipe that would be created in a dry run has no ID yet.
+		if recipe.ID != 0 {
+			batch.RecipeID = &recipe.ID
+		}
+	}
+
+	if m.applier.Create(ctx, kind, b.Ref, b.ShortName, func(ctx context.Context) (any, error) {
+		created, err := m.db.CreateBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		batch = created
+		return dto.NewBatchResponse(created), nil
+	}) {
+		m.batches[key] = batch
+	}
+}
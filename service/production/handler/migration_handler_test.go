@@ -0,0 +1,210 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/brewimport"
+	"github.com/brewpipes/brewpipes/internal/database/entity"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockMigrationStore implements handler.MigrationStore for testing.
+type mockMigrationStore struct {
+	vessels        []storage.Vessel
+	styles         []storage.Style
+	recipes        []storage.Recipe
+	batches        []storage.Batch
+	createdVessels []storage.Vessel
+	importedRecipe []storage.RecipeImport
+	createdBatches []storage.Batch
+}
+
+func (m *mockMigrationStore) ListVessels(context.Context) ([]storage.Vessel, error) {
+	return m.vessels, nil
+}
+
+func (m *mockMigrationStore) CreateVessel(_ context.Context, vessel storage.Vessel) (storage.Vessel, error) {
+	m.createdVessels = append(m.createdVessels, vessel)
+	vessel.UUID = uuid.Must(uuid.NewV4())
+	return vessel, nil
+}
+
+func (m *mockMigrationStore) ListStyles(context.Context) ([]storage.Style, error) {
+	return m.styles, nil
+}
+
+func (m *mockMigrationStore) ListRecipes(context.Context) ([]storage.Recipe, error) {
+	return m.recipes, nil
+}
+
+func (m *mockMigrationStore) ImportRecipe(_ context.Context, req storage.RecipeImport) (storage.Recipe, error) {
+	m.importedRecipe = append(m.importedRecipe, req)
+	recipe := req.Recipe
+	recipe.ID = int64(100 + len(m.importedRecipe))
+	recipe.UUID = uuid.Must(uuid.NewV4())
+	return recipe, nil
+}
+
+func (m *mockMigrationStore) ListBatches(context.Context) ([]storage.Batch, error) {
+	return m.batches, nil
+}
+
+func (m *mockMigrationStore) CreateBatch(_ context.Context, batch storage.Batch) (storage.Batch, error) {
+	m.createdBatches = append(m.createdBatches, batch)
+	batch.UUID = uuid.Must(uuid.NewV4())
+	return batch, nil
+}
+
+// mockMigrationInventory implements handler.MigrationInventory for testing.
+type mockMigrationInventory struct {
+	ingredients []handler.InventoryIngredient
+}
+
+func (m *mockMigrationInventory) ListIngredients(context.Context) ([]handler.InventoryIngredient, error) {
+	return m.ingredients, nil
+}
+
+func TestHandleMigrationPreview(t *testing.T) {
+	preview := func(source, contents string) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		form := multipart.NewWriter(&buf)
+		form.WriteField("source", source)
+		part, _ := form.CreateFormFile("file", "export")
+		part.Write([]byte(contents))
+		form.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/migrations/preview", &buf)
+		req.Header.Set("Content-Type", form.FormDataContentType())
+		rec := httptest.NewRecorder()
+		handler.HandleMigrationPreview().ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := preview("ekos", "Tank Name,Type,Capacity,Unit\nFV1,Fermenter,30,bbl\n")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var plan brewimport.Plan
+	if err := json.NewDecoder(rec.Body).Decode(&plan); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if len(plan.Vessels) != 1 || plan.Vessels[0].Name != "FV1" || plan.Vessels[0].Type != "fermenter" {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+
+	if rec := preview("untappd", "x"); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for an unknown source, got %d", rec.Code)
+	}
+}
+
+func TestHandleProductionMigration(t *testing.T) {
+	style := "American IPA"
+	recipeName := "Hop Harvest"
+	missing := "Unknown Recipe"
+	brewDate := time.Date(2024, 7, 12, 0, 0, 0, 0, time.UTC)
+	plan := brewimport.Plan{
+		Source: brewimport.SourceBrewfather,
+		Vessels: []brewimport.Vessel{
+			{Ref: "row 2", Name: "FV1", Type: "fermenter", Capacity: 15, CapacityUnit: "bbl"},
+			{Ref: "row 3", Name: "fv2", Type: "fermenter", Capacity: 15, CapacityUnit: "bbl"},
+			{Ref: "row 4", Name: "BT1", Type: "brite_tank", Capacity: 7.5, CapacityUnit: "bbl"},
+		},
+		Recipes: []brewimport.Recipe{{
+			Ref: "b1", Name: recipeName, Style: &style,
+			Ingredients: []brewimport.RecipeIngredient{
+				{Name: "Pale Ale Malt", IngredientType: "fermentable", Amount: 5.2, AmountUnit: "kg", UseStage: "mash"},
+				{Name: "Citra", IngredientType: "hop", Amount: 28, AmountUnit: "g", UseStage: "boil"},
+			},
+		}},
+		Batches: []brewimport.Batch{
+			{Ref: "b1", ShortName: "Hop Harvest #12", Recipe: &recipeName, BrewDate: &brewDate},
+			{Ref: "b2", ShortName: "Mystery", Recipe: &missing},
+			{Ref: "b3", ShortName: "Old", Skip: true},
+		},
+	}
+	body, _ := json.Marshal(plan)
+	maltUUID := uuid.Must(uuid.NewV4())
+
+	apply := func(t *testing.T, store *mockMigrationStore, target string) brewimport.Result {
+		t.Helper()
+		inventory := &mockMigrationInventory{ingredients: []handler.InventoryIngredient{
+			{UUID: maltUUID.String(), Name: "pale ale malt"},
+			{UUID: uuid.Must(uuid.NewV4()).String(), Name: "Citra"},
+			{UUID: uuid.Must(uuid.NewV4()).String(), Name: "citra"},
+		}}
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.HandleProductionMigration(store, inventory).ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var result brewimport.Result
+		if err := json.NewDecoder(rec.Body).Decode(&result); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		return result
+	}
+	newStore := func() *mockMigrationStore {
+		return &mockMigrationStore{
+			vessels: []storage.Vessel{{Name: "FV2", Type: "fermenter", Capacity: 15, CapacityUnit: "bbl", Status: "active"}},
+			styles:  []storage.Style{{Identifiers: entity.Identifiers{ID: 7}, Name: "American IPA"}},
+		}
+	}
+
+	t.Run("creates and matches records", func(t *testing.T) {
+		store := newStore()
+
+		result := apply(t, store, "/migrations/production")
+
+		expected := brewimport.Totals{Records: 7, Created: 4, Matched: 1, Skipped: 1, Failed: 1}
+		if result.Totals != expected {
+			t.Errorf("expected totals %+v, got %+v", expected, result.Totals)
+		}
+		if len(store.createdVessels) != 2 || store.createdVessels[1].Capacity != 29760 || store.createdVessels[1].CapacityUnit != "usfloz" {
+			t.Errorf("unexpected vessels %+v", store.createdVessels)
+		}
+
+		if len(store.importedRecipe) != 1 {
+			t.Fatalf("expected one recipe import, got %d", len(store.importedRecipe))
+		}
+		imp := store.importedRecipe[0]
+		if imp.Recipe.StyleID == nil || *imp.Recipe.StyleID != 7 {
+			t.Errorf("expected the style to be linked, got %v", imp.Recipe.StyleID)
+		}
+		if got := imp.Ingredients[0].IngredientUUID; got == nil || *got != maltUUID {
+			t.Errorf("expected the malt to link to inventory, got %v", got)
+		}
+		if imp.Ingredients[1].IngredientUUID != nil {
+			t.Error("expected an ambiguous ingredient name to stay unlinked")
+		}
+
+		if len(store.createdBatches) != 1 || *store.createdBatches[0].RecipeID != 101 {
+			t.Errorf("expected the batch to use the created recipe, got %+v", store.createdBatches)
+		}
+		if got := result.Results[5]; got.Status != brewimport.StatusError || *got.Error != `recipe "Unknown Recipe" not found` {
+			t.Errorf("unexpected result for a missing recipe %+v", got)
+		}
+	})
+
+	t.Run("dry run writes nothing", func(t *testing.T) {
+		store := newStore()
+
+		result := apply(t, store, "/migrations/production?dry_run=true")
+
+		if !result.DryRun || result.Totals.Valid != 4 || result.Totals.Created != 0 {
+			t.Errorf("unexpected totals %+v", result.Totals)
+		}
+		if len(store.createdVessels)+len(store.importedRecipe)+len(store.createdBatches) != 0 {
+			t.Error("expected a dry run to write nothing")
+		}
+	})
+}
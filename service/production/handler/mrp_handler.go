@@ -0,0 +1,556 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// MRPStore defines the storage operations needed for material requirements
+// planning.
+type MRPStore interface {
+	ListBatches(context.Context) ([]storage.Batch, error)
+	ListRecipeIngredients(ctx context.Context, recipeUUID string) ([]storage.RecipeIngredient, error)
+	GetRecipe(ctx context.Context, recipeUUID string, opts *storage.RecipeQueryOpts) (storage.Recipe, error)
+}
+
+// MRPInventoryFetcher abstracts the inter-service calls to the Inventory
+// service for stock, reservations, and receipts against purchase orders.
+type MRPInventoryFetcher interface {
+	GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error)
+	ListActiveReservations(ctx context.Context) ([]BatchReservation, error)
+	ListIngredientLotReceipts(ctx context.Context) ([]IngredientLotReceipt, error)
+	ListReorderPolicies(ctx context.Context) ([]ReorderPolicy, error)
+}
+
+// MRPSupplyFetcher abstracts the inter-service call to the Procurement service
+// for open order lines and last-used suppliers.
+type MRPSupplyFetcher interface {
+	LookupItemSupply(ctx context.Context, itemUUIDs []string) (*ItemSupply, error)
+}
+
+// MRPPurchaseOrderCreator adds draft purchase order creation to MRPSupplyFetcher.
+type MRPPurchaseOrderCreator interface {
+	MRPSupplyFetcher
+	CreateDraftPurchaseOrder(ctx context.Context, req DraftPurchaseOrderRequest) (*DraftPurchaseOrder, error)
+}
+
+// HandleMRPShortages handles [GET /mrp/shortages]. It explodes the recipe of
+// every planned batch, nets the demand against stock and open orders, and
+// returns a shortage list phased by brew date. Nothing is changed.
+func HandleMRPShortages(db MRPStore, invClient MRPInventoryFetcher, procClient MRPSupplyFetcher) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		plan, err := runMRP(r.Context(), db, invClient, procClient)
+		if err != nil {
+			service.InternalError(w, "error running material requirements plan", "error", err)
+			return
+		}
+
+		service.JSON(w, plan)
+	}
+}
+
+// HandleMRPPurchaseOrders handles [POST /mrp/purchase-orders]. It reruns the
+// plan and creates one draft purchase order per supplier covering the net
+// shortages. Shortages with no supplier, or whose supplier has no known
+// currency, are returned as unassigned.
+func HandleMRPPurchaseOrders(db MRPStore, invClient MRPInventoryFetcher, procClient MRPPurchaseOrderCreator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.CreateMRPPurchaseOrdersRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+
+		plan, err := runMRP(ctx, db, invClient, procClient)
+		if err != nil {
+			service.InternalError(w, "error running material requirements plan", "error", err)
+			return
+		}
+
+		selected := make(map[string]bool, len(req.IngredientUUIDs))
+		for _, id := range req.IngredientUUIDs {
+			selected[id] = true
+		}
+
+		resp := dto.MRPPurchaseOrdersResponse{
+			PurchaseOrders: make([]dto.MRPPurchaseOrder, 0),
+			Unassigned:     make([]dto.MRPRequirement, 0),
+		}
+
+		var supplierOrder []string
+		bySupplier := make(map[string]*dto.MRPPurchaseOrder)
+		for _, reqm := range plan.Requirements {
+			if reqm.NetShortage <= 0 {
+				continue
+			}
+			if len(selected) > 0 && !selected[reqm.IngredientUUID] {
+				continue
+			}
+			if reqm.Supplier == nil || reqm.Supplier.Currency == "" {
+				resp.Unassigned = append(resp.Unassigned, reqm)
+				continue
+			}
+
+			po, ok := bySupplier[reqm.Supplier.SupplierUUID]
+			if !ok {
+				po = &dto.MRPPurchaseOrder{
+					SupplierUUID: reqm.Supplier.SupplierUUID,
+					SupplierName: reqm.Supplier.SupplierName,
+				}
+				bySupplier[reqm.Supplier.SupplierUUID] = po
+				supplierOrder = append(supplierOrder, reqm.Supplier.SupplierUUID)
+			}
+			if reqm.NeededBy != nil && (po.ExpectedAt == nil || reqm.NeededBy.Before(*po.ExpectedAt)) {
+				po.ExpectedAt = reqm.NeededBy
+			}
+
+			itemName := reqm.Supplier.ItemName
+			if itemName == "" {
+				itemName = reqm.Name
+			}
+			// The supplier's cost only carries over when it was priced in the
+			// same unit as the requirement.
+			var unitCost int64
+			if reqm.Supplier.QuantityUnit == reqm.AmountUnit {
+				unitCost = reqm.Supplier.UnitCostCents
+			}
+			po.Lines = append(po.Lines, dto.MRPPurchaseOrderLine{
+				IngredientUUID: reqm.IngredientUUID,
+				ItemName:       itemName,
+				Quantity:       reqm.NetShortage,
+				QuantityUnit:   reqm.AmountUnit,
+				UnitCostCents:  unitCost,
+				Currency:       reqm.Supplier.Currency,
+			})
+		}
+
+		notes := fmt.Sprintf("Generated by material requirements planning across %d planned batches", len(plan.Batches))
+		for _, supplierUUID := range supplierOrder {
+			po := bySupplier[supplierUUID]
+
+			draft := DraftPurchaseOrderRequest{
+				SupplierUUID: po.SupplierUUID,
+				ExpectedAt:   po.ExpectedAt,
+				Notes:        &notes,
+				Lines:        make([]DraftPurchaseOrderLine, 0, len(po.Lines)),
+			}
+			for _, line := range po.Lines {
+				ingredientUUID := line.IngredientUUID
+				draft.Lines = append(draft.Lines, DraftPurchaseOrderLine{
+					ItemType:          "ingredient",
+					ItemName:          line.ItemName,
+					InventoryItemUUID: &ingredientUUID,
+					Quantity:          line.Quantity,
+					QuantityUnit:      line.QuantityUnit,
+					UnitCostCents:     line.UnitCostCents,
+					Currency:          line.Currency,
+				})
+			}
+
+			created, err := procClient.CreateDraftPurchaseOrder(ctx, draft)
+			if err != nil {
+				service.InternalError(w, "error creating draft purchase order", "error", err, "supplier_uuid", po.SupplierUUID)
+				return
+			}
+			po.PurchaseOrderUUID = created.UUID
+			po.OrderNumber = created.OrderNumber
+
+			slog.Info("mrp draft purchase order created", "purchase_order_uuid", created.UUID, "supplier_uuid", po.SupplierUUID, "lines", len(po.Lines))
+			resp.PurchaseOrders = append(resp.PurchaseOrders, *po)
+		}
+
+		service.JSONCreated(w, resp)
+	}
+}
+
+// runMRP gathers planned batches, their recipes, and the current supply
+// picture from inventory and procurement, then builds the plan.
+func runMRP(ctx context.Context, db MRPStore, invClient MRPInventoryFetcher, procClient MRPSupplyFetcher) (dto.MRPResponse, error) {
+	batches, err := db.ListBatches(ctx)
+	if err != nil {
+		return dto.MRPResponse{}, fmt.Errorf("listing batches: %w", err)
+	}
+
+	var planned []storage.Batch
+	ingredientsByRecipe := make(map[string][]storage.RecipeIngredient)
+	recipes := make(map[string]storage.Recipe)
+	for _, batch := range batches {
+		if batch.RecipeUUID == nil {
+			continue
+		}
+		if batch.CurrentPhase != nil && *batch.CurrentPhase != storage.ProcessPhasePlanning {
+			continue
+		}
+		planned = append(planned, batch)
+
+		if _, ok := ingredientsByRecipe[*batch.RecipeUUID]; ok {
+			continue
+		}
+		ingredients, err := db.ListRecipeIngredients(ctx, *batch.RecipeUUID)
+		if err != nil {
+			return dto.MRPResponse{}, fmt.Errorf("listing recipe ingredients for %s: %w", *batch.RecipeUUID, err)
+		}
+		ingredientsByRecipe[*batch.RecipeUUID] = ingredients
+
+		recipe, err := db.GetRecipe(ctx, *batch.RecipeUUID, nil)
+		if err != nil && !errors.Is(err, service.ErrNotFound) {
+			return dto.MRPResponse{}, fmt.Errorf("getting recipe %s: %w", *batch.RecipeUUID, err)
+		}
+		recipes[*batch.RecipeUUID] = recipe
+	}
+
+	levels, err := invClient.GetIngredientLotStockLevels(ctx)
+	if err != nil {
+		return dto.MRPResponse{}, fmt.Errorf("fetching ingredient lot stock levels: %w", err)
+	}
+	reservations, err := invClient.ListActiveReservations(ctx)
+	if err != nil {
+		return dto.MRPResponse{}, fmt.Errorf("fetching reservations: %w", err)
+	}
+	receipts, err := invClient.ListIngredientLotReceipts(ctx)
+	if err != nil {
+		return dto.MRPResponse{}, fmt.Errorf("fetching ingredient lots: %w", err)
+	}
+	policies, err := invClient.ListReorderPolicies(ctx)
+	if err != nil {
+		return dto.MRPResponse{}, fmt.Errorf("fetching reorder policies: %w", err)
+	}
+
+	// An ingredient's preferred supplier comes from its all-locations
+	// reorder policy, or else from any of its location policies.
+	preferred := make(map[string]string)
+	for _, p := range policies {
+		if p.PreferredSupplierUUID == nil {
+			continue
+		}
+		if _, ok := preferred[p.IngredientUUID]; ok && p.StockLocationUUID != nil {
+			continue
+		}
+		preferred[p.IngredientUUID] = *p.PreferredSupplierUUID
+	}
+
+	itemSet := make(map[string]bool)
+	var itemUUIDs []string
+	for _, ingredients := range ingredientsByRecipe {
+		for _, ri := range ingredients {
+			if ri.IngredientUUID == nil || itemSet[ri.IngredientUUID.String()] {
+				continue
+			}
+			itemSet[ri.IngredientUUID.String()] = true
+			itemUUIDs = append(itemUUIDs, ri.IngredientUUID.String())
+		}
+	}
+
+	supply := &ItemSupply{}
+	if len(itemUUIDs) > 0 {
+		supply, err = procClient.LookupItemSupply(ctx, itemUUIDs)
+		if err != nil {
+			return dto.MRPResponse{}, fmt.Errorf("looking up item supply: %w", err)
+		}
+	}
+
+	return planMaterials(planned, recipes, ingredientsByRecipe, levels, reservations, receipts, supply, preferred, time.Now().UTC()), nil
+}
+
+// batchScale is the factor a batch's recipe bill is scaled by: the batch's
+// planned volume over the recipe's batch size, as the allocation endpoint's
+// scale. Batches without a planned volume, recipes without a batch size, and
+// units that cannot be converted plan at the recipe's written size.
+func batchScale(batch storage.Batch, recipe storage.Recipe) float64 {
+	if batch.PlannedVolume == nil || batch.PlannedVolumeUnit == nil || recipe.BatchSize == nil || recipe.BatchSizeUnit == nil {
+		return 1
+	}
+	planned, ok := dto.VolumeInLitres(*batch.PlannedVolume, *batch.PlannedVolumeUnit)
+	if !ok {
+		return 1
+	}
+	size, ok := dto.VolumeInLitres(*recipe.BatchSize, *recipe.BatchSizeUnit)
+	if !ok || size <= 0 {
+		return 1
+	}
+	return planned / size
+}
+
+func fillMRPSupplierHistory(dst *dto.MRPSupplier, last ItemSupplier) {
+	dst.SupplierName = last.SupplierName
+	dst.ItemName = last.ItemName
+	dst.QuantityUnit = last.QuantityUnit
+	dst.UnitCostCents = last.UnitCostCents
+	dst.Currency = last.Currency
+}
+
+// mrpKey identifies a requirement. Quantities are only netted within the same
+// unit; stock or orders held in another unit are not counted.
+type mrpKey struct {
+	ingredientUUID string
+	unit           string
+}
+
+type mrpDemand struct {
+	batch  storage.Batch
+	amount int64
+}
+
+// planMaterials nets planned demand against supply. Each batch draws on stock
+// in brew date order (undated batches last). Open orders arrive on their
+// expected date; orders without one count toward the net shortage but are not
+// scheduled against any batch. Each batch's bill is scaled by batchScale.
+// Shortages are assigned to the ingredient's preferred supplier, keyed by
+// ingredient UUID in preferred, or else to the supplier it was last ordered
+// from.
+func planMaterials(
+	batches []storage.Batch,
+	recipes map[string]storage.Recipe,
+	ingredientsByRecipe map[string][]storage.RecipeIngredient,
+	levels []IngredientLotStockLevel,
+	reservations []BatchReservation,
+	receipts []IngredientLotReceipt,
+	supply *ItemSupply,
+	preferred map[string]string,
+	now time.Time,
+) dto.MRPResponse {
+	sort.SliceStable(batches, func(i, j int) bool {
+		a, b := batches[i].BrewDate, batches[j].BrewDate
+		if a == nil || b == nil {
+			if a != nil {
+				return true
+			}
+			if b != nil {
+				return false
+			}
+		} else if !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return batches[i].ShortName < batches[j].ShortName
+	})
+
+	resp := dto.MRPResponse{
+		GeneratedAt:  now,
+		Batches:      make([]dto.MRPBatch, 0, len(batches)),
+		Requirements: make([]dto.MRPRequirement, 0),
+		Unlinked:     make([]dto.MRPUnlinkedLine, 0),
+	}
+
+	plannedBatches := make(map[string]bool, len(batches))
+	demand := make(map[mrpKey][]mrpDemand)
+	names := make(map[mrpKey]string)
+	var keys []mrpKey
+
+	for _, batch := range batches {
+		batchUUID := batch.UUID.String()
+		plannedBatches[batchUUID] = true
+		scale := batchScale(batch, recipes[*batch.RecipeUUID])
+		resp.Batches = append(resp.Batches, dto.MRPBatch{
+			BatchUUID:         batchUUID,
+			ShortName:         batch.ShortName,
+			BrewDate:          batch.BrewDate,
+			RecipeUUID:        *batch.RecipeUUID,
+			RecipeName:        batch.RecipeName,
+			PlannedVolume:     batch.PlannedVolume,
+			PlannedVolumeUnit: batch.PlannedVolumeUnit,
+			Scale:             scale,
+		})
+
+		for _, ri := range ingredientsByRecipe[*batch.RecipeUUID] {
+			amount := scaledAmount(ri.Amount, ri.ScalingFactor, scale)
+			if amount == 0 {
+				continue
+			}
+			if ri.IngredientUUID == nil {
+				resp.Unlinked = append(resp.Unlinked, dto.MRPUnlinkedLine{
+					BatchUUID:            batchUUID,
+					BatchShortName:       batch.ShortName,
+					RecipeIngredientUUID: ri.UUID.String(),
+					Name:                 ri.Name,
+					RequiredAmount:       amount,
+					AmountUnit:           ri.AmountUnit,
+				})
+				continue
+			}
+
+			key := mrpKey{ingredientUUID: ri.IngredientUUID.String(), unit: ri.AmountUnit}
+			if _, ok := demand[key]; !ok {
+				keys = append(keys, key)
+				names[key] = ri.Name
+			}
+			// A recipe listing the same ingredient twice is one draw per batch.
+			if lines := demand[key]; len(lines) > 0 && lines[len(lines)-1].batch.UUID == batch.UUID {
+				lines[len(lines)-1].amount += amount
+				continue
+			}
+			demand[key] = append(demand[key], mrpDemand{batch: batch, amount: amount})
+		}
+	}
+
+	// Stock reserved for batches in this run is part of their supply.
+	held := make(map[string]int64)
+	for _, res := range reservations {
+		if plannedBatches[res.ProductionRefUUID] {
+			held[res.IngredientLotUUID+"|"+res.StockLocationUUID] += res.OutstandingAmount
+		}
+	}
+	onHand := make(map[mrpKey]int64)
+	for _, level := range levels {
+		if level.ExpiresAt != nil && level.ExpiresAt.Before(now) {
+			continue
+		}
+		available := level.AvailableAmount + held[level.IngredientLotUUID+"|"+level.StockLocationUUID]
+		available = min(available, level.CurrentAmount)
+		if available <= 0 {
+			continue
+		}
+		key := mrpKey{ingredientUUID: level.IngredientUUID, unit: level.CurrentUnit}
+		onHand[key] += available
+		if _, ok := names[key]; ok && level.IngredientName != "" {
+			names[key] = level.IngredientName
+		}
+	}
+
+	received := make(map[string]int64)
+	for _, lot := range receipts {
+		if lot.PurchaseOrderLineUUID != nil {
+			received[*lot.PurchaseOrderLineUUID+"|"+lot.ReceivedUnit] += lot.ReceivedAmount
+		}
+	}
+	openOrders := make(map[mrpKey][]dto.MRPOpenOrder)
+	for _, line := range supply.OpenLines {
+		outstanding := line.Quantity - received[line.PurchaseOrderLineUUID+"|"+line.QuantityUnit]
+		if outstanding <= 0 {
+			continue
+		}
+		key := mrpKey{ingredientUUID: line.InventoryItemUUID, unit: line.QuantityUnit}
+		openOrders[key] = append(openOrders[key], dto.MRPOpenOrder{
+			PurchaseOrderUUID:     line.PurchaseOrderUUID,
+			PurchaseOrderLineUUID: line.PurchaseOrderLineUUID,
+			OrderNumber:           line.OrderNumber,
+			Status:                line.Status,
+			OutstandingAmount:     outstanding,
+			ExpectedAt:            line.ExpectedAt,
+		})
+	}
+	suppliers := make(map[string]ItemSupplier, len(supply.Suppliers))
+	for _, s := range supply.Suppliers {
+		suppliers[s.InventoryItemUUID] = s
+	}
+	catalog := make(map[string]ItemCatalogEntry, len(supply.CatalogItems))
+	for _, entry := range supply.CatalogItems {
+		key := entry.InventoryItemUUID + "|" + entry.SupplierUUID
+		if _, ok := catalog[key]; !ok {
+			catalog[key] = entry
+		}
+	}
+
+	for _, key := range keys {
+		orders := openOrders[key]
+		sort.SliceStable(orders, func(i, j int) bool {
+			a, b := orders[i].ExpectedAt, orders[j].ExpectedAt
+			if a == nil || b == nil {
+				return a != nil && b == nil
+			}
+			return a.Before(*b)
+		})
+
+		reqm := dto.MRPRequirement{
+			IngredientUUID: key.ingredientUUID,
+			Name:           names[key],
+			AmountUnit:     key.unit,
+			OnHand:         onHand[key],
+			Periods:        make([]dto.MRPPeriod, 0, len(demand[key])),
+			OpenOrders:     make([]dto.MRPOpenOrder, 0, len(orders)),
+		}
+		reqm.OpenOrders = append(reqm.OpenOrders, orders...)
+		for _, o := range orders {
+			reqm.OnOrder += o.OutstandingAmount
+		}
+
+		balance := reqm.OnHand
+		nextOrder := 0
+		for _, d := range demand[key] {
+			period := dto.MRPPeriod{
+				BatchUUID:      d.batch.UUID.String(),
+				BatchShortName: d.batch.ShortName,
+				BrewDate:       d.batch.BrewDate,
+				RequiredAmount: d.amount,
+			}
+			for nextOrder < len(orders) {
+				expected := orders[nextOrder].ExpectedAt
+				if expected == nil || (d.batch.BrewDate != nil && expected.After(*d.batch.BrewDate)) {
+					break
+				}
+				period.ScheduledReceipts += orders[nextOrder].OutstandingAmount
+				nextOrder++
+			}
+
+			balance += period.ScheduledReceipts
+			period.ShortageAmount = max(0, min(d.amount, d.amount-balance))
+			balance -= d.amount
+			period.ProjectedBalance = balance
+
+			if period.ShortageAmount > 0 && reqm.NeededBy == nil {
+				reqm.NeededBy = d.batch.BrewDate
+			}
+			reqm.GrossRequired += d.amount
+			reqm.Periods = append(reqm.Periods, period)
+		}
+
+		reqm.NetShortage = max(0, reqm.GrossRequired-reqm.OnHand-reqm.OnOrder)
+
+		last, hasLast := suppliers[key.ingredientUUID]
+		if supplierUUID, ok := preferred[key.ingredientUUID]; ok {
+			reqm.Supplier = &dto.MRPSupplier{SupplierUUID: supplierUUID, Source: "preferred"}
+			if entry, ok := catalog[key.ingredientUUID+"|"+supplierUUID]; ok {
+				reqm.Supplier.SupplierName = entry.SupplierName
+				reqm.Supplier.ItemName = entry.ItemName
+				reqm.Supplier.QuantityUnit = entry.PackUnit
+				if entry.UnitCostCents != nil && entry.Currency != nil {
+					reqm.Supplier.UnitCostCents = *entry.UnitCostCents
+					reqm.Supplier.Currency = *entry.Currency
+				}
+			}
+			if hasLast && last.SupplierUUID == supplierUUID {
+				fillMRPSupplierHistory(reqm.Supplier, last)
+			}
+		} else if hasLast {
+			reqm.Supplier = &dto.MRPSupplier{SupplierUUID: last.SupplierUUID, Source: "last_order"}
+			fillMRPSupplierHistory(reqm.Supplier, last)
+		}
+
+		resp.Requirements = append(resp.Requirements, reqm)
+	}
+
+	sort.SliceStable(resp.Requirements, func(i, j int) bool {
+		a, b := resp.Requirements[i], resp.Requirements[j]
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.AmountUnit < b.AmountUnit
+	})
+
+	return resp
+}
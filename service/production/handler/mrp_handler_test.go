@@ -0,0 +1,395 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockMRPStore implements handler.MRPStore for testing.
+type mockMRPStore struct {
+	batches     []storage.Batch
+	ingredients map[string][]storage.RecipeIngredient
+	recipes     map[string]storage.Recipe
+}
+
+func (m *mockMRPStore) ListBatches(_ context.Context) ([]storage.Batch, error) {
+	return m.batches, nil
+}
+
+func (m *mockMRPStore) ListRecipeIngredients(_ context.Context, recipeUUID string) ([]storage.RecipeIngredient, error) {
+	return m.ingredients[recipeUUID], nil
+}
+
+func (m *mockMRPStore) GetRecipe(_ context.Context, recipeUUID string, _ *storage.RecipeQueryOpts) (storage.Recipe, error) {
+	recipe, ok := m.recipes[recipeUUID]
+	if !ok {
+		return storage.Recipe{}, service.ErrNotFound
+	}
+	return recipe, nil
+}
+
+// mockMRPInventory implements handler.MRPInventoryFetcher for testing.
+type mockMRPInventory struct {
+	levels       []handler.IngredientLotStockLevel
+	reservations []handler.BatchReservation
+	receipts     []handler.IngredientLotReceipt
+	policies     []handler.ReorderPolicy
+}
+
+func (m *mockMRPInventory) GetIngredientLotStockLevels(_ context.Context) ([]handler.IngredientLotStockLevel, error) {
+	return m.levels, nil
+}
+
+func (m *mockMRPInventory) ListActiveReservations(_ context.Context) ([]handler.BatchReservation, error) {
+	return m.reservations, nil
+}
+
+func (m *mockMRPInventory) ListIngredientLotReceipts(_ context.Context) ([]handler.IngredientLotReceipt, error) {
+	return m.receipts, nil
+}
+
+func (m *mockMRPInventory) ListReorderPolicies(_ context.Context) ([]handler.ReorderPolicy, error) {
+	return m.policies, nil
+}
+
+// mockMRPProcurement implements handler.MRPPurchaseOrderCreator for testing.
+type mockMRPProcurement struct {
+	supply handler.ItemSupply
+	drafts []handler.DraftPurchaseOrderRequest
+}
+
+func (m *mockMRPProcurement) LookupItemSupply(_ context.Context, _ []string) (*handler.ItemSupply, error) {
+	return &m.supply, nil
+}
+
+func (m *mockMRPProcurement) CreateDraftPurchaseOrder(_ context.Context, req handler.DraftPurchaseOrderRequest) (*handler.DraftPurchaseOrder, error) {
+	m.drafts = append(m.drafts, req)
+	return &handler.DraftPurchaseOrder{UUID: uuid.Must(uuid.NewV4()).String(), OrderNumber: "20261019001", Status: "draft"}, nil
+}
+
+func TestHandleMRPShortages(t *testing.T) {
+	recipeUUID := "220e8400-e29b-41d4-a716-446655440000"
+	maltUUID := "110e8400-e29b-41d4-a716-446655440001"
+	hopUUID := "110e8400-e29b-41d4-a716-446655440002"
+
+	first := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC)
+	arrives := time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC)
+	planning := storage.ProcessPhasePlanning
+	brewing := "fermenting"
+
+	// Two planned batches of the same recipe and one already brewing.
+	b1 := storage.Batch{ShortName: "IPA-1", BrewDate: &first, RecipeUUID: &recipeUUID}
+	b1.UUID = uuid.Must(uuid.NewV4())
+	b2 := storage.Batch{ShortName: "IPA-2", BrewDate: &second, RecipeUUID: &recipeUUID, CurrentPhase: &planning}
+	b2.UUID = uuid.Must(uuid.NewV4())
+	b3 := storage.Batch{ShortName: "IPA-0", BrewDate: &first, RecipeUUID: &recipeUUID, CurrentPhase: &brewing}
+	b3.UUID = uuid.Must(uuid.NewV4())
+
+	// 100 kg of malt and 2 kg of hops per batch, plus a salt addition not
+	// linked to an ingredient.
+	malt := storage.RecipeIngredient{Name: "Pale Malt", IngredientUUID: uuidPtr(maltUUID), Amount: 100, AmountUnit: "kg", ScalingFactor: 1}
+	malt.UUID = uuid.Must(uuid.NewV4())
+	hop := storage.RecipeIngredient{Name: "Citra", IngredientUUID: uuidPtr(hopUUID), Amount: 2, AmountUnit: "kg", ScalingFactor: 1}
+	hop.UUID = uuid.Must(uuid.NewV4())
+	salt := storage.RecipeIngredient{Name: "Gypsum", Amount: 1, AmountUnit: "kg", ScalingFactor: 1}
+	salt.UUID = uuid.Must(uuid.NewV4())
+	ingredients := map[string][]storage.RecipeIngredient{recipeUUID: {malt, hop, salt}}
+
+	// 120 kg of malt on hand, 30 kg of it reserved for the first batch.
+	levels := []handler.IngredientLotStockLevel{
+		{IngredientLotUUID: "lot-1", IngredientUUID: maltUUID, IngredientName: "Pale Malt", StockLocationUUID: "loc-a", CurrentAmount: 120, CurrentUnit: "kg", AvailableAmount: 90},
+	}
+	reservations := []handler.BatchReservation{
+		{ProductionRefUUID: b1.UUID.String(), IngredientLotUUID: "lot-1", StockLocationUUID: "loc-a", OutstandingAmount: 30, AmountUnit: "kg"},
+	}
+	// 50 kg of malt on order between the brew dates, 20 kg already
+	// received. Hops have never been ordered.
+	receipts := []handler.IngredientLotReceipt{
+		{UUID: "lot-2", IngredientUUID: maltUUID, PurchaseOrderLineUUID: sp("pol-1"), ReceivedAmount: 20, ReceivedUnit: "kg"},
+	}
+	supply := handler.ItemSupply{
+		OpenLines: []handler.OpenPurchaseOrderLine{
+			{PurchaseOrderLineUUID: "pol-1", PurchaseOrderUUID: "po-1", OrderNumber: "20261001001", Status: "partially_received", SupplierUUID: "sup-1", InventoryItemUUID: maltUUID, Quantity: 50, QuantityUnit: "kg", ExpectedAt: &arrives},
+		},
+		Suppliers: []handler.ItemSupplier{
+			{InventoryItemUUID: maltUUID, SupplierUUID: "sup-1", SupplierName: "Malt Co", ItemName: "Pale Malt 25kg", QuantityUnit: "kg", UnitCostCents: 150, Currency: "USD"},
+		},
+	}
+
+	// The recipe is written for 10 hl and the first batch is planned at
+	// 2000 l; the second has no planned volume.
+	size, sizeUnit := 10.0, "hl"
+	recipes := map[string]storage.Recipe{recipeUUID: {Name: "IPA", BatchSize: &size, BatchSizeUnit: &sizeUnit}}
+	volume, volumeUnit := 2000.0, "l"
+	scaledB1 := b1
+	scaledB1.PlannedVolume = &volume
+	scaledB1.PlannedVolumeUnit = &volumeUnit
+
+	tests := []struct {
+		name     string
+		store    *mockMRPStore
+		inv      *mockMRPInventory
+		proc     *mockMRPProcurement
+		validate func(t *testing.T, resp dto.MRPResponse)
+	}{
+		{
+			name:  "nets stock, reservations and open orders by brew date",
+			store: &mockMRPStore{batches: []storage.Batch{b2, b3, b1}, ingredients: ingredients},
+			inv:   &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts},
+			proc:  &mockMRPProcurement{supply: supply},
+			validate: func(t *testing.T, resp dto.MRPResponse) {
+				if len(resp.Batches) != 2 || resp.Batches[0].ShortName != "IPA-1" {
+					t.Fatalf("expected 2 planned batches in brew date order, got %+v", resp.Batches)
+				}
+				if len(resp.Unlinked) != 2 {
+					t.Errorf("expected 2 unlinked lines, got %d", len(resp.Unlinked))
+				}
+				if len(resp.Requirements) != 2 {
+					t.Fatalf("expected 2 requirements, got %d", len(resp.Requirements))
+				}
+
+				hops := resp.Requirements[0]
+				if hops.IngredientUUID != hopUUID || hops.NetShortage != 4 || hops.Supplier != nil {
+					t.Errorf("hops: expected shortage of 4 with no supplier, got %+v", hops)
+				}
+
+				malt := resp.Requirements[1]
+				if malt.IngredientUUID != maltUUID {
+					t.Fatalf("expected malt second, got %s", malt.IngredientUUID)
+				}
+				if malt.GrossRequired != 200 || malt.OnHand != 120 || malt.OnOrder != 30 || malt.NetShortage != 50 {
+					t.Errorf("malt: expected 200/120/30/50, got %d/%d/%d/%d", malt.GrossRequired, malt.OnHand, malt.OnOrder, malt.NetShortage)
+				}
+				if len(malt.Periods) != 2 {
+					t.Fatalf("malt: expected 2 periods, got %d", len(malt.Periods))
+				}
+				if p := malt.Periods[0]; p.ShortageAmount != 0 || p.ProjectedBalance != 20 {
+					t.Errorf("malt first batch: expected no shortage and balance 20, got %+v", p)
+				}
+				if p := malt.Periods[1]; p.ScheduledReceipts != 30 || p.ShortageAmount != 50 || p.ProjectedBalance != -50 {
+					t.Errorf("malt second batch: expected 30 received, 50 short, got %+v", p)
+				}
+				if malt.NeededBy == nil || !malt.NeededBy.Equal(second) {
+					t.Errorf("malt: expected needed by second brew date, got %v", malt.NeededBy)
+				}
+			},
+		},
+		{
+			name:  "scales batches by planned volume",
+			store: &mockMRPStore{batches: []storage.Batch{b2, b3, scaledB1}, ingredients: ingredients, recipes: recipes},
+			inv:   &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts},
+			proc:  &mockMRPProcurement{supply: supply},
+			validate: func(t *testing.T, resp dto.MRPResponse) {
+				if len(resp.Batches) != 2 || resp.Batches[0].Scale != 2 || resp.Batches[1].Scale != 1 {
+					t.Fatalf("expected scales 2 and 1, got %+v", resp.Batches)
+				}
+
+				for _, r := range resp.Requirements {
+					switch r.IngredientUUID {
+					case maltUUID:
+						if r.GrossRequired != 300 || r.Periods[0].RequiredAmount != 200 {
+							t.Errorf("malt: expected 300 gross with 200 for the first batch, got %d/%d", r.GrossRequired, r.Periods[0].RequiredAmount)
+						}
+					case hopUUID:
+						if r.GrossRequired != 6 {
+							t.Errorf("hops: expected 6 gross, got %d", r.GrossRequired)
+						}
+					}
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/mrp/shortages", nil)
+			rec := httptest.NewRecorder()
+
+			handler.HandleMRPShortages(tt.store, tt.inv, tt.proc).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var resp dto.MRPResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandleMRPPurchaseOrders(t *testing.T) {
+	recipeUUID := "220e8400-e29b-41d4-a716-446655440000"
+	maltUUID := "110e8400-e29b-41d4-a716-446655440001"
+	hopUUID := "110e8400-e29b-41d4-a716-446655440002"
+
+	first := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 11, 15, 0, 0, 0, 0, time.UTC)
+	arrives := time.Date(2026, 11, 10, 0, 0, 0, 0, time.UTC)
+	planning := storage.ProcessPhasePlanning
+	brewing := "fermenting"
+
+	// Two planned batches of the same recipe and one already brewing.
+	b1 := storage.Batch{ShortName: "IPA-1", BrewDate: &first, RecipeUUID: &recipeUUID}
+	b1.UUID = uuid.Must(uuid.NewV4())
+	b2 := storage.Batch{ShortName: "IPA-2", BrewDate: &second, RecipeUUID: &recipeUUID, CurrentPhase: &planning}
+	b2.UUID = uuid.Must(uuid.NewV4())
+	b3 := storage.Batch{ShortName: "IPA-0", BrewDate: &first, RecipeUUID: &recipeUUID, CurrentPhase: &brewing}
+	b3.UUID = uuid.Must(uuid.NewV4())
+
+	// 100 kg of malt and 2 kg of hops per batch, plus a salt addition not
+	// linked to an ingredient.
+	malt := storage.RecipeIngredient{Name: "Pale Malt", IngredientUUID: uuidPtr(maltUUID), Amount: 100, AmountUnit: "kg", ScalingFactor: 1}
+	malt.UUID = uuid.Must(uuid.NewV4())
+	hop := storage.RecipeIngredient{Name: "Citra", IngredientUUID: uuidPtr(hopUUID), Amount: 2, AmountUnit: "kg", ScalingFactor: 1}
+	hop.UUID = uuid.Must(uuid.NewV4())
+	salt := storage.RecipeIngredient{Name: "Gypsum", Amount: 1, AmountUnit: "kg", ScalingFactor: 1}
+	salt.UUID = uuid.Must(uuid.NewV4())
+	ingredients := map[string][]storage.RecipeIngredient{recipeUUID: {malt, hop, salt}}
+
+	// 120 kg of malt on hand, 30 kg of it reserved for the first batch.
+	levels := []handler.IngredientLotStockLevel{
+		{IngredientLotUUID: "lot-1", IngredientUUID: maltUUID, IngredientName: "Pale Malt", StockLocationUUID: "loc-a", CurrentAmount: 120, CurrentUnit: "kg", AvailableAmount: 90},
+	}
+	reservations := []handler.BatchReservation{
+		{ProductionRefUUID: b1.UUID.String(), IngredientLotUUID: "lot-1", StockLocationUUID: "loc-a", OutstandingAmount: 30, AmountUnit: "kg"},
+	}
+	// 50 kg of malt on order between the brew dates, 20 kg already
+	// received. Hops have never been ordered.
+	receipts := []handler.IngredientLotReceipt{
+		{UUID: "lot-2", IngredientUUID: maltUUID, PurchaseOrderLineUUID: sp("pol-1"), ReceivedAmount: 20, ReceivedUnit: "kg"},
+	}
+	supply := handler.ItemSupply{
+		OpenLines: []handler.OpenPurchaseOrderLine{
+			{PurchaseOrderLineUUID: "pol-1", PurchaseOrderUUID: "po-1", OrderNumber: "20261001001", Status: "partially_received", SupplierUUID: "sup-1", InventoryItemUUID: maltUUID, Quantity: 50, QuantityUnit: "kg", ExpectedAt: &arrives},
+		},
+		Suppliers: []handler.ItemSupplier{
+			{InventoryItemUUID: maltUUID, SupplierUUID: "sup-1", SupplierName: "Malt Co", ItemName: "Pale Malt 25kg", QuantityUnit: "kg", UnitCostCents: 150, Currency: "USD"},
+		},
+	}
+
+	// sup-2 is the malt policy's preferred supplier and lists it in its
+	// catalog.
+	preferred := "sup-2"
+	policies := []handler.ReorderPolicy{{IngredientUUID: maltUUID, PreferredSupplierUUID: &preferred}}
+	cost, currency := int64(140), "USD"
+	supplyWithCatalog := supply
+	supplyWithCatalog.CatalogItems = []handler.ItemCatalogEntry{
+		{InventoryItemUUID: maltUUID, SupplierUUID: "sup-2", SupplierName: "Maltings Ltd", ItemName: "Maris Otter 25kg", PackUnit: "kg", UnitCostCents: &cost, Currency: &currency},
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		store          *mockMRPStore
+		inv            *mockMRPInventory
+		proc           *mockMRPProcurement
+		expectedStatus int
+		validate       func(t *testing.T, proc *mockMRPProcurement, resp dto.MRPPurchaseOrdersResponse)
+	}{
+		{
+			name:           "drafts the malt shortfall from the last supplier",
+			body:           `{}`,
+			store:          &mockMRPStore{batches: []storage.Batch{b2, b3, b1}, ingredients: ingredients},
+			inv:            &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts},
+			proc:           &mockMRPProcurement{supply: supply},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, proc *mockMRPProcurement, resp dto.MRPPurchaseOrdersResponse) {
+				if len(proc.drafts) != 1 {
+					t.Fatalf("expected 1 draft purchase order, got %d", len(proc.drafts))
+				}
+				draft := proc.drafts[0]
+				if draft.SupplierUUID != "sup-1" || len(draft.Lines) != 1 {
+					t.Fatalf("unexpected draft %+v", draft)
+				}
+				line := draft.Lines[0]
+				if *line.InventoryItemUUID != maltUUID || line.Quantity != 50 || line.UnitCostCents != 150 || line.ItemName != "Pale Malt 25kg" {
+					t.Errorf("unexpected draft line %+v", line)
+				}
+
+				if len(resp.PurchaseOrders) != 1 || resp.PurchaseOrders[0].OrderNumber == "" {
+					t.Errorf("expected 1 generated purchase order, got %+v", resp.PurchaseOrders)
+				}
+				if len(resp.Unassigned) != 1 || resp.Unassigned[0].IngredientUUID != hopUUID {
+					t.Errorf("expected hops unassigned, got %+v", resp.Unassigned)
+				}
+			},
+		},
+		{
+			name:           "preferred supplier",
+			body:           `{}`,
+			store:          &mockMRPStore{batches: []storage.Batch{b2, b3, b1}, ingredients: ingredients},
+			inv:            &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts, policies: policies},
+			proc:           &mockMRPProcurement{supply: supplyWithCatalog},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, proc *mockMRPProcurement, _ dto.MRPPurchaseOrdersResponse) {
+				if len(proc.drafts) != 1 {
+					t.Fatalf("expected 1 draft purchase order, got %d", len(proc.drafts))
+				}
+				draft := proc.drafts[0]
+				if draft.SupplierUUID != "sup-2" || len(draft.Lines) != 1 {
+					t.Fatalf("expected a draft for the preferred supplier, got %+v", draft)
+				}
+				if line := draft.Lines[0]; line.ItemName != "Maris Otter 25kg" || line.UnitCostCents != 140 || line.Currency != "USD" {
+					t.Errorf("expected the preferred supplier's catalog item, got %+v", line)
+				}
+			},
+		},
+		{
+			name:           "ingredient filter",
+			body:           `{"ingredient_uuids":["` + hopUUID + `"]}`,
+			store:          &mockMRPStore{batches: []storage.Batch{b2, b3, b1}, ingredients: ingredients},
+			inv:            &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts},
+			proc:           &mockMRPProcurement{supply: supply},
+			expectedStatus: http.StatusCreated,
+			validate: func(t *testing.T, proc *mockMRPProcurement, _ dto.MRPPurchaseOrdersResponse) {
+				if len(proc.drafts) != 0 {
+					t.Errorf("expected no drafts, got %d", len(proc.drafts))
+				}
+			},
+		},
+		{
+			name:           "invalid ingredient uuid",
+			body:           `{"ingredient_uuids":["nope"]}`,
+			store:          &mockMRPStore{batches: []storage.Batch{b2, b3, b1}, ingredients: ingredients},
+			inv:            &mockMRPInventory{levels: levels, reservations: reservations, receipts: receipts},
+			proc:           &mockMRPProcurement{supply: supply},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mrp/purchase-orders", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandleMRPPurchaseOrders(tt.store, tt.inv, tt.proc).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.MRPPurchaseOrdersResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, tt.proc, resp)
+		})
+	}
+}
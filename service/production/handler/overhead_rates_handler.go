@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// OverheadRateStore defines the storage methods needed by overhead rate handlers.
+type OverheadRateStore interface {
+	CreateOverheadRate(context.Context, storage.OverheadRate) (storage.OverheadRate, error)
+	ListOverheadRates(context.Context, bool) ([]storage.OverheadRate, error)
+	SetOverheadRateActive(context.Context, string, bool) (storage.OverheadRate, error)
+	DeleteOverheadRateByUUID(context.Context, string) error
+}
+
+// HandleOverheadRates handles [GET /overhead-rates] and [POST /overhead-rates].
+// Pass active=true to list only the rates applied to batch costs.
+func HandleOverheadRates(db OverheadRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			rates, err := db.ListOverheadRates(r.Context(), r.URL.Query().Get("active") == "true")
+			if err != nil {
+				service.InternalError(w, "error listing overhead rates", "error", err)
+				return
+			}
+
+			service.JSON(w, dto.NewOverheadRatesResponse(rates))
+		case http.MethodPost:
+			var req dto.CreateOverheadRateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			rate := storage.OverheadRate{
+				Name:      req.Name,
+				Basis:     req.Basis,
+				RateCents: req.RateCents,
+				IsActive:  true,
+			}
+			if req.IsActive != nil {
+				rate.IsActive = *req.IsActive
+			}
+
+			created, err := db.CreateOverheadRate(r.Context(), rate)
+			if errors.Is(err, storage.ErrDuplicateOverheadRate) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating overhead rate", "error", err)
+				return
+			}
+
+			slog.Info("overhead rate created", "overhead_rate_uuid", created.UUID, "name", created.Name)
+
+			service.JSONCreated(w, dto.NewOverheadRateResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandleOverheadRateByUUID handles [PATCH /overhead-rates/{uuid}] and
+// [DELETE /overhead-rates/{uuid}].
+func HandleOverheadRateByUUID(db OverheadRateStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rateUUID := r.PathValue("uuid")
+		if rateUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPatch:
+			var req dto.UpdateOverheadRateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			updated, err := db.SetOverheadRateActive(r.Context(), rateUUID, *req.IsActive)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "overhead rate not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error updating overhead rate", "error", err, "overhead_rate_uuid", rateUUID)
+				return
+			}
+
+			service.JSON(w, dto.NewOverheadRateResponse(updated))
+		case http.MethodDelete:
+			err := db.DeleteOverheadRateByUUID(r.Context(), rateUUID)
+			if errors.Is(err, service.ErrNotFound) {
+				http.Error(w, "overhead rate not found", http.StatusNotFound)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error deleting overhead rate", "error", err, "overhead_rate_uuid", rateUUID)
+				return
+			}
+
+			slog.Info("overhead rate deleted", "overhead_rate_uuid", rateUUID)
+
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// PackageFormatMaterialStore defines the storage methods needed by package
+// format bill of materials handlers.
+type PackageFormatMaterialStore interface {
+	GetPackageFormatByUUID(context.Context, string) (storage.PackageFormat, error)
+	CreatePackageFormatMaterial(context.Context, storage.PackageFormatMaterial) (storage.PackageFormatMaterial, error)
+	GetPackageFormatMaterialByUUID(context.Context, string) (storage.PackageFormatMaterial, error)
+	ListPackageFormatMaterials(context.Context, int64) ([]storage.PackageFormatMaterial, error)
+	DeletePackageFormatMaterial(context.Context, int64) error
+}
+
+// HandlePackageFormatMaterials handles [GET /package-formats/{uuid}/materials]
+// and [POST /package-formats/{uuid}/materials].
+func HandlePackageFormatMaterials(db PackageFormatMaterialStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		formatUUID := r.PathValue("uuid")
+		if formatUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		format, err := db.GetPackageFormatByUUID(r.Context(), formatUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "package format not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting package format", "error", err, "package_format_uuid", formatUUID)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			materials, err := db.ListPackageFormatMaterials(r.Context(), format.ID)
+			if err != nil {
+				service.InternalError(w, "error listing package format materials", "error", err, "package_format_uuid", formatUUID)
+				return
+			}
+
+			service.JSON(w, dto.NewPackageFormatMaterialsResponse(materials))
+		case http.MethodPost:
+			var req dto.CreatePackageFormatMaterialRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+			if err := req.Validate(); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			material := storage.PackageFormatMaterial{
+				PackageFormatID: format.ID,
+				IngredientUUID:  uuid.FromStringOrNil(req.IngredientUUID),
+				Name:            req.Name,
+				Quantity:        req.Quantity,
+				Unit:            req.Unit,
+			}
+
+			created, err := db.CreatePackageFormatMaterial(r.Context(), material)
+			if errors.Is(err, storage.ErrDuplicatePackageFormatMaterial) {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			} else if err != nil {
+				service.InternalError(w, "error creating package format material", "error", err, "package_format_uuid", formatUUID)
+				return
+			}
+
+			slog.Info("package format material created", "material_uuid", created.UUID, "package_format_uuid", formatUUID)
+
+			service.JSONCreated(w, dto.NewPackageFormatMaterialResponse(created))
+		default:
+			service.MethodNotAllowed(w)
+		}
+	}
+}
+
+// HandlePackageFormatMaterialByUUID handles [DELETE /package-format-materials/{uuid}].
+func HandlePackageFormatMaterialByUUID(db PackageFormatMaterialStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		materialUUID := r.PathValue("uuid")
+		if materialUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		material, err := db.GetPackageFormatMaterialByUUID(r.Context(), materialUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "package format material not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting package format material", "error", err, "material_uuid", materialUUID)
+			return
+		}
+
+		if err := db.DeletePackageFormatMaterial(r.Context(), material.ID); errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "package format material not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error deleting package format material", "error", err, "material_uuid", materialUUID)
+			return
+		}
+
+		slog.Info("package format material deleted", "material_uuid", materialUUID)
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,335 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// PackagingMaterialStore defines the storage methods needed to check and
+// deduct the packaging materials of a packaging run.
+type PackagingMaterialStore interface {
+	GetPackageFormatByUUID(context.Context, string) (storage.PackageFormat, error)
+	ListPackageFormatMaterials(context.Context, int64) ([]storage.PackageFormatMaterial, error)
+	GetPackagingRunByUUID(context.Context, string) (storage.PackagingRun, error)
+	ListPackagingRunLinesByRunID(context.Context, int64) ([]storage.PackagingRunLine, error)
+	CompletePackagingRun(context.Context, int64, time.Time, *string) error
+	RunInTx(context.Context, func(context.Context) error) error
+}
+
+// PackagingMaterialInventory abstracts the inter-service calls to the
+// Inventory service for packaging material stock and its deduction.
+type PackagingMaterialInventory interface {
+	GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error)
+	CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (*BatchUsageResponse, error)
+}
+
+// errPackagingMaterialShortage is returned when stock does not cover a
+// packaging run's bill of materials.
+var errPackagingMaterialShortage = errors.New("insufficient packaging materials")
+
+// HandlePackagingMaterialCheck handles [POST /packaging-runs/material-check].
+// It totals the bills of materials of the planned lines and proposes lot
+// picks for them, flagging shortages before a run starts.
+func HandlePackagingMaterialCheck(db PackagingMaterialStore, invClient PackagingMaterialInventory) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		var req dto.PackagingMaterialCheckRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+
+		formats := make([]formatQuantity, 0, len(req.Lines))
+		for _, line := range req.Lines {
+			format, ok := service.ResolveFK(ctx, w, line.PackageFormatUUID, "package format", db.GetPackageFormatByUUID)
+			if !ok {
+				return
+			}
+			formats = append(formats, formatQuantity{formatID: format.ID, quantity: line.Quantity})
+		}
+
+		requirements, err := packagingMaterialRequirements(ctx, db, formats)
+		if err != nil {
+			service.InternalError(w, "error listing package format materials", "error", err)
+			return
+		}
+
+		var levels []IngredientLotStockLevel
+		if len(requirements) > 0 {
+			levels, err = invClient.GetIngredientLotStockLevels(ctx)
+			if err != nil {
+				service.InternalError(w, "error fetching ingredient lot stock levels", "error", err)
+				return
+			}
+		}
+
+		service.JSON(w, planPackagingMaterials(requirements, levels, req.StockLocationUUID, time.Now().UTC()))
+	}
+}
+
+// HandleCompletePackagingRun handles [POST /packaging-runs/{uuid}/complete].
+// The run's packaging materials are deducted from inventory in one usage
+// record that references the batch, and the run is marked ended. Nothing is
+// deducted when stock does not cover every material.
+func HandleCompletePackagingRun(db PackagingMaterialStore, invClient PackagingMaterialInventory) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		runUUID := r.PathValue("uuid")
+		if runUUID == "" {
+			http.Error(w, "invalid uuid", http.StatusBadRequest)
+			return
+		}
+
+		var req dto.CompletePackagingRunRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+
+		run, err := db.GetPackagingRunByUUID(ctx, runUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "packaging run not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting packaging run", "error", err, "packaging_run_uuid", runUUID)
+			return
+		}
+		if run.MaterialUsageUUID != nil {
+			http.Error(w, storage.ErrPackagingRunCompleted.Error(), http.StatusConflict)
+			return
+		}
+
+		endedAt := time.Now().UTC()
+		if req.EndedAt != nil {
+			endedAt = *req.EndedAt
+		}
+		if endedAt.Before(run.StartedAt) {
+			http.Error(w, "ended_at must be after started_at", http.StatusBadRequest)
+			return
+		}
+
+		lines, err := db.ListPackagingRunLinesByRunID(ctx, run.ID)
+		if err != nil {
+			service.InternalError(w, "error listing packaging run lines", "error", err, "packaging_run_uuid", runUUID)
+			return
+		}
+
+		// Deducting the materials and recording the usage on the run share a
+		// transaction, so when Inventory is called in process a run completed
+		// concurrently does not leave a second deduction behind.
+		var plan dto.PackagingMaterialCheckResponse
+		var usageUUID *string
+		err = db.RunInTx(ctx, func(ctx context.Context) error {
+			var err error
+			plan, usageUUID, err = deductPackagingMaterials(ctx, db, invClient, run, lines, req.StockLocationUUID, endedAt, req.Notes)
+			if err != nil {
+				return err
+			}
+			return db.CompletePackagingRun(ctx, run.ID, endedAt, usageUUID)
+		})
+		var rejected *InventoryRejectedError
+		switch {
+		case errors.Is(err, errPackagingMaterialShortage), errors.Is(err, storage.ErrPackagingRunCompleted):
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		case errors.As(err, &rejected):
+			http.Error(w, rejected.Message, http.StatusConflict)
+			return
+		case err != nil:
+			service.InternalError(w, "error completing packaging run", "error", err, "packaging_run_uuid", runUUID)
+			return
+		}
+
+		run.EndedAt = &endedAt
+		run.MaterialUsageUUID = usageUUID
+
+		slog.Info("packaging run completed", "packaging_run_uuid", runUUID, "materials", len(plan.Lines))
+
+		service.JSON(w, dto.CompletePackagingRunResponse{
+			PackagingRun: dto.NewPackagingRunResponse(run, lines),
+			Materials:    plan.Lines,
+		})
+	}
+}
+
+// deductPackagingMaterials plans picks for a packaging run's bill of
+// materials and deducts them through the Inventory movement ledger with the
+// package reason. It returns the plan and the usage UUID, which is nil when
+// the run's formats have no materials. errPackagingMaterialShortage is
+// returned, and nothing deducted, when stock falls short.
+func deductPackagingMaterials(ctx context.Context, db PackagingMaterialStore, invClient PackagingMaterialInventory, run storage.PackagingRun, lines []storage.PackagingRunLine, locationUUID *string, usedAt time.Time, notes *string) (dto.PackagingMaterialCheckResponse, *string, error) {
+	formats := make([]formatQuantity, 0, len(lines))
+	for _, line := range lines {
+		formats = append(formats, formatQuantity{formatID: line.PackageFormatID, quantity: line.Quantity})
+	}
+
+	requirements, err := packagingMaterialRequirements(ctx, db, formats)
+	if err != nil {
+		return dto.PackagingMaterialCheckResponse{}, nil, fmt.Errorf("listing package format materials: %w", err)
+	}
+	if len(requirements) == 0 {
+		return planPackagingMaterials(nil, nil, nil, usedAt), nil, nil
+	}
+
+	levels, err := invClient.GetIngredientLotStockLevels(ctx)
+	if err != nil {
+		return dto.PackagingMaterialCheckResponse{}, nil, fmt.Errorf("fetching ingredient lot stock levels: %w", err)
+	}
+
+	plan := planPackagingMaterials(requirements, levels, locationUUID, usedAt)
+	if !plan.Complete {
+		short := make([]string, 0, len(plan.Lines))
+		for _, line := range plan.Lines {
+			if line.ShortfallAmount > 0 {
+				short = append(short, fmt.Sprintf("%s short %d %s", line.Name, line.ShortfallAmount, line.AmountUnit))
+			}
+		}
+		return plan, nil, fmt.Errorf("%w: %s", errPackagingMaterialShortage, strings.Join(short, ", "))
+	}
+
+	if notes == nil {
+		runNotes := "Packaging run " + run.UUID.String()
+		notes = &runNotes
+	}
+
+	picks := make([]BatchUsagePick, len(plan.Picks))
+	for i, p := range plan.Picks {
+		picks[i] = BatchUsagePick{
+			IngredientLotUUID: p.IngredientLotUUID,
+			StockLocationUUID: p.StockLocationUUID,
+			Amount:            p.Amount,
+			AmountUnit:        p.AmountUnit,
+		}
+	}
+
+	usage, err := invClient.CreateBatchUsage(ctx, BatchUsageRequest{
+		ProductionRefUUID: run.BatchUUID,
+		UsedAt:            usedAt.Format(time.RFC3339),
+		Reason:            "package",
+		Picks:             picks,
+		Notes:             notes,
+	})
+	if err != nil {
+		return plan, nil, err
+	}
+
+	return plan, &usage.UsageUUID, nil
+}
+
+// formatQuantity is a number of units of one package format.
+type formatQuantity struct {
+	formatID int64
+	quantity int
+}
+
+// materialRequirement is the total amount of one packaging material needed.
+type materialRequirement struct {
+	lotRequirement
+	name string
+}
+
+// packagingMaterialRequirements multiplies each format's bill of materials by
+// its unit count and totals the result per material and unit, in first-seen
+// order.
+func packagingMaterialRequirements(ctx context.Context, db PackagingMaterialStore, formats []formatQuantity) ([]materialRequirement, error) {
+	bomByFormat := make(map[int64][]storage.PackageFormatMaterial)
+	var requirements []materialRequirement
+	index := make(map[string]int)
+
+	for _, fq := range formats {
+		bom, ok := bomByFormat[fq.formatID]
+		if !ok {
+			var err error
+			bom, err = db.ListPackageFormatMaterials(ctx, fq.formatID)
+			if err != nil {
+				return nil, err
+			}
+			bomByFormat[fq.formatID] = bom
+		}
+
+		for _, material := range bom {
+			key := material.IngredientUUID.String() + "|" + material.Unit
+			i, seen := index[key]
+			if !seen {
+				i = len(requirements)
+				index[key] = i
+				requirements = append(requirements, materialRequirement{
+					lotRequirement: lotRequirement{
+						ingredientUUID: material.IngredientUUID.String(),
+						unit:           material.Unit,
+					},
+					name: material.Name,
+				})
+			}
+			requirements[i].amount += material.Quantity * int64(fq.quantity)
+		}
+	}
+
+	return requirements, nil
+}
+
+// planPackagingMaterials proposes picks for each material requirement from
+// the given stock levels with pickFEFO, the same way batch allocation picks
+// ingredients. When locationUUID is set only that location is used.
+func planPackagingMaterials(requirements []materialRequirement, levels []IngredientLotStockLevel, locationUUID *string, now time.Time) dto.PackagingMaterialCheckResponse {
+	lots := make([]lotRequirement, len(requirements))
+	for i, req := range requirements {
+		lots[i] = req.lotRequirement
+	}
+	allocations := pickFEFO(lots, levels, locationUUID, now)
+
+	resp := dto.PackagingMaterialCheckResponse{
+		Lines:    make([]dto.PackagingMaterialLine, 0, len(requirements)),
+		Picks:    make([]dto.AllocationPickInput, 0),
+		Complete: true,
+	}
+
+	for i, req := range requirements {
+		allocation := allocations[i]
+		resp.Lines = append(resp.Lines, dto.PackagingMaterialLine{
+			IngredientUUID:  req.ingredientUUID,
+			Name:            req.name,
+			RequiredAmount:  req.amount,
+			AmountUnit:      req.unit,
+			AllocatedAmount: req.amount - allocation.shortfall,
+			ShortfallAmount: allocation.shortfall,
+			Picks:           allocation.picks,
+		})
+		resp.Picks = append(resp.Picks, allocation.inputs...)
+		if allocation.shortfall > 0 {
+			resp.Complete = false
+		}
+	}
+
+	return resp
+}
@@ -0,0 +1,316 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockPackagingMaterialStore implements handler.PackagingMaterialStore for testing.
+type mockPackagingMaterialStore struct {
+	formats   map[string]storage.PackageFormat
+	materials map[int64][]storage.PackageFormatMaterial
+	run       storage.PackagingRun
+	lines     []storage.PackagingRunLine
+	completed *string
+	// completeErr makes CompletePackagingRun fail, as it does when the run
+	// was completed concurrently.
+	completeErr error
+	rolledBack  bool
+}
+
+func (m *mockPackagingMaterialStore) GetPackageFormatByUUID(_ context.Context, formatUUID string) (storage.PackageFormat, error) {
+	format, ok := m.formats[formatUUID]
+	if !ok {
+		return storage.PackageFormat{}, service.ErrNotFound
+	}
+	return format, nil
+}
+
+func (m *mockPackagingMaterialStore) ListPackageFormatMaterials(_ context.Context, formatID int64) ([]storage.PackageFormatMaterial, error) {
+	return m.materials[formatID], nil
+}
+
+func (m *mockPackagingMaterialStore) GetPackagingRunByUUID(_ context.Context, runUUID string) (storage.PackagingRun, error) {
+	if m.run.UUID.String() != runUUID {
+		return storage.PackagingRun{}, service.ErrNotFound
+	}
+	return m.run, nil
+}
+
+func (m *mockPackagingMaterialStore) ListPackagingRunLinesByRunID(_ context.Context, _ int64) ([]storage.PackagingRunLine, error) {
+	return m.lines, nil
+}
+
+func (m *mockPackagingMaterialStore) CompletePackagingRun(_ context.Context, _ int64, endedAt time.Time, usageUUID *string) error {
+	if m.completeErr != nil {
+		return m.completeErr
+	}
+	m.run.EndedAt = &endedAt
+	m.run.MaterialUsageUUID = usageUUID
+	m.completed = usageUUID
+	return nil
+}
+
+func (m *mockPackagingMaterialStore) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		m.rolledBack = true
+		return err
+	}
+	return nil
+}
+
+// mockPackagingMaterialInventory implements handler.PackagingMaterialInventory for testing.
+type mockPackagingMaterialInventory struct {
+	levels   []handler.IngredientLotStockLevel
+	usages   []handler.BatchUsageRequest
+	usageErr error
+}
+
+func (m *mockPackagingMaterialInventory) GetIngredientLotStockLevels(_ context.Context) ([]handler.IngredientLotStockLevel, error) {
+	return m.levels, nil
+}
+
+func (m *mockPackagingMaterialInventory) CreateBatchUsage(_ context.Context, req handler.BatchUsageRequest) (*handler.BatchUsageResponse, error) {
+	if m.usageErr != nil {
+		return nil, m.usageErr
+	}
+	m.usages = append(m.usages, req)
+	return &handler.BatchUsageResponse{UsageUUID: "usage-1"}, nil
+}
+
+func TestHandlePackagingMaterialCheck(t *testing.T) {
+	canUUID := "110e8400-e29b-41d4-a716-446655440010"
+	carrierUUID := "110e8400-e29b-41d4-a716-446655440011"
+	formatUUID := "330e8400-e29b-41d4-a716-446655440002"
+
+	// A 4-pack uses 4 cans and 1 carrier.
+	format := storage.PackageFormat{Name: "16oz 4-pack", Container: "can", VolumePerUnit: 1893, VolumePerUnitUnit: "ml"}
+	format.ID = 1
+	format.UUID = uuid.Must(uuid.FromString(formatUUID))
+	bom := []storage.PackageFormatMaterial{
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(canUUID), Name: "16oz can", Quantity: 4, Unit: "each"},
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(carrierUUID), Name: "4-pack carrier", Quantity: 1, Unit: "each"},
+	}
+
+	// Cans are stocked in two lots at loc-a, 30 expiring first and then 100;
+	// there are only 6 carriers.
+	soon := time.Now().UTC().Add(30 * 24 * time.Hour)
+	levels := []handler.IngredientLotStockLevel{
+		{IngredientLotUUID: "can-lot-2", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100, ReceivedAt: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 30, CurrentUnit: "each", AvailableAmount: 30, ReceivedAt: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), ExpiresAt: &soon},
+		{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 6, CurrentUnit: "each", AvailableAmount: 6},
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		inv      *mockPackagingMaterialInventory
+		validate func(t *testing.T, resp dto.PackagingMaterialCheckResponse)
+	}{
+		{
+			name: "picks expiring lots first and reports shortfalls",
+			body: `{"lines":[{"package_format_uuid":"` + formatUUID + `","quantity":10}]}`,
+			inv:  &mockPackagingMaterialInventory{levels: levels},
+			validate: func(t *testing.T, resp dto.PackagingMaterialCheckResponse) {
+				if resp.Complete {
+					t.Error("expected incomplete check with carriers short")
+				}
+				if len(resp.Lines) != 2 {
+					t.Fatalf("expected 2 material lines, got %d", len(resp.Lines))
+				}
+
+				cans := resp.Lines[0]
+				if cans.RequiredAmount != 40 || cans.ShortfallAmount != 0 || len(cans.Picks) != 2 {
+					t.Fatalf("cans: expected 40 required in 2 picks, got %+v", cans)
+				}
+				if cans.Picks[0].IngredientLotUUID != "can-lot-1" || cans.Picks[0].Amount != 30 || cans.Picks[1].Amount != 10 {
+					t.Errorf("cans: expected expiring lot first, got %+v", cans.Picks)
+				}
+
+				carriers := resp.Lines[1]
+				if carriers.RequiredAmount != 10 || carriers.AllocatedAmount != 6 || carriers.ShortfallAmount != 4 {
+					t.Errorf("carriers: expected 10/6/4, got %d/%d/%d", carriers.RequiredAmount, carriers.AllocatedAmount, carriers.ShortfallAmount)
+				}
+			},
+		},
+		{
+			name: "other location",
+			body: `{"lines":[{"package_format_uuid":"` + formatUUID + `","quantity":10}],"stock_location_uuid":"440e8400-e29b-41d4-a716-446655440000"}`,
+			inv:  &mockPackagingMaterialInventory{levels: levels},
+			validate: func(t *testing.T, resp dto.PackagingMaterialCheckResponse) {
+				if len(resp.Picks) != 0 || resp.Lines[0].ShortfallAmount != 40 {
+					t.Errorf("expected no picks from an empty location, got %+v", resp)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockPackagingMaterialStore{
+				formats:   map[string]storage.PackageFormat{formatUUID: format},
+				materials: map[int64][]storage.PackageFormatMaterial{1: bom},
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/packaging-runs/material-check", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandlePackagingMaterialCheck(store, tt.inv).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+			}
+			var resp dto.PackagingMaterialCheckResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
+
+func TestHandleCompletePackagingRun(t *testing.T) {
+	canUUID := "110e8400-e29b-41d4-a716-446655440010"
+	carrierUUID := "110e8400-e29b-41d4-a716-446655440011"
+	formatUUID := "330e8400-e29b-41d4-a716-446655440002"
+
+	// A 4-pack uses 4 cans and 1 carrier; the run packages 10 packs.
+	format := storage.PackageFormat{Name: "16oz 4-pack", Container: "can", VolumePerUnit: 1893, VolumePerUnitUnit: "ml"}
+	format.ID = 1
+	format.UUID = uuid.Must(uuid.FromString(formatUUID))
+	bom := []storage.PackageFormatMaterial{
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(canUUID), Name: "16oz can", Quantity: 4, Unit: "each"},
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(carrierUUID), Name: "4-pack carrier", Quantity: 1, Unit: "each"},
+	}
+	lines := []storage.PackagingRunLine{{PackageFormatID: 1, Quantity: 10}}
+
+	run := storage.PackagingRun{BatchUUID: "330e8400-e29b-41d4-a716-446655440000", StartedAt: time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)}
+	run.ID = 7
+	run.UUID = uuid.Must(uuid.NewV4())
+	endedAt, usageUUID := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC), "usage-0"
+	completedRun := run
+	completedRun.EndedAt = &endedAt
+	completedRun.MaterialUsageUUID = &usageUUID
+
+	// Cans come from two lots, the expiring one first.
+	soon := time.Now().UTC().Add(30 * 24 * time.Hour)
+	stocked := []handler.IngredientLotStockLevel{
+		{IngredientLotUUID: "can-lot-2", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100, ReceivedAt: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 30, CurrentUnit: "each", AvailableAmount: 30, ReceivedAt: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), ExpiresAt: &soon},
+		{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 10, CurrentUnit: "each", AvailableAmount: 10},
+	}
+	shortCarriers := []handler.IngredientLotStockLevel{
+		{IngredientLotUUID: "can-lot-2", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100},
+		{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 6, CurrentUnit: "each", AvailableAmount: 6},
+	}
+
+	tests := []struct {
+		name             string
+		body             string
+		run              storage.PackagingRun
+		completeErr      error
+		inv              *mockPackagingMaterialInventory
+		expectedStatus   int
+		expectedBody     string
+		expectedUsages   int
+		expectRolledBack bool
+		validate         func(t *testing.T, store *mockPackagingMaterialStore, inv *mockPackagingMaterialInventory, resp dto.CompletePackagingRunResponse)
+	}{
+		{
+			name:           "deducts materials",
+			body:           `{"ended_at":"2026-10-01T12:00:00Z"}`,
+			run:            run,
+			inv:            &mockPackagingMaterialInventory{levels: stocked},
+			expectedStatus: http.StatusOK,
+			expectedUsages: 1,
+			validate: func(t *testing.T, store *mockPackagingMaterialStore, inv *mockPackagingMaterialInventory, resp dto.CompletePackagingRunResponse) {
+				usage := inv.usages[0]
+				if usage.Reason != "package" || usage.ProductionRefUUID != run.BatchUUID || len(usage.Picks) != 3 {
+					t.Errorf("unexpected usage %+v", usage)
+				}
+				if store.completed == nil || *store.completed != "usage-1" {
+					t.Errorf("expected run completed with usage-1, got %v", store.completed)
+				}
+				if resp.PackagingRun.MaterialUsageUUID == nil || resp.PackagingRun.EndedAt == nil || len(resp.Materials) != 2 {
+					t.Errorf("unexpected response %+v", resp)
+				}
+			},
+		},
+		{
+			name:             "shortage",
+			body:             `{}`,
+			run:              run,
+			inv:              &mockPackagingMaterialInventory{levels: shortCarriers},
+			expectedStatus:   http.StatusConflict,
+			expectedBody:     "4-pack carrier short 4 each",
+			expectRolledBack: true,
+		},
+		{
+			name:           "already completed",
+			body:           `{}`,
+			run:            completedRun,
+			inv:            &mockPackagingMaterialInventory{levels: stocked},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:             "completed concurrently",
+			body:             `{}`,
+			run:              run,
+			completeErr:      storage.ErrPackagingRunCompleted,
+			inv:              &mockPackagingMaterialInventory{levels: stocked},
+			expectedStatus:   http.StatusConflict,
+			expectedUsages:   1,
+			expectRolledBack: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockPackagingMaterialStore{
+				formats:     map[string]storage.PackageFormat{formatUUID: format},
+				materials:   map[int64][]storage.PackageFormatMaterial{1: bom},
+				run:         tt.run,
+				lines:       lines,
+				completeErr: tt.completeErr,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/packaging-runs/"+run.UUID.String()+"/complete", strings.NewReader(tt.body))
+			req.SetPathValue("uuid", run.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleCompletePackagingRun(store, tt.inv).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedBody) {
+				t.Errorf("expected body to contain %q, got %q", tt.expectedBody, rec.Body.String())
+			}
+			// A failed completion rolls back the transaction, along with
+			// any deduction already made in it.
+			if len(tt.inv.usages) != tt.expectedUsages || store.rolledBack != tt.expectRolledBack {
+				t.Errorf("expected %d usages and rolled back %v, got %d and %v", tt.expectedUsages, tt.expectRolledBack, len(tt.inv.usages), store.rolledBack)
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.CompletePackagingRunResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, store, tt.inv, resp)
+		})
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
-	"strings"
 	"time"
 
 	"github.com/brewpipes/brewpipes/service"
@@ -27,11 +26,18 @@ type PackagingRunStore interface {
 	DeletePackagingRun(context.Context, int64) error
 	ListPackagingRunLinesByRunID(context.Context, int64) ([]storage.PackagingRunLine, error)
 	CloseOccupancy(context.Context, int64, time.Time) error
+	ListPackageFormatMaterials(context.Context, int64) ([]storage.PackageFormatMaterial, error)
+	CompletePackagingRun(context.Context, int64, time.Time, *string) error
+	RunInTx(context.Context, func(context.Context) error) error
 }
 
-// BeerLotCreator abstracts the inter-service call to the Inventory service for creating beer lots.
-type BeerLotCreator interface {
-	CreateBeerLot(ctx context.Context, authToken string, req BeerLotRequest) (*BeerLotResponse, error)
+// PackagingRunInventory abstracts the inter-service calls to the Inventory
+// service made when a packaging run is recorded: creating beer lots and
+// deducting packaging materials.
+type PackagingRunInventory interface {
+	CreateBeerLot(ctx context.Context, req BeerLotRequest) (*BeerLotResponse, error)
+	GetIngredientLotStockLevels(ctx context.Context) ([]IngredientLotStockLevel, error)
+	CreateBatchUsage(ctx context.Context, req BatchUsageRequest) (*BatchUsageResponse, error)
 }
 
 // BeerLotRequest is the request payload for creating a beer lot via the Inventory service.
@@ -65,7 +71,10 @@ var containerAbbreviation = map[string]string{
 }
 
 // HandlePackagingRuns handles [GET /packaging-runs] and [POST /packaging-runs].
-func HandlePackagingRuns(db PackagingRunStore, invClient BeerLotCreator) http.HandlerFunc {
+// A run recorded with ended_at is already complete, so its packaging materials
+// are deducted as well; a shortage is rejected with 409 as
+// [POST /packaging-runs/{uuid}/complete] rejects it, and no run is created.
+func HandlePackagingRuns(db PackagingRunStore, invClient PackagingRunInventory) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
@@ -159,36 +168,58 @@ func HandlePackagingRuns(db PackagingRunStore, invClient BeerLotCreator) http.Ha
 				})
 			}
 
-			// Create packaging run and lines atomically
-			created, createdLines, err := db.CreatePackagingRunWithLines(r.Context(), run, storageLines)
-			if err != nil {
-				service.InternalError(w, "error creating packaging run", "error", err)
-				return
-			}
-
 			// Close source occupancy if requested (default true)
 			closeSource := true
 			if req.CloseSource != nil {
 				closeSource = *req.CloseSource
 			}
-			if closeSource {
-				outAt := created.StartedAt
-				if err := db.CloseOccupancy(r.Context(), occupancy.ID, outAt); err != nil {
-					service.InternalError(w, "error closing source occupancy during packaging run",
-						"error", err,
-						"packaging_run_uuid", created.UUID,
-						"occupancy_id", occupancy.ID,
-					)
-					return
+
+			// Create the run, close its source and, for a run recorded as
+			// finished, deduct its packaging materials in one transaction,
+			// so a shortage leaves nothing behind.
+			var created storage.PackagingRun
+			var createdLines []storage.PackagingRunLine
+			err := db.RunInTx(r.Context(), func(ctx context.Context) error {
+				var err error
+				created, createdLines, err = db.CreatePackagingRunWithLines(ctx, run, storageLines)
+				if err != nil {
+					return fmt.Errorf("creating packaging run: %w", err)
+				}
+
+				if closeSource {
+					if err := db.CloseOccupancy(ctx, occupancy.ID, created.StartedAt); err != nil {
+						return fmt.Errorf("closing source occupancy %d: %w", occupancy.ID, err)
+					}
+				}
+
+				if created.EndedAt == nil || invClient == nil {
+					return nil
+				}
+				_, usageUUID, err := deductPackagingMaterials(ctx, db, invClient, created, createdLines, nil, *created.EndedAt, nil)
+				if err != nil || usageUUID == nil {
+					return err
 				}
+				if err := db.CompletePackagingRun(ctx, created.ID, *created.EndedAt, usageUUID); err != nil {
+					return err
+				}
+				created.MaterialUsageUUID = usageUUID
+				return nil
+			})
+			var rejected *InventoryRejectedError
+			switch {
+			case errors.Is(err, errPackagingMaterialShortage):
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			case errors.As(err, &rejected):
+				http.Error(w, rejected.Message, http.StatusConflict)
+				return
+			case err != nil:
+				service.InternalError(w, "error creating packaging run", "error", err, "batch_uuid", req.BatchUUID)
+				return
 			}
 
 			// Create beer lots in inventory if stock_location_uuid is provided
 			if req.StockLocationUUID != nil && invClient != nil {
-				// Extract auth token from the inbound request
-				authHeader := r.Header.Get("Authorization")
-				authToken := strings.TrimPrefix(authHeader, "Bearer ")
-
 				// Determine lot code prefix
 				lotCodePrefix := batch.ShortName
 				if req.LotCodePrefix != nil && *req.LotCodePrefix != "" {
@@ -217,7 +248,7 @@ func HandlePackagingRuns(db PackagingRunStore, invClient BeerLotCreator) http.Ha
 						StockLocationUUID:   *req.StockLocationUUID,
 					}
 
-					resp, err := invClient.CreateBeerLot(r.Context(), authToken, beerLotReq)
+					resp, err := invClient.CreateBeerLot(r.Context(), beerLotReq)
 					if err != nil {
 						slog.Error("error creating beer lot in inventory (best-effort)",
 							"error", err,
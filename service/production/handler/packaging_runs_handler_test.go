@@ -0,0 +1,215 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockPackagingRunStore implements handler.PackagingRunStore for testing
+// packaging run creation. A rolled-back transaction discards the run and the
+// closed occupancy.
+type mockPackagingRunStore struct {
+	*mockPackagingMaterialStore
+	batch     storage.Batch
+	occupancy storage.Occupancy
+	created   bool
+	closed    bool
+}
+
+func (m *mockPackagingRunStore) GetBatchByUUID(_ context.Context, batchUUID string) (storage.Batch, error) {
+	if m.batch.UUID.String() != batchUUID {
+		return storage.Batch{}, service.ErrNotFound
+	}
+	return m.batch, nil
+}
+
+func (m *mockPackagingRunStore) GetOccupancyByUUID(_ context.Context, occupancyUUID string) (storage.Occupancy, error) {
+	if m.occupancy.UUID.String() != occupancyUUID {
+		return storage.Occupancy{}, service.ErrNotFound
+	}
+	return m.occupancy, nil
+}
+
+func (m *mockPackagingRunStore) CreatePackagingRunWithLines(_ context.Context, run storage.PackagingRun, lines []storage.PackagingRunLine) (storage.PackagingRun, []storage.PackagingRunLine, error) {
+	run.ID = 7
+	run.UUID = uuid.Must(uuid.NewV4())
+	run.BatchUUID = m.batch.UUID.String()
+	m.run = run
+	m.lines = lines
+	m.created = true
+	return run, lines, nil
+}
+
+func (m *mockPackagingRunStore) ListPackagingRuns(context.Context) ([]storage.PackagingRun, error) {
+	return nil, nil
+}
+
+func (m *mockPackagingRunStore) ListPackagingRunsByBatchUUID(context.Context, string) ([]storage.PackagingRun, error) {
+	return nil, nil
+}
+
+func (m *mockPackagingRunStore) DeletePackagingRun(context.Context, int64) error {
+	return nil
+}
+
+func (m *mockPackagingRunStore) CloseOccupancy(context.Context, int64, time.Time) error {
+	m.closed = true
+	return nil
+}
+
+func (m *mockPackagingRunStore) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		m.created, m.closed, m.completed = false, false, nil
+		m.rolledBack = true
+		return err
+	}
+	return nil
+}
+
+// CreateBeerLot implements handler.PackagingRunInventory.
+func (m *mockPackagingMaterialInventory) CreateBeerLot(context.Context, handler.BeerLotRequest) (*handler.BeerLotResponse, error) {
+	return &handler.BeerLotResponse{UUID: uuid.Must(uuid.NewV4()).String()}, nil
+}
+
+func TestHandlePackagingRunsCreate(t *testing.T) {
+	batchUUID := "330e8400-e29b-41d4-a716-446655440000"
+	occupancyUUID := "330e8400-e29b-41d4-a716-446655440001"
+	formatUUID := "330e8400-e29b-41d4-a716-446655440002"
+	canUUID := "110e8400-e29b-41d4-a716-446655440010"
+	carrierUUID := "110e8400-e29b-41d4-a716-446655440011"
+
+	batch := storage.Batch{ShortName: "IPA 26-10"}
+	batch.ID = 1
+	batch.UUID = uuid.Must(uuid.FromString(batchUUID))
+	occupancy := storage.Occupancy{}
+	occupancy.ID = 2
+	occupancy.UUID = uuid.Must(uuid.FromString(occupancyUUID))
+
+	// A 4-pack uses 4 cans and 1 carrier; the runs below package 10 packs.
+	format := storage.PackageFormat{Name: "16oz 4-pack", Container: "can", VolumePerUnit: 1893, VolumePerUnitUnit: "ml"}
+	format.ID = 1
+	format.UUID = uuid.Must(uuid.FromString(formatUUID))
+	bom := []storage.PackageFormatMaterial{
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(canUUID), Name: "16oz can", Quantity: 4, Unit: "each"},
+		{PackageFormatID: 1, IngredientUUID: uuid.FromStringOrNil(carrierUUID), Name: "4-pack carrier", Quantity: 1, Unit: "each"},
+	}
+
+	endedBody := `{"batch_uuid":"` + batchUUID + `","occupancy_uuid":"` + occupancyUUID + `","started_at":"2026-10-01T08:00:00Z","ended_at":"2026-10-01T12:00:00Z","lines":[{"package_format_uuid":"` + formatUUID + `","quantity":10}]}`
+
+	tests := []struct {
+		name           string
+		body           string
+		inv            *mockPackagingMaterialInventory
+		expectedStatus int
+		expectedBody   string
+		expectUsage    bool
+		expectCreated  bool
+	}{
+		{
+			name: "run without ended_at is not deducted",
+			body: `{"batch_uuid":"` + batchUUID + `","occupancy_uuid":"` + occupancyUUID + `","lines":[{"package_format_uuid":"` + formatUUID + `","quantity":10}]}`,
+			inv: &mockPackagingMaterialInventory{
+				levels: []handler.IngredientLotStockLevel{
+					{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100},
+					{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 10, CurrentUnit: "each", AvailableAmount: 10},
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectCreated:  true,
+		},
+		{
+			name: "finished run deducts its materials",
+			body: endedBody,
+			inv: &mockPackagingMaterialInventory{
+				levels: []handler.IngredientLotStockLevel{
+					{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100},
+					{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 10, CurrentUnit: "each", AvailableAmount: 10},
+				},
+			},
+			expectedStatus: http.StatusCreated,
+			expectUsage:    true,
+			expectCreated:  true,
+		},
+		{
+			name: "finished run with a shortage returns 409",
+			body: endedBody,
+			inv: &mockPackagingMaterialInventory{
+				levels: []handler.IngredientLotStockLevel{
+					{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100},
+					{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 6, CurrentUnit: "each", AvailableAmount: 6},
+				},
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "4-pack carrier short 4 each",
+		},
+		{
+			name: "finished run rejected by inventory returns 409",
+			body: endedBody,
+			inv: &mockPackagingMaterialInventory{
+				levels: []handler.IngredientLotStockLevel{
+					{IngredientLotUUID: "can-lot-1", IngredientUUID: canUUID, StockLocationUUID: "loc-a", CurrentAmount: 100, CurrentUnit: "each", AvailableAmount: 100},
+					{IngredientLotUUID: "carrier-lot-1", IngredientUUID: carrierUUID, StockLocationUUID: "loc-a", CurrentAmount: 10, CurrentUnit: "each", AvailableAmount: 10},
+				},
+				usageErr: &handler.InventoryRejectedError{Message: "pick exceeds lot balance"},
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "pick exceeds lot balance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &mockPackagingRunStore{
+				mockPackagingMaterialStore: &mockPackagingMaterialStore{
+					formats:   map[string]storage.PackageFormat{formatUUID: format},
+					materials: map[int64][]storage.PackageFormatMaterial{1: bom},
+				},
+				batch:     batch,
+				occupancy: occupancy,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/packaging-runs", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+
+			handler.HandlePackagingRuns(store, tt.inv).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedBody) {
+				t.Errorf("expected body to contain %q, got %q", tt.expectedBody, rec.Body.String())
+			}
+			if store.created != tt.expectCreated || store.closed != tt.expectCreated {
+				t.Errorf("expected run created and source closed = %v, got %v and %v", tt.expectCreated, store.created, store.closed)
+			}
+			if tt.expectUsage != (store.completed != nil) {
+				t.Errorf("expected usage recorded on run = %v, got %v", tt.expectUsage, store.completed)
+			}
+			if !tt.expectUsage {
+				return
+			}
+
+			var resp dto.PackagingRunResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			if resp.MaterialUsageUUID == nil || *resp.MaterialUsageUUID != "usage-1" {
+				t.Errorf("expected material_usage_uuid usage-1, got %v", resp.MaterialUsageUUID)
+			}
+			if len(tt.inv.usages) != 1 || tt.inv.usages[0].Reason != "package" || len(tt.inv.usages[0].Picks) != 2 {
+				t.Errorf("unexpected usages %+v", tt.inv.usages)
+			}
+		})
+	}
+}
@@ -1,76 +1,187 @@
 package handler
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
+	"strings"
 	"time"
+
+	procurementapi "github.com/brewpipes/brewpipes/client/procurement"
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/brewpipes/brewpipes/service"
 )
 
+// ProcurementAPI is everything production calls on the Procurement service.
+// ProcurementClient implements it over HTTP.
+type ProcurementAPI interface {
+	BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error)
+	LookupItemSupply(ctx context.Context, itemUUIDs []string) (*ItemSupply, error)
+	CreateDraftPurchaseOrder(ctx context.Context, req DraftPurchaseOrderRequest) (*DraftPurchaseOrder, error)
+}
+
 // ProcurementClient handles inter-service communication with the Procurement service.
 type ProcurementClient struct {
-	baseURL    string
-	httpClient *http.Client
+	api *procurementapi.Client
 }
 
-// NewProcurementClient creates a new ProcurementClient with the given base URL.
-func NewProcurementClient(baseURL string) *ProcurementClient {
+// NewProcurementClient creates a new ProcurementClient with the given base URL. Requests are
+// authenticated with service tokens from tokens.
+func NewProcurementClient(baseURL string, tokens *service.ServiceTokenSource) *ProcurementClient {
 	return &ProcurementClient{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+		api: &procurementapi.Client{
+			Server: strings.TrimSuffix(baseURL, "/") + "/",
+			Client: &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: tokens.Transport(telemetry.Transport("procurement", nil)),
+			},
 		},
 	}
 }
 
 // PurchaseOrderLineCost holds the cost-relevant fields from a procurement PO line.
+// FeeAllocatedCents is the line's share of the order's fees (freight,
+// surcharges, duties) across its whole quantity. ExchangeRate converts the
+// line's currency into BaseCurrency and is nil when no rate is available.
 type PurchaseOrderLineCost struct {
-	UUID          string `json:"uuid"`
-	UnitCostCents int64  `json:"unit_cost_cents"`
-	Quantity      int64  `json:"quantity"`
-	QuantityUnit  string `json:"quantity_unit"`
-	Currency      string `json:"currency"`
+	UUID              string                     `json:"uuid"`
+	UnitCostCents     int64                      `json:"unit_cost_cents"`
+	Quantity          int64                      `json:"quantity"`
+	QuantityUnit      string                     `json:"quantity_unit"`
+	Currency          string                     `json:"currency"`
+	FeeAllocatedCents int64                      `json:"fee_allocated_cents"`
+	BaseCurrency      string                     `json:"base_currency"`
+	ExchangeRate      *PurchaseOrderExchangeRate `json:"exchange_rate"`
 }
 
-// batchLookupRequest is the request body for the procurement batch-lookup endpoint.
-type batchLookupRequest struct {
-	UUIDs []string `json:"uuids"`
+// PurchaseOrderExchangeRate is the rate applied to a PO line. Locked rates
+// were fixed when the order was received.
+type PurchaseOrderExchangeRate struct {
+	Rate   float64 `json:"rate"`
+	Locked bool    `json:"locked"`
 }
 
 // BatchLookupPOLines calls the Procurement service to look up multiple PO lines by UUID.
-// The authToken is passed through from the original request (JWT forwarding).
-func (c *ProcurementClient) BatchLookupPOLines(ctx context.Context, authToken string, uuids []string) ([]PurchaseOrderLineCost, error) {
-	body, err := json.Marshal(batchLookupRequest{UUIDs: uuids})
-	if err != nil {
-		return nil, fmt.Errorf("marshaling batch lookup request: %w", err)
+func (c *ProcurementClient) BatchLookupPOLines(ctx context.Context, uuids []string) ([]PurchaseOrderLineCost, error) {
+	var result []PurchaseOrderLineCost
+	resp, err := c.api.BatchLookupPurchaseOrderLines(ctx, procurementapi.BatchLookupPurchaseOrderLinesRequest{Uuids: uuids})
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
+	return result, nil
+}
 
-	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/purchase-order-lines/batch-lookup", bytes.NewReader(body))
-	if err != nil {
-		return nil, fmt.Errorf("creating batch lookup request: %w", err)
-	}
+// OpenPurchaseOrderLine is an order line for an inventory item that has not
+// been fully received or cancelled.
+type OpenPurchaseOrderLine struct {
+	PurchaseOrderLineUUID string     `json:"purchase_order_line_uuid"`
+	PurchaseOrderUUID     string     `json:"purchase_order_uuid"`
+	OrderNumber           string     `json:"order_number"`
+	Status                string     `json:"status"`
+	SupplierUUID          string     `json:"supplier_uuid"`
+	InventoryItemUUID     string     `json:"inventory_item_uuid"`
+	Quantity              int64      `json:"quantity"`
+	QuantityUnit          string     `json:"quantity_unit"`
+	ExpectedAt            *time.Time `json:"expected_at"`
+}
+
+// ItemSupplier is the supplier an inventory item was last ordered from.
+type ItemSupplier struct {
+	InventoryItemUUID string    `json:"inventory_item_uuid"`
+	SupplierUUID      string    `json:"supplier_uuid"`
+	SupplierName      string    `json:"supplier_name"`
+	ItemName          string    `json:"item_name"`
+	QuantityUnit      string    `json:"quantity_unit"`
+	UnitCostCents     int64     `json:"unit_cost_cents"`
+	Currency          string    `json:"currency"`
+	LastOrderedAt     time.Time `json:"last_ordered_at"`
+}
 
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", "Bearer "+authToken)
+// ItemCatalogEntry is a supplier catalog item linked to an inventory item,
+// with the price currently in effect if there is one.
+type ItemCatalogEntry struct {
+	InventoryItemUUID string  `json:"inventory_item_uuid"`
+	SupplierUUID      string  `json:"supplier_uuid"`
+	SupplierName      string  `json:"supplier_name"`
+	SupplierItemUUID  string  `json:"supplier_item_uuid"`
+	ItemName          string  `json:"item_name"`
+	PackUnit          string  `json:"pack_unit"`
+	LeadTimeDays      *int    `json:"lead_time_days"`
+	UnitCostCents     *int64  `json:"unit_cost_cents"`
+	Currency          *string `json:"currency"`
+}
 
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("calling procurement service: %w", err)
-	}
-	defer resp.Body.Close()
+// ItemSupply is the supply-lookup response from the Procurement service.
+type ItemSupply struct {
+	OpenLines    []OpenPurchaseOrderLine `json:"open_lines"`
+	Suppliers    []ItemSupplier          `json:"suppliers"`
+	CatalogItems []ItemCatalogEntry      `json:"catalog_items"`
+}
 
-	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("procurement service returned %d: %s", resp.StatusCode, string(respBody))
+// LookupItemSupply calls the Procurement service to get open order lines and
+// the last-used supplier for the given inventory items.
+func (c *ProcurementClient) LookupItemSupply(ctx context.Context, itemUUIDs []string) (*ItemSupply, error) {
+	var result ItemSupply
+	resp, err := c.api.LookupItemSupply(ctx, procurementapi.ItemSupplyLookupRequest{InventoryItemUuids: itemUUIDs})
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusOK, &result); err != nil {
+		return nil, err
 	}
+	return &result, nil
+}
 
-	var result []PurchaseOrderLineCost
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("decoding batch lookup response: %w", err)
+// DraftPurchaseOrderLine is a line on a draft purchase order sent to the
+// Procurement service.
+type DraftPurchaseOrderLine struct {
+	ItemType          string  `json:"item_type"`
+	ItemName          string  `json:"item_name"`
+	InventoryItemUUID *string `json:"inventory_item_uuid,omitempty"`
+	Quantity          int64   `json:"quantity"`
+	QuantityUnit      string  `json:"quantity_unit"`
+	UnitCostCents     int64   `json:"unit_cost_cents"`
+	Currency          string  `json:"currency"`
+}
+
+// DraftPurchaseOrderRequest is the payload for creating a draft purchase order
+// with its lines.
+type DraftPurchaseOrderRequest struct {
+	SupplierUUID string                   `json:"supplier_uuid"`
+	ExpectedAt   *time.Time               `json:"expected_at,omitempty"`
+	Notes        *string                  `json:"notes,omitempty"`
+	Lines        []DraftPurchaseOrderLine `json:"lines"`
+}
+
+// DraftPurchaseOrder holds the identifying fields of a created draft order.
+type DraftPurchaseOrder struct {
+	UUID         string `json:"uuid"`
+	SupplierUUID string `json:"supplier_uuid"`
+	OrderNumber  string `json:"order_number"`
+	Status       string `json:"status"`
+}
+
+// CreateDraftPurchaseOrder calls the Procurement service to create a draft
+// purchase order and its lines in one request.
+func (c *ProcurementClient) CreateDraftPurchaseOrder(ctx context.Context, req DraftPurchaseOrderRequest) (*DraftPurchaseOrder, error) {
+	body := procurementapi.CreateDraftPurchaseOrderRequest{
+		SupplierUuid: req.SupplierUUID,
+		ExpectedAt:   req.ExpectedAt,
+		Notes:        req.Notes,
+		Lines:        make([]procurementapi.CreateDraftPurchaseOrderLine, 0, len(req.Lines)),
+	}
+	for _, line := range req.Lines {
+		body.Lines = append(body.Lines, procurementapi.CreateDraftPurchaseOrderLine{
+			ItemType:          &line.ItemType,
+			ItemName:          line.ItemName,
+			InventoryItemUuid: line.InventoryItemUUID,
+			Quantity:          &line.Quantity,
+			QuantityUnit:      line.QuantityUnit,
+			UnitCostCents:     &line.UnitCostCents,
+			Currency:          &line.Currency,
+		})
 	}
 
-	return result, nil
+	var result DraftPurchaseOrder
+	resp, err := c.api.CreateDraftPurchaseOrder(ctx, body)
+	if err = service.DecodeResponse("procurement", resp, err, http.StatusCreated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
 }
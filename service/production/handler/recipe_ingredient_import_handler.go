@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// RecipeIngredientImportStore defines the storage methods needed to import
+// recipe bills.
+type RecipeIngredientImportStore interface {
+	ListRecipes(context.Context) ([]storage.Recipe, error)
+	CreateRecipeIngredient(context.Context, storage.RecipeIngredient) (storage.RecipeIngredient, error)
+	RunInTx(context.Context, func(context.Context) error) error
+}
+
+// recipeIngredientImport is a parsed bill line with the UUID of its recipe,
+// which the storage model does not carry.
+type recipeIngredientImport struct {
+	ingredient storage.RecipeIngredient
+	recipeUUID string
+}
+
+// HandleRecipeIngredientImport handles [POST /recipe-ingredients/import],
+// which loads recipe bills. The upload is a CSV file with recipe (a name or
+// UUID), name, ingredient_type, amount, amount_unit and use_stage columns,
+// and optional ingredient_uuid, use_type, timing_duration_minutes,
+// timing_temperature_c, alpha_acid_assumed, scaling_factor, sort_order and
+// notes columns. Rows are added to the existing recipes' bills. With
+// dry_run=true rows are created in a transaction that is rolled back.
+func HandleRecipeIngredientImport(db RecipeIngredientImportStore) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		dryRun, err := csvimport.ParseDryRun(r.URL.Query().Get("dry_run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		recipes, err := db.ListRecipes(r.Context())
+		if err != nil {
+			service.InternalError(w, "error listing recipes", "error", err)
+			return
+		}
+
+		importer := csvimport.Importer[recipeIngredientImport]{
+			Name: "recipe ingredient",
+			Headers: []string{
+				"recipe", "name", "ingredient_uuid", "ingredient_type", "amount", "amount_unit",
+				"use_stage", "use_type", "timing_duration_minutes", "timing_temperature_c",
+				"alpha_acid_assumed", "scaling_factor", "sort_order", "notes",
+			},
+			Required: []string{"recipe", "name", "ingredient_type", "amount", "amount_unit", "use_stage"},
+			Parse: func(_ context.Context, row csvimport.Row) (recipeIngredientImport, error) {
+				return parseRecipeIngredientImportRow(row, recipes)
+			},
+			Create: func(ctx context.Context, imp recipeIngredientImport) (any, error) {
+				created, err := db.CreateRecipeIngredient(ctx, imp.ingredient)
+				if err != nil {
+					return nil, err
+				}
+				return dto.NewRecipeIngredientResponse(created, imp.recipeUUID), nil
+			},
+			RunInTx: db.RunInTx,
+		}
+
+		file, ok := csvimport.Read(w, r, importer)
+		if !ok {
+			return
+		}
+
+		result, err := csvimport.Run(r.Context(), importer, file, dryRun)
+		if err != nil {
+			service.InternalError(w, "error importing recipe ingredients", "error", err)
+			return
+		}
+		service.JSON(w, result)
+	}
+}
+
+func parseRecipeIngredientImportRow(row csvimport.Row, recipes []storage.Recipe) (recipeIngredientImport, error) {
+	if err := row.Require("recipe", "name", "amount"); err != nil {
+		return recipeIngredientImport{}, err
+	}
+
+	recipe, err := csvimport.Lookup(recipes, row.Value("recipe"), "recipe", func(r storage.Recipe) (string, string) {
+		return r.UUID.String(), r.Name
+	})
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+
+	amount, err := row.Float("amount")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+	timingDuration, err := row.Int("timing_duration_minutes")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+	timingTemperature, err := row.Float("timing_temperature_c")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+	alphaAcid, err := row.Float("alpha_acid_assumed")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+	scalingFactor, err := row.Float("scaling_factor")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+	sortOrder, err := row.Int("sort_order")
+	if err != nil {
+		return recipeIngredientImport{}, err
+	}
+
+	req := dto.RecipeIngredientRequest{
+		Name:                  row.Value("name"),
+		IngredientUUID:        row.Optional("ingredient_uuid"),
+		IngredientType:        row.Value("ingredient_type"),
+		Amount:                *amount,
+		AmountUnit:            row.Value("amount_unit"),
+		UseStage:              row.Value("use_stage"),
+		UseType:               row.Optional("use_type"),
+		TimingDurationMinutes: timingDuration,
+		TimingTemperatureC:    timingTemperature,
+		AlphaAcidAssumed:      alphaAcid,
+		ScalingFactor:         scalingFactor,
+		SortOrder:             sortOrder,
+		Notes:                 row.Optional("notes"),
+	}
+	if err := req.Validate(); err != nil {
+		return recipeIngredientImport{}, err
+	}
+
+	var ingredientUUID *uuid.UUID
+	if req.IngredientUUID != nil {
+		parsed, err := uuid.FromString(*req.IngredientUUID)
+		if err != nil {
+			return recipeIngredientImport{}, fmt.Errorf("invalid ingredient_uuid")
+		}
+		ingredientUUID = &parsed
+	}
+
+	ri := storage.RecipeIngredient{
+		RecipeID:              recipe.ID,
+		Name:                  req.Name,
+		IngredientUUID:        ingredientUUID,
+		IngredientType:        req.IngredientType,
+		Amount:                req.Amount,
+		AmountUnit:            req.AmountUnit,
+		UseStage:              req.UseStage,
+		UseType:               req.UseType,
+		TimingDurationMinutes: req.TimingDurationMinutes,
+		TimingTemperatureC:    req.TimingTemperatureC,
+		AlphaAcidAssumed:      req.AlphaAcidAssumed,
+		ScalingFactor:         1.0,
+		Notes:                 req.Notes,
+	}
+	if req.ScalingFactor != nil {
+		ri.ScalingFactor = *req.ScalingFactor
+	}
+	if req.SortOrder != nil {
+		ri.SortOrder = *req.SortOrder
+	}
+
+	return recipeIngredientImport{ingredient: ri, recipeUUID: recipe.UUID.String()}, nil
+}
@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/internal/csvimport"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockRecipeIngredientImportStore implements
+// handler.RecipeIngredientImportStore for testing.
+type mockRecipeIngredientImportStore struct {
+	recipes []storage.Recipe
+	created []storage.RecipeIngredient
+}
+
+func (m *mockRecipeIngredientImportStore) ListRecipes(context.Context) ([]storage.Recipe, error) {
+	return m.recipes, nil
+}
+
+func (m *mockRecipeIngredientImportStore) RunInTx(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *mockRecipeIngredientImportStore) CreateRecipeIngredient(_ context.Context, ri storage.RecipeIngredient) (storage.RecipeIngredient, error) {
+	ri.UUID = uuid.Must(uuid.NewV4())
+	m.created = append(m.created, ri)
+	return ri, nil
+}
+
+func TestHandleRecipeIngredientImport(t *testing.T) {
+	ipa := storage.Recipe{Name: "West Coast IPA"}
+	ipa.ID, ipa.UUID = 1, uuid.Must(uuid.NewV4())
+	stout := storage.Recipe{Name: "Dry Stout"}
+	stout.ID, stout.UUID = 2, uuid.Must(uuid.NewV4())
+	twin := storage.Recipe{Name: "Dry Stout"}
+	twin.ID, twin.UUID = 3, uuid.Must(uuid.NewV4())
+	store := &mockRecipeIngredientImportStore{recipes: []storage.Recipe{ipa, stout, twin}}
+
+	csv := "recipe,name,ingredient_type,amount,amount_unit,use_stage,timing_duration_minutes,alpha_acid_assumed,sort_order\n" +
+		"west coast ipa,Pale Malt,fermentable,200,kg,mash,,,1\n" +
+		"West Coast IPA,Citra,hop,2.5,kg,boil,10,12.5,2\n" +
+		stout.UUID.String() + ",Roasted Barley,fermentable,20,kg,mash,,,\n" +
+		"Dry Stout,Fuggle,hop,1,kg,boil,60,,\n" +
+		"Hazy IPA,Mosaic,hop,1,kg,boil,,,\n" +
+		"West Coast IPA,Pale Malt,fermentable,200,kg,mash,,5,\n"
+
+	var buf bytes.Buffer
+	form := multipart.NewWriter(&buf)
+	part, err := form.CreateFormFile("file", "bills.csv")
+	if err != nil {
+		t.Fatalf("creating form file: %v", err)
+	}
+	part.Write([]byte(csv))
+	form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/recipe-ingredients/import", &buf)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	rec := httptest.NewRecorder()
+
+	handler.HandleRecipeIngredientImport(store).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp csvimport.Result
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	if resp.Totals.TotalRows != 6 || resp.Totals.Created != 3 || resp.Totals.Failed != 3 {
+		t.Errorf("unexpected totals %+v", resp.Totals)
+	}
+
+	expectedErrors := map[int]string{
+		5: `recipe "Dry Stout" is ambiguous`,
+		6: "recipe not found",
+		7: "alpha_acid_assumed can only be set for hop ingredients",
+	}
+	for _, result := range resp.Results {
+		if want, failed := expectedErrors[result.Row]; failed && (result.Error == nil || *result.Error != want) {
+			t.Errorf("row %d: expected error %q, got %+v", result.Row, want, result)
+		}
+	}
+
+	if len(store.created) != 3 {
+		t.Fatalf("expected 3 recipe ingredients, got %d", len(store.created))
+	}
+	if got := store.created[1]; got.RecipeID != ipa.ID || got.ScalingFactor != 1 || got.SortOrder != 2 || *got.TimingDurationMinutes != 10 {
+		t.Errorf("unexpected hop line %+v", got)
+	}
+	if got := store.created[2]; got.RecipeID != stout.ID {
+		t.Errorf("expected the stout matched by uuid, got recipe %d", got.RecipeID)
+	}
+}
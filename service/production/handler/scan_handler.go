@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// ScanStore defines the storage methods needed to resolve scanned codes.
+type ScanStore interface {
+	ListVesselsByName(context.Context, string) ([]storage.Vessel, error)
+	GetVesselByUUID(context.Context, string) (storage.Vessel, error)
+	ListActiveOccupancies(context.Context) ([]storage.Occupancy, error)
+	GetBatchByUUID(context.Context, string) (storage.Batch, error)
+	ListOccupanciesByBatchUUID(context.Context, string) ([]storage.Occupancy, error)
+}
+
+// ScanInventory abstracts the inter-service call to the Inventory service,
+// which resolves lot codes, beer lot item identifiers, keg serials and
+// inventory UUIDs.
+type ScanInventory interface {
+	ScanInventory(ctx context.Context, code string) ([]ScanMatch, error)
+}
+
+// HandleScan handles [GET /scan/{code}]. It resolves a scanned barcode or QR
+// value against vessel names and vessel and batch UUIDs here, and against
+// inventory lot codes, identifiers, serials and UUIDs in the Inventory
+// service. Every match is returned with the actions allowed in its current
+// state. A code that matches nothing is 404. If Inventory cannot be reached
+// the production matches are still returned, but a code with no production
+// match fails rather than report it as unknown.
+func HandleScan(db ScanStore, inventory ScanInventory) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		code := strings.TrimSpace(r.PathValue("code"))
+		if code == "" {
+			http.Error(w, "code is required", http.StatusBadRequest)
+			return
+		}
+
+		var vessels []storage.Vessel
+		var batches []storage.Batch
+		var err error
+		matchedOn := "name"
+		if _, parseErr := uuid.FromString(code); parseErr == nil {
+			matchedOn = "uuid"
+			vessels, batches, err = scanProductionUUID(r.Context(), db, code)
+		} else {
+			vessels, err = db.ListVesselsByName(r.Context(), code)
+		}
+		if err != nil {
+			service.InternalError(w, "error resolving scanned code", "error", err, "code", code)
+			return
+		}
+
+		matches := []dto.ScanMatchResponse{}
+		if len(vessels) > 0 {
+			occupancies, err := db.ListActiveOccupancies(r.Context())
+			if err != nil {
+				service.InternalError(w, "error listing active occupancies", "error", err)
+				return
+			}
+			for _, vessel := range vessels {
+				matches = append(matches, vesselScanMatch(vessel, occupancies, matchedOn))
+			}
+		}
+
+		for _, batch := range batches {
+			occupancies, err := db.ListOccupanciesByBatchUUID(r.Context(), batch.UUID.String())
+			if err != nil {
+				service.InternalError(w, "error listing batch occupancies", "error", err, "batch_uuid", batch.UUID.String())
+				return
+			}
+			matches = append(matches, batchScanMatch(batch, occupancies))
+		}
+
+		inventoryMatches, err := inventory.ScanInventory(r.Context(), code)
+		if err != nil {
+			if len(matches) == 0 {
+				service.InternalError(w, "error resolving scanned code in inventory", "error", err, "code", code)
+				return
+			}
+			slog.Warn("failed to resolve scanned code in inventory, returning production matches only", "code", code, "error", err)
+		}
+		for _, m := range inventoryMatches {
+			matches = append(matches, dto.ScanMatchResponse(m))
+		}
+
+		if len(matches) == 0 {
+			http.Error(w, "no match for scanned code", http.StatusNotFound)
+			return
+		}
+
+		service.JSON(w, dto.ScanResponse{Code: code, Matches: matches})
+	}
+}
+
+// scanProductionUUID resolves the UUID of a vessel or batch.
+func scanProductionUUID(ctx context.Context, db ScanStore, code string) ([]storage.Vessel, []storage.Batch, error) {
+	var vessels []storage.Vessel
+	if vessel, err := db.GetVesselByUUID(ctx, code); err == nil {
+		vessels = append(vessels, vessel)
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	var batches []storage.Batch
+	if batch, err := db.GetBatchByUUID(ctx, code); err == nil {
+		batches = append(batches, batch)
+	} else if !errors.Is(err, service.ErrNotFound) {
+		return nil, nil, err
+	}
+
+	return vessels, batches, nil
+}
+
+// vesselScanMatch describes a vessel by its current occupancy. An occupied
+// vessel offers the work done on the beer in it; an empty active vessel can
+// be filled; inactive and retired vessels only print their tank card.
+func vesselScanMatch(vessel storage.Vessel, activeOccupancies []storage.Occupancy, matchedOn string) dto.ScanMatchResponse {
+	state := vessel.Status
+	actions := []string{}
+
+	idx := slices.IndexFunc(activeOccupancies, func(o storage.Occupancy) bool { return o.VesselID == vessel.ID })
+	switch {
+	case idx >= 0:
+		state = "occupied"
+		actions = append(actions, "record_measurement", "add_addition", "transfer", "update_status")
+		if activeOccupancies[idx].BatchUUID != nil {
+			actions = append(actions, "package")
+		}
+		actions = append(actions, "empty")
+	case vessel.Status == storage.VesselStatusActive:
+		state = "empty"
+		actions = append(actions, "fill")
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityVessel,
+		UUID:       vessel.UUID.String(),
+		Name:       vessel.Name,
+		MatchedOn:  matchedOn,
+		State:      state,
+		Actions:    append(actions, "print_label"),
+	}
+}
+
+// batchScanMatch describes a batch by its current process phase. Finished
+// batches offer no actions; others can be packaged while in a vessel.
+func batchScanMatch(batch storage.Batch, occupancies []storage.Occupancy) dto.ScanMatchResponse {
+	state := storage.ProcessPhasePlanning
+	if batch.CurrentPhase != nil {
+		state = *batch.CurrentPhase
+	}
+
+	actions := []string{}
+	if state != storage.ProcessPhaseFinished {
+		actions = append(actions, "record_phase", "record_measurement", "add_addition", "record_labor")
+		if slices.ContainsFunc(occupancies, func(o storage.Occupancy) bool { return o.OutAt == nil }) {
+			actions = append(actions, "package")
+		}
+	}
+
+	return dto.ScanMatchResponse{
+		EntityType: dto.ScanEntityBatch,
+		UUID:       batch.UUID.String(),
+		Name:       batch.ShortName,
+		MatchedOn:  "uuid",
+		State:      state,
+		Actions:    actions,
+	}
+}
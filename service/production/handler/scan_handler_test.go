@@ -0,0 +1,197 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/handler/dto"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockScanStore implements handler.ScanStore for testing.
+type mockScanStore struct {
+	vessels     []storage.Vessel
+	occupancies []storage.Occupancy
+	batches     []storage.Batch
+}
+
+func (m *mockScanStore) ListVesselsByName(_ context.Context, name string) ([]storage.Vessel, error) {
+	var vessels []storage.Vessel
+	for _, v := range m.vessels {
+		if v.Name == name {
+			vessels = append(vessels, v)
+		}
+	}
+	return vessels, nil
+}
+
+func (m *mockScanStore) GetVesselByUUID(_ context.Context, vesselUUID string) (storage.Vessel, error) {
+	for _, v := range m.vessels {
+		if v.UUID.String() == vesselUUID {
+			return v, nil
+		}
+	}
+	return storage.Vessel{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) ListActiveOccupancies(context.Context) ([]storage.Occupancy, error) {
+	return m.occupancies, nil
+}
+
+func (m *mockScanStore) GetBatchByUUID(_ context.Context, batchUUID string) (storage.Batch, error) {
+	for _, b := range m.batches {
+		if b.UUID.String() == batchUUID {
+			return b, nil
+		}
+	}
+	return storage.Batch{}, service.ErrNotFound
+}
+
+func (m *mockScanStore) ListOccupanciesByBatchUUID(context.Context, string) ([]storage.Occupancy, error) {
+	return m.occupancies, nil
+}
+
+// mockScanInventory implements handler.ScanInventory for testing.
+type mockScanInventory struct {
+	matches []handler.ScanMatch
+	err     error
+}
+
+func (m *mockScanInventory) ScanInventory(context.Context, string) ([]handler.ScanMatch, error) {
+	return m.matches, m.err
+}
+
+func TestHandleScan(t *testing.T) {
+	// FV-3 holds a fermenting batch and BBT-1 is empty.
+	fv := storage.Vessel{Name: "FV-3", Status: storage.VesselStatusActive}
+	fv.ID, fv.UUID = 3, uuid.Must(uuid.NewV4())
+	bbt := storage.Vessel{Name: "BBT-1", Status: storage.VesselStatusActive}
+	bbt.ID, bbt.UUID = 4, uuid.Must(uuid.NewV4())
+
+	phase := storage.ProcessPhaseFermenting
+	batch := storage.Batch{ShortName: "24-IPA-07", CurrentPhase: &phase}
+	batch.UUID = uuid.Must(uuid.NewV4())
+	batchUUID := batch.UUID.String()
+
+	lotMatch := handler.ScanMatch{EntityType: "ingredient_lot", UUID: "lot-1", MatchedOn: "brewery_lot_code", State: "in_stock", Actions: []string{"record_usage"}}
+
+	tests := []struct {
+		name           string
+		code           string
+		store          *mockScanStore
+		inventory      *mockScanInventory
+		expectedStatus int
+		validate       func(t *testing.T, resp dto.ScanResponse)
+	}{
+		{
+			name:           "occupied vessel by name",
+			code:           "FV-3",
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}, occupancies: []storage.Occupancy{{VesselID: 3, BatchUUID: &batchUUID}}, batches: []storage.Batch{batch}},
+			inventory:      &mockScanInventory{},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.ScanResponse) {
+				if len(resp.Matches) != 1 {
+					t.Fatalf("expected 1 match, got %+v", resp.Matches)
+				}
+				got := resp.Matches[0]
+				want := []string{"record_measurement", "add_addition", "transfer", "update_status", "package", "empty", "print_label"}
+				if got.EntityType != dto.ScanEntityVessel || got.MatchedOn != "name" || got.State != "occupied" || !slices.Equal(got.Actions, want) {
+					t.Errorf("unexpected match %+v", got)
+				}
+			},
+		},
+		{
+			name:           "empty vessel by uuid",
+			code:           bbt.UUID.String(),
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}, occupancies: []storage.Occupancy{{VesselID: 3, BatchUUID: &batchUUID}}, batches: []storage.Batch{batch}},
+			inventory:      &mockScanInventory{},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.ScanResponse) {
+				if got := resp.Matches[0]; got.Name != "BBT-1" || got.MatchedOn != "uuid" || got.State != "empty" || !slices.Equal(got.Actions, []string{"fill", "print_label"}) {
+					t.Errorf("unexpected match %+v", got)
+				}
+			},
+		},
+		{
+			name:           "batch by uuid",
+			code:           batchUUID,
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}, occupancies: []storage.Occupancy{{VesselID: 3, BatchUUID: &batchUUID}}, batches: []storage.Batch{batch}},
+			inventory:      &mockScanInventory{},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.ScanResponse) {
+				if got := resp.Matches[0]; got.EntityType != dto.ScanEntityBatch || got.State != "fermenting" || !slices.Contains(got.Actions, "package") {
+					t.Errorf("unexpected match %+v", got)
+				}
+			},
+		},
+		{
+			name:           "includes inventory matches",
+			code:           "IL-2026-014",
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}},
+			inventory:      &mockScanInventory{matches: []handler.ScanMatch{lotMatch}},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.ScanResponse) {
+				if len(resp.Matches) != 1 || resp.Matches[0].EntityType != "ingredient_lot" || resp.Matches[0].UUID != "lot-1" {
+					t.Errorf("expected the inventory match, got %+v", resp.Matches)
+				}
+			},
+		},
+		{
+			name:           "no match",
+			code:           "unknown",
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}},
+			inventory:      &mockScanInventory{},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "inventory unavailable with a production match",
+			code:           "FV-3",
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}, occupancies: []storage.Occupancy{{VesselID: 3, BatchUUID: &batchUUID}}, batches: []storage.Batch{batch}},
+			inventory:      &mockScanInventory{err: errors.New("inventory unavailable")},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, resp dto.ScanResponse) {
+				if len(resp.Matches) != 1 {
+					t.Errorf("expected the vessel match, got %+v", resp.Matches)
+				}
+			},
+		},
+		{
+			name:           "inventory unavailable without a production match",
+			code:           "IL-2026-014",
+			store:          &mockScanStore{vessels: []storage.Vessel{fv, bbt}},
+			inventory:      &mockScanInventory{err: errors.New("inventory unavailable")},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/scan/"+tt.code, nil)
+			req.SetPathValue("code", tt.code)
+			rec := httptest.NewRecorder()
+
+			handler.HandleScan(tt.store, tt.inventory).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate == nil {
+				return
+			}
+
+			var resp dto.ScanResponse
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			tt.validate(t, resp)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+)
+
+// VesselLabelStore defines the storage methods needed to print vessel tank cards.
+type VesselLabelStore interface {
+	GetVesselByUUID(context.Context, string) (storage.Vessel, error)
+	ListActiveOccupancies(context.Context) ([]storage.Occupancy, error)
+	GetBatchByUUID(context.Context, string) (storage.Batch, error)
+	GetVolumeByUUID(context.Context, string) (storage.Volume, error)
+}
+
+// VesselLabelTemplates abstracts the inter-service call to the Inventory
+// service, which stores label templates.
+type VesselLabelTemplates interface {
+	ListLabelTemplates(ctx context.Context, subject string) ([]LabelTemplate, error)
+}
+
+// HandleVesselLabel handles [GET /vessels/{uuid}/label], a tank card showing
+// the vessel and the batch in its current occupancy, with a barcode of the
+// vessel name or UUID. Query parameters: format (pdf or zpl, default pdf),
+// copies (default 1) and template_uuid. Without template_uuid the default
+// vessel template is used; if Inventory cannot be reached the built-in
+// layout is used instead.
+func HandleVesselLabel(db VesselLabelStore, templates VesselLabelTemplates) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			service.MethodNotAllowed(w)
+			return
+		}
+
+		q := r.URL.Query()
+		format, err := label.ParseFormat(q.Get("format"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		copies, err := label.ParseCopies(q.Get("copies"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		vesselUUID := r.PathValue("uuid")
+		vessel, err := db.GetVesselByUUID(r.Context(), vesselUUID)
+		if errors.Is(err, service.ErrNotFound) {
+			http.Error(w, "vessel not found", http.StatusNotFound)
+			return
+		} else if err != nil {
+			service.InternalError(w, "error getting vessel", "error", err)
+			return
+		}
+
+		l := label.Label{
+			Code: vessel.Name,
+			UUID: vessel.UUID.String(),
+			Fields: map[string]label.Field{
+				"vessel_name": {Name: "Vessel", Value: vessel.Name},
+				"vessel_type": {Name: "Type", Value: vessel.Type},
+				"capacity":    {Name: "Capacity", Value: strconv.FormatInt(vessel.Capacity, 10) + " " + vessel.CapacityUnit},
+			},
+		}
+		if ok := addOccupancyLabelFields(w, r, db, vessel, l.Fields); !ok {
+			return
+		}
+
+		layout, ok := vesselLabelLayout(w, r, templates, q.Get("template_uuid"))
+		if !ok {
+			return
+		}
+
+		out, err := label.Render(format, layout, slices.Repeat([]label.Label{l}, copies))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", label.ContentType(format))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "vessel-"+vessel.UUID.String()+"."+format))
+		w.Write(out)
+	}
+}
+
+// addOccupancyLabelFields adds the batch and volume in the vessel's current
+// occupancy, if any, to fields.
+func addOccupancyLabelFields(w http.ResponseWriter, r *http.Request, db VesselLabelStore, vessel storage.Vessel, fields map[string]label.Field) bool {
+	occupancies, err := db.ListActiveOccupancies(r.Context())
+	if err != nil {
+		service.InternalError(w, "error listing active occupancies", "error", err)
+		return false
+	}
+	idx := slices.IndexFunc(occupancies, func(o storage.Occupancy) bool { return o.VesselID == vessel.ID })
+	if idx < 0 {
+		return true
+	}
+	occupancy := occupancies[idx]
+
+	fields["filled_date"] = label.Field{Name: "Filled", Value: occupancy.InAt.Format("2006-01-02")}
+
+	volume, err := db.GetVolumeByUUID(r.Context(), occupancy.VolumeUUID)
+	if err != nil {
+		service.InternalError(w, "error getting volume", "error", err)
+		return false
+	}
+	fields["volume"] = label.Field{Name: "Volume", Value: strconv.FormatInt(volume.Amount, 10) + " " + volume.AmountUnit}
+
+	if occupancy.BatchUUID == nil {
+		return true
+	}
+	batch, err := db.GetBatchByUUID(r.Context(), *occupancy.BatchUUID)
+	if err != nil {
+		service.InternalError(w, "error getting batch", "error", err)
+		return false
+	}
+	fields["batch_short_name"] = label.Field{Name: "Batch", Value: batch.ShortName}
+	if batch.RecipeName != nil {
+		fields["recipe_name"] = label.Field{Name: "Recipe", Value: *batch.RecipeName}
+	}
+	if batch.BrewDate != nil {
+		fields["brew_date"] = label.Field{Name: "Brewed", Value: batch.BrewDate.Format("2006-01-02")}
+	}
+	if batch.CurrentPhase != nil {
+		fields["phase"] = label.Field{Name: "Phase", Value: *batch.CurrentPhase}
+	}
+	return true
+}
+
+// vesselLabelLayout returns the requested vessel template, the default one,
+// or the built-in layout.
+func vesselLabelLayout(w http.ResponseWriter, r *http.Request, templates VesselLabelTemplates, templateUUID string) (label.Template, bool) {
+	available, err := templates.ListLabelTemplates(r.Context(), label.SubjectVessel)
+
+	if templateUUID != "" {
+		if err != nil {
+			service.InternalError(w, "error fetching label templates", "error", err)
+			return label.Template{}, false
+		}
+		idx := slices.IndexFunc(available, func(t LabelTemplate) bool { return t.UUID == templateUUID })
+		if idx < 0 {
+			http.Error(w, "label template not found", http.StatusBadRequest)
+			return label.Template{}, false
+		}
+		return available[idx].Template, true
+	}
+
+	if err != nil {
+		slog.Warn("failed to fetch vessel label templates, using the built-in layout", "error", err)
+		return label.DefaultTemplate(label.SubjectVessel), true
+	}
+	for _, t := range available {
+		if t.IsDefault {
+			return t.Template, true
+		}
+	}
+	return label.DefaultTemplate(label.SubjectVessel), true
+}
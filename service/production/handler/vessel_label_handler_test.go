@@ -0,0 +1,142 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/label"
+	"github.com/brewpipes/brewpipes/service/production/handler"
+	"github.com/brewpipes/brewpipes/service/production/storage"
+	"github.com/gofrs/uuid/v5"
+)
+
+// mockVesselLabelStore implements handler.VesselLabelStore for testing.
+type mockVesselLabelStore struct {
+	vessel      storage.Vessel
+	occupancies []storage.Occupancy
+	batch       storage.Batch
+}
+
+func (m *mockVesselLabelStore) GetVesselByUUID(context.Context, string) (storage.Vessel, error) {
+	return m.vessel, nil
+}
+
+func (m *mockVesselLabelStore) ListActiveOccupancies(context.Context) ([]storage.Occupancy, error) {
+	return m.occupancies, nil
+}
+
+func (m *mockVesselLabelStore) GetBatchByUUID(context.Context, string) (storage.Batch, error) {
+	return m.batch, nil
+}
+
+func (m *mockVesselLabelStore) GetVolumeByUUID(context.Context, string) (storage.Volume, error) {
+	return storage.Volume{Amount: 18, AmountUnit: "bbl"}, nil
+}
+
+// mockLabelTemplates implements handler.VesselLabelTemplates for testing.
+type mockLabelTemplates struct {
+	templates []handler.LabelTemplate
+	err       error
+}
+
+func (m *mockLabelTemplates) ListLabelTemplates(context.Context, string) ([]handler.LabelTemplate, error) {
+	return m.templates, m.err
+}
+
+func TestHandleVesselLabel(t *testing.T) {
+	// FV-3 holds batch 24-IPA-07 since 2026-03-02.
+	vessel := storage.Vessel{Name: "FV-3", Type: "fermenter", Capacity: 20, CapacityUnit: "bbl"}
+	vessel.ID = 3
+	vessel.UUID = uuid.Must(uuid.NewV4())
+
+	batchUUID := uuid.Must(uuid.NewV4()).String()
+	occupancy := storage.Occupancy{VesselID: 3, InAt: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), BatchUUID: &batchUUID}
+	phase, recipe := "fermenting", "West Coast IPA"
+	batch := storage.Batch{ShortName: "24-IPA-07", RecipeName: &recipe, CurrentPhase: &phase}
+
+	// A default vessel template with only the batch and a Code 128 barcode.
+	layout := label.DefaultTemplate(label.SubjectVessel)
+	layout.Fields = []string{"batch_short_name"}
+	layout.Barcode, layout.BarcodeContent = label.BarcodeCode128, label.BarcodeContentCode
+	batchOnly := handler.LabelTemplate{UUID: "t1", IsDefault: true, Template: layout}
+
+	tests := []struct {
+		name           string
+		query          string
+		store          *mockVesselLabelStore
+		templates      *mockLabelTemplates
+		expectedStatus int
+		validate       func(t *testing.T, rec *httptest.ResponseRecorder)
+	}{
+		{
+			name:           "tank card shows the current batch",
+			query:          "?format=zpl",
+			store:          &mockVesselLabelStore{vessel: vessel, occupancies: []storage.Occupancy{occupancy}, batch: batch},
+			templates:      &mockLabelTemplates{},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				zpl := rec.Body.String()
+				for _, want := range []string{"^FDFV-3^FS", "^FDBatch: 24-IPA-07^FS", "^FDPhase: fermenting^FS", "^FDVolume: 18 bbl^FS", "^FDFilled: 2026-03-02^FS"} {
+					if !strings.Contains(zpl, want) {
+						t.Errorf("expected %q in %s", want, zpl)
+					}
+				}
+				if !strings.Contains(zpl, "^FDMA,"+vessel.UUID.String()+"^FS") {
+					t.Errorf("expected a QR code of the vessel UUID, got %s", zpl)
+				}
+			},
+		},
+		{
+			name:           "uses the default vessel template",
+			query:          "?format=zpl",
+			store:          &mockVesselLabelStore{vessel: vessel, occupancies: []storage.Occupancy{occupancy}, batch: batch},
+			templates:      &mockLabelTemplates{templates: []handler.LabelTemplate{batchOnly}},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if zpl := rec.Body.String(); strings.Contains(zpl, "Vessel:") || !strings.Contains(zpl, "^BCN,") {
+					t.Errorf("expected only the batch with a Code 128 barcode, got %s", zpl)
+				}
+			},
+		},
+		{
+			name:           "empty vessel without inventory",
+			store:          &mockVesselLabelStore{vessel: vessel},
+			templates:      &mockLabelTemplates{err: errors.New("inventory unavailable")},
+			expectedStatus: http.StatusOK,
+			validate: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				if ct := rec.Header().Get("Content-Type"); ct != "application/pdf" {
+					t.Errorf("expected application/pdf, got %s", ct)
+				}
+			},
+		},
+		{
+			name:           "unknown template",
+			query:          "?template_uuid=missing",
+			store:          &mockVesselLabelStore{vessel: vessel, occupancies: []storage.Occupancy{occupancy}, batch: batch},
+			templates:      &mockLabelTemplates{},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/vessels/"+vessel.UUID.String()+"/label"+tt.query, nil)
+			req.SetPathValue("uuid", vessel.UUID.String())
+			rec := httptest.NewRecorder()
+
+			handler.HandleVesselLabel(tt.store, tt.templates).ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if tt.validate != nil {
+				tt.validate(t, rec)
+			}
+		})
+	}
+}
@@ -15,112 +15,167 @@ import (
 type Config struct {
 	PostgresDSN string
 	SecretKey   string
+	// ServiceSecret is the client secret the service presents to identity
+	// for the service tokens it calls other services with. It is not needed
+	// when Inventory and Procurement are both set.
+	ServiceSecret string
+	// Inventory and Procurement, when set, replace the HTTP clients for the
+	// Inventory and Procurement services, typically with in-process
+	// implementations when every service runs in one binary.
+	Inventory   handler.InventoryAPI
+	Procurement handler.ProcurementAPI
 }
 
 type Service struct {
 	storage           *storage.Client
 	secretKey         string
-	inventoryClient   *handler.InventoryClient
-	procurementClient *handler.ProcurementClient
+	serviceSecret     string
+	callsOverHTTP     bool
+	inventoryClient   handler.InventoryAPI
+	procurementClient handler.ProcurementAPI
 }
 
 // New creates and initializes a new production service instance.
 func New(cfg Config) *Service {
+	s := &Service{
+		storage:           storage.New(cfg.PostgresDSN),
+		secretKey:         cfg.SecretKey,
+		serviceSecret:     cfg.ServiceSecret,
+		inventoryClient:   cfg.Inventory,
+		procurementClient: cfg.Procurement,
+	}
+	if s.inventoryClient != nil && s.procurementClient != nil {
+		slog.Info("inventory and procurement called in process")
+		return s
+	}
+
 	// Initialize inter-service clients at construction time so they are
 	// available when HTTPRoutes() is called (which happens before Start()).
-	inventoryURL := os.Getenv("INVENTORY_API_URL")
-	if inventoryURL == "" {
-		inventoryURL = "http://localhost:8080/api"
+	s.callsOverHTTP = true
+	identityURL := os.Getenv("IDENTITY_API_URL")
+	if identityURL == "" {
+		identityURL = "http://localhost:8080/api"
 	}
-	slog.Info("inventory client configured", "inventory_api_url", inventoryURL)
+	slog.Info("identity client configured", "identity_api_url", identityURL)
+	tokens := service.NewServiceTokenSource(identityURL, "production", cfg.ServiceSecret)
 
-	procurementURL := os.Getenv("PROCUREMENT_API_URL")
-	if procurementURL == "" {
-		procurementURL = "http://localhost:8080/api"
+	if s.inventoryClient == nil {
+		inventoryURL := os.Getenv("INVENTORY_API_URL")
+		if inventoryURL == "" {
+			inventoryURL = "http://localhost:8080/api"
+		}
+		slog.Info("inventory client configured", "inventory_api_url", inventoryURL)
+		s.inventoryClient = handler.NewInventoryClient(inventoryURL, tokens)
 	}
-	slog.Info("procurement client configured", "procurement_api_url", procurementURL)
 
-	return &Service{
-		storage:           storage.New(cfg.PostgresDSN),
-		secretKey:         cfg.SecretKey,
-		inventoryClient:   handler.NewInventoryClient(inventoryURL),
-		procurementClient: handler.NewProcurementClient(procurementURL),
+	if s.procurementClient == nil {
+		procurementURL := os.Getenv("PROCUREMENT_API_URL")
+		if procurementURL == "" {
+			procurementURL = "http://localhost:8080/api"
+		}
+		slog.Info("procurement client configured", "procurement_api_url", procurementURL)
+		s.procurementClient = handler.NewProcurementClient(procurementURL, tokens)
 	}
+
+	return s
 }
 
 func (s *Service) HTTPRoutes() []service.HTTPRoute {
 	auth := service.RequireAccessToken(s.secretKey)
-	return []service.HTTPRoute{
-		{Method: http.MethodGet, Path: "/styles", Handler: auth(handler.HandleStyles(s.storage))},
-		{Method: http.MethodPost, Path: "/styles", Handler: auth(handler.HandleStyles(s.storage))},
-		{Method: http.MethodGet, Path: "/styles/{uuid}", Handler: auth(handler.HandleStyleByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/recipes", Handler: auth(handler.HandleRecipes(s.storage))},
-		{Method: http.MethodPost, Path: "/recipes", Handler: auth(handler.HandleRecipes(s.storage))},
-		{Method: http.MethodGet, Path: "/recipes/{uuid}", Handler: auth(handler.HandleRecipeByUUID(s.storage, s.storage))},
-		{Method: http.MethodPut, Path: "/recipes/{uuid}", Handler: auth(handler.HandleRecipeByUUID(s.storage, s.storage))},
-		{Method: http.MethodPatch, Path: "/recipes/{uuid}", Handler: auth(handler.HandleRecipeByUUID(s.storage, s.storage))},
-		{Method: http.MethodDelete, Path: "/recipes/{uuid}", Handler: auth(handler.HandleRecipeByUUID(s.storage, s.storage))},
-		{Method: http.MethodGet, Path: "/recipes/{uuid}/ingredients", Handler: auth(handler.HandleRecipeIngredients(s.storage, s.storage))},
-		{Method: http.MethodPost, Path: "/recipes/{uuid}/ingredients", Handler: auth(handler.HandleRecipeIngredients(s.storage, s.storage))},
-		{Method: http.MethodGet, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Handler: auth(handler.HandleRecipeIngredient(s.storage, s.storage))},
-		{Method: http.MethodPatch, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Handler: auth(handler.HandleRecipeIngredient(s.storage, s.storage))},
-		{Method: http.MethodDelete, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Handler: auth(handler.HandleRecipeIngredient(s.storage, s.storage))},
-		{Method: http.MethodGet, Path: "/batches", Handler: auth(handler.HandleBatches(s.storage))},
-		{Method: http.MethodPost, Path: "/batches", Handler: auth(handler.HandleBatches(s.storage))},
-		{Method: http.MethodPost, Path: "/batches/import", Handler: auth(handler.HandleBatchImport(s.storage))},
-		{Method: http.MethodGet, Path: "/batches/{uuid}", Handler: auth(handler.HandleBatchByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/batches/{uuid}", Handler: auth(handler.HandleBatchByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/batches/{uuid}", Handler: auth(handler.HandleBatchByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/batches/{uuid}/summary", Handler: auth(handler.HandleBatchSummaryByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/batches/{uuid}/costs", Handler: auth(handler.HandleBatchCosts(s.storage, s.inventoryClient, s.procurementClient))},
-		{Method: http.MethodGet, Path: "/brew-sessions", Handler: auth(handler.HandleBrewSessions(s.storage))},
-		{Method: http.MethodPost, Path: "/brew-sessions", Handler: auth(handler.HandleBrewSessions(s.storage))},
-		{Method: http.MethodGet, Path: "/brew-sessions/{uuid}", Handler: auth(handler.HandleBrewSessionByUUID(s.storage))},
-		{Method: http.MethodPut, Path: "/brew-sessions/{uuid}", Handler: auth(handler.HandleBrewSessionByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/volumes", Handler: auth(handler.HandleVolumes(s.storage))},
-		{Method: http.MethodPost, Path: "/volumes", Handler: auth(handler.HandleVolumes(s.storage))},
-		{Method: http.MethodGet, Path: "/volumes/{uuid}", Handler: auth(handler.HandleVolumeByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/volume-relations", Handler: auth(handler.HandleVolumeRelations(s.storage))},
-		{Method: http.MethodPost, Path: "/volume-relations", Handler: auth(handler.HandleVolumeRelations(s.storage))},
-		{Method: http.MethodGet, Path: "/volume-relations/{uuid}", Handler: auth(handler.HandleVolumeRelationByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/vessels", Handler: auth(handler.HandleVessels(s.storage))},
-		{Method: http.MethodPost, Path: "/vessels", Handler: auth(handler.HandleVessels(s.storage))},
-		{Method: http.MethodGet, Path: "/vessels/{uuid}", Handler: auth(handler.HandleVesselByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/vessels/{uuid}", Handler: auth(handler.HandleVesselByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/occupancies", Handler: auth(handler.HandleOccupancies(s.storage))},
-		{Method: http.MethodPost, Path: "/occupancies", Handler: auth(handler.HandleCreateOccupancy(s.storage))},
-		{Method: http.MethodGet, Path: "/occupancies/{uuid}", Handler: auth(handler.HandleOccupancyByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/occupancies/{uuid}/close", Handler: auth(handler.HandleCloseOccupancy(s.storage))},
-		{Method: http.MethodPatch, Path: "/occupancies/{uuid}/status", Handler: auth(handler.HandleOccupancyStatus(s.storage))},
-		{Method: http.MethodGet, Path: "/occupancies/active", Handler: auth(handler.HandleActiveOccupancy(s.storage))},
-		{Method: http.MethodGet, Path: "/transfers", Handler: auth(handler.HandleTransfers(s.storage))},
-		{Method: http.MethodPost, Path: "/transfers", Handler: auth(handler.HandleTransfers(s.storage))},
-		{Method: http.MethodGet, Path: "/transfers/{uuid}", Handler: auth(handler.HandleTransferByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-volumes", Handler: auth(handler.HandleBatchVolumes(s.storage))},
-		{Method: http.MethodPost, Path: "/batch-volumes", Handler: auth(handler.HandleBatchVolumes(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-volumes/{uuid}", Handler: auth(handler.HandleBatchVolumeByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-process-phases", Handler: auth(handler.HandleBatchProcessPhases(s.storage))},
-		{Method: http.MethodPost, Path: "/batch-process-phases", Handler: auth(handler.HandleBatchProcessPhases(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-process-phases/{uuid}", Handler: auth(handler.HandleBatchProcessPhaseByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-relations", Handler: auth(handler.HandleBatchRelations(s.storage))},
-		{Method: http.MethodPost, Path: "/batch-relations", Handler: auth(handler.HandleBatchRelations(s.storage))},
-		{Method: http.MethodGet, Path: "/batch-relations/{uuid}", Handler: auth(handler.HandleBatchRelationByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/additions", Handler: auth(handler.HandleAdditions(s.storage))},
-		{Method: http.MethodPost, Path: "/additions", Handler: auth(handler.HandleAdditions(s.storage))},
-		{Method: http.MethodGet, Path: "/additions/{uuid}", Handler: auth(handler.HandleAdditionByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/measurements", Handler: auth(handler.HandleMeasurements(s.storage))},
-		{Method: http.MethodPost, Path: "/measurements", Handler: auth(handler.HandleMeasurements(s.storage))},
-		{Method: http.MethodGet, Path: "/measurements/{uuid}", Handler: auth(handler.HandleMeasurementByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/package-formats", Handler: auth(handler.HandlePackageFormats(s.storage))},
-		{Method: http.MethodPost, Path: "/package-formats", Handler: auth(handler.HandlePackageFormats(s.storage))},
-		{Method: http.MethodGet, Path: "/package-formats/{uuid}", Handler: auth(handler.HandlePackageFormatByUUID(s.storage))},
-		{Method: http.MethodPatch, Path: "/package-formats/{uuid}", Handler: auth(handler.HandlePackageFormatByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/package-formats/{uuid}", Handler: auth(handler.HandlePackageFormatByUUID(s.storage))},
-		{Method: http.MethodGet, Path: "/packaging-runs", Handler: auth(handler.HandlePackagingRuns(s.storage, s.inventoryClient))},
-		{Method: http.MethodPost, Path: "/packaging-runs", Handler: auth(handler.HandlePackagingRuns(s.storage, s.inventoryClient))},
-		{Method: http.MethodGet, Path: "/packaging-runs/{uuid}", Handler: auth(handler.HandlePackagingRunByUUID(s.storage))},
-		{Method: http.MethodDelete, Path: "/packaging-runs/{uuid}", Handler: auth(handler.HandlePackagingRunByUUID(s.storage))},
-	}
+	batchCosts := handler.NewBatchCostCalculator(s.storage, s.inventoryClient, s.procurementClient)
+	return handler.API.Routes([]service.HTTPRoute{
+		{Method: http.MethodGet, Path: "/styles", Auth: auth, Handler: handler.HandleStyles(s.storage)},
+		{Method: http.MethodPost, Path: "/styles", Auth: auth, Handler: handler.HandleStyles(s.storage)},
+		{Method: http.MethodGet, Path: "/styles/{uuid}", Auth: auth, Handler: handler.HandleStyleByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/recipes", Auth: auth, Handler: handler.HandleRecipes(s.storage)},
+		{Method: http.MethodPost, Path: "/recipes", Auth: auth, Handler: handler.HandleRecipes(s.storage)},
+		{Method: http.MethodGet, Path: "/recipes/{uuid}", Auth: auth, Handler: handler.HandleRecipeByUUID(s.storage, s.storage)},
+		{Method: http.MethodPut, Path: "/recipes/{uuid}", Auth: auth, Handler: handler.HandleRecipeByUUID(s.storage, s.storage)},
+		{Method: http.MethodPatch, Path: "/recipes/{uuid}", Auth: auth, Handler: handler.HandleRecipeByUUID(s.storage, s.storage)},
+		{Method: http.MethodDelete, Path: "/recipes/{uuid}", Auth: auth, Handler: handler.HandleRecipeByUUID(s.storage, s.storage)},
+		{Method: http.MethodPost, Path: "/recipe-ingredients/import", Auth: auth, Handler: handler.HandleRecipeIngredientImport(s.storage)},
+		{Method: http.MethodGet, Path: "/recipes/{uuid}/ingredients", Auth: auth, Handler: handler.HandleRecipeIngredients(s.storage, s.storage)},
+		{Method: http.MethodPost, Path: "/recipes/{uuid}/ingredients", Auth: auth, Handler: handler.HandleRecipeIngredients(s.storage, s.storage)},
+		{Method: http.MethodGet, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Auth: auth, Handler: handler.HandleRecipeIngredient(s.storage, s.storage)},
+		{Method: http.MethodPatch, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Auth: auth, Handler: handler.HandleRecipeIngredient(s.storage, s.storage)},
+		{Method: http.MethodDelete, Path: "/recipes/{uuid}/ingredients/{ingredient_uuid}", Auth: auth, Handler: handler.HandleRecipeIngredient(s.storage, s.storage)},
+		{Method: http.MethodGet, Path: "/batches", Auth: auth, Handler: handler.HandleBatches(s.storage)},
+		{Method: http.MethodPost, Path: "/batches", Auth: auth, Handler: handler.HandleBatches(s.storage)},
+		{Method: http.MethodPost, Path: "/batches/import", Auth: auth, Handler: handler.HandleBatchImport(s.storage)},
+		{Method: http.MethodGet, Path: "/batches/{uuid}", Auth: auth, Handler: handler.HandleBatchByUUID(s.storage, s.inventoryClient)},
+		{Method: http.MethodPatch, Path: "/batches/{uuid}", Auth: auth, Handler: handler.HandleBatchByUUID(s.storage, s.inventoryClient)},
+		{Method: http.MethodDelete, Path: "/batches/{uuid}", Auth: auth, Handler: handler.HandleBatchByUUID(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/batches/{uuid}/summary", Auth: auth, Handler: handler.HandleBatchSummaryByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/batches/{uuid}/costs", Auth: auth, Handler: handler.HandleBatchCosts(s.storage, s.inventoryClient, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/batches/{uuid}/costs/snapshot", Auth: auth, Handler: handler.HandleBatchCostSnapshot(s.storage, s.inventoryClient, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/batches/{uuid}/labor", Auth: auth, Handler: handler.HandleBatchLabor(s.storage)},
+		{Method: http.MethodPost, Path: "/batches/{uuid}/labor", Auth: auth, Handler: handler.HandleBatchLabor(s.storage)},
+		{Method: http.MethodDelete, Path: "/batch-labor/{uuid}", Auth: auth, Handler: handler.HandleBatchLaborByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/overhead-rates", Auth: auth, Handler: handler.HandleOverheadRates(s.storage)},
+		{Method: http.MethodPost, Path: "/overhead-rates", Auth: auth, Handler: handler.HandleOverheadRates(s.storage)},
+		{Method: http.MethodPatch, Path: "/overhead-rates/{uuid}", Auth: auth, Handler: handler.HandleOverheadRateByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/overhead-rates/{uuid}", Auth: auth, Handler: handler.HandleOverheadRateByUUID(s.storage)},
+		{Method: http.MethodPost, Path: "/batches/{uuid}/allocation", Auth: auth, Handler: handler.HandleBatchAllocation(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/batches/{uuid}/allocation/confirm", Auth: auth, Handler: handler.HandleConfirmBatchAllocation(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/mrp/shortages", Auth: auth, Handler: handler.HandleMRPShortages(s.storage, s.inventoryClient, s.procurementClient)},
+		{Method: http.MethodPost, Path: "/mrp/purchase-orders", Auth: auth, Handler: handler.HandleMRPPurchaseOrders(s.storage, s.inventoryClient, s.procurementClient)},
+		{Method: http.MethodGet, Path: "/brew-sessions", Auth: auth, Handler: handler.HandleBrewSessions(s.storage)},
+		{Method: http.MethodPost, Path: "/brew-sessions", Auth: auth, Handler: handler.HandleBrewSessions(s.storage)},
+		{Method: http.MethodGet, Path: "/brew-sessions/{uuid}", Auth: auth, Handler: handler.HandleBrewSessionByUUID(s.storage)},
+		{Method: http.MethodPut, Path: "/brew-sessions/{uuid}", Auth: auth, Handler: handler.HandleBrewSessionByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/volumes", Auth: auth, Handler: handler.HandleVolumes(s.storage)},
+		{Method: http.MethodPost, Path: "/volumes", Auth: auth, Handler: handler.HandleVolumes(s.storage)},
+		{Method: http.MethodGet, Path: "/volumes/{uuid}", Auth: auth, Handler: handler.HandleVolumeByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/volume-relations", Auth: auth, Handler: handler.HandleVolumeRelations(s.storage)},
+		{Method: http.MethodPost, Path: "/volume-relations", Auth: auth, Handler: handler.HandleVolumeRelations(s.storage)},
+		{Method: http.MethodGet, Path: "/volume-relations/{uuid}", Auth: auth, Handler: handler.HandleVolumeRelationByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/vessels", Auth: auth, Handler: handler.HandleVessels(s.storage)},
+		{Method: http.MethodPost, Path: "/vessels", Auth: auth, Handler: handler.HandleVessels(s.storage)},
+		{Method: http.MethodGet, Path: "/vessels/{uuid}", Auth: auth, Handler: handler.HandleVesselByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/vessels/{uuid}", Auth: auth, Handler: handler.HandleVesselByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/vessels/{uuid}/label", Auth: auth, Handler: handler.HandleVesselLabel(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/scan/{code}", Auth: auth, Handler: handler.HandleScan(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/occupancies", Auth: auth, Handler: handler.HandleOccupancies(s.storage)},
+		{Method: http.MethodPost, Path: "/occupancies", Auth: auth, Handler: handler.HandleCreateOccupancy(s.storage)},
+		{Method: http.MethodGet, Path: "/occupancies/{uuid}", Auth: auth, Handler: handler.HandleOccupancyByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/occupancies/{uuid}/close", Auth: auth, Handler: handler.HandleCloseOccupancy(s.storage)},
+		{Method: http.MethodPatch, Path: "/occupancies/{uuid}/status", Auth: auth, Handler: handler.HandleOccupancyStatus(s.storage)},
+		{Method: http.MethodGet, Path: "/occupancies/active", Auth: auth, Handler: handler.HandleActiveOccupancy(s.storage)},
+		{Method: http.MethodGet, Path: "/transfers", Auth: auth, Handler: handler.HandleTransfers(s.storage)},
+		{Method: http.MethodPost, Path: "/transfers", Auth: auth, Handler: handler.HandleTransfers(s.storage)},
+		{Method: http.MethodGet, Path: "/transfers/{uuid}", Auth: auth, Handler: handler.HandleTransferByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/batch-volumes", Auth: auth, Handler: handler.HandleBatchVolumes(s.storage)},
+		{Method: http.MethodPost, Path: "/batch-volumes", Auth: auth, Handler: handler.HandleBatchVolumes(s.storage)},
+		{Method: http.MethodGet, Path: "/batch-volumes/{uuid}", Auth: auth, Handler: handler.HandleBatchVolumeByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/batch-process-phases", Auth: auth, Handler: handler.HandleBatchProcessPhases(s.storage, batchCosts)},
+		{Method: http.MethodPost, Path: "/batch-process-phases", Auth: auth, Handler: handler.HandleBatchProcessPhases(s.storage, batchCosts)},
+		{Method: http.MethodGet, Path: "/batch-process-phases/{uuid}", Auth: auth, Handler: handler.HandleBatchProcessPhaseByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/batch-relations", Auth: auth, Handler: handler.HandleBatchRelations(s.storage)},
+		{Method: http.MethodPost, Path: "/batch-relations", Auth: auth, Handler: handler.HandleBatchRelations(s.storage)},
+		{Method: http.MethodGet, Path: "/batch-relations/{uuid}", Auth: auth, Handler: handler.HandleBatchRelationByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/additions", Auth: auth, Handler: handler.HandleAdditions(s.storage)},
+		{Method: http.MethodPost, Path: "/additions", Auth: auth, Handler: handler.HandleAdditions(s.storage)},
+		{Method: http.MethodGet, Path: "/additions/{uuid}", Auth: auth, Handler: handler.HandleAdditionByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/measurements", Auth: auth, Handler: handler.HandleMeasurements(s.storage)},
+		{Method: http.MethodPost, Path: "/measurements", Auth: auth, Handler: handler.HandleMeasurements(s.storage)},
+		{Method: http.MethodGet, Path: "/measurements/{uuid}", Auth: auth, Handler: handler.HandleMeasurementByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/package-formats", Auth: auth, Handler: handler.HandlePackageFormats(s.storage)},
+		{Method: http.MethodPost, Path: "/package-formats", Auth: auth, Handler: handler.HandlePackageFormats(s.storage)},
+		{Method: http.MethodGet, Path: "/package-formats/{uuid}", Auth: auth, Handler: handler.HandlePackageFormatByUUID(s.storage)},
+		{Method: http.MethodPatch, Path: "/package-formats/{uuid}", Auth: auth, Handler: handler.HandlePackageFormatByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/package-formats/{uuid}", Auth: auth, Handler: handler.HandlePackageFormatByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/package-formats/{uuid}/materials", Auth: auth, Handler: handler.HandlePackageFormatMaterials(s.storage)},
+		{Method: http.MethodPost, Path: "/package-formats/{uuid}/materials", Auth: auth, Handler: handler.HandlePackageFormatMaterials(s.storage)},
+		{Method: http.MethodDelete, Path: "/package-format-materials/{uuid}", Auth: auth, Handler: handler.HandlePackageFormatMaterialByUUID(s.storage)},
+		{Method: http.MethodGet, Path: "/packaging-runs", Auth: auth, Handler: handler.HandlePackagingRuns(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/packaging-runs", Auth: auth, Handler: handler.HandlePackagingRuns(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/packaging-runs/material-check", Auth: auth, Handler: handler.HandlePackagingMaterialCheck(s.storage, s.inventoryClient)},
+		{Method: http.MethodGet, Path: "/packaging-runs/{uuid}", Auth: auth, Handler: handler.HandlePackagingRunByUUID(s.storage)},
+		{Method: http.MethodDelete, Path: "/packaging-runs/{uuid}", Auth: auth, Handler: handler.HandlePackagingRunByUUID(s.storage)},
+		{Method: http.MethodPost, Path: "/packaging-runs/{uuid}/complete", Auth: auth, Handler: handler.HandleCompletePackagingRun(s.storage, s.inventoryClient)},
+		{Method: http.MethodPost, Path: "/migrations/preview", Auth: auth, Handler: handler.HandleMigrationPreview()},
+		{Method: http.MethodPost, Path: "/migrations/production", Auth: auth, Handler: handler.HandleProductionMigration(s.storage, s.inventoryClient)},
+	})
 }
 
 func (s *Service) Start(ctx context.Context) error {
@@ -128,6 +183,9 @@ func (s *Service) Start(ctx context.Context) error {
 	if s.secretKey == "" {
 		return fmt.Errorf("missing BREWPIPES_SECRET_KEY for access token verification")
 	}
+	if s.callsOverHTTP && s.serviceSecret == "" {
+		return fmt.Errorf("missing BREWPIPES_SERVICE_SECRET for calls to other services")
+	}
 	if err := s.storage.Start(ctx); err != nil {
 		return fmt.Errorf("starting storage: %w", err)
 	}
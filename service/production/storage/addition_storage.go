@@ -38,7 +38,7 @@ func (c *Client) CreateAddition(ctx context.Context, addition Addition) (Additio
 	}
 
 	var inventoryLotUUID pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO addition (
 			batch_id,
 			occupancy_id,
@@ -88,19 +88,19 @@ func (c *Client) CreateAddition(ctx context.Context, addition Addition) (Additio
 	// Resolve FK UUIDs
 	if addition.BatchID != nil {
 		var batchUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM batch WHERE id = $1`, *addition.BatchID).Scan(&batchUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM batch WHERE id = $1`, *addition.BatchID).Scan(&batchUUID); err == nil {
 			addition.BatchUUID = &batchUUID
 		}
 	}
 	if addition.OccupancyID != nil {
 		var occUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM occupancy WHERE id = $1`, *addition.OccupancyID).Scan(&occUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM occupancy WHERE id = $1`, *addition.OccupancyID).Scan(&occUUID); err == nil {
 			addition.OccupancyUUID = &occUUID
 		}
 	}
 	if addition.VolumeID != nil {
 		var volUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, *addition.VolumeID).Scan(&volUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, *addition.VolumeID).Scan(&volUUID); err == nil {
 			addition.VolumeUUID = &volUUID
 		}
 	}
@@ -148,7 +148,7 @@ const additionSelectWithJoins = `
 	LEFT JOIN volume v ON v.id = a.volume_id`
 
 func (c *Client) GetAddition(ctx context.Context, id int64) (Addition, error) {
-	addition, err := scanAddition(c.DB().QueryRow(ctx,
+	addition, err := scanAddition(c.Conn(ctx).QueryRow(ctx,
 		additionSelectWithJoins+` WHERE a.id = $1 AND a.deleted_at IS NULL`, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -160,7 +160,7 @@ func (c *Client) GetAddition(ctx context.Context, id int64) (Addition, error) {
 }
 
 func (c *Client) GetAdditionByUUID(ctx context.Context, additionUUID string) (Addition, error) {
-	addition, err := scanAddition(c.DB().QueryRow(ctx,
+	addition, err := scanAddition(c.Conn(ctx).QueryRow(ctx,
 		additionSelectWithJoins+` WHERE a.uuid = $1 AND a.deleted_at IS NULL`, additionUUID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -172,7 +172,7 @@ func (c *Client) GetAdditionByUUID(ctx context.Context, additionUUID string) (Ad
 }
 
 func (c *Client) listAdditions(ctx context.Context, query string, args ...any) ([]Addition, error) {
-	rows, err := c.DB().Query(ctx, query, args...)
+	rows, err := c.Conn(ctx).Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,22 @@
+package storage
+
+import "github.com/brewpipes/brewpipes/internal/archive"
+
+// Archive describes the production data included in a full export.
+var Archive = archive.Service{
+	Name:           "production",
+	MigrationTable: "production_schema_migrations",
+	Tables: append(archive.Tables(
+		"batch", "volume", "volume_relation", "vessel", "occupancy", "transfer",
+		"batch_volume", "batch_process_phase", "batch_relation", "addition",
+		"measurement", "style", "recipe", "brew_session", "recipe_ingredient",
+		"package_format", "packaging_run", "packaging_run_line",
+		"batch_labor_entry", "overhead_rate", "package_format_material",
+	), archive.Table{Name: "batch_cost_snapshot", Key: "batch_id"}),
+	References: []archive.Reference{
+		{Table: "addition", Column: "inventory_lot_uuid", Service: "inventory", Target: "ingredient_lot"},
+		{Table: "package_format_material", Column: "ingredient_uuid", Service: "inventory", Target: "ingredient"},
+		{Table: "packaging_run", Column: "material_usage_uuid", Service: "inventory", Target: "inventory_usage"},
+		{Table: "recipe_ingredient", Column: "ingredient_uuid", Service: "inventory", Target: "ingredient"},
+	},
+}
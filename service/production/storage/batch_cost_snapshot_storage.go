@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+)
+
+func (c *Client) GetBatchCostSnapshot(ctx context.Context, batchUUID string) (BatchCostSnapshot, error) {
+	var snapshot BatchCostSnapshot
+	err := c.Conn(ctx).QueryRow(ctx, `
+		SELECT s.batch_id, s.costs, s.snapshotted_at
+		FROM batch_cost_snapshot s
+		JOIN batch b ON b.id = s.batch_id
+		WHERE b.uuid = $1`,
+		batchUUID,
+	).Scan(&snapshot.BatchID, &snapshot.Costs, &snapshot.SnapshottedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return BatchCostSnapshot{}, service.ErrNotFound
+		}
+		return BatchCostSnapshot{}, fmt.Errorf("getting batch cost snapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// SaveBatchCostSnapshot stores the cost report for a batch, replacing any
+// earlier snapshot.
+func (c *Client) SaveBatchCostSnapshot(ctx context.Context, batchID int64, costs []byte, snapshottedAt time.Time) error {
+	_, err := c.Conn(ctx).Exec(ctx, `
+		INSERT INTO batch_cost_snapshot (batch_id, costs, snapshotted_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (batch_id) DO UPDATE
+		SET costs = EXCLUDED.costs,
+			snapshotted_at = EXCLUDED.snapshotted_at`,
+		batchID,
+		costs,
+		snapshottedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("saving batch cost snapshot: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/brewpipes/brewpipes/service"
+)
+
+func (c *Client) CreateBatchLaborEntry(ctx context.Context, entry BatchLaborEntry) (BatchLaborEntry, error) {
+	workedAt := entry.WorkedAt
+	if workedAt.IsZero() {
+		workedAt = time.Now().UTC()
+	}
+
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO batch_labor_entry (
+			batch_id,
+			role,
+			hours,
+			hourly_rate_cents,
+			worked_at,
+			notes
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, uuid, batch_id, role, hours, hourly_rate_cents, worked_at, notes, created_at, updated_at, deleted_at`,
+		entry.BatchID,
+		entry.Role,
+		entry.Hours,
+		entry.HourlyRateCents,
+		workedAt,
+		entry.Notes,
+	).Scan(
+		&entry.ID,
+		&entry.UUID,
+		&entry.BatchID,
+		&entry.Role,
+		&entry.Hours,
+		&entry.HourlyRateCents,
+		&entry.WorkedAt,
+		&entry.Notes,
+		&entry.CreatedAt,
+		&entry.UpdatedAt,
+		&entry.DeletedAt,
+	)
+	if err != nil {
+		return BatchLaborEntry{}, fmt.Errorf("creating batch labor entry: %w", err)
+	}
+
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM batch WHERE id = $1`, entry.BatchID).Scan(&entry.BatchUUID)
+
+	return entry, nil
+}
+
+func (c *Client) ListBatchLaborEntriesByBatchUUID(ctx context.Context, batchUUID string) ([]BatchLaborEntry, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT l.id, l.uuid, l.batch_id, b.uuid, l.role, l.hours, l.hourly_rate_cents,
+		       l.worked_at, l.notes, l.created_at, l.updated_at, l.deleted_at
+		FROM batch_labor_entry l
+		JOIN batch b ON b.id = l.batch_id
+		WHERE b.uuid = $1 AND l.deleted_at IS NULL
+		ORDER BY l.worked_at ASC, l.id ASC`,
+		batchUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing batch labor entries: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []BatchLaborEntry
+	for rows.Next() {
+		var entry BatchLaborEntry
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.UUID,
+			&entry.BatchID,
+			&entry.BatchUUID,
+			&entry.Role,
+			&entry.Hours,
+			&entry.HourlyRateCents,
+			&entry.WorkedAt,
+			&entry.Notes,
+			&entry.CreatedAt,
+			&entry.UpdatedAt,
+			&entry.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning batch labor entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing batch labor entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+func (c *Client) DeleteBatchLaborEntryByUUID(ctx context.Context, entryUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE batch_labor_entry
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		entryUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting batch labor entry: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
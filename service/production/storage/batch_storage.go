@@ -10,18 +10,22 @@ import (
 )
 
 func (c *Client) CreateBatch(ctx context.Context, batch Batch) (Batch, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO batch (
 			short_name,
 			brew_date,
 			notes,
-			recipe_id
-		) VALUES ($1, $2, $3, $4)
-		RETURNING id, uuid, short_name, brew_date, notes, recipe_id, created_at, updated_at, deleted_at`,
+			recipe_id,
+			planned_volume,
+			planned_volume_unit
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, uuid, short_name, brew_date, notes, recipe_id, planned_volume, planned_volume_unit, created_at, updated_at, deleted_at`,
 		batch.ShortName,
 		batch.BrewDate,
 		batch.Notes,
 		batch.RecipeID,
+		batch.PlannedVolume,
+		batch.PlannedVolumeUnit,
 	).Scan(
 		&batch.ID,
 		&batch.UUID,
@@ -29,6 +33,8 @@ func (c *Client) CreateBatch(ctx context.Context, batch Batch) (Batch, error) {
 		&batch.BrewDate,
 		&batch.Notes,
 		&batch.RecipeID,
+		&batch.PlannedVolume,
+		&batch.PlannedVolumeUnit,
 		&batch.CreatedAt,
 		&batch.UpdatedAt,
 		&batch.DeletedAt,
@@ -41,7 +47,7 @@ func (c *Client) CreateBatch(ctx context.Context, batch Batch) (Batch, error) {
 	if batch.RecipeID != nil {
 		var recipeUUID string
 		var recipeName string
-		err := c.DB().QueryRow(ctx, `SELECT uuid, name FROM recipe WHERE id = $1`, *batch.RecipeID).Scan(&recipeUUID, &recipeName)
+		err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid, name FROM recipe WHERE id = $1`, *batch.RecipeID).Scan(&recipeUUID, &recipeName)
 		if err == nil {
 			batch.RecipeUUID = &recipeUUID
 			batch.RecipeName = &recipeName
@@ -53,9 +59,9 @@ func (c *Client) CreateBatch(ctx context.Context, batch Batch) (Batch, error) {
 
 func (c *Client) GetBatch(ctx context.Context, id int64) (Batch, error) {
 	var batch Batch
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT b.id, b.uuid, b.short_name, b.brew_date, b.notes, b.recipe_id, r.uuid, r.name,
-		       latest_phase.process_phase,
+		       latest_phase.process_phase, b.planned_volume, b.planned_volume_unit,
 		       b.created_at, b.updated_at, b.deleted_at
 		FROM batch b
 		LEFT JOIN recipe r ON r.id = b.recipe_id
@@ -78,6 +84,8 @@ func (c *Client) GetBatch(ctx context.Context, id int64) (Batch, error) {
 		&batch.RecipeUUID,
 		&batch.RecipeName,
 		&batch.CurrentPhase,
+		&batch.PlannedVolume,
+		&batch.PlannedVolumeUnit,
 		&batch.CreatedAt,
 		&batch.UpdatedAt,
 		&batch.DeletedAt,
@@ -94,9 +102,9 @@ func (c *Client) GetBatch(ctx context.Context, id int64) (Batch, error) {
 
 func (c *Client) GetBatchByUUID(ctx context.Context, batchUUID string) (Batch, error) {
 	var batch Batch
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT b.id, b.uuid, b.short_name, b.brew_date, b.notes, b.recipe_id, r.uuid, r.name,
-		       latest_phase.process_phase,
+		       latest_phase.process_phase, b.planned_volume, b.planned_volume_unit,
 		       b.created_at, b.updated_at, b.deleted_at
 		FROM batch b
 		LEFT JOIN recipe r ON r.id = b.recipe_id
@@ -119,6 +127,8 @@ func (c *Client) GetBatchByUUID(ctx context.Context, batchUUID string) (Batch, e
 		&batch.RecipeUUID,
 		&batch.RecipeName,
 		&batch.CurrentPhase,
+		&batch.PlannedVolume,
+		&batch.PlannedVolumeUnit,
 		&batch.CreatedAt,
 		&batch.UpdatedAt,
 		&batch.DeletedAt,
@@ -135,7 +145,7 @@ func (c *Client) GetBatchByUUID(ctx context.Context, batchUUID string) (Batch, e
 
 func (c *Client) CountBatchesByRecipe(ctx context.Context, recipeUUID string) (int, error) {
 	var count int
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT COUNT(*)
 		FROM batch b
 		JOIN recipe r ON b.recipe_id = r.id
@@ -150,9 +160,9 @@ func (c *Client) CountBatchesByRecipe(ctx context.Context, recipeUUID string) (i
 }
 
 func (c *Client) ListBatches(ctx context.Context) ([]Batch, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT b.id, b.uuid, b.short_name, b.brew_date, b.notes, b.recipe_id, r.uuid, r.name,
-		       latest_phase.process_phase,
+		       latest_phase.process_phase, b.planned_volume, b.planned_volume_unit,
 		       b.created_at, b.updated_at, b.deleted_at
 		FROM batch b
 		LEFT JOIN recipe r ON r.id = b.recipe_id
@@ -184,6 +194,8 @@ func (c *Client) ListBatches(ctx context.Context) ([]Batch, error) {
 			&batch.RecipeUUID,
 			&batch.RecipeName,
 			&batch.CurrentPhase,
+			&batch.PlannedVolume,
+			&batch.PlannedVolumeUnit,
 			&batch.CreatedAt,
 			&batch.UpdatedAt,
 			&batch.DeletedAt,
@@ -200,15 +212,18 @@ func (c *Client) ListBatches(ctx context.Context) ([]Batch, error) {
 }
 
 func (c *Client) UpdateBatchByUUID(ctx context.Context, batchUUID string, batch Batch) (Batch, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE batch
-		SET short_name = $1, brew_date = $2, notes = $3, recipe_id = $4, updated_at = timezone('utc', now())
-		WHERE uuid = $5 AND deleted_at IS NULL
-		RETURNING id, uuid, short_name, brew_date, notes, recipe_id, created_at, updated_at, deleted_at`,
+		SET short_name = $1, brew_date = $2, notes = $3, recipe_id = $4,
+			planned_volume = $5, planned_volume_unit = $6, updated_at = timezone('utc', now())
+		WHERE uuid = $7 AND deleted_at IS NULL
+		RETURNING id, uuid, short_name, brew_date, notes, recipe_id, planned_volume, planned_volume_unit, created_at, updated_at, deleted_at`,
 		batch.ShortName,
 		batch.BrewDate,
 		batch.Notes,
 		batch.RecipeID,
+		batch.PlannedVolume,
+		batch.PlannedVolumeUnit,
 		batchUUID,
 	).Scan(
 		&batch.ID,
@@ -217,6 +232,8 @@ func (c *Client) UpdateBatchByUUID(ctx context.Context, batchUUID string, batch
 		&batch.BrewDate,
 		&batch.Notes,
 		&batch.RecipeID,
+		&batch.PlannedVolume,
+		&batch.PlannedVolumeUnit,
 		&batch.CreatedAt,
 		&batch.UpdatedAt,
 		&batch.DeletedAt,
@@ -232,7 +249,7 @@ func (c *Client) UpdateBatchByUUID(ctx context.Context, batchUUID string, batch
 	if batch.RecipeID != nil {
 		var recipeUUID string
 		var recipeName string
-		err := c.DB().QueryRow(ctx, `SELECT uuid, name FROM recipe WHERE id = $1`, *batch.RecipeID).Scan(&recipeUUID, &recipeName)
+		err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid, name FROM recipe WHERE id = $1`, *batch.RecipeID).Scan(&recipeUUID, &recipeName)
 		if err == nil {
 			batch.RecipeUUID = &recipeUUID
 			batch.RecipeName = &recipeName
@@ -262,7 +279,7 @@ func (d BatchDependencies) HasDependencies() bool {
 
 func (c *Client) GetBatchDependencies(ctx context.Context, id int64) (BatchDependencies, error) {
 	var deps BatchDependencies
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT
 			(SELECT COUNT(*) FROM batch_volume WHERE batch_id = $1 AND deleted_at IS NULL),
 			(SELECT COUNT(*) FROM batch_process_phase WHERE batch_id = $1 AND deleted_at IS NULL),
@@ -286,7 +303,7 @@ func (c *Client) GetBatchDependencies(ctx context.Context, id int64) (BatchDepen
 
 // DeleteBatch soft-deletes a batch and cascades the soft-delete to all related records.
 func (c *Client) DeleteBatch(ctx context.Context, id int64) error {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("beginning transaction: %w", err)
 	}
@@ -382,7 +399,7 @@ func (c *Client) DeleteBatch(ctx context.Context, id int64) error {
 func (c *Client) DeleteBatchByUUID(ctx context.Context, batchUUID string) error {
 	// Resolve UUID to internal ID for cascade operations
 	var id int64
-	err := c.DB().QueryRow(ctx, `SELECT id FROM batch WHERE uuid = $1 AND deleted_at IS NULL`, batchUUID).Scan(&id)
+	err := c.Conn(ctx).QueryRow(ctx, `SELECT id FROM batch WHERE uuid = $1 AND deleted_at IS NULL`, batchUUID).Scan(&id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return service.ErrNotFound
@@ -396,7 +413,7 @@ func (c *Client) DeleteBatchByUUID(ctx context.Context, batchUUID string) error
 // GetBatchDependenciesByUUID returns dependency counts for a batch identified by UUID.
 func (c *Client) GetBatchDependenciesByUUID(ctx context.Context, batchUUID string) (BatchDependencies, error) {
 	var id int64
-	err := c.DB().QueryRow(ctx, `SELECT id FROM batch WHERE uuid = $1 AND deleted_at IS NULL`, batchUUID).Scan(&id)
+	err := c.Conn(ctx).QueryRow(ctx, `SELECT id FROM batch WHERE uuid = $1 AND deleted_at IS NULL`, batchUUID).Scan(&id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return BatchDependencies{}, service.ErrNotFound
@@ -154,7 +154,7 @@ func (c *Client) GetBatchSummary(ctx context.Context, batchID int64) (BatchSumma
 
 // listBatchVolumesWithAmounts returns all batch volumes with their volume data in a single JOIN query.
 func (c *Client) listBatchVolumesWithAmounts(ctx context.Context, batchID int64) ([]BatchVolumeWithAmount, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT
 			bv.id, bv.uuid, bv.batch_id, b.uuid, bv.volume_id, v.uuid,
 			bv.liquid_phase, bv.phase_at, bv.created_at, bv.updated_at, bv.deleted_at,
@@ -196,7 +196,7 @@ func (c *Client) listBatchVolumesWithAmounts(ctx context.Context, batchID int64)
 
 // listOccupanciesByBatch returns all occupancies for volumes linked to a batch.
 func (c *Client) listOccupanciesByBatch(ctx context.Context, batchID int64) ([]OccupancyWithVessel, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT 
 			o.id, o.uuid, o.vessel_id, o.volume_id, o.in_at, o.out_at, o.status, o.created_at, o.updated_at, o.deleted_at,
 			v.id, v.uuid, v.type, v.name, v.capacity, v.capacity_unit, v.make, v.model, v.status, v.created_at, v.updated_at, v.deleted_at
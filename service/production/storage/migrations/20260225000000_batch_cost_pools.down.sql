@@ -0,0 +1,5 @@
+BEGIN;
+DROP TABLE IF EXISTS batch_cost_snapshot CASCADE;
+DROP TABLE IF EXISTS overhead_rate CASCADE;
+DROP TABLE IF EXISTS batch_labor_entry CASCADE;
+COMMIT;
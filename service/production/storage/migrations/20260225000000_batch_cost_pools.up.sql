@@ -0,0 +1,54 @@
+-- Full batch costing: labor logged against batches, overhead rates, and the
+-- cost figures frozen when a batch finishes. Amounts are in the base currency
+-- configured in Procurement.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS batch_labor_entry (
+    id                 serial PRIMARY KEY,
+    uuid               uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    batch_id           int NOT NULL REFERENCES batch(id),
+    role               varchar(64) NOT NULL,
+    hours              numeric(10,2) NOT NULL,
+    hourly_rate_cents  bigint NOT NULL,
+    worked_at          timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    notes              text,
+
+    created_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at         timestamptz,
+
+    CONSTRAINT batch_labor_entry_hours_check CHECK (hours > 0),
+    CONSTRAINT batch_labor_entry_rate_check CHECK (hourly_rate_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS batch_labor_entry_uuid_idx ON batch_labor_entry(uuid);
+CREATE INDEX IF NOT EXISTS batch_labor_entry_batch_id_idx ON batch_labor_entry(batch_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS overhead_rate (
+    id          serial PRIMARY KEY,
+    uuid        uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    name        varchar(255) NOT NULL,
+    basis       varchar(32) NOT NULL,
+    rate_cents  bigint NOT NULL,
+    is_active   boolean NOT NULL DEFAULT true,
+
+    created_at  timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at  timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at  timestamptz,
+
+    CONSTRAINT overhead_rate_basis_check CHECK (basis IN ('per_bbl', 'per_vessel_day')),
+    CONSTRAINT overhead_rate_rate_check CHECK (rate_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS overhead_rate_uuid_idx ON overhead_rate(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS overhead_rate_name_lower_idx ON overhead_rate(lower(name)) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS batch_cost_snapshot (
+    batch_id        int PRIMARY KEY REFERENCES batch(id),
+    costs           jsonb NOT NULL,
+    snapshotted_at  timestamptz NOT NULL DEFAULT timezone('utc', now())
+);
+
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE packaging_run DROP COLUMN IF EXISTS material_usage_uuid;
+DROP TABLE IF EXISTS package_format_material CASCADE;
+COMMIT;
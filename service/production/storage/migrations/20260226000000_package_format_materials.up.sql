@@ -0,0 +1,30 @@
+-- Packaging material bills of materials: the Inventory ingredients (category
+-- packaging) consumed per unit of a package format, e.g. a 16oz 4-pack uses
+-- 4 cans, 4 lids, 4 labels and 1 carrier. Completing a packaging run deducts
+-- the materials through the Inventory movement ledger and records the usage.
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS package_format_material (
+    id                 serial PRIMARY KEY,
+    uuid               uuid NOT NULL DEFAULT gen_random_uuid(),
+
+    package_format_id  int NOT NULL REFERENCES package_format(id),
+    ingredient_uuid    uuid NOT NULL,
+    name               varchar(255) NOT NULL,
+    quantity           bigint NOT NULL,
+    unit               varchar(16) NOT NULL,
+
+    created_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    updated_at         timestamptz NOT NULL DEFAULT timezone('utc', now()),
+    deleted_at         timestamptz,
+
+    CONSTRAINT package_format_material_quantity_check CHECK (quantity > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS package_format_material_uuid_idx ON package_format_material(uuid);
+CREATE UNIQUE INDEX IF NOT EXISTS package_format_material_format_ingredient_idx
+    ON package_format_material(package_format_id, ingredient_uuid) WHERE deleted_at IS NULL;
+
+ALTER TABLE packaging_run ADD COLUMN IF NOT EXISTS material_usage_uuid uuid;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+ALTER TABLE batch DROP CONSTRAINT IF EXISTS batch_planned_volume_unit_check;
+ALTER TABLE batch DROP CONSTRAINT IF EXISTS batch_planned_volume_check;
+ALTER TABLE batch DROP COLUMN IF EXISTS planned_volume_unit;
+ALTER TABLE batch DROP COLUMN IF EXISTS planned_volume;
+COMMIT;
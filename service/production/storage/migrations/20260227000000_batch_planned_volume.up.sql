@@ -0,0 +1,14 @@
+-- Planned batch volume: the volume a batch is expected to yield, used to scale
+-- its recipe's ingredient bill against the recipe's batch size when planning
+-- material requirements.
+BEGIN;
+
+ALTER TABLE batch ADD COLUMN IF NOT EXISTS planned_volume numeric(10,2);
+ALTER TABLE batch ADD COLUMN IF NOT EXISTS planned_volume_unit varchar(7);
+
+ALTER TABLE batch ADD CONSTRAINT batch_planned_volume_check
+    CHECK (planned_volume IS NULL OR planned_volume > 0);
+ALTER TABLE batch ADD CONSTRAINT batch_planned_volume_unit_check
+    CHECK ((planned_volume IS NULL) = (planned_volume_unit IS NULL));
+
+COMMIT;
@@ -128,6 +128,10 @@ type Batch struct {
 	RecipeUUID   *string // Joined from recipe table
 	RecipeName   *string // Joined from recipe table
 	CurrentPhase *string // Derived from most recent batch_process_phase
+	// PlannedVolume is the volume the batch is expected to yield, in
+	// PlannedVolumeUnit. Both are nil when no volume is planned.
+	PlannedVolume     *float64
+	PlannedVolumeUnit *string
 	entity.Timestamps
 }
 
@@ -338,18 +342,34 @@ type PackageFormat struct {
 	entity.Timestamps
 }
 
-// PackagingRun represents a packaging event for a batch.
+// PackageFormatMaterial is one line of a package format's bill of materials:
+// the quantity of an Inventory packaging ingredient used per package unit.
+type PackageFormatMaterial struct {
+	entity.Identifiers
+	PackageFormatID   int64
+	PackageFormatUUID string // Joined from package_format table
+	IngredientUUID    uuid.UUID
+	Name              string
+	Quantity          int64
+	Unit              string
+	entity.Timestamps
+}
+
+// PackagingRun represents a packaging event for a batch. MaterialUsageUUID is
+// the Inventory usage that deducted the run's packaging materials, set when
+// the run is completed.
 type PackagingRun struct {
 	entity.Identifiers
-	BatchID       int64
-	BatchUUID     string // Joined from batch table
-	OccupancyID   int64
-	OccupancyUUID string // Joined from occupancy table
-	StartedAt     time.Time
-	EndedAt       *time.Time
-	LossAmount    *int64
-	LossUnit      *string
-	Notes         *string
+	BatchID           int64
+	BatchUUID         string // Joined from batch table
+	OccupancyID       int64
+	OccupancyUUID     string // Joined from occupancy table
+	StartedAt         time.Time
+	EndedAt           *time.Time
+	LossAmount        *int64
+	LossUnit          *string
+	Notes             *string
+	MaterialUsageUUID *string
 	entity.Timestamps
 }
 
@@ -366,3 +386,41 @@ type PackagingRunLine struct {
 	Quantity                       int
 	entity.Timestamps
 }
+
+// Overhead allocation bases.
+const (
+	OverheadBasisPerBBL       = "per_bbl"
+	OverheadBasisPerVesselDay = "per_vessel_day"
+)
+
+// BatchLaborEntry is time worked on a batch by one role at an hourly rate.
+type BatchLaborEntry struct {
+	entity.Identifiers
+	BatchID         int64
+	BatchUUID       string // Joined from batch table
+	Role            string
+	Hours           float64
+	HourlyRateCents int64
+	WorkedAt        time.Time
+	Notes           *string
+	entity.Timestamps
+}
+
+// OverheadRate is an overhead cost charged to every batch, either per barrel
+// brewed or per day a batch occupies a vessel.
+type OverheadRate struct {
+	entity.Identifiers
+	Name      string
+	Basis     string
+	RateCents int64
+	IsActive  bool
+	entity.Timestamps
+}
+
+// BatchCostSnapshot is the batch cost report frozen when the batch finished,
+// stored as the JSON response body.
+type BatchCostSnapshot struct {
+	BatchID       int64
+	Costs         []byte
+	SnapshottedAt time.Time
+}
@@ -20,7 +20,7 @@ func (c *Client) CreateOccupancy(ctx context.Context, occupancy Occupancy) (Occu
 		inAt = time.Now().UTC()
 	}
 
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO occupancy (
 			vessel_id,
 			volume_id,
@@ -51,15 +51,15 @@ func (c *Client) CreateOccupancy(ctx context.Context, occupancy Occupancy) (Occu
 	}
 
 	// Resolve vessel and volume UUIDs
-	c.DB().QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, occupancy.VesselID).Scan(&occupancy.VesselUUID)
-	c.DB().QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, occupancy.VolumeID).Scan(&occupancy.VolumeUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, occupancy.VesselID).Scan(&occupancy.VesselUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, occupancy.VolumeID).Scan(&occupancy.VolumeUUID)
 
 	return occupancy, nil
 }
 
 func (c *Client) GetOccupancy(ctx context.Context, id int64) (Occupancy, error) {
 	var occupancy Occupancy
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
 		       o.in_at, o.out_at, o.status, o.created_at, o.updated_at, o.deleted_at
 		FROM occupancy o
@@ -93,7 +93,7 @@ func (c *Client) GetOccupancy(ctx context.Context, id int64) (Occupancy, error)
 
 func (c *Client) GetOccupancyByUUID(ctx context.Context, occupancyUUID string) (Occupancy, error) {
 	var occupancy Occupancy
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
 		       o.in_at, o.out_at, o.status, o.created_at, o.updated_at, o.deleted_at
 		FROM occupancy o
@@ -127,7 +127,7 @@ func (c *Client) GetOccupancyByUUID(ctx context.Context, occupancyUUID string) (
 
 func (c *Client) GetActiveOccupancyByVessel(ctx context.Context, vesselID int64) (Occupancy, error) {
 	var occupancy Occupancy
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
 		       o.in_at, o.out_at, o.status, o.created_at, o.updated_at, o.deleted_at
 		FROM occupancy o
@@ -170,7 +170,7 @@ func (c *Client) GetActiveOccupancyByVesselUUID(ctx context.Context, vesselUUID
 
 func (c *Client) GetActiveOccupancyByVolume(ctx context.Context, volumeID int64) (Occupancy, error) {
 	var occupancy Occupancy
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
 		       o.in_at, o.out_at, o.status, o.created_at, o.updated_at, o.deleted_at
 		FROM occupancy o
@@ -215,7 +215,7 @@ func (c *Client) ListActiveOccupancies(ctx context.Context) ([]Occupancy, error)
 	// Use a subquery to get the most recent batch_id for each volume.
 	// A volume can have multiple batch_volume records (e.g., wort -> beer phase transitions),
 	// so we select the one with the latest phase_at to avoid duplicate occupancy rows.
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
 		       o.in_at, o.out_at, o.status,
 		       o.created_at, o.updated_at, o.deleted_at,
@@ -271,9 +271,61 @@ func (c *Client) ListActiveOccupancies(ctx context.Context) ([]Occupancy, error)
 	return occupancies, nil
 }
 
+// ListOccupanciesByBatchUUID returns every occupancy, open or closed, of a
+// volume that belongs to the batch.
+func (c *Client) ListOccupanciesByBatchUUID(ctx context.Context, batchUUID string) ([]Occupancy, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT DISTINCT o.id, o.uuid, o.vessel_id, ve.uuid, o.volume_id, vo.uuid,
+		       o.in_at, o.out_at, o.status,
+		       o.created_at, o.updated_at, o.deleted_at,
+		       b.id, b.uuid
+		FROM occupancy o
+		JOIN vessel ve ON ve.id = o.vessel_id
+		JOIN volume vo ON vo.id = o.volume_id
+		JOIN batch_volume bv ON bv.volume_id = o.volume_id AND bv.deleted_at IS NULL
+		JOIN batch b ON b.id = bv.batch_id
+		WHERE b.uuid = $1 AND o.deleted_at IS NULL
+		ORDER BY o.in_at ASC`,
+		batchUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing occupancies by batch: %w", err)
+	}
+	defer rows.Close()
+
+	var occupancies []Occupancy
+	for rows.Next() {
+		var occupancy Occupancy
+		if err := rows.Scan(
+			&occupancy.ID,
+			&occupancy.UUID,
+			&occupancy.VesselID,
+			&occupancy.VesselUUID,
+			&occupancy.VolumeID,
+			&occupancy.VolumeUUID,
+			&occupancy.InAt,
+			&occupancy.OutAt,
+			&occupancy.Status,
+			&occupancy.CreatedAt,
+			&occupancy.UpdatedAt,
+			&occupancy.DeletedAt,
+			&occupancy.BatchID,
+			&occupancy.BatchUUID,
+		); err != nil {
+			return nil, fmt.Errorf("scanning occupancy: %w", err)
+		}
+		occupancies = append(occupancies, occupancy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing occupancies by batch: %w", err)
+	}
+
+	return occupancies, nil
+}
+
 func (c *Client) CloseOccupancy(ctx context.Context, occupancyID int64, outAt time.Time) error {
 	var id int64
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE occupancy
 		SET out_at = $1, updated_at = timezone('utc', now())
 		WHERE id = $2 AND deleted_at IS NULL
@@ -315,7 +367,7 @@ func (c *Client) CloseOccupancyByUUID(ctx context.Context, occupancyUUID string,
 
 func (c *Client) HasActiveOccupancy(ctx context.Context, vesselID int64) (bool, error) {
 	var exists bool
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT EXISTS(
 			SELECT 1 FROM occupancy
 			WHERE vessel_id = $1 AND out_at IS NULL AND deleted_at IS NULL
@@ -340,7 +392,7 @@ func (c *Client) HasActiveOccupancyByVesselUUID(ctx context.Context, vesselUUID
 
 func (c *Client) UpdateOccupancyStatus(ctx context.Context, occupancyID int64, status *string) (Occupancy, error) {
 	var occupancy Occupancy
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE occupancy
 		SET status = $1, updated_at = timezone('utc', now())
 		WHERE id = $2 AND deleted_at IS NULL
@@ -367,8 +419,8 @@ func (c *Client) UpdateOccupancyStatus(ctx context.Context, occupancyID int64, s
 	}
 
 	// Resolve vessel and volume UUIDs
-	c.DB().QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, occupancy.VesselID).Scan(&occupancy.VesselUUID)
-	c.DB().QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, occupancy.VolumeID).Scan(&occupancy.VolumeUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, occupancy.VesselID).Scan(&occupancy.VesselUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, occupancy.VolumeID).Scan(&occupancy.VolumeUUID)
 
 	return occupancy, nil
 }
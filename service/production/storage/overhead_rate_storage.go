@@ -0,0 +1,138 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrDuplicateOverheadRate is returned when an overhead rate with the same
+// name already exists.
+var ErrDuplicateOverheadRate = fmt.Errorf("overhead rate with this name already exists")
+
+func (c *Client) CreateOverheadRate(ctx context.Context, rate OverheadRate) (OverheadRate, error) {
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO overhead_rate (
+			name,
+			basis,
+			rate_cents,
+			is_active
+		) VALUES ($1, $2, $3, $4)
+		RETURNING id, uuid, name, basis, rate_cents, is_active, created_at, updated_at, deleted_at`,
+		rate.Name,
+		rate.Basis,
+		rate.RateCents,
+		rate.IsActive,
+	).Scan(
+		&rate.ID,
+		&rate.UUID,
+		&rate.Name,
+		&rate.Basis,
+		&rate.RateCents,
+		&rate.IsActive,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+		&rate.DeletedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return OverheadRate{}, ErrDuplicateOverheadRate
+		}
+		return OverheadRate{}, fmt.Errorf("creating overhead rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+// ListOverheadRates returns every overhead rate, or only the active ones.
+func (c *Client) ListOverheadRates(ctx context.Context, activeOnly bool) ([]OverheadRate, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT id, uuid, name, basis, rate_cents, is_active, created_at, updated_at, deleted_at
+		FROM overhead_rate
+		WHERE deleted_at IS NULL AND (is_active OR NOT $1)
+		ORDER BY name ASC`,
+		activeOnly,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing overhead rates: %w", err)
+	}
+	defer rows.Close()
+
+	var rates []OverheadRate
+	for rows.Next() {
+		var rate OverheadRate
+		if err := rows.Scan(
+			&rate.ID,
+			&rate.UUID,
+			&rate.Name,
+			&rate.Basis,
+			&rate.RateCents,
+			&rate.IsActive,
+			&rate.CreatedAt,
+			&rate.UpdatedAt,
+			&rate.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning overhead rate: %w", err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing overhead rates: %w", err)
+	}
+
+	return rates, nil
+}
+
+// SetOverheadRateActive switches an overhead rate on or off for future cost
+// reports. Snapshots already taken are unaffected.
+func (c *Client) SetOverheadRateActive(ctx context.Context, rateUUID string, active bool) (OverheadRate, error) {
+	var rate OverheadRate
+	err := c.Conn(ctx).QueryRow(ctx, `
+		UPDATE overhead_rate
+		SET is_active = $2, updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL
+		RETURNING id, uuid, name, basis, rate_cents, is_active, created_at, updated_at, deleted_at`,
+		rateUUID,
+		active,
+	).Scan(
+		&rate.ID,
+		&rate.UUID,
+		&rate.Name,
+		&rate.Basis,
+		&rate.RateCents,
+		&rate.IsActive,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+		&rate.DeletedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return OverheadRate{}, service.ErrNotFound
+		}
+		return OverheadRate{}, fmt.Errorf("updating overhead rate: %w", err)
+	}
+
+	return rate, nil
+}
+
+func (c *Client) DeleteOverheadRateByUUID(ctx context.Context, rateUUID string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE overhead_rate
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE uuid = $1 AND deleted_at IS NULL`,
+		rateUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting overhead rate: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
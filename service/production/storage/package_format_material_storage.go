@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/brewpipes/brewpipes/service"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrDuplicatePackageFormatMaterial is returned when a package format's bill
+// of materials already lists the ingredient.
+var ErrDuplicatePackageFormatMaterial = fmt.Errorf("package format already lists this material")
+
+// packageFormatMaterialSelectSQL is the column list and join shared by
+// package format material queries.
+const packageFormatMaterialSelectSQL = `
+	SELECT m.id, m.uuid, m.package_format_id, pf.uuid, m.ingredient_uuid,
+	       m.name, m.quantity, m.unit,
+	       m.created_at, m.updated_at, m.deleted_at
+	FROM package_format_material m
+	JOIN package_format pf ON pf.id = m.package_format_id`
+
+func scanPackageFormatMaterial(row pgx.Row) (PackageFormatMaterial, error) {
+	var m PackageFormatMaterial
+	err := row.Scan(
+		&m.ID,
+		&m.UUID,
+		&m.PackageFormatID,
+		&m.PackageFormatUUID,
+		&m.IngredientUUID,
+		&m.Name,
+		&m.Quantity,
+		&m.Unit,
+		&m.CreatedAt,
+		&m.UpdatedAt,
+		&m.DeletedAt,
+	)
+	return m, err
+}
+
+func (c *Client) CreatePackageFormatMaterial(ctx context.Context, material PackageFormatMaterial) (PackageFormatMaterial, error) {
+	var id int64
+	err := c.Conn(ctx).QueryRow(ctx, `
+		INSERT INTO package_format_material (
+			package_format_id,
+			ingredient_uuid,
+			name,
+			quantity,
+			unit
+		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id`,
+		material.PackageFormatID,
+		material.IngredientUUID,
+		material.Name,
+		material.Quantity,
+		material.Unit,
+	).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return PackageFormatMaterial{}, ErrDuplicatePackageFormatMaterial
+		}
+		return PackageFormatMaterial{}, fmt.Errorf("creating package format material: %w", err)
+	}
+
+	created, err := scanPackageFormatMaterial(c.Conn(ctx).QueryRow(ctx, packageFormatMaterialSelectSQL+`
+		WHERE m.id = $1`, id))
+	if err != nil {
+		return PackageFormatMaterial{}, fmt.Errorf("getting created package format material: %w", err)
+	}
+
+	return created, nil
+}
+
+func (c *Client) GetPackageFormatMaterialByUUID(ctx context.Context, materialUUID string) (PackageFormatMaterial, error) {
+	material, err := scanPackageFormatMaterial(c.Conn(ctx).QueryRow(ctx, packageFormatMaterialSelectSQL+`
+		WHERE m.uuid = $1 AND m.deleted_at IS NULL`, materialUUID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PackageFormatMaterial{}, service.ErrNotFound
+		}
+		return PackageFormatMaterial{}, fmt.Errorf("getting package format material by uuid: %w", err)
+	}
+
+	return material, nil
+}
+
+// ListPackageFormatMaterials returns the bill of materials of a package
+// format.
+func (c *Client) ListPackageFormatMaterials(ctx context.Context, formatID int64) ([]PackageFormatMaterial, error) {
+	rows, err := c.Conn(ctx).Query(ctx, packageFormatMaterialSelectSQL+`
+		WHERE m.package_format_id = $1 AND m.deleted_at IS NULL
+		ORDER BY m.name ASC`,
+		formatID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing package format materials: %w", err)
+	}
+	defer rows.Close()
+
+	var materials []PackageFormatMaterial
+	for rows.Next() {
+		material, err := scanPackageFormatMaterial(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning package format material: %w", err)
+		}
+		materials = append(materials, material)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing package format materials: %w", err)
+	}
+
+	return materials, nil
+}
+
+func (c *Client) DeletePackageFormatMaterial(ctx context.Context, id int64) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE package_format_material
+		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
+		WHERE id = $1 AND deleted_at IS NULL`,
+		id,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting package format material: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return service.ErrNotFound
+	}
+
+	return nil
+}
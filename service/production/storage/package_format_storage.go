@@ -14,7 +14,7 @@ import (
 var ErrPackageFormatInUse = fmt.Errorf("package format is in use and cannot be deleted")
 
 func (c *Client) CreatePackageFormat(ctx context.Context, format PackageFormat) (PackageFormat, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO package_format (
 			name,
 			container,
@@ -49,7 +49,7 @@ func (c *Client) CreatePackageFormat(ctx context.Context, format PackageFormat)
 
 func (c *Client) GetPackageFormatByUUID(ctx context.Context, formatUUID string) (PackageFormat, error) {
 	var format PackageFormat
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, name, container, volume_per_unit, volume_per_unit_unit, is_active, created_at, updated_at, deleted_at
 		FROM package_format
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -77,7 +77,7 @@ func (c *Client) GetPackageFormatByUUID(ctx context.Context, formatUUID string)
 }
 
 func (c *Client) ListPackageFormats(ctx context.Context) ([]PackageFormat, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, name, container, volume_per_unit, volume_per_unit_unit, is_active, created_at, updated_at, deleted_at
 		FROM package_format
 		WHERE deleted_at IS NULL
@@ -115,7 +115,7 @@ func (c *Client) ListPackageFormats(ctx context.Context) ([]PackageFormat, error
 }
 
 func (c *Client) UpdatePackageFormat(ctx context.Context, id int64, format PackageFormat) (PackageFormat, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE package_format
 		SET name = $1, container = $2, volume_per_unit = $3, volume_per_unit_unit = $4, is_active = $5, updated_at = timezone('utc', now())
 		WHERE id = $6 AND deleted_at IS NULL
@@ -151,7 +151,7 @@ func (c *Client) UpdatePackageFormat(ctx context.Context, id int64, format Packa
 func (c *Client) DeletePackageFormat(ctx context.Context, id int64) error {
 	// Check if any non-deleted packaging_run_line references this format
 	var refCount int
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT COUNT(*)
 		FROM packaging_run_line
 		WHERE package_format_id = $1 AND deleted_at IS NULL`,
@@ -164,7 +164,7 @@ func (c *Client) DeletePackageFormat(ctx context.Context, id int64) error {
 		return ErrPackageFormatInUse
 	}
 
-	tag, err := c.DB().Exec(ctx, `
+	tag, err := c.Conn(ctx).Exec(ctx, `
 		UPDATE package_format
 		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -6,7 +6,7 @@ import (
 )
 
 func (c *Client) CreatePackagingRunLine(ctx context.Context, line PackagingRunLine) (PackagingRunLine, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO packaging_run_line (
 			packaging_run_id,
 			package_format_id,
@@ -31,8 +31,8 @@ func (c *Client) CreatePackagingRunLine(ctx context.Context, line PackagingRunLi
 	}
 
 	// Resolve joined fields
-	c.DB().QueryRow(ctx, `SELECT uuid FROM packaging_run WHERE id = $1`, line.PackagingRunID).Scan(&line.PackagingRunUUID)
-	c.DB().QueryRow(ctx, `
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM packaging_run WHERE id = $1`, line.PackagingRunID).Scan(&line.PackagingRunUUID)
+	c.Conn(ctx).QueryRow(ctx, `
 		SELECT uuid, name, volume_per_unit, volume_per_unit_unit
 		FROM package_format WHERE id = $1`,
 		line.PackageFormatID,
@@ -47,7 +47,7 @@ func (c *Client) CreatePackagingRunLine(ctx context.Context, line PackagingRunLi
 }
 
 func (c *Client) ListPackagingRunLinesByRunID(ctx context.Context, runID int64) ([]PackagingRunLine, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT prl.id, prl.uuid, prl.packaging_run_id, pr.uuid,
 		       prl.package_format_id, pf.uuid, pf.name, pf.volume_per_unit, pf.volume_per_unit_unit,
 		       prl.quantity, prl.created_at, prl.updated_at, prl.deleted_at
@@ -93,7 +93,7 @@ func (c *Client) ListPackagingRunLinesByRunID(ctx context.Context, runID int64)
 }
 
 func (c *Client) ListPackagingRunLinesByRunUUID(ctx context.Context, runUUID string) ([]PackagingRunLine, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT prl.id, prl.uuid, prl.packaging_run_id, pr.uuid,
 		       prl.package_format_id, pf.uuid, pf.name, pf.volume_per_unit, pf.volume_per_unit_unit,
 		       prl.quantity, prl.created_at, prl.updated_at, prl.deleted_at
@@ -137,3 +137,52 @@ func (c *Client) ListPackagingRunLinesByRunUUID(ctx context.Context, runUUID str
 
 	return lines, nil
 }
+
+// ListPackagingRunLinesByBatchUUID returns the lines of every packaging run
+// for a batch.
+func (c *Client) ListPackagingRunLinesByBatchUUID(ctx context.Context, batchUUID string) ([]PackagingRunLine, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT prl.id, prl.uuid, prl.packaging_run_id, pr.uuid,
+		       prl.package_format_id, pf.uuid, pf.name, pf.volume_per_unit, pf.volume_per_unit_unit,
+		       prl.quantity, prl.created_at, prl.updated_at, prl.deleted_at
+		FROM packaging_run_line prl
+		JOIN packaging_run pr ON pr.id = prl.packaging_run_id
+		JOIN package_format pf ON pf.id = prl.package_format_id
+		JOIN batch b ON b.id = pr.batch_id
+		WHERE b.uuid = $1 AND prl.deleted_at IS NULL AND pr.deleted_at IS NULL
+		ORDER BY prl.id ASC`,
+		batchUUID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing packaging run lines by batch uuid: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []PackagingRunLine
+	for rows.Next() {
+		var line PackagingRunLine
+		if err := rows.Scan(
+			&line.ID,
+			&line.UUID,
+			&line.PackagingRunID,
+			&line.PackagingRunUUID,
+			&line.PackageFormatID,
+			&line.PackageFormatUUID,
+			&line.PackageFormatName,
+			&line.PackageFormatVolumePerUnit,
+			&line.PackageFormatVolumePerUnitUnit,
+			&line.Quantity,
+			&line.CreatedAt,
+			&line.UpdatedAt,
+			&line.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning packaging run line: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing packaging run lines by batch uuid: %w", err)
+	}
+
+	return lines, nil
+}
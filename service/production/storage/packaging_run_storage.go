@@ -10,10 +10,14 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// ErrPackagingRunCompleted is returned when a packaging run's materials have
+// already been deducted.
+var ErrPackagingRunCompleted = fmt.Errorf("packaging run materials have already been deducted")
+
 // CreatePackagingRunWithLines atomically creates a packaging run and its lines
 // in a single transaction. It returns the created run and lines.
 func (c *Client) CreatePackagingRunWithLines(ctx context.Context, run PackagingRun, lines []PackagingRunLine) (PackagingRun, []PackagingRunLine, error) {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return PackagingRun{}, nil, fmt.Errorf("starting packaging run transaction: %w", err)
 	}
@@ -36,7 +40,7 @@ func (c *Client) CreatePackagingRunWithLines(ctx context.Context, run PackagingR
 			loss_unit,
 			notes
 		) VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, uuid, batch_id, occupancy_id, started_at, ended_at, loss_amount, loss_unit, notes, created_at, updated_at, deleted_at`,
+		RETURNING id, uuid, batch_id, occupancy_id, started_at, ended_at, loss_amount, loss_unit, notes, material_usage_uuid, created_at, updated_at, deleted_at`,
 		run.BatchID,
 		run.OccupancyID,
 		startedAt,
@@ -54,6 +58,7 @@ func (c *Client) CreatePackagingRunWithLines(ctx context.Context, run PackagingR
 		&run.LossAmount,
 		&run.LossUnit,
 		&run.Notes,
+		&run.MaterialUsageUUID,
 		&run.CreatedAt,
 		&run.UpdatedAt,
 		&run.DeletedAt,
@@ -118,9 +123,9 @@ func (c *Client) CreatePackagingRunWithLines(ctx context.Context, run PackagingR
 
 func (c *Client) GetPackagingRunByUUID(ctx context.Context, runUUID string) (PackagingRun, error) {
 	var run PackagingRun
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT pr.id, pr.uuid, pr.batch_id, b.uuid, pr.occupancy_id, o.uuid,
-		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes,
+		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes, pr.material_usage_uuid,
 		       pr.created_at, pr.updated_at, pr.deleted_at
 		FROM packaging_run pr
 		JOIN batch b ON b.id = pr.batch_id
@@ -139,6 +144,7 @@ func (c *Client) GetPackagingRunByUUID(ctx context.Context, runUUID string) (Pac
 		&run.LossAmount,
 		&run.LossUnit,
 		&run.Notes,
+		&run.MaterialUsageUUID,
 		&run.CreatedAt,
 		&run.UpdatedAt,
 		&run.DeletedAt,
@@ -154,9 +160,9 @@ func (c *Client) GetPackagingRunByUUID(ctx context.Context, runUUID string) (Pac
 }
 
 func (c *Client) ListPackagingRuns(ctx context.Context) ([]PackagingRun, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT pr.id, pr.uuid, pr.batch_id, b.uuid, pr.occupancy_id, o.uuid,
-		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes,
+		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes, pr.material_usage_uuid,
 		       pr.created_at, pr.updated_at, pr.deleted_at
 		FROM packaging_run pr
 		JOIN batch b ON b.id = pr.batch_id
@@ -184,6 +190,7 @@ func (c *Client) ListPackagingRuns(ctx context.Context) ([]PackagingRun, error)
 			&run.LossAmount,
 			&run.LossUnit,
 			&run.Notes,
+			&run.MaterialUsageUUID,
 			&run.CreatedAt,
 			&run.UpdatedAt,
 			&run.DeletedAt,
@@ -200,9 +207,9 @@ func (c *Client) ListPackagingRuns(ctx context.Context) ([]PackagingRun, error)
 }
 
 func (c *Client) ListPackagingRunsByBatchUUID(ctx context.Context, batchUUID string) ([]PackagingRun, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT pr.id, pr.uuid, pr.batch_id, b.uuid, pr.occupancy_id, o.uuid,
-		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes,
+		       pr.started_at, pr.ended_at, pr.loss_amount, pr.loss_unit, pr.notes, pr.material_usage_uuid,
 		       pr.created_at, pr.updated_at, pr.deleted_at
 		FROM packaging_run pr
 		JOIN batch b ON b.id = pr.batch_id
@@ -231,6 +238,7 @@ func (c *Client) ListPackagingRunsByBatchUUID(ctx context.Context, batchUUID str
 			&run.LossAmount,
 			&run.LossUnit,
 			&run.Notes,
+			&run.MaterialUsageUUID,
 			&run.CreatedAt,
 			&run.UpdatedAt,
 			&run.DeletedAt,
@@ -246,8 +254,30 @@ func (c *Client) ListPackagingRunsByBatchUUID(ctx context.Context, batchUUID str
 	return runs, nil
 }
 
+// CompletePackagingRun sets a packaging run's end time and the Inventory usage
+// that deducted its packaging materials. It returns ErrPackagingRunCompleted
+// if materials were already deducted for the run.
+func (c *Client) CompletePackagingRun(ctx context.Context, id int64, endedAt time.Time, materialUsageUUID *string) error {
+	tag, err := c.Conn(ctx).Exec(ctx, `
+		UPDATE packaging_run
+		SET ended_at = $2, material_usage_uuid = $3, updated_at = timezone('utc', now())
+		WHERE id = $1 AND deleted_at IS NULL AND material_usage_uuid IS NULL`,
+		id,
+		endedAt,
+		materialUsageUUID,
+	)
+	if err != nil {
+		return fmt.Errorf("completing packaging run: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrPackagingRunCompleted
+	}
+
+	return nil
+}
+
 func (c *Client) DeletePackagingRun(ctx context.Context, id int64) error {
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("starting packaging run delete transaction: %w", err)
 	}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// RecipeImport is a recipe and its ingredient bill, created together.
+type RecipeImport struct {
+	Recipe      Recipe
+	Ingredients []RecipeIngredient
+}
+
+// ImportRecipe atomically creates a recipe and its ingredients. The
+// ingredients' RecipeID is set to the new recipe.
+func (c *Client) ImportRecipe(ctx context.Context, req RecipeImport) (Recipe, error) {
+	tx, err := c.Conn(ctx).Begin(ctx)
+	if err != nil {
+		return Recipe{}, fmt.Errorf("starting recipe import transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	recipe := req.Recipe
+	var recipeID int64
+	err = tx.QueryRow(ctx, `
+		INSERT INTO recipe (
+			name, style_id, style_name, notes,
+			batch_size, batch_size_unit,
+			target_og, target_fg, target_ibu, brewhouse_efficiency
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id`,
+		recipe.Name,
+		recipe.StyleID,
+		recipe.StyleName,
+		recipe.Notes,
+		recipe.BatchSize,
+		recipe.BatchSizeUnit,
+		recipe.TargetOG,
+		recipe.TargetFG,
+		recipe.TargetIBU,
+		recipe.BrewhouseEfficiency,
+	).Scan(&recipeID)
+	if err != nil {
+		return Recipe{}, fmt.Errorf("creating recipe: %w", err)
+	}
+
+	for _, ri := range req.Ingredients {
+		var ingredientUUID any
+		if ri.IngredientUUID != nil {
+			ingredientUUID = *ri.IngredientUUID
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO recipe_ingredient (
+				recipe_id, name, ingredient_uuid, ingredient_type, amount, amount_unit,
+				use_stage, use_type, timing_duration_minutes, timing_temperature_c,
+				alpha_acid_assumed, scaling_factor, sort_order, notes
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+			recipeID,
+			ri.Name,
+			ingredientUUID,
+			ri.IngredientType,
+			ri.Amount,
+			ri.AmountUnit,
+			ri.UseStage,
+			ri.UseType,
+			ri.TimingDurationMinutes,
+			ri.TimingTemperatureC,
+			ri.AlphaAcidAssumed,
+			ri.ScalingFactor,
+			ri.SortOrder,
+			ri.Notes,
+		)
+		if err != nil {
+			return Recipe{}, fmt.Errorf("creating recipe ingredient %q: %w", ri.Name, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Recipe{}, fmt.Errorf("committing recipe import: %w", err)
+	}
+
+	return c.getRecipeByID(ctx, recipeID, nil)
+}
@@ -13,7 +13,7 @@ import (
 
 // ListRecipeIngredients returns all ingredients for a recipe, ordered by sort_order.
 func (c *Client) ListRecipeIngredients(ctx context.Context, recipeUUID string) ([]RecipeIngredient, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT ri.id, ri.uuid, ri.recipe_id, ri.name, ri.ingredient_uuid, ri.ingredient_type, ri.amount, ri.amount_unit,
 		       ri.use_stage, ri.use_type, ri.timing_duration_minutes, ri.timing_temperature_c,
 		       ri.alpha_acid_assumed, ri.scaling_factor, ri.sort_order, ri.notes,
@@ -46,7 +46,7 @@ func (c *Client) ListRecipeIngredients(ctx context.Context, recipeUUID string) (
 
 // GetRecipeIngredient returns a recipe ingredient by UUID.
 func (c *Client) GetRecipeIngredient(ctx context.Context, ingredientUUID string) (RecipeIngredient, error) {
-	row := c.DB().QueryRow(ctx, `
+	row := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, recipe_id, name, ingredient_uuid, ingredient_type, amount, amount_unit,
 		       use_stage, use_type, timing_duration_minutes, timing_temperature_c,
 		       alpha_acid_assumed, scaling_factor, sort_order, notes,
@@ -75,7 +75,7 @@ func (c *Client) CreateRecipeIngredient(ctx context.Context, ri RecipeIngredient
 	}
 
 	var ingredientUUIDResult pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO recipe_ingredient (
 			recipe_id, name, ingredient_uuid, ingredient_type, amount, amount_unit,
 			use_stage, use_type, timing_duration_minutes, timing_temperature_c,
@@ -136,7 +136,7 @@ func (c *Client) UpdateRecipeIngredient(ctx context.Context, ingredientUUID stri
 	}
 
 	var invIngredientUUIDResult pgtype.UUID
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE recipe_ingredient
 		SET name = $1,
 		    ingredient_uuid = $2,
@@ -205,7 +205,7 @@ func (c *Client) UpdateRecipeIngredient(ctx context.Context, ingredientUUID stri
 
 // DeleteRecipeIngredient soft-deletes a recipe ingredient by UUID.
 func (c *Client) DeleteRecipeIngredient(ctx context.Context, ingredientUUID string) error {
-	result, err := c.DB().Exec(ctx, `
+	result, err := c.Conn(ctx).Exec(ctx, `
 		UPDATE recipe_ingredient
 		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -62,7 +62,7 @@ func scanRecipe(row pgx.Row) (Recipe, error) {
 }
 
 func (c *Client) CreateRecipe(ctx context.Context, recipe Recipe) (Recipe, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO recipe (
 			name, style_id, style_name, notes,
 			batch_size, batch_size_unit,
@@ -136,7 +136,7 @@ func (c *Client) CreateRecipe(ctx context.Context, recipe Recipe) (Recipe, error
 	// Resolve style UUID if style_id is set
 	if recipe.StyleID != nil {
 		var styleUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM style WHERE id = $1`, *recipe.StyleID).Scan(&styleUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM style WHERE id = $1`, *recipe.StyleID).Scan(&styleUUID); err == nil {
 			recipe.StyleUUID = &styleUUID
 		}
 	}
@@ -162,7 +162,7 @@ func (c *Client) GetRecipe(ctx context.Context, recipeUUID string, opts *RecipeQ
 		query += ` AND r.deleted_at IS NULL`
 	}
 
-	recipe, err := scanRecipe(c.DB().QueryRow(ctx, query, recipeUUID))
+	recipe, err := scanRecipe(c.Conn(ctx).QueryRow(ctx, query, recipeUUID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return Recipe{}, service.ErrNotFound
@@ -174,7 +174,7 @@ func (c *Client) GetRecipe(ctx context.Context, recipeUUID string, opts *RecipeQ
 }
 
 func (c *Client) ListRecipes(ctx context.Context) ([]Recipe, error) {
-	rows, err := c.DB().Query(ctx, recipeSelectWithJoins+`
+	rows, err := c.Conn(ctx).Query(ctx, recipeSelectWithJoins+`
 		WHERE r.deleted_at IS NULL
 		ORDER BY r.name ASC`)
 	if err != nil {
@@ -198,7 +198,7 @@ func (c *Client) ListRecipes(ctx context.Context) ([]Recipe, error) {
 }
 
 func (c *Client) UpdateRecipe(ctx context.Context, recipeUUID string, recipe Recipe) (Recipe, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE recipe
 		SET name = $1, style_id = $2, style_name = $3, notes = $4,
 		    batch_size = $5, batch_size_unit = $6,
@@ -277,7 +277,7 @@ func (c *Client) UpdateRecipe(ctx context.Context, recipeUUID string, recipe Rec
 	// Resolve style UUID if style_id is set
 	if recipe.StyleID != nil {
 		var styleUUID string
-		if err := c.DB().QueryRow(ctx, `SELECT uuid FROM style WHERE id = $1`, *recipe.StyleID).Scan(&styleUUID); err == nil {
+		if err := c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM style WHERE id = $1`, *recipe.StyleID).Scan(&styleUUID); err == nil {
 			recipe.StyleUUID = &styleUUID
 		}
 	}
@@ -286,7 +286,7 @@ func (c *Client) UpdateRecipe(ctx context.Context, recipeUUID string, recipe Rec
 }
 
 func (c *Client) DeleteRecipe(ctx context.Context, recipeUUID string) error {
-	result, err := c.DB().Exec(ctx, `
+	result, err := c.Conn(ctx).Exec(ctx, `
 		UPDATE recipe
 		SET deleted_at = timezone('utc', now()), updated_at = timezone('utc', now())
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -313,7 +313,7 @@ func (c *Client) getRecipeByID(ctx context.Context, id int64, opts *RecipeQueryO
 		query += ` AND r.deleted_at IS NULL`
 	}
 
-	recipe, err := scanRecipe(c.DB().QueryRow(ctx, query, id))
+	recipe, err := scanRecipe(c.Conn(ctx).QueryRow(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return Recipe{}, service.ErrNotFound
@@ -30,7 +30,7 @@ func (c *Client) CreateTransfer(ctx context.Context, transfer Transfer) (Transfe
 		startedAt = time.Now().UTC()
 	}
 
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO transfer (
 			source_occupancy_id,
 			dest_occupancy_id,
@@ -74,7 +74,7 @@ func (c *Client) CreateTransfer(ctx context.Context, transfer Transfer) (Transfe
 
 func (c *Client) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 	var transfer Transfer
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT t.id, t.uuid, t.source_occupancy_id, so.uuid, t.dest_occupancy_id, desto.uuid,
 		       t.amount, t.amount_unit, t.loss_amount, t.loss_unit, t.started_at, t.ended_at,
 		       t.created_at, t.updated_at, t.deleted_at
@@ -112,7 +112,7 @@ func (c *Client) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 
 func (c *Client) GetTransferByUUID(ctx context.Context, transferUUID string) (Transfer, error) {
 	var transfer Transfer
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT t.id, t.uuid, t.source_occupancy_id, so.uuid, t.dest_occupancy_id, desto.uuid,
 		       t.amount, t.amount_unit, t.loss_amount, t.loss_unit, t.started_at, t.ended_at,
 		       t.created_at, t.updated_at, t.deleted_at
@@ -159,7 +159,7 @@ func (c *Client) RecordTransfer(ctx context.Context, record TransferRecord) (Tra
 		outAt = *record.EndedAt
 	}
 
-	tx, err := c.DB().Begin(ctx)
+	tx, err := c.Conn(ctx).Begin(ctx)
 	if err != nil {
 		return Transfer{}, Occupancy{}, fmt.Errorf("starting transfer transaction: %w", err)
 	}
@@ -257,16 +257,16 @@ func (c *Client) RecordTransfer(ctx context.Context, record TransferRecord) (Tra
 	}
 
 	// Resolve UUIDs for the transfer and occupancy
-	c.DB().QueryRow(ctx, `SELECT uuid FROM occupancy WHERE id = $1`, transfer.SourceOccupancyID).Scan(&transfer.SourceOccupancyUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM occupancy WHERE id = $1`, transfer.SourceOccupancyID).Scan(&transfer.SourceOccupancyUUID)
 	transfer.DestOccupancyUUID = dest.UUID.String()
-	c.DB().QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, dest.VesselID).Scan(&dest.VesselUUID)
-	c.DB().QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, dest.VolumeID).Scan(&dest.VolumeUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM vessel WHERE id = $1`, dest.VesselID).Scan(&dest.VesselUUID)
+	c.Conn(ctx).QueryRow(ctx, `SELECT uuid FROM volume WHERE id = $1`, dest.VolumeID).Scan(&dest.VolumeUUID)
 
 	return transfer, dest, nil
 }
 
 func (c *Client) ListTransfersByBatch(ctx context.Context, batchID int64) ([]Transfer, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT t.id, t.uuid, t.source_occupancy_id, so.uuid, t.dest_occupancy_id, desto.uuid,
 		       t.amount, t.amount_unit, t.loss_amount, t.loss_unit, t.started_at, t.ended_at,
 		       t.created_at, t.updated_at, t.deleted_at
@@ -15,7 +15,7 @@ func (c *Client) CreateVessel(ctx context.Context, vessel Vessel) (Vessel, error
 		status = VesselStatusActive
 	}
 
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		INSERT INTO vessel (
 			type,
 			name,
@@ -56,7 +56,7 @@ func (c *Client) CreateVessel(ctx context.Context, vessel Vessel) (Vessel, error
 
 func (c *Client) GetVessel(ctx context.Context, id int64) (Vessel, error) {
 	var vessel Vessel
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, type, name, capacity, capacity_unit, make, model, status, created_at, updated_at, deleted_at
 		FROM vessel
 		WHERE id = $1 AND deleted_at IS NULL`,
@@ -86,7 +86,7 @@ func (c *Client) GetVessel(ctx context.Context, id int64) (Vessel, error) {
 }
 
 func (c *Client) ListVessels(ctx context.Context) ([]Vessel, error) {
-	rows, err := c.DB().Query(ctx, `
+	rows, err := c.Conn(ctx).Query(ctx, `
 		SELECT id, uuid, type, name, capacity, capacity_unit, make, model, status, created_at, updated_at, deleted_at
 		FROM vessel
 		WHERE deleted_at IS NULL
@@ -125,9 +125,52 @@ func (c *Client) ListVessels(ctx context.Context) ([]Vessel, error) {
 	return vessels, nil
 }
 
+// ListVesselsByName returns vessels with the given name. Names are not
+// unique, so more than one may match.
+func (c *Client) ListVesselsByName(ctx context.Context, name string) ([]Vessel, error) {
+	rows, err := c.Conn(ctx).Query(ctx, `
+		SELECT id, uuid, type, name, capacity, capacity_unit, make, model, status, created_at, updated_at, deleted_at
+		FROM vessel
+		WHERE name = $1 AND deleted_at IS NULL
+		ORDER BY id ASC`,
+		name,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing vessels by name: %w", err)
+	}
+	defer rows.Close()
+
+	var vessels []Vessel
+	for rows.Next() {
+		var vessel Vessel
+		if err := rows.Scan(
+			&vessel.ID,
+			&vessel.UUID,
+			&vessel.Type,
+			&vessel.Name,
+			&vessel.Capacity,
+			&vessel.CapacityUnit,
+			&vessel.Make,
+			&vessel.Model,
+			&vessel.Status,
+			&vessel.CreatedAt,
+			&vessel.UpdatedAt,
+			&vessel.DeletedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning vessel: %w", err)
+		}
+		vessels = append(vessels, vessel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("listing vessels by name: %w", err)
+	}
+
+	return vessels, nil
+}
+
 func (c *Client) GetVesselByUUID(ctx context.Context, uuid string) (Vessel, error) {
 	var vessel Vessel
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		SELECT id, uuid, type, name, capacity, capacity_unit, make, model, status, created_at, updated_at, deleted_at
 		FROM vessel
 		WHERE uuid = $1 AND deleted_at IS NULL`,
@@ -157,7 +200,7 @@ func (c *Client) GetVesselByUUID(ctx context.Context, uuid string) (Vessel, erro
 }
 
 func (c *Client) UpdateVessel(ctx context.Context, id int64, vessel Vessel) (Vessel, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE vessel
 		SET type = $1, name = $2, capacity = $3, capacity_unit = $4, make = $5, model = $6, status = $7, updated_at = timezone('utc', now())
 		WHERE id = $8 AND deleted_at IS NULL
@@ -195,7 +238,7 @@ func (c *Client) UpdateVessel(ctx context.Context, id int64, vessel Vessel) (Ves
 }
 
 func (c *Client) UpdateVesselByUUID(ctx context.Context, vesselUUID string, vessel Vessel) (Vessel, error) {
-	err := c.DB().QueryRow(ctx, `
+	err := c.Conn(ctx).QueryRow(ctx, `
 		UPDATE vessel
 		SET type = $1, name = $2, capacity = $3, capacity_unit = $4, make = $5, model = $6, status = $7, updated_at = timezone('utc', now())
 		WHERE uuid = $8 AND deleted_at IS NULL
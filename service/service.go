@@ -15,6 +15,17 @@ type HTTPRoute struct {
 	Method  string
 	Path    string
 	Handler http.Handler
+	// Auth, when set, authenticates requests before anything else runs, so
+	// middleware wrapped around Handler only sees authenticated requests.
+	Auth func(http.Handler) http.Handler
+}
+
+// Serve returns the route's handler behind its authentication.
+func (r HTTPRoute) Serve() http.Handler {
+	if r.Auth == nil {
+		return r.Handler
+	}
+	return r.Auth(r.Handler)
 }
 
 func JSON(w http.ResponseWriter, v any) {
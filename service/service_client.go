@@ -0,0 +1,44 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// StatusError is a response from another service with an unexpected status.
+// Body is the response body, which for handler errors is a plain-text
+// message.
+type StatusError struct {
+	Service    string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s service returned %d: %s", e.Service, e.StatusCode, e.Body)
+}
+
+// DecodeResponse finishes a call to the service named name made with a
+// generated client: it closes the response, returns a *StatusError unless
+// the status is want, and decodes the JSON body into v when v is not nil.
+// err is the error the client method returned.
+func DecodeResponse(name string, resp *http.Response, err error, want int, v any) error {
+	if err != nil {
+		return fmt.Errorf("calling %s service: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != want {
+		body, _ := io.ReadAll(resp.Body)
+		return &StatusError{Service: name, StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	if v == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("decoding %s service response: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/brewpipes/brewpipes/internal/telemetry"
+	"github.com/gofrs/uuid/v5"
+	"golang.org/x/sync/singleflight"
+)
+
+// serviceTokenRefreshMargin is how long before expiry a cached service token
+// is replaced, so a token never expires during a call.
+const serviceTokenRefreshMargin = time.Minute
+
+// ServiceTokenSource obtains service tokens from the identity service with
+// the client credentials of one service and caches them until shortly before
+// they expire. A token is minted for each user the service acts for, plus one
+// without a user for calls made outside a user request.
+type ServiceTokenSource struct {
+	identityURL  string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	// mu guards tokens only; fetches run outside it, one at a time per user.
+	mu      sync.Mutex
+	tokens  map[uuid.UUID]serviceToken
+	fetches singleflight.Group
+}
+
+type serviceToken struct {
+	value     string
+	expiresAt time.Time
+}
+
+// NewServiceTokenSource creates a ServiceTokenSource for the service named
+// clientID, fetching tokens from the identity service at identityURL.
+func NewServiceTokenSource(identityURL, clientID, clientSecret string) *ServiceTokenSource {
+	return &ServiceTokenSource{
+		identityURL:  identityURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: telemetry.Transport("identity", nil),
+		},
+		now:    time.Now,
+		tokens: make(map[uuid.UUID]serviceToken),
+	}
+}
+
+// Token returns a service token for calls made in ctx. When ctx belongs to
+// an authenticated request the token names its user as the originating user.
+// Concurrent calls for the same user share one fetch.
+func (s *ServiceTokenSource) Token(ctx context.Context) (string, error) {
+	userID, _ := UserIDFromContext(ctx)
+
+	if token, ok := s.cached(userID); ok {
+		return token, nil
+	}
+
+	// The fetch outlives a caller that gives up, since others may share it;
+	// the HTTP client's timeout bounds it.
+	fetchCtx := context.WithoutCancel(ctx)
+	ch := s.fetches.DoChan(userID.String(), func() (any, error) {
+		token, err := s.fetch(fetchCtx, userID)
+		if err != nil {
+			return nil, err
+		}
+		s.store(userID, token)
+		return token.value, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return "", res.Err
+		}
+		return res.Val.(string), nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// cached returns the cached token for userID unless it nears expiry.
+func (s *ServiceTokenSource) cached(userID uuid.UUID) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[userID]
+	if !ok || !s.now().Add(serviceTokenRefreshMargin).Before(token.expiresAt) {
+		return "", false
+	}
+	return token.value, true
+}
+
+// store caches token for userID and drops expired tokens.
+func (s *ServiceTokenSource) store(userID uuid.UUID, token serviceToken) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for id, cached := range s.tokens {
+		if !now.Before(cached.expiresAt) {
+			delete(s.tokens, id)
+		}
+	}
+	s.tokens[userID] = token
+}
+
+// serviceTokenRequest is the request body for the identity service-token
+// endpoint.
+type serviceTokenRequest struct {
+	ClientID     string  `json:"client_id"`
+	ClientSecret string  `json:"client_secret"`
+	OnBehalfOf   *string `json:"on_behalf_of,omitempty"`
+}
+
+// serviceTokenResponse is the response body of the identity service-token
+// endpoint.
+type serviceTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+func (s *ServiceTokenSource) fetch(ctx context.Context, userID uuid.UUID) (serviceToken, error) {
+	req := serviceTokenRequest{ClientID: s.clientID, ClientSecret: s.clientSecret}
+	if userID != uuid.Nil {
+		onBehalfOf := userID.String()
+		req.OnBehalfOf = &onBehalfOf
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return serviceToken{}, fmt.Errorf("marshaling service token request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.identityURL+"/service-tokens", bytes.NewReader(body))
+	if err != nil {
+		return serviceToken{}, fmt.Errorf("creating service token request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	requestedAt := s.now()
+	resp, err := s.httpClient.Do(httpReq)
+	if err != nil {
+		return serviceToken{}, fmt.Errorf("calling identity service: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return serviceToken{}, fmt.Errorf("identity service returned %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var result serviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return serviceToken{}, fmt.Errorf("decoding service token response: %w", err)
+	}
+
+	return serviceToken{
+		value:     result.AccessToken,
+		expiresAt: requestedAt.Add(time.Duration(result.ExpiresIn) * time.Second),
+	}, nil
+}
+
+// Transport authenticates requests sent through base with a service token
+// for the request's context. A nil base uses http.DefaultTransport.
+func (s *ServiceTokenSource) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &serviceTokenTransport{tokens: s, base: base}
+}
+
+type serviceTokenTransport struct {
+	tokens *ServiceTokenSource
+	base   http.RoundTripper
+}
+
+func (t *serviceTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.tokens.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("getting service token: %w", err)
+	}
+
+	// RoundTrippers must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
@@ -99,6 +99,8 @@ function makeBatch (overrides: Partial<Batch> = {}): Batch {
     recipe_uuid: null,
     recipe_name: null,
     current_phase: 'fermenting',
+    planned_volume: null,
+    planned_volume_unit: null,
     notes: null,
     created_at: new Date().toISOString(),
     updated_at: new Date().toISOString(),
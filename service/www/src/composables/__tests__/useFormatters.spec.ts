@@ -268,6 +268,7 @@ describe('usePurchaseOrderStatusFormatters', () => {
       expect(formatPurchaseOrderStatus('confirmed')).toBe('Confirmed')
       expect(formatPurchaseOrderStatus('partially_received')).toBe('Partially Received')
       expect(formatPurchaseOrderStatus('received')).toBe('Received')
+      expect(formatPurchaseOrderStatus('closed')).toBe('Closed')
       expect(formatPurchaseOrderStatus('cancelled')).toBe('Cancelled')
     })
 
@@ -287,6 +288,7 @@ describe('usePurchaseOrderStatusFormatters', () => {
       expect(getPurchaseOrderStatusColor('confirmed')).toBe('green')
       expect(getPurchaseOrderStatusColor('partially_received')).toBe('orange')
       expect(getPurchaseOrderStatusColor('received')).toBe('green')
+      expect(getPurchaseOrderStatusColor('closed')).toBe('grey')
       expect(getPurchaseOrderStatusColor('cancelled')).toBe('red')
     })
 
@@ -303,6 +305,7 @@ describe('usePurchaseOrderStatusFormatters', () => {
         { title: 'Confirmed', value: 'confirmed' },
         { title: 'Partially Received', value: 'partially_received' },
         { title: 'Received', value: 'received' },
+        { title: 'Closed', value: 'closed' },
         { title: 'Cancelled', value: 'cancelled' },
       ])
     })
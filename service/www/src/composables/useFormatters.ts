@@ -260,6 +260,7 @@ const PURCHASE_ORDER_STATUS_LABELS: Record<string, string> = {
   confirmed: 'Confirmed',
   partially_received: 'Partially Received',
   received: 'Received',
+  closed: 'Closed',
   cancelled: 'Cancelled',
 }
 
@@ -269,6 +270,7 @@ const PURCHASE_ORDER_STATUS_COLORS: Record<string, string> = {
   confirmed: 'green',
   partially_received: 'orange',
   received: 'green',
+  closed: 'grey',
   cancelled: 'red',
 }
 
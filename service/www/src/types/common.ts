@@ -28,3 +28,232 @@ export interface SoftDeletable {
  * Base entity type combining identifiers and timestamps.
  */
 export type BaseEntity = EntityIdentifiers & EntityTimestamps
+
+/**
+ * Outcome of one CSV import row. Rows are numbered as in the file, so the
+ * first row after the header is row 2. A dry run reports valid rows as
+ * "valid" without a record.
+ */
+export type ImportRowStatus = 'created' | 'valid' | 'error'
+
+export interface ImportRowResult<T = unknown> {
+  row: number
+  status: ImportRowStatus
+  record?: T
+  error?: string
+}
+
+/** Row counts of a CSV import by outcome */
+export interface ImportTotals {
+  total_rows: number
+  created: number
+  valid: number
+  failed: number
+}
+
+/** Response of the CSV import endpoints */
+export interface ImportResult<T = unknown> {
+  dry_run: boolean
+  totals: ImportTotals
+  results: ImportRowResult<T>[]
+}
+
+/** Software a migration export comes from */
+export type MigrationSource = 'ekos' | 'brewfather' | 'beersmith'
+
+/** Source value that did not map cleanly, e.g. an unknown category */
+export interface MigrationWarning {
+  ref: string
+  message: string
+}
+
+/**
+ * Records in a migration plan. `ref` locates the record in the export
+ * (e.g. "row 4"), and `skip` leaves it out when the plan is applied.
+ */
+export interface MigrationIngredient {
+  ref: string
+  skip?: boolean
+  name: string
+  category: string
+  default_unit: string
+  description?: string
+}
+
+export interface MigrationLot {
+  ref: string
+  skip?: boolean
+  /** Ingredient name, in the plan or already in inventory */
+  ingredient: string
+  /** Stock location name or UUID; defaults to the plan's default */
+  stock_location?: string
+  amount: number
+  unit: string
+  brewery_lot_code?: string
+  originator_lot_code?: string
+  originator_name?: string
+  received_at?: string
+  expires_at?: string
+  notes?: string
+}
+
+export interface MigrationVessel {
+  ref: string
+  skip?: boolean
+  name: string
+  type: string
+  capacity: number
+  capacity_unit: string
+  status: string
+  make?: string
+  model?: string
+}
+
+export interface MigrationRecipeIngredient {
+  name: string
+  ingredient_type: string
+  amount: number
+  amount_unit: string
+  use_stage: string
+  use_type?: string
+  timing_duration_minutes?: number
+  alpha_acid_assumed?: number
+}
+
+export interface MigrationRecipe {
+  ref: string
+  skip?: boolean
+  name: string
+  style?: string
+  notes?: string
+  batch_size?: number
+  batch_size_unit?: string
+  target_og?: number
+  target_fg?: number
+  target_ibu?: number
+  brewhouse_efficiency?: number
+  ingredients: MigrationRecipeIngredient[]
+}
+
+export interface MigrationBatch {
+  ref: string
+  skip?: boolean
+  short_name: string
+  /** Recipe name, in the plan or already in production */
+  recipe?: string
+  brew_date?: string
+  notes?: string
+}
+
+/** Response of POST /migrations/preview, and the body of the apply endpoints */
+export interface MigrationPlan {
+  source: MigrationSource
+  ingredients: MigrationIngredient[]
+  lots: MigrationLot[]
+  vessels: MigrationVessel[]
+  recipes: MigrationRecipe[]
+  batches: MigrationBatch[]
+  default_stock_location?: string
+  warnings: MigrationWarning[]
+}
+
+/**
+ * Outcome of one migration record. "matched" records already exist by name
+ * and are left as they are; a dry run reports new records as "valid".
+ */
+export type MigrationRecordStatus = 'created' | 'valid' | 'matched' | 'skipped' | 'error'
+
+export interface MigrationRecordResult {
+  kind: string
+  ref: string
+  name: string
+  status: MigrationRecordStatus
+  record?: unknown
+  error?: string
+}
+
+export interface MigrationTotals {
+  records: number
+  created: number
+  valid: number
+  matched: number
+  skipped: number
+  failed: number
+}
+
+/** Response of POST /migrations/inventory and /migrations/production */
+export interface MigrationResult {
+  dry_run: boolean
+  totals: MigrationTotals
+  results: MigrationRecordResult[]
+}
+
+// ==================== Accounting Export ====================
+
+/** Vocabulary an account mapping key comes from */
+export type AccountKind = 'role' | 'category' | 'fee_type' | 'item_type'
+
+/** Maps one key of a kind to a GL account name (QuickBooks) or code (Xero) */
+export interface GLAccount {
+  kind: AccountKind
+  key: string
+  account: string
+}
+
+/** Response of GET /accounting/accounts and payload of PUT */
+export interface AccountMapping {
+  xero_tax_rate: string
+  accounts: GLAccount[]
+}
+
+/** One debit or credit of a journal entry, in base currency cents */
+export interface JournalLine {
+  account: string
+  /** Vendor of accounts payable lines */
+  name?: string
+  debit_cents: number
+  credit_cents: number
+}
+
+export interface JournalEntry {
+  date: string
+  number: string
+  memo: string
+  lines: JournalLine[]
+}
+
+/** Activity left out of a journal, such as an order without an exchange rate */
+export interface JournalSkipped {
+  reference: string
+  reason: string
+}
+
+/** Purchase or inventory journal of one month */
+export interface Journal {
+  period: string
+  base_currency: string
+  entries: JournalEntry[]
+  total_cents: number
+  skipped: JournalSkipped[]
+}
+
+export type JournalExportFormat = 'iif' | 'xero'
+
+/** Payload of POST /accounting/{purchase,inventory}-journal/exports */
+export interface CreateJournalExportRequest {
+  /** YYYY-MM; the month must have ended */
+  period: string
+  format: JournalExportFormat
+  /** Required to export a period again */
+  reexport?: boolean
+}
+
+export interface JournalExport {
+  uuid: string
+  period: string
+  format: JournalExportFormat
+  entry_count: number
+  total_cents: number
+  file_name: string
+  exported_at: string
+}
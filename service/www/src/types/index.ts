@@ -12,9 +12,36 @@ export type { AuthTokens } from './auth'
 
 // Common/base types
 export type {
+  AccountKind,
+  AccountMapping,
   BaseEntity,
+  CreateJournalExportRequest,
   EntityIdentifiers,
   EntityTimestamps,
+  GLAccount,
+  ImportResult,
+  ImportRowResult,
+  ImportRowStatus,
+  ImportTotals,
+  Journal,
+  JournalEntry,
+  JournalExport,
+  JournalExportFormat,
+  JournalLine,
+  JournalSkipped,
+  MigrationBatch,
+  MigrationIngredient,
+  MigrationLot,
+  MigrationPlan,
+  MigrationRecipe,
+  MigrationRecipeIngredient,
+  MigrationRecordResult,
+  MigrationRecordStatus,
+  MigrationResult,
+  MigrationSource,
+  MigrationTotals,
+  MigrationVessel,
+  MigrationWarning,
   SoftDeletable,
 } from './common'
 
@@ -24,8 +51,12 @@ export type {
   BatchUsageResponse,
   BeerLot,
   BeerLotStockLevel,
+  ConsumptionCategoryTotal,
+  ConsumptionReasonTotal,
+  CostedMovement,
   CreateBatchUsageRequest,
   CreateBeerLotRequest,
+  CreateCycleCountRequest,
   CreateIngredientLotRequest,
   CreateIngredientLotHopDetailRequest,
   CreateIngredientLotMaltDetailRequest,
@@ -38,6 +69,17 @@ export type {
   CreateInventoryUsageRequest,
   CreateRemovalRequest,
   CreateStockLocationRequest,
+  CreateSupplierReturnRequest,
+  CreditStatus,
+  CycleCount,
+  CycleCountAccuracyEntry,
+  CycleCountAccuracyPeriod,
+  CycleCountAccuracyReport,
+  CycleCountAccuracyTotals,
+  CycleCountEntry,
+  CycleCountLine,
+  CycleCountStatus,
+  CycleCountSummary,
   Ingredient,
   IngredientLot,
   IngredientLotHopDetail,
@@ -45,11 +87,22 @@ export type {
   IngredientLotMaltDetail,
   IngredientLotYeastDetail,
   InventoryAdjustment,
+  InventoryConsumption,
   InventoryMovement,
   InventoryReceipt,
   InventoryTransfer,
   InventoryUsage,
+  InventoryValuation,
+  LabelBarcode,
+  LabelBarcodeContent,
+  LabelFormat,
+  LabelSheet,
+  LabelSubject,
+  LabelTemplate,
+  LabelTemplateRequest,
   LineReceivingDetails,
+  PurchaseOrderSyncStatus,
+  RecordCycleCountRequest,
   Removal,
   RemovalCategory,
   RemovalCategorySummary,
@@ -58,22 +111,48 @@ export type {
   StockLevel,
   StockLevelLocation,
   StockLocation,
+  SupplierReturn,
+  SupplierReturnReason,
+  UncostedLot,
   UpdateIngredientLotHopDetailRequest,
   UpdateIngredientLotMaltDetailRequest,
   UpdateIngredientLotYeastDetailRequest,
   UpdateRemovalRequest,
   UpdateStockLocationRequest,
+  UpdateSupplierReturnRequest,
+  ValuationCategoryTotal,
+  ValuationItem,
+  ValuationLocationTotal,
+  ValuationMethod,
+  ValuationSettings,
 } from './inventory'
 
 // Procurement types
 export type {
+  AppliedExchangeRate,
+  CreateExchangeRateRequest,
   CreatePurchaseOrderFeeRequest,
   CreatePurchaseOrderLineRequest,
   CreatePurchaseOrderRequest,
+  CreateSupplierInvoiceLineRequest,
+  CreateSupplierInvoiceRequest,
+  CurrencySettings,
+  ExchangeRate,
+  FeeAllocationBasis,
+  InvoiceLineMatch,
+  InvoiceMatchSettings,
+  InvoiceMatchStatus,
   PurchaseOrder,
+  PurchaseOrderCurrencyTotal,
   PurchaseOrderFee,
   PurchaseOrderLine,
+  PurchaseOrderLineMatch,
+  PurchaseOrderMatch,
+  PurchaseOrderTotals,
   Supplier,
+  SupplierInvoice,
+  SupplierInvoiceLine,
+  UpdateInvoiceMatchSettingsRequest,
   UpdatePurchaseOrderFeeRequest,
   UpdatePurchaseOrderLineRequest,
   UpdatePurchaseOrderRequest,
@@ -86,6 +165,7 @@ export type {
   AdditionType,
   Batch,
   BatchCostsResponse,
+  BatchLaborEntry,
   BatchProcessPhase,
   BatchRelation,
   BatchRelationType,
@@ -93,11 +173,14 @@ export type {
   BatchSummaryBrewSession,
   BatchVolume,
   BrewSession,
+  CompletePackagingRunRequest,
+  CompletePackagingRunResponse,
   ContainerType,
   CostLineItem,
   CostSource,
   CostTotals,
   CreateAdditionRequest,
+  CreateBatchLaborEntryRequest,
   CreateBatchProcessPhaseRequest,
   CreateBatchRelationRequest,
   CreateBatchRequest,
@@ -105,6 +188,8 @@ export type {
   CreateBrewSessionRequest,
   CreateMeasurementRequest,
   CreateOccupancyRequest,
+  CreateOverheadRateRequest,
+  CreatePackageFormatMaterialRequest,
   CreatePackageFormatRequest,
   CreatePackagingRunLineRequest,
   CreatePackagingRunRequest,
@@ -115,11 +200,24 @@ export type {
   CreateVesselRequest,
   CreateVolumeRelationRequest,
   CreateVolumeRequest,
+  FullCostTotals,
+  LaborCost,
   LiquidPhase,
+  LossWriteOff,
   Measurement,
   Occupancy,
   OccupancyStatus,
+  OverheadBasis,
+  OverheadCost,
+  OverheadRate,
   PackageFormat,
+  PackageFormatCost,
+  PackageFormatMaterial,
+  PackagingMaterialCheck,
+  PackagingMaterialCheckRequest,
+  PackagingMaterialCost,
+  PackagingMaterialLine,
+  PackagingMaterialPick,
   PackagingRun,
   PackagingRunLine,
   ProcessPhase,
@@ -128,6 +226,9 @@ export type {
   RecipeIngredientType,
   RecipeUseStage,
   RecipeUseType,
+  ScanEntityType,
+  ScanMatch,
+  ScanResponse,
   Style,
   Transfer,
   TransferRecordResponse,
@@ -135,6 +236,7 @@ export type {
   UncostedReason,
   UpdateBatchRequest,
   UpdateBrewSessionRequest,
+  UpdateOverheadRateRequest,
   UpdatePackageFormatRequest,
   UpdateRecipeIngredientRequest,
   UpdateRecipeRequest,
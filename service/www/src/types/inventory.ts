@@ -123,10 +123,14 @@ export interface IngredientLotStockLevel {
   received_at: string
   received_amount: number
   received_unit: string
+  best_by_at?: string
+  expires_at?: string
   stock_location_uuid: string
   stock_location_name: string
   current_amount: number
   current_unit: string
+  reserved_amount: number
+  available_amount: number
 }
 
 // ============================================================================
@@ -191,6 +195,7 @@ export interface InventoryMovement {
   usage_uuid: string | null
   adjustment_uuid: string | null
   transfer_uuid: string | null
+  return_uuid?: string | null
   notes: string | null
 }
 
@@ -393,7 +398,7 @@ export interface CreateInventoryMovementRequest {
   beer_lot_uuid?: string | null
   stock_location_uuid: string
   direction: 'in' | 'out'
-  reason: 'receive' | 'use' | 'transfer' | 'adjust' | 'waste'
+  reason: 'receive' | 'use' | 'transfer' | 'adjust' | 'waste' | 'package'
   amount: number
   amount_unit: string
   occurred_at?: string | null
@@ -413,6 +418,8 @@ export interface StockLevelLocation {
   location_uuid: string
   location_name: string
   quantity: number
+  reserved: number
+  available: number
 }
 
 /** Aggregated stock level for an ingredient across all locations */
@@ -422,9 +429,117 @@ export interface StockLevel {
   category: string
   default_unit: string
   total_on_hand: number
+  total_reserved: number
+  total_available: number
   locations: StockLevelLocation[]
 }
 
+// ============================================================================
+// Inventory Valuation Types
+// ============================================================================
+
+/** Costing method used to value inventory */
+export type ValuationMethod = 'fifo' | 'weighted_average'
+
+/** Inventory valuation settings */
+export interface ValuationSettings {
+  method: ValuationMethod
+}
+
+/** Ingredient lot left out of valuation because its cost is unknown */
+export interface UncostedLot {
+  ingredient_lot_uuid: string
+  ingredient_uuid: string
+  ingredient_name: string
+  quantity: number
+  unit: string
+  reason: 'no_purchase_order_line' | 'purchase_order_line_not_found' | 'unit_mismatch' | 'no_exchange_rate'
+}
+
+/** Value of one ingredient held at one location, in the base currency */
+export interface ValuationItem {
+  ingredient_uuid: string
+  ingredient_name: string
+  category: string
+  stock_location_uuid: string
+  stock_location_name: string
+  quantity: number
+  unit: string
+  value_cents: number
+  unit_cost_cents?: number
+}
+
+/** Inventory value for one ingredient category */
+export interface ValuationCategoryTotal {
+  category: string
+  value_cents: number
+}
+
+/** Inventory value held at one stock location */
+export interface ValuationLocationTotal {
+  stock_location_uuid: string
+  stock_location_name: string
+  value_cents: number
+}
+
+/** As-of-date inventory valuation report */
+export interface InventoryValuation {
+  as_of: string
+  method: ValuationMethod
+  base_currency?: string
+  total_value_cents: number
+  categories: ValuationCategoryTotal[]
+  locations: ValuationLocationTotal[]
+  items: ValuationItem[]
+  uncosted_lots: UncostedLot[]
+}
+
+/** Movement valued in the base currency */
+export interface CostedMovement {
+  movement_uuid: string
+  occurred_at: string
+  ingredient_lot_uuid: string
+  ingredient_uuid: string
+  ingredient_name: string
+  category: string
+  stock_location_uuid: string
+  direction: 'in' | 'out'
+  reason: string
+  amount: number
+  amount_unit: string
+  value_cents: number
+}
+
+/** Cost of movements out of inventory for one reason */
+export interface ConsumptionReasonTotal {
+  reason: string
+  cost_cents: number
+  movements: number
+}
+
+/** Cost of movements out of inventory for one ingredient category */
+export interface ConsumptionCategoryTotal {
+  category: string
+  cost_cents: number
+}
+
+/** Consumption (COGS) report for a period; closing = opening + receipts + adjustments in - consumption */
+export interface InventoryConsumption {
+  from: string
+  to: string
+  method: ValuationMethod
+  base_currency?: string
+  opening_value_cents: number
+  receipts_cents: number
+  adjustments_in_cents: number
+  consumption_cents: number
+  closing_value_cents: number
+  by_reason: ConsumptionReasonTotal[]
+  by_category: ConsumptionCategoryTotal[]
+  movements: CostedMovement[]
+  uncosted_lots: UncostedLot[]
+}
+
 // ============================================================================
 // Batch Usage Types
 // ============================================================================
@@ -554,3 +669,230 @@ export interface RemovalCategorySummary {
   total_bbl: number
   count: number
 }
+
+// ============================================================================
+// Supplier Return Types
+// ============================================================================
+
+/** Why goods were returned to the supplier */
+export type SupplierReturnReason =
+  | 'damaged'
+  | 'dead_on_arrival'
+  | 'quality_reject'
+  | 'wrong_item'
+  | 'expired'
+  | 'other'
+
+/** Progress of the credit expected from the supplier for a return */
+export type CreditStatus = 'requested' | 'credited' | 'refused'
+
+/** Whether a return has been recorded against its purchase order line in Procurement */
+export type PurchaseOrderSyncStatus = 'pending' | 'synced' | 'failed'
+
+/** Part of an ingredient lot returned to its supplier, taken out of stock with a return movement */
+export interface SupplierReturn {
+  uuid: string
+  ingredient_lot_uuid: string
+  brewery_lot_code?: string
+  ingredient_uuid: string
+  ingredient_name: string
+  stock_location_uuid: string
+  purchase_order_line_uuid: string
+  amount: number
+  amount_unit: string
+  reason: SupplierReturnReason
+  reference_code?: string
+  returned_at: string
+  credit_amount_cents?: number
+  credit_currency?: string
+  credit_status: CreditStatus
+  credit_resolved_at?: string
+  purchase_order_sync_status: PurchaseOrderSyncStatus
+  purchase_order_synced_at?: string
+  purchase_order_sync_error?: string
+  notes?: string
+  movement_uuid?: string
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for returning part of an ingredient lot to its supplier */
+export interface CreateSupplierReturnRequest {
+  ingredient_lot_uuid: string
+  stock_location_uuid: string
+  amount: number
+  amount_unit: string
+  reason: SupplierReturnReason
+  reference_code?: string | null
+  returned_at?: string | null
+  credit_amount_cents?: number | null
+  credit_currency?: string | null
+  notes?: string | null
+}
+
+/** Request payload for tracking the credit of a supplier return */
+export interface UpdateSupplierReturnRequest {
+  credit_status?: CreditStatus
+  credit_amount_cents?: number
+  credit_currency?: string
+  reference_code?: string
+  notes?: string
+}
+
+/** Lifecycle of a cycle count session */
+export type CycleCountStatus = 'open' | 'posted' | 'cancelled'
+
+/** Totals of a count sheet; variance_value_cents nets the valued variances */
+export interface CycleCountSummary {
+  lines_total: number
+  lines_counted: number
+  lines_with_variance: number
+  variance_value_cents: number
+  unvalued_variances: number
+  value_currency?: string
+}
+
+/** One lot on a count sheet; expected amounts and variances are withheld in an open blind count */
+export interface CycleCountLine {
+  uuid: string
+  ingredient_lot_uuid?: string
+  beer_lot_uuid?: string
+  lot_code?: string
+  item_name?: string
+  amount_unit: string
+  expected_amount?: number
+  counted_amount?: number
+  counted_at?: string
+  variance?: number
+  variance_value_cents?: number
+  value_currency?: string
+  adjustment_uuid?: string
+  notes?: string
+}
+
+/** A stock count of one location against balances frozen when the session was opened */
+export interface CycleCount {
+  uuid: string
+  stock_location_uuid: string
+  stock_location_name: string
+  status: CycleCountStatus
+  blind: boolean
+  frozen_at: string
+  posted_at?: string
+  notes?: string
+  summary?: CycleCountSummary
+  lines?: CycleCountLine[]
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for opening a cycle count session */
+export interface CreateCycleCountRequest {
+  stock_location_uuid: string
+  blind?: boolean
+  notes?: string | null
+}
+
+/** A counted quantity for a sheet line, or for a lot found at the location that is not on the sheet */
+export interface CycleCountEntry {
+  line_uuid?: string
+  ingredient_lot_uuid?: string
+  beer_lot_uuid?: string
+  amount_unit?: string
+  counted_amount: number
+  notes?: string | null
+}
+
+/** Request payload for recording counted quantities */
+export interface RecordCycleCountRequest {
+  counts: CycleCountEntry[]
+}
+
+/** Line totals shared by the rows of the cycle count accuracy report */
+export interface CycleCountAccuracyTotals {
+  lines_counted: number
+  lines_accurate: number
+  accuracy_percent: number
+  net_variance_value_cents: number
+  absolute_variance_value_cents: number
+  unvalued_variances: number
+}
+
+/** Accuracy of one posted cycle count */
+export interface CycleCountAccuracyEntry extends CycleCountAccuracyTotals {
+  cycle_count_uuid: string
+  stock_location_uuid: string
+  stock_location_name: string
+  posted_at: string
+}
+
+/** Accuracy of the counts posted in a month (YYYY-MM), or in the whole period */
+export interface CycleCountAccuracyPeriod extends CycleCountAccuracyTotals {
+  month?: string
+  counts: number
+}
+
+/** Count accuracy over a period, per count, per month and in total */
+export interface CycleCountAccuracyReport {
+  from: string
+  to: string
+  value_currency?: string
+  counts: CycleCountAccuracyEntry[]
+  months: CycleCountAccuracyPeriod[]
+  total: CycleCountAccuracyPeriod
+}
+
+/** What a label template prints labels for */
+export type LabelSubject = 'ingredient_lot' | 'beer_lot' | 'vessel'
+
+/** Barcode printed on a label */
+export type LabelBarcode = 'code128' | 'qr' | 'none'
+
+/** What the barcode encodes: the lot code or vessel name (falling back to the UUID), or the UUID */
+export type LabelBarcodeContent = 'code' | 'uuid'
+
+/** Output format of the label endpoints */
+export type LabelFormat = 'pdf' | 'zpl'
+
+/** Grid of labels per PDF page for sheet printers */
+export interface LabelSheet {
+  page_width_mm: number
+  page_height_mm: number
+  columns: number
+  rows: number
+  margin_mm: number
+  gap_mm: number
+}
+
+/** A label layout; the first field printed is the title */
+export interface LabelTemplate {
+  uuid: string
+  name: string
+  subject: LabelSubject
+  is_default: boolean
+  width_mm: number
+  height_mm: number
+  fields: string[]
+  barcode: LabelBarcode
+  barcode_content: LabelBarcodeContent
+  font_size_pt: number
+  printer_dpi: number
+  sheet?: LabelSheet
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for creating or replacing a label template */
+export interface LabelTemplateRequest {
+  name: string
+  subject: LabelSubject
+  is_default?: boolean
+  width_mm: number
+  height_mm: number
+  fields: string[]
+  barcode?: LabelBarcode
+  barcode_content?: LabelBarcodeContent
+  font_size_pt?: number
+  printer_dpi?: number
+  sheet?: LabelSheet | null
+}
@@ -71,6 +71,8 @@ export interface UpdatePurchaseOrderRequest {
   ordered_at?: string | null
   expected_at?: string | null
   notes?: string | null
+  /** Receipt date that exchange rates are locked at when receiving; defaults to now */
+  received_at?: string
 }
 
 // ============================================================================
@@ -89,6 +91,8 @@ export interface PurchaseOrderLine {
   quantity_unit: string
   unit_cost_cents: number
   currency: string
+  /** Quantity returned to the supplier */
+  returned_quantity: number
   created_at: string
   updated_at: string
 }
@@ -97,6 +101,9 @@ export interface PurchaseOrderLine {
 // Purchase Order Fee Types
 // ============================================================================
 
+/** How a fee is split across purchase order lines for landed costs */
+export type FeeAllocationBasis = 'value' | 'quantity' | 'weight'
+
 /** A fee associated with a purchase order (shipping, tax, etc.) */
 export interface PurchaseOrderFee {
   uuid: string
@@ -104,6 +111,7 @@ export interface PurchaseOrderFee {
   fee_type: string
   amount_cents: number
   currency: string
+  allocation_basis: FeeAllocationBasis
   created_at: string
   updated_at: string
 }
@@ -127,6 +135,7 @@ export interface CreatePurchaseOrderFeeRequest {
   fee_type: string
   amount_cents: number | null
   currency: string
+  allocation_basis?: FeeAllocationBasis
 }
 
 /** Request payload for updating an existing purchase order line */
@@ -146,4 +155,189 @@ export interface UpdatePurchaseOrderFeeRequest {
   fee_type?: string
   amount_cents?: number
   currency?: string
+  allocation_basis?: FeeAllocationBasis
+}
+
+// ============================================================================
+// Currency Types
+// ============================================================================
+
+/** The currency that costs are reported in */
+export interface CurrencySettings {
+  base_currency: string
+}
+
+/** A dated rate converting one unit of from_currency into to_currency */
+export interface ExchangeRate {
+  uuid: string
+  from_currency: string
+  to_currency: string
+  rate: number
+  effective_date: string
+  source: 'manual' | 'import'
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for recording an exchange rate */
+export interface CreateExchangeRateRequest {
+  from_currency: string
+  /** Defaults to the base currency */
+  to_currency?: string
+  rate: number
+  /** YYYY-MM-DD, defaults to today */
+  effective_date?: string
+}
+
+/** The rate used to report an amount in the base currency */
+export interface AppliedExchangeRate {
+  rate: number
+  rate_date?: string
+  /** True when the rate was fixed at receipt */
+  locked: boolean
+}
+
+/** Purchase order value in one original currency */
+export interface PurchaseOrderCurrencyTotal {
+  currency: string
+  line_total_cents: number
+  fee_total_cents: number
+  total_cents: number
+  exchange_rate?: AppliedExchangeRate
+  base_total_cents?: number
+}
+
+/** Purchase order totals per currency and in the base currency */
+export interface PurchaseOrderTotals {
+  purchase_order_uuid: string
+  base_currency: string
+  currencies: PurchaseOrderCurrencyTotal[]
+  base_line_total_cents?: number
+  base_fee_total_cents?: number
+  base_total_cents?: number
+  exchange_rates_locked: boolean
+}
+
+// ============================================================================
+// Supplier Invoice Types
+// ============================================================================
+
+/** A line on a supplier invoice, billed against a purchase order line */
+export interface SupplierInvoiceLine {
+  uuid: string
+  purchase_order_line_uuid: string
+  quantity: number
+  quantity_unit: string
+  unit_price_cents: number
+  total_cents: number
+  notes?: string
+  created_at: string
+  updated_at: string
+}
+
+/** An invoice received from a supplier for a purchase order */
+export interface SupplierInvoice {
+  uuid: string
+  supplier_uuid: string
+  purchase_order_uuid: string
+  order_number: string
+  invoice_number: string
+  /** YYYY-MM-DD */
+  invoice_date: string
+  /** YYYY-MM-DD */
+  due_date?: string
+  currency: string
+  total_cents: number
+  notes?: string
+  lines: SupplierInvoiceLine[]
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for one line of a new supplier invoice */
+export interface CreateSupplierInvoiceLineRequest {
+  purchase_order_line_uuid: string
+  quantity: number
+  quantity_unit: string
+  unit_price_cents: number
+  notes?: string | null
+}
+
+/** Request payload for recording a supplier invoice */
+export interface CreateSupplierInvoiceRequest {
+  purchase_order_uuid: string
+  invoice_number: string
+  invoice_date: string
+  due_date?: string | null
+  /** Defaults to the currency of the first invoiced line */
+  currency?: string | null
+  notes?: string | null
+  lines: CreateSupplierInvoiceLineRequest[]
+}
+
+/** Three-way match tolerances, as percentages */
+export interface InvoiceMatchSettings {
+  quantity_tolerance_percent: number
+  price_tolerance_percent: number
+  updated_at: string
+}
+
+/** Request payload for changing the three-way match tolerances */
+export interface UpdateInvoiceMatchSettingsRequest {
+  quantity_tolerance_percent: number
+  price_tolerance_percent: number
+}
+
+/** Match status of a purchase order line */
+export type InvoiceMatchStatus =
+  | 'matched'
+  | 'not_received'
+  | 'awaiting_invoice'
+  | 'quantity_variance'
+  | 'price_variance'
+  | 'unit_mismatch'
+
+/** An invoice line billed against a purchase order line */
+export interface InvoiceLineMatch {
+  supplier_invoice_uuid: string
+  supplier_invoice_line_uuid: string
+  invoice_number: string
+  quantity: number
+  quantity_unit: string
+  unit_price_cents: number
+  currency: string
+  /** Invoiced unit price minus the ordered unit cost */
+  price_variance_cents: number
+  within_tolerance: boolean
+}
+
+/** A purchase order line compared with its receipts and invoices */
+export interface PurchaseOrderLineMatch {
+  purchase_order_line_uuid: string
+  line_number: number
+  item_name: string
+  quantity_unit: string
+  currency: string
+  unit_cost_cents: number
+  ordered_quantity: number
+  /** Net of returned_quantity */
+  received_quantity: number
+  returned_quantity: number
+  invoiced_quantity: number
+  /** Invoiced minus received */
+  quantity_variance: number
+  price_variance: boolean
+  status: InvoiceMatchStatus
+  invoices: InvoiceLineMatch[]
+}
+
+/** Three-way match of a purchase order; only fully matched orders can be closed */
+export interface PurchaseOrderMatch {
+  purchase_order_uuid: string
+  order_number: string
+  status: string
+  quantity_tolerance_percent: number
+  price_tolerance_percent: number
+  lines: PurchaseOrderLineMatch[]
+  fully_matched: boolean
 }
@@ -231,6 +231,8 @@ export interface Batch {
   recipe_uuid: string | null
   recipe_name: string | null
   current_phase: string | null
+  planned_volume: number | null
+  planned_volume_unit: VolumeUnit | null
   notes: string | null
   created_at: string
   updated_at: string
@@ -242,6 +244,8 @@ export interface CreateBatchRequest {
   brew_date?: string | null
   notes?: string | null
   recipe_uuid?: string | null
+  planned_volume?: number | null
+  planned_volume_unit?: VolumeUnit | null
 }
 
 /** Request payload for updating an existing batch */
@@ -250,6 +254,8 @@ export interface UpdateBatchRequest {
   brew_date?: string | null
   notes?: string | null
   recipe_uuid?: string | null
+  planned_volume?: number | null
+  planned_volume_unit?: VolumeUnit | null
 }
 
 // ============================================================================
@@ -702,6 +708,26 @@ export interface CreatePackageFormatRequest {
   volume_per_unit_unit: string
 }
 
+/** A packaging material used per unit of a package format (bill of materials) */
+export interface PackageFormatMaterial {
+  uuid: string
+  package_format_uuid: string
+  ingredient_uuid: string
+  name: string
+  quantity: number
+  unit: string
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for adding a material to a package format's bill of materials */
+export interface CreatePackageFormatMaterialRequest {
+  ingredient_uuid: string
+  name: string
+  quantity: number
+  unit: string
+}
+
 /** Request payload for updating an existing package format */
 export interface UpdatePackageFormatRequest {
   name?: string
@@ -738,6 +764,7 @@ export interface PackagingRun {
   loss_amount: number | null
   loss_unit: string | null
   notes: string | null
+  material_usage_uuid: string | null
   lines: PackagingRunLine[]
   created_at: string
   updated_at: string
@@ -764,6 +791,56 @@ export interface CreatePackagingRunRequest {
   lot_code_prefix?: string
 }
 
+/** Request payload for checking packaging material stock before a run */
+export interface PackagingMaterialCheckRequest {
+  lines: CreatePackagingRunLineRequest[]
+  stock_location_uuid?: string
+}
+
+/** A proposed deduction of a packaging material from one lot at one location */
+export interface PackagingMaterialPick {
+  ingredient_lot_uuid: string
+  brewery_lot_code: string | null
+  stock_location_uuid: string
+  stock_location_name: string
+  amount: number
+  amount_unit: string
+  available_amount: number
+  received_at: string
+  best_by_at: string | null
+  expires_at: string | null
+}
+
+/** The requirement for one packaging material across a run */
+export interface PackagingMaterialLine {
+  ingredient_uuid: string
+  name: string
+  required_amount: number
+  allocated_amount: number
+  shortfall_amount: number
+  amount_unit: string
+  picks: PackagingMaterialPick[]
+}
+
+/** Packaging material requirements and proposed picks for a run */
+export interface PackagingMaterialCheck {
+  lines: PackagingMaterialLine[]
+  complete: boolean
+}
+
+/** Request payload for completing a packaging run */
+export interface CompletePackagingRunRequest {
+  ended_at?: string
+  stock_location_uuid?: string
+  notes?: string
+}
+
+/** A completed packaging run with the packaging materials deducted for it */
+export interface CompletePackagingRunResponse {
+  packaging_run: PackagingRun
+  materials: PackagingMaterialLine[]
+}
+
 // ============================================================================
 // Batch Cost Types
 // ============================================================================
@@ -788,6 +865,12 @@ export interface CostLineItem {
   unit_cost_cents: number | null
   unit_cost_unit: string | null
   cost_cents: number | null
+  material_cost_cents: number | null
+  fee_cost_cents: number | null
+  currency: string | null
+  exchange_rate: number | null
+  exchange_rate_locked: boolean | null
+  base_cost_cents: number | null
   cost_source: CostSource
   purchase_order_line_uuid: string | null
 }
@@ -804,20 +887,159 @@ export interface UncostedAddition {
 /** Aggregate cost totals for a batch */
 export interface CostTotals {
   total_cost_cents: number
+  material_cost_cents: number
+  fee_cost_cents: number
   costed_line_count: number
   uncosted_line_count: number
   cost_complete: boolean
   cost_per_bbl_cents: number | null
   batch_volume_bbl: number | null
+  base_total_cost_cents: number | null
+  base_cost_per_bbl_cents: number | null
+}
+
+/** A packaging material lot deducted by one of the batch's packaging runs */
+export interface PackagingMaterialCost {
+  movement_uuid: string
+  packaging_run_uuid: string
+  package_format_uuid: string | null
+  ingredient_lot_uuid: string
+  ingredient_uuid: string
+  name: string
+  lot_code: string | null
+  quantity: number
+  unit: string
+  unit_cost_cents: number | null
+  currency: string | null
+  purchase_order_line_uuid: string | null
+  cost_source: CostSource
+  cost_cents: number | null
+}
+
+/** Labor on a batch for one role at one hourly rate */
+export interface LaborCost {
+  role: string
+  hourly_rate_cents: number
+  hours: number
+  cost_cents: number
+}
+
+/** An active overhead rate applied to a batch */
+export interface OverheadCost {
+  overhead_rate_uuid: string
+  name: string
+  basis: OverheadBasis
+  rate_cents: number
+  quantity: number
+  cost_cents: number
+}
+
+/** Beer removed from a batch, valued at the liquid cost per barrel */
+export interface LossWriteOff {
+  removal_uuid: string
+  category: string
+  reason: string
+  amount: number
+  amount_unit: string
+  volume_bbl: number | null
+  removed_at: string
+  cost_cents: number | null
+}
+
+/** Cost of the units packaged in one package format */
+export interface PackageFormatCost {
+  package_format_uuid: string
+  package_format_name: string
+  units: number
+  volume_bbl: number
+  liquid_cost_cents: number | null
+  material_cost_cents: number
+  total_cost_cents: number | null
+  cost_per_unit_cents: number | null
+}
+
+/** Full batch cost in the base currency */
+export interface FullCostTotals {
+  ingredient_cost_cents: number | null
+  packaging_material_cost_cents: number
+  uncosted_packaging_material_count: number
+  labor_cost_cents: number
+  overhead_cost_cents: number
+  liquid_cost_cents: number | null
+  total_cost_cents: number | null
+  cost_per_bbl_cents: number | null
+  loss_volume_bbl: number
+  loss_write_off_cents: number | null
+  vessel_days: number
+  packaged_units: number
+  cost_per_unit_cents: number | null
 }
 
 /** Full batch cost breakdown response */
 export interface BatchCostsResponse {
   batch_uuid: string
   currency: string | null
+  base_currency: string | null
   line_items: CostLineItem[]
   uncosted_additions: UncostedAddition[]
   totals: CostTotals
+  packaging_materials: PackagingMaterialCost[]
+  labor: LaborCost[]
+  overhead: OverheadCost[]
+  loss_write_offs: LossWriteOff[]
+  package_formats: PackageFormatCost[]
+  full_cost: FullCostTotals
+  snapshot_at: string | null
+}
+
+/** Basis an overhead rate is charged on */
+export type OverheadBasis = 'per_bbl' | 'per_vessel_day'
+
+/** An overhead cost charged to every batch */
+export interface OverheadRate {
+  uuid: string
+  name: string
+  basis: OverheadBasis
+  rate_cents: number
+  is_active: boolean
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for creating an overhead rate */
+export interface CreateOverheadRateRequest {
+  name: string
+  basis: OverheadBasis
+  rate_cents: number
+  is_active?: boolean
+}
+
+/** Request payload for switching an overhead rate on or off */
+export interface UpdateOverheadRateRequest {
+  is_active: boolean
+}
+
+/** Time worked on a batch */
+export interface BatchLaborEntry {
+  uuid: string
+  batch_uuid: string
+  role: string
+  hours: number
+  hourly_rate_cents: number
+  cost_cents: number
+  worked_at: string
+  notes: string | null
+  created_at: string
+  updated_at: string
+}
+
+/** Request payload for recording labor on a batch */
+export interface CreateBatchLaborEntryRequest {
+  role: string
+  hours: number
+  hourly_rate_cents: number
+  worked_at?: string
+  notes?: string
 }
 
 // ============================================================================
@@ -859,3 +1081,22 @@ export interface BatchSummary {
   created_at: string
   updated_at: string
 }
+
+/** Kind of entity a scanned code resolved to */
+export type ScanEntityType = 'vessel' | 'batch' | 'ingredient_lot' | 'beer_lot' | 'beer_lot_item' | 'keg'
+
+/** One entity a scanned code resolved to, with the actions allowed in its current state */
+export interface ScanMatch {
+  entity_type: ScanEntityType
+  uuid: string
+  name: string
+  matched_on: string
+  state: string
+  actions: string[]
+}
+
+/** Response of GET /scan/{code} */
+export interface ScanResponse {
+  code: string
+  matches: ScanMatch[]
+}